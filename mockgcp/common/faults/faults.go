@@ -0,0 +1,84 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faults
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// Injector returns injected errors from the RPCs of a set of gRPC services,
+// so that tests can exercise retry and backoff against the mocks.
+type Injector struct {
+	// services are the full names of the gRPC services (e.g. `mockgcp.logging.v2.ConfigServiceV2`) whose calls we intercept.
+	services map[string]bool
+
+	mutex  sync.Mutex
+	faults map[string][]error
+}
+
+// NewInjector creates an Injector for the calls to the named gRPC services.
+func NewInjector(serviceNames ...string) *Injector {
+	i := &Injector{
+		services: make(map[string]bool),
+		faults:   make(map[string][]error),
+	}
+	for _, serviceName := range serviceNames {
+		i.services[serviceName] = true
+	}
+	return i
+}
+
+// Inject causes the next count calls to method (e.g. `GetLink`) to return err.
+// Faults queued for the same method are returned in the order they were injected.
+func (i *Injector) Inject(method string, count int, err error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for n := 0; n < count; n++ {
+		i.faults[method] = append(i.faults[method], err)
+	}
+}
+
+// Next consumes and returns the next fault injected for method, or nil if there is none.
+func (i *Injector) Next(method string) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	pending := i.faults[method]
+	if len(pending) == 0 {
+		return nil
+	}
+	i.faults[method] = pending[1:]
+	return pending[0]
+}
+
+// UnaryServerInterceptor returns an interceptor that fails calls to our services with their injected faults.
+// Calls to other services on the same server are passed through unchanged.
+func (i *Injector) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// FullMethod is of the form `/package.Service/Method`
+		service, method, ok := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		if ok && i.services[service] {
+			if err := i.Next(method); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
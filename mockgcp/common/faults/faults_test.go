@@ -0,0 +1,80 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faults
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInterceptorReturnsInjectedFaults(t *testing.T) {
+	ctx := context.Background()
+	i := NewInjector("test.Service")
+	i.Inject("Get", 2, status.Error(codes.Unavailable, "try again"))
+
+	intercept := i.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	// Other methods and services are not affected.
+	for _, fullMethod := range []string{"/test.Service/List", "/other.Service/Get"} {
+		if _, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler); err != nil {
+			t.Errorf("unexpected error calling %s: %v", fullMethod, err)
+		}
+	}
+
+	var codesSeen []codes.Code
+	for n := 0; n < 3; n++ {
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Get"}, handler)
+		codesSeen = append(codesSeen, status.Code(err))
+	}
+	want := []codes.Code{codes.Unavailable, codes.Unavailable, codes.OK}
+	for n := range want {
+		if codesSeen[n] != want[n] {
+			t.Errorf("unexpected result of call %d; got %v, want %v", n+1, codesSeen[n], want[n])
+		}
+	}
+}
+
+func TestInjectorIsThreadSafe(t *testing.T) {
+	i := NewInjector("test.Service")
+	i.Inject("Get", 100, status.Error(codes.Unavailable, "try again"))
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	failures := 0
+	for n := 0; n < 200; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := i.Next("Get"); err != nil {
+				mutex.Lock()
+				failures++
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failures != 100 {
+		t.Errorf("unexpected number of injected failures; got %d, want 100", failures)
+	}
+}
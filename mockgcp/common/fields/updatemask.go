@@ -15,83 +15,97 @@
 package fields
 
 import (
-	"errors"
-	"fmt"
 	"strings"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
-	"k8s.io/klog/v2"
 )
 
-// UpdateByFieldMask updates the `original` Message with the `update` Message value in the given `updatePaths` fields
+// UpdateByFieldMask updates the `original` Message with the `update` Message value in the given `updatePaths` fields.
+// Paths may be nested (e.g. `bigquery_dataset.dataset_id`), and may use either the proto or the JSON field names.
+// Fields that are named but unset in `update` are cleared in `original`. All paths are validated before `original`
+// is changed, and an unknown path is an InvalidArgument error.
 func UpdateByFieldMask(original, update proto.Message, updatePaths []string) error {
-	var errs []error
+	descriptor := original.ProtoReflect().Descriptor()
+	if update.ProtoReflect().Descriptor().FullName() != descriptor.FullName() {
+		return status.Errorf(codes.Internal, "cannot update %v from %v", descriptor.FullName(), update.ProtoReflect().Descriptor().FullName())
+	}
+
+	var resolved [][]protoreflect.FieldDescriptor
 	for _, path := range updatePaths {
-		if err := walk(original, update, path); err != nil {
-			errs = append(errs, err)
+		fds, err := resolvePath(descriptor, path)
+		if err != nil {
+			return err
 		}
+		resolved = append(resolved, fds)
 	}
-	return errors.Join(errs...)
-}
-
-func walk(original, update proto.Message, path string) error {
-	originalRm := original.ProtoReflect()
-	updateRm := update.ProtoReflect()
-	segments := strings.SplitN(path, ".", 2)
-	if len(segments) == 1 {
-		return replace(originalRm, updateRm, segments[0])
+	for _, fds := range resolved {
+		replace(original.ProtoReflect(), update.ProtoReflect(), fds)
 	}
-	return walk(originalChildMessage(originalRm, segments[0]), updateChildMessage(updateRm, segments[0]), segments[1])
+	return nil
 }
 
-func replace(original, update protoreflect.Message, fieldName string) error {
-	originalFd := original.Descriptor().Fields().ByJSONName(fieldName)
-	originalVal := original.Get(originalFd)
-	updateFd := update.Descriptor().Fields().ByJSONName(fieldName)
-	updateVal := update.Get(updateFd)
+// resolvePath returns the fields along path, starting from a message of type descriptor.
+func resolvePath(descriptor protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	segments := strings.Split(path, ".")
+	var fds []protoreflect.FieldDescriptor
+	for i, segment := range segments {
+		if descriptor == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
+		}
+		fd := descriptor.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			fd = descriptor.Fields().ByJSONName(segment)
+		}
+		if fd == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
+		}
+		fds = append(fds, fd)
 
-	// Update Map
-	if originalFd.IsMap() {
-		originalVal.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
-			originalVal.Map().Clear(k)
-			return true
-		})
-		updateVal.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
-			originalVal.Map().Set(k, v)
-			return true
-		})
-		return nil
-	}
-	// Update List
-	if originalFd.IsList() {
-		originalVal.List().Truncate(0)
-		for i := 0; i < updateVal.List().Len(); i++ {
-			originalVal.List().Append(updateVal.List().Get(i))
+		// Only singular messages have fields that can be named by the rest of the path.
+		descriptor = nil
+		if i < len(segments)-1 && fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			descriptor = fd.Message()
 		}
-		return nil
 	}
+	return fds, nil
+}
 
-	switch originalFd.Kind() {
-	case protoreflect.MessageKind, protoreflect.StringKind, protoreflect.DoubleKind, protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.BoolKind, protoreflect.EnumKind:
-		if !original.IsValid() {
-			return fmt.Errorf("%s is read-only or empty", fieldName)
+func replace(original, update protoreflect.Message, fds []protoreflect.FieldDescriptor) {
+	for _, fd := range fds[:len(fds)-1] {
+		update = update.Get(fd).Message()
+		original = original.Mutable(fd).Message()
+	}
+
+	fd := fds[len(fds)-1]
+	original.Clear(fd)
+	if !update.Has(fd) {
+		return
+	}
+	value := update.Get(fd)
+	switch {
+	case fd.IsList():
+		list := original.Mutable(fd).List()
+		for i := 0; i < value.List().Len(); i++ {
+			list.Append(cloneValue(fd, value.List().Get(i)))
 		}
-		original.Set(updateFd, updateVal)
-		return nil
+	case fd.IsMap():
+		m := original.Mutable(fd).Map()
+		value.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			m.Set(k, cloneValue(fd.MapValue(), v))
+			return true
+		})
 	default:
-		klog.Warningf("unhandled type %v for field %v", originalFd.Kind(), fieldName)
-		return fmt.Errorf("unhandled type %v for field %v", originalFd.Kind(), fieldName)
+		original.Set(fd, cloneValue(fd, value))
 	}
 }
 
-// originalChildMessage get the orignal Message's mutable reference to the `fieldName“ composite.
-func originalChildMessage(m protoreflect.Message, fieldName string) proto.Message {
-	fd := m.Descriptor().Fields().ByJSONName(fieldName)
-	return m.Mutable(fd).Message().Interface()
-}
-
-func updateChildMessage(m protoreflect.Message, fieldName string) proto.Message {
-	fd := m.Descriptor().Fields().ByJSONName(fieldName)
-	return m.Get(fd).Message().Interface()
+// cloneValue copies message values, so that `original` does not share them with `update`.
+func cloneValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		return v
+	}
+	return protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
 }
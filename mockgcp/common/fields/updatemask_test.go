@@ -0,0 +1,117 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fields
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestUpdateByFieldMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		dst   proto.Message
+		src   proto.Message
+		paths []string
+		want  proto.Message
+	}{
+		{
+			name:  "only masked fields are copied",
+			dst:   &pb.LogBucket{Description: "original", RetentionDays: 30},
+			src:   &pb.LogBucket{Description: "ignored", RetentionDays: 60, Locked: true},
+			paths: []string{"retention_days", "locked"},
+			want:  &pb.LogBucket{Description: "original", RetentionDays: 60, Locked: true},
+		},
+		{
+			name:  "JSON names",
+			dst:   &pb.LogBucket{RetentionDays: 30},
+			src:   &pb.LogBucket{RetentionDays: 60},
+			paths: []string{"retentionDays"},
+			want:  &pb.LogBucket{RetentionDays: 60},
+		},
+		{
+			name:  "masked fields that are unset in src are cleared",
+			dst:   &pb.LogBucket{Description: "original", RetentionDays: 30},
+			src:   &pb.LogBucket{},
+			paths: []string{"description"},
+			want:  &pb.LogBucket{RetentionDays: 30},
+		},
+		{
+			name:  "nested path",
+			dst:   &pb.Link{Description: "original", BigqueryDataset: &pb.BigQueryDataset{DatasetId: "old"}},
+			src:   &pb.Link{Description: "ignored", BigqueryDataset: &pb.BigQueryDataset{DatasetId: "new"}},
+			paths: []string{"bigquery_dataset.dataset_id"},
+			want:  &pb.Link{Description: "original", BigqueryDataset: &pb.BigQueryDataset{DatasetId: "new"}},
+		},
+		{
+			name:  "nested path into an unset message",
+			dst:   &pb.Link{},
+			src:   &pb.Link{BigqueryDataset: &pb.BigQueryDataset{DatasetId: "new"}},
+			paths: []string{"bigqueryDataset.datasetId"},
+			want:  &pb.Link{BigqueryDataset: &pb.BigQueryDataset{DatasetId: "new"}},
+		},
+		{
+			name:  "repeated fields are replaced",
+			dst:   &pb.LogSink{Exclusions: []*pb.LogExclusion{{Name: "a"}, {Name: "b"}}},
+			src:   &pb.LogSink{Exclusions: []*pb.LogExclusion{{Name: "c"}}},
+			paths: []string{"exclusions"},
+			want:  &pb.LogSink{Exclusions: []*pb.LogExclusion{{Name: "c"}}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := UpdateByFieldMask(tc.dst, tc.src, tc.paths); err != nil {
+				t.Fatalf("UpdateByFieldMask failed: %v", err)
+			}
+			if !proto.Equal(tc.dst, tc.want) {
+				t.Errorf("unexpected result; got %v, want %v", tc.dst, tc.want)
+			}
+		})
+	}
+}
+
+func TestUpdateByFieldMaskDoesNotAliasUpdate(t *testing.T) {
+	dst := &pb.Link{}
+	src := &pb.Link{BigqueryDataset: &pb.BigQueryDataset{DatasetId: "new"}}
+	if err := UpdateByFieldMask(dst, src, []string{"bigquery_dataset"}); err != nil {
+		t.Fatalf("UpdateByFieldMask failed: %v", err)
+	}
+	src.BigqueryDataset.DatasetId = "changed"
+	if got := dst.GetBigqueryDataset().GetDatasetId(); got != "new" {
+		t.Errorf("dst changed along with src; got dataset_id %q", got)
+	}
+}
+
+func TestUpdateByFieldMaskInvalidPath(t *testing.T) {
+	for _, path := range []string{"no_such_field", "bigquery_dataset.no_such_field", "description.length", ""} {
+		t.Run(path, func(t *testing.T) {
+			dst := &pb.Link{Description: "original"}
+			src := &pb.Link{Description: "updated"}
+			err := UpdateByFieldMask(dst, src, []string{"description", path})
+			if code := status.Code(err); code != codes.InvalidArgument {
+				t.Fatalf("unexpected error code for path %q; got %v (%v), want %v", path, code, err, codes.InvalidArgument)
+			}
+			// No path is applied if any of them is invalid.
+			if dst.GetDescription() != "original" {
+				t.Errorf("dst was changed by an invalid mask: %v", dst)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	pb "google.golang.org/genproto/googleapis/longrunning"
@@ -36,12 +37,24 @@ import (
 type Operations struct {
 	storage storage.Storage
 
+	// mutex guards pending
+	mutex sync.Mutex
+	// pending holds the operations started with PollLRO that are not yet done, keyed by name.
+	pending map[string]*pendingLRO
+
 	pb.UnimplementedOperationsServer
 }
 
+// pendingLRO is an operation that completes once it has been polled a number of times.
+type pendingLRO struct {
+	pollsRemaining int
+	callback       func() (proto.Message, error)
+}
+
 func NewOperationsService(storage storage.Storage) *Operations {
 	return &Operations{
 		storage: storage,
+		pending: make(map[string]*pendingLRO),
 	}
 }
 
@@ -132,6 +145,7 @@ func markDone(op *pb.Operation, result proto.Message, err error) error {
 	if err != nil {
 		op.Result = &pb.Operation_Error{
 			Error: &rpcstatus.Status{
+				Code:    int32(status.Code(err)),
 				Message: fmt.Sprintf("error processing operation: %v", err),
 			},
 		}
@@ -152,8 +166,68 @@ func markDone(op *pb.Operation, result proto.Message, err error) error {
 }
 
 func (s *Operations) DoneLRO(ctx context.Context, prefix string, metadata proto.Message, result proto.Message) (*pb.Operation, error) {
-	now := time.Now()
-	millis := now.UnixMilli()
+	op, err := s.newOperation(prefix, metadata)
+	if err != nil {
+		return nil, err
+	}
+	if err := markDone(op, result, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Create(ctx, op.Name, op); err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating LRO: %v", err)
+	}
+
+	return op, nil
+}
+
+// DoneLROWithError creates an operation that has already failed with err.
+// The gRPC status code of err is reported as the code of the operation error.
+func (s *Operations) DoneLROWithError(ctx context.Context, prefix string, metadata proto.Message, err error) (*pb.Operation, error) {
+	op, err2 := s.newOperation(prefix, metadata)
+	if err2 != nil {
+		return nil, err2
+	}
+	if err2 := markDone(op, nil, err); err2 != nil {
+		return nil, err2
+	}
+
+	if err := s.storage.Create(ctx, op.Name, op); err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating LRO: %v", err)
+	}
+	return op, nil
+}
+
+// PollLRO creates an operation that is reported as not done for the first polls calls to GetOperation.
+// On the following call, callback is invoked and the operation completes with its result or error.
+// This lets tests exercise the polling logic of controllers deterministically, unlike StartLRO.
+func (s *Operations) PollLRO(ctx context.Context, prefix string, metadata proto.Message, polls int, callback func() (proto.Message, error)) (*pb.Operation, error) {
+	op, err := s.newOperation(prefix, metadata)
+	if err != nil {
+		return nil, err
+	}
+	if polls <= 0 {
+		result, err := callback()
+		if err2 := markDone(op, result, err); err2 != nil {
+			return nil, err2
+		}
+	}
+
+	if err := s.storage.Create(ctx, op.Name, op); err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating LRO: %v", err)
+	}
+
+	if polls > 0 {
+		s.mutex.Lock()
+		s.pending[op.Name] = &pendingLRO{pollsRemaining: polls, callback: callback}
+		s.mutex.Unlock()
+	}
+	return op, nil
+}
+
+// newOperation builds an operation that is not done, with a unique name under prefix.
+func (s *Operations) newOperation(prefix string, metadata proto.Message) (*pb.Operation, error) {
+	millis := time.Now().UnixMilli()
 	id := uuid.NewUUID()
 
 	op := &pb.Operation{}
@@ -162,11 +236,6 @@ func (s *Operations) DoneLRO(ctx context.Context, prefix string, metadata proto.
 	if prefix != "" {
 		op.Name = prefix + "/" + op.Name
 	}
-	op.Done = false
-
-	if err := markDone(op, result, nil); err != nil {
-		return nil, err
-	}
 
 	if metadata != nil {
 		metadataAny, err := anypb.New(metadata)
@@ -177,13 +246,34 @@ func (s *Operations) DoneLRO(ctx context.Context, prefix string, metadata proto.
 
 		op.Metadata = metadataAny
 	}
-	fqn := op.Name
+	return op, nil
+}
 
-	if err := s.storage.Create(ctx, fqn, op); err != nil {
-		return nil, status.Errorf(codes.Internal, "error creating LRO: %v", err)
+// pollPending counts a poll of an operation started with PollLRO, completing the operation
+// when it has been polled enough times.
+func (s *Operations) pollPending(ctx context.Context, op *pb.Operation) error {
+	s.mutex.Lock()
+	pending := s.pending[op.Name]
+	if pending == nil {
+		s.mutex.Unlock()
+		return nil
+	}
+	if pending.pollsRemaining > 0 {
+		pending.pollsRemaining--
+		s.mutex.Unlock()
+		return nil
 	}
+	delete(s.pending, op.Name)
+	s.mutex.Unlock()
 
-	return op, nil
+	result, err := pending.callback()
+	if err2 := markDone(op, result, err); err2 != nil {
+		return err2
+	}
+	if err := s.storage.Update(ctx, op.Name, op); err != nil {
+		return status.Errorf(codes.Internal, "error updating LRO: %v", err)
+	}
+	return nil
 }
 
 func rewriteTypes(any *anypb.Any) {
@@ -209,5 +299,9 @@ func (s *Operations) GetOperation(ctx context.Context, req *pb.GetOperationReque
 		return nil, err
 	}
 
+	if err := s.pollPending(ctx, op); err != nil {
+		return nil, err
+	}
+
 	return op, nil
 }
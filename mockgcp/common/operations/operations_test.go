@@ -0,0 +1,175 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+const testPrefix = "projects/test-project/locations/global"
+
+// getOperation fails the test if the operation cannot be read back.
+func getOperation(t *testing.T, s *Operations, name string) *pb.Operation {
+	t.Helper()
+
+	op, err := s.GetOperation(context.Background(), &pb.GetOperationRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetOperation(%q) failed: %v", name, err)
+	}
+	return op
+}
+
+// wantResponse fails the test if the operation is not done with the expected response.
+func wantResponse(t *testing.T, op *pb.Operation, want proto.Message) {
+	t.Helper()
+
+	if !op.GetDone() {
+		t.Fatalf("expected operation to be done, got %v", op)
+	}
+	got := proto.Clone(want)
+	proto.Reset(got)
+	if err := op.GetResponse().UnmarshalTo(got); err != nil {
+		t.Fatalf("unmarshalling response of %v: %v", op, err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("unexpected response; got %v, want %v", got, want)
+	}
+}
+
+func TestDoneLRO(t *testing.T) {
+	ctx := context.Background()
+	s := NewOperationsService(storage.NewInMemoryStorage())
+
+	metadata := wrapperspb.String("creating")
+	op, err := s.DoneLRO(ctx, testPrefix, metadata, structpb.NewStringValue("created"))
+	if err != nil {
+		t.Fatalf("DoneLRO failed: %v", err)
+	}
+	if !strings.HasPrefix(op.GetName(), testPrefix+"/operations/") {
+		t.Errorf("unexpected operation name %q", op.GetName())
+	}
+
+	got := getOperation(t, s, op.GetName())
+	wantResponse(t, got, structpb.NewStringValue("created"))
+	gotMetadata := &wrapperspb.StringValue{}
+	if err := got.GetMetadata().UnmarshalTo(gotMetadata); err != nil {
+		t.Fatalf("unmarshalling metadata: %v", err)
+	}
+	if !proto.Equal(gotMetadata, metadata) {
+		t.Errorf("unexpected metadata; got %v, want %v", gotMetadata, metadata)
+	}
+}
+
+func TestDoneLROWithError(t *testing.T) {
+	ctx := context.Background()
+	s := NewOperationsService(storage.NewInMemoryStorage())
+
+	op, err := s.DoneLROWithError(ctx, testPrefix, nil, status.Errorf(codes.FailedPrecondition, "bucket is locked"))
+	if err != nil {
+		t.Fatalf("DoneLROWithError failed: %v", err)
+	}
+
+	got := getOperation(t, s, op.GetName())
+	if !got.GetDone() || got.GetResponse() != nil {
+		t.Fatalf("expected operation to be done without a response, got %v", got)
+	}
+	if code := codes.Code(got.GetError().GetCode()); code != codes.FailedPrecondition {
+		t.Errorf("unexpected error code; got %v, want %v", code, codes.FailedPrecondition)
+	}
+	if !strings.Contains(got.GetError().GetMessage(), "bucket is locked") {
+		t.Errorf("unexpected error message %q", got.GetError().GetMessage())
+	}
+}
+
+func TestPollLRO(t *testing.T) {
+	ctx := context.Background()
+	s := NewOperationsService(storage.NewInMemoryStorage())
+
+	calls := 0
+	op, err := s.PollLRO(ctx, testPrefix, nil, 2, func() (proto.Message, error) {
+		calls++
+		return structpb.NewStringValue("created"), nil
+	})
+	if err != nil {
+		t.Fatalf("PollLRO failed: %v", err)
+	}
+	if op.GetDone() {
+		t.Fatalf("expected operation to be pending, got %v", op)
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := getOperation(t, s, op.GetName()); got.GetDone() {
+			t.Fatalf("expected operation to be pending on poll %d, got %v", i+1, got)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("callback should not run while the operation is pending; ran %d times", calls)
+	}
+
+	wantResponse(t, getOperation(t, s, op.GetName()), structpb.NewStringValue("created"))
+	// Further polls return the stored result, without running the callback again.
+	wantResponse(t, getOperation(t, s, op.GetName()), structpb.NewStringValue("created"))
+	if calls != 1 {
+		t.Errorf("expected callback to run once, ran %d times", calls)
+	}
+}
+
+func TestPollLROWithError(t *testing.T) {
+	ctx := context.Background()
+	s := NewOperationsService(storage.NewInMemoryStorage())
+
+	op, err := s.PollLRO(ctx, testPrefix, nil, 1, func() (proto.Message, error) {
+		return nil, status.Errorf(codes.ResourceExhausted, "quota exceeded")
+	})
+	if err != nil {
+		t.Fatalf("PollLRO failed: %v", err)
+	}
+
+	if got := getOperation(t, s, op.GetName()); got.GetDone() {
+		t.Fatalf("expected operation to be pending on the first poll, got %v", got)
+	}
+	got := getOperation(t, s, op.GetName())
+	if !got.GetDone() {
+		t.Fatalf("expected operation to be done on the second poll, got %v", got)
+	}
+	if code := codes.Code(got.GetError().GetCode()); code != codes.ResourceExhausted {
+		t.Errorf("unexpected error code; got %v, want %v", code, codes.ResourceExhausted)
+	}
+}
+
+func TestPollLROWithoutPollsIsDone(t *testing.T) {
+	ctx := context.Background()
+	s := NewOperationsService(storage.NewInMemoryStorage())
+
+	op, err := s.PollLRO(ctx, testPrefix, nil, 0, func() (proto.Message, error) {
+		return structpb.NewStringValue("created"), nil
+	})
+	if err != nil {
+		t.Fatalf("PollLRO failed: %v", err)
+	}
+	wantResponse(t, op, structpb.NewStringValue("created"))
+	wantResponse(t, getOperation(t, s, op.GetName()), structpb.NewStringValue("created"))
+}
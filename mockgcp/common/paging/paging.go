@@ -0,0 +1,51 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paging
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Page returns the page of items that follows pageToken, and the token for the next page.
+// items must be sorted by key; a pageSize of zero or less returns all remaining items.
+// The returned token is empty when there are no further pages.
+//
+// Tokens encode the last key returned rather than an offset, so they remain valid
+// across mock restarts and when objects are created or deleted between pages.
+func Page[T any](items []T, key func(T) string, pageSize int32, pageToken string) ([]T, string, error) {
+	start := 0
+	if pageToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil || len(b) == 0 {
+			return nil, "", status.Errorf(codes.InvalidArgument, "page token %q is not valid", pageToken)
+		}
+		lastKey := string(b)
+		start = sort.Search(len(items), func(i int) bool {
+			return key(items[i]) > lastKey
+		})
+	}
+
+	remaining := items[start:]
+	if pageSize <= 0 || int(pageSize) >= len(remaining) {
+		return remaining, "", nil
+	}
+	page := remaining[:pageSize]
+	nextPageToken := base64.RawURLEncoding.EncodeToString([]byte(key(page[len(page)-1])))
+	return page, nextPageToken, nil
+}
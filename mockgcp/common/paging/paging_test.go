@@ -0,0 +1,90 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paging
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func identity(s string) string { return s }
+
+// allPages follows next page tokens until the last page, returning each page.
+func allPages(t *testing.T, items []string, pageSize int32) [][]string {
+	t.Helper()
+
+	var pages [][]string
+	token := ""
+	for {
+		page, next, err := Page(items, identity, pageSize, token)
+		if err != nil {
+			t.Fatalf("Page(%q) failed: %v", token, err)
+		}
+		pages = append(pages, page)
+		if next == "" {
+			return pages
+		}
+		token = next
+	}
+}
+
+func TestPage(t *testing.T) {
+	grid := []struct {
+		name     string
+		items    []string
+		pageSize int32
+		want     string
+	}{
+		{name: "empty", items: nil, pageSize: 2, want: "[[]]"},
+		{name: "exact multiple", items: []string{"a", "b", "c", "d"}, pageSize: 2, want: "[[a b] [c d]]"},
+		{name: "partial last page", items: []string{"a", "b", "c"}, pageSize: 2, want: "[[a b] [c]]"},
+		{name: "no page size", items: []string{"a", "b", "c"}, pageSize: 0, want: "[[a b c]]"},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			if got := fmt.Sprint(allPages(t, g.items, g.pageSize)); got != g.want {
+				t.Errorf("unexpected pages; got %v, want %v", got, g.want)
+			}
+		})
+	}
+}
+
+func TestPageTokenSurvivesChanges(t *testing.T) {
+	_, token, err := Page([]string{"a", "b", "c"}, identity, 1, "")
+	if err != nil {
+		t.Fatalf("Page failed: %v", err)
+	}
+
+	// "a" was deleted and "aa" was created after the first page was returned.
+	page, _, err := Page([]string{"aa", "b", "c"}, identity, 1, token)
+	if err != nil {
+		t.Fatalf("Page failed: %v", err)
+	}
+	if fmt.Sprint(page) != "[aa]" {
+		t.Errorf("unexpected second page; got %v, want [aa]", page)
+	}
+}
+
+func TestPageInvalidToken(t *testing.T) {
+	for _, token := range []string{"not base64!", "="} {
+		_, _, err := Page([]string{"a"}, identity, 1, token)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Page(%q): unexpected error; got %v, want code %v", token, err, codes.InvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projects
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FakeProjectStore is a ProjectStore that knows about a fixed set of projects.
+// It lets the tests of a single mock service run without mockresourcemanager.
+type FakeProjectStore struct {
+	projects []*ProjectData
+}
+
+var _ ProjectStore = &FakeProjectStore{}
+
+// NewFakeProjectStore returns a FakeProjectStore for the given projects.
+func NewFakeProjectStore(projects ...*ProjectData) *FakeProjectStore {
+	return &FakeProjectStore{projects: projects}
+}
+
+// GetProject returns the project for the parsed ProjectName.
+func (s *FakeProjectStore) GetProject(name *ProjectName) (*ProjectData, error) {
+	if name.ProjectID != "" {
+		return s.GetProjectByID(name.ProjectID)
+	}
+	return s.GetProjectByNumber(strconv.FormatInt(name.ProjectNumber, 10))
+}
+
+// GetProjectByID returns the project with the specified project id, or NotFound.
+func (s *FakeProjectStore) GetProjectByID(projectID string) (*ProjectData, error) {
+	for _, project := range s.projects {
+		if project.ID == projectID {
+			return project, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+}
+
+// GetProjectByNumber returns the project with the specified project number, or NotFound.
+func (s *FakeProjectStore) GetProjectByNumber(projectNumber string) (*ProjectData, error) {
+	for _, project := range s.projects {
+		if strconv.FormatInt(project.Number, 10) == projectNumber {
+			return project, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "project %q not found", projectNumber)
+}
+
+// GetProjectByIDOrNumber returns the project for the provided id or number.
+func (s *FakeProjectStore) GetProjectByIDOrNumber(projectIDOrNumber string) (*ProjectData, error) {
+	name, err := ParseProjectIDOrNumber(projectIDOrNumber)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetProject(name)
+}
@@ -139,6 +139,11 @@ type SupportsTestCommands interface {
 	RunTestCommand(ctx context.Context, service string, command string) error
 }
 
+// SupportsUnaryServerInterceptor is implemented by services that intercept their gRPC calls, for example to inject faults.
+type SupportsUnaryServerInterceptor interface {
+	UnaryServerInterceptor() grpc.UnaryServerInterceptor
+}
+
 func NewMockRoundTripper(t *testing.T, k8sClient client.Client, storage storage.Storage) Interface {
 	ctx := context.Background()
 
@@ -159,9 +164,6 @@ func NewMockRoundTripper(t *testing.T, k8sClient client.Client, storage storage.
 	resourcemanagerService := mockresourcemanager.New(env, storage)
 	env.Projects = resourcemanagerService.GetProjectStore()
 
-	var serverOpts []grpc.ServerOption
-	server := grpc.NewServer(serverOpts...)
-
 	var services []MockService
 
 	services = append(services, resourcemanagerService)
@@ -213,6 +215,17 @@ func NewMockRoundTripper(t *testing.T, k8sClient client.Client, storage storage.
 	services = append(services, mockbigqueryanalyticshub.New(env, storage))
 	services = append(services, mockvpcaccess.New(env, storage))
 
+	var interceptors []grpc.UnaryServerInterceptor
+	for _, service := range services {
+		if supportsInterceptor, ok := service.(SupportsUnaryServerInterceptor); ok {
+			interceptors = append(interceptors, supportsInterceptor.UnaryServerInterceptor())
+		}
+	}
+
+	var serverOpts []grpc.ServerOption
+	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(interceptors...))
+	server := grpc.NewServer(serverOpts...)
+
 	for _, service := range services {
 		service.Register(server)
 	}
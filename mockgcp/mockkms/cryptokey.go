@@ -23,7 +23,7 @@ package mockkms
 import (
 	"context"
 	"fmt"
-	"strings"
+	"sort"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -32,7 +32,9 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
 )
 
 func (r *kmsServer) GetCryptoKey(ctx context.Context, req *pb.GetCryptoKeyRequest) (*pb.CryptoKey, error) {
@@ -51,9 +53,126 @@ func (r *kmsServer) GetCryptoKey(ctx context.Context, req *pb.GetCryptoKeyReques
 		return nil, err
 	}
 
+	if err := r.rotateCryptoKeyIfDue(ctx, obj); err != nil {
+		return nil, err
+	}
+
 	return obj, nil
 }
 
+func (r *kmsServer) ListCryptoKeys(ctx context.Context, req *pb.ListCryptoKeysRequest) (*pb.ListCryptoKeysResponse, error) {
+	parentName, err := r.parseKeyRingName(req.GetParent())
+	if err != nil {
+		return nil, err
+	}
+	namePrefix := parentName.String() + "/cryptoKeys/"
+
+	matches, err := parseNameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListCryptoKeysResponse{}
+
+	var names []string
+	cryptoKeyKind := (&pb.CryptoKey{}).ProtoReflect().Descriptor()
+	if err := r.storage.List(ctx, cryptoKeyKind, storage.ListOptions{
+		Prefix: namePrefix,
+	}, func(obj proto.Message) error {
+		if name := obj.(*pb.CryptoKey).GetName(); matches(name) {
+			names = append(names, name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	// Go through GetCryptoKey so that listed crypto keys are also rotated when due.
+	var cryptoKeys []*pb.CryptoKey
+	for _, name := range names {
+		cryptoKey, err := r.GetCryptoKey(ctx, &pb.GetCryptoKeyRequest{Name: name})
+		if err != nil {
+			return nil, err
+		}
+		cryptoKeys = append(cryptoKeys, cryptoKey)
+	}
+	response.TotalSize = int32(len(cryptoKeys))
+
+	page, nextPageToken, err := paging.Page(cryptoKeys, (*pb.CryptoKey).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.CryptoKeys = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+const (
+	// minCryptoKeyRotationPeriod and maxCryptoKeyRotationPeriod bound the rotation_period of a crypto key.
+	minCryptoKeyRotationPeriod = 24 * time.Hour
+	maxCryptoKeyRotationPeriod = 876000 * time.Hour
+)
+
+// validateCryptoKeyRotation checks the rotation schedule of a new crypto key.
+// Only ENCRYPT_DECRYPT keys can be rotated automatically, and a rotation_period needs a next_rotation_time.
+func validateCryptoKeyRotation(obj *pb.CryptoKey) error {
+	if obj.GetRotationPeriod() == nil && obj.GetNextRotationTime() == nil {
+		return nil
+	}
+	if obj.GetPurpose() != pb.CryptoKey_ENCRYPT_DECRYPT {
+		return status.Errorf(codes.InvalidArgument, "Only keys with purpose ENCRYPT_DECRYPT support automatic rotation.")
+	}
+	if obj.GetRotationPeriod() != nil {
+		period := obj.GetRotationPeriod().AsDuration()
+		if period < minCryptoKeyRotationPeriod || period > maxCryptoKeyRotationPeriod {
+			return status.Errorf(codes.InvalidArgument, "The rotation period must be at least %v and at most %v.", minCryptoKeyRotationPeriod, maxCryptoKeyRotationPeriod)
+		}
+		if obj.GetNextRotationTime() == nil {
+			return status.Errorf(codes.InvalidArgument, "The next rotation time must be set when the rotation period is set.")
+		}
+	}
+	return nil
+}
+
+// rotateCryptoKeyIfDue simulates automatic rotation: once the clock passes next_rotation_time, it creates a new
+// version from the version template, makes it primary, and advances next_rotation_time by the rotation period
+// (or clears it, for a key without a period). A key that missed several rotations is rotated only once.
+func (r *kmsServer) rotateCryptoKeyIfDue(ctx context.Context, obj *pb.CryptoKey) error {
+	if obj.GetNextRotationTime() == nil {
+		return nil
+	}
+	now := r.now()
+	nextRotationTime := obj.GetNextRotationTime().AsTime()
+	if now.Before(nextRotationTime) {
+		return nil
+	}
+
+	version, err := r.CreateCryptoKeyVersion(ctx, &pb.CreateCryptoKeyVersionRequest{
+		Parent: obj.GetName(),
+		CryptoKeyVersion: &pb.CryptoKeyVersion{
+			Algorithm:       obj.GetVersionTemplate().GetAlgorithm(),
+			ProtectionLevel: obj.GetVersionTemplate().GetProtectionLevel(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	obj.Primary = version
+
+	if obj.GetRotationPeriod() == nil {
+		obj.NextRotationTime = nil
+	} else {
+		period := obj.GetRotationPeriod().AsDuration()
+		for !nextRotationTime.After(now) {
+			nextRotationTime = nextRotationTime.Add(period)
+		}
+		obj.NextRotationTime = timestamppb.New(nextRotationTime)
+	}
+
+	return r.storage.Update(ctx, obj.GetName(), obj)
+}
+
 func (r *kmsServer) CreateCryptoKey(ctx context.Context, req *pb.CreateCryptoKeyRequest) (*pb.CryptoKey, error) {
 	reqName := fmt.Sprintf("%s/cryptoKeys/%s", req.GetParent(), req.GetCryptoKeyId())
 	name, err := r.parseCryptoKeyName(reqName)
@@ -63,7 +182,7 @@ func (r *kmsServer) CreateCryptoKey(ctx context.Context, req *pb.CreateCryptoKey
 
 	fqn := name.String()
 
-	now := time.Now()
+	now := r.now()
 
 	obj := proto.Clone(req.GetCryptoKey()).(*pb.CryptoKey)
 	obj.Name = fqn
@@ -71,6 +190,13 @@ func (r *kmsServer) CreateCryptoKey(ctx context.Context, req *pb.CreateCryptoKey
 
 	r.populateDefaultsForCryptoKey(name, obj)
 
+	if err := validateCryptoKeyRotation(obj); err != nil {
+		return nil, err
+	}
+	if err := r.validateCryptoKeyBackend(ctx, name, obj); err != nil {
+		return nil, err
+	}
+
 	if !req.SkipInitialVersionCreation {
 		var primary *pb.CryptoKeyVersion
 
@@ -130,21 +256,13 @@ func (n *CryptoKeyName) String() string {
 // parseCryptoKeyName parses a string into an CryptoKeyName.
 // The expected form is `projects/*/locations/*/keyRings/*/cryptoKeys/*`.
 func (r *kmsServer) parseCryptoKeyName(name string) (*CryptoKeyName, error) {
-	tokens := strings.Split(name, "/")
-
-	if len(tokens) == 8 && tokens[6] == "cryptoKeys" {
-		keyRingName, err := r.parseKeyRingName(strings.Join(tokens[0:6], "/"))
-		if err != nil {
-			return nil, err
-		}
-
-		name := &CryptoKeyName{
-			KeyRingName: *keyRingName,
-			CryptoKeyID: tokens[7],
-		}
-
-		return name, nil
+	keyRingName, ids, err := r.parseKMSName(name, "cryptoKeys")
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	return &CryptoKeyName{
+		KeyRingName: *keyRingName,
+		CryptoKeyID: ids[0],
+	}, nil
 }
@@ -0,0 +1,199 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+const testCryptoKeyFQN = testKeyRingFQN + "/cryptoKeys/mykey"
+
+func TestCryptoKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.SetClock(func() time.Time { return now })
+
+	createTestKeyRing(t, r)
+	period := 48 * time.Hour
+	created, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+		Parent:      testKeyRingFQN,
+		CryptoKeyId: "mykey",
+		CryptoKey: &pb.CryptoKey{
+			Purpose:          pb.CryptoKey_ENCRYPT_DECRYPT,
+			NextRotationTime: timestamppb.New(now.Add(period)),
+			RotationSchedule: &pb.CryptoKey_RotationPeriod{RotationPeriod: durationpb.New(period)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateCryptoKey failed: %v", err)
+	}
+	if want := testCryptoKeyFQN + "/cryptoKeyVersions/1"; created.GetPrimary().GetName() != want {
+		t.Fatalf("unexpected initial primary version; got %q, want %q", created.GetPrimary().GetName(), want)
+	}
+
+	// Before the rotation time, Get returns the key unchanged.
+	now = now.Add(period - time.Minute)
+	got, err := r.GetCryptoKey(ctx, &pb.GetCryptoKeyRequest{Name: testCryptoKeyFQN})
+	if err != nil {
+		t.Fatalf("GetCryptoKey failed: %v", err)
+	}
+	if got.GetPrimary().GetName() != created.GetPrimary().GetName() {
+		t.Errorf("key rotated before its rotation time; got primary %q", got.GetPrimary().GetName())
+	}
+
+	// Once the rotation time passes, a new version becomes primary and the next rotation is scheduled.
+	now = now.Add(2 * time.Minute)
+	got, err = r.GetCryptoKey(ctx, &pb.GetCryptoKeyRequest{Name: testCryptoKeyFQN})
+	if err != nil {
+		t.Fatalf("GetCryptoKey failed: %v", err)
+	}
+	if want := testCryptoKeyFQN + "/cryptoKeyVersions/2"; got.GetPrimary().GetName() != want {
+		t.Fatalf("unexpected primary version after rotation; got %q, want %q", got.GetPrimary().GetName(), want)
+	}
+	if got.GetPrimary().GetState() != pb.CryptoKeyVersion_ENABLED || got.GetPrimary().GetAlgorithm() != pb.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION {
+		t.Errorf("unexpected rotated version %v", got.GetPrimary())
+	}
+	if want := created.GetNextRotationTime().AsTime().Add(period); !got.GetNextRotationTime().AsTime().Equal(want) {
+		t.Errorf("unexpected next rotation time; got %v, want %v", got.GetNextRotationTime().AsTime(), want)
+	}
+
+	// The rotation is stored, so reading the key again does not rotate it again.
+	again, err := r.GetCryptoKey(ctx, &pb.GetCryptoKeyRequest{Name: testCryptoKeyFQN})
+	if err != nil {
+		t.Fatalf("GetCryptoKey failed: %v", err)
+	}
+	if again.GetPrimary().GetName() != got.GetPrimary().GetName() {
+		t.Errorf("key rotated twice in one period; got primary %q", again.GetPrimary().GetName())
+	}
+	versions, err := r.ListCryptoKeyVersions(ctx, &pb.ListCryptoKeyVersionsRequest{Parent: testCryptoKeyFQN})
+	if err != nil {
+		t.Fatalf("ListCryptoKeyVersions failed: %v", err)
+	}
+	if len(versions.GetCryptoKeyVersions()) != 2 {
+		t.Errorf("expected two versions after one rotation, got %v", versions.GetCryptoKeyVersions())
+	}
+}
+
+func TestCryptoKeyRotationValidation(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	grid := []struct {
+		name      string
+		cryptoKey *pb.CryptoKey
+	}{
+		{
+			name: "period under 24h",
+			cryptoKey: &pb.CryptoKey{
+				Purpose:          pb.CryptoKey_ENCRYPT_DECRYPT,
+				NextRotationTime: timestamppb.New(now.Add(time.Hour)),
+				RotationSchedule: &pb.CryptoKey_RotationPeriod{RotationPeriod: durationpb.New(23 * time.Hour)},
+			},
+		},
+		{
+			name: "period without next rotation time",
+			cryptoKey: &pb.CryptoKey{
+				Purpose:          pb.CryptoKey_ENCRYPT_DECRYPT,
+				RotationSchedule: &pb.CryptoKey_RotationPeriod{RotationPeriod: durationpb.New(24 * time.Hour)},
+			},
+		},
+		{
+			name: "asymmetric key",
+			cryptoKey: &pb.CryptoKey{
+				Purpose:          pb.CryptoKey_ASYMMETRIC_SIGN,
+				NextRotationTime: timestamppb.New(now.Add(time.Hour)),
+				VersionTemplate:  &pb.CryptoKeyVersionTemplate{Algorithm: pb.CryptoKeyVersion_EC_SIGN_P256_SHA256},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestKMSServer(t)
+			createTestKeyRing(t, r)
+
+			_, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+				Parent:      testKeyRingFQN,
+				CryptoKeyId: "mykey",
+				CryptoKey:   g.cryptoKey,
+			})
+			wantCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestListCryptoKeysFilterAndPaging(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	for _, id := range []string{"signing-b", "encryption", "signing-a"} {
+		if _, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+			Parent:      testKeyRingFQN,
+			CryptoKeyId: id,
+			CryptoKey:   &pb.CryptoKey{Purpose: pb.CryptoKey_ENCRYPT_DECRYPT},
+		}); err != nil {
+			t.Fatalf("CreateCryptoKey failed: %v", err)
+		}
+	}
+
+	var ids []string
+	pageToken := ""
+	for {
+		page, err := r.ListCryptoKeys(ctx, &pb.ListCryptoKeysRequest{
+			Parent:    testKeyRingFQN,
+			Filter:    "name:signing-",
+			PageSize:  1,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			t.Fatalf("ListCryptoKeys failed: %v", err)
+		}
+		if page.GetTotalSize() != 2 {
+			t.Errorf("unexpected total size; got %d, want 2", page.GetTotalSize())
+		}
+		for _, obj := range page.GetCryptoKeys() {
+			ids = append(ids, strings.TrimPrefix(obj.GetName(), testKeyRingFQN+"/cryptoKeys/"))
+		}
+		pageToken = page.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+	if got, want := strings.Join(ids, ","), "signing-a,signing-b"; got != want {
+		t.Errorf("unexpected crypto keys listed; got %v, want %v", got, want)
+	}
+
+	all, err := r.ListCryptoKeys(ctx, &pb.ListCryptoKeysRequest{Parent: testKeyRingFQN})
+	if err != nil {
+		t.Fatalf("ListCryptoKeys failed: %v", err)
+	}
+	if len(all.GetCryptoKeys()) != 3 || all.GetCryptoKeys()[0].GetName() != testKeyRingFQN+"/cryptoKeys/encryption" {
+		t.Errorf("unexpected crypto keys listed; got %v", all.GetCryptoKeys())
+	}
+
+	_, err = r.ListCryptoKeys(ctx, &pb.ListCryptoKeysRequest{Parent: testKeyRingFQN, Filter: "purpose=ENCRYPT_DECRYPT"})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = r.ListCryptoKeys(ctx, &pb.ListCryptoKeysRequest{Parent: testLocation})
+	wantCode(t, err, codes.InvalidArgument)
+}
@@ -93,8 +93,33 @@ func (r *kmsServer) listCryptoKeyVersions(ctx context.Context, parentFQN string)
 }
 
 func (r *kmsServer) CreateCryptoKeyVersion(ctx context.Context, req *pb.CreateCryptoKeyVersionRequest) (*pb.CryptoKeyVersion, error) {
+	name, err := r.nextCryptoKeyVersionName(ctx, req.GetParent())
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	now := r.now()
 
-	versions, err := r.listCryptoKeyVersions(ctx, req.GetParent())
+	var obj *pb.CryptoKeyVersion
+	obj = proto.Clone(req.GetCryptoKeyVersion()).(*pb.CryptoKeyVersion)
+	obj.Name = fqn
+	obj.CreateTime = timestamppb.New(now)
+	obj.GenerateTime = timestamppb.New(now)
+	obj.State = pb.CryptoKeyVersion_ENABLED
+	obj.Algorithm = req.CryptoKeyVersion.GetAlgorithm()
+	obj.ProtectionLevel = req.CryptoKeyVersion.GetProtectionLevel()
+
+	if err := r.storage.Create(ctx, fqn, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// nextCryptoKeyVersionName returns the name of the next version of the crypto key; versions are numbered from 1.
+func (r *kmsServer) nextCryptoKeyVersionName(ctx context.Context, parent string) (*CryptoKeyVersionName, error) {
+	versions, err := r.listCryptoKeyVersions(ctx, parent)
 	if err != nil {
 		return nil, err
 	}
@@ -113,31 +138,137 @@ func (r *kmsServer) CreateCryptoKeyVersion(ctx context.Context, req *pb.CreateCr
 
 	nextVersion := maxVersion + 1
 
-	reqName := fmt.Sprintf("%s/cryptoKeyVersions/%d", req.GetParent(), nextVersion)
-	name, err := r.parseCryptoKeyVersionName(reqName)
+	return r.parseCryptoKeyVersionName(fmt.Sprintf("%s/cryptoKeyVersions/%d", parent, nextVersion))
+}
+
+// ImportCryptoKeyVersion creates a new version of the crypto key, or re-imports into an existing version,
+// from key material that was wrapped with the public key of an import job.
+// The mock does not unwrap the key material; it only checks that some was provided.
+func (r *kmsServer) ImportCryptoKeyVersion(ctx context.Context, req *pb.ImportCryptoKeyVersionRequest) (*pb.CryptoKeyVersion, error) {
+	cryptoKey, err := r.GetCryptoKey(ctx, &pb.GetCryptoKeyRequest{Name: req.GetParent()})
 	if err != nil {
 		return nil, err
 	}
-	fqn := name.String()
 
-	now := time.Now()
+	if req.GetAlgorithm() == pb.CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "ImportCryptoKeyVersionRequest.algorithm is required.")
+	}
+	if len(req.GetWrappedKey()) == 0 && len(req.GetRsaAesWrappedKey()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ImportCryptoKeyVersionRequest.wrapped_key is required.")
+	}
 
-	var obj *pb.CryptoKeyVersion
-	obj = proto.Clone(req.GetCryptoKeyVersion()).(*pb.CryptoKeyVersion)
-	obj.Name = fqn
-	obj.CreateTime = timestamppb.New(now)
-	obj.GenerateTime = timestamppb.New(now)
-	obj.State = pb.CryptoKeyVersion_ENABLED
-	obj.Algorithm = req.CryptoKeyVersion.GetAlgorithm()
-	obj.ProtectionLevel = req.CryptoKeyVersion.GetProtectionLevel()
+	importJob, err := r.getImportJobForImport(ctx, req.GetImportJob())
+	if err != nil {
+		return nil, err
+	}
+
+	protectionLevel := cryptoKey.GetVersionTemplate().GetProtectionLevel()
+	if protectionLevel == pb.ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED {
+		protectionLevel = pb.ProtectionLevel_SOFTWARE
+	}
+	if protectionLevel != importJob.GetProtectionLevel() {
+		return nil, status.Errorf(codes.InvalidArgument, "ImportJob %s has protection_level %v, but CryptoKey %s creates versions with protection_level %v.", importJob.GetName(), importJob.GetProtectionLevel(), cryptoKey.GetName(), protectionLevel)
+	}
+
+	now := r.now()
+
+	if req.GetCryptoKeyVersion() != "" {
+		// Re-import into an existing version, which must have been imported before.
+		name, err := r.parseCryptoKeyVersionName(req.GetCryptoKeyVersion())
+		if err != nil {
+			return nil, err
+		}
+		if name.CryptoKeyName.String() != cryptoKey.GetName() {
+			return nil, status.Errorf(codes.InvalidArgument, "CryptoKeyVersion %s is not a version of CryptoKey %s.", name, cryptoKey.GetName())
+		}
+		fqn := name.String()
+		obj, err := r.GetCryptoKeyVersion(ctx, &pb.GetCryptoKeyVersionRequest{Name: fqn})
+		if err != nil {
+			return nil, err
+		}
+		if obj.GetImportJob() == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "CryptoKeyVersion %s was not imported.", fqn)
+		}
+		if obj.GetState() != pb.CryptoKeyVersion_DESTROYED && obj.GetState() != pb.CryptoKeyVersion_IMPORT_FAILED {
+			return nil, status.Errorf(codes.FailedPrecondition, "CryptoKeyVersion %s is in state %v; only DESTROYED or IMPORT_FAILED versions can be re-imported.", fqn, obj.GetState())
+		}
+		if obj.GetProtectionLevel() != importJob.GetProtectionLevel() {
+			return nil, status.Errorf(codes.InvalidArgument, "ImportJob %s has protection_level %v, but CryptoKeyVersion %s has protection_level %v.", importJob.GetName(), importJob.GetProtectionLevel(), fqn, obj.GetProtectionLevel())
+		}
+		setImportedCryptoKeyVersionFields(obj, req, importJob, now)
+		obj.DestroyTime = nil
+		obj.DestroyEventTime = nil
+		obj.ImportFailureReason = ""
+		if err := r.storage.Update(ctx, fqn, obj); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
 
+	name, err := r.nextCryptoKeyVersionName(ctx, cryptoKey.GetName())
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	obj := &pb.CryptoKeyVersion{
+		Name:            fqn,
+		CreateTime:      timestamppb.New(now),
+		ProtectionLevel: protectionLevel,
+	}
+	setImportedCryptoKeyVersionFields(obj, req, importJob, now)
 	if err := r.storage.Create(ctx, fqn, obj); err != nil {
 		return nil, err
 	}
+	return obj, nil
+}
 
+// getImportJobForImport returns the import job that key material is imported with.
+// It returns FailedPrecondition unless the import job is ACTIVE.
+func (r *kmsServer) getImportJobForImport(ctx context.Context, importJobName string) (*pb.ImportJob, error) {
+	name, err := r.parseImportJobName(importJobName)
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	// Unlike GetImportJob, this does not complete key generation: key material cannot be
+	// imported until the client has seen the import job become ACTIVE.
+	obj := &pb.ImportJob{}
+	if err := r.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "ImportJob %s not found.", fqn)
+		}
+		return nil, err
+	}
+	if obj.GetState() == pb.ImportJob_ACTIVE {
+		expired, err := r.advanceImportJobState(obj, r.now())
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			if err := r.storage.Update(ctx, fqn, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if obj.GetState() != pb.ImportJob_ACTIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "ImportJob %s is in state %v; key material can only be imported with an ACTIVE ImportJob.", fqn, obj.GetState())
+	}
 	return obj, nil
 }
 
+// setImportedCryptoKeyVersionFields sets the fields of a crypto key version that an import populates.
+func setImportedCryptoKeyVersionFields(obj *pb.CryptoKeyVersion, req *pb.ImportCryptoKeyVersionRequest, importJob *pb.ImportJob, now time.Time) {
+	obj.State = pb.CryptoKeyVersion_ENABLED
+	obj.Algorithm = req.GetAlgorithm()
+	obj.ImportJob = importJob.GetName()
+	obj.ImportTime = timestamppb.New(now)
+	if obj.ProtectionLevel == pb.ProtectionLevel_HSM {
+		obj.Attestation = fakeAttestation(obj.Name)
+	}
+}
+
 func (r *kmsServer) UpdateCryptoKeyVersion(ctx context.Context, req *pb.UpdateCryptoKeyVersionRequest) (*pb.CryptoKeyVersion, error) {
 	name, err := r.parseCryptoKeyVersionName(req.GetCryptoKeyVersion().GetName())
 	if err != nil {
@@ -162,7 +293,7 @@ func (r *kmsServer) DestroyCryptoKeyVersion(ctx context.Context, req *pb.Destroy
 	}
 	fqn := name.String()
 
-	now := time.Now()
+	now := r.now()
 
 	obj := &pb.CryptoKeyVersion{}
 	if err := r.storage.Get(ctx, fqn, obj); err != nil {
@@ -222,23 +353,17 @@ func (n *CryptoKeyVersionName) String() string {
 // parseCryptoKeyVersionName parses a string into a CryptoKeyVersionName.
 // The expected form is `projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*`.
 func (r *kmsServer) parseCryptoKeyVersionName(name string) (*CryptoKeyVersionName, error) {
-	tokens := strings.Split(name, "/")
-
-	if len(tokens) == 10 && tokens[8] == "cryptoKeyVersions" {
-		cryptoKeyName, err := r.parseCryptoKeyName(strings.Join(tokens[0:8], "/"))
-		if err != nil {
-			return nil, err
-		}
-
-		// TODO:  validate id is numeric
-		id := tokens[9]
-		name := &CryptoKeyVersionName{
-			CryptoKeyName:      *cryptoKeyName,
-			CryptoKeyVersionID: id,
-		}
-
-		return name, nil
+	keyRingName, ids, err := r.parseKMSName(name, "cryptoKeys", "cryptoKeyVersions")
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	// TODO:  validate id is numeric
+	return &CryptoKeyVersionName{
+		CryptoKeyName: CryptoKeyName{
+			KeyRingName: *keyRingName,
+			CryptoKeyID: ids[0],
+		},
+		CryptoKeyVersionID: ids[1],
+	}, nil
 }
@@ -0,0 +1,171 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+const testImportJobFQN = testKeyRingFQN + "/importJobs/myjob"
+
+// createTestImportDestination creates an import job and an import-only crypto key with no versions,
+// both at the SOFTWARE protection level. It returns the import job as created, i.e. PENDING_GENERATION.
+func createTestImportDestination(t *testing.T, r *kmsServer) (*pb.ImportJob, *pb.CryptoKey) {
+	t.Helper()
+	ctx := context.Background()
+
+	createTestKeyRing(t, r)
+	importJob, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "myjob",
+		ImportJob:   newTestImportJob(),
+	})
+	if err != nil {
+		t.Fatalf("CreateImportJob failed: %v", err)
+	}
+	cryptoKey, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+		Parent:      testKeyRingFQN,
+		CryptoKeyId: "mykey",
+		CryptoKey: &pb.CryptoKey{
+			Purpose:    pb.CryptoKey_ENCRYPT_DECRYPT,
+			ImportOnly: true,
+			VersionTemplate: &pb.CryptoKeyVersionTemplate{
+				Algorithm:       pb.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION,
+				ProtectionLevel: pb.ProtectionLevel_SOFTWARE,
+			},
+		},
+		SkipInitialVersionCreation: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateCryptoKey failed: %v", err)
+	}
+	return importJob, cryptoKey
+}
+
+func newTestImportRequest(cryptoKey *pb.CryptoKey) *pb.ImportCryptoKeyVersionRequest {
+	return &pb.ImportCryptoKeyVersionRequest{
+		Parent:    cryptoKey.GetName(),
+		Algorithm: pb.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION,
+		ImportJob: testImportJobFQN,
+		WrappedKeyMaterial: &pb.ImportCryptoKeyVersionRequest_RsaAesWrappedKey{
+			RsaAesWrappedKey: []byte("wrapped"),
+		},
+	}
+}
+
+func TestImportCryptoKeyVersion(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	_, cryptoKey := createTestImportDestination(t, r)
+
+	// The client waits for key generation to complete before importing.
+	if _, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: testImportJobFQN}); err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+
+	imported, err := r.ImportCryptoKeyVersion(ctx, newTestImportRequest(cryptoKey))
+	if err != nil {
+		t.Fatalf("ImportCryptoKeyVersion failed: %v", err)
+	}
+	if want := cryptoKey.GetName() + "/cryptoKeyVersions/1"; imported.GetName() != want {
+		t.Errorf("unexpected version name; got %q, want %q", imported.GetName(), want)
+	}
+	if imported.GetState() != pb.CryptoKeyVersion_ENABLED {
+		t.Errorf("unexpected state %v", imported.GetState())
+	}
+	if imported.GetImportJob() != testImportJobFQN || imported.GetImportTime() == nil {
+		t.Errorf("imported version should record the import job and time; got %v", imported)
+	}
+	if imported.GetProtectionLevel() != pb.ProtectionLevel_SOFTWARE {
+		t.Errorf("unexpected protection level %v", imported.GetProtectionLevel())
+	}
+
+	got, err := r.GetCryptoKeyVersion(ctx, &pb.GetCryptoKeyVersionRequest{Name: imported.GetName()})
+	if err != nil {
+		t.Fatalf("GetCryptoKeyVersion failed: %v", err)
+	}
+	if !proto.Equal(got, imported) {
+		t.Errorf("unexpected version; got %v, want %v", got, imported)
+	}
+
+	// A second import creates the next version.
+	next, err := r.ImportCryptoKeyVersion(ctx, newTestImportRequest(cryptoKey))
+	if err != nil {
+		t.Fatalf("ImportCryptoKeyVersion failed: %v", err)
+	}
+	if want := cryptoKey.GetName() + "/cryptoKeyVersions/2"; next.GetName() != want {
+		t.Errorf("unexpected version name; got %q, want %q", next.GetName(), want)
+	}
+}
+
+func TestImportCryptoKeyVersionRequiresActiveImportJob(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	importJob, cryptoKey := createTestImportDestination(t, r)
+	if importJob.GetState() != pb.ImportJob_PENDING_GENERATION {
+		t.Fatalf("expected newly created import job to be PENDING_GENERATION, got %v", importJob.GetState())
+	}
+
+	_, err := r.ImportCryptoKeyVersion(ctx, newTestImportRequest(cryptoKey))
+	wantCode(t, err, codes.FailedPrecondition)
+
+	versions, err := r.ListCryptoKeyVersions(ctx, &pb.ListCryptoKeyVersionsRequest{Parent: cryptoKey.GetName()})
+	if err != nil {
+		t.Fatalf("ListCryptoKeyVersions failed: %v", err)
+	}
+	if len(versions.GetCryptoKeyVersions()) != 0 {
+		t.Errorf("failed import should not create a version; got %v", versions.GetCryptoKeyVersions())
+	}
+
+	req := newTestImportRequest(cryptoKey)
+	req.ImportJob = testKeyRingFQN + "/importJobs/missing"
+	_, err = r.ImportCryptoKeyVersion(ctx, req)
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestImportCryptoKeyVersionValidatesProtectionLevel(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestImportDestination(t, r)
+	if _, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: testImportJobFQN}); err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+
+	hsmKey, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+		Parent:      testKeyRingFQN,
+		CryptoKeyId: "hsmkey",
+		CryptoKey: &pb.CryptoKey{
+			Purpose:    pb.CryptoKey_ENCRYPT_DECRYPT,
+			ImportOnly: true,
+			VersionTemplate: &pb.CryptoKeyVersionTemplate{
+				Algorithm:       pb.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION,
+				ProtectionLevel: pb.ProtectionLevel_HSM,
+			},
+		},
+		SkipInitialVersionCreation: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateCryptoKey failed: %v", err)
+	}
+
+	_, err = r.ImportCryptoKeyVersion(ctx, newTestImportRequest(hsmKey))
+	wantCode(t, err, codes.InvalidArgument)
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +tool:mockgcp-support
+// proto.service: google.cloud.kms.v1.EkmService
+// proto.resource: EkmConnection
+
+package mockkms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+type ekmServer struct {
+	*MockService
+	pb.UnimplementedEkmServiceServer
+}
+
+func (r *ekmServer) GetEkmConnection(ctx context.Context, req *pb.GetEkmConnectionRequest) (*pb.EkmConnection, error) {
+	name, err := r.parseEkmConnectionName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+
+	obj := &pb.EkmConnection{}
+	if err := r.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "EkmConnection %s not found.", fqn)
+		}
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *ekmServer) CreateEkmConnection(ctx context.Context, req *pb.CreateEkmConnectionRequest) (*pb.EkmConnection, error) {
+	reqName := fmt.Sprintf("%s/ekmConnections/%s", req.GetParent(), req.GetEkmConnectionId())
+	name, err := r.parseEkmConnectionName(reqName)
+	if err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+
+	if err := validateEkmConnection(req.GetEkmConnection()); err != nil {
+		return nil, err
+	}
+
+	obj := proto.Clone(req.GetEkmConnection()).(*pb.EkmConnection)
+	obj.Name = fqn
+	obj.CreateTime = timestamppb.New(r.now())
+	if obj.KeyManagementMode == pb.EkmConnection_KEY_MANAGEMENT_MODE_UNSPECIFIED {
+		obj.KeyManagementMode = pb.EkmConnection_MANUAL
+	}
+	obj.Etag = fields.ComputeWeakEtag(obj)
+
+	if err := r.storage.Create(ctx, fqn, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// validateEkmConnection checks that a new EKM connection says how to reach the external key manager.
+func validateEkmConnection(obj *pb.EkmConnection) error {
+	if len(obj.GetServiceResolvers()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "EkmConnection must have at least one service resolver.")
+	}
+	for _, resolver := range obj.GetServiceResolvers() {
+		if resolver.GetServiceDirectoryService() == "" || resolver.GetHostname() == "" {
+			return status.Errorf(codes.InvalidArgument, "Each service resolver must have a service_directory_service and a hostname.")
+		}
+	}
+	return nil
+}
+
+// validateCryptoKeyBackend checks that an EXTERNAL_VPC crypto key is backed by an existing EKM connection
+// in the key ring's project and location. Keys at other protection levels do not need one.
+func (r *kmsServer) validateCryptoKeyBackend(ctx context.Context, name *CryptoKeyName, obj *pb.CryptoKey) error {
+	if obj.GetVersionTemplate().GetProtectionLevel() != pb.ProtectionLevel_EXTERNAL_VPC {
+		return nil
+	}
+	backend := obj.GetCryptoKeyBackend()
+	if backend == "" {
+		return status.Errorf(codes.InvalidArgument, "crypto_key_backend must be set for keys with protection level EXTERNAL_VPC.")
+	}
+	connectionName, err := r.parseEkmConnectionName(backend)
+	if err != nil {
+		return err
+	}
+	if connectionName.Project.ID != name.Project.ID || connectionName.Location != name.Location {
+		return status.Errorf(codes.InvalidArgument, "crypto_key_backend %s must be in the same project and location as the CryptoKey.", backend)
+	}
+	if err := r.storage.Get(ctx, connectionName.String(), &pb.EkmConnection{}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.FailedPrecondition, "EkmConnection %s does not exist.", backend)
+		}
+		return err
+	}
+	return nil
+}
+
+type EkmConnectionName struct {
+	Project         *projects.ProjectData
+	Location        string
+	EkmConnectionID string
+}
+
+func (n *EkmConnectionName) String() string {
+	return "projects/" + n.Project.ID + "/locations/" + n.Location + "/ekmConnections/" + n.EkmConnectionID
+}
+
+// parseEkmConnectionName parses a string into an EkmConnectionName.
+// The expected form is `projects/*/locations/*/ekmConnections/*`.
+func (s *MockService) parseEkmConnectionName(name string) (*EkmConnectionName, error) {
+	tokens := strings.Split(name, "/")
+
+	if len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "locations" && tokens[4] == "ekmConnections" && tokens[5] != "" {
+		project, err := s.Projects.GetProjectByID(tokens[1])
+		if err != nil {
+			return nil, err
+		}
+
+		name := &EkmConnectionName{
+			Project:         project,
+			Location:        tokens[3],
+			EkmConnectionID: tokens[5],
+		}
+
+		return name, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+}
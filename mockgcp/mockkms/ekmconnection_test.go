@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+const testEkmConnectionFQN = testLocation + "/ekmConnections/myconnection"
+
+// newTestEkmConnection returns a minimal valid EKM connection.
+func newTestEkmConnection() *pb.EkmConnection {
+	return &pb.EkmConnection{
+		ServiceResolvers: []*pb.EkmConnection_ServiceResolver{
+			{
+				ServiceDirectoryService: "projects/" + testProjectID + "/locations/us-central1/namespaces/ekm/services/ekm",
+				Hostname:                "ekm.example.com",
+			},
+		},
+	}
+}
+
+// newTestExternalVPCCryptoKey returns an EXTERNAL_VPC crypto key backed by backend.
+func newTestExternalVPCCryptoKey(backend string) *pb.CryptoKey {
+	return &pb.CryptoKey{
+		Purpose: pb.CryptoKey_ENCRYPT_DECRYPT,
+		VersionTemplate: &pb.CryptoKeyVersionTemplate{
+			Algorithm:       pb.CryptoKeyVersion_EXTERNAL_SYMMETRIC_ENCRYPTION,
+			ProtectionLevel: pb.ProtectionLevel_EXTERNAL_VPC,
+		},
+		CryptoKeyBackend: backend,
+	}
+}
+
+func TestEkmConnectionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	ekm := &ekmServer{MockService: newTestMockService(t)}
+
+	created, err := ekm.CreateEkmConnection(ctx, &pb.CreateEkmConnectionRequest{
+		Parent:          testLocation,
+		EkmConnectionId: "myconnection",
+		EkmConnection:   newTestEkmConnection(),
+	})
+	if err != nil {
+		t.Fatalf("CreateEkmConnection failed: %v", err)
+	}
+	if created.GetName() != testEkmConnectionFQN {
+		t.Errorf("unexpected name; got %q, want %q", created.GetName(), testEkmConnectionFQN)
+	}
+	if created.GetKeyManagementMode() != pb.EkmConnection_MANUAL || created.GetEtag() == "" || created.GetCreateTime() == nil {
+		t.Errorf("expected defaults to be populated; got %v", created)
+	}
+
+	got, err := ekm.GetEkmConnection(ctx, &pb.GetEkmConnectionRequest{Name: testEkmConnectionFQN})
+	if err != nil {
+		t.Fatalf("GetEkmConnection failed: %v", err)
+	}
+	if got.GetEtag() != created.GetEtag() {
+		t.Errorf("unexpected etag; got %q, want %q", got.GetEtag(), created.GetEtag())
+	}
+
+	_, err = ekm.CreateEkmConnection(ctx, &pb.CreateEkmConnectionRequest{
+		Parent:          testLocation,
+		EkmConnectionId: "myconnection",
+		EkmConnection:   newTestEkmConnection(),
+	})
+	wantCode(t, err, codes.AlreadyExists)
+
+	_, err = ekm.GetEkmConnection(ctx, &pb.GetEkmConnectionRequest{Name: testLocation + "/ekmConnections/doesnotexist"})
+	wantCode(t, err, codes.NotFound)
+
+	for _, connection := range []*pb.EkmConnection{{}, {ServiceResolvers: []*pb.EkmConnection_ServiceResolver{{Hostname: "ekm.example.com"}}}} {
+		_, err = ekm.CreateEkmConnection(ctx, &pb.CreateEkmConnectionRequest{
+			Parent:          testLocation,
+			EkmConnectionId: "invalid",
+			EkmConnection:   connection,
+		})
+		wantCode(t, err, codes.InvalidArgument)
+	}
+}
+
+func TestCreateExternalVPCCryptoKey(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	ekm := &ekmServer{MockService: r.MockService}
+	createTestKeyRing(t, r)
+
+	if _, err := ekm.CreateEkmConnection(ctx, &pb.CreateEkmConnectionRequest{
+		Parent:          testLocation,
+		EkmConnectionId: "myconnection",
+		EkmConnection:   newTestEkmConnection(),
+	}); err != nil {
+		t.Fatalf("CreateEkmConnection failed: %v", err)
+	}
+
+	created, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+		Parent:      testKeyRingFQN,
+		CryptoKeyId: "external",
+		CryptoKey:   newTestExternalVPCCryptoKey(testEkmConnectionFQN),
+	})
+	if err != nil {
+		t.Fatalf("CreateCryptoKey failed: %v", err)
+	}
+	if created.GetCryptoKeyBackend() != testEkmConnectionFQN || created.GetPrimary().GetProtectionLevel() != pb.ProtectionLevel_EXTERNAL_VPC {
+		t.Errorf("unexpected crypto key %v", created)
+	}
+}
+
+func TestCreateExternalVPCCryptoKeyRequiresEkmConnection(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	grid := []struct {
+		backend string
+		want    codes.Code
+	}{
+		{backend: testEkmConnectionFQN, want: codes.FailedPrecondition},
+		{backend: "", want: codes.InvalidArgument},
+		{backend: "projects/" + testProjectID + "/locations/europe-west1/ekmConnections/myconnection", want: codes.InvalidArgument},
+		{backend: testLocation + "/ekmConnections", want: codes.InvalidArgument},
+	}
+	for _, g := range grid {
+		_, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+			Parent:      testKeyRingFQN,
+			CryptoKeyId: "external",
+			CryptoKey:   newTestExternalVPCCryptoKey(g.backend),
+		})
+		wantCode(t, err, g.want)
+	}
+
+	// Nothing was created for the rejected keys.
+	_, err := r.GetCryptoKey(ctx, &pb.GetCryptoKeyRequest{Name: testKeyRingFQN + "/cryptoKeys/external"})
+	wantCode(t, err, codes.NotFound)
+}
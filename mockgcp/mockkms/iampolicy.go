@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+// The IAM methods are served for key rings and crypto keys, with the policy of each resource stored under its name.
+// They are not registered as a gRPC service: the IAMPolicy service is shared by all the mocks on the gRPC server,
+// and over HTTP the :getIamPolicy and :setIamPolicy verbs are served by the mock round tripper.
+
+func (r *kmsServer) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest) (*iampb.Policy, error) {
+	fqn, err := r.iamResourceName(ctx, req.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	policy, _, err := r.getIAMPolicy(ctx, fqn)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (r *kmsServer) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest) (*iampb.Policy, error) {
+	fqn, err := r.iamResourceName(ctx, req.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPolicy() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "policy is required")
+	}
+	existing, found, err := r.getIAMPolicy(ctx, fqn)
+	if err != nil {
+		return nil, err
+	}
+
+	// An etag means the caller read-modified-wrote the policy; reject the write if the policy changed in between.
+	if len(req.GetPolicy().GetEtag()) != 0 && !bytes.Equal(req.GetPolicy().GetEtag(), existing.GetEtag()) {
+		return nil, status.Errorf(codes.Aborted, "There were concurrent policy changes. Please retry the whole read-modify-write with exponential backoff.")
+	}
+
+	policy := proto.Clone(req.GetPolicy()).(*iampb.Policy)
+	// Conditional role bindings need version 3; GCP returns version 1 for policies without conditions.
+	policy.Version = 1
+	for _, binding := range policy.GetBindings() {
+		if binding.GetCondition() != nil {
+			policy.Version = 3
+			break
+		}
+	}
+	policy.Etag = computeIAMPolicyEtag(policy)
+
+	if found {
+		err = r.storage.Update(ctx, fqn, policy)
+	} else {
+		err = r.storage.Create(ctx, fqn, policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (r *kmsServer) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest) (*iampb.TestIamPermissionsResponse, error) {
+	if _, err := r.iamResourceName(ctx, req.GetResource()); err != nil {
+		return nil, err
+	}
+	// The caller is assumed to have every permission.
+	return &iampb.TestIamPermissionsResponse{Permissions: req.GetPermissions()}, nil
+}
+
+// getIAMPolicy returns the stored policy of the resource, or an empty policy (and false) if none has been set.
+func (r *kmsServer) getIAMPolicy(ctx context.Context, fqn string) (*iampb.Policy, bool, error) {
+	policy := &iampb.Policy{}
+	if err := r.storage.Get(ctx, fqn, policy); err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, false, err
+		}
+		policy = &iampb.Policy{Version: 1}
+		policy.Etag = computeIAMPolicyEtag(policy)
+		return policy, false, nil
+	}
+	return policy, true, nil
+}
+
+// iamResourceName returns the normalized name of the key ring or crypto key that an IAM request is for,
+// and NotFound if it does not exist.
+func (r *kmsServer) iamResourceName(ctx context.Context, resource string) (string, error) {
+	if strings.Contains(resource, "/cryptoKeys/") {
+		name, err := r.parseCryptoKeyName(resource)
+		if err != nil {
+			return "", err
+		}
+		fqn := name.String()
+		if err := r.storage.Get(ctx, fqn, &pb.CryptoKey{}); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return "", status.Errorf(codes.NotFound, "CryptoKey %s not found.", fqn)
+			}
+			return "", err
+		}
+		return fqn, nil
+	}
+
+	name, err := r.parseKeyRingName(resource)
+	if err != nil {
+		return "", err
+	}
+	fqn := name.String()
+	if err := r.storage.Get(ctx, fqn, &pb.KeyRing{}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", status.Errorf(codes.NotFound, "KeyRing %s not found.", fqn)
+		}
+		return "", err
+	}
+	return fqn, nil
+}
+
+// computeIAMPolicyEtag returns the etag of a policy, which changes whenever the policy does.
+func computeIAMPolicyEtag(policy *iampb.Policy) []byte {
+	withoutEtag := proto.Clone(policy).(*iampb.Policy)
+	withoutEtag.Etag = nil
+	b, err := proto.Marshal(withoutEtag)
+	if err != nil {
+		panic(fmt.Sprintf("converting to proto: %v", err))
+	}
+	hash := sha256.Sum256(b)
+	return hash[:]
+}
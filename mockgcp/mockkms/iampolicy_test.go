@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+func TestSetThenGetIamPolicy(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+	if _, err := r.CreateCryptoKey(ctx, &pb.CreateCryptoKeyRequest{
+		Parent:      testKeyRingFQN,
+		CryptoKeyId: "mykey",
+		CryptoKey:   &pb.CryptoKey{Purpose: pb.CryptoKey_ENCRYPT_DECRYPT},
+	}); err != nil {
+		t.Fatalf("CreateCryptoKey failed: %v", err)
+	}
+
+	for _, resource := range []string{testKeyRingFQN, testCryptoKeyFQN} {
+		t.Run(resource, func(t *testing.T) {
+			empty, err := r.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{Resource: resource})
+			if err != nil {
+				t.Fatalf("GetIamPolicy failed: %v", err)
+			}
+			if len(empty.GetBindings()) != 0 || len(empty.GetEtag()) == 0 {
+				t.Errorf("unexpected initial policy %v", empty)
+			}
+
+			set, err := r.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+				Resource: resource,
+				Policy: &iampb.Policy{
+					Etag: empty.GetEtag(),
+					Bindings: []*iampb.Binding{
+						{Role: "roles/cloudkms.cryptoKeyEncrypterDecrypter", Members: []string{"serviceAccount:sa@test-project.iam.gserviceaccount.com"}},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("SetIamPolicy failed: %v", err)
+			}
+			if set.GetVersion() != 1 || bytes.Equal(set.GetEtag(), empty.GetEtag()) {
+				t.Errorf("unexpected version or etag of the new policy %v", set)
+			}
+
+			got, err := r.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{Resource: resource})
+			if err != nil {
+				t.Fatalf("GetIamPolicy failed: %v", err)
+			}
+			if !proto.Equal(got, set) {
+				t.Errorf("unexpected policy; got %v, want %v", got, set)
+			}
+		})
+	}
+
+	// Conditional bindings need version 3.
+	conditional, err := r.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+		Resource: testCryptoKeyFQN,
+		Policy: &iampb.Policy{
+			Bindings: []*iampb.Binding{{
+				Role:      "roles/cloudkms.viewer",
+				Members:   []string{"user:someone@example.com"},
+				Condition: &expr.Expr{Title: "expires", Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetIamPolicy failed: %v", err)
+	}
+	if conditional.GetVersion() != 3 {
+		t.Errorf("unexpected version of conditional policy; got %d, want 3", conditional.GetVersion())
+	}
+
+	permissions, err := r.TestIamPermissions(ctx, &iampb.TestIamPermissionsRequest{
+		Resource:    testCryptoKeyFQN,
+		Permissions: []string{"cloudkms.cryptoKeys.get", "cloudkms.cryptoKeys.update"},
+	})
+	if err != nil {
+		t.Fatalf("TestIamPermissions failed: %v", err)
+	}
+	if len(permissions.GetPermissions()) != 2 {
+		t.Errorf("unexpected permissions %v", permissions.GetPermissions())
+	}
+}
+
+func TestSetIamPolicyStaleEtag(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	initial, err := r.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{Resource: testKeyRingFQN})
+	if err != nil {
+		t.Fatalf("GetIamPolicy failed: %v", err)
+	}
+	writePolicy := func(member string) (*iampb.Policy, error) {
+		return r.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+			Resource: testKeyRingFQN,
+			Policy: &iampb.Policy{
+				Etag:     initial.GetEtag(),
+				Bindings: []*iampb.Binding{{Role: "roles/cloudkms.admin", Members: []string{member}}},
+			},
+		})
+	}
+
+	// Two writers read the same policy; only the first write succeeds.
+	first, err := writePolicy("user:first@example.com")
+	if err != nil {
+		t.Fatalf("SetIamPolicy failed: %v", err)
+	}
+	_, err = writePolicy("user:second@example.com")
+	wantCode(t, err, codes.Aborted)
+
+	got, err := r.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{Resource: testKeyRingFQN})
+	if err != nil {
+		t.Fatalf("GetIamPolicy failed: %v", err)
+	}
+	if !proto.Equal(got, first) {
+		t.Errorf("rejected write changed the policy; got %v, want %v", got, first)
+	}
+}
+
+func TestIamPolicyRequiresResource(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+
+	_, err := r.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{Resource: testKeyRingFQN})
+	wantCode(t, err, codes.NotFound)
+	_, err = r.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{Resource: testCryptoKeyFQN, Policy: &iampb.Policy{}})
+	wantCode(t, err, codes.NotFound)
+}
@@ -0,0 +1,342 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +tool:mockgcp-support
+// krm.apiVersion: kms.cnrm.cloud.google.com/v1alpha1
+// krm.kind: KMSKeyRingImportJob
+// proto.service: google.cloud.kms.v1.KeyManagementService
+// proto.resource: ImportJob
+
+package mockkms
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+func (r *kmsServer) GetImportJob(ctx context.Context, req *pb.GetImportJobRequest) (*pb.ImportJob, error) {
+	name, err := r.parseImportJobName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+
+	obj := &pb.ImportJob{}
+	if err := r.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "ImportJob %s not found.", fqn)
+		}
+		return nil, err
+	}
+
+	// Key generation completes in the background in GCP; we simulate that happening before the first Get.
+	changed, err := r.advanceImportJobState(obj, r.now())
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		if err := r.storage.Update(ctx, fqn, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// deleteImportJobPath is the REST path that clients (e.g. terraform) send DELETE requests for import jobs to.
+const deleteImportJobPath = "/v1/projects/{project}/locations/{location}/keyRings/{keyRing}/importJobs/{importJob}"
+
+// registerDeleteImportJobPath serves DELETE requests for import jobs.
+// KMS has no method to delete an import job (they expire instead), so like GCP we always return NotFound;
+// clients that delete import jobs treat that as the job being gone.
+func (s *MockService) registerDeleteImportJobPath(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	r := &kmsServer{MockService: s}
+	return mux.HandlePath("DELETE", deleteImportJobPath, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		name := fmt.Sprintf("projects/%s/locations/%s/keyRings/%s/importJobs/%s",
+			pathParams["project"], pathParams["location"], pathParams["keyRing"], pathParams["importJob"])
+		err := r.deleteImportJob(req.Context(), name)
+		_, outbound := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(req.Context(), mux, outbound, w, req, err)
+	})
+}
+
+// deleteImportJob returns the error for an attempt to delete the named import job, which is always NotFound.
+func (r *kmsServer) deleteImportJob(ctx context.Context, name string) error {
+	obj, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	return status.Errorf(codes.NotFound, "ImportJob %s cannot be deleted; it is %v and expires at %s.", obj.GetName(), obj.GetState(), obj.GetExpireTime().AsTime().Format(time.RFC3339))
+}
+
+func (r *kmsServer) ListImportJobs(ctx context.Context, req *pb.ListImportJobsRequest) (*pb.ListImportJobsResponse, error) {
+	parentName, err := r.parseKeyRingName(req.GetParent())
+	if err != nil {
+		return nil, err
+	}
+	namePrefix := parentName.String() + "/importJobs/"
+
+	matches, err := parseImportJobFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListImportJobsResponse{}
+
+	var names []string
+	importJobKind := (&pb.ImportJob{}).ProtoReflect().Descriptor()
+	if err := r.storage.List(ctx, importJobKind, storage.ListOptions{
+		Prefix: namePrefix,
+	}, func(obj proto.Message) error {
+		names = append(names, obj.(*pb.ImportJob).GetName())
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	// Go through GetImportJob so that listed import jobs also advance their state.
+	var importJobs []*pb.ImportJob
+	for _, name := range names {
+		importJob, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: name})
+		if err != nil {
+			return nil, err
+		}
+		if matches(importJob) {
+			importJobs = append(importJobs, importJob)
+		}
+	}
+	response.TotalSize = int32(len(importJobs))
+
+	page, nextPageToken, err := paging.Page(importJobs, (*pb.ImportJob).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.ImportJobs = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+// parseImportJobFilter parses the filter of a ListImportJobs request.
+// We support restrictions of the form `field=VALUE` (or `field:VALUE`) on state, protection_level and
+// import_method, joined with AND; an empty filter matches every import job.
+func parseImportJobFilter(filter string) (func(obj *pb.ImportJob) bool, error) {
+	if strings.TrimSpace(filter) == "" {
+		return func(obj *pb.ImportJob) bool { return true }, nil
+	}
+
+	var restrictions []func(obj *pb.ImportJob) bool
+
+	for _, term := range strings.Split(filter, " AND ") {
+		field, value, ok := strings.Cut(term, "=")
+		if !ok {
+			field, value, ok = strings.Cut(term, ":")
+		}
+		field = strings.TrimSpace(field)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		if !ok || field == "" || value == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q", filter)
+		}
+
+		switch field {
+		case "state":
+			v, found := pb.ImportJob_ImportJobState_value[value]
+			if !found {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q: unknown state %q", filter, value)
+			}
+			restrictions = append(restrictions, func(obj *pb.ImportJob) bool { return int32(obj.GetState()) == v })
+		case "protection_level", "protectionLevel":
+			v, found := pb.ProtectionLevel_value[value]
+			if !found {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q: unknown protection level %q", filter, value)
+			}
+			restrictions = append(restrictions, func(obj *pb.ImportJob) bool { return int32(obj.GetProtectionLevel()) == v })
+		case "import_method", "importMethod":
+			v, found := pb.ImportJob_ImportMethod_value[value]
+			if !found {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q: unknown import method %q", filter, value)
+			}
+			restrictions = append(restrictions, func(obj *pb.ImportJob) bool { return int32(obj.GetImportMethod()) == v })
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q: field %q is not supported", filter, field)
+		}
+	}
+
+	return func(obj *pb.ImportJob) bool {
+		for _, restriction := range restrictions {
+			if !restriction(obj) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func (r *kmsServer) CreateImportJob(ctx context.Context, req *pb.CreateImportJobRequest) (*pb.ImportJob, error) {
+	reqName := fmt.Sprintf("%s/importJobs/%s", req.GetParent(), req.GetImportJobId())
+	name, err := r.parseImportJobName(reqName)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parent key ring must exist.
+	if _, err := r.GetKeyRing(ctx, &pb.GetKeyRingRequest{Name: name.KeyRingName.String()}); err != nil {
+		return nil, err
+	}
+
+	if err := validateImportJobMethod(req.GetImportJob()); err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+
+	now := r.now()
+
+	obj := proto.Clone(req.GetImportJob()).(*pb.ImportJob)
+	obj.Name = fqn
+	obj.CreateTime = timestamppb.New(now)
+
+	r.populateDefaultsForImportJob(name, obj, now)
+
+	if err := r.storage.Create(ctx, fqn, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// importProtectionLevels are the protection levels at which keys can be imported. Every import method can be
+// used at each of them; keys cannot be imported at the EXTERNAL protection levels.
+// The import methods are those that have a wrapping key (see wrappingKeyBits).
+// The KMSKeyRingImportJob webhook validation in pkg/resourceoverrides applies the same rule to the same enums.
+var importProtectionLevels = []pb.ProtectionLevel{pb.ProtectionLevel_SOFTWARE, pb.ProtectionLevel_HSM}
+
+// supportedImportMethods returns the import methods that have a wrapping key, in the order of the enum.
+func supportedImportMethods() []string {
+	var methods []string
+	values := pb.ImportJob_IMPORT_METHOD_UNSPECIFIED.Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		method := pb.ImportJob_ImportMethod(values.Get(i).Number())
+		if method == pb.ImportJob_IMPORT_METHOD_UNSPECIFIED {
+			continue
+		}
+		if _, err := wrappingKeyBits(method); err == nil {
+			methods = append(methods, method.String())
+		}
+	}
+	return methods
+}
+
+// validateImportJobMethod returns InvalidArgument unless the import method is supported at the import job's protection level.
+func validateImportJobMethod(obj *pb.ImportJob) error {
+	importMethod := obj.GetImportMethod()
+	protectionLevel := obj.GetProtectionLevel()
+
+	if _, err := wrappingKeyBits(importMethod); err != nil {
+		return status.Errorf(codes.InvalidArgument, "ImportJob.import_method %v is not supported; allowed values are %s.", importMethod, strings.Join(supportedImportMethods(), ", "))
+	}
+	for _, level := range importProtectionLevels {
+		if level == protectionLevel {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "ImportJob.import_method %v is not supported with ImportJob.protection_level %v.", importMethod, protectionLevel)
+}
+
+// importJobLifetime is how long an import job can be used before it expires.
+const importJobLifetime = 3 * 24 * time.Hour
+
+func (r *kmsServer) populateDefaultsForImportJob(name *ImportJobName, obj *pb.ImportJob, now time.Time) {
+	obj.State = pb.ImportJob_PENDING_GENERATION
+	obj.ExpireTime = timestamppb.New(now.Add(importJobLifetime))
+}
+
+// advanceImportJobState moves the import job through PENDING_GENERATION -> ACTIVE -> EXPIRED.
+// It returns true if the import job was changed.
+func (r *kmsServer) advanceImportJobState(obj *pb.ImportJob, now time.Time) (bool, error) {
+	changed := false
+	if obj.State == pb.ImportJob_PENDING_GENERATION {
+		obj.State = pb.ImportJob_ACTIVE
+		obj.GenerateTime = timestamppb.New(now)
+		publicKeyPEM, err := wrappingPublicKeyPEM(obj.GetImportMethod())
+		if err != nil {
+			return false, err
+		}
+		obj.PublicKey = &pb.ImportJob_WrappingPublicKey{
+			Pem: publicKeyPEM,
+		}
+		if obj.ProtectionLevel == pb.ProtectionLevel_HSM {
+			obj.Attestation = fakeAttestation(obj.Name)
+		}
+		changed = true
+	}
+	if obj.State == pb.ImportJob_ACTIVE && !now.Before(obj.GetExpireTime().AsTime()) {
+		// An expired import job can no longer be used to wrap key material, so it has no public key.
+		obj.State = pb.ImportJob_EXPIRED
+		obj.ExpireEventTime = timestamppb.New(now)
+		obj.PublicKey = nil
+		changed = true
+	}
+	return changed, nil
+}
+
+// fakeAttestation returns a stable HSM attestation for the import job.
+// Like the wrapping key, the content is derived from the name so that golden output does not change between runs.
+func fakeAttestation(fqn string) *pb.KeyOperationAttestation {
+	sum := sha256.Sum256([]byte("attestation/" + fqn))
+	return &pb.KeyOperationAttestation{
+		Format:  pb.KeyOperationAttestation_CAVIUM_V2_COMPRESSED,
+		Content: sum[:],
+	}
+}
+
+type ImportJobName struct {
+	KeyRingName
+	ImportJobID string
+}
+
+func (n *ImportJobName) String() string {
+	return n.KeyRingName.String() + "/importJobs/" + n.ImportJobID
+}
+
+// parseImportJobName parses a string into an ImportJobName.
+// The expected form is `projects/*/locations/*/keyRings/*/importJobs/*`.
+func (r *kmsServer) parseImportJobName(name string) (*ImportJobName, error) {
+	keyRingName, ids, err := r.parseKMSName(name, "importJobs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportJobName{
+		KeyRingName: *keyRingName,
+		ImportJobID: ids[0],
+	}, nil
+}
@@ -0,0 +1,533 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+const (
+	testLocation   = "projects/" + testProjectID + "/locations/us-central1"
+	testKeyRingFQN = testLocation + "/keyRings/mykeyring"
+)
+
+// createTestKeyRing creates the key ring that test import jobs are created in.
+func createTestKeyRing(t *testing.T, r *kmsServer) {
+	t.Helper()
+
+	if _, err := r.CreateKeyRing(context.Background(), &pb.CreateKeyRingRequest{
+		Parent:    testLocation,
+		KeyRingId: "mykeyring",
+		KeyRing:   &pb.KeyRing{},
+	}); err != nil {
+		t.Fatalf("creating key ring: %v", err)
+	}
+}
+
+func newTestImportJob() *pb.ImportJob {
+	return &pb.ImportJob{
+		ImportMethod:    pb.ImportJob_RSA_OAEP_3072_SHA1_AES_256,
+		ProtectionLevel: pb.ProtectionLevel_SOFTWARE,
+	}
+}
+
+func TestCreateImportJobRequiresKeyRing(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+
+	_, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "myjob",
+		ImportJob:   newTestImportJob(),
+	})
+	wantCode(t, err, codes.NotFound)
+	_, err = r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: testKeyRingFQN + "/importJobs/myjob"})
+	wantCode(t, err, codes.NotFound)
+
+	createTestKeyRing(t, r)
+	created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "myjob",
+		ImportJob:   newTestImportJob(),
+	})
+	if err != nil {
+		t.Fatalf("CreateImportJob failed: %v", err)
+	}
+	if want := testKeyRingFQN + "/importJobs/myjob"; created.GetName() != want {
+		t.Errorf("unexpected import job name; got %q, want %q", created.GetName(), want)
+	}
+	if _, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()}); err != nil {
+		t.Errorf("GetImportJob failed: %v", err)
+	}
+}
+
+func TestListKeyRings(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+	if _, err := r.CreateKeyRing(ctx, &pb.CreateKeyRingRequest{
+		Parent:    "projects/" + testProjectID + "/locations/europe-west1",
+		KeyRingId: "elsewhere",
+		KeyRing:   &pb.KeyRing{},
+	}); err != nil {
+		t.Fatalf("creating key ring: %v", err)
+	}
+
+	list, err := r.ListKeyRings(ctx, &pb.ListKeyRingsRequest{Parent: testLocation})
+	if err != nil {
+		t.Fatalf("ListKeyRings failed: %v", err)
+	}
+	if len(list.GetKeyRings()) != 1 || list.GetKeyRings()[0].GetName() != testKeyRingFQN {
+		t.Errorf("unexpected key rings listed; got %v", list.GetKeyRings())
+	}
+}
+
+func TestCreateImportJobValidatesImportMethod(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	_, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "invalid",
+		ImportJob:   &pb.ImportJob{ImportMethod: pb.ImportJob_IMPORT_METHOD_UNSPECIFIED, ProtectionLevel: pb.ProtectionLevel_SOFTWARE},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	// An import job that omits the import method is rejected with the import methods that can be used.
+	_, err = r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "omitted",
+		ImportJob:   &pb.ImportJob{ProtectionLevel: pb.ProtectionLevel_SOFTWARE},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	wantMessage := "ImportJob.import_method IMPORT_METHOD_UNSPECIFIED is not supported; allowed values are " +
+		"RSA_OAEP_3072_SHA1_AES_256, RSA_OAEP_4096_SHA1_AES_256, RSA_OAEP_3072_SHA256_AES_256, " +
+		"RSA_OAEP_4096_SHA256_AES_256, RSA_OAEP_3072_SHA256, RSA_OAEP_4096_SHA256."
+	if got := status.Convert(err).Message(); got != wantMessage {
+		t.Errorf("unexpected error message; got %q, want %q", got, wantMessage)
+	}
+
+	// Every other import method can be used at both protection levels that support import.
+	for value := range pb.ImportJob_ImportMethod_name {
+		importMethod := pb.ImportJob_ImportMethod(value)
+		if importMethod == pb.ImportJob_IMPORT_METHOD_UNSPECIFIED {
+			continue
+		}
+		for _, protectionLevel := range []pb.ProtectionLevel{pb.ProtectionLevel_SOFTWARE, pb.ProtectionLevel_HSM} {
+			if _, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+				Parent:      testKeyRingFQN,
+				ImportJobId: strings.ToLower(strings.ReplaceAll(importMethod.String()+"-"+protectionLevel.String(), "_", "-")),
+				ImportJob:   &pb.ImportJob{ImportMethod: importMethod, ProtectionLevel: protectionLevel},
+			}); err != nil {
+				t.Errorf("CreateImportJob with %v at %v failed: %v", importMethod, protectionLevel, err)
+			}
+		}
+	}
+}
+
+func TestImportJobLifecycle(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	importJob := newTestImportJob()
+	importJob.ProtectionLevel = pb.ProtectionLevel_HSM
+	created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "myjob",
+		ImportJob:   importJob,
+	})
+	if err != nil {
+		t.Fatalf("CreateImportJob failed: %v", err)
+	}
+	if created.GetState() != pb.ImportJob_PENDING_GENERATION {
+		t.Errorf("unexpected state after create; got %v, want PENDING_GENERATION", created.GetState())
+	}
+	if created.GetPublicKey() != nil || created.GetAttestation() != nil {
+		t.Errorf("public key and attestation should not be set before generation; got %v", created)
+	}
+	if got, want := created.GetExpireTime().AsTime().Sub(created.GetCreateTime().AsTime()), 3*24*time.Hour; got != want {
+		t.Errorf("unexpected lifetime; got %v, want %v", got, want)
+	}
+
+	got, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()})
+	if err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+	if got.GetState() != pb.ImportJob_ACTIVE {
+		t.Errorf("unexpected state after get; got %v, want ACTIVE", got.GetState())
+	}
+	if block, _ := pem.Decode([]byte(got.GetPublicKey().GetPem())); block == nil || block.Type != "PUBLIC KEY" {
+		t.Errorf("expected a PEM public key, got %q", got.GetPublicKey().GetPem())
+	}
+	if got.GetAttestation() == nil {
+		t.Errorf("expected an attestation for an HSM import job")
+	}
+
+	list, err := r.ListImportJobs(ctx, &pb.ListImportJobsRequest{Parent: testKeyRingFQN})
+	if err != nil {
+		t.Fatalf("ListImportJobs failed: %v", err)
+	}
+	if len(list.GetImportJobs()) != 1 || !proto.Equal(list.GetImportJobs()[0], got) {
+		t.Errorf("unexpected import jobs listed; got %v", list.GetImportJobs())
+	}
+}
+
+func TestImportJobExpires(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.SetClock(func() time.Time { return now })
+	createTestKeyRing(t, r)
+
+	created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "myjob",
+		ImportJob:   newTestImportJob(),
+	})
+	if err != nil {
+		t.Fatalf("CreateImportJob failed: %v", err)
+	}
+	if want := now.Add(3 * 24 * time.Hour); !created.GetExpireTime().AsTime().Equal(want) {
+		t.Errorf("unexpected expire time; got %v, want %v", created.GetExpireTime().AsTime(), want)
+	}
+
+	// Just before the expiry the import job is still usable.
+	now = created.GetExpireTime().AsTime().Add(-time.Second)
+	got, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()})
+	if err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+	if got.GetState() != pb.ImportJob_ACTIVE || got.GetPublicKey() == nil {
+		t.Errorf("expected import job to be ACTIVE with a public key before expiry; got %v", got)
+	}
+
+	now = created.GetExpireTime().AsTime().Add(time.Minute)
+	got, err = r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()})
+	if err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+	if got.GetState() != pb.ImportJob_EXPIRED {
+		t.Errorf("unexpected state after expiry; got %v, want EXPIRED", got.GetState())
+	}
+	if !got.GetExpireEventTime().AsTime().Equal(now) {
+		t.Errorf("unexpected expire event time; got %v, want %v", got.GetExpireEventTime().AsTime(), now)
+	}
+	if got.GetPublicKey() != nil {
+		t.Errorf("expected no public key after expiry; got %v", got.GetPublicKey())
+	}
+}
+
+func TestImportJobAttestation(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	get := func(importJobID string, protectionLevel pb.ProtectionLevel) *pb.ImportJob {
+		t.Helper()
+
+		importJob := newTestImportJob()
+		importJob.ProtectionLevel = protectionLevel
+		created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+			Parent:      testKeyRingFQN,
+			ImportJobId: importJobID,
+			ImportJob:   importJob,
+		})
+		if err != nil {
+			t.Fatalf("CreateImportJob failed: %v", err)
+		}
+		got, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()})
+		if err != nil {
+			t.Fatalf("GetImportJob failed: %v", err)
+		}
+		return got
+	}
+
+	hsm := get("hsm", pb.ProtectionLevel_HSM)
+	if hsm.GetAttestation().GetFormat() != pb.KeyOperationAttestation_CAVIUM_V2_COMPRESSED || len(hsm.GetAttestation().GetContent()) == 0 {
+		t.Fatalf("unexpected attestation %v", hsm.GetAttestation())
+	}
+	again, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: hsm.GetName()})
+	if err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+	if !proto.Equal(again.GetAttestation(), hsm.GetAttestation()) {
+		t.Errorf("attestation changed between Gets; got %v, want %v", again.GetAttestation(), hsm.GetAttestation())
+	}
+	if !proto.Equal(fakeAttestation(hsm.GetName()), hsm.GetAttestation()) {
+		t.Errorf("attestation should be derived from the import job name")
+	}
+	if other := get("other-hsm", pb.ProtectionLevel_HSM); bytes.Equal(other.GetAttestation().GetContent(), hsm.GetAttestation().GetContent()) {
+		t.Errorf("different import jobs should have different attestation content")
+	}
+
+	if software := get("software", pb.ProtectionLevel_SOFTWARE); software.GetAttestation() != nil {
+		t.Errorf("SOFTWARE import jobs should not have an attestation; got %v", software.GetAttestation())
+	}
+}
+
+func TestCreateImportJobValidatesProtectionLevel(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	if _, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "hsm",
+		ImportJob:   &pb.ImportJob{ImportMethod: pb.ImportJob_RSA_OAEP_4096_SHA1_AES_256, ProtectionLevel: pb.ProtectionLevel_HSM},
+	}); err != nil {
+		t.Errorf("CreateImportJob with a supported combination failed: %v", err)
+	}
+
+	_, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "external",
+		ImportJob:   &pb.ImportJob{ImportMethod: pb.ImportJob_RSA_OAEP_4096_SHA1_AES_256, ProtectionLevel: pb.ProtectionLevel_EXTERNAL},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestListImportJobsFilterAndPaging(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	// Import jobs d and b have expired, the others are active.
+	for _, id := range []string{"d", "a", "e", "b", "c"} {
+		created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+			Parent:      testKeyRingFQN,
+			ImportJobId: id,
+			ImportJob:   newTestImportJob(),
+		})
+		if err != nil {
+			t.Fatalf("CreateImportJob failed: %v", err)
+		}
+		if id == "d" || id == "b" {
+			stored := &pb.ImportJob{}
+			if err := r.storage.Get(ctx, created.GetName(), stored); err != nil {
+				t.Fatalf("reading import job: %v", err)
+			}
+			stored.ExpireTime = timestamppb.New(time.Now().Add(-time.Minute))
+			if err := r.storage.Update(ctx, created.GetName(), stored); err != nil {
+				t.Fatalf("updating import job: %v", err)
+			}
+		}
+	}
+
+	// listAll follows the page tokens, returning the import job IDs in the order they were listed.
+	listAll := func(t *testing.T, filter string, pageSize int32) []string {
+		t.Helper()
+
+		var ids []string
+		pageToken := ""
+		for {
+			page, err := r.ListImportJobs(ctx, &pb.ListImportJobsRequest{
+				Parent:    testKeyRingFQN,
+				Filter:    filter,
+				PageSize:  pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				t.Fatalf("ListImportJobs(%q) failed: %v", filter, err)
+			}
+			for _, obj := range page.GetImportJobs() {
+				ids = append(ids, strings.TrimPrefix(obj.GetName(), testKeyRingFQN+"/importJobs/"))
+			}
+			pageToken = page.GetNextPageToken()
+			if pageToken == "" {
+				return ids
+			}
+		}
+	}
+
+	grid := []struct {
+		filter   string
+		pageSize int32
+		want     []string
+	}{
+		{filter: "", want: []string{"a", "b", "c", "d", "e"}},
+		{filter: "", pageSize: 2, want: []string{"a", "b", "c", "d", "e"}},
+		{filter: "state=ACTIVE", want: []string{"a", "c", "e"}},
+		{filter: `state = "ACTIVE"`, pageSize: 1, want: []string{"a", "c", "e"}},
+		{filter: "state:EXPIRED", want: []string{"b", "d"}},
+		{filter: "state=ACTIVE AND protection_level=SOFTWARE", want: []string{"a", "c", "e"}},
+		{filter: "state=ACTIVE AND protection_level=HSM", want: nil},
+	}
+	for _, g := range grid {
+		got := listAll(t, g.filter, g.pageSize)
+		if strings.Join(got, ",") != strings.Join(g.want, ",") {
+			t.Errorf("ListImportJobs(filter=%q, pageSize=%d): got %v, want %v", g.filter, g.pageSize, got, g.want)
+		}
+	}
+
+	for _, filter := range []string{"state", "state=", "state=BOGUS", "name=foo", "state=ACTIVE OR state=EXPIRED"} {
+		_, err := r.ListImportJobs(ctx, &pb.ListImportJobsRequest{Parent: testKeyRingFQN, Filter: filter})
+		wantCode(t, err, codes.InvalidArgument)
+	}
+}
+
+// newTestHTTPHandler serves the REST API of s, as clients such as terraform see it.
+func newTestHTTPHandler(t *testing.T, s *MockService) http.Handler {
+	t.Helper()
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(s.UnaryServerInterceptor()))
+	s.Register(server)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("net.Listen failed: %v", err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dialing mock: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	handler, err := s.NewHTTPMux(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("NewHTTPMux failed: %v", err)
+	}
+	return handler
+}
+
+// TestDeleteExpiredImportJob deletes an import job that has expired, as the KMSKeyRingImportJob controller
+// does when the KRM object is deleted. Import jobs cannot be deleted in KMS, so the request must return 404,
+// which terraform treats as the job already being gone.
+func TestDeleteExpiredImportJob(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.SetClock(func() time.Time { return now })
+	createTestKeyRing(t, r)
+	handler := newTestHTTPHandler(t, r.MockService)
+
+	created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+		Parent:      testKeyRingFQN,
+		ImportJobId: "myjob",
+		ImportJob:   newTestImportJob(),
+	})
+	if err != nil {
+		t.Fatalf("CreateImportJob failed: %v", err)
+	}
+	now = created.GetExpireTime().AsTime().Add(time.Minute)
+
+	for _, name := range []string{created.GetName(), testKeyRingFQN + "/importJobs/doesnotexist"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("DELETE", "https://cloudkms.googleapis.com/v1/"+name, nil))
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("unexpected status code for DELETE of %q; got %d, want %d (%s)", name, w.Code, http.StatusNotFound, w.Body)
+		}
+		var body struct {
+			Error struct {
+				Status string `json:"status"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("parsing error response %q: %v", w.Body, err)
+		}
+		if body.Error.Status != "NOT_FOUND" {
+			t.Errorf("unexpected error status for DELETE of %q; got %q, want NOT_FOUND", name, body.Error.Status)
+		}
+	}
+
+	// The expired import job is still readable.
+	got, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()})
+	if err != nil {
+		t.Fatalf("GetImportJob failed: %v", err)
+	}
+	if got.GetState() != pb.ImportJob_EXPIRED {
+		t.Errorf("unexpected state after delete; got %v, want EXPIRED", got.GetState())
+	}
+}
+
+func TestImportJobWrappingKeyMatchesImportMethod(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+	createTestKeyRing(t, r)
+
+	grid := []struct {
+		id       string
+		method   pb.ImportJob_ImportMethod
+		wantBits int
+	}{
+		{id: "rsa3072", method: pb.ImportJob_RSA_OAEP_3072_SHA1_AES_256, wantBits: 3072},
+		{id: "rsa4096", method: pb.ImportJob_RSA_OAEP_4096_SHA1_AES_256, wantBits: 4096},
+	}
+	for _, g := range grid {
+		t.Run(g.id, func(t *testing.T) {
+			created, err := r.CreateImportJob(ctx, &pb.CreateImportJobRequest{
+				Parent:      testKeyRingFQN,
+				ImportJobId: g.id,
+				ImportJob:   &pb.ImportJob{ImportMethod: g.method, ProtectionLevel: pb.ProtectionLevel_SOFTWARE},
+			})
+			if err != nil {
+				t.Fatalf("CreateImportJob failed: %v", err)
+			}
+			got, err := r.GetImportJob(ctx, &pb.GetImportJobRequest{Name: created.GetName()})
+			if err != nil {
+				t.Fatalf("GetImportJob failed: %v", err)
+			}
+
+			block, _ := pem.Decode([]byte(got.GetPublicKey().GetPem()))
+			if block == nil || block.Type != "PUBLIC KEY" {
+				t.Fatalf("expected a PEM public key, got %q", got.GetPublicKey().GetPem())
+			}
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				t.Fatalf("parsing public key: %v", err)
+			}
+			rsaKey, ok := key.(*rsa.PublicKey)
+			if !ok {
+				t.Fatalf("expected an RSA public key, got %T", key)
+			}
+			if rsaKey.N.BitLen() != g.wantBits || rsaKey.E != 65537 {
+				t.Errorf("unexpected wrapping key; got %d bits with exponent %d, want %d bits with exponent 65537", rsaKey.N.BitLen(), rsaKey.E, g.wantBits)
+			}
+
+			// The keys are fixed, so they do not change between runs.
+			again, err := wrappingPublicKeyPEM(g.method)
+			if err != nil {
+				t.Fatalf("getting wrapping key: %v", err)
+			}
+			if again != got.GetPublicKey().GetPem() {
+				t.Errorf("wrapping key is not deterministic")
+			}
+		})
+	}
+}
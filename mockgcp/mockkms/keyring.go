@@ -23,16 +23,18 @@ package mockkms
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
 )
 
 type kmsServer struct {
@@ -68,7 +70,7 @@ func (r *kmsServer) CreateKeyRing(ctx context.Context, req *pb.CreateKeyRingRequ
 
 	fqn := name.String()
 
-	now := time.Now()
+	now := r.now()
 
 	obj := proto.Clone(req.GetKeyRing()).(*pb.KeyRing)
 	obj.Name = fqn
@@ -83,6 +85,46 @@ func (r *kmsServer) CreateKeyRing(ctx context.Context, req *pb.CreateKeyRingRequ
 	return obj, nil
 }
 
+func (r *kmsServer) ListKeyRings(ctx context.Context, req *pb.ListKeyRingsRequest) (*pb.ListKeyRingsResponse, error) {
+	// The parent is `projects/*/locations/*`; reuse the key ring parser to validate it.
+	parentName, err := r.parseKeyRingName(req.GetParent() + "/keyRings/-")
+	if err != nil {
+		return nil, err
+	}
+	namePrefix := strings.TrimSuffix(parentName.String(), "-")
+
+	matches, err := parseNameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListKeyRingsResponse{}
+
+	var keyRings []*pb.KeyRing
+	keyRingKind := (&pb.KeyRing{}).ProtoReflect().Descriptor()
+	if err := r.storage.List(ctx, keyRingKind, storage.ListOptions{
+		Prefix: namePrefix,
+	}, func(obj proto.Message) error {
+		keyRing := obj.(*pb.KeyRing)
+		if matches(keyRing.GetName()) {
+			keyRings = append(keyRings, keyRing)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(keyRings, func(i, j int) bool { return keyRings[i].GetName() < keyRings[j].GetName() })
+	response.TotalSize = int32(len(keyRings))
+
+	page, nextPageToken, err := paging.Page(keyRings, (*pb.KeyRing).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.KeyRings = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
 func (r *kmsServer) populateDefaultsForKeyRing(name *KeyRingName, obj *pb.KeyRing) {
 
 }
@@ -100,22 +142,49 @@ func (n *KeyRingName) String() string {
 // parseKeyRingName parses a string into an KeyRingName.
 // The expected form is `projects/*/locations/*/keyRings/*`.
 func (r *kmsServer) parseKeyRingName(name string) (*KeyRingName, error) {
+	keyRingName, _, err := r.parseKMSName(name)
+	return keyRingName, err
+}
+
+// parseKMSName parses the name of a resource in a key ring, of the form `projects/*/locations/*/keyRings/*`
+// followed by a `<collection>/*` pair for each of collections, and returns the key ring and the ids in the
+// collections. Unlike logging resources, KMS resources can only be created in projects, so names under
+// folders or organizations are rejected.
+func (s *MockService) parseKMSName(name string, collections ...string) (*KeyRingName, []string, error) {
 	tokens := strings.Split(name, "/")
 
-	if len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "locations" && tokens[4] == "keyRings" {
-		project, err := r.Projects.GetProjectByID(tokens[1])
-		if err != nil {
-			return nil, err
+	if len(tokens) >= 2 && (tokens[0] == "folders" || tokens[0] == "organizations") {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "name %q is not valid: KMS resources must be in a project", name)
+	}
+	if len(tokens) != 6+2*len(collections) || tokens[0] != "projects" || tokens[2] != "locations" || tokens[4] != "keyRings" {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	}
+	for i, collection := range collections {
+		if tokens[6+2*i] != collection {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
 		}
-
-		name := &KeyRingName{
-			Project:   project,
-			Location:  tokens[3],
-			KeyRingID: tokens[5],
+	}
+	for i := 1; i < len(tokens); i += 2 {
+		if tokens[i] == "" {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
 		}
+	}
+
+	project, err := s.Projects.GetProjectByID(tokens[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyRingName := &KeyRingName{
+		Project:   project,
+		Location:  tokens[3],
+		KeyRingID: tokens[5],
+	}
 
-		return name, nil
+	var ids []string
+	for i := range collections {
+		ids = append(ids, tokens[7+2*i])
 	}
 
-	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	return keyRingName, ids, nil
 }
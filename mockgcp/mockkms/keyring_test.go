@@ -0,0 +1,163 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+func TestParseKMSNames(t *testing.T) {
+	r := newTestKMSServer(t)
+
+	grid := []struct {
+		name  string
+		parse func(string) (interface{ String() string }, error)
+	}{
+		{
+			name:  testKeyRingFQN,
+			parse: func(s string) (interface{ String() string }, error) { return r.parseKeyRingName(s) },
+		},
+		{
+			name:  testKeyRingFQN + "/cryptoKeys/mykey",
+			parse: func(s string) (interface{ String() string }, error) { return r.parseCryptoKeyName(s) },
+		},
+		{
+			name:  testKeyRingFQN + "/cryptoKeys/mykey/cryptoKeyVersions/1",
+			parse: func(s string) (interface{ String() string }, error) { return r.parseCryptoKeyVersionName(s) },
+		},
+		{
+			name:  testKeyRingFQN + "/importJobs/myjob",
+			parse: func(s string) (interface{ String() string }, error) { return r.parseImportJobName(s) },
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			got, err := g.parse(g.name)
+			if err != nil {
+				t.Fatalf("parsing %q failed: %v", g.name, err)
+			}
+			if got.String() != g.name {
+				t.Errorf("unexpected name after round trip; got %q, want %q", got.String(), g.name)
+			}
+		})
+	}
+}
+
+func TestParseKMSNameRejectsMalformedNames(t *testing.T) {
+	r := newTestKMSServer(t)
+
+	grid := []struct {
+		name        string
+		collections []string
+		want        codes.Code
+	}{
+		{name: "", want: codes.InvalidArgument},
+		{name: testLocation, want: codes.InvalidArgument},
+		{name: testLocation + "/keyRings/", want: codes.InvalidArgument},
+		{name: testLocation + "/keyrings/mykeyring", want: codes.InvalidArgument},
+		{name: "projects//locations/us-central1/keyRings/mykeyring", want: codes.InvalidArgument},
+		{name: "projects/" + testProjectID + "/locations//keyRings/mykeyring", want: codes.InvalidArgument},
+		{name: "folders/123/locations/us-central1/keyRings/mykeyring", want: codes.InvalidArgument},
+		{name: "organizations/123/locations/us-central1/keyRings/mykeyring", want: codes.InvalidArgument},
+		{name: testKeyRingFQN + "/cryptoKeys/mykey", want: codes.InvalidArgument},
+		{name: testKeyRingFQN, collections: []string{"cryptoKeys"}, want: codes.InvalidArgument},
+		{name: testKeyRingFQN + "/cryptoKeys/", collections: []string{"cryptoKeys"}, want: codes.InvalidArgument},
+		{name: testKeyRingFQN + "/importJobs/myjob", collections: []string{"cryptoKeys"}, want: codes.InvalidArgument},
+		{name: testKeyRingFQN + "/cryptoKeys/mykey/cryptoKeyVersions", collections: []string{"cryptoKeys", "cryptoKeyVersions"}, want: codes.InvalidArgument},
+		{name: "projects/other-project/locations/us-central1/keyRings/mykeyring", want: codes.NotFound},
+	}
+	for _, g := range grid {
+		_, _, err := r.parseKMSName(g.name, g.collections...)
+		wantCode(t, err, g.want)
+	}
+}
+
+func TestListKeyRingsFilterAndPaging(t *testing.T) {
+	ctx := context.Background()
+	r := newTestKMSServer(t)
+
+	for _, id := range []string{"prod-b", "dev-a", "prod-a", "dev-b", "test"} {
+		if _, err := r.CreateKeyRing(ctx, &pb.CreateKeyRingRequest{
+			Parent:    testLocation,
+			KeyRingId: id,
+			KeyRing:   &pb.KeyRing{},
+		}); err != nil {
+			t.Fatalf("creating key ring: %v", err)
+		}
+	}
+
+	// listAll follows the page tokens, returning the key ring IDs in the order they were listed.
+	listAll := func(t *testing.T, filter string, pageSize int32) []string {
+		t.Helper()
+
+		var ids []string
+		pageToken := ""
+		for {
+			page, err := r.ListKeyRings(ctx, &pb.ListKeyRingsRequest{
+				Parent:    testLocation,
+				Filter:    filter,
+				PageSize:  pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				t.Fatalf("ListKeyRings(%q) failed: %v", filter, err)
+			}
+			if page.GetTotalSize() < int32(len(page.GetKeyRings())) {
+				t.Errorf("unexpected total size %d for a page of %d key rings", page.GetTotalSize(), len(page.GetKeyRings()))
+			}
+			for _, obj := range page.GetKeyRings() {
+				ids = append(ids, strings.TrimPrefix(obj.GetName(), testLocation+"/keyRings/"))
+			}
+			pageToken = page.GetNextPageToken()
+			if pageToken == "" {
+				return ids
+			}
+		}
+	}
+
+	grid := []struct {
+		filter   string
+		pageSize int32
+		want     []string
+	}{
+		{filter: "", want: []string{"dev-a", "dev-b", "prod-a", "prod-b", "test"}},
+		{filter: "", pageSize: 2, want: []string{"dev-a", "dev-b", "prod-a", "prod-b", "test"}},
+		{filter: "name:prod", want: []string{"prod-a", "prod-b"}},
+		{filter: `name:"dev-"`, pageSize: 1, want: []string{"dev-a", "dev-b"}},
+		{filter: "name:" + testLocation + "/keyRings/prod-b", want: []string{"prod-b"}},
+		{filter: "name:prod AND name:prod-a", want: []string{"prod-a"}},
+		{filter: "name:staging", want: nil},
+	}
+	for _, g := range grid {
+		got := listAll(t, g.filter, g.pageSize)
+		if strings.Join(got, ",") != strings.Join(g.want, ",") {
+			t.Errorf("ListKeyRings(filter=%q, pageSize=%d): got %v, want %v", g.filter, g.pageSize, got, g.want)
+		}
+	}
+
+	for _, filter := range []string{"name", "name:", "labels.env:prod", "name:prod OR name:dev"} {
+		_, err := r.ListKeyRings(ctx, &pb.ListKeyRingsRequest{Parent: testLocation, Filter: filter})
+		wantCode(t, err, codes.InvalidArgument)
+	}
+
+	_, err := r.ListKeyRings(ctx, &pb.ListKeyRingsRequest{Parent: testLocation, PageToken: "!"})
+	wantCode(t, err, codes.InvalidArgument)
+}
@@ -18,10 +18,12 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/faults"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/httpmux"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/operations"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
@@ -33,8 +35,12 @@ type MockService struct {
 	*common.MockEnvironment
 	storage              storage.Storage
 	operations           *operations.Operations
+	faults               *faults.Injector
 	v1AutokeyAdminServer *autokeyAdminServer
 	v1AutokeyServer      *autokeyServer
+
+	// now returns the current time; tests can replace it with SetClock to simulate the passage of time.
+	now func() time.Time
 }
 
 // New creates a MockService.
@@ -43,12 +49,35 @@ func New(env *common.MockEnvironment, storage storage.Storage) *MockService {
 		MockEnvironment: env,
 		storage:         storage,
 		operations:      operations.NewOperationsService(storage),
+		faults: faults.NewInjector(
+			pb.KeyManagementService_ServiceDesc.ServiceName,
+			pb.AutokeyAdmin_ServiceDesc.ServiceName,
+			pb.Autokey_ServiceDesc.ServiceName,
+			pb.EkmService_ServiceDesc.ServiceName,
+		),
+		now: time.Now,
 	}
 	s.v1AutokeyAdminServer = &autokeyAdminServer{MockService: s}
 	s.v1AutokeyServer = &autokeyServer{MockService: s}
 	return s
 }
 
+// SetClock overrides the clock used for timestamps and for time-based state changes, such as import job expiry.
+// The clock defaults to the real time.
+func (s *MockService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// InjectFault causes the next count calls to the RPC method (e.g. `GetImportJob`) to fail with err.
+func (s *MockService) InjectFault(method string, count int, err error) {
+	s.faults.Inject(method, count, err)
+}
+
+// UnaryServerInterceptor returns the interceptor that applies injected faults to our RPCs.
+func (s *MockService) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return s.faults.UnaryServerInterceptor()
+}
+
 func (s *MockService) ExpectedHosts() []string {
 	return []string{"cloudkms.googleapis.com"}
 }
@@ -57,6 +86,7 @@ func (s *MockService) Register(grpcServer *grpc.Server) {
 	pb.RegisterKeyManagementServiceServer(grpcServer, &kmsServer{MockService: s})
 	pb.RegisterAutokeyAdminServer(grpcServer, s.v1AutokeyAdminServer)
 	pb.RegisterAutokeyServer(grpcServer, s.v1AutokeyServer)
+	pb.RegisterEkmServiceServer(grpcServer, &ekmServer{MockService: s})
 }
 
 func (s *MockService) NewHTTPMux(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
@@ -64,7 +94,9 @@ func (s *MockService) NewHTTPMux(ctx context.Context, conn *grpc.ClientConn) (ht
 		pb.RegisterKeyManagementServiceHandler,
 		pb.RegisterAutokeyAdminHandler,
 		pb.RegisterAutokeyHandler,
+		pb.RegisterEkmServiceHandler,
 		s.operations.RegisterOperationsPath("/v1/{prefix=**}/operations/{name}"),
+		s.registerDeleteImportJobPath,
 	)
 	if err != nil {
 		return nil, err
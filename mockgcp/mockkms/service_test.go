@@ -0,0 +1,57 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+const (
+	testProjectID     = "test-project"
+	testProjectNumber = 123456789
+)
+
+// newTestMockService builds a MockService backed by in-memory storage, with a single project.
+func newTestMockService(t *testing.T) *MockService {
+	t.Helper()
+
+	env := &common.MockEnvironment{
+		Projects: projects.NewFakeProjectStore(&projects.ProjectData{ID: testProjectID, Number: testProjectNumber}),
+	}
+	return New(env, storage.NewInMemoryStorage())
+}
+
+// newTestKMSServer builds a kmsServer backed by in-memory storage, with a single project.
+func newTestKMSServer(t *testing.T) *kmsServer {
+	t.Helper()
+
+	return &kmsServer{MockService: newTestMockService(t)}
+}
+
+// wantCode fails the test if err does not have the expected gRPC status code.
+func wantCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("unexpected status code; got %v (%v), want %v", got, err, want)
+	}
+}
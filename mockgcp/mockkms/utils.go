@@ -14,9 +14,48 @@
 
 package mockkms
 
-import "strings"
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 func lastComponent(s string) string {
 	i := strings.LastIndex(s, "/")
 	return s[i+1:]
 }
+
+// parseNameFilter parses the filter of a ListKeyRings or ListCryptoKeys request.
+// We support restrictions of the form `name:PREFIX`, joined with AND, which match resources whose full name
+// or id starts with PREFIX; an empty filter matches every name.
+func parseNameFilter(filter string) (func(name string) bool, error) {
+	if strings.TrimSpace(filter) == "" {
+		return func(name string) bool { return true }, nil
+	}
+
+	var prefixes []string
+
+	for _, term := range strings.Split(filter, " AND ") {
+		field, value, ok := strings.Cut(term, ":")
+		field = strings.TrimSpace(field)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		// Resource names cannot contain spaces, so a value with a space is a term we do not support, such as OR.
+		if !ok || field == "" || value == "" || strings.ContainsAny(value, " \t") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q", filter)
+		}
+		if field != "name" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q: field %q is not supported", filter, field)
+		}
+		prefixes = append(prefixes, value)
+	}
+
+	return func(name string) bool {
+		for _, prefix := range prefixes {
+			if !strings.HasPrefix(name, prefix) && !strings.HasPrefix(lastComponent(name), prefix) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
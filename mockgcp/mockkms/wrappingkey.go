@@ -0,0 +1,59 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockkms
+
+import (
+	_ "embed"
+	"fmt"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/cloud/kms/v1"
+)
+
+// The wrapping keys are real RSA public keys (with exponent 65537), so clients can parse them,
+// but they are fixed so that golden output does not change between runs.
+// They were generated once with `openssl genpkey -algorithm RSA -pkeyopt rsa_keygen_bits:<bits> | openssl pkey -pubout`;
+// the private keys were not kept, as the mock never unwraps key material.
+var (
+	//go:embed wrappingkeys/rsa3072.pem
+	wrappingPublicKey3072PEM string
+	//go:embed wrappingkeys/rsa4096.pem
+	wrappingPublicKey4096PEM string
+)
+
+// wrappingKeyBits returns the size of the RSA wrapping key for the import method.
+func wrappingKeyBits(method pb.ImportJob_ImportMethod) (int, error) {
+	switch method {
+	case pb.ImportJob_RSA_OAEP_3072_SHA1_AES_256, pb.ImportJob_RSA_OAEP_3072_SHA256_AES_256, pb.ImportJob_RSA_OAEP_3072_SHA256:
+		return 3072, nil
+	case pb.ImportJob_RSA_OAEP_4096_SHA1_AES_256, pb.ImportJob_RSA_OAEP_4096_SHA256_AES_256, pb.ImportJob_RSA_OAEP_4096_SHA256:
+		return 4096, nil
+	default:
+		return 0, fmt.Errorf("import method %v has no wrapping key", method)
+	}
+}
+
+// wrappingPublicKeyPEM returns the PEM-encoded public part of the RSA wrapping key for the import method.
+func wrappingPublicKeyPEM(method pb.ImportJob_ImportMethod) (string, error) {
+	bits, err := wrappingKeyBits(method)
+	if err != nil {
+		return "", err
+	}
+	switch bits {
+	case 3072:
+		return wrappingPublicKey3072PEM, nil
+	default:
+		return wrappingPublicKey4096PEM, nil
+	}
+}
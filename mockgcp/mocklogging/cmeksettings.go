@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func (s *configService) GetCmekSettings(ctx context.Context, req *pb.GetCmekSettingsRequest) (*pb.CmekSettings, error) {
+	name, err := s.parseCmekSettingsName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	obj, _, err := s.getCmekSettings(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s *configService) UpdateCmekSettings(ctx context.Context, req *pb.UpdateCmekSettingsRequest) (*pb.CmekSettings, error) {
+	name, err := s.parseCmekSettingsName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	existing, found, err := s.getCmekSettings(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := proto.Clone(existing).(*pb.CmekSettings)
+
+	// Without a mask, all the mutable fields are updated.
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"kms_key_name", "kms_key_version_name"}
+	}
+	for _, path := range paths {
+		switch path {
+		case "kms_key_name", "kmsKeyName", "kms_key_version_name", "kmsKeyVersionName":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
+		}
+	}
+	if err := fields.UpdateByFieldMask(updated, req.GetCmekSettings(), paths); err != nil {
+		return nil, err
+	}
+
+	if found {
+		err = s.storage.Update(ctx, fqn, updated)
+	} else {
+		err = s.storage.Create(ctx, fqn, updated)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// getCmekSettings returns the stored CMEK settings, or the defaults if they have never been updated.
+func (s *configService) getCmekSettings(ctx context.Context, name *cmekSettingsName) (*pb.CmekSettings, bool, error) {
+	fqn := name.String()
+
+	obj := &pb.CmekSettings{}
+	if err := s.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, false, err
+		}
+		serviceAccount, err := s.loggingServiceAccount(&name.Parent)
+		if err != nil {
+			return nil, false, err
+		}
+		return &pb.CmekSettings{
+			Name:             fqn,
+			ServiceAccountId: serviceAccount,
+		}, false, nil
+	}
+	return obj, true, nil
+}
+
+// loggingServiceAccount returns the email of the logging service agent for a project, folder,
+// organization or billing account.
+func (s *MockService) loggingServiceAccount(parent *FolderOrgOrProject) (string, error) {
+	switch {
+	case parent.Folder != "":
+		return fmt.Sprintf("service-folder-%s@gcp-sa-logging.iam.gserviceaccount.com", parent.Folder), nil
+	case parent.Organization != "":
+		return fmt.Sprintf("service-org-%s@gcp-sa-logging.iam.gserviceaccount.com", parent.Organization), nil
+	case parent.BillingAccount != "":
+		return fmt.Sprintf("service-billing-%s@gcp-sa-logging.iam.gserviceaccount.com", parent.BillingAccount), nil
+	default:
+		projectNumber, err := s.projectNumber(parent.Project.ID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("service-%d@gcp-sa-logging.iam.gserviceaccount.com", projectNumber), nil
+	}
+}
+
+type cmekSettingsName struct {
+	Parent FolderOrgOrProject
+}
+
+func (n *cmekSettingsName) String() string {
+	return n.Parent.String() + "/cmekSettings"
+}
+
+// parseCmekSettingsName parses a string into a cmekSettingsName.
+// The expected form is `{projects,folders,organizations,billingAccounts}/*/cmekSettings`
+func (s *configService) parseCmekSettingsName(name string) (*cmekSettingsName, error) {
+	tokens := strings.Split(name, "/")
+
+	parent, remainder, err := s.PopFolderOrgOrProject(tokens)
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) == 1 && remainder[0] == "cmekSettings" {
+		return &cmekSettingsName{Parent: *parent}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+}
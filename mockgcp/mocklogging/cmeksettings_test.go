@@ -0,0 +1,99 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestCmekSettingsProject(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	name := "projects/" + testProjectID + "/cmekSettings"
+	wantServiceAccount := fmt.Sprintf("service-%d@gcp-sa-logging.iam.gserviceaccount.com", testProjectNumber)
+	kmsKeyName := "projects/" + testProjectID + "/locations/global/keyRings/my-ring/cryptoKeys/my-key"
+
+	initial, err := s.GetCmekSettings(ctx, &pb.GetCmekSettingsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetCmekSettings failed: %v", err)
+	}
+	if initial.GetName() != name || initial.GetKmsKeyName() != "" || initial.GetServiceAccountId() != wantServiceAccount {
+		t.Errorf("unexpected default cmek settings %v", initial)
+	}
+
+	if _, err := s.UpdateCmekSettings(ctx, &pb.UpdateCmekSettingsRequest{
+		Name: name,
+		CmekSettings: &pb.CmekSettings{
+			KmsKeyName:        kmsKeyName,
+			KmsKeyVersionName: kmsKeyName + "/cryptoKeyVersions/1",
+			ServiceAccountId:  "ignored",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"kms_key_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateCmekSettings failed: %v", err)
+	}
+
+	got, err := s.GetCmekSettings(ctx, &pb.GetCmekSettingsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetCmekSettings failed: %v", err)
+	}
+	if got.GetKmsKeyName() != kmsKeyName {
+		t.Errorf("unexpected kms_key_name; got %q, want %q", got.GetKmsKeyName(), kmsKeyName)
+	}
+	if got.GetKmsKeyVersionName() != "" {
+		t.Errorf("kms_key_version_name was updated even though it is not in the update_mask; got %q", got.GetKmsKeyVersionName())
+	}
+	if got.GetServiceAccountId() != wantServiceAccount {
+		t.Errorf("unexpected service_account_id; got %q, want %q", got.GetServiceAccountId(), wantServiceAccount)
+	}
+
+	if _, err := s.UpdateCmekSettings(ctx, &pb.UpdateCmekSettingsRequest{
+		Name:         name,
+		CmekSettings: &pb.CmekSettings{KmsKeyVersionName: kmsKeyName + "/cryptoKeyVersions/2"},
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"kms_key_version_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateCmekSettings failed: %v", err)
+	}
+	got, err = s.GetCmekSettings(ctx, &pb.GetCmekSettingsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetCmekSettings failed: %v", err)
+	}
+	if got.GetKmsKeyName() != kmsKeyName || got.GetKmsKeyVersionName() != kmsKeyName+"/cryptoKeyVersions/2" {
+		t.Errorf("unexpected cmek settings after second update %v", got)
+	}
+}
+
+func TestCmekSettingsInvalid(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	_, err := s.GetCmekSettings(ctx, &pb.GetCmekSettingsRequest{Name: "projects/" + testProjectID + "/settings"})
+	wantCode(t, err, codes.InvalidArgument)
+
+	_, err = s.UpdateCmekSettings(ctx, &pb.UpdateCmekSettingsRequest{
+		Name:         "organizations/123/cmekSettings",
+		CmekSettings: &pb.CmekSettings{},
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"service_account_id"}},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
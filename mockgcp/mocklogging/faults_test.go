@@ -0,0 +1,88 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+// newTestGRPCClient serves the MockService over gRPC, with its interceptor installed, and returns a client for it.
+func newTestGRPCClient(t *testing.T, s *MockService) pb.ConfigServiceV2Client {
+	t.Helper()
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(s.UnaryServerInterceptor()))
+	s.Register(server)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("net.Listen failed: %v", err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dialing mock: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewConfigServiceV2Client(conn)
+}
+
+func TestInjectedFaultsAreRetried(t *testing.T) {
+	ctx := context.Background()
+	s := newTestMockService(t)
+	client := newTestGRPCClient(t, s)
+	createTestBucket(t, &configService{MockService: s})
+	if _, err := client.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+
+	s.InjectFault("GetLink", 2, status.Error(codes.Unavailable, "the service is currently unavailable"))
+
+	// Retry transient errors, as a controller reconciling the link would.
+	var link *pb.Link
+	attempts := 0
+	for link == nil && attempts < 5 {
+		attempts++
+		got, err := client.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN})
+		if err != nil {
+			wantCode(t, err, codes.Unavailable)
+			continue
+		}
+		link = got
+	}
+	if link == nil {
+		t.Fatalf("GetLink did not succeed after %d attempts", attempts)
+	}
+	if attempts != 3 {
+		t.Errorf("unexpected number of attempts; got %d, want 3", attempts)
+	}
+	if link.GetName() != testLinkFQN {
+		t.Errorf("unexpected link %v", link)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+// outOfOrderIDs are the IDs that resources are created with in the list tests; they are not sorted.
+var outOfOrderIDs = []string{"m", "c", "x", "a", "q", "b"}
+
+// wantSortedNames fails the test if names is not sorted, or does not contain all of want.
+func wantSortedNames(t *testing.T, names []string, want ...string) {
+	t.Helper()
+
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("list results are not sorted by name: %v", names)
+	}
+	found := make(map[string]bool)
+	for _, name := range names {
+		found[name] = true
+	}
+	for _, name := range want {
+		if !found[name] {
+			t.Errorf("list results %v do not contain %q", names, name)
+		}
+	}
+}
+
+func TestListMethodsSortByName(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	metrics := &metricsService{MockService: s.MockService}
+	createTestBucket(t, s)
+
+	var wantBuckets, wantLinks, wantSinks, wantExclusions, wantMetrics []string
+	for _, id := range outOfOrderIDs {
+		if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+			Parent:   testBucketParent,
+			BucketId: "bucket-" + id,
+			Bucket:   &pb.LogBucket{RetentionDays: 30},
+		}); err != nil {
+			t.Fatalf("CreateBucket failed: %v", err)
+		}
+		wantBuckets = append(wantBuckets, testBucketParent+"/buckets/bucket-"+id)
+
+		if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+			Parent: testBucketFQN,
+			LinkId: "link_" + id,
+			Link:   &pb.Link{},
+		}); err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+		wantLinks = append(wantLinks, testBucketFQN+"/links/link_"+id)
+
+		if _, err := s.CreateSink(ctx, &pb.CreateSinkRequest{
+			Parent: "projects/" + testProjectID,
+			Sink:   &pb.LogSink{Name: "sink-" + id, Destination: "storage.googleapis.com/my-bucket"},
+		}); err != nil {
+			t.Fatalf("CreateSink failed: %v", err)
+		}
+		wantSinks = append(wantSinks, "sink-"+id)
+
+		if _, err := s.CreateExclusion(ctx, &pb.CreateExclusionRequest{
+			Parent:    "projects/" + testProjectID,
+			Exclusion: &pb.LogExclusion{Name: "exclusion-" + id, Filter: "severity<ERROR"},
+		}); err != nil {
+			t.Fatalf("CreateExclusion failed: %v", err)
+		}
+		wantExclusions = append(wantExclusions, "exclusion-"+id)
+
+		if _, err := metrics.CreateLogMetric(ctx, &pb.CreateLogMetricRequest{
+			Parent: testMetricParent,
+			Metric: &pb.LogMetric{Name: "metric-" + id, Filter: "severity>=ERROR"},
+		}); err != nil {
+			t.Fatalf("CreateLogMetric failed: %v", err)
+		}
+		wantMetrics = append(wantMetrics, "metric-"+id)
+	}
+
+	buckets, err := s.ListBuckets(ctx, &pb.ListBucketsRequest{Parent: testBucketParent})
+	if err != nil {
+		t.Fatalf("ListBuckets failed: %v", err)
+	}
+	var bucketNames []string
+	for _, obj := range buckets.GetBuckets() {
+		bucketNames = append(bucketNames, obj.GetName())
+	}
+	wantSortedNames(t, bucketNames, wantBuckets...)
+
+	links, err := s.ListLinks(ctx, &pb.ListLinksRequest{Parent: testBucketFQN})
+	if err != nil {
+		t.Fatalf("ListLinks failed: %v", err)
+	}
+	var linkNames []string
+	for _, obj := range links.GetLinks() {
+		linkNames = append(linkNames, obj.GetName())
+	}
+	wantSortedNames(t, linkNames, wantLinks...)
+
+	sinks, err := s.ListSinks(ctx, &pb.ListSinksRequest{Parent: "projects/" + testProjectID})
+	if err != nil {
+		t.Fatalf("ListSinks failed: %v", err)
+	}
+	var sinkNames []string
+	for _, obj := range sinks.GetSinks() {
+		sinkNames = append(sinkNames, obj.GetName())
+	}
+	wantSortedNames(t, sinkNames, wantSinks...)
+
+	exclusions, err := s.ListExclusions(ctx, &pb.ListExclusionsRequest{Parent: "projects/" + testProjectID})
+	if err != nil {
+		t.Fatalf("ListExclusions failed: %v", err)
+	}
+	var exclusionNames []string
+	for _, obj := range exclusions.GetExclusions() {
+		exclusionNames = append(exclusionNames, obj.GetName())
+	}
+	wantSortedNames(t, exclusionNames, wantExclusions...)
+
+	// Paging must also follow the sorted order.
+	var metricNames []string
+	pageToken := ""
+	for {
+		page, err := metrics.ListLogMetrics(ctx, &pb.ListLogMetricsRequest{Parent: testMetricParent, PageSize: 4, PageToken: pageToken})
+		if err != nil {
+			t.Fatalf("ListLogMetrics failed: %v", err)
+		}
+		for _, obj := range page.GetMetrics() {
+			metricNames = append(metricNames, obj.GetName())
+		}
+		pageToken = page.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+	if len(metricNames) != len(wantMetrics) {
+		t.Errorf("unexpected metrics across pages; got %v, want %v", metricNames, wantMetrics)
+	}
+	wantSortedNames(t, metricNames, wantMetrics...)
+}
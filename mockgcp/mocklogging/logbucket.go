@@ -23,6 +23,7 @@ package mocklogging
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,8 +33,11 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
 )
 
 type configService struct {
@@ -41,20 +45,111 @@ type configService struct {
 	pb.UnimplementedConfigServiceV2Server
 }
 
-// createDefaultObjects will ensure that the default log bucket is created for the folder/project/org
+// defaultSupportedBucketLocations are the locations accepted in log bucket and link names by default.
+var defaultSupportedBucketLocations = []string{
+	"global",
+	"us",
+	"eu",
+	"asia-east1",
+	"asia-northeast1",
+	"asia-south1",
+	"asia-southeast1",
+	"australia-southeast1",
+	"europe-west1",
+	"europe-west2",
+	"europe-west3",
+	"europe-west4",
+	"northamerica-northeast1",
+	"southamerica-east1",
+	"us-central1",
+	"us-east1",
+	"us-east4",
+	"us-west1",
+	"us-west2",
+}
+
+// reservedBucketNames are the buckets that GCP creates for every project/folder/org/billing account.
+// What can be done with them is restricted; see checkReservedBucket.
+var reservedBucketNames = []string{"_Default", "_Required"}
+
+// bucketOperation is an operation on a bucket (or on a resource in it) that is restricted for reserved buckets.
+type bucketOperation int
+
+const (
+	deleteBucketOperation bucketOperation = iota
+	createViewOperation
+	createLinkOperation
+	enableAnalyticsOperation
+)
+
+// checkReservedBucket returns an error if op cannot be done on the bucket with ID bucketID because it is one
+// of the reserved buckets. Neither reserved bucket can be deleted. The _Required bucket holds audit logs and
+// cannot be upgraded to use Log Analytics, so it also cannot have views or links; the _Default bucket can.
+// Keep all the rules for reserved buckets here, so that they are the same for buckets, views and links.
+func checkReservedBucket(bucketID string, op bucketOperation) error {
+	isReserved := false
+	for _, reserved := range reservedBucketNames {
+		if bucketID == reserved {
+			isReserved = true
+		}
+	}
+	if !isReserved {
+		return nil
+	}
+
+	switch op {
+	case deleteBucketOperation:
+		return status.Errorf(codes.FailedPrecondition, "Bucket `%s` is a reserved bucket and cannot be deleted", bucketID)
+	case createViewOperation:
+		if bucketID == "_Required" {
+			return status.Errorf(codes.InvalidArgument, "Views cannot be created on the _Required bucket")
+		}
+	case createLinkOperation:
+		if bucketID == "_Required" {
+			return status.Errorf(codes.FailedPrecondition, "Links cannot be created on the _Required bucket")
+		}
+	case enableAnalyticsOperation:
+		if bucketID == "_Required" {
+			return status.Errorf(codes.FailedPrecondition, "The _Required bucket cannot be upgraded to use Log Analytics")
+		}
+	}
+	return nil
+}
+
+// defaultBucketRetentionDays is the retention of the _Default bucket when it is created.
+// GCP has no setting for it (Settings has no retention field), so it is the same for every project/folder/org.
+const defaultBucketRetentionDays = 30
+
+// createDefaultObjects will ensure that the default log buckets are created for the folder/project/org/billing account
 func (s *configService) createDefaultObjects(ctx context.Context, name *logBucketName) error {
 	// Create the default bucket
 	{
+		defaultBucketName := *name
+		defaultBucketName.location = "global"
+		defaultBucketName.BucketName = "_Default"
 		bucket := &pb.LogBucket{
+			Name:           defaultBucketName.String(),
 			Description:    "Default bucket",
 			LifecycleState: pb.LifecycleState_ACTIVE,
-			RetentionDays:  30,
+			RetentionDays:  defaultBucketRetentionDays,
 		}
-		if name.folder != "" {
-			bucket.Name = fmt.Sprintf("folders/%s/locations/global/buckets/_Default", name.folder)
+		if err := s.createBucketIfNotExists(ctx, bucket); err != nil {
+			return err
 		}
-		// TODO: Other orgs
+	}
 
+	// Create the required bucket
+	{
+		requiredBucketName := *name
+		requiredBucketName.location = "global"
+		requiredBucketName.BucketName = "_Required"
+		bucket := &pb.LogBucket{
+			Name:           requiredBucketName.String(),
+			Description:    "Audit bucket",
+			LifecycleState: pb.LifecycleState_ACTIVE,
+			RetentionDays:  400,
+			Locked:         true,
+		}
 		if err := s.createBucketIfNotExists(ctx, bucket); err != nil {
 			return err
 		}
@@ -64,6 +159,10 @@ func (s *configService) createDefaultObjects(ctx context.Context, name *logBucke
 }
 
 func (s *configService) createBucketIfNotExists(ctx context.Context, obj *pb.LogBucket) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
 	fqn := obj.Name
 	existing := &pb.LogBucket{}
 	err := s.storage.Get(ctx, fqn, existing)
@@ -103,20 +202,32 @@ func (s *configService) GetBucket(ctx context.Context, req *pb.GetBucketRequest)
 }
 
 func (s *configService) CreateBucket(ctx context.Context, req *pb.CreateBucketRequest) (*pb.LogBucket, error) {
+	if err := requireFields(req, "bucket"); err != nil {
+		return nil, err
+	}
 	reqName := req.Parent + "/buckets/" + req.GetBucketId()
 	name, err := s.parseLogBucketName(reqName)
 	if err != nil {
 		return nil, err
 	}
+	// The parser lets the `-` wildcard location through for ListBuckets, but buckets
+	// cannot be created there.
+	if name.location == "-" {
+		return nil, s.validateBucketLocation(name.location)
+	}
 	if err := s.createDefaultObjects(ctx, name); err != nil {
 		return nil, err
 	}
+	if err := validateIndexConfigs(req.GetBucket().GetIndexConfigs()); err != nil {
+		return nil, err
+	}
 	fqn := name.String()
-	now := time.Now()
+	now := s.now()
 	obj := proto.Clone(req.GetBucket()).(*pb.LogBucket)
 	obj.Name = fqn
 	obj.CreateTime = timestamppb.New(now)
 	obj.UpdateTime = timestamppb.New(now)
+	populateIndexConfigCreateTimes(obj, nil, now)
 	s.populateDefaultsForLogBucket(obj)
 	if err := s.storage.Create(ctx, fqn, obj); err != nil {
 		return nil, err
@@ -124,12 +235,104 @@ func (s *configService) CreateBucket(ctx context.Context, req *pb.CreateBucketRe
 	return obj, nil
 }
 
+func (s *configService) ListBuckets(ctx context.Context, req *pb.ListBucketsRequest) (*pb.ListBucketsResponse, error) {
+	// The parent is `{projects,folders,organizations,billingAccounts}/*/locations/*`, where the location may be `-`.
+	name, err := s.parseLogBucketName(req.GetParent() + "/buckets/-")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.createDefaultObjects(ctx, name); err != nil {
+		return nil, err
+	}
+
+	prefix := name.scope() + "/locations/"
+	if name.location != "-" {
+		prefix += name.location + "/buckets/"
+	}
+
+	response := &pb.ListBucketsResponse{}
+	findKind := (&pb.LogBucket{}).ProtoReflect().Descriptor()
+	if err := s.storage.List(ctx, findKind, storage.ListOptions{
+		Prefix: prefix,
+	}, func(obj proto.Message) error {
+		bucket := obj.(*pb.LogBucket)
+		if bucket.GetLifecycleState() == pb.LifecycleState_DELETE_REQUESTED && !s.showDeletedBuckets {
+			return nil
+		}
+		response.Buckets = append(response.Buckets, bucket)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(response.Buckets, func(i, j int) bool {
+		return response.Buckets[i].GetName() < response.Buckets[j].GetName()
+	})
+	page, nextPageToken, err := paging.Page(response.Buckets, (*pb.LogBucket).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.Buckets = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+// validateBucketLocation returns InvalidArgument if location is not one of the supported
+// locations of log buckets (and so of their links).
+func (s *MockService) validateBucketLocation(location string) error {
+	for _, supported := range s.supportedBucketLocations {
+		if location == supported {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "Location %q is not supported for log buckets. Supported locations are: %s", location, strings.Join(s.supportedBucketLocations, ", "))
+}
+
+// validateIndexConfigs checks the index configs of a bucket: each must have a field_path and a known
+// type, and a field can only be indexed once.
+func validateIndexConfigs(configs []*pb.IndexConfig) error {
+	seen := make(map[string]bool)
+	for _, config := range configs {
+		if config.GetFieldPath() == "" {
+			return status.Errorf(codes.InvalidArgument, "index_configs.field_path is required")
+		}
+		if _, known := pb.IndexType_name[int32(config.GetType())]; !known || config.GetType() == pb.IndexType_INDEX_TYPE_UNSPECIFIED {
+			return status.Errorf(codes.InvalidArgument, "index_configs.type of field %q must be INDEX_TYPE_STRING or INDEX_TYPE_INTEGER", config.GetFieldPath())
+		}
+		if seen[config.GetFieldPath()] {
+			return status.Errorf(codes.InvalidArgument, "field %q is indexed more than once", config.GetFieldPath())
+		}
+		seen[config.GetFieldPath()] = true
+	}
+	return nil
+}
+
+// populateIndexConfigCreateTimes sets the (output only) create_time of the index configs of obj. Configs for
+// fields that existing already indexed keep their create time, even if their type changed; new ones get now.
+func populateIndexConfigCreateTimes(obj, existing *pb.LogBucket, now time.Time) {
+	createTimes := make(map[string]*timestamppb.Timestamp)
+	for _, config := range existing.GetIndexConfigs() {
+		createTimes[config.GetFieldPath()] = config.GetCreateTime()
+	}
+	for _, config := range obj.GetIndexConfigs() {
+		if createTime, ok := createTimes[config.GetFieldPath()]; ok {
+			config.CreateTime = createTime
+		} else {
+			config.CreateTime = timestamppb.New(now)
+		}
+	}
+}
+
 func (s *configService) populateDefaultsForLogBucket(obj *pb.LogBucket) {
 	if obj.LifecycleState == pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
 		obj.LifecycleState = pb.LifecycleState_ACTIVE
 	}
 }
 
+// updatableLogBucketFields are the fields of a LogBucket that UpdateBucket can change; they are also the
+// fields that the update mask `*` stands for.
+var updatableLogBucketFields = []string{"description", "retention_days", "locked", "index_configs", "cmek_settings", "analytics_enabled"}
+
 func (s *configService) UpdateBucket(ctx context.Context, req *pb.UpdateBucketRequest) (*pb.LogBucket, error) {
 	reqName := req.Name
 	name, err := s.parseLogBucketName(reqName)
@@ -144,7 +347,10 @@ func (s *configService) UpdateBucket(ctx context.Context, req *pb.UpdateBucketRe
 	if err := s.storage.Get(ctx, fqn, existing); err != nil {
 		return nil, err
 	}
-	now := time.Now()
+	if existing.GetLifecycleState() == pb.LifecycleState_DELETE_REQUESTED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Bucket `%s` has been deleted and cannot be updated", name.BucketName)
+	}
+	now := s.now()
 	updated := proto.Clone(existing).(*pb.LogBucket)
 	updated.CreateTime = existing.CreateTime
 	updated.UpdateTime = timestamppb.New(now)
@@ -155,19 +361,46 @@ func (s *configService) UpdateBucket(ctx context.Context, req *pb.UpdateBucketRe
 		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
 	}
 
-	// TODO: Some sort of helper for fieldmask?
+	var mask []string
 	for _, path := range paths {
 		switch path {
-		case "description":
-			updated.Description = req.GetBucket().GetDescription()
-		case "retentionDays":
-			updated.RetentionDays = req.GetBucket().GetRetentionDays()
-		// case "labels":
-		// 	updated.Labels = req.GetDnsAuthorization().GetLabels()
+		case "*":
+			mask = append(mask, updatableLogBucketFields...)
+		case "description", "retentionDays", "retention_days", "locked", "indexConfigs", "index_configs", "cmekSettings", "cmek_settings", "analyticsEnabled", "analytics_enabled":
+			mask = append(mask, path)
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
 		}
 	}
+	if err := fields.UpdateByFieldMask(updated, req.GetBucket(), mask); err != nil {
+		return nil, err
+	}
+	if existing.Locked && updated.RetentionDays != existing.RetentionDays {
+		return nil, status.Errorf(codes.FailedPrecondition, "The retention period of locked bucket %q cannot be changed", fqn)
+	}
+	if existing.Locked && !updated.Locked {
+		return nil, status.Errorf(codes.FailedPrecondition, "Bucket %q is locked and cannot be unlocked", fqn)
+	}
+	// CMEK can only be configured when a bucket is created; afterwards only the key can be changed.
+	if existing.GetCmekSettings() == nil && updated.GetCmekSettings() != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "CMEK settings of bucket %q can only be set when the bucket is created", fqn)
+	}
+	if existing.GetCmekSettings() != nil && updated.GetCmekSettings().GetKmsKeyName() == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "CMEK cannot be disabled on bucket %q", fqn)
+	}
+	// A bucket can be upgraded to use Log Analytics, but the upgrade cannot be undone.
+	if existing.AnalyticsEnabled && !updated.AnalyticsEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "Log Analytics cannot be disabled on bucket %q", fqn)
+	}
+	if !existing.AnalyticsEnabled && updated.AnalyticsEnabled {
+		if err := checkReservedBucket(name.BucketName, enableAnalyticsOperation); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateIndexConfigs(updated.GetIndexConfigs()); err != nil {
+		return nil, err
+	}
+	populateIndexConfigCreateTimes(updated, existing, now)
 
 	s.populateDefaultsForLogBucket(updated)
 	if err := s.storage.Update(ctx, fqn, updated); err != nil {
@@ -184,9 +417,45 @@ func (s *configService) DeleteBucket(ctx context.Context, req *pb.DeleteBucketRe
 	if err := s.createDefaultObjects(ctx, name); err != nil {
 		return nil, err
 	}
+	if err := checkReservedBucket(name.BucketName, deleteBucketOperation); err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+	obj := &pb.LogBucket{}
+	if err := s.storage.Get(ctx, fqn, obj); err != nil {
+		return nil, err
+	}
+	// GCP keeps deleted buckets for 7 days, during which they can be undeleted; we never purge them.
+	if obj.GetLifecycleState() != pb.LifecycleState_ACTIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "Bucket `%s` must be ACTIVE to be deleted", name.BucketName)
+	}
+	obj.LifecycleState = pb.LifecycleState_DELETE_REQUESTED
+	obj.UpdateTime = timestamppb.New(s.now())
+	if err := s.storage.Update(ctx, fqn, obj); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *configService) UndeleteBucket(ctx context.Context, req *pb.UndeleteBucketRequest) (*empty.Empty, error) {
+	name, err := s.parseLogBucketName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
 	fqn := name.String()
-	deletedObj := &pb.LogBucket{}
-	if err := s.storage.Delete(ctx, fqn, deletedObj); err != nil {
+	obj := &pb.LogBucket{}
+	if err := s.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Bucket `%s` does not exist", name.BucketName)
+		}
+		return nil, err
+	}
+	if obj.GetLifecycleState() != pb.LifecycleState_DELETE_REQUESTED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Bucket `%s` has not been deleted", name.BucketName)
+	}
+	obj.LifecycleState = pb.LifecycleState_ACTIVE
+	obj.UpdateTime = timestamppb.New(s.now())
+	if err := s.storage.Update(ctx, fqn, obj); err != nil {
 		return nil, err
 	}
 	return &empty.Empty{}, nil
@@ -204,55 +473,57 @@ type logBucketName struct {
 }
 
 func (n *logBucketName) String() string {
+	return n.scope() + "/locations/" + n.location + "/buckets/" + n.BucketName
+}
+
+// scope returns the project/folder/org/billing account that owns the bucket.
+func (n *logBucketName) scope() string {
 	if n.organization != "" {
-		return "organizations/" + n.organization + "/locations/" + n.location + "/buckets/" + n.BucketName
+		return "organizations/" + n.organization
 	}
 	if n.folder != "" {
-		return "folders/" + n.folder + "/locations/" + n.location + "/buckets/" + n.BucketName
+		return "folders/" + n.folder
 	}
 	if n.billingAccount != "" {
-		return "billingAccounts/" + n.billingAccount + "/locations/" + n.location + "/buckets/" + n.BucketName
+		return "billingAccounts/" + n.billingAccount
 	}
-	return "projects/" + n.project.ID + "/locations/" + n.location + "/buckets/" + n.BucketName
+	return "projects/" + n.project.ID
 }
 
 // parseLogBucketName parses a string into a logBucketName.
-// The expected form is `projects/*/locations/*/buckets/*`.
+// The expected form is `{projects,folders,organizations,billingAccounts}/*/locations/*/buckets/*`.
 func (s *MockService) parseLogBucketName(name string) (*logBucketName, error) {
 	tokens := strings.Split(name, "/")
-	if len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "locations" && tokens[4] == "buckets" {
+	if len(tokens) != 6 || tokens[2] != "locations" || tokens[4] != "buckets" {
+		return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	}
+
+	bucket := &logBucketName{
+		location:   tokens[3],
+		BucketName: tokens[5],
+	}
+	switch tokens[0] {
+	case "projects":
 		project, err := s.Projects.GetProjectByID(tokens[1])
 		if err != nil {
 			return nil, err
 		}
-		name := &logBucketName{
-			project:    project,
-			location:   tokens[3],
-			BucketName: tokens[5],
-		}
-		return name, nil
-	} else if len(tokens) == 6 && tokens[0] == "folders" && tokens[2] == "locations" && tokens[4] == "buckets" {
-		name := &logBucketName{
-			folder:     tokens[1],
-			location:   tokens[3],
-			BucketName: tokens[5],
-		}
-		return name, nil
-	} else if len(tokens) == 6 && tokens[0] == "organizations" && tokens[2] == "locations" && tokens[4] == "buckets" {
-		name := &logBucketName{
-			organization: tokens[1],
-			location:     tokens[3],
-			BucketName:   tokens[5],
-		}
-		return name, nil
-	} else if len(tokens) == 6 && tokens[0] == "billingAccounts" && tokens[2] == "locations" && tokens[4] == "buckets" {
-		name := &logBucketName{
-			billingAccount: tokens[1],
-			location:       tokens[3],
-			BucketName:     tokens[5],
-		}
-		return name, nil
-	} else {
+		bucket.project = project
+	case "folders":
+		bucket.folder = tokens[1]
+	case "organizations":
+		bucket.organization = tokens[1]
+	case "billingAccounts":
+		bucket.billingAccount = tokens[1]
+	default:
 		return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
 	}
+
+	// ListBuckets accepts the `-` wildcard location.
+	if bucket.location != "-" {
+		if err := s.validateBucketLocation(bucket.location); err != nil {
+			return nil, err
+		}
+	}
+	return bucket, nil
 }
@@ -0,0 +1,563 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestCreateBucketLocation(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	s.SetSupportedBucketLocations([]string{"global", "us-central1"})
+
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   "projects/" + testProjectID + "/locations/us-central1",
+		BucketId: "supported",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	}); err != nil {
+		t.Fatalf("creating bucket in supported location: %v", err)
+	}
+
+	_, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   "projects/" + testProjectID + "/locations/mars-north1",
+		BucketId: "unsupported",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	msg := status.Convert(err).Message()
+	if !strings.Contains(msg, `"mars-north1"`) || !strings.Contains(msg, "global, us-central1") {
+		t.Errorf("error message should name the location and list the supported locations; got %q", msg)
+	}
+
+	// Names in unsupported locations are rejected by every method, not only on create.
+	_, err = s.GetBucket(ctx, &pb.GetBucketRequest{
+		Name: "projects/" + testProjectID + "/locations/mars-north1/buckets/unsupported",
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	if _, err := s.ListBuckets(ctx, &pb.ListBucketsRequest{
+		Parent: "projects/" + testProjectID + "/locations/-",
+	}); err != nil {
+		t.Errorf("listing buckets in all locations: %v", err)
+	}
+}
+
+func TestBucketRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "organizations/456/locations/us-central1"
+	fqn := parent + "/buckets/mybucket"
+	created, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   parent,
+		BucketId: "mybucket",
+		Bucket:   &pb.LogBucket{Description: "original", RetentionDays: 30},
+	})
+	if err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+	if created.GetName() != fqn || created.GetLifecycleState() != pb.LifecycleState_ACTIVE {
+		t.Errorf("unexpected created bucket %v", created)
+	}
+
+	got, err := s.GetBucket(ctx, &pb.GetBucketRequest{Name: fqn})
+	if err != nil {
+		t.Fatalf("GetBucket failed: %v", err)
+	}
+	if !proto.Equal(got, created) {
+		t.Errorf("unexpected bucket; got %v, want %v", got, created)
+	}
+
+	// Only the fields named in the update mask should change.
+	updated, err := s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+		Name:       fqn,
+		Bucket:     &pb.LogBucket{Description: "ignored", RetentionDays: 60, Locked: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"retention_days", "locked"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBucket failed: %v", err)
+	}
+	if updated.GetDescription() != "original" || updated.GetRetentionDays() != 60 || !updated.GetLocked() {
+		t.Errorf("unexpected updated bucket %v", updated)
+	}
+
+	// The retention of a locked bucket cannot change.
+	_, err = s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+		Name:       fqn,
+		Bucket:     &pb.LogBucket{RetentionDays: 90},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"retentionDays"}},
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	// A bucket can be upgraded to use Log Analytics, but not downgraded.
+	upgraded, err := s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+		Name:       fqn,
+		Bucket:     &pb.LogBucket{AnalyticsEnabled: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"analyticsEnabled"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBucket failed: %v", err)
+	}
+	if !upgraded.GetAnalyticsEnabled() || upgraded.GetRetentionDays() != 60 {
+		t.Errorf("unexpected upgraded bucket %v", upgraded)
+	}
+	_, err = s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+		Name:       fqn,
+		Bucket:     &pb.LogBucket{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"analytics_enabled"}},
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	list, err := s.ListBuckets(ctx, &pb.ListBucketsRequest{Parent: "organizations/456/locations/-"})
+	if err != nil {
+		t.Fatalf("ListBuckets failed: %v", err)
+	}
+	var names []string
+	for _, bucket := range list.GetBuckets() {
+		names = append(names, bucket.GetName())
+	}
+	sort.Strings(names)
+	wantNames := []string{
+		"organizations/456/locations/global/buckets/_Default",
+		"organizations/456/locations/global/buckets/_Required",
+		fqn,
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("unexpected buckets listed; got %v, want %v", names, wantNames)
+	}
+
+	list, err = s.ListBuckets(ctx, &pb.ListBucketsRequest{Parent: parent})
+	if err != nil {
+		t.Fatalf("ListBuckets failed: %v", err)
+	}
+	if len(list.GetBuckets()) != 1 || list.GetBuckets()[0].GetName() != fqn {
+		t.Errorf("unexpected buckets listed in %s: %v", parent, list.GetBuckets())
+	}
+
+	if _, err := s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: fqn}); err != nil {
+		t.Fatalf("DeleteBucket failed: %v", err)
+	}
+	deleted, err := s.GetBucket(ctx, &pb.GetBucketRequest{Name: fqn})
+	if err != nil {
+		t.Fatalf("GetBucket failed: %v", err)
+	}
+	if deleted.GetLifecycleState() != pb.LifecycleState_DELETE_REQUESTED {
+		t.Errorf("unexpected lifecycle state of deleted bucket; got %v, want DELETE_REQUESTED", deleted.GetLifecycleState())
+	}
+}
+
+func TestDeletedBucketsAreNotListed(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "organizations/456/locations/us-central1"
+	fqn := parent + "/buckets/mybucket"
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   parent,
+		BucketId: "mybucket",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	}); err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+	if _, err := s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: fqn}); err != nil {
+		t.Fatalf("DeleteBucket failed: %v", err)
+	}
+
+	list, err := s.ListBuckets(ctx, &pb.ListBucketsRequest{Parent: parent})
+	if err != nil {
+		t.Fatalf("ListBuckets failed: %v", err)
+	}
+	if len(list.GetBuckets()) != 0 {
+		t.Errorf("expected deleted bucket not to be listed, got %v", list.GetBuckets())
+	}
+
+	s.SetShowDeletedBuckets(true)
+	list, err = s.ListBuckets(ctx, &pb.ListBucketsRequest{Parent: parent})
+	if err != nil {
+		t.Fatalf("ListBuckets failed: %v", err)
+	}
+	if len(list.GetBuckets()) != 1 || list.GetBuckets()[0].GetLifecycleState() != pb.LifecycleState_DELETE_REQUESTED {
+		t.Errorf("expected the deleted bucket to be listed as DELETE_REQUESTED, got %v", list.GetBuckets())
+	}
+
+	// A deleted bucket cannot be deleted again, updated, or re-created.
+	_, err = s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: fqn})
+	wantCode(t, err, codes.FailedPrecondition)
+	_, err = s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+		Name:       fqn,
+		Bucket:     &pb.LogBucket{RetentionDays: 60},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"retention_days"}},
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+	_, err = s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   parent,
+		BucketId: "mybucket",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	})
+	wantCode(t, err, codes.AlreadyExists)
+}
+
+func TestUndeleteBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "organizations/456/locations/us-central1"
+	fqn := parent + "/buckets/mybucket"
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   parent,
+		BucketId: "mybucket",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	}); err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+
+	// Only deleted buckets can be undeleted.
+	_, err := s.UndeleteBucket(ctx, &pb.UndeleteBucketRequest{Name: fqn})
+	wantCode(t, err, codes.FailedPrecondition)
+	_, err = s.UndeleteBucket(ctx, &pb.UndeleteBucketRequest{Name: parent + "/buckets/nosuchbucket"})
+	wantCode(t, err, codes.NotFound)
+
+	if _, err := s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: fqn}); err != nil {
+		t.Fatalf("DeleteBucket failed: %v", err)
+	}
+	if _, err := s.UndeleteBucket(ctx, &pb.UndeleteBucketRequest{Name: fqn}); err != nil {
+		t.Fatalf("UndeleteBucket failed: %v", err)
+	}
+
+	got, err := s.GetBucket(ctx, &pb.GetBucketRequest{Name: fqn})
+	if err != nil {
+		t.Fatalf("GetBucket failed: %v", err)
+	}
+	if got.GetLifecycleState() != pb.LifecycleState_ACTIVE || got.GetRetentionDays() != 30 {
+		t.Errorf("unexpected undeleted bucket %v", got)
+	}
+	list, err := s.ListBuckets(ctx, &pb.ListBucketsRequest{Parent: parent})
+	if err != nil {
+		t.Fatalf("ListBuckets failed: %v", err)
+	}
+	if len(list.GetBuckets()) != 1 || list.GetBuckets()[0].GetName() != fqn {
+		t.Errorf("expected the undeleted bucket to be listed, got %v", list.GetBuckets())
+	}
+}
+
+func TestUpdateBucketWildcard(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "organizations/456/locations/us-central1"
+	fqn := parent + "/buckets/mybucket"
+	created, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   parent,
+		BucketId: "mybucket",
+		Bucket:   &pb.LogBucket{Description: "original", RetentionDays: 30},
+	})
+	if err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+
+	// `*` replaces the updatable fields, but leaves the output-only fields alone.
+	updated, err := s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+		Name:       fqn,
+		Bucket:     &pb.LogBucket{Name: "ignored", RetentionDays: 60},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBucket failed: %v", err)
+	}
+	if updated.GetName() != fqn || updated.GetDescription() != "" || updated.GetRetentionDays() != 60 {
+		t.Errorf("unexpected updated bucket %v", updated)
+	}
+	if !proto.Equal(updated.GetCreateTime(), created.GetCreateTime()) || updated.GetLifecycleState() != pb.LifecycleState_ACTIVE {
+		t.Errorf("output-only fields changed by update; got %v, created %v", updated, created)
+	}
+}
+
+func TestDeleteReservedBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	for _, bucketName := range []string{"_Default", "_Required"} {
+		fqn := "projects/" + testProjectID + "/locations/global/buckets/" + bucketName
+		_, err := s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: fqn})
+		wantCode(t, err, codes.FailedPrecondition)
+
+		if _, err := s.GetBucket(ctx, &pb.GetBucketRequest{Name: fqn}); err != nil {
+			t.Errorf("reserved bucket %q should still exist: %v", bucketName, err)
+		}
+	}
+}
+
+func TestUpdateBucketIndexConfigs(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.SetClock(func() time.Time { return createTime })
+	createTestBucket(t, s)
+
+	updateIndexConfigs := func(t *testing.T, configs ...*pb.IndexConfig) (*pb.LogBucket, error) {
+		t.Helper()
+
+		return s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+			Name:       testBucketFQN,
+			Bucket:     &pb.LogBucket{IndexConfigs: configs},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"index_configs"}},
+		})
+	}
+	// indexTypes returns the indexed fields of a bucket, with their types.
+	indexTypes := func(bucket *pb.LogBucket) map[string]pb.IndexType {
+		types := make(map[string]pb.IndexType)
+		for _, config := range bucket.GetIndexConfigs() {
+			types[config.GetFieldPath()] = config.GetType()
+		}
+		return types
+	}
+
+	// Add an index config.
+	added, err := updateIndexConfigs(t, &pb.IndexConfig{FieldPath: "jsonPayload.user", Type: pb.IndexType_INDEX_TYPE_STRING})
+	if err != nil {
+		t.Fatalf("UpdateBucket failed: %v", err)
+	}
+	if want := map[string]pb.IndexType{"jsonPayload.user": pb.IndexType_INDEX_TYPE_STRING}; !reflect.DeepEqual(indexTypes(added), want) {
+		t.Errorf("unexpected index configs after adding; got %v, want %v", indexTypes(added), want)
+	}
+	if got := added.GetIndexConfigs()[0].GetCreateTime().AsTime(); !got.Equal(createTime) {
+		t.Errorf("unexpected index config create time; got %v, want %v", got, createTime)
+	}
+	if !added.GetAnalyticsEnabled() || added.GetRetentionDays() != 30 {
+		t.Errorf("fields outside the update mask changed; got %v", added)
+	}
+
+	// Modify it, and add another; the modified config keeps its create time.
+	updateTime := createTime.Add(time.Hour)
+	s.SetClock(func() time.Time { return updateTime })
+	modified, err := updateIndexConfigs(t,
+		&pb.IndexConfig{FieldPath: "jsonPayload.user", Type: pb.IndexType_INDEX_TYPE_INTEGER},
+		&pb.IndexConfig{FieldPath: "jsonPayload.status", Type: pb.IndexType_INDEX_TYPE_INTEGER},
+	)
+	if err != nil {
+		t.Fatalf("UpdateBucket failed: %v", err)
+	}
+	if want := map[string]pb.IndexType{"jsonPayload.user": pb.IndexType_INDEX_TYPE_INTEGER, "jsonPayload.status": pb.IndexType_INDEX_TYPE_INTEGER}; !reflect.DeepEqual(indexTypes(modified), want) {
+		t.Errorf("unexpected index configs after modifying; got %v, want %v", indexTypes(modified), want)
+	}
+	for _, config := range modified.GetIndexConfigs() {
+		want := updateTime
+		if config.GetFieldPath() == "jsonPayload.user" {
+			want = createTime
+		}
+		if got := config.GetCreateTime().AsTime(); !got.Equal(want) {
+			t.Errorf("unexpected create time of index config %q; got %v, want %v", config.GetFieldPath(), got, want)
+		}
+	}
+
+	// Remove one.
+	removed, err := updateIndexConfigs(t, &pb.IndexConfig{FieldPath: "jsonPayload.status", Type: pb.IndexType_INDEX_TYPE_INTEGER})
+	if err != nil {
+		t.Fatalf("UpdateBucket failed: %v", err)
+	}
+	if want := map[string]pb.IndexType{"jsonPayload.status": pb.IndexType_INDEX_TYPE_INTEGER}; !reflect.DeepEqual(indexTypes(removed), want) {
+		t.Errorf("unexpected index configs after removing; got %v, want %v", indexTypes(removed), want)
+	}
+
+	// Invalid index configs are rejected, and leave the bucket unchanged.
+	for _, configs := range [][]*pb.IndexConfig{
+		{{Type: pb.IndexType_INDEX_TYPE_STRING}},
+		{{FieldPath: "jsonPayload.user"}},
+		{{FieldPath: "jsonPayload.user", Type: pb.IndexType(42)}},
+		{
+			{FieldPath: "jsonPayload.user", Type: pb.IndexType_INDEX_TYPE_STRING},
+			{FieldPath: "jsonPayload.user", Type: pb.IndexType_INDEX_TYPE_INTEGER},
+		},
+	} {
+		_, err := updateIndexConfigs(t, configs...)
+		wantCode(t, err, codes.InvalidArgument)
+	}
+	got, err := s.GetBucket(ctx, &pb.GetBucketRequest{Name: testBucketFQN})
+	if err != nil {
+		t.Fatalf("GetBucket failed: %v", err)
+	}
+	if !proto.Equal(got, removed) {
+		t.Errorf("bucket changed by rejected updates; got %v, want %v", got, removed)
+	}
+
+	_, err = s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   testBucketParent,
+		BucketId: "invalid",
+		Bucket:   &pb.LogBucket{IndexConfigs: []*pb.IndexConfig{{FieldPath: "jsonPayload.user"}}},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+// TestReservedBucketRules checks that buckets, views and links apply the same rules for the reserved buckets.
+func TestUpdateBucketCmekSettings(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "projects/" + testProjectID + "/locations/global"
+	key := func(name string) *pb.CmekSettings {
+		return &pb.CmekSettings{KmsKeyName: "projects/" + testProjectID + "/locations/global/keyRings/ring/cryptoKeys/" + name}
+	}
+	updateCmek := func(bucketID string, cmek *pb.CmekSettings) (*pb.LogBucket, error) {
+		return s.UpdateBucket(ctx, &pb.UpdateBucketRequest{
+			Name:       parent + "/buckets/" + bucketID,
+			Bucket:     &pb.LogBucket{CmekSettings: cmek},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cmek_settings"}},
+		})
+	}
+
+	for _, bucketID := range []string{"plain", "encrypted"} {
+		bucket := &pb.LogBucket{}
+		if bucketID == "encrypted" {
+			bucket.CmekSettings = key("first")
+		}
+		if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{Parent: parent, BucketId: bucketID, Bucket: bucket}); err != nil {
+			t.Fatalf("CreateBucket(%q) failed: %v", bucketID, err)
+		}
+	}
+
+	// CMEK cannot be added to a bucket after it is created...
+	_, err := updateCmek("plain", key("first"))
+	wantCode(t, err, codes.FailedPrecondition)
+
+	// ... nor removed from it, but the key can be changed.
+	_, err = updateCmek("encrypted", nil)
+	wantCode(t, err, codes.FailedPrecondition)
+	updated, err := updateCmek("encrypted", key("second"))
+	if err != nil {
+		t.Fatalf("changing the key failed: %v", err)
+	}
+	if got, want := updated.GetCmekSettings().GetKmsKeyName(), key("second").GetKmsKeyName(); got != want {
+		t.Errorf("unexpected kmsKeyName; got %q, want %q", got, want)
+	}
+}
+
+func TestReservedBucketRules(t *testing.T) {
+	ctx := context.Background()
+
+	grid := []struct {
+		name   string
+		bucket string
+		do     func(s *configService, bucketFQN string) error
+		want   codes.Code
+	}{
+		{
+			name:   "delete _Default",
+			bucket: "_Default",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: bucketFQN})
+				return err
+			},
+			want: codes.FailedPrecondition,
+		},
+		{
+			name:   "delete _Required",
+			bucket: "_Required",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.DeleteBucket(ctx, &pb.DeleteBucketRequest{Name: bucketFQN})
+				return err
+			},
+			want: codes.FailedPrecondition,
+		},
+		{
+			name:   "create view on _Default",
+			bucket: "_Default",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.CreateView(ctx, &pb.CreateViewRequest{Parent: bucketFQN, ViewId: "myview", View: &pb.LogView{}})
+				return err
+			},
+			want: codes.OK,
+		},
+		{
+			name:   "create view on _Required",
+			bucket: "_Required",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.CreateView(ctx, &pb.CreateViewRequest{Parent: bucketFQN, ViewId: "myview", View: &pb.LogView{}})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "create link on _Default",
+			bucket: "_Default",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{Parent: bucketFQN, LinkId: "mylink", Link: &pb.Link{}})
+				return err
+			},
+			want: codes.OK,
+		},
+		{
+			name:   "create link on _Required",
+			bucket: "_Required",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{Parent: bucketFQN, LinkId: "mylink", Link: &pb.Link{}})
+				return err
+			},
+			want: codes.FailedPrecondition,
+		},
+		{
+			name:   "seed link on _Required",
+			bucket: "_Required",
+			do: func(s *configService, bucketFQN string) error {
+				return s.SeedLinks(ctx, []*pb.Link{{Name: bucketFQN + "/links/mylink"}})
+			},
+			want: codes.FailedPrecondition,
+		},
+		{
+			name:   "enable analytics on _Default",
+			bucket: "_Default",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.UpdateBucket(ctx, &pb.UpdateBucketRequest{Name: bucketFQN, Bucket: &pb.LogBucket{AnalyticsEnabled: true}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"analytics_enabled"}}})
+				return err
+			},
+			want: codes.OK,
+		},
+		{
+			name:   "enable analytics on _Required",
+			bucket: "_Required",
+			do: func(s *configService, bucketFQN string) error {
+				_, err := s.UpdateBucket(ctx, &pb.UpdateBucketRequest{Name: bucketFQN, Bucket: &pb.LogBucket{AnalyticsEnabled: true}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"analytics_enabled"}}})
+				return err
+			},
+			want: codes.FailedPrecondition,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			s := newTestConfigService(t)
+			bucketFQN := testBucketParent + "/buckets/" + g.bucket
+			wantCode(t, g.do(s, bucketFQN), g.want)
+		})
+	}
+
+	// Other buckets are not restricted.
+	for _, op := range []bucketOperation{deleteBucketOperation, createViewOperation, createLinkOperation, enableAnalyticsOperation} {
+		if err := checkReservedBucket("analytics", op); err != nil {
+			t.Errorf("unexpected error for operation %v on a bucket that is not reserved: %v", op, err)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +tool:mockgcp-support
+// apiVersion: logging.cnrm.cloud.google.com/v1beta1
+// kind: LoggingLogExclusion
+// service: google.logging.v2.ConfigServiceV2
+// resource: LogExclusion
+
+package mocklogging
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+func (s *configService) GetExclusion(ctx context.Context, req *pb.GetExclusionRequest) (*pb.LogExclusion, error) {
+	name, err := s.parseLogExclusionName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+
+	obj := &pb.LogExclusion{}
+	if err := s.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Exclusion %s does not exist", name.ExclusionName)
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s *configService) CreateExclusion(ctx context.Context, req *pb.CreateExclusionRequest) (*pb.LogExclusion, error) {
+	reqName := fmt.Sprintf("%s/exclusions/%s", req.GetParent(), req.GetExclusion().GetName())
+	name, err := s.parseLogExclusionName(reqName)
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	if err := requireFields(req, "exclusion.filter"); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	obj := proto.Clone(req.GetExclusion()).(*pb.LogExclusion)
+	obj.CreateTime = timestamppb.New(now)
+	obj.UpdateTime = timestamppb.New(now)
+
+	if err := s.storage.Create(ctx, fqn, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (s *configService) ListExclusions(ctx context.Context, req *pb.ListExclusionsRequest) (*pb.ListExclusionsResponse, error) {
+	parent, remainder, err := s.PopFolderOrgOrProject(strings.Split(req.GetParent(), "/"))
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "parent %q is not valid", req.GetParent())
+	}
+
+	response := &pb.ListExclusionsResponse{}
+	findKind := (&pb.LogExclusion{}).ProtoReflect().Descriptor()
+	if err := s.storage.List(ctx, findKind, storage.ListOptions{
+		Prefix: parent.String() + "/exclusions/",
+	}, func(obj proto.Message) error {
+		exclusion := obj.(*pb.LogExclusion)
+		response.Exclusions = append(response.Exclusions, exclusion)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(response.Exclusions, func(i, j int) bool {
+		return response.Exclusions[i].GetName() < response.Exclusions[j].GetName()
+	})
+	page, nextPageToken, err := paging.Page(response.Exclusions, (*pb.LogExclusion).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.Exclusions = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+func (s *configService) UpdateExclusion(ctx context.Context, req *pb.UpdateExclusionRequest) (*pb.LogExclusion, error) {
+	name, err := s.parseLogExclusionName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	existing := &pb.LogExclusion{}
+	if err := s.storage.Get(ctx, fqn, existing); err != nil {
+		return nil, err
+	}
+
+	updated := proto.Clone(existing).(*pb.LogExclusion)
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "description", "filter", "disabled":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
+		}
+	}
+	if err := fields.UpdateByFieldMask(updated, req.GetExclusion(), paths); err != nil {
+		return nil, err
+	}
+	updated.UpdateTime = timestamppb.New(s.now())
+	if err := s.storage.Update(ctx, fqn, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (s *configService) DeleteExclusion(ctx context.Context, req *pb.DeleteExclusionRequest) (*empty.Empty, error) {
+	name, err := s.parseLogExclusionName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+	deletedObj := &pb.LogExclusion{}
+
+	if err := s.storage.Delete(ctx, fqn, deletedObj); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+type logExclusionName struct {
+	Parent        FolderOrgOrProject
+	ExclusionName string
+}
+
+func (n *logExclusionName) String() string {
+	return fmt.Sprintf("%s/exclusions/%s", n.Parent.String(), n.ExclusionName)
+}
+
+// parseLogExclusionName parses a string into a logExclusionName.
+// The expected form is `{projects,folders,organizations,billingAccounts}/*/exclusions/*`
+func (s *configService) parseLogExclusionName(name string) (*logExclusionName, error) {
+	tokens := strings.Split(name, "/")
+
+	parent, remainder, err := s.PopFolderOrgOrProject(tokens)
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) == 2 && remainder[0] == "exclusions" && remainder[1] != "" {
+		return &logExclusionName{
+			Parent:        *parent,
+			ExclusionName: remainder[1],
+		}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+}
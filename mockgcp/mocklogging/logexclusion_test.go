@@ -0,0 +1,107 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestLogExclusionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "projects/" + testProjectID
+	name := parent + "/exclusions/myexclusion"
+
+	created, err := s.CreateExclusion(ctx, &pb.CreateExclusionRequest{
+		Parent: parent,
+		Exclusion: &pb.LogExclusion{
+			Name:        "myexclusion",
+			Description: "drop debug logs",
+			Filter:      "severity<INFO",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateExclusion failed: %v", err)
+	}
+	if created.GetCreateTime() == nil || created.GetUpdateTime() == nil {
+		t.Errorf("expected create and update times to be set, got %v", created)
+	}
+
+	updated, err := s.UpdateExclusion(ctx, &pb.UpdateExclusionRequest{
+		Name:       name,
+		Exclusion:  &pb.LogExclusion{Filter: "ignored", Disabled: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"disabled"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateExclusion failed: %v", err)
+	}
+	if !updated.GetDisabled() || updated.GetFilter() != "severity<INFO" || updated.GetDescription() != "drop debug logs" {
+		t.Errorf("unexpected updated exclusion %v", updated)
+	}
+
+	got, err := s.GetExclusion(ctx, &pb.GetExclusionRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetExclusion failed: %v", err)
+	}
+	if !got.GetDisabled() {
+		t.Errorf("update was not stored; got %v", got)
+	}
+
+	list, err := s.ListExclusions(ctx, &pb.ListExclusionsRequest{Parent: parent})
+	if err != nil {
+		t.Fatalf("ListExclusions failed: %v", err)
+	}
+	if len(list.GetExclusions()) != 1 || list.GetExclusions()[0].GetName() != "myexclusion" {
+		t.Errorf("unexpected exclusions listed %v", list.GetExclusions())
+	}
+
+	if _, err := s.DeleteExclusion(ctx, &pb.DeleteExclusionRequest{Name: name}); err != nil {
+		t.Fatalf("DeleteExclusion failed: %v", err)
+	}
+	_, err = s.GetExclusion(ctx, &pb.GetExclusionRequest{Name: name})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestUpdateExclusionRequiresMask(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	if _, err := s.CreateExclusion(ctx, &pb.CreateExclusionRequest{
+		Parent:    "folders/123",
+		Exclusion: &pb.LogExclusion{Name: "myexclusion", Filter: "severity<INFO"},
+	}); err != nil {
+		t.Fatalf("CreateExclusion failed: %v", err)
+	}
+
+	_, err := s.UpdateExclusion(ctx, &pb.UpdateExclusionRequest{
+		Name:      "folders/123/exclusions/myexclusion",
+		Exclusion: &pb.LogExclusion{Disabled: true},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	_, err = s.UpdateExclusion(ctx, &pb.UpdateExclusionRequest{
+		Name:       "folders/123/exclusions/myexclusion",
+		Exclusion:  &pb.LogExclusion{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"create_time"}},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
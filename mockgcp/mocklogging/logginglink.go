@@ -0,0 +1,351 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	longrunning "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+// createLinkDefaultObjects ensures that the default log buckets exist for the link's project/folder/org/billing account.
+func (s *configService) createLinkDefaultObjects(ctx context.Context, name *loggingLinkName) error {
+	return s.createDefaultObjects(ctx, &name.bucket)
+}
+
+func (s *configService) GetLink(ctx context.Context, req *pb.GetLinkRequest) (*pb.Link, error) {
+	name, err := s.parseLoggingLinkName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.createLinkDefaultObjects(ctx, name); err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+	obj := &pb.Link{}
+	if err := s.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Requested entity was not found. Link `%s` does not exist", fqn)
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s *configService) ListLinks(ctx context.Context, req *pb.ListLinksRequest) (*pb.ListLinksResponse, error) {
+	bucketName, err := s.parseLogBucketName(req.GetParent())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListLinksResponse{}
+	findKind := (&pb.Link{}).ProtoReflect().Descriptor()
+	if err := s.storage.List(ctx, findKind, storage.ListOptions{
+		Prefix: bucketName.String() + "/links/",
+	}, func(obj proto.Message) error {
+		link := obj.(*pb.Link)
+		response.Links = append(response.Links, link)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(response.Links, func(i, j int) bool {
+		return response.Links[i].GetName() < response.Links[j].GetName()
+	})
+	page, nextPageToken, err := paging.Page(response.Links, (*pb.Link).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.Links = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+func (s *configService) CreateLink(ctx context.Context, req *pb.CreateLinkRequest) (*longrunning.Operation, error) {
+	// bigquery_dataset is output only, so only the link itself is required.
+	if err := requireFields(req, "link"); err != nil {
+		return nil, err
+	}
+	name, err := s.newLoggingLinkName(req.GetParent(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkReservedBucket(name.bucket.BucketName, createLinkOperation); err != nil {
+		return nil, err
+	}
+	if err := validateLinkDescription(req.GetLink()); err != nil {
+		return nil, err
+	}
+
+	if err := s.createLinkDefaultObjects(ctx, name); err != nil {
+		return nil, err
+	}
+
+	// The bucket the link is created in must already exist; only the _Default bucket is created on demand.
+	if err := s.storage.Get(ctx, name.bucket.String(), &pb.LogBucket{}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Bucket `%s` does not exist", name.bucket.BucketName)
+		}
+		return nil, err
+	}
+
+	// Creating the link (and its dataset) takes a while in GCP; give up if the caller already has.
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+	now := s.now()
+	obj := s.newLinkObject(name, req.GetLink(), now)
+	if err := s.storage.Create(ctx, fqn, obj); err != nil {
+		// Link IDs are only unique within their bucket, as the storage key is the full link name.
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, status.Errorf(codes.AlreadyExists, "link %q already exists", fqn)
+		}
+		return nil, err
+	}
+
+	metadata := &pb.LinkMetadata{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now),
+		State:     pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		Request: &pb.LinkMetadata_CreateLinkRequest{
+			CreateLinkRequest: req,
+		},
+	}
+	return s.operations.DoneLRO(ctx, name.operationPrefix(), metadata, obj)
+}
+
+// linkIDRegex matches valid link IDs. The link ID is also the ID of the BigQuery dataset created for the link,
+// so it must follow the BigQuery dataset naming rules.
+var linkIDRegex = regexp.MustCompile(`^[A-Za-z0-9_]{1,100}$`)
+
+// validateLinkID returns InvalidArgument if the link ID cannot be used as a BigQuery dataset ID.
+// Note that bigquery_dataset itself is output only: GCP fills it in from the link ID, so we do not require it.
+func validateLinkID(linkID string) error {
+	if !linkIDRegex.MatchString(linkID) {
+		return status.Errorf(codes.InvalidArgument, "link_id %q is not valid: it must have at most 100 characters, and only contain alphanumeric characters and underscores", linkID)
+	}
+	return nil
+}
+
+// maxLinkDescriptionLength is the maximum length of a link description, in characters.
+const maxLinkDescriptionLength = 8000
+
+// validateLinkDescription returns InvalidArgument if the description of link is too long.
+func validateLinkDescription(link *pb.Link) error {
+	if n := utf8.RuneCountInString(link.GetDescription()); n > maxLinkDescriptionLength {
+		return status.Errorf(codes.InvalidArgument, "link description has %d characters, but the maximum is %d", n, maxLinkDescriptionLength)
+	}
+	return nil
+}
+
+// newLinkObject returns the link that is stored when link is created with the given name.
+// The result does not share any sub-messages (e.g. bigquery_dataset) with link, which the
+// caller still owns and which may also be recorded in the operation metadata.
+func (s *configService) newLinkObject(name *loggingLinkName, link *pb.Link, now time.Time) *pb.Link {
+	obj := proto.Clone(link).(*pb.Link)
+	if obj == nil {
+		obj = &pb.Link{}
+	}
+	obj.Name = name.String()
+	obj.CreateTime = timestamppb.New(now)
+	s.populateDefaultsForLoggingLink(obj)
+	if name.bucket.project != nil {
+		obj.BigqueryDataset = &pb.BigQueryDataset{
+			DatasetId: "bigquery.googleapis.com/projects/" + name.bucket.project.ID + "/datasets/" + name.LinkName,
+		}
+	}
+	return obj
+}
+
+// SeedLinks stores links directly, without creating operations, for tests that need many links.
+// Each link must have a valid name in an existing bucket (only the _Default bucket is created on
+// demand); output-only fields are filled in as by CreateLink. The links are all checked before
+// any is stored, so on error none of them are.
+func (s *MockService) SeedLinks(ctx context.Context, links []*pb.Link) error {
+	cs := &configService{MockService: s}
+	now := s.now()
+
+	var objs []*pb.Link
+	seen := make(map[string]bool)
+	for _, link := range links {
+		name, err := s.parseLoggingLinkName(link.GetName())
+		if err != nil {
+			return err
+		}
+		if err := validateLinkID(name.LinkName); err != nil {
+			return err
+		}
+		if err := checkReservedBucket(name.bucket.BucketName, createLinkOperation); err != nil {
+			return err
+		}
+		if err := validateLinkDescription(link); err != nil {
+			return err
+		}
+		fqn := name.String()
+		if seen[fqn] {
+			return status.Errorf(codes.AlreadyExists, "link %q is seeded more than once", fqn)
+		}
+		seen[fqn] = true
+
+		if err := cs.createLinkDefaultObjects(ctx, name); err != nil {
+			return err
+		}
+		if err := s.storage.Get(ctx, name.bucket.String(), &pb.LogBucket{}); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return status.Errorf(codes.NotFound, "Bucket `%s` does not exist", name.bucket.BucketName)
+			}
+			return err
+		}
+		if err := s.storage.Get(ctx, fqn, &pb.Link{}); err == nil {
+			return status.Errorf(codes.AlreadyExists, "link %q already exists", fqn)
+		} else if status.Code(err) != codes.NotFound {
+			return err
+		}
+		objs = append(objs, cs.newLinkObject(name, link, now))
+	}
+
+	for _, obj := range objs {
+		if err := s.storage.Create(ctx, obj.GetName(), obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *configService) populateDefaultsForLoggingLink(obj *pb.Link) {
+	if obj.LifecycleState == pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
+		obj.LifecycleState = pb.LifecycleState_ACTIVE
+	}
+}
+
+func (s *configService) DeleteLink(ctx context.Context, req *pb.DeleteLinkRequest) (*longrunning.Operation, error) {
+	name, err := s.parseLoggingLinkName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	fqn := name.String()
+	now := s.now()
+	// Deleting a link that is already gone succeeds, so that controllers can retry deletes without
+	// special-casing NotFound.
+	deletedObj := &pb.Link{}
+	if err := s.storage.Delete(ctx, fqn, deletedObj); err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	metadata := &pb.LinkMetadata{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now),
+		State:     pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		Request: &pb.LinkMetadata_DeleteLinkRequest{
+			DeleteLinkRequest: req,
+		},
+	}
+	return s.operations.DoneLRO(ctx, name.operationPrefix(), metadata, &emptypb.Empty{})
+}
+
+type loggingLinkName struct {
+	bucket   logBucketName
+	LinkName string
+}
+
+func (n *loggingLinkName) String() string {
+	return n.bucket.String() + "/links/" + n.LinkName
+}
+
+// operationPrefix is the parent location under which link operations are created.
+func (n *loggingLinkName) operationPrefix() string {
+	return n.bucket.scope() + "/locations/" + n.bucket.location
+}
+
+// newLoggingLinkName builds the name of the link with ID linkID in the bucket parent.
+// A trailing slash on parent is ignored; the link ID must be valid (see validateLinkID).
+func (s *MockService) newLoggingLinkName(parent string, linkID string) (*loggingLinkName, error) {
+	if err := validateLinkID(linkID); err != nil {
+		return nil, err
+	}
+	parent = strings.TrimSuffix(parent, "/")
+	bucket, err := s.parseLogBucketName(parent)
+	if err != nil {
+		return nil, err
+	}
+	// Unlike ListBuckets, links must be created in a single bucket.
+	if bucket.location == "-" {
+		return nil, status.Errorf(codes.InvalidArgument, "parent %q is not valid", parent)
+	}
+	return &loggingLinkName{
+		bucket:   *bucket,
+		LinkName: linkID,
+	}, nil
+}
+
+// parseLoggingLinkName parses a string into a loggingLinkName.
+// The expected form is `{projects,folders,organizations,billingAccounts}/*/locations/*/buckets/*/links/*`.
+func (s *MockService) parseLoggingLinkName(name string) (*loggingLinkName, error) {
+	tokens := strings.Split(name, "/")
+	if len(tokens) != 8 || tokens[2] != "locations" || tokens[4] != "buckets" || tokens[6] != "links" {
+		return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	}
+
+	bucket := logBucketName{
+		location:   tokens[3],
+		BucketName: tokens[5],
+	}
+	switch tokens[0] {
+	case "projects":
+		project, err := s.Projects.GetProjectByID(tokens[1])
+		if err != nil {
+			return nil, err
+		}
+		bucket.project = project
+	case "folders":
+		bucket.folder = tokens[1]
+	case "organizations":
+		bucket.organization = tokens[1]
+	case "billingAccounts":
+		bucket.billingAccount = tokens[1]
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+	}
+
+	if err := s.validateBucketLocation(bucket.location); err != nil {
+		return nil, err
+	}
+
+	return &loggingLinkName{
+		bucket:   bucket,
+		LinkName: tokens[7],
+	}, nil
+}
@@ -0,0 +1,891 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+const (
+	testBucketParent = "projects/" + testProjectID + "/locations/global"
+	testBucketFQN    = testBucketParent + "/buckets/analytics"
+	testLinkFQN      = testBucketFQN + "/links/mylink"
+)
+
+// createTestBucket creates the bucket that test links are created in.
+func createTestBucket(t *testing.T, s *configService) {
+	t.Helper()
+
+	if _, err := s.CreateBucket(context.Background(), &pb.CreateBucketRequest{
+		Parent:   testBucketParent,
+		BucketId: "analytics",
+		Bucket:   &pb.LogBucket{RetentionDays: 30, AnalyticsEnabled: true},
+	}); err != nil {
+		t.Fatalf("creating bucket: %v", err)
+	}
+}
+
+func TestCreateLinkReturnsDoneOperation(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	op, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{Description: "my link"},
+	})
+	if err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	if !op.GetDone() {
+		t.Fatalf("expected operation to be done, got %+v", op)
+	}
+	if !strings.HasPrefix(op.GetName(), testBucketParent+"/operations/") {
+		t.Errorf("unexpected operation name %q", op.GetName())
+	}
+
+	metadata := &pb.LinkMetadata{}
+	if err := proto.Unmarshal(op.GetMetadata().GetValue(), metadata); err != nil {
+		t.Fatalf("unmarshalling metadata: %v", err)
+	}
+	if metadata.GetStartTime() == nil {
+		t.Errorf("expected metadata to carry a start time")
+	}
+	if got := metadata.GetCreateLinkRequest().GetParent(); got != testBucketFQN {
+		t.Errorf("unexpected parent in metadata; got %q, want %q", got, testBucketFQN)
+	}
+
+	got := &pb.Link{}
+	if err := proto.Unmarshal(op.GetResponse().GetValue(), got); err != nil {
+		t.Fatalf("unmarshalling response: %v", err)
+	}
+	want, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN})
+	if err != nil {
+		t.Fatalf("GetLink failed: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("unexpected link in operation response; got %v, want %v", got, want)
+	}
+	if got.GetName() != testLinkFQN || got.GetDescription() != "my link" {
+		t.Errorf("unexpected link %v", got)
+	}
+	if wantDataset := "bigquery.googleapis.com/projects/" + testProjectID + "/datasets/mylink"; got.GetBigqueryDataset().GetDatasetId() != wantDataset {
+		t.Errorf("unexpected dataset; got %q, want %q", got.GetBigqueryDataset().GetDatasetId(), wantDataset)
+	}
+}
+
+func TestCreateLinkValidatesDatasetID(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	// The link ID becomes the BigQuery dataset ID, so it must be a valid dataset ID.
+	for _, linkID := range []string{"", "my-link", "my.link", strings.Repeat("a", 101)} {
+		_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+			Parent: testBucketFQN,
+			LinkId: linkID,
+			Link:   &pb.Link{},
+		})
+		wantCode(t, err, codes.InvalidArgument)
+	}
+
+	// bigquery_dataset is output only; a link without it is valid, and a supplied value is replaced.
+	for _, dataset := range []*pb.BigQueryDataset{nil, {DatasetId: "not a dataset"}} {
+		linkID := "link_" + strings.Repeat("a", 99-len("link_"))
+		if dataset != nil {
+			linkID = "link_with_dataset"
+		}
+		op, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+			Parent: testBucketFQN,
+			LinkId: linkID,
+			Link:   &pb.Link{BigqueryDataset: dataset},
+		})
+		if err != nil {
+			t.Fatalf("CreateLink(%q) failed: %v", linkID, err)
+		}
+		got := &pb.Link{}
+		if err := proto.Unmarshal(op.GetResponse().GetValue(), got); err != nil {
+			t.Fatalf("unmarshalling response: %v", err)
+		}
+		if want := "bigquery.googleapis.com/projects/" + testProjectID + "/datasets/" + linkID; got.GetBigqueryDataset().GetDatasetId() != want {
+			t.Errorf("unexpected dataset; got %q, want %q", got.GetBigqueryDataset().GetDatasetId(), want)
+		}
+	}
+}
+
+func TestLinkLifecycleAfterBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	// A link cannot be created before its bucket.
+	_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	})
+	wantCode(t, err, codes.NotFound)
+
+	createTestBucket(t, s)
+	if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+
+	list, err := s.ListLinks(ctx, &pb.ListLinksRequest{Parent: testBucketFQN})
+	if err != nil {
+		t.Fatalf("ListLinks failed: %v", err)
+	}
+	if len(list.GetLinks()) != 1 || list.GetLinks()[0].GetName() != testLinkFQN {
+		t.Errorf("unexpected links %v", list.GetLinks())
+	}
+
+	op, err := s.DeleteLink(ctx, &pb.DeleteLinkRequest{Name: testLinkFQN})
+	if err != nil {
+		t.Fatalf("DeleteLink failed: %v", err)
+	}
+	if !op.GetDone() {
+		t.Errorf("expected delete operation to be done, got %+v", op)
+	}
+
+	_, err = s.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN})
+	wantCode(t, err, codes.NotFound)
+}
+
+// TestCreateLinkAlreadyExists checks that a link cannot be re-created, e.g. to point it at another dataset:
+// bigquery_dataset is immutable, and Link has no update method, so the only way to change it is to delete the link.
+func TestCreateLinkAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	// bigquery_dataset is only taken from the request for links outside projects.
+	bucketFQN := "folders/123/locations/global/buckets/analytics"
+	linkFQN := bucketFQN + "/links/mylink"
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   "folders/123/locations/global",
+		BucketId: "analytics",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	}); err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+	createLink := func(datasetID string) error {
+		_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+			Parent: bucketFQN,
+			LinkId: "mylink",
+			Link:   &pb.Link{BigqueryDataset: &pb.BigQueryDataset{DatasetId: datasetID}},
+		})
+		return err
+	}
+
+	if err := createLink("original"); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	for _, datasetID := range []string{"original", "other"} {
+		wantCode(t, createLink(datasetID), codes.AlreadyExists)
+	}
+
+	got, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: linkFQN})
+	if err != nil {
+		t.Fatalf("GetLink failed: %v", err)
+	}
+	if got.GetBigqueryDataset().GetDatasetId() != "original" {
+		t.Errorf("duplicate create changed the link's dataset; got %v", got.GetBigqueryDataset())
+	}
+
+	// Once the link is deleted, it can be created again with another dataset.
+	if _, err := s.DeleteLink(ctx, &pb.DeleteLinkRequest{Name: linkFQN}); err != nil {
+		t.Fatalf("DeleteLink failed: %v", err)
+	}
+	if err := createLink("other"); err != nil {
+		t.Fatalf("re-creating deleted link failed: %v", err)
+	}
+}
+
+func TestCreateLinkIDIsScopedToBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	otherBucketFQN := testBucketParent + "/buckets/other"
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   testBucketParent,
+		BucketId: "other",
+		Bucket:   &pb.LogBucket{RetentionDays: 30, AnalyticsEnabled: true},
+	}); err != nil {
+		t.Fatalf("creating bucket: %v", err)
+	}
+	createLink := func(parent string) error {
+		_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+			Parent: parent,
+			LinkId: "mylink",
+			Link:   &pb.Link{},
+		})
+		return err
+	}
+
+	for _, parent := range []string{testBucketFQN, otherBucketFQN} {
+		if err := createLink(parent); err != nil {
+			t.Fatalf("CreateLink in %q failed: %v", parent, err)
+		}
+	}
+	for _, name := range []string{testLinkFQN, otherBucketFQN + "/links/mylink"} {
+		if _, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: name}); err != nil {
+			t.Errorf("GetLink(%q) failed: %v", name, err)
+		}
+	}
+
+	err := createLink(testBucketFQN)
+	wantCode(t, err, codes.AlreadyExists)
+	if !strings.Contains(err.Error(), testLinkFQN) {
+		t.Errorf("expected error to name the existing link; got %v", err)
+	}
+}
+
+func TestCreateLinkParentBucket(t *testing.T) {
+	grid := []struct {
+		name     string
+		parent   string
+		wantCode codes.Code
+	}{
+		{name: "existing bucket", parent: testBucketFQN, wantCode: codes.OK},
+		{name: "default bucket", parent: testBucketParent + "/buckets/_Default", wantCode: codes.OK},
+		{name: "missing bucket", parent: testBucketParent + "/buckets/missing", wantCode: codes.NotFound},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestConfigService(t)
+			createTestBucket(t, s)
+
+			_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+				Parent: g.parent,
+				LinkId: "mylink",
+				Link:   &pb.Link{},
+			})
+			if code := status.Code(err); code != g.wantCode {
+				t.Fatalf("unexpected error code from CreateLink; got %v (%v), want %v", code, err, g.wantCode)
+			}
+
+			_, getErr := s.GetLink(ctx, &pb.GetLinkRequest{Name: g.parent + "/links/mylink"})
+			if g.wantCode != codes.OK {
+				if !strings.Contains(err.Error(), "Bucket `missing` does not exist") {
+					t.Errorf("error does not name the missing bucket: %v", err)
+				}
+				// Nothing is created under the missing bucket.
+				wantCode(t, getErr, codes.NotFound)
+				return
+			}
+			if getErr != nil {
+				t.Errorf("GetLink failed: %v", getErr)
+			}
+		})
+	}
+}
+
+func TestParseLoggingLinkName(t *testing.T) {
+	s := newTestConfigService(t)
+
+	grid := []struct {
+		name    string
+		wantErr codes.Code
+	}{
+		{name: "projects/" + testProjectID + "/locations/global/buckets/b/links/l"},
+		{name: "folders/123/locations/global/buckets/b/links/l"},
+		{name: "organizations/456/locations/us-central1/buckets/b/links/l"},
+		{name: "billingAccounts/0000-AAAA/locations/eu/buckets/b/links/l"},
+		{name: "projects/unknown-project/locations/global/buckets/b/links/l", wantErr: codes.NotFound},
+		{name: "projects/" + testProjectID + "/locations/mars-north1/buckets/b/links/l", wantErr: codes.InvalidArgument},
+		{name: "organizations/456/locations/global/buckets/b", wantErr: codes.InvalidArgument},
+		{name: "organizations/456/locations/global/buckets/b/links", wantErr: codes.InvalidArgument},
+		{name: "billingAccounts/0000-AAAA/locations/global/buckets/b/views/l", wantErr: codes.InvalidArgument},
+		{name: "folders/123/regions/global/buckets/b/links/l", wantErr: codes.InvalidArgument},
+		{name: "networks/123/locations/global/buckets/b/links/l", wantErr: codes.InvalidArgument},
+		{name: "", wantErr: codes.InvalidArgument},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			got, err := s.parseLoggingLinkName(g.name)
+			if g.wantErr != codes.OK {
+				wantCode(t, err, g.wantErr)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != g.name {
+				t.Errorf("name did not round-trip; got %q, want %q", got.String(), g.name)
+			}
+			if got.LinkName != "l" || got.bucket.BucketName != "b" {
+				t.Errorf("unexpected parse result %+v", got)
+			}
+		})
+	}
+}
+
+func TestLinkLocation(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	s.SetSupportedBucketLocations([]string{"global", "europe-west1"})
+
+	parent := "projects/" + testProjectID + "/locations/europe-west1/buckets/my-bucket"
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   "projects/" + testProjectID + "/locations/europe-west1",
+		BucketId: "my-bucket",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	}); err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+	if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: parent,
+		LinkId: "my_link",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("creating link in supported location: %v", err)
+	}
+	if _, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: parent + "/links/my_link"}); err != nil {
+		t.Errorf("getting link in supported location: %v", err)
+	}
+
+	// us-central1 is supported by default, but not in this test.
+	_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: "projects/" + testProjectID + "/locations/us-central1/buckets/my-bucket",
+		LinkId: "my_link",
+		Link:   &pb.Link{},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	if msg := status.Convert(err).Message(); !strings.Contains(msg, `"us-central1"`) {
+		t.Errorf("error message should name the location; got %q", msg)
+	}
+
+	_, err = s.GetLink(ctx, &pb.GetLinkRequest{Name: "projects/" + testProjectID + "/locations/us-central1/buckets/my-bucket/links/my_link"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestGetLinkNotFound(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	_, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN})
+	wantCode(t, err, codes.NotFound)
+	want := "Requested entity was not found. Link `" + testLinkFQN + "` does not exist"
+	if got := status.Convert(err).Message(); got != want {
+		t.Errorf("unexpected error message; got %q, want %q", got, want)
+	}
+}
+
+func TestCreateLinkDefaultsLifecycleState(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	grid := []struct {
+		linkID string
+		state  pb.LifecycleState
+		want   pb.LifecycleState
+	}{
+		{linkID: "unset", state: pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED, want: pb.LifecycleState_ACTIVE},
+		{linkID: "creating", state: pb.LifecycleState_CREATING, want: pb.LifecycleState_CREATING},
+	}
+	for _, g := range grid {
+		t.Run(g.linkID, func(t *testing.T) {
+			if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+				Parent: testBucketFQN,
+				LinkId: g.linkID,
+				Link:   &pb.Link{LifecycleState: g.state},
+			}); err != nil {
+				t.Fatalf("CreateLink failed: %v", err)
+			}
+			got, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: testBucketFQN + "/links/" + g.linkID})
+			if err != nil {
+				t.Fatalf("GetLink failed: %v", err)
+			}
+			if got.GetLifecycleState() != g.want {
+				t.Errorf("unexpected lifecycle state; got %v, want %v", got.GetLifecycleState(), g.want)
+			}
+		})
+	}
+}
+
+func TestCreateLinkCreatesDefaultBucket(t *testing.T) {
+	grid := []struct {
+		name   string
+		parent string
+	}{
+		{name: "project", parent: "projects/" + testProjectID},
+		{name: "folder", parent: "folders/123"},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestConfigService(t)
+
+			defaultBucketFQN := g.parent + "/locations/global/buckets/_Default"
+			if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+				Parent: defaultBucketFQN,
+				LinkId: "mylink",
+				Link:   &pb.Link{},
+			}); err != nil {
+				t.Fatalf("CreateLink failed: %v", err)
+			}
+
+			bucket := &pb.LogBucket{}
+			if err := s.storage.Get(ctx, defaultBucketFQN, bucket); err != nil {
+				t.Fatalf("getting _Default bucket: %v", err)
+			}
+			if bucket.GetRetentionDays() != 30 || bucket.GetLifecycleState() != pb.LifecycleState_ACTIVE {
+				t.Errorf("unexpected _Default bucket %v", bucket)
+			}
+		})
+	}
+}
+
+func TestLinkDefaultObjectsKeepExistingBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	defaultBucketFQN := "folders/123/locations/global/buckets/_Default"
+	if err := s.storage.Create(ctx, defaultBucketFQN, &pb.LogBucket{
+		Name:           defaultBucketFQN,
+		LifecycleState: pb.LifecycleState_ACTIVE,
+		RetentionDays:  90,
+	}); err != nil {
+		t.Fatalf("creating _Default bucket: %v", err)
+	}
+
+	// GetLink also ensures the default objects exist; neither call may reset the retention.
+	_, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: defaultBucketFQN + "/links/mylink"})
+	wantCode(t, err, codes.NotFound)
+	if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: defaultBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+
+	bucket := &pb.LogBucket{}
+	if err := s.storage.Get(ctx, defaultBucketFQN, bucket); err != nil {
+		t.Fatalf("getting _Default bucket: %v", err)
+	}
+	if bucket.GetRetentionDays() != 90 {
+		t.Errorf("existing _Default bucket retention was overwritten; got %d, want 90", bucket.GetRetentionDays())
+	}
+}
+
+// cancelOnCreateStorage cancels a context after the first object is created, simulating a caller
+// that gives up part way through a multi-step mock method.
+type cancelOnCreateStorage struct {
+	storage.Storage
+	cancel context.CancelFunc
+}
+
+func (s *cancelOnCreateStorage) Create(ctx context.Context, fqn string, create proto.Message) error {
+	err := s.Storage.Create(ctx, fqn, create)
+	s.cancel()
+	return err
+}
+
+func TestCreateLinkHonorsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := &common.MockEnvironment{
+		Projects: projects.NewFakeProjectStore(&projects.ProjectData{ID: testProjectID, Number: testProjectNumber}),
+	}
+	backing := storage.NewInMemoryStorage()
+	s := &configService{MockService: New(env, &cancelOnCreateStorage{Storage: backing, cancel: cancel})}
+
+	// The context is canceled once the _Default bucket has been created, before the _Required bucket and the link.
+	_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	})
+	wantCode(t, err, codes.Canceled)
+	if err := backing.Get(context.Background(), testBucketParent+"/buckets/_Required", &pb.LogBucket{}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected the _Required bucket not to be created after cancellation; got %v", err)
+	}
+
+	deadlineCtx, cancelDeadline := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelDeadline()
+	_, err = s.CreateLink(deadlineCtx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	})
+	wantCode(t, err, codes.DeadlineExceeded)
+
+	if err := backing.Get(context.Background(), testLinkFQN, &pb.Link{}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected no link to be created; got %v", err)
+	}
+}
+
+// recordingStorage records the objects that are passed to Create, without copying them,
+// so that tests can check that services do not hand storage objects that alias the request.
+type recordingStorage struct {
+	storage.Storage
+	created map[string]proto.Message
+}
+
+func (s *recordingStorage) Create(ctx context.Context, fqn string, create proto.Message) error {
+	s.created[fqn] = create
+	return s.Storage.Create(ctx, fqn, create)
+}
+
+func TestCreateLinkDoesNotAliasRequest(t *testing.T) {
+	ctx := context.Background()
+
+	env := &common.MockEnvironment{
+		Projects: projects.NewFakeProjectStore(&projects.ProjectData{ID: testProjectID, Number: testProjectNumber}),
+	}
+	recorder := &recordingStorage{Storage: storage.NewInMemoryStorage(), created: make(map[string]proto.Message)}
+	s := &configService{MockService: New(env, recorder)}
+
+	// bigquery_dataset is only filled in for links in projects, so a folder link keeps the request's copy.
+	bucketFQN := "folders/123/locations/global/buckets/analytics"
+	linkFQN := bucketFQN + "/links/mylink"
+	if _, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{
+		Parent:   "folders/123/locations/global",
+		BucketId: "analytics",
+		Bucket:   &pb.LogBucket{RetentionDays: 30},
+	}); err != nil {
+		t.Fatalf("CreateBucket failed: %v", err)
+	}
+
+	req := &pb.CreateLinkRequest{
+		Parent: bucketFQN,
+		LinkId: "mylink",
+		Link: &pb.Link{
+			Description:     "my link",
+			BigqueryDataset: &pb.BigQueryDataset{DatasetId: "requested"},
+		},
+	}
+	op, err := s.CreateLink(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	want, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: linkFQN})
+	if err != nil {
+		t.Fatalf("GetLink failed: %v", err)
+	}
+	if want.GetDescription() != "my link" || want.GetBigqueryDataset().GetDatasetId() != "requested" {
+		t.Fatalf("unexpected link %v", want)
+	}
+
+	// The caller still owns the request, and may reuse it.
+	req.Parent = testBucketFQN
+	req.Link.Description = "changed"
+	req.Link.BigqueryDataset.DatasetId = "changed"
+	req.Link.LifecycleState = pb.LifecycleState_DELETE_REQUESTED
+
+	if stored := recorder.created[linkFQN]; !proto.Equal(stored, want) {
+		t.Errorf("object passed to storage changed with the request; got %v, want %v", stored, want)
+	}
+	got, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: linkFQN})
+	if err != nil {
+		t.Fatalf("GetLink failed: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("stored link changed with the request; got %v, want %v", got, want)
+	}
+
+	metadata := &pb.LinkMetadata{}
+	if err := proto.Unmarshal(op.GetMetadata().GetValue(), metadata); err != nil {
+		t.Fatalf("unmarshalling metadata: %v", err)
+	}
+	if got := metadata.GetCreateLinkRequest(); got.GetParent() != bucketFQN || got.GetLink().GetDescription() != "my link" {
+		t.Errorf("operation metadata changed with the request; got %v", got)
+	}
+
+	// Nor do responses alias the stored object.
+	got.BigqueryDataset.DatasetId = "changed"
+	again, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: linkFQN})
+	if err != nil {
+		t.Fatalf("GetLink failed: %v", err)
+	}
+	if !proto.Equal(again, want) {
+		t.Errorf("stored link changed with a response; got %v, want %v", again, want)
+	}
+}
+
+func TestNewLoggingLinkName(t *testing.T) {
+	s := newTestConfigService(t)
+
+	grid := []struct {
+		parent  string
+		linkID  string
+		want    string
+		wantErr codes.Code
+	}{
+		{parent: testBucketFQN, linkID: "mylink", want: testBucketFQN + "/links/mylink"},
+		{parent: testBucketFQN + "/", linkID: "mylink", want: testBucketFQN + "/links/mylink"},
+		{parent: "folders/123/locations/global/buckets/b/", linkID: "my_link_2", want: "folders/123/locations/global/buckets/b/links/my_link_2"},
+		{parent: testBucketFQN, linkID: "", wantErr: codes.InvalidArgument},
+		{parent: testBucketFQN, linkID: "my/link", wantErr: codes.InvalidArgument},
+		{parent: testBucketFQN, linkID: "my.link", wantErr: codes.InvalidArgument},
+		{parent: testBucketFQN, linkID: strings.Repeat("a", 101), wantErr: codes.InvalidArgument},
+		{parent: testBucketFQN + "//", linkID: "mylink", wantErr: codes.InvalidArgument},
+		{parent: "projects/" + testProjectID + "/locations/-/buckets/b", linkID: "mylink", wantErr: codes.InvalidArgument},
+		{parent: "", linkID: "mylink", wantErr: codes.InvalidArgument},
+	}
+	for _, g := range grid {
+		got, err := s.newLoggingLinkName(g.parent, g.linkID)
+		if g.wantErr != codes.OK {
+			if status.Code(err) != g.wantErr {
+				t.Errorf("newLoggingLinkName(%q, %q): unexpected status code; got %v (%v), want %v", g.parent, g.linkID, status.Code(err), err, g.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("newLoggingLinkName(%q, %q) failed: %v", g.parent, g.linkID, err)
+			continue
+		}
+		if got.String() != g.want {
+			t.Errorf("newLoggingLinkName(%q, %q) = %q, want %q", g.parent, g.linkID, got.String(), g.want)
+		}
+	}
+}
+
+func TestCreateLinkParentWithTrailingSlash(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN + "/",
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	if _, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN}); err != nil {
+		t.Fatalf("GetLink(%q) failed: %v", testLinkFQN, err)
+	}
+
+	_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "",
+		Link:   &pb.Link{},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestSeedLinks(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	var links []*pb.Link
+	var want []string
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("%s/links/link_%02d", testBucketFQN, i)
+		links = append(links, &pb.Link{Name: name})
+		want = append(want, name)
+	}
+	if err := s.SeedLinks(ctx, links); err != nil {
+		t.Fatalf("SeedLinks failed: %v", err)
+	}
+
+	var got []*pb.Link
+	pageToken := ""
+	for {
+		page, err := s.ListLinks(ctx, &pb.ListLinksRequest{Parent: testBucketFQN, PageSize: 20, PageToken: pageToken})
+		if err != nil {
+			t.Fatalf("ListLinks failed: %v", err)
+		}
+		got = append(got, page.GetLinks()...)
+		pageToken = page.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of links; got %d, want %d", len(got), len(want))
+	}
+	for i, link := range got {
+		if link.GetName() != want[i] {
+			t.Errorf("unexpected link %d; got %q, want %q", i, link.GetName(), want[i])
+		}
+		if link.GetLifecycleState() != pb.LifecycleState_ACTIVE || link.GetCreateTime() == nil {
+			t.Errorf("seeded link %q is missing defaults: %v", link.GetName(), link)
+		}
+		if !link.GetCreateTime().AsTime().Equal(got[0].GetCreateTime().AsTime()) {
+			t.Errorf("seeded link %q has create time %v, want %v", link.GetName(), link.GetCreateTime().AsTime(), got[0].GetCreateTime().AsTime())
+		}
+		if wantDataset := "bigquery.googleapis.com/projects/" + testProjectID + "/datasets/" + strings.TrimPrefix(link.GetName(), testBucketFQN+"/links/"); link.GetBigqueryDataset().GetDatasetId() != wantDataset {
+			t.Errorf("unexpected dataset for %q; got %q, want %q", link.GetName(), link.GetBigqueryDataset().GetDatasetId(), wantDataset)
+		}
+	}
+}
+
+func TestSeedLinksRejectsMalformedLinks(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	grid := []struct {
+		name    string
+		links   []*pb.Link
+		wantErr codes.Code
+	}{
+		{name: "invalid name", links: []*pb.Link{{Name: testBucketFQN + "/links/ok"}, {Name: testBucketFQN}}, wantErr: codes.InvalidArgument},
+		{name: "invalid link id", links: []*pb.Link{{Name: testBucketFQN + "/links/ok"}, {Name: testBucketFQN + "/links/not-ok"}}, wantErr: codes.InvalidArgument},
+		{name: "missing bucket", links: []*pb.Link{{Name: testBucketParent + "/buckets/nosuchbucket/links/ok"}}, wantErr: codes.NotFound},
+		{name: "duplicate", links: []*pb.Link{{Name: testBucketFQN + "/links/ok"}, {Name: testBucketFQN + "/links/ok"}}, wantErr: codes.AlreadyExists},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			err := s.SeedLinks(ctx, g.links)
+			wantCode(t, err, g.wantErr)
+
+			// Nothing is stored if any link is malformed.
+			links, err := s.ListLinks(ctx, &pb.ListLinksRequest{Parent: testBucketFQN})
+			if err != nil {
+				t.Fatalf("ListLinks failed: %v", err)
+			}
+			if len(links.GetLinks()) != 0 {
+				t.Errorf("expected no links to be seeded, got %v", links.GetLinks())
+			}
+		})
+	}
+
+	if err := s.SeedLinks(ctx, []*pb.Link{{Name: testLinkFQN}}); err != nil {
+		t.Fatalf("SeedLinks failed: %v", err)
+	}
+	wantCode(t, s.SeedLinks(ctx, []*pb.Link{{Name: testLinkFQN}}), codes.AlreadyExists)
+}
+
+func TestCreateLinkUsesClock(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.SetClock(func() time.Time { return now })
+	createTestBucket(t, s)
+
+	op, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	})
+	if err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	metadata := &pb.LinkMetadata{}
+	if err := proto.Unmarshal(op.GetMetadata().GetValue(), metadata); err != nil {
+		t.Fatalf("unmarshalling metadata: %v", err)
+	}
+	if got := metadata.GetStartTime().AsTime(); !got.Equal(now) {
+		t.Errorf("unexpected operation start time; got %v, want %v", got, now)
+	}
+
+	got, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN})
+	if err != nil {
+		t.Fatalf("GetLink failed: %v", err)
+	}
+	if !got.GetCreateTime().AsTime().Equal(now) {
+		t.Errorf("unexpected create time; got %v, want %v", got.GetCreateTime().AsTime(), now)
+	}
+
+	bucket, err := s.GetBucket(ctx, &pb.GetBucketRequest{Name: testBucketFQN})
+	if err != nil {
+		t.Fatalf("GetBucket failed: %v", err)
+	}
+	if !bucket.GetCreateTime().AsTime().Equal(now) {
+		t.Errorf("unexpected bucket create time; got %v, want %v", bucket.GetCreateTime().AsTime(), now)
+	}
+}
+
+func TestDeleteLinkTwice(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+	if _, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		op, err := s.DeleteLink(ctx, &pb.DeleteLinkRequest{Name: testLinkFQN})
+		if err != nil {
+			t.Fatalf("DeleteLink #%d failed: %v", i+1, err)
+		}
+		if !op.GetDone() || op.GetError() != nil {
+			t.Errorf("expected delete #%d to succeed, got %+v", i+1, op)
+		}
+	}
+
+	_, err := s.GetLink(ctx, &pb.GetLinkRequest{Name: testLinkFQN})
+	wantCode(t, err, codes.NotFound)
+
+	// The link name is still validated.
+	_, err = s.DeleteLink(ctx, &pb.DeleteLinkRequest{Name: testBucketFQN + "/links"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestCreateLinkDescriptionLength(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	// The limit is in characters, not bytes.
+	longest := strings.Repeat("é", maxLinkDescriptionLength)
+	op, err := s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "longest",
+		Link:   &pb.Link{Description: longest},
+	})
+	if err != nil {
+		t.Fatalf("CreateLink with a description of exactly %d characters failed: %v", maxLinkDescriptionLength, err)
+	}
+	got := &pb.Link{}
+	if err := proto.Unmarshal(op.GetResponse().GetValue(), got); err != nil {
+		t.Fatalf("unmarshalling response: %v", err)
+	}
+	if got.GetDescription() != longest {
+		t.Errorf("description was not stored as given")
+	}
+
+	_, err = s.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "toolong",
+		Link:   &pb.Link{Description: longest + "x"},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.GetLink(ctx, &pb.GetLinkRequest{Name: testBucketFQN + "/links/toolong"})
+	wantCode(t, err, codes.NotFound)
+
+	err = s.SeedLinks(ctx, []*pb.Link{{Name: testBucketFQN + "/links/seeded", Description: longest + "x"}})
+	wantCode(t, err, codes.InvalidArgument)
+}
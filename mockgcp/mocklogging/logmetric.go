@@ -23,18 +23,21 @@ package mocklogging
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/genproto/googleapis/api"
+	"google.golang.org/genproto/googleapis/api/metric"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
 )
 
 type metricsService struct {
@@ -78,9 +81,13 @@ func (s *metricsService) CreateLogMetric(ctx context.Context, req *pb.CreateLogM
 		return nil, err
 	}
 
+	if err := validateLogMetric(req.GetMetric()); err != nil {
+		return nil, err
+	}
+
 	fqn := name.String()
 
-	now := time.Now()
+	now := s.now()
 
 	obj := proto.Clone(req.GetMetric()).(*pb.LogMetric)
 	obj.Name = name.MetricName
@@ -100,6 +107,44 @@ func (s *metricsService) CreateLogMetric(ctx context.Context, req *pb.CreateLogM
 	return redactForReturn(obj), nil
 }
 
+// logMetricValueTypes is the matrix of the metric kinds that logs-based metrics support, and the value types
+// that are valid for each kind: counter metrics are INT64, and distribution metrics are DISTRIBUTION.
+var logMetricValueTypes = map[metric.MetricDescriptor_MetricKind]map[metric.MetricDescriptor_ValueType]bool{
+	metric.MetricDescriptor_DELTA: {
+		metric.MetricDescriptor_INT64:        true,
+		metric.MetricDescriptor_DISTRIBUTION: true,
+	},
+}
+
+const (
+	// defaultLogMetricKind and defaultLogMetricValueType are used when the metric descriptor leaves them unset.
+	defaultLogMetricKind      = metric.MetricDescriptor_DELTA
+	defaultLogMetricValueType = metric.MetricDescriptor_INT64
+)
+
+// validateLogMetric returns InvalidArgument if the metric cannot be created or updated as given.
+func validateLogMetric(obj *pb.LogMetric) error {
+	metricKind := obj.GetMetricDescriptor().GetMetricKind()
+	if metricKind == metric.MetricDescriptor_METRIC_KIND_UNSPECIFIED {
+		metricKind = defaultLogMetricKind
+	}
+	valueType := obj.GetMetricDescriptor().GetValueType()
+	if valueType == metric.MetricDescriptor_VALUE_TYPE_UNSPECIFIED {
+		valueType = defaultLogMetricValueType
+	}
+	valueTypes, ok := logMetricValueTypes[metricKind]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "metricKind %v is not supported for logs-based metric %q", metricKind, obj.GetName())
+	}
+	if !valueTypes[valueType] {
+		return status.Errorf(codes.InvalidArgument, "valueType %v is not supported for logs-based metric %q with metricKind %v", valueType, obj.GetName(), metricKind)
+	}
+	if obj.GetMetricDescriptor().GetValueType() == metric.MetricDescriptor_DISTRIBUTION && obj.GetBucketOptions() == nil {
+		return status.Errorf(codes.InvalidArgument, "bucketOptions must be set for metric %q with value type DISTRIBUTION", obj.GetName())
+	}
+	return nil
+}
+
 func (s *metricsService) populateDefaultsForLogMetric(name *logMetricName, obj *pb.LogMetric) {
 
 	if obj.MetricDescriptor != nil {
@@ -108,6 +153,40 @@ func (s *metricsService) populateDefaultsForLogMetric(name *logMetricName, obj *
 	}
 }
 
+func (s *metricsService) ListLogMetrics(ctx context.Context, req *pb.ListLogMetricsRequest) (*pb.ListLogMetricsResponse, error) {
+	projectName, err := projects.ParseProjectName(req.GetParent())
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.Projects.GetProject(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListLogMetricsResponse{}
+	findKind := (&pb.LogMetric{}).ProtoReflect().Descriptor()
+	if err := s.storage.List(ctx, findKind, storage.ListOptions{
+		Prefix: "projects/" + project.ID + "/metrics/",
+	}, func(obj proto.Message) error {
+		logMetric := obj.(*pb.LogMetric)
+		response.Metrics = append(response.Metrics, redactForReturn(logMetric))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(response.Metrics, func(i, j int) bool {
+		return response.Metrics[i].GetName() < response.Metrics[j].GetName()
+	})
+	page, nextPageToken, err := paging.Page(response.Metrics, (*pb.LogMetric).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.Metrics = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
 func (s *metricsService) UpdateLogMetric(ctx context.Context, req *pb.UpdateLogMetricRequest) (*pb.LogMetric, error) {
 	reqName := req.MetricName
 
@@ -116,13 +195,17 @@ func (s *metricsService) UpdateLogMetric(ctx context.Context, req *pb.UpdateLogM
 		return nil, err
 	}
 
+	if err := validateLogMetric(req.GetMetric()); err != nil {
+		return nil, err
+	}
+
 	fqn := name.String()
 	existing := &pb.LogMetric{}
 	if err := s.storage.Get(ctx, fqn, existing); err != nil {
 		return nil, err
 	}
 
-	now := time.Now()
+	now := s.now()
 
 	updated := proto.Clone(req.GetMetric()).(*pb.LogMetric)
 	updated.Name = name.MetricName
@@ -168,7 +251,7 @@ func (n *logMetricName) String() string {
 }
 
 // parseLogMetricName parses a string into a logmetricName.
-// The expected form is `projects/*/locations/global/logmetrices/*`.
+// The expected form is `projects/*/metrics/*`.
 func (s *MockService) parseLogMetricName(name string) (*logMetricName, error) {
 	tokens := strings.Split(name, "/")
 
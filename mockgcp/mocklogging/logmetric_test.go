@@ -0,0 +1,281 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/api/distribution"
+	"google.golang.org/genproto/googleapis/api/label"
+	"google.golang.org/genproto/googleapis/api/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+const testMetricParent = "projects/" + testProjectID
+
+func distributionMetric(name string, bucketOptions *distribution.Distribution_BucketOptions) *pb.LogMetric {
+	return &pb.LogMetric{
+		Name:            name,
+		Filter:          "resource.type=gae_app AND severity>=ERROR",
+		ValueExtractor:  "EXTRACT(jsonPayload.latency)",
+		LabelExtractors: map[string]string{"status": "EXTRACT(jsonPayload.status)"},
+		MetricDescriptor: &metric.MetricDescriptor{
+			MetricKind: metric.MetricDescriptor_DELTA,
+			ValueType:  metric.MetricDescriptor_DISTRIBUTION,
+			Unit:       "ms",
+			Labels: []*label.LabelDescriptor{
+				{Key: "status", ValueType: label.LabelDescriptor_STRING, Description: "HTTP status"},
+			},
+		},
+		BucketOptions: bucketOptions,
+	}
+}
+
+func TestLogMetricBucketOptionsRoundTrip(t *testing.T) {
+	grid := []struct {
+		name          string
+		bucketOptions *distribution.Distribution_BucketOptions
+	}{
+		{
+			name: "linear",
+			bucketOptions: &distribution.Distribution_BucketOptions{
+				Options: &distribution.Distribution_BucketOptions_LinearBuckets{
+					LinearBuckets: &distribution.Distribution_BucketOptions_Linear{NumFiniteBuckets: 3, Width: 10, Offset: 1},
+				},
+			},
+		},
+		{
+			name: "exponential",
+			bucketOptions: &distribution.Distribution_BucketOptions{
+				Options: &distribution.Distribution_BucketOptions_ExponentialBuckets{
+					ExponentialBuckets: &distribution.Distribution_BucketOptions_Exponential{NumFiniteBuckets: 3, GrowthFactor: 2, Scale: 1},
+				},
+			},
+		},
+		{
+			name: "explicit",
+			bucketOptions: &distribution.Distribution_BucketOptions{
+				Options: &distribution.Distribution_BucketOptions_ExplicitBuckets{
+					ExplicitBuckets: &distribution.Distribution_BucketOptions_Explicit{Bounds: []float64{1, 10, 100}},
+				},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestMetricsService(t)
+
+			if _, err := s.CreateLogMetric(ctx, &pb.CreateLogMetricRequest{
+				Parent: testMetricParent,
+				Metric: distributionMetric(g.name, g.bucketOptions),
+			}); err != nil {
+				t.Fatalf("CreateLogMetric failed: %v", err)
+			}
+
+			got, err := s.GetLogMetric(ctx, &pb.GetLogMetricRequest{MetricName: testMetricParent + "/metrics/" + g.name})
+			if err != nil {
+				t.Fatalf("GetLogMetric failed: %v", err)
+			}
+			if !proto.Equal(got.GetBucketOptions(), g.bucketOptions) {
+				t.Errorf("bucket options did not round-trip; got %v, want %v", got.GetBucketOptions(), g.bucketOptions)
+			}
+			labels := got.GetMetricDescriptor().GetLabels()
+			if len(labels) != 1 || labels[0].GetKey() != "status" || labels[0].GetDescription() != "HTTP status" {
+				t.Errorf("metric descriptor labels did not round-trip; got %v", labels)
+			}
+			if want := "logging.googleapis.com/user/" + g.name; got.GetMetricDescriptor().GetType() != want {
+				t.Errorf("unexpected metric type; got %q, want %q", got.GetMetricDescriptor().GetType(), want)
+			}
+		})
+	}
+}
+
+func TestLogMetricDistributionRequiresBucketOptions(t *testing.T) {
+	ctx := context.Background()
+	s := newTestMetricsService(t)
+
+	_, err := s.CreateLogMetric(ctx, &pb.CreateLogMetricRequest{
+		Parent: testMetricParent,
+		Metric: distributionMetric("nobuckets", nil),
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	list, err := s.ListLogMetrics(ctx, &pb.ListLogMetricsRequest{Parent: testMetricParent})
+	if err != nil {
+		t.Fatalf("ListLogMetrics failed: %v", err)
+	}
+	if len(list.GetMetrics()) != 0 {
+		t.Errorf("invalid metric should not have been created; got %v", list.GetMetrics())
+	}
+}
+
+func TestLogMetricKindAndValueType(t *testing.T) {
+	grid := []struct {
+		name     string
+		metric   *pb.LogMetric
+		wantCode codes.Code
+	}{
+		{
+			name: "counter",
+			metric: &pb.LogMetric{
+				Filter: "severity>=ERROR",
+				MetricDescriptor: &metric.MetricDescriptor{
+					MetricKind: metric.MetricDescriptor_DELTA,
+					ValueType:  metric.MetricDescriptor_INT64,
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "defaults",
+			metric:   &pb.LogMetric{Filter: "severity>=ERROR"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "gauge-distribution",
+			metric: &pb.LogMetric{
+				Filter:         "severity>=ERROR",
+				ValueExtractor: "EXTRACT(jsonPayload.latency)",
+				MetricDescriptor: &metric.MetricDescriptor{
+					MetricKind: metric.MetricDescriptor_GAUGE,
+					ValueType:  metric.MetricDescriptor_DISTRIBUTION,
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "delta-string",
+			metric: &pb.LogMetric{
+				Filter: "severity>=ERROR",
+				MetricDescriptor: &metric.MetricDescriptor{
+					MetricKind: metric.MetricDescriptor_DELTA,
+					ValueType:  metric.MetricDescriptor_STRING,
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestMetricsService(t)
+
+			g.metric.Name = g.name
+			_, err := s.CreateLogMetric(ctx, &pb.CreateLogMetricRequest{Parent: testMetricParent, Metric: g.metric})
+			if code := status.Code(err); code != g.wantCode {
+				t.Fatalf("unexpected error code from CreateLogMetric; got %v (%v), want %v", code, err, g.wantCode)
+			}
+
+			// Updates are validated in the same way.
+			valid := &pb.LogMetric{Name: "valid", Filter: "severity>=ERROR"}
+			if _, err := s.CreateLogMetric(ctx, &pb.CreateLogMetricRequest{Parent: testMetricParent, Metric: valid}); err != nil {
+				t.Fatalf("CreateLogMetric failed: %v", err)
+			}
+			_, err = s.UpdateLogMetric(ctx, &pb.UpdateLogMetricRequest{MetricName: testMetricParent + "/metrics/valid", Metric: g.metric})
+			if code := status.Code(err); code != g.wantCode {
+				t.Errorf("unexpected error code from UpdateLogMetric; got %v (%v), want %v", code, err, g.wantCode)
+			}
+		})
+	}
+}
+
+func TestLogMetricLabelsReplacedOnUpdate(t *testing.T) {
+	ctx := context.Background()
+	s := newTestMetricsService(t)
+
+	metricName := testMetricParent + "/metrics/labels"
+	withLabels := func(labels map[string]string) *pb.LogMetric {
+		obj := &pb.LogMetric{
+			Name:            "labels",
+			Filter:          "resource.type=gae_app",
+			LabelExtractors: map[string]string{},
+			MetricDescriptor: &metric.MetricDescriptor{
+				MetricKind: metric.MetricDescriptor_DELTA,
+				ValueType:  metric.MetricDescriptor_INT64,
+			},
+		}
+		for key, description := range labels {
+			obj.LabelExtractors[key] = "EXTRACT(jsonPayload." + key + ")"
+			obj.MetricDescriptor.Labels = append(obj.MetricDescriptor.Labels, &label.LabelDescriptor{Key: key, Description: description})
+		}
+		return obj
+	}
+	checkLabels := func(t *testing.T, got *pb.LogMetric, want map[string]string) {
+		t.Helper()
+
+		gotLabels := map[string]string{}
+		for _, l := range got.GetMetricDescriptor().GetLabels() {
+			gotLabels[l.GetKey()] = l.GetDescription()
+		}
+		if len(gotLabels) != len(want) || len(got.GetLabelExtractors()) != len(want) {
+			t.Fatalf("unexpected labels; got descriptors %v and extractors %v, want %v", gotLabels, got.GetLabelExtractors(), want)
+		}
+		for key, description := range want {
+			if d, ok := gotLabels[key]; !ok || d != description {
+				t.Errorf("unexpected descriptor for label %q; got %q (found=%v), want %q", key, d, ok, description)
+			}
+			if _, ok := got.GetLabelExtractors()[key]; !ok {
+				t.Errorf("missing extractor for label %q", key)
+			}
+		}
+	}
+
+	steps := []struct {
+		name   string
+		labels map[string]string
+	}{
+		{name: "create", labels: map[string]string{"status": "HTTP status"}},
+		{name: "add", labels: map[string]string{"status": "HTTP status", "method": "HTTP method"}},
+		{name: "modify", labels: map[string]string{"status": "HTTP status code", "method": "HTTP method"}},
+		{name: "remove", labels: map[string]string{"method": "HTTP method"}},
+		{name: "remove all", labels: map[string]string{}},
+	}
+	for i, step := range steps {
+		var returned *pb.LogMetric
+		var err error
+		if i == 0 {
+			returned, err = s.CreateLogMetric(ctx, &pb.CreateLogMetricRequest{Parent: testMetricParent, Metric: withLabels(step.labels)})
+		} else {
+			returned, err = s.UpdateLogMetric(ctx, &pb.UpdateLogMetricRequest{MetricName: metricName, Metric: withLabels(step.labels)})
+		}
+		if err != nil {
+			t.Fatalf("%s: writing metric failed: %v", step.name, err)
+		}
+		checkLabels(t, returned, step.labels)
+
+		got, err := s.GetLogMetric(ctx, &pb.GetLogMetricRequest{MetricName: metricName})
+		if err != nil {
+			t.Fatalf("%s: GetLogMetric failed: %v", step.name, err)
+		}
+		checkLabels(t, got, step.labels)
+
+		// Changing the returned objects must not change what is stored.
+		got.MetricDescriptor.Labels = append(got.MetricDescriptor.Labels, &label.LabelDescriptor{Key: "stale"})
+		list, err := s.ListLogMetrics(ctx, &pb.ListLogMetricsRequest{Parent: testMetricParent})
+		if err != nil {
+			t.Fatalf("%s: ListLogMetrics failed: %v", step.name, err)
+		}
+		if len(list.GetMetrics()) != 1 {
+			t.Fatalf("%s: unexpected number of metrics; got %d, want 1", step.name, len(list.GetMetrics()))
+		}
+		checkLabels(t, list.GetMetrics()[0], step.labels)
+	}
+}
@@ -23,8 +23,8 @@ package mocklogging
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -32,8 +32,11 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/paging"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
 )
 
 func (s *configService) GetSink(ctx context.Context, req *pb.GetSinkRequest) (*pb.LogSink, error) {
@@ -55,6 +58,9 @@ func (s *configService) GetSink(ctx context.Context, req *pb.GetSinkRequest) (*p
 }
 
 func (s *configService) CreateSink(ctx context.Context, req *pb.CreateSinkRequest) (*pb.LogSink, error) {
+	if err := requireFields(req, "sink"); err != nil {
+		return nil, err
+	}
 	reqName := fmt.Sprintf("%s/sinks/%s", req.Parent, req.GetSink().GetName())
 	name, err := s.parseLogSinkName(reqName)
 	if err != nil {
@@ -63,26 +69,66 @@ func (s *configService) CreateSink(ctx context.Context, req *pb.CreateSinkReques
 	fqn := name.String()
 
 	obj := proto.Clone(req.GetSink()).(*pb.LogSink)
-	obj.CreateTime = timestamppb.New(time.Now())
-	obj.UpdateTime = timestamppb.New(time.Now())
+	now := s.now()
+	obj.CreateTime = timestamppb.New(now)
+	obj.UpdateTime = timestamppb.New(now)
 
-	if name.Parent.Project != nil {
-		obj.WriterIdentity = fmt.Sprintf("serviceAccount:service-%d@gcp-sa-logging.iam.gserviceaccount.com", name.Parent.Project.Number)
+	obj.WriterIdentity, err = s.writerIdentityForSink(name, req.GetUniqueWriterIdentity())
+	if err != nil {
+		return nil, err
 	}
 
-	if name.Parent.Folder != "" {
-		obj.WriterIdentity = fmt.Sprintf("serviceAccount:service-folder-%s@gcp-sa-logging.iam.gserviceaccount.com", name.Parent.Folder)
+	if err := s.storage.Create(ctx, fqn, obj); err != nil {
+		return nil, err
 	}
 
-	if name.Parent.Organization != "" {
-		obj.WriterIdentity = fmt.Sprintf("serviceAccount:service-org-%s@gcp-sa-logging.iam.gserviceaccount.com", name.Parent.Organization)
+	return obj, nil
+}
+
+func (s *configService) ListSinks(ctx context.Context, req *pb.ListSinksRequest) (*pb.ListSinksResponse, error) {
+	parent, remainder, err := s.PopFolderOrgOrProject(strings.Split(req.GetParent(), "/"))
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "parent %q is not valid", req.GetParent())
 	}
 
-	if err := s.storage.Create(ctx, fqn, obj); err != nil {
+	response := &pb.ListSinksResponse{}
+	findKind := (&pb.LogSink{}).ProtoReflect().Descriptor()
+	if err := s.storage.List(ctx, findKind, storage.ListOptions{
+		Prefix: parent.String() + "/sinks/",
+	}, func(obj proto.Message) error {
+		sink := obj.(*pb.LogSink)
+		response.Sinks = append(response.Sinks, sink)
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
-	return obj, nil
+	sort.Slice(response.Sinks, func(i, j int) bool {
+		return response.Sinks[i].GetName() < response.Sinks[j].GetName()
+	})
+	page, nextPageToken, err := paging.Page(response.Sinks, (*pb.LogSink).GetName, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	response.Sinks = page
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+// writerIdentityForSink returns the service account that GCP uses to write the sink's logs.
+// Sinks outside of projects always get a unique writer identity.
+func (s *configService) writerIdentityForSink(name *logSinkName, uniqueWriterIdentity bool) (string, error) {
+	if name.Parent.Project != nil && !uniqueWriterIdentity {
+		return "serviceAccount:cloud-logs@system.gserviceaccount.com", nil
+	}
+	serviceAccount, err := s.loggingServiceAccount(&name.Parent)
+	if err != nil {
+		return "", err
+	}
+	return "serviceAccount:" + serviceAccount, nil
 }
 
 func (s *configService) UpdateSink(ctx context.Context, req *pb.UpdateSinkRequest) (*pb.LogSink, error) {
@@ -106,18 +152,23 @@ func (s *configService) UpdateSink(ctx context.Context, req *pb.UpdateSinkReques
 		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required by mock")
 	}
 
-	// TODO: Some sort of helper for fieldmask?
 	for _, path := range paths {
 		switch path {
-		case "description":
-			updated.Description = req.GetSink().GetDescription()
-		case "filter":
-			updated.Filter = req.GetSink().GetFilter()
+		case "description", "filter", "destination", "disabled":
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
 		}
 	}
-	updated.UpdateTime = timestamppb.New(time.Now())
+	if err := fields.UpdateByFieldMask(updated, req.GetSink(), paths); err != nil {
+		return nil, err
+	}
+	if req.GetUniqueWriterIdentity() {
+		updated.WriterIdentity, err = s.writerIdentityForSink(name, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	updated.UpdateTime = timestamppb.New(s.now())
 	if err := s.storage.Update(ctx, fqn, updated); err != nil {
 		return nil, err
 	}
@@ -139,9 +190,10 @@ func (s *configService) DeleteSink(ctx context.Context, req *pb.DeleteSinkReques
 }
 
 type FolderOrgOrProject struct {
-	Folder       string
-	Organization string
-	Project      *projects.ProjectData
+	Folder         string
+	Organization   string
+	BillingAccount string
+	Project        *projects.ProjectData
 }
 
 func (s *configService) PopFolderOrgOrProject(tokens []string) (*FolderOrgOrProject, []string, error) {
@@ -174,6 +226,14 @@ func (s *configService) PopFolderOrgOrProject(tokens []string) (*FolderOrgOrProj
 		return name, tokens[2:], nil
 	}
 
+	if len(tokens) >= 2 && tokens[0] == "billingAccounts" {
+		name := &FolderOrgOrProject{
+			BillingAccount: tokens[1],
+		}
+
+		return name, tokens[2:], nil
+	}
+
 	return nil, nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", strings.Join(tokens, "/"))
 }
 
@@ -187,7 +247,10 @@ func (n *FolderOrgOrProject) String() string {
 		return fmt.Sprintf("organizations/%s", n.Organization)
 	}
 	if n.Folder != "" {
-		return fmt.Sprintf("folders/%s", n.Organization)
+		return fmt.Sprintf("folders/%s", n.Folder)
+	}
+	if n.BillingAccount != "" {
+		return fmt.Sprintf("billingAccounts/%s", n.BillingAccount)
 	}
 	return fmt.Sprintf("projects/%s", n.Project.ID)
 }
@@ -197,7 +260,7 @@ func (n *logSinkName) String() string {
 }
 
 // parseLogSinkName parses a string into a logSinkName.
-// The expected form is `projects/*/sinks/*`
+// The expected form is `{projects,folders,organizations,billingAccounts}/*/sinks/*`
 func (s *configService) parseLogSinkName(name string) (*logSinkName, error) {
 	tokens := strings.Split(name, "/")
 
@@ -0,0 +1,143 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestCreateSinkWriterIdentity(t *testing.T) {
+	grid := []struct {
+		parent               string
+		uniqueWriterIdentity bool
+		want                 string
+	}{
+		{
+			parent:               "projects/" + testProjectID,
+			uniqueWriterIdentity: true,
+			want:                 fmt.Sprintf("serviceAccount:service-%d@gcp-sa-logging.iam.gserviceaccount.com", testProjectNumber),
+		},
+		{
+			parent: "projects/" + testProjectID,
+			want:   "serviceAccount:cloud-logs@system.gserviceaccount.com",
+		},
+		{
+			parent: "folders/123",
+			want:   "serviceAccount:service-folder-123@gcp-sa-logging.iam.gserviceaccount.com",
+		},
+		{
+			parent: "organizations/456",
+			want:   "serviceAccount:service-org-456@gcp-sa-logging.iam.gserviceaccount.com",
+		},
+		{
+			parent: "billingAccounts/0000-AAAA",
+			want:   "serviceAccount:service-billing-0000-AAAA@gcp-sa-logging.iam.gserviceaccount.com",
+		},
+	}
+	for _, g := range grid {
+		t.Run(fmt.Sprintf("%s/unique=%v", g.parent, g.uniqueWriterIdentity), func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestConfigService(t)
+
+			created, err := s.CreateSink(ctx, &pb.CreateSinkRequest{
+				Parent:               g.parent,
+				Sink:                 &pb.LogSink{Name: "mysink", Destination: "storage.googleapis.com/mybucket"},
+				UniqueWriterIdentity: g.uniqueWriterIdentity,
+			})
+			if err != nil {
+				t.Fatalf("CreateSink failed: %v", err)
+			}
+			if created.GetWriterIdentity() != g.want {
+				t.Errorf("unexpected writer identity; got %q, want %q", created.GetWriterIdentity(), g.want)
+			}
+
+			got, err := s.GetSink(ctx, &pb.GetSinkRequest{SinkName: g.parent + "/sinks/mysink"})
+			if err != nil {
+				t.Fatalf("GetSink failed: %v", err)
+			}
+			if got.GetWriterIdentity() != g.want {
+				t.Errorf("writer identity not stored; got %q, want %q", got.GetWriterIdentity(), g.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSinkUniqueWriterIdentity(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	parent := "projects/" + testProjectID
+	if _, err := s.CreateSink(ctx, &pb.CreateSinkRequest{
+		Parent: parent,
+		Sink:   &pb.LogSink{Name: "mysink", Filter: "severity>=ERROR"},
+	}); err != nil {
+		t.Fatalf("CreateSink failed: %v", err)
+	}
+
+	updated, err := s.UpdateSink(ctx, &pb.UpdateSinkRequest{
+		SinkName:             parent + "/sinks/mysink",
+		Sink:                 &pb.LogSink{Filter: "ignored", Destination: "storage.googleapis.com/other", Disabled: true},
+		UpdateMask:           &fieldmaskpb.FieldMask{Paths: []string{"destination", "disabled"}},
+		UniqueWriterIdentity: true,
+	})
+	if err != nil {
+		t.Fatalf("UpdateSink failed: %v", err)
+	}
+	if updated.GetFilter() != "severity>=ERROR" || updated.GetDestination() != "storage.googleapis.com/other" || !updated.GetDisabled() {
+		t.Errorf("unexpected updated sink %v", updated)
+	}
+	if want := fmt.Sprintf("serviceAccount:service-%d@gcp-sa-logging.iam.gserviceaccount.com", testProjectNumber); updated.GetWriterIdentity() != want {
+		t.Errorf("unexpected writer identity; got %q, want %q", updated.GetWriterIdentity(), want)
+	}
+}
+
+func TestListSinksByParent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	for _, sink := range []struct{ parent, name string }{
+		{"folders/123", "a"},
+		{"folders/123", "b"},
+		{"folders/1234", "c"},
+		{"organizations/123", "d"},
+	} {
+		if _, err := s.CreateSink(ctx, &pb.CreateSinkRequest{
+			Parent: sink.parent,
+			Sink:   &pb.LogSink{Name: sink.name},
+		}); err != nil {
+			t.Fatalf("CreateSink failed: %v", err)
+		}
+	}
+
+	list, err := s.ListSinks(ctx, &pb.ListSinksRequest{Parent: "folders/123"})
+	if err != nil {
+		t.Fatalf("ListSinks failed: %v", err)
+	}
+	var names []string
+	for _, sink := range list.GetSinks() {
+		names = append(names, sink.GetName())
+	}
+	sort.Strings(names)
+	if fmt.Sprint(names) != "[a b]" {
+		t.Errorf("unexpected sinks listed for folders/123; got %v, want [a b]", names)
+	}
+}
@@ -24,7 +24,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -32,6 +31,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
 )
@@ -59,8 +59,12 @@ func (s *configService) CreateView(ctx context.Context, req *pb.CreateViewReques
 		return nil, err
 	}
 
+	if err := checkReservedBucket(name.bucketName, createViewOperation); err != nil {
+		return nil, err
+	}
+
 	fqn := name.String()
-	now := time.Now()
+	now := s.now()
 	obj := proto.Clone(req.GetView()).(*pb.LogView)
 	obj.Name = fqn
 	obj.CreateTime = timestamppb.New(now)
@@ -82,7 +86,7 @@ func (s *configService) UpdateView(ctx context.Context, req *pb.UpdateViewReques
 	if err := s.storage.Get(ctx, fqn, existing); err != nil {
 		return nil, err
 	}
-	now := time.Now()
+	now := s.now()
 	updated := proto.Clone(existing).(*pb.LogView)
 
 	// Required. The update mask applies to the resource.
@@ -91,17 +95,16 @@ func (s *configService) UpdateView(ctx context.Context, req *pb.UpdateViewReques
 		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required by mock")
 	}
 
-	// TODO: Some sort of helper for fieldmask?
 	for _, path := range paths {
 		switch path {
-		case "description":
-			updated.Description = req.GetView().GetDescription()
-		case "filter":
-			updated.Filter = req.GetView().GetFilter()
+		case "description", "filter":
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
 		}
 	}
+	if err := fields.UpdateByFieldMask(updated, req.GetView(), paths); err != nil {
+		return nil, err
+	}
 
 	updated.UpdateTime = timestamppb.New(now)
 	if err := s.storage.Update(ctx, fqn, updated); err != nil {
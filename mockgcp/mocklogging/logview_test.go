@@ -0,0 +1,82 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestViewLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	viewFQN := testBucketFQN + "/views/myview"
+	created, err := s.CreateView(ctx, &pb.CreateViewRequest{
+		Parent: testBucketFQN,
+		ViewId: "myview",
+		View:   &pb.LogView{Description: "my view", Filter: `LOG_ID("stdout")`},
+	})
+	if err != nil {
+		t.Fatalf("CreateView failed: %v", err)
+	}
+	if created.GetName() != viewFQN || created.GetCreateTime() == nil {
+		t.Errorf("unexpected created view %v", created)
+	}
+
+	updated, err := s.UpdateView(ctx, &pb.UpdateViewRequest{
+		Name:       viewFQN,
+		View:       &pb.LogView{Description: "ignored", Filter: `LOG_ID("stderr")`},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filter"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateView failed: %v", err)
+	}
+	if updated.GetFilter() != `LOG_ID("stderr")` || updated.GetDescription() != "my view" {
+		t.Errorf("unexpected updated view %v", updated)
+	}
+
+	got, err := s.GetView(ctx, &pb.GetViewRequest{Name: viewFQN})
+	if err != nil {
+		t.Fatalf("GetView failed: %v", err)
+	}
+	if got.GetFilter() != `LOG_ID("stderr")` {
+		t.Errorf("filter update was not stored; got %q", got.GetFilter())
+	}
+
+	if _, err := s.DeleteView(ctx, &pb.DeleteViewRequest{Name: viewFQN}); err != nil {
+		t.Fatalf("DeleteView failed: %v", err)
+	}
+	_, err = s.GetView(ctx, &pb.GetViewRequest{Name: viewFQN})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestCreateViewOnRequiredBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	_, err := s.CreateView(ctx, &pb.CreateViewRequest{
+		Parent: testBucketParent + "/buckets/_Required",
+		ViewId: "myview",
+		View:   &pb.LogView{},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
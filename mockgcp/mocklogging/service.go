@@ -17,21 +17,45 @@ package mocklogging
 import (
 	"context"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/go-logr/logr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/faults"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/httpmux"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/operations"
 	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
 )
 
-// MockService represents a mocked networkservices service.
+// MockService represents a mocked logging service.
+//
+// All List methods return results sorted by resource name (before paging), so that
+// list output does not depend on storage iteration order.
 type MockService struct {
 	*common.MockEnvironment
 	storage    storage.Storage
 	operations *operations.Operations
+	faults     *faults.Injector
+
+	// log is the logger for our RPCs; it discards everything unless set with SetLogger.
+	log logr.Logger
+
+	// supportedBucketLocations are the locations accepted in log bucket and link names.
+	supportedBucketLocations []string
+
+	// showDeletedBuckets makes ListBuckets include buckets that are DELETE_REQUESTED.
+	showDeletedBuckets bool
+
+	// now returns the current time; tests can replace it with SetClock to get deterministic timestamps.
+	now func() time.Time
 }
 
 // New creates a MockService.
@@ -40,10 +64,143 @@ func New(env *common.MockEnvironment, storage storage.Storage) *MockService {
 		MockEnvironment: env,
 		storage:         storage,
 		operations:      operations.NewOperationsService(storage),
+		faults:          faults.NewInjector(pb.ConfigServiceV2_ServiceDesc.ServiceName, pb.MetricsServiceV2_ServiceDesc.ServiceName),
+		log:             logr.Discard(),
+		now:             time.Now,
+
+		supportedBucketLocations: defaultSupportedBucketLocations,
 	}
 	return s
 }
 
+// SetLogger sets the logger that each RPC is logged to at V(2), with its method, resource name and error.
+// By default the RPCs are not logged; pass klog.Background() to debug controller tests.
+func (s *MockService) SetLogger(log logr.Logger) {
+	s.log = log
+}
+
+// SetSupportedBucketLocations overrides the locations accepted in log bucket and link names;
+// requests for buckets or links in any other location fail with InvalidArgument.
+func (s *MockService) SetSupportedBucketLocations(locations []string) {
+	s.supportedBucketLocations = locations
+}
+
+// SetClock overrides the clock used for the create and update times of logging resources.
+// The clock defaults to the real time.
+func (s *MockService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// SetShowDeletedBuckets controls whether ListBuckets includes deleted buckets, which are kept in the
+// DELETE_REQUESTED state so that they can be undeleted. By default they are not listed.
+func (s *MockService) SetShowDeletedBuckets(show bool) {
+	s.showDeletedBuckets = show
+}
+
+// projectNumber resolves a project ID to the project number, which GCP uses in the emails of a
+// project's service agents. It returns NotFound if the project is not known.
+func (s *MockService) projectNumber(projectID string) (int64, error) {
+	project, err := s.Projects.GetProjectByID(projectID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, status.Errorf(codes.NotFound, "project %q not found", projectID)
+		}
+		return 0, err
+	}
+	if project.Number == 0 {
+		return 0, status.Errorf(codes.Internal, "project %q has no project number", projectID)
+	}
+	return project.Number, nil
+}
+
+// checkContext returns Canceled or DeadlineExceeded if the request context is done, so that
+// clients can exercise their timeout handling against the multi-step mock methods.
+func checkContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// requireFields returns InvalidArgument naming the first of paths that is not set in req, so that every
+// method reports missing fields the same way. Each path is a dot-separated list of proto field names
+// (e.g. `exclusion.filter`); a field is missing if it, or any message that contains it, is unset or empty.
+func requireFields(req proto.Message, paths ...string) error {
+	for _, path := range paths {
+		m := req.ProtoReflect()
+		for _, fieldName := range strings.Split(path, ".") {
+			fd := m.Descriptor().Fields().ByName(protoreflect.Name(fieldName))
+			if fd == nil {
+				return status.Errorf(codes.Internal, "%s has no field %q", m.Descriptor().FullName(), fieldName)
+			}
+			if !m.Has(fd) {
+				return status.Errorf(codes.InvalidArgument, "%s is required", path)
+			}
+			if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+				m = m.Get(fd).Message()
+			}
+		}
+	}
+	return nil
+}
+
+// InjectFault causes the next count calls to the RPC method (e.g. `GetLink`) to fail with err.
+func (s *MockService) InjectFault(method string, count int, err error) {
+	s.faults.Inject(method, count, err)
+}
+
+// UnaryServerInterceptor returns the interceptor that applies injected faults to our RPCs, and logs them.
+func (s *MockService) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	applyFaults := s.faults.UnaryServerInterceptor()
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := applyFaults(ctx, req, info, handler)
+
+		// FullMethod is of the form `/package.Service/Method`
+		service, method, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		if service == pb.ConfigServiceV2_ServiceDesc.ServiceName || service == pb.MetricsServiceV2_ServiceDesc.ServiceName {
+			if log := s.log.V(2); log.Enabled() {
+				log.Info("mocklogging RPC", "method", method, "name", rpcResourceName(req), "error", err)
+			}
+		}
+		return resp, err
+	}
+}
+
+// rpcResourceName returns the name of the resource that a request is for, for logging.
+// Create requests name their parent and the ID of the new resource (e.g. `link_id`), or the
+// parent and the new resource itself (e.g. `sink`); other requests name the resource directly.
+func rpcResourceName(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	var parent, name string
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldName := string(fd.Name())
+		switch {
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && (fieldName == "name" || strings.HasSuffix(fieldName, "_name")):
+			return m.Get(fd).String()
+		case fd.Kind() == protoreflect.StringKind && fieldName == "parent":
+			parent = m.Get(fd).String()
+		case fd.Kind() == protoreflect.StringKind && strings.HasSuffix(fieldName, "_id") && m.Get(fd).String() != "":
+			name = strings.TrimSuffix(fieldName, "_id") + "s/" + m.Get(fd).String()
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && m.Has(fd) && name == "":
+			resource := m.Get(fd).Message()
+			if nameField := resource.Descriptor().Fields().ByName("name"); nameField != nil && resource.Get(nameField).String() != "" {
+				name = fieldName + "s/" + resource.Get(nameField).String()
+			}
+		}
+	}
+	if name == "" {
+		return parent
+	}
+	return parent + "/" + name
+}
+
 func (s *MockService) ExpectedHosts() []string {
 	return []string{"logging.googleapis.com"}
 }
@@ -56,7 +213,8 @@ func (s *MockService) Register(grpcServer *grpc.Server) {
 func (s *MockService) NewHTTPMux(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
 	mux, err := httpmux.NewServeMux(ctx, conn, httpmux.Options{},
 		pb.RegisterMetricsServiceV2Handler,
-		pb.RegisterConfigServiceV2Handler)
+		pb.RegisterConfigServiceV2Handler,
+		s.operations.RegisterOperationsPath("/v2/{prefix=**}/operations/{name}"))
 	if err != nil {
 		return nil, err
 	}
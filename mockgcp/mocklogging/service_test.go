@@ -0,0 +1,257 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+)
+
+const (
+	testProjectID     = "test-project"
+	testProjectNumber = 123456789
+)
+
+// newTestMockService builds a MockService backed by in-memory storage, with a single project.
+func newTestMockService(t *testing.T) *MockService {
+	t.Helper()
+
+	env := &common.MockEnvironment{
+		Projects: projects.NewFakeProjectStore(&projects.ProjectData{ID: testProjectID, Number: testProjectNumber}),
+	}
+	return New(env, storage.NewInMemoryStorage())
+}
+
+// newTestConfigService builds a configService backed by in-memory storage, with a single project.
+func newTestConfigService(t *testing.T) *configService {
+	t.Helper()
+
+	return &configService{MockService: newTestMockService(t)}
+}
+
+// newTestMetricsService builds a metricsService backed by in-memory storage, with a single project.
+func newTestMetricsService(t *testing.T) *metricsService {
+	t.Helper()
+
+	return &metricsService{MockService: newTestMockService(t)}
+}
+
+// wantCode fails the test if err does not have the expected gRPC status code.
+func wantCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("unexpected status code; got %v (%v), want %v", got, err, want)
+	}
+}
+
+func TestProjectNumber(t *testing.T) {
+	s := newTestMockService(t)
+
+	got, err := s.projectNumber(testProjectID)
+	if err != nil {
+		t.Fatalf("projectNumber failed: %v", err)
+	}
+	if got != testProjectNumber {
+		t.Errorf("unexpected project number; got %d, want %d", got, testProjectNumber)
+	}
+
+	_, err = s.projectNumber("unknown-project")
+	wantCode(t, err, codes.NotFound)
+	if msg := status.Convert(err).Message(); !strings.Contains(msg, `"unknown-project"`) {
+		t.Errorf("error message should name the project; got %q", msg)
+	}
+
+	noNumber := New(&common.MockEnvironment{
+		Projects: projects.NewFakeProjectStore(&projects.ProjectData{ID: "no-number"}),
+	}, storage.NewInMemoryStorage())
+	_, err = noNumber.projectNumber("no-number")
+	wantCode(t, err, codes.Internal)
+}
+
+func TestProjectServiceAgentsUseProjectNumber(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	want := fmt.Sprintf("service-%d@gcp-sa-logging.iam.gserviceaccount.com", testProjectNumber)
+
+	settings, err := s.GetSettings(ctx, &pb.GetSettingsRequest{Name: "projects/" + testProjectID + "/settings"})
+	if err != nil {
+		t.Fatalf("GetSettings failed: %v", err)
+	}
+	if settings.GetKmsServiceAccountId() != want {
+		t.Errorf("unexpected kms_service_account_id; got %q, want %q", settings.GetKmsServiceAccountId(), want)
+	}
+
+	cmekSettings, err := s.GetCmekSettings(ctx, &pb.GetCmekSettingsRequest{Name: "projects/" + testProjectID + "/cmekSettings"})
+	if err != nil {
+		t.Fatalf("GetCmekSettings failed: %v", err)
+	}
+	if cmekSettings.GetServiceAccountId() != want {
+		t.Errorf("unexpected service_account_id; got %q, want %q", cmekSettings.GetServiceAccountId(), want)
+	}
+
+	sink, err := s.CreateSink(ctx, &pb.CreateSinkRequest{
+		Parent:               "projects/" + testProjectID,
+		Sink:                 &pb.LogSink{Name: "my-sink", Destination: "storage.googleapis.com/my-bucket"},
+		UniqueWriterIdentity: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateSink failed: %v", err)
+	}
+	if sink.GetWriterIdentity() != "serviceAccount:"+want {
+		t.Errorf("unexpected writer_identity; got %q, want %q", sink.GetWriterIdentity(), "serviceAccount:"+want)
+	}
+}
+
+func TestRPCsAreLogged(t *testing.T) {
+	ctx := context.Background()
+	s := newTestMockService(t)
+	client := newTestGRPCClient(t, s)
+	createTestBucket(t, &configService{MockService: s})
+
+	// By default nothing is logged; only the RPCs after SetLogger should be recorded.
+	if _, err := client.GetBucket(ctx, &pb.GetBucketRequest{Name: testBucketFQN}); err != nil {
+		t.Fatalf("GetBucket failed: %v", err)
+	}
+
+	var mutex sync.Mutex
+	var lines []string
+	s.SetLogger(funcr.New(func(prefix, args string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: 2}))
+
+	if _, err := client.CreateLink(ctx, &pb.CreateLinkRequest{
+		Parent: testBucketFQN,
+		LinkId: "mylink",
+		Link:   &pb.Link{},
+	}); err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	_, err := client.GetLink(ctx, &pb.GetLinkRequest{Name: testBucketFQN + "/links/missing"})
+	wantCode(t, err, codes.NotFound)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(lines) != 2 {
+		t.Fatalf("expected one log line per RPC after SetLogger, got %q", lines)
+	}
+	if !strings.Contains(lines[0], `"method"="CreateLink"`) || !strings.Contains(lines[0], `"name"="`+testLinkFQN+`"`) || !strings.Contains(lines[0], `"error"=null`) {
+		t.Errorf("unexpected log line for CreateLink: %s", lines[0])
+	}
+	if !strings.Contains(lines[1], `"method"="GetLink"`) || !strings.Contains(lines[1], "/links/missing") || !strings.Contains(lines[1], "NotFound") {
+		t.Errorf("unexpected log line for GetLink: %s", lines[1])
+	}
+}
+
+func TestRPCResourceName(t *testing.T) {
+	grid := []struct {
+		req  any
+		want string
+	}{
+		{req: &pb.GetLinkRequest{Name: testLinkFQN}, want: testLinkFQN},
+		{req: &pb.CreateLinkRequest{Parent: testBucketFQN, LinkId: "mylink"}, want: testLinkFQN},
+		{req: &pb.GetSinkRequest{SinkName: "projects/test-project/sinks/mysink"}, want: "projects/test-project/sinks/mysink"},
+		{req: &pb.CreateSinkRequest{Parent: "projects/test-project", Sink: &pb.LogSink{Name: "mysink"}}, want: "projects/test-project/sinks/mysink"},
+		{req: &pb.ListBucketsRequest{Parent: testBucketParent}, want: testBucketParent},
+	}
+	for _, g := range grid {
+		if got := rpcResourceName(g.req); got != g.want {
+			t.Errorf("unexpected resource name for %T; got %q, want %q", g.req, got, g.want)
+		}
+	}
+}
+
+func TestRequiredFieldsHaveTheSameMessage(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+	createTestBucket(t, s)
+
+	grid := []struct {
+		field string
+		call  func() error
+	}{
+		{field: "link", call: func() error {
+			_, err := s.CreateLink(ctx, &pb.CreateLinkRequest{Parent: testBucketFQN, LinkId: "mylink"})
+			return err
+		}},
+		{field: "bucket", call: func() error {
+			_, err := s.CreateBucket(ctx, &pb.CreateBucketRequest{Parent: testBucketParent, BucketId: "mybucket"})
+			return err
+		}},
+		{field: "sink", call: func() error {
+			_, err := s.CreateSink(ctx, &pb.CreateSinkRequest{Parent: "projects/" + testProjectID})
+			return err
+		}},
+		{field: "exclusion.filter", call: func() error {
+			_, err := s.CreateExclusion(ctx, &pb.CreateExclusionRequest{Parent: "projects/" + testProjectID, Exclusion: &pb.LogExclusion{Name: "myexclusion"}})
+			return err
+		}},
+	}
+	for _, g := range grid {
+		t.Run(g.field, func(t *testing.T) {
+			err := g.call()
+			wantCode(t, err, codes.InvalidArgument)
+			if got, want := status.Convert(err).Message(), g.field+" is required"; got != want {
+				t.Errorf("unexpected message; got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRequireFields(t *testing.T) {
+	grid := []struct {
+		req     proto.Message
+		paths   []string
+		wantErr string
+	}{
+		{req: &pb.CreateLinkRequest{Link: &pb.Link{}}, paths: []string{"link"}},
+		{req: &pb.CreateLinkRequest{}, paths: []string{"link"}, wantErr: "link is required"},
+		{req: &pb.CreateLinkRequest{}, paths: []string{"link.bigquery_dataset.dataset_id"}, wantErr: "link.bigquery_dataset.dataset_id is required"},
+		{req: &pb.CreateLinkRequest{Link: &pb.Link{BigqueryDataset: &pb.BigQueryDataset{}}}, paths: []string{"link.bigquery_dataset.dataset_id"}, wantErr: "link.bigquery_dataset.dataset_id is required"},
+		{req: &pb.CreateLinkRequest{Link: &pb.Link{BigqueryDataset: &pb.BigQueryDataset{DatasetId: "d"}}}, paths: []string{"link.bigquery_dataset.dataset_id"}},
+		{req: &pb.CreateLinkRequest{LinkId: "l"}, paths: []string{"link_id", "link"}, wantErr: "link is required"},
+	}
+	for _, g := range grid {
+		err := requireFields(g.req, g.paths...)
+		if g.wantErr == "" {
+			if err != nil {
+				t.Errorf("requireFields(%v, %v) failed: %v", g.req, g.paths, err)
+			}
+			continue
+		}
+		if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != g.wantErr {
+			t.Errorf("requireFields(%v, %v) = %v, want InvalidArgument %q", g.req, g.paths, err, g.wantErr)
+		}
+	}
+
+	if err := requireFields(&pb.CreateLinkRequest{}, "nosuchfield"); status.Code(err) != codes.Internal {
+		t.Errorf("expected Internal for an unknown field, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/fields"
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func (s *configService) GetSettings(ctx context.Context, req *pb.GetSettingsRequest) (*pb.Settings, error) {
+	name, err := s.parseSettingsName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	obj, _, err := s.getSettings(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s *configService) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.Settings, error) {
+	name, err := s.parseSettingsName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	fqn := name.String()
+
+	existing, found, err := s.getSettings(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := proto.Clone(existing).(*pb.Settings)
+
+	// Without a mask, all the mutable fields are updated.
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"kms_key_name", "storage_location", "disable_default_sink"}
+	}
+	for _, path := range paths {
+		switch path {
+		case "kms_key_name", "kmsKeyName", "storage_location", "storageLocation", "disable_default_sink", "disableDefaultSink":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q not valid", path)
+		}
+	}
+	if err := fields.UpdateByFieldMask(updated, req.GetSettings(), paths); err != nil {
+		return nil, err
+	}
+
+	if found {
+		err = s.storage.Update(ctx, fqn, updated)
+	} else {
+		err = s.storage.Create(ctx, fqn, updated)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// getSettings returns the stored settings, or the defaults if they have never been updated.
+func (s *configService) getSettings(ctx context.Context, name *settingsName) (*pb.Settings, bool, error) {
+	fqn := name.String()
+
+	obj := &pb.Settings{}
+	if err := s.storage.Get(ctx, fqn, obj); err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, false, err
+		}
+		serviceAccount, err := s.loggingServiceAccount(&name.Parent)
+		if err != nil {
+			return nil, false, err
+		}
+		return &pb.Settings{
+			Name:                fqn,
+			KmsServiceAccountId: serviceAccount,
+		}, false, nil
+	}
+	return obj, true, nil
+}
+
+type settingsName struct {
+	Parent FolderOrgOrProject
+}
+
+func (n *settingsName) String() string {
+	return n.Parent.String() + "/settings"
+}
+
+// parseSettingsName parses a string into a settingsName.
+// The expected form is `{projects,folders,organizations,billingAccounts}/*/settings`
+func (s *configService) parseSettingsName(name string) (*settingsName, error) {
+	tokens := strings.Split(name, "/")
+
+	parent, remainder, err := s.PopFolderOrgOrProject(tokens)
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) == 1 && remainder[0] == "settings" {
+		return &settingsName{Parent: *parent}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "name %q is not valid", name)
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocklogging
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+)
+
+func TestUpdateThenGetSettings(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	name := "organizations/123/settings"
+	kmsKeyName := "projects/" + testProjectID + "/locations/global/keyRings/my-ring/cryptoKeys/my-key"
+
+	if _, err := s.UpdateSettings(ctx, &pb.UpdateSettingsRequest{
+		Name: name,
+		Settings: &pb.Settings{
+			KmsKeyName:         kmsKeyName,
+			StorageLocation:    "europe-west1",
+			DisableDefaultSink: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"kms_key_name", "storage_location", "disable_default_sink"}},
+	}); err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+
+	got, err := s.GetSettings(ctx, &pb.GetSettingsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetSettings failed: %v", err)
+	}
+	if got.GetName() != name || got.GetKmsKeyName() != kmsKeyName || got.GetStorageLocation() != "europe-west1" || !got.GetDisableDefaultSink() {
+		t.Errorf("unexpected settings %v", got)
+	}
+	if want := "service-org-123@gcp-sa-logging.iam.gserviceaccount.com"; got.GetKmsServiceAccountId() != want {
+		t.Errorf("unexpected kms_service_account_id; got %q, want %q", got.GetKmsServiceAccountId(), want)
+	}
+}
+
+func TestUpdateSettingsMasked(t *testing.T) {
+	ctx := context.Background()
+	s := newTestConfigService(t)
+
+	name := "projects/" + testProjectID + "/settings"
+	if _, err := s.UpdateSettings(ctx, &pb.UpdateSettingsRequest{
+		Name:       name,
+		Settings:   &pb.Settings{StorageLocation: "us-central1", DisableDefaultSink: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"storage_location", "disable_default_sink"}},
+	}); err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+
+	updated, err := s.UpdateSettings(ctx, &pb.UpdateSettingsRequest{
+		Name:       name,
+		Settings:   &pb.Settings{StorageLocation: "asia-east1"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"storage_location"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+	if updated.GetStorageLocation() != "asia-east1" {
+		t.Errorf("unexpected storage_location; got %q, want %q", updated.GetStorageLocation(), "asia-east1")
+	}
+	if !updated.GetDisableDefaultSink() {
+		t.Errorf("disable_default_sink was changed even though it is not in the update_mask")
+	}
+
+	_, err = s.UpdateSettings(ctx, &pb.UpdateSettingsRequest{
+		Name:       name,
+		Settings:   &pb.Settings{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"kms_service_account_id"}},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
@@ -21,6 +21,10 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// Storage stores the mock resources.
+//
+// Implementations store a copy of the object passed to Create and Update, and pass copies to Get
+// and List callbacks, so that neither the caller's request nor a response can alias a stored object.
 type Storage interface {
 	// Create stores the object, erroring if it already exists
 	Create(ctx context.Context, fqn string, create proto.Message) error
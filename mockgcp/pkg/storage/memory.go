@@ -148,8 +148,8 @@ func (s *typeStorage) List(ctx context.Context, options ListOptions, callback fu
 		if options.Prefix != "" && !strings.HasPrefix(fqn, options.Prefix) {
 			continue
 		}
-		// Technically we should clone here
-		if err := callback(obj); err != nil {
+		// Clone so that callers cannot modify the stored object (e.g. its label maps).
+		if err := callback(proto.Clone(obj)); err != nil {
 			return err
 		}
 	}
@@ -42,9 +42,13 @@ type KMSKeyRingImportJobSpec struct {
 	/* Immutable. The wrapping method to be used for incoming key material. Possible values: ["RSA_OAEP_3072_SHA1_AES_256", "RSA_OAEP_4096_SHA1_AES_256"]. */
 	ImportMethod string `json:"importMethod"`
 
-	/* Immutable. The KeyRing that this import job belongs to.
-	Format: ''projects/{{project}}/locations/{{location}}/keyRings/{{keyRing}}''. */
-	KeyRing string `json:"keyRing"`
+	/* DEPRECATED. Although this field is still available, there is limited support. We recommend that you use `spec.keyRingRef` instead. */
+	// +optional
+	KeyRing *string `json:"keyRing,omitempty"`
+
+	/* The KMSKeyRing that this import job belongs to. */
+	// +optional
+	KeyRingRef *v1alpha1.ResourceRef `json:"keyRingRef,omitempty"`
 
 	/* Immutable. The protection level of the ImportJob. This must match the protectionLevel of the
 	versionTemplate on the CryptoKey you attempt to import into. Possible values: ["SOFTWARE", "HSM", "EXTERNAL"]. */
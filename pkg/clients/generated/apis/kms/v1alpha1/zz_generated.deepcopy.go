@@ -635,6 +635,16 @@ func (in *KMSKeyRingImportJobList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KMSKeyRingImportJobSpec) DeepCopyInto(out *KMSKeyRingImportJobSpec) {
 	*out = *in
+	if in.KeyRing != nil {
+		in, out := &in.KeyRing, &out.KeyRing
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyRingRef != nil {
+		in, out := &in.KeyRingRef, &out.KeyRingRef
+		*out = new(k8sv1alpha1.ResourceRef)
+		**out = **in
+	}
 	if in.ResourceID != nil {
 		in, out := &in.ResourceID, &out.ResourceID
 		*out = new(string)
@@ -35,11 +35,28 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+type LogbucketCmekSettings struct {
+	/* The KMSCryptoKey that is used to encrypt the logs in the bucket. The Cloud Logging service account of the bucket's parent must have 'roles/cloudkms.cryptoKeyEncrypterDecrypter' on the key. */
+	KmsKeyRef v1alpha1.ResourceRef `json:"kmsKeyRef"`
+}
+
+type LogbucketIndexConfigs struct {
+	/* The LogEntry field path to index, e.g. `jsonPayload.request.status`. */
+	FieldPath string `json:"fieldPath"`
+
+	/* The type of data in this index. Possible values: INDEX_TYPE_STRING, INDEX_TYPE_INTEGER */
+	Type string `json:"type"`
+}
+
 type LoggingLogBucketSpec struct {
 	/* Immutable. The BillingAccount that this resource belongs to. Only one of [billingAccountRef, folderRef, organizationRef, projectRef] may be specified. */
 	// +optional
 	BillingAccountRef *v1alpha1.ResourceRef `json:"billingAccountRef,omitempty"`
 
+	/* The customer-managed encryption key (CMEK) settings of the bucket. CMEK can only be configured when the bucket is created; only the key may be changed afterwards. Only supported by the direct controller. */
+	// +optional
+	CmekSettings *LogbucketCmekSettings `json:"cmekSettings,omitempty"`
+
 	/* Describes this bucket. */
 	// +optional
 	Description *string `json:"description,omitempty"`
@@ -52,6 +69,10 @@ type LoggingLogBucketSpec struct {
 	// +optional
 	FolderRef *v1alpha1.ResourceRef `json:"folderRef,omitempty"`
 
+	/* The LogEntry fields to index, so that queries filtering on them are faster. A field may only be indexed once. Only supported by the direct controller. */
+	// +optional
+	IndexConfigs []LogbucketIndexConfigs `json:"indexConfigs,omitempty"`
+
 	/* Immutable. The location of the resource. The supported locations are: global, us-central1, us-east1, us-west1, asia-east1, europe-west1. */
 	Location string `json:"location"`
 
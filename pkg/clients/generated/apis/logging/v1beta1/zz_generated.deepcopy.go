@@ -29,6 +29,39 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogbucketCmekSettings) DeepCopyInto(out *LogbucketCmekSettings) {
+	*out = *in
+	out.KmsKeyRef = in.KmsKeyRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogbucketCmekSettings.
+func (in *LogbucketCmekSettings) DeepCopy() *LogbucketCmekSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(LogbucketCmekSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogbucketIndexConfigs) DeepCopyInto(out *LogbucketIndexConfigs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogbucketIndexConfigs.
+func (in *LogbucketIndexConfigs) DeepCopy() *LogbucketIndexConfigs {
+	if in == nil {
+		return nil
+	}
+	out := new(LogbucketIndexConfigs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingLogBucket) DeepCopyInto(out *LoggingLogBucket) {
 	*out = *in
@@ -98,6 +131,11 @@ func (in *LoggingLogBucketSpec) DeepCopyInto(out *LoggingLogBucketSpec) {
 		*out = new(v1alpha1.ResourceRef)
 		**out = **in
 	}
+	if in.CmekSettings != nil {
+		in, out := &in.CmekSettings, &out.CmekSettings
+		*out = new(LogbucketCmekSettings)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -113,6 +151,11 @@ func (in *LoggingLogBucketSpec) DeepCopyInto(out *LoggingLogBucketSpec) {
 		*out = new(v1alpha1.ResourceRef)
 		**out = **in
 	}
+	if in.IndexConfigs != nil {
+		in, out := &in.IndexConfigs, &out.IndexConfigs
+		*out = make([]LogbucketIndexConfigs, len(*in))
+		copy(*out, *in)
+	}
 	if in.Locked != nil {
 		in, out := &in.Locked, &out.Locked
 		*out = new(bool)
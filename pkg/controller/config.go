@@ -15,6 +15,8 @@
 package controller
 
 import (
+	"time"
+
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/jitter"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/dcl/conversion"
@@ -31,4 +33,7 @@ type Deps struct {
 	DclConverter *conversion.Converter
 	Defaulters   []k8s.Defaulter
 	JitterGen    jitter.Generator
+	// PendingStateRequeuePeriod is the period at which tf-based resources in a pending state are re-read;
+	// if it is 0, the period is derived from each resource's reconcile period.
+	PendingStateRequeuePeriod time.Duration
 }
@@ -0,0 +1,64 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ClearOutputOnlyFields clears every field of msg, including those of nested messages, that is annotated as OUTPUT_ONLY.
+// Controllers should call this on the result of a Spec_ToProto mapper before sending it in a create or update request,
+// so that server-managed values (create time, lifecycle state, ...) are never written; they are read back
+// through the ObservedState_FromProto mappers instead.
+func ClearOutputOnlyFields(msg proto.Message) {
+	clearOutputOnlyFields(msg.ProtoReflect())
+}
+
+func clearOutputOnlyFields(m protoreflect.Message) {
+	var outputOnly []protoreflect.FieldDescriptor
+	m.Range(func(field protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if IsFieldBehavior(field, annotations.FieldBehavior_OUTPUT_ONLY) {
+			outputOnly = append(outputOnly, field)
+			return true
+		}
+
+		switch {
+		case field.IsList():
+			if field.Kind() == protoreflect.MessageKind {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					clearOutputOnlyFields(list.Get(i).Message())
+				}
+			}
+		case field.IsMap():
+			if field.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					clearOutputOnlyFields(v.Message())
+					return true
+				})
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			clearOutputOnlyFields(v.Message())
+		}
+		return true
+	})
+
+	// Fields must not be cleared while ranging over the message.
+	for _, field := range outputOnly {
+		m.Clear(field)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	pb "cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestClearOutputOnlyFields(t *testing.T) {
+	got := &pb.SecretVersion{
+		Name:       "projects/my-project/secrets/my-secret/versions/1",
+		CreateTime: timestamppb.Now(),
+		State:      pb.SecretVersion_ENABLED,
+		ReplicationStatus: &pb.ReplicationStatus{
+			ReplicationStatus: &pb.ReplicationStatus_Automatic{
+				Automatic: &pb.ReplicationStatus_AutomaticStatus{
+					CustomerManagedEncryption: &pb.CustomerManagedEncryptionStatus{KmsKeyVersionName: "my-key-version"},
+				},
+			},
+		},
+		Etag: "abc",
+	}
+	ClearOutputOnlyFields(got)
+
+	// Only the (empty) parents of nested output-only fields remain.
+	want := &pb.SecretVersion{
+		ReplicationStatus: &pb.ReplicationStatus{
+			ReplicationStatus: &pb.ReplicationStatus_Automatic{
+				Automatic: &pb.ReplicationStatus_AutomaticStatus{},
+			},
+		},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("unexpected message after clearing output-only fields; got %v, want %v", got, want)
+	}
+}
+
+func TestClearOutputOnlyFieldsKeepsInputs(t *testing.T) {
+	want := &pb.Secret{
+		Labels: map[string]string{"env": "test"},
+		Rotation: &pb.Rotation{
+			NextRotationTime: timestamppb.Now(),
+		},
+		Topics: []*pb.Topic{{Name: "projects/my-project/topics/my-topic"}},
+	}
+	got := proto.Clone(want).(*pb.Secret)
+	got.Name = "projects/my-project/secrets/my-secret"
+	got.CreateTime = timestamppb.Now()
+
+	ClearOutputOnlyFields(got)
+	if !proto.Equal(got, want) {
+		t.Errorf("unexpected message after clearing output-only fields; got %v, want %v", got, want)
+	}
+}
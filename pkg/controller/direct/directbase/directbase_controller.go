@@ -264,6 +264,9 @@ func (r *reconcileContext) doReconcile(ctx context.Context, u *unstructured.Unst
 			logger.Info("deletion defender has not yet finalized; requeuing", "resource", k8s.GetNamespacedName(u))
 			return true, nil
 		}
+		if err := r.handleDeleting(ctx, u); err != nil {
+			return false, err
+		}
 		if !k8s.HasAbandonAnnotation(u) {
 			deleteOp := NewDeleteOperation(r.Reconciler.Client, u)
 			if _, err := adapter.Delete(ctx, deleteOp); err != nil {
@@ -359,6 +362,24 @@ func (r *reconcileContext) handleUpdateFailed(ctx context.Context, policy *unstr
 	return r.Reconciler.HandleUpdateFailed(ctx, resource, origErr)
 }
 
+// handleDeleting marks the object as Ready=False with reason Deleting while the GCP object is torn down.
+// The object is synced with the response from the API server, so that later writes do not conflict.
+func (r *reconcileContext) handleDeleting(ctx context.Context, u *unstructured.Unstructured) error {
+	resource, err := toK8sResource(u)
+	if err != nil {
+		return fmt.Errorf("error converting to k8s resource while handling %v event: %w", k8s.Deleting, err)
+	}
+	if err := r.Reconciler.HandleDeleting(ctx, resource); err != nil {
+		return err
+	}
+	updated, err := resource.MarshalAsUnstructured()
+	if err != nil {
+		return fmt.Errorf("error converting from k8s resource while handling %v event: %w", k8s.Deleting, err)
+	}
+	u.Object = updated.Object
+	return nil
+}
+
 func (r *reconcileContext) handleDeleted(ctx context.Context, policy *unstructured.Unstructured) error {
 	resource, err := toK8sResource(policy)
 	if err != nil {
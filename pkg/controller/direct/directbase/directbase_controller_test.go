@@ -0,0 +1,164 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directbase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	opv1beta1 "github.com/GoogleCloudPlatform/k8s-config-connector/operator/pkg/apis/core/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/lifecyclehandler"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var testGVK = schema.GroupVersionKind{Group: "test.cnrm.cloud.google.com", Version: "v1alpha1", Kind: "TestResource"}
+
+type fakeModel struct {
+	adapter *fakeAdapter
+}
+
+func (m *fakeModel) AdapterForObject(ctx context.Context, reader client.Reader, u *unstructured.Unstructured) (Adapter, error) {
+	return m.adapter, nil
+}
+
+func (m *fakeModel) AdapterForURL(ctx context.Context, url string) (Adapter, error) {
+	return nil, nil
+}
+
+type fakeAdapter struct {
+	// onDelete is invoked while the (simulated) GCP delete is in progress.
+	onDelete func(ctx context.Context) error
+}
+
+func (a *fakeAdapter) Find(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (a *fakeAdapter) Delete(ctx context.Context, op *DeleteOperation) (bool, error) {
+	if a.onDelete != nil {
+		if err := a.onDelete(ctx); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (a *fakeAdapter) Create(ctx context.Context, op *CreateOperation) error {
+	return fmt.Errorf("unexpected call to Create")
+}
+
+func (a *fakeAdapter) Update(ctx context.Context, op *UpdateOperation) error {
+	return fmt.Errorf("unexpected call to Update")
+}
+
+func (a *fakeAdapter) Export(ctx context.Context) (*unstructured.Unstructured, error) {
+	return nil, fmt.Errorf("unexpected call to Export")
+}
+
+func newTestReconcileContext(t *testing.T, model Model, objects ...client.Object) (*reconcileContext, client.Client) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := opv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("error registering scheme: %v", err)
+	}
+	kubeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
+		Build()
+
+	reconciler := &DirectReconciler{
+		LifecycleHandler: lifecyclehandler.NewLifecycleHandler(kubeClient, record.NewFakeRecorder(10)),
+		Client:           kubeClient,
+		gvk:              testGVK,
+		model:            model,
+	}
+	return &reconcileContext{
+		Reconciler:     reconciler,
+		gvk:            testGVK,
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "test"},
+	}, kubeClient
+}
+
+func TestDoReconcileSetsDeletingConditionDuringTeardown(t *testing.T) {
+	ctx := context.Background()
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(testGVK)
+	u.SetNamespace("default")
+	u.SetName("test")
+	u.SetGeneration(2)
+	u.SetFinalizers([]string{k8s.ControllerFinalizerName})
+	now := metav1.Now()
+	u.SetDeletionTimestamp(&now)
+
+	var conditionDuringDelete *unstructured.Unstructured
+	adapter := &fakeAdapter{}
+	runCtx, kubeClient := newTestReconcileContext(t, &fakeModel{adapter: adapter}, u)
+	adapter.onDelete = func(ctx context.Context) error {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(testGVK)
+		if err := kubeClient.Get(ctx, runCtx.NamespacedName, live); err != nil {
+			return fmt.Errorf("getting object during delete: %w", err)
+		}
+		conditionDuringDelete = live
+		return nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(testGVK)
+	if err := kubeClient.Get(ctx, runCtx.NamespacedName, obj); err != nil {
+		t.Fatalf("getting object: %v", err)
+	}
+	if _, err := runCtx.doReconcile(ctx, obj); err != nil {
+		t.Fatalf("doReconcile failed: %v", err)
+	}
+
+	if conditionDuringDelete == nil {
+		t.Fatalf("expected Delete to be called on the adapter")
+	}
+	var status statusWithConditions
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(conditionDuringDelete.Object["status"].(map[string]interface{}), &status); err != nil {
+		t.Fatalf("converting status: %v", err)
+	}
+	if len(status.Conditions) != 1 {
+		t.Fatalf("expected exactly one condition during delete, got %+v", status.Conditions)
+	}
+	got := status.Conditions[0]
+	if got.Type != "Ready" || got.Status != corev1.ConditionFalse || got.Reason != k8s.Deleting {
+		t.Errorf("unexpected condition during delete; got %+v, want Ready=False with reason %q", got, k8s.Deleting)
+	}
+	if got.Message != k8s.DeletingMessage {
+		t.Errorf("unexpected condition message during delete; got %q, want %q", got.Message, k8s.DeletingMessage)
+	}
+
+	// The finalizer should have been removed without a conflict, so the object is gone.
+	if err := kubeClient.Get(ctx, runCtx.NamespacedName, obj); !apierrors.IsNotFound(err) {
+		t.Errorf("expected object to be deleted after teardown, got err=%v", err)
+	}
+}
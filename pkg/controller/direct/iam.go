@@ -17,10 +17,18 @@ package direct
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"cloud.google.com/go/iam/apiv1/iampb"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/apis/iam/v1beta1"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/registry"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -78,40 +86,34 @@ func SetIAMPolicyMember(ctx context.Context, reader client.Reader, want *v1beta1
 		return nil, fmt.Errorf("adapter does not implement IAMAdapter")
 	}
 
-	policy, err := iamAdapter.GetIAMPolicy(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("getting IAM policy: %w", err)
-	}
-
-	var binding *iampb.Binding
-	for _, b := range policy.Bindings {
-		if b.Role != want.Spec.Role {
-			continue
+	latest, err := updateIAMPolicy(ctx, iamAdapter, func(policy *iampb.Policy) {
+		var binding *iampb.Binding
+		for _, b := range policy.Bindings {
+			if b.Role != want.Spec.Role {
+				continue
+			}
+			binding = b
 		}
-		binding = b
-	}
 
-	if binding == nil {
-		binding = &iampb.Binding{
-			Role: want.Spec.Role,
+		if binding == nil {
+			binding = &iampb.Binding{
+				Role: want.Spec.Role,
+			}
+			policy.Bindings = append(policy.Bindings, binding)
 		}
-		policy.Bindings = append(policy.Bindings, binding)
-	}
 
-	hasMember := false
-	for _, member := range binding.Members {
-		if member == string(memberID) {
-			hasMember = true
+		hasMember := false
+		for _, member := range binding.Members {
+			if member == string(memberID) {
+				hasMember = true
+			}
 		}
-	}
-	latest := policy
-	if !hasMember {
-		binding.Members = append(binding.Members, string(memberID))
-		newPolicy, err := iamAdapter.SetIAMPolicy(ctx, policy)
-		if err != nil {
-			return nil, fmt.Errorf("setting IAM policy: %w", err)
+		if !hasMember {
+			binding.Members = append(binding.Members, string(memberID))
 		}
-		latest = newPolicy
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	actual := &v1beta1.IAMPolicyMember{}
@@ -148,42 +150,166 @@ func DeleteIAMPolicyMember(ctx context.Context, reader client.Reader, want *v1be
 		return fmt.Errorf("adapter does not implement IAMAdapter")
 	}
 
-	policy, err := iamAdapter.GetIAMPolicy(ctx)
+	newPolicy, err := updateIAMPolicy(ctx, iamAdapter, func(policy *iampb.Policy) {
+		var binding *iampb.Binding
+		for _, b := range policy.Bindings {
+			if b.Role != want.Spec.Role {
+				continue
+			}
+			binding = b
+		}
+
+		if binding == nil {
+			return
+		}
+
+		var newMembers []string
+		for _, member := range binding.Members {
+			if member == string(removeMember) {
+				continue
+			}
+			newMembers = append(newMembers, member)
+		}
+		binding.Members = newMembers
+	})
 	if err != nil {
-		return fmt.Errorf("getting IAM policy: %w", err)
+		return err
 	}
 
-	var binding *iampb.Binding
-	for _, b := range policy.Bindings {
-		if b.Role != want.Spec.Role {
-			continue
+	log.Info("updated iam policy to remove member", "updatedPolicy", newPolicy, "member", removeMember)
+	return nil
+}
+
+// iamPolicyUpdateBackoff bounds the read-modify-write attempts of updateIAMPolicy; Steps is the number of attempts.
+var iamPolicyUpdateBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// updateIAMPolicy reads the IAM policy, applies mutate to a copy of it, and writes it back if that changed the
+// bindings, returning the latest policy. The policy carries the etag it was read with, so if it is changed
+// concurrently GCP rejects the write as Aborted; we then re-read the policy and re-apply mutate, up to
+// iamPolicyUpdateBackoff.Steps times.
+func updateIAMPolicy(ctx context.Context, iamAdapter IAMAdapter, mutate func(policy *iampb.Policy)) (*iampb.Policy, error) {
+	log := klog.FromContext(ctx)
+
+	var latest *iampb.Policy
+	err := retry.OnError(iamPolicyUpdateBackoff, isIAMPolicyConcurrentChange, func() error {
+		existing, err := iamAdapter.GetIAMPolicy(ctx)
+		if err != nil {
+			return fmt.Errorf("getting IAM policy: %w", err)
 		}
-		binding = b
-	}
+		policy := proto.Clone(existing).(*iampb.Policy)
+		mutate(policy)
 
-	if binding == nil {
+		if added, removed := DiffIAMPolicyBindings(existing, policy); len(added) == 0 && len(removed) == 0 {
+			latest = existing
+			return nil
+		}
+		newPolicy, err := iamAdapter.SetIAMPolicy(ctx, policy)
+		if err != nil {
+			if isIAMPolicyConcurrentChange(err) {
+				log.Info("IAM policy was changed concurrently, retrying", "error", err)
+			}
+			return fmt.Errorf("setting IAM policy: %w", err)
+		}
+		latest = newPolicy
 		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return latest, nil
+}
 
-	var newMembers []string
-	removedMember := false
-	for _, member := range binding.Members {
-		if member == string(removeMember) {
-			removedMember = true
-			continue
-		}
-		newMembers = append(newMembers, member)
+// isIAMPolicyConcurrentChange returns true if err is GCP rejecting a SetIamPolicy because the etag is stale:
+// Aborted over gRPC, or 409 Conflict over HTTP.
+func isIAMPolicyConcurrentChange(err error) bool {
+	if status.Code(err) == codes.Aborted {
+		return true
 	}
-	binding.Members = newMembers
+	return HasHTTPCode(err, 409)
+}
 
-	if !removedMember {
-		return nil
+// DiffIAMPolicyBindings compares the bindings of two IAM policies, and returns the members that are bound in to
+// but not in from (added), and those that are bound in from but not in to (removed). Each returned binding holds
+// only the changed members of one role and condition; bindings are sorted by role and members are sorted.
+// The order of bindings and members, and duplicate members, are ignored, so a policy that GCP returns with its
+// members reordered is not a change. Bindings with different conditions are compared separately.
+func DiffIAMPolicyBindings(from, to *iampb.Policy) (added, removed []*iampb.Binding) {
+	fromMembers := iamBindingMembers(from)
+	toMembers := iamBindingMembers(to)
+	added = diffIAMBindingMembers(toMembers, fromMembers)
+	removed = diffIAMBindingMembers(fromMembers, toMembers)
+	return added, removed
+}
+
+// iamBinding is the set of members bound to a role with a condition.
+type iamBinding struct {
+	role      string
+	condition *expr.Expr
+	members   map[string]bool
+}
+
+// iamBindingMembers indexes the bindings of policy by role and condition.
+func iamBindingMembers(policy *iampb.Policy) map[string]*iamBinding {
+	bindings := make(map[string]*iamBinding)
+	for _, binding := range policy.GetBindings() {
+		key := binding.GetRole()
+		if binding.GetCondition() != nil {
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(binding.GetCondition())
+			if err != nil {
+				// Marshalling a valid expr.Expr cannot fail; fall back to the expression.
+				b = []byte(binding.GetCondition().GetExpression())
+			}
+			key += "\x00" + string(b)
+		}
+		entry := bindings[key]
+		if entry == nil {
+			entry = &iamBinding{
+				role:      binding.GetRole(),
+				condition: binding.GetCondition(),
+				members:   make(map[string]bool),
+			}
+			bindings[key] = entry
+		}
+		for _, member := range binding.GetMembers() {
+			entry.members[member] = true
+		}
 	}
-	newPolicy, err := iamAdapter.SetIAMPolicy(ctx, policy)
-	if err != nil {
-		return fmt.Errorf("setting IAM policy: %w", err)
+	return bindings
+}
+
+// diffIAMBindingMembers returns the members in a that are not in b, as bindings.
+func diffIAMBindingMembers(a, b map[string]*iamBinding) []*iampb.Binding {
+	var keys []string
+	for key := range a {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
-	log.Info("updated iam policy to remove member", "updatedPolicy", newPolicy, "member", removeMember)
-	return nil
+	var diff []*iampb.Binding
+	for _, key := range keys {
+		var members []string
+		for member := range a[key].members {
+			if other := b[key]; other == nil || !other.members[member] {
+				members = append(members, member)
+			}
+		}
+		if len(members) == 0 {
+			continue
+		}
+		sort.Strings(members)
+		binding := &iampb.Binding{
+			Role:    a[key].role,
+			Members: members,
+		}
+		if a[key].condition != nil {
+			binding.Condition = proto.Clone(a[key].condition).(*expr.Expr)
+		}
+		diff = append(diff, binding)
+	}
+	return diff
 }
@@ -0,0 +1,228 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package direct
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDiffIAMPolicyBindings(t *testing.T) {
+	const (
+		encrypter = "roles/cloudkms.cryptoKeyEncrypter"
+		viewer    = "roles/cloudkms.viewer"
+	)
+	expiring := &expr.Expr{Title: "expiring", Expression: `request.time < timestamp("2025-01-01T00:00:00Z")`}
+
+	policy := &iampb.Policy{
+		Bindings: []*iampb.Binding{
+			{Role: encrypter, Members: []string{"user:a@example.com", "serviceAccount:sa@example.com"}},
+			{Role: viewer, Members: []string{"user:b@example.com"}},
+		},
+	}
+
+	grid := []struct {
+		name        string
+		to          *iampb.Policy
+		wantAdded   []*iampb.Binding
+		wantRemoved []*iampb.Binding
+	}{
+		{
+			name: "no change, with bindings and members reordered and duplicated",
+			to: &iampb.Policy{
+				Bindings: []*iampb.Binding{
+					{Role: viewer, Members: []string{"user:b@example.com"}},
+					{Role: encrypter, Members: []string{"serviceAccount:sa@example.com", "user:a@example.com", "user:a@example.com"}},
+				},
+			},
+		},
+		{
+			name: "added member",
+			to: &iampb.Policy{
+				Bindings: []*iampb.Binding{
+					{Role: encrypter, Members: []string{"user:a@example.com", "serviceAccount:sa@example.com"}},
+					{Role: viewer, Members: []string{"user:c@example.com", "user:b@example.com"}},
+				},
+			},
+			wantAdded: []*iampb.Binding{
+				{Role: viewer, Members: []string{"user:c@example.com"}},
+			},
+		},
+		{
+			name: "removed role",
+			to: &iampb.Policy{
+				Bindings: []*iampb.Binding{
+					{Role: viewer, Members: []string{"user:b@example.com"}},
+				},
+			},
+			wantRemoved: []*iampb.Binding{
+				{Role: encrypter, Members: []string{"serviceAccount:sa@example.com", "user:a@example.com"}},
+			},
+		},
+		{
+			name: "member moved to a conditional binding",
+			to: &iampb.Policy{
+				Bindings: []*iampb.Binding{
+					{Role: encrypter, Members: []string{"serviceAccount:sa@example.com"}},
+					{Role: encrypter, Members: []string{"user:a@example.com"}, Condition: expiring},
+					{Role: viewer, Members: []string{"user:b@example.com"}},
+				},
+			},
+			wantAdded: []*iampb.Binding{
+				{Role: encrypter, Members: []string{"user:a@example.com"}, Condition: expiring},
+			},
+			wantRemoved: []*iampb.Binding{
+				{Role: encrypter, Members: []string{"user:a@example.com"}},
+			},
+		},
+		{
+			name: "empty policy",
+			to:   &iampb.Policy{},
+			wantRemoved: []*iampb.Binding{
+				{Role: encrypter, Members: []string{"serviceAccount:sa@example.com", "user:a@example.com"}},
+				{Role: viewer, Members: []string{"user:b@example.com"}},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			added, removed := DiffIAMPolicyBindings(policy, g.to)
+			if !bindingsEqual(added, g.wantAdded) {
+				t.Errorf("unexpected added bindings; got %v, want %v", added, g.wantAdded)
+			}
+			if !bindingsEqual(removed, g.wantRemoved) {
+				t.Errorf("unexpected removed bindings; got %v, want %v", removed, g.wantRemoved)
+			}
+		})
+	}
+}
+
+func bindingsEqual(a, b []*iampb.Binding) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// fakeIAMAdapter holds an IAM policy and, like GCP, rejects writes with a stale etag as Aborted.
+type fakeIAMAdapter struct {
+	policy *iampb.Policy
+	etag   int
+
+	// beforeSet, if set, is called at the start of each SetIAMPolicy, e.g. to inject a concurrent change.
+	beforeSet func(a *fakeIAMAdapter)
+
+	gets, sets int
+}
+
+func (a *fakeIAMAdapter) GetIAMPolicy(ctx context.Context) (*iampb.Policy, error) {
+	a.gets++
+	return proto.Clone(a.policy).(*iampb.Policy), nil
+}
+
+func (a *fakeIAMAdapter) SetIAMPolicy(ctx context.Context, policy *iampb.Policy) (*iampb.Policy, error) {
+	a.sets++
+	if a.beforeSet != nil {
+		a.beforeSet(a)
+	}
+	if !bytes.Equal(policy.GetEtag(), a.policy.GetEtag()) {
+		return nil, status.Errorf(codes.Aborted, "There were concurrent policy changes.")
+	}
+	a.store(policy)
+	return proto.Clone(a.policy).(*iampb.Policy), nil
+}
+
+// store replaces the policy, giving it a new etag.
+func (a *fakeIAMAdapter) store(policy *iampb.Policy) {
+	a.etag++
+	a.policy = proto.Clone(policy).(*iampb.Policy)
+	a.policy.Etag = []byte(strconv.Itoa(a.etag))
+}
+
+func TestUpdateIAMPolicyRetriesConcurrentChanges(t *testing.T) {
+	iamPolicyUpdateBackoff.Duration = time.Millisecond
+	t.Cleanup(func() { iamPolicyUpdateBackoff.Duration = 100 * time.Millisecond })
+
+	const viewer = "roles/cloudkms.viewer"
+	addMember := func(member string) func(*iampb.Policy) {
+		return func(policy *iampb.Policy) {
+			policy.Bindings = append(policy.Bindings, &iampb.Binding{Role: viewer, Members: []string{member}})
+		}
+	}
+
+	t.Run("one conflict", func(t *testing.T) {
+		a := &fakeIAMAdapter{}
+		a.store(&iampb.Policy{})
+		a.beforeSet = func(a *fakeIAMAdapter) {
+			// Another writer changes the policy between our read and our write, once.
+			a.beforeSet = nil
+			a.store(&iampb.Policy{Bindings: []*iampb.Binding{{Role: viewer, Members: []string{"user:other@example.com"}}}})
+		}
+
+		got, err := updateIAMPolicy(context.Background(), a, addMember("user:me@example.com"))
+		if err != nil {
+			t.Fatalf("updateIAMPolicy failed: %v", err)
+		}
+		if a.gets != 2 || a.sets != 2 {
+			t.Errorf("expected the read-modify-write to be retried once; got %d gets and %d sets", a.gets, a.sets)
+		}
+		want := &iampb.Policy{Bindings: []*iampb.Binding{{Role: viewer, Members: []string{"user:me@example.com", "user:other@example.com"}}}}
+		if added, removed := DiffIAMPolicyBindings(want, got); len(added) != 0 || len(removed) != 0 {
+			t.Errorf("retry did not keep the concurrent change; got %v", got.GetBindings())
+		}
+	})
+
+	t.Run("persistent conflicts", func(t *testing.T) {
+		a := &fakeIAMAdapter{}
+		a.store(&iampb.Policy{})
+		a.beforeSet = func(a *fakeIAMAdapter) {
+			a.store(a.policy)
+		}
+
+		_, err := updateIAMPolicy(context.Background(), a, addMember("user:me@example.com"))
+		if status.Code(err) != codes.Aborted {
+			t.Fatalf("expected Aborted after running out of attempts; got %v", err)
+		}
+		if a.sets != iamPolicyUpdateBackoff.Steps {
+			t.Errorf("expected %d attempts, got %d", iamPolicyUpdateBackoff.Steps, a.sets)
+		}
+	})
+
+	t.Run("no change", func(t *testing.T) {
+		a := &fakeIAMAdapter{}
+		a.store(&iampb.Policy{Bindings: []*iampb.Binding{{Role: viewer, Members: []string{"user:me@example.com"}}}})
+
+		if _, err := updateIAMPolicy(context.Background(), a, func(*iampb.Policy) {}); err != nil {
+			t.Fatalf("updateIAMPolicy failed: %v", err)
+		}
+		if a.sets != 0 {
+			t.Errorf("expected no SetIAMPolicy for an unchanged policy, got %d", a.sets)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importjob
+
+import (
+	"encoding/base64"
+	"strings"
+
+	pb "cloud.google.com/go/kms/apiv1/kmspb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	krm "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/kms/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+)
+
+// KMSKeyRingImportJob_CreateRequest builds the request to create the import job in the key ring parent,
+// which must already have been resolved from spec.keyRing or spec.keyRingRef.
+// Only the fields a caller can set are mapped: everything else in an ImportJob is output only.
+func KMSKeyRingImportJob_CreateRequest(mapCtx *direct.MapContext, parent string, in *krm.KMSKeyRingImportJobSpec) *pb.CreateImportJobRequest {
+	if in == nil {
+		return nil
+	}
+	return &pb.CreateImportJobRequest{
+		Parent:      parent,
+		ImportJobId: in.ImportJobId,
+		ImportJob:   KMSKeyRingImportJobSpec_ToProto(mapCtx, in),
+	}
+}
+
+// KMSKeyRingImportJobSpec_ToProto maps the KMSKeyRingImportJob spec to an ImportJob.
+// The name is left unset; the import job is identified by the parent and ImportJobId of the create request.
+func KMSKeyRingImportJobSpec_ToProto(mapCtx *direct.MapContext, in *krm.KMSKeyRingImportJobSpec) *pb.ImportJob {
+	if in == nil {
+		return nil
+	}
+	out := &pb.ImportJob{}
+	out.ImportMethod = direct.Enum_ToProto[pb.ImportJob_ImportMethod](mapCtx, &in.ImportMethod)
+	out.ProtectionLevel = direct.Enum_ToProto[pb.ProtectionLevel](mapCtx, &in.ProtectionLevel)
+	return out
+}
+
+// KMSKeyRingImportJobSpec_FromProto maps an ImportJob to the KMSKeyRingImportJob spec.
+// The import job ID and key ring are taken from the name, the key ring as an external reference.
+func KMSKeyRingImportJobSpec_FromProto(mapCtx *direct.MapContext, in *pb.ImportJob) *krm.KMSKeyRingImportJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := &krm.KMSKeyRingImportJobSpec{}
+	if keyRing, importJobID, ok := strings.Cut(in.GetName(), "/importJobs/"); ok {
+		out.ImportJobId = importJobID
+		out.KeyRingRef = &v1alpha1.ResourceRef{External: keyRing}
+	} else if in.GetName() != "" {
+		mapCtx.Errorf("import job name %q is not valid", in.GetName())
+	}
+	out.ImportMethod = direct.ValueOf(direct.Enum_FromProto(mapCtx, in.GetImportMethod()))
+	out.ProtectionLevel = direct.ValueOf(direct.Enum_FromProto(mapCtx, in.GetProtectionLevel()))
+	return out
+}
+
+// KMSKeyRingImportJobStatus_FromProto maps the output only fields of an ImportJob to the KMSKeyRingImportJob status.
+// The public key and attestation are lists of at most one element, as in the terraform schema the CRD comes from.
+func KMSKeyRingImportJobStatus_FromProto(mapCtx *direct.MapContext, in *pb.ImportJob) *krm.KMSKeyRingImportJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := &krm.KMSKeyRingImportJobStatus{}
+	if in.GetAttestation() != nil {
+		out.Attestation = []krm.KeyringimportjobAttestationStatus{
+			KeyringimportjobAttestationStatus_FromProto(mapCtx, in.GetAttestation()),
+		}
+	}
+	out.ExpireTime = direct.StringTimestamp_FromProto(mapCtx, in.GetExpireTime())
+	out.Name = direct.LazyPtr(in.GetName())
+	if in.GetPublicKey() != nil {
+		out.PublicKey = []krm.KeyringimportjobPublicKeyStatus{
+			{Pem: direct.LazyPtr(in.GetPublicKey().GetPem())},
+		}
+	}
+	out.State = direct.Enum_FromProto(mapCtx, in.GetState())
+	return out
+}
+
+// KeyringimportjobAttestationStatus_FromProto maps an HSM attestation; the content is base64-encoded.
+func KeyringimportjobAttestationStatus_FromProto(mapCtx *direct.MapContext, in *pb.KeyOperationAttestation) krm.KeyringimportjobAttestationStatus {
+	out := krm.KeyringimportjobAttestationStatus{}
+	if len(in.GetContent()) != 0 {
+		out.Content = direct.PtrTo(base64.StdEncoding.EncodeToString(in.GetContent()))
+	}
+	out.Format = direct.Enum_FromProto(mapCtx, in.GetFormat())
+	return out
+}
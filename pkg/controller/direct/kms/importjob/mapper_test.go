@@ -0,0 +1,157 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importjob
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "cloud.google.com/go/kms/apiv1/kmspb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	krm "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/kms/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/test"
+)
+
+const testKeyRing = "projects/my-project/locations/us-central1/keyRings/my-ring"
+
+// TestKMSKeyRingImportJobRoundTrip maps a spec to its create request, fills in the output only fields as GCP
+// would, and maps the result back to the spec and status. The create request and status are compared to
+// testdata/<name>/; set WRITE_GOLDEN_OUTPUT to update them.
+func TestKMSKeyRingImportJobRoundTrip(t *testing.T) {
+	createTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	grid := []struct {
+		name string
+		spec krm.KMSKeyRingImportJobSpec
+		// created fills in the output only fields of the import job, as GCP does.
+		created func(obj *pb.ImportJob)
+	}{
+		{
+			name: "software",
+			spec: krm.KMSKeyRingImportJobSpec{
+				ImportJobId:     "software-job",
+				ImportMethod:    "RSA_OAEP_4096_SHA1_AES_256",
+				ProtectionLevel: "SOFTWARE",
+			},
+			created: func(obj *pb.ImportJob) {
+				obj.State = pb.ImportJob_ACTIVE
+				obj.PublicKey = &pb.ImportJob_WrappingPublicKey{Pem: "-----BEGIN PUBLIC KEY-----\nc29mdHdhcmU=\n-----END PUBLIC KEY-----\n"}
+			},
+		},
+		{
+			name: "hsm",
+			spec: krm.KMSKeyRingImportJobSpec{
+				ImportJobId:     "hsm-job",
+				ImportMethod:    "RSA_OAEP_3072_SHA1_AES_256",
+				ProtectionLevel: "HSM",
+			},
+			created: func(obj *pb.ImportJob) {
+				obj.State = pb.ImportJob_ACTIVE
+				obj.PublicKey = &pb.ImportJob_WrappingPublicKey{Pem: "-----BEGIN PUBLIC KEY-----\naHNt\n-----END PUBLIC KEY-----\n"}
+				obj.Attestation = &pb.KeyOperationAttestation{
+					Format:  pb.KeyOperationAttestation_CAVIUM_V2_COMPRESSED,
+					Content: []byte("attestation for hsm-job"),
+				}
+			},
+		},
+		{
+			name: "pending",
+			spec: krm.KMSKeyRingImportJobSpec{
+				ImportJobId:     "pending-job",
+				ImportMethod:    "RSA_OAEP_3072_SHA1_AES_256",
+				ProtectionLevel: "HSM",
+			},
+			created: func(obj *pb.ImportJob) {
+				obj.State = pb.ImportJob_PENDING_GENERATION
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			mapCtx := &direct.MapContext{}
+			req := KMSKeyRingImportJob_CreateRequest(mapCtx, testKeyRing, &g.spec)
+			if err := mapCtx.Err(); err != nil {
+				t.Fatalf("mapping create request: %v", err)
+			}
+			// Only the import method and protection level can be set when creating an import job.
+			want := &pb.ImportJob{}
+			want.ImportMethod = req.GetImportJob().GetImportMethod()
+			want.ProtectionLevel = req.GetImportJob().GetProtectionLevel()
+			if !proto.Equal(req.GetImportJob(), want) {
+				t.Errorf("create request has output only fields: %v", req.GetImportJob())
+			}
+			test.CompareGoldenFile(t, filepath.Join("testdata", g.name, "create_request.golden.json"), protoJSON(t, req))
+
+			created := proto.Clone(req.GetImportJob()).(*pb.ImportJob)
+			created.Name = req.GetParent() + "/importJobs/" + req.GetImportJobId()
+			created.CreateTime = timestamppb.New(createTime)
+			created.GenerateTime = timestamppb.New(createTime)
+			created.ExpireTime = timestamppb.New(createTime.Add(3 * 24 * time.Hour))
+			g.created(created)
+
+			gotSpec := KMSKeyRingImportJobSpec_FromProto(mapCtx, created)
+			status := KMSKeyRingImportJobStatus_FromProto(mapCtx, created)
+			if err := mapCtx.Err(); err != nil {
+				t.Fatalf("mapping created import job: %v", err)
+			}
+			wantSpec := g.spec
+			wantSpec.KeyRingRef = &v1alpha1.ResourceRef{External: testKeyRing}
+			if !reflect.DeepEqual(gotSpec, &wantSpec) {
+				t.Errorf("spec did not round trip; got %+v, want %+v", gotSpec, &wantSpec)
+			}
+			b, err := json.MarshalIndent(status, "", "  ")
+			if err != nil {
+				t.Fatalf("marshalling status: %v", err)
+			}
+			test.CompareGoldenFile(t, filepath.Join("testdata", g.name, "status.golden.json"), string(b)+"\n")
+		})
+	}
+}
+
+func TestKMSKeyRingImportJobSpecToProtoRejectsUnknownEnums(t *testing.T) {
+	mapCtx := &direct.MapContext{}
+	KMSKeyRingImportJobSpec_ToProto(mapCtx, &krm.KMSKeyRingImportJobSpec{
+		ImportJobId:     "my-job",
+		ImportMethod:    "RSA_OAEP_1024",
+		ProtectionLevel: "HSM",
+	})
+	if mapCtx.Err() == nil {
+		t.Errorf("expected an error for an unknown importMethod")
+	}
+}
+
+// protoJSON formats msg as indented JSON; protojson output is deliberately unstable, so it is reformatted.
+func protoJSON(t *testing.T, msg proto.Message) string {
+	t.Helper()
+
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		t.Fatalf("marshalling %T: %v", msg, err)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, b, "", "  "); err != nil {
+		t.Fatalf("formatting %T: %v", msg, err)
+	}
+	return out.String() + "\n"
+}
@@ -46,3 +46,59 @@ func (m *gcpClient) newProjectMetricsService(ctx context.Context) (*api.Projects
 
 	return api.NewProjectsMetricsService(service), nil
 }
+
+func (m *gcpClient) newLocationsBucketsService(ctx context.Context) (*api.LocationsBucketsService, error) {
+	opts, err := m.config.RESTClientOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := api.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("building service for logging: %w", err)
+	}
+
+	return api.NewLocationsBucketsService(service), nil
+}
+
+func (m *gcpClient) newLocationsBucketsViewsService(ctx context.Context) (*api.LocationsBucketsViewsService, error) {
+	opts, err := m.config.RESTClientOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := api.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("building service for logging: %w", err)
+	}
+
+	return api.NewLocationsBucketsViewsService(service), nil
+}
+
+func (m *gcpClient) newExclusionsService(ctx context.Context) (*api.ExclusionsService, error) {
+	opts, err := m.config.RESTClientOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := api.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("building service for logging: %w", err)
+	}
+
+	return api.NewExclusionsService(service), nil
+}
+
+func (m *gcpClient) newSinksService(ctx context.Context) (*api.SinksService, error) {
+	opts, err := m.config.RESTClientOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := api.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("building service for logging: %w", err)
+	}
+
+	return api.NewSinksService(service), nil
+}
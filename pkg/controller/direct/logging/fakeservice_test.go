@@ -0,0 +1,73 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	api "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+)
+
+const testLogBucketFQN = "projects/my-project/locations/global/buckets/my-bucket"
+
+// recordedRequest is a request received by the fake logging API.
+type recordedRequest struct {
+	method     string
+	path       string
+	updateMask string
+	query      url.Values
+	body       []byte
+}
+
+// newTestLoggingService returns a client for a fake logging API, which replies to each request with the result of reply.
+// Prefer newMockLoggingFixture, which runs against mocklogging, for the resources that the mock supports.
+func newTestLoggingService(t *testing.T, reply func(req recordedRequest) any) (*api.Service, *[]recordedRequest) {
+	t.Helper()
+
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+		req := recordedRequest{
+			method:     r.Method,
+			path:       r.URL.Path,
+			updateMask: r.URL.Query().Get("updateMask"),
+			query:      r.URL.Query(),
+			body:       b,
+		}
+		requests = append(requests, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(reply(req)); err != nil {
+			t.Errorf("writing response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	service, err := api.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("building logging service: %v", err)
+	}
+	return service, &requests
+}
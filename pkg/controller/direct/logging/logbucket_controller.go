@@ -0,0 +1,411 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "google.golang.org/api/logging/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	refs "github.com/GoogleCloudPlatform/k8s-config-connector/apis/refs/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/config"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/registry"
+)
+
+const logBucketCtrlName = "logbucket-controller"
+
+// reservedLogBucketIDs are the buckets that GCP creates for every project, folder, organization and billing account.
+// They cannot be created or deleted, only updated.
+var reservedLogBucketIDs = map[string]bool{
+	"_Default":  true,
+	"_Required": true,
+}
+
+func init() {
+	registry.RegisterModel(v1beta1.LoggingLogBucketGVK, NewLogBucketModel)
+}
+
+func NewLogBucketModel(ctx context.Context, config *config.ControllerConfig) (directbase.Model, error) {
+	return &logBucketModel{config: config}, nil
+}
+
+type logBucketModel struct {
+	config *config.ControllerConfig
+}
+
+// model implements the Model interface.
+var _ directbase.Model = &logBucketModel{}
+
+type logBucketAdapter struct {
+	// parent is the scope of the bucket, e.g. `projects/my-project/locations/global`
+	parent     string
+	resourceID string
+
+	desired      *v1beta1.LoggingLogBucket
+	actual       *api.LogBucket
+	bucketClient *api.LocationsBucketsService
+}
+
+var _ directbase.Adapter = &logBucketAdapter{}
+
+// AdapterForObject implements the Model interface.
+func (m *logBucketModel) AdapterForObject(ctx context.Context, reader client.Reader, u *unstructured.Unstructured) (directbase.Adapter, error) {
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketClient, err := gcpClient.newLocationsBucketsService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &v1beta1.LoggingLogBucket{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+		return nil, fmt.Errorf("error converting to %T: %w", obj, err)
+	}
+
+	resourceID := direct.ValueOf(obj.Spec.ResourceID)
+	if resourceID == "" {
+		resourceID = obj.GetName()
+	}
+	if resourceID == "" {
+		return nil, fmt.Errorf("cannot resolve resource ID")
+	}
+
+	location := obj.Spec.Location
+	if location == "" {
+		return nil, fmt.Errorf("spec.location is required")
+	}
+
+	scope, err := resolveLogBucketScope(ctx, reader, obj, obj.Spec.ProjectRef, obj.Spec.FolderRef, obj.Spec.OrganizationRef, obj.Spec.BillingAccountRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Spec.CmekSettings != nil {
+		kmsKey, err := resolveLogBucketKMSKeyRef(ctx, reader, obj, &obj.Spec.CmekSettings.KmsKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		obj.Spec.CmekSettings.KmsKeyRef = v1alpha1.ResourceRef{External: kmsKey}
+	}
+
+	return &logBucketAdapter{
+		parent:       scope + "/locations/" + location,
+		resourceID:   resourceID,
+		desired:      obj,
+		bucketClient: bucketClient,
+	}, nil
+}
+
+// resolveLogBucketScope returns the project, folder, organization or billing account that a bucket belongs to,
+// e.g. `projects/my-project`. Exactly one of the references must be set.
+func resolveLogBucketScope(ctx context.Context, reader client.Reader, src client.Object, projectRef, folderRef, organizationRef, billingAccountRef *v1alpha1.ResourceRef) (string, error) {
+	var scopes []string
+
+	if projectRef != nil {
+		project, err := refs.ResolveProject(ctx, reader, src, refs.AsProjectRef(projectRef))
+		if err != nil {
+			return "", err
+		}
+		scopes = append(scopes, "projects/"+project.ProjectID)
+	}
+	if folderRef != nil {
+		folder, err := refs.ResolveFolder(ctx, reader, src, refs.AsFolderRef(folderRef))
+		if err != nil {
+			return "", err
+		}
+		scopes = append(scopes, "folders/"+folder.FolderID)
+	}
+	if organizationRef != nil {
+		organization, err := refs.ResolveOrganization(ctx, reader, src, refs.AsOrganizationRef(organizationRef))
+		if err != nil {
+			return "", err
+		}
+		scopes = append(scopes, "organizations/"+organization.OrganizationID)
+	}
+	if billingAccountRef != nil {
+		billingAccount, err := resolveBillingAccountRef(billingAccountRef)
+		if err != nil {
+			return "", err
+		}
+		scopes = append(scopes, "billingAccounts/"+billingAccount)
+	}
+
+	if len(scopes) != 1 {
+		return "", fmt.Errorf("exactly one of [billingAccountRef, folderRef, organizationRef, projectRef] must be specified")
+	}
+	return scopes[0], nil
+}
+
+func resolveBillingAccountRef(ref *v1alpha1.ResourceRef) (string, error) {
+	if ref.External == "" {
+		return "", fmt.Errorf("must specify 'external' in 'billingAccountRef'")
+	}
+	tokens := strings.Split(ref.External, "/")
+	switch {
+	case len(tokens) == 1:
+		return tokens[0], nil
+	case len(tokens) == 2 && tokens[0] == "billingAccounts":
+		return tokens[1], nil
+	}
+	return "", fmt.Errorf("format of 'billingAccountRef.external'=%q was not known (use billingAccounts/<billingAccountID>)", ref.External)
+}
+
+// resolveLogBucketKMSKeyRef returns the name of the KMSCryptoKey that encrypts a bucket,
+// e.g. `projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key`.
+// External keys are passed through as-is and are validated by GCP.
+func resolveLogBucketKMSKeyRef(ctx context.Context, reader client.Reader, src client.Object, ref *v1alpha1.ResourceRef) (string, error) {
+	if ref.External != "" {
+		if ref.Name != "" {
+			return "", fmt.Errorf("cannot specify both name and external on cmekSettings.kmsKeyRef")
+		}
+		return ref.External, nil
+	}
+	kmsKey, err := refs.ResolveKMSCryptoKeyRef(ctx, reader, src, &refs.KMSCryptoKeyRef{Name: ref.Name, Namespace: ref.Namespace})
+	if err != nil {
+		return "", err
+	}
+	return kmsKey.External, nil
+}
+
+func (m *logBucketModel) AdapterForURL(ctx context.Context, url string) (directbase.Adapter, error) {
+	// Format: //logging.googleapis.com/{projects,folders,organizations,billingAccounts}/<id>/locations/<location>/buckets/<id>
+	if !strings.HasPrefix(url, "//logging.googleapis.com/") {
+		return nil, nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(url, "//logging.googleapis.com/"), "/")
+	if len(tokens) != 6 || tokens[2] != "locations" || tokens[4] != "buckets" {
+		return nil, nil
+	}
+	switch tokens[0] {
+	case "projects", "folders", "organizations", "billingAccounts":
+	default:
+		return nil, nil
+	}
+
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketClient, err := gcpClient.newLocationsBucketsService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logBucketAdapter{
+		parent:       strings.Join(tokens[:4], "/"),
+		resourceID:   tokens[5],
+		bucketClient: bucketClient,
+	}, nil
+}
+
+func (a *logBucketAdapter) Find(ctx context.Context) (bool, error) {
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	bucket, err := a.bucketClient.Get(a.fullyQualifiedName()).Context(ctx).Do()
+	if err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting logBucket %q: %w", a.fullyQualifiedName(), err)
+	}
+	// Deleted buckets can still be read (and undeleted) for 7 days, but for us they are gone.
+	if bucket.LifecycleState == "DELETE_REQUESTED" {
+		return false, nil
+	}
+
+	a.actual = bucket
+
+	return true, nil
+}
+
+// Delete implements the Adapter interface.
+func (a *logBucketAdapter) Delete(ctx context.Context, deleteOp *directbase.DeleteOperation) (bool, error) {
+	log := klog.FromContext(ctx).WithName(logBucketCtrlName)
+
+	// Already deleted
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	if reservedLogBucketIDs[a.resourceID] {
+		log.Info("not deleting reserved logBucket, it will be left in place", "name", a.fullyQualifiedName())
+		return false, nil
+	}
+
+	if _, err := a.bucketClient.Delete(a.fullyQualifiedName()).Context(ctx).Do(); err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("deleting logBucket %s: %w", a.fullyQualifiedName(), err)
+	}
+
+	return true, nil
+}
+
+func (a *logBucketAdapter) Create(ctx context.Context, createOp *directbase.CreateOperation) error {
+	u := createOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logBucketCtrlName)
+	log.V(2).Info("creating object", "u", u)
+
+	if reservedLogBucketIDs[a.resourceID] {
+		return fmt.Errorf("logBucket %q is created by GCP and cannot be created, it can only be acquired", a.fullyQualifiedName())
+	}
+
+	mapCtx := &direct.MapContext{}
+	bucket := LogBucketSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+
+	created, err := a.bucketClient.Create(a.parent, bucket).BucketId(a.resourceID).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("logBucket %s creation failed: %w", a.fullyQualifiedName(), err)
+	}
+
+	log.V(2).Info("created logBucket", "logBucket", created)
+
+	if err := unstructured.SetNestedField(u.Object, a.resourceID, "spec", "resourceID"); err != nil {
+		return fmt.Errorf("setting spec.resourceID: %w", err)
+	}
+
+	status := LogBucketStatus_FromProto(mapCtx, created)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logBucketAdapter) Update(ctx context.Context, updateOp *directbase.UpdateOperation) error {
+	u := updateOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logBucketCtrlName)
+
+	mapCtx := &direct.MapContext{}
+	desired := LogBucketSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+
+	// Fields that are unset in the spec are left as they are in GCP.
+	var updateMask []string
+	if a.desired.Spec.Description != nil && desired.Description != a.actual.Description {
+		updateMask = append(updateMask, "description")
+	}
+	if a.desired.Spec.RetentionDays != nil && desired.RetentionDays != a.actual.RetentionDays {
+		updateMask = append(updateMask, "retention_days")
+	}
+	if a.desired.Spec.Locked != nil && desired.Locked != a.actual.Locked {
+		updateMask = append(updateMask, "locked")
+	}
+	if a.desired.Spec.EnableAnalytics != nil && desired.AnalyticsEnabled != a.actual.AnalyticsEnabled {
+		updateMask = append(updateMask, "analytics_enabled")
+	}
+	if a.desired.Spec.CmekSettings != nil && (a.actual.CmekSettings == nil || desired.CmekSettings.KmsKeyName != a.actual.CmekSettings.KmsKeyName) {
+		updateMask = append(updateMask, "cmek_settings")
+	}
+	if a.desired.Spec.IndexConfigs != nil && !indexConfigsEqual(desired.IndexConfigs, a.actual.IndexConfigs) {
+		updateMask = append(updateMask, "index_configs")
+	}
+
+	latest := a.actual
+	if len(updateMask) != 0 {
+		log.Info("updating logBucket", "name", a.fullyQualifiedName(), "updateMask", updateMask)
+
+		updated, err := a.bucketClient.Patch(a.fullyQualifiedName(), desired).UpdateMask(strings.Join(updateMask, ",")).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("logBucket %s update failed: %w", a.fullyQualifiedName(), err)
+		}
+		latest = updated
+	}
+
+	status := LogBucketStatus_FromProto(mapCtx, latest)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+// indexConfigsEqual compares the indexed fields and their types, ignoring the output-only createTime.
+func indexConfigsEqual(desired, actual []*api.IndexConfig) bool {
+	if len(desired) != len(actual) {
+		return false
+	}
+	for i := range desired {
+		if desired[i].FieldPath != actual[i].FieldPath || desired[i].Type != actual[i].Type {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *logBucketAdapter) Export(ctx context.Context) (*unstructured.Unstructured, error) {
+	if a.actual == nil {
+		return nil, fmt.Errorf("logBucket %q not found", a.fullyQualifiedName())
+	}
+
+	mapCtx := &direct.MapContext{}
+	obj := &v1beta1.LoggingLogBucket{}
+	obj.Spec = direct.ValueOf(LogBucketSpec_FromProto(mapCtx, a.actual))
+	if mapCtx.Err() != nil {
+		return nil, mapCtx.Err()
+	}
+
+	tokens := strings.Split(a.parent, "/")
+	ref := &v1alpha1.ResourceRef{External: tokens[0] + "/" + tokens[1]}
+	switch tokens[0] {
+	case "projects":
+		obj.Spec.ProjectRef = ref
+	case "folders":
+		obj.Spec.FolderRef = ref
+	case "organizations":
+		obj.Spec.OrganizationRef = ref
+	case "billingAccounts":
+		obj.Spec.BillingAccountRef = ref
+	}
+	obj.Spec.Location = tokens[3]
+	obj.Spec.ResourceID = direct.LazyPtr(a.resourceID)
+
+	uObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting logBucket to unstructured %w", err)
+	}
+
+	u := &unstructured.Unstructured{Object: uObj}
+	u.SetGroupVersionKind(v1beta1.LoggingLogBucketGVK)
+	u.SetName(a.resourceID)
+	return u, nil
+}
+
+func (a *logBucketAdapter) fullyQualifiedName() string {
+	return a.parent + "/buckets/" + a.resourceID
+}
@@ -0,0 +1,350 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/lifecyclehandler"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+)
+
+const (
+	testKMSKeyName      = "projects/" + fixtureProjectID + "/locations/global/keyRings/my-ring/cryptoKeys/my-key"
+	testOtherKMSKeyName = "projects/" + fixtureProjectID + "/locations/global/keyRings/my-ring/cryptoKeys/other-key"
+)
+
+// newTestLogBucket returns a LoggingLogBucket in the fixture project, in the global location.
+func newTestLogBucket(name string, spec map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "logging.cnrm.cloud.google.com/v1beta1",
+		"kind":       "LoggingLogBucket",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"projectRef": map[string]interface{}{"external": "projects/" + fixtureProjectID},
+			"location":   "global",
+		},
+	}}
+	for k, v := range spec {
+		u.Object["spec"].(map[string]interface{})[k] = v
+	}
+	return u
+}
+
+// findLogBucket builds the adapter for u against the mock, and reads the bucket from it.
+func (f *mockLoggingFixture) findLogBucket(u *unstructured.Unstructured) (*logBucketAdapter, bool) {
+	f.t.Helper()
+
+	model, err := NewLogBucketModel(f.ctx, f.config)
+	if err != nil {
+		f.t.Fatalf("building model: %v", err)
+	}
+	adapter, err := model.AdapterForObject(f.ctx, f.kubeClient, u)
+	if err != nil {
+		f.t.Fatalf("building adapter: %v", err)
+	}
+	found, err := adapter.Find(f.ctx)
+	if err != nil {
+		f.t.Fatalf("Find failed: %v", err)
+	}
+	return adapter.(*logBucketAdapter), found
+}
+
+// createLogBucket creates the bucket for u in the mock.
+func (f *mockLoggingFixture) createLogBucket(u *unstructured.Unstructured) {
+	f.t.Helper()
+
+	a, found := f.findLogBucket(u)
+	if found {
+		f.t.Fatalf("bucket %q already exists", a.fullyQualifiedName())
+	}
+	if err := a.Create(f.ctx, directbase.NewCreateOperation(nil, u)); err != nil {
+		f.t.Fatalf("Create failed: %v", err)
+	}
+}
+
+// updateMasks returns the updateMask of each PATCH sent to the mock since the last call.
+func (f *mockLoggingFixture) updateMasks() []string {
+	f.t.Helper()
+
+	var masks []string
+	for _, event := range f.events.HTTPEvents {
+		if event.Request.Method != http.MethodPatch {
+			continue
+		}
+		u, err := url.Parse(event.Request.URL)
+		if err != nil {
+			f.t.Fatalf("parsing request URL %q: %v", event.Request.URL, err)
+		}
+		masks = append(masks, u.Query().Get("updateMask"))
+	}
+	f.events.HTTPEvents = nil
+	return masks
+}
+
+func wantUpdateMasks(t *testing.T, got []string, want ...string) {
+	t.Helper()
+
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("unexpected update masks; got %q, want %q", got, want)
+	}
+}
+
+func TestLogBucketUpdatePatchesRetentionDays(t *testing.T) {
+	f := newMockLoggingFixture(t)
+	f.createLogBucket(newTestLogBucket("my-bucket", map[string]interface{}{
+		"description":   "my bucket",
+		"retentionDays": int64(30),
+	}))
+	f.updateMasks()
+
+	u := newTestLogBucket("my-bucket", map[string]interface{}{
+		"description":   "my bucket",
+		"retentionDays": int64(60),
+	})
+	a, found := f.findLogBucket(u)
+	if !found {
+		t.Fatalf("bucket %q not found", a.fullyQualifiedName())
+	}
+	if err := a.Update(f.ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	wantUpdateMasks(t, f.updateMasks(), "retention_days")
+
+	if a, _ := f.findLogBucket(u); a.actual.RetentionDays != 60 || a.actual.Description != "my bucket" {
+		t.Errorf("unexpected bucket after update: %+v", a.actual)
+	}
+	if state, _, _ := unstructured.NestedString(u.Object, "status", "lifecycleState"); state != "ACTIVE" {
+		t.Errorf("unexpected status.lifecycleState %q", state)
+	}
+}
+
+func TestLogBucketUpdatePatchesCmekSettingsAndIndexConfigs(t *testing.T) {
+	f := newMockLoggingFixture(t)
+	f.createLogBucket(newTestLogBucket("my-bucket", map[string]interface{}{
+		"cmekSettings": map[string]interface{}{
+			"kmsKeyRef": map[string]interface{}{"external": testKMSKeyName},
+		},
+		"indexConfigs": []interface{}{
+			map[string]interface{}{"fieldPath": "jsonPayload.request.status", "type": "INDEX_TYPE_INTEGER"},
+		},
+	}))
+	f.updateMasks()
+
+	u := newTestLogBucket("my-bucket", map[string]interface{}{
+		"cmekSettings": map[string]interface{}{
+			"kmsKeyRef": map[string]interface{}{"external": testOtherKMSKeyName},
+		},
+		"indexConfigs": []interface{}{
+			map[string]interface{}{"fieldPath": "jsonPayload.request.status", "type": "INDEX_TYPE_INTEGER"},
+			map[string]interface{}{"fieldPath": "resource.labels.pod_name", "type": "INDEX_TYPE_STRING"},
+		},
+	})
+	a, found := f.findLogBucket(u)
+	if !found {
+		t.Fatalf("bucket %q not found", a.fullyQualifiedName())
+	}
+	if err := a.Update(f.ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	wantUpdateMasks(t, f.updateMasks(), "cmek_settings,index_configs")
+
+	a, _ = f.findLogBucket(u)
+	if got := a.actual.CmekSettings; got == nil || got.KmsKeyName != testOtherKMSKeyName {
+		t.Errorf("unexpected cmekSettings after update: %+v", got)
+	}
+	if got := a.actual.IndexConfigs; len(got) != 2 || got[1].FieldPath != "resource.labels.pod_name" || got[1].Type != "INDEX_TYPE_STRING" {
+		t.Errorf("unexpected indexConfigs after update: %+v", got)
+	}
+}
+
+func TestLogBucketUpdateWithoutChanges(t *testing.T) {
+	f := newMockLoggingFixture(t)
+	// The index configs come back with a createTime, which is not part of the spec.
+	u := newTestLogBucket("my-bucket", map[string]interface{}{
+		"retentionDays": int64(30),
+		"cmekSettings": map[string]interface{}{
+			"kmsKeyRef": map[string]interface{}{"external": testKMSKeyName},
+		},
+		"indexConfigs": []interface{}{
+			map[string]interface{}{"fieldPath": "jsonPayload.request.status", "type": "INDEX_TYPE_INTEGER"},
+		},
+	})
+	f.createLogBucket(u.DeepCopy())
+	f.updateMasks()
+
+	a, found := f.findLogBucket(u)
+	if !found {
+		t.Fatalf("bucket %q not found", a.fullyQualifiedName())
+	}
+	if err := a.Update(f.ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	wantUpdateMasks(t, f.updateMasks())
+}
+
+// TestLogBucketUpdateReservedBuckets acquires the buckets that GCP creates for every project: the _Default bucket
+// can be changed, but the _Required bucket is locked, so its retention cannot be.
+func TestLogBucketUpdateReservedBuckets(t *testing.T) {
+	for _, tc := range []struct {
+		resourceID string
+		wantErr    bool
+	}{
+		{resourceID: "_Default"},
+		{resourceID: "_Required", wantErr: true},
+	} {
+		t.Run(tc.resourceID, func(t *testing.T) {
+			f := newMockLoggingFixture(t)
+			u := newTestLogBucket(strings.ToLower(strings.TrimPrefix(tc.resourceID, "_")), map[string]interface{}{
+				"resourceID":    tc.resourceID,
+				"retentionDays": int64(90),
+			})
+			a, found := f.findLogBucket(u)
+			if !found {
+				t.Fatalf("reserved bucket %q not found", a.fullyQualifiedName())
+			}
+			err := a.Update(f.ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u))
+			wantUpdateMasks(t, f.updateMasks(), "retention_days")
+			if tc.wantErr {
+				// FailedPrecondition is returned as a 400.
+				if !direct.IsBadRequest(err) {
+					t.Errorf("expected the update of %s to be rejected, got error %v", tc.resourceID, err)
+				}
+			} else if err != nil {
+				t.Errorf("Update failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestLogBucketDeleteSkipsReservedBuckets(t *testing.T) {
+	for _, resourceID := range []string{"_Default", "_Required"} {
+		t.Run(resourceID, func(t *testing.T) {
+			f := newMockLoggingFixture(t)
+			u := newTestLogBucket(strings.ToLower(strings.TrimPrefix(resourceID, "_")), map[string]interface{}{"resourceID": resourceID})
+			a, found := f.findLogBucket(u)
+			if !found {
+				t.Fatalf("reserved bucket %q not found", a.fullyQualifiedName())
+			}
+			if err := a.Create(f.ctx, directbase.NewCreateOperation(nil, u)); err == nil {
+				t.Errorf("expected reserved bucket %q not to be created", resourceID)
+			}
+
+			f.events.HTTPEvents = nil
+			deleted, err := a.Delete(f.ctx, directbase.NewDeleteOperation(nil, nil))
+			if err != nil {
+				t.Fatalf("Delete failed: %v", err)
+			}
+			if deleted || len(f.events.HTTPEvents) != 0 {
+				t.Errorf("expected %s not to be deleted, got deleted=%v with %d requests", resourceID, deleted, len(f.events.HTTPEvents))
+			}
+			if _, found := f.findLogBucket(u); !found {
+				t.Errorf("reserved bucket %q should still exist", resourceID)
+			}
+		})
+	}
+}
+
+func TestLogBucketFindIgnoresDeletedBuckets(t *testing.T) {
+	f := newMockLoggingFixture(t)
+	u := newTestLogBucket("my-bucket", nil)
+	f.createLogBucket(u)
+
+	a, found := f.findLogBucket(u)
+	if !found {
+		t.Fatalf("bucket %q not found", a.fullyQualifiedName())
+	}
+	if deleted, err := a.Delete(f.ctx, directbase.NewDeleteOperation(nil, nil)); err != nil || !deleted {
+		t.Fatalf("Delete failed; got deleted=%v, error %v", deleted, err)
+	}
+
+	// GCP keeps deleted buckets for 7 days, so the bucket can still be read...
+	bucket, err := a.bucketClient.Get(a.fullyQualifiedName()).Context(f.ctx).Do()
+	if err != nil {
+		t.Fatalf("reading deleted bucket: %v", err)
+	}
+	if bucket.LifecycleState != "DELETE_REQUESTED" {
+		t.Errorf("unexpected lifecycleState %q of deleted bucket", bucket.LifecycleState)
+	}
+	// ... but it is gone for us.
+	if _, found := f.findLogBucket(u); found {
+		t.Errorf("expected deleted bucket %q not to be found", a.fullyQualifiedName())
+	}
+}
+
+// TestLogBucketReconcileSetsReadyCondition checks the Ready condition that the direct reconciler sets for a bucket:
+// Ready=True once it is created, and Ready=False with the error when GCP rejects the create.
+func TestLogBucketReconcileSetsReadyCondition(t *testing.T) {
+	indexConfig := map[string]interface{}{"fieldPath": "jsonPayload.request.status", "type": "INDEX_TYPE_INTEGER"}
+
+	tests := []struct {
+		name         string
+		indexConfigs []interface{}
+		wantErr      bool
+		wantStatus   string
+		wantReason   string
+		wantMessage  string
+	}{
+		{
+			name:         "created",
+			indexConfigs: []interface{}{indexConfig},
+			wantStatus:   "True",
+			wantReason:   k8s.UpToDate,
+			wantMessage:  k8s.UpToDateMessage,
+		},
+		{
+			// A field can only be indexed once, so GCP rejects the bucket.
+			name:         "create error",
+			indexConfigs: []interface{}{indexConfig, indexConfig},
+			wantErr:      true,
+			wantStatus:   "False",
+			wantReason:   k8s.UpdateFailed,
+			wantMessage:  "logBucket projects/" + fixtureProjectID + "/locations/global/buckets/my-bucket creation failed",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newMockLoggingFixture(t)
+
+			u := newTestLogBucket("my-bucket", map[string]interface{}{"indexConfigs": tc.indexConfigs})
+			reconciler := f.newReconciler(NewLogBucketModel, u)
+			if err := f.kubeClient.Create(f.ctx, u); err != nil {
+				t.Fatalf("error creating bucket: %v", err)
+			}
+
+			err := f.reconcile(reconciler, u)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error from reconcile; got %v, want error: %v", err, tc.wantErr)
+			}
+			status, reason, message := readyCondition(u)
+			if status != tc.wantStatus || reason != tc.wantReason {
+				t.Errorf("unexpected Ready condition; got status %q with reason %q, want status %q with reason %q", status, reason, tc.wantStatus, tc.wantReason)
+			}
+			if !strings.Contains(message, tc.wantMessage) {
+				t.Errorf("unexpected Ready condition message %q, want it to contain %q", message, tc.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	api "google.golang.org/api/logging/v2"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+)
+
+// LogBucketSpec_FromProto maps the mutable fields of a LogBucket to the LoggingLogBucket spec.
+// The parent reference, location and resourceID are not part of the LogBucket body and are left unset.
+func LogBucketSpec_FromProto(mapCtx *direct.MapContext, in *api.LogBucket) *v1beta1.LoggingLogBucketSpec {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogBucketSpec{}
+	out.Description = direct.LazyPtr(in.Description)
+	out.RetentionDays = direct.LazyPtr(in.RetentionDays)
+	out.Locked = direct.LazyPtr(in.Locked)
+	out.EnableAnalytics = direct.LazyPtr(in.AnalyticsEnabled)
+	out.CmekSettings = LogBucketCmekSettings_FromProto(mapCtx, in.CmekSettings)
+	out.IndexConfigs = direct.Slice_FromProto(mapCtx, in.IndexConfigs, LogBucketIndexConfig_FromProto)
+	return out
+}
+
+func LogBucketSpec_ToProto(mapCtx *direct.MapContext, in *v1beta1.LoggingLogBucketSpec) *api.LogBucket {
+	if in == nil {
+		return nil
+	}
+	out := &api.LogBucket{}
+	out.Description = direct.ValueOf(in.Description)
+	out.RetentionDays = direct.ValueOf(in.RetentionDays)
+	out.Locked = direct.ValueOf(in.Locked)
+	out.AnalyticsEnabled = direct.ValueOf(in.EnableAnalytics)
+	out.CmekSettings = LogBucketCmekSettings_ToProto(mapCtx, in.CmekSettings)
+	out.IndexConfigs = direct.Slice_ToProto(mapCtx, in.IndexConfigs, LogBucketIndexConfig_ToProto)
+	return out
+}
+
+// LogBucketCmekSettings_FromProto maps the key of the CMEK settings; the key version and service account are output-only.
+func LogBucketCmekSettings_FromProto(mapCtx *direct.MapContext, in *api.CmekSettings) *v1beta1.LogbucketCmekSettings {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LogbucketCmekSettings{}
+	out.KmsKeyRef = v1alpha1.ResourceRef{External: in.KmsKeyName}
+	return out
+}
+
+// LogBucketCmekSettings_ToProto expects kmsKeyRef to have been resolved to its external form.
+func LogBucketCmekSettings_ToProto(mapCtx *direct.MapContext, in *v1beta1.LogbucketCmekSettings) *api.CmekSettings {
+	if in == nil {
+		return nil
+	}
+	if in.KmsKeyRef.External == "" {
+		mapCtx.Errorf("cmekSettings.kmsKeyRef was not resolved")
+	}
+	out := &api.CmekSettings{}
+	out.KmsKeyName = in.KmsKeyRef.External
+	return out
+}
+
+func LogBucketIndexConfig_FromProto(mapCtx *direct.MapContext, in *api.IndexConfig) *v1beta1.LogbucketIndexConfigs {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LogbucketIndexConfigs{}
+	out.FieldPath = in.FieldPath
+	out.Type = in.Type
+	return out
+}
+
+func LogBucketIndexConfig_ToProto(mapCtx *direct.MapContext, in *v1beta1.LogbucketIndexConfigs) *api.IndexConfig {
+	if in == nil {
+		return nil
+	}
+	out := &api.IndexConfig{}
+	out.FieldPath = in.FieldPath
+	out.Type = in.Type
+	return out
+}
+
+func LogBucketStatus_FromProto(mapCtx *direct.MapContext, in *api.LogBucket) *v1beta1.LoggingLogBucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogBucketStatus{}
+	out.CreateTime = direct.LazyPtr(in.CreateTime)
+	out.UpdateTime = direct.LazyPtr(in.UpdateTime)
+	out.LifecycleState = direct.LazyPtr(in.LifecycleState)
+	return out
+}
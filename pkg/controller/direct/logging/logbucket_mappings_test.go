@@ -0,0 +1,172 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "google.golang.org/api/logging/v2"
+
+	pb "github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/generated/mockgcp/logging/v2"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+)
+
+func TestLogBucketSpecRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *v1beta1.LoggingLogBucketSpec
+	}{
+		{
+			name: "all fields",
+			spec: &v1beta1.LoggingLogBucketSpec{
+				Description:     direct.PtrTo("my bucket"),
+				RetentionDays:   direct.PtrTo(int64(45)),
+				Locked:          direct.PtrTo(true),
+				EnableAnalytics: direct.PtrTo(true),
+				CmekSettings: &v1beta1.LogbucketCmekSettings{
+					KmsKeyRef: v1alpha1.ResourceRef{External: "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key"},
+				},
+				IndexConfigs: []v1beta1.LogbucketIndexConfigs{
+					{FieldPath: "jsonPayload.request.status", Type: "INDEX_TYPE_INTEGER"},
+					{FieldPath: "resource.labels.pod_name", Type: "INDEX_TYPE_STRING"},
+				},
+			},
+		},
+		{
+			name: "empty indexConfigs",
+			spec: &v1beta1.LoggingLogBucketSpec{
+				IndexConfigs: []v1beta1.LogbucketIndexConfigs{},
+			},
+		},
+		{
+			name: "empty",
+			spec: &v1beta1.LoggingLogBucketSpec{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapCtx := &direct.MapContext{}
+			got := LogBucketSpec_FromProto(mapCtx, LogBucketSpec_ToProto(mapCtx, tc.spec))
+			if mapCtx.Err() != nil {
+				t.Fatalf("mapping failed: %v", mapCtx.Err())
+			}
+			if diff := cmp.Diff(tc.spec, got); diff != "" {
+				t.Errorf("spec did not round-trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestLogBucketSpec_FromProtoIgnoresOutputFields checks that the output-only fields of the CMEK settings and
+// index configs are dropped, so that they are not reported as a diff against the spec.
+func TestLogBucketSpec_FromProtoIgnoresOutputFields(t *testing.T) {
+	mapCtx := &direct.MapContext{}
+	got := LogBucketSpec_FromProto(mapCtx, &api.LogBucket{
+		CmekSettings: &api.CmekSettings{
+			KmsKeyName:        "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key",
+			KmsKeyVersionName: "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key/cryptoKeyVersions/1",
+			ServiceAccountId:  "service-123@gcp-sa-logging.iam.gserviceaccount.com",
+		},
+		IndexConfigs: []*api.IndexConfig{
+			{FieldPath: "jsonPayload.request.status", Type: "INDEX_TYPE_INTEGER", CreateTime: "2024-01-02T03:04:05Z"},
+		},
+	})
+	if mapCtx.Err() != nil {
+		t.Fatalf("mapping failed: %v", mapCtx.Err())
+	}
+	want := &v1beta1.LoggingLogBucketSpec{
+		CmekSettings: &v1beta1.LogbucketCmekSettings{
+			KmsKeyRef: v1alpha1.ResourceRef{External: "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key"},
+		},
+		IndexConfigs: []v1beta1.LogbucketIndexConfigs{
+			{FieldPath: "jsonPayload.request.status", Type: "INDEX_TYPE_INTEGER"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected spec (-want +got):\n%s", diff)
+	}
+}
+
+func TestLogBucketCmekSettings_ToProtoUnresolved(t *testing.T) {
+	mapCtx := &direct.MapContext{}
+	LogBucketCmekSettings_ToProto(mapCtx, &v1beta1.LogbucketCmekSettings{KmsKeyRef: v1alpha1.ResourceRef{Name: "my-key"}})
+	if mapCtx.Err() == nil {
+		t.Fatalf("expected an error for an unresolved kmsKeyRef")
+	}
+}
+
+func TestLogBucketStatus_FromProto(t *testing.T) {
+	mapCtx := &direct.MapContext{}
+	got := LogBucketStatus_FromProto(mapCtx, &api.LogBucket{
+		CreateTime:     "2024-01-02T03:04:05Z",
+		LifecycleState: "ACTIVE",
+	})
+	want := &v1beta1.LoggingLogBucketStatus{
+		CreateTime:     direct.PtrTo("2024-01-02T03:04:05Z"),
+		LifecycleState: direct.PtrTo("ACTIVE"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected status (-want +got):\n%s", diff)
+	}
+}
+
+// knownLifecycleStates are the lifecycle states that we have checked are reported correctly in
+// status.lifecycleState. The REST API carries the state as a string, which we copy unchanged.
+var knownLifecycleStates = map[string]bool{
+	"ACTIVE":           true,
+	"DELETE_REQUESTED": true,
+	"UPDATING":         true,
+	"CREATING":         true,
+	"FAILED":           true,
+}
+
+// TestLifecycleStateMapping enumerates the proto LifecycleState values, so that a value added when the protos
+// are regenerated fails here until it has been added to knownLifecycleStates.
+func TestLifecycleStateMapping(t *testing.T) {
+	values := pb.LifecycleState(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		state := pb.LifecycleState(values.Get(i).Number())
+		t.Run(state.String(), func(t *testing.T) {
+			mapCtx := &direct.MapContext{}
+			krmState := direct.Enum_FromProto(mapCtx, state)
+			back := direct.Enum_ToProto[pb.LifecycleState](mapCtx, krmState)
+			if err := mapCtx.Err(); err != nil {
+				t.Fatalf("mapping lifecycle state %v: %v", state, err)
+			}
+			if back != state {
+				t.Errorf("lifecycle state did not round-trip; got %v, want %v", back, state)
+			}
+
+			status := LogBucketStatus_FromProto(mapCtx, &api.LogBucket{LifecycleState: direct.ValueOf(krmState)})
+			if !cmp.Equal(status.LifecycleState, krmState) {
+				t.Errorf("unexpected status.lifecycleState; got %v, want %v", direct.ValueOf(status.LifecycleState), direct.ValueOf(krmState))
+			}
+
+			if state == pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
+				// The unspecified state is omitted from the status, rather than reported.
+				if krmState != nil || status.LifecycleState != nil {
+					t.Errorf("unspecified lifecycle state should be omitted; got %q in status", direct.ValueOf(status.LifecycleState))
+				}
+				return
+			}
+			if !knownLifecycleStates[direct.ValueOf(krmState)] {
+				t.Errorf("lifecycle state %q is not in knownLifecycleStates; check how it should be reported and add it", direct.ValueOf(krmState))
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "google.golang.org/api/logging/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/config"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/registry"
+)
+
+const logExclusionCtrlName = "logexclusion-controller"
+
+func init() {
+	registry.RegisterModel(v1beta1.LoggingLogExclusionGVK, NewLogExclusionModel)
+}
+
+func NewLogExclusionModel(ctx context.Context, config *config.ControllerConfig) (directbase.Model, error) {
+	return &logExclusionModel{config: config}, nil
+}
+
+type logExclusionModel struct {
+	config *config.ControllerConfig
+}
+
+// model implements the Model interface.
+var _ directbase.Model = &logExclusionModel{}
+
+type logExclusionAdapter struct {
+	// parent is the scope of the exclusion, e.g. `projects/my-project`
+	parent     string
+	resourceID string
+
+	desired         *v1beta1.LoggingLogExclusion
+	actual          *api.LogExclusion
+	exclusionClient *api.ExclusionsService
+}
+
+var _ directbase.Adapter = &logExclusionAdapter{}
+
+// AdapterForObject implements the Model interface.
+func (m *logExclusionModel) AdapterForObject(ctx context.Context, reader client.Reader, u *unstructured.Unstructured) (directbase.Adapter, error) {
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	exclusionClient, err := gcpClient.newExclusionsService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &v1beta1.LoggingLogExclusion{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+		return nil, fmt.Errorf("error converting to %T: %w", obj, err)
+	}
+
+	resourceID := direct.ValueOf(obj.Spec.ResourceID)
+	if resourceID == "" {
+		resourceID = obj.GetName()
+	}
+	if resourceID == "" {
+		return nil, fmt.Errorf("cannot resolve resource ID")
+	}
+
+	scope, err := resolveLogBucketScope(ctx, reader, obj, obj.Spec.ProjectRef, obj.Spec.FolderRef, obj.Spec.OrganizationRef, obj.Spec.BillingAccountRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logExclusionAdapter{
+		parent:          scope,
+		resourceID:      resourceID,
+		desired:         obj,
+		exclusionClient: exclusionClient,
+	}, nil
+}
+
+func (m *logExclusionModel) AdapterForURL(ctx context.Context, url string) (directbase.Adapter, error) {
+	// Format: //logging.googleapis.com/{projects,folders,organizations,billingAccounts}/<id>/exclusions/<id>
+	if !strings.HasPrefix(url, "//logging.googleapis.com/") {
+		return nil, nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(url, "//logging.googleapis.com/"), "/")
+	if len(tokens) != 4 || tokens[2] != "exclusions" {
+		return nil, nil
+	}
+	switch tokens[0] {
+	case "projects", "folders", "organizations", "billingAccounts":
+	default:
+		return nil, nil
+	}
+
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	exclusionClient, err := gcpClient.newExclusionsService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logExclusionAdapter{
+		parent:          strings.Join(tokens[:2], "/"),
+		resourceID:      tokens[3],
+		exclusionClient: exclusionClient,
+	}, nil
+}
+
+func (a *logExclusionAdapter) Find(ctx context.Context) (bool, error) {
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	exclusion, err := a.exclusionClient.Get(a.fullyQualifiedName()).Context(ctx).Do()
+	if err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting logExclusion %q: %w", a.fullyQualifiedName(), err)
+	}
+
+	a.actual = exclusion
+
+	return true, nil
+}
+
+// Delete implements the Adapter interface.
+func (a *logExclusionAdapter) Delete(ctx context.Context, deleteOp *directbase.DeleteOperation) (bool, error) {
+	// Already deleted
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	if _, err := a.exclusionClient.Delete(a.fullyQualifiedName()).Context(ctx).Do(); err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("deleting logExclusion %s: %w", a.fullyQualifiedName(), err)
+	}
+
+	return true, nil
+}
+
+func (a *logExclusionAdapter) Create(ctx context.Context, createOp *directbase.CreateOperation) error {
+	u := createOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logExclusionCtrlName)
+	log.V(2).Info("creating object", "u", u)
+
+	mapCtx := &direct.MapContext{}
+	exclusion := LogExclusionSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	exclusion.Name = a.resourceID
+
+	created, err := a.exclusionClient.Create(a.parent, exclusion).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("logExclusion %s creation failed: %w", a.fullyQualifiedName(), err)
+	}
+
+	log.V(2).Info("created logExclusion", "logExclusion", created)
+
+	if err := unstructured.SetNestedField(u.Object, a.resourceID, "spec", "resourceID"); err != nil {
+		return fmt.Errorf("setting spec.resourceID: %w", err)
+	}
+
+	status := LogExclusionStatus_FromProto(mapCtx, created)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logExclusionAdapter) Update(ctx context.Context, updateOp *directbase.UpdateOperation) error {
+	u := updateOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logExclusionCtrlName)
+
+	mapCtx := &direct.MapContext{}
+	desired := LogExclusionSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+
+	// Fields that are unset in the spec are left as they are in GCP.
+	var updateMask []string
+	if a.desired.Spec.Description != nil && desired.Description != a.actual.Description {
+		updateMask = append(updateMask, "description")
+	}
+	if a.desired.Spec.Disabled != nil && desired.Disabled != a.actual.Disabled {
+		updateMask = append(updateMask, "disabled")
+	}
+	if desired.Filter != a.actual.Filter {
+		updateMask = append(updateMask, "filter")
+	}
+
+	latest := a.actual
+	if len(updateMask) != 0 {
+		log.Info("updating logExclusion", "name", a.fullyQualifiedName(), "updateMask", updateMask)
+
+		// disabled is dropped from the JSON body when false, so send it explicitly.
+		desired.ForceSendFields = append(desired.ForceSendFields, "Disabled")
+		updated, err := a.exclusionClient.Patch(a.fullyQualifiedName(), desired).UpdateMask(strings.Join(updateMask, ",")).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("logExclusion %s update failed: %w", a.fullyQualifiedName(), err)
+		}
+		latest = updated
+	}
+
+	status := LogExclusionStatus_FromProto(mapCtx, latest)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logExclusionAdapter) Export(ctx context.Context) (*unstructured.Unstructured, error) {
+	if a.actual == nil {
+		return nil, fmt.Errorf("logExclusion %q not found", a.fullyQualifiedName())
+	}
+
+	mapCtx := &direct.MapContext{}
+	obj := &v1beta1.LoggingLogExclusion{}
+	obj.Spec = direct.ValueOf(LogExclusionSpec_FromProto(mapCtx, a.actual))
+	if mapCtx.Err() != nil {
+		return nil, mapCtx.Err()
+	}
+
+	ref := &v1alpha1.ResourceRef{External: a.parent}
+	switch strings.Split(a.parent, "/")[0] {
+	case "projects":
+		obj.Spec.ProjectRef = ref
+	case "folders":
+		obj.Spec.FolderRef = ref
+	case "organizations":
+		obj.Spec.OrganizationRef = ref
+	case "billingAccounts":
+		obj.Spec.BillingAccountRef = ref
+	}
+	obj.Spec.ResourceID = direct.LazyPtr(a.resourceID)
+
+	uObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting logExclusion to unstructured %w", err)
+	}
+
+	u := &unstructured.Unstructured{Object: uObj}
+	u.SetGroupVersionKind(v1beta1.LoggingLogExclusionGVK)
+	u.SetName(a.resourceID)
+	return u, nil
+}
+
+func (a *logExclusionAdapter) fullyQualifiedName() string {
+	return a.parent + "/exclusions/" + a.resourceID
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "google.golang.org/api/logging/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/lifecyclehandler"
+)
+
+// newTestExclusionsService returns an exclusions client for a fake logging API, which echoes back the exclusion in each request.
+func newTestExclusionsService(t *testing.T) (*api.ExclusionsService, *[]recordedRequest) {
+	t.Helper()
+
+	service, requests := newTestLoggingService(t, func(req recordedRequest) any {
+		exclusion := &api.LogExclusion{}
+		if len(req.body) != 0 {
+			if err := json.Unmarshal(req.body, exclusion); err != nil {
+				t.Errorf("parsing request body: %v", err)
+			}
+		}
+		exclusion.UpdateTime = "2024-01-02T03:04:05Z"
+		return exclusion
+	})
+	return api.NewExclusionsService(service), requests
+}
+
+func TestLogExclusionSpecRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *v1beta1.LoggingLogExclusionSpec
+	}{
+		{
+			name: "all fields",
+			spec: &v1beta1.LoggingLogExclusionSpec{
+				Description: direct.PtrTo("drop debug logs"),
+				Disabled:    direct.PtrTo(true),
+				Filter:      "severity<INFO",
+			},
+		},
+		{
+			name: "filter only",
+			spec: &v1beta1.LoggingLogExclusionSpec{Filter: "severity<INFO"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapCtx := &direct.MapContext{}
+			got := LogExclusionSpec_FromProto(mapCtx, LogExclusionSpec_ToProto(mapCtx, tc.spec))
+			if mapCtx.Err() != nil {
+				t.Fatalf("mapping failed: %v", mapCtx.Err())
+			}
+			if diff := cmp.Diff(tc.spec, got); diff != "" {
+				t.Errorf("spec did not round-trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLogExclusionUpdateTogglesDisabled(t *testing.T) {
+	for _, disabled := range []bool{true, false} {
+		t.Run(map[bool]string{true: "disable", false: "enable"}[disabled], func(t *testing.T) {
+			ctx := context.Background()
+			exclusionClient, requests := newTestExclusionsService(t)
+
+			a := &logExclusionAdapter{
+				parent:     "projects/my-project",
+				resourceID: "my-exclusion",
+				desired: &v1beta1.LoggingLogExclusion{
+					Spec: v1beta1.LoggingLogExclusionSpec{
+						Filter:   "severity<INFO",
+						Disabled: direct.PtrTo(disabled),
+					},
+				},
+				actual: &api.LogExclusion{
+					Name:     "my-exclusion",
+					Filter:   "severity<INFO",
+					Disabled: !disabled,
+				},
+				exclusionClient: exclusionClient,
+			}
+
+			u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+			if err := a.Update(ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+				t.Fatalf("Update failed: %v", err)
+			}
+
+			if len(*requests) != 1 {
+				t.Fatalf("expected exactly one request, got %+v", *requests)
+			}
+			got := (*requests)[0]
+			if got.method != http.MethodPatch || got.path != "/v2/projects/my-project/exclusions/my-exclusion" {
+				t.Errorf("unexpected request %s %s", got.method, got.path)
+			}
+			if got.updateMask != "disabled" {
+				t.Errorf("unexpected updateMask; got %q, want %q", got.updateMask, "disabled")
+			}
+			body := map[string]any{}
+			if err := json.Unmarshal(got.body, &body); err != nil {
+				t.Fatalf("parsing request body: %v", err)
+			}
+			if body["disabled"] != disabled {
+				t.Errorf("unexpected disabled in request body; got %v, want %v", body["disabled"], disabled)
+			}
+			if updateTime, _, _ := unstructured.NestedString(u.Object, "status", "updateTime"); updateTime != "2024-01-02T03:04:05Z" {
+				t.Errorf("unexpected status.updateTime %q", updateTime)
+			}
+		})
+	}
+}
+
+func TestLogExclusionUpdateNoChanges(t *testing.T) {
+	ctx := context.Background()
+	exclusionClient, requests := newTestExclusionsService(t)
+
+	a := &logExclusionAdapter{
+		parent:     "folders/123",
+		resourceID: "my-exclusion",
+		desired: &v1beta1.LoggingLogExclusion{
+			Spec: v1beta1.LoggingLogExclusionSpec{Filter: "severity<INFO"},
+		},
+		actual: &api.LogExclusion{
+			Name:     "my-exclusion",
+			Filter:   "severity<INFO",
+			Disabled: true,
+		},
+		exclusionClient: exclusionClient,
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := a.Update(ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no requests when disabled is unset in the spec, got %+v", *requests)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	api "google.golang.org/api/logging/v2"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+)
+
+// LogExclusionSpec_FromProto maps the mutable fields of a LogExclusion to the LoggingLogExclusion spec.
+// The parent references are not part of the LogExclusion body and are left unset.
+func LogExclusionSpec_FromProto(mapCtx *direct.MapContext, in *api.LogExclusion) *v1beta1.LoggingLogExclusionSpec {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogExclusionSpec{}
+	out.Description = direct.LazyPtr(in.Description)
+	out.Disabled = direct.LazyPtr(in.Disabled)
+	out.Filter = in.Filter
+	return out
+}
+
+func LogExclusionSpec_ToProto(mapCtx *direct.MapContext, in *v1beta1.LoggingLogExclusionSpec) *api.LogExclusion {
+	if in == nil {
+		return nil
+	}
+	out := &api.LogExclusion{}
+	out.Description = direct.ValueOf(in.Description)
+	out.Disabled = direct.ValueOf(in.Disabled)
+	out.Filter = in.Filter
+	return out
+}
+
+func LogExclusionStatus_FromProto(mapCtx *direct.MapContext, in *api.LogExclusion) *v1beta1.LoggingLogExclusionStatus {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogExclusionStatus{}
+	out.CreateTime = direct.LazyPtr(in.CreateTime)
+	out.UpdateTime = direct.LazyPtr(in.UpdateTime)
+	return out
+}
@@ -0,0 +1,354 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "google.golang.org/api/logging/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	refs "github.com/GoogleCloudPlatform/k8s-config-connector/apis/refs/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/config"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/registry"
+)
+
+const logSinkCtrlName = "logsink-controller"
+
+// sharedSinkWriterIdentity is the writer identity of project sinks that were created without a unique writer identity.
+const sharedSinkWriterIdentity = "serviceAccount:cloud-logs@system.gserviceaccount.com"
+
+func init() {
+	registry.RegisterModel(v1beta1.LoggingLogSinkGVK, NewLogSinkModel)
+}
+
+func NewLogSinkModel(ctx context.Context, config *config.ControllerConfig) (directbase.Model, error) {
+	return &logSinkModel{config: config}, nil
+}
+
+type logSinkModel struct {
+	config *config.ControllerConfig
+}
+
+// model implements the Model interface.
+var _ directbase.Model = &logSinkModel{}
+
+type logSinkAdapter struct {
+	// parent is the scope of the sink, e.g. `projects/my-project`
+	parent     string
+	resourceID string
+
+	desired    *v1beta1.LoggingLogSink
+	actual     *api.LogSink
+	sinkClient *api.SinksService
+}
+
+var _ directbase.Adapter = &logSinkAdapter{}
+
+// AdapterForObject implements the Model interface.
+func (m *logSinkModel) AdapterForObject(ctx context.Context, reader client.Reader, u *unstructured.Unstructured) (directbase.Adapter, error) {
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	sinkClient, err := gcpClient.newSinksService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &v1beta1.LoggingLogSink{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+		return nil, fmt.Errorf("error converting to %T: %w", obj, err)
+	}
+
+	resourceID := direct.ValueOf(obj.Spec.ResourceID)
+	if resourceID == "" {
+		resourceID = obj.GetName()
+	}
+	if resourceID == "" {
+		return nil, fmt.Errorf("cannot resolve resource ID")
+	}
+
+	scope, err := resolveLogBucketScope(ctx, reader, obj, obj.Spec.ProjectRef, obj.Spec.FolderRef, obj.Spec.OrganizationRef, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resolveLogSinkDestination(ctx, reader, obj); err != nil {
+		return nil, err
+	}
+
+	return &logSinkAdapter{
+		parent:     scope,
+		resourceID: resourceID,
+		desired:    obj,
+		sinkClient: sinkClient,
+	}, nil
+}
+
+// resolveLogSinkDestination replaces a destination reference by name with its external form.
+func resolveLogSinkDestination(ctx context.Context, reader client.Reader, obj *v1beta1.LoggingLogSink) error {
+	destination := &obj.Spec.Destination
+
+	if ref := destination.StorageBucketRef; ref != nil && ref.External == "" {
+		key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		if key.Namespace == "" {
+			key.Namespace = obj.GetNamespace()
+		}
+		storageBucket := &unstructured.Unstructured{}
+		storageBucket.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   "storage.cnrm.cloud.google.com",
+			Version: "v1beta1",
+			Kind:    "StorageBucket",
+		})
+		if err := reader.Get(ctx, key, storageBucket); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("referenced StorageBucket %v not found", key)
+			}
+			return fmt.Errorf("error reading referenced StorageBucket %v: %w", key, err)
+		}
+		destination.StorageBucketRef = &v1alpha1.ResourceRef{External: storageSinkDestinationPrefix + getResourceID(storageBucket)}
+	}
+
+	if ref := destination.BigQueryDatasetRef; ref != nil && ref.External == "" {
+		dataset, err := refs.ResolveBigQueryDataset(ctx, reader, obj, &refs.BigQueryDatasetRef{Name: ref.Name, Namespace: ref.Namespace})
+		if err != nil {
+			return err
+		}
+		destination.BigQueryDatasetRef = &v1alpha1.ResourceRef{External: bigquerySinkDestinationPrefix + dataset.String()}
+	}
+
+	if ref := destination.PubSubTopicRef; ref != nil && ref.External == "" {
+		topic, err := refs.ResolvePubSubTopic(ctx, reader, obj, &refs.PubSubTopicRef{Name: ref.Name, Namespace: ref.Namespace})
+		if err != nil {
+			return err
+		}
+		destination.PubSubTopicRef = &v1alpha1.ResourceRef{External: pubsubSinkDestinationPrefix + topic.String()}
+	}
+
+	if ref := destination.LoggingLogBucketRef; ref != nil && ref.External == "" {
+		if err := LogBucketRef_ConvertToExternal(ctx, reader, obj, &destination.LoggingLogBucketRef); err != nil {
+			return err
+		}
+		destination.LoggingLogBucketRef.External = loggingSinkDestinationPrefix + destination.LoggingLogBucketRef.External
+	}
+
+	return nil
+}
+
+func (m *logSinkModel) AdapterForURL(ctx context.Context, url string) (directbase.Adapter, error) {
+	// Format: //logging.googleapis.com/{projects,folders,organizations,billingAccounts}/<id>/sinks/<id>
+	if !strings.HasPrefix(url, "//logging.googleapis.com/") {
+		return nil, nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(url, "//logging.googleapis.com/"), "/")
+	if len(tokens) != 4 || tokens[2] != "sinks" {
+		return nil, nil
+	}
+	switch tokens[0] {
+	case "projects", "folders", "organizations", "billingAccounts":
+	default:
+		return nil, nil
+	}
+
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	sinkClient, err := gcpClient.newSinksService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logSinkAdapter{
+		parent:     strings.Join(tokens[:2], "/"),
+		resourceID: tokens[3],
+		sinkClient: sinkClient,
+	}, nil
+}
+
+func (a *logSinkAdapter) Find(ctx context.Context) (bool, error) {
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	sink, err := a.sinkClient.Get(a.fullyQualifiedName()).Context(ctx).Do()
+	if err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting logSink %q: %w", a.fullyQualifiedName(), err)
+	}
+
+	a.actual = sink
+
+	return true, nil
+}
+
+// Delete implements the Adapter interface.
+func (a *logSinkAdapter) Delete(ctx context.Context, deleteOp *directbase.DeleteOperation) (bool, error) {
+	// Already deleted
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	if _, err := a.sinkClient.Delete(a.fullyQualifiedName()).Context(ctx).Do(); err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("deleting logSink %s: %w", a.fullyQualifiedName(), err)
+	}
+
+	return true, nil
+}
+
+func (a *logSinkAdapter) Create(ctx context.Context, createOp *directbase.CreateOperation) error {
+	u := createOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logSinkCtrlName)
+	log.V(2).Info("creating object", "u", u)
+
+	mapCtx := &direct.MapContext{}
+	sink := LogSinkSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	sink.Name = a.resourceID
+
+	// unique_writer_identity can only be chosen here; Update never changes it.
+	uniqueWriterIdentity := direct.ValueOf(a.desired.Spec.UniqueWriterIdentity)
+	created, err := a.sinkClient.Create(a.parent, sink).UniqueWriterIdentity(uniqueWriterIdentity).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("logSink %s creation failed: %w", a.fullyQualifiedName(), err)
+	}
+
+	log.V(2).Info("created logSink", "logSink", created)
+
+	if err := unstructured.SetNestedField(u.Object, a.resourceID, "spec", "resourceID"); err != nil {
+		return fmt.Errorf("setting spec.resourceID: %w", err)
+	}
+
+	status := LogSinkStatus_FromProto(mapCtx, created)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logSinkAdapter) Update(ctx context.Context, updateOp *directbase.UpdateOperation) error {
+	u := updateOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logSinkCtrlName)
+
+	mapCtx := &direct.MapContext{}
+	desired := LogSinkSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+
+	// Fields that are unset in the spec are left as they are in GCP.
+	var updateMask []string
+	if a.desired.Spec.Description != nil && desired.Description != a.actual.Description {
+		updateMask = append(updateMask, "description")
+	}
+	if desired.Destination != a.actual.Destination {
+		updateMask = append(updateMask, "destination")
+	}
+	if a.desired.Spec.Disabled != nil && desired.Disabled != a.actual.Disabled {
+		updateMask = append(updateMask, "disabled")
+	}
+	if a.desired.Spec.Filter != nil && desired.Filter != a.actual.Filter {
+		updateMask = append(updateMask, "filter")
+	}
+
+	latest := a.actual
+	if len(updateMask) != 0 {
+		log.Info("updating logSink", "name", a.fullyQualifiedName(), "updateMask", updateMask)
+
+		// disabled is dropped from the JSON body when false, so send it explicitly.
+		desired.ForceSendFields = append(desired.ForceSendFields, "Disabled")
+		call := a.sinkClient.Update(a.fullyQualifiedName(), desired).UpdateMask(strings.Join(updateMask, ","))
+		// Leaving unique_writer_identity unset defaults it to false, which GCP rejects
+		// for a sink that has a unique writer identity, so repeat the current value.
+		if a.actual.WriterIdentity != sharedSinkWriterIdentity {
+			call = call.UniqueWriterIdentity(true)
+		}
+		updated, err := call.Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("logSink %s update failed: %w", a.fullyQualifiedName(), err)
+		}
+		latest = updated
+	}
+
+	status := LogSinkStatus_FromProto(mapCtx, latest)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logSinkAdapter) Export(ctx context.Context) (*unstructured.Unstructured, error) {
+	if a.actual == nil {
+		return nil, fmt.Errorf("logSink %q not found", a.fullyQualifiedName())
+	}
+
+	mapCtx := &direct.MapContext{}
+	obj := &v1beta1.LoggingLogSink{}
+	obj.Spec = direct.ValueOf(LogSinkSpec_FromProto(mapCtx, a.actual))
+	if mapCtx.Err() != nil {
+		return nil, mapCtx.Err()
+	}
+
+	ref := &v1alpha1.ResourceRef{External: a.parent}
+	switch strings.Split(a.parent, "/")[0] {
+	case "projects":
+		obj.Spec.ProjectRef = ref
+	case "folders":
+		obj.Spec.FolderRef = ref
+	case "organizations":
+		obj.Spec.OrganizationRef = ref
+	}
+	obj.Spec.ResourceID = direct.LazyPtr(a.resourceID)
+	obj.Spec.UniqueWriterIdentity = direct.LazyPtr(a.actual.WriterIdentity != sharedSinkWriterIdentity)
+
+	uObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting logSink to unstructured %w", err)
+	}
+
+	u := &unstructured.Unstructured{Object: uObj}
+	u.SetGroupVersionKind(v1beta1.LoggingLogSinkGVK)
+	u.SetName(a.resourceID)
+	return u, nil
+}
+
+func (a *logSinkAdapter) fullyQualifiedName() string {
+	return a.parent + "/sinks/" + a.resourceID
+}
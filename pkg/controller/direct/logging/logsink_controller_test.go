@@ -0,0 +1,455 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "google.golang.org/api/logging/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/lifecyclehandler"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+)
+
+const testSinkWriterIdentity = "serviceAccount:service-123456789@gcp-sa-logging.iam.gserviceaccount.com"
+
+// newTestSinksService returns a sinks client for a fake logging API, which echoes back the sink in each request
+// with a unique writer identity.
+func newTestSinksService(t *testing.T) (*api.SinksService, *[]recordedRequest) {
+	t.Helper()
+
+	service, requests := newTestLoggingService(t, func(req recordedRequest) any {
+		sink := &api.LogSink{}
+		if len(req.body) != 0 {
+			if err := json.Unmarshal(req.body, sink); err != nil {
+				t.Errorf("parsing request body: %v", err)
+			}
+		}
+		sink.WriterIdentity = testSinkWriterIdentity
+		return sink
+	})
+	return api.NewSinksService(service), requests
+}
+
+func TestLogSinkSpecRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *v1beta1.LoggingLogSinkSpec
+	}{
+		{
+			name: "all fields",
+			spec: &v1beta1.LoggingLogSinkSpec{
+				BigqueryOptions: &v1beta1.LogsinkBigqueryOptions{UsePartitionedTables: true},
+				Description:     direct.PtrTo("audit logs"),
+				Destination: v1beta1.LogsinkDestination{
+					BigQueryDatasetRef: &v1alpha1.ResourceRef{External: "bigquery.googleapis.com/projects/my-project/datasets/my_dataset"},
+				},
+				Disabled: direct.PtrTo(true),
+				Exclusions: []v1beta1.LogsinkExclusions{
+					{
+						Description: direct.PtrTo("drop debug logs"),
+						Disabled:    direct.PtrTo(true),
+						Filter:      "severity<INFO",
+						Name:        "debug",
+					},
+				},
+				Filter:          direct.PtrTo(`logName:"cloudaudit.googleapis.com"`),
+				IncludeChildren: direct.PtrTo(true),
+			},
+		},
+		{
+			name: "storage bucket",
+			spec: &v1beta1.LoggingLogSinkSpec{
+				Destination: v1beta1.LogsinkDestination{
+					StorageBucketRef: &v1alpha1.ResourceRef{External: "storage.googleapis.com/my-bucket"},
+				},
+			},
+		},
+		{
+			name: "pubsub topic",
+			spec: &v1beta1.LoggingLogSinkSpec{
+				Destination: v1beta1.LogsinkDestination{
+					PubSubTopicRef: &v1alpha1.ResourceRef{External: "pubsub.googleapis.com/projects/my-project/topics/my-topic"},
+				},
+			},
+		},
+		{
+			name: "log bucket",
+			spec: &v1beta1.LoggingLogSinkSpec{
+				Destination: v1beta1.LogsinkDestination{
+					LoggingLogBucketRef: &v1alpha1.ResourceRef{External: "logging.googleapis.com/" + testLogBucketFQN},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapCtx := &direct.MapContext{}
+			got := LogSinkSpec_FromProto(mapCtx, LogSinkSpec_ToProto(mapCtx, tc.spec))
+			if mapCtx.Err() != nil {
+				t.Fatalf("mapping failed: %v", mapCtx.Err())
+			}
+			if diff := cmp.Diff(tc.spec, got); diff != "" {
+				t.Errorf("spec did not round-trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLogSinkDestinationToProto(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination *v1beta1.LogsinkDestination
+		want        string
+		wantErr     bool
+	}{
+		{
+			name: "external without service prefix",
+			destination: &v1beta1.LogsinkDestination{
+				PubSubTopicRef: &v1alpha1.ResourceRef{External: "projects/my-project/topics/my-topic"},
+			},
+			want: "pubsub.googleapis.com/projects/my-project/topics/my-topic",
+		},
+		{
+			name:        "no destination",
+			destination: &v1beta1.LogsinkDestination{},
+			wantErr:     true,
+		},
+		{
+			name: "more than one destination",
+			destination: &v1beta1.LogsinkDestination{
+				StorageBucketRef: &v1alpha1.ResourceRef{External: "storage.googleapis.com/my-bucket"},
+				PubSubTopicRef:   &v1alpha1.ResourceRef{External: "pubsub.googleapis.com/projects/my-project/topics/my-topic"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unresolved reference",
+			destination: &v1beta1.LogsinkDestination{
+				StorageBucketRef: &v1alpha1.ResourceRef{Name: "my-bucket"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapCtx := &direct.MapContext{}
+			got := LogSinkDestination_ToProto(mapCtx, tc.destination)
+			if gotErr := mapCtx.Err() != nil; gotErr != tc.wantErr {
+				t.Fatalf("got error %v, want error %v", mapCtx.Err(), tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("got destination %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLogSinkUpdateChangesDestination(t *testing.T) {
+	tests := []struct {
+		name                     string
+		actualWriterIdentity     string
+		wantUniqueWriterIdentity string
+	}{
+		{
+			name:                 "shared writer identity",
+			actualWriterIdentity: sharedSinkWriterIdentity,
+		},
+		{
+			name:                     "unique writer identity is kept",
+			actualWriterIdentity:     testSinkWriterIdentity,
+			wantUniqueWriterIdentity: "true",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			sinkClient, requests := newTestSinksService(t)
+
+			a := &logSinkAdapter{
+				parent:     "projects/my-project",
+				resourceID: "my-sink",
+				desired: &v1beta1.LoggingLogSink{
+					Spec: v1beta1.LoggingLogSinkSpec{
+						Destination: v1beta1.LogsinkDestination{
+							StorageBucketRef: &v1alpha1.ResourceRef{External: "storage.googleapis.com/new-bucket"},
+						},
+						Filter: direct.PtrTo("severity>=ERROR"),
+						// Changing uniqueWriterIdentity after creation has no effect.
+						UniqueWriterIdentity: direct.PtrTo(false),
+					},
+				},
+				actual: &api.LogSink{
+					Name:           "my-sink",
+					Destination:    "storage.googleapis.com/old-bucket",
+					Filter:         "severity>=ERROR",
+					Disabled:       true,
+					WriterIdentity: tc.actualWriterIdentity,
+				},
+				sinkClient: sinkClient,
+			}
+
+			u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+			if err := a.Update(ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+				t.Fatalf("Update failed: %v", err)
+			}
+
+			if len(*requests) != 1 {
+				t.Fatalf("expected exactly one request, got %+v", *requests)
+			}
+			got := (*requests)[0]
+			if got.method != http.MethodPut || got.path != "/v2/projects/my-project/sinks/my-sink" {
+				t.Errorf("unexpected request %s %s", got.method, got.path)
+			}
+			// disabled is unset in the spec, so it is not part of the update.
+			if got.updateMask != "destination" {
+				t.Errorf("unexpected updateMask; got %q, want %q", got.updateMask, "destination")
+			}
+			if uniqueWriterIdentity := got.query.Get("uniqueWriterIdentity"); uniqueWriterIdentity != tc.wantUniqueWriterIdentity {
+				t.Errorf("unexpected uniqueWriterIdentity; got %q, want %q", uniqueWriterIdentity, tc.wantUniqueWriterIdentity)
+			}
+			body := &api.LogSink{}
+			if err := json.Unmarshal(got.body, body); err != nil {
+				t.Fatalf("parsing request body: %v", err)
+			}
+			if body.Destination != "storage.googleapis.com/new-bucket" {
+				t.Errorf("unexpected destination in request body %q", body.Destination)
+			}
+			if writerIdentity, _, _ := unstructured.NestedString(u.Object, "status", "writerIdentity"); writerIdentity != testSinkWriterIdentity {
+				t.Errorf("unexpected status.writerIdentity %q", writerIdentity)
+			}
+		})
+	}
+}
+
+// TestLogSinkWriteRequestsOmitOutputOnlyFields checks that writer_identity, create_time and update_time,
+// which GCP sets, are only ever read into the status: neither the spec mapping nor the create and update
+// requests carry them, even when the sink in GCP already has them.
+func TestLogSinkWriteRequestsOmitOutputOnlyFields(t *testing.T) {
+	ctx := context.Background()
+	spec := v1beta1.LoggingLogSinkSpec{
+		Destination: v1beta1.LogsinkDestination{
+			StorageBucketRef: &v1alpha1.ResourceRef{External: "storage.googleapis.com/my-bucket"},
+		},
+		Filter: direct.PtrTo("severity>=ERROR"),
+	}
+
+	mapCtx := &direct.MapContext{}
+	sink := LogSinkSpec_ToProto(mapCtx, &spec)
+	if mapCtx.Err() != nil {
+		t.Fatalf("mapping failed: %v", mapCtx.Err())
+	}
+	if sink.WriterIdentity != "" || sink.CreateTime != "" || sink.UpdateTime != "" {
+		t.Errorf("spec mapped to output-only fields; got %+v", sink)
+	}
+
+	status := LogSinkStatus_FromProto(mapCtx, &api.LogSink{
+		WriterIdentity: testSinkWriterIdentity,
+		CreateTime:     "2024-01-01T00:00:00Z",
+		UpdateTime:     "2024-01-02T00:00:00Z",
+	})
+	if direct.ValueOf(status.WriterIdentity) != testSinkWriterIdentity {
+		t.Errorf("unexpected status.writerIdentity %q", direct.ValueOf(status.WriterIdentity))
+	}
+
+	sinkClient, requests := newTestSinksService(t)
+	a := &logSinkAdapter{
+		parent:     "projects/my-project",
+		resourceID: "my-sink",
+		desired:    &v1beta1.LoggingLogSink{Spec: spec},
+		sinkClient: sinkClient,
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := a.Create(ctx, directbase.NewCreateOperation(nil, u)); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	a.actual = &api.LogSink{
+		Name:           "my-sink",
+		Destination:    "storage.googleapis.com/old-bucket",
+		Filter:         "severity>=ERROR",
+		WriterIdentity: testSinkWriterIdentity,
+		CreateTime:     "2024-01-01T00:00:00Z",
+		UpdateTime:     "2024-01-02T00:00:00Z",
+	}
+	if err := a.Update(ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("expected a create and an update request, got %+v", *requests)
+	}
+	for _, req := range *requests {
+		body := map[string]any{}
+		if err := json.Unmarshal(req.body, &body); err != nil {
+			t.Fatalf("parsing %s request body: %v", req.method, err)
+		}
+		for _, field := range []string{"writerIdentity", "createTime", "updateTime"} {
+			if v, found := body[field]; found {
+				t.Errorf("%s request body has output-only field %s=%v", req.method, field, v)
+			}
+		}
+	}
+}
+
+func TestLogSinkUpdateNoChanges(t *testing.T) {
+	ctx := context.Background()
+	sinkClient, requests := newTestSinksService(t)
+
+	a := &logSinkAdapter{
+		parent:     "folders/123",
+		resourceID: "my-sink",
+		desired: &v1beta1.LoggingLogSink{
+			Spec: v1beta1.LoggingLogSinkSpec{
+				Destination: v1beta1.LogsinkDestination{
+					PubSubTopicRef: &v1alpha1.ResourceRef{External: "projects/my-project/topics/my-topic"},
+				},
+			},
+		},
+		actual: &api.LogSink{
+			Name:           "my-sink",
+			Destination:    "pubsub.googleapis.com/projects/my-project/topics/my-topic",
+			Filter:         "severity>=ERROR",
+			WriterIdentity: testSinkWriterIdentity,
+		},
+		sinkClient: sinkClient,
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := a.Update(ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no requests when only unset fields differ, got %+v", *requests)
+	}
+	if writerIdentity, _, _ := unstructured.NestedString(u.Object, "status", "writerIdentity"); writerIdentity != testSinkWriterIdentity {
+		t.Errorf("unexpected status.writerIdentity %q", writerIdentity)
+	}
+}
+
+func TestLogSinkCreateSetsUniqueWriterIdentity(t *testing.T) {
+	ctx := context.Background()
+	sinkClient, requests := newTestSinksService(t)
+
+	a := &logSinkAdapter{
+		parent:     "projects/my-project",
+		resourceID: "my-sink",
+		desired: &v1beta1.LoggingLogSink{
+			Spec: v1beta1.LoggingLogSinkSpec{
+				Destination: v1beta1.LogsinkDestination{
+					StorageBucketRef: &v1alpha1.ResourceRef{External: "storage.googleapis.com/my-bucket"},
+				},
+				UniqueWriterIdentity: direct.PtrTo(true),
+			},
+		},
+		sinkClient: sinkClient,
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := a.Create(ctx, directbase.NewCreateOperation(nil, u)); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected exactly one request, got %+v", *requests)
+	}
+	got := (*requests)[0]
+	if got.method != http.MethodPost || got.path != "/v2/projects/my-project/sinks" {
+		t.Errorf("unexpected request %s %s", got.method, got.path)
+	}
+	if uniqueWriterIdentity := got.query.Get("uniqueWriterIdentity"); uniqueWriterIdentity != "true" {
+		t.Errorf("unexpected uniqueWriterIdentity; got %q, want %q", uniqueWriterIdentity, "true")
+	}
+	if writerIdentity, _, _ := unstructured.NestedString(u.Object, "status", "writerIdentity"); writerIdentity != testSinkWriterIdentity {
+		t.Errorf("unexpected status.writerIdentity %q", writerIdentity)
+	}
+}
+
+// TestLogSinkUpdateObservedGeneration checks that status.observedGeneration follows metadata.generation once
+// an updated sink is reconciled. The adapter keeps the observed generation of the previous reconcile, and the
+// lifecycle handler records the new one when the direct controller reports the sink as up to date.
+func TestLogSinkUpdateObservedGeneration(t *testing.T) {
+	ctx := context.Background()
+	sinkClient, _ := newTestSinksService(t)
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.GroupVersionKind{Group: "logging.cnrm.cloud.google.com", Version: "v1beta1", Kind: "LoggingLogSink"})
+	u.SetNamespace("default")
+	u.SetName("my-sink")
+	u.SetGeneration(2)
+	u.Object["status"] = map[string]interface{}{"observedGeneration": int64(1)}
+	kubeClient := fake.NewClientBuilder().
+		WithObjects(u).
+		WithStatusSubresource(u).
+		Build()
+
+	a := &logSinkAdapter{
+		parent:     "projects/my-project",
+		resourceID: "my-sink",
+		desired: &v1beta1.LoggingLogSink{
+			Spec: v1beta1.LoggingLogSinkSpec{
+				Destination: v1beta1.LogsinkDestination{
+					StorageBucketRef: &v1alpha1.ResourceRef{External: "storage.googleapis.com/other-bucket"},
+				},
+			},
+		},
+		actual: &api.LogSink{
+			Name:           "my-sink",
+			Destination:    "storage.googleapis.com/my-bucket",
+			WriterIdentity: testSinkWriterIdentity,
+		},
+		sinkClient: sinkClient,
+	}
+	h := lifecyclehandler.NewLifecycleHandler(kubeClient, record.NewFakeRecorder(10))
+	if err := a.Update(ctx, directbase.NewUpdateOperation(h, kubeClient, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got := getObservedGeneration(u); got != 1 {
+		t.Errorf("adapter should not change status.observedGeneration; got %d, want 1", got)
+	}
+
+	resource, err := k8s.NewResource(u)
+	if err != nil {
+		t.Fatalf("error parsing resource: %v", err)
+	}
+	if err := h.HandleUpToDate(ctx, resource); err != nil {
+		t.Fatalf("HandleUpToDate failed: %v", err)
+	}
+
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(u.GroupVersionKind())
+	if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "my-sink"}, got); err != nil {
+		t.Fatalf("error getting resource: %v", err)
+	}
+	if observedGeneration := getObservedGeneration(got); observedGeneration != 2 {
+		t.Errorf("unexpected status.observedGeneration after reconcile; got %d, want 2", observedGeneration)
+	}
+	if writerIdentity, _, _ := unstructured.NestedString(got.Object, "status", "writerIdentity"); writerIdentity != testSinkWriterIdentity {
+		t.Errorf("unexpected status.writerIdentity %q", writerIdentity)
+	}
+}
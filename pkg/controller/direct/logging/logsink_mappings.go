@@ -0,0 +1,162 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"strings"
+
+	api "google.golang.org/api/logging/v2"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+)
+
+const (
+	storageSinkDestinationPrefix  = "storage.googleapis.com/"
+	bigquerySinkDestinationPrefix = "bigquery.googleapis.com/"
+	pubsubSinkDestinationPrefix   = "pubsub.googleapis.com/"
+	loggingSinkDestinationPrefix  = "logging.googleapis.com/"
+)
+
+// LogSinkSpec_FromProto maps a LogSink to the LoggingLogSink spec.
+// The parent references and uniqueWriterIdentity are not part of the LogSink body and are left unset.
+func LogSinkSpec_FromProto(mapCtx *direct.MapContext, in *api.LogSink) *v1beta1.LoggingLogSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogSinkSpec{}
+	if in.BigqueryOptions != nil {
+		out.BigqueryOptions = &v1beta1.LogsinkBigqueryOptions{
+			UsePartitionedTables: in.BigqueryOptions.UsePartitionedTables,
+		}
+	}
+	out.Description = direct.LazyPtr(in.Description)
+	out.Destination = direct.ValueOf(LogSinkDestination_FromProto(mapCtx, in.Destination))
+	out.Disabled = direct.LazyPtr(in.Disabled)
+	out.Exclusions = direct.Slice_FromProto(mapCtx, in.Exclusions, LogSinkExclusion_FromProto)
+	out.Filter = direct.LazyPtr(in.Filter)
+	out.IncludeChildren = direct.LazyPtr(in.IncludeChildren)
+	return out
+}
+
+// LogSinkSpec_ToProto maps the LoggingLogSink spec to a LogSink.
+// The destination references must already have been resolved to their external form.
+func LogSinkSpec_ToProto(mapCtx *direct.MapContext, in *v1beta1.LoggingLogSinkSpec) *api.LogSink {
+	if in == nil {
+		return nil
+	}
+	out := &api.LogSink{}
+	if in.BigqueryOptions != nil {
+		out.BigqueryOptions = &api.BigQueryOptions{
+			UsePartitionedTables: in.BigqueryOptions.UsePartitionedTables,
+		}
+	}
+	out.Description = direct.ValueOf(in.Description)
+	out.Destination = LogSinkDestination_ToProto(mapCtx, &in.Destination)
+	out.Disabled = direct.ValueOf(in.Disabled)
+	out.Exclusions = direct.Slice_ToProto(mapCtx, in.Exclusions, LogSinkExclusion_ToProto)
+	out.Filter = direct.ValueOf(in.Filter)
+	out.IncludeChildren = direct.ValueOf(in.IncludeChildren)
+	return out
+}
+
+// LogSinkExclusion_FromProto maps one of the exclusions of a LogSink to the LoggingLogSink spec.
+func LogSinkExclusion_FromProto(mapCtx *direct.MapContext, in *api.LogExclusion) *v1beta1.LogsinkExclusions {
+	return &v1beta1.LogsinkExclusions{
+		Description: direct.LazyPtr(in.Description),
+		Disabled:    direct.LazyPtr(in.Disabled),
+		Filter:      in.Filter,
+		Name:        in.Name,
+	}
+}
+
+// LogSinkExclusion_ToProto maps one of the exclusions of the LoggingLogSink spec to a LogExclusion.
+func LogSinkExclusion_ToProto(mapCtx *direct.MapContext, in *v1beta1.LogsinkExclusions) *api.LogExclusion {
+	return &api.LogExclusion{
+		Description: direct.ValueOf(in.Description),
+		Disabled:    direct.ValueOf(in.Disabled),
+		Filter:      in.Filter,
+		Name:        in.Name,
+	}
+}
+
+// LogSinkDestination_FromProto maps a sink destination, e.g. `storage.googleapis.com/my-bucket`,
+// to an external reference of the matching kind.
+func LogSinkDestination_FromProto(mapCtx *direct.MapContext, in string) *v1beta1.LogsinkDestination {
+	if in == "" {
+		return nil
+	}
+	out := &v1beta1.LogsinkDestination{}
+	ref := &v1alpha1.ResourceRef{External: in}
+	switch {
+	case strings.HasPrefix(in, storageSinkDestinationPrefix):
+		out.StorageBucketRef = ref
+	case strings.HasPrefix(in, bigquerySinkDestinationPrefix):
+		out.BigQueryDatasetRef = ref
+	case strings.HasPrefix(in, pubsubSinkDestinationPrefix):
+		out.PubSubTopicRef = ref
+	case strings.HasPrefix(in, loggingSinkDestinationPrefix):
+		out.LoggingLogBucketRef = ref
+	default:
+		mapCtx.Errorf("sink destination %q is not a Cloud Storage bucket, BigQuery dataset, Pub/Sub topic or log bucket", in)
+	}
+	return out
+}
+
+// LogSinkDestination_ToProto maps the destination reference to the sink destination.
+// The service prefix of the destination is added to external values that do not already have it.
+func LogSinkDestination_ToProto(mapCtx *direct.MapContext, in *v1beta1.LogsinkDestination) string {
+	if in == nil {
+		return ""
+	}
+	var destinations []string
+	for _, d := range []struct {
+		ref    *v1alpha1.ResourceRef
+		prefix string
+	}{
+		{in.StorageBucketRef, storageSinkDestinationPrefix},
+		{in.BigQueryDatasetRef, bigquerySinkDestinationPrefix},
+		{in.PubSubTopicRef, pubsubSinkDestinationPrefix},
+		{in.LoggingLogBucketRef, loggingSinkDestinationPrefix},
+	} {
+		if d.ref == nil {
+			continue
+		}
+		if d.ref.External == "" {
+			mapCtx.Errorf("destination reference %q has not been resolved", d.ref.Name)
+			continue
+		}
+		destination := d.ref.External
+		if !strings.HasPrefix(destination, d.prefix) {
+			destination = d.prefix + destination
+		}
+		destinations = append(destinations, destination)
+	}
+	if len(destinations) != 1 {
+		mapCtx.Errorf("exactly one of storageBucketRef, bigQueryDatasetRef, pubSubTopicRef or loggingLogBucketRef must be set in destination")
+		return ""
+	}
+	return destinations[0]
+}
+
+func LogSinkStatus_FromProto(mapCtx *direct.MapContext, in *api.LogSink) *v1beta1.LoggingLogSinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogSinkStatus{}
+	out.WriterIdentity = direct.LazyPtr(in.WriterIdentity)
+	return out
+}
@@ -0,0 +1,316 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "google.golang.org/api/logging/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/config"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/registry"
+)
+
+const logViewCtrlName = "logview-controller"
+
+func init() {
+	registry.RegisterModel(v1beta1.LoggingLogViewGVK, NewLogViewModel)
+}
+
+func NewLogViewModel(ctx context.Context, config *config.ControllerConfig) (directbase.Model, error) {
+	return &logViewModel{config: config}, nil
+}
+
+type logViewModel struct {
+	config *config.ControllerConfig
+}
+
+// model implements the Model interface.
+var _ directbase.Model = &logViewModel{}
+
+type logViewAdapter struct {
+	// bucket is the fully qualified name of the bucket, e.g. `projects/my-project/locations/global/buckets/my-bucket`
+	bucket     string
+	resourceID string
+
+	desired    *v1beta1.LoggingLogView
+	actual     *api.LogView
+	viewClient *api.LocationsBucketsViewsService
+}
+
+var _ directbase.Adapter = &logViewAdapter{}
+
+// AdapterForObject implements the Model interface.
+func (m *logViewModel) AdapterForObject(ctx context.Context, reader client.Reader, u *unstructured.Unstructured) (directbase.Adapter, error) {
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	viewClient, err := gcpClient.newLocationsBucketsViewsService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &v1beta1.LoggingLogView{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+		return nil, fmt.Errorf("error converting to %T: %w", obj, err)
+	}
+
+	resourceID := direct.ValueOf(obj.Spec.ResourceID)
+	if resourceID == "" {
+		resourceID = obj.GetName()
+	}
+	if resourceID == "" {
+		return nil, fmt.Errorf("cannot resolve resource ID")
+	}
+
+	bucket, err := resolveLogViewBucket(ctx, reader, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logViewAdapter{
+		bucket:     bucket,
+		resourceID: resourceID,
+		desired:    obj,
+		viewClient: viewClient,
+	}, nil
+}
+
+// resolveLogViewBucket returns the fully qualified name of the bucket that the view is in.
+// bucketRef.external may be the fully qualified name of the bucket, or the bucket ID when
+// the parent reference and location are set on the view.
+func resolveLogViewBucket(ctx context.Context, reader client.Reader, obj *v1beta1.LoggingLogView) (string, error) {
+	bucketRef := &obj.Spec.BucketRef
+	var bucket string
+	switch {
+	case bucketRef.External != "" && !strings.Contains(bucketRef.External, "/"):
+		scope, err := resolveLogBucketScope(ctx, reader, obj, obj.Spec.ProjectRef, obj.Spec.FolderRef, obj.Spec.OrganizationRef, obj.Spec.BillingAccountRef)
+		if err != nil {
+			return "", err
+		}
+		location := direct.ValueOf(obj.Spec.Location)
+		if location == "" {
+			return "", fmt.Errorf("spec.location is required when bucketRef.external is a bucket ID")
+		}
+		bucket = scope + "/locations/" + location + "/buckets/" + bucketRef.External
+	case bucketRef.External != "":
+		bucket = bucketRef.External
+	default:
+		if err := LogBucketRef_ConvertToExternal(ctx, reader, obj, &bucketRef); err != nil {
+			return "", err
+		}
+		bucket = bucketRef.External
+	}
+
+	if err := validateLogViewBucket(bucket); err != nil {
+		return "", err
+	}
+	return bucket, nil
+}
+
+// validateLogViewBucket checks that views can be created in the bucket, which must be of the form
+// `{projects,folders,organizations,billingAccounts}/*/locations/*/buckets/*`.
+func validateLogViewBucket(bucket string) error {
+	tokens := strings.Split(bucket, "/")
+	if len(tokens) != 6 || tokens[2] != "locations" || tokens[4] != "buckets" {
+		return fmt.Errorf("bucket %q is not in the format {projects,folders,organizations,billingAccounts}/ID/locations/LOCATION_ID/buckets/BUCKET_ID", bucket)
+	}
+	switch tokens[0] {
+	case "projects", "folders", "organizations", "billingAccounts":
+	default:
+		return fmt.Errorf("bucket %q is not in the format {projects,folders,organizations,billingAccounts}/ID/locations/LOCATION_ID/buckets/BUCKET_ID", bucket)
+	}
+	// GCP does not allow views on the _Required bucket.
+	if tokens[5] == "_Required" {
+		return fmt.Errorf("views cannot be created on the _Required bucket %q", bucket)
+	}
+	return nil
+}
+
+func (m *logViewModel) AdapterForURL(ctx context.Context, url string) (directbase.Adapter, error) {
+	// Format: //logging.googleapis.com/{projects,folders,organizations,billingAccounts}/<id>/locations/<location>/buckets/<id>/views/<id>
+	if !strings.HasPrefix(url, "//logging.googleapis.com/") {
+		return nil, nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(url, "//logging.googleapis.com/"), "/")
+	if len(tokens) != 8 || tokens[6] != "views" {
+		return nil, nil
+	}
+	bucket := strings.Join(tokens[:6], "/")
+	if err := validateLogViewBucket(bucket); err != nil {
+		return nil, nil
+	}
+
+	gcpClient, err := newGCPClient(ctx, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	viewClient, err := gcpClient.newLocationsBucketsViewsService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logViewAdapter{
+		bucket:     bucket,
+		resourceID: tokens[7],
+		viewClient: viewClient,
+	}, nil
+}
+
+func (a *logViewAdapter) Find(ctx context.Context) (bool, error) {
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	view, err := a.viewClient.Get(a.fullyQualifiedName()).Context(ctx).Do()
+	if err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting logView %q: %w", a.fullyQualifiedName(), err)
+	}
+
+	a.actual = view
+
+	return true, nil
+}
+
+// Delete implements the Adapter interface.
+func (a *logViewAdapter) Delete(ctx context.Context, deleteOp *directbase.DeleteOperation) (bool, error) {
+	// Already deleted
+	if a.resourceID == "" {
+		return false, nil
+	}
+
+	if _, err := a.viewClient.Delete(a.fullyQualifiedName()).Context(ctx).Do(); err != nil {
+		if direct.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("deleting logView %s: %w", a.fullyQualifiedName(), err)
+	}
+
+	return true, nil
+}
+
+func (a *logViewAdapter) Create(ctx context.Context, createOp *directbase.CreateOperation) error {
+	u := createOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logViewCtrlName)
+	log.V(2).Info("creating object", "u", u)
+
+	mapCtx := &direct.MapContext{}
+	view := LogViewSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+
+	created, err := a.viewClient.Create(a.bucket, view).ViewId(a.resourceID).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("logView %s creation failed: %w", a.fullyQualifiedName(), err)
+	}
+
+	log.V(2).Info("created logView", "logView", created)
+
+	if err := unstructured.SetNestedField(u.Object, a.resourceID, "spec", "resourceID"); err != nil {
+		return fmt.Errorf("setting spec.resourceID: %w", err)
+	}
+
+	status := LogViewStatus_FromProto(mapCtx, created)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logViewAdapter) Update(ctx context.Context, updateOp *directbase.UpdateOperation) error {
+	u := updateOp.GetUnstructured()
+
+	log := klog.FromContext(ctx).WithName(logViewCtrlName)
+
+	mapCtx := &direct.MapContext{}
+	desired := LogViewSpec_ToProto(mapCtx, &a.desired.Spec)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+
+	// Fields that are unset in the spec are left as they are in GCP.
+	var updateMask []string
+	if a.desired.Spec.Description != nil && desired.Description != a.actual.Description {
+		updateMask = append(updateMask, "description")
+	}
+	if a.desired.Spec.Filter != nil && desired.Filter != a.actual.Filter {
+		updateMask = append(updateMask, "filter")
+	}
+
+	latest := a.actual
+	if len(updateMask) != 0 {
+		log.Info("updating logView", "name", a.fullyQualifiedName(), "updateMask", updateMask)
+
+		updated, err := a.viewClient.Patch(a.fullyQualifiedName(), desired).UpdateMask(strings.Join(updateMask, ",")).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("logView %s update failed: %w", a.fullyQualifiedName(), err)
+		}
+		latest = updated
+	}
+
+	status := LogViewStatus_FromProto(mapCtx, latest)
+	if mapCtx.Err() != nil {
+		return mapCtx.Err()
+	}
+	return setStatus(u, status)
+}
+
+func (a *logViewAdapter) Export(ctx context.Context) (*unstructured.Unstructured, error) {
+	if a.actual == nil {
+		return nil, fmt.Errorf("logView %q not found", a.fullyQualifiedName())
+	}
+
+	mapCtx := &direct.MapContext{}
+	obj := &v1beta1.LoggingLogView{}
+	obj.Spec = direct.ValueOf(LogViewSpec_FromProto(mapCtx, a.actual))
+	if mapCtx.Err() != nil {
+		return nil, mapCtx.Err()
+	}
+	obj.Spec.BucketRef = v1alpha1.ResourceRef{External: a.bucket}
+	obj.Spec.ResourceID = direct.LazyPtr(a.resourceID)
+
+	uObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting logView to unstructured %w", err)
+	}
+
+	u := &unstructured.Unstructured{Object: uObj}
+	u.SetGroupVersionKind(v1beta1.LoggingLogViewGVK)
+	u.SetName(a.resourceID)
+	return u, nil
+}
+
+func (a *logViewAdapter) fullyQualifiedName() string {
+	return a.bucket + "/views/" + a.resourceID
+}
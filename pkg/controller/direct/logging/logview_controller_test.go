@@ -0,0 +1,188 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "google.golang.org/api/logging/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct/directbase"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/lifecyclehandler"
+)
+
+// newTestViewsService returns a views client for a fake logging API, which echoes back the view in each request.
+func newTestViewsService(t *testing.T) (*api.LocationsBucketsViewsService, *[]recordedRequest) {
+	t.Helper()
+
+	service, requests := newTestLoggingService(t, func(req recordedRequest) any {
+		view := &api.LogView{}
+		if len(req.body) != 0 {
+			if err := json.Unmarshal(req.body, view); err != nil {
+				t.Errorf("parsing request body: %v", err)
+			}
+		}
+		view.CreateTime = "2024-01-02T03:04:05Z"
+		return view
+	})
+	return api.NewLocationsBucketsViewsService(service), requests
+}
+
+func TestLogViewSpecRoundTrip(t *testing.T) {
+	spec := &v1beta1.LoggingLogViewSpec{
+		Description: direct.PtrTo("my view"),
+		Filter:      direct.PtrTo(`LOG_ID("stdout")`),
+	}
+	mapCtx := &direct.MapContext{}
+	got := LogViewSpec_FromProto(mapCtx, LogViewSpec_ToProto(mapCtx, spec))
+	if mapCtx.Err() != nil {
+		t.Fatalf("mapping failed: %v", mapCtx.Err())
+	}
+	if diff := cmp.Diff(spec, got); diff != "" {
+		t.Errorf("spec did not round-trip (-want +got):\n%s", diff)
+	}
+}
+
+func TestLogViewCreate(t *testing.T) {
+	ctx := context.Background()
+	viewClient, requests := newTestViewsService(t)
+
+	a := &logViewAdapter{
+		bucket:     testLogBucketFQN,
+		resourceID: "my-view",
+		desired: &v1beta1.LoggingLogView{
+			Spec: v1beta1.LoggingLogViewSpec{Filter: direct.PtrTo(`LOG_ID("stdout")`)},
+		},
+		viewClient: viewClient,
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := a.Create(ctx, directbase.NewCreateOperation(nil, u)); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected exactly one request, got %+v", *requests)
+	}
+	got := (*requests)[0]
+	if got.method != http.MethodPost || got.path != "/v2/"+testLogBucketFQN+"/views" {
+		t.Errorf("unexpected request %s %s", got.method, got.path)
+	}
+	if resourceID, _, _ := unstructured.NestedString(u.Object, "spec", "resourceID"); resourceID != "my-view" {
+		t.Errorf("unexpected spec.resourceID %q", resourceID)
+	}
+	if createTime, _, _ := unstructured.NestedString(u.Object, "status", "createTime"); createTime != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected status.createTime %q", createTime)
+	}
+}
+
+func TestLogViewUpdateFilter(t *testing.T) {
+	ctx := context.Background()
+	viewClient, requests := newTestViewsService(t)
+
+	a := &logViewAdapter{
+		bucket:     testLogBucketFQN,
+		resourceID: "my-view",
+		desired: &v1beta1.LoggingLogView{
+			Spec: v1beta1.LoggingLogViewSpec{
+				Description: direct.PtrTo("my view"),
+				Filter:      direct.PtrTo(`LOG_ID("stderr")`),
+			},
+		},
+		actual: &api.LogView{
+			Name:        testLogBucketFQN + "/views/my-view",
+			Description: "my view",
+			Filter:      `LOG_ID("stdout")`,
+		},
+		viewClient: viewClient,
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := a.Update(ctx, directbase.NewUpdateOperation(lifecyclehandler.LifecycleHandler{}, nil, u)); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected exactly one request, got %+v", *requests)
+	}
+	got := (*requests)[0]
+	if got.method != http.MethodPatch || got.path != "/v2/"+testLogBucketFQN+"/views/my-view" {
+		t.Errorf("unexpected request %s %s", got.method, got.path)
+	}
+	if got.updateMask != "filter" {
+		t.Errorf("unexpected updateMask; got %q, want %q", got.updateMask, "filter")
+	}
+}
+
+func TestResolveLogViewBucket(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		spec    v1beta1.LoggingLogViewSpec
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "bucket name",
+			spec: v1beta1.LoggingLogViewSpec{BucketRef: v1alpha1.ResourceRef{External: "folders/123/locations/global/buckets/my-bucket"}},
+			want: "folders/123/locations/global/buckets/my-bucket",
+		},
+		{
+			name: "bucket ID",
+			spec: v1beta1.LoggingLogViewSpec{
+				BucketRef:  v1alpha1.ResourceRef{External: "my-bucket"},
+				ProjectRef: &v1alpha1.ResourceRef{External: "projects/my-project"},
+				Location:   direct.PtrTo("global"),
+			},
+			want: testLogBucketFQN,
+		},
+		{
+			name:    "_Required bucket",
+			spec:    v1beta1.LoggingLogViewSpec{BucketRef: v1alpha1.ResourceRef{External: "projects/my-project/locations/global/buckets/_Required"}},
+			wantErr: true,
+		},
+		{
+			name:    "malformed bucket",
+			spec:    v1beta1.LoggingLogViewSpec{BucketRef: v1alpha1.ResourceRef{External: "projects/my-project/buckets/my-bucket"}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolveLogViewBucket(ctx, nil, &v1beta1.LoggingLogView{Spec: tc.spec})
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got bucket %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("unexpected bucket; got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
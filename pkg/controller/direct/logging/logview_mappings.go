@@ -0,0 +1,54 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	api "google.golang.org/api/logging/v2"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/clients/generated/apis/logging/v1beta1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/direct"
+)
+
+// LogViewSpec_FromProto maps the mutable fields of a LogView to the LoggingLogView spec.
+// The bucket and parent references are not part of the LogView body and are left unset.
+func LogViewSpec_FromProto(mapCtx *direct.MapContext, in *api.LogView) *v1beta1.LoggingLogViewSpec {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogViewSpec{}
+	out.Description = direct.LazyPtr(in.Description)
+	out.Filter = direct.LazyPtr(in.Filter)
+	return out
+}
+
+func LogViewSpec_ToProto(mapCtx *direct.MapContext, in *v1beta1.LoggingLogViewSpec) *api.LogView {
+	if in == nil {
+		return nil
+	}
+	out := &api.LogView{}
+	out.Description = direct.ValueOf(in.Description)
+	out.Filter = direct.ValueOf(in.Filter)
+	return out
+}
+
+func LogViewStatus_FromProto(mapCtx *direct.MapContext, in *api.LogView) *v1beta1.LoggingLogViewStatus {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.LoggingLogViewStatus{}
+	out.CreateTime = direct.LazyPtr(in.CreateTime)
+	out.UpdateTime = direct.LazyPtr(in.UpdateTime)
+	return out
+}
@@ -50,8 +50,32 @@ func (c *MapContext) NotImplemented() {
 	c.Errorf("function %q not implemented", functionName)
 }
 
+// Err returns the errors accumulated while mapping, or nil if there were none.
+// Multiple errors are rendered on a single line, separated by "; ",
+// so that they read well when surfaced in a status condition.
 func (c *MapContext) Err() error {
-	return errors.Join(c.errs...)
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return &mapErrors{errs: c.errs}
+}
+
+// mapErrors is the error returned by MapContext.Err.
+type mapErrors struct {
+	errs []error
+}
+
+func (e *mapErrors) Error() string {
+	var msgs []string
+	for _, err := range e.errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap allows errors.Is and errors.As to match any of the accumulated errors.
+func (e *mapErrors) Unwrap() []error {
+	return e.errs
 }
 
 type ProtoEnum interface {
@@ -59,6 +83,8 @@ type ProtoEnum interface {
 	Descriptor() protoreflect.EnumDescriptor
 }
 
+// Slice_ToProto maps each element of a KRM slice to a proto message with mapper, which records any errors on mapCtx.
+// A nil slice maps to nil and an empty slice to an empty slice, so callers can tell "unset" from "cleared".
 func Slice_ToProto[T, U any](mapCtx *MapContext, in []T, mapper func(mapCtx *MapContext, in *T) *U) []*U {
 	if in == nil {
 		return nil
@@ -72,6 +98,8 @@ func Slice_ToProto[T, U any](mapCtx *MapContext, in []T, mapper func(mapCtx *Map
 	return outSlice
 }
 
+// Slice_FromProto maps each proto message of a slice to a KRM value with mapper, which records any errors on mapCtx.
+// As with Slice_ToProto, nil and empty slices are preserved.
 func Slice_FromProto[T, U any](mapCtx *MapContext, in []*T, mapper func(mapCtx *MapContext, in *T) *U) []U {
 	if in == nil {
 		return nil
@@ -172,6 +200,8 @@ func LazyPtr[V comparable](v V) *V {
 	return &v
 }
 
+// StringTimestamp_FromProto formats the timestamp in its canonical form: RFC3339 in UTC (AsTime is always UTC),
+// with nanosecond precision and trailing zeros trimmed (e.g. "2024-01-02T03:04:05.1Z").
 func StringTimestamp_FromProto(mapCtx *MapContext, ts *timestamppb.Timestamp) *string {
 	if ts == nil {
 		return nil
@@ -180,6 +210,8 @@ func StringTimestamp_FromProto(mapCtx *MapContext, ts *timestamppb.Timestamp) *s
 	return &formatted
 }
 
+// StringTimestamp_ToProto parses an RFC3339 timestamp with any offset, keeping nanosecond precision,
+// so that StringTimestamp_FromProto(StringTimestamp_ToProto(s)) is the canonical form of s.
 func StringTimestamp_ToProto(mapCtx *MapContext, s *string) *timestamppb.Timestamp {
 	if s == nil {
 		return nil
@@ -187,6 +219,7 @@ func StringTimestamp_ToProto(mapCtx *MapContext, s *string) *timestamppb.Timesta
 	t, err := time.Parse(time.RFC3339Nano, *s)
 	if err != nil {
 		mapCtx.Errorf("invalid timestamp %q", *s)
+		return nil
 	}
 	ts := timestamppb.New(t)
 	return ts
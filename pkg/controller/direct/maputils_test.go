@@ -43,3 +43,149 @@ func TestStringDuration_ToProto(t *testing.T) {
 		t.Fatalf("google.protobuf.Duration -> String error: %s", mapctx.Err())
 	}
 }
+
+func TestStringTimestamp_RoundTrip(t *testing.T) {
+	mapctx := &MapContext{}
+	s := "2024-03-04T10:30:05.123456789+05:30"
+	ts := StringTimestamp_ToProto(mapctx, &s)
+	if mapctx.Err() != nil {
+		t.Fatalf("string -> google.protobuf.Timestamp error: %s", mapctx.Err())
+	}
+	if ts.Seconds != 1709528405 || ts.Nanos != 123456789 {
+		t.Fatalf("string -> google.protobuf.Timestamp, expect \"seconds:1709528405 nanos:123456789\", got %s", ts)
+	}
+
+	want := "2024-03-04T05:00:05.123456789Z"
+	krm := StringTimestamp_FromProto(mapctx, ts)
+	if *krm != want {
+		t.Fatalf("google.protobuf.Timestamp -> string, expect %q, got %q", want, *krm)
+	}
+	if again := StringTimestamp_FromProto(mapctx, StringTimestamp_ToProto(mapctx, krm)); *again != want {
+		t.Fatalf("canonical timestamp did not round-trip, expect %q, got %q", want, *again)
+	}
+}
+
+func TestStringTimestamp_ToProtoInvalid(t *testing.T) {
+	mapctx := &MapContext{}
+	s := "yesterday"
+	if ts := StringTimestamp_ToProto(mapctx, &s); ts != nil {
+		t.Fatalf("expected nil timestamp for %q, got %s", s, ts)
+	}
+	if mapctx.Err() == nil {
+		t.Fatalf("expected an error for %q", s)
+	}
+}
+
+func TestMapContext_ErrCombinesErrors(t *testing.T) {
+	mapCtx := &MapContext{}
+	if err := mapCtx.Err(); err != nil {
+		t.Fatalf("expected no error from an empty MapContext, got %v", err)
+	}
+
+	badTimestamp := "not-a-timestamp"
+	StringTimestamp_ToProto(mapCtx, &badTimestamp)
+	badDuration := "forever"
+	StringDuration_ToProto(mapCtx, &badDuration)
+
+	err := mapCtx.Err()
+	if err == nil {
+		t.Fatalf("expected an error after two mapping failures")
+	}
+	want := `invalid timestamp "not-a-timestamp"; invalid duration "forever"`
+	if got := err.Error(); got != want {
+		t.Errorf("unexpected combined error message; got %q, want %q", got, want)
+	}
+}
+
+func TestSlice_ToProto(t *testing.T) {
+	// parse maps a duration string, recording an error on mapCtx if it is invalid.
+	parse := func(mapCtx *MapContext, in *string) *durationpb.Duration {
+		return StringDuration_ToProto(mapCtx, in)
+	}
+
+	grid := []struct {
+		name    string
+		in      []string
+		want    []int64
+		wantNil bool
+		wantErr string
+	}{
+		{name: "nil", in: nil, wantNil: true},
+		{name: "empty", in: []string{}, want: []int64{}},
+		{name: "populated", in: []string{"1s", "60s"}, want: []int64{1, 60}},
+		{name: "invalid element", in: []string{"1s", "forever", "never"}, want: []int64{1, 0, 0}, wantErr: `invalid duration "forever"; invalid duration "never"`},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			mapCtx := &MapContext{}
+			got := Slice_ToProto(mapCtx, g.in, parse)
+			if (got == nil) != g.wantNil {
+				t.Fatalf("Slice_ToProto(%#v) returned %#v; want nil: %v", g.in, got, g.wantNil)
+			}
+			if len(got) != len(g.want) {
+				t.Fatalf("Slice_ToProto(%#v) returned %d elements, want %d", g.in, len(got), len(g.want))
+			}
+			for i, d := range got {
+				if d.GetSeconds() != g.want[i] {
+					t.Errorf("element %d: got %v, want %ds", i, d, g.want[i])
+				}
+			}
+			wantMapErr(t, mapCtx, g.wantErr)
+		})
+	}
+}
+
+func TestSlice_FromProto(t *testing.T) {
+	// format maps a duration, recording an error on mapCtx if it is out of range.
+	format := func(mapCtx *MapContext, in *durationpb.Duration) *string {
+		if err := in.CheckValid(); err != nil {
+			mapCtx.Errorf("invalid duration of %ds and %dns", in.GetSeconds(), in.GetNanos())
+		}
+		return StringDuration_FromProto(mapCtx, in)
+	}
+
+	grid := []struct {
+		name    string
+		in      []*durationpb.Duration
+		want    []string
+		wantNil bool
+		wantErr string
+	}{
+		{name: "nil", in: nil, wantNil: true},
+		{name: "empty", in: []*durationpb.Duration{}, want: []string{}},
+		{name: "populated", in: []*durationpb.Duration{{Seconds: 1}, {Seconds: 2}}, want: []string{"1s", "2s"}},
+		{name: "invalid element", in: []*durationpb.Duration{{Seconds: 1}, {Seconds: 1, Nanos: -1}}, want: []string{"1s", "999.999999ms"}, wantErr: "invalid duration of 1s and -1ns"},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			mapCtx := &MapContext{}
+			got := Slice_FromProto(mapCtx, g.in, format)
+			if (got == nil) != g.wantNil {
+				t.Fatalf("Slice_FromProto(%v) returned %#v; want nil: %v", g.in, got, g.wantNil)
+			}
+			if len(got) != len(g.want) {
+				t.Fatalf("Slice_FromProto(%v) returned %v, want %v", g.in, got, g.want)
+			}
+			for i := range got {
+				if got[i] != g.want[i] {
+					t.Errorf("element %d: got %q, want %q", i, got[i], g.want[i])
+				}
+			}
+			wantMapErr(t, mapCtx, g.wantErr)
+		})
+	}
+}
+
+// wantMapErr checks that mapCtx holds the error wantErr, or no error if wantErr is empty.
+func wantMapErr(t *testing.T, mapCtx *MapContext, wantErr string) {
+	t.Helper()
+
+	err := mapCtx.Err()
+	if wantErr == "" {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	} else if err == nil || err.Error() != wantErr {
+		t.Errorf("unexpected error; got %v, want %q", err, wantErr)
+	}
+}
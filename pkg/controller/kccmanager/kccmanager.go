@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	operatorv1beta1 "github.com/GoogleCloudPlatform/k8s-config-connector/operator/pkg/apis/core/v1beta1"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/apis"
@@ -74,6 +75,11 @@ type Config struct {
 	// StateIntoSpecUserOverride is an optional field. If specified, it is used
 	// as the default value for 'state-into-spec' annotation if unset.
 	StateIntoSpecUserOverride *string
+
+	// PendingStateRequeuePeriod is the period at which resources in a pending
+	// state (e.g. a KMS import job that is generating its key material) are
+	// re-read. If 0, it is derived from each resource's reconcile period.
+	PendingStateRequeuePeriod time.Duration
 }
 
 // Creates a new controller-runtime manager.Manager and starts all of the KCC controllers pointed at the
@@ -155,6 +161,8 @@ func New(ctx context.Context, restConfig *rest.Config, cfg Config) (manager.Mana
 		DclConfig:    dclConfig,
 		DclConverter: dclConverter,
 		Defaulters:   []k8s.Defaulter{stateIntoSpecDefaulter},
+
+		PendingStateRequeuePeriod: cfg.PendingStateRequeuePeriod,
 	}
 	// Register the registration controller, which will dynamically create controllers for
 	// all our resources.
@@ -229,6 +229,31 @@ func (r *LifecycleHandler) HandleUpToDate(ctx context.Context, resource *k8s.Res
 	return nil
 }
 
+// HandleUpdatePending records that the underlying resource is up to date with the spec, but is still
+// in a transitional state in which it cannot be used yet (e.g. its key material is being generated).
+// The resource is not Ready until the controller calls HandleUpToDate.
+func (r *LifecycleHandler) HandleUpdatePending(ctx context.Context, resource *k8s.Resource, msg string) error {
+	setCondition(resource, corev1.ConditionFalse, k8s.UpdatePending, msg)
+	if err := r.updateAPIServer(ctx, resource); err != nil {
+		return err
+	}
+
+	r.recordEvent(ctx, resource, corev1.EventTypeNormal, k8s.UpdatePending, msg)
+	return nil
+}
+
+// HandleExpired records that the underlying resource is up to date with the spec, but has expired
+// and can no longer be used (e.g. a KMS import job can no longer import keys).
+func (r *LifecycleHandler) HandleExpired(ctx context.Context, resource *k8s.Resource, msg string) error {
+	setCondition(resource, corev1.ConditionFalse, k8s.Expired, msg)
+	if err := r.updateAPIServer(ctx, resource); err != nil {
+		return err
+	}
+
+	r.recordEvent(ctx, resource, corev1.EventTypeWarning, k8s.Expired, msg)
+	return nil
+}
+
 func (r *LifecycleHandler) HandleUnresolvableDeps(ctx context.Context, resource *k8s.Resource, originErr error) error {
 	reason, err := reasonForUnresolvableDeps(originErr)
 	if err != nil {
@@ -379,7 +404,14 @@ func setCondition(resource *k8s.Resource, status corev1.ConditionStatus, reason,
 			newReadyCondition.LastTransitionTime = currentReadyCondition.LastTransitionTime
 		}
 	}
-	resource.Status["conditions"] = []k8sv1alpha1.Condition{newReadyCondition}
+	// Conditions of other types (e.g. Expired) are set by the controllers, and are kept.
+	conditions := []k8sv1alpha1.Condition{newReadyCondition}
+	for _, c := range k8s.GetConditions(resource) {
+		if c.Type != k8sv1alpha1.ReadyConditionType {
+			conditions = append(conditions, c)
+		}
+	}
+	resource.Status["conditions"] = conditions
 }
 
 func setObservedGeneration(resource *k8s.Resource, observedGeneration int64) {
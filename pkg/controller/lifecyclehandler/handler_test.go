@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	corekccv1alpha1 "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/apis/core/v1alpha1"
+	k8sv1alpha1 "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/apis/k8s/v1alpha1"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/test"
 	testvariable "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/test/resourcefixture/variable"
@@ -28,6 +29,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestIsOrphaned(t *testing.T) {
@@ -258,3 +261,105 @@ func Test_reasonForUnresolvableDeps(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUpdatePendingThenUpToDate(t *testing.T) {
+	ctx := context.Background()
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.GroupVersionKind{Group: "kms.cnrm.cloud.google.com", Version: "v1alpha1", Kind: "KMSKeyRingImportJob"})
+	u.SetNamespace("default")
+	u.SetName("test")
+	kubeClient := fake.NewClientBuilder().
+		WithObjects(u).
+		WithStatusSubresource(u).
+		Build()
+	h := NewLifecycleHandler(kubeClient, record.NewFakeRecorder(10))
+
+	// readyCondition reads back the Ready condition stored in the API server.
+	readyCondition := func(t *testing.T) (corev1.ConditionStatus, string, string) {
+		t.Helper()
+
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(u.GroupVersionKind())
+		if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test"}, got); err != nil {
+			t.Fatalf("error getting resource: %v", err)
+		}
+		resource, err := k8s.NewResource(got)
+		if err != nil {
+			t.Fatalf("error parsing resource: %v", err)
+		}
+		condition, found := k8s.GetReadyCondition(resource)
+		if !found {
+			t.Fatalf("no Ready condition in status %v", resource.Status)
+		}
+		return condition.Status, condition.Reason, condition.Message
+	}
+
+	resource, err := k8s.NewResource(u.DeepCopy())
+	if err != nil {
+		t.Fatalf("error parsing resource: %v", err)
+	}
+	resource.Status = map[string]interface{}{"state": "PENDING_GENERATION"}
+	msg := "ImportJob key material is still being generated"
+	if err := h.HandleUpdatePending(ctx, resource, msg); err != nil {
+		t.Fatalf("HandleUpdatePending failed: %v", err)
+	}
+	if status, reason, gotMsg := readyCondition(t); status != corev1.ConditionFalse || reason != k8s.UpdatePending || gotMsg != msg {
+		t.Errorf("unexpected Ready condition while pending; got (%v, %q, %q), want (False, %q, %q)", status, reason, gotMsg, k8s.UpdatePending, msg)
+	}
+
+	resource.Status["state"] = "ACTIVE"
+	resource.Status["publicKey"] = []interface{}{map[string]interface{}{"pem": "-----BEGIN PUBLIC KEY-----"}}
+	if err := h.HandleUpToDate(ctx, resource); err != nil {
+		t.Fatalf("HandleUpToDate failed: %v", err)
+	}
+	if status, reason, gotMsg := readyCondition(t); status != corev1.ConditionTrue || reason != k8s.UpToDate || gotMsg != k8s.UpToDateMessage {
+		t.Errorf("unexpected Ready condition once active; got (%v, %q, %q), want (True, %q, %q)", status, reason, gotMsg, k8s.UpToDate, k8s.UpToDateMessage)
+	}
+}
+
+func TestHandleExpiredKeepsOtherConditions(t *testing.T) {
+	ctx := context.Background()
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.GroupVersionKind{Group: "kms.cnrm.cloud.google.com", Version: "v1alpha1", Kind: "KMSKeyRingImportJob"})
+	u.SetNamespace("default")
+	u.SetName("test")
+	kubeClient := fake.NewClientBuilder().
+		WithObjects(u).
+		WithStatusSubresource(u).
+		Build()
+	h := NewLifecycleHandler(kubeClient, record.NewFakeRecorder(10))
+
+	resource, err := k8s.NewResource(u.DeepCopy())
+	if err != nil {
+		t.Fatalf("error parsing resource: %v", err)
+	}
+	expired := k8sv1alpha1.Condition{
+		Type:    "Expired",
+		Status:  corev1.ConditionTrue,
+		Reason:  k8s.Expired,
+		Message: "The resource expired at 2024-01-04T00:00:00Z and can no longer be used",
+	}
+	k8s.SetCondition(resource, expired)
+	if err := h.HandleExpired(ctx, resource, expired.Message); err != nil {
+		t.Fatalf("HandleExpired failed: %v", err)
+	}
+
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(u.GroupVersionKind())
+	if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test"}, got); err != nil {
+		t.Fatalf("error getting resource: %v", err)
+	}
+	gotResource, err := k8s.NewResource(got)
+	if err != nil {
+		t.Fatalf("error parsing resource: %v", err)
+	}
+	want := []k8sv1alpha1.Condition{
+		k8s.NewCustomReadyCondition(corev1.ConditionFalse, k8s.Expired, expired.Message),
+		expired,
+	}
+	if conditions := k8s.GetConditions(gotResource); !k8s.ConditionSlicesEqual(conditions, want) {
+		t.Errorf("unexpected conditions; got %+v, want %+v", conditions, want)
+	}
+}
@@ -75,16 +75,17 @@ func Add(mgr manager.Manager, rd *controller.Deps, regFunc registrationFunc) err
 	}
 
 	r := &ReconcileRegistration{
-		Client:           mgr.GetClient(),
-		provider:         rd.TfProvider,
-		smLoader:         rd.TfLoader,
-		dclConfig:        rd.DclConfig,
-		dclConverter:     rd.DclConverter,
-		mgr:              mgr,
-		controllers:      make(map[string]map[string]controllerContext),
-		registrationFunc: regFunc,
-		defaulters:       rd.Defaulters,
-		jitterGenerator:  rd.JitterGen,
+		Client:                    mgr.GetClient(),
+		provider:                  rd.TfProvider,
+		smLoader:                  rd.TfLoader,
+		dclConfig:                 rd.DclConfig,
+		dclConverter:              rd.DclConverter,
+		mgr:                       mgr,
+		controllers:               make(map[string]map[string]controllerContext),
+		registrationFunc:          regFunc,
+		defaulters:                rd.Defaulters,
+		jitterGenerator:           rd.JitterGen,
+		pendingStateRequeuePeriod: rd.PendingStateRequeuePeriod,
 	}
 	c, err := crcontroller.New(controllerName, mgr,
 		crcontroller.Options{
@@ -111,6 +112,8 @@ type ReconcileRegistration struct {
 	registrationFunc registrationFunc
 	defaulters       []k8s.Defaulter
 	jitterGenerator  jitter.Generator
+	// pendingStateRequeuePeriod is passed to the tf-based controllers; see tf.WithPendingStateRequeuePeriod.
+	pendingStateRequeuePeriod time.Duration
 
 	mu sync.Mutex
 }
@@ -283,7 +286,8 @@ func registerDefaultController(r *ReconcileRegistration, config *config.Controll
 		}
 		// register controllers for tf-based CRDs
 		if hasTerraformController {
-			su, err := tf.Add(r.mgr, crd, r.provider, r.smLoader, r.defaulters, r.jitterGenerator, useLegacyPredicate)
+			su, err := tf.Add(r.mgr, crd, r.provider, r.smLoader, r.defaulters, r.jitterGenerator, useLegacyPredicate,
+				tf.WithPendingStateRequeuePeriod(r.pendingStateRequeuePeriod))
 			if err != nil {
 				return nil, fmt.Errorf("error adding terraform controller for %v to a manager: %w", crd.Spec.Names.Kind, err)
 			}
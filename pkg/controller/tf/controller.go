@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/metrics"
 	kccpredicate "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/predicate"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/ratelimiter"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/reconciliationinterval"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/resourceactuation"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/resourcewatcher"
 	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/execution"
@@ -74,18 +75,23 @@ type Reconciler struct {
 	smLoader        *servicemappingloader.ServiceMappingLoader
 	logger          logr.Logger
 	jitterGenerator jitter.Generator
+	// pendingStateRequeueBase overrides the period derived from the reconcile period at which resources
+	// in a pending state are re-read; see WithPendingStateRequeuePeriod.
+	pendingStateRequeueBase time.Duration
+	// now returns the current time; see WithClock.
+	now func() time.Time
 	// Fields used for triggering reconciliations when dependencies are ready
 	immediateReconcileRequests chan event.GenericEvent
 	resourceWatcherRoutines    *semaphore.Weighted // Used to cap number of goroutines watching unready dependencies
 }
 
-func Add(mgr manager.Manager, crd *apiextensions.CustomResourceDefinition, provider *tfschema.Provider, smLoader *servicemappingloader.ServiceMappingLoader, defaulters []k8s.Defaulter, jitterGenerator jitter.Generator, additionalPredicate predicate.Predicate) (k8s.SchemaReferenceUpdater, error) {
+func Add(mgr manager.Manager, crd *apiextensions.CustomResourceDefinition, provider *tfschema.Provider, smLoader *servicemappingloader.ServiceMappingLoader, defaulters []k8s.Defaulter, jitterGenerator jitter.Generator, additionalPredicate predicate.Predicate, opts ...ReconcilerOption) (k8s.SchemaReferenceUpdater, error) {
 	kind := crd.Spec.Names.Kind
 	apiVersion := k8s.GetAPIVersionFromCRD(crd)
 	controllerName := fmt.Sprintf("%v-controller", strings.ToLower(kind))
 	immediateReconcileRequests := make(chan event.GenericEvent, k8s.ImmediateReconcileRequestsBufferSize)
 	resourceWatcherRoutines := semaphore.NewWeighted(k8s.MaxNumResourceWatcherRoutines)
-	r, err := NewReconciler(mgr, crd, provider, smLoader, immediateReconcileRequests, resourceWatcherRoutines, defaulters, jitterGenerator)
+	r, err := NewReconciler(mgr, crd, provider, smLoader, immediateReconcileRequests, resourceWatcherRoutines, defaulters, jitterGenerator, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -121,14 +127,15 @@ func NewReconciler(mgr manager.Manager,
 	immediateReconcileRequests chan event.GenericEvent,
 	resourceWatcherRoutines *semaphore.Weighted,
 	defaulters []k8s.Defaulter,
-	jitterGenerator jitter.Generator) (*Reconciler, error) {
+	jitterGenerator jitter.Generator,
+	opts ...ReconcilerOption) (*Reconciler, error) {
 
 	if jitterGenerator == nil {
 		return nil, fmt.Errorf("jitterGenerator must not be nil")
 	}
 
 	controllerName := fmt.Sprintf("%v-controller", strings.ToLower(crd.Spec.Names.Kind))
-	return &Reconciler{
+	r := &Reconciler{
 		LifecycleHandler: lifecyclehandler.NewLifecycleHandler(
 			mgr.GetClient(),
 			mgr.GetEventRecorderFor(controllerName),
@@ -154,7 +161,12 @@ func NewReconciler(mgr manager.Manager,
 		immediateReconcileRequests: immediateReconcileRequests,
 		resourceWatcherRoutines:    resourceWatcherRoutines,
 		jitterGenerator:            jitterGenerator,
-	}, nil
+		now:                        time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (res reconcile.Result, err error) {
@@ -247,6 +259,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (res
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+	reconcilePeriod, err := r.reconcilePeriod(u)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if period, ok := r.pendingStateRequeueAfter(resource.Kind, resource.Status, reconcilePeriod); ok {
+		r.logger.Info("underlying resource is still pending; requeuing", "resource", k8s.GetNamespacedName(resource), "time to next reconciliation", period)
+		return reconcile.Result{RequeueAfter: period}, nil
+	}
 	r.logger.Info("successfully finished reconcile", "resource", k8s.GetNamespacedName(resource), "time to next reconciliation", jitteredPeriod)
 	return reconcile.Result{RequeueAfter: jitteredPeriod}, nil
 }
@@ -524,14 +544,47 @@ func (r *Reconciler) handleUpToDate(ctx context.Context, resource *krmtotf.Resou
 	if err := resourceoverrides.Handler.PostActuationTransform(resource.Original, &resource.Resource, liveState, nil); err != nil {
 		return r.HandlePostActuationTransformFailed(ctx, &resource.Resource, fmt.Errorf("error applying post-actuation transformation to resource '%v': %w", resource.GetNamespacedName(), err))
 	}
-	if !k8s.IsSpecOrStatusUpdateRequired(&resource.Resource, resource.Original) &&
-		!k8s.IsAnnotationsUpdateRequired(&resource.Resource, resource.Original) &&
-		k8s.ReadyConditionMatches(&resource.Resource, corev1.ConditionTrue, k8s.UpToDate, k8s.UpToDateMessage) {
+	// The Expired condition of a resource that expires is kept up to date alongside the Ready condition, and
+	// a resource that has expired is up to date with its spec, but not Ready.
+	if condition, ok := r.expiredCondition(resource.Kind, resource.Status); ok {
+		k8s.SetCondition(&resource.Resource, condition)
+		if condition.Status == corev1.ConditionTrue {
+			if isUpToDateWithReadyCondition(resource, corev1.ConditionFalse, k8s.Expired, condition.Message) {
+				return nil
+			}
+			return r.HandleExpired(ctx, &resource.Resource, condition.Message)
+		}
+	}
+	// A resource that is still pending (e.g. generating key material) is up to date with its spec, but not Ready yet.
+	if msg, pending := pendingStateMessage(resource.Kind, resource.Status); pending {
+		if isUpToDateWithReadyCondition(resource, corev1.ConditionFalse, k8s.UpdatePending, msg) {
+			return nil
+		}
+		return r.HandleUpdatePending(ctx, &resource.Resource, msg)
+	}
+	if isUpToDateWithReadyCondition(resource, corev1.ConditionTrue, k8s.UpToDate, k8s.UpToDateMessage) {
 		return nil
 	}
 	return r.HandleUpToDate(ctx, &resource.Resource)
 }
 
+// reconcilePeriod returns the mean period at which the resource is reconciled again, which JitteredReenqueue
+// jitters: the reconcile interval from the resource's annotation or, if it is not set, from the service mapping.
+func (r *Reconciler) reconcilePeriod(u *unstructured.Unstructured) (time.Duration, error) {
+	if val, ok := k8s.GetAnnotation(k8s.ReconcileIntervalInSecondsAnnotation, u); ok {
+		return reconciliationinterval.MeanReconcileReenqueuePeriodFromAnnotation(val)
+	}
+	return reconciliationinterval.MeanReconcileReenqueuePeriod(r.schemaRef.GVK, r.smLoader, nil), nil
+}
+
+// isUpToDateWithReadyCondition returns whether resource has the given Ready condition and nothing else of it
+// needs to be written to the API server.
+func isUpToDateWithReadyCondition(resource *krmtotf.Resource, status corev1.ConditionStatus, reason, msg string) bool {
+	return !k8s.IsSpecOrStatusUpdateRequired(&resource.Resource, resource.Original) &&
+		!k8s.IsAnnotationsUpdateRequired(&resource.Resource, resource.Original) &&
+		k8s.ReadyConditionMatches(&resource.Resource, status, reason, msg)
+}
+
 // isOrphaned returns whether the resource has been orphaned (i.e. its parent
 // Kubernetes resource has already been deleted). Note:
 // * A resource with no parent will always return false.
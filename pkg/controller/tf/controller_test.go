@@ -0,0 +1,131 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/controller/lifecyclehandler"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/krmtotf"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/servicemapping/servicemappingloader"
+	tfprovider "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/tf/provider"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestHandleUpToDateImportJobReadyCondition follows the Ready condition that the controller writes for an
+// import job as mockkms moves it from PENDING_GENERATION to ACTIVE.
+func TestHandleUpToDateImportJobReadyCondition(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	kms := newMockKMS(t, func() time.Time { return now })
+
+	smLoader, err := servicemappingloader.New()
+	if err != nil {
+		t.Fatalf("error creating service mapping loader: %v", err)
+	}
+	sm, err := smLoader.GetServiceMapping("kms.cnrm.cloud.google.com")
+	if err != nil {
+		t.Fatalf("error getting service mapping: %v", err)
+	}
+	provider := tfprovider.NewOrLogFatal(tfprovider.UnitTestConfig())
+
+	keyRing := "projects/" + mockKMSProjectID + "/locations/us-central1/keyRings/mykeyring"
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.GroupVersionKind{Group: "kms.cnrm.cloud.google.com", Version: "v1alpha1", Kind: "KMSKeyRingImportJob"})
+	u.SetNamespace("default")
+	u.SetName("myjob")
+	u.Object["spec"] = map[string]interface{}{
+		"keyRingRef":      map[string]interface{}{"external": keyRing},
+		"importMethod":    "RSA_OAEP_3072_SHA1_AES_256",
+		"protectionLevel": "SOFTWARE",
+		"resourceID":      "myjob",
+	}
+	kubeClient := fake.NewClientBuilder().
+		WithObjects(u).
+		WithStatusSubresource(u).
+		Build()
+	r := &Reconciler{
+		LifecycleHandler: lifecyclehandler.NewLifecycleHandler(kubeClient, record.NewFakeRecorder(10)),
+		now:              func() time.Time { return now },
+	}
+
+	// handleUpToDate writes the Ready condition for the import job that the mock returned, and returns the
+	// Ready condition of the resource read back from the API server.
+	handleUpToDate := func(job map[string]interface{}) (reason, msg string, status corev1.ConditionStatus) {
+		t.Helper()
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(u.GroupVersionKind())
+		if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "myjob"}, got); err != nil {
+			t.Fatalf("error getting resource: %v", err)
+		}
+		resource, err := krmtotf.NewResource(got, sm, provider)
+		if err != nil {
+			t.Fatalf("error parsing resource: %v", err)
+		}
+		state := map[string]interface{}{
+			"id":               job["name"],
+			"name":             job["name"],
+			"key_ring":         keyRing,
+			"import_job_id":    "myjob",
+			"import_method":    job["importMethod"],
+			"protection_level": job["protectionLevel"],
+			"state":            job["state"],
+			"expire_time":      job["expireTime"],
+		}
+		if pem, _, _ := unstructured.NestedString(job, "publicKey", "pem"); pem != "" {
+			state["public_key"] = []interface{}{map[string]interface{}{"pem": pem}}
+		}
+		if err := r.handleUpToDate(ctx, resource, krmtotf.MapToInstanceState(resource.TFResource, state), nil); err != nil {
+			t.Fatalf("handleUpToDate failed: %v", err)
+		}
+
+		if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "myjob"}, got); err != nil {
+			t.Fatalf("error getting resource: %v", err)
+		}
+		gotResource, err := k8s.NewResource(got)
+		if err != nil {
+			t.Fatalf("error parsing resource: %v", err)
+		}
+		ready, found := k8s.GetReadyCondition(gotResource)
+		if !found {
+			t.Fatalf("resource has no Ready condition; got status %v", gotResource.Status)
+		}
+		return ready.Reason, ready.Message, ready.Status
+	}
+
+	kms.do(t, "POST", "projects/"+mockKMSProjectID+"/locations/us-central1/keyRings?keyRingId=mykeyring", `{}`)
+	created := kms.do(t, "POST", keyRing+"/importJobs?importJobId=myjob",
+		`{"importMethod": "RSA_OAEP_3072_SHA1_AES_256", "protectionLevel": "SOFTWARE"}`)
+	reason, msg, status := handleUpToDate(created)
+	if status != corev1.ConditionFalse || reason != k8s.UpdatePending || msg != "ImportJob key material is still being generated" {
+		t.Errorf("unexpected Ready condition while key material is generated; got (%v, %q, %q)", status, reason, msg)
+	}
+
+	now = now.Add(time.Minute)
+	activated := kms.do(t, "GET", keyRing+"/importJobs/myjob", "")
+	reason, msg, status = handleUpToDate(activated)
+	if status != corev1.ConditionTrue || reason != k8s.UpToDate || msg != k8s.UpToDateMessage {
+		t.Errorf("unexpected Ready condition once the import job is active; got (%v, %q, %q)", status, reason, msg)
+	}
+}
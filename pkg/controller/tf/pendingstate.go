@@ -0,0 +1,223 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tf
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	k8sv1alpha1 "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/apis/k8s/v1alpha1"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// minPendingStateRequeuePeriod and maxPendingStateRequeuePeriod bound how
+	// often a resource in a pending state is re-read.
+	minPendingStateRequeuePeriod = 5 * time.Second
+	maxPendingStateRequeuePeriod = 30 * time.Second
+	// pendingStateRequeueDivisor is the fraction of the resource's regular
+	// reconcile period that is waited between re-reads while it is pending.
+	pendingStateRequeueDivisor = 20
+	// pendingStateRequeueJitter is the fraction by which the period between
+	// re-reads is randomly shortened or lengthened, so that many resources
+	// that became pending together are not all re-read at the same time.
+	pendingStateRequeueJitter = 0.2
+)
+
+// ReconcilerOption configures optional behavior of a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithPendingStateRequeuePeriod sets the period at which resources in a
+// pending state are re-read, instead of deriving it from the resource's
+// reconcile period; a period of 0 keeps the derived one. The period is still
+// jittered, and still never exceeds the reconcile period. The manager sets it
+// from its --pending-state-requeue-period flag.
+func WithPendingStateRequeuePeriod(period time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.pendingStateRequeueBase = period
+	}
+}
+
+// WithClock sets the function that returns the current time, which is used
+// to tell whether an expiring resource has expired; see expiredCondition.
+func WithClock(now func() time.Time) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.now = now
+	}
+}
+
+// pendingState describes the values of status.state during which the
+// underlying resource has been created but some of its output-only fields
+// (e.g. the public key of a KMS import job) are not yet available.
+type pendingState struct {
+	states []string
+	// message is the message of the Ready condition while the resource is pending.
+	message string
+}
+
+// pendingStates lists the pending states of each kind that has them.
+var pendingStates = map[string]pendingState{
+	"KMSKeyRingImportJob": {
+		states:  []string{"PENDING_GENERATION"},
+		message: "ImportJob key material is still being generated",
+	},
+}
+
+// pendingStateRequeuePeriod returns how long to wait before re-reading a
+// resource that is still in a pending state, and false if the resource is not
+// pending. The period is derived from the resource's regular reconcile period,
+// which is configurable through the reconcile-interval annotation or the
+// service mapping, and is clamped to
+// [minPendingStateRequeuePeriod, maxPendingStateRequeuePeriod]. A resource
+// that is never re-reconciled (i.e. whose reconcile period is 0) is not polled
+// either, and the period never exceeds the regular reconcile period.
+func pendingStateRequeuePeriod(kind string, status map[string]interface{}, reconcilePeriod time.Duration) (time.Duration, bool) {
+	if reconcilePeriod <= 0 {
+		return 0, false
+	}
+	if _, ok := pendingStateMessage(kind, status); !ok {
+		return 0, false
+	}
+	period := reconcilePeriod / pendingStateRequeueDivisor
+	if period < minPendingStateRequeuePeriod {
+		period = minPendingStateRequeuePeriod
+	}
+	if period > maxPendingStateRequeuePeriod {
+		period = maxPendingStateRequeuePeriod
+	}
+	if period > reconcilePeriod {
+		period = reconcilePeriod
+	}
+	return period, true
+}
+
+// pendingStateMessage returns the Ready condition message for a resource
+// that is in a pending state, and false if the resource is not pending.
+func pendingStateMessage(kind string, status map[string]interface{}) (string, bool) {
+	state, _, _ := unstructured.NestedString(status, "state")
+	if state == "" {
+		return "", false
+	}
+	pending, ok := pendingStates[kind]
+	if !ok {
+		return "", false
+	}
+	for _, s := range pending.states {
+		if s == state {
+			return pending.message, true
+		}
+	}
+	return "", false
+}
+
+// pendingStateRequeueAfter returns how long to wait before re-reading a
+// resource that is still in a pending state, and false if the resource is not
+// pending. It jitters the period from pendingStateRequeuePeriod (or the
+// configured base period) by up to pendingStateRequeueJitter either way.
+func (r *Reconciler) pendingStateRequeueAfter(kind string, status map[string]interface{}, reconcilePeriod time.Duration) (time.Duration, bool) {
+	period, ok := pendingStateRequeuePeriod(kind, status, reconcilePeriod)
+	if !ok {
+		return 0, false
+	}
+	if r.pendingStateRequeueBase > 0 {
+		period = r.pendingStateRequeueBase
+	}
+	return jitterPendingStateRequeuePeriod(period, reconcilePeriod), true
+}
+
+// jitterPendingStateRequeuePeriod returns a random duration in
+// [period * (1 - pendingStateRequeueJitter), period * (1 + pendingStateRequeueJitter)),
+// capped at the reconcile period.
+func jitterPendingStateRequeuePeriod(period, reconcilePeriod time.Duration) time.Duration {
+	lowest := time.Duration(float64(period) * (1 - pendingStateRequeueJitter))
+	jittered := wait.Jitter(lowest, 2*pendingStateRequeueJitter/(1-pendingStateRequeueJitter))
+	if jittered > reconcilePeriod {
+		jittered = reconcilePeriod
+	}
+	return jittered
+}
+
+// expiringResource describes how to tell whether the underlying resource of a
+// kind that expires has expired.
+type expiringResource struct {
+	// expireTimeField is the status field that holds the expiry time, in RFC3339 format.
+	expireTimeField string
+	// expiredStates are the values of status.state of an expired resource.
+	expiredStates []string
+}
+
+// expiringResources lists the kinds whose underlying resources expire.
+var expiringResources = map[string]expiringResource{
+	"KMSKeyRingImportJob": {
+		expireTimeField: "expireTime",
+		expiredStates:   []string{"EXPIRED"},
+	},
+}
+
+// expiredConditionType is the type of the condition that reports when a
+// resource of a kind in expiringResources expires. It is kept alongside the
+// Ready condition, which is False once the resource has expired.
+const expiredConditionType = "Expired"
+
+// notExpiredReason is the reason of the Expired condition of a resource that has not expired yet.
+const notExpiredReason = "NotExpired"
+
+// expiredCondition returns the Expired condition of a resource, and false if
+// the resource does not expire or its expiry time is not known yet. The
+// resource has expired once GCP reports it in an expired state, or once its
+// expiry time has passed. Until then the message gives the expiry time and,
+// coarsely, the time left, so that it only changes a few times over the
+// resource's life and not on every reconcile.
+func (r *Reconciler) expiredCondition(kind string, status map[string]interface{}) (k8sv1alpha1.Condition, bool) {
+	expiring, ok := expiringResources[kind]
+	if !ok {
+		return k8sv1alpha1.Condition{}, false
+	}
+	expireTime, _, _ := unstructured.NestedString(status, expiring.expireTimeField)
+	t, err := time.Parse(time.RFC3339, expireTime)
+	if err != nil {
+		return k8sv1alpha1.Condition{}, false
+	}
+	condition := k8sv1alpha1.Condition{
+		LastTransitionTime: metav1.Now().Format(time.RFC3339),
+		Type:               expiredConditionType,
+	}
+
+	state, _, _ := unstructured.NestedString(status, "state")
+	remaining := t.Sub(r.now())
+	if remaining <= 0 || slices.Contains(expiring.expiredStates, state) {
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = k8s.Expired
+		condition.Message = fmt.Sprintf("The resource expired at %s and can no longer be used", expireTime)
+		return condition, true
+	}
+	left := "more than a day"
+	switch {
+	case remaining <= time.Hour:
+		left = "less than an hour"
+	case remaining <= 24*time.Hour:
+		left = "less than a day"
+	}
+	condition.Status = corev1.ConditionFalse
+	condition.Reason = notExpiredReason
+	condition.Message = fmt.Sprintf("The resource expires at %s, in %s", expireTime, left)
+	return condition, true
+}
@@ -0,0 +1,342 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tf
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/common/projects"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/mockkms"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/mockgcp/pkg/storage"
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestPendingStateRequeuePeriod(t *testing.T) {
+	tests := []struct {
+		name            string
+		kind            string
+		status          map[string]interface{}
+		reconcilePeriod time.Duration
+		wantPending     bool
+		wantPeriod      time.Duration
+	}{
+		{
+			name:            "pending import job is requeued within the bounds",
+			kind:            "KMSKeyRingImportJob",
+			status:          map[string]interface{}{"state": "PENDING_GENERATION"},
+			reconcilePeriod: 10 * time.Minute,
+			wantPending:     true,
+			wantPeriod:      30 * time.Second,
+		},
+		{
+			name:            "short reconcile period is clamped to the minimum",
+			kind:            "KMSKeyRingImportJob",
+			status:          map[string]interface{}{"state": "PENDING_GENERATION"},
+			reconcilePeriod: time.Minute,
+			wantPending:     true,
+			wantPeriod:      minPendingStateRequeuePeriod,
+		},
+		{
+			name:            "period never exceeds the reconcile period",
+			kind:            "KMSKeyRingImportJob",
+			status:          map[string]interface{}{"state": "PENDING_GENERATION"},
+			reconcilePeriod: 2 * time.Second,
+			wantPending:     true,
+			wantPeriod:      2 * time.Second,
+		},
+		{
+			name:            "resource that is never reconciled again is not polled",
+			kind:            "KMSKeyRingImportJob",
+			status:          map[string]interface{}{"state": "PENDING_GENERATION"},
+			reconcilePeriod: 0,
+		},
+		{
+			name:            "active import job is not requeued",
+			kind:            "KMSKeyRingImportJob",
+			status:          map[string]interface{}{"state": "ACTIVE"},
+			reconcilePeriod: 10 * time.Minute,
+		},
+		{
+			name:            "import job without state is not requeued",
+			kind:            "KMSKeyRingImportJob",
+			status:          nil,
+			reconcilePeriod: 10 * time.Minute,
+		},
+		{
+			name:            "other kinds are not requeued",
+			kind:            "KMSKeyRing",
+			status:          map[string]interface{}{"state": "PENDING_GENERATION"},
+			reconcilePeriod: 10 * time.Minute,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPeriod, gotPending := pendingStateRequeuePeriod(tc.kind, tc.status, tc.reconcilePeriod)
+			if gotPending != tc.wantPending {
+				t.Fatalf("got pending %v, want %v", gotPending, tc.wantPending)
+			}
+			if gotPeriod != tc.wantPeriod {
+				t.Errorf("got period %v, want %v", gotPeriod, tc.wantPeriod)
+			}
+		})
+	}
+}
+
+// TestPendingStateRequeueImportJobTransition follows the status that the controller reads back for an import
+// job from mockkms. The mock reports PENDING_GENERATION on create, ACTIVE with a public key once the job is
+// read again, and EXPIRED once its clock passes the job's expireTime.
+func TestPendingStateRequeueImportJobTransition(t *testing.T) {
+	reconcilePeriod := 10 * time.Minute
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := &Reconciler{}
+	WithClock(func() time.Time { return now })(r)
+	kms := newMockKMS(t, func() time.Time { return now })
+
+	kms.do(t, "POST", "projects/"+mockKMSProjectID+"/locations/us-central1/keyRings?keyRingId=mykeyring", `{}`)
+	keyRing := "projects/" + mockKMSProjectID + "/locations/us-central1/keyRings/mykeyring"
+	created := kms.do(t, "POST", keyRing+"/importJobs?importJobId=myjob",
+		`{"importMethod": "RSA_OAEP_3072_SHA1_AES_256", "protectionLevel": "SOFTWARE"}`)
+	if _, pending := pendingStateRequeuePeriod("KMSKeyRingImportJob", created, reconcilePeriod); !pending {
+		t.Fatalf("expected the newly created import job to be requeued; got status %v", created)
+	}
+	if msg, pending := pendingStateMessage("KMSKeyRingImportJob", created); !pending || msg != "ImportJob key material is still being generated" {
+		t.Errorf("unexpected pending message for the newly created import job; got (%q, %v)", msg, pending)
+	}
+
+	now = now.Add(time.Minute)
+	activated := kms.do(t, "GET", keyRing+"/importJobs/myjob", "")
+	if pem, _, _ := unstructured.NestedString(activated, "publicKey", "pem"); pem == "" {
+		t.Errorf("expected the active import job to have a public key; got status %v", activated)
+	}
+	if period, pending := pendingStateRequeuePeriod("KMSKeyRingImportJob", activated, reconcilePeriod); pending {
+		t.Fatalf("expected the active import job to only be reconciled on the regular schedule, got requeue after %v", period)
+	}
+	if condition, ok := r.expiredCondition("KMSKeyRingImportJob", activated); !ok || condition.Status != corev1.ConditionFalse {
+		t.Errorf("expected the active import job not to have expired; got %+v", condition)
+	}
+
+	now = now.Add(3 * 24 * time.Hour)
+	expired := kms.do(t, "GET", keyRing+"/importJobs/myjob", "")
+	if state, _, _ := unstructured.NestedString(expired, "state"); state != "EXPIRED" {
+		t.Errorf("unexpected state once the clock has passed expireTime; got %q", state)
+	}
+	if _, pending := pendingStateRequeuePeriod("KMSKeyRingImportJob", expired, reconcilePeriod); pending {
+		t.Errorf("expected the expired import job not to be requeued")
+	}
+	if condition, ok := r.expiredCondition("KMSKeyRingImportJob", expired); !ok || condition.Status != corev1.ConditionTrue {
+		t.Errorf("expected the import job to have expired; got %+v", condition)
+	}
+}
+
+const mockKMSProjectID = "test-project"
+
+// mockKMS serves the REST API of mockkms, as the terraform provider sees it.
+type mockKMS struct {
+	handler http.Handler
+}
+
+func newMockKMS(t *testing.T, now func() time.Time) *mockKMS {
+	t.Helper()
+
+	env := &common.MockEnvironment{
+		Projects: projects.NewFakeProjectStore(&projects.ProjectData{ID: mockKMSProjectID, Number: 123456789}),
+	}
+	s := mockkms.New(env, storage.NewInMemoryStorage())
+	s.SetClock(now)
+
+	server := grpc.NewServer()
+	s.Register(server)
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("net.Listen failed: %v", err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dialing mock: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	handler, err := s.NewHTTPMux(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("NewHTTPMux failed: %v", err)
+	}
+	return &mockKMS{handler: handler}
+}
+
+// do sends a request for path to the mock, and returns the object in the response.
+func (m *mockKMS) do(t *testing.T, method, path, body string) map[string]interface{} {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	m.handler.ServeHTTP(w, httptest.NewRequest(method, "https://cloudkms.googleapis.com/v1/"+path, strings.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("%s %s: got status %d: %s", method, path, w.Code, w.Body.String())
+	}
+	obj := make(map[string]interface{})
+	if err := json.Unmarshal(w.Body.Bytes(), &obj); err != nil {
+		t.Fatalf("%s %s: error parsing response: %v", method, path, err)
+	}
+	return obj
+}
+
+func TestPendingStateRequeueAfterIsJittered(t *testing.T) {
+	pending := map[string]interface{}{"state": "PENDING_GENERATION"}
+	reconcilePeriod := 10 * time.Minute
+
+	tests := []struct {
+		name     string
+		opts     []ReconcilerOption
+		wantBase time.Duration
+	}{
+		{
+			name:     "derived from the reconcile period",
+			wantBase: 30 * time.Second,
+		},
+		{
+			name:     "configured base period",
+			opts:     []ReconcilerOption{WithPendingStateRequeuePeriod(10 * time.Second)},
+			wantBase: 10 * time.Second,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{}
+			for _, opt := range tc.opts {
+				opt(r)
+			}
+			lowest := tc.wantBase * 4 / 5
+			highest := tc.wantBase * 6 / 5
+			for i := 0; i < 100; i++ {
+				period, ok := r.pendingStateRequeueAfter("KMSKeyRingImportJob", pending, reconcilePeriod)
+				if !ok {
+					t.Fatalf("expected the pending import job to be requeued")
+				}
+				if period < lowest || period > highest {
+					t.Fatalf("got period %v, want within [%v, %v]", period, lowest, highest)
+				}
+			}
+		})
+	}
+
+	r := &Reconciler{}
+	WithPendingStateRequeuePeriod(time.Hour)(r)
+	if period, _ := r.pendingStateRequeueAfter("KMSKeyRingImportJob", pending, reconcilePeriod); period > reconcilePeriod {
+		t.Errorf("got period %v, want no more than the reconcile period %v", period, reconcilePeriod)
+	}
+	if period, ok := r.pendingStateRequeueAfter("KMSKeyRingImportJob", map[string]interface{}{"state": "ACTIVE"}, reconcilePeriod); ok {
+		t.Errorf("expected the active import job not to be requeued, got requeue after %v", period)
+	}
+}
+
+func TestReconcilePeriodIsNotJittered(t *testing.T) {
+	r := &Reconciler{
+		schemaRef: &k8s.SchemaReference{
+			GVK: schema.GroupVersionKind{Group: "kms.cnrm.cloud.google.com", Version: "v1alpha1", Kind: "KMSKeyRingImportJob"},
+		},
+	}
+	u := &unstructured.Unstructured{}
+	for i := 0; i < 10; i++ {
+		if period, err := r.reconcilePeriod(u); err != nil || period != k8s.MeanReconcileReenqueuePeriod {
+			t.Fatalf("got reconcile period (%v, %v), want %v", period, err, k8s.MeanReconcileReenqueuePeriod)
+		}
+	}
+
+	k8s.SetAnnotation(k8s.ReconcileIntervalInSecondsAnnotation, "60", u)
+	if period, err := r.reconcilePeriod(u); err != nil || period != time.Minute {
+		t.Errorf("got reconcile period (%v, %v) from the annotation, want %v", period, err, time.Minute)
+	}
+	k8s.SetAnnotation(k8s.ReconcileIntervalInSecondsAnnotation, "often", u)
+	if _, err := r.reconcilePeriod(u); err == nil {
+		t.Errorf("expected an error for an invalid reconcile interval annotation")
+	}
+}
+
+func TestExpiredConditionFollowsTheClock(t *testing.T) {
+	expireTime := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)
+	now := expireTime.Add(-72 * time.Hour)
+	r := &Reconciler{}
+	WithClock(func() time.Time { return now })(r)
+
+	activated := map[string]interface{}{
+		"state":      "ACTIVE",
+		"expireTime": expireTime.Format(time.RFC3339),
+	}
+	// The condition only changes when the resource crosses into a coarser bucket of time left, and when it expires.
+	steps := []struct {
+		advance    time.Duration
+		wantStatus corev1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{
+		{advance: 0, wantStatus: corev1.ConditionFalse, wantReason: notExpiredReason, wantMsg: "The resource expires at 2024-01-04T00:00:00Z, in more than a day"},
+		{advance: 47 * time.Hour, wantStatus: corev1.ConditionFalse, wantReason: notExpiredReason, wantMsg: "The resource expires at 2024-01-04T00:00:00Z, in more than a day"},
+		{advance: time.Hour, wantStatus: corev1.ConditionFalse, wantReason: notExpiredReason, wantMsg: "The resource expires at 2024-01-04T00:00:00Z, in less than a day"},
+		{advance: 23 * time.Hour, wantStatus: corev1.ConditionFalse, wantReason: notExpiredReason, wantMsg: "The resource expires at 2024-01-04T00:00:00Z, in less than an hour"},
+		{advance: 2 * time.Hour, wantStatus: corev1.ConditionTrue, wantReason: k8s.Expired, wantMsg: "The resource expired at 2024-01-04T00:00:00Z and can no longer be used"},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		condition, ok := r.expiredCondition("KMSKeyRingImportJob", activated)
+		if !ok {
+			t.Fatalf("at %v: expected an Expired condition", now)
+		}
+		if condition.Type != expiredConditionType || condition.Status != step.wantStatus || condition.Reason != step.wantReason || condition.Message != step.wantMsg {
+			t.Errorf("at %v: got condition (%v, %v, %q, %q), want (%v, %v, %q, %q)", now,
+				condition.Type, condition.Status, condition.Reason, condition.Message,
+				expiredConditionType, step.wantStatus, step.wantReason, step.wantMsg)
+		}
+	}
+
+	// GCP is the authority on whether the resource has expired, even if our clock says it has time left.
+	now = expireTime.Add(-time.Hour)
+	expired := map[string]interface{}{
+		"state":      "EXPIRED",
+		"expireTime": expireTime.Format(time.RFC3339),
+	}
+	if condition, ok := r.expiredCondition("KMSKeyRingImportJob", expired); !ok || condition.Status != corev1.ConditionTrue {
+		t.Errorf("expected an EXPIRED import job to have expired; got %+v", condition)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		kind   string
+		status map[string]interface{}
+	}{
+		{name: "kind that does not expire", kind: "KMSKeyRing", status: activated},
+		{name: "import job without expireTime", kind: "KMSKeyRingImportJob", status: map[string]interface{}{"state": "ACTIVE"}},
+		{name: "invalid expireTime", kind: "KMSKeyRingImportJob", status: map[string]interface{}{"expireTime": "soon"}},
+	} {
+		if condition, ok := r.expiredCondition(tc.kind, tc.status); ok {
+			t.Errorf("%s: expected no Expired condition, got %+v", tc.name, condition)
+		}
+	}
+}
@@ -44,7 +44,9 @@ const (
 	CreateFailedMessageTmpl              = "Create call failed: %v"
 	Updating                             = "Updating"
 	UpdatingMessage                      = "Update in progress"
+	UpdatePending                        = "UpdatePending"
 	UpdateFailed                         = "UpdateFailed"
+	Expired                              = "Expired"
 	Deleting                             = "Deleting"
 	DeletingMessage                      = "Deletion in progress"
 	Deleted                              = "Deleted"
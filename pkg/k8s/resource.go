@@ -126,18 +126,59 @@ func IsResourceReady(r *Resource) bool {
 }
 
 func GetReadyCondition(r *Resource) (condition k8sv1alpha1.Condition, found bool) {
-	if currConditionsRaw, ok := r.Status["conditions"].([]interface{}); ok {
-		if currConditions, err := MarshalAsConditionsSlice(currConditionsRaw); err == nil {
-			for _, condition := range currConditions {
-				if condition.Type == k8sv1alpha1.ReadyConditionType {
-					return condition, true
-				}
-			}
+	for _, condition := range GetConditions(r) {
+		if condition.Type == k8sv1alpha1.ReadyConditionType {
+			return condition, true
 		}
 	}
 	return k8sv1alpha1.Condition{}, false
 }
 
+// GetConditions returns the conditions in r's status, which are either as read
+// from the API server or as set by SetCondition.
+func GetConditions(r *Resource) []k8sv1alpha1.Condition {
+	switch conditions := r.Status["conditions"].(type) {
+	case []k8sv1alpha1.Condition:
+		return conditions
+	case []interface{}:
+		if ret, err := MarshalAsConditionsSlice(conditions); err == nil {
+			return ret
+		}
+	}
+	return nil
+}
+
+// SetCondition sets the condition of condition.Type in r's status, and leaves
+// the conditions of other types as they are. The condition's last transition
+// time is kept if its status has not changed, and r's status is left untouched
+// if the condition is already set, so that setting it on every reconcile does
+// not cause a status update.
+func SetCondition(r *Resource, condition k8sv1alpha1.Condition) {
+	var conditions []k8sv1alpha1.Condition
+	found := false
+	for _, c := range GetConditions(r) {
+		if c.Type != condition.Type {
+			conditions = append(conditions, c)
+			continue
+		}
+		if ConditionsEqualIgnoreTransitionTime(c, condition) {
+			return
+		}
+		if c.Status == condition.Status {
+			condition.LastTransitionTime = c.LastTransitionTime
+		}
+		conditions = append(conditions, condition)
+		found = true
+	}
+	if !found {
+		conditions = append(conditions, condition)
+	}
+	if r.Status == nil {
+		r.Status = make(map[string]interface{})
+	}
+	r.Status["conditions"] = conditions
+}
+
 func ReadyConditionMatches(resource *Resource, status corev1.ConditionStatus, rs, msg string) bool {
 	cond, found := GetReadyCondition(resource)
 	if !found {
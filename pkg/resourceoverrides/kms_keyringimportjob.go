@@ -0,0 +1,173 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourceoverrides
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+
+	kmspb "cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+var (
+	kmsKeyRingImportJobKeyRingField    = []string{"keyRing"}
+	kmsKeyRingImportJobKeyRingRefField = []string{"keyRingRef"}
+)
+
+// kmsImportProtectionLevels are the protection levels at which keys can be imported. Every import method of the
+// KMS API can be used at each of them; keys cannot be imported at the EXTERNAL protection levels.
+// mockgcp/mockkms applies the same rule to the same enums when creating import jobs.
+var kmsImportProtectionLevels = []string{"SOFTWARE", "HSM"}
+
+func GetKMSKeyRingImportJobResourceOverrides() ResourceOverrides {
+	ro := ResourceOverrides{
+		Kind: "KMSKeyRingImportJob",
+	}
+	ro.Overrides = append(ro.Overrides, buildKMSKeyRingImportJob())
+	// Preserve the legacy non-reference field 'keyRing' after it is changed to
+	// a reference field, 'keyRingRef'.
+	ro.Overrides = append(ro.Overrides, keepKMSKeyRingImportJobKeyRingField())
+	return ro
+}
+
+// kmsLocations is the set of locations in which key rings, and so import jobs, are known to be creatable.
+// It follows https://cloud.google.com/kms/docs/locations; as KMS adds regions faster than the set is updated,
+// a location outside of it is only warned about, and GCP remains the authority on which locations exist.
+var kmsLocations = sets.New(
+	"global",
+	// Multi-regions
+	"asia", "europe", "us",
+	// Dual-regions
+	"eur4", "nam4",
+	// Regions
+	"africa-south1",
+	"asia-east1", "asia-east2", "asia-northeast1", "asia-northeast2", "asia-northeast3",
+	"asia-south1", "asia-south2", "asia-southeast1", "asia-southeast2",
+	"australia-southeast1", "australia-southeast2",
+	"europe-central2", "europe-north1", "europe-southwest1",
+	"europe-west1", "europe-west2", "europe-west3", "europe-west4", "europe-west6",
+	"europe-west8", "europe-west9", "europe-west10", "europe-west12",
+	"me-central1", "me-central2", "me-west1",
+	"northamerica-northeast1", "northamerica-northeast2",
+	"southamerica-east1", "southamerica-west1",
+	"us-central1", "us-east1", "us-east4", "us-east5", "us-south1",
+	"us-west1", "us-west2", "us-west3", "us-west4",
+)
+
+func buildKMSKeyRingImportJob() ResourceOverride {
+	return ResourceOverride{
+		ConfigValidate: func(r *unstructured.Unstructured) error {
+			if err := validateKMSKeyRingImportJobImportMethod(r); err != nil {
+				return err
+			}
+			warning, err := kmsKeyRingImportJobLocationWarning(r, kmsLocations)
+			if err != nil {
+				return err
+			}
+			if warning != "" {
+				klog.Warningf("KMSKeyRingImportJob %v: %s", k8s.GetNamespacedName(r), warning)
+			}
+			return nil
+		},
+	}
+}
+
+// validateKMSKeyRingImportJobImportMethod rejects an importMethod that cannot be used at the resource's protectionLevel.
+func validateKMSKeyRingImportJobImportMethod(r *unstructured.Unstructured) error {
+	protectionLevel, _, err := unstructured.NestedString(r.Object, "spec", "protectionLevel")
+	if err != nil {
+		return fmt.Errorf("error getting spec.protectionLevel: %w", err)
+	}
+	importMethod, _, err := unstructured.NestedString(r.Object, "spec", "importMethod")
+	if err != nil {
+		return fmt.Errorf("error getting spec.importMethod: %w", err)
+	}
+	if value, found := kmspb.ImportJob_ImportMethod_value[importMethod]; found && value != int32(kmspb.ImportJob_IMPORT_METHOD_UNSPECIFIED) {
+		for _, level := range kmsImportProtectionLevels {
+			if level == protectionLevel {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("spec.importMethod %q is not supported with spec.protectionLevel %q", importMethod, protectionLevel)
+}
+
+// kmsKeyRingImportJobLocationWarning returns a warning for a key ring, given in spec.keyRing or
+// spec.keyRingRef.external, whose location is not one of locations, and "" otherwise. A keyRingRef to a KMSKeyRing object is not checked here: that
+// KMSKeyRing's own location is validated by GCP when it is created.
+func kmsKeyRingImportJobLocationWarning(r *unstructured.Unstructured, locations sets.Set[string]) (string, error) {
+	field := "spec.keyRing"
+	keyRing, _, err := unstructured.NestedString(r.Object, "spec", "keyRing")
+	if err != nil {
+		return "", fmt.Errorf("error getting %s: %w", field, err)
+	}
+	if keyRing == "" {
+		field = "spec.keyRingRef.external"
+		keyRing, _, err = unstructured.NestedString(r.Object, "spec", "keyRingRef", "external")
+		if err != nil {
+			return "", fmt.Errorf("error getting %s: %w", field, err)
+		}
+	}
+
+	// The key ring is `projects/{project}/locations/{location}/keyRings/{keyRing}`; other forms are left to GCP.
+	tokens := strings.Split(keyRing, "/")
+	if len(tokens) != 6 || tokens[0] != "projects" || tokens[2] != "locations" || tokens[4] != "keyRings" {
+		return "", nil
+	}
+	if location := tokens[3]; !locations.Has(location) {
+		return fmt.Sprintf("%s %q is in location %q, which is not a known KMS location; GCP will reject it if KMS is not available there", field, keyRing, location), nil
+	}
+	return "", nil
+}
+
+func keepKMSKeyRingImportJobKeyRingField() ResourceOverride {
+	o := ResourceOverride{}
+	o.CRDDecorate = func(crd *apiextensions.CustomResourceDefinition) error {
+		if err := PreserveMutuallyExclusiveNonReferenceField(crd, nil, kmsKeyRingImportJobKeyRingRefField[0], kmsKeyRingImportJobKeyRingField[0]); err != nil {
+			return fmt.Errorf("error preserving '%v' field in KMSKeyRingImportJob: %w", kmsKeyRingImportJobKeyRingField[0], err)
+		}
+		// Like keyRingRef, the legacy field cannot be changed once the import job is created.
+		schema := k8s.GetOpenAPIV3SchemaFromCRD(crd)
+		spec := schema.Properties["spec"]
+		keyRing := spec.Properties[kmsKeyRingImportJobKeyRingField[0]]
+		keyRing.Description = "Immutable. " + keyRing.Description
+		spec.Properties[kmsKeyRingImportJobKeyRingField[0]] = keyRing
+		schema.Properties["spec"] = spec
+		return nil
+	}
+	o.PreActuationTransform = func(r *k8s.Resource) error {
+		if err := FavorAuthoritativeFieldOverLegacyField(r, kmsKeyRingImportJobKeyRingField, kmsKeyRingImportJobKeyRingRefField); err != nil {
+			return fmt.Errorf("error handling '%v' and '%v' fields in pre-actuation transformation: %w", strings.Join(kmsKeyRingImportJobKeyRingField, "."), strings.Join(kmsKeyRingImportJobKeyRingRefField, "."), err)
+		}
+		return nil
+	}
+	o.PostActuationTransform = func(original, reconciled *k8s.Resource, tfState *terraform.InstanceState, dclState *unstructured.Unstructured) error {
+		if err := PreserveUserSpecifiedLegacyField(original, reconciled, kmsKeyRingImportJobKeyRingField...); err != nil {
+			return fmt.Errorf("error preserving '%v' in post-actuation transformation: %w", strings.Join(kmsKeyRingImportJobKeyRingField, "."), err)
+		}
+		if err := PruneDefaultedAuthoritativeFieldIfOnlyLegacyFieldSpecified(original, reconciled, kmsKeyRingImportJobKeyRingField, kmsKeyRingImportJobKeyRingRefField); err != nil {
+			return fmt.Errorf("error conditionally pruning '%v' in post-actuation transformation: %w", strings.Join(kmsKeyRingImportJobKeyRingRefField, "."), err)
+		}
+		return nil
+	}
+	return o
+}
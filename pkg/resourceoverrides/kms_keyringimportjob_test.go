@@ -0,0 +1,349 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourceoverrides
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-config-connector/pkg/k8s"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const testKMSKeyRing = "projects/my-project/locations/us-central1/keyRings/my-ring"
+
+func TestKMSKeyRingImportJobConfigValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		protectionLevel string
+		importMethod    string
+		wantErr         bool
+	}{
+		{
+			name:            "HSM with a supported import method",
+			protectionLevel: "HSM",
+			importMethod:    "RSA_OAEP_3072_SHA1_AES_256",
+		},
+		{
+			name:            "SOFTWARE with a SHA-256 import method",
+			protectionLevel: "SOFTWARE",
+			importMethod:    "RSA_OAEP_4096_SHA256",
+		},
+		{
+			name:            "HSM with a SHA-256 import method",
+			protectionLevel: "HSM",
+			importMethod:    "RSA_OAEP_3072_SHA256_AES_256",
+		},
+		{
+			name:            "unknown import method",
+			protectionLevel: "HSM",
+			importMethod:    "RSA_OAEP_1024_SHA1_AES_256",
+			wantErr:         true,
+		},
+		{
+			name:            "unspecified import method",
+			protectionLevel: "HSM",
+			importMethod:    "IMPORT_METHOD_UNSPECIFIED",
+			wantErr:         true,
+		},
+		{
+			name:            "EXTERNAL does not support import",
+			protectionLevel: "EXTERNAL",
+			importMethod:    "RSA_OAEP_3072_SHA1_AES_256",
+			wantErr:         true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind": "KMSKeyRingImportJob",
+				"spec": map[string]interface{}{
+					"protectionLevel": tc.protectionLevel,
+					"importMethod":    tc.importMethod,
+				},
+			}}
+			err := Handler.ConfigValidate(r)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error for protectionLevel %q and importMethod %q", tc.protectionLevel, tc.importMethod)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestKMSKeyRingImportJobConfigValidateLocation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		spec    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "keyRing in a supported location",
+			spec: map[string]interface{}{"keyRing": testKMSKeyRing},
+		},
+		{
+			name: "keyRingRef.external in a supported location",
+			spec: map[string]interface{}{"keyRingRef": map[string]interface{}{"external": "projects/my-project/locations/global/keyRings/my-ring"}},
+		},
+		{
+			name: "keyRingRef to a KMSKeyRing",
+			spec: map[string]interface{}{"keyRingRef": map[string]interface{}{"name": "my-ring"}},
+		},
+		{
+			name: "keyRing in an unknown location is left to GCP",
+			spec: map[string]interface{}{"keyRing": "projects/my-project/locations/us-central99/keyRings/my-ring"},
+		},
+		{
+			name: "keyRingRef.external in an unknown location is left to GCP",
+			spec: map[string]interface{}{"keyRingRef": map[string]interface{}{"external": "projects/my-project/locations/mars-north1/keyRings/my-ring"}},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			spec := map[string]interface{}{
+				"protectionLevel": "SOFTWARE",
+				"importMethod":    "RSA_OAEP_3072_SHA1_AES_256",
+			}
+			for k, v := range tc.spec {
+				spec[k] = v
+			}
+			r := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind": "KMSKeyRingImportJob",
+				"spec": spec,
+			}}
+			err := Handler.ConfigValidate(r)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error for spec %v", tc.spec)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestKMSKeyRingImportJobLocationWarningWithCustomLocations(t *testing.T) {
+	t.Parallel()
+	r := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"keyRing": "projects/my-project/locations/test-region1/keyRings/my-ring",
+		},
+	}}
+	warning, err := kmsKeyRingImportJobLocationWarning(r, sets.New("test-region1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if warning != "" {
+		t.Errorf("unexpected warning for a known location: %v", warning)
+	}
+	warning, err = kmsKeyRingImportJobLocationWarning(r, sets.New("us-central1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `spec.keyRing "projects/my-project/locations/test-region1/keyRings/my-ring" is in location "test-region1", which is not a known KMS location; GCP will reject it if KMS is not available there`
+	if warning != want {
+		t.Errorf("unexpected warning; got %q, want %q", warning, want)
+	}
+}
+
+func TestKMSKeyRingImportJobPreActuationTransform(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected map[string]interface{}
+		hasError bool
+	}{
+		{
+			name: "literal keyRing is moved to keyRingRef.external",
+			spec: map[string]interface{}{
+				"keyRing": testKMSKeyRing,
+			},
+			expected: map[string]interface{}{
+				"keyRingRef": map[string]interface{}{
+					"external": testKMSKeyRing,
+				},
+			},
+		},
+		{
+			name: "keyRingRef to a KMSKeyRing object is left for reference resolution",
+			spec: map[string]interface{}{
+				"keyRingRef": map[string]interface{}{
+					"name": "my-ring",
+				},
+			},
+			expected: map[string]interface{}{
+				"keyRingRef": map[string]interface{}{
+					"name": "my-ring",
+				},
+			},
+		},
+		{
+			name: "keyRing and keyRingRef with different values",
+			spec: map[string]interface{}{
+				"keyRing": testKMSKeyRing,
+				"keyRingRef": map[string]interface{}{
+					"external": "projects/my-project/locations/us-central1/keyRings/other-ring",
+				},
+			},
+			hasError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := &k8s.Resource{
+				TypeMeta: v1.TypeMeta{Kind: "KMSKeyRingImportJob"},
+				Spec:     tc.spec,
+			}
+			err := Handler.PreActuationTransform(r)
+			if tc.hasError {
+				if err == nil {
+					t.Fatalf("expected an error, got spec %v", r.Spec)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(r.Spec, tc.expected) {
+				t.Errorf("unexpected spec; got %v, want %v", r.Spec, tc.expected)
+			}
+		})
+	}
+}
+
+func TestKMSKeyRingImportJobPostActuationTransform(t *testing.T) {
+	t.Parallel()
+	original := &k8s.Resource{
+		TypeMeta: v1.TypeMeta{Kind: "KMSKeyRingImportJob"},
+		Spec: map[string]interface{}{
+			"keyRing": testKMSKeyRing,
+		},
+	}
+	reconciled := &k8s.Resource{
+		TypeMeta: v1.TypeMeta{Kind: "KMSKeyRingImportJob"},
+		Spec: map[string]interface{}{
+			"keyRingRef": map[string]interface{}{
+				"external": testKMSKeyRing,
+			},
+		},
+	}
+	if err := Handler.PostActuationTransform(original, reconciled, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{
+		"keyRing": testKMSKeyRing,
+	}
+	if !reflect.DeepEqual(reconciled.Spec, expected) {
+		t.Errorf("user-specified keyRing was not preserved; got %v, want %v", reconciled.Spec, expected)
+	}
+}
+
+func TestKMSKeyRingImportJobPostActuationTransformKeepsKeyRingRef(t *testing.T) {
+	t.Parallel()
+	spec := map[string]interface{}{
+		"keyRingRef": map[string]interface{}{
+			"name": "my-ring",
+		},
+	}
+	original := &k8s.Resource{
+		TypeMeta: v1.TypeMeta{Kind: "KMSKeyRingImportJob"},
+		Spec:     spec,
+	}
+	reconciled := &k8s.Resource{
+		TypeMeta: v1.TypeMeta{Kind: "KMSKeyRingImportJob"},
+		Spec: map[string]interface{}{
+			"keyRingRef": map[string]interface{}{
+				"name": "my-ring",
+			},
+		},
+	}
+	if err := Handler.PostActuationTransform(original, reconciled, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(reconciled.Spec, spec) {
+		t.Errorf("keyRingRef was not kept as specified; got %v, want %v", reconciled.Spec, spec)
+	}
+}
+
+func TestKMSKeyRingImportJobCRDDecorate(t *testing.T) {
+	t.Parallel()
+	crd := &apiextensions.CustomResourceDefinition{
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Names: apiextensions.CustomResourceDefinitionNames{Kind: "KMSKeyRingImportJob"},
+			Versions: []apiextensions.CustomResourceDefinitionVersion{
+				{
+					Name: "v1alpha1",
+					Schema: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensions.JSONSchemaProps{
+								"spec": {
+									Type: "object",
+									Properties: map[string]apiextensions.JSONSchemaProps{
+										"importJobId":     {Type: "string"},
+										"importMethod":    {Type: "string"},
+										"keyRingRef":      {Type: "object"},
+										"protectionLevel": {Type: "string"},
+									},
+									Required: []string{"importJobId", "importMethod", "keyRingRef", "protectionLevel"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := Handler.CRDDecorate(crd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spec := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+	keyRing, found := spec.Properties["keyRing"]
+	if !found {
+		t.Fatalf("legacy field keyRing was not added to the schema; got %v", spec.Properties)
+	}
+	if keyRing.Type != "string" || !strings.HasPrefix(keyRing.Description, "Immutable. DEPRECATED.") {
+		t.Errorf("unexpected schema for keyRing: %+v", keyRing)
+	}
+	// Exactly one of keyRing and keyRingRef must be set, so keyRingRef itself is no longer required.
+	wantRequired := []string{"importJobId", "importMethod", "protectionLevel"}
+	if !reflect.DeepEqual(spec.Required, wantRequired) {
+		t.Errorf("unexpected required fields; got %v, want %v", spec.Required, wantRequired)
+	}
+	wantOneOf := []apiextensions.JSONSchemaProps{
+		{Required: []string{"keyRing"}},
+		{Required: []string{"keyRingRef"}},
+	}
+	if !reflect.DeepEqual(spec.OneOf, wantOneOf) {
+		t.Errorf("unexpected oneOf rule; got %+v, want %+v", spec.OneOf, wantOneOf)
+	}
+}
@@ -239,5 +239,8 @@ func init() {
 	// IAM
 	Handler.Register(GetIAMCustomRoleResourceOverrides())
 
+	// KMS
+	Handler.Register(GetKMSKeyRingImportJobResourceOverrides())
+
 	Handler.Register(GetCloudIDSEndpointResourceOverrides())
 }
@@ -319,6 +319,11 @@ func validateImmutableFieldsForTFBasedResource(obj, oldObj *unstructured.Unstruc
 			k8s.NewImmutableFieldsMutationError([]string{"spec.location"}))
 	}
 
+	if res := findChangesOnImmutableLegacyFields(obj.GetKind(), spec, oldSpec); len(res) != 0 {
+		return admission.Errored(http.StatusForbidden,
+			k8s.NewImmutableFieldsMutationError(res))
+	}
+
 	fields := list.New()
 	compareAndFindChangesOnImmutableFields(spec, oldSpec, r.Schema, "", rc, getIgnoredFields(rc), fields)
 	if fields.Len() != 0 {
@@ -333,6 +338,23 @@ func validateImmutableFieldsForTFBasedResource(obj, oldObj *unstructured.Unstruc
 	return allowedResponse
 }
 
+// immutableLegacyFields are the immutable legacy fields that resource overrides keep in the CRD after the
+// underlying TF field was changed to a reference field. They are not in the TF schema, so
+// compareAndFindChangesOnImmutableFields does not cover them.
+var immutableLegacyFields = map[string][]string{
+	"KMSKeyRingImportJob": {"keyRing"},
+}
+
+func findChangesOnImmutableLegacyFields(kind string, spec, oldSpec map[string]interface{}) []string {
+	var res []string
+	for _, field := range immutableLegacyFields[kind] {
+		if isImmutableFieldModified(oldSpec, spec, field) {
+			res = append(res, field)
+		}
+	}
+	return res
+}
+
 func isImmutableFieldModified(oldSpec, newSpec map[string]interface{}, field string) bool {
 	tokens := strings.Split(field, ".")
 	oldVal, ok1, err1 := unstructured.NestedFieldCopy(oldSpec, tokens...)
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	corekccv1alpha1 "github.com/GoogleCloudPlatform/k8s-config-connector/pkg/apis/core/v1alpha1"
@@ -1617,6 +1618,92 @@ func TestChangesOnImmutableResourceIDField(t *testing.T) {
 	}
 }
 
+func TestChangesOnImmutableFieldsForKMSKeyRingImportJob(t *testing.T) {
+	smLoader, err := servicemappingloader.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tfResourceMap := provider.ResourceMap()
+
+	newImportJob := func(keyRingField string, keyRing interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "kms.cnrm.cloud.google.com/v1alpha1",
+				"kind":       "KMSKeyRingImportJob",
+				"metadata": map[string]interface{}{
+					"name":      "test-import-job",
+					"namespace": "test-namespace",
+				},
+				"spec": map[string]interface{}{
+					"importJobId":     "test-import-job",
+					"importMethod":    "RSA_OAEP_3072_SHA1_AES_256",
+					keyRingField:      keyRing,
+					"protectionLevel": "SOFTWARE",
+					"resourceID":      "test-import-job",
+				},
+			},
+		}
+	}
+	newLegacyImportJob := func() *unstructured.Unstructured {
+		return newImportJob("keyRing", "projects/test-project/locations/us-central1/keyRings/test-key-ring")
+	}
+	newReferenceImportJob := func() *unstructured.Unstructured {
+		return newImportJob("keyRingRef", map[string]interface{}{
+			"external": "projects/test-project/locations/us-central1/keyRings/test-key-ring",
+		})
+	}
+
+	tests := []struct {
+		name     string
+		newObj   func() *unstructured.Unstructured
+		field    string
+		newValue interface{}
+	}{
+		{name: "importJobId", newObj: newLegacyImportJob, field: "importJobId", newValue: "other-import-job"},
+		{name: "importMethod", newObj: newLegacyImportJob, field: "importMethod", newValue: "RSA_OAEP_4096_SHA1_AES_256"},
+		{name: "keyRing", newObj: newLegacyImportJob, field: "keyRing", newValue: "projects/test-project/locations/us-central1/keyRings/other-key-ring"},
+		{name: "keyRingRef", newObj: newReferenceImportJob, field: "keyRingRef", newValue: map[string]interface{}{"name": "other-key-ring"}},
+		{name: "protectionLevel", newObj: newLegacyImportJob, field: "protectionLevel", newValue: "HSM"},
+		{name: "resourceID", newObj: newLegacyImportJob, field: "resourceID", newValue: "other-import-job"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			oldObj := tc.newObj()
+			obj := tc.newObj()
+			if err := unstructured.SetNestedField(obj.Object, tc.newValue, "spec", tc.field); err != nil {
+				t.Fatal(err)
+			}
+			spec := obj.Object["spec"].(map[string]interface{})
+			oldSpec := oldObj.Object["spec"].(map[string]interface{})
+
+			response := validateImmutableFieldsForTFBasedResource(obj, oldObj, spec, oldSpec, smLoader, tfResourceMap)
+			if response.Allowed {
+				t.Fatalf("expected a change to spec.%v to be rejected", tc.field)
+			}
+			if !strings.Contains(response.Result.Message, tc.field) {
+				t.Errorf("expected the rejection to name %v, got %q", tc.field, response.Result.Message)
+			}
+		})
+	}
+
+	for name, newObj := range map[string]func() *unstructured.Unstructured{
+		"no changes with keyRing":    newLegacyImportJob,
+		"no changes with keyRingRef": newReferenceImportJob,
+	} {
+		newObj := newObj
+		t.Run(name, func(t *testing.T) {
+			obj := newObj()
+			oldObj := newObj()
+			spec := obj.Object["spec"].(map[string]interface{})
+			oldSpec := oldObj.Object["spec"].(map[string]interface{})
+			if response := validateImmutableFieldsForTFBasedResource(obj, oldObj, spec, oldSpec, smLoader, tfResourceMap); !response.Allowed {
+				t.Errorf("expected an unchanged spec to be allowed, got %q", response.Result.Message)
+			}
+		})
+	}
+}
+
 func newImmutableFieldsValidatorHandler(t *testing.T) HandlerFunc {
 	t.Helper()
 	smLoader, err := servicemappingloader.New()
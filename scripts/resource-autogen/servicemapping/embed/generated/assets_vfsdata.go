@@ -29,749 +29,749 @@ var Assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 3145,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x73\xdb\x36\x10\xbd\xf3\x57\xec\x48\x97\x76\xc6\xa6\x6c\x1f\xd9\x93\xe2\x8f\x84\x53\x47\xca\x88\x72\x33\x39\x79\x56\xe0\x92\x44\x0d\x02\x28\xb0\x94\xa2\xa6\xf9\xef\x1d\x7e\xd9\xfa\x9c\x2a\x4e\x73\x08\x8f\xc0\xbe\xb7\x6f\xb1\xef\x71\x08\xd7\xc6\xae\x9d\xcc\x0b\x86\xab\x8b\xab\x2b\x78\x6b\x4c\xae\x08\xee\xef\xaf\x83\x61\x30\x84\x7b\x29\x48\x7b\x4a\xa1\xd2\x29\x39\xe0\x82\x60\x6c\x51\x14\xd4\xdf\x9c\xc1\x1f\xe4\xbc\x34\x1a\xae\xc2\x0b\xf8\xa5\x2e\x18\x74\x57\x83\x5f\x7f\x0b\x86\xb0\x36\x15\x94\xb8\x06\x6d\x18\x2a\x4f\xc0\x85\xf4\x90\x49\x45\x40\x9f\x05\x59\x06\xa9\x41\x98\xd2\x2a\x89\x5a\x10\xac\x24\x17\x4d\x9b\x8e\x24\x0c\x86\xf0\xa9\xa3\x30\x0b\x46\xa9\x01\x41\x18\xbb\x06\x93\x6d\xd6\x01\x72\x23\xb8\xf9\x0a\x66\x1b\x8d\x46\xab\xd5\x2a\xc4\x46\x6d\x68\x5c\x3e\x52\x6d\xa5\x1f\xdd\xc7\xd7\xb7\x93\xe4\xf6\xfc\x2a\xbc\x68\x30\x0f\x5a\x91\xf7\xe0\xe8\xaf\x4a\x3a\x4a\x61\xb1\x06\xb4\x56\x49\x81\x0b\x45\xa0\x70\x05\xc6\x01\xe6\x8e\x28\x05\x36\xb5\xe0\x95\x93\x2c\x75\x7e\x06\xde\x64\xbc\x42\x47\xc1\x10\x52\xe9\xd9\xc9\x45\xc5\x5b\xaf\xd5\xcb\x93\x7e\xab\xc0\x68\x40\x0d\x83\x71\x02\x71\x32\x80\x37\xe3\x24\x4e\xce\x82\x21\x7c\x8c\xe7\xef\xa6\x0f\x73\xf8\x38\x9e\xcd\xc6\x93\x79\x7c\x9b\xc0\x74\x06\xd7\xd3\xc9\x4d\x3c\x8f\xa7\x93\x04\xa6\x77\x30\x9e\x7c\x82\xdf\xe3\xc9\xcd\x19\x90\xe4\x82\x1c\xd0\x67\xeb\x6a\xfd\xc6\x81\xac\xdf\x91\xd2\xfa\xd1\x12\xa2\x2d\x01\x99\x69\x05\x79\x4b\x42\x66\x52\x80\x42\x9d\x57\x98\x13\xe4\x66\x49\x4e\x4b\x9d\x83\x25\x57\x4a\x5f\x6f\xd3\x03\xea\x34\x18\x82\x92\xa5\x64\xe4\xe6\x64\x6f\xa8\x30\x08\xd0\xca\x6e\xff\x11\x08\xe3\x28\x14\xda\x95\xa1\x50\xa6\x4a\xc3\xbc\xb1\x52\x28\x4c\x39\x5a\x5e\xa2\xb2\x05\x5e\x06\x4f\x52\xa7\x11\x24\xe4\x96\x52\xd0\x7b\xb4\x56\xea\x3c\x28\x89\x31\x45\xc6\x28\x00\xd0\x58\x52\x04\x28\x04\x79\x8f\xd6\x3a\xb3\x44\x75\x98\xb4\x2b\xf6\x16\x05\x45\x50\x97\x9c\xfb\xb5\x67\x2a\x83\x7a\xc4\x17\xae\x71\xc3\x35\xee\xb8\x02\x80\x65\x2f\x78\x79\xb9\x20\xc6\xcb\x00\xc0\xb7\x82\xde\x19\xcf\x93\x43\x02\xda\xae\x68\xa5\xef\x3a\x3b\xf2\xa6\x72\x82\x7c\xdd\x07\xe0\xbc\xeb\xd5\xd6\x3d\x66\x46\xa5\xe4\x1e\x5b\x92\xc7\x9e\xe5\xd1\x13\xd7\xae\xf1\x41\xeb\xd2\xf6\x2d\xb6\xe5\xdd\x35\xc8\x64\xbb\x10\x2b\x36\x6f\x49\x93\x43\xa6\x34\x02\x76\x15\x75\x37\x32\x9d\x53\x69\x15\x32\x45\x30\x68\xbb\xfa\xd1\x97\x2f\x5d\x7f\x99\x7e\xfd\x3a\xc2\x2d\xfe\x9e\x79\xb0\x47\x70\x8d\xfa\x0d\x3d\x78\x4a\xe7\xe6\x3d\xb2\x28\x66\xdd\x84\xed\x7b\x64\xa8\x7c\xdf\xb4\x9f\x7d\xbc\x44\xa9\xea\x88\xc4\x7a\xec\x3d\x71\xac\x97\xa4\xd9\xb8\xf5\x76\x79\xbf\xdd\x6e\xdb\x51\x77\xdc\xaf\xe7\x59\xeb\x0e\x7b\x7c\xf3\x52\xc9\xe8\x72\xe2\x3b\x49\x2a\xdd\x07\x14\x92\x1c\x3a\x51\x48\x81\x6a\x46\x19\x39\xd2\xcf\x7b\x69\x77\xc3\x6b\xfb\xdc\xe8\xf9\x18\xe0\x89\xd6\xfd\xe9\x8c\xb2\x9d\xf6\x47\x98\xb2\x23\x22\x8e\xf2\xd5\x5f\x4a\x5e\x38\x69\xb9\x31\xdd\x3f\xe7\x1b\x37\x00\xf3\x82\x3a\x0c\x70\x81\xdc\xfe\x1e\x7b\x11\xb0\x20\x65\x74\xee\x81\x4d\xb8\x81\xca\x97\x4f\xd1\x16\x49\xeb\xa4\xbb\xdd\x01\x0f\x5a\x7d\x83\xc6\x99\xca\x46\xcf\xcd\x4a\xd4\x98\x93\x3b\x1a\xb6\x3d\x97\x1b\x97\xa3\x96\x7f\x37\x7f\x87\xd7\x78\x7d\xba\x81\x7f\x9d\xe3\x37\x15\xd4\xbe\xdf\x52\xf4\x53\xb8\x7f\x47\xf1\xa9\x19\x38\x0c\x3b\x35\x09\x9b\xe8\x5d\xff\x6e\xde\x7d\x73\x2a\x0e\xcb\xfa\x0f\xee\x53\x12\xb2\x89\xfc\xee\x9c\x4c\x0f\x8f\xff\x83\xd3\x62\x9d\xf9\x93\x04\xbf\x26\x28\x1f\x5a\xe8\xeb\x32\xd2\xf5\xad\xe3\xd1\x4b\xf8\x29\x92\xf1\x22\xf6\xd4\x50\xec\x21\x4e\xcd\x43\x07\xdc\xb5\x6b\x77\xfc\xcd\x29\xd8\xd3\x71\x9c\xf1\x14\xef\x77\xa0\xef\xb6\xfd\x87\xbd\x29\xff\x4f\xc7\xff\x1b\x00\x00\xff\xff\xf4\x9a\x68\x6d\x49\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x5d\x6f\xdb\x36\x14\x7d\xd7\xaf\x38\xb0\x5f\x36\x20\x91\x9b\x3c\x6a\x4f\x6e\x3e\x5a\x61\xa9\x3d\xd8\xce\x8a\x3e\x05\xd7\xd4\xb5\xc4\x85\x22\x39\x92\xb2\xeb\x75\xfd\xef\x83\x2c\x29\xfe\x8a\xb1\x34\xc5\x06\x34\xf1\x13\x79\xcf\xb9\xe7\xf2\x9e\xa3\x3e\xae\x8c\x5d\x3b\x99\x17\x01\x97\x6f\x2e\x2f\xf1\xce\x98\x5c\x31\xee\xee\xae\xa2\x7e\xd4\xc7\x9d\x14\xac\x3d\x67\xa8\x74\xc6\x0e\xa1\x60\x0c\x2d\x89\x82\xbb\x9b\x33\xfc\xce\xce\x4b\xa3\x71\x19\xbf\xc1\x4f\x75\x41\xaf\xbd\xea\xfd\xfc\x4b\xd4\xc7\xda\x54\x28\x69\x0d\x6d\x02\x2a\xcf\x08\x85\xf4\x58\x48\xc5\xe0\xcf\x82\x6d\x80\xd4\x10\xa6\xb4\x4a\x92\x16\x8c\x95\x0c\x05\xc2\x96\x3f\x8e\xfa\xf8\xd4\x52\x98\x79\x20\xa9\x41\x10\xc6\xae\x61\x16\xbb\x75\xa0\xb0\x11\xbc\xf9\x2b\x42\xb0\xc9\x60\xb0\x5a\xad\x62\xda\xa8\x8d\x8d\xcb\x07\xaa\xa9\xf4\x83\xbb\xf4\xea\x66\x34\xbd\x39\xbf\x8c\xdf\x6c\x30\xf7\x5a\xb1\xf7\x70\xfc\x67\x25\x1d\x67\x98\xaf\x41\xd6\x2a\x29\x68\xae\x18\x8a\x56\x30\x0e\x94\x3b\xe6\x0c\xc1\xd4\x82\x57\x4e\x06\xa9\xf3\x33\x78\xb3\x08\x2b\x72\x1c\xf5\x91\x49\x1f\x9c\x9c\x57\x61\xef\xb5\x3a\x79\xd2\xef\x15\x18\x0d\xd2\xe8\x0d\xa7\x48\xa7\x3d\xbc\x1d\x4e\xd3\xe9\x59\xd4\xc7\xc7\x74\xf6\x7e\x7c\x3f\xc3\xc7\xe1\x64\x32\x1c\xcd\xd2\x9b\x29\xc6\x13\x5c\x8d\x47\xd7\xe9\x2c\x1d\x8f\xa6\x18\xdf\x62\x38\xfa\x84\x5f\xd3\xd1\xf5\x19\x58\x86\x82\x1d\xf8\xb3\x75\xb5\x7e\xe3\x20\xeb\x77\xe4\xac\x7e\xb4\x29\xf3\x9e\x80\x85\x69\xd6\xe7\x2d\x0b\xb9\x90\x02\x8a\x74\x5e\x51\xce\xc8\xcd\x92\x9d\x96\x3a\x87\x65\x57\x4a\x5f\x6f\xd3\x83\x74\x16\xf5\xa1\x64\x29\x03\x85\xcd\xc9\xd1\x50\x71\x14\x91\x95\xed\xfe\x13\x08\xe3\x38\x16\xda\x95\xb1\x50\xa6\xca\xe2\x7c\x63\xa5\x58\x98\x72\xb0\xbc\x20\x65\x0b\xba\x88\x1e\xa5\xce\x12\x4c\xd9\x2d\xa5\xe0\x0f\x64\xad\xd4\x79\x54\x72\xa0\x8c\x02\x25\x11\xa0\xa9\xe4\x04\x24\x04\x7b\x4f\xd6\x3a\xb3\x24\xf5\x3c\x69\x5b\xec\x2d\x09\x4e\x50\x97\x9c\xfb\xb5\x0f\x5c\x46\xf5\x88\x5b\xae\xe1\x86\x6b\xd8\x72\x45\xc0\xb2\x13\xbc\xbc\x98\x73\xa0\x8b\x08\xf0\x8d\xa0\xf7\xc6\x87\xd1\x73\x02\x9a\xae\x64\xa5\x6f\x3b\x3b\xf6\xa6\x72\x82\x7d\xdd\x07\x38\x6f\x7b\x35\x75\x0f\x0b\xa3\x32\x76\x0f\xcd\x14\x0f\x1d\xcb\x83\xe7\x50\xbb\xc6\x6f\x20\x40\xf3\x16\xfb\xf2\x6e\x37\xc8\xe9\x7e\x21\x55\xc1\xbc\x63\xcd\x8e\x02\x67\x09\x82\xab\xb8\xbd\x91\xd9\x8c\x4b\xab\x28\x70\x82\x5e\xd3\xd5\x0f\xbe\x7c\x69\xfb\xcb\xec\xeb\xd7\x01\xed\xf1\x77\xcc\xbd\x23\x82\x2b\xd2\x6f\xf9\xde\x73\x36\x33\x1f\x28\x88\x62\xd2\x4e\xd8\xbc\xc7\x82\x94\xef\x9a\x76\xb3\x0f\x97\x24\x55\x1d\x91\x54\x0f\xbd\xe7\x90\xea\x25\xeb\x60\xdc\x7a\xbf\xbc\xdb\x6e\xbb\xed\xa4\x3d\xee\xd6\xf3\xa4\xf5\x80\x3d\xbd\xde\x56\x06\x72\x39\x87\x5b\xc9\x2a\x3b\x06\x14\x92\x1d\x39\x51\x48\x41\x6a\xc2\x0b\x76\xac\x9f\xf6\x52\xff\xce\x11\xd6\xf6\xa9\xd1\xd3\x31\xf0\xc8\xeb\xee\x74\xc2\x8b\x83\xf6\x27\x98\x16\x27\x44\x9c\xe4\xab\x7f\x19\x7b\xe1\xa4\xad\x53\x94\xe0\xef\xf3\x9d\x1b\x60\x56\x70\x8b\x41\x28\x28\x34\x9f\xc7\x4e\x04\xe6\xac\x8c\xce\x3d\x82\x89\x77\x50\xf9\xf2\x71\x2b\x6a\xeb\xa4\xdb\xc3\x01\x9f\xb5\xfa\xf6\x3f\x77\xa6\xb2\xc9\x53\xb3\x92\x34\xe5\xec\x4e\x86\xed\xc8\xe5\xc6\xe5\xa4\xe5\x5f\x9b\xaf\xc3\x6b\xbc\x3e\xde\xc1\xbf\xce\xf1\xbb\x0a\x6a\xdf\xef\x29\xfa\x21\xdc\x7f\xa0\xf8\xa0\xc7\xc9\x0c\x3c\x0f\x7b\x69\x12\x76\xd1\x87\xfe\xdd\xbd\xfb\xe6\x54\x3c\x2f\xeb\x5f\xb8\x5f\x92\x90\x5d\xe4\x77\xe7\x64\xd7\x77\xff\x5f\x5a\xac\x33\x7f\xb0\x08\xaf\x09\xca\x6f\x0d\xf4\x75\x19\x69\xfb\xd6\xf1\xe8\x24\xfc\x10\xc9\xd8\x8a\x3d\xa0\x3f\x19\x8a\x23\xc4\x4b\xf3\xd0\x02\x0f\xed\xda\x1e\x7f\x73\x0a\x8e\x74\x9c\x66\x7c\x89\xf7\x5b\xd0\x77\xdb\xbe\x75\xd1\x7f\xe4\xf8\x7f\x06\x00\xf4\x9a\x68\x6d\x49\x0c\x00\x00"),
 		},
 		"/accesscontextmanager.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "accesscontextmanager.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 5450,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4b\x6f\xe2\x48\x10\xbe\xfb\x57\x94\xe0\xb2\x2b\x25\x90\xe4\xc8\x9e\x08\xc9\x64\xac\x4d\x20\x02\xb2\xa3\x39\xa1\xa2\x5d\xd8\xad\xd8\xdd\xbd\xdd\x6d\x08\xc3\xf2\xdf\x57\x76\xdb\x60\x1e\x09\x0f\x69\x77\x72\x18\x1f\x90\x70\x57\x55\x7f\xf5\xd5\xc3\x55\x75\xe8\x48\x35\xd7\x3c\x8c\x2c\xdc\x5c\xdd\xdc\xc0\x83\x94\x61\x4c\xf0\xf8\xd8\xf1\xea\x5e\x1d\x1e\x39\x23\x61\x28\x80\x54\x04\xa4\xc1\x46\x04\x6d\x85\x2c\xa2\xf2\xe4\x02\xfe\x22\x6d\xb8\x14\x70\xd3\xb8\x82\xdf\x32\x81\x5a\x71\x54\xfb\xfd\x0f\xaf\x0e\x73\x99\x42\x82\x73\x10\xd2\x42\x6a\x08\x6c\xc4\x0d\x4c\x78\x4c\x40\x6f\x8c\x94\x05\x2e\x80\xc9\x44\xc5\x1c\x05\x23\x98\x71\x1b\xe5\xd7\x14\x46\x1a\x5e\x1d\xbe\x17\x26\xe4\xd8\x22\x17\x80\xc0\xa4\x9a\x83\x9c\x54\xe5\x00\x6d\x0e\x38\x7f\x22\x6b\x55\xab\xd9\x9c\xcd\x66\x0d\xcc\xd1\x36\xa4\x0e\x9b\xb1\x93\x34\xcd\x47\xbf\x73\xdf\x1d\xdc\x5f\xde\x34\xae\x72\x9d\x17\x11\x93\x31\xa0\xe9\xef\x94\x6b\x0a\x60\x3c\x07\x54\x2a\xe6\x0c\xc7\x31\x41\x8c\x33\x90\x1a\x30\xd4\x44\x01\x58\x99\x01\x9e\x69\x6e\xb9\x08\x2f\xc0\xc8\x89\x9d\xa1\x26\xaf\x0e\x01\x37\x56\xf3\x71\x6a\x37\xd8\x2a\xe1\x71\xb3\x21\x20\x05\xa0\x80\x5a\x7b\x00\xfe\xa0\x06\xb7\xed\x81\x3f\xb8\xf0\xea\xf0\xcd\x1f\x7e\xed\xbd\x0c\xe1\x5b\xbb\xdf\x6f\x77\x87\xfe\xfd\x00\x7a\x7d\xe8\xf4\xba\x77\xfe\xd0\xef\x75\x07\xd0\xfb\x02\xed\xee\x77\xf8\xd3\xef\xde\x5d\x00\x71\x1b\x91\x06\x7a\x53\x3a\xc3\x2f\x35\xf0\x8c\x47\x0a\x32\xd2\x06\x44\x1b\x00\x26\xd2\x01\x32\x8a\x18\x9f\x70\x06\x31\x8a\x30\xc5\x90\x20\x94\x53\xd2\x82\x8b\x10\x14\xe9\x84\x9b\x2c\x9a\x06\x50\x04\x5e\x1d\x62\x9e\x70\x8b\x36\x7f\xb3\xe3\x54\xc3\xf3\x50\xf1\x22\xfe\x2d\x60\x52\x53\x83\x09\x9d\x34\x58\x2c\xd3\xa0\x11\xe6\xa9\xd4\x60\x32\x69\x4e\xaf\x31\x56\x11\x5e\x7b\xaf\x5c\x04\x2d\x18\x90\x9e\x72\x46\x4f\xa8\x14\x17\xa1\x97\x90\xc5\x00\x2d\xb6\x3c\x00\x81\x09\xb5\x00\x19\x23\x63\x98\x14\x96\xde\x6c\x82\x02\x43\xd2\xfb\x4d\x17\x2a\x46\x21\xa3\x16\x64\x22\x97\x66\x6e\x2c\x25\x5e\xe6\xe8\xda\x62\x3b\xb7\xd8\x71\x16\x9f\x9c\x45\x0f\x60\x5a\x82\x9f\x5e\x8f\xc9\xe2\xb5\x07\x60\x1c\xb8\xaf\xd2\xd8\xee\xfb\x60\x1c\x02\x54\xdc\x14\x28\x34\x19\x99\x6a\x46\x26\xbb\x13\xe0\xb2\xb8\xd7\xc9\x8d\x9c\x8d\x51\x61\x64\x54\x58\x29\x5f\xc7\x34\xa5\xd8\x73\xb9\xeb\x18\xda\x07\xd7\xbd\x7b\xac\xc8\x62\x6a\xe5\x03\x09\xd2\x68\x29\x68\x81\xd5\x29\x15\x27\x3c\x18\x52\xa2\x62\xb4\xd4\x82\xda\x62\x91\x61\x59\x2e\x6b\x3b\x87\x1d\x14\xb7\xf4\x62\x28\x18\xca\x27\xb4\x2c\xea\x17\x4e\x38\xc7\x27\x18\x9b\xd2\x60\xe9\x5e\x7b\x8a\x3c\xce\xea\xc2\x17\x6d\x63\xc8\xfa\x62\x4a\xc2\x4a\x3d\xdf\x14\x2f\x43\x5a\x84\xb8\x55\xbc\x2e\xa3\x91\xfd\x6e\x19\xf6\xef\xd6\x42\x16\x75\x48\xf6\x0b\xa7\x38\xa8\xc8\x9e\x4e\x69\x76\x18\xf0\x2c\x7b\x4f\x21\xb7\xb3\xa5\x74\x34\xcb\xd5\xab\x3f\x13\xdb\x7b\xb2\xec\x20\xeb\x1f\xe8\xf4\x69\x42\x9a\xc4\x2a\xd5\x5d\x6c\x5e\x69\x5e\x6a\xe5\x2c\xf6\x69\xb2\x3a\x05\xb0\x93\xf7\xed\x66\x4f\x38\x7d\x6d\x55\xfe\x9e\x56\x06\xee\xd9\x53\xca\x15\xfb\x5a\xa6\xea\xc4\xbe\xf2\x71\x36\xba\x47\xa1\x26\x61\x2b\x49\x71\x7a\x92\x9a\x53\x72\xd3\x9c\x9a\x93\x0e\xe0\x72\xd9\xac\x84\xc6\x7c\x9e\xd4\x74\xf0\x8e\x4d\xca\x8a\xf4\x49\x3c\x2b\x19\x73\x36\x3f\x92\xe7\xe7\xaa\xf0\x07\x3c\x63\xd2\x91\x62\xc2\x2b\x2e\xb9\x6b\xba\xa7\xe2\x1a\x71\x4c\x36\x21\x96\xa6\x9e\x28\x19\x93\x3e\xcf\x60\x92\xeb\xae\x0c\xea\xb2\x68\x1d\x95\x95\x14\xde\xe9\xc8\x39\xed\x73\x45\x2d\xe0\xc1\xea\x95\x49\x95\x92\xda\x9a\x55\x73\x34\x9b\x71\xfe\xd9\x1f\x9c\xec\xab\x4d\x7a\x15\x2a\xff\x6e\xb7\x5e\x8f\xff\xd2\xe4\xed\x04\xe3\x94\x2a\x3e\xe1\x3a\x39\x38\x99\xe6\x62\x91\x0b\x94\x3e\x1e\x99\x8e\xa9\x8d\xa4\xe6\x3f\x28\x18\x49\x1d\x9a\x51\x40\x86\x1d\xce\xca\x95\x52\x4f\x87\xe6\x6e\xad\xf2\xeb\xeb\xbf\xcb\x70\xc8\xd4\x28\x35\xeb\x82\x18\x73\x11\x64\x23\xe6\x21\x92\x1f\x3a\xcf\x2f\xa6\xec\x00\xb7\x1b\x4a\x9f\x97\xe6\xff\x3c\xe7\xa5\x0e\x51\xf0\x1f\x6e\xfe\x6f\x2e\x16\xd5\xff\x23\x1e\x2c\x97\xcd\x90\xa9\x1d\xde\x76\x8a\x03\x20\xe2\xa4\x51\xb3\x88\x33\x8c\xf7\x8f\x0f\xae\xe1\x54\x2f\xa8\x74\xa3\x7c\xb6\xa8\x9e\xad\x87\x8b\x8f\x87\x92\xd5\xd8\xb1\x85\xfc\x38\xdb\xd9\x93\x95\xa8\xe6\xca\xe6\x93\xc5\x3f\x97\x1b\x43\xc5\x30\xa2\x0d\x4d\xb0\x11\x5a\xb7\xe0\x96\xb0\x60\x4c\xb1\x14\xa1\x01\x2b\x1b\x87\xc7\x9d\xde\x7e\xf7\x8f\x9b\x6d\xca\x2b\x0f\x8f\x35\xc7\x95\x52\xb1\x07\x8d\x14\x69\x9e\x90\x5d\x7d\x4b\xde\x2f\xa2\x62\xad\x7b\xde\x52\xf8\xbc\x05\xf4\xf3\xfa\xd4\x0e\xb9\xa3\xf2\x86\x93\x59\xee\x6f\x2a\x1e\x3f\x19\xae\xae\x76\xbc\x37\x17\x8b\x12\xc3\xff\x1a\x84\x23\xd6\x8a\x15\xd4\xec\xb2\x77\x16\x8b\x4d\x77\xce\x5b\x2d\xde\x49\xe0\xe3\x6b\xf0\xac\xfd\x62\xbb\xeb\x56\x86\x76\xb3\x05\x68\x4f\x6b\x85\xf3\xb7\x90\x9d\x24\x3c\xbc\x8a\x6c\x33\x74\xfe\x3e\xb2\xe3\xda\xe7\xa9\xfb\xb3\x96\x92\x7f\x03\x00\x00\xff\xff\xdf\x7a\xcd\xe5\x4a\x15\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4b\x73\xe2\x38\x10\xbe\xfb\x57\x74\xc1\x65\xb7\x2a\x81\x49\x8e\xec\x89\x21\x99\x8c\x6b\x13\x48\x01\xd9\xa9\x39\x51\x8d\xdc\xd8\xaa\xd8\x92\x56\x92\x21\x0c\xcb\x7f\xdf\x92\x1f\x60\x1e\x09\x38\x5b\xbb\x9b\xc3\xc4\xae\x54\x22\xf5\xe3\xeb\xaf\xbb\xe5\x56\x13\x7a\x52\x2d\x35\x0f\x23\x0b\xd7\x9f\xae\xaf\xe1\x4e\xca\x30\x26\xb8\xbf\xef\x79\x4d\xaf\x09\xf7\x9c\x91\x30\x14\x40\x2a\x02\xd2\x60\x23\x82\xae\x42\x16\x51\xb9\x73\x01\x7f\x90\x36\x5c\x0a\xb8\x6e\x7d\x82\x5f\x9c\x40\xa3\xd8\x6a\xfc\xfa\x9b\xd7\x84\xa5\x4c\x21\xc1\x25\x08\x69\x21\x35\x04\x36\xe2\x06\x66\x3c\x26\xa0\x17\x46\xca\x02\x17\xc0\x64\xa2\x62\x8e\x82\x11\x2c\xb8\x8d\xc0\x6e\xed\xb7\xbc\x26\x7c\x2f\x4c\xc8\xa9\x45\x2e\x00\x81\x49\xb5\x04\x39\xab\xca\x01\xda\x0c\x70\xf6\x13\x59\xab\x3a\xed\xf6\x62\xb1\x68\x61\x86\xb6\x25\x75\xd8\x8e\x73\x49\xd3\xbe\xf7\x7b\xb7\xfd\xd1\xed\xe5\x75\xeb\x53\xa6\xf3\x24\x62\x32\x06\x34\xfd\x99\x72\x4d\x01\x4c\x97\x80\x4a\xc5\x9c\xe1\x34\x26\x88\x71\x01\x52\x03\x86\x9a\x28\x00\x2b\x1d\xe0\x85\xe6\x96\x8b\xf0\x02\x8c\x9c\xd9\x05\x6a\xf2\x9a\x10\x70\x63\x35\x9f\xa6\x76\x87\xad\x12\x1e\x37\x3b\x02\x52\x00\x0a\x68\x74\x47\xe0\x8f\x1a\xf0\xb9\x3b\xf2\x47\x17\x5e\x13\xbe\xf9\xe3\xaf\x83\xa7\x31\x7c\xeb\x0e\x87\xdd\xfe\xd8\xbf\x1d\xc1\x60\x08\xbd\x41\xff\xc6\x1f\xfb\x83\xfe\x08\x06\x5f\xa0\xdb\xff\x0e\xbf\xfb\xfd\x9b\x0b\x20\x6e\x23\xd2\x40\x2f\x4a\x3b\xfc\x52\x03\x77\x3c\x52\xe0\x48\x1b\x11\xed\x00\x98\xc9\x3c\x7d\x46\x11\xe3\x33\xce\x20\x46\x11\xa6\x18\x12\x84\x72\x4e\x5a\x70\x11\x82\x22\x9d\x70\xe3\xb2\x69\x00\x45\xe0\x35\x21\xe6\x09\xb7\x68\xb3\x95\x83\xa0\x5a\x9e\x87\x8a\x17\xf9\xef\x00\x93\x9a\x5a\x4c\xe8\xa4\xc5\x62\x99\x06\xad\x30\x2b\xa5\x16\x93\x49\x7b\x7e\x85\xb1\x8a\xf0\xca\x7b\xe6\x22\xe8\xc0\x88\xf4\x9c\x33\x7a\x40\xa5\xb8\x08\xbd\x84\x2c\x06\x68\xb1\xe3\x01\x08\x4c\xa8\x03\xc8\x18\x19\xc3\xa4\xb0\xf4\x62\x13\x14\x18\x92\x3e\x6e\xba\x50\x31\x0a\x19\x75\xc0\x89\x5c\x9a\xa5\xb1\x94\x78\x2e\xd0\xad\xc5\x6e\x66\xb1\x97\x5b\x7c\xc8\x2d\x7a\x00\xf3\x12\xfc\xfc\x6a\x4a\x16\xaf\x3c\x00\x93\x83\xfb\x2a\x8d\xed\xbf\x0e\x26\x47\x80\x8a\x9b\x02\x85\x26\x23\x53\xcd\xc8\x38\x9f\x00\x97\x85\xdf\x5c\x6e\x92\x07\x34\x29\x8c\x4c\x0a\x2b\xe5\x72\x4c\x73\x8a\x33\x35\x80\x9c\xa1\x63\x70\xf3\xb5\xfb\x8a\x2c\xa6\x56\xde\x91\x20\x8d\x96\x82\x0e\x58\x9d\x52\xb1\xc3\x83\x31\x25\x2a\x46\x4b\x1d\x68\xac\x56\x0e\xcb\x7a\xdd\x38\xd8\xec\xa1\xf8\x4c\x4f\x86\x82\xb1\x7c\x40\xcb\xa2\x61\x11\x44\x1e\xf8\x0c\x63\x53\x1a\x2c\xc3\xeb\xce\x91\xc7\xae\x2f\x7c\xd1\x35\x86\xac\x2f\xe6\x24\xac\xd4\xcb\x5d\xf1\x32\xa5\x45\x8a\x3b\xc5\x72\x99\x0d\xf7\x7b\xcf\xb0\x7f\xb3\x15\xb2\xa8\x43\xb2\x5f\x38\xc5\x41\x45\xb6\x3e\xa5\x8e\xef\x80\xbb\xea\xad\x43\x6e\x6f\x4f\xe9\x6c\x96\xab\xae\x3f\x12\xdb\x55\x5c\x7b\x0e\x5e\x65\xfd\x0d\x9d\x21\xcd\x48\x93\xd8\x94\xba\x7b\x2f\xe1\x99\x96\xa5\x56\x56\xa2\x43\x9a\x6d\x76\x01\xec\xec\x75\xbb\xee\x09\xe7\xcf\x5b\x63\x75\xdb\x20\x7f\x8e\xb4\xf2\xf6\x09\xb5\x4c\x55\xe9\xbd\x28\x99\x53\xe7\xca\x51\x5e\x2a\x95\xeb\x5e\x85\x9a\x84\xad\x14\x45\xfd\x22\x35\x5e\x8d\x88\x4d\xdd\x9a\xcc\x01\xae\xd7\xed\x4a\x6a\xcc\xc7\x29\xcd\x1c\xde\x9e\xe9\x57\x8b\xb2\x22\x5d\x8b\x67\x25\x63\xce\x96\x67\xf2\xfc\x58\x15\x7e\x83\x67\x4c\x7a\x52\xcc\x78\x25\xa4\xdc\x4d\xbf\x2e\xae\x09\xc7\xa4\xf8\x73\xcf\xd4\x03\x25\x53\xd2\xef\x33\x98\x64\xba\x1b\x83\xba\x6c\xda\x9c\xca\xcd\x7a\x99\x88\xbd\xba\xb6\x4b\x45\x1d\xe0\xc1\x66\xc9\xa4\x4a\x49\x6d\xcd\xe6\x70\x34\xbb\x79\xfe\xbf\x3f\x38\xee\xab\x4d\x7a\x93\x2a\xff\xe6\xb0\x5f\x4f\x96\xd7\x0e\x07\x73\x8c\x53\xaa\xc4\x84\xdb\xe2\xe0\x64\xda\xab\x55\x26\x50\xc6\x78\x66\x39\xa6\x36\x92\x9a\xff\xa0\x60\x22\x75\x68\x26\x01\x19\x76\xba\x2a\x37\x4a\x03\x1d\x9a\x9b\xad\xca\xcf\xaf\xff\xe1\xd7\x3f\x64\x6a\x92\x9a\x6d\x43\x4c\xb9\x08\xdc\x88\x79\x8a\xe4\xbb\xde\xe3\x93\x29\x4f\x80\xcf\x3b\x4a\x1f\x97\xe6\x7f\xbd\xe6\xa5\x0e\x51\xf0\x1f\xf9\xfc\xdf\x5e\xad\xaa\xff\x4f\x78\xb0\x5e\xb7\x43\xa6\x0e\x78\x3b\x68\x0e\x80\x88\x93\x46\xcd\x22\xce\x30\x3e\x3e\x3e\xe4\x07\x4e\xd5\xc1\x66\x13\xf2\xd9\xa2\xba\xb7\x1d\x2e\xde\x1e\x4a\x36\x63\x47\x55\x79\xc2\x83\xf3\x6c\xbb\xc7\xb5\xa8\xe6\xca\x6d\x74\xe0\xaf\xcb\xca\x0e\xc0\x38\xa2\x1d\x4d\xb0\x11\xda\xfc\x82\x5b\xc2\x82\x29\xc5\x52\x84\x06\xac\x6c\x9d\x1e\x77\x06\x15\x63\xb5\x67\x9b\xd2\xe5\xe9\xb1\xe6\xbc\x56\x2a\xee\x41\x13\x45\x9a\x27\x64\x49\x9f\x6c\xa2\xe2\x5a\xf7\xb8\xa7\xf0\xf3\x9c\x3a\x3c\xa7\x0e\xc8\x9d\x94\x1e\x6a\xb3\x3c\xdc\x55\x3c\x9b\xed\xad\x6b\x07\x78\xbd\x6e\xaf\x56\x25\x86\xff\x34\x09\x6f\x77\x70\xd6\xfa\x1b\xa8\xce\xd9\x2b\x17\x8b\xdd\x70\x4e\xf7\xda\x39\xd4\xd6\xee\xc1\x77\xdd\x2f\xf6\x4f\xdd\xca\xd0\x6e\xf6\x00\x1d\x39\x5a\xff\xc1\x2d\xe4\xa0\x08\x8d\x57\x97\xa1\xf7\xdf\x47\x0e\x42\xfb\x38\x7d\xff\xae\x4b\xc9\xdf\x03\x00\xdf\x7a\xcd\xe5\x4a\x15\x00\x00"),
 		},
 		"/activedirectory.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "activedirectory.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3209,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x0c\xa4\x4b\x0b\xd8\xa4\xed\x23\x7b\x52\x2c\x27\x21\xea\xc8\x81\x24\x37\xc8\x49\x18\x2d\x47\xe4\xd6\xcb\xdd\xed\xee\x90\x8a\xa0\xea\xbf\x17\xfc\x92\x25\xcb\x4e\xdc\x43\x0b\x1f\xc2\x8b\x88\xdd\x37\x6f\xde\x7c\x3c\x71\x08\xd7\xc6\x6e\x9c\xcc\x72\x86\xab\x8b\xab\x2b\xf8\x60\x4c\xa6\x08\x6e\x6f\xaf\x83\x61\x30\x84\x5b\x29\x48\x7b\x4a\xa1\xd4\x29\x39\xe0\x9c\x60\x64\x51\xe4\xd4\xdf\x9c\xc1\x1f\xe4\xbc\x34\x1a\xae\xc2\x0b\xf8\xa5\x06\x0c\xba\xab\xc1\xaf\xbf\x05\x43\xd8\x98\x12\x0a\xdc\x80\x36\x0c\xa5\x27\xe0\x5c\x7a\x58\x49\x45\x40\xdf\x04\x59\x06\xa9\x41\x98\xc2\x2a\x89\x5a\x10\xac\x25\xe7\x4d\x9a\x8e\x24\x0c\x86\xf0\xb5\xa3\x30\x4b\x46\xa9\x01\x41\x18\xbb\x01\xb3\x3a\xc4\x01\x72\x23\xb8\x79\x72\x66\x1b\x47\xd1\x7a\xbd\x0e\xb1\x51\x1b\x1a\x97\x45\xaa\x45\xfa\xe8\x36\xb9\xbe\x99\xcc\x6e\xce\xaf\xc2\x8b\x26\xe6\x5e\x2b\xf2\x1e\x1c\xfd\x55\x4a\x47\x29\x2c\x37\x80\xd6\x2a\x29\x70\xa9\x08\x14\xae\xc1\x38\xc0\xcc\x11\xa5\xc0\xa6\x16\xbc\x76\x92\xa5\xce\xce\xc0\x9b\x15\xaf\xd1\x51\x30\x84\x54\x7a\x76\x72\x59\xf2\x51\xb7\x7a\x79\xd2\x1f\x01\x8c\x06\xd4\x30\x18\xcd\x20\x99\x0d\xe0\xdd\x68\x96\xcc\xce\x82\x21\x7c\x49\xe6\x1f\xef\xee\xe7\xf0\x65\x34\x9d\x8e\x26\xf3\xe4\x66\x06\x77\x53\xb8\xbe\x9b\x8c\x93\x79\x72\x37\x99\xc1\xdd\x7b\x18\x4d\xbe\xc2\xef\xc9\x64\x7c\x06\x24\x39\x27\x07\xf4\xcd\xba\x5a\xbf\x71\x20\xeb\x3e\x52\x5a\x37\x6d\x46\x74\x24\x60\x65\x5a\x41\xde\x92\x90\x2b\x29\x40\xa1\xce\x4a\xcc\x08\x32\x53\x91\xd3\x52\x67\x60\xc9\x15\xd2\xd7\xd3\xf4\x80\x3a\x0d\x86\xa0\x64\x21\x19\xb9\x39\x39\x29\x2a\x0c\x02\xb4\xb2\x9b\x7f\x0c\xc2\x38\x0a\x85\x76\x45\x28\x94\x29\xd3\x30\x6b\x56\x29\x14\xa6\x88\xaa\x4b\x54\x36\xc7\xcb\xe0\x41\xea\x34\x86\x19\xb9\x4a\x0a\xfa\x84\xd6\x4a\x9d\x05\x05\x31\xa6\xc8\x18\x07\x00\x1a\x0b\x8a\x01\x05\xcb\x8a\x52\xe9\x48\xb0\x71\x9b\xe7\x59\x3b\xb4\xb7\x28\x28\x86\x1a\x72\xee\x37\x9e\xa9\x08\xea\x1a\x1f\xc9\x46\x0d\xd9\xb8\x27\x0b\x00\xaa\x5e\x72\x75\xb9\x24\xc6\xcb\x00\xc0\xb7\x92\x3e\x1a\xcf\x93\x67\x25\xb4\x79\xd1\x4a\xdf\xe5\x76\xe4\x4d\xe9\x04\xf9\x3a\x13\xc0\x79\x97\xad\xc5\x2d\xda\xf0\xc5\x3e\x7e\x91\x9a\x02\xa5\x0e\xda\x05\x6d\xdb\xf0\x44\xd8\xf8\x10\x81\x25\x9b\x0f\xa4\xc9\x21\x53\x1a\x03\xbb\x92\xba\x1b\x99\xce\xa9\xb0\x0a\x99\x62\x18\x6c\xb7\x75\xd6\xdd\x6e\x70\x72\x79\x8d\xfa\x1d\xdd\x7b\x4a\xe7\xe6\x13\xb2\xc8\xa7\x9d\xdc\xb6\xba\x15\x2a\xdf\x13\xf6\x85\x8c\x2a\x94\xaa\x5e\xf9\x44\x8f\xbc\x27\x4e\x74\x45\xba\x16\x76\x0c\xef\xa7\xd5\x4d\x2f\xee\x8e\x01\x14\x2e\x49\xf9\xb8\xfb\xed\x8e\xeb\xbe\x92\xdb\x57\x92\x8c\xdf\x4b\x52\x69\xdc\x34\xeb\x49\xfe\x64\xfc\xc8\xc5\xe8\x32\xe2\x53\x2c\x40\x85\xaa\xa4\x83\x0e\x58\x67\xfe\x24\xc1\x3e\xda\x6e\xbb\xd7\xdd\x2e\x52\x46\xb4\x4b\x1b\x65\xca\x2c\x51\x45\x6d\xf7\x6b\x50\x13\xff\xd8\xb0\x5c\x92\x43\x27\x72\x29\x50\x4d\x69\x45\x8e\xf4\x7e\xa4\xed\x58\x79\x63\x29\x86\x8e\x7b\x7f\x0e\xf0\x40\x9b\xfd\xf1\x94\x56\x4f\x8a\x79\x81\x6b\xd5\x95\xf4\x5a\xba\xfa\x49\xc9\x0b\x27\x2d\x37\x1b\xfb\xf7\xf9\xc1\x0d\xc0\x3c\xa7\x3e\x08\x38\x47\x6e\xff\x5e\x7b\x11\xb0\x24\x65\x74\xe6\x81\x4d\x78\x10\x96\x55\x0f\xf1\x11\x4b\xbb\x8e\x9f\x4f\x34\x3d\xeb\x94\x03\x1e\x67\x4a\x1b\xef\xb3\x15\xa8\x31\x23\xf7\xa2\x59\x5f\xeb\x91\x05\xbb\xd2\xf3\x8f\x9d\x32\x3f\x80\xbd\xd2\x2e\xff\x76\x59\xda\xb7\xdd\x2e\xda\x6e\xdb\x8d\xec\x25\xbe\x1d\xd7\xb5\x1d\x3d\x55\xf7\x5a\x73\xbd\x18\xf9\xd3\x19\x6f\xc8\x19\x96\xc8\xd5\x9f\xca\xef\x98\xe2\xf3\x11\xe4\x3f\x32\x44\xa7\x63\x21\xd3\xb7\xb4\xfd\x8f\xaa\xbe\xff\x31\xfa\xa1\x19\x4e\x88\x7e\x9a\xe0\x7f\x32\xc1\x3f\x01\x00\x00\xff\xff\x19\x53\x43\xae\x89\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\x29\xdb\x47\xf6\xa4\x58\x4e\x42\xd4\x91\x03\x49\x6e\x90\x93\x31\x5a\x8e\xc8\xad\x97\xbb\xdb\xdd\xa5\x14\x41\xd5\x7f\x2f\x28\x52\x5f\x96\x9d\xb8\x87\x16\x3e\xc4\x34\x60\x62\xf7\xcd\x9b\x37\x1f\xcf\xec\xe2\xda\xd8\xa5\x93\x79\x11\x70\x75\x71\x75\x85\x0f\xc6\xe4\x8a\x71\x7b\x7b\x1d\x75\xa3\x2e\x6e\xa5\x60\xed\x39\x43\xa5\x33\x76\x08\x05\xa3\x6f\x49\x14\xbc\xbd\x39\xc3\x1f\xec\xbc\x34\x1a\x57\xf1\x05\x7e\xa9\x01\x9d\xf6\xaa\xf3\xeb\x6f\x51\x17\x4b\x53\xa1\xa4\x25\xb4\x09\xa8\x3c\x23\x14\xd2\x63\x26\x15\x83\xbf\x09\xb6\x01\x52\x43\x98\xd2\x2a\x49\x5a\x30\x16\x32\x14\x08\x7b\xfe\x38\xea\xe2\x6b\x4b\x61\xa6\x81\xa4\x06\x41\x18\xbb\x84\x99\x1d\xe2\x40\x61\x23\x78\xf3\x53\x84\x60\x93\x5e\x6f\xb1\x58\xc4\xb4\x51\x1b\x1b\x97\xf7\x54\x83\xf4\xbd\xdb\xf4\xfa\x66\x38\xbe\x39\xbf\x8a\x2f\x36\x31\xf7\x5a\xb1\xf7\x70\xfc\x57\x25\x1d\x67\x98\x2e\x41\xd6\x2a\x29\x68\xaa\x18\x8a\x16\x30\x0e\x94\x3b\xe6\x0c\xc1\xd4\x82\x17\x4e\x06\xa9\xf3\x33\x78\x33\x0b\x0b\x72\x1c\x75\x91\x49\x1f\x9c\x9c\x56\xe1\xa8\x5b\x5b\x79\xd2\x1f\x01\x8c\x06\x69\x74\xfa\x63\xa4\xe3\x0e\xde\xf5\xc7\xe9\xf8\x2c\xea\xe2\x4b\x3a\xf9\x78\x77\x3f\xc1\x97\xfe\x68\xd4\x1f\x4e\xd2\x9b\x31\xee\x46\xb8\xbe\x1b\x0e\xd2\x49\x7a\x37\x1c\xe3\xee\x3d\xfa\xc3\xaf\xf8\x3d\x1d\x0e\xce\xc0\x32\x14\xec\xc0\xdf\xac\xab\xf5\x1b\x07\x59\xf7\x91\xb3\xba\x69\x63\xe6\x23\x01\x33\xd3\x8c\xcf\x5b\x16\x72\x26\x05\x14\xe9\xbc\xa2\x9c\x91\x9b\x39\x3b\x2d\x75\x0e\xcb\xae\x94\xbe\x9e\xa6\x07\xe9\x2c\xea\x42\xc9\x52\x06\x0a\x9b\x93\x93\xa2\xe2\x28\x22\x2b\xdb\xf9\x27\x10\xc6\x71\x2c\xb4\x2b\x63\xa1\x4c\x95\xc5\xf9\x66\x95\x62\x61\xca\xde\xfc\x92\x94\x2d\xe8\x32\x7a\x94\x3a\x4b\x30\x66\x37\x97\x82\x3f\x91\xb5\x52\xe7\x51\xc9\x81\x32\x0a\x94\x44\x80\xa6\x92\x13\x90\x08\x72\xce\x99\x74\x2c\x82\x71\xcb\xe7\x59\x5b\xb4\xb7\x24\x38\x41\x0d\x39\xf7\x4b\x1f\xb8\x8c\xea\x1a\xf7\x64\xfd\x0d\xd9\x60\x4b\x16\x01\xf3\xad\xe4\xf9\xe5\x94\x03\x5d\x46\x80\x6f\x24\x7d\x34\x3e\x0c\x9f\x95\xd0\xe4\x25\x2b\x7d\x9b\xdb\xb1\x37\x95\x13\xec\xeb\x4c\xc0\x79\x9b\xad\xc1\x3d\x34\x15\x3c\xec\xe2\x1f\x32\x53\x92\xd4\x1b\x28\xd0\xb4\xe1\x89\xb0\xc1\x21\x82\xaa\x60\x3e\xb0\x66\x47\x81\xb3\x04\xc1\x55\xdc\xde\xc8\x6c\xc2\xa5\x55\x14\x38\x41\x67\xb5\xaa\xb3\xae\xd7\x9d\x93\xcb\x6b\xd2\xef\xf8\xde\x73\x36\x31\x9f\x28\x88\x62\xd4\xca\x6d\xaa\x9b\x91\xf2\x5b\xc2\x6d\x21\xfd\x39\x49\x55\xaf\x7c\xaa\xfb\xde\x73\x48\xf5\x9c\x75\x5d\xfb\x31\x7c\x3b\xad\x76\x7a\x49\x7b\x0c\x28\x9a\xb2\xf2\x49\xfb\xb7\x3d\xae\xfb\xca\x6e\x57\x49\x3a\x78\x2f\x59\x65\xc9\xa6\x59\x4f\xf2\xa7\x83\x3d\x57\x20\x97\x73\x38\xc5\x02\x73\x52\x15\x1f\x74\xc0\x3a\xf3\x27\x8b\xe0\x7b\xab\x55\xfb\xba\x5e\xf7\x94\x11\xcd\xd2\xf6\x72\x65\xa6\xa4\x7a\x4d\xf7\x6b\xd0\x26\x7e\xdf\xb0\x42\xb2\x23\x27\x0a\x29\x48\x8d\x78\xc6\x8e\xf5\x6e\xa4\xf5\xef\x39\xc2\xd2\x72\x82\x96\x7b\x77\x0e\x3c\xf2\x72\x77\x3c\xe2\xd9\x93\x62\x5e\xe0\x9a\xb5\x25\xbd\x96\xae\x7e\x32\xf6\xc2\x49\x5b\xd7\x93\xe0\xef\xf3\x83\x1b\x60\x52\xf0\x36\x08\xa1\xa0\xd0\xfc\x7b\xdd\x8a\xc0\x94\x95\xd1\xb9\x47\x30\xf1\x41\x58\x3e\x7f\xdc\x8b\xda\xaf\xe3\xe7\x13\x4d\xcf\x3a\x65\xff\xe4\xce\x54\x36\xd9\x65\x2b\x49\x53\xce\xee\x45\xb3\xbe\xd6\x23\x0f\xc1\x55\x3e\xfc\xd8\x29\x93\x03\xd8\x2b\xed\xf2\x6f\x97\xa5\x79\x5b\xaf\x7b\xab\x55\xb3\x91\x5b\x89\x6f\xc7\x75\xb5\x92\x04\xa7\xea\x9e\xa4\x79\xd1\x5c\x2f\x46\xfe\x74\xc6\x1b\x72\x86\x65\x76\xf5\xa7\xf2\x3b\xa6\xf8\x7c\x04\xf9\x8f\x0c\xd1\xea\x78\x90\xd9\x5b\xda\xfe\xbd\xaa\xef\x7f\x8c\x7e\x68\x86\x13\xa2\x9f\x26\xf8\x9f\x4c\xf0\xcf\x00\x19\x53\x43\xae\x89\x0c\x00\x00"),
 		},
 		"/alloydb.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "alloydb.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2960,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xc1\x6e\xe3\x36\x10\x86\xef\x7a\x8a\x81\x7d\x69\x81\x44\x4e\x72\x54\x4f\x8e\x93\xdd\x15\x9a\x75\x0a\xdb\xe9\x62\x4f\xc1\x98\x1a\x4b\x6c\x28\x92\x25\x47\xf6\x0a\xa9\xdf\xbd\x90\x45\x39\xd6\xda\xd9\x06\x58\x14\xdd\x43\x7d\x31\xc1\x19\xfe\xf3\x0f\xe7\x93\xa0\x21\x4c\x8c\xad\x9d\xcc\x0b\x86\xab\x8b\xab\x2b\x78\x6f\x4c\xae\x08\xee\xee\x26\xd1\x30\x1a\xc2\x9d\x14\xa4\x3d\x65\x50\xe9\x8c\x1c\x70\x41\x30\xb6\x28\x0a\xea\x22\x67\xf0\x3b\x39\x2f\x8d\x86\xab\xf8\x02\x7e\x6a\x12\x06\x21\x34\xf8\xf9\x97\x68\x08\xb5\xa9\xa0\xc4\x1a\xb4\x61\xa8\x3c\x01\x17\xd2\xc3\x4a\x2a\x02\xfa\x22\xc8\x32\x48\x0d\xc2\x94\x56\x49\xd4\x82\x60\x23\xb9\xd8\x95\x09\x22\x71\x34\x84\xcf\x41\xc2\x2c\x19\xa5\x06\x04\x61\x6c\x0d\x66\x75\x98\x07\xc8\x3b\xc3\xbb\x5f\xc1\x6c\x93\xd1\x68\xb3\xd9\xc4\xb8\x73\x1b\x1b\x97\x8f\x54\x9b\xe9\x47\x77\xe9\xe4\x76\x3a\xbf\x3d\xbf\x8a\x2f\x76\x67\x1e\xb4\x22\xef\xc1\xd1\x9f\x95\x74\x94\xc1\xb2\x06\xb4\x56\x49\x81\x4b\x45\xa0\x70\x03\xc6\x01\xe6\x8e\x28\x03\x36\x8d\xe1\x8d\x93\x2c\x75\x7e\x06\xde\xac\x78\x83\x8e\xa2\x21\x64\xd2\xb3\x93\xcb\x8a\x7b\xb7\xd5\xd9\x93\xbe\x97\x60\x34\xa0\x86\xc1\x78\x0e\xe9\x7c\x00\xd7\xe3\x79\x3a\x3f\x8b\x86\xf0\x29\x5d\x7c\xb8\x7f\x58\xc0\xa7\xf1\x6c\x36\x9e\x2e\xd2\xdb\x39\xdc\xcf\x60\x72\x3f\xbd\x49\x17\xe9\xfd\x74\x0e\xf7\xef\x60\x3c\xfd\x0c\xbf\xa6\xd3\x9b\x33\x20\xc9\x05\x39\xa0\x2f\xd6\x35\xfe\x8d\x03\xd9\xdc\x23\x65\xcd\xa5\xcd\x89\x7a\x06\x56\xa6\x35\xe4\x2d\x09\xb9\x92\x02\x14\xea\xbc\xc2\x9c\x20\x37\x6b\x72\x5a\xea\x1c\x2c\xb9\x52\xfa\x66\x9a\x1e\x50\x67\xd1\x10\x94\x2c\x25\x23\xef\x76\x8e\x9a\x8a\xa3\x08\xad\x0c\xf3\x4f\x40\x18\x47\xb1\xd0\xae\x8c\x85\x32\x55\x16\xe7\x3b\x94\x62\x61\xca\xd1\xfa\x12\x95\x2d\xf0\x32\x7a\x92\x3a\x4b\x60\x4e\x6e\x2d\x05\x7d\x44\x6b\xa5\xce\xa3\x92\x18\x33\x64\x4c\x22\x00\x8d\x25\x25\x80\x4a\x99\x3a\x5b\x9e\x56\x0b\x59\xde\xa2\xa0\x04\x9a\x94\x73\x5f\x7b\xa6\x32\x6a\x7a\x7b\x11\x19\x37\x22\x37\xd7\x11\xc0\xba\xb3\xb8\xbe\x5c\x12\xe3\x65\x04\xe0\x5b\x0b\x1f\x8c\xe7\x69\xaf\x64\x5b\x07\xad\xf4\xa1\x96\x23\x6f\x2a\x27\xc8\x37\xca\x00\xe7\x41\xbd\xcd\x7b\x0c\xc7\x1e\x97\x28\x9e\x2a\x1b\xb5\xfc\xb5\x5d\x86\xfa\xd7\x87\x11\xac\xd8\xbc\x27\x4d\x0e\x99\xb2\x04\xd8\x55\x14\x22\x32\x5b\x50\x69\x15\x32\x25\x30\xb0\xce\xfc\x41\x82\xfd\xe8\xf9\x39\x2c\xb7\xdb\x91\x32\xa2\x9d\xc4\xe8\xf9\xb9\x5b\x6f\xb7\xa3\xb6\x72\xb3\xd9\xae\x1e\x65\xb6\xdd\x0e\x8e\x54\x27\xa8\xaf\xe9\xc1\x53\xb6\x30\x1f\x91\x45\x31\x0b\x6d\xb5\xdd\xaf\x50\xf9\xce\x49\xd7\xf0\x78\x8d\x52\x35\x8f\x40\xaa\xc7\xde\x13\xa7\x7a\x4d\x9a\x8d\xab\xfb\xe9\xdd\xf4\xc2\x34\x93\xb0\xdd\x4d\x61\x6f\x6a\xbf\xaf\x70\x49\xca\x27\xe1\xff\xab\xa2\xe9\xcd\x8b\x00\xa3\xcb\x89\xdf\x49\x52\xd9\xb1\x4e\x21\xc9\xa1\x13\x85\x14\xa8\x66\xb4\x22\x47\x7a\x3f\xa3\x76\x4e\x5c\x5b\x4a\x20\xdc\xdf\x7e\x1f\xe0\x89\xea\xfd\xf6\x8c\x56\x5f\x19\x78\x45\x6b\x15\x6c\xbc\x55\xae\xf9\x65\xe4\x85\x93\x96\x77\xe8\xfd\x75\x7e\x10\x01\x58\x14\xd4\x1d\x02\x2e\x90\xdb\xf7\x62\x67\x02\x96\xa4\x8c\xce\x3d\xb0\x89\x0f\x8e\xe5\xeb\xa7\xa4\xa7\xd2\x82\xf6\xdb\x91\xa7\x93\xc8\x1f\xe8\x38\x53\xd9\x64\x5f\xad\x44\x8d\x39\xb9\x57\x9f\xb6\x57\xa1\x17\xaa\xf2\x4c\xee\x14\xf5\x93\x5e\xe8\x5f\xc0\x3e\xd4\x6e\x76\xc3\xf2\x07\x03\xff\xc5\xd5\x77\x92\x7f\x24\xf4\x3f\xfa\xff\x3d\xfa\x52\x7b\x6e\x3e\x58\x4e\xb1\x9f\xf6\x63\x6f\x84\x7f\xcf\xf1\x76\x3b\xea\xd4\x1b\xba\xbb\xf5\x0f\x86\xf7\x81\xad\xef\xe4\xfb\x58\xe9\xdb\x40\xee\xa8\x0b\x77\xd5\xa7\x6e\x8f\x6a\xff\xc5\xf4\x0d\x7e\x4e\xbe\xad\xde\x8e\xd1\x3f\x7d\xa7\x9c\xec\xb7\xb9\xbf\x83\x98\x45\x47\x9a\x03\x17\x7f\x07\x00\x00\xff\xff\x7a\xf1\x40\xbc\x90\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xcf\x6e\xe3\x36\x10\xc6\xef\x7a\x8a\x81\x7d\x69\x81\x44\x4e\x72\x54\x4f\x8e\x93\xdd\x15\x9a\xb5\x0b\xdb\xe9\x62\x4f\xc1\x98\x1a\x4b\x6c\x28\x92\x25\x47\xf6\x0a\xa9\xdf\xbd\x90\x45\xf9\xcf\xda\xd9\x06\x58\x14\xdd\x43\xa3\x00\x21\x38\xc3\x6f\xbe\xe1\xfc\x24\xa4\x0f\x23\x63\x6b\x27\xf3\x82\xe1\xe6\xea\xe6\x06\xde\x1b\x93\x2b\x82\x87\x87\x51\xd4\x8f\xfa\xf0\x20\x05\x69\x4f\x19\x54\x3a\x23\x07\x5c\x10\x0c\x2d\x8a\x82\xba\xc8\x05\xfc\x4e\xce\x4b\xa3\xe1\x26\xbe\x82\x9f\x9a\x84\x5e\x08\xf5\x7e\xfe\x25\xea\x43\x6d\x2a\x28\xb1\x06\x6d\x18\x2a\x4f\xc0\x85\xf4\xb0\x94\x8a\x80\xbe\x08\xb2\x0c\x52\x83\x30\xa5\x55\x12\xb5\x20\x58\x4b\x2e\x80\xf7\xfa\x71\xd4\x87\xcf\x41\xc2\x2c\x18\xa5\x06\x04\x61\x6c\x0d\x66\x79\x98\x07\xc8\x5b\xc3\xdb\x9f\x82\xd9\x26\x83\xc1\x7a\xbd\x8e\x71\xeb\x36\x36\x2e\x1f\xa8\x36\xd3\x0f\x1e\xd2\xd1\xfd\x78\x76\x7f\x79\x13\x5f\x6d\xcf\x3c\x6a\x45\xde\x83\xa3\x3f\x2b\xe9\x28\x83\x45\x0d\x68\xad\x92\x02\x17\x8a\x40\xe1\x1a\x8c\x03\xcc\x1d\x51\x06\x6c\x1a\xc3\x6b\x27\x59\xea\xfc\x02\xbc\x59\xf2\x1a\x1d\x45\x7d\xc8\xa4\x67\x27\x17\x15\x1f\xdd\x56\x67\x4f\xfa\xa3\x04\xa3\x01\x35\xf4\x86\x33\x48\x67\x3d\xb8\x1d\xce\xd2\xd9\x45\xd4\x87\x4f\xe9\xfc\xc3\xe4\x71\x0e\x9f\x86\xd3\xe9\x70\x3c\x4f\xef\x67\x30\x99\xc2\x68\x32\xbe\x4b\xe7\xe9\x64\x3c\x83\xc9\x3b\x18\x8e\x3f\xc3\xaf\xe9\xf8\xee\x02\x48\x72\x41\x0e\xe8\x8b\x75\x8d\x7f\xe3\x40\x36\xf7\x48\x59\x73\x69\x33\xa2\x23\x03\x4b\xd3\x8e\xcf\x5b\x12\x72\x29\x05\x28\xd4\x79\x85\x39\x41\x6e\x56\xe4\xb4\xd4\x39\x58\x72\xa5\xf4\xcd\x34\x3d\xa0\xce\xa2\x3e\x28\x59\x4a\x46\xde\xee\x9c\x34\x15\x47\x11\x5a\x19\xe6\x9f\x80\x30\x8e\x62\xa1\x5d\x19\x0b\x65\xaa\x2c\xce\xb7\x28\xc5\xc2\x94\x83\xd5\x35\x2a\x5b\xe0\x75\xf4\x2c\x75\x96\xc0\x8c\xdc\x4a\x0a\xfa\x88\xd6\x4a\x9d\x47\x25\x31\x66\xc8\x98\x44\x00\x1a\x4b\x4a\x00\x95\x32\x75\xb6\x38\xaf\x16\xb2\xbc\x45\x41\x09\x34\x29\x97\xbe\xf6\x4c\x65\xd4\xf4\xb6\x17\x19\x36\x22\x77\xb7\x11\xc0\xaa\xb3\xb8\xba\x5e\x10\xe3\x75\x04\xe0\x5b\x0b\x1f\x8c\xe7\xf1\x51\xc9\xb6\x0e\x5a\xe9\x43\x2d\x47\xde\x54\x4e\x90\x6f\x94\x01\x2e\x83\x7a\x9b\xf7\x14\x8e\x3d\x2d\x50\x3c\x57\x76\x9b\x01\xd0\x76\x19\xea\xdf\x1e\x46\xb0\x62\xf3\x9e\x34\x39\x64\xca\x12\x60\x57\x51\x88\xc8\x6c\x4e\xa5\x55\xc8\x94\x40\xcf\x3a\xf3\x07\x09\xf6\x83\x97\x97\xb0\xdc\x6c\x06\xca\x88\x76\x12\x83\x97\x97\x6e\xbd\xd9\x0c\xda\xca\x4d\x6a\xbb\x7a\x92\xd9\x66\xd3\x3b\x51\x1d\xa1\xbe\xa5\x47\x4f\xd9\xdc\x7c\x44\x16\xc5\x34\xb4\xd5\x76\xbf\x44\xe5\x3b\x27\x5d\xc3\xc3\x15\x4a\xd5\xbc\x02\xa9\x1e\x7a\x4f\x9c\xea\x15\x69\x36\xae\x3e\x4e\xef\xa6\x17\xa6\x99\x84\xed\x6e\x0a\x3b\x53\xbb\x7d\x85\x0b\x52\x3e\x09\x7f\xbf\x2a\x9a\xde\xed\x05\x18\x5d\x4e\xfc\x4e\x92\xca\x4e\x75\x0a\x49\x0e\x9d\x28\xa4\x40\x35\xa5\x25\x39\xd2\xbb\x19\x35\xbf\x97\xc0\xb5\xa5\x04\xc2\xfd\xed\xf6\x01\x9e\xa9\xde\x6d\x4f\x69\xf9\x95\x81\x57\xb4\x96\xc1\xc6\x5b\xe5\x9a\x27\x23\x2f\x9c\xb4\xcd\x9c\x12\xf8\xeb\xf2\x20\x02\x30\x2f\xa8\x3b\x04\x5c\x20\xb7\xdf\xc5\xce\x04\x2c\x48\x19\x9d\x7b\x60\x13\x1f\x1c\xcb\x57\xcf\x7b\x53\x7b\xd0\x7e\x3b\xf1\x74\x16\xf9\xfd\x93\x3b\x53\xd9\x64\x57\xad\x44\x8d\x39\xb9\x57\xdf\xb6\x57\xa1\x17\xaa\xf2\x4c\xee\x1c\xf5\xa3\xa3\xd0\xbf\x80\x7d\xa8\xdd\xec\x86\xe5\x0f\x06\xfe\xde\xd5\x77\x92\x7f\x22\xf4\x3f\xfa\xff\x3d\xfa\x52\x7b\x6e\xfe\x61\x39\xc7\x7e\x7a\x1c\x7b\x23\xfc\x3b\x8e\x37\x9b\x41\xa7\xde\xd0\xdd\xad\x7f\x30\xbc\x0f\x6c\x7d\x27\xdf\xa7\x4a\xdf\x06\x72\x0b\x71\xb8\xab\x63\xea\x76\xa8\x86\xe8\x3f\xf3\x73\xf6\x6b\xf5\x76\x8c\x02\x0d\xdf\xc0\xe7\x4c\xbf\xcd\xa7\xf4\x20\x66\xd1\x91\xe6\x04\xd8\x55\x14\xfd\x3d\x00\x7a\xf1\x40\xbc\x90\x0b\x00\x00"),
 		},
 		"/apigateway.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "apigateway.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3844,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4b\x73\xdb\x36\x10\xbe\xf3\x57\xec\x48\x97\x76\xc6\x26\x63\x1f\xd9\x93\x22\x3b\x0e\xa7\xb6\xec\x91\xe4\x66\x72\xf2\xac\xc0\x15\xb9\x35\x08\xa0\x00\x28\x85\xa3\xea\xbf\x77\xf8\xd0\xd3\xf6\x24\xcd\xa3\xf5\x21\xbc\x90\xc6\x2e\xbe\xfd\xf6\xf1\xad\xd5\x87\xa1\x36\x95\xe5\x2c\xf7\x70\xfe\xe6\xfc\x1c\xae\xb4\xce\x24\xc1\xf5\xf5\x30\xe8\x07\x7d\xb8\x66\x41\xca\x51\x0a\xa5\x4a\xc9\x82\xcf\x09\x06\x06\x45\x4e\x1b\xcb\x09\xfc\x41\xd6\xb1\x56\x70\x1e\xbe\x81\x5f\x6a\x87\x5e\x67\xea\xfd\xfa\x5b\xd0\x87\x4a\x97\x50\x60\x05\x4a\x7b\x28\x1d\x81\xcf\xd9\xc1\x9c\x25\x01\x7d\x12\x64\x3c\xb0\x02\xa1\x0b\x23\x19\x95\x20\x58\xb2\xcf\x9b\x30\x1d\x48\x18\xf4\xe1\x63\x07\xa1\x67\x1e\x59\x01\x82\xd0\xa6\x02\x3d\xdf\xf7\x03\xf4\x0d\xe1\xe6\xc9\xbd\x37\x71\x14\x2d\x97\xcb\x10\x1b\xb6\xa1\xb6\x59\x24\x5b\x4f\x17\x5d\x27\xc3\xcb\xd1\xe4\xf2\xf4\x3c\x7c\xd3\xdc\xb9\x57\x92\x9c\x03\x4b\x7f\x95\x6c\x29\x85\x59\x05\x68\x8c\x64\x81\x33\x49\x20\x71\x09\xda\x02\x66\x96\x28\x05\xaf\x6b\xc2\x4b\xcb\x9e\x55\x76\x02\x4e\xcf\xfd\x12\x2d\x05\x7d\x48\xd9\x79\xcb\xb3\xd2\x1f\x54\x6b\x43\x8f\xdd\x81\x83\x56\x80\x0a\x7a\x83\x09\x24\x93\x1e\xbc\x1d\x4c\x92\xc9\x49\xd0\x87\x0f\xc9\xf4\xfd\xed\xfd\x14\x3e\x0c\xc6\xe3\xc1\x68\x9a\x5c\x4e\xe0\x76\x0c\xc3\xdb\xd1\x45\x32\x4d\x6e\x47\x13\xb8\x7d\x07\x83\xd1\x47\xf8\x3d\x19\x5d\x9c\x00\xb1\xcf\xc9\x02\x7d\x32\xb6\xe6\xaf\x2d\x70\x5d\x47\x4a\xeb\xa2\x4d\x88\x0e\x08\xcc\x75\x4b\xc8\x19\x12\x3c\x67\x01\x12\x55\x56\x62\x46\x90\xe9\x05\x59\xc5\x2a\x03\x43\xb6\x60\x57\x77\xd3\x01\xaa\x34\xe8\x83\xe4\x82\x3d\xfa\xe6\xe4\x49\x52\x61\x10\xa0\xe1\xae\xff\x31\x08\x6d\x29\x14\xca\x16\xa1\x90\xba\x4c\xc3\xac\x19\xa5\x50\xe8\x22\x5a\x9c\xa1\x34\x39\x9e\x05\x8f\xac\xd2\x18\x26\x64\x17\x2c\xe8\x06\x8d\x61\x95\x05\x05\x79\x4c\xd1\x63\x1c\x00\x28\x2c\x28\x06\x34\x9c\xa1\xa7\x25\x56\xcf\x03\x76\x8e\xce\xa0\xa0\x18\x6a\x97\x53\x57\x39\x4f\x45\x50\xa7\xb7\xc3\x19\xdc\x25\x57\x2d\x4e\x00\xb0\xd8\x10\x5d\x9c\xcd\xc8\xe3\x59\x00\xe0\x5a\x22\xef\xb5\xf3\xa3\xe3\xc0\x6d\x34\x34\xec\xba\x88\x96\x9c\x2e\xad\x20\x57\xe3\x03\x9c\x76\x31\x5a\xbf\x07\x34\xfc\xd0\x5d\x6d\xbe\x85\x56\x73\xce\x82\x76\x20\xdb\xb4\x77\x6c\x06\x77\xc9\x70\xdf\x8e\xa5\xd7\x57\xa4\xc8\xa2\xa7\x34\x06\x6f\x4b\xea\x2c\x8c\x45\xeb\x19\x77\x07\x00\x46\x4b\x16\xd5\xe8\xf3\xc1\x1f\x18\x8b\x87\xd6\xfb\xe8\xf2\x0d\x15\x33\xb2\x5f\x0a\x51\x34\xde\x5b\x08\x4b\x73\xb2\xa4\x04\xbd\x63\x92\xe9\x8e\xd7\x5e\xf3\x0e\xb3\xaf\x1f\x5f\x19\x8a\x1b\x87\xed\xa1\x2b\x8d\xd1\xd6\xbb\xa1\x56\x29\x37\x23\x16\xc3\x1c\xa5\xdb\xa6\x9e\x4e\xa9\x30\x12\x3d\xc5\xd0\x33\x56\xff\x49\xc2\xbb\x68\xb5\xea\x3e\xd7\xeb\x48\x6a\xd1\xce\x66\x94\x49\x3d\x43\x19\xd5\xdd\x8a\x56\x2b\x34\xbc\x5e\x47\x2d\x87\xee\xef\x6d\x3e\xe9\x7a\xdd\x7b\x12\x60\x88\xea\x2d\xdd\x3b\x4a\xa7\xfa\x06\xbd\xc8\xc7\x5d\xab\xdb\x0a\xed\x93\xda\x0c\xc1\x60\x81\x2c\xeb\xfd\x90\xa8\x81\x73\xe4\x13\xb5\x20\xe5\xb5\xad\x0e\xdd\x37\xa3\xdd\x8d\xfa\xae\x58\xc7\xa5\x7a\xe0\x74\x6b\x93\x38\x23\xe9\xe2\xee\x7d\x14\x38\xb9\xd8\x81\x78\xb4\x19\xf9\xb6\x0d\xcf\x62\xe5\x4c\x16\xad\xc8\x59\xa0\x1c\x6f\xda\xe6\x76\x00\xa7\x5d\x5f\xba\x92\xee\xf5\xeb\x91\xaa\xed\xf1\x98\xe6\x47\x24\x5e\xc0\x9a\x77\x54\xbe\x14\xae\x7e\x52\x72\xc2\xb2\xf1\x8d\x32\xff\x3e\xdd\xb3\x00\x4c\x73\xda\x5c\x02\x9f\xa3\x6f\xff\x79\x6c\x48\xc0\x8c\xa4\x56\x99\x03\xaf\xc3\xbd\x6b\xd9\xe2\x31\x3e\x40\x69\xc5\x77\xf7\x84\xd3\xb3\x1b\x61\x0f\xc7\xea\xd2\xc4\xdb\x68\x05\x2a\xcc\xc8\xbe\xb8\x8f\x3e\xb7\x10\x5e\xde\x04\x3f\x68\x07\x7c\x8b\xf8\xbf\x4e\xf5\xff\xa3\xdc\x5f\xa1\xae\xbf\x8b\xa0\x7f\x2a\x79\x1f\xe5\x15\x28\x39\xdb\xfe\x9c\x80\x67\xd4\x7c\x75\x60\xfd\x9e\x8a\xde\xbc\xbf\x56\xd5\xfb\xf7\xff\xa5\xb2\x0f\x53\x86\x1f\xaf\xee\xd5\xca\x52\xc6\x5a\xad\xd7\x51\x17\xbb\x3e\xdb\x66\xf0\xaa\x94\xbe\x63\xf5\x8d\x6a\x7f\x02\xf4\x53\xf1\xff\x91\xe2\xff\x09\x00\x00\xff\xff\x64\xac\x09\xc2\x04\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x73\xdb\x36\x10\xbd\xf3\x57\xec\x48\x97\x76\xc6\x16\x63\x1f\xd9\x93\xa2\x24\x0e\xa7\xb6\xec\x91\xe4\x66\x72\xd2\xac\xc0\x15\xb9\x35\x08\xa0\x00\x28\x85\xa3\xea\xbf\x77\x48\x42\x1f\xfe\x9a\xa4\x49\x9a\xfa\x10\xd3\x33\xa4\xb1\x6f\xdf\x3e\x60\xf7\xc1\x7d\x18\x69\x53\x5b\xce\x0b\x0f\xe7\xaf\xce\xcf\xe1\x42\xeb\x5c\x12\x5c\x5e\x8e\xa2\x7e\xd4\x87\x4b\x16\xa4\x1c\x65\x50\xa9\x8c\x2c\xf8\x82\x60\x68\x50\x14\xb4\x8b\x9c\xc0\x1f\x64\x1d\x6b\x05\xe7\x83\x57\xf0\x4b\x03\xe8\x85\x50\xef\xd7\xdf\xa2\x3e\xd4\xba\x82\x12\x6b\x50\xda\x43\xe5\x08\x7c\xc1\x0e\x96\x2c\x09\xe8\x93\x20\xe3\x81\x15\x08\x5d\x1a\xc9\xa8\x04\xc1\x9a\x7d\x01\xfe\xc0\x3f\x88\xfa\xf0\x31\x50\xe8\x85\x47\x56\x80\x20\xb4\xa9\x41\x2f\x8f\x71\x80\xbe\x15\xdc\xfe\x14\xde\x9b\x24\x8e\xd7\xeb\xf5\x00\x5b\xb5\x03\x6d\xf3\x58\x76\x48\x17\x5f\xa6\xa3\xb7\xe3\xe9\xdb\xd3\xf3\xc1\xab\x36\xe7\x56\x49\x72\x0e\x2c\xfd\x55\xb1\xa5\x0c\x16\x35\xa0\x31\x92\x05\x2e\x24\x81\xc4\x35\x68\x0b\x98\x5b\xa2\x0c\xbc\x6e\x04\xaf\x2d\x7b\x56\xf9\x09\x38\xbd\xf4\x6b\xb4\x14\xf5\x21\x63\xe7\x2d\x2f\x2a\x7f\xef\xb4\x76\xf2\xd8\xdd\x03\x68\x05\xa8\xa0\x37\x9c\x42\x3a\xed\xc1\xeb\xe1\x34\x9d\x9e\x44\x7d\xf8\x90\xce\xde\x5f\xdf\xce\xe0\xc3\x70\x32\x19\x8e\x67\xe9\xdb\x29\x5c\x4f\x60\x74\x3d\x7e\x93\xce\xd2\xeb\xf1\x14\xae\xdf\xc1\x70\xfc\x11\x7e\x4f\xc7\x6f\x4e\x80\xd8\x17\x64\x81\x3e\x19\xdb\xe8\xd7\x16\xb8\x39\x47\xca\x9a\x43\x9b\x12\xdd\x13\xb0\xd4\x5d\xfb\x9c\x21\xc1\x4b\x16\x20\x51\xe5\x15\xe6\x04\xb9\x5e\x91\x55\xac\x72\x30\x64\x4b\x76\x4d\x37\x1d\xa0\xca\xa2\x3e\x48\x2e\xd9\xa3\x6f\x57\x1e\x6d\x6a\x10\x45\x68\x38\xf4\x3f\x01\xa1\x2d\x0d\x84\xb2\xe5\x40\x48\x5d\x65\x83\xbc\x1d\xa5\x81\xd0\x65\xbc\x3a\x43\x69\x0a\x3c\x8b\xee\x58\x65\x09\x4c\xc9\xae\x58\xd0\x15\x1a\xc3\x2a\x8f\x4a\xf2\x98\xa1\xc7\x24\x02\x50\x58\x52\x02\x68\x38\x47\x4f\x6b\xac\x9f\x26\x0c\x40\x67\x50\x50\x02\x0d\xe4\xd4\xd5\xce\x53\x19\x35\xdb\x3b\xf0\x0c\x6f\xd2\x8b\x8e\x27\x02\x58\xed\x84\xae\xce\x16\xe4\xf1\x2c\x02\x70\x9d\x90\xf7\xda\xf9\xf1\xc3\xc2\x5d\x35\x34\xec\x42\x45\x4b\x4e\x57\x56\x90\x6b\xf8\x01\x4e\x43\x8d\x0e\x37\x47\xc3\xf3\x90\xda\x7e\x0b\xad\x96\x9c\xb7\x48\x80\x6e\xdb\x07\x35\xc3\x9b\x74\x74\x1c\xc7\xca\xeb\x0b\x52\x64\xd1\x53\x96\x80\xb7\x15\x85\x08\x63\xd9\x21\x93\xb0\x00\x60\xb4\x64\x51\x8f\x3f\x5f\x7c\xce\x58\xce\x3b\xf4\x83\xe4\x2b\x2a\x17\x64\xbf\x94\xa2\x6c\xd1\x7b\x0a\x4b\x4b\xb2\xa4\x04\xbd\x63\x92\xd9\x41\xd7\x51\xf3\x42\xf6\x51\xc8\xd7\x86\x92\x16\xb0\x5f\x74\x95\x31\xda\x7a\x37\xd2\x2a\xe3\x76\xc4\x12\x58\xa2\x74\xfb\xad\x67\x33\x2a\x8d\x44\x4f\x09\xf4\x8c\xd5\x7f\x92\xf0\x2e\xde\x6c\xc2\xe7\x76\x1b\x4b\x2d\xba\xd9\x8c\x73\xa9\x17\x28\xe3\xa6\x5b\xf1\x66\x83\x86\xb7\xdb\xb8\xd3\x10\xfe\x0e\x8a\xe6\x9c\x6d\xb7\xbd\x47\x05\x46\xa8\x5e\xd3\xad\xa3\x6c\xa6\xaf\xd0\x8b\x62\x12\x5a\xdd\x9d\xd0\xb1\xa8\xdd\x10\x0c\x57\xc8\xb2\xb9\x1f\x52\x35\x74\x8e\x7c\xaa\x56\xa4\xbc\xb6\xf5\x7d\xf8\x6e\xb4\xc3\xa8\x27\xd1\x73\x47\x35\xe7\x6c\x1f\x93\xb8\x20\xe9\x92\xf0\x7e\x50\x38\x7d\x73\x20\xf1\x68\x73\xf2\x5d\x1b\x9e\xe4\x2a\x98\x2c\x5a\x51\xb0\x40\x39\xd9\xb5\x2d\xcc\x6f\xf3\x7b\x1a\xfa\x12\x8e\x74\xbf\x0e\x70\x47\xf5\x7e\x79\x42\xcb\x07\x22\x9e\xe1\x5a\x06\x29\x5f\x4a\xd7\x3c\x19\x39\x61\xd9\x34\x6d\x4c\xe0\xef\xd3\xa3\x08\xc0\xac\xa0\x5d\x12\xf8\x02\x7d\xf7\xcf\x63\x27\x02\x16\x24\xb5\xca\x1d\x78\x3d\x38\x4a\xcb\x57\x77\x07\x51\x07\xf3\xdd\x3c\xd2\xf4\xe4\x8d\x70\x78\x72\xab\x2b\x93\xec\xab\x95\xa8\x30\x27\xfb\xec\x7d\xf4\xb9\x0b\xe1\xf9\x9b\xe0\x3f\xba\x03\xbe\xc5\xfc\x5f\xe7\xfa\xff\xd1\xee\x2f\xd0\xd7\xdf\xc5\xd0\x3f\x9d\xfc\xc2\x9c\x1c\xde\xcf\xb8\xf9\xe2\x5e\xf4\x7b\x3a\x7a\xf7\xfe\x5a\x57\x1f\xe7\xff\x4b\x67\x87\xd4\x1f\xe7\xee\xcd\xc6\x52\xce\x5a\x6d\xb7\x71\xa8\xdd\xac\x85\xcf\x17\xe6\xf4\x83\xaa\x6f\x74\xfb\x23\xa2\x9f\x8e\xff\x41\x8e\xff\x67\x00\x64\xac\x09\xc2\x04\x0f\x00\x00"),
 		},
 		"/apigee.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "apigee.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 5949,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x4f\x6f\xdb\xb8\x13\xbd\xeb\x53\x0c\xe2\xcb\xef\x07\x24\x76\x93\xa3\xf7\xa4\x3a\x69\x2b\x34\x75\x80\xc8\xdd\xa2\x27\x61\x4c\x8d\x24\x22\x12\xc9\x25\x29\xb9\x5a\x6f\xbe\xfb\x42\xff\x6c\xc9\x95\xb3\x6e\x76\x51\xb4\xa9\x0f\x81\xe3\x79\xf3\xe6\xf1\x0d\x35\xa2\x34\x81\x85\x54\xa5\xe6\x71\x62\xe1\xea\xd5\xd5\x15\xbc\x95\x32\x4e\x09\x6e\x6f\x17\xce\xc4\x99\xc0\x2d\x67\x24\x0c\x85\x90\x8b\x90\x34\xd8\x84\xc0\x55\xc8\x12\xea\x22\xe7\xf0\x3b\x69\xc3\xa5\x80\xab\xe9\x2b\xf8\x5f\x05\x38\x6b\x43\x67\xff\xff\xcd\x99\x40\x29\x73\xc8\xb0\x04\x21\x2d\xe4\x86\xc0\x26\xdc\x40\xc4\x53\x02\xfa\xc2\x48\x59\xe0\x02\x98\xcc\x54\xca\x51\x30\x82\x0d\xb7\x49\x5d\xa6\x25\x99\x3a\x13\xf8\xdc\x52\xc8\xb5\x45\x2e\x00\x81\x49\x55\x82\x8c\xfa\x38\x40\x5b\x0b\xae\x3f\x89\xb5\x6a\x3e\x9b\x6d\x36\x9b\x29\xd6\x6a\xa7\x52\xc7\xb3\xb4\x41\x9a\xd9\xad\xb7\xb8\x59\xfa\x37\x17\x57\xd3\x57\x75\xce\x47\x91\x92\x31\xa0\xe9\x8f\x9c\x6b\x0a\x61\x5d\x02\x2a\x95\x72\x86\xeb\x94\x20\xc5\x0d\x48\x0d\x18\x6b\xa2\x10\xac\xac\x04\x6f\x34\xb7\x5c\xc4\xe7\x60\x64\x64\x37\xa8\xc9\x99\x40\xc8\x8d\xd5\x7c\x9d\xdb\x81\x5b\x9d\x3c\x6e\x06\x00\x29\x00\x05\x9c\xb9\x3e\x78\xfe\x19\xbc\x76\x7d\xcf\x3f\x77\x26\xf0\xc9\x5b\xbd\xbb\xfb\xb8\x82\x4f\xee\xfd\xbd\xbb\x5c\x79\x37\x3e\xdc\xdd\xc3\xe2\x6e\x79\xed\xad\xbc\xbb\xa5\x0f\x77\x6f\xc0\x5d\x7e\x86\xf7\xde\xf2\xfa\x1c\x88\xdb\x84\x34\xd0\x17\xa5\x2b\xfd\x52\x03\xaf\x7c\xa4\xb0\x32\xcd\x27\x1a\x08\x88\x64\x23\xc8\x28\x62\x3c\xe2\x0c\x52\x14\x71\x8e\x31\x41\x2c\x0b\xd2\x82\x8b\x18\x14\xe9\x8c\x9b\xaa\x9b\x06\x50\x84\xce\x04\x52\x9e\x71\x8b\xb6\xfe\xe5\xab\x45\x4d\x1d\x07\x15\x6f\xfb\x3f\x07\x26\x35\x4d\x99\xd0\xd9\x94\xa5\x32\x0f\xa7\x71\xbd\x95\xa6\x4c\x66\xb3\xe2\x12\x53\x95\xe0\xa5\xf3\xc0\x45\x38\x07\x9f\x74\xc1\x19\x7d\x40\xa5\xb8\x88\x9d\x8c\x2c\x86\x68\x71\xee\x00\x08\xcc\x68\x0e\xa8\x78\x4c\x47\xc8\x5a\x90\x51\xc8\x68\x0e\x15\xe4\xc2\x94\xc6\x52\xe6\x54\x4b\xdb\x73\xb8\x35\x87\x03\x50\x74\x02\x8b\xcb\x35\x59\xbc\x74\x00\x4c\x23\xe0\x9d\x34\x76\xd9\x2f\xd8\x54\x41\xc5\x4d\x5b\x49\x93\x91\xb9\x66\x64\x2a\x5e\x80\x8b\x96\xbb\xc1\x05\x4d\x56\x80\x61\x28\x85\x09\x98\x14\x11\x8f\x9d\x66\x0b\x36\x0b\x6d\x34\xb8\x75\x7c\xd1\x0f\x63\x6e\xe5\x5b\x12\xa4\xd1\x52\x38\x07\xab\x73\x6a\x23\x3c\x5c\x51\xa6\x52\xb4\x34\x87\x33\xa9\x63\x14\xfc\xcf\xa6\x01\xb3\xed\xb6\xaa\xfe\xf8\x78\xf6\x15\x74\x81\xe2\x35\x7d\x34\x14\xae\xe4\x07\xb4\x2c\xb9\x6f\x65\x37\x8b\x8b\x30\x35\x1d\x7d\xb7\x20\xb7\x40\x9e\x56\xfb\xdb\x13\xae\x31\x64\x3d\x51\x90\xb0\x52\x97\x43\x78\xd7\x9a\xb6\x55\xf3\xf6\xe7\xce\xe3\xea\xef\x01\xb1\x77\xbd\x07\x59\xd4\x31\xd9\x37\x9c\xd2\xb0\x87\x1d\x37\x91\x44\xa8\x24\x17\x36\x40\x6b\x91\x25\x19\x09\x3b\x62\xe5\x4d\x8b\x72\x0f\x41\x27\x1a\xba\xdd\x4a\x1d\x07\x3c\x7c\x7c\x9c\xd1\x57\x54\x95\xc3\x23\x32\x6a\xf8\x8f\xe3\xf9\xb8\xc2\x53\xbb\xf0\x44\xf6\xb1\xbe\x14\xc1\x03\x95\xc6\x4a\x4d\xa3\x0d\x29\xde\x0f\xa3\x27\x77\xa2\x62\xae\x3b\xd1\xd1\xbf\xf8\x1d\x5e\x04\x9a\x22\xd2\x24\x18\x99\x71\x2f\xef\x0f\xe3\xdf\xee\xe6\xbe\xc4\x8b\xb7\x33\xd6\x32\x57\xe3\x46\xf6\x43\xcf\x99\x0d\x0d\xc1\x2f\x62\xe1\x3f\xcd\xdc\x06\xf5\xfc\x99\xbb\xab\x53\x9b\x8b\x83\x81\xfb\xdd\xed\xad\xee\xfd\xa4\x77\xba\xbd\xeb\x43\xaf\xfe\x13\x5f\xb9\x96\xe2\xb8\x9f\x07\xd1\x27\x8c\xc4\xac\x39\x37\xec\x85\x28\x99\x72\x56\x2e\x9f\x2e\x1b\x70\xcc\x82\x06\x79\x90\xf8\x81\xb2\x35\xe9\x53\xd2\xb3\x1a\xb9\x4b\xdf\xcd\x95\xc6\x83\xdd\xef\xfb\xfb\x52\xb1\xbf\x0f\xd5\x8e\x95\x6a\xb8\x5d\x01\x4c\xae\x94\xd4\xb6\x3a\x0b\x85\xbc\x3e\xd4\x0c\x7b\xf3\xc4\xf5\xd8\x49\x7b\xe1\x97\x24\x17\xc6\x56\x0f\x42\x23\xfb\xc6\x1b\x86\xbe\x7d\xaa\x75\xdc\xbf\x88\x85\x4f\x4f\xb5\xce\xcd\xe7\x4f\xb5\x5d\x9d\x5f\x64\xaa\xed\x8e\x69\x01\xa6\x1c\x0d\x99\xc0\x50\x1a\x05\x86\xc7\x82\xc2\x80\x91\x1e\xb3\xb9\x3b\x1c\x1a\xb7\xc9\xf1\x29\x8d\xfc\x3a\x63\xb1\x4f\x78\xe6\xd3\xd0\xb1\xf1\xd0\xfb\xf7\xe0\x74\xd9\x7d\xaf\xfa\xd5\xe8\x99\x6d\xb7\xf5\xb7\x1f\xe9\x5a\xa8\x05\x9d\xda\xb4\x3e\x38\xe1\xa4\x51\xb3\x84\x33\x4c\xf7\x27\xc9\x7d\xe2\x45\x3b\x93\xfb\x46\xf6\x06\xf6\x03\x95\xc3\xd8\x3d\x45\x07\x32\x8e\xb0\x46\xad\x98\xa6\x23\xa7\x51\x56\x9f\x90\x0c\xd3\x5c\xd9\xfa\xf1\xfc\xaf\x8b\x5e\x04\x60\x95\xd0\x20\x13\x6c\x82\xb6\x79\x7d\xd4\xa9\x81\x35\xa5\x52\xc4\x06\xac\x9c\xf6\x72\xe3\xe2\x61\x3e\xa0\x6a\x36\xe4\xdd\xf8\xaa\x47\x5f\x10\xf4\xc8\xaa\x83\xcb\x7c\x57\x32\x43\x81\x31\xe9\xa3\xaf\x26\x8e\x5d\x3c\x02\x6d\x80\x61\xa8\xc9\x8c\x9d\xfc\x97\xee\xca\x1d\x04\x9f\x39\x84\x04\xda\x96\xe7\xa5\x0f\xf9\x91\x2d\xdc\x37\x74\xa4\xd7\x3f\xcb\x2b\x97\xef\x30\xc9\x4d\x29\x58\x80\xb9\x4d\xa4\x3e\x6e\xa1\x5f\x0a\xe6\x8e\x60\xfe\x95\x8f\x33\x73\xc8\xfa\x13\xef\xce\xbf\x03\x00\x00\xff\xff\x61\xef\xc6\x0b\x3d\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\xdf\x6f\xdb\x36\x10\x7e\xd7\x5f\x71\x88\x5f\x36\x20\xb1\x9b\x3c\x7a\x4f\xaa\x93\xb6\x42\x53\x07\x88\xdc\x15\x7d\x12\xce\xd4\x49\x22\x22\x91\x1c\x49\xd9\xd5\xbc\xfc\xef\x83\x7e\xd9\x92\x2a\x1b\x69\x36\x60\x5d\x52\x03\x45\xa2\xfb\xee\xbb\x8f\xdf\x91\x17\x5a\x13\x58\x48\x55\x68\x1e\x27\x16\xae\xde\x5c\x5d\xc1\x7b\x29\xe3\x94\xe0\xf6\x76\xe1\x4c\x9c\x09\xdc\x72\x46\xc2\x50\x08\xb9\x08\x49\x83\x4d\x08\x5c\x85\x2c\xa1\x36\x72\x0e\xbf\x93\x36\x5c\x0a\xb8\x9a\xbe\x81\x5f\x4a\xc0\x59\x13\x3a\xfb\xf5\x37\x67\x02\x85\xcc\x21\xc3\x02\x84\xb4\x90\x1b\x02\x9b\x70\x03\x11\x4f\x09\xe8\x1b\x23\x65\x81\x0b\x60\x32\x53\x29\x47\xc1\x08\xb6\xdc\x26\x60\x0f\xfc\x53\x67\x02\x5f\x1b\x0a\xb9\xb6\xc8\x05\x20\x30\xa9\x0a\x90\x51\x17\x07\x68\x2b\xc1\xd5\xbf\xc4\x5a\x35\x9f\xcd\xb6\xdb\xed\x14\x2b\xb5\x53\xa9\xe3\x59\x5a\x23\xcd\xec\xd6\x5b\xdc\x2c\xfd\x9b\x8b\xab\xe9\x9b\x2a\xe7\xb3\x48\xc9\x18\xd0\xf4\x47\xce\x35\x85\xb0\x2e\x00\x95\x4a\x39\xc3\x75\x4a\x90\xe2\x16\xa4\x06\x8c\x35\x51\x08\x56\x96\x82\xb7\x9a\x5b\x2e\xe2\x73\x30\x32\xb2\x5b\xd4\xe4\x4c\x20\xe4\xc6\x6a\xbe\xce\x6d\xcf\xad\x56\x1e\x37\x3d\x80\x14\x80\x02\xce\x5c\x1f\x3c\xff\x0c\xde\xba\xbe\xe7\x9f\x3b\x13\xf8\xe2\xad\x3e\xdc\x7d\x5e\xc1\x17\xf7\xfe\xde\x5d\xae\xbc\x1b\x1f\xee\xee\x61\x71\xb7\xbc\xf6\x56\xde\xdd\xd2\x87\xbb\x77\xe0\x2e\xbf\xc2\x47\x6f\x79\x7d\x0e\xc4\x6d\x42\x1a\xe8\x9b\xd2\xa5\x7e\xa9\x81\x97\x3e\x52\x58\x9a\xe6\x13\xf5\x04\x44\xb2\x6e\x9f\x51\xc4\x78\xc4\x19\xa4\x28\xe2\x1c\x63\x82\x58\x6e\x48\x0b\x2e\x62\x50\xa4\x33\x6e\xca\x6e\x1a\x40\x11\x3a\x13\x48\x79\xc6\x2d\xda\xea\xc9\x77\x8b\x9a\x3a\x0e\x2a\xde\xf4\x7f\x0e\x4c\x6a\x9a\x32\xa1\xb3\x29\x4b\x65\x1e\x4e\xe3\x6a\x2b\x4d\x99\xcc\x66\x9b\x4b\x4c\x55\x82\x97\xce\x03\x17\xe1\x1c\x7c\xd2\x1b\xce\xe8\x13\x2a\xc5\x45\xec\x64\x64\x31\x44\x8b\x73\x07\x40\x60\x46\x73\x40\xc5\x63\x3a\x42\xd6\x80\x8c\x42\x46\x73\x28\x21\x17\xa6\x30\x96\x32\xa7\x5c\xda\x81\xc3\xad\x38\x1c\x80\x4d\x2b\x70\x73\xb9\x26\x8b\x97\x0e\x80\xa9\x05\x7c\x90\xc6\x2e\xbb\x05\x6b\xc9\xa8\xb8\x69\x2a\x69\x32\x32\xd7\x8c\x4c\xc9\x0b\x70\xd1\x70\xd7\xb8\xa0\x96\x19\x60\x18\x4a\x61\x02\x26\x45\xc4\xe3\x0a\x07\x50\x2f\xb4\xd6\xe0\x56\xf1\x45\x37\x8c\xb9\x95\xef\x49\x90\x46\x4b\xe1\x1c\xac\xce\xa9\x89\xf0\x70\x45\x99\x4a\xd1\xd2\x1c\xce\xa4\x8e\x51\xf0\x3f\xeb\x06\xcc\x76\xbb\xb2\xfa\xe3\xe3\xd9\x77\xd0\x05\x8a\xb7\xf4\xd9\x50\xb8\x92\x9f\xd0\xb2\xe4\xbe\x91\x5d\x2f\x2e\xc2\xd4\xb4\xf4\xed\x82\xdc\x0d\xf2\xb4\xdc\xdf\x9e\x70\x8d\x21\xeb\x89\x0d\x09\x2b\x75\xd1\x87\xb7\xad\x69\x5a\x35\x6f\x1e\xb7\x1e\x97\xff\x0f\x88\xbd\xeb\x03\xc8\xa2\x8e\xc9\xbe\xe3\x94\x86\x1d\xec\xb8\x89\x24\x42\x25\xb9\xb0\x01\x5a\x8b\x2c\xc9\x48\xd8\x11\x2b\x6f\x1a\x94\x3b\x04\x3d\xd1\xd0\xdd\x4e\xea\x38\xe0\xe1\xe3\xe3\x8c\xbe\xa3\x32\xb3\xdd\x6e\x44\x46\x05\xff\x79\x3c\x1f\x57\x38\x28\x75\xb4\x0b\x27\xb2\x8f\xf5\x65\x13\x3c\x50\x61\xac\xd4\x34\xda\x90\xcd\xc7\x7e\xf4\xc9\x9d\x28\x99\x4b\x6b\x67\x2d\xfd\x8b\xdf\xe1\x9b\x40\x53\x44\x9a\x04\x23\x33\xee\xe5\xfd\x30\xfe\xe3\x6e\x1e\x4a\xbc\x78\x3b\x63\x2d\x73\x35\x6e\x64\x37\xf4\x9c\xd9\x50\x13\xbc\x12\x0b\x3b\xc3\xe0\x84\x9b\xcf\x9f\xb9\xfb\x3a\xd5\x71\x3f\x14\xfb\x4f\xec\x2d\xff\xf6\x93\xde\xeb\xf6\xae\x87\x5e\xfd\x2b\xbe\x72\x2d\xc5\x71\x3f\x07\xd1\x13\x46\x62\x56\xdf\x1b\x0e\x42\x94\x4c\x39\x2b\x96\xa7\xcb\x06\x1c\xb3\xa0\x46\x0e\x12\x3f\x51\xb6\x26\xfd\x94\xf4\xac\x42\xee\xd3\xf7\x73\xa5\xf6\x60\xff\xbc\xdd\x97\xf5\x38\xef\x3c\xb6\x85\xea\x6f\x57\x00\x93\x2b\x25\xb5\x35\x0b\x29\x42\x5e\x5d\x6a\xfa\xbd\x39\x71\x1e\x5b\x69\x2f\xfc\x48\x72\x61\x6c\xf9\x45\x68\x64\xdf\x78\xfd\xd0\x8f\x4f\xb5\x96\xfb\x95\x58\x78\x7a\xaa\xb5\x6e\x3e\x7f\xaa\xed\xeb\xbc\x92\xa9\xb6\xbf\xa6\x05\x98\x72\x34\x64\x02\x43\x69\x14\x18\x1e\x0b\x0a\x03\x46\x7a\xcc\xe6\xf6\x72\x68\xdc\x3a\xc7\xa7\x34\xf2\xab\x8c\x05\xe9\x1f\xb4\x7c\xf8\x6d\xe8\xd8\x78\xe8\xfc\x3a\xb8\x5d\xb6\x3f\x97\xfd\xaa\xf5\xcc\x76\xbb\x6a\x35\x3f\xd3\x59\xa8\x04\x0d\x98\x8f\x36\xad\x0b\x4e\x38\x69\xd4\x2c\xe1\x0c\xd3\xc3\x4d\xf2\x90\x78\xd1\xcc\xe4\xae\x91\xfb\x20\xc0\x03\x15\xfd\xd8\x3d\x45\x03\x19\x47\x58\xa3\x46\x4c\xdd\x91\xa7\x51\x96\x9f\x90\x0c\xd3\x5c\x95\x81\x39\xfc\x75\xd1\x89\x00\xac\x12\xea\x65\x82\x4d\xd0\xd6\xaf\x8f\x5a\x35\xb0\xa6\x54\x8a\xd8\x80\x95\xd3\x4e\x6e\xbc\x79\x38\xa8\x3b\x6c\xc8\xbb\x0e\x59\x2f\x3c\xf2\x82\xe0\xf0\xa9\xae\x3e\xf3\x7d\xc9\x0c\x05\xc6\xa4\x8f\xbe\x9a\x38\x76\x78\x04\xda\xf2\x3d\x41\xf9\xa2\x66\xe4\x94\x2c\xdd\x95\xdb\x0b\x3e\x73\x08\x09\xb4\x0d\xcf\x4b\x1f\xf2\xdd\x9d\x31\x62\xe8\x48\xaf\x9f\x39\x64\x5e\xe2\x24\x37\x85\x60\x01\xe6\x36\x91\xfa\xb8\x85\x7e\x21\x98\x3b\x82\xf9\x47\x3e\xce\xcc\x90\xf5\x7f\xbc\x3b\xff\x1e\x00\x61\xef\xc6\x0b\x3d\x17\x00\x00"),
 		},
 		"/appengine.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "appengine.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3975,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xdb\x48\x0c\xbd\xeb\x57\x10\xf6\x65\x17\x48\xec\x24\x47\xed\x49\xcd\x47\x2b\x6c\xe2\x00\x96\xd3\xa2\x27\x81\x96\x28\x99\x9b\xd1\xcc\xec\xcc\xc8\x8e\x51\xe4\xbf\x2f\x24\x8d\x63\x39\x89\x1b\xa3\xdb\x43\x0e\xd1\x49\x1e\x3e\x92\x8f\x8f\x14\xc7\x43\x38\x57\x7a\x6d\xb8\x5c\x38\x38\x3b\x39\x3b\x83\xcf\x4a\x95\x82\xe0\xfa\xfa\x3c\x18\x06\x43\xb8\xe6\x8c\xa4\xa5\x1c\x6a\x99\x93\x01\xb7\x20\x88\x34\x66\x0b\xda\x58\x8e\xe0\x2b\x19\xcb\x4a\xc2\xd9\xe8\x04\xfe\x68\x00\x03\x6f\x1a\xfc\xf9\x57\x30\x84\xb5\xaa\xa1\xc2\x35\x48\xe5\xa0\xb6\x04\x6e\xc1\x16\x0a\x16\x04\xf4\x90\x91\x76\xc0\x12\x32\x55\x69\xc1\x28\x33\x82\x15\xbb\x45\x9b\xc6\x07\x19\x05\x43\xf8\xee\x43\xa8\xb9\x43\x96\x80\x90\x29\xbd\x06\x55\xf4\x71\x80\xae\x25\xdc\x3e\x0b\xe7\x74\x38\x1e\xaf\x56\xab\x11\xb6\x6c\x47\xca\x94\x63\xd1\x21\xed\xf8\x3a\x3e\xbf\x9c\x24\x97\xc7\x67\xa3\x93\xd6\xe7\x4e\x0a\xb2\x16\x0c\xfd\x5b\xb3\xa1\x1c\xe6\x6b\x40\xad\x05\x67\x38\x17\x04\x02\x57\xa0\x0c\x60\x69\x88\x72\x70\xaa\x21\xbc\x32\xec\x58\x96\x47\x60\x55\xe1\x56\x68\x28\x18\x42\xce\xd6\x19\x9e\xd7\x6e\x47\xad\x0d\x3d\xb6\x3b\x00\x25\x01\x25\x0c\xa2\x04\xe2\x64\x00\x9f\xa2\x24\x4e\x8e\x82\x21\x7c\x8b\x67\x5f\x6e\xef\x66\xf0\x2d\x9a\x4e\xa3\xc9\x2c\xbe\x4c\xe0\x76\x0a\xe7\xb7\x93\x8b\x78\x16\xdf\x4e\x12\xb8\xbd\x82\x68\xf2\x1d\xfe\x8e\x27\x17\x47\x40\xec\x16\x64\x80\x1e\xb4\x69\xf8\x2b\x03\xdc\xe8\x48\x79\x23\x5a\x42\xb4\x43\xa0\x50\x1d\x21\xab\x29\xe3\x82\x33\x10\x28\xcb\x1a\x4b\x82\x52\x2d\xc9\x48\x96\x25\x68\x32\x15\xdb\xa6\x9b\x16\x50\xe6\xc1\x10\x04\x57\xec\xd0\xb5\x27\x2f\x8a\x1a\x05\x01\x6a\xf6\xfd\x0f\x21\x53\x86\x46\x99\x34\xd5\x28\x13\xaa\xce\x47\x65\x3b\x4a\xa3\x4c\x55\xe3\xe5\x29\x0a\xbd\xc0\xd3\xe0\x9e\x65\x1e\x42\x42\x66\xc9\x19\xdd\xa0\xd6\x2c\xcb\xa0\x22\x87\x39\x3a\x0c\x03\x00\x89\x15\x85\x8d\xfc\x24\x4b\x96\x7b\xe2\x79\x9c\xd5\x98\x51\x08\x0d\xe4\xd8\xae\xad\xa3\x2a\x68\xaa\xdb\x86\x89\xb4\xbe\x6c\xc3\x04\x00\xcb\x0d\xcd\xe5\xe9\x9c\x1c\x9e\x06\x00\xb6\xa3\xf1\x45\x59\x37\x79\x96\xb6\xcb\x85\x9a\xad\xcf\x67\xc8\xaa\xda\x64\x64\x9b\xe8\x00\xc7\x3e\x43\x87\x4b\x51\xeb\xb4\xf3\x4c\xfd\xe4\x34\x92\xa5\xb5\x11\x69\xce\x56\xa3\xcb\x16\xa9\xa9\x05\xd9\xa0\x1b\xd0\x4e\x86\x27\x7a\xd1\xd6\xe7\x6e\x7a\x7d\xe1\x3d\xa6\x3d\x07\xac\x9d\xfa\x4c\x92\x0c\x3a\xca\x43\x70\xa6\x26\x6f\xe1\x7c\x46\x95\x16\xe8\x28\x84\xc1\x8f\x1f\xda\xa8\x7f\x28\x73\x8f\x8f\x83\x17\xf6\x73\x94\x9f\xe8\xce\x52\x3e\x53\x37\x6d\x7c\x5f\x52\x57\x7b\x81\xc2\x6e\x62\x6e\x8a\x8d\x96\xc8\xa2\xf9\x08\x62\x19\x59\x4b\x2e\x96\x4b\x92\x4e\x99\xf5\x2e\x7c\xd3\x3f\xdf\xcf\xd0\x1f\x6f\xba\xe0\x29\x3d\x8b\x1d\x5f\x6c\x71\x0e\x4d\x49\xee\x8a\x49\xe4\xbb\xf0\xfd\x32\xe7\xaa\x42\x96\x69\xe5\x47\xe8\x55\x59\x2f\x5a\xcc\xcd\x0e\xe4\x40\x21\x51\x6b\x3b\xee\xa9\x39\xce\xfb\xb1\x1a\x93\x27\xd0\xf0\x7b\x4f\x62\xf7\x68\x1d\x2a\xf8\x73\x97\xfd\xa2\x17\x6c\x68\x85\x42\xb4\xb3\xfc\xba\xe6\x57\x1e\x32\xdd\x22\x7e\x55\xf2\x4d\xb6\x31\xcb\xb2\x59\x70\xed\xf7\xd0\x22\x58\x19\x76\xeb\xf7\x24\xfb\x86\xd3\xe1\x43\xde\xc3\xff\x44\x70\x41\x0f\x3c\xf7\x67\x7e\x83\xed\xd1\xdd\x23\x23\xad\xbf\xee\xe0\x7e\x55\x7d\xbf\x1c\x9b\x43\xff\xfa\xf8\x38\xf6\x14\x9a\x43\xff\x9a\x72\xfe\x9e\xfa\xb0\x65\x75\x68\x27\xf6\x7a\x4c\xa9\x20\x43\xf2\x69\xe5\x77\x9d\xba\xa7\x75\xb8\xb9\x38\xa6\x54\x3c\x59\x00\x5c\xe1\x23\x7a\x6b\xcf\x54\x2e\xef\xc3\xde\xcf\x17\xcd\x4b\x5e\x78\xbc\x7a\x5f\xf5\x02\x1a\x55\xeb\x43\xae\xc9\x57\x4b\xee\x2d\x87\xe6\xd1\x68\x48\xba\xde\x68\xec\x9f\x47\x5f\x5a\x2a\xc9\xad\x94\xb9\x4f\x2d\xb9\xe6\x7f\xd0\x9e\x6b\xcd\x97\x35\xe9\xc0\xc9\x2e\xf6\x77\xce\xe5\xfb\x99\xbf\xdd\xde\xbf\x39\x7c\x7d\xf8\xdb\xaa\x5b\x2d\xd8\xa5\xce\x60\x51\x70\xf6\x53\xc9\x93\x06\x39\xdb\x01\x7e\xe8\x7d\xb8\xde\x0e\x65\x8e\x26\x7f\x7b\xeb\x26\x1e\xf9\xb1\x75\x3f\xb6\xee\xff\xd9\xba\xff\x05\x00\x00\xff\xff\x02\x05\x1a\x1f\x87\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\x0c\xec\xcb\x2e\x90\xd8\x49\x8e\xde\x93\x9a\x8f\x56\xd8\xd4\x01\x2c\xa7\x45\x4f\xc2\x58\x1a\xc9\xb3\xa1\x48\x2e\x49\xd9\x31\x8a\xfc\xf7\x05\x2d\x3a\x96\x1d\x3b\xed\x76\xf7\x90\x43\x12\x1d\x04\xce\x9b\xc7\x37\x6f\x26\xa3\xf4\xe1\x52\xe9\x95\xe1\x6a\xee\xe0\xe2\xec\xe2\x02\x3e\x2a\x55\x09\x82\xdb\xdb\xcb\xa8\x1f\xf5\xe1\x96\x73\x92\x96\x0a\x68\x64\x41\x06\xdc\x9c\x20\xd6\x98\xcf\x69\x13\x39\x81\x2f\x64\x2c\x2b\x09\x17\x83\x33\xf8\xcd\x03\x7a\x21\xd4\xfb\xfd\x8f\xa8\x0f\x2b\xd5\x40\x8d\x2b\x90\xca\x41\x63\x09\xdc\x9c\x2d\x94\x2c\x08\xe8\x31\x27\xed\x80\x25\xe4\xaa\xd6\x82\x51\xe6\x04\x4b\x76\x73\x70\x5b\xfe\x41\xd4\x87\x6f\x81\x42\xcd\x1c\xb2\x04\x84\x5c\xe9\x15\xa8\xb2\x8b\x03\x74\x6b\xc1\xeb\x9f\xb9\x73\x7a\x34\x1c\x2e\x97\xcb\x01\xae\xd5\x0e\x94\xa9\x86\xa2\x45\xda\xe1\x6d\x72\x79\x3d\x4e\xaf\x4f\x2f\x06\x67\xeb\x9c\x7b\x29\xc8\x5a\x30\xf4\x77\xc3\x86\x0a\x98\xad\x00\xb5\x16\x9c\xe3\x4c\x10\x08\x5c\x82\x32\x80\x95\x21\x2a\xc0\x29\x2f\x78\x69\xd8\xb1\xac\x4e\xc0\xaa\xd2\x2d\xd1\x50\xd4\x87\x82\xad\x33\x3c\x6b\xdc\x8e\x5b\x1b\x79\x6c\x77\x00\x4a\x02\x4a\xe8\xc5\x29\x24\x69\x0f\x3e\xc4\x69\x92\x9e\x44\x7d\xf8\x9a\x4c\x3f\xdd\xdd\x4f\xe1\x6b\x3c\x99\xc4\xe3\x69\x72\x9d\xc2\xdd\x04\x2e\xef\xc6\x57\xc9\x34\xb9\x1b\xa7\x70\x77\x03\xf1\xf8\x1b\xfc\x99\x8c\xaf\x4e\x80\xd8\xcd\xc9\x00\x3d\x6a\xe3\xf5\x2b\x03\xec\x7d\xa4\xc2\x9b\x96\x12\xed\x08\x28\x55\xdb\x3e\xab\x29\xe7\x92\x73\x10\x28\xab\x06\x2b\x82\x4a\x2d\xc8\x48\x96\x15\x68\x32\x35\x5b\xdf\x4d\x0b\x28\x8b\xa8\x0f\x82\x6b\x76\xe8\xd6\x27\x2f\x8a\x1a\x44\x11\x6a\x0e\xfd\x1f\x41\xae\x0c\x0d\x72\x69\xea\x41\x2e\x54\x53\x0c\xaa\xf5\x28\x0d\x72\x55\x0f\x17\xe7\x28\xf4\x1c\xcf\xa3\x07\x96\xc5\x08\x52\x32\x0b\xce\xe9\x33\x6a\xcd\xb2\x8a\x6a\x72\x58\xa0\xc3\x51\x04\x20\xb1\xa6\x91\xb7\x9f\x64\xc5\xf2\x08\x5f\xc0\x59\x8d\x39\x8d\xc0\x43\x4e\xed\xca\x3a\xaa\x23\x5f\xdd\x96\x26\xd6\xfa\x7a\x4d\x13\x01\x2c\x36\x32\x17\xe7\x33\x72\x78\x1e\x01\xd8\x56\xc6\x27\x65\xdd\x78\xef\xda\x56\x3b\x6a\xb6\xe1\x3e\x43\x56\x35\x26\x27\xeb\xd9\x01\x4e\xc3\x0d\x2d\x2e\x43\xad\xb3\x36\x33\x0b\x93\xe3\x2d\xcb\x1a\x23\xb2\x82\xad\x46\x97\xcf\x33\xd3\x08\xb2\xeb\x64\x80\xd6\x86\x67\x79\xf1\x36\xe7\x7e\x72\x7b\x15\x32\x26\x9d\x04\x6c\x9c\xfa\x48\x92\x0c\x3a\x2a\x46\xe0\x4c\x43\x21\xc2\xc5\x94\x6a\x2d\xd0\xd1\x08\x7a\xdf\xbf\x6b\xa3\xfe\xa2\xdc\x3d\x3d\xf5\x5e\xc4\x2f\x51\x7e\xa0\x7b\x4b\xc5\x54\x7d\xf6\x8a\x26\xa1\xa4\xb6\xf6\x12\x85\xdd\x70\x6e\x8a\x8d\x17\xc8\xc2\xff\x11\x24\x32\xb6\x96\x5c\x22\x17\x24\x9d\x32\xab\x5d\xf8\xa6\x7f\xa1\x9f\xa3\x70\xbc\xe9\x42\x90\xb4\xc7\x9d\x5c\x6d\x71\x0e\x4d\x45\xee\x86\x49\x14\xbb\xf0\xe3\x36\x17\xaa\x46\x96\x59\x1d\x46\xe8\xa0\xad\x57\x6b\xcc\x66\xca\xfe\x95\x91\xa8\xb5\x1d\x76\xdc\x1c\x16\x5d\x2e\x1f\x0a\x02\xbc\xbe\xb7\x64\x76\x47\xd6\x1e\xff\x51\xc3\xf7\x53\x8e\x9b\x5e\xb2\xa1\x25\x0a\xb1\x9e\xe5\xc3\x9e\xdf\x04\xc8\x64\x8b\xf8\x55\xcb\x37\xb7\x0d\x59\x56\x7e\xc1\x79\xca\x16\xc1\xca\xb0\x5b\xbd\xad\x19\x6f\x35\xed\x91\xbf\x32\xe4\x1d\xfc\x2b\x86\x0b\x7a\xe4\x59\x38\x0b\x1b\xec\x88\xef\x01\x19\x6b\xfd\x65\x07\xf7\xab\xee\x87\xe5\xe8\x0f\xc3\xeb\xd3\xd3\x30\x48\xf0\x87\xe1\x35\xe3\xe2\x2d\xf5\x61\xab\x6a\x8f\xfe\x68\x27\x8e\x66\x4c\xa8\x24\x43\xf2\x79\xe5\xfb\xe7\x14\x1e\x68\x35\xda\x7c\x38\x26\x54\x3e\x47\x00\x5c\x19\x18\x43\xb4\x13\xaa\x16\x0f\x5b\x8e\x03\xcd\x4b\x5f\x64\x1c\xfc\x5e\x6d\x7f\x2b\xa3\x1a\xfd\x33\x9f\xc9\x83\x25\x77\x96\x83\x7f\x34\x1a\x92\xae\x33\x1a\xc7\xe7\x31\x94\x96\x49\x72\x4b\x65\x1e\x32\x4b\xce\xff\x1f\x64\xa3\xd7\xca\x1a\xb7\xe0\x74\x17\xfb\x7f\xce\xe5\xdb\x99\xbf\x20\x69\x8f\xfb\xe8\xf0\x75\xe1\x3f\x76\xdd\x6a\xc1\x2e\x73\x06\xcb\x92\xf3\x57\x2d\x4f\x3d\x72\xba\x03\x7c\xf7\xfb\xe7\xfd\x76\x28\x0b\x34\xc5\x8f\xb7\x6e\x1a\x90\xef\x5b\xf7\x7d\xeb\xfe\x97\xad\xfb\xcf\x00\x02\x05\x1a\x1f\x87\x0f\x00\x00"),
 		},
 		"/artifactregistry.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "artifactregistry.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1905,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4f\x6f\xe3\xb8\x0f\xbd\xfb\x53\x10\xc9\xe5\xf7\x03\x5a\xa7\xed\xd1\x7b\xca\xa4\x9d\x19\x63\xdb\x74\x91\xa4\x3b\x98\x53\xc0\xc8\xb4\xcd\xad\x2c\x69\x25\x3a\x99\xa0\x9b\xef\xbe\xf0\xbf\x34\x4d\x3b\xc0\xac\x2e\x31\xc4\xc7\xc7\xa7\x47\x4a\x19\xc3\xcc\xba\xbd\xe7\xa2\x14\xb8\xb9\xba\xb9\x81\x2f\xd6\x16\x9a\xe0\xfe\x7e\x16\x8d\xa3\x31\xdc\xb3\x22\x13\x28\x83\xda\x64\xe4\x41\x4a\x82\xa9\x43\x55\xd2\x10\xb9\x80\x3f\xc9\x07\xb6\x06\x6e\xe2\x2b\xf8\x5f\x03\x18\xf5\xa1\xd1\xff\x7f\x8b\xc6\xb0\xb7\x35\x54\xb8\x07\x63\x05\xea\x40\x20\x25\x07\xc8\x59\x13\xd0\x0f\x45\x4e\x80\x0d\x28\x5b\x39\xcd\x68\x14\xc1\x8e\xa5\x6c\xcb\xf4\x24\x71\x34\x86\xef\x3d\x85\xdd\x08\xb2\x01\x04\x65\xdd\x1e\x6c\x7e\x8a\x03\x94\x56\x70\xbb\x4a\x11\x97\x4c\x26\xbb\xdd\x2e\xc6\x56\x6d\x6c\x7d\x31\xd1\x1d\x32\x4c\xee\xd3\xd9\xdd\x7c\x79\x77\x79\x13\x5f\xb5\x39\x4f\x46\x53\x08\xe0\xe9\xef\x9a\x3d\x65\xb0\xd9\x03\x3a\xa7\x59\xe1\x46\x13\x68\xdc\x81\xf5\x80\x85\x27\xca\x40\x6c\x23\x78\xe7\x59\xd8\x14\x17\x10\x6c\x2e\x3b\xf4\x14\x8d\x21\xe3\x20\x9e\x37\xb5\xbc\x71\x6b\x90\xc7\xe1\x0d\xc0\x1a\x40\x03\xa3\xe9\x12\xd2\xe5\x08\x3e\x4d\x97\xe9\xf2\x22\x1a\xc3\xb7\x74\xf5\xf5\xf1\x69\x05\xdf\xa6\x8b\xc5\x74\xbe\x4a\xef\x96\xf0\xb8\x80\xd9\xe3\xfc\x36\x5d\xa5\x8f\xf3\x25\x3c\x7e\x86\xe9\xfc\x3b\xfc\x9e\xce\x6f\x2f\x80\x58\x4a\xf2\x40\x3f\x9c\x6f\xf4\x5b\x0f\xdc\xf8\x48\x59\x63\xda\x92\xe8\x8d\x80\xdc\x76\x82\x82\x23\xc5\x39\x2b\xd0\x68\x8a\x1a\x0b\x82\xc2\x6e\xc9\x1b\x36\x05\x38\xf2\x15\x87\xa6\x9b\x01\xd0\x64\xd1\x18\x34\x57\x2c\x28\xed\xce\xbb\x43\xc5\x51\x84\x8e\xfb\xfe\x27\xa0\xac\xa7\x58\x19\x5f\xc5\x4a\xdb\x3a\x8b\x8b\x76\x94\x62\x65\xab\xc9\xf6\x1a\xb5\x2b\xf1\x3a\x7a\x66\x93\x25\xb0\x24\xbf\x65\x45\x0f\xe8\x1c\x9b\x22\xaa\x48\x30\x43\xc1\x24\x02\x30\x58\x51\x02\xe8\x85\x73\x54\xe2\xa9\x68\x4c\xdb\x7f\x4c\xdb\xc3\x83\x43\x45\x09\x34\x90\xcb\xb0\x0f\x42\x55\xd4\x1c\xf2\x95\x6d\xda\xb3\x2d\x7a\xb6\x08\x60\x3b\x88\xde\x5e\x6f\x48\xf0\x3a\x02\x08\x9d\xa8\xaf\x36\xc8\xfc\x63\x11\x5d\x65\x74\x1c\xfa\xea\x9e\x82\xad\xbd\xa2\xd0\xd4\x02\xb8\xec\xeb\x75\xb8\xf5\x90\xbf\x1e\x08\xd6\x9e\x9c\x0d\x2c\xb6\x95\xd0\xac\xce\x8e\x73\x7d\x8b\x73\x18\xd6\x62\xbf\x90\x21\x8f\x42\x59\x02\xe2\x6b\xea\x23\x8c\xd5\xcc\x9a\x9c\x8b\xa4\xdf\x00\x70\x56\xb3\xda\xcf\x7f\x55\xc9\x9a\xb1\x5a\x77\x39\x67\x14\x0f\x54\x6d\xc8\xff\x37\xa2\xaa\xcd\x39\x12\x79\xca\xc9\x93\x51\xf4\x99\x49\x67\xaf\x1a\x87\xce\xbc\x33\xa4\x59\xb2\x77\x94\xb4\x80\xe3\x66\xa8\x9d\xb3\x5e\xc2\xcc\x9a\x8c\xdb\x69\x4c\x20\x47\x1d\x8e\x36\x64\x2b\xaa\x9c\x46\xa1\x04\x46\xce\xdb\xbf\x48\x49\x98\xbc\xbc\xf4\x9f\x87\xc3\x44\x5b\xd5\x8d\xf1\xe4\xe5\x65\xf8\x3e\x1c\x26\x47\x01\x4c\x4d\xe4\xf4\x34\xd9\xe1\x30\x7a\x47\x3f\x43\xf3\x89\x9e\x02\x65\x2b\xfb\x80\xa2\xca\x45\x3f\x01\x9d\x4b\xa7\x92\x86\xd9\x98\x6e\x91\x75\xf3\x90\xa4\x66\x1a\x02\x49\x6a\xb6\x64\x9a\x0a\x6f\xe1\xc3\x1d\xe8\xef\xc4\xab\x55\xe7\x46\xad\x39\x3b\xc6\x34\x6e\x48\x87\xa4\xff\x3d\x2b\x9c\xde\xbe\x92\x08\xfa\x82\xa4\x6b\xc2\x87\x5c\x25\x93\x47\xaf\x4a\x56\xa8\x17\x43\xd3\xc2\x2b\xc1\x65\xdf\x95\xde\xd0\x93\x6e\x3d\xd3\xfe\xb8\xbd\xa0\xfc\x4c\xc4\x4f\xb8\xf2\x5e\xca\xaf\xd2\x35\x2b\xa3\xa0\x3c\x3b\x69\xaf\xed\x3f\x97\x27\x11\x80\x55\x49\x43\x12\x48\x89\xd2\xfd\xcb\x0c\x22\x60\x43\xda\x9a\x22\x80\xd8\xf8\x24\xad\xd8\x3e\x27\x6f\x58\xba\xdb\xf8\xc7\x3b\x4d\x1f\x3e\x17\x27\x3c\xde\xd6\x2e\x39\x56\xab\xd0\x60\x41\xfe\x27\x4f\xd6\xbf\x01\x00\x00\xff\xff\xda\xb4\xa9\x39\x71\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x95\xcf\x6e\xe2\x48\x10\xc6\xef\x7e\x8a\x12\x5c\x76\xa5\xc4\x24\x39\x7a\x4f\x0c\xc9\xcc\x58\x9b\x90\x15\x90\x1d\xcd\x09\x15\xed\xb2\x5d\x9b\x76\x77\x6f\x77\x19\xc6\xca\xf2\xee\x2b\x63\x1b\xf2\x57\x9a\xc1\x48\x58\xdd\x5f\x7d\xf5\x73\x55\xb9\x19\xc3\xcc\xba\xc6\x73\x51\x0a\x5c\x5d\x5c\x5d\xc1\x17\x6b\x0b\x4d\x70\x7b\x3b\x8b\xc6\xd1\x18\x6e\x59\x91\x09\x94\x41\x6d\x32\xf2\x20\x25\xc1\xd4\xa1\x2a\x69\xd8\x39\x83\xbf\xc9\x07\xb6\x06\xae\xe2\x0b\xf8\xad\x15\x8c\xfa\xad\xd1\xef\x7f\x44\x63\x68\x6c\x0d\x15\x36\x60\xac\x40\x1d\x08\xa4\xe4\x00\x39\x6b\x02\xfa\xa1\xc8\x09\xb0\x01\x65\x2b\xa7\x19\x8d\x22\xd8\xb1\x94\x20\x27\xff\x38\x1a\xc3\xf7\xde\xc2\x6e\x04\xd9\x00\x82\xb2\xae\x01\x9b\x3f\xd7\x01\xca\x01\xf8\xf0\x29\x45\x5c\x32\x99\xec\x76\xbb\x18\x0f\xb4\xb1\xf5\xc5\x44\x77\xca\x30\xb9\x4d\x67\x37\xf3\xe5\xcd\xf9\x55\x7c\x71\x88\x79\x30\x9a\x42\x00\x4f\xff\xd6\xec\x29\x83\x4d\x03\xe8\x9c\x66\x85\x1b\x4d\xa0\x71\x07\xd6\x03\x16\x9e\x28\x03\xb1\x2d\xf0\xce\xb3\xb0\x29\xce\x20\xd8\x5c\x76\xe8\x29\x1a\x43\xc6\x41\x3c\x6f\x6a\x79\x51\xad\x01\x8f\xc3\x0b\x81\x35\x80\x06\x46\xd3\x25\xa4\xcb\x11\x7c\x9a\x2e\xd3\xe5\x59\x34\x86\x6f\xe9\xea\xeb\xfd\xc3\x0a\xbe\x4d\x17\x8b\xe9\x7c\x95\xde\x2c\xe1\x7e\x01\xb3\xfb\xf9\x75\xba\x4a\xef\xe7\x4b\xb8\xff\x0c\xd3\xf9\x77\xf8\x33\x9d\x5f\x9f\x01\xb1\x94\xe4\x81\x7e\x38\xdf\xf2\x5b\x0f\xdc\xd6\x91\xb2\xb6\x68\x4b\xa2\x17\x00\xb9\xed\xda\x17\x1c\x29\xce\x59\x81\x46\x53\xd4\x58\x10\x14\x76\x4b\xde\xb0\x29\xc0\x91\xaf\x38\xb4\xdd\x0c\x80\x26\x8b\xc6\xa0\xb9\x62\x41\x39\xac\xbc\x79\xa8\x38\x8a\xd0\x71\xdf\xff\x04\x94\xf5\x14\x2b\xe3\xab\x58\x69\x5b\x67\x71\x71\x18\xa5\x58\xd9\x6a\xb2\xbd\x44\xed\x4a\xbc\x8c\x1e\xd9\x64\x09\x2c\xc9\x6f\x59\xd1\x1d\x3a\xc7\xa6\x88\x2a\x12\xcc\x50\x30\x89\x00\x0c\x56\x94\x00\x7a\xe1\x1c\x95\x78\x2a\xda\xa2\x35\xef\xdb\xf6\xf2\xe0\x50\x51\x02\xad\xe4\x3c\x34\x41\xa8\x8a\xda\x87\x3c\xb9\x4d\x7b\xb7\x45\xef\x16\x01\x6c\x07\xe8\xed\xe5\x86\x04\x2f\x23\x80\xd0\x41\x7d\xb5\x41\xe6\xef\x43\x74\x99\xd1\x71\xe8\xb3\x7b\x0a\xb6\xf6\x8a\x42\x9b\x0b\xe0\xbc\xcf\xd7\xe9\xd6\x43\xfc\x7a\x30\x58\x7b\x72\x36\xb0\x58\xdf\x1c\xf4\x00\x5d\x39\x5e\xf3\x2d\x5e\xcb\xb0\x16\xfb\x85\x0c\x79\x14\xca\x12\x10\x5f\x53\xbf\xc3\x58\xcd\xac\xc9\xb9\x48\xfa\x05\x00\x67\x35\xab\x66\xfe\xb3\x24\x6b\xc6\x6a\xdd\xc5\xbc\xb2\xb8\xa3\x6a\x43\xfe\xd7\x8c\xaa\x43\xcc\xd1\xc8\x53\x4e\x9e\x8c\xa2\xcf\x4c\x3a\x3b\x31\x0e\x9d\x39\x45\x3f\xdb\x92\xc6\x51\x72\x10\x1c\x17\x43\xed\x9c\xf5\x12\x66\xd6\x64\x7c\x98\xc6\x04\x72\xd4\xe1\x58\x86\x6c\x45\x95\xd3\x28\x94\xc0\xc8\x79\xfb\x0f\x29\x09\x93\xa7\xa7\xfe\x76\xbf\x9f\x68\xab\xba\x31\x9e\x3c\x3d\x0d\xf7\xfb\xfd\xe4\x08\xc0\xd4\xea\x4f\x3c\x6b\xce\xf6\xfb\xd1\x1b\xfb\x19\x9a\x4f\xf4\x10\x28\x5b\xd9\x3b\x14\x55\x2e\xfa\x09\xe8\xaa\xf4\x1c\x69\x98\x8d\xe9\x16\x59\xb7\x07\x49\x6a\xa6\x21\x90\xa4\x66\x4b\xa6\xcd\xf0\x52\x3e\xbc\x03\xfd\x3b\x91\x44\x1f\x15\x6a\xcd\xd9\x71\x4f\xe3\x86\x74\x48\xfa\xdf\x57\x89\xd3\xeb\x93\x89\xa0\x2f\x48\xba\x26\xbc\xeb\x55\x32\x79\xf4\xaa\x64\x85\x7a\x31\x34\xad\x1f\xeb\xf6\x7b\xde\x77\xa5\x2f\xe8\x71\x1d\xe0\x91\x9a\xe3\xf2\x82\xf2\x57\x10\x1f\x78\xe5\x3d\xca\xcf\xda\xb5\x57\x46\x41\x79\x76\x6d\xe3\x12\xf8\xef\xfc\xd9\x0e\xc0\xaa\xa4\x21\x08\xa4\x44\xe9\xfe\x65\x06\x08\xd8\x90\xb6\xa6\x08\x20\x36\x7e\x16\x56\x6c\x1f\x4f\x50\xa7\xb7\xf1\xaf\x37\x4c\xef\x1e\x17\xa7\xab\xf0\xb6\x76\xc9\x31\x5b\x85\x06\x0b\xf2\x1f\x1c\x59\xff\x0f\x00\xda\xb4\xa9\x39\x71\x07\x00\x00"),
 		},
 		"/beyondcorp.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "beyondcorp.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3115,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xc1\x72\xe2\x46\x10\xbd\xeb\x2b\xba\xe0\x92\x54\xd9\xc2\xe6\xa8\x9c\x30\xf6\x7a\x55\xf1\x42\x0a\x70\xb6\xf6\xe4\x6a\x46\x8d\x34\xf1\x68\x7a\x32\xd3\x82\x55\x39\xfc\x7b\x4a\x48\x60\xb0\xd7\x95\x3d\x64\xf7\x64\x1d\x80\x9a\x7e\xfd\xde\x9b\xee\x27\xfa\x30\x66\x57\x7b\x9d\x17\x02\xc3\x8b\xe1\x10\x6e\x99\x73\x43\x70\x77\x37\x8e\xfa\x51\x1f\xee\xb4\x22\x1b\x28\x83\xca\x66\xe4\x41\x0a\x82\x91\x43\x55\xd0\xbe\x72\x06\x7f\x92\x0f\x9a\x2d\x0c\xe3\x0b\xf8\xa5\x01\xf4\xba\x52\xef\xd7\xdf\xa2\x3e\xd4\x5c\x41\x89\x35\x58\x16\xa8\x02\x81\x14\x3a\xc0\x4a\x1b\x02\xfa\xaa\xc8\x09\x68\x0b\x8a\x4b\x67\x34\x5a\x45\xb0\xd1\x52\xec\x64\x3a\x92\x38\xea\xc3\x97\x8e\x82\x97\x82\xda\x02\x82\x62\x57\x03\xaf\x8e\x71\x80\xb2\x33\xbc\x7b\x0a\x11\x97\x0c\x06\x9b\xcd\x26\xc6\x9d\xdb\x98\x7d\x3e\x30\x2d\x32\x0c\xee\xd2\xf1\xcd\x64\x7e\x73\x3e\x8c\x2f\x76\x3d\xf7\xd6\x50\x08\xe0\xe9\xef\x4a\x7b\xca\x60\x59\x03\x3a\x67\xb4\xc2\xa5\x21\x30\xb8\x01\xf6\x80\xb9\x27\xca\x40\xb8\x31\xbc\xf1\x5a\xb4\xcd\xcf\x20\xf0\x4a\x36\xe8\x29\xea\x43\xa6\x83\x78\xbd\xac\xe4\x64\x5a\x7b\x7b\x3a\x9c\x00\xd8\x02\x5a\xe8\x8d\xe6\x90\xce\x7b\x70\x35\x9a\xa7\xf3\xb3\xa8\x0f\x9f\xd3\xc5\xc7\xe9\xfd\x02\x3e\x8f\x66\xb3\xd1\x64\x91\xde\xcc\x61\x3a\x83\xf1\x74\x72\x9d\x2e\xd2\xe9\x64\x0e\xd3\x0f\x30\x9a\x7c\x81\xdf\xd3\xc9\xf5\x19\x90\x96\x82\x3c\xd0\x57\xe7\x1b\xff\xec\x41\x37\x73\xa4\xac\x19\xda\x9c\xe8\xc4\xc0\x8a\x5b\x43\xc1\x91\xd2\x2b\xad\xc0\xa0\xcd\x2b\xcc\x09\x72\x5e\x93\xb7\xda\xe6\xe0\xc8\x97\x3a\x34\xdb\x0c\x80\x36\x8b\xfa\x60\x74\xa9\x05\x65\x77\xf2\xea\x52\x71\x14\xa1\xd3\xdd\xfe\x13\x50\xec\x29\x56\xd6\x97\xb1\x32\x5c\x65\x71\xbe\x8b\x52\xac\xb8\x1c\xac\x2f\xd1\xb8\x02\x2f\xa3\x47\x6d\xb3\x04\xe6\xe4\xd7\x5a\xd1\x27\x74\x4e\xdb\x3c\x2a\x49\x30\x43\xc1\x24\x02\xb0\x58\x52\x02\x4b\xaa\xd9\x66\x8a\xbd\xfb\x36\x61\x07\x0c\x0e\x15\x25\xd0\x40\xce\x43\x1d\x84\xca\xa8\xb9\xde\x33\xcf\xd5\x8e\x67\xcc\xde\x45\x00\xeb\xbd\xd1\xf5\xe5\x92\x04\x2f\x23\x80\xd0\x1a\xf9\xc8\x41\x26\x2f\x85\x5b\x35\x74\x3a\x74\x8a\x9e\x02\x57\x5e\x51\x68\xf8\x01\xce\x3b\x8d\x16\xf7\xf0\xdc\xf9\x80\xce\x3d\x28\xb6\x96\x54\x33\xb8\xa8\xcd\x64\x7b\xf3\x67\x43\x23\xe7\xc6\x2f\x31\x58\x09\xdf\x92\x25\x8f\x42\x59\x02\xe2\x2b\xea\x2a\x3a\x5b\x50\xe9\x0c\x0a\x25\xd0\x73\x9e\xff\x22\x25\x61\xf0\xf4\xd4\xfd\xdc\x6e\x07\x86\x55\xbb\xa7\xc1\xd3\x93\xa7\x5c\xb3\xdd\x6e\x07\x78\x2c\xd2\x54\x1a\xcb\xdb\x6d\xef\x15\xeb\x18\xed\x15\xdd\x07\xca\x16\xfc\x09\x45\x15\xb3\xee\xae\xed\x54\x56\x68\xc2\xde\xc9\x7e\x0a\xa3\x35\x6a\xd3\xbc\x20\xa9\x1d\x85\x40\x92\xda\x35\x59\x61\x5f\x9f\xc2\xf7\xbb\xed\x76\x9d\x74\xc7\xfb\x05\x35\x9f\x87\x23\x83\x4b\x32\x21\xe9\xbe\x5f\xe8\xa5\xd7\xcf\xbd\x82\x3e\x27\xf9\xa0\xc9\x64\x27\x14\x85\x26\x8f\x5e\x15\x5a\xa1\x99\xd1\x8a\x3c\xd9\xc3\xba\xda\x95\x49\xed\x28\x81\x6e\x6a\x87\x73\x80\x47\xaa\x0f\xc7\x33\x5a\xbd\xd0\x7e\x83\x6b\xd5\x39\xf8\x5e\xba\xe6\xc9\x28\x28\xaf\x9d\xec\x82\xf8\xcf\xf9\x51\x05\x60\x51\xd0\xbe\x09\xa4\x40\x69\xff\x2b\xf7\x26\x60\x49\x86\x6d\x1e\x40\x38\x3e\x6a\xcb\xd7\x8f\xc9\x09\x4b\x1b\xb4\x3f\x5e\x79\xfa\xe6\x0b\x70\xc4\xe3\xb9\x72\xc9\x41\xad\x44\x8b\x39\xf9\x37\x5f\xbf\xef\xcc\x3f\xfb\xff\x8c\xff\x01\xf2\x03\xd3\xcf\xfe\x3d\xfc\xef\xe1\xff\x69\xe1\xcf\x51\x68\x83\xf5\xdb\xd1\xbf\x3d\x01\xfc\x88\xe0\x77\x0a\xef\xb1\x7f\x8f\xfd\xff\x14\xfb\x7f\x03\x00\x00\xff\xff\xf0\xa9\x20\x51\x2b\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xc1\x72\xe2\x38\x14\xbc\xfb\x2b\xba\xe0\xb2\x5b\x95\x98\x84\xa3\xf7\x44\x48\x26\xe3\xda\x0c\x6c\x01\xd9\xa9\x39\xa5\x1e\xf2\xc3\xd6\x46\x96\xb4\x92\x0c\xe3\xca\xf2\xef\x5b\x06\x1b\x42\x32\xa9\x9d\xc3\xce\x9c\x82\x29\xa0\xa4\x7e\xfd\x5a\xaf\x5b\xf4\x31\x36\xb6\x76\x32\x2f\x02\x86\x17\xc3\x21\x6e\x8d\xc9\x15\xe3\xee\x6e\x1c\xf5\xa3\x3e\xee\xa4\x60\xed\x39\x43\xa5\x33\x76\x08\x05\x63\x64\x49\x14\xdc\xed\x9c\xe1\x4f\x76\x5e\x1a\x8d\x61\x7c\x81\x5f\x1a\x40\xaf\xdd\xea\xfd\xfa\x5b\xd4\x47\x6d\x2a\x94\x54\x43\x9b\x80\xca\x33\x42\x21\x3d\x56\x52\x31\xf8\xab\x60\x1b\x20\x35\x84\x29\xad\x92\xa4\x05\x63\x23\x43\x81\x70\xe4\x8f\xa3\x3e\xbe\xb4\x14\x66\x19\x48\x6a\x10\x84\xb1\x35\xcc\xea\x39\x0e\x14\x76\x82\x77\xaf\x22\x04\x9b\x0c\x06\x9b\xcd\x26\xa6\x9d\xda\xd8\xb8\x7c\xa0\xf6\x48\x3f\xb8\x4b\xc7\x37\x93\xf9\xcd\xf9\x30\xbe\xd8\xd5\xdc\x6b\xc5\xde\xc3\xf1\xdf\x95\x74\x9c\x61\x59\x83\xac\x55\x52\xd0\x52\x31\x14\x6d\x60\x1c\x28\x77\xcc\x19\x82\x69\x04\x6f\x9c\x0c\x52\xe7\x67\xf0\x66\x15\x36\xe4\x38\xea\x23\x93\x3e\x38\xb9\xac\xc2\xc9\xb4\x3a\x79\xd2\x9f\x00\x8c\x06\x69\xf4\x46\x73\xa4\xf3\x1e\xae\x46\xf3\x74\x7e\x16\xf5\xf1\x39\x5d\x7c\x9c\xde\x2f\xf0\x79\x34\x9b\x8d\x26\x8b\xf4\x66\x8e\xe9\x0c\xe3\xe9\xe4\x3a\x5d\xa4\xd3\xc9\x1c\xd3\x0f\x18\x4d\xbe\xe0\xf7\x74\x72\x7d\x06\x96\xa1\x60\x07\xfe\x6a\x5d\xa3\xdf\x38\xc8\x66\x8e\x9c\x35\x43\x9b\x33\x9f\x08\x58\x99\xbd\x7d\xde\xb2\x90\x2b\x29\xa0\x48\xe7\x15\xe5\x8c\xdc\xac\xd9\x69\xa9\x73\x58\x76\xa5\xf4\x8d\x9b\x1e\xa4\xb3\xa8\x0f\x25\x4b\x19\x28\xec\x56\x5e\x1d\x2a\x8e\x22\xb2\xb2\xf5\x3f\x81\x30\x8e\x63\xa1\x5d\x19\x0b\x65\xaa\x2c\xce\x77\x51\x8a\x85\x29\x07\xeb\x4b\x52\xb6\xa0\xcb\xe8\x51\xea\x2c\xc1\x9c\xdd\x5a\x0a\xfe\x44\xd6\x4a\x9d\x47\x25\x07\xca\x28\x50\x12\x01\x9a\x4a\x4e\xb0\xe4\xda\xe8\x4c\x18\x67\xbf\x4d\xd8\x02\xbd\x25\xc1\x09\x1a\xc8\xb9\xaf\x7d\xe0\x32\x6a\x8e\x77\xe4\xb9\xda\xf1\x8c\x8d\xb3\x11\xb0\xee\x84\xae\x2f\x97\x1c\xe8\x32\x02\xfc\x5e\xc8\x47\xe3\xc3\xe4\x65\xe3\x7d\x37\xb2\xd2\xb7\x1d\x1d\x7b\x53\x39\xc1\xbe\xe1\x07\xce\xdb\x1e\x7b\xdc\xc3\xb1\xf2\x81\xac\x7d\x10\x46\x6b\x16\xcd\xe0\x76\x60\x60\x7f\xf2\xa3\xa0\x91\xb5\xe3\x97\x18\xaa\x82\xb9\x65\xcd\x8e\x02\x67\x09\x82\xab\xb8\xdd\x91\xd9\x82\x4b\xab\x28\x70\x82\x9e\x75\xe6\x2f\x16\xc1\x0f\x9e\x9e\xda\x9f\xdb\xed\x40\x19\xb1\xf7\x69\xf0\xf4\xe4\x38\x97\x46\x6f\xb7\x03\x7a\xde\xa4\xc1\x37\x92\xb7\xdb\xde\x2b\xd6\x31\xe9\x2b\xbe\xf7\x9c\x2d\xcc\x27\x0a\xa2\x98\xb5\x67\xdd\x4f\x65\x45\xca\x77\x4a\xba\x29\x8c\xd6\x24\x55\x73\x41\x52\x3d\xf2\x9e\x43\xaa\xd7\xac\x83\x71\xf5\x29\xbc\xf3\xb6\xf5\x3a\x69\x97\x3b\x83\x9a\xcf\xc3\x92\xa2\x25\x2b\x9f\xb4\xdf\x2f\xfa\xa5\xd7\xc7\xda\x40\x2e\xe7\xf0\x41\xb2\xca\x4e\x28\x0a\xc9\x8e\x9c\x28\xa4\x20\x35\xe3\x15\x3b\xd6\x07\xbb\x9a\xf7\x39\x42\x6d\x39\x41\x3b\xb5\xc3\x3a\xf0\xc8\xf5\x61\x79\xc6\xab\x17\xbd\xdf\xe0\x5a\xb5\x0a\xbe\x97\xae\x79\x32\xf6\xc2\x49\xdb\xf8\x91\xe0\x9f\xf3\x67\x3b\xc0\xa2\xe0\xae\x08\xa1\xa0\xb0\xff\xaf\xec\x44\x60\xc9\xca\xe8\xdc\x23\x98\xf8\x59\x59\xbe\x7e\x3c\x8a\x3a\x06\xed\x8f\x57\x9a\xbe\x79\x01\x8e\x4f\xee\x4c\x65\x93\x43\xb7\x92\x34\xe5\xec\xde\xbc\x7e\xdf\x99\x7f\xe3\xfe\x33\xfe\xc6\xfd\xf8\xf4\x1b\xf7\x1e\xfe\xf7\xf0\xff\xb4\xf0\xe7\x14\x78\x43\xf5\xdb\xd1\xbf\x3d\x01\xfc\x88\xe0\xb7\x1d\xde\x63\xff\x1e\xfb\xff\x29\xf6\xff\x0e\x00\xf0\xa9\x20\x51\x2b\x0c\x00\x00"),
 		},
 		"/bigquery.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigquery.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 4137,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xc1\x72\xdb\x36\x10\xbd\xf3\x2b\x76\xa4\x4b\x3b\x63\x4b\xb1\x8f\xec\x49\xb1\x9d\x84\xad\x23\xb7\x92\xdc\x4c\x4e\x9a\x25\xb8\x22\xd7\x06\x01\x04\x00\xa5\x68\x54\xff\x7b\x87\x24\x28\x9b\xb1\x52\xbb\xd3\xc9\xd4\x07\xf3\x22\x68\xb1\x78\xfb\x76\xf1\x16\x24\x86\x70\xa6\xcd\xd6\x72\x5e\x78\x38\x7d\x73\x7a\x0a\xef\xb5\xce\x25\xc1\xe5\xe5\x59\x34\x8c\x86\x70\xc9\x82\x94\xa3\x0c\x2a\x95\x91\x05\x5f\x10\x4c\x0c\x8a\x82\xba\x99\x23\xf8\x93\xac\x63\xad\xe0\x74\xf4\x06\x7e\xaa\x1d\x06\x61\x6a\xf0\xf3\x2f\xd1\x10\xb6\xba\x82\x12\xb7\xa0\xb4\x87\xca\x11\xf8\x82\x1d\xac\x58\x12\xd0\x57\x41\xc6\x03\x2b\x10\xba\x34\x92\x51\x09\x82\x0d\xfb\xa2\x09\x13\x40\x46\xd1\x10\x3e\x07\x08\x9d\x7a\x64\x05\x08\x42\x9b\x2d\xe8\xd5\x43\x3f\x40\xdf\x10\x6e\x9e\xc2\x7b\x13\x8f\xc7\x9b\xcd\x66\x84\x0d\xdb\x91\xb6\xf9\x58\xb6\x9e\x6e\x7c\x99\x9c\x5d\x4c\xe7\x17\xc7\xa7\xa3\x37\xcd\x9a\x6b\x25\xc9\x39\xb0\xf4\xa5\x62\x4b\x19\xa4\x5b\x40\x63\x24\x0b\x4c\x25\x81\xc4\x0d\x68\x0b\x98\x5b\xa2\x0c\xbc\xae\x09\x6f\x2c\x7b\x56\xf9\x11\x38\xbd\xf2\x1b\xb4\x14\x0d\x21\x63\xe7\x2d\xa7\x95\xef\x55\xab\xa3\xc7\xae\xe7\xa0\x15\xa0\x82\xc1\x64\x0e\xc9\x7c\x00\x6f\x27\xf3\x64\x7e\x14\x0d\xe1\x53\xb2\xf8\x70\x75\xbd\x80\x4f\x93\xd9\x6c\x32\x5d\x24\x17\x73\xb8\x9a\xc1\xd9\xd5\xf4\x3c\x59\x24\x57\xd3\x39\x5c\xbd\x83\xc9\xf4\x33\xfc\x96\x4c\xcf\x8f\x80\xd8\x17\x64\x81\xbe\x1a\x5b\xf3\xd7\x16\xb8\xae\x23\x65\x75\xd1\xe6\x44\x3d\x02\x2b\xdd\x12\x72\x86\x04\xaf\x58\x80\x44\x95\x57\x98\x13\xe4\x7a\x4d\x56\xb1\xca\xc1\x90\x2d\xd9\xd5\xbb\xe9\x00\x55\x16\x0d\x41\x72\xc9\x1e\x7d\x63\x79\x94\xd4\x28\x8a\xd0\x70\xd8\xff\x18\x84\xb6\x34\x12\xca\x96\x23\x21\x75\x95\x8d\xf2\x46\x4a\x23\xa1\xcb\xf1\xfa\x04\xa5\x29\xf0\x24\xba\x65\x95\xc5\x30\x27\xbb\x66\x41\x1f\xd1\x18\x56\x79\x54\x92\xc7\x0c\x3d\xc6\x11\x80\xc2\x92\x62\x48\x39\xff\x52\x91\xdd\x1e\x86\x0b\x6e\xce\xa0\xa0\x18\x6a\x97\x63\xb7\x75\x9e\xca\xa8\x4e\xee\x1e\xe5\x2d\xe7\x7f\xd4\x28\x11\xc0\xba\x23\xb9\x3e\x49\xc9\xe3\x49\x04\xe0\x5a\x12\x1f\xb4\xf3\xd3\x7e\xd0\x36\x12\x1a\x76\x21\x9a\x25\xa7\x2b\x2b\xc8\xd5\xd8\x00\xc7\x01\xbf\xf5\x5b\x76\xeb\x96\x75\x0e\x8e\x7c\xd4\x8a\xb0\x4d\xb5\xe3\x70\xde\x9b\xc3\xca\xeb\xf7\xa4\xc8\xa2\xa7\x2c\x06\x6f\x2b\x0a\x33\x9c\x2d\xa8\x34\x12\x3d\xc5\x30\x30\x56\xdf\x90\xf0\x6e\xbc\xdb\x85\xe1\xdd\xdd\x38\x44\xa9\x8d\x61\xb8\xe4\xec\xee\x6e\xf0\x08\xe0\x0c\xd5\x5b\xba\x76\x94\x2d\xf4\x47\xf4\xa2\x98\x85\x2c\xda\x6c\x57\x28\x5d\x17\xb4\xcb\x6f\xb2\x46\x96\xb5\xe8\x13\x35\x71\x8e\x7c\xa2\xd6\xa4\xbc\xb6\xdb\xbe\x7b\xb7\x5f\x61\xff\xe2\x60\xee\xca\x7e\xcf\x6a\x3f\x21\x31\x25\xe9\xe2\xf0\xfb\x4d\xd4\xe4\xfc\x1e\xc1\xa3\xcd\xc9\xbf\x63\x92\xd9\x01\xa0\x82\xc9\xa2\x15\x05\x0b\x94\x33\x5a\x91\x25\xb5\xdf\x94\x76\x63\xfc\xd6\x50\x0c\xa1\x58\x7b\x3b\xc0\x2d\x6d\xf7\xe6\x19\xad\xbe\x61\xf0\x1d\xac\x55\xe0\xf1\x5c\xb8\xfa\xc9\xc8\x09\xcb\xc6\x37\x62\xfb\xeb\xf8\xc1\x0c\xc0\xa2\xa0\x6e\x11\xf8\x02\x7d\x7b\x16\x76\x24\x20\x25\xa9\x55\xee\xc0\xeb\xd1\x83\x65\xf9\xfa\x36\xee\xa1\xb4\xba\xfa\xfd\x11\xa7\x83\x22\x7f\x80\x63\x75\x65\xe2\x7d\xb4\x12\x15\xe6\x64\xbf\xdb\x60\x4f\xaa\x7c\x89\x42\x90\x73\xff\x24\xf6\xc9\x43\x8f\x1f\x20\xf9\xf1\x6e\x67\xb5\xa4\x66\x50\x39\xb2\xcb\x74\xbb\xa4\x12\x59\x36\x96\x26\xe1\xbe\x29\xd3\x25\xb2\x6a\x86\xcd\x31\x88\x72\xd9\x78\x35\x16\xc6\x72\x59\x52\x99\x92\x6d\xfe\xae\x99\x36\xed\xa2\x36\x62\x08\x57\x79\x56\xf4\x92\xba\x2d\x50\x7a\x6e\x4f\xf5\xdd\x5f\x1b\xea\x05\x34\xd4\x8d\x4e\x0f\x76\xd1\xaf\x7b\xfb\x7f\xe9\x9d\x1b\x9d\xd6\x86\x1b\x9d\xb6\x3d\x23\xb5\x68\xde\xe7\xe3\xdd\xae\x1b\xbe\x24\x39\xb7\x3c\x9f\xab\xe6\x9e\xf7\xab\x98\x5f\x80\x98\xfb\xe7\x4b\x5f\xd0\xb3\xde\xdc\x8f\x78\x21\x84\xe8\xee\xfe\xa8\x7e\x61\xdf\x46\xf7\xac\xfe\xe5\x81\xfd\x2a\xf3\xff\x45\xe6\x6d\x11\x9a\x4c\x83\xd0\xfa\x99\xee\xcb\x73\xe0\xa3\xf7\xe9\x3a\x24\xe7\xdd\x2d\x36\x2c\x07\xa1\x55\x7d\xc7\xad\x2f\x63\x6d\x5d\xda\xdd\x7f\x46\x2d\x0e\x5f\x34\x9e\x5f\x93\x27\xae\x5c\x7f\x07\x00\x00\xff\xff\x16\xa8\x9e\xd5\x29\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x73\xda\x48\x13\xbe\xeb\x57\x74\xc1\xe5\x7d\xab\x0c\xd8\x3e\x6a\x4f\xf8\x23\x89\x76\x1d\xbc\x0b\x78\x53\x39\x51\xad\x51\x23\xb5\x3d\x9a\x99\xcc\x8c\x20\x14\xcb\x7f\xdf\xd2\x17\x58\xd8\xde\x24\x55\x9b\x5a\x1f\x6c\xa8\xb2\xe8\xee\x79\xfa\xe9\x9e\xa7\x47\x52\x1f\x2e\xb5\xd9\x58\x4e\x33\x0f\xe7\xa7\xe7\xe7\xf0\x5e\xeb\x54\x12\xdc\xdc\x5c\x06\xfd\xa0\x0f\x37\x2c\x48\x39\x4a\xa0\x50\x09\x59\xf0\x19\xc1\xd8\xa0\xc8\xa8\xf5\x9c\xc0\x9f\x64\x1d\x6b\x05\xe7\xc3\x53\xf8\x5f\x19\xd0\x6b\x5c\xbd\xff\xff\x12\xf4\x61\xa3\x0b\xc8\x71\x03\x4a\x7b\x28\x1c\x81\xcf\xd8\xc1\x92\x25\x01\x7d\x15\x64\x3c\xb0\x02\xa1\x73\x23\x19\x95\x20\x58\xb3\xcf\xc0\x1f\xf0\x87\x41\x1f\x3e\x37\x10\x3a\xf6\xc8\x0a\x10\x84\x36\x1b\xd0\xcb\xc7\x71\x80\xbe\x22\x5c\xfd\x65\xde\x9b\x70\x34\x5a\xaf\xd7\x43\xac\xd8\x0e\xb5\x4d\x47\xb2\x8e\x74\xa3\x9b\xe8\xf2\x7a\x32\xbb\x1e\x9c\x0f\x4f\xab\x35\x77\x4a\x92\x73\x60\xe9\x4b\xc1\x96\x12\x88\x37\x80\xc6\x48\x16\x18\x4b\x02\x89\x6b\xd0\x16\x30\xb5\x44\x09\x78\x5d\x12\x5e\x5b\xf6\xac\xd2\x13\x70\x7a\xe9\xd7\x68\x29\xe8\x43\xc2\xce\x5b\x8e\x0b\xdf\xe9\x56\x4b\x8f\x5d\x27\x40\x2b\x40\x05\xbd\xf1\x0c\xa2\x59\x0f\x2e\xc6\xb3\x68\x76\x12\xf4\xe1\x53\x34\xff\x70\x7b\x37\x87\x4f\xe3\xe9\x74\x3c\x99\x47\xd7\x33\xb8\x9d\xc2\xe5\xed\xe4\x2a\x9a\x47\xb7\x93\x19\xdc\xbe\x83\xf1\xe4\x33\xfc\x16\x4d\xae\x4e\x80\xd8\x67\x64\x81\xbe\x1a\x5b\xf2\xd7\x16\xb8\xec\x23\x25\x65\xd3\x66\x44\x1d\x02\x4b\x5d\x6f\x9f\x33\x24\x78\xc9\x02\x24\xaa\xb4\xc0\x94\x20\xd5\x2b\xb2\x8a\x55\x0a\x86\x6c\xce\xae\xdc\x4d\x07\xa8\x92\xa0\x0f\x92\x73\xf6\xe8\x2b\xcb\x93\xa2\x86\x41\x80\x86\x9b\xfd\x0f\x41\x68\x4b\x43\xa1\x6c\x3e\x14\x52\x17\xc9\x30\xad\xa4\x34\x14\x3a\x1f\xad\xce\x50\x9a\x0c\xcf\x82\x07\x56\x49\x08\x33\xb2\x2b\x16\xf4\x11\x8d\x61\x95\x06\x39\x79\x4c\xd0\x63\x18\x00\x28\xcc\x29\x84\x98\xd3\x2f\x05\xd9\xcd\xf3\x70\x4d\x98\x33\x28\x28\x84\x32\x64\xe0\x36\xce\x53\x1e\x94\xc5\x1d\x50\x2e\x38\xfd\xa3\x44\x09\x00\x56\x2d\xc9\xd5\x59\x4c\x1e\xcf\x02\x00\x57\x93\xf8\xa0\x9d\x9f\x74\x93\xd6\x99\xd0\xb0\x6b\xb2\x59\x72\xba\xb0\x82\x5c\x89\x0d\x30\x68\xf0\xeb\xb8\x45\xbb\x6e\x51\xd6\xe0\xc8\x57\x31\x00\x75\xa9\x2d\x87\xab\x8e\x0f\x0b\xaf\xdf\x93\x22\x8b\x9e\x92\x10\xbc\x2d\xa8\xf1\x70\x32\xa7\xdc\x48\xf4\x14\x42\xcf\x58\x7d\x4f\xc2\xbb\xd1\x76\xdb\x5c\xee\x76\xa3\x26\x4b\x69\x6c\x2e\x17\x9c\xec\x76\xbd\x27\x00\x97\xa8\x2e\xe8\xce\x51\x32\xd7\x1f\xd1\x8b\x6c\xda\x54\x51\x57\xbb\x44\xe9\xda\xa4\x6d\x7d\xe3\x15\xb2\x2c\x45\x1f\xa9\xb1\x73\xe4\x23\xb5\x22\xe5\xb5\xdd\x74\xc3\xdb\xfd\x6a\xf6\x2f\x6c\xcc\x6d\xdb\x0f\xac\xf6\x0e\x89\x31\x49\x17\x36\xff\x8f\xb2\x46\x57\x07\x04\x8f\x36\x25\xff\x8e\x49\x26\xcf\x00\x65\x4c\x16\xad\xc8\x58\xa0\x9c\xd2\x92\x2c\xa9\xfd\xa6\x94\xdf\x01\xf8\x8d\xa1\x10\x9a\x66\xed\xed\x00\x0f\xb4\xd9\x9b\xa7\xb4\x3c\x62\xf0\x02\xd6\xb2\xe1\xf1\xbd\x70\xe5\x27\x21\x27\x2c\x9b\x72\x62\x42\xf8\x6b\xf0\xc8\x03\x30\xcf\xa8\x5d\x04\x3e\x43\x5f\x9f\x85\x2d\x09\x88\x49\x6a\x95\x3a\xf0\x7a\xf8\x68\x59\xba\x7a\x38\x90\x3a\xe8\xea\xf7\x27\x9c\x9e\x15\xf9\xe1\x93\x5a\x5d\x98\x70\x9f\x2d\x47\x85\x29\xd9\x17\x07\xec\x9b\x2a\x5f\xa0\x10\xe4\xdc\x3f\x89\x7d\xfc\x38\xe2\x27\x48\x7e\xb4\xdd\x5a\x2d\x69\xb7\x1b\x6d\xb7\x85\x23\xbb\x88\x37\x0b\xca\x91\x65\x65\xa9\x0a\xee\x9a\x12\x9d\x23\xab\xca\x5b\x9e\x14\x8c\x72\x51\x45\x55\x16\xc6\x7c\x91\x53\x1e\x93\xad\x7e\xae\x98\xd6\xbb\xdd\x21\x63\x93\xae\xf0\xac\xe8\x35\x4d\x5b\x43\xe9\x08\xfb\xc5\x99\xea\x86\xbf\x0d\xd4\x2b\x18\xa8\x7b\x1d\x3f\x3b\x45\xbf\xea\xf8\x5f\x98\x9d\x7b\x1d\x97\xc3\x74\xaf\xe3\x7a\x66\xa4\x16\xd5\xfd\x7c\xb4\xdd\xb6\x97\xaf\x49\xce\x35\xcf\x23\xe8\x17\xd5\xdc\x89\x7e\x13\xf3\x2b\x10\x73\xf7\x7c\xe9\x0a\x7a\xda\xf1\xfd\x8c\x1b\x42\x93\xdd\x1d\x8e\xea\x57\xf6\x6c\x74\x60\x75\x04\xff\xad\x03\xfb\x4d\xe6\xff\x89\xcc\xcb\xef\xa0\xae\xb4\x11\x5a\xb7\xd2\x7d\x7b\x0e\x32\xfc\x81\x3e\x44\x57\xed\x5b\x6c\xb3\x1c\x84\x56\xe5\x3b\x6e\xf9\x32\x56\xf7\xa5\xde\xfd\xef\xe8\xc5\xd1\xb3\xd7\x0f\xf7\xa4\x9d\xe0\x17\x9a\xf1\xf7\x00\x16\xa8\x9e\xd5\x29\x10\x00\x00"),
 		},
 		"/bigqueryanalyticshub.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigqueryanalyticshub.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3010,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x95\xcf\x6e\xe3\x36\x10\xc6\xef\x7a\x8a\x81\x7d\x69\x81\x44\xde\xe4\xa8\x9e\x1c\x27\xbb\x11\x9a\x38\xad\xed\x74\xb1\x27\x63\x44\x8d\xa5\x69\x28\x92\x4b\x52\x76\x84\xd4\xef\x5e\xe8\x9f\x63\xc7\x49\x77\xb7\x68\x8b\x3d\x54\x27\x81\x9c\xf9\xf8\xd3\xcc\xf0\xd3\x10\x26\xda\x54\x96\xb3\xdc\xc3\xf9\xbb\xf3\x73\xf8\xa0\x75\x26\x09\x6e\x6e\x26\xc1\x30\x18\xc2\x0d\x0b\x52\x8e\x52\x28\x55\x4a\x16\x7c\x4e\x30\x36\x28\x72\xea\x77\x4e\xe0\x37\xb2\x8e\xb5\x82\xf3\xf0\x1d\xfc\x50\x07\x0c\xba\xad\xc1\x8f\x3f\x05\x43\xa8\x74\x09\x05\x56\xa0\xb4\x87\xd2\x11\xf8\x9c\x1d\xac\x58\x12\xd0\xa3\x20\xe3\x81\x15\x08\x5d\x18\xc9\xa8\x04\xc1\x86\x7d\xde\x1c\xd3\x89\x84\xc1\x10\x3e\x75\x12\x3a\xf1\xc8\x0a\x10\x84\x36\x15\xe8\xd5\x7e\x1c\xa0\x6f\x80\x9b\x27\xf7\xde\x44\xa3\xd1\x66\xb3\x09\xb1\xa1\x0d\xb5\xcd\x46\xb2\x8d\x74\xa3\x9b\x78\x72\x35\x9d\x5f\x9d\x9e\x87\xef\x9a\x9c\x7b\x25\xc9\x39\xb0\xf4\xb9\x64\x4b\x29\x24\x15\xa0\x31\x92\x05\x26\x92\x40\xe2\x06\xb4\x05\xcc\x2c\x51\x0a\x5e\xd7\xc0\x1b\xcb\x9e\x55\x76\x02\x4e\xaf\xfc\x06\x2d\x05\x43\x48\xd9\x79\xcb\x49\xe9\x0f\xaa\xd5\xe3\xb1\x3b\x08\xd0\x0a\x50\xc1\x60\x3c\x87\x78\x3e\x80\x8b\xf1\x3c\x9e\x9f\x04\x43\xf8\x18\x2f\xae\xef\xee\x17\xf0\x71\x3c\x9b\x8d\xa7\x8b\xf8\x6a\x0e\x77\x33\x98\xdc\x4d\x2f\xe3\x45\x7c\x37\x9d\xc3\xdd\x7b\x18\x4f\x3f\xc1\xcf\xf1\xf4\xf2\x04\x88\x7d\x4e\x16\xe8\xd1\xd8\x9a\x5f\x5b\xe0\xba\x8e\x94\xd6\x45\x9b\x13\x1d\x00\xac\x74\x0b\xe4\x0c\x09\x5e\xb1\x00\x89\x2a\x2b\x31\x23\xc8\xf4\x9a\xac\x62\x95\x81\x21\x5b\xb0\xab\xbb\xe9\x00\x55\x1a\x0c\x41\x72\xc1\x1e\x7d\xb3\x72\xf4\x51\x61\x10\xa0\xe1\xae\xff\x11\x08\x6d\x29\x14\xca\x16\xa1\x90\xba\x4c\xc3\xac\x19\xa5\x50\xe8\x62\xb4\x3e\x43\x69\x72\x3c\x0b\x1e\x58\xa5\x11\xcc\xc9\xae\x59\xd0\x2d\x1a\xc3\x2a\x0b\x0a\xf2\x98\xa2\xc7\x28\x00\x50\x58\x50\x04\x09\x67\x9f\x4b\xb2\x15\x2a\x94\x95\x67\xe1\xf2\x32\x79\x5d\xba\x4b\x71\x06\x05\x45\x50\x87\x9c\xba\xca\x79\x2a\x82\xfa\x43\x9f\x15\x2f\x38\xfb\xb5\x56\x1c\xf7\x8a\xd7\x65\x12\x00\xac\x7b\xf8\xf5\x59\x42\x1e\xcf\x02\x00\xd7\xc2\x5d\x6b\xe7\xa7\x6f\xc3\xb4\x04\x68\xd8\x75\x14\x96\x9c\x2e\xad\x20\x57\x9f\x09\x70\xda\x9d\xdb\xc6\x2d\x7b\x8d\xe5\x4e\x64\x99\x97\xc9\xb2\xfe\xea\x25\x3d\x8a\x1c\x55\x46\x41\x3b\xbc\x6d\x89\x5e\xe3\xbd\x44\x8f\x57\x87\xc1\x58\x7a\xfd\x81\x14\x59\xf4\x94\x46\xe0\x6d\xd9\xef\x30\x16\x13\xad\x56\x9c\x45\xdd\x02\x80\xd1\x92\x45\x35\xfd\x56\xae\x25\x63\xb1\x6c\x73\x5f\x48\xdd\x52\x91\x90\xfd\x7b\x82\x45\x93\xbb\x13\xb4\xb4\x22\x4b\x4a\xd0\x7b\x26\x99\x3e\x33\xf7\xfd\x7b\x21\x90\xee\x05\xf8\xca\x50\xd4\x84\xed\x16\x5d\x69\x8c\xb6\xde\x4d\xb4\x4a\xb9\x99\xde\x08\x56\x28\xdd\xae\x38\xe9\x82\x0a\x23\xd1\x53\x04\x03\x63\xf5\xef\x24\xbc\x1b\x3d\x3d\x75\xaf\xdb\xed\x48\x6a\xd1\x8e\xfd\xe8\xe9\xa9\x7f\xdf\x6e\x47\xe9\x5e\x0b\xea\xad\x97\x58\xdb\xed\xe0\xe8\x88\x09\xaa\x0b\xba\x77\x94\x2e\xf4\x2d\x7a\x91\xcf\xba\x49\x69\xeb\xb6\x8f\xd5\xcf\xd0\x78\x8d\x2c\x6b\xf3\x89\xd5\xd8\x39\xf2\xb1\x5a\x93\xf2\xda\x56\x87\xe1\xfd\xbd\xe9\xee\xd1\x73\xd1\xfe\xb2\x64\xfd\x21\xf1\xe5\x73\x82\x47\x9b\x91\x6f\x4b\xff\x56\x5e\xce\x64\xd1\x8a\x9c\x05\xca\x59\xdf\x2d\xf7\xac\x71\xda\x35\xa2\xab\xe1\x5e\x83\x1e\xa8\xda\x2d\xcf\x68\xf5\x82\xe3\x0d\xad\x55\x47\xf3\xb5\x72\xf5\x93\x92\x13\x96\x8d\x6f\x6e\xf4\x1f\xa7\x7b\x3b\x00\x8b\x9c\xfa\x24\xf0\x39\xfa\xf6\x47\xd4\x43\x40\x42\x52\xab\xcc\x81\xd7\xe1\x5e\x5a\xb6\x7e\x88\x0e\x54\xda\xcb\xf9\xcb\x11\xd3\xab\x4e\xb2\xa7\x63\x75\x69\xa2\xdd\x69\x05\x2a\xcc\xc8\xbe\xe9\x68\x5f\x6b\x1f\x92\x5d\xfd\x1b\xfa\xa2\x71\xdc\x1c\xc4\xfd\x4b\x9e\xd1\xc1\xfc\x13\x6e\xb1\x2f\xf5\x8d\x3e\xb1\x4b\xfd\x7e\x1d\x62\xd4\x31\x36\x89\x3b\xdc\xef\xc9\x39\x8e\x8a\xf8\x45\xcf\x38\xca\xf8\xdf\x2d\xfe\x23\xb7\xf8\x33\x00\x00\xff\xff\x2f\xc4\x2f\xd3\xc2\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x95\x4b\x6f\xe3\x36\x10\xc7\xef\xfa\x14\x03\xfb\xd2\x02\x89\xbc\xc9\x51\x3d\x39\x4e\x76\x23\x34\x71\x5a\xdb\xe9\x62\x4f\xc6\x88\x1a\x4b\xd3\x50\x24\x97\xa4\xec\x08\xa9\xbf\x7b\xa1\x97\x1f\x79\xa0\xd9\xa2\x2d\xf6\x50\xcb\x07\x81\x9c\xf9\xf3\xa7\x99\xd1\x5f\x43\x98\x68\x53\x59\xce\x72\x0f\xe7\x1f\xce\xcf\xe1\x93\xd6\x99\x24\xb8\xb9\x99\x04\xc3\x60\x08\x37\x2c\x48\x39\x4a\xa1\x54\x29\x59\xf0\x39\xc1\xd8\xa0\xc8\xa9\xdf\x39\x81\xdf\xc8\x3a\xd6\x0a\xce\xc3\x0f\xf0\x43\x1d\x30\xe8\xb6\x06\x3f\xfe\x14\x0c\xa1\xd2\x25\x14\x58\x81\xd2\x1e\x4a\x47\xe0\x73\x76\xb0\x62\x49\x40\x8f\x82\x8c\x07\x56\x20\x74\x61\x24\xa3\x12\x04\x1b\xf6\x39\xf8\xbd\x7e\x18\x0c\xe1\x4b\x27\xa1\x13\x8f\xac\x00\x41\x68\x53\x81\x5e\x1d\xc6\x01\xfa\x06\xb8\xf9\xe5\xde\x9b\x68\x34\xda\x6c\x36\x21\x36\xb4\xa1\xb6\xd9\x48\xb6\x91\x6e\x74\x13\x4f\xae\xa6\xf3\xab\xd3\xf3\xf0\x43\x93\x73\xaf\x24\x39\x07\x96\xbe\x96\x6c\x29\x85\xa4\x02\x34\x46\xb2\xc0\x44\x12\x48\xdc\x80\xb6\x80\x99\x25\x4a\xc1\xeb\x1a\x78\x63\xd9\xb3\xca\x4e\xc0\xe9\x95\xdf\xa0\xa5\x60\x08\x29\x3b\x6f\x39\x29\xfd\x51\xb5\x7a\x3c\x76\x47\x01\x5a\x01\x2a\x18\x8c\xe7\x10\xcf\x07\x70\x31\x9e\xc7\xf3\x93\x60\x08\x9f\xe3\xc5\xf5\xdd\xfd\x02\x3e\x8f\x67\xb3\xf1\x74\x11\x5f\xcd\xe1\x6e\x06\x93\xbb\xe9\x65\xbc\x88\xef\xa6\x73\xb8\xfb\x08\xe3\xe9\x17\xf8\x39\x9e\x5e\x9e\x00\xb1\xcf\xc9\x02\x3d\x1a\x5b\xf3\x6b\x0b\x5c\xd7\x91\xd2\xba\x68\x73\xa2\x23\x80\x95\x6e\xdb\xe7\x0c\x09\x5e\xb1\x00\x89\x2a\x2b\x31\x23\xc8\xf4\x9a\xac\x62\x95\x81\x21\x5b\xb0\xab\xbb\xe9\x00\x55\x1a\x0c\x41\x72\xc1\x1e\x7d\xb3\xf2\xe2\xa1\xc2\x20\x40\xc3\x5d\xff\x23\x10\xda\x52\x28\x94\x2d\x42\x21\x75\x99\x86\x59\x33\x4a\xa1\xd0\xc5\x68\x7d\x86\xd2\xe4\x78\x16\x3c\xb0\x4a\x23\x98\x93\x5d\xb3\xa0\x5b\x34\x86\x55\x16\x14\xe4\x31\x45\x8f\x51\x00\xa0\xb0\xa0\x08\x12\xce\xbe\x96\x64\x2b\x54\x28\x2b\xcf\xc2\xe5\x65\xf2\xba\x74\x97\xe2\x0c\x0a\x8a\xa0\x0e\x39\x75\x95\xf3\x54\x04\xf5\x83\xee\x15\x2f\x38\xfb\xb5\x56\x1c\xf7\x8a\xd7\x65\x12\x00\xac\x7b\xf8\xf5\x59\x42\x1e\xcf\x02\x00\xd7\xc2\x5d\x6b\xe7\xa7\x6f\xc3\xb4\x04\x68\xd8\x75\x14\x96\x9c\x2e\xad\x20\x57\x9f\x09\x70\xda\x9d\xdb\xc6\x2d\x7b\x8d\xe5\x4e\x64\x99\x97\xc9\xb2\x7e\xea\x25\x3d\x8a\x1c\x55\x46\x4d\x1e\x40\x5b\xa2\xd7\x78\x2f\xd1\xe3\xd5\x71\x30\x96\x5e\x7f\x22\x45\x16\x3d\xa5\x11\x78\x5b\xf6\x3b\x8c\xc5\x44\xab\x15\x67\x51\xb7\x00\x60\xb4\x64\x51\x4d\xbf\x95\x6b\xc9\x58\x2c\xdb\xdc\x67\x52\xb7\x54\x24\x64\xff\x9e\x60\xd1\xe4\xee\x04\x2d\xad\xc8\x92\x12\xf4\x91\x49\xa6\x7b\xe6\xbe\x7f\xcf\x04\xd2\x83\x00\x5f\x19\x8a\x9a\xb0\xdd\xa2\x2b\x8d\xd1\xd6\xbb\x89\x56\x29\x37\xd3\x1b\xc1\x0a\xa5\xdb\x15\x27\x5d\x50\x61\x24\x7a\x8a\x60\x60\xac\xfe\x9d\x84\x77\xa3\xa7\xa7\xee\x76\xbb\x1d\x49\x2d\xda\xb1\x1f\x3d\x3d\xf5\xf7\xdb\xed\x28\x3d\x68\x41\x9d\xf0\x1c\x6b\xbb\x1d\xbc\x38\x62\x82\xea\x82\xee\x1d\xa5\x0b\x7d\x8b\x5e\xe4\xb3\x6e\x52\xda\xba\x1d\x62\xf5\x33\x34\x5e\x23\xcb\xda\x7c\x62\x35\x76\x8e\x7c\xac\xd6\xa4\xbc\xb6\xd5\x71\x78\xff\xde\x74\xef\x51\x14\xbc\xab\x64\xfd\x21\xf1\xe5\x3e\xc1\xa3\xcd\xc8\xb7\xa5\x7f\x2b\x2f\x67\xb2\x68\x45\xce\x02\xe5\xac\xef\x56\x37\xed\xf5\xff\xb4\x6b\x44\x57\xc3\xdd\x3a\xc0\x03\x55\xbb\xe5\x19\xad\x9e\x71\xbc\xa1\xb5\xea\x68\xde\x2b\x57\x5f\x29\x39\x61\xd9\xd4\xbd\x8a\xe0\x8f\xd3\x83\x1d\x80\x45\x4e\x7d\x12\xf8\x1c\x7d\xfb\x21\xea\x21\x20\x21\xa9\x55\xe6\xc0\xeb\xf0\x20\x2d\x5b\x3f\xec\xa1\xf6\x2f\xe7\x2f\x2f\x98\x5e\x75\x92\xfd\x95\x59\x5d\x9a\x68\x77\x5a\x81\x0a\x33\xb2\x6f\x3a\xda\x7b\xed\x43\xb2\xab\x3f\x43\x7f\x69\x1c\x37\x47\x71\xff\x92\x67\x74\x30\xff\x84\x5b\x1c\x4a\x7d\xa3\x4f\xec\x52\xbf\x5f\x87\x18\x75\x8c\xf5\xee\x1e\xf7\x7b\x72\x8e\x3d\xd5\x33\xf9\x37\x3d\xe3\x45\xc6\xff\x6e\xf1\x1f\xb9\xc5\x9f\x03\x00\x2f\xc4\x2f\xd3\xc2\x0b\x00\x00"),
 		},
 		"/bigqueryconnection.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigqueryconnection.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2202,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x95\x4d\x73\xe2\x38\x13\xc7\xef\xfe\x14\x5d\x70\x79\x9e\xaa\x60\x07\x76\x0e\xbb\xde\x13\x21\x99\x19\xd7\x26\x30\x0b\x64\xa7\xe6\x44\x35\x72\xdb\xd6\x46\x96\x14\x49\x86\xf1\x66\xf9\xee\x5b\x7e\x03\x13\x92\xda\x39\xac\x4e\x46\xea\xfe\xf7\x4f\xdd\xad\x66\x08\x33\xa5\x4b\xc3\xd3\xcc\xc1\xe4\x7a\x32\x81\x4f\x4a\xa5\x82\xe0\xfe\x7e\xe6\x0d\xbd\x21\xdc\x73\x46\xd2\x52\x0c\x85\x8c\xc9\x80\xcb\x08\xa6\x1a\x59\x46\xdd\xc9\x15\xfc\x41\xc6\x72\x25\x61\xe2\x5f\xc3\xff\x2a\x83\x41\x7b\x34\xf8\xff\xaf\xde\x10\x4a\x55\x40\x8e\x25\x48\xe5\xa0\xb0\x04\x2e\xe3\x16\x12\x2e\x08\xe8\x3b\x23\xed\x80\x4b\x60\x2a\xd7\x82\xa3\x64\x04\x7b\xee\xb2\x3a\x4c\x2b\xe2\x7b\x43\xf8\xd6\x4a\xa8\xad\x43\x2e\x01\x81\x29\x5d\x82\x4a\xfa\x76\x80\xae\x06\xae\x57\xe6\x9c\x0e\x83\x60\xbf\xdf\xfb\x58\xd3\xfa\xca\xa4\x81\x68\x2c\x6d\x70\x1f\xcd\xee\xe6\xab\xbb\xd1\xc4\xbf\xae\x7d\x1e\xa5\x20\x6b\xc1\xd0\x73\xc1\x0d\xc5\xb0\x2d\x01\xb5\x16\x9c\xe1\x56\x10\x08\xdc\x83\x32\x80\xa9\x21\x8a\xc1\xa9\x0a\x78\x6f\xb8\xe3\x32\xbd\x02\xab\x12\xb7\x47\x43\xde\x10\x62\x6e\x9d\xe1\xdb\xc2\x9d\x65\xab\xc3\xe3\xf6\xcc\x40\x49\x40\x09\x83\xe9\x0a\xa2\xd5\x00\x6e\xa6\xab\x68\x75\xe5\x0d\xe1\x6b\xb4\xfe\xbc\x78\x5c\xc3\xd7\xe9\x72\x39\x9d\xaf\xa3\xbb\x15\x2c\x96\x30\x5b\xcc\x6f\xa3\x75\xb4\x98\xaf\x60\xf1\x11\xa6\xf3\x6f\xf0\x5b\x34\xbf\xbd\x02\xe2\x2e\x23\x03\xf4\x5d\x9b\x8a\x5f\x19\xe0\x55\x1e\x29\xae\x92\xb6\x22\x3a\x03\x48\x54\x03\x64\x35\x31\x9e\x70\x06\x02\x65\x5a\x60\x4a\x90\xaa\x1d\x19\xc9\x65\x0a\x9a\x4c\xce\x6d\x55\x4d\x0b\x28\x63\x6f\x08\x82\xe7\xdc\xa1\xab\x77\x2e\x2e\xe5\x7b\x1e\x6a\xde\xd6\x3f\x04\xa6\x0c\xf9\x4c\x9a\xdc\x67\x42\x15\xb1\x9f\xd6\xad\xe4\x33\x95\x07\xbb\x31\x0a\x9d\xe1\xd8\x7b\xe2\x32\x0e\x61\x45\x66\xc7\x19\x3d\xa0\xd6\x5c\xa6\x5e\x4e\x0e\x63\x74\x18\x7a\x00\x12\x73\x0a\x61\xcb\xd3\xe7\x82\x4c\xc9\x94\x94\xc4\xaa\xf0\x6f\x0b\xb7\x0e\x56\x23\xa3\x10\x2a\x93\x91\x2d\xad\xa3\xdc\xab\xae\x79\xd2\xbb\xe1\xe9\xef\x95\xde\xec\xa8\xe7\x01\xec\x3a\xf0\xdd\x78\x4b\x0e\xc7\x1e\x80\x6d\xc0\x3e\x2b\xeb\xe6\xef\x81\x34\xd1\x51\x73\xdb\x12\x18\xb2\xaa\x30\x8c\x6c\x15\x0f\x60\xd4\xc6\x6c\xec\x36\x9d\xc2\x86\xf5\x63\x57\xab\xc9\xc5\x25\xda\xec\xb5\x21\x16\x4e\x7d\x22\x49\x06\x1d\xc5\x21\x38\x53\x50\x7b\xc2\x31\x9f\x29\x99\xf0\x34\x6c\x37\x00\xb4\x12\x9c\x95\xf3\x7f\x41\xd8\x70\xcc\x37\x8d\xe9\x2b\xcf\x07\xca\xb7\x64\x7e\xc8\x3f\xaf\x4d\x8f\xfe\x86\x12\x32\x24\x19\x7d\xe4\x24\xe2\x13\x51\x57\x84\xbe\x77\xdc\x3b\x75\xa5\xa6\xb0\xb6\x39\x6e\xda\x42\x6b\x65\x9c\x9d\x29\x19\xf3\xba\xfb\x42\x48\x50\xd8\xe3\xbd\xe3\x35\xe5\x5a\xa0\xa3\x10\x06\xda\xa8\x3f\x89\x39\x1b\xbc\xbc\xb4\x9f\x87\x43\x20\x14\x6b\xda\x36\x78\x79\xe9\xbe\x0f\x87\xe0\xc4\x50\x1d\x9c\x11\x1d\x0e\x83\x0b\xf5\x19\xca\x1b\x7a\xb4\x14\xaf\xd5\x03\x3a\x96\x2d\xdb\x52\x37\xe9\xe9\x13\x75\x4d\x30\xdd\x21\x17\xd5\xdc\x88\xe4\xd4\x5a\x72\x91\xdc\x91\x74\xca\x94\xaf\x2e\x90\x4a\x65\x28\xae\x33\x65\x4f\xa9\x1a\xc2\x7a\x71\xbb\x08\xeb\x01\x66\xc3\x20\x48\xb9\xcb\x8a\x6d\xfd\x84\x9a\xc1\x3c\xab\x1e\xc0\x17\x81\x2e\x51\x26\x0f\x9e\x7e\xb6\x23\x56\xd7\x7f\xd4\x5e\x45\x99\x80\x5b\x5b\x90\x0d\x26\xe3\x0f\xbf\x1c\x75\x47\x50\xbf\x9c\x8d\x7d\x16\x97\x7b\x1a\xa5\xec\x95\xf1\xbf\x64\xf8\xe9\xc3\x75\x2f\x1e\xee\x6d\xff\xd7\x5f\x85\xe9\xd2\xa1\xb6\xd5\xcb\xbb\xcc\x47\xc7\xd8\x65\xd7\x6f\x1f\xe8\x06\x19\x53\x85\x74\xa7\x46\xea\x66\x48\x3b\x53\x4e\x12\xef\xf7\x5e\x27\x1a\xdd\x9e\xac\x1d\x9a\x94\x5c\xd3\xc0\x6f\x3a\x65\x9c\x0c\x1a\x96\x71\x86\x62\xd9\x35\xfc\x19\x71\xd3\xce\x6d\x27\xf6\xda\xfc\x89\xca\xe3\xf6\x92\x92\x57\x10\xef\x68\x25\x2d\xca\x8f\xca\x55\x2b\x26\xcb\x0c\xd7\xae\x9e\x6d\x7f\x8f\x7a\x27\x00\xeb\x8c\x3a\x27\x70\x19\xba\xe6\xef\xb8\x83\x80\x2d\x09\x25\x53\x0b\x4e\xf9\x3d\xb7\x74\xf7\x14\x9e\xa9\x34\x93\xeb\xcb\x05\xd3\x9b\x33\xb5\xa7\x63\x54\xa1\xc3\x63\xb4\x1c\x25\xa6\x64\xde\x99\xec\xff\x04\x00\x00\xff\xff\x36\x0e\xb0\x26\x9a\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x95\x4b\x93\xe2\x36\x10\xc7\xef\xfe\x14\x5d\x70\x49\xaa\x06\x7b\x86\xec\x21\x71\x4e\x2c\xb3\x0f\x2a\xb3\xb0\x01\x26\x5b\x7b\xa2\x1a\xb9\xb1\x3b\x23\x4b\x1a\x49\x86\x75\x26\x7c\xf7\x94\x5f\x3c\x86\x99\xda\xbd\x04\x73\x30\xd2\xbf\xff\xfa\xa9\xd5\x6a\xfa\x30\xd6\xa6\xb4\x9c\x66\x1e\x86\xd7\xc3\x21\x7c\xd0\x3a\x95\x04\x77\x77\xe3\xa0\x1f\xf4\xe1\x8e\x05\x29\x47\x09\x14\x2a\x21\x0b\x3e\x23\x18\x19\x14\x19\x75\x33\x57\xf0\x17\x59\xc7\x5a\xc1\x30\xbc\x86\x9f\x2a\x41\xaf\x9d\xea\xfd\xfc\x7b\xd0\x87\x52\x17\x90\x63\x09\x4a\x7b\x28\x1c\x81\xcf\xd8\xc1\x86\x25\x01\x7d\x13\x64\x3c\xb0\x02\xa1\x73\x23\x19\x95\x20\xd8\xb1\xcf\xc0\x1f\xfd\xc3\xa0\x0f\x5f\x5b\x0b\xbd\xf6\xc8\x0a\x10\x84\x36\x25\xe8\xcd\xa9\x0e\xd0\xd7\xc0\xf5\x27\xf3\xde\xc4\x51\xb4\xdb\xed\x42\xac\x69\x43\x6d\xd3\x48\x36\x4a\x17\xdd\x4d\xc6\xef\xa6\x8b\x77\x83\x61\x78\x5d\xc7\xdc\x2b\x49\xce\x81\xa5\xc7\x82\x2d\x25\xb0\x2e\x01\x8d\x91\x2c\x70\x2d\x09\x24\xee\x40\x5b\xc0\xd4\x12\x25\xe0\x75\x05\xbc\xb3\xec\x59\xa5\x57\xe0\xf4\xc6\xef\xd0\x52\xd0\x87\x84\x9d\xb7\xbc\x2e\xfc\x59\xb6\x3a\x3c\x76\x67\x02\xad\x00\x15\xf4\x46\x0b\x98\x2c\x7a\xf0\x76\xb4\x98\x2c\xae\x82\x3e\x7c\x99\x2c\x3f\xce\xee\x97\xf0\x65\x34\x9f\x8f\xa6\xcb\xc9\xbb\x05\xcc\xe6\x30\x9e\x4d\x6f\x27\xcb\xc9\x6c\xba\x80\xd9\x7b\x18\x4d\xbf\xc2\x1f\x93\xe9\xed\x15\x10\xfb\x8c\x2c\xd0\x37\x63\x2b\x7e\x6d\x81\xab\x3c\x52\x52\x25\x6d\x41\x74\x06\xb0\xd1\xcd\xf1\x39\x43\x82\x37\x2c\x40\xa2\x4a\x0b\x4c\x09\x52\xbd\x25\xab\x58\xa5\x60\xc8\xe6\xec\xaa\xd3\x74\x80\x2a\x09\xfa\x20\x39\x67\x8f\xbe\x1e\xb9\xd8\x54\x18\x04\x68\xb8\x3d\xff\x18\x84\xb6\x14\x0a\x65\xf3\x50\x48\x5d\x24\x61\x5a\x97\x52\x28\x74\x1e\x6d\x6f\x50\x9a\x0c\x6f\x82\x07\x56\x49\x0c\x0b\xb2\x5b\x16\xf4\x09\x8d\x61\x95\x06\x39\x79\x4c\xd0\x63\x1c\x00\x28\xcc\x29\x86\x35\xa7\x8f\x05\xd9\x52\x68\xa5\x48\x54\xcb\xbf\x6c\xdc\x06\x38\x83\x82\x62\xa8\x24\x03\x57\x3a\x4f\x79\x50\x6d\xf3\xe8\xf7\x96\xd3\x3f\x2b\xbf\xf1\xc1\x2f\x00\xd8\x76\xe0\xdb\x9b\x35\x79\xbc\x09\x00\x5c\x03\xf6\x51\x3b\x3f\x7d\x0d\xa4\x59\x1d\x0d\xbb\x96\xc0\x92\xd3\x85\x15\xe4\xaa\xf5\x00\x06\xed\x9a\x8d\x6e\xd5\x39\xac\x8e\x16\xb5\x0c\xa0\xc9\xc5\x25\xda\x19\x64\xa5\xc4\xc2\xeb\x0f\xa4\xc8\xa2\xa7\x24\x06\x6f\x0b\x6a\x67\x18\xf3\xb1\x56\x1b\x4e\xe3\x76\x00\xc0\x68\xc9\xa2\x9c\x7e\x07\x61\xc5\x98\xaf\x1a\xe9\xb3\xc8\x4f\x94\xaf\xc9\xfe\x50\x7c\x5e\x4b\x0f\xf1\x96\x36\x64\x49\x09\x7a\xcf\x24\x93\x23\x51\x77\x08\xa7\xd1\xc9\xc9\xac\x2f\x0d\xc5\xb5\xe6\x30\xe8\x0a\x63\xb4\xf5\x6e\xac\x55\xc2\x55\x84\x8b\x61\x83\xd2\x1d\xf6\x9d\x2c\x29\x37\x12\x3d\xc5\xd0\x33\x56\xff\x4d\xc2\xbb\xe8\xe9\xa9\x7d\xdd\xef\x23\xa9\x45\x53\xb6\xd1\xd3\x53\xf7\xbe\xdf\x47\x47\x86\x4a\x7e\x46\xb4\xdf\xf7\x2e\xdc\xc7\xa8\xde\xd2\xbd\xa3\x64\xa9\x3f\xa1\x17\xd9\xbc\x3d\xea\x26\x3d\xa7\x44\x5d\x11\x8c\xb6\xc8\xb2\xea\x1b\x13\x35\x72\x8e\xfc\x44\x6d\x49\x79\x6d\xcb\x67\x1b\x48\x95\xb6\x94\xd4\x99\x6a\xeb\xa6\xfa\xf6\x61\x39\xbb\x9d\xc5\x75\x03\x73\x71\x14\xa5\xec\xb3\x62\x5d\xd5\x59\xd4\x34\xe6\xb1\xd4\x45\xf2\x59\xa2\xdf\x68\x9b\x47\x0f\xbf\xba\x81\xa8\xcf\x7f\xd0\x6e\x45\xdb\x88\x9d\x2b\xc8\x45\xc3\x9b\x37\xbf\x1d\x7c\x07\x50\xdf\x9c\x95\x7b\x94\x97\x63\x06\x95\x22\xfb\xbf\x30\xfc\xf2\xe6\xfa\x64\x3d\xdc\xb9\xd3\x5f\xff\x14\xb6\x4b\x87\x5e\x57\x37\xef\x32\x1f\x1d\x63\x97\xdd\xb0\xbd\xa0\x2b\x14\x42\x17\xca\x1f\x0b\xa9\xeb\x21\x6d\x4f\x89\x83\xef\xd7\x5e\x67\x3a\xb9\x3d\xaa\x3d\xda\x94\x7c\x8d\xf1\x72\x50\xc6\x64\xd1\x8a\x8c\x05\xca\x79\x57\xf0\x67\xc4\x4d\x39\xb7\x95\x78\x18\x07\x78\xa0\xf2\x30\x3c\xa7\xcd\x33\x88\x57\xbc\x36\x2d\xca\x8f\xda\x55\x4f\x42\x4e\x58\x36\x15\x77\x0c\xff\x0e\x4e\x66\x00\x96\x19\x75\x41\xe0\x33\xf4\xcd\xdf\x71\x07\x01\x6b\x92\x5a\xa5\x0e\xbc\x0e\x4f\xc2\xd2\xed\xc3\x11\xea\xd8\xb9\x3e\x5f\x30\xbd\xd8\x53\x8f\x4f\x6a\x75\x61\xe2\xc3\x6a\x39\x2a\x4c\xc9\xbe\xd2\xd9\xff\x1b\x00\x36\x0e\xb0\x26\x9a\x08\x00\x00"),
 		},
 		"/bigquerydatapolicy.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigquerydatapolicy.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1906,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x95\xcf\x72\xdb\x36\x10\xc6\xef\x7c\x8a\x1d\xe9\xd2\xce\xd8\x54\xec\x23\x7b\x92\x65\x27\xe1\xd4\x96\x53\x49\x6e\x26\x27\xcf\x0a\x5c\x91\x5b\x83\x00\x02\x2c\xa5\x70\x5c\xbd\x7b\x87\xff\x2c\xa9\xb6\x3b\x0d\x4e\x1c\xec\xee\xb7\x3f\x7d\x0b\x40\x63\x98\x59\x57\x7b\xce\x0b\x81\xcb\x0f\x97\x97\xf0\xc9\xda\x5c\x13\xdc\xde\xce\xa2\x71\x34\x86\x5b\x56\x64\x02\x65\x50\x99\x8c\x3c\x48\x41\x30\x75\xa8\x0a\x1a\x22\x67\xf0\x27\xf9\xc0\xd6\xc0\x65\xfc\x01\x7e\x69\x12\x46\x7d\x68\xf4\xeb\x6f\xd1\x18\x6a\x5b\x41\x89\x35\x18\x2b\x50\x05\x02\x29\x38\xc0\x86\x35\x01\xfd\x50\xe4\x04\xd8\x80\xb2\xa5\xd3\x8c\x46\x11\xec\x58\x8a\xb6\x4d\x2f\x12\x47\x63\xf8\xd6\x4b\xd8\xb5\x20\x1b\x40\x50\xd6\xd5\x60\x37\xc7\x79\x80\xd2\x02\xb7\xab\x10\x71\xc9\x64\xb2\xdb\xed\x62\x6c\x69\x63\xeb\xf3\x89\xee\x32\xc3\xe4\x36\x9d\xdd\xcc\x97\x37\xe7\x97\xf1\x87\xb6\xe6\xc1\x68\x0a\x01\x3c\x7d\xaf\xd8\x53\x06\xeb\x1a\xd0\x39\xcd\x0a\xd7\x9a\x40\xe3\x0e\xac\x07\xcc\x3d\x51\x06\x62\x1b\xe0\x9d\x67\x61\x93\x9f\x41\xb0\x1b\xd9\xa1\xa7\x68\x0c\x19\x07\xf1\xbc\xae\xe4\xc4\xad\x01\x8f\xc3\x49\x82\x35\x80\x06\x46\xd3\x25\xa4\xcb\x11\x5c\x4d\x97\xe9\xf2\x2c\x1a\xc3\xd7\x74\xf5\xf9\xfe\x61\x05\x5f\xa7\x8b\xc5\x74\xbe\x4a\x6f\x96\x70\xbf\x80\xd9\xfd\xfc\x3a\x5d\xa5\xf7\xf3\x25\xdc\x7f\x84\xe9\xfc\x1b\xfc\x9e\xce\xaf\xcf\x80\x58\x0a\xf2\x40\x3f\x9c\x6f\xf8\xad\x07\x6e\x7c\xa4\xac\x31\x6d\x49\x74\x02\xb0\xb1\x1d\x50\x70\xa4\x78\xc3\x0a\x34\x9a\xbc\xc2\x9c\x20\xb7\x5b\xf2\x86\x4d\x0e\x8e\x7c\xc9\xa1\x99\x66\x00\x34\x59\x34\x06\xcd\x25\x0b\x4a\xbb\xf3\xea\x47\xc5\x51\x84\x8e\xfb\xf9\x27\xa0\xac\xa7\x58\x19\x5f\xc6\x4a\xdb\x2a\x8b\xf3\xf6\x28\xc5\xca\x96\x93\xed\x05\x6a\x57\xe0\x45\xf4\xc4\x26\x4b\x60\x49\x7e\xcb\x8a\xee\xd0\x39\x36\x79\x54\x92\x60\x86\x82\x49\x04\x60\xb0\xa4\x04\xd6\x9c\x7f\xaf\xc8\xd7\xcd\xae\xb3\x9a\x55\xfd\xb6\x70\x5f\x10\x1c\x2a\x4a\xa0\x49\x39\x0f\x75\x10\x2a\xa3\xe6\x67\x1e\xf4\xae\x38\xff\xa3\xd1\xbb\x46\xc1\x2f\xad\x5e\x04\xb0\x1d\xc0\xb7\x17\x6b\x12\xbc\x88\x00\x42\x07\xf6\xd9\x06\x99\xbf\x07\xd2\x75\x47\xc7\xa1\x27\xf0\x14\x6c\xe5\x15\x85\xa6\x1f\xc0\x79\xdf\xb3\xcb\x7b\x1c\x14\x1e\x0f\x12\xed\xe7\xa3\x1b\x38\x9a\xd5\xf9\xf2\x1a\xf3\x04\xb8\x59\x58\x89\xfd\x44\x86\x3c\x0a\x65\x09\x88\xaf\xa8\x8f\x30\x96\x33\x6b\x36\x9c\x27\xfd\x06\x40\xd7\x62\xfe\x13\x38\x8f\x8c\xe5\x29\xd9\xa0\x72\x47\xe5\x9a\xfc\x4f\x6b\x95\x6d\xd9\x8b\x96\xa7\x0d\x79\x32\x8a\x3e\x32\xe9\xec\x40\x3a\x0c\xea\xa4\x3c\x3b\x0a\x4b\xed\x28\x69\x93\x5e\x36\x43\xe5\x9c\xf5\x12\x66\xd6\x64\xdc\x1e\xd1\x04\x36\xa8\xc3\x8b\x21\xd9\x8a\x4a\xa7\x51\x28\x81\x91\xf3\xf6\x2f\x52\x12\x26\xcf\xcf\xfd\xe7\x7e\x3f\xd1\x56\x75\x67\x7b\xf2\xfc\x3c\x7c\xef\xf7\x93\x6c\x30\x9d\xa9\x89\x9c\x32\xed\xf7\xa3\x57\xfa\x33\x34\x57\xf4\x10\x28\x5b\xd9\x3b\x14\x55\x2c\xfa\x13\xd1\xb9\x75\xcc\x34\x9c\x95\xe9\x16\x59\x37\xcf\x4b\x6a\xa6\x21\x90\xa4\x66\x4b\x46\xac\xaf\x4f\xd3\x87\x9b\xd1\xdf\x94\x83\x5f\xff\xe1\xd6\xd0\x22\xbd\x3e\xa4\x0b\xfa\x9c\xa4\xf3\xfc\xed\xaa\x82\xc9\xa3\x57\x05\x2b\xd4\x8b\x61\x48\xe1\xa0\x70\xde\x4f\xa0\x37\xef\x68\x32\x4f\x54\xbf\x6c\x2f\x68\xf3\x2f\x8a\x77\xb4\x36\x3d\xcb\xff\x95\x6b\x56\x46\x41\x79\x76\xd2\xde\xd9\xbf\xcf\x8f\x22\x00\xab\x82\x86\x22\x90\x02\xa5\xfb\x9b\x19\x20\x60\x4d\xda\x9a\x3c\x80\xd8\xf8\xa8\x2c\xdf\x3e\x25\x27\x2a\xdd\x2d\xfc\xf2\x8a\xe9\xcd\xb7\xe2\x48\xc7\xdb\xca\x25\x2f\xdd\x4a\x34\x98\x93\x7f\xe7\xc5\xfa\x27\x00\x00\xff\xff\x24\x81\xb3\xd6\x72\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x95\xdf\x6f\xdb\x38\x0c\xc7\xdf\xfd\x57\x10\xc9\xcb\x1d\xd0\x3a\x6b\x1f\x7d\x4f\x69\xda\x6d\xc6\xb5\xe9\x2e\x49\x6f\xd8\x53\xc1\xc8\x8c\xcd\xab\x2c\x69\x12\x9d\xcc\xe8\xe5\x7f\x3f\xf8\x57\xd2\x5c\x5b\x60\x8b\xf3\x20\x50\xe4\x97\x1f\x93\x94\x3c\x86\x99\x75\xb5\xe7\xbc\x10\xb8\xfc\x70\x79\x09\x9f\xac\xcd\x35\xc1\xed\xed\x2c\x1a\x47\x63\xb8\x65\x45\x26\x50\x06\x95\xc9\xc8\x83\x14\x04\x53\x87\xaa\xa0\x61\xe7\x0c\xfe\x26\x1f\xd8\x1a\xb8\x8c\x3f\xc0\x6f\x8d\xc3\xa8\xdf\x1a\xfd\xfe\x47\x34\x86\xda\x56\x50\x62\x0d\xc6\x0a\x54\x81\x40\x0a\x0e\xb0\x61\x4d\x40\x3f\x14\x39\x01\x36\xa0\x6c\xe9\x34\xa3\x51\x04\x3b\x96\x02\xe4\xa8\x1f\x47\x63\xf8\xd6\x4b\xd8\xb5\x20\x1b\x40\x50\xd6\xd5\x60\x37\x2f\xfd\x00\xa5\x05\x6e\x7f\x85\x88\x4b\x26\x93\xdd\x6e\x17\x63\x4b\x1b\x5b\x9f\x4f\x74\xe7\x19\x26\xb7\xe9\xec\x66\xbe\xbc\x39\xbf\x8c\x3f\xb4\x31\x0f\x46\x53\x08\xe0\xe9\x7b\xc5\x9e\x32\x58\xd7\x80\xce\x69\x56\xb8\xd6\x04\x1a\x77\x60\x3d\x60\xee\x89\x32\x10\xdb\x00\xef\x3c\x0b\x9b\xfc\x0c\x82\xdd\xc8\x0e\x3d\x45\x63\xc8\x38\x88\xe7\x75\x25\x27\xd5\x1a\xf0\x38\x9c\x38\x58\x03\x68\x60\x34\x5d\x42\xba\x1c\xc1\xd5\x74\x99\x2e\xcf\xa2\x31\x7c\x4d\x57\x9f\xef\x1f\x56\xf0\x75\xba\x58\x4c\xe7\xab\xf4\x66\x09\xf7\x0b\x98\xdd\xcf\xaf\xd3\x55\x7a\x3f\x5f\xc2\xfd\x47\x98\xce\xbf\xc1\x9f\xe9\xfc\xfa\x0c\x88\xa5\x20\x0f\xf4\xc3\xf9\x86\xdf\x7a\xe0\xa6\x8e\x94\x35\x45\x5b\x12\x9d\x00\x6c\x6c\xd7\xbe\xe0\x48\xf1\x86\x15\x68\x34\x79\x85\x39\x41\x6e\xb7\xe4\x0d\x9b\x1c\x1c\xf9\x92\x43\xd3\xcd\x00\x68\xb2\x68\x0c\x9a\x4b\x16\x94\xd6\xf2\xea\xa5\xe2\x28\x42\xc7\x7d\xff\x13\x50\xd6\x53\xac\x8c\x2f\x63\xa5\x6d\x95\xc5\x79\x3b\x4a\xb1\xb2\xe5\x64\x7b\x81\xda\x15\x78\x11\x3d\xb1\xc9\x12\x58\x92\xdf\xb2\xa2\x3b\x74\x8e\x4d\x1e\x95\x24\x98\xa1\x60\x12\x01\x18\x2c\x29\x81\x35\xe7\xdf\x2b\xf2\x75\x63\x75\x56\xb3\xaa\xdf\x16\xee\x03\x82\x43\x45\x09\x34\x2e\xe7\xa1\x0e\x42\x65\xd4\xbc\xe6\x51\xef\x8a\xf3\xbf\x1a\xbd\x6b\x14\xfc\xd2\xea\x45\x00\xdb\x01\x7c\x7b\xb1\x26\xc1\x8b\x08\x20\x74\x60\x9f\x6d\x90\xf9\x7b\x20\x5d\x76\x74\x1c\x7a\x02\x4f\xc1\x56\x5e\x51\x68\xf2\x01\x9c\xf7\x39\x3b\xbf\xc7\x41\xe1\xf1\x28\xd1\x2e\x1f\xdd\xc0\xd1\x04\x75\x75\x79\x8d\x79\x02\xdc\x38\x62\x25\xf6\x13\x19\xf2\x28\x94\x25\x20\xbe\xa2\x7e\x87\xb1\x9c\x59\xb3\xe1\x3c\xe9\x0d\x00\x5d\x8a\xf9\x2f\xe0\x3c\x32\x96\xa7\x64\x83\xca\x1d\x95\x6b\xf2\xbf\xac\x55\xb6\x61\x07\x2d\x4f\x1b\xf2\x64\x14\x7d\x64\xd2\xd9\x91\x74\x68\xd4\x49\x78\xf6\x62\x5b\x6a\x47\x49\xeb\x74\x30\x86\xca\x39\xeb\x25\xcc\xac\xc9\xb8\x1d\xd1\x04\x36\xa8\xc3\xa1\x20\xd9\x8a\x4a\xa7\x51\x28\x81\x91\xf3\xf6\x1f\x52\x12\x26\xcf\xcf\xfd\x72\xbf\x9f\x68\xab\xba\xd9\x9e\x3c\x3f\x0f\xeb\xfd\x7e\x92\x0d\x45\x67\x6a\xfc\x4f\x99\xf6\xfb\xd1\x2b\xfd\x19\x9a\x2b\x7a\x08\x94\xad\xec\x1d\x8a\x2a\x16\xfd\x44\x74\xd5\x7a\xc9\x34\xcc\xca\x74\x8b\xac\x9b\xeb\x25\x35\xd3\x10\x48\x52\xb3\x25\x23\xd6\xd7\xa7\xee\xc3\xc9\xe8\x4f\x4a\x12\xfd\x44\xb5\x86\x14\xe9\xf5\xd1\x5d\xd0\xe7\x24\x5d\xcd\xdf\x8e\x2a\x98\x3c\x7a\x55\xb0\x42\xbd\x18\x9a\xd4\x4f\x74\xf3\x3f\xef\x3b\xd0\x17\xef\x60\x07\x78\xa2\xfa\x60\x5e\xd0\xe6\x7f\x14\xef\x68\x6d\x7a\x96\x9f\x95\x6b\x9e\x8c\x82\xf2\xec\x9a\x26\x25\xf0\xef\xf9\x8b\x1d\x80\x55\x41\x43\x10\x48\x81\xd2\x7d\x66\x06\x08\x58\x93\xb6\x26\x0f\x20\x36\x7e\x11\x96\x6f\x9f\x8e\x50\xc7\x53\xf8\xe5\x15\xd3\x9b\x77\xc5\xf1\xc9\xbd\xad\x5c\x72\xc8\x56\xa2\xc1\x9c\xfc\x3b\x37\xd6\x7f\x03\x00\x24\x81\xb3\xd6\x72\x07\x00\x00"),
 		},
 		"/bigquerydatatransfer.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigquerydatatransfer.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1680,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe3\x36\x13\xc6\xef\xfa\x14\x03\xfb\xf2\xbe\x40\x22\x25\x39\xaa\x28\x0a\xc7\xc9\xee\x0a\xcd\xda\xad\xed\x74\xb1\x27\x63\x4c\x8d\x24\x36\x14\xc9\x25\x47\xf6\x0a\xaa\xbf\x7b\xa1\x7f\x5e\x7b\x93\x00\xf5\x49\x18\x0e\x9f\xf9\xcd\x33\x43\x4f\x61\x6e\x6c\xed\x64\x5e\x30\xdc\xdd\xdc\xdd\xc1\x47\x63\x72\x45\xf0\xf4\x34\x0f\xa6\xc1\x14\x9e\xa4\x20\xed\x29\x85\x4a\xa7\xe4\x80\x0b\x82\x99\x45\x51\xd0\x78\x72\x05\x7f\x91\xf3\xd2\x68\xb8\x0b\x6f\xe0\x7f\x6d\xc2\x64\x38\x9a\xfc\xff\x97\x60\x0a\xb5\xa9\xa0\xc4\x1a\xb4\x61\xa8\x3c\x01\x17\xd2\x43\x26\x15\x01\x7d\x17\x64\x19\xa4\x06\x61\x4a\xab\x24\x6a\x41\x70\x90\x5c\x74\x65\x06\x91\x30\x98\xc2\xd7\x41\xc2\xec\x18\xa5\x06\x04\x61\x6c\x0d\x26\x3b\xcf\x03\xe4\x0e\xb8\xfb\x15\xcc\x36\x8e\xa2\xc3\xe1\x10\x62\x47\x1b\x1a\x97\x47\xaa\xcf\xf4\xd1\x53\x32\x7f\x5c\xac\x1f\xaf\xef\xc2\x9b\xee\xce\xb3\x56\xe4\x3d\x38\xfa\x56\x49\x47\x29\xec\x6a\x40\x6b\x95\x14\xb8\x53\x04\x0a\x0f\x60\x1c\x60\xee\x88\x52\x60\xd3\x02\x1f\x9c\x64\xa9\xf3\x2b\xf0\x26\xe3\x03\x3a\x0a\xa6\x90\x4a\xcf\x4e\xee\x2a\xbe\x70\x6b\xc4\x93\xfe\x22\xc1\x68\x40\x0d\x93\xd9\x1a\x92\xf5\x04\xee\x67\xeb\x64\x7d\x15\x4c\xe1\x4b\xb2\xf9\xb4\x7c\xde\xc0\x97\xd9\x6a\x35\x5b\x6c\x92\xc7\x35\x2c\x57\x30\x5f\x2e\x1e\x92\x4d\xb2\x5c\xac\x61\xf9\x01\x66\x8b\xaf\xf0\x7b\xb2\x78\xb8\x02\x92\x5c\x90\x03\xfa\x6e\x5d\xcb\x6f\x1c\xc8\xd6\x47\x4a\x5b\xd3\xd6\x44\x17\x00\x99\xe9\x81\xbc\x25\x21\x33\x29\x40\xa1\xce\x2b\xcc\x09\x72\xb3\x27\xa7\xa5\xce\xc1\x92\x2b\xa5\x6f\xa7\xe9\x01\x75\x1a\x4c\x41\xc9\x52\x32\x72\x17\x79\xd5\x54\x18\x04\x68\xe5\x30\xff\x18\x84\x71\x14\x0a\xed\xca\x50\x28\x53\xa5\x61\xde\xad\x52\x28\x4c\x19\xed\x6f\x51\xd9\x02\x6f\x83\x17\xa9\xd3\x18\xd6\xe4\xf6\x52\xd0\x67\xb4\x56\xea\x3c\x28\x89\x31\x45\xc6\x38\x00\xd0\x58\x52\x0c\x3b\x99\x7f\xab\xc8\xd5\x6d\x94\x1d\x6a\x9f\x91\x7b\x5b\x7a\xb8\xe2\x2d\x0a\x8a\xa1\x4d\xb9\xf6\xb5\x67\x2a\x83\xb6\xd1\x1f\x8a\xf7\x32\xff\xb3\x55\x7c\x40\xc6\xcd\xa0\x18\x00\xec\x47\xf8\xfd\xed\x8e\x18\x6f\x03\x00\xdf\xc3\x7d\x32\x9e\x17\xef\xc3\xf4\x04\x68\xa5\x1f\x28\x1c\x79\x53\x39\x41\xbe\xad\x09\x70\x3d\xd4\xed\xf3\xb6\xa3\xc6\xb6\x15\xd9\x8e\x2a\x5b\x61\x74\x26\xf3\xa0\xdf\xda\xde\x9b\xb7\x40\xe7\xe7\x69\x58\xb1\xf9\x48\x9a\x1c\x32\xa5\x31\xb0\xab\x68\x38\x91\xe9\x86\x4a\xab\x90\x29\x86\x49\xd3\xb4\x00\xc7\xe3\xe4\xd5\xe1\x1c\xf5\x3d\x3d\x7b\x4a\x37\xe6\x33\xb2\x28\x56\x03\x79\xdf\x6d\x86\xca\x8f\x82\x63\x4f\xb3\x3d\x4a\xd5\x3e\x86\x44\xcf\xbc\x27\x4e\xf4\x9e\x34\x1b\x57\x5f\xa6\xb7\xce\x91\x3b\xb1\x25\x0f\x1f\x24\xa9\x34\xee\x9c\xf8\x49\x31\x79\x88\x87\x08\x00\xa3\xcb\x89\x5f\xe7\x02\xec\x51\x55\x74\xd6\x93\x75\xe6\x6f\x12\xec\xa3\xa6\x19\x3e\x8f\xc7\x48\x19\xd1\x2f\x68\xd4\x34\xe3\xf7\xf1\x18\xf1\x85\x77\xfe\xb7\x61\xac\x33\x21\x4c\xa5\xbb\xc9\xfe\xda\x34\x43\x70\x8b\x7d\x74\xdb\x5b\x16\x35\x4d\x57\xf9\x87\x79\x85\x24\x87\x4e\x14\x52\xa0\x5a\x51\x46\x8e\xf4\x69\xd2\xfd\xb4\xb9\xb6\x14\xc3\x40\x75\x8a\x03\xbc\x50\x7d\x0a\xaf\x28\xfb\xc9\x86\x77\xb4\xb2\xc1\x8c\xff\x2a\xd7\xfe\x52\xf2\xc2\x49\xcb\xdd\x36\xff\x73\x7d\x76\x02\xb0\x29\x68\xbc\x04\x5c\x20\xf7\x7f\xc2\x23\x04\xec\x48\x19\x9d\x7b\x60\x13\x9e\x5d\xcb\xf7\x2f\xf1\x85\x4a\xbf\x9f\x7f\xbc\x62\x7a\xf3\x15\x9d\xe9\x38\x53\xd9\xf8\x54\xad\x44\x8d\xf9\xbb\xaf\xf9\xdf\x00\x00\x00\xff\xff\x75\x54\x91\x32\x90\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe3\x36\x10\xc5\xef\xfa\x14\x0f\xf6\xa5\x05\x12\x39\xc9\x51\x45\x51\x38\x4e\x76\x57\x68\xd6\x69\x6d\xa7\x8b\x3d\x19\x63\x6a\x24\xb1\xa1\x48\x2e\x49\xd9\x6b\xb8\xfe\xee\x85\xfe\xc5\xc9\x26\x01\xd6\xf2\x41\x18\xce\x3c\xfe\xe6\x0d\xa9\x31\x66\xc6\xee\x9d\x2c\xca\x80\xab\x8b\xab\x2b\x7c\x34\xa6\x50\x8c\xbb\xbb\x59\x34\x8e\xc6\xb8\x93\x82\xb5\xe7\x0c\xb5\xce\xd8\x21\x94\x8c\xa9\x25\x51\xf2\xb0\x72\x86\x7f\xd8\x79\x69\x34\xae\xe2\x0b\xfc\xd2\x24\x8c\xfa\xa5\xd1\xaf\xbf\x45\x63\xec\x4d\x8d\x8a\xf6\xd0\x26\xa0\xf6\x8c\x50\x4a\x8f\x5c\x2a\x06\x7f\x17\x6c\x03\xa4\x86\x30\x95\x55\x92\xb4\x60\xec\x64\x28\x11\x4e\xfa\x71\x34\xc6\xd7\x5e\xc2\x6c\x02\x49\x0d\x82\x30\x76\x0f\x93\x3f\xcf\x03\x85\x16\xb8\xfd\x95\x21\xd8\x64\x32\xd9\xed\x76\x31\xb5\xb4\xb1\x71\xc5\x44\x75\x99\x7e\x72\x97\xce\x6e\xe7\xcb\xdb\xf3\xab\xf8\xa2\xad\x79\xd0\x8a\xbd\x87\xe3\x6f\xb5\x74\x9c\x61\xb3\x07\x59\xab\xa4\xa0\x8d\x62\x28\xda\xc1\x38\x50\xe1\x98\x33\x04\xd3\x00\xef\x9c\x0c\x52\x17\x67\xf0\x26\x0f\x3b\x72\x1c\x8d\x91\x49\x1f\x9c\xdc\xd4\xe1\x85\x5b\x03\x9e\xf4\x2f\x12\x8c\x06\x69\x8c\xa6\x4b\xa4\xcb\x11\xae\xa7\xcb\x74\x79\x16\x8d\xf1\x25\x5d\x7d\xba\x7f\x58\xe1\xcb\x74\xb1\x98\xce\x57\xe9\xed\x12\xf7\x0b\xcc\xee\xe7\x37\xe9\x2a\xbd\x9f\x2f\x71\xff\x01\xd3\xf9\x57\xfc\x99\xce\x6f\xce\xc0\x32\x94\xec\xc0\xdf\xad\x6b\xf8\x8d\x83\x6c\x7c\xe4\xac\x31\x6d\xc9\xfc\x02\x20\x37\xdd\xf8\xbc\x65\x21\x73\x29\xa0\x48\x17\x35\x15\x8c\xc2\x6c\xd9\x69\xa9\x0b\x58\x76\x95\xf4\xcd\x34\x3d\x48\x67\xd1\x18\x4a\x56\x32\x50\x68\x23\xaf\x9a\x8a\xa3\x88\xac\xec\xe7\x9f\x40\x18\xc7\xb1\xd0\xae\x8a\x85\x32\x75\x16\x17\xed\x51\x8a\x85\xa9\x26\xdb\x4b\x52\xb6\xa4\xcb\xe8\x51\xea\x2c\xc1\x92\xdd\x56\x0a\xfe\x4c\xd6\x4a\x5d\x44\x15\x07\xca\x28\x50\x12\x01\x9a\x2a\x4e\xb0\x91\xc5\xb7\x9a\xdd\xbe\x89\x06\x47\xda\xe7\xec\xde\x96\xee\x4b\xbc\x25\xc1\x09\x9a\x94\x73\xbf\xf7\x81\xab\xa8\x69\xf4\xa4\x78\x2d\x8b\xbf\x1b\xc5\x1b\x0a\xb4\xea\x15\x23\x60\x3b\xc0\x6f\x2f\x37\x1c\xe8\x32\x02\x7c\x07\xf7\xc9\xf8\x30\x7f\x1f\xa6\x23\x20\x2b\x7d\x4f\xe1\xd8\x9b\xda\x09\xf6\xcd\x9e\xc0\x79\xbf\x6f\x97\xb7\x1e\x34\xd6\x4d\x47\xeb\x41\x65\x2d\x8c\xce\x65\xd1\x16\x00\x9d\x37\x6f\x81\xce\x9e\xa7\x51\x1d\xcc\x47\xd6\xec\x28\x70\x96\x20\xb8\x9a\xfb\x15\x99\xad\xb8\xb2\x8a\x02\x27\x18\x1d\x0e\x0d\xc0\xf1\x38\x7a\xb5\x38\x23\x7d\xcd\x0f\x9e\xb3\x95\xf9\x4c\x41\x94\x8b\x9e\xbc\xeb\x36\x27\xe5\x07\xc1\xa1\xa7\xe9\x96\xa4\x6a\x2e\x43\xaa\xa7\xde\x73\x48\xf5\x96\x75\x30\x6e\xff\x32\xbd\x71\x8e\xdd\x13\x5b\x7a\xf3\x41\xb2\xca\x92\xd6\x89\x1f\x14\xd3\x9b\xa4\x8f\x00\x81\x5c\xc1\xe1\x75\x2e\xb0\x25\x55\xf3\xb3\x9e\xac\x33\xff\xb2\x08\x7e\x72\x38\xf4\xaf\xc7\xe3\x44\x19\xd1\x1d\xd0\xc9\xe1\x30\xbc\x1f\x8f\x93\xc1\xe3\xce\x3b\xff\x47\x3f\xd6\xa9\x10\xa6\xd6\xed\x64\x7f\x3f\x1c\xfa\xe0\x9a\xba\xe8\xba\x21\x3d\x1e\x27\x87\x43\xbb\xf3\xc9\xbc\x52\xb2\x23\x27\x4a\x29\x48\x2d\x38\x67\xc7\xfa\x69\xd2\xcd\xff\x1c\x61\x6f\x39\x41\x4f\xf5\x14\x07\x1e\x79\xff\x14\x5e\x70\xfe\x83\x0d\xef\x68\xe5\xbd\x19\x3f\x2b\xd7\x3c\x19\x7b\xe1\xa4\x6d\xba\x4f\xf0\xdf\xf9\xb3\x15\x60\x55\xf2\x50\x84\x50\x52\xe8\x3e\xc2\x03\x04\x36\xac\x8c\x2e\x3c\x82\x89\x9f\x95\x15\xdb\xc7\x13\xd4\xe9\x7c\xfe\xf5\x8a\xe9\xcd\x5b\x74\x7a\x0a\x67\x6a\x9b\x3c\xed\x56\x91\xa6\xe2\xdd\xdb\xfc\xff\x00\x75\x54\x91\x32\x90\x06\x00\x00"),
 		},
 		"/bigqueryreservation.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigqueryreservation.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2420,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xc1\x6e\xe3\x46\x0c\x86\xef\x7a\x0a\xc2\xbe\xb4\x40\x22\x6f\x72\x54\x4f\x8e\x93\xdd\x15\x9a\x75\x5a\xdb\xe9\x62\x4f\x01\x3d\xa2\x25\x36\xa3\x99\xd9\x19\xca\x5e\x21\xf5\xbb\x17\xb2\xa4\xc4\x8e\x9d\x76\x2f\x2d\x7a\xa8\x0e\x86\x30\x43\xfe\xfc\x44\xfe\xf4\x10\x26\xd6\xd5\x9e\xf3\x42\xe0\xf2\xdd\xe5\x25\x7c\xb0\x36\xd7\x04\xb7\xb7\x93\x68\x18\x0d\xe1\x96\x15\x99\x40\x19\x54\x26\x23\x0f\x52\x10\x8c\x1d\xaa\x82\xfa\x9b\x33\xf8\x8d\x7c\x60\x6b\xe0\x32\x7e\x07\x3f\x34\x01\x83\xee\x6a\xf0\xe3\x4f\xd1\x10\x6a\x5b\x41\x89\x35\x18\x2b\x50\x05\x02\x29\x38\xc0\x8a\x35\x01\x7d\x53\xe4\x04\xd8\x80\xb2\xa5\xd3\x8c\x46\x11\x6c\x58\x8a\x5d\x99\x4e\x24\x8e\x86\xf0\xa5\x93\xb0\x4b\x41\x36\x80\xa0\xac\xab\xc1\xae\xf6\xe3\x00\x65\x07\xbc\x7b\x0a\x11\x97\x8c\x46\x9b\xcd\x26\xc6\x1d\x6d\x6c\x7d\x3e\xd2\x6d\x64\x18\xdd\xa6\x93\x9b\xe9\xfc\xe6\xfc\x32\x7e\xb7\xcb\xb9\x37\x9a\x42\x00\x4f\x5f\x2b\xf6\x94\xc1\xb2\x06\x74\x4e\xb3\xc2\xa5\x26\xd0\xb8\x01\xeb\x01\x73\x4f\x94\x81\xd8\x06\x78\xe3\x59\xd8\xe4\x67\x10\xec\x4a\x36\xe8\x29\x1a\x42\xc6\x41\x3c\x2f\x2b\x39\xe8\x56\x8f\xc7\xe1\x20\xc0\x1a\x40\x03\x83\xf1\x1c\xd2\xf9\x00\xae\xc6\xf3\x74\x7e\x16\x0d\xe1\x73\xba\xf8\x78\x77\xbf\x80\xcf\xe3\xd9\x6c\x3c\x5d\xa4\x37\x73\xb8\x9b\xc1\xe4\x6e\x7a\x9d\x2e\xd2\xbb\xe9\x1c\xee\xde\xc3\x78\xfa\x05\x7e\x4e\xa7\xd7\x67\x40\x2c\x05\x79\xa0\x6f\xce\x37\xfc\xd6\x03\x37\x7d\xa4\xac\x69\xda\x9c\xe8\x00\x60\x65\x5b\xa0\xe0\x48\xf1\x8a\x15\x68\x34\x79\x85\x39\x41\x6e\xd7\xe4\x0d\x9b\x1c\x1c\xf9\x92\x43\x33\xcd\x00\x68\xb2\x68\x08\x9a\x4b\x16\x94\xdd\xc9\xd1\x47\xc5\x51\x84\x8e\xbb\xf9\x27\xa0\xac\xa7\x58\x19\x5f\xc6\x4a\xdb\x2a\x8b\xf3\x9d\x95\x62\x65\xcb\xd1\xfa\x02\xb5\x2b\xf0\x22\x7a\x64\x93\x25\x30\x27\xbf\x66\x45\x9f\xd0\x39\x36\x79\x54\x92\x60\x86\x82\x49\x04\x60\xb0\xa4\x04\x96\x9c\x7f\xad\xc8\xd7\x9e\x02\xf9\xf5\xae\xfe\x69\xe5\x2e\x23\x38\x54\x94\x40\x13\x72\x1e\xea\x20\x54\x46\xcd\x77\xbe\x08\x5e\x71\xfe\x6b\x23\x38\x7b\x11\x8c\x00\xd6\x3d\xfa\xfa\x62\x49\x82\x17\x11\x40\x68\xd1\x3e\xda\x20\xd3\x37\x51\xda\xfa\xe8\x38\x74\x0c\x9e\x82\xad\xbc\xa2\xd0\x54\x04\x38\xef\xaa\xb6\x71\x0f\xbd\xc4\x83\x6a\xdc\xc8\x52\x3f\x28\x5b\x96\x2c\x25\x19\x89\x5a\xc7\xb6\x7d\x39\x41\x39\xe9\x52\x26\xaf\x33\xb0\x12\xfb\x81\x0c\x79\x14\xca\x12\x10\x5f\x51\x77\xc3\xd9\x82\x4a\xa7\x51\x28\x81\x81\xf3\xf6\x77\x52\x12\x46\x4f\x4f\xdd\xeb\x76\x3b\xd2\x56\xb5\x33\x1d\x3d\x3d\xf5\xef\xdb\xed\x48\x1d\xd5\x6a\x02\x4e\x40\x3f\x70\xb6\xdd\x0e\x8e\xca\x4d\xd0\x5c\xd1\x7d\xa0\x6c\x61\x3f\xa1\xa8\x62\xd6\x75\xa5\x6d\xe4\x0a\x75\xe8\x11\xfb\x7e\x8d\xd7\xc8\xba\xd9\xb2\xd4\x8c\x43\x20\x49\xcd\x9a\x8c\x58\x5f\x1f\x86\xf7\x06\xe9\x0c\x93\x74\xc7\xfd\x70\x4f\x13\xbe\x2a\x95\x5e\xbf\xa4\x09\xfa\x9c\xe4\x3d\x93\xce\xfe\x26\xbb\x60\xf2\xe8\x55\xc1\x0a\xf5\x8c\x56\xe4\xc9\x3c\x4f\xb9\x9d\xb4\xd4\x8e\x12\xe8\x7a\xfb\x7c\x0e\xf0\x48\xf5\xf3\xf1\x8c\x56\xaf\x68\xde\xd0\x5a\x75\x4c\xdf\x2b\xd7\x3c\x19\x05\xe5\xd9\xc9\xce\xc8\x7f\x9c\xef\xdd\x00\x2c\x0a\xea\x93\x40\x0a\x94\xf6\xdf\xb7\x87\x80\x25\x69\x6b\xf2\x00\x62\xe3\xbd\xb4\x7c\xfd\x98\x1c\xa8\xb4\xe6\xfc\xe5\x88\xe9\xe4\x02\xed\xe9\x78\x5b\xb9\xe4\xb9\x5a\x89\x06\x73\xf2\x6f\xee\xf1\xdb\x6b\xe3\x0f\x96\xf6\x2f\xd7\x65\x76\x14\xfa\x0f\xec\xc9\x1e\x4f\x73\xd3\x40\xff\x97\xd6\xa1\xf9\xfd\x5e\xf3\xef\xc5\xfe\x6f\xf5\x7f\xc9\xea\x7f\x06\x00\x00\xff\xff\xcd\x46\xa4\xb4\x74\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xdf\x6f\xe2\x46\x10\xc7\xdf\xfd\x57\x8c\xe0\xa5\x95\x12\x93\xe4\xd1\x7d\x22\x24\x77\x67\x35\x07\x2d\x90\x9e\xee\x29\x1a\xd6\x83\x3d\x8d\xbd\xbb\xb7\x3b\x86\xb3\xae\xfc\xef\xd5\x82\x1d\x48\x00\x5d\x5f\x5a\xf5\xe1\x30\x42\xd6\xce\xcc\x77\x3e\x3b\x3f\xe8\xc3\xc8\xd8\xc6\x71\x5e\x08\xdc\x5c\xdd\xdc\xc0\x7b\x63\xf2\x92\xe0\xe1\x61\x14\xf5\xa3\x3e\x3c\xb0\x22\xed\x29\x83\x5a\x67\xe4\x40\x0a\x82\xa1\x45\x55\x50\x67\xb9\x80\x3f\xc8\x79\x36\x1a\x6e\xe2\x2b\xf8\x29\x38\xf4\x5a\x53\xef\xe7\x5f\xa2\x3e\x34\xa6\x86\x0a\x1b\xd0\x46\xa0\xf6\x04\x52\xb0\x87\x25\x97\x04\xf4\x55\x91\x15\x60\x0d\xca\x54\xb6\x64\xd4\x8a\x60\xcd\x52\x80\xec\xf5\xe3\xa8\x0f\x9f\x5b\x09\xb3\x10\x64\x0d\x08\xca\xd8\x06\xcc\xf2\xd0\x0f\x50\xb6\xc0\xdb\x4f\x21\x62\x93\xc1\x60\xbd\x5e\xc7\xb8\xa5\x8d\x8d\xcb\x07\xe5\xce\xd3\x0f\x1e\xd2\xd1\xfd\x78\x76\x7f\x79\x13\x5f\x6d\x63\x1e\x75\x49\xde\x83\xa3\x2f\x35\x3b\xca\x60\xd1\x00\x5a\x5b\xb2\xc2\x45\x49\x50\xe2\x1a\x8c\x03\xcc\x1d\x51\x06\x62\x02\xf0\xda\xb1\xb0\xce\x2f\xc0\x9b\xa5\xac\xd1\x51\xd4\x87\x8c\xbd\x38\x5e\xd4\xf2\xaa\x5a\x1d\x1e\xfb\x57\x0e\x46\x03\x6a\xe8\x0d\x67\x90\xce\x7a\x70\x3b\x9c\xa5\xb3\x8b\xa8\x0f\x9f\xd2\xf9\x87\xc9\xe3\x1c\x3e\x0d\xa7\xd3\xe1\x78\x9e\xde\xcf\x60\x32\x85\xd1\x64\x7c\x97\xce\xd3\xc9\x78\x06\x93\x77\x30\x1c\x7f\x86\x5f\xd3\xf1\xdd\x05\x10\x4b\x41\x0e\xe8\xab\x75\x81\xdf\x38\xe0\x50\x47\xca\x42\xd1\x66\x44\xaf\x00\x96\x66\xd7\x3e\x6f\x49\xf1\x92\x15\x94\xa8\xf3\x1a\x73\x82\xdc\xac\xc8\x69\xd6\x39\x58\x72\x15\xfb\xd0\x4d\x0f\xa8\xb3\xa8\x0f\x25\x57\x2c\x28\xdb\x93\xa3\x4b\xc5\x51\x84\x96\xdb\xfe\x27\xa0\x8c\xa3\x58\x69\x57\xc5\xaa\x34\x75\x16\xe7\xdb\x51\x8a\x95\xa9\x06\xab\x6b\x2c\x6d\x81\xd7\xd1\x33\xeb\x2c\x81\x19\xb9\x15\x2b\xfa\x88\xd6\xb2\xce\xa3\x8a\x04\x33\x14\x4c\x22\x00\x8d\x15\x25\xb0\xe0\xfc\x4b\x4d\xae\x71\xe4\xc9\xad\xb6\xf9\x4f\x2b\xb7\x11\xde\xa2\xa2\x04\x82\xcb\xa5\x6f\xbc\x50\x15\x85\x7b\xee\x05\x6f\x39\xff\x3d\x08\x4e\xf7\x82\x11\xc0\xaa\x43\x5f\x5d\x2f\x48\xf0\x3a\x02\x08\x66\x56\xf4\xc1\x78\x19\x9f\x45\xd9\xe5\x47\xcb\xbe\x65\x70\xe4\x4d\xed\x14\xf9\x90\x11\xe0\xb2\xcd\xba\xf3\x7b\xea\x24\x9e\x54\x98\x46\x96\xe6\x49\x99\xaa\x62\xa9\x48\xcb\xd6\x1f\x60\x57\x97\x13\x94\xa3\x36\x64\xf4\x36\x02\x6b\x31\xef\x49\x93\x43\xa1\x2c\x01\x71\x35\xb5\x16\xce\xe6\x54\xd9\x12\x85\x12\xe8\x59\x67\xfe\x24\x25\x7e\xf0\xed\x5b\xfb\xba\xd9\x0c\x4a\xa3\xb6\xe2\xe1\xb4\x7b\xdf\x6c\x06\xea\x28\x57\x70\x38\x01\xfd\xc4\xd9\x66\xd3\x3b\x4a\x37\x42\x7d\x4b\x8f\x9e\xb2\xb9\xf9\x88\xa2\x8a\x69\x5b\x95\x5d\x21\x97\x58\xfa\x0e\xb1\xab\xd7\x70\x85\x5c\x86\x2d\x4b\xf5\xd0\x7b\x92\x54\xaf\x48\x8b\x71\xcd\x6b\xf7\x6e\x40\xda\x81\x49\xda\xe3\xae\xb9\xa7\x09\xdf\xa4\x4a\xef\xf6\x61\x82\x2e\x27\x79\xc7\x54\x66\xdf\x89\x2e\x98\x1c\x3a\x55\xb0\xc2\x72\x4a\x4b\x72\xa4\x5f\xba\x1c\xbe\x97\x20\x8d\xa5\x04\xda\xda\xbe\x9c\x03\x3c\x53\xf3\x72\x3c\xa5\xe5\x1b\x9a\x33\x5a\xcb\x96\xe9\x9f\xca\x85\x27\x23\xaf\x1c\xdb\xd0\xc3\x04\xfe\xba\x3c\xb0\x00\xcc\x0b\xea\x82\x40\x0a\x94\xdd\xbf\x6f\x07\x01\x0b\x2a\x8d\xce\x3d\x88\x89\x0f\xc2\xf2\xd5\xf3\x1e\x6a\x3f\x9c\xbf\x1d\x31\x9d\x5c\xa0\xfd\x93\x3b\x53\xdb\xe4\x25\x5b\x85\x1a\x73\x72\x67\xf7\xf8\xfc\xda\x1c\xac\xde\xf7\xd6\x65\x7a\xe4\xfa\x2f\xec\xc9\x01\x4f\xb0\x04\xe8\xff\xd3\x3a\x84\xdf\x37\xc2\x67\x87\xff\xc0\xf7\xc7\xa8\xff\x47\xa3\xfe\xf7\x00\xcd\x46\xa4\xb4\x74\x09\x00\x00"),
 		},
 		"/bigtable.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "bigtable.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1581,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4d\x6f\xe2\x48\x10\x86\xef\xfe\x15\xaf\xe0\xb2\x2b\x25\x26\xe1\xe8\x3d\x11\x92\x99\xb1\x36\x03\x23\x20\x3b\x9a\x53\x54\xb4\x0b\xbb\x37\x76\x77\x6f\x77\x19\x06\xcd\xf2\xdf\x57\xc6\x36\x03\x93\x44\x5a\x9f\xac\xfa\x78\xeb\xa9\x0f\x7b\x88\xa9\x75\x7b\xaf\xf3\x42\x30\xbe\x19\x8f\xf1\xd1\xda\xbc\x64\x3c\x3e\x4e\xa3\x61\x34\xc4\xa3\x56\x6c\x02\x67\xa8\x4d\xc6\x1e\x52\x30\x26\x8e\x54\xc1\xbd\xe7\x0a\x7f\xb1\x0f\xda\x1a\x8c\xe3\x1b\xfc\xd6\x04\x0c\x3a\xd7\xe0\xf7\x3f\xa2\x21\xf6\xb6\x46\x45\x7b\x18\x2b\xa8\x03\x43\x0a\x1d\xb0\xd1\x25\x83\xbf\x2b\x76\x02\x6d\xa0\x6c\xe5\x4a\x4d\x46\x31\x76\x5a\x8a\x63\x99\x4e\x24\x8e\x86\xf8\xd6\x49\xd8\xb5\x90\x36\x20\x28\xeb\xf6\xb0\x9b\xf3\x38\x90\x1c\x81\x8f\x4f\x21\xe2\x92\xd1\x68\xb7\xdb\xc5\x74\xa4\x8d\xad\xcf\x47\x65\x1b\x19\x46\x8f\xe9\xf4\x61\xb6\x7c\xb8\x1e\xc7\x37\xc7\x9c\x27\x53\x72\x08\xf0\xfc\x4f\xad\x3d\x67\x58\xef\x41\xce\x95\x5a\xd1\xba\x64\x94\xb4\x83\xf5\xa0\xdc\x33\x67\x10\xdb\x00\xef\xbc\x16\x6d\xf2\x2b\x04\xbb\x91\x1d\x79\x8e\x86\xc8\x74\x10\xaf\xd7\xb5\x5c\x4c\xab\xc7\xd3\xe1\x22\xc0\x1a\x90\xc1\x60\xb2\x44\xba\x1c\xe0\x6e\xb2\x4c\x97\x57\xd1\x10\x5f\xd3\xd5\xa7\xf9\xd3\x0a\x5f\x27\x8b\xc5\x64\xb6\x4a\x1f\x96\x98\x2f\x30\x9d\xcf\xee\xd3\x55\x3a\x9f\x2d\x31\xff\x80\xc9\xec\x1b\xfe\x4c\x67\xf7\x57\x60\x2d\x05\x7b\xf0\x77\xe7\x1b\x7e\xeb\xa1\x9b\x39\x72\xd6\x0c\x6d\xc9\x7c\x01\xb0\xb1\x2d\x50\x70\xac\xf4\x46\x2b\x94\x64\xf2\x9a\x72\x46\x6e\xb7\xec\x8d\x36\x39\x1c\xfb\x4a\x87\x66\x9b\x01\x64\xb2\x68\x88\x52\x57\x5a\x48\x8e\x96\x57\x4d\xc5\x51\x44\x4e\x77\xfb\x4f\xa0\xac\xe7\x58\x19\x5f\xc5\xaa\xb4\x75\x16\xe7\xc7\x53\x8a\x95\xad\x46\xdb\x5b\x2a\x5d\x41\xb7\xd1\x8b\x36\x59\x82\x25\xfb\xad\x56\xfc\x99\x9c\xd3\x26\x8f\x2a\x16\xca\x48\x28\x89\x00\x43\x15\x27\x58\xeb\x5c\x9a\xe1\xbf\x2d\xd7\x85\x05\x47\x8a\x13\x34\x21\xd7\x61\x1f\x84\xab\xa8\x69\xee\xa7\xca\x5d\xa7\x12\x01\xdb\x1e\x72\x7b\xbb\x66\xa1\xdb\x08\x08\x2d\xc4\x27\x1b\x64\x76\x59\xb4\xad\x44\x4e\x87\xae\x9a\xe7\x60\x6b\xaf\x38\x34\xda\xc0\x75\xa7\xdf\xc6\x3d\xf7\x79\xcf\xe4\xdc\xb3\xf3\xb6\x39\xee\xa8\x3d\xc4\xb6\xdd\x9e\x63\xe2\xdc\x97\x0b\x37\xd5\x62\x3f\xb2\x61\x4f\xc2\x59\x02\xf1\x75\xef\xd1\xd9\x8a\x2b\x57\x92\x70\x82\x81\xf3\xf6\x6f\x56\x12\x46\x3f\x7e\x74\xaf\x87\xc3\x48\x9b\x20\xcd\x17\xd3\x58\xfb\xf7\xc3\x61\x44\xa7\x1a\x8d\xe3\x8c\xe8\x59\x67\x87\xc3\xe0\x95\xfc\x94\xcc\x1d\x3f\x05\xce\x56\xf6\x33\x89\x2a\x16\x5d\xab\xed\x48\x36\x54\x86\x1e\xa9\x1f\xc2\x64\x4b\xba\x6c\xda\x49\xcd\x24\x04\x96\xd4\x6c\xd9\x88\xf5\xfb\xcb\xf0\x7e\xa9\xdd\x92\x93\xce\xdc\xef\xe6\x92\xec\x97\x12\xe9\xfd\xcf\x70\x21\x9f\xb3\x7c\xd0\x5c\x66\xef\x64\x15\x9a\x3d\x79\x55\x68\x45\xe5\x82\x37\xec\xd9\x9c\x56\xd5\xae\x4b\xf6\x8e\x13\x74\xb3\x3b\xd9\x81\x17\xde\x9f\xcc\x0b\xde\xfc\x42\xf1\x8e\xd6\xa6\x63\xf9\xbf\x72\xcd\x93\x71\x50\x5e\x3b\x39\x9e\xe0\xbf\xd7\x67\x1e\x60\x55\x70\x9f\x04\x29\x48\xda\x3f\x64\x0f\x81\x35\x97\xd6\xe4\x01\x62\xe3\xb3\xb4\x7c\xfb\x92\x5c\xa8\xb4\x97\xf6\xe5\x15\xd3\x9b\xa7\x7f\xa6\xe3\x6d\xed\x92\x53\xb5\x8a\x0c\xe5\xec\xdf\xf9\xec\xfe\x0b\x00\x00\xff\xff\x30\xaa\x7a\xdb\x2d\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xe2\x48\x10\xbd\xfb\x57\x3c\xc1\x65\x57\x4a\x4c\xc2\xd1\x7b\x22\x24\x33\x63\x6d\x06\x46\x40\x76\x34\xa7\xa8\x68\x17\x76\x6f\xec\xee\xde\xee\x32\x8c\x35\xcb\x7f\x5f\x19\x6c\x08\x93\x89\xb4\xee\x4b\xab\xea\xd5\xab\x57\x1f\xed\x21\xa6\xd6\x35\x5e\xe7\x85\x60\x7c\x33\x1e\xe3\xa3\xb5\x79\xc9\x78\x7c\x9c\x46\xc3\x68\x88\x47\xad\xd8\x04\xce\x50\x9b\x8c\x3d\xa4\x60\x4c\x1c\xa9\x82\x7b\xcf\x15\xfe\x62\x1f\xb4\x35\x18\xc7\x37\xf8\xad\x05\x0c\x3a\xd7\xe0\xf7\x3f\xa2\x21\x1a\x5b\xa3\xa2\x06\xc6\x0a\xea\xc0\x90\x42\x07\x6c\x74\xc9\xe0\xef\x8a\x9d\x40\x1b\x28\x5b\xb9\x52\x93\x51\x8c\x9d\x96\x02\x72\xe6\x8f\xa3\x21\xbe\x75\x14\x76\x2d\xa4\x0d\x08\xca\xba\x06\x76\xf3\x1a\x07\x92\x83\xe0\xc3\x57\x88\xb8\x64\x34\xda\xed\x76\x31\x1d\xd4\xc6\xd6\xe7\xa3\xf2\x88\x0c\xa3\xc7\x74\xfa\x30\x5b\x3e\x5c\x8f\xe3\x9b\x43\xcc\x93\x29\x39\x04\x78\xfe\xa7\xd6\x9e\x33\xac\x1b\x90\x73\xa5\x56\xb4\x2e\x19\x25\xed\x60\x3d\x28\xf7\xcc\x19\xc4\xb6\x82\x77\x5e\x8b\x36\xf9\x15\x82\xdd\xc8\x8e\x3c\x47\x43\x64\x3a\x88\xd7\xeb\x5a\x2e\xba\xd5\xcb\xd3\xe1\x02\x60\x0d\xc8\x60\x30\x59\x22\x5d\x0e\x70\x37\x59\xa6\xcb\xab\x68\x88\xaf\xe9\xea\xd3\xfc\x69\x85\xaf\x93\xc5\x62\x32\x5b\xa5\x0f\x4b\xcc\x17\x98\xce\x67\xf7\xe9\x2a\x9d\xcf\x96\x98\x7f\xc0\x64\xf6\x0d\x7f\xa6\xb3\xfb\x2b\xb0\x96\x82\x3d\xf8\xbb\xf3\xad\x7e\xeb\xa1\xdb\x3e\x72\xd6\x36\x6d\xc9\x7c\x21\x60\x63\x8f\xe3\x0b\x8e\x95\xde\x68\x85\x92\x4c\x5e\x53\xce\xc8\xed\x96\xbd\xd1\x26\x87\x63\x5f\xe9\xd0\x4e\x33\x80\x4c\x16\x0d\x51\xea\x4a\x0b\xc9\xc1\xf2\xa6\xa8\x38\x8a\xc8\xe9\x6e\xfe\x09\x94\xf5\x1c\x2b\xe3\xab\x58\x95\xb6\xce\xe2\xfc\xb0\x4a\xb1\xb2\xd5\x68\x7b\x4b\xa5\x2b\xe8\x36\x7a\xd1\x26\x4b\xb0\x64\xbf\xd5\x8a\x3f\x93\x73\xda\xe4\x51\xc5\x42\x19\x09\x25\x11\x60\xa8\xe2\x04\x6b\x9d\x4b\xdb\xfc\x5f\xd3\x75\xb0\xe0\x48\x71\x82\x16\x72\x1d\x9a\x20\x5c\x45\x6d\x71\x67\x96\xbb\x8e\x25\x02\xb6\xbd\xc8\xed\xed\x9a\x85\x6e\x23\x20\x1c\x45\x7c\xb2\x41\x66\x97\x49\x8f\x99\xc8\xe9\xd0\x65\xf3\x1c\x6c\xed\x15\x87\x96\x1b\xb8\xee\xf8\x8f\xb8\xe7\x3e\xee\x99\x9c\x7b\x76\xde\xb6\xcb\x7d\xc0\x01\xc7\x72\x7b\x1d\x13\xe7\xbe\x5c\xb8\xa9\x16\xfb\x91\x0d\x7b\x12\xce\x12\x88\xaf\x7b\x8f\xce\x56\x5c\xb9\x92\x84\x13\x0c\x9c\xb7\x7f\xb3\x92\x30\xfa\xf1\xa3\xbb\xee\xf7\x23\x6d\x82\xb4\x2f\xa6\xb5\xf6\xf7\xfd\x7e\x44\xa7\x1c\xad\xe3\x95\xa2\x67\x9d\xed\xf7\x83\x37\xf4\x53\x32\x77\xfc\x14\x38\x5b\xd9\xcf\x24\xaa\x58\x74\xa5\x1e\x5b\xb2\xa1\x32\xf4\x92\xfa\x26\x4c\xb6\xa4\xcb\xb6\x9c\xd4\x4c\x42\x60\x49\xcd\x96\x8d\x58\xdf\x5c\xc2\xfb\xa1\x76\x43\x4e\x3a\x73\x3f\x9b\x4b\x65\x3f\xa5\x48\xef\xcf\x70\x21\x9f\xb3\x7c\xd0\x5c\x66\xef\x44\x15\x9a\x3d\x79\x55\x68\x45\xe5\x82\x37\xec\xd9\x9c\x46\xd5\x9e\x6b\x48\xe3\x38\x41\xd7\xbb\x93\x1d\x78\xe1\xe6\x64\x5e\xf0\xe6\x27\x15\xef\x70\x6d\x3a\x2d\xff\x97\xae\x3d\x19\x07\xe5\xb5\x6b\xdf\x51\x82\x7f\xaf\x5f\x79\x80\x55\xc1\x7d\x10\xa4\x20\x39\xfe\x21\x7b\x11\x58\x73\x69\x4d\x1e\x20\x36\x7e\x15\x96\x6f\x5f\xce\xa2\xce\x9b\xf6\xe5\x8d\xa6\x5f\xae\xfe\xf9\xe4\xde\xd6\x2e\x39\x65\xab\xc8\x50\xce\xfe\x9d\x67\xf7\xdf\x00\x30\xaa\x7a\xdb\x2d\x06\x00\x00"),
 		},
 		"/billing.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "billing.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1561,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xe2\x48\x14\xbc\xfb\x2b\x4a\x70\xd9\x95\x12\x93\x70\xf4\x9e\x08\xc9\xcc\x58\x9b\x01\x09\xc8\x8e\xe6\x34\x7a\xb4\x1f\x76\x2b\xed\xee\xde\xee\x67\x18\x34\x9b\x7f\x5f\x19\x9b\x0c\x90\x89\x34\x3e\x76\x55\x57\xd5\xab\x7e\x30\xc4\xd4\xf9\x7d\xd0\x65\x25\x18\xdf\x8c\xc7\xf8\xe8\x5c\x69\x18\x8f\x8f\xd3\x64\x98\x0c\xf1\xa8\x15\xdb\xc8\x05\x1a\x5b\x70\x80\x54\x8c\x89\x27\x55\xf1\x11\xb9\xc2\x3f\x1c\xa2\x76\x16\xe3\xf4\x06\x7f\xb4\x84\x41\x0f\x0d\xfe\xfc\x2b\x19\x62\xef\x1a\xd4\xb4\x87\x75\x82\x26\x32\xa4\xd2\x11\x1b\x6d\x18\xfc\x5d\xb1\x17\x68\x0b\xe5\x6a\x6f\x34\x59\xc5\xd8\x69\xa9\x0e\x36\xbd\x48\x9a\x0c\xf1\xb5\x97\x70\x6b\x21\x6d\x41\x50\xce\xef\xe1\x36\xa7\x3c\x90\x1c\x02\x1f\xbe\x4a\xc4\x67\xa3\xd1\x6e\xb7\x4b\xe9\x90\x36\x75\xa1\x1c\x99\x8e\x19\x47\x8f\xf9\xf4\x61\xb6\x7c\xb8\x1e\xa7\x37\x87\x3b\x4f\xd6\x70\x8c\x08\xfc\x6f\xa3\x03\x17\x58\xef\x41\xde\x1b\xad\x68\x6d\x18\x86\x76\x70\x01\x54\x06\xe6\x02\xe2\xda\xc0\xbb\xa0\x45\xdb\xf2\x0a\xd1\x6d\x64\x47\x81\x93\x21\x0a\x1d\x25\xe8\x75\x23\x67\x6d\x1d\xe3\xe9\x78\x46\x70\x16\x64\x31\x98\x2c\x91\x2f\x07\xb8\x9b\x2c\xf3\xe5\x55\x32\xc4\x97\x7c\xf5\x69\xfe\xb4\xc2\x97\xc9\x62\x31\x99\xad\xf2\x87\x25\xe6\x0b\x4c\xe7\xb3\xfb\x7c\x95\xcf\x67\x4b\xcc\x3f\x60\x32\xfb\x8a\xbf\xf3\xd9\xfd\x15\x58\x4b\xc5\x01\xfc\xdd\x87\x36\xbf\x0b\xd0\x6d\x8f\x5c\xb4\xa5\x2d\x99\xcf\x02\x6c\x5c\x17\x28\x7a\x56\x7a\xa3\x15\x0c\xd9\xb2\xa1\x92\x51\xba\x2d\x07\xab\x6d\x09\xcf\xa1\xd6\xb1\x7d\xcd\x08\xb2\x45\x32\x84\xd1\xb5\x16\x92\xc3\xc9\x9b\xa1\xd2\x24\x21\xaf\xfb\xf7\xcf\xa0\x5c\xe0\x54\xd9\x50\xa7\xca\xb8\xa6\x48\xcb\xc3\x2a\xa5\xca\xd5\xa3\xed\x2d\x19\x5f\xd1\x6d\xf2\xac\x6d\x91\x61\xc9\x61\xab\x15\x7f\x26\xef\xb5\x2d\x93\x9a\x85\x0a\x12\xca\x12\xc0\x52\xcd\x19\xd6\xda\x18\x6d\xcb\x5f\xab\xf5\xac\xe8\x49\x71\x86\x96\x72\x1d\xf7\x51\xb8\x4e\xda\xd9\x7e\x8a\xdc\x75\x22\x09\xb0\x3d\x46\xdc\xde\xae\x59\xe8\x36\x01\x62\x17\xe1\x93\x8b\x32\x3b\xb3\xec\x7c\xc8\xeb\xd8\x7b\x05\x8e\xae\x09\x8a\x63\xab\x0c\x5c\xf7\xea\x1d\xef\x5b\x7f\xed\xdb\xba\x29\x4a\x96\xa4\xdb\xbf\x6e\xca\xde\xff\xee\x14\xa1\x46\xdc\x47\xb6\x1c\x48\xb8\xc8\x20\xa1\xe1\x1e\xd1\xc5\x8a\x6b\x6f\x48\x38\xc3\xa0\x57\x9d\x28\xe5\x1a\x2b\x71\xf4\xe3\xc7\xd1\x87\xba\xa3\x97\x97\x51\xe7\xd8\x62\x6d\xa0\x97\x97\xc1\x1b\xa1\x29\xd9\x3b\x7e\x8a\x5c\xac\xdc\x67\x12\x55\x2d\xfa\x49\xba\x81\x37\x64\xe2\xd1\xfc\x38\xe3\x64\x4b\xda\xb4\x5b\x9f\xdb\x49\x8c\x2c\xb9\xdd\xb2\x15\x17\xf6\xe7\xf4\xb6\x3c\x0e\xaf\x73\xe4\xf7\x1f\x34\x9b\x22\x3b\x34\x73\xa1\x98\xdf\x67\xfd\x09\x20\x14\x4a\x96\xb7\xdc\x4a\x73\xa0\xa0\x2a\xad\xc8\x2c\x78\xc3\x81\xed\x6b\xdd\x5d\xe5\xb2\xf7\x3f\x9f\xa8\x6f\xe5\x15\x06\x9e\x79\x7f\x89\x2e\x78\x73\x91\xe4\x1d\xe5\x4d\x9f\xe7\xa2\xe0\xdf\x55\x6f\xbf\x82\xa3\x0a\xda\xcb\x61\xc5\xfe\xbb\x3e\x41\x80\x55\xc5\x17\x77\x21\x15\x49\xf7\x37\x78\x8c\x86\x35\x1b\x67\xcb\x08\x71\xe9\xc9\xed\x72\xfb\x9c\x9d\x89\x9d\xed\xd5\xdb\xa0\xbf\x5c\xf4\x13\xb9\xe0\x1a\x9f\xbd\x9a\xd6\x64\xa9\xe4\xf0\xce\x6f\xec\xff\x00\x00\x00\xff\xff\x6a\x65\xa4\x29\x19\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xdb\x38\x14\xbc\xeb\x2b\x06\xf6\x65\x17\x48\xe4\x24\x47\xed\xc9\x71\xd2\x56\xd8\xd4\x06\x6c\x67\x8b\x9e\x8a\x67\xea\x59\x22\x42\x91\x5c\xf2\xc9\xae\xd1\xcd\xbf\x2f\x64\xc9\x71\x6c\xb7\x40\x2d\x9f\x38\xc3\x99\x79\x43\x4a\x43\x4c\x9c\xdf\x05\x5d\x56\x82\xbb\x9b\xbb\x3b\x7c\x74\xae\x34\x8c\xa7\xa7\x49\x32\x4c\x86\x78\xd2\x8a\x6d\xe4\x02\x8d\x2d\x38\x40\x2a\xc6\xd8\x93\xaa\xf8\x80\x5c\xe1\x1f\x0e\x51\x3b\x8b\xbb\xf4\x06\x7f\xb4\x84\x41\x0f\x0d\xfe\xfc\x2b\x19\x62\xe7\x1a\xd4\xb4\x83\x75\x82\x26\x32\xa4\xd2\x11\x6b\x6d\x18\xfc\x5d\xb1\x17\x68\x0b\xe5\x6a\x6f\x34\x59\xc5\xd8\x6a\xa9\x20\x47\xfd\x34\x19\xe2\x6b\x2f\xe1\x56\x42\xda\x82\xa0\x9c\xdf\xc1\xad\xdf\xf3\x40\xb2\x0f\xbc\xff\x55\x22\x3e\x1b\x8d\xb6\xdb\x6d\x4a\xfb\xb4\xa9\x0b\xe5\xc8\x74\xcc\x38\x7a\xca\x27\x8f\xd3\xc5\xe3\xf5\x5d\x7a\xb3\xdf\xf3\x6c\x0d\xc7\x88\xc0\xff\x36\x3a\x70\x81\xd5\x0e\xe4\xbd\xd1\x8a\x56\x86\x61\x68\x0b\x17\x40\x65\x60\x2e\x20\xae\x0d\xbc\x0d\x5a\xb4\x2d\xaf\x10\xdd\x5a\xb6\x14\x38\x19\xa2\xd0\x51\x82\x5e\x35\x72\xd2\xd6\x21\x9e\x8e\x27\x04\x67\x41\x16\x83\xf1\x02\xf9\x62\x80\xfb\xf1\x22\x5f\x5c\x25\x43\x7c\xc9\x97\x9f\x66\xcf\x4b\x7c\x19\xcf\xe7\xe3\xe9\x32\x7f\x5c\x60\x36\xc7\x64\x36\x7d\xc8\x97\xf9\x6c\xba\xc0\xec\x03\xc6\xd3\xaf\xf8\x3b\x9f\x3e\x5c\x81\xb5\x54\x1c\xc0\xdf\x7d\x68\xf3\xbb\x00\xdd\xf6\xc8\x45\x5b\xda\x82\xf9\x24\xc0\xda\x75\xc7\x17\x3d\x2b\xbd\xd6\x0a\x86\x6c\xd9\x50\xc9\x28\xdd\x86\x83\xd5\xb6\x84\xe7\x50\xeb\xd8\x9e\x66\x04\xd9\x22\x19\xc2\xe8\x5a\x0b\xc9\x7e\xe5\x62\xa8\x34\x49\xc8\xeb\xfe\xfc\x33\x28\x17\x38\x55\x36\xd4\xa9\x32\xae\x29\xd2\x72\x7f\x95\x52\xe5\xea\xd1\xe6\x96\x8c\xaf\xe8\x36\x79\xd1\xb6\xc8\xb0\xe0\xb0\xd1\x8a\x3f\x93\xf7\xda\x96\x49\xcd\x42\x05\x09\x65\x09\x60\xa9\xe6\x0c\x2b\x6d\x8c\xb6\xe5\xcf\xd5\x7a\x56\xf4\xa4\x38\x43\x4b\xb9\x8e\xbb\x28\x5c\x27\xed\x6c\x47\x91\xfb\x4e\x24\x01\x36\x87\x88\x9b\xdb\x15\x0b\xdd\x26\x40\xec\x22\x7c\x72\x51\xa6\x27\x96\x9d\x0f\x79\x1d\x7b\xaf\xc0\xd1\x35\x41\x71\x6c\x95\x81\xeb\x5e\xbd\xe3\x7d\xeb\xb7\x7d\x5b\x35\x45\xc9\xb2\x67\x00\xdd\x94\xbd\xff\xfd\x7b\x84\x1a\x71\x1f\xd9\x72\x20\xe1\x22\x83\x84\x86\x7b\x44\x17\x4b\xae\xbd\x21\xe1\x0c\x83\x5e\x75\xac\x94\x6b\xac\xc4\xd1\x8f\x1f\x07\x1f\xea\x96\x5e\x5f\x47\x9d\x63\x8b\xb5\x81\x5e\x5f\x07\x17\x42\x13\xb2\xf7\xfc\x1c\xb9\x58\xba\xcf\x24\xaa\x9a\xf7\x93\x74\x03\xaf\xc9\xc4\x83\xf9\x61\xc6\xf1\x86\xb4\x69\x6f\x7d\x6e\xc7\x31\xb2\xe4\x76\xc3\x56\x5c\xd8\x9d\xd2\xdb\xf2\x38\xbc\xcd\x91\x3f\x7c\xd0\x6c\x8a\x6c\xdf\xcc\x99\x62\xfe\x90\xf5\x2b\x80\x50\x28\x59\x2e\xb9\x95\xe6\x40\x41\x55\x5a\x91\x99\xf3\x9a\x03\xdb\xb7\xba\xdb\xff\x35\x64\xe7\x8f\x47\xd4\xb7\xf2\x06\x03\x2f\xbc\x3b\x47\xe7\xbc\x3e\x4b\xf2\x0b\xe5\x75\x9f\xe7\xac\xe0\xdf\x55\x6f\x9f\x82\xa3\x0a\xda\xb7\x6f\x49\x86\xff\xae\xdf\x21\xc0\xb2\xe2\xb3\xbd\x90\x8a\xa4\xfb\x0c\x1e\xa2\x61\xc5\xc6\xd9\x32\x42\x5c\xfa\x6e\x77\xb9\x79\x39\x46\xbd\xb8\x57\x97\x41\x7f\x7a\xd1\x8f\x4f\x19\x5c\xe3\xb3\x37\xd3\x9a\x2c\x95\x1c\x7e\xf1\x8e\xfd\x3f\x00\x6a\x65\xa4\x29\x19\x06\x00\x00"),
 		},
 		"/binaryauthorization.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "binaryauthorization.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2550,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xcd\x72\xe3\x36\x13\xbc\xf3\x29\xa6\xa4\xcb\xf7\x55\xd9\x94\xed\x23\x73\x92\x65\xef\x2e\x2b\xb6\xbc\x25\xc9\xd9\xda\x93\x6b\x04\x8e\xc8\x89\x49\x00\x01\x86\xd2\x32\x1b\xbf\x7b\x8a\x7f\x36\xe9\x9f\xac\x73\x49\xe5\x10\x9c\x54\x40\x4f\x4f\xa3\xd1\x43\x4d\x61\x61\x6c\xe5\x38\xcd\x04\xce\x4e\xce\xce\xe0\xa3\x31\x69\x4e\x70\x75\xb5\x08\xa6\xc1\x14\xae\x58\x91\xf6\x94\x40\xa9\x13\x72\x20\x19\xc1\xdc\xa2\xca\xa8\x3f\x39\x82\x5f\xc8\x79\x36\x1a\xce\xc2\x13\xf8\x5f\x0d\x98\x74\x47\x93\xff\xff\x14\x4c\xa1\x32\x25\x14\x58\x81\x36\x02\xa5\x27\x90\x8c\x3d\xec\x38\x27\xa0\x6f\x8a\xac\x00\x6b\x50\xa6\xb0\x39\xa3\x56\x04\x07\x96\xac\x69\xd3\x91\x84\xc1\x14\xbe\x76\x14\x66\x2b\xc8\x1a\x10\x94\xb1\x15\x98\xdd\x10\x07\x28\x8d\xe0\x66\x65\x22\x36\x9a\xcd\x0e\x87\x43\x88\x8d\xda\xd0\xb8\x74\x96\xb7\x48\x3f\xbb\x8a\x17\x97\xcb\xf5\xe5\xf1\x59\x78\xd2\xd4\xdc\xea\x9c\xbc\x07\x47\xbf\x95\xec\x28\x81\x6d\x05\x68\x6d\xce\x0a\xb7\x39\x41\x8e\x07\x30\x0e\x30\x75\x44\x09\x88\xa9\x05\x1f\x1c\x0b\xeb\xf4\x08\xbc\xd9\xc9\x01\x1d\x05\x53\x48\xd8\x8b\xe3\x6d\x29\x23\xb7\x7a\x79\xec\x47\x00\xa3\x01\x35\x4c\xe6\x6b\x88\xd7\x13\x38\x9f\xaf\xe3\xf5\x51\x30\x85\x2f\xf1\xe6\xd3\xcd\xed\x06\xbe\xcc\x57\xab\xf9\x72\x13\x5f\xae\xe1\x66\x05\x8b\x9b\xe5\x45\xbc\x89\x6f\x96\x6b\xb8\xf9\x00\xf3\xe5\x57\xf8\x39\x5e\x5e\x1c\x01\xb1\x64\xe4\x80\xbe\x59\x57\xeb\x37\x0e\xb8\xf6\x91\x92\xda\xb4\x35\xd1\x48\xc0\xce\xb4\x82\xbc\x25\xc5\x3b\x56\x90\xa3\x4e\x4b\x4c\x09\x52\xb3\x27\xa7\x59\xa7\x60\xc9\x15\xec\xeb\xd7\xf4\x80\x3a\x09\xa6\x90\x73\xc1\x82\xd2\xec\xbc\xb8\x54\x18\x04\x68\xb9\x7b\xff\x08\x94\x71\x14\x2a\xed\x8a\x50\xe5\xa6\x4c\xc2\xb4\x89\x52\xa8\x4c\x31\xdb\x9f\x62\x6e\x33\x3c\x0d\xee\x59\x27\x11\xac\xc9\xed\x59\xd1\x35\x5a\xcb\x3a\x0d\x0a\x12\x4c\x50\x30\x0a\x00\x34\x16\x14\xc1\x96\x35\xba\x0a\x4b\xc9\x8c\xe3\xdf\x9b\xfe\xaf\x33\x77\x15\xde\xa2\xa2\x08\x6a\xc8\xb1\xaf\xbc\x50\x11\xd4\xf7\x7c\x22\x3c\x6f\x08\xe7\x43\xc2\x00\x60\xdf\x4b\xdf\x9f\x6e\x49\xf0\x34\x00\xf0\xad\xb4\x4f\xc6\xcb\xf2\x4d\x29\x6d\x7f\xb4\xec\x3b\x0d\x8e\xbc\x29\x9d\x22\x5f\x77\x04\x38\xee\xba\xb6\xb8\xbb\x96\xe2\x6e\xc4\x71\x87\x22\xe4\xc5\xb8\xa0\x8d\x6c\x6b\xcc\x2b\x32\xe7\x63\x1c\x96\x62\x3e\x92\x26\x87\x42\x49\x04\xe2\x4a\xea\x4e\x18\x8b\x85\xd1\x3b\x4e\xa3\x6e\x03\xc0\x9a\x9c\x55\xb5\x7c\xb7\x96\x3b\xc6\xe2\xae\x2d\x7a\xc6\x71\x4d\xc5\x96\xdc\xdf\x64\x2a\x9a\xa2\x47\x26\x47\x3b\x72\xa4\x15\x7d\x60\xca\x93\x27\x95\xfd\x1b\x3d\x73\xa4\x5e\x52\x59\x8a\x9a\xe3\xc7\x4d\x5f\x5a\x6b\x9c\xf8\x85\xd1\x09\x37\xc1\x8c\x60\x87\xb9\x7f\xb4\x21\xd9\x50\x61\x73\x14\x8a\x60\x62\x9d\xf9\x95\x94\xf8\xd9\xf7\xef\xdd\xcf\x87\x87\x59\xdf\xa7\xde\xad\xa9\x1f\x1e\x26\x2f\x6a\x17\xa8\xcf\xe9\xd6\x53\xb2\x31\xd7\x28\x2a\x5b\x75\x0f\xdc\x3a\x30\xec\xd7\x3f\xfd\x7c\x8f\x9c\xd7\x1f\x8c\x58\xcf\xbd\x27\x89\xf5\x9e\xb4\x18\x57\x8d\xe1\x7d\xd6\xbb\xec\x3f\xb9\xd0\x7a\x30\xb8\x6a\x4f\x1c\x5f\x3c\x81\x04\x5d\x4a\xd2\xfa\x37\xc4\x66\x4c\x0e\x9d\xca\x58\x61\xbe\xea\x6d\xf6\x4f\x75\xc7\x9d\x93\x9d\x09\x03\x87\xef\xa9\x7a\xdc\x5e\xd1\xee\x59\xef\x37\xb8\x76\x9d\x82\xf7\xd2\xd5\x2b\x21\xaf\x1c\x5b\x69\xe6\xed\x8f\xe3\xc1\x09\xc0\x26\xa3\xbe\x08\x24\x43\x69\xff\x24\x7a\x11\xb0\xa5\xdc\xe8\xd4\x83\x98\x70\x50\x96\xee\xef\xa3\x11\x4b\x3b\x42\x9f\x5f\x68\x7a\x75\xce\x07\x3c\xce\x94\x36\x7a\xec\x56\xa0\xc6\x94\xdc\x9b\x9f\x9b\xf7\x4d\xf7\x68\x88\xde\x9c\xed\xcf\x43\xd4\x5f\x4c\xf6\x8f\x22\xfd\xef\xc9\xef\x38\x11\x3f\x8c\xf0\x18\xfe\x5f\x8a\xff\xa1\x14\xff\x19\x00\x00\xff\xff\x27\xed\xcb\xd1\xf6\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x10\xf6\xa5\x05\x12\x39\xc9\x51\x3d\x39\x4e\x76\x57\x68\xe2\x2c\x6c\xa7\x8b\x3d\x05\xf4\x88\x92\xd8\x48\x33\xd3\x19\xca\x5e\x75\x9b\xff\x5e\xe8\xcb\x1f\xf9\x68\xd3\x4b\xd1\x43\x2d\x1f\x8c\xe1\xe3\xe3\x9b\x47\x52\x1e\xc3\xcc\xd8\xda\x71\x96\x0b\x5c\x9c\x5d\x5c\xc0\x47\x63\xb2\x82\xe0\xe6\x66\x16\x8c\x83\x31\xdc\xb0\x22\xed\x29\x81\x4a\x27\xe4\x40\x72\x82\xa9\x45\x95\xd3\x10\x39\x81\x5f\xc8\x79\x36\x1a\x2e\xc2\x33\xf8\xa1\x01\x8c\xfa\xd0\xe8\xc7\x9f\x82\x31\xd4\xa6\x82\x12\x6b\xd0\x46\xa0\xf2\x04\x92\xb3\x87\x94\x0b\x02\xfa\xa6\xc8\x0a\xb0\x06\x65\x4a\x5b\x30\x6a\x45\xb0\x65\xc9\x41\xf6\xfc\x61\x30\x86\xaf\x3d\x85\x59\x0b\xb2\x06\x04\x65\x6c\x0d\x26\x3d\xc4\x01\x4a\x2b\xb8\xfd\xe4\x22\x36\x9a\x4c\xb6\xdb\x6d\x88\xad\xda\xd0\xb8\x6c\x52\x74\x48\x3f\xb9\x89\x67\xd7\xf3\xe5\xf5\xe9\x45\x78\xd6\xe6\xdc\xeb\x82\xbc\x07\x47\xbf\x55\xec\x28\x81\x75\x0d\x68\x6d\xc1\x0a\xd7\x05\x41\x81\x5b\x30\x0e\x30\x73\x44\x09\x88\x69\x04\x6f\x1d\x0b\xeb\xec\x04\xbc\x49\x65\x8b\x8e\x82\x31\x24\xec\xc5\xf1\xba\x92\x23\xb7\x06\x79\xec\x8f\x00\x46\x03\x6a\x18\x4d\x97\x10\x2f\x47\x70\x39\x5d\xc6\xcb\x93\x60\x0c\x5f\xe2\xd5\xa7\xbb\xfb\x15\x7c\x99\x2e\x16\xd3\xf9\x2a\xbe\x5e\xc2\xdd\x02\x66\x77\xf3\xab\x78\x15\xdf\xcd\x97\x70\xf7\x01\xa6\xf3\xaf\xf0\x73\x3c\xbf\x3a\x01\x62\xc9\xc9\x01\x7d\xb3\xae\xd1\x6f\x1c\x70\xe3\x23\x25\x8d\x69\x4b\xa2\x23\x01\xa9\xe9\xda\xe7\x2d\x29\x4e\x59\x41\x81\x3a\xab\x30\x23\xc8\xcc\x86\x9c\x66\x9d\x81\x25\x57\xb2\x6f\xba\xe9\x01\x75\x12\x8c\xa1\xe0\x92\x05\xa5\x3d\x79\x71\xa9\x30\x08\xd0\x72\xdf\xff\x08\x94\x71\x14\x2a\xed\xca\x50\x15\xa6\x4a\xc2\xac\x1d\xa5\x50\x99\x72\xb2\x39\xc7\xc2\xe6\x78\x1e\x3c\xb2\x4e\x22\x58\x92\xdb\xb0\xa2\x5b\xb4\x96\x75\x16\x94\x24\x98\xa0\x60\x14\x00\x68\x2c\x29\x82\x35\x6b\x74\x35\x56\x92\x1b\xc7\xbf\xb7\xf5\x5f\x67\xee\x33\xbc\x45\x45\x11\x34\x90\x53\x5f\x7b\xa1\x32\x68\xee\xb9\x27\xbc\x6c\x09\xa7\x87\x84\x01\xc0\x66\x90\xbe\x39\x5f\x93\xe0\x79\x00\xe0\x3b\x69\x9f\x8c\x97\xf9\x9b\x52\xba\xfa\x68\xd9\xf7\x1a\x1c\x79\x53\x39\x45\xbe\xa9\x08\x70\xda\x57\xed\x70\x0f\xdd\x6d\x1e\x8e\x38\x1e\x50\x84\xbc\x18\xd7\x26\x00\x74\xc6\xbc\x22\x73\x7a\x8c\xc3\x4a\xcc\x47\xd2\xe4\x50\x28\x89\x40\x5c\x45\x7d\x84\xb1\x9c\x19\x9d\x72\x16\xf5\x07\x00\xd6\x14\xac\xea\xf9\xbb\xb5\x3c\x30\x96\x0f\x5d\xd2\x33\x8e\x5b\x2a\xd7\xe4\xfe\x21\x53\xd9\x26\xed\x98\x1c\xa5\xe4\x48\x2b\xfa\xc0\x54\x24\x7b\x95\x43\x8f\x86\xdc\x83\x80\xd4\x96\xa2\x36\xbc\x3b\xf4\x95\xb5\xc6\x89\x9f\x19\x9d\x70\xd3\x0d\x1f\x41\x8a\x85\xdf\xd9\x90\xac\xa8\xb4\x05\x0a\x45\x30\xb2\xce\xfc\x4a\x4a\xfc\xe4\xfb\xf7\xfe\xe7\xd3\xd3\x64\xa8\xd3\x9c\x36\xd4\x4f\x4f\xa3\x17\xb9\x33\xd4\x97\x74\xef\x29\x59\x99\x5b\x14\x95\x2f\xfa\x06\x77\x0e\x1c\xd6\x1b\x5a\x3f\xdd\x20\x17\xcd\x0b\x23\xd6\x53\xef\x49\x62\xbd\x21\x2d\xc6\xd5\xc7\xf0\x61\xd6\xfb\xd9\xdf\xbb\xd0\x28\x39\xba\xea\x40\x1c\x5f\xed\x41\x82\x2e\x23\xe9\xfc\x3b\xc4\xe6\x4c\x0e\x9d\xca\x59\x61\xb1\x18\x6c\xee\x87\xb1\xf9\x9e\xf6\x4e\xf6\x26\xec\xce\x01\x1e\xa9\xde\x1d\x2f\x28\x7d\x56\xfb\x0d\xae\xb4\x57\xf0\x5e\xba\xe6\x49\xc8\x2b\xc7\xb6\xe9\x58\x04\x7f\x9c\x1e\x44\x00\x56\x39\x0d\x49\x20\x39\x4a\xf7\x27\x31\x88\x80\x35\x15\x46\x67\x1e\xc4\x84\x07\x69\xd9\xe6\x71\x2f\x6a\xbf\x42\x9f\x5f\x68\x7a\x75\xcf\xf7\x4f\xe6\x4c\x65\xa3\x5d\xb5\x12\x35\x66\xe4\xde\x7c\xdd\xbc\x6f\xbb\x8f\x96\xe8\xcd\xdd\xfe\x7c\x88\xfa\x8b\xcd\xfe\xbb\x91\xfe\xef\xcc\x6f\x2f\xe9\x19\xf7\x9b\x23\x7c\x0c\xff\x7f\x8a\xff\xa5\x29\xfe\x73\x00\x27\xed\xcb\xd1\xf6\x09\x00\x00"),
 		},
 		"/certificatemanager.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "certificatemanager.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 4271,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xe3\x46\x0f\xbe\xeb\x57\x10\xf6\xe5\x7d\x81\x44\x4e\x72\x54\x4f\x5e\x27\xbb\x2b\x34\x71\x0a\xcb\xe9\x62\x4f\x01\x3d\xa2\xa5\x69\x46\x33\xd3\x19\xca\x5e\x75\xeb\xff\x5e\xe8\xcb\xb1\xf3\xd1\x75\x80\x2c\x7a\xb1\x0e\xb6\xc1\x21\x1f\x3e\x43\xf2\xa1\x3c\x84\x89\xb1\x95\x93\x59\xce\x70\x71\x76\x71\x01\x9f\x8c\xc9\x14\xc1\xf5\xf5\x24\x18\x06\x43\xb8\x96\x82\xb4\xa7\x14\x4a\x9d\x92\x03\xce\x09\xc6\x16\x45\x4e\xfd\xc9\x09\xfc\x4e\xce\x4b\xa3\xe1\x22\x3c\x83\xff\xd5\x0e\x83\xee\x68\xf0\xff\x5f\x82\x21\x54\xa6\x84\x02\x2b\xd0\x86\xa1\xf4\x04\x9c\x4b\x0f\x4b\xa9\x08\xe8\x9b\x20\xcb\x20\x35\x08\x53\x58\x25\x51\x0b\x82\xb5\xe4\xbc\x49\xd3\x81\x84\xc1\x10\xbe\x76\x10\x66\xc1\x28\x35\x20\x08\x63\x2b\x30\xcb\x5d\x3f\x40\x6e\x08\x37\x4f\xce\x6c\xa3\xd1\x68\xbd\x5e\x87\xd8\xb0\x0d\x8d\xcb\x46\xaa\xf5\xf4\xa3\xeb\x78\x72\x35\x4d\xae\x4e\x2f\xc2\xb3\x26\xe6\x4e\x2b\xf2\x1e\x1c\xfd\x59\x4a\x47\x29\x2c\x2a\x40\x6b\x95\x14\xb8\x50\x04\x0a\xd7\x60\x1c\x60\xe6\x88\x52\x60\x53\x13\x5e\x3b\xc9\x52\x67\x27\xe0\xcd\x92\xd7\xe8\x28\x18\x42\x2a\x3d\x3b\xb9\x28\x79\xaf\x5a\x3d\x3d\xe9\xf7\x1c\x8c\x06\xd4\x30\x18\x27\x10\x27\x03\xf8\x30\x4e\xe2\xe4\x24\x18\xc2\x97\x78\xfe\xf9\xf6\x6e\x0e\x5f\xc6\xb3\xd9\x78\x3a\x8f\xaf\x12\xb8\x9d\xc1\xe4\x76\x7a\x19\xcf\xe3\xdb\x69\x02\xb7\x1f\x61\x3c\xfd\x0a\xbf\xc6\xd3\xcb\x13\x20\xc9\x39\x39\xa0\x6f\xd6\xd5\xfc\x8d\x03\x59\xd7\x91\xd2\xba\x68\x09\xd1\x1e\x81\xa5\x69\x09\x79\x4b\x42\x2e\xa5\x00\x85\x3a\x2b\x31\x23\xc8\xcc\x8a\x9c\x96\x3a\x03\x4b\xae\x90\xbe\xee\xa6\x07\xd4\x69\x30\x04\x25\x0b\xc9\xc8\x8d\xe5\xd9\xa5\xc2\x20\x40\x2b\xbb\xfe\x47\x20\x8c\xa3\x50\x68\x57\x84\x42\x99\x32\x0d\xb3\x66\x94\x42\x61\x8a\xd1\xea\x1c\x95\xcd\xf1\x3c\x78\x90\x3a\x8d\x20\x21\xb7\x92\x82\x6e\xd0\x5a\xa9\xb3\xa0\x20\xc6\x14\x19\xa3\x00\x40\x63\x41\x11\x08\x72\x5c\x93\x44\xa6\x02\x35\x66\xe4\x5e\x06\xee\x02\xbc\x45\x51\x47\x69\x57\x9c\xfa\xca\x33\x15\x41\x7d\xcd\x47\xbc\xc9\x23\xde\x4d\x8b\x17\x00\xac\x7a\xe2\xab\xf3\x05\x31\x9e\x07\x00\xbe\x25\xf6\xd9\x78\x9e\xbe\x46\xa4\xcd\x8e\x56\xfa\x8e\x81\x23\x6f\x4a\x27\xc8\xd7\xf9\x00\x4e\xbb\x9c\xad\xdf\xfd\x0e\xc2\x7d\x07\xb1\x6b\x0b\xda\x89\x6d\xeb\xf2\x9c\xe6\xe4\x99\x27\x96\x6c\x3e\x91\x26\x87\x4c\x69\x04\xec\xca\xfe\x44\xa6\x73\x2a\xac\x42\xa6\x08\x06\xd6\x99\x3f\x48\xb0\x1f\x7d\xff\xde\xfd\xdc\x6c\x46\xca\x88\xb6\x97\xa3\x4c\x99\x05\xaa\xd1\x0e\x91\xda\xb3\x26\xbe\xd9\x0c\x9e\xe1\x4d\x50\x7f\xa0\x3b\x4f\xe9\xdc\xdc\x20\x8b\x7c\xd6\xdd\xb8\xad\xd1\x12\x95\xef\x39\xf4\xb5\x18\xaf\x50\xaa\x5a\x3e\xb1\x1e\x7b\x4f\x1c\xeb\x15\x69\x36\xae\xda\x77\xef\x3b\xdf\x4d\x42\xd4\x99\xfb\xb6\xd5\x9f\x5b\x93\xc2\x05\x29\x1f\x75\xdf\x4f\xf2\xc5\x97\x8f\xb1\x8c\x2e\x23\xfe\x28\x49\xa5\x7b\x10\xb9\x24\x87\x4e\xe4\x52\xa0\x9a\xd1\x92\x1c\xe9\x6d\xd3\xda\xc6\x71\x65\x29\x82\xae\x5e\x5b\x3b\xc0\x03\x55\x5b\xf3\x8c\x96\x4f\x72\xbf\x82\xb5\xec\x18\x1c\x0a\x57\x3f\x29\x79\xe1\xa4\xe5\x66\x2c\xff\x3e\xdd\x39\x01\x98\xe7\xd4\x07\x01\xe7\xc8\xed\x26\xed\x49\xc0\x82\x94\xd1\x99\x07\x36\xe1\x4e\x58\xb6\x7a\x88\xf6\x50\xda\x41\xfb\xed\x19\xa7\x17\xe5\xb0\x83\xe3\x4c\x69\xa3\x6d\xb6\x1f\x89\xf2\xad\x2a\xb8\x2f\xd0\x1e\xae\x84\x9b\xad\xf3\xcf\x12\xc3\x0d\xda\xa3\x1e\x8e\x7a\xf8\x4f\xf5\x70\x4f\x9a\x5d\xf5\x26\x55\x5c\xed\x44\xfc\x64\x69\x14\x68\x37\x9b\x27\xf6\x3a\xbd\x3c\xbe\x48\x8e\xc2\x79\x4f\xe1\xb4\x45\x68\x6e\x5a\xa0\xdd\xbf\xe5\xb6\x34\x8f\xaf\x8f\x7f\xe1\x7a\xe0\x3b\xe5\xf0\x4b\xbc\xe1\x5f\xea\x96\xf1\x2b\x23\x55\x3f\x16\x1d\x69\xde\x91\xea\x01\x3b\x23\xd5\xfe\x1e\x4b\xce\x8d\x93\x7f\x35\x92\xfd\xc1\xbe\xb8\x9c\x26\xe3\x17\xdc\xdf\x75\x59\xa4\xda\xef\xe5\x38\x2e\x84\xe3\x42\x78\xa7\x85\xf0\x4f\x00\x00\x00\xff\xff\xc3\x3b\x16\x85\xaf\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xdf\x6f\xe2\x38\x10\x7e\xcf\x5f\x31\x82\x97\x3b\xa9\x84\x6d\x1f\x73\x4f\x2c\xed\xee\x46\xd7\xd2\x13\xd0\x5b\xed\x53\x35\x38\x43\xe2\xab\x63\xfb\xec\x09\x2c\xb7\xc7\xff\x7e\x32\x49\xf8\x51\xda\xdd\x56\xea\xea\x5e\xc0\x11\xa0\xf1\xcc\x37\xdf\xcc\xf8\x73\xba\x30\x34\x76\xe5\x64\x5e\x30\x5c\xbc\xbb\xb8\x80\x8f\xc6\xe4\x8a\xe0\xfa\x7a\x18\x75\xa3\x2e\x5c\x4b\x41\xda\x53\x06\x95\xce\xc8\x01\x17\x04\x03\x8b\xa2\xa0\x76\xe7\x0c\xfe\x24\xe7\xa5\xd1\x70\x11\xbf\x83\x5f\x82\x43\xa7\xd9\xea\xfc\xfa\x5b\xd4\x85\x95\xa9\xa0\xc4\x15\x68\xc3\x50\x79\x02\x2e\xa4\x87\xb9\x54\x04\xf4\x55\x90\x65\x90\x1a\x84\x29\xad\x92\xa8\x05\xc1\x52\x72\x01\xbc\xc3\x8f\xa3\x2e\x7c\x69\x20\xcc\x8c\x51\x6a\x40\x10\xc6\xae\xc0\xcc\xf7\xfd\x00\x79\x43\x78\xf3\x29\x98\x6d\xd2\xef\x2f\x97\xcb\x18\x37\x6c\x63\xe3\xf2\xbe\xaa\x3d\x7d\xff\x3a\x1d\x5e\x8d\x26\x57\xbd\x8b\xf8\xdd\x26\xe6\x4e\x2b\xf2\x1e\x1c\xfd\x5d\x49\x47\x19\xcc\x56\x80\xd6\x2a\x29\x70\xa6\x08\x14\x2e\xc1\x38\xc0\xdc\x11\x65\xc0\x26\x10\x5e\x3a\xc9\x52\xe7\x67\xe0\xcd\x9c\x97\xe8\x28\xea\x42\x26\x3d\x3b\x39\xab\xf8\xa0\x5b\x2d\x3d\xe9\x0f\x1c\x8c\x06\xd4\xd0\x19\x4c\x20\x9d\x74\xe0\xfd\x60\x92\x4e\xce\xa2\x2e\x7c\x4e\xa7\x9f\x6e\xef\xa6\xf0\x79\x30\x1e\x0f\x46\xd3\xf4\x6a\x02\xb7\x63\x18\xde\x8e\x2e\xd3\x69\x7a\x3b\x9a\xc0\xed\x07\x18\x8c\xbe\xc0\xef\xe9\xe8\xf2\x0c\x48\x72\x41\x0e\xe8\xab\x75\x81\xbf\x71\x20\x43\x1f\x29\x0b\x4d\x9b\x10\x1d\x10\x98\x9b\x7a\x7c\xde\x92\x90\x73\x29\x40\xa1\xce\x2b\xcc\x09\x72\xb3\x20\xa7\xa5\xce\xc1\x92\x2b\xa5\x0f\xd3\xf4\x80\x3a\x8b\xba\xa0\x64\x29\x19\x79\x63\x39\x2a\x2a\x8e\x22\xb4\xb2\x99\x7f\x02\xc2\x38\x8a\x85\x76\x65\x2c\x94\xa9\xb2\x38\xdf\x1c\xa5\x58\x98\xb2\xbf\x38\x47\x65\x0b\x3c\x8f\x1e\xa4\xce\x12\x98\x90\x5b\x48\x41\x37\x68\xad\xd4\x79\x54\x12\x63\x86\x8c\x49\x04\xa0\xb1\xa4\x04\x04\x39\x0e\x24\x91\xa9\x44\x8d\x39\xb9\xa7\x81\x9b\x00\x6f\x51\x84\x28\xed\xca\x9e\x5f\x79\xa6\x32\x0a\x65\xee\xf0\x86\x3b\xbc\x9b\x1a\x2f\x02\x58\xb4\xc4\x17\xe7\x33\x62\x3c\x8f\x00\x7c\x4d\xec\x93\xf1\x3c\x7a\x8e\x48\x9d\x1d\xad\xf4\x0d\x03\x47\xde\x54\x4e\x90\x0f\xf9\x00\x7a\x4d\xce\xda\xef\x7e\x0f\xe1\xbe\x81\xd8\xb7\x6d\x42\x00\xea\xbe\x1c\xd3\x1c\x1e\x79\x62\xc5\xe6\x23\x69\x72\xc8\x94\x25\xc0\xae\x6a\x77\x64\x36\xa5\xd2\x2a\x64\x4a\xa0\x63\x9d\xf9\x8b\x04\xfb\xfe\xb7\x6f\xcd\xdf\xf5\xba\xaf\x8c\xa8\x67\xd9\xcf\x95\x99\xa1\xea\xef\x11\x09\x9e\x81\xf8\x7a\xdd\x39\xc2\x1b\xa2\x7e\x4f\x77\x9e\xb2\xa9\xb9\x41\x16\xc5\xb8\xa9\xb8\xee\xd1\x1c\x95\x6f\x39\xb4\xbd\x18\x2c\x50\xaa\x20\x9f\x54\x0f\xbc\x27\x4e\xf5\x82\x34\x1b\xb7\x3a\x74\x6f\x27\xdf\x9c\x84\xa4\x31\xb7\x63\x0b\xdf\x5b\x93\xc2\x19\x29\x9f\x34\xbf\x8f\xf2\xa5\x97\xbb\x58\x46\x97\x13\x7f\x90\xa4\xb2\x03\x88\x42\x92\x43\x27\x0a\x29\x50\x8d\x69\x4e\x8e\xf4\x76\x68\xe1\xe9\x01\xaf\x2c\x25\xd0\xf4\x6b\x6b\x07\x78\xa0\xd5\xd6\x3c\xa6\xf9\xa3\xdc\xcf\x60\xcd\x1b\x06\x2f\x85\x0b\x2b\x23\x2f\x9c\xb4\x61\x46\x09\xfc\xdb\xdb\xdb\x01\x98\x16\xd4\x06\x01\x17\xc8\xf5\x4d\xda\x92\x80\x19\x29\xa3\x73\x0f\x6c\xe2\xbd\xb0\x7c\xf1\xb0\x23\xb5\x3b\x68\x7f\x1c\x71\x7a\x52\x0e\xbb\x95\x3b\x53\xd9\x64\x9b\xed\x47\xa2\x7c\xad\x0a\xee\x4b\xb4\x2f\x57\xc2\x0d\xda\x9f\x2c\x86\x1b\xb4\x27\x3d\x9c\xf4\xf0\xbf\xea\xe1\x9e\x34\xbb\xd5\xab\x54\x71\xb5\x17\xf1\x93\xa5\x51\xa2\x5d\xaf\x1f\xd9\x43\x7a\x49\x27\xe1\x9c\x84\xf3\x86\xc2\x09\x4f\xaf\xae\xb4\x44\x7b\x58\xe5\xb6\x35\xbb\xd7\xc7\x77\xb8\xfe\x48\x3d\xaf\x2e\x62\xef\xf4\xbf\xac\x8e\xe7\x8f\x54\x58\x16\x1d\x69\xde\x93\xea\x0b\xee\x8c\x4c\xfb\x7b\xac\xb8\x30\x4e\xfe\xb3\x79\x9b\x45\xdf\xaf\xf8\x72\x34\x19\x3c\xe1\xfe\xa6\x97\x45\xa6\xfd\x41\x8e\xd3\x85\x70\xba\x10\xde\xe8\x42\xf8\x6f\x00\xc3\x3b\x16\x85\xaf\x10\x00\x00"),
 		},
 		"/cloudasset.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudasset.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2972,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x94\x41\x73\xda\xc8\x13\xc5\xef\xfa\x14\x5d\x70\xf9\xff\xab\x6c\x61\x73\xd4\x9e\x08\x36\x89\x6a\x1d\x48\x01\xde\x54\x4e\xae\x66\xd4\x92\x66\x3d\x9a\x99\x9d\x69\x41\xd8\xac\xbf\xfb\xd6\x48\x02\x03\x76\xbc\x76\x65\x6b\x2f\xd1\x89\x9a\x79\xfd\xfa\x75\xeb\x27\xfa\x30\x36\x76\xeb\x64\x51\x32\x0c\x2f\x86\x43\x78\x6f\x4c\xa1\x08\x6e\x6e\xc6\x51\x3f\xea\xc3\x8d\x14\xa4\x3d\x65\x50\xeb\x8c\x1c\x70\x49\x30\xb2\x28\x4a\xda\xdd\x9c\xc1\x6f\xe4\xbc\x34\x1a\x86\xf1\x05\xfc\x2f\x08\x7a\xdd\x55\xef\xff\xbf\x44\x7d\xd8\x9a\x1a\x2a\xdc\x82\x36\x0c\xb5\x27\xe0\x52\x7a\xc8\xa5\x22\xa0\xaf\x82\x2c\x83\xd4\x20\x4c\x65\x95\x44\x2d\x08\x36\x92\xcb\xa6\x4d\x67\x12\x47\x7d\xf8\xd2\x59\x98\x15\xa3\xd4\x80\x20\x8c\xdd\x82\xc9\x0f\x75\x80\xdc\x04\x6e\x9e\x92\xd9\x26\x83\xc1\x66\xb3\x89\xb1\x49\x1b\x1b\x57\x0c\x54\xab\xf4\x83\x9b\x74\x7c\x3d\x5d\x5c\x9f\x0f\xe3\x8b\xa6\xe6\x56\x2b\xf2\x1e\x1c\xfd\x51\x4b\x47\x19\xac\xb6\x80\xd6\x2a\x29\x70\xa5\x08\x14\x6e\xc0\x38\xc0\xc2\x11\x65\xc0\x26\x04\xde\x38\xc9\x52\x17\x67\xe0\x4d\xce\x1b\x74\x14\xf5\x21\x93\x9e\x9d\x5c\xd5\x7c\xb4\xad\x5d\x3c\xe9\x8f\x04\x46\x03\x6a\xe8\x8d\x16\x90\x2e\x7a\xf0\x6e\xb4\x48\x17\x67\x51\x1f\x3e\xa7\xcb\x0f\xb3\xdb\x25\x7c\x1e\xcd\xe7\xa3\xe9\x32\xbd\x5e\xc0\x6c\x0e\xe3\xd9\xf4\x2a\x5d\xa6\xb3\xe9\x02\x66\x13\x18\x4d\xbf\xc0\xaf\xe9\xf4\xea\x0c\x48\x72\x49\x0e\xe8\xab\x75\x21\xbf\x71\x20\xc3\x1e\x29\x0b\x4b\x5b\x10\x1d\x05\xc8\x4d\x1b\xc8\x5b\x12\x32\x97\x02\x14\xea\xa2\xc6\x82\xa0\x30\x6b\x72\x5a\xea\x02\x2c\xb9\x4a\xfa\xf0\x36\x3d\xa0\xce\xa2\x3e\x28\x59\x49\x46\x6e\x4e\x9e\x0c\x15\x47\x11\x5a\xd9\xbd\xff\x04\x84\x71\x14\x0b\xed\xaa\x58\x28\x53\x67\x71\xd1\xa0\x14\x0b\x53\x0d\xd6\x97\xa8\x6c\x89\x97\xd1\xbd\xd4\x59\x02\x0b\x72\x6b\x29\xe8\x23\x5a\x2b\x75\x11\x55\xc4\x98\x21\x63\x12\x01\x68\xac\x28\x81\xc6\x00\xbd\x27\x7e\xde\xb0\x13\x7a\x8b\x22\xa8\xb5\xab\xce\xfd\xd6\x33\x55\x51\x18\xef\xd1\x67\x1c\xea\x46\xc1\x27\x02\x58\xef\x82\xae\x2f\x57\xc4\x78\x19\x01\xf8\x36\xc8\x07\xe3\x79\x7a\xda\xb8\xed\x86\x56\xfa\xae\xa3\x23\x6f\x6a\x27\xc8\x07\x7f\x80\xf3\xae\x47\xab\xbb\x6b\x2a\xef\x9a\xd2\xbb\xdc\xa8\x8c\xdc\x5d\x4e\x94\x45\x2d\x91\xed\xdc\x8f\x71\x26\x8d\x62\xf2\x28\xc0\x9a\xcd\x7b\xd2\xe4\x90\x29\x4b\x80\x5d\x4d\xdd\x8d\xcc\x96\x54\x59\x85\x4c\x09\xf4\x5a\x67\x3f\xf8\xf6\xad\xeb\x21\xb3\x87\x87\x41\x68\x14\xce\x42\xa0\x87\x87\xde\x93\xc2\x31\xea\x77\x74\xeb\x29\x5b\x9a\x8f\xc8\xa2\x9c\x77\x93\xb4\x33\xe7\xa8\xfc\xae\xd9\x6e\xc6\xd1\x1a\xa5\x0a\xf8\xa7\xba\xc9\x9b\xea\x35\x69\x36\x6e\x7b\x2c\x0f\xfb\x23\xb7\xcf\x9d\x5e\x4d\x24\xa9\x2c\x69\x36\x73\xe2\x98\x5e\x25\xdd\x09\x00\xa3\x2b\x88\x9f\x6a\x4b\x49\x0e\x9d\x28\xa5\x40\x35\xa7\x9c\x1c\xe9\xfd\xba\xdb\x95\xf3\xd6\x86\xc4\xcd\xec\xfb\x63\x80\x7b\xda\xee\x4e\xe7\x94\x9f\x74\xfe\x8e\x53\xde\xf5\xdf\x2f\xf2\x9f\xfc\xc2\x93\x91\x17\x4e\x5a\x6e\x38\xfa\xeb\xfc\xe0\x06\x60\x59\x52\x57\x03\x5c\x22\xb7\xff\x74\xbb\x10\xb0\x22\x65\x74\xe1\x81\x4d\x7c\x50\x55\xac\xef\x93\x23\x93\x16\x94\xc9\xe9\x80\xcf\xd2\x7b\x60\xe3\x4c\x6d\x93\x7d\xb3\x0a\x35\x16\xe4\xbe\xfb\xed\xbc\x08\xaf\x71\x05\x6a\xf9\x67\xf3\xd1\xbf\x84\xf0\xec\x40\xf7\x76\x90\x0f\xbb\x04\x74\x8d\x2b\x7e\x4e\x96\x0f\x17\x71\x4a\xe0\xe1\xdd\x9b\xb9\x6e\x37\xfa\x3a\xcb\xd7\xa0\x7d\x58\xf9\xc3\x80\xcf\x9e\x9f\xfa\x3f\xc4\xdc\x3a\xf3\x3b\x09\x7e\x89\xf0\x4f\xad\xe4\xed\x70\x77\xde\x01\xe3\xee\xe7\x4f\x08\x76\x37\xf9\x29\x80\xdd\xf1\x9b\x71\x7e\xad\xdd\x6b\x50\xee\x8a\x7e\x98\xe2\x4f\x4f\x32\xfd\x9b\x00\xff\x1d\x00\x00\xff\xff\xbc\x11\x8c\xc7\x9c\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x94\x41\x6f\xdb\x46\x10\x85\xef\xfc\x15\x0f\xd2\xa5\x05\x6c\x2a\xf6\x91\x3d\x29\xb2\x9d\x10\x75\xa4\x42\x92\x1b\xe4\x64\x8c\x96\x43\x72\x6b\x72\x77\xbb\xbb\x94\xa2\xa6\xfe\xef\xc5\x92\x94\x25\xcb\x89\x6b\x23\x28\x7a\x88\xe9\x83\xb1\xfb\xe6\xcd\x9b\xe1\x47\x0f\x31\xd1\x66\x6b\x65\x51\x7a\x9c\xbf\x39\x3f\xc7\x3b\xad\x8b\x8a\x71\x7d\x3d\x89\x86\xd1\x10\xd7\x52\xb0\x72\x9c\xa1\x51\x19\x5b\xf8\x92\x31\x36\x24\x4a\xde\xdd\x9c\xe0\x77\xb6\x4e\x6a\x85\xf3\xf8\x0d\x7e\x0a\x82\x41\x7f\x35\xf8\xf9\x97\x68\x88\xad\x6e\x50\xd3\x16\x4a\x7b\x34\x8e\xe1\x4b\xe9\x90\xcb\x8a\xc1\x9f\x05\x1b\x0f\xa9\x20\x74\x6d\x2a\x49\x4a\x30\x36\xd2\x97\xf0\x7b\xff\x38\x1a\xe2\x53\x6f\xa1\x57\x9e\xa4\x02\x41\x68\xb3\x85\xce\x0f\x75\x20\xdf\x06\x6e\x7f\x4a\xef\x4d\x32\x1a\x6d\x36\x9b\x98\xda\xb4\xb1\xb6\xc5\xa8\xea\x94\x6e\x74\x9d\x4e\x2e\xa7\x8b\xcb\xd3\xf3\xf8\x4d\x5b\x73\xa3\x2a\x76\x0e\x96\xff\x6c\xa4\xe5\x0c\xab\x2d\xc8\x98\x4a\x0a\x5a\x55\x8c\x8a\x36\xd0\x16\x54\x58\xe6\x0c\x5e\x87\xc0\x1b\x2b\xbd\x54\xc5\x09\x9c\xce\xfd\x86\x2c\x47\x43\x64\xd2\x79\x2b\x57\x8d\x7f\xb4\xad\x5d\x3c\xe9\x1e\x09\xb4\x02\x29\x0c\xc6\x0b\xa4\x8b\x01\xde\x8e\x17\xe9\xe2\x24\x1a\xe2\x63\xba\x7c\x3f\xbb\x59\xe2\xe3\x78\x3e\x1f\x4f\x97\xe9\xe5\x02\xb3\x39\x26\xb3\xe9\x45\xba\x4c\x67\xd3\x05\x66\x57\x18\x4f\x3f\xe1\xd7\x74\x7a\x71\x02\x96\xbe\x64\x0b\xfe\x6c\x6c\xc8\xaf\x2d\x64\xd8\x23\x67\x61\x69\x0b\xe6\x47\x01\x72\xdd\xbd\x3e\x67\x58\xc8\x5c\x0a\x54\xa4\x8a\x86\x0a\x46\xa1\xd7\x6c\x95\x54\x05\x0c\xdb\x5a\xba\xf0\x36\x1d\x48\x65\xd1\x10\x95\xac\xa5\x27\xdf\x9e\x3c\x19\x2a\x8e\x22\x32\xb2\x7f\xff\x09\x84\xb6\x1c\x0b\x65\xeb\x58\x54\xba\xc9\xe2\xa2\x45\x29\x16\xba\x1e\xad\xcf\xa8\x32\x25\x9d\x45\x77\x52\x65\x09\x16\x6c\xd7\x52\xf0\x07\x32\x46\xaa\x22\xaa\xd9\x53\x46\x9e\x92\x08\x50\x54\x73\x82\xd6\x80\x9c\x63\xff\x75\xc3\x5e\xe8\x0c\x89\xa0\x56\xb6\x3e\x75\x5b\xe7\xb9\x8e\xc2\x78\x7b\x9f\x49\xf0\x19\x07\x9f\x08\x58\xef\x82\xae\xcf\x56\xec\xe9\x2c\x02\x5c\x17\xe4\xbd\x76\x7e\x7a\xdc\xb8\xeb\x46\x46\xba\xbe\xa3\x65\xa7\x1b\x2b\xd8\x05\x7f\xe0\xb4\xef\xd1\xe9\x6e\xdb\x88\xb7\x6d\xe9\x6d\xae\xab\x8c\xed\x6d\xce\x9c\xb5\x52\xa0\x9b\x7b\x1f\xe7\xaa\x55\x5c\xed\x05\xd4\x78\xfd\x8e\x15\x5b\xf2\x9c\x25\xf0\xb6\xe1\xfe\x46\x66\x4b\xae\x4d\x45\x9e\x13\x0c\x3a\x67\x37\xfa\xf2\xa5\xef\x21\xb3\xfb\xfb\x51\x68\x14\xce\x42\xa0\xfb\xfb\xc1\x93\xc2\x09\xa9\xb7\x7c\xe3\x38\x5b\xea\x0f\xe4\x45\x39\xef\x27\xe9\x66\xce\xa9\x72\xbb\x66\xbb\x19\xc7\x6b\x92\x55\xc0\x3f\x55\xed\xfa\x52\xb5\x66\xe5\xb5\xdd\x3e\x96\x87\xfd\xb1\x7d\xc8\x9d\x5e\x5c\x49\xae\xb2\xa4\xdd\xcc\x91\x63\x7a\x91\xf4\x27\x80\x27\x5b\xb0\x7f\xaa\x2d\x25\x5b\xb2\xa2\x94\x82\xaa\x39\xe7\x6c\x59\x3d\xac\x3b\xfc\x9e\xc2\x6f\x0d\x27\xe8\x66\x7f\x38\x06\xee\x78\xbb\x3b\x9d\x73\x7e\xd4\xf9\x1b\x4e\x79\xdf\xff\x61\x91\xff\xe6\x17\x9e\x8c\x9d\xb0\xd2\x84\x0f\x22\xc1\xdf\xa7\x07\x37\xc0\xb2\xe4\xbe\x06\xbe\x24\xdf\xfd\xa7\xdb\x85\xc0\x8a\x2b\xad\x0a\x07\xaf\xe3\x83\xaa\x62\x7d\xb7\x0f\xb5\x07\xe5\xea\x78\xc0\xaf\xd2\xbb\x7f\x0a\xab\x1b\x93\x3c\x34\xab\x49\x51\xc1\xf6\x9b\xdf\xce\xb3\xf0\x6a\x5b\x90\x92\x7f\xb5\x1f\xfd\x73\x08\xcf\x0e\x74\xaf\x07\xf9\xb0\x4b\x40\x57\xdb\xe2\xc7\x64\xf9\x70\x11\xc7\x04\x1e\xde\xbd\x9a\xeb\x6e\xa3\x2f\xb3\x7c\x09\xda\x87\x95\xdf\x0d\xf8\x21\x3c\xff\x0f\xe6\xc6\xea\x3f\x58\xf8\xe7\x08\xff\xad\x93\xbc\x1e\xee\xde\x3b\x70\xdd\xff\xf9\x03\x82\xdd\x4f\x7e\x0c\x60\x7f\xfc\x6a\x9c\x5f\x6a\xf7\x12\x94\xfb\xa2\xef\xa6\xb8\x07\xe4\x3f\x02\xf8\x9f\x01\x00\xbc\x11\x8c\xc7\x9c\x0b\x00\x00"),
 		},
 		"/cloudbuild.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudbuild.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2356,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x10\xf6\xa5\x05\x12\x39\xc9\x51\x3d\x39\x4e\x76\x57\x68\xd6\x29\x6c\xa7\x8b\x3d\x05\xf4\x88\x92\xd8\x8c\x66\xa6\x33\x94\xbd\x46\xea\xff\x5e\xe8\x2b\x89\xe3\xa4\xd8\x4b\x8b\x1e\xd6\xa7\x01\xf9\xf8\xf8\x44\x3e\x7a\x0c\x33\xeb\x76\x9e\x8b\x52\xe0\xe2\xec\xe2\x02\x3e\x5a\x5b\x68\x82\x9b\x9b\x59\x34\x8e\xc6\x70\xc3\x8a\x4c\xa0\x0c\x6a\x93\x91\x07\x29\x09\xa6\x0e\x55\x49\x43\xe6\x04\x7e\x27\x1f\xd8\x1a\xb8\x88\xcf\xe0\xa7\x06\x30\xea\x53\xa3\x9f\x7f\x89\xc6\xb0\xb3\x35\x54\xb8\x03\x63\x05\xea\x40\x20\x25\x07\xc8\x59\x13\xd0\x37\x45\x4e\x80\x0d\x28\x5b\x39\xcd\x68\x14\xc1\x96\xa5\x6c\xdb\xf4\x24\x71\x34\x86\xaf\x3d\x85\x5d\x0b\xb2\x01\x04\x65\xdd\x0e\x6c\xfe\x12\x07\x28\xad\xe0\xf6\x57\x8a\xb8\x64\x32\xd9\x6e\xb7\x31\xb6\x6a\x63\xeb\x8b\x89\xee\x90\x61\x72\x93\xce\xae\xe7\xcb\xeb\xd3\x8b\xf8\xac\xad\xb9\x33\x9a\x42\x00\x4f\x7f\xd6\xec\x29\x83\xf5\x0e\xd0\x39\xcd\x0a\xd7\x9a\x40\xe3\x16\xac\x07\x2c\x3c\x51\x06\x62\x1b\xc1\x5b\xcf\xc2\xa6\x38\x81\x60\x73\xd9\xa2\xa7\x68\x0c\x19\x07\xf1\xbc\xae\xe5\x60\x5a\x83\x3c\x0e\x07\x00\x6b\x00\x0d\x8c\xa6\x4b\x48\x97\x23\xb8\x9c\x2e\xd3\xe5\x49\x34\x86\x2f\xe9\xea\xd3\xed\xdd\x0a\xbe\x4c\x17\x8b\xe9\x7c\x95\x5e\x2f\xe1\x76\x01\xb3\xdb\xf9\x55\xba\x4a\x6f\xe7\x4b\xb8\xfd\x00\xd3\xf9\x57\xf8\x35\x9d\x5f\x9d\x00\xb1\x94\xe4\x81\xbe\x39\xdf\xe8\xb7\x1e\xb8\x99\x23\x65\xcd\xd0\x96\x44\x07\x02\x72\xdb\x09\x0a\x8e\x14\xe7\xac\x40\xa3\x29\x6a\x2c\x08\x0a\xbb\x21\x6f\xd8\x14\xe0\xc8\x57\x1c\x9a\x6d\x06\x40\x93\x45\x63\xd0\x5c\xb1\xa0\xb4\x91\xa3\x8f\x8a\xa3\x08\x1d\xf7\xfb\x4f\x40\x59\x4f\xb1\x32\xbe\x8a\x95\xb6\x75\x16\x17\xad\x95\x62\x65\xab\xc9\xe6\x1c\xb5\x2b\xf1\x3c\x7a\x60\x93\x25\xb0\x24\xbf\x61\x45\x9f\xd1\x39\x36\x45\x54\x91\x60\x86\x82\x49\x04\x60\xb0\xa2\x04\x5a\x82\x75\xcd\x3a\x7b\x9b\xb0\x07\x06\x87\xaa\x41\x1b\x5f\x9d\x86\x5d\x10\xaa\xa2\xe6\xf3\x9e\x79\x66\x4d\xdd\x65\xc3\x13\x01\x6c\x06\xa1\x9b\xf3\x35\x09\x9e\x47\x00\xa1\x13\xf2\xc9\x06\x99\xbf\x6e\xdc\x75\x43\xc7\xa1\xef\xe8\x29\xd8\xda\x2b\x0a\x0d\x3f\xc0\x69\xdf\xa3\xc3\xdd\x3f\x57\xde\xaf\x59\xd6\xb5\x7a\x20\xb9\x6f\xf8\xc9\xdf\x2b\x6b\x72\x2e\xa2\xce\x9c\xdd\x08\x9e\x95\x5d\x0e\xe8\x65\x0b\x9e\xbd\xc4\x62\x2d\xf6\x23\x19\xf2\x28\x94\x25\x20\xbe\xa6\x3e\xc3\xd9\x8a\x2a\xa7\x51\x28\x81\x91\xf3\xf6\x0f\x52\x12\x26\x8f\x8f\xfd\x73\xbf\x9f\x68\xab\xba\xc5\x4d\x1e\x1f\x87\xf7\x7e\x3f\x59\xbf\xd5\xae\xc1\x74\x22\xef\x39\xdb\xef\x47\x47\x4d\x66\x68\x2e\xe9\x2e\x50\xb6\xb2\x9f\x51\x54\xb9\xe8\x67\xd1\x4d\x2d\x47\x1d\x06\x61\xc3\x94\xa6\x1b\x64\xdd\x1c\x50\x6a\xa6\x21\x90\xa4\x66\x43\x46\xac\xdf\x1d\xc2\x87\xdd\xf7\x5e\x48\xfa\xf0\x93\x11\x06\x51\xaf\xd8\xd3\xab\x67\xa4\xa0\x2f\x48\x3e\x30\xe9\xec\xb8\xa0\x64\xf2\xe8\x55\xc9\x0a\xf5\x82\x72\xf2\x64\x9e\x36\xd8\x6d\x51\x76\x8e\x12\xe8\xe7\xf6\x14\x07\x78\xa0\xdd\x53\x78\x41\xf9\x2b\x01\xef\x70\xe5\xbd\x8c\xef\xa5\x6b\x7e\x19\x05\xe5\xd9\x49\xeb\xcd\xbf\x4e\x5f\x64\x00\x56\x25\x0d\x45\x20\x25\x4a\xf7\xf7\x39\x88\x80\x35\x69\x6b\x8a\x00\x62\xe3\x17\x65\xc5\xe6\x21\x39\x60\xe9\x2c\xf7\xdb\x91\xa6\x37\x6f\xe2\x05\x8f\xb7\xb5\x4b\x9e\xba\x55\x68\xb0\x20\xff\xee\x45\xfe\xd3\x49\x88\xe7\xa2\x20\xff\xce\x09\xac\x0e\xb2\xff\x82\xe9\xfb\xf6\x4d\xb4\x7f\xfe\xcf\x7c\xfe\xac\xea\x7b\x8d\x7e\x54\xf1\xc3\xe9\xff\x91\xd3\xff\x0e\x00\x00\xff\xff\x04\xbd\xc1\x79\x34\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\xd8\x97\x16\x48\xe4\x4d\x8e\xea\xc9\x71\xb2\xbb\x42\xb3\x4e\x61\x3b\x5d\xec\x29\x18\x53\x63\x89\x0d\x45\xb2\xe4\xc8\x5e\x23\xf5\x7f\x2f\x64\x4b\x71\x3e\x8b\x5e\x5a\xf4\x50\xcb\x07\x82\xf3\xe6\xcd\xd3\xcc\x1b\x0d\x31\x71\x7e\x1b\x74\x59\x09\xce\x3f\x9c\x9f\xe3\x93\x73\xa5\x61\x5c\x5f\x4f\x92\x61\x32\xc4\xb5\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xc6\x9e\x54\xc5\x7d\xe4\x04\xbf\x72\x88\xda\x59\x9c\xa7\x1f\xf0\x43\x0b\x18\x74\xa1\xc1\x8f\x3f\x25\x43\x6c\x5d\x83\x9a\xb6\xb0\x4e\xd0\x44\x86\x54\x3a\x62\xa5\x0d\x83\xbf\x2b\xf6\x02\x6d\xa1\x5c\xed\x8d\x26\xab\x18\x1b\x2d\x15\xe4\xc8\x9f\x26\x43\x7c\xeb\x28\xdc\x52\x48\x5b\x10\x94\xf3\x5b\xb8\xd5\x53\x1c\x48\xf6\x82\xf7\xbf\x4a\xc4\x67\xa3\xd1\x66\xb3\x49\x69\xaf\x36\x75\xa1\x1c\x99\x03\x32\x8e\xae\xf3\xc9\xd5\x74\x7e\x75\x7a\x9e\x7e\xd8\xe7\xdc\x5a\xc3\x31\x22\xf0\xef\x8d\x0e\x5c\x60\xb9\x05\x79\x6f\xb4\xa2\xa5\x61\x18\xda\xc0\x05\x50\x19\x98\x0b\x88\x6b\x05\x6f\x82\x16\x6d\xcb\x13\x44\xb7\x92\x0d\x05\x4e\x86\x28\x74\x94\xa0\x97\x8d\x3c\xeb\x56\x2f\x4f\xc7\x67\x00\x67\x41\x16\x83\xf1\x1c\xf9\x7c\x80\x8b\xf1\x3c\x9f\x9f\x24\x43\x7c\xcd\x17\x9f\x6f\x6e\x17\xf8\x3a\x9e\xcd\xc6\xd3\x45\x7e\x35\xc7\xcd\x0c\x93\x9b\xe9\x65\xbe\xc8\x6f\xa6\x73\xdc\x7c\xc4\x78\xfa\x0d\x3f\xe7\xd3\xcb\x13\xb0\x96\x8a\x03\xf8\xbb\x0f\xad\x7e\x17\xa0\xdb\x3e\x72\xd1\x36\x6d\xce\xfc\x4c\xc0\xca\x1d\xc6\x17\x3d\x2b\xbd\xd2\x0a\x86\x6c\xd9\x50\xc9\x28\xdd\x9a\x83\xd5\xb6\x84\xe7\x50\xeb\xd8\x4e\x33\x82\x6c\x91\x0c\x61\x74\xad\x85\x64\x7f\xf3\xea\xa5\xd2\x24\x21\xaf\xbb\xf9\x67\x50\x2e\x70\xaa\x6c\xa8\x53\x65\x5c\x53\xa4\xe5\xde\x4a\xa9\x72\xf5\x68\x7d\x46\xc6\x57\x74\x96\xdc\x6b\x5b\x64\x98\x73\x58\x6b\xc5\x5f\xc8\x7b\x6d\xcb\xa4\x66\xa1\x82\x84\xb2\x04\xb0\x54\x73\x86\x3d\xc1\xb2\xd1\xa6\x78\x9b\xb0\x03\x46\x4f\xaa\x45\xdb\x50\x9f\xc6\x6d\x14\xae\x93\xf6\xf5\x8e\x3c\x93\x96\xe7\xa2\xe5\x49\x80\x75\x2f\x74\x7d\xb6\x64\xa1\xb3\x04\x88\x07\x21\x9f\x5d\x94\xe9\xcb\xc2\x87\x6a\xe4\x75\xec\x2a\x06\x8e\xae\x09\x8a\x63\xcb\x0f\x9c\x76\x35\x0e\xb8\xbb\x63\xe6\xdd\x52\xcb\xb2\x51\xf7\x2c\x77\x2d\x3f\x87\x3b\xe5\xec\x4a\x97\xfb\x2c\xe0\xd0\x82\xa3\xb2\x8b\x1e\xdd\x76\x85\xc3\xe4\x29\x96\x1a\x71\x9f\xd8\x72\x20\xe1\x22\x83\x84\x86\xbb\x88\x2e\x16\x5c\x7b\x43\xc2\x19\x06\x3e\xb8\xdf\x58\x49\x1c\x3d\x3c\x74\xc7\xdd\x6e\x64\x9c\x3a\x0c\x6e\xf4\xf0\xd0\x9f\x77\xbb\xd1\xf2\xad\x72\x6d\xe6\x41\xe4\x9d\x2e\x76\xbb\xc1\xab\x22\x13\xb2\x17\x7c\x1b\xb9\x58\xb8\x2f\x24\xaa\x9a\x75\xbd\x38\x74\x6d\x45\x26\xf6\xc2\xfa\x2e\x8d\xd7\xa4\x4d\xbb\x40\xb9\x1d\xc7\xc8\x92\xdb\x35\x5b\x71\x61\xfb\x1c\xde\xcf\xbe\xf3\x42\xd6\x5d\x3f\x1a\xa1\x17\xf5\x82\x3d\xbf\x3c\x22\x85\x42\xc9\xf2\x51\xb3\x29\x5e\x27\x54\x9a\x03\x05\x55\x69\x45\x66\xc6\x2b\x0e\x6c\x1f\x27\xd8\xfe\x4f\x21\x5b\xcf\x19\xba\xbe\x3d\xde\x03\xf7\xbc\x7d\xbc\x9e\xf1\xea\x85\x80\x77\xb8\x56\x9d\x8c\xbf\x4b\xd7\x3e\x05\x47\x15\xb4\x6f\xe7\x93\xe1\x8f\xd3\x27\x11\x60\x51\x71\x9f\x04\xa9\x48\x0e\x9f\xcf\x5e\x04\x96\x6c\x9c\x2d\x23\xc4\xa5\x4f\xd2\xca\xf5\xfd\x51\xd4\xd1\x72\xbf\xbc\xd2\xf4\xe6\x4e\x1c\x9f\x32\xb8\xc6\x67\x8f\xd5\x6a\xb2\x54\x72\x78\x77\x23\xff\x6a\x25\x24\xe8\xb2\xe4\xf0\xce\x0a\x2c\x9e\x45\xff\x01\xd3\x77\xe5\xdb\xdb\xee\xf8\x1f\xf3\xf9\x51\xd5\x0b\xfa\x77\x8d\xfe\x2a\xe3\x7f\xa7\xff\x4b\x4e\xff\x73\x00\x04\xbd\xc1\x79\x34\x09\x00\x00"),
 		},
 		"/cloudbuildv2.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudbuildv2.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 829,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x73\xda\x30\x10\x85\xef\xfa\x15\x6f\xf0\xa5\x9d\x21\x26\x70\xa4\x27\x4a\xd2\xc6\x53\x6a\x66\x62\xd2\x4c\x8e\x8b\xbc\xd8\x3b\xb5\x25\x55\x92\x71\xf8\xf7\x1d\x1b\x32\x0d\x93\xea\xa8\x7d\x7a\xef\xdb\x5d\x25\x58\x5b\x77\xf2\x52\xd5\x11\x8b\xdb\xc5\x02\xdf\xad\xad\x1a\xc6\x66\xb3\x56\x89\x4a\xb0\x11\xcd\x26\x70\x89\xce\x94\xec\x11\x6b\xc6\xca\x91\xae\xf9\xad\x32\xc5\x2f\xf6\x41\xac\xc1\x22\xbd\xc5\xa7\x41\x30\xb9\x94\x26\x9f\xbf\xa8\x04\x27\xdb\xa1\xa5\x13\x8c\x8d\xe8\x02\x23\xd6\x12\x70\x90\x86\xc1\xaf\x9a\x5d\x84\x18\x68\xdb\xba\x46\xc8\x68\x46\x2f\xb1\x1e\x63\x2e\x26\xa9\x4a\xf0\x72\xb1\xb0\xfb\x48\x62\x40\xd0\xd6\x9d\x60\x0f\xef\x75\xa0\x38\x02\x8f\xa7\x8e\xd1\x2d\x67\xb3\xbe\xef\x53\x1a\x69\x53\xeb\xab\x59\x73\x56\x86\xd9\x26\x5b\xdf\xe7\xc5\xfd\xcd\x22\xbd\x1d\xdf\x3c\x99\x86\x43\x80\xe7\x3f\x9d\x78\x2e\xb1\x3f\x81\x9c\x6b\x44\xd3\xbe\x61\x34\xd4\xc3\x7a\x50\xe5\x99\x4b\x44\x3b\x00\xf7\x5e\xa2\x98\x6a\x8a\x60\x0f\xb1\x27\xcf\x2a\x41\x29\x21\x7a\xd9\x77\xf1\x6a\x5a\x6f\x78\x12\xae\x04\xd6\x80\x0c\x26\xab\x02\x59\x31\xc1\xd7\x55\x91\x15\x53\x95\xe0\x39\xdb\x3d\x6c\x9f\x76\x78\x5e\x3d\x3e\xae\xf2\x5d\x76\x5f\x60\xfb\x88\xf5\x36\xbf\xcb\x76\xd9\x36\x2f\xb0\xfd\x86\x55\xfe\x82\x1f\x59\x7e\x37\x05\x4b\xac\xd9\x83\x5f\x9d\x1f\xf8\xad\x87\x0c\x73\xe4\x72\x18\x5a\xc1\x7c\x05\x70\xb0\x67\xa0\xe0\x58\xcb\x41\x34\x1a\x32\x55\x47\x15\xa3\xb2\x47\xf6\x46\x4c\x05\xc7\xbe\x95\x30\x6c\x33\x80\x4c\xa9\x12\x34\xd2\x4a\xa4\x38\xde\x7c\x68\x2a\x55\x8a\x9c\x5c\xf6\xbf\x84\xb6\x9e\x53\x6d\x7c\x9b\xea\xc6\x76\x65\x5a\x8d\x5f\x29\xd5\xb6\x9d\x1d\xe7\xd4\xb8\x9a\xe6\xea\xb7\x98\x72\x89\x82\xfd\x51\x34\xff\x24\xe7\xc4\x54\xaa\xe5\x48\x25\x45\x5a\x2a\xc0\x50\xcb\x4b\x8c\x06\xfb\x4e\x9a\xf2\xb8\xf8\xbf\xe5\x45\x1a\x1c\xe9\x41\x6f\x7c\x7b\x13\x4e\x21\x72\xab\x86\x06\xff\x39\xad\xdf\x39\x29\xe0\xf8\x06\x7b\x9c\xef\x39\xd2\x5c\x01\xe1\x0c\xf3\x60\x43\xcc\x3f\x86\x9f\x13\xc9\x49\xb8\xa4\x7a\x0e\xb6\xf3\x9a\xc3\x52\xfd\x0d\x00\x00\xff\xff\xb0\x42\x78\x2f\x3d\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xc1\x6e\xdb\x3c\x10\x84\xef\x7c\x8a\x81\x75\xf9\x7f\xc0\x91\x63\x1f\xdd\x93\xeb\xa4\x8d\x50\x57\x06\x22\xa7\x41\x8e\x6b\x6a\x2d\x2d\x2a\x91\x2c\x49\x59\xf1\xdb\x17\x92\x1d\x24\x46\xca\x23\x77\x38\xfb\xed\x0e\x13\xac\xad\x3b\x79\xa9\xea\x88\xc5\xed\x62\x81\xef\xd6\x56\x0d\x63\xb3\x59\xab\x44\x25\xd8\x88\x66\x13\xb8\x44\x67\x4a\xf6\x88\x35\x63\xe5\x48\xd7\xfc\x56\x99\xe2\x17\xfb\x20\xd6\x60\x91\xde\xe2\xbf\x41\x30\xb9\x94\x26\xff\x7f\x51\x09\x4e\xb6\x43\x4b\x27\x18\x1b\xd1\x05\x46\xac\x25\xe0\x20\x0d\x83\x5f\x35\xbb\x08\x31\xd0\xb6\x75\x8d\x90\xd1\x8c\x5e\x62\x8d\xf8\xee\x9f\xaa\x04\x2f\x17\x0b\xbb\x8f\x24\x06\x04\x6d\xdd\x09\xf6\xf0\x51\x07\x8a\x23\xf0\x78\xea\x18\xdd\x72\x36\xeb\xfb\x3e\xa5\x91\x36\xb5\xbe\x9a\x35\x67\x65\x98\x6d\xb2\xf5\x7d\x5e\xdc\xdf\x2c\xd2\xdb\xf1\xcd\x93\x69\x38\x04\x78\xfe\xd3\x89\xe7\x12\xfb\x13\xc8\xb9\x46\x34\xed\x1b\x46\x43\x3d\xac\x07\x55\x9e\xb9\x44\xb4\x03\x70\xef\x25\x8a\xa9\xa6\x08\xf6\x10\x7b\xf2\xac\x12\x94\x12\xa2\x97\x7d\x17\xaf\xb6\xf5\x86\x27\xe1\x4a\x60\x0d\xc8\x60\xb2\x2a\x90\x15\x13\x7c\x5d\x15\x59\x31\x55\x09\x9e\xb3\xdd\xc3\xf6\x69\x87\xe7\xd5\xe3\xe3\x2a\xdf\x65\xf7\x05\xb6\x8f\x58\x6f\xf3\xbb\x6c\x97\x6d\xf3\x02\xdb\x6f\x58\xe5\x2f\xf8\x91\xe5\x77\x53\xb0\xc4\x9a\x3d\xf8\xd5\xf9\x81\xdf\x7a\xc8\xb0\x47\x2e\x87\xa5\x15\xcc\x57\x00\x07\x7b\x8e\x2f\x38\xd6\x72\x10\x8d\x86\x4c\xd5\x51\xc5\xa8\xec\x91\xbd\x11\x53\xc1\xb1\x6f\x25\x0c\x69\x06\x90\x29\x55\x82\x46\x5a\x89\x14\xc7\x9b\x4f\x43\xa5\x4a\x91\x93\x4b\xfe\x4b\x68\xeb\x39\xd5\xc6\xb7\xa9\x6e\x6c\x57\xa6\xd5\xf8\x95\x52\x6d\xdb\xd9\x71\x4e\x8d\xab\x69\xae\x7e\x8b\x29\x97\x28\xd8\x1f\x45\xf3\x4f\x72\x4e\x4c\xa5\x5a\x8e\x54\x52\xa4\xa5\x02\x0c\xb5\xbc\xc4\x68\xb0\xef\xa4\x29\x8f\x8b\x7f\x5b\x5e\xa4\xc1\x91\x1e\xf4\xc6\xb7\x37\xe1\x14\x22\xb7\x6a\x18\xf0\xdd\x69\xfd\xc1\x49\x01\xc7\x37\xd8\xe3\x7c\xcf\x91\xe6\x0a\x08\x67\x98\x07\x1b\x62\xfe\xb9\xf9\xb9\x23\x39\x09\x97\xae\x9e\x83\xed\xbc\xe6\xb0\x54\x7f\x07\x00\xb0\x42\x78\x2f\x3d\x03\x00\x00"),
 		},
 		"/cloudfunctions.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudfunctions.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 835,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6f\xda\x40\x10\x85\xef\xfb\x2b\x9e\xf0\xa5\x95\x88\x09\x1c\xe9\x89\x92\xa4\xb1\x4a\x8d\x14\x93\x46\x39\x0e\xeb\xc1\x1e\xd5\xde\xdd\xee\xae\x71\xf8\xf7\x95\x0d\xa8\x8d\x1a\x1f\x3d\x6f\xde\xfb\x66\x66\x13\xac\xad\x3b\x79\xa9\xea\x88\xc5\xed\x62\x81\x6f\xd6\x56\x0d\x63\xb3\x59\xab\x44\x25\xd8\x88\x66\x13\xb8\x44\x67\x4a\xf6\x88\x35\x63\xe5\x48\xd7\x7c\xad\x4c\xf1\x93\x7d\x10\x6b\xb0\x48\x6f\xf1\x69\x10\x4c\x2e\xa5\xc9\xe7\x2f\x2a\xc1\xc9\x76\x68\xe9\x04\x63\x23\xba\xc0\x88\xb5\x04\x1c\xa4\x61\xf0\x9b\x66\x17\x21\x06\xda\xb6\xae\x11\x32\x9a\xd1\x4b\xac\xc7\x98\x8b\x49\xaa\x12\xbc\x5e\x2c\xec\x3e\x92\x18\x10\xb4\x75\x27\xd8\xc3\xbf\x3a\x50\x1c\x81\xc7\xaf\x8e\xd1\x2d\x67\xb3\xbe\xef\x53\x1a\x69\x53\xeb\xab\x59\x73\x56\x86\xd9\x26\x5b\xdf\xe7\xc5\xfd\xcd\x22\xbd\x1d\x7b\x9e\x4d\xc3\x21\xc0\xf3\xef\x4e\x3c\x97\xd8\x9f\x40\xce\x35\xa2\x69\xdf\x30\x1a\xea\x61\x3d\xa8\xf2\xcc\x25\xa2\x1d\x80\x7b\x2f\x51\x4c\x35\x45\xb0\x87\xd8\x93\x67\x95\xa0\x94\x10\xbd\xec\xbb\xf8\x6e\x5b\x57\x3c\x09\xef\x04\xd6\x80\x0c\x26\xab\x02\x59\x31\xc1\xd7\x55\x91\x15\x53\x95\xe0\x25\xdb\x3d\x6e\x9f\x77\x78\x59\x3d\x3d\xad\xf2\x5d\x76\x5f\x60\xfb\x84\xf5\x36\xbf\xcb\x76\xd9\x36\x2f\xb0\x7d\xc0\x2a\x7f\xc5\xf7\x2c\xbf\x9b\x82\x25\xd6\xec\xc1\x6f\xce\x0f\xfc\xd6\x43\x86\x3d\x72\x39\x2c\xad\x60\x7e\x07\x70\xb0\x67\xa0\xe0\x58\xcb\x41\x34\x1a\x32\x55\x47\x15\xa3\xb2\x47\xf6\x46\x4c\x05\xc7\xbe\x95\x30\x5c\x33\x80\x4c\xa9\x12\x34\xd2\x4a\xa4\x38\xfe\xf9\x6f\xa8\x54\x29\x72\x72\xb9\xff\x12\xda\x7a\x4e\xb5\xf1\x6d\xaa\x1b\xdb\x95\x69\x35\x3e\xa5\x54\xdb\x76\x76\x9c\x53\xe3\x6a\x9a\xab\x5f\x62\xca\x25\x0a\xf6\x47\xd1\xfc\x83\x9c\x13\x53\xa9\x96\x23\x95\x14\x69\xa9\x00\x43\x2d\x2f\x31\x1a\x1c\x3a\xa3\xc7\xe4\x8f\x4d\x2f\xe2\xe0\x48\x0f\x1d\xc6\xb7\x37\xe1\x14\x22\xb7\x6a\x18\xf1\xaf\xd7\x7a\xe8\x7b\xb8\x7a\x29\xe0\x78\x05\x3e\xce\xf7\x1c\x69\xae\x80\x70\x06\x7a\xb4\x21\xe6\x1f\x01\x9c\x53\xc9\x49\xb8\x24\x7b\x0e\xb6\xf3\x9a\xc3\x52\xfd\x09\x00\x00\xff\xff\x16\x61\x05\xfa\x43\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xc1\x6e\xdb\x3a\x10\x45\xf7\xfc\x8a\x0b\x6b\xf3\x1e\xe0\xc8\xb1\x97\xee\xca\x75\x92\x46\xa8\x2b\x03\x91\xd3\x20\xcb\x31\x35\x96\x06\x95\x48\x96\xa4\xac\xf8\xef\x0b\xc9\x36\xd2\xa0\xd1\x52\xbc\x3c\x73\x66\x86\x09\xd6\xd6\x9d\xbc\x54\x75\xc4\xe2\x76\xb1\xc0\x37\x6b\xab\x86\xb1\xd9\xac\x55\xa2\x12\x6c\x44\xb3\x09\x5c\xa2\x33\x25\x7b\xc4\x9a\xb1\x72\xa4\x6b\xbe\x9e\x4c\xf1\x93\x7d\x10\x6b\xb0\x48\x6f\xf1\xdf\x10\x98\x5c\x8e\x26\xff\x7f\x51\x09\x4e\xb6\x43\x4b\x27\x18\x1b\xd1\x05\x46\xac\x25\xe0\x20\x0d\x83\xdf\x34\xbb\x08\x31\xd0\xb6\x75\x8d\x90\xd1\x8c\x5e\x62\x8d\xf8\xce\x4f\x55\x82\xd7\x0b\xc2\xee\x23\x89\x01\x41\x5b\x77\x82\x3d\xfc\x9d\x03\xc5\x51\x78\xfc\xea\x18\xdd\x72\x36\xeb\xfb\x3e\xa5\xd1\x36\xb5\xbe\x9a\x35\xe7\x64\x98\x6d\xb2\xf5\x7d\x5e\xdc\xdf\x2c\xd2\xdb\xf1\xce\xb3\x69\x38\x04\x78\xfe\xdd\x89\xe7\x12\xfb\x13\xc8\xb9\x46\x34\xed\x1b\x46\x43\x3d\xac\x07\x55\x9e\xb9\x44\xb4\x83\x70\xef\x25\x8a\xa9\xa6\x08\xf6\x10\x7b\xf2\xac\x12\x94\x12\xa2\x97\x7d\x17\x3f\x4c\xeb\xaa\x27\xe1\x43\xc0\x1a\x90\xc1\x64\x55\x20\x2b\x26\xf8\xba\x2a\xb2\x62\xaa\x12\xbc\x64\xbb\xc7\xed\xf3\x0e\x2f\xab\xa7\xa7\x55\xbe\xcb\xee\x0b\x6c\x9f\xb0\xde\xe6\x77\xd9\x2e\xdb\xe6\x05\xb6\x0f\x58\xe5\xaf\xf8\x9e\xe5\x77\x53\xb0\xc4\x9a\x3d\xf8\xcd\xf9\xc1\xdf\x7a\xc8\x30\x47\x2e\x87\xa1\x15\xcc\x1f\x04\x0e\xf6\xbc\xbe\xe0\x58\xcb\x41\x34\x1a\x32\x55\x47\x15\xa3\xb2\x47\xf6\x46\x4c\x05\xc7\xbe\x95\x30\x6c\x33\x80\x4c\xa9\x12\x34\xd2\x4a\xa4\x38\xfe\xf9\xa7\xa9\x54\x29\x72\x72\xd9\xff\x12\xda\x7a\x4e\xb5\xf1\x6d\xaa\x1b\xdb\x95\x69\x35\x3e\xa5\x54\xdb\x76\x76\x9c\x53\xe3\x6a\x9a\xab\x5f\x62\xca\x25\x0a\xf6\x47\xd1\xfc\x83\x9c\x13\x53\xa9\x96\x23\x95\x14\x69\xa9\x00\x43\x2d\x2f\x31\x02\x0e\x9d\xd1\x63\xe5\xcf\xa1\x97\x70\x70\xa4\x87\x1b\xc6\xb7\x37\xe1\x14\x22\xb7\x6a\x68\xf1\x9d\xb5\x1e\x58\x0f\x57\x96\x02\x8e\x57\xe1\xe3\x7c\xcf\x91\xe6\x0a\x08\x67\xa1\x47\x1b\x62\xfe\x99\xc0\xb9\x2a\x39\x09\x97\xca\x9e\x83\xed\xbc\xe6\xb0\x54\x7f\x06\x00\x16\x61\x05\xfa\x43\x03\x00\x00"),
 		},
 		"/cloudfunctions2.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudfunctions2.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1861,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\x52\xe2\xa3\x7a\xf2\x3a\xc9\xae\xd0\xc4\x29\x6c\xa7\x8b\x3d\x05\x63\x6a\x24\xb1\xa1\x48\x96\x1c\xd9\x2b\xb8\xfe\xef\x85\xbe\xfc\x95\x2c\x10\x5d\x2c\x90\x6f\xde\x7b\x7c\x33\xa2\xc7\x30\x33\xb6\x76\x32\x2f\x18\x26\x37\x93\x09\x7c\x35\x26\x57\x04\x8f\x8f\xb3\x60\x1c\x8c\xe1\x51\x0a\xd2\x9e\x52\xa8\x74\x4a\x0e\xb8\x20\x98\x5a\x14\x05\x0d\x3b\x57\xf0\x37\x39\x2f\x8d\x86\x49\x78\x03\xbf\x35\x80\x51\xbf\x35\xfa\xfd\x8f\x60\x0c\xb5\xa9\xa0\xc4\x1a\xb4\x61\xa8\x3c\x01\x17\xd2\x43\x26\x15\x01\xfd\x14\x64\x19\xa4\x06\x61\x4a\xab\x24\x6a\x41\xb0\x95\x5c\xb4\x32\x3d\x49\x18\x8c\xe1\x47\x4f\x61\xd6\x8c\x52\x03\x82\x30\xb6\x06\x93\x9d\xe2\x00\xb9\x35\xdc\x3e\x05\xb3\x8d\xa3\x68\xbb\xdd\x86\xd8\xba\x0d\x8d\xcb\x23\xd5\x21\x7d\xf4\x98\xcc\xee\xe7\xcb\xfb\xeb\x49\x78\xd3\xd6\xbc\x68\x45\xde\x83\xa3\x7f\x2b\xe9\x28\x85\x75\x0d\x68\xad\x92\x02\xd7\x8a\x40\xe1\x16\x8c\x03\xcc\x1d\x51\x0a\x6c\x1a\xc3\x5b\x27\x59\xea\xfc\x0a\xbc\xc9\x78\x8b\x8e\x82\x31\xa4\xd2\xb3\x93\xeb\x8a\xcf\xd2\x1a\xec\x49\x7f\x06\x30\x1a\x50\xc3\x68\xba\x84\x64\x39\x82\x2f\xd3\x65\xb2\xbc\x0a\xc6\xf0\x3d\x59\x7d\x7b\x7e\x59\xc1\xf7\xe9\x62\x31\x9d\xaf\x92\xfb\x25\x3c\x2f\x60\xf6\x3c\xbf\x4b\x56\xc9\xf3\x7c\x09\xcf\x0f\x30\x9d\xff\x80\x3f\x93\xf9\xdd\x15\x90\xe4\x82\x1c\xd0\x4f\xeb\x1a\xff\xc6\x81\x6c\x72\xa4\xb4\x09\x6d\x49\x74\x66\x20\x33\x9d\x21\x6f\x49\xc8\x4c\x0a\x50\xa8\xf3\x0a\x73\x82\xdc\x6c\xc8\x69\xa9\x73\xb0\xe4\x4a\xe9\x9b\x6e\x7a\x40\x9d\x06\x63\x50\xb2\x94\x8c\xdc\xae\xbc\x3b\x54\x18\x04\x68\x65\xdf\xff\x18\x84\x71\x14\x0a\xed\xca\x50\x28\x53\xa5\x61\xde\x8e\x52\x28\x4c\x19\x6d\x6e\x51\xd9\x02\x6f\x83\x37\xa9\xd3\x18\x96\xe4\x36\x52\xd0\x13\x5a\x2b\x75\x1e\x94\xc4\x98\x22\x63\x1c\x00\x68\x2c\x29\x86\x96\x20\xab\xb4\x68\x95\x27\x1f\xb3\xf6\x68\x6f\x51\x34\x25\xda\x95\xd7\xbe\xf6\x4c\x65\xd0\x9c\xf1\x48\x36\x6b\xea\x1e\x0e\x64\x01\xc0\x66\xb0\xbc\xb9\x5d\x13\xe3\x6d\x00\xe0\x3b\x4b\xdf\x8c\xe7\xf9\x87\x16\x3a\x5d\xb4\xd2\xf7\xda\x8e\xbc\xa9\x9c\x20\xdf\x28\x01\x5c\xf7\x6a\x1d\xee\xf5\xa2\xfc\x75\x78\x0d\xba\x09\xed\x72\xb8\x70\xf6\x70\x8e\xc1\x8a\xcd\x57\xd2\xe4\x90\x29\x8d\x81\x5d\x45\xfd\x8e\xc4\x72\x66\x74\x26\xf3\xb8\x5f\x00\xb0\x46\x49\x51\xcf\x3f\x63\xe1\x55\x62\xf9\xda\xe1\x2f\xca\x9f\xa8\x5c\x93\xfb\x3c\x49\xd9\xe2\x0f\x24\x8e\x32\x72\xa4\x05\x3d\x48\x52\xe9\xd1\xdb\x59\x57\x2f\x93\x68\x1e\xae\x2d\xc5\x2d\xe8\xb0\xe8\x2b\x6b\x8d\x63\x3f\x33\x3a\x95\xad\x76\x0c\x19\x2a\x7f\x88\x20\x5d\x51\x69\x15\x32\xc5\x30\xb2\xce\xfc\x43\x82\x7d\xb4\xdb\xf5\xaf\xfb\x7d\xa4\x8c\xe8\x06\x37\xda\xed\x86\xf7\xfd\x3e\x3a\x9c\x25\xda\xed\x1a\xc5\xfd\x7e\xf4\x8e\x72\x86\xfa\x0b\xbd\x78\x4a\x57\xe6\x09\x59\x14\x8b\xbe\xd5\x5d\x32\xa7\x36\x86\x21\x98\x6e\x50\xaa\xe6\xba\x48\xf4\xd4\x7b\xe2\x44\x6f\x48\xb3\x71\xf5\x39\x7c\x98\xf4\x7e\xf2\x8f\x11\x75\x01\x9d\x25\xa0\x70\x4d\xca\xc7\xfd\xef\x85\x5e\x72\x77\xac\x65\x74\x39\x71\x97\xf9\x29\x45\x21\xc9\xa1\x13\x85\x14\xa8\x16\x43\x6b\xfc\xb1\xee\xba\xcf\xbd\x8f\xec\xa4\x1f\x6f\x54\x1f\x96\x17\x94\x5d\x68\xff\x82\x2b\xeb\x1d\x7c\x96\xae\x79\x52\xf2\xc2\x49\xcb\xed\xc7\xf8\xdf\xf5\xc9\x0e\xc0\xaa\xa0\xa1\x08\xb8\x40\xee\xfe\x39\x06\x13\xb0\x26\x65\x74\xee\x81\x4d\x78\x52\x96\x6f\xde\xe2\x33\x96\xee\x43\xfb\xeb\x9d\xa7\x0f\x2f\x81\x13\x1e\x67\x2a\x1b\x1f\xd4\x4a\xd4\x98\x93\xfb\xc5\x3d\xf4\x7f\x00\x00\x00\xff\xff\x08\x02\x3e\x56\x45\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xe3\x36\x14\xbc\xeb\x57\x0c\xec\x4b\x0b\x24\xf6\xc6\x47\xf5\xe4\xf5\x26\xbb\x42\x13\xa7\xb0\x9d\x2e\xf6\x14\x3c\x53\x4f\x12\x1b\x8a\x64\x49\xca\x5e\x23\xf5\x7f\x2f\xf4\xe5\xaf\x64\x81\x44\x01\x4c\x90\xf3\x66\x46\xf3\x9e\xe9\x21\x66\xc6\xee\x9c\xcc\x8b\x80\xc9\xa7\xc9\x04\x5f\x8d\xc9\x15\xe3\xfe\x7e\x16\x0d\xa3\x21\xee\xa5\x60\xed\x39\x45\xa5\x53\x76\x08\x05\x63\x6a\x49\x14\xdc\x9f\x5c\xe1\x6f\x76\x5e\x1a\x8d\xc9\xe8\x13\x7e\xab\x01\x83\xee\x68\xf0\xfb\x1f\xd1\x10\x3b\x53\xa1\xa4\x1d\xb4\x09\xa8\x3c\x23\x14\xd2\x23\x93\x8a\xc1\x3f\x05\xdb\x00\xa9\x21\x4c\x69\x95\x24\x2d\x18\x5b\x19\x0a\x84\x23\xff\x28\x1a\xe2\x47\x47\x61\xd6\x81\xa4\x06\x41\x18\xbb\x83\xc9\x4e\x71\xa0\xd0\x18\x6e\xfe\x8a\x10\x6c\x3c\x1e\x6f\xb7\xdb\x11\x35\x6e\x47\xc6\xe5\x63\xd5\x22\xfd\xf8\x3e\x99\xdd\xce\x97\xb7\xd7\x93\xd1\xa7\xa6\xe6\x49\x2b\xf6\x1e\x8e\xff\xad\xa4\xe3\x14\xeb\x1d\xc8\x5a\x25\x05\xad\x15\x43\xd1\x16\xc6\x81\x72\xc7\x9c\x22\x98\xda\xf0\xd6\xc9\x20\x75\x7e\x05\x6f\xb2\xb0\x25\xc7\xd1\x10\xa9\xf4\xc1\xc9\x75\x15\xce\xd2\xea\xed\x49\x7f\x06\x30\x1a\xa4\x31\x98\x2e\x91\x2c\x07\xf8\x3c\x5d\x26\xcb\xab\x68\x88\xef\xc9\xea\xdb\xe3\xd3\x0a\xdf\xa7\x8b\xc5\x74\xbe\x4a\x6e\x97\x78\x5c\x60\xf6\x38\xff\x92\xac\x92\xc7\xf9\x12\x8f\x77\x98\xce\x7f\xe0\xcf\x64\xfe\xe5\x0a\x2c\x43\xc1\x0e\xfc\xd3\xba\xda\xbf\x71\x90\x75\x8e\x9c\xd6\xa1\x2d\x99\xcf\x0c\x64\xa6\x6d\x9f\xb7\x2c\x64\x26\x05\x14\xe9\xbc\xa2\x9c\x91\x9b\x0d\x3b\x2d\x75\x0e\xcb\xae\x94\xbe\xee\xa6\x07\xe9\x34\x1a\x42\xc9\x52\x06\x0a\xcd\xce\x9b\x97\x1a\x45\x11\x59\xd9\xf5\x3f\x86\x30\x8e\x47\x42\xbb\x72\x24\x94\xa9\xd2\x51\xde\x8c\xd2\x48\x98\x72\xbc\xb9\x21\x65\x0b\xba\x89\x5e\xa4\x4e\x63\x2c\xd9\x6d\xa4\xe0\x07\xb2\x56\xea\x3c\x2a\x39\x50\x4a\x81\xe2\x08\xd0\x54\x72\x8c\x86\x20\xab\xb4\x68\x94\x27\xef\xb3\x76\x68\x6f\x49\xd4\x25\xda\x95\xd7\x7e\xe7\x03\x97\x51\xfd\x8e\x47\xb2\x59\x4d\x76\x77\x20\x8b\x80\x4d\x6f\x79\x73\xb3\xe6\x40\x37\x11\xe0\x5b\x4b\xdf\x8c\x0f\xf3\x77\x2d\xb4\xba\x64\xa5\xef\xb4\x1d\x7b\x53\x39\xc1\xbe\x56\x02\xae\x3b\xb5\x16\xf7\x7c\x51\xfe\xdc\x2f\x1b\x2c\xd0\xe6\x70\xe1\xec\xee\x1c\x43\x55\x30\x5f\x59\xb3\xa3\xc0\x69\x8c\xe0\x2a\xee\x4e\x24\x95\x33\xa3\x33\x99\xc7\xdd\x06\x60\x8d\x92\x62\x37\xff\x88\x85\x67\x49\xe5\x73\x8b\xbf\x28\x7f\xe0\x72\xcd\xee\xe3\x24\x65\x83\x3f\x90\x38\xce\xd8\xb1\x16\x7c\x27\x59\xa5\x47\x6f\x67\x5d\x3d\x30\x9c\x1c\x87\x9d\xe5\xb8\x01\x1d\x36\x7d\x65\xad\x71\xc1\xcf\x8c\x4e\x65\xa3\x1d\x23\x23\xe5\x0f\x11\xa4\x2b\x2e\xad\xa2\xc0\x31\x06\xd6\x99\x7f\x58\x04\x3f\x7e\x7d\xed\x96\xfb\xfd\x58\x19\xd1\x0e\xee\xf8\xf5\xb5\x5f\xef\xf7\xe3\x5e\xbe\x06\xd7\x8a\xfb\xfd\xe0\x0d\xe5\x8c\xf4\x67\x7e\xf2\x9c\xae\xcc\x03\x05\x51\x2c\xba\x56\xb7\xc9\x9c\xda\xe8\x87\x60\xba\x21\xa9\xea\xeb\x22\xd1\x53\xef\x39\x24\x7a\xc3\x3a\x18\xb7\x3b\x87\xf7\x93\xde\x4d\xfe\x31\xa2\xda\xc9\x45\x02\x8a\xd6\xac\x7c\xdc\x7d\x5e\xe8\x25\x5f\x8e\xb5\x81\x5c\xce\xa1\xcd\xfc\x94\xa2\x90\xec\xc8\x89\x42\x0a\x52\x8b\xbe\x35\xdd\xb4\xd6\xff\xd7\x5d\xee\x5d\x64\x87\x7d\xe0\x85\x77\x87\xed\x05\x67\x17\xda\xbf\xe0\xca\x3a\x07\x1f\xa5\xab\x9f\x94\xbd\x70\xd2\xd6\xfd\x88\xf1\xdf\xf5\xc9\x09\xb0\x2a\xb8\x2f\x42\x28\x28\xb4\xbf\x1c\xbd\x09\xac\x59\x19\x9d\x7b\x04\x33\x3a\x29\xcb\x37\x2f\x47\x53\xc7\x2f\xda\x5f\x6f\x3c\xbd\x7b\x09\x1c\x9f\xdc\x99\xca\xc6\x07\xb5\x92\x34\xe5\xec\x7e\x71\x0f\xfd\x3f\x00\x08\x02\x3e\x56\x45\x07\x00\x00"),
 		},
 		"/cloudidentity.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudidentity.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1912,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x6f\xe2\x48\x10\xbd\xfb\x57\x3c\xc1\x65\x57\x4a\x20\xc9\xd1\xab\xd5\x8a\x21\x99\x8c\xb5\x09\x91\x80\xec\x68\x4e\x51\x61\x97\xed\x52\xda\xdd\xbd\xdd\x6d\x18\x84\xf8\xef\x2b\x7f\x90\x40\x32\x52\xe6\xb2\xd2\xf8\x82\xa8\x7a\xf5\xea\xd5\xab\xee\x1e\x62\x6a\xec\xd6\x49\x51\x06\x5c\x5d\x5c\x5d\xe1\xd6\x98\x42\x31\xee\xee\xa6\xd1\x30\x1a\xe2\x4e\x52\xd6\x9e\x33\xd4\x3a\x63\x87\x50\x32\x26\x96\xd2\x92\x0f\x99\x33\xfc\xc3\xce\x8b\xd1\xb8\x1a\x5d\xe0\xb7\x06\x30\xe8\x53\x83\xdf\xff\x88\x86\xd8\x9a\x1a\x15\x6d\xa1\x4d\x40\xed\x19\xa1\x14\x8f\x5c\x14\x83\xbf\xa7\x6c\x03\x44\x23\x35\x95\x55\x42\x3a\x65\x6c\x24\x94\x6d\x9b\x9e\x64\x14\x0d\xf1\xad\xa7\x30\xab\x40\xa2\x41\x48\x8d\xdd\xc2\xe4\xc7\x38\x50\x68\x05\xb7\x5f\x19\x82\x8d\xc7\xe3\xcd\x66\x33\xa2\x56\xed\xc8\xb8\x62\xac\x3a\xa4\x1f\xdf\x25\xd3\x9b\xd9\xe2\xe6\xfc\x6a\x74\xd1\xd6\x3c\x6a\xc5\xde\xc3\xf1\xbf\xb5\x38\xce\xb0\xda\x82\xac\x55\x92\xd2\x4a\x31\x14\x6d\x60\x1c\xa8\x70\xcc\x19\x82\x69\x04\x6f\x9c\x04\xd1\xc5\x19\xbc\xc9\xc3\x86\x1c\x47\x43\x64\xe2\x83\x93\x55\x1d\x4e\xdc\x3a\xc8\x13\x7f\x02\x30\x1a\xa4\x31\x98\x2c\x90\x2c\x06\xf8\x34\x59\x24\x8b\xb3\x68\x88\xaf\xc9\xf2\xcb\xc3\xe3\x12\x5f\x27\xf3\xf9\x64\xb6\x4c\x6e\x16\x78\x98\x63\xfa\x30\xbb\x4e\x96\xc9\xc3\x6c\x81\x87\xcf\x98\xcc\xbe\xe1\xef\x64\x76\x7d\x06\x96\x50\xb2\x03\x7f\xb7\xae\xd1\x6f\x1c\xa4\xf1\x91\xb3\xc6\xb4\x05\xf3\x89\x80\xdc\x74\x82\xbc\xe5\x54\x72\x49\xa1\x48\x17\x35\x15\x8c\xc2\xac\xd9\x69\xd1\x05\x2c\xbb\x4a\x7c\xb3\x4d\x0f\xd2\x59\x34\x84\x92\x4a\x02\x85\x36\xf2\x6e\xa8\x51\x14\x91\x95\x7e\xff\x31\x52\xe3\x78\x94\x6a\x57\x8d\x52\x65\xea\x6c\x54\xb4\x47\x69\x94\x9a\x6a\xbc\xbe\x24\x65\x4b\xba\x8c\x9e\x45\x67\x31\x16\xec\xd6\x92\xf2\x3d\x59\x2b\xba\x88\x2a\x0e\x94\x51\xa0\x38\x02\x34\x55\x1c\xa3\x25\x90\x8c\x75\x90\xb0\xfd\x31\x67\x8f\xf5\x96\xd2\xa6\x40\xbb\xea\xdc\x6f\x7d\xe0\x2a\x6a\x26\x7c\xa5\x9a\x36\x75\x49\x4f\x15\x01\xeb\x83\xdc\xf5\xe5\x8a\x03\x5d\x46\x80\xef\xe4\x7c\x31\x3e\xcc\x7e\xd0\xbe\xeb\x49\x56\x7c\xdf\xd7\xb1\x37\xb5\x4b\xd9\x37\x5d\x80\xf3\xbe\x53\x87\x7b\x6a\x8b\x9f\x0e\xd5\x4f\x85\x33\xb5\x8d\xba\x73\xd9\x4d\x7f\xa2\xe8\xf6\x28\x4d\x75\x30\xb7\xac\xd9\x51\xe0\x2c\x46\x70\x35\xf7\x19\xc9\x96\x5c\x59\x45\x81\x63\x0c\x76\xbb\xa6\xdf\x7e\x3f\x78\x97\x9c\x92\xfe\xc4\x8f\x9e\xb3\xa5\xb9\xa7\x90\x96\xf3\x5e\x68\x37\x55\x4e\xca\x1f\x08\x0f\x23\x4c\xd6\x24\xaa\x39\xe6\x89\x9e\x78\xcf\x21\xd1\x6b\xd6\xc1\xb8\xed\x29\xfc\xb0\xa1\x7e\x63\x71\x1f\x06\x14\xad\x58\xf9\xb8\xff\xed\xc3\x8d\x9f\xec\x5e\x26\x49\xae\x3f\x0b\xab\x2c\x6e\x6d\x7a\xd3\x3f\xb9\x7e\xe5\x0a\xe4\x0a\x0e\xef\xb1\xc0\x9a\x54\xcd\x47\x0e\xb4\x9e\xfa\xbf\x44\x4b\x10\x52\xad\x85\x53\xa3\x73\x29\xfe\xdc\xed\xfa\x60\xe7\xfb\x53\xda\x86\xf7\xfb\xf1\x6e\xd7\x92\x1c\x5c\xfb\x89\x9d\x3d\x55\x5c\xad\xd8\xf9\x52\x3e\x5a\xdf\xfd\x5b\xe0\xaf\xbb\xc8\xff\x7d\x35\xbb\x5d\x6b\xde\x7e\x3f\x7e\xb5\xcf\xbf\x75\xff\xb5\xc9\x9c\x73\x76\xac\x5f\xee\x52\xb7\x9b\x67\xde\xc6\x68\x79\xe6\x9c\xbf\xc4\x81\x90\xf7\x12\x8e\x2f\x55\xf3\x15\xeb\xe7\xf8\xe8\xef\x87\x37\xad\xd7\xfe\xfe\x29\x38\xa2\x6c\xe0\x3f\xfb\x12\x7d\xec\x13\x60\xc9\xb1\x0e\xfd\x69\xf8\x2f\x00\x00\xff\xff\x84\x11\x85\xbe\x78\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\xd1\x6a\xe3\x48\x10\x7c\xd7\x57\x14\xf6\xcb\x1d\x24\x72\x92\x47\x1d\xc7\xe1\x75\xb2\x59\x71\x89\x03\xb6\x73\xcb\x3e\x85\xb6\xd4\x92\x86\x8c\x66\xe6\x66\x5a\xf6\x1a\xe3\x7f\x3f\x24\xcb\x71\x9c\x2c\x6c\x5e\x0e\x56\x09\x18\xba\x6b\xaa\xab\xab\x34\x1a\x62\x62\xdd\xc6\xab\xb2\x12\x5c\x5d\x5c\x5d\xe1\xd6\xda\x52\x33\xee\xee\x26\xd1\x30\x1a\xe2\x4e\x65\x6c\x02\xe7\x68\x4c\xce\x1e\x52\x31\xc6\x8e\xb2\x8a\x0f\x9d\x33\xfc\xc3\x3e\x28\x6b\x70\x15\x5f\xe0\xb7\x16\x30\xe8\x5b\x83\xdf\xff\x88\x86\xd8\xd8\x06\x35\x6d\x60\xac\xa0\x09\x0c\xa9\x54\x40\xa1\x34\x83\xbf\x67\xec\x04\xca\x20\xb3\xb5\xd3\x8a\x4c\xc6\x58\x2b\xa9\x20\x47\xfe\x38\x1a\xe2\x5b\x4f\x61\x97\x42\xca\x80\x90\x59\xb7\x81\x2d\x5e\xe3\x40\xd2\x09\xee\x9e\x4a\xc4\x25\xa3\xd1\x7a\xbd\x8e\xa9\x53\x1b\x5b\x5f\x8e\xf4\x1e\x19\x46\x77\xe9\xe4\x66\x3a\xbf\x39\xbf\x8a\x2f\xba\x33\x8f\x46\x73\x08\xf0\xfc\x6f\xa3\x3c\xe7\x58\x6e\x40\xce\x69\x95\xd1\x52\x33\x34\xad\x61\x3d\xa8\xf4\xcc\x39\xc4\xb6\x82\xd7\x5e\x89\x32\xe5\x19\x82\x2d\x64\x4d\x9e\xa3\x21\x72\x15\xc4\xab\x65\x23\x27\x6e\x1d\xe4\xa9\x70\x02\xb0\x06\x64\x30\x18\xcf\x91\xce\x07\xf8\x34\x9e\xa7\xf3\xb3\x68\x88\xaf\xe9\xe2\xcb\xc3\xe3\x02\x5f\xc7\xb3\xd9\x78\xba\x48\x6f\xe6\x78\x98\x61\xf2\x30\xbd\x4e\x17\xe9\xc3\x74\x8e\x87\xcf\x18\x4f\xbf\xe1\xef\x74\x7a\x7d\x06\x56\x52\xb1\x07\x7f\x77\xbe\xd5\x6f\x3d\x54\xeb\x23\xe7\xad\x69\x73\xe6\x13\x01\x85\xdd\xc7\x17\x1c\x67\xaa\x50\x19\x34\x99\xb2\xa1\x92\x51\xda\x15\x7b\xa3\x4c\x09\xc7\xbe\x56\xa1\x4d\x33\x80\x4c\x1e\x0d\xa1\x55\xad\x84\xa4\xab\xbc\x5b\x2a\x8e\x22\x72\xaa\xcf\x3f\x41\x66\x3d\xc7\x99\xf1\x75\x9c\x69\xdb\xe4\x71\xd9\xbd\x4a\x71\x66\xeb\xd1\xea\x92\xb4\xab\xe8\x32\x7a\x56\x26\x4f\x30\x67\xbf\x52\x19\xdf\x93\x73\xca\x94\x51\xcd\x42\x39\x09\x25\x11\x60\xa8\xe6\x04\x1d\x81\xca\xd9\x88\x92\xcd\x8f\x39\x7b\x6c\x70\x94\xb5\x07\x8c\xaf\xcf\xc3\x26\x08\xd7\x51\xbb\xe1\x91\x6a\xd2\x52\xa5\x3d\x55\x04\xac\x0e\x72\x57\x97\x4b\x16\xba\x8c\x80\xb0\x97\xf3\xc5\x06\x99\xfe\x60\xfc\x7e\x26\x39\x15\xfa\xb9\x9e\x83\x6d\x7c\xc6\xa1\x9d\x02\x9c\xf7\x93\xf6\xb8\xa7\x4e\xe8\xd3\xe1\xf4\x53\xe9\x6d\xe3\x3a\x1c\xb0\xdf\xfe\x44\xd1\xed\xab\x36\x35\x62\x6f\xd9\xb0\x27\xe1\x3c\x81\xf8\x86\xfb\x8e\xca\x17\x5c\x3b\x4d\xc2\x09\x06\xdb\x6d\x3b\x6f\xb7\x1b\xbc\x6b\x4e\xc8\x7c\xe2\xc7\xc0\xf9\xc2\xde\x93\x64\xd5\xac\x17\xba\xdf\xaa\x20\x1d\x0e\x84\x87\x15\xc6\x2b\x52\xba\x7d\xcd\x53\x33\x0e\x81\x25\x35\x2b\x36\x62\xfd\xe6\x14\x7e\x48\xa8\x4f\x2c\xe9\xcb\x80\xa6\x25\xeb\x90\xf4\xbf\x7d\xb9\xf5\x93\xfd\xcb\x26\xe9\xf5\x67\xc5\x3a\x4f\x3a\x9b\xde\xcc\x4f\xaf\x8f\x5c\x42\xbe\x64\x79\x8f\x05\x56\xa4\x1b\x7e\xe5\x40\xe7\x69\xf8\x4b\x19\x25\x8a\x74\x67\xe1\xc4\x9a\x42\x95\x7f\x6e\xb7\x7d\x71\xef\xfb\x53\xd6\x95\x77\xbb\xd1\x76\xdb\x91\x1c\x5c\xfb\x40\x66\x4f\x35\xd7\x4b\xf6\xa1\x52\x3f\x8b\xef\xfe\x2d\xf0\xd7\x0d\xf2\x7f\x8f\x66\xbb\xed\xcc\xdb\xed\x46\x47\xfb\xc2\x5b\xf7\x8f\x43\x66\x5c\xb0\x67\xf3\x72\x97\xda\xff\x73\x3c\xf3\x26\x41\xc7\x33\xe3\xe2\xa5\x0e\x48\xd1\x4b\x78\x7d\xa9\xda\xbf\x72\xf5\x7c\x3c\xff\x81\x9b\xd6\x6b\x7f\xff\x29\x38\x3e\xdd\x88\x8f\x7e\x89\x7e\xee\x13\xe0\xc8\xb3\x91\x04\xe2\x1b\x8e\xfe\x1b\x00\x84\x11\x85\xbe\x78\x07\x00\x00"),
 		},
 		"/cloudids.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudids.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2016,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\x52\x92\xa3\xf7\xe4\x3a\x69\x2b\x6c\xea\x14\x96\xd3\xa2\xa7\x60\x4c\x8d\xa4\xd9\x50\x24\x97\x1c\xd9\x35\xb2\xfe\xef\x0b\x7d\x25\x71\xd2\x00\xd1\xc1\x20\x86\x6f\xde\x3c\xce\x1b\xd2\x53\x58\x58\xb7\xf7\x5c\x56\x02\x17\x67\x17\x17\xf0\xc5\xda\x52\x13\x5c\x5f\x2f\xa2\x69\x34\x85\x6b\x56\x64\x02\xe5\xd0\x98\x9c\x3c\x48\x45\x30\x77\xa8\x2a\x1a\x77\x4e\xe0\x07\xf9\xc0\xd6\xc0\x45\x7c\x06\x1f\x5a\xc0\x64\xd8\x9a\x7c\xfc\x2b\x9a\xc2\xde\x36\x50\xe3\x1e\x8c\x15\x68\x02\x81\x54\x1c\xa0\x60\x4d\x40\xbf\x15\x39\x01\x36\xa0\x6c\xed\x34\xa3\x51\x04\x3b\x96\xaa\x2b\x33\x90\xc4\xd1\x14\x7e\x0d\x14\x76\x23\xc8\x06\x10\x94\x75\x7b\xb0\xc5\x73\x1c\xa0\x74\x82\xbb\xaf\x12\x71\xb3\x24\xd9\xed\x76\x31\x76\x6a\x63\xeb\xcb\x44\xf7\xc8\x90\x5c\xa7\x8b\xab\x65\x76\x75\x7a\x11\x9f\x75\x39\xb7\x46\x53\x08\xe0\xe9\xdf\x86\x3d\xe5\xb0\xd9\x03\x3a\xa7\x59\xe1\x46\x13\x68\xdc\x81\xf5\x80\xa5\x27\xca\x41\x6c\x2b\x78\xe7\x59\xd8\x94\x27\x10\x6c\x21\x3b\xf4\x14\x4d\x21\xe7\x20\x9e\x37\x8d\x1c\x75\x6b\x94\xc7\xe1\x08\x60\x0d\xa0\x81\xc9\x3c\x83\x34\x9b\xc0\xa7\x79\x96\x66\x27\xd1\x14\x7e\xa6\xeb\xaf\x37\xb7\x6b\xf8\x39\x5f\xad\xe6\xcb\x75\x7a\x95\xc1\xcd\x0a\x16\x37\xcb\xcb\x74\x9d\xde\x2c\x33\xb8\xf9\x0c\xf3\xe5\x2f\xf8\x3b\x5d\x5e\x9e\x00\xb1\x54\xe4\x81\x7e\x3b\xdf\xea\xb7\x1e\xb8\xed\x23\xe5\x6d\xd3\x32\xa2\x23\x01\x85\xed\x05\x05\x47\x8a\x0b\x56\xa0\xd1\x94\x0d\x96\x04\xa5\xdd\x92\x37\x6c\x4a\x70\xe4\x6b\x0e\xad\x9b\x01\xd0\xe4\xd1\x14\x34\xd7\x2c\x28\x5d\xe4\xd5\xa1\xe2\x28\x42\xc7\x83\xff\x33\x50\xd6\x53\xac\x8c\xaf\x63\xa5\x6d\x93\xc7\x65\x37\x4a\xb1\xb2\x75\xb2\x3d\x47\xed\x2a\x3c\x8f\xee\xd9\xe4\x33\xc8\xc8\x6f\x59\xd1\x37\x74\x8e\x4d\x19\xd5\x24\x98\xa3\xe0\x2c\x02\x30\x58\xd3\x0c\x3a\x02\xce\xc3\x9f\xe9\x06\x58\x70\xa8\x5a\xac\xf1\xf5\x69\xd8\x07\xa1\x3a\x6a\x0f\xf7\xc4\xb2\x68\xf3\xd2\xcb\x2c\x02\xd8\x8e\x22\xb7\xe7\x1b\x12\x3c\x8f\x00\x42\x2f\xe2\xab\x0d\xb2\x3c\x2e\xda\x57\x42\xc7\x61\xa8\xe6\x29\xd8\xc6\x2b\x0a\x2d\x37\xc0\xe9\xc0\xdf\xe3\xee\xba\xbc\x3b\xce\xc3\x1d\x99\xdc\x59\x36\x12\xf5\x63\xd8\x1f\x76\x54\x71\x75\xbc\x89\x8d\xd8\x2f\x64\xc8\xa3\x50\x3e\x03\xf1\x0d\x0d\x3b\x9c\xaf\xa9\x76\x1a\x85\x66\x30\x71\xde\xfe\x43\x4a\x42\xf2\xf0\x30\x2c\x0f\x87\x44\x5b\xd5\x7b\x92\x3c\x3c\x8c\xeb\xc3\x21\x19\xcb\xb7\xe1\x56\xe1\xe1\x30\x79\x45\xb9\x40\xf3\x89\x6e\x03\xe5\x6b\xfb\x0d\x45\x55\xab\xe1\x68\x7d\x0b\x0a\xd4\x61\x94\x31\x1e\x7a\xbe\x45\xd6\xed\x4d\x48\xcd\x3c\x04\x92\xd4\x6c\xc9\x88\xf5\xfb\x63\xf8\x68\xe2\x60\xea\x6c\x08\x8f\x5e\xb4\xbf\x2f\x88\xd3\xcb\x27\x90\xa0\x2f\x49\x3e\x33\xe9\xfc\x08\x5b\x31\x79\xf4\xaa\x62\x85\x7a\x45\x05\x79\x32\x8f\x36\xf4\x56\xc8\xde\xd1\x0c\x86\xde\x3c\xc6\x01\xee\x69\xff\x18\x5e\x51\xf1\xa2\xf6\x1b\x5c\xc5\xa0\xe0\xbd\x74\xed\x97\x53\x50\x9e\x9d\x74\xe3\xf5\xdf\xe9\xb3\x1d\x80\x75\x45\x63\x12\x48\x85\xd2\xbf\x7e\xa3\x08\xd8\x90\xb6\xa6\x0c\x20\x36\x7e\x96\x56\x6e\xef\x67\x47\x2c\xfd\x1c\x7d\x7f\xa5\xe9\x8f\x63\xfd\x8c\xc7\xdb\xc6\xcd\x1e\xab\xd5\x68\xb0\x24\xff\xe6\x95\x7a\xd5\x04\x43\xb2\xb3\xfe\xfe\x65\x13\x86\xf0\xfb\x9b\x90\xd6\x75\x23\xed\x00\xc5\xd0\x4e\xd9\xf8\x70\xff\xf8\xbe\x18\xb9\xfa\xde\x70\x00\x65\x8d\x21\x25\x94\x1f\x11\x88\xed\x12\xd2\xcb\x0c\xc6\x11\x8f\x61\xdd\x76\x52\xa1\x19\x5f\x41\x65\x4d\xf7\xef\xf0\x92\xfa\xd9\x30\xf5\x1f\x4b\x20\x5d\xc0\x07\xcd\xf7\x04\x93\xe0\xd5\xa9\x21\x99\x7c\x84\xe1\x7d\x2c\x1a\xad\xe1\x76\x75\x3d\x56\x1d\x79\x06\xfc\xd3\x7d\x1c\x56\x77\x9c\x1f\x92\x52\xdb\x0d\xea\x64\xc0\x86\xe4\x91\xf5\x1d\xbe\x2e\x6c\xed\x1a\xa1\xe5\xab\x6e\xbf\xcf\x5e\xd5\xa7\xbf\x61\xeb\xff\x01\x00\x00\xff\xff\xf4\x41\xd8\x74\xe0\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xc1\x6e\xdb\x38\x10\xbd\xeb\x2b\x1e\xec\x4b\x0b\xc4\x52\x92\xa3\xf7\xe4\x3a\x69\x2b\x6c\xea\x14\x96\xd3\xa2\xa7\x80\xa6\x46\x12\x37\x14\xc9\x25\x47\x76\x8d\xac\xff\x7d\x21\x59\x8a\xe3\xa4\x01\x12\x05\x86\x40\x3e\xbe\x79\x33\x6f\x38\x1a\x63\x6e\xdd\xce\xab\xb2\x62\x5c\x9e\x5f\x5e\xe2\x8b\xb5\xa5\x26\xdc\xdc\xcc\xa3\x71\x34\xc6\x8d\x92\x64\x02\xe5\x68\x4c\x4e\x1e\x5c\x11\x66\x4e\xc8\x8a\x86\x9d\x33\xfc\x20\x1f\x94\x35\xb8\x8c\xcf\xf1\xa1\x05\x8c\xfa\xad\xd1\xc7\xbf\xa2\x31\x76\xb6\x41\x2d\x76\x30\x96\xd1\x04\x02\x57\x2a\xa0\x50\x9a\x40\xbf\x25\x39\x86\x32\x90\xb6\x76\x5a\x09\x23\x09\x5b\xc5\x15\xf8\xc8\x1f\x47\x63\xfc\xea\x29\xec\x9a\x85\x32\x10\x90\xd6\xed\x60\x8b\xe7\x38\x08\xee\x04\x77\x7f\x15\xb3\x9b\x26\xc9\x76\xbb\x8d\x45\xa7\x36\xb6\xbe\x4c\xf4\x01\x19\x92\x9b\x74\x7e\xbd\xc8\xae\x27\x97\xf1\x79\x77\xe6\xce\x68\x0a\x01\x9e\xfe\x6d\x94\xa7\x1c\xeb\x1d\x84\x73\x5a\x49\xb1\xd6\x04\x2d\xb6\xb0\x1e\xa2\xf4\x44\x39\xd8\xb6\x82\xb7\x5e\xb1\x32\xe5\x19\x82\x2d\x78\x2b\x3c\x45\x63\xe4\x2a\xb0\x57\xeb\x86\x4f\xaa\x35\xc8\x53\xe1\x04\x60\x0d\x84\xc1\x68\x96\x21\xcd\x46\xf8\x34\xcb\xd2\xec\x2c\x1a\xe3\x67\xba\xfa\x7a\x7b\xb7\xc2\xcf\xd9\x72\x39\x5b\xac\xd2\xeb\x0c\xb7\x4b\xcc\x6f\x17\x57\xe9\x2a\xbd\x5d\x64\xb8\xfd\x8c\xd9\xe2\x17\xfe\x4e\x17\x57\x67\x20\xc5\x15\x79\xd0\x6f\xe7\x5b\xfd\xd6\x43\xb5\x75\xa4\xbc\x2d\x5a\x46\x74\x22\xa0\xb0\x07\xfb\x82\x23\xa9\x0a\x25\xa1\x85\x29\x1b\x51\x12\x4a\xbb\x21\x6f\x94\x29\xe1\xc8\xd7\x2a\xb4\x6e\x06\x08\x93\x47\x63\x68\x55\x2b\x16\xdc\xad\xbc\x4a\x2a\x8e\x22\xe1\x54\xef\xff\x14\xd2\x7a\x8a\xa5\xf1\x75\x2c\xb5\x6d\xf2\xb8\xec\x5a\x29\x96\xb6\x4e\x36\x17\x42\xbb\x4a\x5c\x44\x0f\xca\xe4\x53\x64\xe4\x37\x4a\xd2\x37\xe1\x9c\x32\x65\x54\x13\x8b\x5c\xb0\x98\x46\x80\x11\x35\x4d\xd1\x11\xa8\x3c\xfc\x99\xae\x87\x05\x27\x64\x8b\x35\xbe\x9e\x84\x5d\x60\xaa\xa3\x36\xb9\x23\xcb\xbc\x65\x49\xaf\xb2\x08\xd8\x0c\x22\x37\x17\x6b\x62\x71\x11\x01\xe1\x20\xe2\xab\x0d\xbc\x38\x0d\x7a\x88\x24\x9c\x0a\x7d\x34\x4f\xc1\x36\x5e\x52\x68\xb9\x81\x49\xcf\x7f\xc0\xdd\x77\xf2\xee\x55\x1e\xee\xc9\xe4\xce\x2a\xc3\x1d\x0a\x38\x24\x3b\xa8\xb8\x3e\xdd\x14\x0d\xdb\x2f\x64\xc8\x0b\xa6\x7c\x0a\xf6\x0d\xf5\x3b\x2a\x5f\x51\xed\xb4\x60\x9a\x62\xe4\xbc\xfd\x87\x24\x87\xe4\xf1\xb1\x7f\xdd\xef\x13\x6d\xe5\xc1\x93\xe4\xf1\x71\x78\xdf\xef\x93\x21\x7c\x0b\x6e\x15\xee\xf7\xa3\x57\x94\x73\x61\x3e\xd1\x5d\xa0\x7c\x65\xbf\x09\x96\xd5\xb2\x4f\xed\x50\x82\x42\xe8\x30\xc8\x18\x92\x9e\x6d\x84\xd2\xed\x4d\x48\xcd\x2c\x04\xe2\xd4\x6c\xc8\xb0\xf5\xbb\x53\xf8\x60\x62\x6f\xea\xb4\x5f\x1e\xbc\x68\x7f\x5f\x10\xa7\x57\x47\x10\x0b\x5f\x12\x7f\x56\xa4\xf3\x13\x6c\xa5\xc8\x0b\x2f\x2b\x25\x85\x5e\x52\x41\x9e\xcc\x93\x0d\xed\xff\x04\xbc\x73\x34\x45\x5f\x9b\xa7\x75\xe0\x81\x76\x4f\xcb\x4b\x2a\x5e\xc4\x7e\x83\xab\xe8\x15\xbc\x97\xae\x7d\x72\x0a\xd2\x2b\xd7\x7a\x30\xc5\x7f\x93\x67\x3b\xc0\xaa\xa2\xe1\x10\xb8\x12\x7c\x98\x7e\x83\x08\xac\x49\x5b\x53\x06\xb0\x8d\x9f\x1d\x2b\x37\x0f\x47\x51\xc7\x3e\xfa\xfe\x4a\xd3\x1f\xdb\xfa\xf8\x94\xde\x36\x6e\xfa\x14\xad\x16\x46\x94\xe4\xdf\xbc\x52\xaf\x8a\x60\x88\xb7\xd6\x3f\x3c\xe3\xec\x8a\xd0\x2f\xbf\xbf\x08\x69\x5d\x37\xdc\x36\x50\x8c\xb6\xcb\x86\xc1\xfd\xe3\xfb\x7c\xe0\x3a\xd4\x46\x05\x48\x6b\x0c\x49\xa6\xfc\x84\x80\x6d\x37\xb9\xd2\xab\x0c\x43\x8b\xc7\x58\x55\x2d\x5e\x98\x61\x0a\x4a\x6b\xba\xaf\xc3\x4b\xea\x67\xcd\xd4\xdf\x05\x0e\xa4\x0b\x7c\xd0\xea\x81\x30\x0a\x5e\x4e\x0c\xf1\xe8\x23\xfa\xf9\x58\x34\x5a\xe3\x6e\x79\x33\x44\x1d\x78\x7a\x7c\xef\x66\x48\x86\xeb\x78\xaf\xf2\x7d\x52\x6a\xbb\x16\x3a\xe9\xb1\x21\x79\x62\x7d\x87\xaf\x73\x5b\xbb\x86\x69\xf1\xaa\xda\xef\xb3\xb7\xfd\x76\x36\xfc\xc6\xe0\x8d\xfe\x1f\x00\xf4\x41\xd8\x74\xe0\x07\x00\x00"),
 		},
 		"/cloudiot.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudiot.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1731,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x53\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x10\xf6\xa5\x05\x12\x29\xf1\xd1\x3d\x79\x9d\xec\xae\xd0\xc4\x06\x2c\xa7\x8b\x3d\x05\xf4\x88\x96\xd8\x8e\x66\xa6\x33\x94\xbd\x42\xe0\xff\x5e\xe8\x6b\x37\xf6\xa6\x41\xcf\xd5\x41\x07\xf2\xf1\xf1\xf1\x91\x33\x85\xa5\x75\x8d\xe7\xa2\x14\x98\xdd\xcc\x66\xf0\xc9\xda\x42\x13\x3c\x3c\x2c\xa3\x69\x34\x85\x07\x56\x64\x02\xe5\x50\x9b\x9c\x3c\x48\x49\xb0\x70\xa8\x4a\x1a\x33\x57\xf0\x07\xf9\xc0\xd6\xc0\x2c\xbe\x81\x5f\x5a\xc0\x64\x48\x4d\x7e\xfd\x2d\x9a\x42\x63\x6b\xa8\xb0\x01\x63\x05\xea\x40\x20\x25\x07\xd8\xb3\x26\xa0\x6f\x8a\x9c\x00\x1b\x50\xb6\x72\x9a\xd1\x28\x82\x23\x4b\xd9\xb5\x19\x48\xe2\x68\x0a\x5f\x07\x0a\xbb\x13\x64\x03\x08\xca\xba\x06\xec\xfe\x35\x0e\x50\x3a\xc1\xdd\x57\x8a\xb8\x79\x92\x1c\x8f\xc7\x18\x3b\xb5\xb1\xf5\x45\xa2\x7b\x64\x48\x1e\xd2\xe5\xfd\x2a\xbb\xbf\x9e\xc5\x37\x5d\xcd\x93\xd1\x14\x02\x78\xfa\xbb\x66\x4f\x39\xec\x1a\x40\xe7\x34\x2b\xdc\x69\x02\x8d\x47\xb0\x1e\xb0\xf0\x44\x39\x88\x6d\x05\x1f\x3d\x0b\x9b\xe2\x0a\x82\xdd\xcb\x11\x3d\x45\x53\xc8\x39\x88\xe7\x5d\x2d\x67\x6e\x8d\xf2\x38\x9c\x01\xac\x01\x34\x30\x59\x64\x90\x66\x13\xf8\xb0\xc8\xd2\xec\x2a\x9a\xc2\x97\x74\xfb\x79\xfd\xb4\x85\x2f\x8b\xcd\x66\xb1\xda\xa6\xf7\x19\xac\x37\xb0\x5c\xaf\xee\xd2\x6d\xba\x5e\x65\xb0\xfe\x08\x8b\xd5\x57\xf8\x3d\x5d\xdd\x5d\x01\xb1\x94\xe4\x81\xbe\x39\xdf\xea\xb7\x1e\xb8\xf5\x91\xf2\xd6\xb4\x8c\xe8\x4c\xc0\xde\xf6\x82\x82\x23\xc5\x7b\x56\xa0\xd1\x14\x35\x16\x04\x85\x3d\x90\x37\x6c\x0a\x70\xe4\x2b\x0e\xed\x36\x03\xa0\xc9\xa3\x29\x68\xae\x58\x50\xba\xc8\x4f\x43\xc5\x51\x84\x8e\x87\xfd\xcf\x41\x59\x4f\xb1\x32\xbe\x8a\x95\xb6\x75\x1e\x17\xdd\x29\xc5\xca\x56\xc9\xe1\x16\xb5\x2b\xf1\x36\xfa\x8b\x4d\x3e\x87\x8c\xfc\x81\x15\x3d\xa2\x73\x6c\x8a\xa8\x22\xc1\x1c\x05\xe7\x11\x80\xc1\x8a\xe6\xd0\x11\xb0\x95\xb7\xe9\x06\x58\x70\xa8\x5a\xac\xf1\xd5\x75\x68\x82\x50\x15\xb5\xc3\xfd\x60\x59\xb6\x75\xe9\x7a\x1b\x01\x1c\x46\x91\x87\xdb\x1d\x09\xde\x46\x00\xa1\x17\xf1\xd9\x06\x59\x9d\x37\xed\x3b\xa1\xe3\x30\x74\xf3\x14\x6c\xed\x15\x85\x96\x1b\xe0\x7a\xe0\xef\x71\xcf\x63\xdd\x73\x4e\x2d\x61\xd4\xdf\x60\x3f\xe9\x28\xe1\xee\x75\x0a\x6b\xb1\x9f\xc8\x90\x47\xa1\x7c\x0e\xe2\xeb\x31\xc3\xf9\x96\x2a\xa7\x51\x68\x0e\x93\x97\x17\x4f\x45\x7b\x33\xcd\xe9\x94\xf4\xdc\x21\x79\x79\x69\x7b\x9f\x4e\x93\x9f\x2a\x96\x68\x3e\xd0\x53\xa0\x7c\x6b\x1f\x51\x54\xb9\x19\x44\xf7\xc3\xed\x51\x87\xb1\xcb\x38\xce\xe2\x80\xac\xdb\x1b\x4f\xcd\x22\x04\x92\xd4\x1c\xc8\x88\xf5\xcd\x39\x7c\x5c\xcf\xb0\xae\xf9\x10\x1e\x5d\x6e\xff\x17\xc4\xe9\xdd\x0f\x90\xa0\x2f\x48\x3e\x32\xe9\xfc\x15\xf6\xdf\x2c\x1c\x27\x7e\xc7\xc4\xcd\x39\xe4\x1d\x33\xb1\x5a\x5a\xb3\xe7\x57\x8a\x9d\xd5\xac\x9a\xd5\xbb\xad\x9f\x19\xab\xe7\x1e\x78\x51\xf7\x48\xd5\x8e\xfc\x7f\xa8\xae\x3a\xe0\xf7\x6a\x4f\x7b\xf2\x64\x14\xf5\x2e\x7c\x8f\xbf\xe1\x60\xe7\x58\xe3\x2e\x83\xa1\x76\xce\x7a\x09\x4b\x6b\x72\xee\x5e\xe3\xf9\x8a\x2e\xee\xc6\x79\xfb\x27\x29\x39\x9d\x12\x6d\x55\xff\x7a\x93\xfe\x9a\xac\x39\x9d\x92\x41\x2b\xff\x3f\xce\xe9\x9f\x00\x00\x00\xff\xff\xc2\xf0\x1e\xb5\xc3\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x53\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x10\xf6\xa5\x05\x12\x29\xf1\xd1\x3d\x79\x9d\xec\xae\xd0\xc4\x06\x2c\xa7\x8b\x3d\x05\xf4\x88\x96\xd8\x8e\x66\xa6\x33\x94\xbd\x42\xe0\xff\x5e\xe8\x6b\x13\x7b\xb7\x41\xcf\x35\x0c\x1d\xc8\xc7\xc7\xc7\x47\xce\x14\x96\xd6\x35\x9e\x8b\x52\x60\x76\x33\x9b\xc1\x27\x6b\x0b\x4d\xf0\xf0\xb0\x8c\xa6\xd1\x14\x1e\x58\x91\x09\x94\x43\x6d\x72\xf2\x20\x25\xc1\xc2\xa1\x2a\x69\xcc\x5c\xc1\x1f\xe4\x03\x5b\x03\xb3\xf8\x06\x7e\x69\x01\x93\x21\x35\xf9\xf5\xb7\x68\x0a\x8d\xad\xa1\xc2\x06\x8c\x15\xa8\x03\x81\x94\x1c\x60\xcf\x9a\x80\xbe\x29\x72\x02\x6c\x40\xd9\xca\x69\x46\xa3\x08\x8e\x2c\x25\xc8\x2b\x7f\x1c\x4d\xe1\xeb\x40\x61\x77\x82\x6c\x00\x41\x59\xd7\x80\xdd\xbf\xc5\x01\x4a\x27\xb8\xfb\x95\x22\x6e\x9e\x24\xc7\xe3\x31\xc6\x4e\x6d\x6c\x7d\x91\xe8\x1e\x19\x92\x87\x74\x79\xbf\xca\xee\xaf\x67\xf1\x4d\x57\xf3\x64\x34\x85\x00\x9e\xfe\xae\xd9\x53\x0e\xbb\x06\xd0\x39\xcd\x0a\x77\x9a\x40\xe3\x11\xac\x07\x2c\x3c\x51\x0e\x62\x5b\xc1\x47\xcf\xc2\xa6\xb8\x82\x60\xf7\x72\x44\x4f\xd1\x14\x72\x0e\xe2\x79\x57\xcb\x99\x5b\xa3\x3c\x0e\x67\x00\x6b\x00\x0d\x4c\x16\x19\xa4\xd9\x04\x3e\x2c\xb2\x34\xbb\x8a\xa6\xf0\x25\xdd\x7e\x5e\x3f\x6d\xe1\xcb\x62\xb3\x59\xac\xb6\xe9\x7d\x06\xeb\x0d\x2c\xd7\xab\xbb\x74\x9b\xae\x57\x19\xac\x3f\xc2\x62\xf5\x15\x7e\x4f\x57\x77\x57\x40\x2c\x25\x79\xa0\x6f\xce\xb7\xfa\xad\x07\x6e\x7d\xa4\xbc\x35\x2d\x23\x3a\x13\xb0\xb7\xfd\xfa\x82\x23\xc5\x7b\x56\xa0\xd1\x14\x35\x16\x04\x85\x3d\x90\x37\x6c\x0a\x70\xe4\x2b\x0e\xed\x36\x03\xa0\xc9\xa3\x29\x68\xae\x58\x50\xba\xc8\x0f\x43\xc5\x51\x84\x8e\x87\xfd\xcf\x41\x59\x4f\xb1\x32\xbe\x8a\x95\xb6\x75\x1e\x17\xdd\x29\xc5\xca\x56\xc9\xe1\x16\xb5\x2b\xf1\x36\xfa\x8b\x4d\x3e\x87\x8c\xfc\x81\x15\x3d\xa2\x73\x6c\x8a\xa8\x22\xc1\x1c\x05\xe7\x11\x80\xc1\x8a\xe6\xd0\x11\xb0\x95\x9f\xd3\x0d\xb0\xe0\x50\xb5\x58\xe3\xab\xeb\xd0\x04\xa1\x2a\x6a\x87\x7b\x65\x59\xb6\x2c\xe9\x7a\x1b\x01\x1c\x46\x91\x87\xdb\x1d\x09\xde\x46\x00\xa1\x17\xf1\xd9\x06\x59\x9d\x37\xed\x3b\xa1\xe3\x30\x74\xf3\x14\x6c\xed\x15\x85\x96\x1b\xe0\x7a\xe0\xef\x71\xcf\x63\xdd\x73\x4e\x2d\x61\x07\x01\xe8\x27\x1d\x25\xdc\xbd\x4d\x61\x2d\xf6\x13\x19\xf2\x28\x94\xcf\x41\x7c\x3d\x66\x38\xdf\x52\xe5\x34\x0a\xcd\x61\xf2\xf2\xe2\xa9\x68\x6f\xa6\x39\x9d\x92\x9e\x3b\x24\x2f\x2f\x6d\xef\xd3\x69\xf2\x43\xc5\x12\xcd\x07\x7a\x0a\x94\x6f\xed\x23\x8a\x2a\x37\x83\xe8\x7e\xb8\x3d\xea\x30\x76\x19\xc7\x59\x1c\x90\x75\x7b\xe3\xa9\x59\x84\x40\x92\x9a\x03\x19\xb1\xbe\x39\x87\x8f\xeb\x19\xd6\x35\x1f\xc2\xa3\xcb\xed\xf7\x82\x38\xbd\x7b\x05\x09\xfa\x82\xe4\x23\x93\xce\xdf\x60\xff\xcd\xc2\x71\xe2\x77\x4c\xdc\x9c\x43\xde\x31\x13\xab\xa5\x35\x7b\x2e\x5e\xc5\x38\xab\x59\x35\xab\x77\x5b\x3f\x33\x56\xcf\x3d\xf0\xa2\xee\x91\xaa\x1d\xf9\xff\x50\x5d\x75\xc0\xef\xd5\x9e\xf6\xe4\xc9\x28\xea\x5d\xf8\x1e\xff\x89\x83\xed\x5f\x1a\x77\x19\x0c\xb5\x73\xd6\x4b\x58\x5a\x93\x73\xf7\x1a\xcf\x57\x74\x71\x37\xce\xdb\x3f\x49\xc9\xe9\x94\x68\xab\xfa\xd7\x9b\xf4\xd7\x64\xcd\xe9\x94\x0c\x5a\xf9\xff\x71\x4e\xff\x0c\x00\xc2\xf0\x1e\xb5\xc3\x06\x00\x00"),
 		},
 		"/cloudrun.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudrun.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1794,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x53\x4d\x8f\x1a\x47\x10\xbd\xcf\xaf\x28\xc1\x25\x91\x76\x87\x5d\x8e\xe4\x84\xd9\xb5\x3d\xca\x02\x12\xc3\xc6\xf2\x09\x15\x3d\xc5\x4c\x25\x3d\xdd\x9d\xee\x1a\xf0\xc8\xe2\xbf\x47\xf3\xb5\xde\xb1\x1d\x2b\x52\x6e\xe6\x80\x50\xd7\xab\x57\xef\xbd\x2a\xa6\xb0\xb2\xae\xf6\x9c\x17\x02\xf3\xbb\xf9\x1c\xde\x59\x9b\x6b\x82\xa7\xa7\x55\x34\x8d\xa6\xf0\xc4\x8a\x4c\xa0\x0c\x2a\x93\x91\x07\x29\x08\x96\x0e\x55\x41\x43\xe5\x06\xfe\x20\x1f\xd8\x1a\x98\xc7\x77\xf0\x4b\x03\x98\xf4\xa5\xc9\xaf\xbf\x45\x53\xa8\x6d\x05\x25\xd6\x60\xac\x40\x15\x08\xa4\xe0\x00\x27\xd6\x04\xf4\x49\x91\x13\x60\x03\xca\x96\x4e\x33\x1a\x45\x70\x61\x29\xda\x31\x3d\x49\x1c\x4d\xe1\x63\x4f\x61\x8f\x82\x6c\x00\x41\x59\x57\x83\x3d\xbd\xc6\x01\x4a\x2b\xb8\xfd\x14\x22\x6e\x31\x9b\x5d\x2e\x97\x18\x5b\xb5\xb1\xf5\xf9\x4c\x77\xc8\x30\x7b\x4a\x56\x8f\x9b\xf4\xf1\x76\x1e\xdf\xb5\x3d\xcf\x46\x53\x08\xe0\xe9\xef\x8a\x3d\x65\x70\xac\x01\x9d\xd3\xac\xf0\xa8\x09\x34\x5e\xc0\x7a\xc0\xdc\x13\x65\x20\xb6\x11\x7c\xf1\x2c\x6c\xf2\x1b\x08\xf6\x24\x17\xf4\x14\x4d\x21\xe3\x20\x9e\x8f\x95\x8c\xd2\x1a\xe4\x71\x18\x01\xac\x01\x34\x30\x59\xa6\x90\xa4\x13\x78\xb3\x4c\x93\xf4\x26\x9a\xc2\x87\x64\xff\x7e\xfb\xbc\x87\x0f\xcb\xdd\x6e\xb9\xd9\x27\x8f\x29\x6c\x77\xb0\xda\x6e\x1e\x92\x7d\xb2\xdd\xa4\xb0\x7d\x0b\xcb\xcd\x47\xf8\x3d\xd9\x3c\xdc\x00\xb1\x14\xe4\x81\x3e\x39\xdf\xe8\xb7\x1e\xb8\xc9\x91\xb2\x26\xb4\x94\x68\x24\xe0\x64\x3b\x41\xc1\x91\xe2\x13\x2b\xd0\x68\xf2\x0a\x73\x82\xdc\x9e\xc9\x1b\x36\x39\x38\xf2\x25\x87\x66\x9b\x01\xd0\x64\xd1\x14\x34\x97\x2c\x28\xed\xcb\x37\xa6\xe2\x28\x42\xc7\xfd\xfe\x17\xa0\xac\xa7\x58\x19\x5f\xc6\x4a\xdb\x2a\x8b\xf3\xf6\x94\x62\x65\xcb\xd9\xf9\x1e\xb5\x2b\xf0\x3e\xfa\x8b\x4d\xb6\x80\x94\xfc\x99\x15\xad\xd1\x39\x36\x79\x54\x92\x60\x86\x82\x8b\x08\xc0\x60\x49\x0b\x68\x09\x7c\x65\xbe\x4f\xd7\xc3\x82\x43\xd5\x60\x8d\x2f\x6f\x43\x1d\x84\xca\xa8\x31\xf7\x85\x65\xd5\xf4\xed\x2a\x13\x01\x9c\x07\x91\xe7\xfb\x23\x09\xde\x47\x00\xa1\x13\xf1\xde\x06\xd9\x8c\x87\x76\x93\xd0\x71\xe8\xa7\x79\x0a\xb6\xf2\x8a\x42\xc3\x0d\x70\xdb\xf3\x77\xb8\x43\xdb\x77\xf0\x95\x39\x64\xb6\x44\x36\x87\xb2\xf7\xd5\x1d\x63\x67\x79\xd0\xf2\xd0\x42\xd6\x23\x04\x56\x62\xdf\x91\x21\x8f\x42\xd9\x02\xc4\x57\xd4\x57\x38\xdb\x53\xe9\x34\x0a\x2d\x60\xa2\xad\xea\x36\x31\xfb\xfc\x79\xf8\x7d\xbd\xce\x5e\xa2\x68\xde\x9d\xb7\x7f\x92\x92\xeb\x75\xd6\x69\xe9\xa5\x34\xa5\x06\x77\xbd\x4e\xbe\x61\x5e\xa1\x79\x43\xcf\x81\xb2\xbd\x5d\xa3\xa8\x62\xd7\x9b\xed\x42\x39\xa1\x0e\x83\x9a\x21\x86\xe5\x19\x59\x37\xff\x8d\xc4\x2c\x43\x20\x49\xcc\x99\x8c\x58\x5f\x8f\xe1\xc3\x5a\x7b\xb3\x8b\xfe\x79\xd8\x4e\xf3\xfd\x15\x71\xf2\xf0\x05\x24\xe8\x73\x92\xb7\x4c\x3a\x7b\x85\xfd\xd7\xe8\xfb\x6d\x7e\x37\xf3\x74\x54\xfb\x41\xda\x58\xae\xac\x39\xf1\x2b\xa9\xce\x6a\x56\xf5\xe6\x87\x33\x0f\x8c\xe5\xa1\x03\x7e\xd5\xb7\xa6\xf2\x48\xfe\x3f\x74\x97\x2d\xf0\xa5\xdb\xd3\x89\x3c\x19\x45\x9d\xfd\x97\xf7\x21\xba\xb1\xd9\x36\xad\xda\x8d\x23\x05\x08\x95\x73\xd6\x4b\x58\x59\x93\x71\x7b\x37\xe3\xf5\xfc\x8f\xdb\xea\xe7\xff\x14\x57\xf5\x4f\x00\x00\x00\xff\xff\xa5\xe7\xeb\x94\x02\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x53\x4d\x6f\x22\x47\x10\xbd\xcf\xaf\x78\x82\x4b\x22\xd9\x83\xcd\x91\x9c\x58\xec\xdd\x1d\xc5\x06\x89\xc1\x59\xed\x09\x15\x3d\xc5\x50\xc9\x4c\x77\xa7\xbb\x07\x76\x64\xf1\xdf\xa3\xf9\xb2\xcd\xee\x66\x15\x29\xb7\xe5\x80\x50\xd7\xab\x57\xaf\xde\x2b\xc6\x58\x18\x5b\x3b\xc9\x0f\x01\xd3\x9b\xe9\x14\x1f\x8c\xc9\x0b\xc6\xc3\xc3\x22\x1a\x47\x63\x3c\x88\x62\xed\x39\x43\xa5\x33\x76\x08\x07\xc6\xdc\x92\x3a\xf0\x50\xb9\xc2\x1f\xec\xbc\x18\x8d\x69\x7c\x83\x5f\x1a\xc0\xa8\x2f\x8d\x7e\xfd\x2d\x1a\xa3\x36\x15\x4a\xaa\xa1\x4d\x40\xe5\x19\xe1\x20\x1e\x7b\x29\x18\xfc\x45\xb1\x0d\x10\x0d\x65\x4a\x5b\x08\x69\xc5\x38\x49\x38\x20\xbc\xf2\xc7\xd1\x18\x9f\x7b\x0a\xb3\x0b\x24\x1a\x04\x65\x6c\x0d\xb3\x7f\x8b\x03\x85\x56\x70\xfb\x39\x84\x60\x67\x93\xc9\xe9\x74\x8a\xa9\x55\x1b\x1b\x97\x4f\x8a\x0e\xe9\x27\x0f\xc9\xe2\x7e\x99\xde\x5f\x4f\xe3\x9b\xb6\xe7\x49\x17\xec\x3d\x1c\xff\x5d\x89\xe3\x0c\xbb\x1a\x64\x6d\x21\x8a\x76\x05\xa3\xa0\x13\x8c\x03\xe5\x8e\x39\x43\x30\x8d\xe0\x93\x93\x20\x3a\xbf\x82\x37\xfb\x70\x22\xc7\xd1\x18\x99\xf8\xe0\x64\x57\x85\x0b\xb7\x06\x79\xe2\x2f\x00\x46\x83\x34\x46\xf3\x14\x49\x3a\xc2\xbb\x79\x9a\xa4\x57\xd1\x18\x9f\x92\xcd\xc7\xd5\xd3\x06\x9f\xe6\xeb\xf5\x7c\xb9\x49\xee\x53\xac\xd6\x58\xac\x96\x77\xc9\x26\x59\x2d\x53\xac\xde\x63\xbe\xfc\x8c\xdf\x93\xe5\xdd\x15\x58\xc2\x81\x1d\xf8\x8b\x75\x8d\x7e\xe3\x20\x8d\x8f\x9c\x35\xa6\xa5\xcc\x17\x02\xf6\xa6\x8b\xcf\x5b\x56\xb2\x17\x85\x82\x74\x5e\x51\xce\xc8\xcd\x91\x9d\x16\x9d\xc3\xb2\x2b\xc5\x37\x69\x7a\x90\xce\xa2\x31\x0a\x29\x25\x50\x68\x5f\xbe\x59\x2a\x8e\x22\xb2\xd2\xe7\x3f\x83\x32\x8e\x63\xa5\x5d\x19\xab\xc2\x54\x59\x9c\xb7\xa7\x14\x2b\x53\x4e\x8e\xb7\x54\xd8\x03\xdd\x46\x7f\x89\xce\x66\x48\xd9\x1d\x45\xf1\x23\x59\x2b\x3a\x8f\x4a\x0e\x94\x51\xa0\x59\x04\x68\x2a\x79\x86\x96\xc0\x55\xfa\xfb\x74\x3d\xcc\x5b\x52\x0d\x56\xbb\xf2\xda\xd7\x3e\x70\x19\x35\xcb\xbd\xb2\x2c\x1a\x96\x75\xa5\x23\xe0\x38\x88\x3c\xde\xee\x38\xd0\x6d\x04\xf8\x4e\xc4\x47\xe3\xc3\xf2\x72\x68\x37\x89\xac\xf8\x7e\x9a\x63\x6f\x2a\xa7\xd8\x37\xdc\xc0\x75\xcf\xdf\xe1\xb6\xad\xbc\xad\xab\xf4\x36\x33\x25\x89\xde\x96\xfd\x5e\x0d\x16\xe8\x56\x1e\xb4\xdc\xb5\x90\x61\xf3\xa6\x0e\x50\x15\xcc\x07\xd6\xec\x28\x70\x36\x43\x70\x15\xf7\x15\xc9\x36\x5c\xda\x82\x02\xcf\x30\x2a\x8c\xea\x92\x98\x3c\x3f\x0f\xbf\xcf\xe7\xc9\x8b\x15\x7e\xf2\xfc\x6c\x9d\xf9\x93\x55\x38\x9f\x27\x9d\x96\x5e\x4a\x53\x6a\x70\xe7\xf3\xe8\x1b\xe6\x05\xe9\x77\xfc\xe4\x39\xdb\x98\x47\x0a\xea\xb0\xee\x97\xed\x4c\xd9\x53\xe1\x07\x35\x83\x0d\xf3\x23\x49\xd1\xfc\x37\x12\x3d\xf7\x9e\x43\xa2\x8f\xac\x83\x71\xf5\x25\x7c\x88\xb5\x5f\x76\xd6\x3f\x0f\xe9\x34\xdf\x5f\x11\x27\x77\xaf\xa0\x40\x2e\xe7\xf0\x5e\xb8\xc8\xde\x60\xff\xd5\xfa\x3e\xcd\xef\x7a\x9e\x5e\xd4\x7e\xe0\x36\x95\x0b\xa3\xf7\x92\xbf\xaa\xb0\xa6\x10\x55\x2f\x7f\x38\x73\x2b\x54\x6e\x3b\xe0\x57\x7d\x8f\x5c\xee\xd8\xfd\x87\xee\xb2\x05\xbe\x74\x3b\xde\xb3\x63\xad\xb8\x5b\xff\xe5\x7d\xb0\xae\x6f\x7d\xf3\x1e\x6a\x7b\x69\x29\xe0\x2b\x6b\x8d\x0b\x7e\x61\x74\x26\xcd\xad\xf8\xcb\x78\xfe\xc7\x6d\xf5\xf3\x7f\x8a\xab\xfa\x67\x00\xa5\xe7\xeb\x94\x02\x07\x00\x00"),
 		},
 		"/cloudrunv2.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudrunv2.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2791,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x0c\xa4\x4b\x0b\xd8\x94\xad\x23\x7b\x52\x64\x27\x61\x6b\x4b\x85\x28\x27\xc8\x49\x18\x2e\x87\xe4\xda\xcb\xdd\xed\xee\x92\x0a\xe1\xea\xbf\x17\xfc\xd2\x97\xed\xb4\x28\x72\x09\x10\x1e\x24\x62\x67\xe6\xbd\xb7\x33\xf3\x38\x86\xb9\xd2\xb5\xe1\x59\xee\x60\x7a\x35\x9d\xc2\x07\xa5\x32\x41\x70\x77\x37\xf7\xc6\xde\x18\xee\x38\x23\x69\x29\x81\x52\x26\x64\xc0\xe5\x04\x33\x8d\x2c\xa7\x21\x72\x01\x9f\xc8\x58\xae\x24\x4c\xfd\x2b\xf8\xa5\x49\x18\xf5\xa1\xd1\xaf\xbf\x79\x63\xa8\x55\x09\x05\xd6\x20\x95\x83\xd2\x12\xb8\x9c\x5b\x48\xb9\x20\xa0\xaf\x8c\xb4\x03\x2e\x81\xa9\x42\x0b\x8e\x92\x11\x6c\xb9\xcb\x5b\x9a\x1e\xc4\xf7\xc6\xf0\xa5\x87\x50\xb1\x43\x2e\x01\x81\x29\x5d\x83\x4a\x8f\xf3\x00\x5d\x2b\xb8\x7d\x72\xe7\x74\x30\x99\x6c\xb7\x5b\x1f\x5b\xb5\xbe\x32\xd9\x44\x74\x99\x76\x72\x17\xce\x6f\x17\xd1\xed\xe5\xd4\xbf\x6a\x6b\x1e\xa4\x20\x6b\xc1\xd0\x5f\x25\x37\x94\x40\x5c\x03\x6a\x2d\x38\xc3\x58\x10\x08\xdc\x82\x32\x80\x99\x21\x4a\xc0\xa9\x46\xf0\xd6\x70\xc7\x65\x76\x01\x56\xa5\x6e\x8b\x86\xbc\x31\x24\xdc\x3a\xc3\xe3\xd2\x9d\x74\x6b\x90\xc7\xed\x49\x82\x92\x80\x12\x46\xb3\x08\xc2\x68\x04\xef\x66\x51\x18\x5d\x78\x63\xf8\x1c\xae\x3f\x2e\x1f\xd6\xf0\x79\xb6\x5a\xcd\x16\xeb\xf0\x36\x82\xe5\x0a\xe6\xcb\xc5\x4d\xb8\x0e\x97\x8b\x08\x96\xef\x61\xb6\xf8\x02\x7f\x84\x8b\x9b\x0b\x20\xee\x72\x32\x40\x5f\xb5\x69\xf4\x2b\x03\xbc\xe9\x23\x25\x4d\xd3\x22\xa2\x13\x01\xa9\xea\x04\x59\x4d\x8c\xa7\x9c\x81\x40\x99\x95\x98\x11\x64\xaa\x22\x23\xb9\xcc\x40\x93\x29\xb8\x6d\xa6\x69\x01\x65\xe2\x8d\x41\xf0\x82\x3b\x74\xed\xc9\x8b\x4b\xf9\x9e\x87\x9a\xf7\xf3\x0f\x80\x29\x43\x3e\x93\xa6\xf0\x99\x50\x65\xe2\x67\xed\x2a\xf9\x4c\x15\x93\xea\x1a\x85\xce\xf1\xda\x7b\xe2\x32\x09\x20\x22\x53\x71\x46\xf7\xa8\x35\x97\x99\x57\x90\xc3\x04\x1d\x06\x1e\x80\xc4\x82\x02\x68\x01\x4c\x29\xab\xe9\xeb\x80\x7d\xa2\xd5\xc8\x9a\x6c\x69\x8a\x4b\x5b\x5b\x47\x85\xd7\x5c\xef\x80\x33\x6f\xea\x56\xa5\xfc\x34\xf5\x00\xaa\x41\x68\x75\x1d\x93\xc3\x6b\x0f\xc0\x76\x42\x3e\x2a\xeb\x16\xe7\xc4\x1d\x1b\x6a\x6e\x7b\x46\x43\x56\x95\x86\x91\x6d\xf0\x01\x2e\x7b\x8e\x2e\x6f\xd3\x56\x6e\x4c\x29\x37\xd5\x74\xf3\xa8\x62\xaf\x5b\xc5\xee\xc2\x07\x1d\xbf\xef\x23\x58\x3a\xf5\x81\x24\x19\x74\x94\x04\xe0\x4c\x49\x7d\x84\x63\x31\x57\x32\xe5\x59\xd0\x1f\x00\x68\x25\x38\xab\x17\xdf\x22\xdc\x70\x2c\x36\x5d\xde\x59\xd9\x3d\x15\x31\x99\x7f\x2f\x2e\xda\xbc\x7d\xb1\xa1\x94\x0c\x49\x46\xef\x39\x89\xe4\xa0\x65\x68\x6e\xf3\x7b\x74\xe8\x6a\x7d\x7e\x68\x4b\xad\x95\x71\x76\xae\x64\xc2\xdb\x25\x0a\x20\x45\x61\xf7\x17\x4d\xd6\x54\x68\x81\x8e\x02\x18\x69\xa3\x1e\x89\x39\x3b\x79\x7e\xee\x5f\x77\xbb\x89\x50\xac\xdb\xbe\xc9\xf3\xf3\xf0\xbe\xdb\x4d\x1e\x55\xdc\x9c\x34\x64\xbb\xdd\xe8\x05\xda\x1c\xe5\x3b\x7a\xb0\x94\xac\xd5\x3d\x3a\x96\xaf\xfa\xc9\x75\x2d\x38\x56\x30\xcc\x74\x56\x21\x17\x8d\xdd\x43\x39\xb3\x96\x5c\x28\x2b\x92\x4e\x99\xfa\x34\x7d\xd8\xd4\x7e\x73\x0f\x3d\x79\xa5\x23\x02\x63\x12\x36\xe8\xff\xcf\xf8\xc2\x9b\x43\xad\x43\x93\x91\xeb\x9a\x7c\x0c\x91\x73\x32\x68\x58\xce\x19\x8a\xd5\x30\x0b\x7b\xa8\xbb\xec\x5b\xde\x77\xeb\x68\x14\x4f\x54\xef\x8f\x57\x94\x9e\x71\xbf\x81\x95\xf6\x0a\xfe\x2b\x5c\xf3\x24\x64\x99\xe1\xda\xb5\xb6\xfa\xfb\xf2\x28\x02\xb0\xce\x69\x28\x02\x97\xa3\xeb\xbe\xfc\x83\x08\x88\x49\x28\x99\x59\x70\xca\x3f\x2a\xcb\xaa\xa7\xe0\x04\xa5\xf3\xcf\x9f\x2f\x34\xbd\x6a\xe7\x23\x1c\xa3\x4a\x1d\xec\xd9\x0a\x94\x98\x91\x79\xf3\x63\xf2\x6d\x37\xf7\x5f\x89\x37\x1c\x1d\x9d\x44\xbf\xab\xab\x7b\xe2\xff\xef\xec\x63\x80\x1f\xc7\xdd\xbd\xea\x9f\x0e\xff\xe9\xf0\xef\xe4\xf0\x7f\x02\x00\x00\xff\xff\x65\xed\x2b\xff\xe7\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\x22\x47\x10\xbd\xcf\xaf\x78\x82\x4b\x22\xd9\x60\x73\x9c\x9c\x58\xec\xdd\x9d\xc4\xc6\x11\xe0\x5d\xed\x09\x15\x3d\xc5\x4c\xdb\x3d\xdd\x9d\xee\x1e\x58\xe4\xf0\xdf\xa3\xf9\x32\xd8\x5e\x3b\x51\xb4\x97\x95\x96\x41\x30\xaa\x7a\xf5\xea\x75\x7d\x74\x1f\x13\x63\x77\x4e\x66\x79\xc0\xe8\x6c\x34\xc2\x07\x63\x32\xc5\xb8\xba\x9a\x44\xfd\xa8\x8f\x2b\x29\x58\x7b\x4e\x51\xea\x94\x1d\x42\xce\x18\x5b\x12\x39\x77\x9e\x13\x7c\x62\xe7\xa5\xd1\x18\x0d\xce\xf0\x4b\x05\xe8\xb5\xae\xde\xaf\xbf\x45\x7d\xec\x4c\x89\x82\x76\xd0\x26\xa0\xf4\x8c\x90\x4b\x8f\xb5\x54\x0c\xfe\x2a\xd8\x06\x48\x0d\x61\x0a\xab\x24\x69\xc1\xd8\xca\x90\x23\x1c\xf8\x07\x51\x1f\x5f\x5a\x0a\xb3\x0a\x24\x35\x08\xc2\xd8\x1d\xcc\xfa\x18\x07\x0a\xb5\xe0\xfa\x93\x87\x60\xe3\xe1\x70\xbb\xdd\x0e\xa8\x56\x3b\x30\x2e\x1b\xaa\x06\xe9\x87\x57\xc9\xe4\x72\x3a\xbf\x3c\x1d\x0d\xce\xea\x98\x5b\xad\xd8\x7b\x38\xfe\xab\x94\x8e\x53\xac\x76\x20\x6b\x95\x14\xb4\x52\x0c\x45\x5b\x18\x07\xca\x1c\x73\x8a\x60\x2a\xc1\x5b\x27\x83\xd4\xd9\x09\xbc\x59\x87\x2d\x39\x8e\xfa\x48\xa5\x0f\x4e\xae\xca\xf0\xa4\x5a\x9d\x3c\xe9\x9f\x00\x8c\x06\x69\xf4\xc6\x73\x24\xf3\x1e\xde\x8d\xe7\xc9\xfc\x24\xea\xe3\x73\xb2\xf8\x78\x73\xbb\xc0\xe7\xf1\x6c\x36\x9e\x2e\x92\xcb\x39\x6e\x66\x98\xdc\x4c\x2f\x92\x45\x72\x33\x9d\xe3\xe6\x3d\xc6\xd3\x2f\xf8\x23\x99\x5e\x9c\x80\x65\xc8\xd9\x81\xbf\x5a\x57\xe9\x37\x0e\xb2\xaa\x23\xa7\x55\xd1\xe6\xcc\x4f\x04\xac\x4d\xd3\x3e\x6f\x59\xc8\xb5\x14\x50\xa4\xb3\x92\x32\x46\x66\x36\xec\xb4\xd4\x19\x2c\xbb\x42\xfa\xaa\x9b\x1e\xa4\xd3\xa8\x0f\x25\x0b\x19\x28\xd4\x96\x17\x87\x1a\x44\x11\x59\xd9\xf6\x3f\x86\x30\x8e\x07\x42\xbb\x62\x20\x94\x29\xd3\x41\x56\x8f\xd2\x40\x98\x62\xb8\x39\x27\x65\x73\x3a\x8f\xee\xa5\x4e\x63\xcc\xd9\x6d\xa4\xe0\x6b\xb2\x56\xea\x2c\x2a\x38\x50\x4a\x81\xe2\x08\xd0\x54\x70\x8c\x9a\xc0\x95\x7a\x33\xfa\x36\x61\x0b\xf4\x96\x44\x85\xd6\xae\x38\xf5\x3b\x1f\xb8\x88\xaa\xe3\x1d\x78\x26\x15\xcf\xac\xd4\x9f\x46\x11\xb0\xe9\x84\x6e\xce\x57\x1c\xe8\x3c\x02\x7c\x23\xe4\xa3\xf1\x61\xfa\x3c\x71\x93\x8d\xac\xf4\x6d\x46\xc7\xde\x94\x4e\xb0\xaf\xf8\x81\xd3\x36\x47\x83\x5b\xd6\x12\x97\xae\xd4\xcb\xcd\x68\x79\x67\x56\x35\x06\x68\x0e\x7c\xd0\xf1\xfb\xa3\x87\xca\x60\x3e\xb0\x66\x47\x81\xd3\x18\xc1\x95\xdc\x7a\x24\x15\x13\xa3\xd7\x32\x8b\x5b\x03\x60\x8d\x92\x62\x37\x7d\x2b\xe1\x52\x52\xb1\x6c\x70\xcf\xc2\xae\xb9\x58\xb1\xfb\xf7\xe0\xa2\xc6\x3d\x06\x3b\x5e\xb3\x63\x2d\xf8\xbd\x64\x95\x1e\xb4\x74\xc5\xad\x7e\x8f\x8c\x61\x67\x9f\x1b\x7d\x69\xad\x71\xc1\x4f\x8c\x4e\x65\x3d\x44\x31\xd6\xa4\x7c\x87\x90\xe9\x82\x0b\xab\x28\x70\x8c\x9e\x75\xe6\x8e\x45\xf0\xc3\x87\x87\xf6\x75\xbf\x1f\x2a\x23\x9a\xe9\x1b\x3e\x3c\x74\xef\xfb\xfd\xf0\xce\xac\x2a\x5c\xa5\x60\xbf\xef\xbd\x60\x9b\x90\x7e\xc7\xb7\x9e\xd3\x85\xb9\xa6\x20\xf2\x59\xdb\xb9\xa6\x04\xc7\x0a\xba\x9e\x8e\x37\x24\x55\xb5\xee\x89\x1e\x7b\xcf\x21\xd1\x1b\xd6\xc1\xb8\xdd\x53\x78\x37\xa9\xed\xe4\xc6\xd1\x1b\x15\x51\xb4\x62\xe5\xe3\xf6\xff\x59\xbe\xe4\xe2\x10\x1b\xc8\x65\x1c\x9a\x22\x1f\x53\xe4\x92\x1d\x39\x91\x4b\x41\x6a\xd6\xf5\xa2\x1d\xbe\xea\x7b\xda\x96\xbc\xad\xd6\xa3\x1d\xb8\xe7\xdd\xa3\x79\xc6\xeb\x67\xb9\x5f\xe1\x5a\xb7\x0a\xfe\x2b\x5d\xf5\xa4\xec\x85\x93\xb6\xea\x4a\x8c\xbf\x4f\x8f\x3c\xc0\x22\xe7\x2e\x08\x21\xa7\xd0\xdc\xfc\x9d\x08\xac\x58\x19\x9d\x79\x04\x33\x38\x0a\xcb\x36\xf7\x07\x51\x87\xfd\xf9\xf3\x85\xa6\x6f\xae\xf3\xe1\xc9\x9c\x29\x6d\xfc\x98\xad\x20\x4d\x19\xbb\x57\x2f\x93\xb7\xb7\xb9\xbd\x25\x5e\xd9\xe8\xf9\x13\xef\x77\xdd\xea\x36\xf1\xff\xdf\xec\x63\x82\x1f\x67\xbb\x5b\xd5\x3f\x37\xfc\xe7\x86\x7f\xa7\x0d\xff\x67\x00\x65\xed\x2b\xff\xe7\x0a\x00\x00"),
 		},
 		"/cloudscheduler.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudscheduler.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1558,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x73\xda\x48\x10\xbd\xeb\x57\xbc\x82\xcb\x6e\x95\x2d\x6c\x8e\xda\x13\xc1\x4e\xa2\x5d\x07\xb6\x10\xde\x54\x4e\xae\x66\xd4\x48\x63\x8f\x66\x66\x67\x46\x10\xca\xcb\x7f\xdf\xd2\x17\x31\xb1\x5d\x15\x1d\x28\xe8\x7e\xfd\xfa\x75\xbf\x16\x63\xcc\x8d\x3d\x38\x59\x94\x01\xd3\xab\xe9\x14\x9f\x8c\x29\x14\xe3\xee\x6e\x1e\x8d\xa3\x31\xee\xa4\x60\xed\x39\x47\xad\x73\x76\x08\x25\x63\x66\x49\x94\x3c\x64\x2e\xf0\x0f\x3b\x2f\x8d\xc6\x34\xbe\xc2\x6f\x0d\x60\xd4\xa7\x46\xbf\xff\x11\x8d\x71\x30\x35\x2a\x3a\x40\x9b\x80\xda\x33\x42\x29\x3d\xb6\x52\x31\xf8\xbb\x60\x1b\x20\x35\x84\xa9\xac\x92\xa4\x05\x63\x2f\x43\xd9\xb6\xe9\x49\xe2\x68\x8c\x6f\x3d\x85\xd9\x04\x92\x1a\x04\x61\xec\x01\x66\xfb\x12\x07\x0a\xad\xe0\xf6\x29\x43\xb0\xc9\x64\xb2\xdf\xef\x63\x6a\xd5\xc6\xc6\x15\x13\xd5\x21\xfd\xe4\x2e\x9d\xdf\x2e\xb2\xdb\xcb\x69\x7c\xd5\xd6\xdc\x6b\xc5\xde\xc3\xf1\xbf\xb5\x74\x9c\x63\x73\x00\x59\xab\xa4\xa0\x8d\x62\x28\xda\xc3\x38\x50\xe1\x98\x73\x04\xd3\x08\xde\x3b\x19\xa4\x2e\x2e\xe0\xcd\x36\xec\xc9\x71\x34\x46\x2e\x7d\x70\x72\x53\x87\xb3\x6d\x0d\xf2\xa4\x3f\x03\x18\x0d\xd2\x18\xcd\x32\xa4\xd9\x08\x1f\x66\x59\x9a\x5d\x44\x63\x7c\x4d\xd7\x9f\x97\xf7\x6b\x7c\x9d\xad\x56\xb3\xc5\x3a\xbd\xcd\xb0\x5c\x61\xbe\x5c\xdc\xa4\xeb\x74\xb9\xc8\xb0\xfc\x88\xd9\xe2\x1b\xfe\x4a\x17\x37\x17\x60\x19\x4a\x76\xe0\xef\xd6\x35\xfa\x8d\x83\x6c\xf6\xc8\x79\xb3\xb4\x8c\xf9\x4c\xc0\xd6\x74\x82\xbc\x65\x21\xb7\x52\x40\x91\x2e\x6a\x2a\x18\x85\xd9\xb1\xd3\x52\x17\xb0\xec\x2a\xe9\x1b\x37\x3d\x48\xe7\xd1\x18\x4a\x56\x32\x50\x68\x23\xaf\x86\x8a\xa3\x88\xac\xec\xfd\x4f\x20\x8c\xe3\x58\x68\x57\xc5\x42\x99\x3a\x8f\x8b\xf6\x94\x62\x61\xaa\xc9\xee\x9a\x94\x2d\xe9\x3a\x7a\x92\x3a\x4f\x90\xb1\xdb\x49\xc1\x5f\xc8\x5a\xa9\x8b\xa8\xe2\x40\x39\x05\x4a\x22\x40\x53\xc5\x09\x5a\x02\x2f\x4a\xce\x6b\xc5\xee\x6d\xd2\x1e\xec\x2d\x89\xa6\x42\xbb\xea\xd2\x1f\x7c\xe0\x2a\x6a\x46\xfc\xc1\x35\x6f\xea\xb2\x81\x2b\x02\x76\x83\xe0\xdd\xf5\x86\x03\x5d\x47\x80\xef\x04\x7d\x36\x3e\x2c\xde\x12\xd0\x75\x25\x2b\x7d\xdf\xd9\xb1\x37\xb5\x13\xec\x9b\x3e\xc0\x65\xdf\xab\xc3\x3d\xb4\xd5\x0f\xa7\xf2\x87\x47\xb3\x89\xba\xcb\xec\xe6\x3f\x97\xf4\xe7\x29\x4b\x75\x30\x9f\x58\xb3\xa3\xc0\x79\x82\xe0\x6a\xee\x33\x32\x5f\x73\x65\x15\x05\x4e\x30\xb2\xce\x3c\xb2\x08\x7e\xf2\xfc\xdc\x7f\x3d\x1e\x27\xca\x88\xce\xa7\xc9\xf3\xb3\xe3\x42\x1a\x7d\x3c\x4e\x1e\xcd\xa6\xf9\xdd\x88\x3b\x1e\x47\xaf\xb8\xe6\xa4\x3f\xf0\xbd\xe7\x7c\x6d\xbe\x50\x10\xe5\xaa\x9f\xaa\xdb\xc1\x96\x94\x1f\xfa\x0f\xf3\xce\x76\x24\x55\xf3\x5a\xa4\x7a\xe6\x3d\x87\x54\xef\x58\x07\xe3\x0e\xe7\xf0\xc1\xd1\xde\xe1\xa4\x0f\x0f\x96\x34\x9f\x3f\x11\xa7\x37\x3f\x40\x81\x5c\xc1\xe1\xa3\x64\x95\x9f\x61\x4b\xc9\x8e\x9c\x28\xa5\x20\xb5\xe2\x2d\x3b\xd6\x27\x07\x3a\x17\xc2\xc1\x72\x82\x7e\x29\xa7\x38\xf0\xc4\x87\x53\x78\xc5\xdb\x9f\x7a\xbf\xc3\xb5\xed\x15\xfc\x2a\x5d\xf3\xe4\xec\x85\x93\x36\xb4\xf7\xf5\xdf\xe5\x8b\x0c\xb0\x2e\x79\x28\x42\x28\x29\x74\x7f\x85\x83\x08\x6c\x58\x19\x5d\x78\x04\x13\xbf\x28\x2b\x76\x4f\xc9\x19\x4b\x77\x41\x7f\xbf\xd2\xf4\xe6\x5d\xbf\xe0\x71\xa6\xb6\xc9\xa9\x5b\x45\x9a\x8a\x77\xdf\xac\xff\x03\x00\x00\xff\xff\x35\x10\x47\x7e\x16\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xe2\x48\x10\xbd\xfb\x2b\x9e\xe0\xb2\x2b\x05\x93\x70\xf4\x9e\x18\x92\x99\xf1\x6e\x06\x56\x98\xec\x68\x4e\x51\xd1\x2e\xec\x4e\xda\xdd\xbd\xdd\x6d\x18\x94\xe5\xdf\x57\xc6\x36\x84\x49\x46\x1a\x8c\x10\xaa\x7a\xf5\xea\x55\xbd\x6e\x0f\x31\x33\x76\xef\x64\x51\x06\x4c\xae\x27\x13\x7c\x32\xa6\x50\x8c\xfb\xfb\x59\x34\x8c\x86\xb8\x97\x82\xb5\xe7\x1c\xb5\xce\xd9\x21\x94\x8c\xa9\x25\x51\x72\x9f\xb9\xc2\x3f\xec\xbc\x34\x1a\x93\xf8\x1a\xbf\x35\x80\x41\x97\x1a\xfc\xfe\x47\x34\xc4\xde\xd4\xa8\x68\x0f\x6d\x02\x6a\xcf\x08\xa5\xf4\xd8\x48\xc5\xe0\xef\x82\x6d\x80\xd4\x10\xa6\xb2\x4a\x92\x16\x8c\x9d\x0c\x25\xc2\x99\x3f\x8e\x86\xf8\xd6\x51\x98\x75\x20\xa9\x41\x10\xc6\xee\x61\x36\xaf\x71\xa0\x70\x14\x7c\xfc\x94\x21\xd8\x64\x3c\xde\xed\x76\x31\x1d\xd5\xc6\xc6\x15\x63\xd5\x22\xfd\xf8\x3e\x9d\xdd\xcd\xb3\xbb\xd1\x24\xbe\x3e\xd6\x3c\x68\xc5\xde\xc3\xf1\xbf\xb5\x74\x9c\x63\xbd\x07\x59\xab\xa4\xa0\xb5\x62\x28\xda\xc1\x38\x50\xe1\x98\x73\x04\xd3\x08\xde\x39\x19\xa4\x2e\xae\xe0\xcd\x26\xec\xc8\x71\x34\x44\x2e\x7d\x70\x72\x5d\x87\x8b\x6d\xf5\xf2\xa4\xbf\x00\x18\x0d\xd2\x18\x4c\x33\xa4\xd9\x00\x1f\xa6\x59\x9a\x5d\x45\x43\x7c\x4d\x57\x9f\x17\x0f\x2b\x7c\x9d\x2e\x97\xd3\xf9\x2a\xbd\xcb\xb0\x58\x62\xb6\x98\xdf\xa6\xab\x74\x31\xcf\xb0\xf8\x88\xe9\xfc\x1b\xfe\x4a\xe7\xb7\x57\x60\x19\x4a\x76\xe0\xef\xd6\x35\xfa\x8d\x83\x6c\xf6\xc8\x79\xb3\xb4\x8c\xf9\x42\xc0\xc6\xb4\xf6\x79\xcb\x42\x6e\xa4\x80\x22\x5d\xd4\x54\x30\x0a\xb3\x65\xa7\xa5\x2e\x60\xd9\x55\xd2\x37\x6e\x7a\x90\xce\xa3\x21\x94\xac\x64\xa0\x70\x8c\xbc\x19\x2a\x8e\x22\xb2\xb2\xf3\x3f\x81\x30\x8e\x63\xa1\x5d\x15\x0b\x65\xea\x3c\x2e\x8e\x47\x29\x16\xa6\x1a\x6f\x6f\x48\xd9\x92\x6e\xa2\x67\xa9\xf3\x04\x19\xbb\xad\x14\xfc\x85\xac\x95\xba\x88\x2a\x0e\x94\x53\xa0\x24\x02\x34\x55\x9c\xe0\x48\xe0\x45\xc9\x79\xad\xd8\xbd\x4f\xda\x81\xbd\x25\xd1\x54\x68\x57\x8d\xfc\xde\x07\xae\xa2\x66\xc4\x33\xd7\xac\xe1\xca\x7a\xae\x08\xd8\xf6\x82\xb7\x37\x6b\x0e\x74\x13\x01\xbe\x15\xf4\xd9\xf8\x30\x7f\x4f\x40\xdb\x95\xac\xf4\x5d\x67\xc7\xde\xd4\x4e\xb0\x6f\xfa\x00\xa3\xae\x57\x8b\x7b\x3c\x4a\x7d\x3c\x95\x3f\x3e\x99\xf5\x11\x06\xb4\xf3\x5f\x4a\xfa\xf3\x94\xa5\x3a\x98\x4f\xac\xd9\x51\xe0\x3c\x41\x70\x35\x77\x19\x99\xaf\xb8\xb2\x8a\x02\x27\x18\x58\x67\x9e\x58\x04\x3f\x7e\x79\xe9\xfe\x1e\x0e\x63\x65\x44\xeb\xd3\xf8\xe5\xc5\x71\x21\x8d\x3e\x1c\xc6\x4f\x66\xdd\xa0\x1a\x71\x87\xc3\xe0\x0d\xd7\x8c\xf4\x07\x7e\xf0\x9c\xaf\xcc\x17\x0a\xa2\x5c\x76\x53\xb5\x3b\xd8\x90\xf2\x7d\xff\x7e\xde\xe9\x96\xa4\x6a\xae\x45\xaa\xa7\xde\x73\x48\xf5\x96\x75\x30\x6e\x7f\x09\xef\x1d\xed\x1c\x4e\xba\x70\x6f\x49\xf3\xfb\x03\x71\x7a\x7b\x06\x05\x72\x05\x87\x8f\x92\x55\x7e\x81\x2d\x25\x3b\x72\xa2\x94\x82\xd4\x92\x37\xec\x58\x9f\x1c\x68\xbe\x23\x84\xbd\xe5\x04\xdd\x52\x4e\x71\xe0\x99\xf7\xa7\xf0\x92\x37\x3f\xf4\xfe\x09\xd7\xa6\x53\xf0\xab\x74\xcd\x93\xb3\x17\x4e\xda\xc6\x88\x04\xff\x8d\x5e\x65\x80\x55\xc9\x7d\x11\x42\x49\xa1\x7d\x15\xf6\x22\xb0\x66\x65\x74\xe1\x11\x4c\xfc\xaa\xac\xd8\x3e\x9f\x45\x9d\x4f\xd0\xdf\x6f\x34\xbd\x7b\xae\xcf\x4f\xe1\x4c\x6d\x93\x53\xb7\x8a\x34\x15\x3f\xbd\x59\xff\x0f\x00\x35\x10\x47\x7e\x16\x06\x00\x00"),
 		},
 		"/cloudtasks.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cloudtasks.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1781,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4f\x6f\xdb\xb8\x13\xbd\xeb\x53\x0c\xec\xcb\xef\x07\x24\x72\x92\xa3\xf6\xe4\x3a\x69\x2b\x6c\xe2\xec\xda\xce\x16\x3d\x05\x63\x6a\x24\xcd\x86\x22\x59\x72\x64\x57\xc8\xfa\xbb\x2f\xf4\x2f\x76\x92\x76\x51\x1d\x04\x61\xf8\xde\x9b\xc7\xc7\xa1\xa6\xb0\xb0\xae\xf1\x5c\x94\x02\x57\x17\x57\x57\xf0\xc9\xda\x42\x13\xdc\xde\x2e\xa2\x69\x34\x85\x5b\x56\x64\x02\x65\x50\x9b\x8c\x3c\x48\x49\x30\x77\xa8\x4a\x1a\x57\xce\xe0\x2f\xf2\x81\xad\x81\xab\xf8\x02\xfe\xd7\x02\x26\xc3\xd2\xe4\xff\xbf\x45\x53\x68\x6c\x0d\x15\x36\x60\xac\x40\x1d\x08\xa4\xe4\x00\x39\x6b\x02\xfa\xae\xc8\x09\xb0\x01\x65\x2b\xa7\x19\x8d\x22\xd8\xb3\x94\x5d\x9b\x41\x24\x8e\xa6\xf0\x75\x90\xb0\x5b\x41\x36\x80\xa0\xac\x6b\xc0\xe6\xa7\x38\x40\xe9\x0c\x77\x4f\x29\xe2\x92\xd9\x6c\xbf\xdf\xc7\xd8\xb9\x8d\xad\x2f\x66\xba\x47\x86\xd9\x6d\xba\xb8\x59\xae\x6f\xce\xaf\xe2\x8b\x8e\xf3\x60\x34\x85\x00\x9e\xbe\xd5\xec\x29\x83\x6d\x03\xe8\x9c\x66\x85\x5b\x4d\xa0\x71\x0f\xd6\x03\x16\x9e\x28\x03\xb1\xad\xe1\xbd\x67\x61\x53\x9c\x41\xb0\xb9\xec\xd1\x53\x34\x85\x8c\x83\x78\xde\xd6\xf2\x2a\xad\xd1\x1e\x87\x57\x00\x6b\x00\x0d\x4c\xe6\x6b\x48\xd7\x13\xf8\x30\x5f\xa7\xeb\xb3\x68\x0a\x5f\xd2\xcd\xe7\xfb\x87\x0d\x7c\x99\xaf\x56\xf3\xe5\x26\xbd\x59\xc3\xfd\x0a\x16\xf7\xcb\xeb\x74\x93\xde\x2f\xd7\x70\xff\x11\xe6\xcb\xaf\xf0\x7b\xba\xbc\x3e\x03\x62\x29\xc9\x03\x7d\x77\xbe\xf5\x6f\x3d\x70\x9b\x23\x65\x6d\x68\x6b\xa2\x57\x06\x72\xdb\x1b\x0a\x8e\x14\xe7\xac\x40\xa3\x29\x6a\x2c\x08\x0a\xbb\x23\x6f\xd8\x14\xe0\xc8\x57\x1c\xda\xd3\x0c\x80\x26\x8b\xa6\xa0\xb9\x62\x41\xe9\x2a\xef\x36\x15\x47\x11\x3a\x1e\xce\x3f\x01\x65\x3d\xc5\xca\xf8\x2a\x56\xda\xd6\x59\x5c\x74\xa3\x14\x2b\x5b\xcd\x76\x97\xa8\x5d\x89\x97\xd1\x13\x9b\x2c\x81\x35\xf9\x1d\x2b\xba\x43\xe7\xd8\x14\x51\x45\x82\x19\x0a\x26\x11\x80\xc1\x8a\x12\xe8\x04\x04\xc3\x53\xf8\xb1\xe0\x00\x0c\x0e\x55\x8b\x36\xbe\x3a\x0f\x4d\x10\xaa\xa2\x76\x7b\x47\x9d\x45\xcb\xdb\xb4\x3a\x11\xc0\x6e\x34\xba\xbb\xdc\x92\xe0\x65\x04\x10\x7a\x23\x9f\x6d\x90\xe5\xdb\xc6\x7d\x37\x74\x1c\x86\x8e\x9e\x82\xad\xbd\xa2\xd0\xea\x03\x9c\x0f\x3d\x7a\xdc\x63\xc7\x7c\xec\xa8\x8f\xdf\x6a\xaa\x29\xea\x67\xb1\xdf\xf1\xd1\xc8\x9f\x27\x6b\x58\x8b\xfd\x44\x86\x3c\x0a\x65\x09\x88\x7f\x59\x61\xac\x16\xd6\xe4\x5c\x24\x43\x01\xc0\x59\xcd\xaa\x59\xfe\x67\xcf\x47\xc6\xea\xb1\x07\xbe\xe1\xdd\x51\xb5\x25\xff\x0b\xec\xaa\x03\xbe\xb0\x3d\xe5\xe4\xc9\x28\xfa\xc8\xa4\xb3\xa3\x9b\x31\xe1\xf6\x7d\x52\x94\xc6\xbd\x2d\x86\xda\x39\xeb\x25\x2c\xac\xc9\xb8\x9b\xa4\x04\x72\xd4\xe1\x65\xab\xd9\x86\x2a\xa7\x51\x28\x81\x89\xf3\xf6\x6f\x52\x12\x66\xcf\xcf\xc3\xe7\xe1\x30\xd3\x56\xf5\x23\x38\x7b\x7e\x1e\xbf\x0f\x87\x59\xe7\xb9\xad\xb5\xed\x0e\x87\xc9\x3b\xbd\x05\x9a\x0f\xf4\x10\x28\xdb\xd8\x3b\x14\x55\xae\x86\x03\xec\x53\x38\xf5\x30\x1e\xed\x7c\x87\xac\xdb\x5b\x9f\x9a\x79\x08\x24\xa9\xd9\x91\x11\xeb\x9b\xd7\xf0\x71\x60\x87\x01\x3e\xa6\xf2\x2e\x93\x51\x38\xbd\x3e\x82\x04\x7d\x41\xd2\xe7\x79\x8a\x2d\x99\x3c\x7a\x55\xb2\x42\xbd\x1a\x63\x0f\x47\xde\xf9\x90\xee\x10\xcc\x49\xea\x4f\xd4\xbc\x94\x57\x94\xbf\xe9\xfd\x13\xad\x7c\x70\xf0\xab\x72\xed\x93\x51\x50\x9e\x9d\x74\xd7\xe8\x9f\xf3\x93\x15\x80\x4d\x49\x23\x09\xa4\x44\xe9\xff\xf4\xa3\x09\xd8\x92\xb6\xa6\x08\x20\x36\x3e\xa1\x15\xbb\xa7\xe4\x95\x4a\x7f\x5d\xfe\x78\xe7\xe9\x87\xd7\xf7\x44\xc7\xdb\xda\x25\x2f\xdd\x2a\x34\x58\x90\xff\xc9\xcf\xe3\xdf\x00\x00\x00\xff\xff\x70\xf8\x05\x5c\xf5\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\x3c\xd8\x97\x5d\x20\x91\x93\x1c\xb5\x27\xd7\x49\x5b\x61\x13\x67\xd7\x76\xb6\xe8\x29\x18\x53\x63\x89\x1b\x8a\x64\x49\xca\xae\x90\xf5\x7f\x5f\xe8\xcb\x76\x92\xa6\x68\x14\x18\xc2\xcc\x9b\x37\x8f\x6f\x86\x1a\x63\x66\x6c\xed\x64\x5e\x04\x5c\x5d\x5c\x5d\xe1\x93\x31\xb9\x62\xdc\xde\xce\xa2\x71\x34\xc6\xad\x14\xac\x3d\x67\xa8\x74\xc6\x0e\xa1\x60\x4c\x2d\x89\x82\x87\xcc\x19\xfe\x61\xe7\xa5\xd1\xb8\x8a\x2f\xf0\x5b\x03\x18\xf5\xa9\xd1\xef\x7f\x44\x63\xd4\xa6\x42\x49\x35\xb4\x09\xa8\x3c\x23\x14\xd2\x63\x23\x15\x83\xbf\x0b\xb6\x01\x52\x43\x98\xd2\x2a\x49\x5a\x30\x76\x32\x14\x08\x47\xfe\x38\x1a\xe3\x6b\x4f\x61\xd6\x81\xa4\x06\x41\x18\x5b\xc3\x6c\x4e\x71\xa0\xd0\x0a\x6e\xff\x8a\x10\x6c\x32\x99\xec\x76\xbb\x98\x5a\xb5\xb1\x71\xf9\x44\x75\x48\x3f\xb9\x4d\x67\x37\xf3\xe5\xcd\xf9\x55\x7c\xd1\xd6\x3c\x68\xc5\xde\xc3\xf1\xb7\x4a\x3a\xce\xb0\xae\x41\xd6\x2a\x29\x68\xad\x18\x8a\x76\x30\x0e\x94\x3b\xe6\x0c\xc1\x34\x82\x77\x4e\x06\xa9\xf3\x33\x78\xb3\x09\x3b\x72\x1c\x8d\x91\x49\x1f\x9c\x5c\x57\xe1\x85\x5b\x83\x3c\xe9\x5f\x00\x8c\x06\x69\x8c\xa6\x4b\xa4\xcb\x11\x3e\x4c\x97\xe9\xf2\x2c\x1a\xe3\x4b\xba\xfa\x7c\xff\xb0\xc2\x97\xe9\x62\x31\x9d\xaf\xd2\x9b\x25\xee\x17\x98\xdd\xcf\xaf\xd3\x55\x7a\x3f\x5f\xe2\xfe\x23\xa6\xf3\xaf\xf8\x33\x9d\x5f\x9f\x81\x65\x28\xd8\x81\xbf\x5b\xd7\xe8\x37\x0e\xb2\xf1\x91\xb3\xc6\xb4\x25\xf3\x0b\x01\x1b\xd3\x8d\xcf\x5b\x16\x72\x23\x05\x14\xe9\xbc\xa2\x9c\x91\x9b\x2d\x3b\x2d\x75\x0e\xcb\xae\x94\xbe\x99\xa6\x07\xe9\x2c\x1a\x43\xc9\x52\x06\x0a\x6d\xe4\xcd\xa1\xe2\x28\x22\x2b\xfb\xf9\x27\x10\xc6\x71\x2c\xb4\x2b\x63\xa1\x4c\x95\xc5\x79\xbb\x4a\xb1\x30\xe5\x64\x7b\x49\xca\x16\x74\x19\x3d\x49\x9d\x25\x58\xb2\xdb\x4a\xc1\x77\x64\xad\xd4\x79\x54\x72\xa0\x8c\x02\x25\x11\xa0\xa9\xe4\x04\x2d\x41\x20\xff\xe4\x7f\x4c\xd8\x03\xbd\x25\xd1\xa0\xb5\x2b\xcf\x7d\xed\x03\x97\x51\x73\xbc\x23\xcf\xac\xe1\x59\x35\x3c\x11\xb0\x1d\x84\x6e\x2f\xd7\x1c\xe8\x32\x02\x7c\x27\xe4\xb3\xf1\x61\xfe\xba\x71\xd7\x8d\xac\xf4\x7d\x47\xc7\xde\x54\x4e\xb0\x6f\xf8\x81\xf3\xbe\x47\x87\x7b\x6c\x25\x3e\xb6\xa5\x8f\xdf\x2a\xae\xb8\x05\x01\xdd\x89\x8f\x42\xfe\x3e\xc9\x51\x15\xcc\x27\xd6\xec\x28\x70\x96\x20\xb8\x43\x46\x52\x39\x33\x7a\x23\xf3\xa4\x0f\x00\xd6\x28\x29\xea\xf9\x4f\x7b\x3e\x4a\x2a\x1f\x3b\xe0\xab\xba\x3b\x2e\xd7\xec\x7e\xa1\xba\x6c\x81\x87\x6a\xc7\x1b\x76\xac\x05\x7f\x94\xac\xb2\xa3\x9a\xc1\xe1\xe6\xf7\x24\x18\x6a\xfb\x3a\xe8\x2b\x6b\x8d\x0b\x7e\x66\x74\x26\xdb\x4d\x4a\xb0\x21\xe5\x07\x84\xcc\x56\x5c\x5a\x45\x81\x13\x8c\xac\x33\xff\xb2\x08\x7e\xf2\xfc\xdc\xbf\xee\xf7\x13\x65\x44\xb7\x82\x93\xe7\xe7\xe1\x7d\xbf\x9f\xb4\x2e\x37\xc8\x46\xc3\x7e\x3f\x7a\xc3\x37\x23\xfd\x81\x1f\x3c\x67\x2b\x73\x47\x41\x14\x8b\x7e\x80\x9d\x0b\xa7\x1a\x86\xd1\x4e\xb7\x24\x55\x73\xeb\x53\x3d\xf5\x9e\x43\xaa\xb7\xac\x83\x71\xf5\x4b\xf8\xb0\xb0\xfd\x02\x27\xd1\xbb\x9e\x0c\xc4\xe9\xf5\x11\x14\xc8\xe5\x1c\x3a\x3f\x4f\xb1\x85\x64\x47\x4e\x14\x52\x90\x5a\x0c\xb6\xf7\xcb\xd6\xfc\x9f\xf7\xee\xf6\xc6\x1c\xe2\xc0\x13\xd7\x87\xf0\x82\x37\xaf\x7a\xbf\xc3\xb5\xe9\x15\xfc\x2a\x5d\xf3\x64\xec\x85\x93\xb6\x19\x40\x82\xff\xce\x4f\x32\xc0\xaa\xe0\xa1\x08\xa1\xa0\xd0\x7d\xe9\x07\x11\x58\xb3\x32\x3a\xf7\x08\x26\x3e\x29\xcb\xb7\x4f\x47\x51\xc7\xeb\xf2\xd7\x1b\x4d\x3f\xbc\xbe\xc7\x27\x77\xa6\xb2\xc9\xa1\x5b\x49\x9a\x72\x76\xef\x7c\x3c\xfe\x1f\x00\x70\xf8\x05\x5c\xf5\x06\x00\x00"),
 		},
 		"/compute.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "compute.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 68764,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x41\x73\xdb\xb8\xaf\xbf\xfb\x53\x70\x9a\xcb\x7b\x33\x6d\xb2\xed\x31\xef\xe4\xa6\x6d\xea\xd9\x24\xcd\xc4\x69\x77\xf6\xa4\x61\x24\x58\xe6\x8b\x4c\x72\x49\x2a\xae\xb7\xff\x7c\xf7\xff\x48\xa4\x6c\xc9\x96\x64\xd9\x96\x12\x25\x45\x0f\x1b\xaf\x04\x91\x04\x89\x1f\x00\x92\x20\x78\x44\xce\x84\x5c\x28\x16\x4e\x0d\xf9\xf0\xc7\x87\x0f\xe4\x5c\x88\x30\x02\x72\x71\x71\x36\x38\x1a\x1c\x91\x0b\xe6\x03\xd7\x10\x90\x98\x07\xa0\x88\x99\x02\x19\x4a\xea\x4f\x21\x7b\xf3\x96\xfc\x00\xa5\x99\xe0\xe4\xc3\xf1\x1f\xe4\x7f\x12\x82\x37\xee\xd5\x9b\xff\xfd\xbf\xc1\x11\x59\x88\x98\xcc\xe8\x82\x70\x61\x48\xac\x81\x98\x29\xd3\x64\xc2\x22\x20\xf0\xd3\x07\x69\x08\xe3\xc4\x17\x33\x19\x31\xca\x7d\x20\x73\x66\xa6\x69\x35\xae\x90\xe3\xc1\x11\xf9\xdb\x15\x21\xee\x0c\x65\x9c\x50\xe2\x0b\xb9\x20\x62\x92\xa7\x23\xd4\xa4\x0d\x4e\xff\x4d\x8d\x91\xa7\x27\x27\xf3\xf9\xfc\x98\xa6\xad\x3d\x16\x2a\x3c\x89\x2c\xa5\x3e\xb9\x18\x9d\x7d\xbe\x1a\x7f\x7e\xf7\xe1\xf8\x8f\xf4\x9b\xef\x3c\x02\xad\x89\x82\x7f\x62\xa6\x20\x20\x77\x0b\x42\xa5\x8c\x98\x4f\xef\x22\x20\x11\x9d\x13\xa1\x08\x0d\x15\x40\x40\x8c\x48\x1a\x3c\x57\xcc\x30\x1e\xbe\x25\x5a\x4c\xcc\x9c\x2a\x18\x1c\x91\x80\x69\xa3\xd8\x5d\x6c\x0a\xbd\x95\x35\x8f\xe9\x02\x81\xe0\x84\x72\xf2\x66\x38\x26\xa3\xf1\x1b\xf2\x71\x38\x1e\x8d\xdf\x0e\x8e\xc8\x5f\xa3\xdb\xaf\xdf\xbe\xdf\x92\xbf\x86\x37\x37\xc3\xab\xdb\xd1\xe7\x31\xf9\x76\x43\xce\xbe\x5d\x7d\x1a\xdd\x8e\xbe\x5d\x8d\xc9\xb7\x2f\x64\x78\xf5\x37\xf9\x73\x74\xf5\xe9\x2d\x01\x66\xa6\xa0\x08\xfc\x94\x2a\x69\xbf\x50\x84\x25\xfd\x08\x41\xd2\x69\x63\x80\x42\x03\x26\xc2\x36\x48\x4b\xf0\xd9\x84\xf9\x24\xa2\x3c\x8c\x69\x08\x24\x14\x0f\xa0\x38\xe3\x21\x91\xa0\x66\x4c\x27\xa3\xa9\x09\xe5\xc1\xe0\x88\x44\x6c\xc6\x0c\x35\xe9\x93\x0d\xa6\x8e\x07\x03\x2a\x99\x1b\xff\x53\xe2\x0b\x05\xc7\x3e\x57\xb3\x63\x3f\x12\x71\x70\x1c\xa6\xa2\x74\xec\x8b\xd9\xc9\xc3\x7b\x1a\xc9\x29\x7d\x3f\xb8\x67\x3c\x38\x25\x63\x50\x0f\xcc\x87\x4b\x2a\x25\xe3\xe1\x60\x06\x86\x06\xd4\xd0\xd3\x01\x21\x9c\xce\xe0\x34\x15\x88\xd8\x54\x94\xe6\xa8\xb4\xa4\x7e\x42\xca\xd5\xec\x9d\x5e\x68\x03\xb3\x41\xc2\xdb\xaa\x90\x33\x5b\xc8\x80\x90\x87\xac\x89\x0f\xef\xef\xc0\xd0\xf7\x03\x42\xb4\x6d\xc2\x57\xa1\xcd\x55\xa1\x4a\x5b\x0f\x95\x4c\xbb\xba\x14\x68\x11\x2b\x1f\x74\x52\x32\x21\xef\x5c\xe9\x96\xce\x73\x9f\x79\x34\x08\x92\x41\x18\x58\x01\xb4\x6c\xba\x06\x0c\x0b\xaf\x68\x6c\xc4\x39\x70\x50\xd4\x40\x70\x4a\x8c\x8a\xc1\xbd\x61\xc1\x2d\xcc\x64\x44\x0d\x9c\x92\x37\x52\x89\xff\x07\xdf\xe8\x93\x5f\xbf\xdc\xcf\xc7\xc7\x13\x05\x61\x32\x12\x27\xbf\x7e\xd9\x5f\x8f\x8f\x27\xae\x5e\x48\x1e\x26\x0d\x7b\x7c\x7c\xb3\x51\xdc\x19\xe5\x1f\xe1\xbb\x86\xe0\x56\x5c\x52\xe3\x4f\x6f\x1c\x47\x96\xf1\x09\x8d\x74\xd6\x84\x8c\xd7\xe1\x03\x65\x51\x22\xfd\x23\x3e\xd4\x1a\xcc\x88\x3f\x00\x37\x42\x2d\x8a\xe4\xd9\xc0\xb9\x81\x3c\x75\x8f\xb3\x01\x48\xfe\xbb\x7c\x14\xd1\x3b\x88\xf4\xa9\xfb\xbb\x56\xdf\xe8\xd3\xea\x5b\x43\x55\x08\xe6\x0b\x83\x28\x28\x14\x31\x65\xa0\xa8\xf2\xa7\xcc\xa7\xd1\x0d\x4c\x40\x01\x5f\x0e\x8a\x1d\x18\xb3\x90\x70\x4a\x5c\x77\x2d\x9f\x13\x72\x0f\x8b\xe5\xe3\x1b\x98\xac\xd5\x5d\x51\xd6\xc4\xb5\xa0\x69\x71\xc9\xbf\x00\xb4\xaf\x98\x34\xa9\xac\xfd\xe7\x5d\xee\x0d\x21\xb7\x53\xc8\x3e\x22\x66\x4a\x8d\x55\x84\x59\x23\xc8\x1d\x44\x82\x87\x9a\x18\x71\x9c\xfb\x2c\x7c\xb8\x3f\x2d\x94\x62\x05\xeb\x7a\xa3\x4d\xa5\x32\x9e\x2b\x47\x89\x58\x9e\x2e\x6b\x9b\x51\x4e\x43\x50\x95\xf0\xca\x3a\x21\xe5\x54\xc7\x77\x1c\xcc\x5c\xa8\xfb\x22\xb3\xcb\x1e\x5a\x11\x6c\x6f\xb9\x83\xc4\xb8\xec\x93\x66\x3c\x6c\x53\x0d\xcb\xe6\xe5\xc5\x48\x43\x34\xf1\x22\xc6\xef\xd7\x99\xab\xe7\x6c\x57\xb6\xae\x9e\x8f\xa7\x2a\xb5\x14\x1b\xa1\x7d\x1a\x81\x2a\xd5\x4c\xeb\x6f\x0f\x51\x4e\xff\x0a\x9e\x6a\xa1\xe4\x6f\xa2\x98\x96\x65\xf7\x5c\x35\xa1\x0e\xea\xbf\x0e\xb2\xe3\x51\x81\x52\xfb\xb2\x31\x48\x47\x5c\x9b\xc4\xd5\x3c\x4f\xda\x73\x69\x5b\xd1\x23\xc4\xde\x51\xff\x1e\x78\xe0\xdd\xc5\xfe\xfd\x92\xab\x02\x03\x1f\x2d\xc5\xc7\x3c\x41\x0d\x70\xe9\xec\x4c\xf0\x09\xcb\x41\x40\x8a\x88\xf9\x8b\xab\xed\xb5\x7b\x8c\xce\x3c\x4b\xbd\xf6\xf1\x25\xcc\xee\x40\x35\x2d\x62\x96\x52\x2f\x8b\x50\x99\xb4\xdb\x7e\xc9\x75\x57\x89\xdf\x40\x1c\xa4\x0a\x0f\x75\x2c\xa5\x50\x46\x9f\x09\x1e\xb0\xd4\x3b\x2d\xa2\x7f\xbb\xb6\x0a\x23\x71\x47\xa3\x93\xbb\x7c\x5f\xa2\xa2\x42\x45\xb5\x8f\xa2\x6a\x84\x64\x4f\xb3\x90\x43\xe0\xc5\x2a\xf2\xee\x61\xb1\x15\xd8\xe3\x94\xfc\xfb\xcd\xc5\x9f\x4b\xe2\x43\xac\x73\x95\xbc\x17\x1b\xf9\xf8\x88\x10\x40\x08\x1c\x66\xab\x0b\x22\x56\x61\xb2\x4b\x8d\x5c\x0d\x03\x35\x96\xaf\x39\x37\x7b\xd9\xec\x35\x5b\x24\xa9\x02\x6e\x72\xd8\xdb\x82\x7d\xb7\xcc\x50\x83\xf6\x71\x81\xa2\x6d\x3b\xee\xea\x3f\xc8\x90\xe7\xcb\x78\x12\x4b\x7e\x80\x62\x73\xbd\x89\x96\x1c\xd5\x58\x07\x96\x3c\x43\x42\x63\x53\xee\xc4\xb1\x53\x5b\x9e\x13\xf9\xb5\x76\xa2\x35\x47\x18\xec\x05\x03\xb2\x61\xcd\x9d\x90\x6d\x31\xe7\x45\x6b\x57\xc3\x42\x9d\x09\x6c\xce\xd0\x53\x1a\xf4\x80\xe9\xfb\x32\xa0\x7f\x5a\x3d\x6f\xcb\x76\x27\x55\xed\x65\xb0\x97\x1f\xf6\x70\xbe\xbd\xb6\x3a\x98\x34\xb5\xe7\x46\x1a\xb7\x2c\x5e\xac\xd2\x4a\x7a\xb5\x6a\xb1\x70\x21\x9b\xeb\xa7\x04\xdc\xb7\xc5\x0f\x9e\x63\x93\x42\x73\x2a\xf5\x54\x54\x4d\xa6\xb2\xd7\x8d\xd9\x1a\x6f\x7e\xf0\xa4\x6c\xed\xa6\x75\xbd\x4c\x04\x9c\x3e\xf4\xa8\x31\xd4\x9f\xce\x80\x97\xae\x8e\x26\x63\x96\x69\x8b\xeb\xf4\x83\xe1\x3a\x7d\x8b\xbb\x1c\x99\x1e\x4b\xfe\xa2\xbb\x45\x50\x73\x1d\xa8\xb9\x82\x54\x7a\x4b\x61\x9e\xf3\x40\x6a\x5a\xbb\xe9\x96\x34\x6f\xf1\x53\xfa\x53\xf0\xd3\x80\xe2\x34\xf2\x1e\x24\xf7\x42\x6a\x60\x4e\x4b\x27\x52\x9f\x1d\xdd\x0f\xc9\xcf\x0b\x54\x2d\x4c\xa0\x60\xa3\x6c\x74\x49\x10\xd8\xdd\x2f\x27\x4c\x98\x82\x39\x8d\xa2\x32\x79\xff\x52\x7c\xd7\x82\x94\x67\xb5\xf5\x5c\xb6\x51\x88\x7b\x2a\xc4\xe4\xf7\x89\x96\x99\x08\x35\xa7\x2a\x60\x3c\xf4\x54\x1c\x95\xae\xda\x7f\x59\x92\xdc\xac\x28\x5a\x8e\xe9\x9b\x14\xea\xe8\x39\x6e\xd1\x26\xbd\x58\x38\xbf\xba\xb5\xbd\xd7\x1f\xe6\x47\x5e\x73\x5c\x66\x85\x56\xb6\x7e\x4c\x5d\x84\xf5\x79\x4a\xd1\x5e\x9c\xb5\xf3\x9c\x30\xb6\x7a\x25\x72\xa8\x81\xd1\xa1\xda\x1b\xba\x0d\xfc\x2a\x0b\xe1\xd6\xbd\xab\x6c\x0e\x84\x1e\x15\xe2\x79\x37\x50\x20\x9e\xab\xf1\xec\x18\xf1\x80\x07\x52\xb0\xf2\x75\x78\x0b\x68\xc7\xc2\xe7\x22\x61\x0b\x88\xe6\xc5\x82\xd3\x60\xee\x84\xb0\xa2\x85\x5e\xda\x35\xe9\x0a\x3d\x93\x99\x2b\x91\xfe\xef\xe4\x9f\x80\xa7\x3f\xa4\x50\xa6\x4f\x1a\x21\x69\x4f\x53\xe8\xe7\x68\x11\xfa\xcf\x03\xfd\xb0\x4c\xde\x53\xb1\xac\xd0\x06\xb5\x92\xda\x58\x47\x94\xc2\xec\x7c\xad\x88\xfe\x2d\xff\x37\x61\x7e\x37\x3e\x3b\xd4\x29\x3d\xf6\x15\xd0\x29\xe8\xa9\x66\xa8\x90\xfb\x29\xd0\xc8\x4c\x3d\x7f\x0a\x7e\x69\x38\xd1\xd7\xf4\xfd\x59\xee\x75\x0b\x72\x3d\x5d\x15\x8a\xe2\x8c\xe2\xdc\xa6\x38\x1b\x23\xb7\xcb\xb4\x31\xb2\x1b\xb9\x2e\x16\x8c\xb2\x8d\xb2\xdd\xb2\x6c\xeb\x46\xc2\xad\x3b\x93\x6e\x8d\xe2\x8d\xe2\xdd\x8d\x78\xb3\x19\x0d\x4b\x57\xe2\x46\xb9\x17\x6d\x85\x34\xa7\x95\xed\x15\xd3\xbc\xfa\x72\xc7\xa0\xe6\x3c\x7f\xa4\xdb\xb3\x47\x69\x55\x3d\x87\x27\x2e\x2a\xbe\x2c\xd4\x92\xdc\x46\x5f\xfa\xc9\xa7\xea\xc8\x40\x17\x1e\xdb\xd3\x00\xc1\xea\x0d\x4c\x2b\x79\x09\x78\xea\x19\x5b\x87\xf2\x96\xfc\x0e\x6b\xd4\xcf\xc7\xda\x78\x4b\xdc\xb6\xe5\xee\x45\x85\x6f\x57\x19\x13\x97\x53\xc3\xae\xdf\x78\x09\x4d\xe0\xe5\x16\x25\xab\x13\x70\x24\x2a\x30\xb8\x5e\x51\xb6\x18\x9b\xcd\xf2\xf5\xa4\x6b\xc3\xcb\x35\x60\xbb\xd6\xdb\x6f\x8d\x8d\xaa\xb9\xff\xaa\x39\xac\x5b\xdf\xdd\x69\x1d\xb7\x00\x8a\xa7\x41\xf6\x9e\x4b\xb7\x8c\x1b\x50\xbe\xe0\x1c\x7c\xb3\xe5\x2c\xc6\x28\x47\xda\xea\x29\x8c\x92\xa0\x39\x56\x5a\x57\xcf\xbd\x32\xc4\x78\xff\x31\xae\x44\x6c\x40\x55\x80\xdc\xbe\x6c\x8c\xf2\x9b\x75\xf2\xe7\x36\xdc\x33\xea\x4f\x19\xaf\x99\x0d\x5e\x5a\x82\x2e\x26\x85\x85\xba\xf7\x9a\x1c\x6e\x96\xb0\xe3\x24\xb1\x8c\x7d\xd2\xed\x64\x71\x96\xeb\x50\xd4\x4e\xa8\x9d\x5a\x99\x1c\x66\xde\xc3\x96\x79\x94\xa3\xda\xd9\x29\xe9\x95\xc2\x4a\x3a\x27\xf0\xb4\x8e\x3c\x1f\x94\x61\x13\xe6\x53\x53\xa1\xba\x52\xd2\xf1\xf8\xe2\x6c\x83\xb0\x85\xf5\x59\xad\xa3\x5c\xb9\x08\x65\x84\x72\x8b\xab\xb3\xc5\x50\xb1\x9a\xa8\xb0\xf6\xc2\x1e\x50\x82\x51\x82\xdb\x97\xe0\xda\x18\xc1\xf6\xa3\x03\xd7\x96\x80\x2a\x23\x7a\x6a\xc2\x03\x9d\xcd\x2b\x8b\x15\xc4\x10\x41\x84\xc7\x01\xbe\x1a\xab\xf7\xd2\x7a\xee\x9e\x15\x66\x43\xc5\x78\xe7\x26\xe1\x8e\x07\xc6\x39\xbe\xb8\x08\xc7\xe6\xa1\x8d\x1d\x05\x35\x36\x55\x85\x68\xf2\x51\xa7\xe1\x89\x87\x3c\x4f\xbf\xcd\xc9\xca\x4c\x47\x49\x00\x95\x1e\xce\x12\xb1\x01\xed\xf9\xe9\x1a\x5e\x8d\xaa\xba\xb6\xf4\xe9\x6a\xa6\x3e\xcb\x53\xb7\x3c\x1d\xb1\x3f\x57\xda\xcb\x55\x9c\x7e\x67\x7f\xf6\x49\x75\xb9\x26\x35\xf6\xc8\x0a\xe4\xa8\xc0\x50\x81\x3d\x91\x67\x22\x02\xa8\xf1\x46\x44\x00\x5d\xb9\x20\x59\xd1\xe8\x77\x20\x6c\x0f\x85\xad\x08\x20\x13\x99\x2a\xec\x26\x72\x6e\x1c\x4d\x73\x04\xe7\x0a\xee\x93\xa9\x2e\x61\x66\x5b\xbb\x5b\xde\x61\xcf\x77\x39\x22\x18\x11\xdc\xe2\x62\xa1\x50\x21\xe5\xec\xdf\xf4\xa2\x49\x4f\x83\x1f\x2b\x66\x16\xc5\xed\xe0\x82\xb0\x7f\xcb\xd1\x8f\x1d\xf9\x75\x9e\xba\xa1\xe8\x47\xc2\xb7\x97\x5b\x2e\x37\x75\xf2\x85\xb1\x54\xcc\x5d\xd6\x51\x16\xf4\x49\xd6\x97\x8d\x6a\xec\x6c\x16\x3e\xd8\x63\x18\x3c\xaa\xb5\xf0\x59\xfa\x6e\xb7\x21\x19\x6e\x7c\xd8\x70\x74\x0a\x7d\x7f\x92\xab\xff\xb5\x28\x9f\x7d\x86\xa1\x2a\x81\x46\x75\xff\xef\x9e\x4a\xa3\xd8\xf1\x52\x31\x91\x94\x96\xda\x07\xfb\xb3\x4f\x3d\x9f\xb5\xa9\x31\x12\xf2\xf4\x15\x23\x20\x69\x7a\x65\xd3\x8c\x29\x25\x72\x73\xb4\x42\x87\x5f\xa7\x34\x97\x6b\x24\x2d\xdb\x5c\x59\xac\x04\xcd\x2e\x9a\xdd\x16\xcd\xae\x04\xb5\x0a\xdd\xae\x5e\xf5\xb9\x06\x95\x6d\x35\xb4\xb6\xd8\x53\x17\xab\xed\x2e\x65\xd4\xb9\xdd\x38\x17\x58\xee\x38\xc5\xd0\x6d\xc4\xc1\x5e\x38\x20\x9b\x9b\x71\x79\x89\xdb\xb2\x31\x57\x14\xc2\xc6\x33\xca\xe7\xbb\x70\x74\xcf\x05\x22\x6b\x7d\xb6\xdc\x1d\x7c\x93\x12\xb5\x7a\x83\x70\xd9\xf5\xe6\x78\x8b\x30\x42\xbe\x04\x0e\x07\x9b\x3e\x27\xe3\x0d\xee\xe9\xb3\x82\xde\xe9\x6d\x7d\xe5\x8d\xd9\x2b\x24\xba\xa6\xa8\xfe\xdd\xdd\x57\x02\x78\xbc\xc7\x0f\x41\x8f\xfb\x3b\x3b\x2c\x0c\x3b\xc0\x57\xdd\x4f\x66\xb5\x57\x07\xb7\x94\xe5\xea\x3d\x44\x51\xf5\xf9\xce\xb2\x12\xf5\x84\xf7\x96\x11\xd4\x55\xbd\xbf\xb7\x6c\x05\x7a\xbc\xbd\xec\x19\x6f\x2f\xcb\xab\xb8\x4c\x1e\x9a\x5d\x62\xb6\x1a\xc0\x0e\xaf\x32\xab\x51\x6f\x78\x9d\xd9\x52\xf4\x50\xa1\xe1\x75\x66\x8d\xa1\xbe\x2d\x5b\x98\xc5\x75\xbb\xe9\xc2\x4a\x60\x8c\x09\x1f\x11\xc4\x9d\x2e\x9b\x34\x0f\x6e\xb7\x12\xdf\x51\x88\x7b\x59\x90\x0a\x86\xb9\x23\x06\x70\x15\x01\xc3\xdc\x37\x95\x56\xc3\xdd\x4e\xab\xb1\xba\xd8\xf3\x2c\xcd\x5a\x53\xbe\xef\xe9\x9a\x8c\xdb\x9f\xa8\xd0\x3a\xca\x6a\x93\x0a\x58\xd9\xd6\x64\x55\xa6\x9b\x3a\x89\xdc\x71\x65\xe2\xa5\xee\x88\x36\x48\x3a\x61\x19\x6c\x3d\xe7\x44\x89\xea\xc0\xfc\x13\xa8\x12\xba\xf6\xf3\x13\x81\xaf\x8e\xc2\x5d\xca\xfa\x3e\xa1\xb7\x3b\x88\x79\x2e\x18\x17\x45\x1c\x45\xbc\x5d\x11\xb7\x43\x64\x6f\x32\x90\x4a\xfc\xac\x91\xf4\xdb\x94\xf4\xab\x31\xf2\x3a\x47\xd8\xb2\xbc\x9b\x42\x25\x28\xf5\x28\xf5\xfb\x49\x3d\x59\xf9\x7a\xb1\x8a\x2e\x69\x55\x5e\x86\x58\x45\xde\x8c\x36\x4f\xc4\x60\xa1\xf0\x3d\x2d\xb2\x7f\x73\xbc\x1c\x9a\x75\x73\x38\xeb\x27\xc1\xb3\x46\x40\xaf\x44\x0a\x01\x7d\x48\xd2\x3f\xe7\x13\x2d\xaa\x16\x6e\xd6\xdd\xb6\x9a\x86\x17\x7d\xb9\xac\xe0\xe7\x40\x36\xf9\x9d\x35\x96\xf1\x1b\xba\x1f\xb7\x7e\xf7\xde\x87\xab\x03\x75\x95\x1d\x0a\xd4\x55\x07\xe8\xaa\x62\x40\x67\x05\xa4\xcb\xc3\x70\x6b\x58\xd8\x1e\x9b\xdb\x9c\xad\xce\x21\x5e\x54\x58\x65\xba\xe9\xe6\x62\xa5\x9b\x5a\x46\xb4\x55\xa5\x08\x64\x04\xf2\xa1\xb1\x1c\x30\xa1\x71\x64\xea\x81\xec\x88\x5e\x1f\x90\x13\x86\x2a\xcf\x3e\xdf\x6c\xbc\x6e\xf1\x68\x5c\xae\x6e\x44\x31\xa2\xb8\xd5\x15\xb0\x42\x4c\x62\x85\x64\xe7\xc2\x0f\xbb\xb1\x50\x2a\x5f\x07\xfa\x9c\x28\xe4\xed\x0a\xb9\x88\x2b\x76\xea\x72\x2f\x5a\x48\x5b\x67\x73\xe7\xa1\xec\xa2\xec\x62\xa4\xd1\x26\x4f\x3f\xcd\x57\x21\xb7\xdc\x52\x91\x50\x79\x53\x21\x5f\xd6\x3d\x15\x9b\x6c\xfe\x90\xfc\x36\xe6\x1c\xa2\x6d\x7c\x3e\x48\xee\x99\x94\xb2\x31\xa7\xcb\xb2\xfb\xe4\x1c\xe7\x2f\x35\xaa\xbe\xee\xa8\x6d\xaf\x21\x2d\x1b\x15\x2e\x2a\x5c\x54\xb8\x0d\xe0\x99\xe6\xf8\xad\xc6\xe8\xc7\x50\x5e\x43\xf7\x50\xb5\xbf\x30\x7e\x91\x20\x6a\x5b\xb9\x95\x6f\x28\x65\xc4\x12\xcb\xbf\xc5\xb5\x70\x18\xa0\x19\xf9\x8b\x75\x31\x5e\xea\x65\x84\xfb\xc6\x5d\xda\x71\xe3\xb4\xfc\x94\x64\xfa\xf6\x6a\x78\x8b\x6a\x8b\xa0\xda\x7a\x41\x6a\xeb\xb7\xc1\x6f\x96\x7e\xa6\xfe\xb4\xb3\x5b\xef\xef\xfa\x70\xb3\x5e\xaf\x06\x27\x0f\x88\xe7\x43\x33\x36\xa4\xe3\x51\xbf\x27\xe6\x22\x3c\x76\xdd\x12\xfb\x22\xd4\x9c\xaa\x80\xf1\x30\x97\xc0\xb5\x39\x3b\xdd\x4d\x28\xd6\x32\x36\xd4\x25\x67\x68\x2b\xd9\x4c\x56\xe5\x5e\x99\x66\x0a\x1f\xf7\x30\xcd\x4c\x96\x7a\xda\x35\xb3\xe7\x5a\x09\xd3\xcb\xbc\x58\x65\x65\x3f\xf9\x54\x9d\x93\xc1\x6d\x07\xbe\xae\xd4\x0c\x0d\x0e\x6d\xe1\x15\xc1\x88\xe0\xfe\x22\xb8\x46\xae\xab\x37\xee\xdb\x3c\x95\xb5\x92\x66\xdc\xa2\x47\x49\xee\x40\x92\xd7\x93\x06\xd4\xa7\x14\x68\xcd\xa9\x5c\x16\xbc\x9f\x5b\x59\xfc\x7c\x47\xc7\xb2\x34\xb7\xc2\x13\xe5\x58\x5d\xd5\x8d\x40\x46\x20\xe3\xf6\x59\xed\x6c\xd7\xcd\xde\x43\x25\xfd\xea\x03\x1a\xf6\x68\xc6\xb9\x92\x7e\x5b\x67\x33\x9c\xcd\x35\x85\x82\xd1\xf2\xda\xae\x47\xc0\xb6\x64\x79\x1b\x1d\x7e\x6e\xff\xd8\x73\x41\xb8\xf1\xa8\x33\x0a\x77\x6b\xd6\xa8\xd5\x83\x83\xbd\x3b\x32\xd8\xec\x74\x73\x07\xe7\x9a\x37\x00\x8b\x67\x99\x73\x32\x83\x88\xed\xd7\x59\xe6\x57\x79\x8a\xb9\xaf\xca\x68\x2d\x92\xa6\x44\x13\xad\x85\xce\xb4\x78\x34\xca\x14\xca\x47\x65\x84\xca\xa8\xa5\x4b\xc1\xb6\x5c\x04\xd6\x18\xb4\xcf\x19\x35\x56\x0f\xdb\x54\xad\xd6\x7b\x10\xe3\xf1\x45\x27\xfe\x43\xa2\x9e\xd1\x7b\x58\xca\x0b\x02\xb6\xaf\xd9\x05\x9e\xff\x38\x32\x79\xbd\x7e\x52\xbd\x7a\xaa\x4d\x87\xd2\x7a\x22\x94\x82\x7a\xc2\xe4\x27\xa8\x9e\x4a\x44\x1e\xd5\x53\x63\x0c\x3f\x48\xee\x85\xd4\xc0\x9c\x96\xc2\xf7\x87\xe4\xe7\x85\xb7\x9d\xe4\x30\x5a\xd5\x82\x38\x46\x1c\xe3\x26\x57\x2d\x62\x6b\x32\x14\xb5\x96\x9b\xc8\x19\x59\xcc\x47\xb4\x12\x0a\xc4\x64\x5f\xf3\x11\xf5\xd6\xb6\x4e\xe9\x36\xf3\xfa\x95\x76\x6d\x60\x1f\xd0\xb4\x22\x8c\x4b\xa4\x1e\x4d\x6b\x85\x33\x5c\x48\xf2\x50\x9b\xcf\xa1\x7d\xa4\xda\xe2\x7b\x0e\x54\x3c\x54\xf0\x62\xf1\xbb\x3e\xdb\xaa\x3f\x04\xb5\x69\xbb\x6a\xf8\xa8\x9a\x2f\x36\xe7\xa8\xdd\x25\xb8\x87\x6d\x4c\xee\xc3\x5d\x89\xb9\x7e\x2e\xfe\x24\x80\xfa\xfc\xd3\x80\xe2\x34\xaa\x67\x34\xa1\xf4\xc0\x91\xee\xcc\x72\x56\x47\xaf\x18\x3f\xf7\x65\x03\x9e\x43\x5f\xbe\xe4\x11\x7e\xa9\xc7\x8f\x1b\x59\xda\x2c\x1a\x7b\xc2\x14\xcc\x69\x94\x2d\x87\x7b\x6a\x75\x7c\xb2\xcc\x57\xf8\xe2\xc8\xdd\xea\xfa\x8a\x78\x7c\xcf\xe4\x68\x26\x85\x32\x05\x43\x5c\x6d\xa2\xb7\xd8\x3b\xa9\x98\x50\xcc\x2c\x9a\x1a\xb7\x35\xfa\xc6\x33\xec\x49\x9e\x21\xbb\x8c\xbd\xd6\x25\x89\xb3\x10\x47\x60\xbf\xb6\x95\x3c\xa9\x63\x80\xb6\xfa\xc9\x6d\xf5\xb2\x13\xd6\x44\x61\xbd\x33\x26\x45\x34\xec\xd4\x27\xd9\xb7\xee\xec\x04\x11\x13\x62\xa6\xb0\x6c\x6a\x83\xde\xa8\x43\xe6\xd3\x68\x9a\x2a\x25\xc2\x82\xb2\xce\x2c\x1e\xed\xf6\xa8\xef\x8b\x98\x1b\xbd\xde\xa9\x85\x53\xe2\x43\x4b\x74\x03\x13\xdd\xb0\x6f\x87\x24\x62\xda\x24\xdd\xe9\x2a\x22\x59\x45\x84\xf1\x80\xf9\xd4\x30\x1e\xa6\x5d\xad\xc1\xe8\x84\x2e\x0b\x54\xd0\x56\x3e\xa9\x02\x92\xe6\xbe\x81\x80\xcc\x99\x99\x3a\x81\x2d\x1e\x2b\xaf\x18\x92\xd1\xf0\xb2\xd8\xf0\x9d\xc7\x81\xd1\xd9\x6e\x63\x00\x33\xca\xa2\xc1\xe0\xbf\x01\x00\x00\xff\xff\xef\x2a\xc7\xc9\x9c\x0c\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xdf\x72\xdb\x36\xb3\xbf\xd7\x53\x60\xe2\x9b\x73\x66\x12\xbb\xc9\xa5\xcf\x95\xe2\x24\x8e\xa6\xb6\xe3\xb1\x94\x74\x7a\xc5\x81\x49\x88\xc2\x31\x05\xa0\x00\x64\x47\xcd\xe7\x77\xff\x06\x24\x28\x11\x12\xff\x49\x02\x6d\xda\xd9\xa6\xd3\xb8\xe6\x12\xd8\x05\xf7\xb7\xbb\x00\x16\x8b\x23\x74\xc6\xc5\x52\xd2\x78\xa6\xd1\x87\x3f\x3e\x7c\x40\xe7\x9c\xc7\x09\x41\x17\x17\x67\x83\xa3\xc1\x11\xba\xa0\x21\x61\x8a\x44\x68\xc1\x22\x22\x91\x9e\x11\x34\x14\x38\x9c\x91\xfc\xc9\x5b\xf4\x83\x48\x45\x39\x43\x1f\x8e\xff\x40\xff\x63\x08\xde\xd8\x47\x6f\xfe\xf7\xff\x06\x47\x68\xc9\x17\x68\x8e\x97\x88\x71\x8d\x16\x8a\x20\x3d\xa3\x0a\x4d\x69\x42\x10\xf9\x19\x12\xa1\x11\x65\x28\xe4\x73\x91\x50\xcc\x42\x82\x1e\xa8\x9e\x21\xbd\x6e\xff\x78\x70\x84\xfe\xb6\x4d\xf0\x5b\x8d\x29\x43\x18\x85\x5c\x2c\x11\x9f\x16\xe9\x10\xd6\x29\xc3\xe9\x3f\x33\xad\xc5\xe9\xc9\xc9\xc3\xc3\xc3\x31\x4e\xb9\x3d\xe6\x32\x3e\x49\x32\x4a\x75\x72\x31\x3a\xfb\x7c\x35\xfe\xfc\xee\xc3\xf1\x1f\xe9\x3b\xdf\x59\x42\x94\x42\x92\xfc\xb3\xa0\x92\x44\xe8\x76\x89\xb0\x10\x09\x0d\xf1\x6d\x42\x50\x82\x1f\x10\x97\x08\xc7\x92\x90\x08\x69\x6e\x18\x7e\x90\x54\x53\x16\xbf\x45\x8a\x4f\xf5\x03\x96\x64\x70\x84\x22\xaa\xb4\xa4\xb7\x0b\xed\x8c\x56\xce\x1e\x55\x0e\x01\x67\x08\x33\xf4\x66\x38\x46\xa3\xf1\x1b\xf4\x71\x38\x1e\x8d\xdf\x0e\x8e\xd0\x5f\xa3\xc9\xd7\x6f\xdf\x27\xe8\xaf\xe1\xcd\xcd\xf0\x6a\x32\xfa\x3c\x46\xdf\x6e\xd0\xd9\xb7\xab\x4f\xa3\xc9\xe8\xdb\xd5\x18\x7d\xfb\x82\x86\x57\x7f\xa3\x3f\x47\x57\x9f\xde\x22\x42\xf5\x8c\x48\x44\x7e\x0a\x69\xf8\xe7\x12\x51\x33\x8e\x24\x32\x83\x36\x26\xc4\x61\x60\xca\xb3\xcf\xa7\x04\x09\xe9\x94\x86\x28\xc1\x2c\x5e\xe0\x98\xa0\x98\xdf\x13\xc9\x28\x8b\x91\x20\x72\x4e\x95\xf9\x9a\x0a\x61\x16\x0d\x8e\x50\x42\xe7\x54\x63\x9d\xfe\x66\x4b\xa8\xe3\xc1\x00\x0b\x6a\xbf\xff\x29\x0a\xb9\x24\xc7\x21\x93\xf3\xe3\x30\xe1\x8b\xe8\x38\x4e\x55\xe9\x38\xe4\xf3\x93\xfb\xf7\x38\x11\x33\xfc\x7e\x70\x47\x59\x74\x8a\xc6\x44\xde\xd3\x90\x5c\x62\x21\x28\x8b\x07\x73\xa2\x71\x84\x35\x3e\x1d\x20\xc4\xf0\x9c\x98\xa6\xe6\x62\xa1\x2b\x5a\xb3\x54\x4a\xe0\xd0\x90\x32\x39\x7f\xa7\x96\x4a\x93\xf9\xc0\xc8\xb6\x6e\xe4\x2c\x6b\x64\x80\xd0\x7d\xce\xe2\xfd\xfb\x5b\xa2\xf1\xfb\x01\x42\x2a\x63\xe1\x2b\x57\xfa\xca\xe9\x32\xe3\x1a\x0b\xaa\x6c\x5f\x92\x28\xbe\x90\x21\x51\xa6\x65\x84\xde\xd9\xd6\x33\xba\xc0\xbe\x16\xe0\x28\x32\x1f\x21\x25\x41\x28\x13\xd3\x32\x30\x74\x1e\xe1\x85\xe6\xe7\x84\x11\x89\x35\x89\x4e\x91\x96\x0b\x62\x9f\xd0\x68\x42\xe6\x22\xc1\x9a\x9c\xa2\x37\x42\xf2\xff\x27\xa1\x56\x27\xbf\x7e\xd9\x1f\x1f\x1f\x4f\x24\x89\xcd\x97\x38\xf9\xf5\x2b\xfb\xe9\xf1\xf1\xc4\xf6\x4b\x0c\xa1\x61\xec\xf1\xf1\xcd\x56\x73\x67\x98\x7d\x24\xdf\x15\x89\x26\xfc\x12\xeb\x70\x76\x63\x25\xca\x04\x9f\xe2\x44\xe5\x2c\xe4\xb2\x0e\xef\x31\x4d\x8c\xf6\x8f\xd8\x50\x29\xa2\x47\xec\x9e\x30\xcd\xe5\xd2\x25\xcf\x3f\x9c\xfd\x90\xa7\xf6\xd7\xf9\x07\x30\xff\x5d\xfd\x2a\xc1\xb7\x24\x51\xa7\xf6\xef\x8d\xfe\x46\x9f\xd6\xef\x6a\x2c\x63\xa2\xbf\x50\x92\x44\x4e\x13\x33\x4a\x24\x96\xe1\x8c\x86\x38\xb9\x21\x53\x22\x09\x5b\x7d\x14\xf3\xef\x3b\xa4\x97\x82\x9c\x22\x3b\x5c\xab\xdf\x23\x74\x47\x96\xab\x5f\xdf\x90\xe9\x46\xdf\x15\x6d\x4d\x2d\x07\x6d\x9b\x33\x7f\x22\xa2\x42\x49\x85\x81\xcb\x29\xfa\xcf\xbb\xc2\x13\x84\x26\x33\x92\xbf\x84\xf4\x0c\xeb\xcc\x10\xe6\x4c\xa0\x5b\x92\x70\x16\x2b\xa4\xf9\x71\xe1\xb5\xf8\xfe\x6e\xcd\xd4\x5a\xb1\xae\xb7\x78\x2a\xd5\xf1\xf5\x9f\x58\xf2\x85\x38\x5d\xf5\x36\xc7\x0c\xc7\x44\x56\xc2\x2b\x1f\x84\x54\x52\xb5\xb8\x65\x44\x3f\x70\x79\xe7\x0a\xbb\x1a\xa1\x35\x41\x33\xe7\x16\x12\xe3\xb2\x57\xda\xc9\xd0\x64\x1a\x4a\xd5\x48\x91\x64\x1a\x24\x94\xdd\x6d\x0a\x57\x2f\xd9\xae\x62\x5d\x3d\x9f\x4c\x55\x66\x69\xa1\xb9\x0a\x71\x42\xe4\xa0\x84\xdf\xe1\xe6\xd3\x43\x8c\xd3\xbf\x9c\xa5\x56\xc8\xfc\x6d\x0c\xd3\xaa\xed\x9e\x9b\x26\xb0\x41\xfd\xb7\x41\xd9\xf7\xa8\x40\x69\xf6\xb0\x99\x63\xab\xf4\x23\xa6\xb4\x09\x35\xcf\x8d\x3d\xb9\xcc\xb8\xd8\x59\x8a\xee\x10\x7b\x8b\xc3\x3b\xc2\xa2\xe0\x76\x11\xde\x11\x5d\x86\xda\x8f\x19\xc5\xc7\x22\x41\x0d\x70\xf1\xfc\x8c\xb3\x29\x2d\x40\x40\xf0\x84\x86\xcb\xab\xe6\xde\x03\x8a\xe7\x41\x46\xbd\xf1\xf2\x25\x99\xdf\x12\xd9\xb6\x89\x79\x4a\xbd\x6a\x42\xe6\xda\x9e\x8d\xcb\xea\xf7\xa5\x71\x03\xb2\x90\x72\x7e\xa9\x16\x42\x70\xa9\xd5\x19\x67\x11\x35\xaa\xae\x5c\xf4\x37\x5b\xab\x38\xe1\xb7\x38\x39\xb1\xcc\x66\x63\x09\x86\x0a\x0c\xd5\x3e\x86\xaa\x15\x92\x03\x45\x63\x46\xa2\x60\x21\x93\xe0\x8e\x2c\x1b\x81\x3d\x4e\xc9\xbf\xdf\x5c\xfc\x49\x96\xcd\x20\xdf\x5b\xdf\x5d\x26\x1f\x1f\xc1\x57\x83\xaf\x3e\xcc\x57\x3b\x2a\xe6\xca\xbb\x1a\x24\x57\xeb\x9a\x05\xa8\xf1\x7c\xed\xa5\xb1\xb8\x6c\x90\xa2\x5a\xa1\xcc\x1f\x81\x25\x61\xba\x80\xbd\x06\xec\xdb\x65\x86\x41\xb5\x30\x63\x87\xc2\xb7\x1f\xb7\xfd\x1f\xe4\xc8\x8b\x6d\x3c\x89\x27\x3f\xc0\xb0\xd9\xd1\x04\x4f\x0e\x9e\xbc\x03\x4f\x9e\x23\xa1\xb5\x2b\xb7\xea\xd8\xa9\x2f\x2f\xa8\xfc\x06\x9f\xe0\xcd\xc1\x9b\x7b\xf2\xe6\x56\xc9\x5c\x81\x57\xa3\xb4\xa1\x77\xcd\x22\xd4\xb9\xc0\xf6\x02\x59\x78\x36\x08\xe2\xc7\xa1\x47\x54\xdd\x0d\x4a\x44\xf8\xb4\xfe\xbd\x2f\xdf\x6d\xba\xda\xcb\x61\xaf\x5e\xec\xe1\x7c\x7b\x63\x75\xd0\xb0\x0a\x5b\x16\xb0\x65\xd1\xcd\x96\x85\x19\xd5\x0a\x53\x65\x1e\x35\x73\x5b\x00\xf7\x64\x29\x9e\xc8\x32\xb9\xcb\x83\x05\x71\x14\xc3\x42\xcd\xb8\xae\x10\x29\x7f\xdc\x5a\xac\xf1\xf6\x0b\x4f\x2a\xd6\x6e\x56\x37\xc8\x55\xc0\xda\xc3\x00\x6b\x8d\xc3\xd9\x9c\x30\x3d\x28\x11\xce\x18\xe4\xdc\x5a\x5c\xa7\x2f\x0c\x37\xe9\x3d\xee\x72\xe4\x76\xcc\xfc\x0d\xe1\x16\x84\x5b\x87\x86\x5b\x46\x8f\x2a\x60\x5e\x88\x40\x9a\x21\x5e\x08\x4b\x3a\x86\xf7\x9e\xf1\x14\xf9\xa9\x89\x64\x38\x09\xee\x05\x0b\x62\xac\xc9\x03\x5e\x0e\x4a\x04\xf9\x6c\xe9\x7e\x08\x76\xee\x50\x79\x98\x40\x91\xad\xb6\x21\x24\x81\x90\xc4\x63\x48\x52\xa1\xfb\x53\x2a\xc9\x03\x4e\x92\x32\x7d\xff\xe2\x3e\xf3\xa0\xe5\x79\x6f\x3d\xd7\x6d\x50\xe2\x9e\x2a\xf1\x6f\x94\x2d\x33\xe5\xf2\x01\xcb\x88\xb2\x38\x90\x8b\x84\x0c\x4a\x98\xfe\xb2\x22\xb9\x59\x53\x78\xce\xe9\x5b\xb3\x61\xfa\x00\x9f\x04\x3e\xc9\xa3\x4f\x7a\xcd\x6b\x7b\xdb\x53\xcd\xd7\x66\xb8\x5e\x75\x5e\x66\x85\x55\xce\xe2\x98\xba\x0c\xeb\xf3\x94\xc2\x5f\x9e\xb5\x8d\x9c\x20\xb7\x1a\x72\xab\xbb\xcd\xad\xb6\x60\xab\x00\xf0\xae\xe8\xbd\xea\x2b\x74\x5b\xc4\x55\x19\x84\xbd\x47\x57\xf9\x1c\x08\x22\x2a\x88\xa8\x9e\x20\xa2\xfa\x4d\xf0\x6c\x05\x09\x08\x8b\x04\xa7\xe5\xeb\xf0\x19\xa0\xad\x08\x9f\x5d\x42\x0f\x88\x66\x6e\xc3\x69\x32\xb7\x21\xac\xe0\x30\x48\xad\x74\xba\x42\x4f\x45\x1e\x4a\xa4\xff\x3b\xfd\x27\x62\xe9\x0f\x66\x83\xb3\x4f\x16\xc1\xf0\xb3\xd1\x70\x25\xf4\x0b\xb4\x00\xfd\xe7\x81\x7e\x5c\xa6\xef\xa9\x5a\x56\x58\x83\x5a\x4d\x6d\x16\xae\x0e\x66\xe7\x1b\x4d\x74\x68\x36\xf6\x5c\xfe\x6f\x23\xfc\x6e\x72\x76\x68\x53\x7a\x1c\x2b\x34\x5a\x06\x08\x0a\x9e\x27\x28\xa8\xd0\xfb\x19\xc1\x89\x9e\x05\xe1\x8c\x84\x77\x65\x6a\xfe\x35\x7d\x7e\x56\x78\xec\x41\xaf\x67\xeb\x46\x41\x9d\x41\x9d\x7d\xaa\xb3\xd6\xa2\x59\xa7\xb5\x16\xdd\xe8\xb5\xdb\x30\xe8\x36\xe8\xb6\x67\xdd\x56\xad\x94\x5b\x75\xa6\xdd\x0a\xd4\x1b\xd4\xbb\x1b\xf5\xa6\x73\x1c\x93\x32\x8d\x1e\x15\x1e\xf8\x4a\x69\x4e\x3b\xdb\x2b\xa7\x79\xfd\xe6\x8e\x49\xcd\x45\xf9\x3a\x3e\x7b\x94\x76\x05\x8b\x8a\xb0\xa8\xd8\xcd\xa2\x62\x36\x4a\x9f\xaa\x33\x03\x6d\x7a\x6c\x4f\x13\x04\xdd\x85\xc5\x2d\xb9\x52\x73\x53\x2f\xd8\x26\x94\xeb\x25\x2b\xda\xaf\xe7\x15\x2d\xcf\xb3\xae\x97\xee\x45\xa5\x6f\x57\x39\x13\x5b\x53\x23\x5b\x66\x0d\x0c\x4d\x14\x14\x16\x25\xab\x0b\x70\x18\x13\x18\x5d\xaf\x29\x3d\xe6\x66\xe7\x4c\xad\xd7\x86\x57\x6b\xc0\x86\x35\x48\xd7\x86\x74\xed\x43\xd3\xb5\xe3\xba\xf5\xdd\xc2\xe2\xe4\x8e\x55\x69\x76\x66\x7e\x2f\x64\xef\xb9\x74\x4b\x99\x26\x32\xe4\x8c\x91\x50\x37\x9c\xc5\x18\x15\x48\xbd\x9e\xc2\x28\x49\x9a\xa3\xa5\x7d\x29\xc0\x38\x60\xfc\x30\x8c\x4b\xbe\xd0\x44\x56\x80\x3c\x7b\xd8\xcc\xb1\x05\xc4\xcd\x26\xf9\x73\x3b\xee\x39\x0e\x67\x94\xb9\x21\x96\xc3\xf1\x65\x46\xd0\xc5\xa4\xd0\xe9\x7b\xaf\xc9\xe1\x76\x0b\x3b\x4e\x12\x9d\x06\x9e\x66\xb2\x68\xbb\x1c\xbd\x80\x39\x23\x58\xa7\xfe\x5b\x27\xfb\x85\x6c\xf4\x50\x61\xa5\xf2\x79\x94\xa5\x6a\x16\x61\x23\x28\xd9\x59\x94\x2e\x0d\x96\x31\xdd\x51\xa0\x54\x12\x84\x44\x6a\x53\xca\x18\x6b\x32\x28\xe1\x3e\xab\xed\x17\x8d\xc7\x17\x67\x5b\x84\x35\x36\xac\x2d\x90\x95\x4a\x0a\xed\x02\x94\x01\xca\xfb\x40\xb9\x42\xcd\x6d\x2a\x42\x99\x5a\x5f\x39\x8f\xfc\xa5\x3d\x80\x06\x83\x06\xfb\xd7\xe0\x55\x6a\x4f\x8d\x2a\x7f\x76\x49\x3c\x2e\x01\x31\xb7\x83\x75\x46\x4f\x69\xde\x51\xba\x22\x94\xaf\x1a\x95\xe5\x0a\x42\x8a\x20\xa4\x08\x1e\x90\x22\x98\xab\x96\x2b\xea\x6a\x7c\xf2\xc7\xcd\x5c\x3f\x4b\x78\x56\xb0\xaf\x2b\x89\xca\xf0\x55\x21\x5d\x39\xe4\x5a\xcb\x5a\x93\xf9\xd7\xcf\x65\xb2\x5a\x79\xdb\x8a\xf6\x14\xa6\x10\x5c\x3e\xb8\xfc\x1d\x5d\xfe\xb6\x05\xa8\x07\x7d\x33\xcf\x2e\x14\x76\x66\xdd\xc3\x7c\xf3\x77\x3c\x59\x69\x39\x0a\x04\x21\x32\x3d\xf4\x6e\x96\x27\x55\x10\xa6\x6b\x78\x83\x12\x29\xec\xf7\xb9\xce\xe8\xd3\xd5\x4c\x75\x56\xa4\xf6\x3c\x1d\xc9\x7e\x5c\x5b\x2f\xdb\xb1\x79\x66\x79\xee\x93\xe9\xb2\x2c\x6d\xb4\x5d\x1d\x91\x39\xe4\x60\xc0\xc0\x80\xed\x68\xc0\xf6\x8d\x4c\x78\x44\x6a\xa2\x11\x1e\x91\xae\x42\x90\xbc\x69\x88\x3b\x20\xee\x38\x34\xee\xe0\x11\xc9\x55\xa6\x0a\xbb\x46\xcf\xb5\xa5\x69\x66\xbf\xa0\xff\x93\xed\x97\x9e\xdd\x55\x97\x08\xd3\xc4\xb7\xe7\x1d\xf6\xe2\x90\x03\x82\x01\xc1\xfb\x20\xb8\x42\xbb\xb9\x8c\x31\xa3\xff\xa6\x17\x4d\x06\x8a\x84\x0b\x49\xf5\xd2\xdd\x0e\x76\x94\xfd\x5b\x81\x7e\x6c\xc9\xaf\x8b\xd4\x2d\x55\x3f\xe1\x61\xda\x86\xca\x83\xcf\xbc\xef\xb4\x31\x9a\x3a\x2f\x5b\x75\x94\x46\x7d\xd2\xf5\x15\x53\x1b\xad\x57\x2a\xbc\xfb\xc2\x1e\x9f\x21\xc0\x4a\xf1\x90\xa6\xcf\x76\xfb\x24\xc3\xad\x17\x5b\x7e\x1d\x67\xec\x4f\x0a\xfd\xbf\x16\xe3\xb3\xcf\x67\xa8\x2a\xa0\x51\x3d\xfe\xbb\x97\xd2\x70\x07\x5e\x48\xca\x4d\x6b\x06\x0b\xf6\xc7\x5e\x41\xc1\xf2\xb4\xd1\x78\x35\x12\x8a\xf4\x15\x5f\x40\x60\x73\xc3\x4c\x30\xa7\x52\xf2\xc2\x1c\xcd\x19\xf0\xeb\x94\xe6\x72\x83\xa4\xe5\x08\xb7\xf5\xb9\xc2\xed\x04\xdc\x2e\xb8\x5d\x8f\x6e\x57\x10\xb9\x4e\xdd\xae\x5e\xf5\xb9\x26\x32\xdf\x6a\xf0\xb6\xd8\xb3\x31\x35\xa4\x25\x97\x32\xaa\xc2\x6e\x9c\x4d\x2c\xb7\x92\x42\xea\x36\xa4\x6e\x1f\x9a\xba\x5d\xa6\x71\xae\xd8\xab\xb1\x2a\x57\xc2\x66\x79\x36\xb6\xe9\x8a\x3d\xed\x2c\xa3\x85\x6c\x83\x6c\x7e\x16\x88\xb2\x19\x5f\xc3\xdd\xc1\x37\x29\x91\xd7\x1b\x84\x4b\xfc\x1f\xdc\x22\x0c\xb7\x08\x1f\x76\x8b\x70\xbd\x8e\x97\x57\x37\x2d\x51\xf4\xd2\x72\xa6\xbe\x32\xa1\xcb\x99\xd9\x2b\x25\xba\xa6\xa9\x1d\x73\xa3\x0b\x0a\xdb\x55\x4a\x74\x09\xe0\x6f\x9d\x71\x56\xe0\xe7\xc1\xcf\x1f\xe6\xe7\x5f\xf0\xfe\x4e\xab\x85\x61\x0b\xf8\xc2\xe1\xdf\x12\xeb\x55\x38\xed\xeb\xd9\x66\xed\x7d\x59\xd9\xe6\xfb\x4f\x62\x9d\x8a\x30\xdf\xcb\x3c\xc1\xbd\x65\x70\x6f\x59\xff\xef\x2d\x5b\x83\x1e\x6e\x2f\x7b\xc6\xdb\xcb\x8a\x26\x2e\xd7\x87\x76\x97\x98\xad\x3f\x60\x87\x57\x99\xd5\x98\x37\xb8\xce\x0c\xae\x33\x83\xeb\xcc\x76\xb8\xce\xcc\x42\xbd\xa9\x5a\x58\x86\x6b\xbf\xe5\xc2\x4a\x60\x0c\x05\x1f\xa1\xe0\x63\x37\x05\x1f\xad\x9e\xb7\x4f\x6e\xcf\x34\xfe\xaa\x24\xf9\xbc\x1b\xd5\x67\x25\x3d\x29\x70\x64\xe0\xc8\x0e\x73\x64\x56\xad\x5c\x49\x57\xc3\x63\x9f\x36\xf3\xfc\xfc\xab\x08\xbf\x63\x9a\xbb\x35\x5a\x2d\x77\x3b\x33\x8b\xd5\xc5\x9e\x67\x89\xb9\xa2\x25\x7b\x43\x6b\x82\xa0\x7c\xe7\x09\x22\x73\x88\xcc\x0f\x8d\xcc\x33\x05\x2b\xdb\x9a\xac\x30\x06\xb5\x1a\xd9\x2c\x9c\x03\xae\xb2\x6e\x77\x16\x7b\x2f\x7b\x71\x58\x78\xdf\xa2\xe8\x44\x66\x3d\xbc\xd7\x9c\x28\x31\x1d\x50\x7f\x02\xea\x4f\x74\x56\x7f\xa2\xa0\xf0\xce\xc2\x7e\xb9\xae\xef\x93\x7a\xbb\x83\x9a\x17\x92\x71\x41\xc5\x41\xc5\xfd\xaa\x78\xe6\x1e\xd2\x6a\xef\x81\x90\xfc\x67\x8d\xa6\x4f\x52\xd2\xaf\x5a\x8b\xeb\x02\xa1\x67\x7d\xd7\x4e\x27\xa0\xf5\xa0\xf5\xfb\x69\x7d\x21\xd6\x5b\xc8\xe4\x12\x57\xd5\x65\x58\xc8\x24\x98\x63\xd1\xcc\xb2\x03\x85\xef\x69\x93\x3b\xb3\x6f\xb1\xd7\xc0\xf6\x01\x73\xbc\x02\x9a\x55\x7b\x38\xab\x27\xc1\xb3\x02\x40\x03\xa0\xbd\x00\x3a\x8f\x89\x96\x15\x98\xde\x0a\xdb\xda\xc2\x7a\x9c\x37\xbc\xb3\x1c\x1e\x90\xfd\x5b\x5b\x2c\x1d\x8a\x76\xf6\x6a\x12\x76\x1f\x7d\x4c\x42\x08\x3e\x20\xf8\xf0\x13\x7c\xb8\x09\x9d\x15\x90\xde\x48\x57\x6d\x16\xa1\x39\x37\xb7\x47\x10\x77\x0d\x56\x89\x00\xdf\x6f\x2e\xd6\xb6\xc9\x33\xa2\xb3\xe0\x0f\x80\x0c\x40\x3e\x10\xc8\x11\x99\xe2\x45\xa2\xeb\x81\x6c\x89\x5e\x1f\x90\x8d\x40\x95\x67\x9f\x6f\xb6\x1e\x7b\x3c\x1a\x57\xe8\x1b\x50\x0c\x28\xde\x07\xc5\xd5\x5a\x5d\xcc\x49\xac\xd0\xec\x42\xfa\xa1\x07\xe5\x2e\xf1\x50\x39\x1b\xb0\xcc\x0b\xcb\xbc\x1d\x2c\xf3\x9a\x22\x75\xa5\xaa\x5d\x78\xe0\xa1\x6c\x5d\x7a\x13\x08\x84\x59\x10\x66\x1d\x18\x66\xd9\xf4\x19\x57\xd2\xd5\xf0\x6c\x27\xd7\x54\xf0\xdc\xab\x4c\x23\x46\x7e\xea\xaf\x5c\xe4\xdb\xfe\x95\xb2\xfd\xd4\xc1\x8c\x8b\x55\x8e\x41\x6b\x29\x47\xdb\x2f\x3c\xa3\x98\x3f\x04\x9b\x2c\x18\x23\x49\x93\x9c\xf7\x82\x05\x3a\xa5\x6c\x2d\xe9\xaa\xed\xe7\x10\xb5\xce\xc2\xca\x41\x09\xb7\xce\x75\x47\xbe\xa3\x86\xb4\x6d\x30\xb8\x60\x70\xc1\xe0\x66\x06\xb7\x16\x9e\x69\x8d\xdf\x41\x09\xc3\x19\x46\x3f\xc6\xe2\x9a\x74\x0f\xd5\xec\x27\xc8\x5f\x84\xfc\xc5\x83\xf3\x17\x53\x4d\x1a\x0a\x91\x50\xe3\xf9\x1b\x42\x0b\x8b\x01\x9c\x93\xbf\xd8\x10\xe3\xa5\x5e\x46\xb8\x6f\xde\x65\x2a\x51\xc0\xb0\x1e\x54\x0a\x70\x35\x9c\x80\xd9\x02\xb3\xf5\x92\xcc\xd6\x6f\x83\x5f\xbb\x0d\xd0\x70\xda\xd9\xae\xf7\x77\x7d\xb8\x59\x6d\x76\x03\x93\x07\x98\x3c\x1c\x38\x79\xc8\xec\xab\x55\xe0\x0a\x58\xdb\x0c\x0f\xab\x7e\xcd\x02\x58\x54\x7c\xe1\xf2\x01\xcb\x88\xb2\xb8\x50\xc0\xf5\x49\xe3\x8e\x2a\x50\xbb\x05\x18\xea\x8a\x33\xf8\x2a\x36\x93\x77\xb9\x57\xa5\x19\xe7\xe5\x1e\x96\x99\xc9\x4b\x4f\x5b\x36\xa1\xbc\x0c\x94\x97\xe9\xa6\xbc\x4c\x36\x4a\x9f\xaa\x6b\x32\xd8\xed\xc0\xd7\x55\x9a\xa1\xc5\xa1\x2d\xb8\x22\x18\xae\x08\x7e\x71\x57\x04\xd7\x9f\xcd\xf2\x79\x2a\x6b\xad\xcd\xb0\x45\x0f\x5b\xf4\xfe\xb7\xe8\xb7\xea\x0c\xd4\x97\x14\xf0\x16\x54\xae\x1a\xde\x2f\xac\x74\x5f\xdf\x31\xb0\xdc\x92\x79\x87\xf0\xf2\xe0\x89\xf0\xaa\x6f\x98\x01\xc3\x0c\xf8\xc0\x19\xb0\xd5\x24\x57\xd2\xd5\xf0\xd8\xa7\xcd\x3c\xf7\x76\xfb\xcc\xce\xde\x63\x29\xc2\xea\x03\x1a\xd9\xd1\x8c\x73\x29\x42\x5f\x67\x33\xac\xcf\xd5\x4e\xc3\xe0\x79\xc1\xf3\x7a\xf5\xbc\xad\x0e\x3f\xfb\x3f\xf6\xec\x28\x37\x1c\x75\x86\xa3\xce\xfd\x3c\xea\xdc\xbb\x23\x83\xed\x4e\x37\x77\x70\xae\x79\x0b\xb0\x70\x96\x19\xce\x32\xf7\xf6\x2c\xf3\xab\x3c\xc5\xdc\x57\x63\xb4\x91\x49\xe3\xf0\x9c\x59\xa2\x8d\xd4\x19\x8f\x47\xa3\xb4\xd3\x3e\x18\x23\x30\x46\x07\x1a\xa3\x5c\x99\x2b\x30\xbb\xa1\xeb\xcd\xa0\xdd\x50\xfd\x1e\xc1\x36\x35\xab\xf5\x11\x84\x59\x42\xee\x22\x7e\x30\xe6\x19\xaa\x0b\x40\x75\x81\xde\x57\x17\x78\xfe\xe3\xc8\xaf\x38\x4e\xaa\x37\x4f\xb5\xe5\x50\xbc\x17\x42\x71\xcc\x13\x14\x3f\x81\xe2\x27\x2f\xa2\xf8\x49\x6f\xab\x25\x98\x73\x6d\x31\xd6\xe4\x01\x97\xc2\xf7\x87\x60\xe7\xce\x53\xcf\x79\x9e\x19\x08\xd6\xbd\xc0\x26\x17\x6c\x72\xc1\x26\x57\xed\x26\x97\xbb\x18\xe1\x30\xeb\xad\x36\x91\x75\xb2\x50\x8f\x08\xea\x11\xf5\xbf\x1e\x51\x6f\x7d\xeb\x0c\x37\xb9\xd7\xaf\xb8\x6b\x07\x7b\x0f\xae\x15\x5c\x2b\xb8\xd6\x76\xae\x75\xab\xc8\x43\x6d\x3d\x07\xff\x48\xcd\x9a\xaf\x00\x2a\x1c\x2a\x80\x43\x05\x07\x1e\x2a\xd8\x9c\x6d\x55\x00\xd9\xae\xeb\x6c\xfb\xae\x66\x4c\x6f\xb9\xb3\x27\x85\x75\x41\xd4\xfb\x26\x21\xf7\x91\xee\x2b\xee\x8d\x7c\xa6\x44\xc4\xe7\x9f\x9a\x48\x86\x93\x7a\x41\x0d\x65\x40\x2c\xe9\xce\x22\xe7\x7d\xf4\x4a\xf0\xf3\x50\xb4\x90\x39\x0e\xc5\x4b\xfe\xc2\x2f\xf5\xf8\x71\x2b\x4f\x6b\x63\x85\x60\x4a\x25\x79\xc0\x49\xbe\x1c\x1e\xc8\xf5\xf1\xc9\xb2\x58\xe1\x8b\x25\xb7\xab\xeb\x6b\xe2\xf1\x1d\x15\xa3\xb9\xc9\xbe\x76\x1c\x71\xb5\x8b\x6e\xf0\x77\x42\x52\x2e\xa9\xce\x75\xa0\xd1\xb9\x6d\xd0\xb7\x9e\x61\xe7\xf2\x17\x0e\x6b\x6c\x0c\x89\x09\xeb\x17\x49\xfa\x24\xef\xe4\x49\x03\x03\xf0\xd5\x4f\xee\xab\x57\x83\xb0\xa1\x0a\x85\xb6\xd3\xb1\x9d\xba\x68\xd8\x69\x4c\xf2\x77\xed\xd9\x09\xc4\xa7\x48\xcf\xc8\x8a\xd5\xe3\xd6\xa6\xa5\x14\x99\x3b\x0f\xd1\x5e\x96\xa6\xca\x88\xd0\xa8\x6c\x30\x6d\x54\x63\x57\x17\x02\x1c\x86\x7c\xc1\xb4\xda\x1c\x54\xe7\x94\xf8\x30\x23\xba\x21\x53\xd5\x72\x6c\x87\x28\xa1\x4a\x9b\xe1\xb4\x1d\xa1\xbc\x23\x44\x59\x64\x4e\x35\x52\x16\xa7\x43\xad\x88\x56\x86\x2e\x4f\x54\x50\x99\x7e\x62\x49\x50\x5a\xfb\x86\x44\xe8\x81\xea\x99\x55\xd8\xb5\xa9\xab\xf9\x24\xa3\xe1\xa5\xcb\xf8\xce\xdf\x81\xe2\xf9\x6e\xdf\x80\xcc\x31\x4d\x06\x83\xff\x0e\x00\xef\x2a\xc7\xc9\x9c\x0c\x01\x00"),
 		},
 		"/containeranalysis.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "containeranalysis.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2277,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4f\x6f\xdb\xc6\x13\xbd\xf3\x53\x0c\xa4\xcb\xef\x07\xd8\x94\xad\x23\x7b\x52\x64\x27\x21\xea\x48\x85\x28\x37\xc8\xc9\x18\x2d\x87\xe4\xd6\xcb\xdd\xed\xee\x50\x0a\x91\xfa\xbb\x17\xcb\x3f\xb2\x65\xc5\x45\x80\x1e\xda\x43\x79\x10\x84\xdd\x37\xef\xbd\x99\x79\xe4\x14\x96\xc6\xb6\x4e\x96\x15\xc3\xfc\x6a\x3e\x87\x0f\xc6\x94\x8a\xe0\xee\x6e\x19\x4d\xa3\x29\xdc\x49\x41\xda\x53\x0e\x8d\xce\xc9\x01\x57\x04\x0b\x8b\xa2\xa2\xf1\xe6\x02\x7e\x25\xe7\xa5\xd1\x30\x8f\xaf\xe0\x7f\x01\x30\x19\xae\x26\xff\xff\x29\x9a\x42\x6b\x1a\xa8\xb1\x05\x6d\x18\x1a\x4f\xc0\x95\xf4\x50\x48\x45\x40\x5f\x05\x59\x06\xa9\x41\x98\xda\x2a\x89\x5a\x10\x1c\x24\x57\x9d\xcc\x40\x12\x47\x53\xf8\x32\x50\x98\x1d\xa3\xd4\x80\x20\x8c\x6d\xc1\x14\x2f\x71\x80\xdc\x19\xee\x9e\x8a\xd9\x26\xb3\xd9\xe1\x70\x88\xb1\x73\x1b\x1b\x57\xce\x54\x8f\xf4\xb3\xbb\x74\x79\xbb\xca\x6e\x2f\xe7\xf1\x55\x57\x73\xaf\x15\x79\x0f\x8e\x7e\x6f\xa4\xa3\x1c\x76\x2d\xa0\xb5\x4a\x0a\xdc\x29\x02\x85\x07\x30\x0e\xb0\x74\x44\x39\xb0\x09\x86\x0f\x4e\xb2\xd4\xe5\x05\x78\x53\xf0\x01\x1d\x45\x53\xc8\xa5\x67\x27\x77\x0d\x9f\x4c\x6b\xb4\x27\xfd\x09\xc0\x68\x40\x0d\x93\x45\x06\x69\x36\x81\x77\x8b\x2c\xcd\x2e\xa2\x29\x7c\x4e\xb7\x1f\xd7\xf7\x5b\xf8\xbc\xd8\x6c\x16\xab\x6d\x7a\x9b\xc1\x7a\x03\xcb\xf5\xea\x26\xdd\xa6\xeb\x55\x06\xeb\xf7\xb0\x58\x7d\x81\x9f\xd3\xd5\xcd\x05\x90\xe4\x8a\x1c\xd0\x57\xeb\x82\x7f\xe3\x40\x86\x39\x52\x1e\x86\x96\x11\x9d\x18\x28\x4c\x6f\xc8\x5b\x12\xb2\x90\x02\x14\xea\xb2\xc1\x92\xa0\x34\x7b\x72\x5a\xea\x12\x2c\xb9\x5a\xfa\xb0\x4d\x0f\xa8\xf3\x68\x0a\x4a\xd6\x92\x91\xbb\x93\xb3\xa6\xe2\x28\x42\x2b\x87\xfd\x27\x20\x8c\xa3\x58\x68\x57\xc7\x42\x99\x26\x8f\xcb\x2e\x4a\xb1\x30\xf5\x6c\x7f\x8d\xca\x56\x78\x1d\x3d\x4a\x9d\x27\x90\x91\xdb\x4b\x41\x9f\xd0\x5a\xa9\xcb\xa8\x26\xc6\x1c\x19\x93\x08\x40\x63\x4d\x81\x4a\x87\x55\x93\x43\x8d\xaa\xf5\xd2\x7f\x9f\x77\xc0\x7b\x8b\x22\x14\x69\x57\x5f\xfa\xd6\x33\xd5\x51\xe8\xf2\x99\x6e\x39\xd2\x2d\x06\xba\x08\x60\x3f\xda\xde\x5f\xef\x88\xf1\x3a\x02\xf0\xbd\xad\x8f\xc6\xf3\xea\x0d\x1b\xbd\x36\xda\xe0\xa8\xd3\x77\xe4\x4d\xe3\x04\xf9\xa0\x06\x70\x39\x28\xf6\xb8\x87\x23\xc1\xc3\xc8\xf0\xa0\x0d\x53\xd4\xc7\xb4\x1f\xc6\x99\xb9\xd5\x33\x02\x1b\x36\x1f\x28\x18\x60\xca\x13\x60\xd7\x8c\x37\x32\xdf\x52\x6d\x15\x32\x25\x30\xb1\xce\xfc\x46\x82\xfd\xec\xdb\xb7\xe1\xef\xd3\xd3\x2c\x08\x85\x93\x60\xe8\xe9\x69\x72\x56\xb7\x44\xfd\x8e\xee\x3d\xe5\x5b\xf3\x09\x59\x54\x9b\xa1\x93\xbe\xf5\x02\x95\x1f\xb5\xc6\x1e\x17\x7b\x94\x2a\xbc\x13\xa9\x5e\x78\x4f\x9c\xea\x3d\x69\x36\xae\x3d\x85\x8f\xeb\x1c\xd6\x9b\x0c\xc7\xe3\x32\xc2\xef\x2b\xe2\xf4\xe6\x19\xc4\xe8\x4a\xe2\xf7\x92\x54\x7e\x82\xad\x24\x39\x74\xa2\x92\x02\xd5\x86\x0a\x72\xa4\x8f\x53\xef\x27\xcf\xad\xa5\x04\x86\x01\x1c\xcf\x01\x1e\xa9\x3d\x1e\x6f\xa8\x78\xa5\xfd\x06\x57\x31\x38\xf8\x51\xba\xf0\xe4\xe4\x85\x93\x96\xbb\x58\xfd\x71\xf9\xe2\x06\x60\x5b\xd1\x58\x04\x5c\x21\xf7\xdf\xc1\xd1\x04\xec\x48\x19\x5d\x7a\x60\x13\xbf\x28\x2b\xf7\x8f\xc9\x09\x4b\x9f\x98\x5f\xce\x3c\x7d\x37\xce\x2f\x78\x9c\x69\x6c\x72\x54\xab\x51\x63\x49\xee\xcd\x77\xea\x47\x62\x6c\x84\x68\x5c\x37\xb7\xbf\x0e\xf3\xfa\x35\xee\xef\x44\xfa\x59\xf4\x1f\x09\x76\xf8\x3e\x90\x3b\x9a\x4f\x6f\xce\x53\xfa\x5f\xa2\xff\x85\x89\xfe\x33\x00\x00\xff\xff\xdb\xe6\x23\x59\xe5\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4f\x6f\xdb\xc6\x13\xbd\xf3\x53\x3c\x48\x97\xdf\x0f\xb0\x29\xdb\x47\xf5\xa4\xc8\x4e\x42\xd4\x91\x0a\x49\x6e\x90\x93\x31\x5a\x8e\xc8\xad\xc9\xdd\xed\xee\x50\x0a\x91\xfa\xbb\x17\x2b\x91\x92\xff\xc4\x45\x80\x1e\xda\x43\x45\x41\x10\x66\xdf\xbc\x79\x33\xf3\x96\x43\x4c\xad\x6b\xbd\x2e\x4a\xc1\xd5\xc5\xd5\x15\x3e\x58\x5b\x54\x8c\xdb\xdb\x69\x32\x4c\x86\xb8\xd5\x8a\x4d\xe0\x1c\x8d\xc9\xd9\x43\x4a\xc6\xc4\x91\x2a\xb9\x3f\x39\xc3\xaf\xec\x83\xb6\x06\x57\xe9\x05\xfe\x17\x01\x83\xee\x68\xf0\xff\x9f\x92\x21\x5a\xdb\xa0\xa6\x16\xc6\x0a\x9a\xc0\x90\x52\x07\x6c\x74\xc5\xe0\xaf\x8a\x9d\x40\x1b\x28\x5b\xbb\x4a\x93\x51\x8c\x9d\x96\x12\x72\xe2\x4f\x93\x21\xbe\x74\x14\x76\x2d\xa4\x0d\x08\xca\xba\x16\x76\xf3\x14\x07\x92\xbd\xe0\xfd\xa7\x14\x71\xe3\xd1\x68\xb7\xdb\xa5\xb4\x57\x9b\x5a\x5f\x8c\xaa\x03\x32\x8c\x6e\xb3\xe9\xcd\x6c\x79\x73\x7e\x95\x5e\xec\x73\xee\x4c\xc5\x21\xc0\xf3\xef\x8d\xf6\x9c\x63\xdd\x82\x9c\xab\xb4\xa2\x75\xc5\xa8\x68\x07\xeb\x41\x85\x67\xce\x21\x36\x0a\xde\x79\x2d\xda\x14\x67\x08\x76\x23\x3b\xf2\x9c\x0c\x91\xeb\x20\x5e\xaf\x1b\x79\x36\xad\x5e\x9e\x0e\xcf\x00\xd6\x80\x0c\x06\x93\x25\xb2\xe5\x00\xef\x26\xcb\x6c\x79\x96\x0c\xf1\x39\x5b\x7d\x9c\xdf\xad\xf0\x79\xb2\x58\x4c\x66\xab\xec\x66\x89\xf9\x02\xd3\xf9\xec\x3a\x5b\x65\xf3\xd9\x12\xf3\xf7\x98\xcc\xbe\xe0\xe7\x6c\x76\x7d\x06\xd6\x52\xb2\x07\x7f\x75\x3e\xea\xb7\x1e\x3a\xce\x91\xf3\x38\xb4\x25\xf3\x33\x01\x1b\x7b\x58\x5f\x70\xac\xf4\x46\x2b\x54\x64\x8a\x86\x0a\x46\x61\xb7\xec\x8d\x36\x05\x1c\xfb\x5a\x87\xb8\xcd\x00\x32\x79\x32\x44\xa5\x6b\x2d\x24\xfb\xc8\xab\xa6\xd2\x24\x21\xa7\xbb\xfd\x8f\xa1\xac\xe7\x54\x19\x5f\xa7\xaa\xb2\x4d\x9e\x16\x7b\x2b\xa5\xca\xd6\xa3\xed\x25\x55\xae\xa4\xcb\xe4\x41\x9b\x7c\x8c\x25\xfb\xad\x56\xfc\x89\x9c\xd3\xa6\x48\x6a\x16\xca\x49\x68\x9c\x00\x86\x6a\x8e\x54\x26\xae\x9a\x3d\x19\xaa\xda\xa0\xc3\xf7\x79\x3b\x7c\x70\xa4\x62\x92\xf1\xf5\x79\x68\x83\x70\x9d\xc4\x2e\x4f\x74\xd3\x9e\x6e\xd2\xd1\x25\xc0\xb6\x97\xbd\xbd\x5c\xb3\xd0\x65\x02\x84\x83\xac\x8f\x36\xc8\xec\x0d\x19\x87\xda\xe4\xa2\xa2\x7d\x7d\xcf\xc1\x36\x5e\x71\x88\xd5\x80\xf3\xae\xe2\x01\x77\x7f\x24\xb8\xef\x19\xee\x8d\x15\xde\x43\x81\xc3\x30\x5e\x89\x9b\x9d\x10\xd4\x88\xfd\xc0\x86\x3d\x09\xe7\x63\x88\x6f\xfa\x13\x9d\xaf\xb8\x76\x15\x09\x8f\x31\x70\xde\xfe\xc6\x4a\xc2\xe8\xdb\xb7\xee\xef\xe3\xe3\x28\x16\x8a\x91\x28\xe8\xf1\x71\xf0\x2a\x6f\x4a\xe6\x1d\xdf\x05\xce\x57\xf6\x13\x89\x2a\x17\x5d\x27\x87\xd6\x37\x54\x85\xbe\x56\xdf\xe3\x64\x4b\xba\x8a\x77\x22\x33\x93\x10\x58\x32\xb3\x65\x23\xd6\xb7\xcf\xe1\xfd\x3a\xbb\xf5\x8e\xbb\x70\xbf\x8c\xf8\xfb\x82\x38\xbb\x3e\x81\x84\x7c\xc1\xf2\x5e\x73\x95\x3f\xc3\x96\x9a\x3d\x79\x55\x6a\x45\xd5\x82\x37\xec\xd9\x1c\xa7\x1e\xbf\xe7\x90\xd6\xf1\x18\xdd\x00\x8e\x71\xe0\x81\xdb\x63\x78\xc1\x9b\x17\xb5\xdf\xe0\xda\x74\x0a\x7e\x94\x2e\x3e\x39\x07\xe5\xb5\x8b\xb7\x65\x8c\x3f\xce\x9f\x9c\x00\xab\x92\xfb\x24\x48\x49\x72\x78\x0f\xf6\x22\xb0\xe6\xca\x9a\x22\x40\x6c\xfa\x24\xad\xd8\x3e\x9c\x44\x9d\x1c\xf3\xcb\x2b\x4d\xdf\xb5\xf3\xe9\x29\xbc\x6d\xdc\xf8\x58\xad\x26\x43\x05\xfb\x37\xef\xd4\x8f\xd8\xd8\x2a\xd5\xf8\xfd\xdc\xfe\xda\xcc\xf3\x97\xb8\xbf\x63\xe9\x53\xd1\x7f\xc4\xd8\xf1\xfd\xc0\xfe\x28\x3e\xbb\x7e\xed\xd2\xff\x1c\xfd\x2f\x74\xf4\x9f\x03\x00\xdb\xe6\x23\x59\xe5\x08\x00\x00"),
 		},
 		"/containerattached.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "containerattached.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1595,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xdb\xb8\x13\xc5\xef\xfa\x14\x0f\xf6\xe5\xff\x07\x12\x39\xc9\x51\x7b\x72\x9d\xb4\x15\x36\x75\x16\xb6\xb3\x45\x4f\xc1\x98\x1a\x4b\xdc\x50\x24\x97\x1c\xd9\x35\xb2\xfe\xee\x0b\x59\x92\x1b\x37\x0d\xb0\x3a\x18\x02\xf9\xf8\xe6\xc7\x79\x23\x8f\x31\x73\x7e\x1f\x74\x59\x09\x6e\xae\x6e\x6e\xf0\xc9\xb9\xd2\x30\xee\xef\x67\xc9\x38\x19\xe3\x5e\x2b\xb6\x91\x0b\x34\xb6\xe0\x00\xa9\x18\x53\x4f\xaa\xe2\x61\xe7\x02\x7f\x72\x88\xda\x59\xdc\xa4\x57\xf8\x5f\x2b\x18\xf5\x5b\xa3\xff\xff\x96\x8c\xb1\x77\x0d\x6a\xda\xc3\x3a\x41\x13\x19\x52\xe9\x88\x8d\x36\x0c\xfe\xae\xd8\x0b\xb4\x85\x72\xb5\x37\x9a\xac\x62\xec\xb4\x54\xc7\x32\xbd\x49\x9a\x8c\xf1\xad\xb7\x70\x6b\x21\x6d\x41\x50\xce\xef\xe1\x36\xaf\x75\x20\x39\x02\x1f\x9f\x4a\xc4\x67\x93\xc9\x6e\xb7\x4b\xe9\x48\x9b\xba\x50\x4e\x4c\xa7\x8c\x93\xfb\x7c\x76\x37\x5f\xde\x5d\xde\xa4\x57\xc7\x33\x8f\xd6\x70\x8c\x08\xfc\x77\xa3\x03\x17\x58\xef\x41\xde\x1b\xad\x68\x6d\x18\x86\x76\x70\x01\x54\x06\xe6\x02\xe2\x5a\xe0\x5d\xd0\xa2\x6d\x79\x81\xe8\x36\xb2\xa3\xc0\xc9\x18\x85\x8e\x12\xf4\xba\x91\xb3\x6e\x0d\x78\x3a\x9e\x09\x9c\x05\x59\x8c\xa6\x4b\xe4\xcb\x11\x3e\x4c\x97\xf9\xf2\x22\x19\xe3\x6b\xbe\xfa\xfc\xf0\xb8\xc2\xd7\xe9\x62\x31\x9d\xaf\xf2\xbb\x25\x1e\x16\x98\x3d\xcc\x6f\xf3\x55\xfe\x30\x5f\xe2\xe1\x23\xa6\xf3\x6f\xf8\x3d\x9f\xdf\x5e\x80\xb5\x54\x1c\xc0\xdf\x7d\x68\xf9\x5d\x80\x6e\xfb\xc8\x45\xdb\xb4\x25\xf3\x19\xc0\xc6\x75\x40\xd1\xb3\xd2\x1b\xad\x60\xc8\x96\x0d\x95\x8c\xd2\x6d\x39\x58\x6d\x4b\x78\x0e\xb5\x8e\x6d\x9a\x11\x64\x8b\x64\x0c\xa3\x6b\x2d\x24\xc7\x95\x37\x97\x4a\x93\x84\xbc\xee\xf3\xcf\xa0\x5c\xe0\x54\xd9\x50\xa7\xca\xb8\xa6\x48\xcb\xe3\x28\xa5\xca\xd5\x93\xed\x35\x19\x5f\xd1\x75\xf2\xac\x6d\x91\x61\xc9\x61\xab\x15\x7f\x21\xef\xb5\x2d\x93\x9a\x85\x0a\x12\xca\x12\xc0\x52\xcd\xad\x95\x6d\xa3\xe6\x40\x22\x6d\x7e\xc5\xaf\x7d\x7b\x7d\xf4\xa4\xda\x43\x36\xd4\x97\x71\x1f\x85\xeb\xa4\xbd\xe5\x0f\xbb\xd9\x60\x37\xed\xed\x12\x60\x3b\x60\x6f\xaf\xd7\x2c\x74\x9d\x00\xb1\xc3\xfa\xec\xa2\xcc\xdf\xc1\xe8\x6a\x93\xd7\xb1\xaf\x1f\x38\xba\x26\x28\x8e\x6d\x35\xe0\xb2\xaf\xd8\xe9\x9e\x4e\x06\x4f\x83\xc3\x93\x32\x4d\x14\x0e\x49\x37\xa9\x5d\x3f\xde\xf0\xcd\xce\x44\xd4\x88\xfb\xc4\x47\x0c\x2e\x32\x48\x68\xb8\xdf\xd1\xc5\x8a\x6b\x6f\x48\x38\xc3\xc8\x07\xf7\x17\x2b\x89\x93\x97\x97\xfe\xf5\x70\x98\x18\xa7\xba\xf8\x26\x2f\x2f\xc3\xfb\xe1\x30\xa1\xf3\x42\xed\x6e\x0b\x7e\x38\x8c\xde\x38\xcf\xc8\x7e\xe0\xc7\xc8\xc5\xca\x7d\x21\x51\xd5\xa2\xbf\x71\xd7\xa2\x0d\x99\x38\xd0\x0c\xbd\x98\x6e\x49\x9b\xf6\xdb\xc9\xed\x34\x46\x96\xdc\x6e\xd9\x8a\x0b\xfb\x73\xf9\x10\x7b\x3f\x06\x59\xbf\x3c\x84\xd6\xfe\xfe\x64\x9c\xdf\xfe\x10\x09\x85\x92\xe5\xa3\x66\x53\x9c\x69\x2b\xcd\x81\x82\xaa\xb4\x22\xb3\xe0\x0d\x07\xb6\xa7\x74\xba\x84\x64\xef\x39\x43\xdf\xa2\xd3\x3a\xf0\xcc\xfb\xd3\xf2\x82\x37\x3f\xd5\x7e\xc7\x6b\xd3\x13\xfc\x57\xbb\xf6\x29\x38\xaa\xa0\xbd\x1c\xc7\xef\x9f\xcb\x57\x3b\xc0\xaa\xe2\xe1\x10\xa4\x22\xe9\xfe\x2f\x07\x08\xac\xd9\x38\x5b\x46\x88\x4b\x5f\x1d\x2b\xb7\xcf\xd9\x99\x4b\x37\x56\x7f\xbc\x61\xfa\xe5\xd8\xbf\xf2\x09\xae\xf1\xd9\xa9\x5a\x4d\x96\x4a\x0e\xef\x7c\x7b\xff\x06\x00\x00\xff\xff\xaa\x08\xb5\x2a\x3b\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x51\x6f\xda\x48\x14\x85\xdf\xfd\x2b\x8e\xe0\x65\x57\x4a\x4c\x92\x47\xef\x13\x25\x69\x6b\x6d\x4a\x56\x40\xb6\xea\x53\x74\x19\x5f\xec\xd9\x8c\x67\x66\x67\xae\xa1\x28\xcb\x7f\x5f\x19\x6c\x08\x4d\x23\x15\x23\x64\xcd\x9c\x7b\xee\xe7\x7b\xc6\x0c\x31\x71\x7e\x1b\x74\x59\x09\x6e\xae\x6e\x6e\xf0\xc9\xb9\xd2\x30\xee\xef\x27\xc9\x30\x19\xe2\x5e\x2b\xb6\x91\x0b\x34\xb6\xe0\x00\xa9\x18\x63\x4f\xaa\xe2\x7e\xe7\x02\x7f\x73\x88\xda\x59\xdc\xa4\x57\xf8\xad\x15\x0c\xba\xad\xc1\xef\x7f\x24\x43\x6c\x5d\x83\x9a\xb6\xb0\x4e\xd0\x44\x86\x54\x3a\x62\xa5\x0d\x83\xbf\x2b\xf6\x02\x6d\xa1\x5c\xed\x8d\x26\xab\x18\x1b\x2d\x15\xe4\xe4\x9f\x26\x43\x7c\xeb\x2c\xdc\x52\x48\x5b\x10\x94\xf3\x5b\xb8\xd5\x6b\x1d\x48\xf6\xc0\xfb\x4f\x25\xe2\xb3\xd1\x68\xb3\xd9\xa4\xb4\xa7\x4d\x5d\x28\x47\xe6\xa0\x8c\xa3\xfb\x7c\x72\x37\x9d\xdf\x5d\xde\xa4\x57\xfb\x9a\x47\x6b\x38\x46\x04\xfe\xb7\xd1\x81\x0b\x2c\xb7\x20\xef\x8d\x56\xb4\x34\x0c\x43\x1b\xb8\x00\x2a\x03\x73\x01\x71\x2d\xf0\x26\x68\xd1\xb6\xbc\x40\x74\x2b\xd9\x50\xe0\x64\x88\x42\x47\x09\x7a\xd9\xc8\xd9\xb4\x7a\x3c\x1d\xcf\x04\xce\x82\x2c\x06\xe3\x39\xf2\xf9\x00\x1f\xc6\xf3\x7c\x7e\x91\x0c\xf1\x35\x5f\x7c\x7e\x78\x5c\xe0\xeb\x78\x36\x1b\x4f\x17\xf9\xdd\x1c\x0f\x33\x4c\x1e\xa6\xb7\xf9\x22\x7f\x98\xce\xf1\xf0\x11\xe3\xe9\x37\xfc\x99\x4f\x6f\x2f\xc0\x5a\x2a\x0e\xe0\xef\x3e\xb4\xfc\x2e\x40\xb7\x73\xe4\xa2\x1d\xda\x9c\xf9\x0c\x60\xe5\x0e\xf1\x45\xcf\x4a\xaf\xb4\x82\x21\x5b\x36\x54\x32\x4a\xb7\xe6\x60\xb5\x2d\xe1\x39\xd4\x3a\xb6\x69\x46\x90\x2d\x92\x21\x8c\xae\xb5\x90\xec\x57\xde\x3c\x54\x9a\x24\xe4\x75\x97\x7f\x06\xe5\x02\xa7\xca\x86\x3a\x55\xc6\x35\x45\x5a\xee\x8f\x52\xaa\x5c\x3d\x5a\x5f\x93\xf1\x15\x5d\x27\xcf\xda\x16\x19\xe6\x1c\xd6\x5a\xf1\x17\xf2\x5e\xdb\x32\xa9\x59\xa8\x20\xa1\x2c\x01\x2c\xd5\xdc\x5a\xd9\x36\x6a\x0e\x24\xd2\xe6\x57\xfc\xdc\xb7\xd3\x47\x4f\xaa\x2d\xb2\xa1\xbe\x8c\xdb\x28\x5c\x27\xed\x53\x9e\xec\x26\xbd\xdd\xb8\xb3\x4b\x80\x75\x8f\xbd\xbe\x5e\xb2\xd0\x75\x02\xc4\x03\xd6\x67\x17\x65\xfa\x0e\xc6\xa1\x37\x79\x1d\xbb\xfe\x81\xa3\x6b\x82\xe2\xd8\x76\x03\x2e\xbb\x8e\x07\xdd\xd3\xd1\xe0\xa9\x77\x78\x52\xa6\x89\xc2\x61\xaf\x06\x0e\xf3\x78\xc3\x37\x39\x13\x51\x23\xee\x13\xef\x31\xb8\xc8\x20\xa1\xe1\x6e\x47\x17\x0b\xae\xbd\x21\xe1\x0c\x03\x1f\xdc\x3f\xac\x24\x8e\x5e\x5e\xba\xdb\xdd\x6e\x64\x9c\x3a\xc4\x37\x7a\x79\xe9\xef\x77\xbb\x11\x9d\x37\x6a\x6b\x5a\xf0\xdd\x6e\xf0\xc6\x79\x42\xf6\x03\x3f\x46\x2e\x16\xee\x0b\x89\xaa\x66\xdd\x13\x1f\x46\xb4\x22\x13\x7b\x9a\x7e\x16\xe3\x35\x69\xd3\xbe\x3b\xb9\x1d\xc7\xc8\x92\xdb\x35\x5b\x71\x61\x7b\x2e\xef\x63\xef\x8e\x41\xd6\x2d\xf7\xa1\xb5\xbf\x3f\x18\xe7\xb7\x27\x91\x50\x28\x59\x3e\x6a\x36\xc5\x99\xb6\xd2\x1c\x28\xa8\x4a\x2b\x32\x33\x5e\x71\x60\x7b\x4c\xa7\xfd\x5e\x42\xb6\x9e\x33\x74\x23\x3a\xae\x03\xcf\xbc\x3d\x2e\xcf\x78\xf5\x43\xef\x77\xbc\x56\x1d\xc1\xaf\xda\xb5\x57\xc1\x51\x05\xed\xdb\x28\x32\xfc\x77\xf9\x6a\x07\x58\x54\xdc\x17\x41\x2a\x92\xc3\xff\x65\x0f\x81\x25\x1b\x67\xcb\x08\x71\xe9\xab\xb2\x72\xfd\x7c\x82\x3a\x1d\xab\xbf\xde\x30\xfd\xf4\xd8\x9f\xae\x32\xb8\xc6\x67\xc7\x6e\x35\x59\x2a\x39\xbc\xf3\xee\xfd\x3f\x00\xaa\x08\xb5\x2a\x3b\x06\x00\x00"),
 		},
 		"/databasemigrationservice.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "databasemigrationservice.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1728,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4b\x73\xe2\x38\x10\xc7\xef\xfe\x14\xff\x82\xcb\x6e\x55\x62\x12\x8e\xde\x13\x43\x32\x33\xae\x4d\x60\x0a\xc8\x4e\xcd\x89\x6a\xe4\xc6\xd6\x46\x96\xb4\x92\x0c\x43\x65\xf9\xee\x5b\x7e\x31\x21\xaf\x5a\x5f\x30\xfd\xf8\xf7\xaf\x5b\x2d\x0f\x31\x35\xf6\xe0\x64\x5e\x04\x8c\xaf\xc6\x63\x7c\x31\x26\x57\x8c\xbb\xbb\x69\x34\x8c\x86\xb8\x93\x82\xb5\xe7\x0c\x95\xce\xd8\x21\x14\x8c\x89\x25\x51\x70\xef\xb9\xc0\x5f\xec\xbc\x34\x1a\xe3\xf8\x0a\xbf\xd5\x01\x83\xce\x35\xf8\xfd\x8f\x68\x88\x83\xa9\x50\xd2\x01\xda\x04\x54\x9e\x11\x0a\xe9\xb1\x95\x8a\xc1\x3f\x05\xdb\x00\xa9\x21\x4c\x69\x95\x24\x2d\x18\x7b\x19\x8a\xa6\x4c\x27\x12\x47\x43\xfc\xe8\x24\xcc\x26\x90\xd4\x20\x08\x63\x0f\x30\xdb\xe7\x71\xa0\xd0\x00\x37\x4f\x11\x82\x4d\x46\xa3\xfd\x7e\x1f\x53\x43\x1b\x1b\x97\x8f\x54\x1b\xe9\x47\x77\xe9\xf4\x76\xb6\xbc\xbd\x1c\xc7\x57\x4d\xce\x83\x56\xec\x3d\x1c\xff\x53\x49\xc7\x19\x36\x07\x90\xb5\x4a\x0a\xda\x28\x86\xa2\x3d\x8c\x03\xe5\x8e\x39\x43\x30\x35\xf0\xde\xc9\x20\x75\x7e\x01\x6f\xb6\x61\x4f\x8e\xa3\x21\x32\xe9\x83\x93\x9b\x2a\x9c\x4d\xab\xc7\x93\xfe\x2c\xc0\x68\x90\xc6\x60\xb2\x44\xba\x1c\xe0\xd3\x64\x99\x2e\x2f\xa2\x21\xbe\xa7\xab\xaf\xf3\x87\x15\xbe\x4f\x16\x8b\xc9\x6c\x95\xde\x2e\x31\x5f\x60\x3a\x9f\xdd\xa4\xab\x74\x3e\x5b\x62\xfe\x19\x93\xd9\x0f\xfc\x99\xce\x6e\x2e\xc0\x32\x14\xec\xc0\x3f\xad\xab\xf9\x8d\x83\xac\xe7\xc8\x59\x3d\xb4\x25\xf3\x19\xc0\xd6\xb4\x40\xde\xb2\x90\x5b\x29\xa0\x48\xe7\x15\xe5\x8c\xdc\xec\xd8\x69\xa9\x73\x58\x76\xa5\xf4\xf5\x69\x7a\x90\xce\xa2\x21\x94\x2c\x65\xa0\xd0\x58\x5e\x35\x15\x47\x11\x59\xd9\x9d\x7f\x02\x61\x1c\xc7\x42\xbb\x32\x16\xca\x54\x59\x9c\x37\xab\x14\x0b\x53\x8e\x76\xd7\xa4\x6c\x41\xd7\xd1\xa3\xd4\x59\x82\x25\xbb\x9d\x14\x7c\x4f\xd6\x4a\x9d\x47\x25\x07\xca\x28\x50\x12\x01\x9a\x4a\x4e\x50\xff\xdb\x90\xe7\x52\xe6\xae\xad\xde\x66\xbc\x2d\xdf\xa5\x79\x4b\x82\x13\xd4\x21\x97\xfe\xe0\x03\x97\x51\xdd\xec\x2f\xd5\x9b\x4e\xf5\xbe\x57\xed\x38\x22\x60\xd7\x37\xb1\xbb\xde\x70\xa0\xeb\x08\xe8\x4a\x7e\x35\x3e\xcc\x3e\x86\x6a\x49\xc8\x4a\xdf\xd1\x38\xf6\xa6\x72\x82\x7d\x5d\x1b\xb8\xec\xea\xb7\x71\xeb\x5e\x67\x7d\x12\x5a\x77\x4a\x6b\x61\xb4\x66\xd1\x98\xac\x33\xf5\x2d\x89\xda\x8d\x6e\xe7\xf6\x5e\x03\xd3\x53\xda\xb7\xb3\x2c\xaa\x82\xf9\xc2\x9a\x1d\x05\xce\x12\x04\x57\xf5\x1e\x99\xad\xb8\xb4\x8a\x02\x27\x18\x58\x67\xfe\x66\x11\xfc\xe8\xe9\xa9\x7b\x3d\x1e\x47\xca\x88\xb6\xc9\xd1\xd3\x53\xff\x7e\x3c\x8e\xc4\xcb\x52\xb5\xff\x35\xf6\x5a\x66\xc7\xe3\xe0\x55\xb1\x29\xe9\x4f\xfc\xe0\x39\x5b\x99\x7b\x0a\xa2\x58\x74\x83\x6a\x07\xbc\x25\xe5\x7b\xc0\x7e\x84\x93\x1d\x49\x55\xdf\xc3\x54\x4f\xbc\xe7\x90\xea\x1d\xeb\x60\xdc\xe1\x3c\xbc\x5f\xa1\x6e\xa5\x92\xce\xdc\x9f\xfc\x9b\x80\xa7\x18\x45\x1b\x56\x3e\xe9\x7e\x5f\x00\xa4\x37\xbf\xc4\x02\xb9\x9c\xc3\x67\xc9\x2a\xfb\x58\xb3\x90\xec\xc8\x89\x42\x0a\x52\x0b\xde\xb2\x63\x7d\xda\x86\x76\x23\xc2\xc1\x72\x82\x6e\xdc\x27\x3b\xf0\xc8\x87\x93\x79\xc1\xdb\x17\x30\xef\x68\x6d\x3b\xa4\xff\x2b\x57\x3f\x19\x7b\xe1\xa4\x0d\xcd\xd6\xff\x7b\xf9\xcc\x03\xac\x0a\xee\x93\x10\x0a\x0a\xed\x47\xbb\x87\xc0\x86\x95\xd1\xb9\x47\x30\xf1\xb3\xb4\x7c\xf7\x98\x9c\xa9\xb4\x3b\xfb\xed\x15\xd3\x9b\xb7\xed\x99\x8e\x33\x95\x4d\x4e\xd5\x4a\xd2\x94\xb3\x7b\xe7\xe6\xff\x17\x00\x00\xff\xff\x0d\xd8\xa1\x7e\xc0\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x5d\x6f\xe2\x38\x14\x86\xef\xf3\x2b\x5e\xc1\xcd\xae\x54\x42\xcb\x65\xf6\x8a\xa1\x9d\x99\x68\x5b\x18\x01\xdd\xd1\x5c\xa1\x83\x73\x48\xbc\x75\x6c\xaf\xed\xc0\xa0\x59\xfe\xfb\x2a\x24\x81\xd2\x2f\x2d\x41\xc2\x9c\x8f\xf7\x3c\x3e\x3e\x4e\x1f\x13\x63\xf7\x4e\xe6\x45\xc0\xe8\x7a\x34\xc2\x17\x63\x72\xc5\xb8\xbf\x9f\x44\xfd\xa8\x8f\x7b\x29\x58\x7b\xce\x50\xe9\x8c\x1d\x42\xc1\x18\x5b\x12\x05\x77\x9e\x2b\xfc\xc5\xce\x4b\xa3\x31\x8a\xaf\xf1\x5b\x1d\xd0\x6b\x5d\xbd\xdf\xff\x88\xfa\xd8\x9b\x0a\x25\xed\xa1\x4d\x40\xe5\x19\xa1\x90\x1e\x1b\xa9\x18\xfc\x53\xb0\x0d\x90\x1a\xc2\x94\x56\x49\xd2\x82\xb1\x93\xa1\x40\x38\xeb\xc7\x51\x1f\x3f\x5a\x09\xb3\x0e\x24\x35\x08\xc2\xd8\x3d\xcc\xe6\x79\x1c\x28\x1c\x81\x8f\x9f\x22\x04\x9b\x0c\x87\xbb\xdd\x2e\xa6\x23\x6d\x6c\x5c\x3e\x54\x4d\xa4\x1f\xde\xa7\x93\xbb\xe9\xe2\x6e\x30\x8a\xaf\x8f\x39\x8f\x5a\xb1\xf7\x70\xfc\x4f\x25\x1d\x67\x58\xef\x41\xd6\x2a\x29\x68\xad\x18\x8a\x76\x30\x0e\x94\x3b\xe6\x0c\xc1\xd4\xc0\x3b\x27\x83\xd4\xf9\x15\xbc\xd9\x84\x1d\x39\x8e\xfa\xc8\xa4\x0f\x4e\xae\xab\x70\xd1\xad\x0e\x4f\xfa\x8b\x00\xa3\x41\x1a\xbd\xf1\x02\xe9\xa2\x87\x4f\xe3\x45\xba\xb8\x8a\xfa\xf8\x9e\x2e\xbf\xce\x1e\x97\xf8\x3e\x9e\xcf\xc7\xd3\x65\x7a\xb7\xc0\x6c\x8e\xc9\x6c\x7a\x9b\x2e\xd3\xd9\x74\x81\xd9\x67\x8c\xa7\x3f\xf0\x67\x3a\xbd\xbd\x02\xcb\x50\xb0\x03\xff\xb4\xae\xe6\x37\x0e\xb2\xee\x23\x67\x75\xd3\x16\xcc\x17\x00\x1b\xd3\x1c\x9f\xb7\x2c\xe4\x46\x0a\x28\xd2\x79\x45\x39\x23\x37\x5b\x76\x5a\xea\x1c\x96\x5d\x29\x7d\x7d\x9a\x1e\xa4\xb3\xa8\x0f\x25\x4b\x19\x28\x1c\x2d\xaf\x36\x15\x47\x11\x59\xd9\x9e\x7f\x02\x61\x1c\xc7\x42\xbb\x32\x16\xca\x54\x59\x9c\x1f\x47\x29\x16\xa6\x1c\x6e\x6f\x48\xd9\x82\x6e\xa2\x27\xa9\xb3\x04\x0b\x76\x5b\x29\xf8\x81\xac\x95\x3a\x8f\x4a\x0e\x94\x51\xa0\x24\x02\x34\x95\x9c\xa0\xfe\xb7\x26\xcf\xa5\xcc\x5d\x53\xbd\xc9\x78\x5b\xbe\x4d\xf3\x96\x04\x27\xa8\x43\x06\x7e\xef\x03\x97\x51\xbd\xd9\xb3\xea\x6d\xab\xfa\xd0\xa9\xb6\x1c\x11\xb0\xed\x36\xb1\xbd\x59\x73\xa0\x9b\x08\x68\x4b\x7e\x35\x3e\x4c\x3f\x86\x6a\x48\xc8\x4a\xdf\xd2\x38\xf6\xa6\x72\x82\x7d\x5d\x1b\x18\xb4\xf5\x9b\xb8\x55\xa7\xb3\x3a\x09\xad\x5a\xa5\x95\x30\x5a\xb3\xa8\xb5\x57\xd6\x99\xfa\x96\x1c\x05\x80\xa6\x6f\xef\x6d\x60\x72\x4a\xfb\x76\x91\x45\x55\x30\x5f\x58\xb3\xa3\xc0\x59\x82\xe0\xaa\xce\x23\xb3\x25\x97\x56\x51\xe0\x04\x3d\xeb\xcc\xdf\x2c\x82\x1f\xfe\xfa\xd5\x2e\x0f\x87\xa1\x32\xe2\x58\xa2\xb6\x76\xeb\xc3\x61\x28\x5e\x96\xaa\xfd\xaf\xb1\x57\x32\x3b\x1c\x7a\xaf\x8a\x4d\x48\x7f\xe2\x47\xcf\xd9\xd2\x3c\x50\x10\xc5\xbc\x6d\x54\xd3\xe0\x0d\x29\xdf\x01\x76\x2d\x1c\x6f\x49\xaa\xfa\x1e\xa6\x7a\xec\x3d\x87\x54\x6f\x59\x07\xe3\xf6\x97\xe1\xdd\x08\xb5\x23\x95\xb4\xe6\xee\xe4\xdf\x04\x3c\xc5\x28\x5a\xb3\xf2\x49\xfb\xfb\x02\x20\xbd\x3d\x8b\x05\x72\x39\x87\xcf\x92\x55\xf6\xb1\x66\x21\xd9\x91\x13\x85\x14\xa4\xe6\xbc\x61\xc7\xfa\x34\x0d\xf5\x77\x80\xb0\xb7\x9c\xa0\x6d\xf7\xc9\x0e\x3c\xf1\xfe\x64\x9e\xf3\xe6\x05\xcc\x3b\x5a\x9b\x16\xe9\xff\xca\xd5\x4f\xc6\x5e\x38\x69\x6b\xfe\x04\xff\x0e\x9e\x79\x80\x65\xc1\x5d\x12\x42\x41\xa1\x79\x69\x77\x10\x58\xb3\x32\x3a\xf7\x08\x26\x7e\x96\x96\x6f\x9f\xce\x50\xe7\x99\xfd\xf6\x8a\xe9\xcd\xdb\x76\x7e\x72\x67\x2a\x9b\x9c\xaa\x95\xa4\x29\x67\xf7\xce\xcd\xff\x6f\x00\x0d\xd8\xa1\x7e\xc0\x06\x00\x00"),
 		},
 		"/datacatalog.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "datacatalog.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 5707,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x0c\xac\x4b\x0b\xd8\x52\xec\x23\x7b\x52\x64\x27\x21\xea\xc8\x81\xa5\x34\xc8\x49\x18\x2d\x47\xe4\x36\xe4\xee\x76\x77\x28\x85\x50\xf5\xdf\x0b\x7e\x49\x94\x29\xcb\x72\x92\x16\x45\x60\x9e\x2c\xee\xec\x9b\x37\x6f\xe7\xcd\x12\xee\xc1\x48\x9b\xdc\xca\x28\x66\xb8\x7a\x75\x75\x05\x6f\xb5\x8e\x12\x82\xdb\xdb\x91\xd7\xf3\x7a\x70\x2b\x05\x29\x47\x21\x64\x2a\x24\x0b\x1c\x13\x0c\x0d\x8a\x98\x9a\x95\x73\xf8\x83\xac\x93\x5a\xc1\x55\xff\x15\xfc\x52\x04\x9c\xd5\x4b\x67\xbf\xfe\xe6\xf5\x20\xd7\x19\xa4\x98\x83\xd2\x0c\x99\x23\xe0\x58\x3a\x58\xc8\x84\x80\xbe\x0a\x32\x0c\x52\x81\xd0\xa9\x49\x24\x2a\x41\xb0\x92\x1c\x97\x69\x6a\x90\xbe\xd7\x83\xcf\x35\x84\x9e\x33\x4a\x05\x08\x42\x9b\x1c\xf4\xa2\x1d\x07\xc8\x25\xe1\xf2\x89\x99\x8d\x3f\x18\xac\x56\xab\x3e\x96\x6c\xfb\xda\x46\x83\xa4\x8a\x74\x83\xdb\x60\x74\x33\x9e\xdc\x5c\x5c\xf5\x5f\x95\x7b\x3e\xaa\x84\x9c\x03\x4b\x7f\x65\xd2\x52\x08\xf3\x1c\xd0\x98\x44\x0a\x9c\x27\x04\x09\xae\x40\x5b\xc0\xc8\x12\x85\xc0\xba\x20\xbc\xb2\x92\xa5\x8a\xce\xc1\xe9\x05\xaf\xd0\x92\xd7\x83\x50\x3a\xb6\x72\x9e\xf1\x9e\x5a\x0d\x3d\xe9\xf6\x02\xb4\x02\x54\x70\x36\x9c\x40\x30\x39\x83\xd7\xc3\x49\x30\x39\xf7\x7a\xf0\x29\x98\xbe\xbb\xfb\x38\x85\x4f\xc3\xfb\xfb\xe1\x78\x1a\xdc\x4c\xe0\xee\x1e\x46\x77\xe3\xeb\x60\x1a\xdc\x8d\x27\x70\xf7\x06\x86\xe3\xcf\xf0\x7b\x30\xbe\x3e\x07\x92\x1c\x93\x05\xfa\x6a\x6c\xc1\x5f\x5b\x90\x85\x8e\x14\x16\xa2\x4d\x88\xf6\x08\x2c\x74\x45\xc8\x19\x12\x72\x21\x05\x24\xa8\xa2\x0c\x23\x82\x48\x2f\xc9\x2a\xa9\x22\x30\x64\x53\xe9\x8a\xd3\x74\x80\x2a\xf4\x7a\x90\xc8\x54\x32\x72\xf9\xa6\x53\x54\xdf\xf3\xd0\xc8\xfa\xfc\x7d\x10\xda\x52\x5f\x28\x9b\xf6\x45\xa2\xb3\xb0\x1f\x95\xad\xd4\x17\x3a\x1d\x2c\x2f\x31\x31\x31\x5e\x7a\x5f\xa4\x0a\x7d\x98\x90\x5d\x4a\x41\xef\xd1\x18\xa9\x22\x2f\x25\xc6\x10\x19\x7d\x0f\x40\x61\x4a\x3e\x14\xbf\x04\x32\x26\x3a\x3a\x8c\x58\x47\x3a\x83\x82\x7c\x28\x42\x2e\x5c\xee\x98\x52\xaf\xa8\x6f\x07\x74\x8d\x8c\xa3\x0a\xc8\x03\x58\x36\x54\x97\x97\x73\x62\xbc\xf4\x00\x5c\x45\xe5\x9d\x76\x3c\xee\xa4\xae\xf2\xa1\x91\xae\xce\x69\xc9\xe9\xcc\x0a\x72\x45\x06\x80\x8b\x3a\x4b\x15\x37\x2b\xb6\xce\xea\xbd\x33\x52\x6c\x73\xaf\xea\xc7\xaa\xea\x16\x97\x9b\xd6\x22\x66\xac\xdf\x92\x22\x8b\x4c\xa1\x0f\x6c\x33\xaa\x57\x64\x38\xa5\xd4\x24\xc8\xe4\xc3\xd9\x7a\x5d\xe4\xda\x6c\xce\x3a\x8b\x23\x54\xaf\xe9\xa3\xa3\x70\xaa\xdf\x23\x8b\xf8\xbe\x26\x59\xd5\xb3\xc0\xc4\x35\x80\x0d\xfd\xe1\x12\x65\x52\x34\x77\xa0\x86\xce\x11\x07\x6a\x49\x8a\xb5\xcd\xf7\xc3\x0b\x6d\xc8\x6e\xb9\x05\xd7\x6f\x24\x25\xa1\x5f\x16\xfd\x00\x31\xb8\xf6\xeb\x37\x00\x8c\x36\x22\xee\xc6\x02\x2c\x31\xc9\x68\xaf\xa6\x52\xa5\x59\x64\x75\x66\x36\x9b\x41\xf1\x4b\x92\x1b\xac\xd7\x65\x64\x53\xec\x93\x32\x57\x00\x47\xc5\x7e\xdb\x8a\x38\xa2\x38\xa6\x23\xad\x16\x32\xda\x55\x63\x74\x22\x45\x3e\x3e\x85\xc0\x4c\x62\x3a\xab\xe2\x1f\x6c\x7f\x4f\xe9\x9c\xec\xe9\x20\x69\x19\xbf\x05\xb1\xb4\x20\x4b\x4a\x50\x25\xea\xf6\x7d\xd3\xe5\x5d\x11\xca\x73\xc8\x0d\xf9\x20\xc3\xed\x2b\x97\x19\xa3\x2d\xbb\x91\x56\xa1\x2c\x3d\xbd\x7f\xde\x3f\x7d\xbf\x19\xab\xff\x24\xc1\x45\x83\xd5\x7f\x6e\x36\x83\x44\x8b\x6a\xc0\x0d\xd6\x6b\x4b\x91\xd4\xaa\xee\xc4\xaa\x67\x3a\xdd\x08\x10\x4b\xb2\x68\x45\x2c\x05\x26\xf7\xcd\xd1\xb8\x1d\xa1\x8b\x5a\xfa\x3a\x47\xeb\x48\xbe\x50\xbe\x7d\x7d\x4f\x8b\x07\x45\x3d\x82\xb5\xa8\x4b\x3b\x15\xae\x78\x42\x72\xc2\x4a\xc3\xe5\xb4\xfb\xfb\xa2\xb5\x02\x30\x8d\xa9\xd9\x04\x1c\x23\x57\x57\x72\x43\x02\xe6\x94\x68\x15\x39\x60\xdd\x6f\x6d\x8b\x96\x5f\xfc\x3d\x94\xca\x66\x1f\x3a\x9c\x0e\x4e\xd9\x16\x4e\xa1\xa9\xbf\xcd\x96\xa2\xc2\x88\xec\xa3\x43\xfe\xb8\xf9\x2b\x73\xcd\x18\xa3\xc7\xbc\xff\xa1\x8c\x98\x6e\x03\x7e\xa8\xf5\x77\xe9\xbf\xdd\xf9\x0f\x30\x9e\x69\xfc\x8e\x00\xf0\xe2\xfb\x83\xf7\x0c\xe3\x57\xad\x74\x9a\x6f\x36\x03\xd3\xb4\xc4\x01\x67\x1f\x77\x62\x65\x37\xb4\xa4\x78\xdb\x57\xfb\xb6\xdb\x79\xb5\x8c\xea\x36\xe8\x11\x2b\x1d\xe9\xda\xd3\x7d\x75\xca\x87\xd3\xd3\xfa\xd5\xa5\x36\xa2\x3d\x52\x63\xb3\xfc\xac\xd2\xa6\xdd\x4d\xff\x65\x65\x50\x9f\x4c\xcb\xfa\xc7\x06\xcc\x91\xc9\x72\xd2\x4c\xf9\xf9\x8d\x55\xc9\xb9\xd9\x0c\xf8\x80\xa1\x9e\x90\x76\xc6\x35\xd2\x11\x8d\xa7\xfb\x21\x3f\x74\x7e\xb7\x29\x7c\xfb\x04\xef\xa0\x3c\x73\x86\x1f\x10\x02\x5e\xa6\xf8\x77\x7d\xbd\xf1\xae\x6f\x5e\x3e\xdf\xca\xe7\x7f\xf9\xf9\xf6\xe0\x02\x79\xea\xae\xf8\xc1\xde\xaf\xb0\xbf\xc7\xf7\x2d\x84\x67\x7b\xbe\x73\x0b\xbe\xf8\xfd\x7b\xfc\x5e\xca\x79\xe0\x5f\x07\x2f\x6e\x6f\x59\xeb\xdf\x75\xfb\x3f\x01\x00\x00\xff\xff\xb9\x5d\x32\x47\x4b\x16\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4f\x73\xda\xc8\x13\xbd\xeb\x53\x74\xc1\xe5\xf7\xab\xb2\x21\xf6\x51\x7b\x22\xd8\x49\x54\xeb\xe0\x14\x90\x4d\xe5\x44\x35\xa3\x46\x9a\xb5\x34\x33\x3b\xd3\x02\xab\x58\xbe\xfb\x96\xfe\x81\x30\x36\xc6\x49\xf6\x4f\xa5\x6c\x7c\x80\x99\x37\xdd\xef\xf5\xf4\x6b\xa9\x0b\x43\x6d\x72\x2b\xa3\x98\xe1\xf2\xcd\xe5\x25\xbc\xd7\x3a\x4a\x08\x6e\x6e\x86\x5e\xd7\xeb\xc2\x8d\x14\xa4\x1c\x85\x90\xa9\x90\x2c\x70\x4c\x30\x30\x28\x62\x6a\x76\xce\xe0\x37\xb2\x4e\x6a\x05\x97\xbd\x37\xf0\xbf\x02\xd0\xa9\xb7\x3a\xff\xff\xc5\xeb\x42\xae\x33\x48\x31\x07\xa5\x19\x32\x47\xc0\xb1\x74\xb0\x90\x09\x01\xdd\x0b\x32\x0c\x52\x81\xd0\xa9\x49\x24\x2a\x41\xb0\x92\x1c\x03\xef\xe2\xf7\xbc\x2e\x7c\xad\x43\xe8\x39\xa3\x54\x80\x20\xb4\xc9\x41\x2f\xda\x38\x40\x2e\x09\x97\x7f\x31\xb3\xf1\xfb\xfd\xd5\x6a\xd5\xc3\x92\x6d\x4f\xdb\xa8\x9f\x54\x48\xd7\xbf\x09\x86\xd7\xa3\xc9\xf5\xf9\x65\xef\x4d\x79\xe6\xb3\x4a\xc8\x39\xb0\xf4\x47\x26\x2d\x85\x30\xcf\x01\x8d\x49\xa4\xc0\x79\x42\x90\xe0\x0a\xb4\x05\x8c\x2c\x51\x08\xac\x0b\xc2\x2b\x2b\x59\xaa\xe8\x0c\x9c\x5e\xf0\x0a\x2d\x79\x5d\x08\xa5\x63\x2b\xe7\x19\xef\x55\xab\xa1\x27\xdd\x1e\x40\x2b\x40\x05\x9d\xc1\x04\x82\x49\x07\xde\x0e\x26\xc1\xe4\xcc\xeb\xc2\x97\x60\xfa\xe1\xf6\xf3\x14\xbe\x0c\xc6\xe3\xc1\x68\x1a\x5c\x4f\xe0\x76\x0c\xc3\xdb\xd1\x55\x30\x0d\x6e\x47\x13\xb8\x7d\x07\x83\xd1\x57\xf8\x35\x18\x5d\x9d\x01\x49\x8e\xc9\x02\xdd\x1b\x5b\xf0\xd7\x16\x64\x51\x47\x0a\x8b\xa2\x4d\x88\xf6\x08\x2c\x74\x75\x7d\xce\x90\x90\x0b\x29\x20\x41\x15\x65\x18\x11\x44\x7a\x49\x56\x49\x15\x81\x21\x9b\x4a\x57\xdc\xa6\x03\x54\xa1\xd7\x85\x44\xa6\x92\x91\xcb\x95\x03\x51\x3d\xcf\x43\x23\xeb\xfb\xf7\x41\x68\x4b\x3d\xa1\x6c\xda\x13\x89\xce\xc2\x5e\x54\xb6\x52\x4f\xe8\xb4\xbf\xbc\xc0\xc4\xc4\x78\xe1\xdd\x49\x15\xfa\x30\x21\xbb\x94\x82\x3e\xa2\x31\x52\x45\x5e\x4a\x8c\x21\x32\xfa\x1e\x80\xc2\x94\x7c\x28\x7e\x09\x64\x4c\x74\xf4\x78\xc4\x1a\xe9\x0c\x0a\xf2\xa1\x80\x9c\xbb\xdc\x31\xa5\x5e\xa1\x6f\x17\xe8\x0a\x19\x87\x55\x20\x0f\x60\xd9\x50\x5d\x5e\xcc\x89\xf1\xc2\x03\x70\x15\x95\x0f\xda\xf1\xe8\x20\x75\x95\x0f\x8d\x74\x75\x4e\x4b\x4e\x67\x56\x90\x2b\x32\x00\x9c\xd7\x59\x2a\xdc\xac\x60\x3d\xab\xcf\xce\x48\xb1\xcd\x4b\x14\x40\xa5\xba\xc5\xe5\xba\xb5\x89\x19\xeb\xf7\xa4\xc8\x22\x53\xe8\x03\xdb\x8c\xea\x1d\x19\x4e\x29\x35\x09\x32\xf9\xd0\x59\xaf\x8b\x5c\x9b\x4d\xe7\x60\x73\x88\xea\x2d\x7d\x76\x14\x4e\xf5\x47\x64\x11\x8f\x6b\x92\x95\x9e\x05\x26\xae\x09\xd8\xd0\x1f\x2c\x51\x26\x45\x73\x07\x6a\xe0\x1c\x71\xa0\x96\xa4\x58\xdb\x7c\x1f\x5e\xd4\x86\xec\x96\x5b\x70\xf5\x4e\x52\x12\xfa\xa5\xe8\x07\x11\x83\x2b\xbf\x5e\x01\x60\xb4\x11\xf1\x21\x16\x60\x89\x49\x46\x7b\x9a\xca\x2a\xcd\x22\xab\x33\xb3\xd9\xf4\x8b\x5f\x92\x5c\x7f\xbd\x2e\x91\x8d\xd8\x67\xcb\x5c\x05\x38\x5a\xec\xf7\x2d\xc4\x91\x8a\x63\x3a\xd4\x6a\x21\xa3\x9d\x1a\xa3\x13\x29\xf2\xd1\x29\x04\x66\x12\xd3\x59\x85\x7f\x70\xfc\x23\xa5\x73\xb2\xa7\x07\x49\x4b\xfc\x36\x88\xa5\x05\x59\x52\x82\xaa\xa2\x6e\xd7\x9b\x2e\x6f\x1d\x6f\xed\x71\x6e\xc8\x07\x19\x6e\x97\x5c\x66\x8c\xb6\xec\x86\x5a\x85\xb2\xf4\xf4\xfe\x7d\xff\xf4\xfd\x66\xac\xfe\x9d\x04\x17\x0d\x56\x7f\xdd\x6c\xfa\x89\x16\xd5\x80\xeb\xaf\xd7\x96\x22\xa9\x55\xdd\x89\x55\xcf\x1c\x74\x23\x40\x2c\xc9\xa2\x15\xb1\x14\x98\x8c\x9b\xab\xa9\x47\x42\xf1\x7f\x5e\x97\xbe\xce\xb1\x5d\x07\xb8\xa3\x7c\xbb\x3c\xa6\xc5\x03\x51\x4f\xc4\x5a\xd4\xd2\x4e\x0d\x57\x7c\x42\x72\xc2\x4a\x53\xe8\xf2\xe1\xcf\xf3\xd6\x0e\xc0\x34\xa6\xe6\x10\x70\x8c\x5c\x3d\x92\x1b\x12\x30\xa7\x44\xab\xc8\x01\xeb\x5e\xeb\x58\xb4\xbc\xdb\x91\xda\xd9\xec\xd3\x01\xa7\x47\xa7\xec\xee\x53\x36\xa9\xbf\xcd\x96\xa2\xc2\x88\xec\x93\x43\xfe\xb8\xf9\x2b\xaf\xcd\x18\xa3\xa7\xbc\xff\xa9\x44\x4c\x31\xfa\x3b\xac\xbf\x4b\xff\xed\xce\x7f\x10\xe3\x85\xc6\xdf\x9d\x7e\xf5\xfd\x11\xdf\xaf\xd7\x8c\xf7\x5a\xe9\x34\xdf\x6c\xfa\xa6\x69\x89\x47\x9c\x7d\xdc\x89\x95\xdd\xd0\x92\xe2\x6d\x5f\xed\xdb\x6e\xe7\xd5\x12\xd5\xba\xdd\xe7\xad\x74\xa4\x6b\x4f\xf7\xd5\x29\x2f\x4e\xcf\xd7\xaf\x96\xda\x14\xed\x09\x8d\xcd\xf6\x8b\xa4\x4d\x0f\x0f\xfd\x93\xca\xa0\xbe\x99\x96\xf5\x8f\x0d\x98\xdd\xc5\x3d\x22\xe4\x84\x99\xf2\xf3\x1b\xab\x2a\xe7\x66\xd3\xe7\x47\x0c\xf5\x4c\x69\x67\x5c\x47\x3a\x52\xe3\xe9\x3e\xe4\x87\xce\xef\x36\x85\x6f\x9f\xe0\x07\x51\x5e\x38\xc3\xdb\xe7\x5f\xa7\xf8\x91\x29\x7e\xfa\xdb\x1b\xef\xfa\xe6\xf5\xf5\xed\xbf\xfb\xfa\xf6\xe0\x01\xf2\xdc\xb3\xe2\x07\x7b\xbf\xca\xfd\x3d\xbe\x6f\x45\x78\xb1\xe7\xf7\x94\xbd\xfa\xfd\x7b\xfd\x5e\x96\x53\xbe\xba\xfd\x5f\x74\xfb\x5f\x03\x00\xb9\x5d\x32\x47\x4b\x16\x00\x00"),
 		},
 		"/dataform.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dataform.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1549,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x72\xda\x48\x10\xbd\xeb\x2b\x5e\xc1\x65\xb7\xca\x16\x36\x47\xed\x89\x60\x27\x51\xad\x03\x5b\x80\x37\x95\x53\xaa\x19\xb5\xa4\x59\x4b\x33\xb3\x33\x2d\x08\xe5\xe5\xdf\xb7\x84\x24\x62\xec\xa4\x2a\x3a\x50\xd0\xfd\xfa\xf5\xeb\x7e\x2d\xc6\x98\x5b\x77\xf0\xba\x28\x05\xd3\x9b\xe9\x14\x1f\xac\x2d\x2a\xc6\xc3\xc3\x3c\x1a\x47\x63\x3c\x68\xc5\x26\x70\x86\xc6\x64\xec\x21\x25\x63\xe6\x48\x95\x3c\x64\xae\xf0\x37\xfb\xa0\xad\xc1\x34\xbe\xc1\x6f\x2d\x60\xd4\xa7\x46\xbf\xff\x11\x8d\x71\xb0\x0d\x6a\x3a\xc0\x58\x41\x13\x18\x52\xea\x80\x5c\x57\x0c\xfe\xa6\xd8\x09\xb4\x81\xb2\xb5\xab\x34\x19\xc5\xd8\x6b\x29\x4f\x6d\x7a\x92\x38\x1a\xe3\x4b\x4f\x61\xb7\x42\xda\x80\xa0\xac\x3b\xc0\xe6\x2f\x71\x20\x39\x09\x3e\x3d\xa5\x88\x4b\x26\x93\xfd\x7e\x1f\xd3\x49\x6d\x6c\x7d\x31\xa9\x3a\x64\x98\x3c\xa4\xf3\xfb\xc5\xfa\xfe\x7a\x1a\xdf\x9c\x6a\x1e\x4d\xc5\x21\xc0\xf3\xbf\x8d\xf6\x9c\x61\x7b\x00\x39\x57\x69\x45\xdb\x8a\x51\xd1\x1e\xd6\x83\x0a\xcf\x9c\x41\x6c\x2b\x78\xef\xb5\x68\x53\x5c\x21\xd8\x5c\xf6\xe4\x39\x1a\x23\xd3\x41\xbc\xde\x36\x72\xb1\xad\x41\x9e\x0e\x17\x00\x6b\x40\x06\xa3\xd9\x1a\xe9\x7a\x84\x77\xb3\x75\xba\xbe\x8a\xc6\xf8\x9c\x6e\x3e\x2e\x1f\x37\xf8\x3c\x5b\xad\x66\x8b\x4d\x7a\xbf\xc6\x72\x85\xf9\x72\x71\x97\x6e\xd2\xe5\x62\x8d\xe5\x7b\xcc\x16\x5f\xf0\x67\xba\xb8\xbb\x02\x6b\x29\xd9\x83\xbf\x39\xdf\xea\xb7\x1e\xba\xdd\x23\x67\xed\xd2\xd6\xcc\x17\x02\x72\xdb\x09\x0a\x8e\x95\xce\xb5\x42\x45\xa6\x68\xa8\x60\x14\x76\xc7\xde\x68\x53\xc0\xb1\xaf\x75\x68\xdd\x0c\x20\x93\x45\x63\x54\xba\xd6\x42\x72\x8a\xbc\x19\x2a\x8e\x22\x72\xba\xf7\x3f\x81\xb2\x9e\x63\x65\x7c\x1d\xab\xca\x36\x59\x5c\x9c\x4e\x29\x56\xb6\x9e\xec\x6e\xa9\x72\x25\xdd\x46\x4f\xda\x64\x09\xd6\xec\x77\x5a\xf1\x27\x72\x4e\x9b\x22\xaa\x59\x28\x23\xa1\x24\x02\x0c\xd5\x9c\xa0\xfd\x95\xdb\x96\xe9\x47\x74\x3d\x2c\x38\x52\x9c\xa0\x85\x5c\x87\x43\x10\xae\xa3\x76\xb8\xef\x2c\x77\x3d\x4b\x04\xec\x06\x91\xbb\xdb\x2d\x0b\xdd\x46\x40\xe8\x44\x7c\xb4\x41\x16\x97\x4d\xbb\x4e\xe4\x74\xe8\xbb\x79\x0e\xb6\xf1\x8a\x43\xcb\x0d\x5c\xf7\xfc\x1d\xee\xeb\x50\xf7\xd5\xb3\xb3\x41\x8b\xf5\x87\xa8\xbb\xc3\x6e\xda\x41\xc6\xea\x75\x9a\x1a\xb1\x1f\xd8\xb0\x27\xe1\x2c\x81\xf8\x86\xfb\x8c\xce\x36\x5c\xbb\x8a\x84\x13\x8c\x9c\xb7\xff\xb0\x92\x30\x79\x7e\xee\xbf\x1e\x8f\x93\xca\xaa\xce\x96\xc9\xf3\xb3\xe7\x42\x5b\x73\x3c\x4e\xce\x02\x34\xb7\xf1\x56\xe5\xf1\x38\x7a\xc3\x39\x27\xf3\x8e\x1f\x03\x67\x1b\xfb\x89\x44\x95\xab\x7e\xbc\x6e\x0d\x39\x55\x61\xd0\x31\x0c\x3e\xdb\x91\xae\xda\xb7\x21\x35\xb3\x10\x58\x52\xb3\x63\xd3\x4e\x72\x09\x1f\x8c\xec\x8d\x4d\xfa\xf0\xe0\x47\xfb\xf9\x8a\x38\xbd\xfb\x0e\x12\xf2\x05\xcb\x7b\xcd\x55\x76\x81\x2d\x35\x7b\xf2\xaa\xd4\x8a\xaa\x15\xe7\xec\xd9\x9c\xad\xe8\xec\x90\x83\xe3\x04\xfd\x72\xce\x71\xe0\x89\x0f\xe7\xf0\x8a\xf3\x57\xbd\x7f\xc2\x95\xf7\x0a\x7e\x95\xae\x7d\x32\x0e\xca\x6b\x27\xa7\x13\xfb\xef\xfa\x45\x06\xd8\x94\x3c\x14\x41\x4a\x92\xee\x1f\x70\x10\x81\x2d\x57\xd6\x14\x01\x62\xe3\x17\x65\xc5\xee\x29\xb9\x60\xe9\x4e\xe9\xaf\x37\x9a\x7e\x78\xda\x2f\x78\xbc\x6d\x5c\x72\xee\x56\x93\xa1\x82\xfd\x4f\x5e\xab\xff\x03\x00\x00\xff\xff\x70\xd1\x4d\x46\x0d\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x41\x6f\xe2\x48\x13\xbd\xfb\x57\x3c\xc1\xe5\xfb\xa4\x60\x12\x8e\xde\x13\x43\x32\x33\xd6\x66\x60\x05\x64\x47\x73\x1a\x15\xed\xb2\xdd\x1b\xbb\xbb\xb7\xbb\x0c\x83\xb2\xfc\xf7\x95\xc1\x86\x90\xcc\x48\x8b\x11\x82\xaa\x57\xaf\x5e\xd5\xeb\x66\x88\x99\x75\x7b\xaf\x8b\x52\x30\xb9\x9d\x4c\xf0\xc9\xda\xa2\x62\x3c\x3e\xce\xa2\x61\x34\xc4\xa3\x56\x6c\x02\x67\x68\x4c\xc6\x1e\x52\x32\xa6\x8e\x54\xc9\x7d\xe6\x06\x7f\xb2\x0f\xda\x1a\x4c\xe2\x5b\xfc\xaf\x05\x0c\xba\xd4\xe0\xff\xbf\x45\x43\xec\x6d\x83\x9a\xf6\x30\x56\xd0\x04\x86\x94\x3a\x20\xd7\x15\x83\x7f\x28\x76\x02\x6d\xa0\x6c\xed\x2a\x4d\x46\x31\x76\x5a\x4a\xc8\x85\x3f\x8e\x86\xf8\xd6\x51\xd8\x8d\x90\x36\x20\x28\xeb\xf6\xb0\xf9\x6b\x1c\x48\x8e\x82\x8f\xaf\x52\xc4\x25\xe3\xf1\x6e\xb7\x8b\xe9\xa8\x36\xb6\xbe\x18\x57\x27\x64\x18\x3f\xa6\xb3\x87\xf9\xea\x61\x34\x89\x6f\x8f\x35\x4f\xa6\xe2\x10\xe0\xf9\xef\x46\x7b\xce\xb0\xd9\x83\x9c\xab\xb4\xa2\x4d\xc5\xa8\x68\x07\xeb\x41\x85\x67\xce\x20\xb6\x15\xbc\xf3\x5a\xb4\x29\x6e\x10\x6c\x2e\x3b\xf2\x1c\x0d\x91\xe9\x20\x5e\x6f\x1a\xb9\xda\x56\x2f\x4f\x87\x2b\x80\x35\x20\x83\xc1\x74\x85\x74\x35\xc0\x87\xe9\x2a\x5d\xdd\x44\x43\x7c\x4d\xd7\x9f\x17\x4f\x6b\x7c\x9d\x2e\x97\xd3\xf9\x3a\x7d\x58\x61\xb1\xc4\x6c\x31\xbf\x4f\xd7\xe9\x62\xbe\xc2\xe2\x23\xa6\xf3\x6f\xf8\x3d\x9d\xdf\xdf\x80\xb5\x94\xec\xc1\x3f\x9c\x6f\xf5\x5b\x0f\xdd\xee\x91\xb3\x76\x69\x2b\xe6\x2b\x01\xb9\x3d\xd9\x17\x1c\x2b\x9d\x6b\x85\x8a\x4c\xd1\x50\xc1\x28\xec\x96\xbd\xd1\xa6\x80\x63\x5f\xeb\xd0\xba\x19\x40\x26\x8b\x86\xa8\x74\xad\x85\xe4\x18\x79\x37\x54\x1c\x45\xe4\x74\xe7\x7f\x02\x65\x3d\xc7\xca\xf8\x3a\x56\x95\x6d\xb2\xb8\x38\x1e\xa5\x58\xd9\x7a\xbc\xbd\xa3\xca\x95\x74\x17\x3d\x6b\x93\x25\x58\xb1\xdf\x6a\xc5\x5f\xc8\x39\x6d\x8a\xa8\x66\xa1\x8c\x84\x92\x08\x30\x54\x73\x82\xf6\x57\x6e\x7d\xfd\x73\xba\x0e\x16\x1c\x29\x4e\xd0\x76\x1c\x85\x7d\x10\xae\xa3\x76\xb8\x0b\xcb\x7d\xc7\x12\x01\xdb\x5e\xe4\xf6\x6e\xc3\x42\x77\x11\x10\x4e\x22\x3e\xdb\x20\xf3\xeb\xa6\xa7\x4e\xe4\x74\xe8\xba\x79\x0e\xb6\xf1\x8a\x43\xcb\x0d\x8c\x3a\xfe\x13\xee\x7b\x5f\xf7\xdd\xb3\xb3\x41\x8b\xf5\xfb\x23\x0c\x38\x4d\xdb\xcb\x58\xbe\x4d\x53\x23\xf6\x13\x1b\xf6\x24\x9c\x25\x10\xdf\x70\x97\xd1\xd9\x9a\x6b\x57\x91\x70\x82\x81\xf3\xf6\x2f\x56\x12\xc6\x2f\x2f\xdd\xd7\xc3\x61\x5c\x59\x75\xb2\x65\xfc\xf2\xe2\xb9\xd0\xd6\x1c\x0e\xe3\xb3\x00\xcd\x2d\xba\x55\x79\x38\x0c\xde\x71\xce\xc8\x7c\xe0\xa7\xc0\xd9\xda\x7e\x21\x51\xe5\xb2\x1b\xef\xb4\x86\x9c\xaa\xd0\xeb\xe8\x07\x9f\x6e\x49\x57\xed\x6d\x48\xcd\x34\x04\x96\xd4\x6c\xd9\xb4\x83\x5e\xc3\x7b\x23\x3b\x63\x93\x2e\xdc\xfb\xd1\x7e\xbe\x21\x4e\xef\x2f\x20\x21\x5f\xb0\x7c\xd4\x5c\x65\x57\xd8\x52\xb3\x27\xaf\x4a\xad\xa8\x5a\x72\xce\x9e\xcd\xd9\x8a\xf6\x3d\x82\xec\x1d\x27\xe8\x96\x73\x8e\x03\xcf\xbc\x3f\x87\x97\x9c\xbf\xe9\xfd\x0b\xae\xbc\x53\xf0\x5f\xe9\xda\x27\xe3\xa0\xbc\x76\xad\x21\x09\xfe\x19\xbd\xca\x00\xeb\x92\xfb\x22\x48\x49\x72\xfa\x07\xec\x45\x60\xc3\x95\x35\x45\x80\xd8\xf8\x55\x59\xb1\x7d\xbe\x88\xba\x1c\xa5\x3f\xde\x69\xfa\xe9\xd1\xbe\x3c\x85\xb7\x8d\x4b\xce\xdd\x6a\x32\x54\xb0\xff\xc5\xb5\xfa\x77\x00\x70\xd1\x4d\x46\x0d\x06\x00\x00"),
 		},
 		"/datafusion.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "datafusion.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1817,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x53\xe3\x46\x10\xbd\xeb\x57\x74\xd9\x97\xa4\x0a\x64\xe0\xa8\x9c\xbc\x06\x76\x55\x01\x93\xb2\x4d\xb6\xf6\x44\xb5\x47\x2d\xa9\xc3\x68\x66\x32\xd3\xb2\xd7\x45\xfc\xdf\x53\xfa\xc2\x36\xb0\xb5\xab\x03\xd8\xfd\xf1\xde\x9b\xd7\x3d\xf2\x18\x66\xd6\xed\x3c\x17\xa5\xc0\xd5\xc5\xd5\x15\x7c\xb6\xb6\xd0\x04\x77\x77\xb3\x68\x1c\x8d\xe1\x8e\x15\x99\x40\x19\xd4\x26\x23\x0f\x52\x12\x4c\x1d\xaa\x92\x86\xcc\x19\xfc\x4d\x3e\xb0\x35\x70\x15\x5f\xc0\x6f\x4d\xc1\xa8\x4f\x8d\x7e\xff\x23\x1a\xc3\xce\xd6\x50\xe1\x0e\x8c\x15\xa8\x03\x81\x94\x1c\x20\x67\x4d\x40\xdf\x15\x39\x01\x36\xa0\x6c\xe5\x34\xa3\x51\x04\x5b\x96\xb2\xa5\xe9\x41\xe2\x68\x0c\xdf\x7a\x08\xbb\x16\x64\x03\x08\xca\xba\x1d\xd8\xfc\xb8\x0e\x50\x5a\xc1\xed\x53\x8a\xb8\x64\x32\xd9\x6e\xb7\x31\xb6\x6a\x63\xeb\x8b\x89\xee\x2a\xc3\xe4\x2e\x9d\xdd\xcc\x97\x37\xe7\x57\xf1\x45\xdb\xf3\x68\x34\x85\x00\x9e\xfe\xad\xd9\x53\x06\xeb\x1d\xa0\x73\x9a\x15\xae\x35\x81\xc6\x2d\x58\x0f\x58\x78\xa2\x0c\xc4\x36\x82\xb7\x9e\x85\x4d\x71\x06\xc1\xe6\xb2\x45\x4f\xd1\x18\x32\x0e\xe2\x79\x5d\xcb\x89\x5b\x83\x3c\x0e\x27\x05\xd6\x00\x1a\x18\x4d\x97\x90\x2e\x47\xf0\x69\xba\x4c\x97\x67\xd1\x18\xbe\xa6\xab\x2f\x0f\x8f\x2b\xf8\x3a\x5d\x2c\xa6\xf3\x55\x7a\xb3\x84\x87\x05\xcc\x1e\xe6\xd7\xe9\x2a\x7d\x98\x2f\xe1\xe1\x16\xa6\xf3\x6f\xf0\x67\x3a\xbf\x3e\x03\x62\x29\xc9\x03\x7d\x77\xbe\xd1\x6f\x3d\x70\xe3\x23\x65\x8d\x69\x4b\xa2\x13\x01\xb9\xed\x04\x05\x47\x8a\x73\x56\xa0\xd1\x14\x35\x16\x04\x85\xdd\x90\x37\x6c\x0a\x70\xe4\x2b\x0e\xcd\x34\x03\xa0\xc9\xa2\x31\x68\xae\x58\x50\xda\xc8\xbb\x43\xc5\x51\x84\x8e\xfb\xf9\x27\xa0\xac\xa7\x58\x19\x5f\xc5\x4a\xdb\x3a\x8b\x8b\x76\x95\x62\x65\xab\xc9\xe6\x12\xb5\x2b\xf1\x32\x7a\x66\x93\x25\xb0\x24\xbf\x61\x45\xf7\xe8\x1c\x9b\x22\xaa\x48\x30\x43\xc1\x24\x02\x30\x58\x51\x02\xcd\xb7\xbc\x6e\x60\x3f\x06\xec\x0b\x83\x43\x45\x09\x34\x25\xe7\x61\x17\x84\xaa\xa8\x39\xde\x01\xe7\x1a\x05\x6f\x5b\x9c\x08\x60\x33\x08\xdd\x5c\xae\x49\xf0\x32\x02\x08\x9d\x90\x2f\x36\xc8\xfc\x2d\x71\xc7\x86\x8e\x43\xcf\xe8\x29\xd8\xda\x2b\x0a\x0d\x3e\xc0\x79\xcf\xd1\xd5\x3d\x35\x9d\x4f\x5d\xeb\x13\x9b\x20\xcd\x36\x47\xdd\x3a\x76\x87\x3e\x68\x49\x4f\xd3\x58\x8b\xfd\x4c\x86\x3c\x0a\x65\x09\x88\xaf\x87\x0c\x63\x35\xb3\x26\xe7\x22\xe9\x03\x00\xce\x6a\x56\xbb\xf9\xcf\x98\x9f\x18\xab\xa7\xae\xf6\x4d\xeb\x3d\x55\x6b\xf2\xbf\x06\x50\xb5\xb5\xaf\x00\x9e\x72\xf2\x64\x14\xdd\x32\xe9\xec\xa0\x69\x70\xbb\xf9\x7b\x14\x94\x9d\x7b\x1b\x0c\xb5\x73\xd6\x4b\x98\x59\x93\x71\xbb\x55\x09\xe4\xa8\xc3\xeb\x81\xb3\x15\x55\x4e\xa3\x50\x02\x23\xe7\xed\x3f\xa4\x24\x4c\x5e\x5e\xfa\x8f\xfb\xfd\x44\x5b\xd5\xad\xe3\xe4\xe5\xc5\x53\xc1\xd6\xec\xf7\x93\x41\x74\x13\x6c\xf8\xf6\xfb\xd1\x3b\xc0\x19\x9a\x4f\xf4\x18\x28\x5b\xd9\x7b\x14\x55\x2e\xfa\x69\x76\x4e\x1c\x8b\x18\xe6\x3c\xdd\x20\xeb\xe6\x15\x90\x9a\x69\x08\x24\xa9\xd9\x90\x11\xeb\x77\xa7\xe5\xc3\xf6\xf6\xdb\x7c\xb0\xe5\x03\x53\x34\xae\x49\x87\xa4\xff\xff\x86\x2f\xbd\x3e\xf4\x0a\xfa\x82\xa4\xf3\xf9\x18\xa2\x64\xf2\xe8\x55\xc9\x0a\xf5\x62\x18\x47\x38\xf4\x9d\xf7\xae\xf7\x86\x1d\x4d\xe3\x99\x76\xaf\xe1\x05\xe5\x6f\xb8\x7f\x80\x95\xf7\x0a\x7e\x15\xae\x79\x32\x0a\xca\xb3\x93\xf6\xaa\xfd\x77\x7e\x94\x01\x58\x95\x34\x34\x81\x94\x28\xdd\xaf\xc1\x20\x02\xd6\xa4\xad\x29\x02\x88\x8d\x8f\xda\x8a\xcd\x73\x72\x82\xd2\xdd\xa7\xbf\xde\x69\xfa\xf0\x8a\x1f\xe1\x78\x5b\xbb\xe4\x95\xad\x42\x83\x05\xf9\x1f\xbc\x60\xfe\x0f\x00\x00\xff\xff\x53\x09\xa0\x3c\x19\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x95\xcf\x72\xdb\x36\x10\xc6\xef\x7c\x8a\x1d\xe9\xd2\xce\xd8\x54\xec\x23\x7b\x52\x64\x3b\xe1\xd4\x96\x3b\x92\xdc\x4c\x4e\x9e\x15\xb8\x24\xb7\x06\x01\x14\x58\x4a\xd1\xb8\x7a\xf7\x0e\x44\xd2\x92\xed\xa4\x8d\xe9\xd1\x9f\xc5\x87\x6f\x7f\xd8\x5d\x52\x63\x98\x59\xb7\xf3\x5c\xd5\x02\x97\x1f\x2e\x2f\xe1\x93\xb5\x95\x26\xb8\xbd\x9d\x25\xe3\x64\x0c\xb7\xac\xc8\x04\x2a\xa0\x35\x05\x79\x90\x9a\x60\xea\x50\xd5\x34\xac\x9c\xc1\x9f\xe4\x03\x5b\x03\x97\xe9\x07\xf8\x25\x0a\x46\xfd\xd2\xe8\xd7\xdf\x92\x31\xec\x6c\x0b\x0d\xee\xc0\x58\x81\x36\x10\x48\xcd\x01\x4a\xd6\x04\xf4\x4d\x91\x13\x60\x03\xca\x36\x4e\x33\x1a\x45\xb0\x65\xa9\x41\x8e\xfe\x69\x32\x86\xaf\xbd\x85\x5d\x0b\xb2\x01\x04\x65\xdd\x0e\x6c\x79\xaa\x03\x94\x03\xf0\xe1\xaf\x16\x71\xd9\x64\xb2\xdd\x6e\x53\x3c\xd0\xa6\xd6\x57\x13\xdd\x29\xc3\xe4\x36\x9f\x5d\xcf\x97\xd7\xe7\x97\xe9\x87\xc3\x9e\x07\xa3\x29\x04\xf0\xf4\x77\xcb\x9e\x0a\x58\xef\x00\x9d\xd3\xac\x70\xad\x09\x34\x6e\xc1\x7a\xc0\xca\x13\x15\x20\x36\x02\x6f\x3d\x0b\x9b\xea\x0c\x82\x2d\x65\x8b\x9e\x92\x31\x14\x1c\xc4\xf3\xba\x95\x57\xd5\x1a\xf0\x38\xbc\x12\x58\x03\x68\x60\x34\x5d\x42\xbe\x1c\xc1\xc7\xe9\x32\x5f\x9e\x25\x63\xf8\x92\xaf\x3e\xdf\x3f\xac\xe0\xcb\x74\xb1\x98\xce\x57\xf9\xf5\x12\xee\x17\x30\xbb\x9f\x5f\xe5\xab\xfc\x7e\xbe\x84\xfb\x1b\x98\xce\xbf\xc2\xef\xf9\xfc\xea\x0c\x88\xa5\x26\x0f\xf4\xcd\xf9\xc8\x6f\x3d\x70\xac\x23\x15\xb1\x68\x4b\xa2\x57\x00\xa5\xed\xda\x17\x1c\x29\x2e\x59\x81\x46\x53\xb5\x58\x11\x54\x76\x43\xde\xb0\xa9\xc0\x91\x6f\x38\xc4\x6e\x06\x40\x53\x24\x63\xd0\xdc\xb0\xa0\x1c\x22\xef\x0e\x95\x26\x09\x3a\xee\xfb\x9f\x81\xb2\x9e\x52\x65\x7c\x93\x2a\x6d\xdb\x22\xad\x0e\xa3\x94\x2a\xdb\x4c\x36\x17\xa8\x5d\x8d\x17\xc9\x13\x9b\x22\x83\x25\xf9\x0d\x2b\xba\x43\xe7\xd8\x54\x49\x43\x82\x05\x0a\x66\x09\x80\xc1\x86\x32\x88\xdf\xca\x36\x82\x7c\xdf\xb0\x17\x06\x87\x8a\x32\x88\x92\xf3\xb0\x0b\x42\x4d\x12\x8f\x77\xf4\xb9\x42\xc1\x9b\x83\x4f\x02\xb0\x19\x40\x37\x17\x6b\x12\xbc\x48\x00\x42\x07\xf2\xd9\x06\x99\xbf\x4d\xdc\x65\x43\xc7\xa1\xcf\xe8\x29\xd8\xd6\x2b\x0a\xd1\x1f\xe0\xbc\xcf\xd1\xe9\x1e\x23\xf2\x63\xc7\xfc\xc8\x26\x48\x9c\xe6\x83\x0e\xa0\x3b\xf4\x91\x25\x7f\xbd\x8c\xad\xd8\x4f\x64\xc8\xa3\x50\x91\x81\xf8\x76\x58\x61\x6c\x66\xd6\x94\x5c\x65\x7d\x00\xc0\x59\xcd\x6a\x37\xff\xbf\xcc\x8f\x8c\xcd\x63\xa7\x7d\xb3\xf5\x8e\x9a\x35\xf9\x9f\x33\x68\x0e\xda\x17\x03\x4f\x25\x79\x32\x8a\x6e\x98\x74\x71\x64\x1a\xaa\x1d\x5f\x4f\x82\xb2\x73\x6f\x83\xa1\x75\xce\x7a\x09\x33\x6b\x0a\x3e\x4c\x55\x06\x25\xea\x30\x28\xb8\x58\x51\xe3\x34\x0a\x65\x30\x72\xde\xfe\x45\x4a\xc2\xe4\xf9\xb9\xff\xb8\xdf\x4f\xb4\x55\xdd\x38\x4e\x9e\x9f\x3d\x55\x6c\xcd\x7e\x3f\x19\xa0\xa3\x34\x42\xec\xf7\xa3\x77\x86\x33\x34\x1f\xe9\x21\x50\xb1\xb2\x77\x28\xaa\x5e\xf4\xdd\xec\x2a\x71\x0a\x31\xf4\x79\xba\x41\xd6\xf1\x11\x90\x9b\x69\x08\x24\xb9\xd9\x90\x11\xeb\x77\xaf\xe5\xc3\xf4\xf6\xd3\x9c\x25\xff\x51\x14\x8d\x6b\xd2\x21\xeb\xdf\xdf\xe4\xcb\xaf\x8e\x7b\x05\x7d\x45\xd2\xd5\xf9\xd4\xa2\x66\xf2\xe8\x55\xcd\x0a\xf5\x62\x68\x47\x3f\x90\xf1\xff\xbc\xaf\x7a\x5f\xb0\x97\x38\xc0\x13\xed\x5e\xc2\x0b\x2a\xdf\xe4\xfe\x81\x57\xd9\x13\xfc\xac\x5d\xbc\x0a\x0a\xca\xb3\x8b\x4d\xca\xe0\x9f\xf3\x93\x15\x80\x55\x4d\xc3\x26\x90\x1a\xa5\xfb\x35\x18\x20\x60\x4d\xda\x9a\x2a\x80\xd8\xf4\x64\x5b\xb5\x79\x3a\x42\x1d\xef\xa7\x3f\xde\x31\x7d\xf7\x16\x3f\x5e\x95\xb7\xad\xcb\x5e\xb2\x35\x68\xb0\x22\xff\x83\x07\xcc\xbf\x03\x00\x53\x09\xa0\x3c\x19\x07\x00\x00"),
 		},
 		"/datalossprevention.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "datalossprevention.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2295,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\x41\x6f\xdb\x46\x10\x85\xef\xfc\x15\x03\xe9\xd2\x02\x36\x65\xfb\xc8\x9e\x14\xc9\x49\xd8\x2a\x52\x61\xca\x0d\x72\x12\x46\xdc\x21\x39\x29\xb9\xbb\xdd\x1d\x4a\x21\x0c\xff\xf7\x62\x49\xca\xae\xe2\x16\x49\xd0\x53\x10\x9d\xa4\xdd\x37\x6f\xde\x7c\x3b\x9a\xc2\xc2\xd8\xce\x71\x59\x09\xdc\x5c\xdd\xdc\xc0\x1b\x63\xca\x9a\x60\xb5\x5a\x44\xd3\x68\x0a\x2b\xce\x49\x7b\x52\xd0\x6a\x45\x0e\xa4\x22\x98\x5b\xcc\x2b\x3a\xdd\x5c\xc0\x1f\xe4\x3c\x1b\x0d\x37\xf1\x15\xfc\x14\x04\x93\xf1\x6a\xf2\xf3\x2f\xd1\x14\x3a\xd3\x42\x83\x1d\x68\x23\xd0\x7a\x02\xa9\xd8\x43\xc1\x35\x01\x7d\xca\xc9\x0a\xb0\x86\xdc\x34\xb6\x66\xd4\x39\xc1\x91\xa5\xea\xdb\x8c\x26\x71\x34\x85\x0f\xa3\x85\xd9\x0b\xb2\x06\x84\xdc\xd8\x0e\x4c\xf1\x4f\x1d\xa0\xf4\x81\xfb\x4f\x25\x62\x93\xd9\xec\x78\x3c\xc6\xd8\xa7\x8d\x8d\x2b\x67\xf5\xa0\xf4\xb3\x55\xba\xb8\x5d\x67\xb7\x97\x37\xf1\x55\x5f\x73\xaf\x6b\xf2\x1e\x1c\xfd\xd5\xb2\x23\x05\xfb\x0e\xd0\xda\x9a\x73\xdc\xd7\x04\x35\x1e\xc1\x38\xc0\xd2\x11\x29\x10\x13\x02\x1f\x1d\x0b\xeb\xf2\x02\xbc\x29\xe4\x88\x8e\xa2\x29\x28\xf6\xe2\x78\xdf\xca\x19\xad\x53\x3c\xf6\x67\x02\xa3\x01\x35\x4c\xe6\x19\xa4\xd9\x04\x5e\xcd\xb3\x34\xbb\x88\xa6\xf0\x3e\xdd\xbe\xdd\xdc\x6f\xe1\xfd\xfc\xee\x6e\xbe\xde\xa6\xb7\x19\x6c\xee\x60\xb1\x59\x2f\xd3\x6d\xba\x59\x67\xb0\x79\x0d\xf3\xf5\x07\xf8\x2d\x5d\x2f\x2f\x80\x58\x2a\x72\x40\x9f\xac\x0b\xf9\x8d\x03\x0e\x1c\x49\x05\x68\x19\xd1\x59\x80\xc2\x0c\x81\xbc\xa5\x9c\x0b\xce\xa1\x46\x5d\xb6\x58\x12\x94\xe6\x40\x4e\xb3\x2e\xc1\x92\x6b\xd8\x87\xd7\xf4\x80\x5a\x45\x53\xa8\xb9\x61\x41\xe9\x4f\x5e\x0c\x15\x47\x11\x5a\x1e\xdf\x3f\x81\xdc\x38\x8a\x73\xed\x9a\x38\xaf\x4d\xab\xe2\xb2\x5f\xa5\x38\x37\xcd\xec\x70\x8d\xb5\xad\xf0\x3a\xfa\x93\xb5\x4a\x20\x23\x77\xe0\x9c\xde\xa1\xb5\xac\xcb\xa8\x21\x41\x85\x82\x49\x04\xa0\xb1\xa1\x04\xc2\xaf\xda\x78\x6f\x1d\x1d\x48\x87\xf6\xff\x6e\x3c\x16\x78\x8b\x39\x25\x10\x24\x97\xbe\xf3\x42\x4d\x14\xc6\x7c\xf6\x5b\xa2\xe0\xca\x78\xff\xfb\x93\x5f\x04\x70\x38\x05\x3f\x5c\xef\x49\xf0\x3a\x02\xf0\x43\xb0\xb7\xc6\xcb\xfa\xbf\x82\x0c\xdd\xd1\xb2\x1f\x13\x38\xf2\xa6\x75\x39\xf9\xd0\x0f\xe0\x72\xec\x39\xe8\x76\xc1\x61\x17\x2c\x76\xcf\x1e\x3b\x45\xac\xc2\xd7\xa2\xdb\x09\x35\xb6\x46\xa1\x68\x58\xdd\x01\xd0\xcb\xbc\xcb\xa7\x8a\xed\x79\x01\xb6\x62\xde\x90\x26\x87\x42\x2a\x01\x71\xed\xe9\x86\xd5\x49\x9a\xc0\xe4\xe1\xc1\xa2\x23\x2d\x8f\x8f\x33\xf5\xc2\xca\xcf\x1e\x1e\x42\xe8\xc7\xc7\xc9\x8b\xda\x05\xea\x57\x74\xef\x49\x6d\xcd\x3b\x94\xbc\xba\x1b\xa7\x1d\xf8\x14\x58\xfb\x53\xbf\x13\x87\xf9\x01\xb9\x0e\x7f\x9d\x54\xcf\xbd\x27\x49\x75\x98\xc1\xb8\xee\x5c\x1e\x58\x93\x7b\x8a\x9e\x2e\x5f\x33\xd5\x2a\xe9\xe9\x7d\xe6\x98\x2e\x93\xf1\x04\x40\xd0\x95\x24\x9f\x6b\xbf\x06\x3a\xeb\xb0\x14\xf2\xb5\xc4\xd3\x41\xfe\xbf\x71\xf3\xb9\xcf\x8f\xc1\xfa\xa3\xd9\xef\xc4\x71\x59\x92\xfb\x02\xe6\x5f\xcd\x7e\x7b\x26\xfc\x76\xc2\x1f\x9f\x2c\x7e\x0c\xb8\x5e\x8c\x23\xb5\x63\x5d\x98\x9d\x74\xf6\x4b\x8b\x9c\xf5\xf2\x54\x17\x66\xfb\x2c\xfe\x76\xca\xfe\xcc\xe6\xfb\x26\xfd\x77\x00\x00\x00\xff\xff\xa9\xf9\xe5\xc5\xf7\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\x4f\x6f\xdb\x46\x10\xc5\xef\xfc\x14\x0f\xd2\xa5\x05\x6c\xca\xf6\x91\x3d\x29\x96\x93\xb0\x75\xa4\xc2\x94\x1b\xe4\x24\x8c\xc8\x11\x35\x29\xb9\xbb\xdd\x1d\x4a\x21\x0c\x7f\xf7\x62\xf5\xc7\xae\xe2\x16\x49\x7a\x0b\xc2\x13\xb9\xfb\xe6\xcd\x9b\xdf\x2e\x87\xb8\xb6\xae\xf7\x52\xaf\x15\x57\x17\x57\x57\x78\x63\x6d\xdd\x30\x6e\x6f\xaf\x93\x61\x32\xc4\xad\x94\x6c\x02\x57\xe8\x4c\xc5\x1e\xba\x66\x8c\x1d\x95\x6b\x3e\xee\x9c\xe1\x0f\xf6\x41\xac\xc1\x55\x7a\x81\x9f\xa2\x60\x70\xd8\x1a\xfc\xfc\x4b\x32\x44\x6f\x3b\xb4\xd4\xc3\x58\x45\x17\x18\xba\x96\x80\x95\x34\x0c\xfe\x54\xb2\x53\x88\x41\x69\x5b\xd7\x08\x99\x92\xb1\x15\x5d\x43\x9f\xfd\xd3\x64\x88\x0f\x07\x0b\xbb\x54\x12\x03\x42\x69\x5d\x0f\xbb\xfa\xa7\x0e\xa4\xbb\xc0\xbb\x67\xad\xea\xb2\xd1\x68\xbb\xdd\xa6\xb4\x4b\x9b\x5a\x5f\x8f\x9a\xbd\x32\x8c\x6e\xf3\xeb\x9b\x69\x71\x73\x7e\x95\x5e\xec\x6a\xee\x4d\xc3\x21\xc0\xf3\x5f\x9d\x78\xae\xb0\xec\x41\xce\x35\x52\xd2\xb2\x61\x34\xb4\x85\xf5\xa0\xda\x33\x57\x50\x1b\x03\x6f\xbd\xa8\x98\xfa\x0c\xc1\xae\x74\x4b\x9e\x93\x21\x2a\x09\xea\x65\xd9\xe9\x09\xad\x63\x3c\x09\x27\x02\x6b\x40\x06\x83\x71\x81\xbc\x18\xe0\xd5\xb8\xc8\x8b\xb3\x64\x88\xf7\xf9\xfc\xed\xec\x7e\x8e\xf7\xe3\xbb\xbb\xf1\x74\x9e\xdf\x14\x98\xdd\xe1\x7a\x36\x9d\xe4\xf3\x7c\x36\x2d\x30\x7b\x8d\xf1\xf4\x03\x7e\xcb\xa7\x93\x33\xb0\xe8\x9a\x3d\xf8\x93\xf3\x31\xbf\xf5\x90\xc8\x91\xab\x08\xad\x60\x3e\x09\xb0\xb2\xfb\xe3\x0b\x8e\x4b\x59\x49\x89\x86\x4c\xdd\x51\xcd\xa8\xed\x86\xbd\x11\x53\xc3\xb1\x6f\x25\xc4\xd3\x0c\x20\x53\x25\x43\x34\xd2\x8a\x92\xee\x56\x5e\x0c\x95\x26\x09\x39\x39\x9c\x7f\x86\xd2\x7a\x4e\x4b\xe3\xdb\xb4\x6c\x6c\x57\xa5\xf5\xee\x2a\xa5\xa5\x6d\x47\x9b\x4b\x6a\xdc\x9a\x2e\x93\x3f\xc5\x54\x19\x0a\xf6\x1b\x29\xf9\x1d\x39\x27\xa6\x4e\x5a\x56\xaa\x48\x29\x4b\x00\x43\x2d\x67\x88\x5f\x8d\x0d\xc1\x79\xde\xb0\x89\xed\xff\xdd\xf8\x50\x10\x1c\x95\x9c\x21\x4a\xce\x43\x1f\x94\xdb\x24\x8e\xf9\xec\x37\x21\xa5\x5b\x1b\xc2\xef\x4f\x7e\x09\xb0\x39\x06\xdf\x5c\x2e\x59\xe9\x32\x01\xc2\x3e\xd8\x5b\x1b\x74\xfa\x5f\x41\xf6\xdd\xc9\x49\x38\x24\xf0\x1c\x6c\xe7\x4b\x0e\xb1\x1f\x70\x7e\xe8\xb9\xd7\x2d\xe2\x28\x8b\x38\xcb\xe2\xd9\x63\x51\xb1\x54\xf1\x75\xd5\x2f\x94\x5b\xd7\x90\xf2\xae\x16\xd8\x03\x7a\x99\x77\xf2\x54\x31\x3f\x2d\xa0\x4e\xed\x1b\x36\xec\x49\xb9\xca\xa0\xbe\x3b\xee\x48\x75\x94\x66\x18\x3c\x3c\x38\xf2\x6c\xf4\xf1\x71\x54\xbd\xb0\x0a\xa3\x87\x87\x18\xfa\xf1\x71\xf0\xa2\xf6\x9a\xcc\x2b\xbe\x0f\x5c\xcd\xed\x3b\xd2\x72\x7d\x77\x98\x76\xcf\x67\x45\x4d\x38\xf6\x3b\x72\x18\x6f\x48\x9a\xf8\xeb\xe4\x66\x1c\x02\x6b\x6e\x22\x3a\xeb\xfb\x53\x79\x64\xcd\xfe\x29\x7a\x3e\x79\x2d\xdc\x54\xd9\x8e\xde\x67\x8e\xf9\x24\x3b\xac\x00\x4a\xbe\x66\xfd\x5c\xfb\x35\xd0\xc5\xc4\x4b\xa1\x5f\x4b\x3c\xdf\xcb\xe7\xa7\xea\x6f\xc7\x2d\xa7\x3e\x3f\x06\xeb\x8f\x76\xb9\x50\x2f\x75\xcd\xfe\x0b\x98\x7f\xb5\xcb\xf9\x89\xf0\xdb\x09\x7f\x7c\xb2\xf8\x31\xe0\x06\xb5\x9e\xab\x85\x98\x95\x5d\x68\xef\xf8\x0b\x84\x8b\x9d\x3c\x37\x2b\x3b\xef\xdd\xff\xbf\xc7\xe1\xc4\xe6\xfb\x26\xfd\xf7\x00\xa9\xf9\xe5\xc5\xf7\x08\x00\x00"),
 		},
 		"/dataplex.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dataplex.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 817,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6f\xdb\x38\x10\x85\xef\xfc\x15\x0f\xd6\x65\x17\x70\xe4\xd8\x47\xef\xc9\xeb\xa4\x8d\x50\x57\x06\x22\xa7\x41\x8e\x63\x6a\x2c\x0d\x2a\x91\x2c\x49\x59\xf1\xbf\x2f\x24\x2b\x68\x8d\x56\x37\x71\x1e\xdf\xfb\x66\x86\x09\xb6\xd6\x5d\xbc\x54\x75\xc4\xea\x7e\xb5\xc2\x67\x6b\xab\x86\xb1\xdb\x6d\x55\xa2\x12\xec\x44\xb3\x09\x5c\xa2\x33\x25\x7b\xc4\x9a\xb1\x71\xa4\x6b\xfe\xa8\xcc\xf1\x8d\x7d\x10\x6b\xb0\x4a\xef\xf1\xcf\x20\x98\x4d\xa5\xd9\xbf\xff\xa9\x04\x17\xdb\xa1\xa5\x0b\x8c\x8d\xe8\x02\x23\xd6\x12\x70\x92\x86\xc1\xef\x9a\x5d\x84\x18\x68\xdb\xba\x46\xc8\x68\x46\x2f\xb1\x1e\x63\x26\x93\x54\x25\x78\x9b\x2c\xec\x31\x92\x18\x10\xb4\x75\x17\xd8\xd3\xef\x3a\x50\x1c\x81\xc7\xaf\x8e\xd1\xad\x17\x8b\xbe\xef\x53\x1a\x69\x53\xeb\xab\x45\x73\x55\x86\xc5\x2e\xdb\x3e\xe6\xc5\xe3\xdd\x2a\xbd\x1f\xef\xbc\x98\x86\x43\x80\xe7\x1f\x9d\x78\x2e\x71\xbc\x80\x9c\x6b\x44\xd3\xb1\x61\x34\xd4\xc3\x7a\x50\xe5\x99\x4b\x44\x3b\x00\xf7\x5e\xa2\x98\x6a\x8e\x60\x4f\xb1\x27\xcf\x2a\x41\x29\x21\x7a\x39\x76\xf1\x66\x5a\x1f\x78\x12\x6e\x04\xd6\x80\x0c\x66\x9b\x02\x59\x31\xc3\xff\x9b\x22\x2b\xe6\x2a\xc1\x6b\x76\x78\xda\xbf\x1c\xf0\xba\x79\x7e\xde\xe4\x87\xec\xb1\xc0\xfe\x19\xdb\x7d\xfe\x90\x1d\xb2\x7d\x5e\x60\xff\x09\x9b\xfc\x0d\x5f\xb2\xfc\x61\x0e\x96\x58\xb3\x07\xbf\x3b\x3f\xf0\x5b\x0f\x19\xe6\xc8\xe5\x30\xb4\x82\xf9\x06\xe0\x64\xaf\x40\xc1\xb1\x96\x93\x68\x34\x64\xaa\x8e\x2a\x46\x65\xcf\xec\x8d\x98\x0a\x8e\x7d\x2b\x61\xd8\x66\x00\x99\x52\x25\x68\xa4\x95\x48\x71\x3c\xf9\xa3\xa9\x54\x29\x72\x32\xed\x7f\x0d\x6d\x3d\xa7\xda\xf8\x36\xd5\x8d\xed\xca\xb4\x1a\x9f\x52\xaa\x6d\xbb\x38\x2f\xa9\x71\x35\x2d\xd5\x77\x31\xe5\x1a\x05\xfb\xb3\x68\xfe\x4a\xce\x89\xa9\x54\xcb\x91\x4a\x8a\xb4\x56\x80\xa1\x96\xd7\x18\xfe\x5c\xc3\xef\x7f\xb7\x9b\x64\xc1\x91\xe6\x35\x06\xc9\x5d\xb8\x84\xc8\xad\x1a\x9a\xfb\xe5\xf2\x30\xb9\x28\xe0\xfc\x01\x79\x5e\x1e\x39\xd2\x52\x01\xe1\x0a\xf1\x64\x43\xcc\x6f\x43\xaf\x49\xe4\x24\x4c\x69\x9e\x83\xed\xbc\xe6\xb0\x56\x3f\x03\x00\x00\xff\xff\x8c\xd4\x87\xaa\x31\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x6e\xdb\x3c\x10\xc4\xef\x7c\x8a\x81\x75\xf9\x3e\xc0\x91\x63\x1f\xd5\x93\xeb\xa4\x8d\x50\x57\x06\x22\xa7\x41\x8e\x6b\x6a\x2d\x2d\x2a\x91\x2c\x49\x59\xf1\xdb\x17\xf2\x1f\xa4\x46\xab\x9b\xb8\xc3\xd9\xdf\xee\x30\xc1\xca\xba\xa3\x97\xba\x89\x58\xdc\x2f\x16\xf8\x6a\x6d\xdd\x32\xd6\xeb\x95\x4a\x54\x82\xb5\x68\x36\x81\x2b\xf4\xa6\x62\x8f\xd8\x30\x96\x8e\x74\xc3\xd7\xca\x14\x3f\xd8\x07\xb1\x06\x8b\xf4\x1e\xff\x8d\x82\xc9\xa5\x34\xf9\xff\x93\x4a\x70\xb4\x3d\x3a\x3a\xc2\xd8\x88\x3e\x30\x62\x23\x01\x7b\x69\x19\xfc\xae\xd9\x45\x88\x81\xb6\x9d\x6b\x85\x8c\x66\x0c\x12\x1b\xc4\x0f\xff\x54\x25\x78\xbb\x58\xd8\x5d\x24\x31\x20\x68\xeb\x8e\xb0\xfb\x3f\x75\xa0\x78\x02\x3e\x7d\x4d\x8c\x2e\x9b\xcd\x86\x61\x48\xe9\x44\x9b\x5a\x5f\xcf\xda\xb3\x32\xcc\xd6\xf9\xea\xb1\x28\x1f\xef\x16\xe9\xfd\xe9\xce\x8b\x69\x39\x04\x78\xfe\xd5\x8b\xe7\x0a\xbb\x23\xc8\xb9\x56\x34\xed\x5a\x46\x4b\x03\xac\x07\xd5\x9e\xb9\x42\xb4\x23\xf0\xe0\x25\x8a\xa9\xa7\x08\x76\x1f\x07\xf2\xac\x12\x54\x12\xa2\x97\x5d\x1f\x6f\xb6\x75\xc5\x93\x70\x23\xb0\x06\x64\x30\x59\x96\xc8\xcb\x09\x3e\x2f\xcb\xbc\x9c\xaa\x04\xaf\xf9\xf6\x69\xf3\xb2\xc5\xeb\xf2\xf9\x79\x59\x6c\xf3\xc7\x12\x9b\x67\xac\x36\xc5\x43\xbe\xcd\x37\x45\x89\xcd\x17\x2c\x8b\x37\x7c\xcb\x8b\x87\x29\x58\x62\xc3\x1e\xfc\xee\xfc\xc8\x6f\x3d\x64\xdc\x23\x57\xe3\xd2\x4a\xe6\x1b\x80\xbd\x3d\xc7\x17\x1c\x6b\xd9\x8b\x46\x4b\xa6\xee\xa9\x66\xd4\xf6\xc0\xde\x88\xa9\xe1\xd8\x77\x12\xc6\x34\x03\xc8\x54\x2a\x41\x2b\x9d\x44\x8a\xa7\x93\xbf\x86\x4a\x95\x22\x27\x97\xfc\x33\x68\xeb\x39\xd5\xc6\x77\xa9\x6e\x6d\x5f\xa5\xf5\xe9\x29\xa5\xda\x76\xb3\xc3\x9c\x5a\xd7\xd0\x5c\xfd\x14\x53\x65\x28\xd9\x1f\x44\xf3\x77\x72\x4e\x4c\xad\x3a\x8e\x54\x51\xa4\x4c\x01\x86\x3a\xce\x30\xfe\xb9\x96\xdf\xff\x6d\x77\x91\x05\x47\x9a\x33\x8c\x92\xbb\x70\x0c\x91\x3b\x35\x0e\xf7\xe1\xf2\x70\x71\x51\xc0\xe1\x0a\x79\x98\xef\x38\xd2\x5c\x01\xe1\x0c\xf1\x64\x43\x2c\x6e\x9b\x9e\x3b\x91\x93\x70\xe9\xe6\x39\xd8\xde\x6b\x0e\x99\xfa\x3d\x00\x8c\xd4\x87\xaa\x31\x03\x00\x00"),
 		},
 		"/dataproc.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dataproc.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1848,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x0c\xa4\x4b\x0b\xd8\x94\xed\x23\x7b\x52\x64\x27\x21\x6a\xcb\x81\x24\x37\xc8\xc9\x18\x2d\x47\xe4\xd4\xcb\xdd\xed\xee\x50\x0a\xe1\xea\xbf\x17\xfc\xd2\x47\xec\x00\x29\x4f\xd4\xce\x7b\x6f\x1e\xdf\x0c\xa9\x31\xcc\xac\xab\x3d\xe7\x85\xc0\xcd\xd5\xcd\x0d\x7c\xb2\x36\xd7\x04\xf7\xf7\xb3\x68\x1c\x8d\xe1\x9e\x15\x99\x40\x19\x54\x26\x23\x0f\x52\x10\x4c\x1d\xaa\x82\x86\xca\x05\xfc\x45\x3e\xb0\x35\x70\x13\x5f\xc1\x6f\x0d\x60\xd4\x97\x46\xbf\xff\x11\x8d\xa1\xb6\x15\x94\x58\x83\xb1\x02\x55\x20\x90\x82\x03\x6c\x58\x13\xd0\x77\x45\x4e\x80\x0d\x28\x5b\x3a\xcd\x68\x14\xc1\x8e\xa5\x68\xdb\xf4\x22\x71\x34\x86\x6f\xbd\x84\x5d\x0b\xb2\x01\x04\x65\x5d\x0d\x76\x73\x8a\x03\x94\xd6\x70\x7b\x15\x22\x2e\x99\x4c\x76\xbb\x5d\x8c\xad\xdb\xd8\xfa\x7c\xa2\x3b\x64\x98\xdc\xa7\xb3\xbb\xf9\xf2\xee\xf2\x26\xbe\x6a\x39\x4f\x46\x53\x08\xe0\xe9\x9f\x8a\x3d\x65\xb0\xae\x01\x9d\xd3\xac\x70\xad\x09\x34\xee\xc0\x7a\xc0\xdc\x13\x65\x20\xb6\x31\xbc\xf3\x2c\x6c\xf2\x0b\x08\x76\x23\x3b\xf4\x14\x8d\x21\xe3\x20\x9e\xd7\x95\x9c\xa5\x35\xd8\xe3\x70\x06\xb0\x06\xd0\xc0\x68\xba\x84\x74\x39\x82\x0f\xd3\x65\xba\xbc\x88\xc6\xf0\x35\x5d\x7d\x7e\x7c\x5a\xc1\xd7\xe9\x62\x31\x9d\xaf\xd2\xbb\x25\x3c\x2e\x60\xf6\x38\xbf\x4d\x57\xe9\xe3\x7c\x09\x8f\x1f\x61\x3a\xff\x06\x7f\xa6\xf3\xdb\x0b\x20\x96\x82\x3c\xd0\x77\xe7\x1b\xff\xd6\x03\x37\x39\x52\xd6\x84\xb6\x24\x3a\x33\xb0\xb1\x9d\xa1\xe0\x48\xf1\x86\x15\x68\x34\x79\x85\x39\x41\x6e\xb7\xe4\x0d\x9b\x1c\x1c\xf9\x92\x43\x33\xcd\x00\x68\xb2\x68\x0c\x9a\x4b\x16\x94\xf6\xe4\xcd\x43\xc5\x51\x84\x8e\xfb\xf9\x27\xa0\xac\xa7\x58\x19\x5f\xc6\x4a\xdb\x2a\x8b\xf3\x76\x95\x62\x65\xcb\xc9\xf6\x1a\xb5\x2b\xf0\x3a\x7a\x61\x93\x25\xb0\x24\xbf\x65\x45\x0f\xe8\x1c\x9b\x3c\x2a\x49\x30\x43\xc1\x24\x02\x30\x58\x52\x02\xcd\x2f\xe7\xad\x7a\x5f\xae\x87\x05\x87\x8a\x12\x68\x20\x97\xa1\x0e\x42\x65\xd4\x3c\xdc\x51\xe5\xb6\x57\x89\x00\xb6\x83\xc9\xed\xf5\x9a\x04\xaf\x23\x80\xd0\x99\xf8\x6c\x83\xcc\xcf\x9b\x76\x9d\xd0\x71\xe8\xbb\x79\x0a\xb6\xf2\x8a\x42\xa3\x0d\x70\xd9\xeb\x77\xb8\xe7\x81\xf7\x8c\x95\xd8\xa0\x50\xb3\xc9\x9f\x9d\xd5\xac\xea\xa8\xdb\xc7\xee\xa9\x07\x3b\xd3\x23\xec\xcb\x29\xaa\xa1\x7f\x22\x43\x1e\x85\xb2\x04\xc4\x57\xd4\x57\x18\xcb\x99\x35\x1b\xce\x93\xfe\x00\xa0\xd3\x9f\xff\xa2\x8f\x67\xc6\xf2\xdc\xd2\xa0\xf0\x40\xe5\x9a\xfc\xff\xd2\x29\x5b\xca\x41\xc7\xd3\x86\x3c\x19\x45\x1f\x99\x74\x76\x74\x38\x4c\x61\x60\x66\x27\x15\xa9\x1d\x25\x6d\xfd\x70\x18\x2a\xe7\xac\x97\x30\xb3\x26\xe3\x76\xe1\x12\xd8\xa0\x0e\x87\x0c\xb2\x15\x95\x4e\xa3\x50\x02\x23\xe7\xed\xdf\xa4\x24\x4c\x5e\x5f\xfb\xdb\xfd\x7e\xa2\xad\xea\x36\x75\xf2\xfa\x3a\xdc\xef\xf7\x13\xfc\x21\x6e\xa6\x96\x36\xb8\xda\xef\x47\x6f\x3a\xcc\xd0\x7c\xa0\xa7\x40\xd9\xca\x3e\xa0\xa8\x62\xd1\xcf\xbf\x8b\xe9\xd4\xd5\xb0\x19\xd3\x2d\xb2\x6e\x3e\x17\xa9\x99\x86\x40\x92\x9a\x2d\x19\xb1\xbe\x3e\x87\x0f\x9b\xde\x6f\xfe\x31\xac\xf7\xa3\x1a\xd4\xd3\xdb\x23\x52\xd0\xe7\x24\x5d\xd6\x6f\x08\x05\x93\x47\xaf\x0a\x56\xa8\x17\xc3\x5c\xc2\x91\x7c\xd9\x27\xdf\x87\x76\x32\x91\x17\xaa\x0f\xc7\x0b\xda\xfc\x60\xe0\x27\x5a\x9b\xc1\xc6\x2f\xca\x35\x57\x46\x41\x79\x76\xd2\xbe\x8d\xff\x5e\x9e\x54\x00\x56\x05\x0d\x24\x90\x02\xa5\xfb\xb3\x18\x4c\xc0\x9a\xb4\x35\x79\x00\xb1\xf1\x09\x2d\xdf\xbe\x24\x67\x2a\xdd\xdb\xf6\xe5\x8d\xa7\x77\xbf\x02\x27\x3a\xde\x56\x2e\x39\x74\x2b\xd1\x60\x4e\xfe\x27\x5f\xa0\xff\x02\x00\x00\xff\xff\xa8\x06\xa0\x43\x38\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4d\x73\xdb\x36\x10\xbd\xf3\x57\xbc\x91\x2e\xed\x8c\x4d\xc5\x3e\xb2\x27\x45\x76\x12\x4e\x6d\x29\x23\xc9\xcd\xe4\xe4\x59\x81\x2b\x12\x35\x08\xa0\x00\x28\x85\xe3\xea\xbf\x77\xf8\xa5\x8f\x3a\xe9\xa4\x36\x0f\xd4\xee\xdb\xb7\x0f\x6f\x57\xd0\x18\x33\x63\x6b\x27\xf3\x22\xe0\xf6\xdd\xed\x2d\x3e\x1a\x93\x2b\xc6\xc3\xc3\x2c\x1a\x47\x63\x3c\x48\xc1\xda\x73\x86\x4a\x67\xec\x10\x0a\xc6\xd4\x92\x28\x78\xc8\x5c\xe1\x0f\x76\x5e\x1a\x8d\xdb\xf8\x1d\x7e\x69\x00\xa3\x3e\x35\xfa\xf5\xb7\x68\x8c\xda\x54\x28\xa9\x86\x36\x01\x95\x67\x84\x42\x7a\x6c\xa5\x62\xf0\x37\xc1\x36\x40\x6a\x08\x53\x5a\x25\x49\x0b\xc6\x5e\x86\x02\xe1\xc4\x1f\x47\x63\x7c\xed\x29\xcc\x26\x90\xd4\x20\x08\x63\x6b\x98\xed\x39\x0e\x14\x5a\xc1\xed\x5f\x11\x82\x4d\x26\x93\xfd\x7e\x1f\x53\xab\x36\x36\x2e\x9f\xa8\x0e\xe9\x27\x0f\xe9\xec\x7e\xbe\xba\xbf\xbe\x8d\xdf\xb5\x35\x4f\x5a\xb1\xf7\x70\xfc\x57\x25\x1d\x67\xd8\xd4\x20\x6b\x95\x14\xb4\x51\x0c\x45\x7b\x18\x07\xca\x1d\x73\x86\x60\x1a\xc1\x7b\x27\x83\xd4\xf9\x15\xbc\xd9\x86\x3d\x39\x8e\xc6\xc8\xa4\x0f\x4e\x6e\xaa\x70\xe1\xd6\x20\x4f\xfa\x0b\x80\xd1\x20\x8d\xd1\x74\x85\x74\x35\xc2\xfb\xe9\x2a\x5d\x5d\x45\x63\x7c\x49\xd7\x9f\x16\x4f\x6b\x7c\x99\x2e\x97\xd3\xf9\x3a\xbd\x5f\x61\xb1\xc4\x6c\x31\xbf\x4b\xd7\xe9\x62\xbe\xc2\xe2\x03\xa6\xf3\xaf\xf8\x3d\x9d\xdf\x5d\x81\x65\x28\xd8\x81\xbf\x59\xd7\xe8\x37\x0e\xb2\xf1\x91\xb3\xc6\xb4\x15\xf3\x85\x80\xad\xe9\xc6\xe7\x2d\x0b\xb9\x95\x02\x8a\x74\x5e\x51\xce\xc8\xcd\x8e\x9d\x96\x3a\x87\x65\x57\x4a\xdf\x4c\xd3\x83\x74\x16\x8d\xa1\x64\x29\x03\x85\x36\xf2\xe6\x50\x71\x14\x91\x95\xfd\xfc\x13\x08\xe3\x38\x16\xda\x95\xb1\x50\xa6\xca\xe2\xbc\x5d\xa5\x58\x98\x72\xb2\xbb\x21\x65\x0b\xba\x89\x5e\xa4\xce\x12\xac\xd8\xed\xa4\xe0\x47\xb2\x56\xea\x3c\x2a\x39\x50\x46\x81\x92\x08\xd0\x54\x72\x82\xe6\x93\x75\x46\x7c\x9f\xae\x87\x79\x4b\x82\x13\x34\x90\x6b\x5f\xfb\xc0\x65\xd4\x1c\xee\xc4\x72\xd7\xb3\x44\xc0\x6e\x10\xb9\xbb\xd9\x70\xa0\x9b\x08\xf0\x9d\x88\x4f\xc6\x87\xf9\x65\xd3\xae\x13\x59\xe9\xfb\x6e\x8e\xbd\xa9\x9c\x60\xdf\x70\x03\xd7\x3d\x7f\x87\x7b\x1e\xea\x9e\xa9\x0a\xc6\x0b\x52\x52\xe7\xcf\xd6\x28\x29\xea\x16\x0e\x74\xa7\x1e\xe4\x4c\x4f\xb0\xcf\xe7\xa8\xa6\xfc\x23\x6b\x76\x14\x38\x4b\x10\x5c\xc5\x7d\x46\x52\x39\x33\x7a\x2b\xf3\xa4\x0f\x00\x1d\xff\xfc\x27\x75\x3c\x4b\x2a\x2f\x25\x0d\x0c\x8f\x5c\x6e\xd8\xfd\x2f\x9e\xb2\x2d\x39\xf2\x38\xde\xb2\x63\x2d\xf8\x83\x64\x95\x9d\x14\x0e\x53\x18\x2a\xb3\xb3\x4c\xa8\x2d\x27\x6d\xfe\x18\xf4\x95\xb5\xc6\x05\x3f\x33\x3a\x93\xed\xc2\x25\xd8\x92\xf2\x47\x0f\xb2\x35\x97\x56\x51\xe0\x04\x23\xeb\xcc\x9f\x2c\x82\x9f\xbc\xbe\xf6\xaf\x87\xc3\x44\x19\xd1\x6d\xea\xe4\xf5\x75\x78\x3f\x1c\x26\x67\xa7\x68\xed\x96\xdc\x96\x0d\xaa\x0e\x87\xd1\x9b\x0e\x33\xd2\xef\xf9\xc9\x73\xb6\x36\x8f\x14\x44\xb1\xec\xe7\xdf\xd9\x74\xae\x6a\xd8\x8c\xe9\x8e\xa4\x6a\xae\x8b\x54\x4f\xbd\xe7\x90\xea\x1d\xeb\x60\x5c\x7d\x09\x1f\x36\xbd\xdf\xfc\x24\xfa\x6f\xab\x06\xf6\xf4\xee\x84\x0c\xe4\x72\x0e\x9d\xd7\x6f\x0a\x0a\xc9\x8e\x9c\x28\xa4\x20\xb5\x1c\xe6\xd2\x6f\x6d\xf3\x5c\xf7\xce\xf7\xa6\x1d\xe3\xc0\x0b\xd7\xc7\xf0\x92\xb7\xff\x12\xf0\x03\xae\xed\x20\xe3\x27\xe9\x9a\x27\x63\x2f\x9c\xb4\xcd\x70\x12\xfc\x7d\x7d\x96\x01\xd6\x05\x0f\x45\x08\x05\x85\xee\xc7\x62\x10\x81\x0d\x2b\xa3\x73\x8f\x60\xe2\xb3\xb2\x7c\xf7\x72\x12\x75\xfa\xb6\x7d\x7e\xa3\xe9\xbb\xb7\xc0\xe9\x3f\x77\xa6\xb2\xc9\xb1\x5b\x49\x9a\x72\x76\x3f\xb8\x81\xfe\x19\x00\xa8\x06\xa0\x43\x38\x07\x00\x00"),
 		},
 		"/dataprocmetastore.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dataprocmetastore.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2957,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\x0c\xec\xcb\xef\x07\x24\x72\xe2\xa3\x7a\xf2\x3a\xc9\xae\xd0\xc4\x2e\x2c\xa7\x8b\x3d\x19\x63\x6a\x24\xb1\xa1\x48\x96\xa4\xec\x15\x52\x7f\xf7\x42\x12\xe5\x3f\x71\xb2\xc8\xb6\xe8\x9f\x43\x75\x89\xc2\x99\x79\xf3\xf4\x66\xf8\x3c\x84\xa9\xd2\xb5\xe1\x79\xe1\x60\x7c\x35\x1e\xc3\x47\xa5\x72\x41\x70\x7f\x3f\x0d\x86\xc1\x10\xee\x39\x23\x69\x29\x85\x4a\xa6\x64\xc0\x15\x04\x13\x8d\xac\xa0\x3e\x72\x01\x3f\x93\xb1\x5c\x49\x18\x87\x57\xf0\xbf\x26\x61\xe0\x43\x83\xff\xff\x10\x0c\xa1\x56\x15\x94\x58\x83\x54\x0e\x2a\x4b\xe0\x0a\x6e\x21\xe3\x82\x80\xbe\x32\xd2\x0e\xb8\x04\xa6\x4a\x2d\x38\x4a\x46\xb0\xe5\xae\x68\xdb\x78\x90\x30\x18\xc2\x17\x0f\xa1\xd6\x0e\xb9\x04\x04\xa6\x74\x0d\x2a\x3b\xce\x03\x74\x2d\xe1\xf6\x29\x9c\xd3\xd1\x68\xb4\xdd\x6e\x43\x6c\xd9\x86\xca\xe4\x23\xd1\x65\xda\xd1\x7d\x3c\xbd\x9d\x25\xb7\x97\xe3\xf0\xaa\xad\x79\x94\x82\xac\x05\x43\xbf\x56\xdc\x50\x0a\xeb\x1a\x50\x6b\xc1\x19\xae\x05\x81\xc0\x2d\x28\x03\x98\x1b\xa2\x14\x9c\x6a\x08\x6f\x0d\x77\x5c\xe6\x17\x60\x55\xe6\xb6\x68\x28\x18\x42\xca\xad\x33\x7c\x5d\xb9\x13\xb5\x7a\x7a\xdc\x9e\x24\x28\x09\x28\x61\x30\x49\x20\x4e\x06\xf0\x61\x92\xc4\xc9\x45\x30\x84\xcf\xf1\xf2\xd3\xfc\x71\x09\x9f\x27\x8b\xc5\x64\xb6\x8c\x6f\x13\x98\x2f\x60\x3a\x9f\xdd\xc4\xcb\x78\x3e\x4b\x60\x7e\x07\x93\xd9\x17\xf8\x31\x9e\xdd\x5c\x00\x71\x57\x90\x01\xfa\xaa\x4d\xc3\x5f\x19\xe0\x8d\x8e\x94\x36\xa2\x25\x44\x27\x04\x32\xd5\x11\xb2\x9a\x18\xcf\x38\x03\x81\x32\xaf\x30\x27\xc8\xd5\x86\x8c\xe4\x32\x07\x4d\xa6\xe4\xb6\x99\xa6\x05\x94\x69\x30\x04\xc1\x4b\xee\xd0\xb5\x27\x67\x1f\x15\x06\x01\x6a\xee\xe7\x1f\x01\x53\x86\x42\x26\x4d\x19\x32\xa1\xaa\x34\xcc\xdb\x55\x0a\x99\x2a\x47\x9b\x6b\x14\xba\xc0\xeb\xe0\x89\xcb\x34\x82\x84\xcc\x86\x33\x7a\x40\xad\xb9\xcc\x83\x92\x1c\xa6\xe8\x30\x0a\x00\x24\x96\x14\x41\xf3\x9f\x36\x8a\x35\x11\xeb\xde\xc4\xf5\xf9\x56\x23\xa3\x08\x9a\x94\x4b\x5b\x5b\x47\x65\xd0\x7c\xe5\x01\xee\xc6\xc3\x3d\xf4\x70\x01\xc0\xa6\xa7\xbd\xb9\x5e\x93\xc3\xeb\x00\xc0\x76\xb4\x3e\x29\xeb\x66\x6f\xd0\xe8\x7a\xa3\xe6\xd6\xf7\x37\x64\x55\x65\x18\xd9\xa6\x1b\xc0\xa5\xef\xd8\xe5\xad\x7a\x80\xd5\x1e\x61\x95\x51\x4a\xa6\x55\x34\xe8\x96\xb5\x93\xe4\x8c\xe2\xdd\xcb\x3c\xac\x9c\xfa\x48\xb2\x39\xa4\x34\x02\x67\x2a\xf2\x11\x8e\xe5\x54\xc9\x8c\xe7\x91\x3f\x00\xd0\x4a\x70\x56\xcf\xde\xcd\x65\xc5\xb1\x5c\x75\x45\x2f\x30\x1e\xa8\x5c\x93\xf9\x4e\xa4\xb2\x2d\xda\x23\x19\xca\xc8\x90\x64\x74\xc7\x49\xa4\x07\x96\xfd\x7c\x8e\xab\xd3\xa3\xa8\xab\x35\x45\x6d\xce\xfe\xd0\x56\x5a\x2b\xe3\xec\x54\xc9\x94\xb7\x7b\x19\x41\x86\xc2\xee\xb5\x48\x97\x54\x6a\x81\x8e\x22\x18\x68\xa3\x7e\x21\xe6\xec\xe8\xf9\xd9\xbf\xee\x76\x23\xa1\x58\xb7\xd0\xa3\xe7\xe7\xfe\x7d\xb7\x1b\x1d\x38\x34\x81\x13\x46\xbb\xdd\xe0\x0c\x7d\x8a\xf2\x03\x3d\x5a\x4a\x97\xea\x01\x1d\x2b\x16\x7e\x0f\x3a\xa1\x8e\x19\xf5\x1b\x32\xd9\x20\x17\x8d\xa3\xc4\x72\x62\x2d\xb9\x58\x6e\x48\x3a\x65\xea\xd3\xf4\xfe\x32\xf8\xcb\x71\x10\xeb\x5b\x52\x09\x5c\x93\xb0\x91\xff\xfb\xa2\x71\x7c\x73\x00\x71\x68\x72\x72\xdd\x18\x5e\xc5\x2a\x38\x19\x34\xac\xe0\x0c\xc5\xa2\x1f\x9b\x3d\x00\x5c\xfa\xa1\x78\x3d\x8f\x86\xf5\x44\xf5\xfe\x78\x41\xd9\x0b\x12\x6f\x60\x65\x9e\xca\x7b\xe1\x9a\x27\x25\xcb\x0c\xd7\xae\xbd\xbd\xbf\x5d\x1e\x45\x00\x96\x05\xf5\x45\xe0\x0a\x74\xdd\xcf\x4d\x4f\x02\xd6\x24\x94\xcc\x2d\x38\x15\x1e\x95\xe5\x9b\xa7\xe8\x04\xa5\xbb\x92\x3f\x9d\x71\x7a\xd5\x35\x8e\x70\x8c\xaa\x74\xb4\xef\x56\xa2\xc4\x9c\xcc\x9b\xd6\xf5\x1e\xb7\xf0\x96\xf4\x6d\xab\x48\x4e\x92\xfe\x02\x9f\xf0\x2c\xfe\xac\x49\x1c\xc3\x7c\xa7\x43\xec\x4b\xff\x29\x7b\xf0\x04\x9a\xd3\x03\x97\x7f\x93\x31\xbc\xa2\xd0\x1f\x73\x85\x33\xa0\xff\x2c\xe1\x6f\xb2\x84\xdf\x03\x00\x00\xff\xff\xaa\x46\xdf\x09\x8d\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x95\x4f\x6f\xdb\x46\x10\xc5\xef\xfc\x14\x0f\xd2\xa5\x05\x6c\x2a\xf6\x91\x3d\x29\x72\x9c\x10\xb5\xe5\x42\x92\x1b\xe4\x64\x8c\x96\x43\x72\xeb\xe5\xee\x76\x77\x29\x45\x48\xf5\xdd\x0b\x52\xa4\xfe\xd8\x71\xe1\x20\xe8\x9f\x43\x4d\x03\xa2\x76\x66\xde\xfe\x38\xb3\x7c\x1a\x62\x62\xec\xc6\xc9\xa2\x0c\xb8\x7c\x73\x79\x89\xf7\xc6\x14\x8a\x71\x73\x33\x89\x86\xd1\x10\x37\x52\xb0\xf6\x9c\xa1\xd6\x19\x3b\x84\x92\x31\xb6\x24\x4a\xee\x23\x67\xf8\x95\x9d\x97\x46\xe3\x32\x7e\x83\x1f\x9a\x84\x41\x17\x1a\xfc\xf8\x53\x34\xc4\xc6\xd4\xa8\x68\x03\x6d\x02\x6a\xcf\x08\xa5\xf4\xc8\xa5\x62\xf0\x67\xc1\x36\x40\x6a\x08\x53\x59\x25\x49\x0b\xc6\x5a\x86\x12\xe1\xa0\x1f\x47\x43\x7c\xea\x24\xcc\x32\x90\xd4\x20\x08\x63\x37\x30\xf9\x71\x1e\x28\xb4\xc0\xed\x5f\x19\x82\x4d\x46\xa3\xf5\x7a\x1d\x53\x4b\x1b\x1b\x57\x8c\xd4\x2e\xd3\x8f\x6e\xd2\xc9\xbb\xe9\xfc\xdd\xf9\x65\xfc\xa6\xad\xb9\xd7\x8a\xbd\x87\xe3\xdf\x6b\xe9\x38\xc3\x72\x03\xb2\x56\x49\x41\x4b\xc5\x50\xb4\x86\x71\xa0\xc2\x31\x67\x08\xa6\x01\x5e\x3b\x19\xa4\x2e\xce\xe0\x4d\x1e\xd6\xe4\x38\x1a\x22\x93\x3e\x38\xb9\xac\xc3\x49\xb7\x7a\x3c\xe9\x4f\x12\x8c\x06\x69\x0c\xc6\x73\xa4\xf3\x01\xde\x8e\xe7\xe9\xfc\x2c\x1a\xe2\x63\xba\xf8\x70\x77\xbf\xc0\xc7\xf1\x6c\x36\x9e\x2e\xd2\x77\x73\xdc\xcd\x30\xb9\x9b\x5e\xa5\x8b\xf4\x6e\x3a\xc7\xdd\x35\xc6\xd3\x4f\xf8\x39\x9d\x5e\x9d\x81\x65\x28\xd9\x81\x3f\x5b\xd7\xf0\x1b\x07\xd9\xf4\x91\xb3\xa6\x69\x73\xe6\x13\x80\xdc\xec\xc6\xe7\x2d\x0b\x99\x4b\x01\x45\xba\xa8\xa9\x60\x14\x66\xc5\x4e\x4b\x5d\xc0\xb2\xab\xa4\x6f\xa6\xe9\x41\x3a\x8b\x86\x50\xb2\x92\x81\x42\xbb\xf2\xec\xa1\xe2\x28\x22\x2b\xbb\xf9\x27\x10\xc6\x71\x2c\xb4\xab\x62\xa1\x4c\x9d\xc5\x45\x7b\x94\x62\x61\xaa\xd1\xea\x82\x94\x2d\xe9\x22\x7a\x94\x3a\x4b\x30\x67\xb7\x92\x82\x6f\xc9\x5a\xa9\x8b\xa8\xe2\x40\x19\x05\x4a\x22\x40\x53\xc5\x09\x9a\x6f\xd6\x19\xd1\x44\x7c\x78\x51\xb7\xcb\xf7\x96\x04\x27\x68\x52\xce\xfd\xc6\x07\xae\xa2\xe6\x29\x0f\x72\x57\x9d\xdc\x6d\x2f\x17\x01\xab\x1e\x7b\x75\xb1\xe4\x40\x17\x11\xe0\x77\x58\x1f\x8c\x0f\xd3\x17\x30\x76\xcf\x44\x56\xfa\x6e\x7f\xc7\xde\xd4\x4e\xb0\x6f\x76\x03\xce\xbb\x1d\x77\x79\x0f\xbd\xc0\xc3\x5e\xe1\x21\xe7\x8c\x5d\xdb\xd1\xb6\x00\xd8\xb5\xe4\x19\xe2\xf5\xd3\x3c\xaa\x83\x79\xcf\xba\x59\xe4\x2c\x41\x70\x35\x77\x11\x49\xd5\xc4\xe8\x5c\x16\x49\xb7\x00\x58\xa3\xa4\xd8\x4c\x5f\xcd\xf2\x20\xa9\x7a\xd8\x15\x3d\xd1\xb8\xe5\x6a\xc9\xee\x1b\x95\xaa\xb6\x68\xaf\xe4\x38\x67\xc7\x5a\xf0\xb5\x64\x95\x1d\x28\xfb\xf9\x1c\x57\x67\x47\xd1\xb0\xb1\x9c\xb4\x39\xfb\x45\x5f\x5b\x6b\x5c\xf0\x13\xa3\x33\xd9\x54\xf8\x04\x39\x29\xbf\xef\x45\xb6\xe0\xca\x2a\x0a\x9c\x60\x60\x9d\xf9\x8d\x45\xf0\xa3\x2f\x5f\xba\xdb\xed\x76\xa4\x8c\x68\xdb\xda\xac\xf6\xf7\xdb\xed\xe8\xc0\xd0\x04\x4e\x88\xb6\xdb\xc1\x33\xf5\x09\xe9\xb7\x7c\xef\x39\x5b\x98\x5b\x0a\xa2\x9c\x75\xe7\x60\xd7\xa8\x63\xa2\xfe\x84\x8c\x57\x24\x55\xe3\x28\xa9\x1e\x7b\xcf\x21\xd5\x2b\xd6\xc1\xb8\xcd\x69\x7a\xff\x32\x74\x2f\x47\x12\xbd\xa6\x55\x8a\x96\xac\x7c\xd2\x7d\x3e\xd9\x38\xbd\x3a\x88\x04\x72\x05\x87\xdd\x18\xbe\xaa\x55\x4a\x76\xe4\x44\x29\x05\xa9\x59\x3f\xb6\xee\x70\x37\xff\xe7\xdd\x50\xba\x7e\xee\xd7\x81\x47\xde\xec\x97\x67\x9c\x3f\x81\x78\x41\x2b\xef\x50\x5e\x2b\xd7\x5c\x19\x7b\xe1\xa4\x6d\x7a\x90\xe0\x8f\xf3\xa3\x08\xb0\x28\xb9\x2f\x42\x28\x29\xec\x7e\x6e\x7a\x08\x2c\x59\x19\x5d\x78\x04\x13\x1f\x95\x15\xab\xc7\x03\xd4\xe1\x95\xfc\xe5\x19\xd3\x57\x5d\xe3\x70\x15\xce\xd4\x36\xd9\xef\x56\x91\xa6\x82\xdd\x8b\xd6\xf5\x1a\xb7\xe8\x2c\xe9\xaf\xad\x62\x7e\x92\xf4\x37\xf8\x44\x47\xf1\xbd\x26\x71\x2c\xf3\x8d\x0e\xb1\x2f\xfd\xb7\xec\xa1\x03\x68\xbc\xe1\xc0\xf2\x5f\x32\x86\x03\xd5\x77\xba\xc2\x33\xa1\xff\x2d\xe1\x1f\xb2\x84\x3f\x07\x00\xaa\x46\xdf\x09\x8d\x0b\x00\x00"),
 		},
 		"/datastore.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "datastore.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1523,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x73\xe2\x46\x10\xbd\xeb\x57\xbc\x82\x4b\x52\x65\x0b\x9b\xa3\x72\x62\xc1\xbb\xab\x8a\x17\x52\x80\xb3\xb5\x27\x57\x33\x6a\xa4\x89\xa5\x99\xc9\x4c\x0b\x4c\x6d\xfc\xdf\x53\xfa\x80\x80\x3f\xaa\x32\xa7\xa1\xfb\xf5\x7b\xaf\x7b\x1a\x0d\x31\xb5\xee\xe0\x75\x5e\x08\xc6\x37\xe3\x31\xbe\x58\x9b\x97\x8c\xfb\xfb\x69\x34\x8c\x86\xb8\xd7\x8a\x4d\xe0\x0c\xb5\xc9\xd8\x43\x0a\xc6\xc4\x91\x2a\xf8\x98\xb9\xc2\x9f\xec\x83\xb6\x06\xe3\xf8\x06\xbf\x34\x80\x41\x9f\x1a\xfc\xfa\x5b\x34\xc4\xc1\xd6\xa8\xe8\x00\x63\x05\x75\x60\x48\xa1\x03\xb6\xba\x64\xf0\xb3\x62\x27\xd0\x06\xca\x56\xae\xd4\x64\x14\x63\xaf\xa5\x68\x65\x7a\x92\x38\x1a\xe2\x47\x4f\x61\x37\x42\xda\x80\xa0\xac\x3b\xc0\x6e\xcf\x71\x20\x69\x0d\xb7\xa7\x10\x71\xc9\x68\xb4\xdf\xef\x63\x6a\xdd\xc6\xd6\xe7\xa3\xb2\x43\x86\xd1\x7d\x3a\xbd\x9b\xaf\xee\xae\xc7\xf1\x4d\x5b\xf3\x60\x4a\x0e\x01\x9e\xff\xae\xb5\xe7\x0c\x9b\x03\xc8\xb9\x52\x2b\xda\x94\x8c\x92\xf6\xb0\x1e\x94\x7b\xe6\x0c\x62\x1b\xc3\x7b\xaf\x45\x9b\xfc\x0a\xc1\x6e\x65\x4f\x9e\xa3\x21\x32\x1d\xc4\xeb\x4d\x2d\x17\xd3\x3a\xda\xd3\xe1\x02\x60\x0d\xc8\x60\x30\x59\x21\x5d\x0d\xf0\x69\xb2\x4a\x57\x57\xd1\x10\xdf\xd3\xf5\xd7\xc5\xc3\x1a\xdf\x27\xcb\xe5\x64\xbe\x4e\xef\x56\x58\x2c\x31\x5d\xcc\x67\xe9\x3a\x5d\xcc\x57\x58\x7c\xc6\x64\xfe\x03\xbf\xa7\xf3\xd9\x15\x58\x4b\xc1\x1e\xfc\xec\x7c\xe3\xdf\x7a\xe8\x66\x8e\x9c\x35\x43\x5b\x31\x5f\x18\xd8\xda\xce\x50\x70\xac\xf4\x56\x2b\x94\x64\xf2\x9a\x72\x46\x6e\x77\xec\x8d\x36\x39\x1c\xfb\x4a\x87\xe6\x35\x03\xc8\x64\xd1\x10\xa5\xae\xb4\x90\xb4\x91\x37\x4d\xc5\x51\x44\x4e\xf7\xef\x9f\x40\x59\xcf\xb1\x32\xbe\x8a\x55\x69\xeb\x2c\xce\xdb\x55\x8a\x95\xad\x46\xbb\x5b\x2a\x5d\x41\xb7\xd1\x93\x36\x59\x82\x15\xfb\x9d\x56\xfc\x8d\x9c\xd3\x26\x8f\x2a\x16\xca\x48\x28\x89\x00\x43\x15\x27\x68\x7e\x05\xf9\x90\xaf\xc7\x05\x47\x8a\x13\x34\x90\xeb\x70\x08\xc2\x55\xd4\x74\xf7\x1f\xcd\xec\x48\x13\x01\xbb\xa3\xcd\xdd\xed\x86\x85\x6e\x23\x20\x74\x36\xbe\xda\x20\xf3\x57\xb2\x9d\x16\x39\x1d\x7a\x3d\xcf\xc1\xd6\x5e\x71\x68\xd8\x81\xeb\x5e\xa1\xc3\x3d\x9e\x0a\x1f\xb5\xc9\xf8\x39\xea\xd6\xb0\x6b\xf6\x64\x22\x3d\x4b\x51\x2d\xf6\x0b\x1b\xf6\x24\x9c\x25\x10\x5f\x73\x9f\xd1\xd9\x9a\x2b\x57\x92\x70\x82\x81\xf3\xf6\x2f\x56\x12\x46\x3f\x7f\xf6\xd7\x97\x97\x51\x2b\xc1\x4d\xac\xbd\x3d\xea\xec\xe5\x65\xf0\xa6\x7a\x4a\xe6\x13\x3f\x04\xce\xd6\xf6\x1b\x89\x2a\x96\x7d\x03\x5d\xa7\x5b\x2a\xc3\x51\xf1\xd8\xda\x64\x47\xba\x6c\x56\x3e\x35\x93\x10\x58\x52\xb3\x63\x23\xd6\x1f\x2e\xe1\xcd\xd4\xd8\x9f\xcc\xa7\xb3\xcf\x9a\xcb\x2c\xc1\xd1\xcc\x2b\xd6\x74\x96\xf4\x11\x40\xc8\xe7\x2c\xef\xe3\x0b\xcd\x9e\xbc\x2a\xb4\xa2\x72\xc9\x5b\xf6\x6c\x4e\xd3\xee\x26\x2e\x07\xc7\x09\xfa\x39\x9c\xe2\xc0\x13\x1f\x4e\xe1\x25\x6f\x5f\xe9\x7f\xc0\xb5\xed\x5d\xfc\x5f\xba\xe6\x64\x1c\x94\xd7\x4e\xda\x35\xfa\xe7\xfa\x2c\x03\xac\x0b\x3e\x16\x41\x0a\x92\xee\x3b\x77\x34\x81\x0d\x97\xd6\xe4\x01\x62\xe3\xb3\xb2\x7c\xf7\x94\x5c\xb0\x74\x1b\xf3\xc7\x1b\x4f\xef\xae\xef\x19\x8f\xb7\xb5\x4b\x4e\x6a\x15\x19\xca\xd9\x7f\xf0\xdf\xf9\x37\x00\x00\xff\xff\x01\x95\x07\xb2\xf3\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4f\x73\xe2\xb8\x13\xbd\xfb\x53\xbc\x82\xcb\xef\x57\x15\x4c\xc2\xd1\x7b\x62\x20\x33\xe3\xda\x0c\x6c\x01\xd9\xa9\x39\xa5\x1a\xb9\xb1\xb5\x91\x25\xad\xd4\x86\x50\xb3\xf9\xee\x5b\x06\x03\xf9\x33\xa9\x5a\xcb\x07\xb9\xfb\xf5\xeb\xd7\xad\x96\xfb\x98\x38\xbf\x0f\xba\xac\x04\xa3\xeb\xd1\x08\x5f\x9c\x2b\x0d\xe3\xee\x6e\x92\xf4\x93\x3e\xee\xb4\x62\x1b\xb9\x40\x63\x0b\x0e\x90\x8a\x31\xf6\xa4\x2a\x3e\x79\xae\xf0\x27\x87\xa8\x9d\xc5\x28\xbd\xc6\xff\x5a\x40\xaf\x73\xf5\xfe\xff\x5b\xd2\xc7\xde\x35\xa8\x69\x0f\xeb\x04\x4d\x64\x48\xa5\x23\x36\xda\x30\xf8\x49\xb1\x17\x68\x0b\xe5\x6a\x6f\x34\x59\xc5\xd8\x69\xa9\x20\x17\xfe\x34\xe9\xe3\x47\x47\xe1\xd6\x42\xda\x82\xa0\x9c\xdf\xc3\x6d\x5e\xe2\x40\x72\x10\x7c\x78\x2a\x11\x9f\x0d\x87\xbb\xdd\x2e\xa5\x83\xda\xd4\x85\x72\x68\x8e\xc8\x38\xbc\xcb\x27\xb7\xb3\xe5\xed\x60\x94\x5e\x1f\x62\xee\xad\xe1\x18\x11\xf8\xef\x46\x07\x2e\xb0\xde\x83\xbc\x37\x5a\xd1\xda\x30\x0c\xed\xe0\x02\xa8\x0c\xcc\x05\xc4\xb5\x82\x77\x41\x8b\xb6\xe5\x15\xa2\xdb\xc8\x8e\x02\x27\x7d\x14\x3a\x4a\xd0\xeb\x46\x5e\x75\xeb\x24\x4f\xc7\x57\x00\x67\x41\x16\xbd\xf1\x12\xf9\xb2\x87\x4f\xe3\x65\xbe\xbc\x4a\xfa\xf8\x9e\xaf\xbe\xce\xef\x57\xf8\x3e\x5e\x2c\xc6\xb3\x55\x7e\xbb\xc4\x7c\x81\xc9\x7c\x36\xcd\x57\xf9\x7c\xb6\xc4\xfc\x33\xc6\xb3\x1f\xf8\x3d\x9f\x4d\xaf\xc0\x5a\x2a\x0e\xe0\x27\x1f\x5a\xfd\x2e\x40\xb7\x7d\xe4\xa2\x6d\xda\x92\xf9\x95\x80\x8d\x3b\x1e\x5f\xf4\xac\xf4\x46\x2b\x18\xb2\x65\x43\x25\xa3\x74\x5b\x0e\x56\xdb\x12\x9e\x43\xad\x63\x7b\x9a\x11\x64\x8b\xa4\x0f\xa3\x6b\x2d\x24\x07\xcb\xbb\xa2\xd2\x24\x21\xaf\xbb\xf3\xcf\xa0\x5c\xe0\x54\xd9\x50\xa7\xca\xb8\xa6\x48\xcb\xc3\x28\xa5\xca\xd5\xc3\xed\x0d\x19\x5f\xd1\x4d\xf2\xa8\x6d\x91\x61\xc9\x61\xab\x15\x7f\x23\xef\xb5\x2d\x93\x9a\x85\x0a\x12\xca\x12\xc0\x52\xcd\x19\xda\xaf\x28\x1f\xf2\x75\xb8\xe8\x49\x71\x86\x16\x32\x88\xfb\x28\x5c\x27\x6d\x75\x17\x9a\xe9\x89\x26\x01\xb6\x27\x99\xdb\x9b\x35\x0b\xdd\x24\x40\x3c\xca\xf8\xea\xa2\xcc\xde\xa4\x3d\x6a\x27\xaf\x63\x97\x2f\x70\x74\x4d\x50\x1c\x5b\x76\x60\xd0\x65\x38\xe2\x1e\xce\x81\x0f\xda\x16\xfc\x74\x80\x00\xc7\x62\xcf\x22\xf2\x17\x2e\x6a\xc4\x7d\x61\xcb\x81\x84\x8b\x0c\x12\x1a\xee\x3c\xba\x58\x71\xed\x0d\x09\x67\xe8\xf9\xe0\xfe\x62\x25\x71\xf8\xf3\x67\xb7\x7d\x7e\x1e\x1e\x52\x70\x6b\x3b\xec\x1e\x74\xf1\xfc\xdc\x7b\x17\x3d\x21\xfb\x89\xef\x23\x17\x2b\xf7\x8d\x44\x55\x8b\xae\x80\x63\xa5\x1b\x32\xf1\x94\xf1\x54\xda\x78\x4b\xda\xb4\x23\x9f\xdb\x71\x8c\x2c\xb9\xdd\xb2\x15\x17\xf6\xaf\xe1\x6d\xd7\x38\x9c\xc5\xe7\xd3\xcf\x9a\x4d\x91\xe1\x24\xe6\x0d\x6b\x3e\xcd\x3a\x0b\x20\x14\x4a\x96\x5f\xe3\x2b\xcd\x81\x82\xaa\xb4\x22\xb3\xe0\x0d\x07\xb6\xe7\x6e\xb7\xef\x00\xb2\xf7\x9c\xa1\xeb\xc3\xd9\x0e\x3c\xf2\xfe\x6c\x5e\xf0\xe6\x4d\xfe\x0f\xb8\x36\x9d\x8a\xff\x4a\xd7\xae\x82\xa3\x0a\xda\xb7\xb7\x21\xc3\x3f\x83\x17\x1e\x60\x55\xf1\x29\x08\x52\x91\x1c\xff\x73\x27\x11\x58\xb3\x71\xb6\x8c\x10\x97\xbe\x08\x2b\xb7\x8f\x17\x51\x97\x89\xf9\xe3\x9d\xa6\x5f\x8e\xef\x65\x95\xc1\x35\x3e\x3b\x67\xab\xc9\x52\xc9\xe1\x83\xbb\xf3\xef\x00\x01\x95\x07\xb2\xf3\x05\x00\x00"),
 		},
 		"/datastream.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "datastream.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3252,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xe2\x48\x10\xbd\xfb\x57\x94\xe0\xb2\x2b\x25\x26\xe1\xe8\x3d\x31\x24\x33\x63\x6d\x06\x22\x4c\x76\x34\xa7\xa8\x68\x17\x76\x6f\xda\xdd\xbd\xdd\x65\x18\x94\xe5\xbf\xaf\x8c\x3f\x02\x84\x44\x2b\x8d\x66\x94\x43\x7c\xc1\x74\xbd\x7a\xf5\xba\xaa\x1e\xf4\x61\x6c\xec\xc6\xc9\x2c\x67\x18\x5e\x0c\x87\xf0\xc9\x98\x4c\x11\xdc\xdc\x8c\x83\x7e\xd0\x87\x1b\x29\x48\x7b\x4a\xa1\xd4\x29\x39\xe0\x9c\x60\x64\x51\xe4\xd4\x46\xce\xe0\x2f\x72\x5e\x1a\x0d\xc3\xf0\x02\x7e\xab\x00\xbd\x26\xd4\xfb\xfd\x8f\xa0\x0f\x1b\x53\x42\x81\x1b\xd0\x86\xa1\xf4\x04\x9c\x4b\x0f\x4b\xa9\x08\xe8\xbb\x20\xcb\x20\x35\x08\x53\x58\x25\x51\x0b\x82\xb5\xe4\x7c\x57\xa6\x21\x09\x83\x3e\x7c\x6b\x28\xcc\x82\x51\x6a\x40\x10\xc6\x6e\xc0\x2c\xf7\x71\x80\xbc\x13\xbc\x7b\x72\x66\x1b\x0d\x06\xeb\xf5\x3a\xc4\x9d\xda\xd0\xb8\x6c\xa0\x6a\xa4\x1f\xdc\xc4\xe3\xeb\x49\x72\x7d\x3e\x0c\x2f\x76\x39\x77\x5a\x91\xf7\xe0\xe8\x9f\x52\x3a\x4a\x61\xb1\x01\xb4\x56\x49\x81\x0b\x45\xa0\x70\x0d\xc6\x01\x66\x8e\x28\x05\x36\x95\xe0\xb5\x93\x2c\x75\x76\x06\xde\x2c\x79\x8d\x8e\x82\x3e\xa4\xd2\xb3\x93\x8b\x92\x0f\xba\xd5\xca\x93\xfe\x00\x60\x34\xa0\x86\xde\x28\x81\x38\xe9\xc1\x87\x51\x12\x27\x67\x41\x1f\xbe\xc6\xf3\xcf\xd3\xbb\x39\x7c\x1d\xcd\x66\xa3\xc9\x3c\xbe\x4e\x60\x3a\x83\xf1\x74\x72\x15\xcf\xe3\xe9\x24\x81\xe9\x47\x18\x4d\xbe\xc1\x9f\xf1\xe4\xea\x0c\x48\x72\x4e\x0e\xe8\xbb\x75\x95\x7e\xe3\x40\x56\x7d\xa4\xb4\x6a\x5a\x42\x74\x20\x60\x69\x6a\x41\xde\x92\x90\x4b\x29\x40\xa1\xce\x4a\xcc\x08\x32\xb3\x22\xa7\xa5\xce\xc0\x92\x2b\xa4\xaf\xa6\xe9\x01\x75\x1a\xf4\x41\xc9\x42\x32\xf2\xee\xe4\xd9\xa5\xc2\x20\x40\x2b\x9b\xf9\x47\x20\x8c\xa3\x50\x68\x57\x84\x42\x99\x32\x0d\xb3\xdd\x2a\x85\xc2\x14\x83\xd5\x25\x2a\x9b\xe3\x65\xf0\x20\x75\x1a\x41\x42\x6e\x25\x05\x7d\x41\x6b\xa5\xce\x82\x82\x18\x53\x64\x8c\x02\x00\x8d\x05\x45\x50\x7d\xf3\xec\x08\x8b\xd3\x84\x0d\xd0\x5b\x14\x14\x41\x05\x39\xf7\x1b\xcf\x54\x04\xd5\xf5\x9e\x78\xae\x3a\x9e\x00\x60\xd5\x0a\x5d\x5d\x2e\x88\xf1\x32\x00\xf0\xb5\x90\xcf\xc6\xf3\xe4\xb8\x70\x5d\x0d\xad\xf4\x4d\x45\x47\xde\x94\x4e\x90\xaf\xf8\x01\xce\x9b\x1a\x35\xee\xfe\x29\xf3\x5e\x18\xad\x49\x54\x4d\xbb\xb7\xce\x54\xbb\x1e\xd4\x7b\x59\xdf\xfe\x49\xd4\xb8\x03\xde\x1e\xe0\xb0\x64\xf3\x89\x34\x39\x64\x4a\x23\x60\x57\xb6\x11\x99\xce\xa9\xb0\x0a\x99\x22\xe8\x59\x67\xfe\x26\xc1\x7e\xf0\xf8\xd8\xbc\x6e\xb7\x03\x65\x44\x3d\xaf\xc1\xe3\x63\xfb\xbe\xdd\x0e\xc4\x71\xa9\x2a\xfe\x5c\xe8\xbd\x4c\xb7\xdb\xde\xb3\x62\x63\xd4\x1f\xe8\xce\x53\x3a\x37\x5f\x90\x45\x3e\x6b\x5a\x51\x37\x6d\x89\xca\xb7\x02\xdb\x26\x8d\x56\x28\x55\xe5\x9f\x58\x8f\xbc\x27\x8e\xf5\x8a\x34\x1b\xb7\x39\x84\xb7\xa3\x6f\x56\x21\x6a\x8e\xdb\xf9\x9d\x14\xd8\x61\x14\x2e\x48\xf9\xa8\xf9\x3c\x12\x10\x5f\x3d\x91\x31\xba\x8c\xf8\xa3\x24\x95\xbe\xce\x99\x4b\x72\xe8\x44\x2e\x05\xaa\x19\x2d\xc9\x91\xee\xe6\x5d\xcf\x9c\x37\x96\x22\x68\xda\xdd\x9d\x03\x3c\xd0\xa6\x3b\x9e\xd1\xf2\x48\xcc\x0b\x5c\xcb\x46\xd2\xff\xa5\xab\x9e\x94\xbc\x70\xd2\xf2\x6e\x93\xff\x3d\xdf\x8b\x00\xcc\x73\x6a\x93\x80\x73\xe4\xfa\xc7\xb6\x15\x01\x0b\x52\x46\x67\x1e\xd8\x84\x7b\x69\xd9\xea\x21\x3a\x60\xa9\xb7\xf4\xf6\x99\xa6\x93\x0e\xda\xe3\x71\xa6\xb4\x51\x57\xad\x40\x8d\x19\xb9\x17\xfd\xfb\x9a\x81\xac\x93\x2b\x64\xda\x33\xd2\x0b\x06\xba\xad\x81\xe3\x63\xdc\x4f\x30\x90\x3d\x2e\x55\x67\x1d\x0b\x7d\x63\x06\x3a\x29\xf0\x07\x0d\xf4\x1a\xe7\xbb\x81\xde\x84\x81\xba\xff\xbc\x53\xa6\x49\xf6\x83\x3f\xc1\x29\x75\x95\xea\xb0\x51\xf3\xb6\x2c\xd1\x89\xfa\x41\x1b\x1c\xf3\xbc\xaf\xfe\x2f\x5a\xfd\xff\x02\x00\x00\xff\xff\x88\x80\x28\x0d\xb4\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x41\x6f\xe2\x48\x13\xbd\xfb\x57\x3c\xc1\xe5\xfb\xa4\xc4\x24\x39\x7a\x4f\x0c\xc9\xcc\x58\x9b\x21\x11\x90\x1d\xcd\x29\x2a\xda\x85\xdd\x9b\x76\x77\x6f\x77\x1b\x06\x65\xf9\xef\x2b\x63\x1b\x12\x42\xa2\x95\x46\xb3\xca\x61\x30\x12\xa6\xeb\xd5\xab\xe7\xaa\x7a\xd0\xc7\xc8\xd8\xb5\x93\x79\x11\x70\x71\x76\x71\x81\x4f\xc6\xe4\x8a\x71\x7d\x3d\x8a\xfa\x51\x1f\xd7\x52\xb0\xf6\x9c\xa1\xd2\x19\x3b\x84\x82\x31\xb4\x24\x0a\xee\x22\x27\xf8\x83\x9d\x97\x46\xe3\x22\x3e\xc3\xff\x6a\x40\xaf\x0d\xf5\xfe\xff\x5b\xd4\xc7\xda\x54\x28\x69\x0d\x6d\x02\x2a\xcf\x08\x85\xf4\x58\x48\xc5\xe0\xef\x82\x6d\x80\xd4\x10\xa6\xb4\x4a\x92\x16\x8c\x95\x0c\x05\xc2\x9e\x3f\x8e\xfa\xf8\xd6\x52\x98\x79\x20\xa9\x41\x10\xc6\xae\x61\x16\x4f\x71\xa0\xb0\x15\xbc\x7d\x15\x21\xd8\x64\x30\x58\xad\x56\x31\x6d\xd5\xc6\xc6\xe5\x03\xd5\x20\xfd\xe0\x3a\x1d\x5d\x8d\xa7\x57\xa7\x17\xf1\xd9\x36\xe7\x4e\x2b\xf6\x1e\x8e\xff\xaa\xa4\xe3\x0c\xf3\x35\xc8\x5a\x25\x05\xcd\x15\x43\xd1\x0a\xc6\x81\x72\xc7\x9c\x21\x98\x5a\xf0\xca\xc9\x20\x75\x7e\x02\x6f\x16\x61\x45\x8e\xa3\x3e\x32\xe9\x83\x93\xf3\x2a\x3c\xeb\x56\x27\x4f\xfa\x67\x00\xa3\x41\x1a\xbd\xe1\x14\xe9\xb4\x87\x0f\xc3\x69\x3a\x3d\x89\xfa\xf8\x9a\xce\x3e\xdf\xdc\xcd\xf0\x75\x38\x99\x0c\xc7\xb3\xf4\x6a\x8a\x9b\x09\x46\x37\xe3\xcb\x74\x96\xde\x8c\xa7\xb8\xf9\x88\xe1\xf8\x1b\x7e\x4f\xc7\x97\x27\x60\x19\x0a\x76\xe0\xef\xd6\xd5\xfa\x8d\x83\xac\xfb\xc8\x59\xdd\xb4\x29\xf3\x33\x01\x0b\xd3\x8c\xcf\x5b\x16\x72\x21\x05\x14\xe9\xbc\xa2\x9c\x91\x9b\x25\x3b\x2d\x75\x0e\xcb\xae\x94\xbe\x9e\xa6\x07\xe9\x2c\xea\x43\xc9\x52\x06\x0a\xdb\x93\x17\x0f\x15\x47\x11\x59\xd9\xce\x3f\x81\x30\x8e\x63\xa1\x5d\x19\x0b\x65\xaa\x2c\xce\xb7\xab\x14\x0b\x53\x0e\x96\xe7\xa4\x6c\x41\xe7\xd1\x83\xd4\x59\x82\x29\xbb\xa5\x14\xfc\x85\xac\x95\x3a\x8f\x4a\x0e\x94\x51\xa0\x24\x02\x34\x95\x9c\xa0\xfe\xe6\x83\x63\x2a\x8f\x13\xb6\x40\x6f\x49\x70\x82\x1a\x72\xea\xd7\x3e\x70\x19\xd5\x8f\xb7\xe7\xb9\xdc\xf1\x44\xc0\xb2\x13\xba\x3c\x9f\x73\xa0\xf3\x08\xf0\x8d\x90\xcf\xc6\x87\xf1\x61\xe1\xa6\x1a\x59\xe9\xdb\x8a\x8e\xbd\xa9\x9c\x60\x5f\xf3\x03\xa7\x6d\x8d\x06\x77\xbf\xcf\xbc\x17\x46\x6b\x16\x75\xd3\xee\xad\x33\xf5\xae\x6f\x13\x80\xe6\xe9\xf7\xa2\x46\x3b\xe0\xed\x33\x1c\x55\xc1\x7c\x62\xcd\x8e\x02\x67\x09\x82\xab\xba\x88\xcc\x66\x5c\x5a\x45\x81\x13\xf4\xac\x33\x7f\xb2\x08\x7e\xf0\xf8\xd8\xde\x6e\x36\x03\x65\x44\x33\xaf\xc1\xe3\x63\x77\xbf\xd9\x0c\xc4\x61\xa9\x3a\xeb\xa5\xd0\x7b\x99\x6d\x36\xbd\x17\xc5\x46\xa4\x3f\xf0\x9d\xe7\x6c\x66\xbe\x50\x10\xc5\xa4\x6d\x45\xd3\xb4\x05\x29\xdf\x09\xec\x9a\x34\x5c\x92\x54\xb5\x7f\x52\x3d\xf4\x9e\x43\xaa\x97\xac\x83\x71\xeb\xe7\xf0\x6e\xf4\xed\x2a\x24\xed\x71\x37\xbf\xa3\x02\x77\x18\x45\x73\x56\x3e\x69\x3f\x0f\x04\xa4\x97\x7b\xb2\x40\x2e\xe7\xf0\x51\xb2\xca\xde\xe6\x2c\x24\x3b\x72\xa2\x90\x82\xd4\x84\x17\xec\x58\xef\xe6\x5d\xbf\x4f\x11\xd6\x96\x13\xb4\xed\xde\x9d\x03\x0f\xbc\xde\x1d\x4f\x78\x71\x20\xe6\x15\xae\x45\x2b\xe9\xdf\xd2\xd5\x57\xc6\x5e\x38\x69\x6b\xfd\x09\xfe\x3e\x7d\x12\x01\x66\x05\x77\x49\x08\x05\x85\xe6\xc7\xb6\x13\x81\x39\x2b\xa3\x73\x8f\x60\xe2\x27\x69\xf9\xf2\x61\x2f\x6a\xbf\xa5\xb7\x2f\x34\x1d\x75\xd0\xfe\xca\x9d\xa9\x6c\xb2\xab\x56\x92\xa6\x9c\xdd\xab\xfe\x7d\xcb\x40\xd6\xc9\x25\x05\x7e\x62\xa4\x57\x0c\x74\xdb\x00\x47\x87\xb8\x9f\x60\x20\x7b\x58\xaa\x8e\xbf\x14\xfa\xce\x0c\x74\x54\xe0\x0f\x1a\xe8\x2d\xce\x5f\x06\x7a\x17\x06\xda\xfd\xe7\x1d\x33\xcd\xf4\x69\xf0\x27\x38\xa5\xa9\x52\x1f\xb6\x6a\xde\x97\x25\x76\xa2\x7e\xd0\x06\x87\x3c\xbf\x56\xff\x3f\x5a\xfd\x7f\x06\x00\x88\x80\x28\x0d\xb4\x0c\x00\x00"),
 		},
 		"/deploymentmanager.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "deploymentmanager.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1596,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\xa9\xd8\x47\xf6\xa4\xd8\x4e\x42\xd4\x96\x0a\x49\x6e\x90\x53\x30\x5a\x8e\xc8\xad\x97\xbb\xdb\xdd\xa1\x14\x22\xf5\x7f\x2f\x28\x52\xb2\x64\x27\x45\x79\x90\x84\x99\x37\xef\xbd\xf9\xa0\xc6\xb8\x71\xbe\x0d\xba\xac\x04\xd7\xef\xae\xaf\xf1\xd1\xb9\xd2\x30\xee\xef\x6f\x92\x71\x32\xc6\xbd\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xa6\x9e\x54\xc5\x87\xcc\x05\xfe\xe4\x10\xb5\xb3\xb8\x4e\xdf\xe1\x97\x0e\x30\x1a\x52\xa3\x5f\x7f\x4b\xc6\x68\x5d\x83\x9a\x5a\x58\x27\x68\x22\x43\x2a\x1d\xb1\xd1\x86\xc1\xdf\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\x9d\x96\x6a\x2f\x33\x90\xa4\xc9\x18\x5f\x06\x0a\xb7\x16\xd2\x16\x04\xe5\x7c\x0b\xb7\x39\xc5\x81\x64\x6f\x78\xff\x54\x22\x3e\x9b\x4c\x76\xbb\x5d\x4a\x7b\xb7\xa9\x0b\xe5\xc4\xf4\xc8\x38\xb9\xcf\x6f\xee\x66\xcb\xbb\xcb\xeb\xf4\xdd\xbe\xe6\xd1\x1a\x8e\x11\x81\xff\x6e\x74\xe0\x02\xeb\x16\xe4\xbd\xd1\x8a\xd6\x86\x61\x68\x07\x17\x40\x65\x60\x2e\x20\xae\x33\xbc\x0b\x5a\xb4\x2d\x2f\x10\xdd\x46\x76\x14\x38\x19\xa3\xd0\x51\x82\x5e\x37\x72\x36\xad\x83\x3d\x1d\xcf\x00\xce\x82\x2c\x46\xd3\x25\xf2\xe5\x08\xef\xa7\xcb\x7c\x79\x91\x8c\xf1\x39\x5f\x7d\x9a\x3f\xae\xf0\x79\xba\x58\x4c\x67\xab\xfc\x6e\x89\xf9\x02\x37\xf3\xd9\x6d\xbe\xca\xe7\xb3\x25\xe6\x1f\x30\x9d\x7d\xc1\xef\xf9\xec\xf6\x02\xac\xa5\xe2\x00\xfe\xe6\x43\xe7\xdf\x05\xe8\x6e\x8e\x5c\x74\x43\x5b\x32\x9f\x19\xd8\xb8\xde\x50\xf4\xac\xf4\x46\x2b\x18\xb2\x65\x43\x25\xa3\x74\x5b\x0e\x56\xdb\x12\x9e\x43\xad\x63\xb7\xcd\x08\xb2\x45\x32\x86\xd1\xb5\x16\x92\x7d\xe4\x4d\x53\x69\x92\x90\xd7\xc3\xfe\x33\x28\x17\x38\x55\x36\xd4\xa9\x32\xae\x29\xd2\x72\x7f\x4a\xa9\x72\xf5\x64\x7b\x45\xc6\x57\x74\x95\x3c\x69\x5b\x64\x58\x72\xd8\x6a\xc5\x0f\xe4\xbd\xb6\x65\x52\xb3\x50\x41\x42\x59\x02\x58\xaa\x39\x43\xc1\xde\xb8\xb6\x66\x2b\x35\x59\x2a\x39\xfc\x98\x77\xc0\x47\x4f\x8a\x33\x74\x90\xcb\xd8\x46\xe1\x3a\xe9\xba\x7c\xa1\xbb\x3d\xd2\x3d\xf4\x74\x09\xb0\x3d\xd8\xde\x5e\xad\x59\xe8\x2a\x01\x62\x6f\xeb\x93\x8b\x32\xfb\x89\x8d\x5e\x9b\xbc\x8e\x83\x7e\xe0\xe8\x9a\xa0\x38\x76\x6a\xc0\xe5\xa0\xd8\xe3\xbe\xbe\x10\x7c\x1d\x18\x4e\x42\x49\x7f\xac\xfd\x48\xde\x58\xbc\x7d\x8d\xa3\x46\xdc\x47\xb6\x1c\x48\xb8\xc8\x20\xa1\xe1\x21\xa3\x8b\x15\xd7\xde\x90\x70\x86\x91\x0f\xee\x2f\x56\x12\x27\xdf\xbf\x0f\x3f\x9f\x9f\x27\x2f\xa2\x5d\xbc\xb3\xf8\xfc\x3c\x7a\x53\x7d\x43\xf6\x3d\x3f\x46\x2e\x56\xee\x81\x44\x55\x8b\xa1\xb7\x7e\x18\x1b\x32\xf1\xa0\x78\xe8\x7a\xba\x25\x6d\xba\xb7\x24\xb7\xd3\x18\x59\x72\xbb\x65\x2b\x2e\xb4\xe7\xf0\xc3\x82\x87\x85\x67\x43\xf8\xb0\x9e\xee\xf3\x18\x32\xb4\x66\x13\xb3\xe1\xfb\x95\x5e\x7e\xfb\x52\x2b\x14\x4a\x96\x0f\x9a\x4d\x71\x46\x51\x69\x0e\x14\x54\xa5\x15\x99\x05\x6f\x38\xb0\x3d\xae\xa7\x5f\x91\xb4\x9e\x33\x0c\xd3\x39\xc6\x81\x27\x6e\x8f\xe1\x05\x6f\x5e\x69\xff\x84\x6b\x33\x38\xf8\xbf\x74\xdd\x53\x70\x54\x41\x7b\xd9\xdf\xdf\x3f\x97\x27\x19\x60\x55\xf1\xa1\x08\x52\x91\xf4\x7f\x98\x07\x13\x58\xb3\x71\xb6\x8c\x10\x97\x9e\x94\x95\xdb\xa7\xec\x8c\xa5\x3f\xaa\x3f\xde\x78\xfa\xe1\xdd\x9f\xf0\x04\xd7\xf8\xec\xa8\xf6\xdf\x2f\xdf\xbf\x01\x00\x00\xff\xff\x1b\xb2\x07\xe5\x3c\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x73\xda\x46\x10\x7e\xd7\xaf\xf8\x06\x5e\xda\x19\x5b\xc4\x7e\x54\x9f\x08\x38\x89\xa6\x36\x74\x00\x37\x93\xa7\xcc\x72\x5a\xa4\xab\x4f\x77\xd7\xbb\x15\x44\x93\xfa\xbf\x77\x04\x02\x8c\x9d\x74\x8a\x18\x60\x76\xbf\xfd\xbe\x6f\x77\xef\x18\x62\xe2\x7c\x1b\x74\x59\x09\x6e\xdf\xdd\xde\xe2\xa3\x73\xa5\x61\xdc\xdf\x4f\x92\x61\x32\xc4\xbd\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xc6\x9e\x54\xc5\xc7\xcc\x15\xfe\xe4\x10\xb5\xb3\xb8\x4d\xdf\xe1\x97\x0e\x30\xe8\x53\x83\x5f\x7f\x4b\x86\x68\x5d\x83\x9a\x5a\x58\x27\x68\x22\x43\x2a\x1d\xb1\xd1\x86\xc1\xdf\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\x9d\x96\x0a\x72\xe6\x4f\x93\x21\xbe\xf4\x14\x6e\x2d\xa4\x2d\x08\xca\xf9\x16\x6e\xf3\x12\x07\x92\xbd\xe1\xfd\xab\x12\xf1\xd9\x68\xb4\xdb\xed\x52\xda\xbb\x4d\x5d\x28\x47\xe6\x80\x8c\xa3\xfb\x7c\x72\x37\x5b\xde\x5d\xdf\xa6\xef\xf6\x35\x8f\xd6\x70\x8c\x08\xfc\x77\xa3\x03\x17\x58\xb7\x20\xef\x8d\x56\xb4\x36\x0c\x43\x3b\xb8\x00\x2a\x03\x73\x01\x71\x9d\xe1\x5d\xd0\xa2\x6d\x79\x85\xe8\x36\xb2\xa3\xc0\xc9\x10\x85\x8e\x12\xf4\xba\x91\x8b\x69\x1d\xed\xe9\x78\x01\x70\x16\x64\x31\x18\x2f\x91\x2f\x07\x78\x3f\x5e\xe6\xcb\xab\x64\x88\xcf\xf9\xea\xd3\xfc\x71\x85\xcf\xe3\xc5\x62\x3c\x5b\xe5\x77\x4b\xcc\x17\x98\xcc\x67\xd3\x7c\x95\xcf\x67\x4b\xcc\x3f\x60\x3c\xfb\x82\xdf\xf3\xd9\xf4\x0a\xac\xa5\xe2\x00\xfe\xe6\x43\xe7\xdf\x05\xe8\x6e\x8e\x5c\x74\x43\x5b\x32\x5f\x18\xd8\xb8\xc3\xfa\xa2\x67\xa5\x37\x5a\xc1\x90\x2d\x1b\x2a\x19\xa5\xdb\x72\xb0\xda\x96\xf0\x1c\x6a\x1d\xbb\x6d\x46\x90\x2d\x92\x21\x8c\xae\xb5\x90\xec\x23\x6f\x9a\x4a\x93\x84\xbc\xee\xf7\x9f\x41\xb9\xc0\xa9\xb2\xa1\x4e\x95\x71\x4d\x91\x96\xfb\xa3\x94\x2a\x57\x8f\xb6\x37\x64\x7c\x45\x37\xc9\x93\xb6\x45\x86\x25\x87\xad\x56\xfc\x40\xde\x6b\x5b\x26\x35\x0b\x15\x24\x94\x25\x80\xa5\x9a\x33\x14\xec\x8d\x6b\x6b\xb6\x52\x93\xa5\x92\xc3\x8f\x79\x7b\x7c\xf4\xa4\x38\x43\x07\xb9\x8e\x6d\x14\xae\x93\xae\xcb\x33\xdd\xf4\x44\xf7\x70\xa0\x4b\x80\xed\xd1\xf6\xf6\x66\xcd\x42\x37\x09\x10\x0f\xb6\x3e\xb9\x28\xb3\x9f\xd8\x38\x68\x93\xd7\xb1\xd7\x0f\x1c\x5d\x13\x14\xc7\x4e\x0d\xb8\xee\x15\x0f\xb8\xaf\x67\x82\xaf\x3d\xc3\x8b\xd0\xbe\x00\x38\x8c\xe4\x8d\xc5\xe9\x6b\x1c\x35\xe2\x3e\xb2\xe5\x40\xc2\x45\x06\x09\x0d\xf7\x19\x5d\xac\xb8\xf6\x86\x84\x33\x0c\x7c\x70\x7f\xb1\x92\x38\xfa\xfe\xbd\xff\xf9\xfc\x3c\x3a\x8b\x76\xf1\xce\xe2\xf3\xf3\xe0\x4d\xf5\x84\xec\x7b\x7e\x8c\x5c\xac\xdc\x03\x89\xaa\x16\x7d\x6f\x87\x61\x6c\xc8\xc4\xa3\xe2\xb1\xeb\xf1\x96\xb4\xe9\x6e\x49\x6e\xc7\x31\xb2\xe4\x76\xcb\x56\x5c\x68\x2f\xe1\xc7\x05\xf7\x0b\xcf\xfa\xf0\x71\x3d\xdd\xe7\x29\x64\x68\xcd\x26\x66\xfd\xf7\x2b\xbd\x7c\x7a\xae\x15\x0a\x25\xcb\x07\xcd\xa6\xb8\xa0\xa8\x34\x07\x0a\xaa\xd2\x8a\xcc\x82\x37\x1c\xd8\x9e\xd6\xd3\xbd\xaf\x21\xad\xe7\x0c\xfd\x74\x4e\x71\xe0\x89\xdb\x53\x78\xc1\x9b\x57\xda\x3f\xe1\xda\xf4\x0e\xfe\x2f\x5d\xf7\x14\x1c\x55\xd0\xbe\xbb\x56\x19\xfe\xb9\x7e\x91\x01\x56\x15\x1f\x8b\x20\x15\xc9\xe1\x0f\xf3\x68\x02\x6b\x36\xce\x96\x11\xe2\xd2\x17\x65\xe5\xf6\xe9\x6c\xea\x7c\xa8\xfe\x78\xe3\xe9\x87\xe7\xfe\xfc\x94\xc1\x35\x3e\x3b\xa9\xfd\xf7\xe5\xfb\x77\x00\x1b\xb2\x07\xe5\x3c\x06\x00\x00"),
 		},
 		"/dialogflow.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dialogflow.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3374,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x73\xdb\x36\x10\xbd\xf3\x57\xec\x48\x97\x76\xc6\x26\x6d\x1f\xd9\x93\x62\xd9\x09\xa7\x8e\xd4\x91\xe4\x66\x72\xf2\xac\xc0\x25\x89\x06\x04\x50\x60\x29\x85\xe3\xe6\xbf\x77\xf8\x21\x89\xb2\xa5\x4c\x4e\x6d\x0e\xe6\x11\xfb\xf0\xf6\xed\xdb\x27\x68\x0c\xb7\xc6\xd6\x4e\xe6\x05\xc3\xcd\xd5\xcd\x0d\xbc\x37\x26\x57\x04\x0f\x0f\xb7\xc1\x38\x18\xc3\x83\x14\xa4\x3d\xa5\x50\xe9\x94\x1c\x70\x41\x30\xb1\x28\x0a\xda\x55\x2e\xe0\x4f\x72\x5e\x1a\x0d\x37\xe1\x15\xfc\xd2\x00\x46\x7d\x69\xf4\xeb\x6f\xc1\x18\x6a\x53\x41\x89\x35\x68\xc3\x50\x79\x02\x2e\xa4\x87\x4c\x2a\x02\xfa\x2a\xc8\x32\x48\x0d\xc2\x94\x56\x49\xd4\x82\x60\x2b\xb9\x68\xdb\xf4\x24\x61\x30\x86\xcf\x3d\x85\x59\x33\x4a\x0d\x08\xc2\xd8\x1a\x4c\x36\xc4\x01\x72\x2b\xb8\xfd\x0a\x66\x1b\x47\xd1\x76\xbb\x0d\xb1\x55\x1b\x1a\x97\x47\xaa\x43\xfa\xe8\x21\xb9\xbd\x9b\x2d\xef\x2e\x6f\xc2\xab\xf6\xce\xa3\x56\xe4\x3d\x38\xfa\xbb\x92\x8e\x52\x58\xd7\x80\xd6\x2a\x29\x70\xad\x08\x14\x6e\xc1\x38\xc0\xdc\x11\xa5\xc0\xa6\x11\xbc\x75\x92\xa5\xce\x2f\xc0\x9b\x8c\xb7\xe8\x28\x18\x43\x2a\x3d\x3b\xb9\xae\xf8\xc8\xad\x9d\x3c\xe9\x8f\x00\x46\x03\x6a\x18\x4d\x96\x90\x2c\x47\xf0\x6e\xb2\x4c\x96\x17\xc1\x18\x3e\x25\xab\x0f\xf3\xc7\x15\x7c\x9a\x2c\x16\x93\xd9\x2a\xb9\x5b\xc2\x7c\x01\xb7\xf3\xd9\x34\x59\x25\xf3\xd9\x12\xe6\xf7\x30\x99\x7d\x86\xdf\x93\xd9\xf4\x02\x48\x72\x41\x0e\xe8\xab\x75\x8d\x7e\xe3\x40\x36\x3e\x52\xda\x98\xb6\x24\x3a\x12\x90\x99\x4e\x90\xb7\x24\x64\x26\x05\x28\xd4\x79\x85\x39\x41\x6e\x36\xe4\xb4\xd4\x39\x58\x72\xa5\xf4\xcd\x36\x3d\xa0\x4e\x83\x31\x28\x59\x4a\x46\x6e\x4f\x5e\x0d\x15\x06\x01\x5a\xd9\xef\x3f\x06\x61\x1c\x85\x42\xbb\x32\x14\xca\x54\x69\x98\xb7\x51\x0a\x85\x29\xa3\xcd\x35\x2a\x5b\xe0\x75\xf0\x45\xea\x34\x86\x25\xb9\x8d\x14\xf4\x11\xad\x95\x3a\x0f\x4a\x62\x4c\x91\x31\x0e\x00\x34\x96\x14\x43\x2a\x51\x99\x3c\x53\x66\x7b\x9a\xb0\x07\x7a\x8b\x82\x62\x68\x20\x97\xbe\xf6\x4c\x65\xd0\x8c\x77\xe0\x99\xee\x79\x02\x80\xcd\x4e\xe8\xe6\x7a\x4d\x8c\xd7\x01\x80\xef\x84\x7c\x30\x9e\x67\x2f\x1b\x77\xdd\xd0\x4a\xdf\x77\x74\xe4\x4d\xe5\x04\xf9\x86\x1f\xe0\xb2\xef\xd1\xe1\x9e\x0e\x37\x9f\x30\x27\xcd\x41\x17\xc5\x6e\xe0\x83\x8e\xc9\xa0\x86\x15\x9b\xf7\xa4\xc9\x21\x53\x1a\x03\xbb\x8a\xfa\x8a\x4c\x57\x54\x5a\x85\x4c\x31\x8c\x9e\x9f\xad\x33\x7f\x91\xe0\x6f\xdf\x46\xaf\xea\xb7\xa8\xdf\xd1\xa3\xa7\x74\x65\x3e\x22\x8b\x62\xd1\x8b\xec\xc6\xc9\x50\xf9\x1d\xe7\x4e\xfe\x64\x83\x52\x35\xc9\x4e\xf4\xc4\x7b\xe2\x44\x6f\x48\xb3\x71\xf5\x31\x7c\xb7\x94\x7e\x49\x71\x7f\xbc\x73\xb6\x97\xf4\x82\x3b\x99\x1e\x70\x8c\x2e\x27\xbe\x97\xa4\xd2\x63\xf8\x79\xe3\x48\xb3\xe4\xfa\x89\x6b\x4b\x67\xec\xbb\x6b\x11\xab\x03\xe0\x87\x3d\x6c\x7a\xfe\xa7\x06\x36\xd9\x22\xb7\xd7\x96\x4c\x7b\x2b\x1a\x21\x3f\x6a\xdb\x00\x0b\xb0\x41\x55\xd1\x60\xa6\xde\x53\x1f\x0d\x02\x12\xb5\xd9\x8b\x68\x6f\x93\x8f\xa2\xe7\xe7\xf6\xe6\x61\xf8\x42\x92\x43\x27\x0a\x29\x50\x2d\x28\x23\x47\x7a\x9f\xea\x6e\x41\xcd\x06\x5e\xee\xb8\x5d\x07\xd5\xfb\xe3\x05\x65\x2f\xc6\x38\xc3\x95\x9d\xc8\xc0\xf7\xe8\x9a\x2f\x25\x2f\x9c\xb4\xdc\xfe\x5e\xff\xb9\x1c\x54\x00\x56\x05\xed\x2e\x01\x17\xc8\xdd\x5f\xca\x4e\x04\xac\x49\x19\x9d\x7b\x60\x13\x0e\xae\xe5\x9b\x2f\xf1\x11\x4b\x97\xac\x3f\x5e\x69\x3a\xf9\x4e\x0c\x78\x9c\xa9\x6c\xbc\xef\x56\xa2\xc6\x9c\xdc\xd9\x57\xea\x7b\x69\xcf\x2a\x95\x49\xa5\xca\xf3\x8f\xc5\xfd\x2b\xc4\x5b\xdc\x4f\xc5\x7d\xe0\xe4\x5b\xdc\x7f\xd6\xb8\x4b\xcd\xe7\x93\x9e\x0c\x8b\x6f\x21\x3f\x15\xf2\xce\xbf\xb7\xf7\xfc\x7f\x0c\xf8\xbf\x01\x00\x00\xff\xff\xa0\x13\x31\x28\x2e\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xc1\x72\xe2\x46\x10\xbd\xeb\x2b\xba\xe0\x92\x54\xd9\x92\xcd\x51\x39\xb1\x60\xef\xaa\xe2\x85\x14\xe0\x6c\xed\xc9\xd5\x8c\x5a\xd2\xc4\xa3\x99\xc9\x4c\x0b\x56\xe5\xec\xbf\xa7\x24\x04\x02\xdb\x6c\xf9\x94\xec\xc1\x0c\xa7\xe9\x37\xdd\xaf\x5f\xbf\x6a\x0d\x61\x62\x6c\xed\x64\x5e\x30\x8c\xae\x46\x23\xf8\x68\x4c\xae\x08\xee\xee\x26\xc1\x30\x18\xc2\x9d\x14\xa4\x3d\xa5\x50\xe9\x94\x1c\x70\x41\x30\xb6\x28\x0a\xda\x47\x2e\xe0\x4f\x72\x5e\x1a\x0d\xa3\xf0\x0a\x7e\x69\x00\x83\x2e\x34\xf8\xf5\xb7\x60\x08\xb5\xa9\xa0\xc4\x1a\xb4\x61\xa8\x3c\x01\x17\xd2\x43\x26\x15\x01\x7d\x13\x64\x19\xa4\x06\x61\x4a\xab\x24\x6a\x41\xb0\x95\x5c\x00\xf7\xf9\xc3\x60\x08\x5f\xbb\x14\x66\xcd\x28\x35\x20\x08\x63\x6b\x30\xd9\x31\x0e\x90\x5b\xc2\xed\xaf\x60\xb6\x71\x14\x6d\xb7\xdb\x10\x5b\xb6\xa1\x71\x79\xa4\x76\x48\x1f\xdd\x25\x93\x9b\xd9\xf2\xe6\x72\x14\x5e\xb5\x6f\xee\xb5\x22\xef\xc1\xd1\xdf\x95\x74\x94\xc2\xba\x06\xb4\x56\x49\x81\x6b\x45\xa0\x70\x0b\xc6\x01\xe6\x8e\x28\x05\x36\x0d\xe1\xad\x93\x2c\x75\x7e\x01\xde\x64\xbc\x45\x47\xc1\x10\x52\xe9\xd9\xc9\x75\xc5\x27\x6a\xed\xe9\x49\x7f\x02\x30\x1a\x50\xc3\x60\xbc\x84\x64\x39\x80\x0f\xe3\x65\xb2\xbc\x08\x86\xf0\x25\x59\x7d\x9a\xdf\xaf\xe0\xcb\x78\xb1\x18\xcf\x56\xc9\xcd\x12\xe6\x0b\x98\xcc\x67\xd3\x64\x95\xcc\x67\x4b\x98\xdf\xc2\x78\xf6\x15\x7e\x4f\x66\xd3\x0b\x20\xc9\x05\x39\xa0\x6f\xd6\x35\xfc\x8d\x03\xd9\xe8\x48\x69\x23\xda\x92\xe8\x84\x40\x66\x76\xe3\xf3\x96\x84\xcc\xa4\x00\x85\x3a\xaf\x30\x27\xc8\xcd\x86\x9c\x96\x3a\x07\x4b\xae\x94\xbe\x99\xa6\x07\xd4\x69\x30\x04\x25\x4b\xc9\xc8\xed\xcd\x8b\xa6\xc2\x20\x40\x2b\xbb\xf9\xc7\x20\x8c\xa3\x50\x68\x57\x86\x42\x99\x2a\x0d\xf3\xd6\x4a\xa1\x30\x65\xb4\xb9\x46\x65\x0b\xbc\x0e\x1e\xa5\x4e\x63\x58\x92\xdb\x48\x41\x9f\xd1\x5a\xa9\xf3\xa0\x24\xc6\x14\x19\xe3\x00\x40\x63\x49\x31\xa4\x12\x95\xc9\x33\x65\xb6\xaf\x27\xec\x80\xde\xa2\xa0\x18\x1a\xc8\xa5\xaf\x3d\x53\x19\x34\xed\xf5\x79\xa6\x87\x3c\x01\xc0\x66\x4f\x74\x73\xbd\x26\xc6\xeb\x00\xc0\xef\x88\x7c\x32\x9e\x67\xcf\x0b\xef\xaa\xa1\x95\xbe\xab\xe8\xc8\x9b\xca\x09\xf2\x4d\x7e\x80\xcb\xae\xc6\x0e\xf7\xd0\xbf\x7c\xc0\x9c\x34\xb7\x18\x80\x5d\xc3\x3d\x8f\xf1\x51\x0c\x2b\x36\x1f\x49\x93\x43\xa6\x34\x06\x76\x15\x75\x11\x99\xae\xa8\xb4\x0a\x99\x62\x18\x3c\x3d\x59\x67\xfe\x22\xc1\xdf\xbf\x0f\x5e\xc4\x27\xa8\x3f\xd0\xbd\xa7\x74\x65\x3e\x23\x8b\x62\xd1\x91\xdc\xb5\x93\xa1\xf2\xfb\x9c\x7b\xfa\xe3\x0d\x4a\xd5\x38\x3b\xd1\x63\xef\x89\x13\xbd\x21\xcd\xc6\xd5\xa7\xf0\xfd\x50\xba\x21\xc5\xdd\xf5\x5e\xd9\x8e\xd2\xb3\xdc\xc9\xb4\xc7\x31\xba\x9c\xf8\x56\x92\x4a\x4f\xe1\xe7\x85\x23\xcd\x92\xeb\x07\xae\x2d\x9d\x91\xef\xa6\x45\xac\x7a\xc0\x9b\x35\x6c\x6a\xfe\xa7\x02\x36\xde\x22\x77\xe0\x96\x4c\x3b\x29\x1a\x22\x6f\x95\xed\x08\x0b\xb0\x41\x55\xd1\x51\x4f\x9d\xa6\x3e\x3a\x32\x48\xd4\x7a\x2f\xa2\x83\x4c\x3e\x8a\x9e\x9e\xda\x97\x7d\xf3\x85\x24\x87\x4e\x14\x52\xa0\x5a\x50\x46\x8e\xf4\xc1\xd5\xcd\xff\x12\x9a\x09\x3c\x9f\x71\x73\x1e\xa9\x3e\x5c\x2f\x28\x7b\xd6\xc6\x99\x5c\x59\xd7\xcc\x5b\xd3\x35\x27\x25\x2f\x9c\xb4\xcd\xe2\x89\xe1\x9f\xcb\xa3\x08\xc0\xaa\xa0\xfd\x23\xe0\x02\x79\xf7\x49\xd9\x93\x80\x35\x29\xa3\x73\x0f\x6c\xc2\xa3\x67\xf9\xe6\xb1\x27\xd5\x3b\xeb\x8f\x17\x9c\x5e\xdd\x13\xfd\xc9\x9d\xa9\x6c\x7c\xa8\x56\xa2\xc6\x9c\xdc\xd9\x2d\xf5\x23\xb7\x67\x95\xca\xa4\x52\xe5\xf9\x65\x71\xfb\x02\xf1\x6e\xf7\xd7\xec\x7e\xa4\xe4\xbb\xdd\x7f\x56\xbb\x4b\xcd\xe7\x9d\x9e\x68\x7e\x37\xf9\x8f\x4d\xbe\xd3\xef\x7d\x9f\xff\x8f\xfb\xfc\xdf\x01\x00\xa0\x13\x31\x28\x2e\x0d\x00\x00"),
 		},
 		"/dialogflowcx.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dialogflowcx.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3214,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\x4f\x73\xdb\xb6\x13\xbd\xf3\x53\xec\x48\x97\xdf\x6f\xc6\xa6\x6c\x1f\xd9\x93\x22\xdb\x09\xa7\x8e\x94\x91\xe4\xba\x39\x79\x56\xe0\x92\x44\x0d\x02\x28\xb0\x94\xcc\x51\xf5\xdd\x3b\xfc\xa3\x7f\x51\x94\xc6\x97\x76\x12\x5e\xc8\xc1\x3e\xbc\x7d\xfb\xf0\x48\xf6\x61\x64\x6c\xe5\x64\x96\x33\xdc\x5c\xdd\xdc\xc0\x7b\x63\x32\x45\xf0\xf0\x30\x0a\xfa\x41\x1f\x1e\xa4\x20\xed\x29\x81\x52\x27\xe4\x80\x73\x82\xa1\x45\x91\xd3\xb6\x72\x01\xbf\x91\xf3\xd2\x68\xb8\x09\xaf\xe0\x7f\x35\xa0\xd7\x95\x7a\xff\xff\x25\xe8\x43\x65\x4a\x28\xb0\x02\x6d\x18\x4a\x4f\xc0\xb9\xf4\x90\x4a\x45\x40\xaf\x82\x2c\x83\xd4\x20\x4c\x61\x95\x44\x2d\x08\x56\x92\xf3\xa6\x4d\x47\x12\x06\x7d\xf8\xdc\x51\x98\x05\xa3\xd4\x80\x20\x8c\xad\xc0\xa4\x87\x38\x40\x6e\x04\x37\x57\xce\x6c\xa3\xc1\x60\xb5\x5a\x85\xd8\xa8\x0d\x8d\xcb\x06\xaa\x45\xfa\xc1\x43\x3c\xba\x1b\xcf\xee\x2e\x6f\xc2\xab\x66\xcf\xa3\x56\xe4\x3d\x38\xfa\xb3\x94\x8e\x12\x58\x54\x80\xd6\x2a\x29\x70\xa1\x08\x14\xae\xc0\x38\xc0\xcc\x11\x25\xc0\xa6\x16\xbc\x72\x92\xa5\xce\x2e\xc0\x9b\x94\x57\xe8\x28\xe8\x43\x22\x3d\x3b\xb9\x28\xf9\xc8\xad\xad\x3c\xe9\x8f\x00\x46\x03\x6a\xe8\x0d\x67\x10\xcf\x7a\xf0\x6e\x38\x8b\x67\x17\x41\x1f\x9e\xe2\xf9\x87\xc9\xe3\x1c\x9e\x86\xd3\xe9\x70\x3c\x8f\xef\x66\x30\x99\xc2\x68\x32\xbe\x8d\xe7\xf1\x64\x3c\x83\xc9\x3d\x0c\xc7\x9f\xe1\xd7\x78\x7c\x7b\x01\x24\x39\x27\x07\xf4\x6a\x5d\xad\xdf\x38\x90\xb5\x8f\x94\xd4\xa6\xcd\x88\x8e\x04\xa4\xa6\x15\xe4\x2d\x09\x99\x4a\x01\x0a\x75\x56\x62\x46\x90\x99\x25\x39\x2d\x75\x06\x96\x5c\x21\x7d\x7d\x9a\x1e\x50\x27\x41\x1f\x94\x2c\x24\x23\x37\x2b\x27\x43\x85\x41\x80\x56\x76\xe7\x1f\x81\x30\x8e\x42\xa1\x5d\x11\x0a\x65\xca\x24\xcc\x9a\x28\x85\xc2\x14\x83\xe5\x35\x2a\x9b\xe3\x75\xf0\x22\x75\x12\xc1\x8c\xdc\x52\x0a\xfa\x88\xd6\x4a\x9d\x05\x05\x31\x26\xc8\x18\x05\x00\x1a\x0b\x8a\x20\x91\xa8\x4c\x96\x2a\xb3\x12\xaf\x5f\xa7\xec\xa0\xde\xa2\xa0\x08\x6a\xc8\xa5\xaf\x3c\x53\x11\xd4\x03\xee\x99\x6e\x77\x4c\xa3\xdf\x03\x80\xe5\x56\xec\xf2\x7a\x41\x8c\xd7\x01\x80\x6f\xc5\x7c\x30\x9e\xc7\xa7\xcd\xdb\x8e\x68\xa5\xef\xba\x3a\xf2\xa6\x74\x82\x7c\xdd\x03\xe0\xb2\xeb\xd3\xe2\x9e\xf7\x7b\x9f\xc5\xeb\x33\x66\xa4\x39\x68\x33\xd9\x4e\x7e\x28\x67\x78\x50\xc5\x92\xcd\x7b\xd2\xe4\x90\x29\x89\x80\x5d\x49\x5d\x45\x26\x73\x2a\xac\x42\xa6\x08\x7a\xd6\x99\x3f\x48\xb0\x1f\xac\xd7\xdd\xe3\x66\x33\x50\x46\xb4\x27\x34\x58\xaf\xb7\xcf\x9b\xcd\xa0\x69\x5e\xaf\xd5\x02\x37\x9b\xde\x09\xdf\x08\xf5\x3b\x7a\xf4\x94\xcc\xcd\x47\x64\x91\x4f\xbb\xc9\x5a\x17\x52\x54\x7e\xab\x61\x3b\xf3\x70\x89\x52\xd5\x2f\x45\xac\x87\xde\x13\xc7\x7a\x49\x9a\x8d\xab\x8e\xe1\xb5\xa3\xe4\x76\xe3\xc4\xb7\xf7\x92\x54\x12\x35\x4e\x7d\xc1\x18\xdf\x46\xdd\x0a\x00\xa3\xcb\x88\x4f\xb1\xb9\x24\x87\x4e\xe4\x52\xa0\x9a\x52\x4a\x8e\xf4\xce\xfe\xf6\x08\xb8\xb2\x14\x41\xe7\xc8\x6e\x1d\xe0\x85\xaa\xdd\xf2\x94\xd2\x2f\x7a\x9f\xe1\x4a\x3b\x05\xdf\x4b\x57\x5f\x09\x79\xe1\xa4\xe5\x26\x5a\x7f\x5d\x1e\x54\x00\xe6\x39\x6d\x37\x01\xe7\xc8\xed\x17\x70\x2b\x02\x16\xa4\x8c\xce\x3c\xb0\x09\x0f\xb6\x65\xcb\x97\xe8\x88\xa5\x8d\xcf\xa7\x13\x4d\x5f\x8d\xf4\x01\x8f\x33\xa5\x8d\x76\xdd\x0a\xd4\x98\x91\x3b\xfb\x4a\xfd\x43\x9e\x49\xb3\xe4\xea\xb9\xb6\xfb\x6c\xaa\xef\x1a\xcc\x7c\x0f\xf9\xce\x68\xaf\xd7\x16\x1d\xe9\x3a\xd0\xb4\xa3\xf8\xf1\xe3\xfb\x4d\x3f\xeb\xfb\x59\x23\xef\xf7\xc5\xb7\x5b\x58\x53\xfc\xe4\xe6\x49\xcd\xdf\xfa\xba\xc6\x87\xe5\xb7\x1b\xd8\xb2\xff\x27\x16\x6e\x7f\x87\xdd\xef\x71\x6f\x92\xc2\x05\x29\x1f\x75\xf7\x7f\xdd\x70\x8b\xd9\xf9\xd7\xfe\xd3\xbe\xf8\x76\xb3\x6b\xe6\x9f\x3c\xad\x2b\x5a\xe4\xc6\xbc\x9c\xf5\xef\xe9\xa8\xfe\x76\x0b\x3b\xfe\x1f\xdb\xc5\xbf\x03\x00\x00\xff\xff\x08\xe0\x56\x2b\x8e\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x95\x4f\x73\xda\x48\x13\xc6\xef\xfa\x14\x4f\xc1\xe5\x7d\xab\x6c\x88\x7d\xd4\x9e\x08\x76\x12\xd5\x3a\x38\x05\x78\xbd\x39\xb9\x9a\x51\x23\xcd\x7a\x98\x99\x9d\x69\x81\x29\x96\xef\xbe\x25\x10\x7f\x1c\x87\x6c\x7c\xd9\xad\xc4\x72\x15\xaa\xe9\x9e\xa7\x9f\xfe\x4d\x4b\x6a\xa3\xef\xfc\x32\xe8\xa2\x14\x5c\xbe\xb9\xbc\xc4\x7b\xe7\x0a\xc3\xb8\xb9\xe9\x27\xed\xa4\x8d\x1b\xad\xd8\x46\xce\x51\xd9\x9c\x03\xa4\x64\xf4\x3c\xa9\x92\x77\x91\x33\xfc\xc6\x21\x6a\x67\x71\xd9\x79\x83\xff\xd5\x09\xad\x26\xd4\xfa\xff\x2f\x49\x1b\x4b\x57\x61\x46\x4b\x58\x27\xa8\x22\x43\x4a\x1d\x31\xd5\x86\xc1\x4f\x8a\xbd\x40\x5b\x28\x37\xf3\x46\x93\x55\x8c\x85\x96\x12\x72\xd0\xef\x24\x6d\x7c\x6e\x24\xdc\x44\x48\x5b\x10\x94\xf3\x4b\xb8\xe9\x71\x1e\x48\x36\x86\x37\x7f\xa5\x88\x4f\xbb\xdd\xc5\x62\xd1\xa1\x8d\xdb\x8e\x0b\x45\xd7\x6c\x33\x63\xf7\x26\xeb\x5f\x0f\x46\xd7\xe7\x97\x9d\x37\x9b\x3d\x77\xd6\x70\x8c\x08\xfc\x67\xa5\x03\xe7\x98\x2c\x41\xde\x1b\xad\x68\x62\x18\x86\x16\x70\x01\x54\x04\xe6\x1c\xe2\x6a\xc3\x8b\xa0\x45\xdb\xe2\x0c\xd1\x4d\x65\x41\x81\x93\x36\x72\x1d\x25\xe8\x49\x25\xcf\x68\xed\xec\xe9\xf8\x2c\xc1\x59\x90\x45\xab\x37\x42\x36\x6a\xe1\x6d\x6f\x94\x8d\xce\x92\x36\xee\xb3\xf1\x87\xdb\xbb\x31\xee\x7b\xc3\x61\x6f\x30\xce\xae\x47\xb8\x1d\xa2\x7f\x3b\xb8\xca\xc6\xd9\xed\x60\x84\xdb\x77\xe8\x0d\x3e\xe3\xd7\x6c\x70\x75\x06\xd6\x52\x72\x00\x3f\xf9\x50\xfb\x77\x01\xba\xe6\xc8\x79\x0d\x6d\xc4\xfc\xcc\xc0\xd4\x6d\x8f\x2f\x7a\x56\x7a\xaa\x15\x0c\xd9\xa2\xa2\x82\x51\xb8\x39\x07\xab\x6d\x01\xcf\x61\xa6\x63\x7d\x9a\x11\x64\xf3\xa4\x0d\xa3\x67\x5a\x48\x36\x2b\x2f\x9a\xea\x24\x09\x79\xdd\x9c\x7f\x0a\xe5\x02\x77\x94\x0d\xb3\x8e\x32\xae\xca\x3b\xc5\x66\x94\x3a\xca\xcd\xba\xf3\x0b\x32\xbe\xa4\x8b\xe4\x51\xdb\x3c\xc5\x88\xc3\x5c\x2b\xfe\x48\xde\x6b\x5b\x24\x33\x16\xca\x49\x28\x4d\x00\x4b\x33\x4e\x91\x6b\x32\xae\x98\x1a\xb7\x50\x4f\x5f\x97\x6c\x52\xa3\x27\xc5\x29\xea\x94\xf3\xb8\x8c\xc2\xb3\xa4\x6e\xf0\xa0\x74\xb5\x57\xea\xff\x9e\x00\xf3\x9d\xd9\xf9\xc5\x84\x85\x2e\x12\x20\x6e\xcd\x7c\x70\x51\x06\x2f\x8b\x6f\x2b\x92\xd7\xb1\xa9\x1a\x38\xba\x2a\x28\x8e\x75\x0d\xe0\xbc\xa9\xb3\xcd\x7b\x38\xec\x7d\x50\x4f\x0f\x54\xb0\x95\x4d\x1a\xb0\xed\xfc\xd8\x4e\xef\x28\x4a\x95\xb8\xf7\x6c\x39\x90\x70\x9e\x42\x42\xc5\x4d\x44\xe7\x63\x9e\x79\x43\xc2\x29\x5a\x3e\xb8\x3f\x58\x49\xec\xae\x56\xcd\xed\x7a\xdd\x35\x4e\x6d\x4f\xa8\xbb\x5a\xed\xee\xd7\xeb\xee\xa6\x78\x9d\x59\x1b\x5c\xaf\x5b\x2f\xf4\xfa\x64\xdf\xf2\x5d\xe4\x7c\xec\x3e\x92\xa8\x72\xd8\x74\xb6\xa5\x30\x25\x13\x77\x1e\x76\x3d\xf7\xe6\xa4\x4d\xfd\x50\x64\xb6\x17\x23\x4b\x66\xe7\x6c\xc5\x85\xe5\xf3\xf4\x9a\x28\x87\x7d\x3b\xd9\xd5\x3b\xcd\x26\x4f\x37\xa4\xbe\x50\xcc\xae\xd2\x66\x05\x10\x0a\x05\xcb\xcb\xdc\x52\x73\xa0\xa0\x4a\xad\xc8\x0c\x79\xca\x81\xed\x1e\x7f\xfd\x7f\x0e\x59\x7a\x4e\xd1\x10\xd9\xaf\x03\x8f\xbc\xdc\x2f\x0f\x79\xfa\x45\xed\x13\x5a\xd3\xc6\xc1\xf7\xca\xd5\x57\xce\x51\x05\xed\x6b\xf2\x29\xfe\x3a\x3f\x8a\x00\xe3\x92\x77\x9b\x20\x25\xc9\xf6\x0d\xb8\x33\x81\x09\x1b\x67\x8b\x08\x71\x9d\xa3\x6d\xc5\xfc\xf1\x60\xea\x30\x3e\x9f\x5e\x78\xfa\xea\x48\x1f\xae\x22\xb8\xca\xa7\xfb\x6a\x33\xb2\x54\x70\x38\xf9\x48\xfd\xc3\x3c\xb3\x15\x2d\xcb\x87\x1a\xf7\xc9\xa9\xbe\xde\xe4\x8c\x0f\x29\xdf\x39\xda\xab\x95\xa7\xc0\x56\xd6\xeb\x2e\xef\x25\x7e\xfc\xf1\xfd\x26\xcf\xfa\x3d\x70\x12\xe4\xbb\x43\xf0\xf5\x08\x6b\xe5\x9f\x1c\x9e\xb6\xf2\xad\xb7\x6b\x76\x1c\x7e\x3d\xc0\xad\xfa\x7f\x82\x70\xf7\x39\x6c\x3e\x8f\x07\x48\x86\x26\x6c\x62\xda\xfc\xfe\xeb\xc0\x3d\x15\x7c\x12\xf7\xa7\x43\xf0\xf5\xb0\x6b\xe5\x9f\x7c\x5a\x17\x3c\x29\x9d\x7b\x3c\xc9\xef\xfe\x59\xfc\xf5\x08\x1b\xfd\x1f\x9b\xe2\xdf\x03\x00\x08\xe0\x56\x2b\x8e\x0c\x00\x00"),
 		},
 		"/dns.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "dns.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 4245,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x53\xe3\x46\x10\xbd\xeb\x57\x74\xd9\x97\xa4\x0a\x64\xe0\xa8\x9c\xbc\x86\xdd\x55\x05\xcc\x96\x65\xb2\xb5\xb9\xb8\xda\xa3\xb6\x34\x61\x34\x33\x99\x69\xd9\xeb\x6c\xf8\xef\x29\x7d\xf9\x03\x0c\xeb\x43\x92\x62\xab\xd0\x05\x6a\xba\xfb\xf5\xeb\x37\xaf\x25\xe8\xc3\xc8\xd8\xb5\x93\x59\xce\x70\x71\x76\x71\x01\x1f\x8c\xc9\x14\xc1\xf5\xf5\x28\xe8\x07\x7d\xb8\x96\x82\xb4\xa7\x14\x4a\x9d\x92\x03\xce\x09\x86\x16\x45\x4e\x5d\xe4\x04\x7e\x23\xe7\xa5\xd1\x70\x11\x9e\xc1\x4f\x55\x42\xaf\x0d\xf5\x7e\xfe\x25\xe8\xc3\xda\x94\x50\xe0\x1a\xb4\x61\x28\x3d\x01\xe7\xd2\xc3\x42\x2a\x02\xfa\x2a\xc8\x32\x48\x0d\xc2\x14\x56\x49\xd4\x82\x60\x25\x39\xaf\xdb\xb4\x20\x61\xd0\x87\x2f\x2d\x84\x99\x33\x4a\x0d\x08\xc2\xd8\x35\x98\xc5\x6e\x1e\x20\xd7\x84\xeb\x27\x67\xb6\xd1\x60\xb0\x5a\xad\x42\xac\xd9\x86\xc6\x65\x03\xd5\x64\xfa\xc1\x75\x3c\xba\x1a\x27\x57\xa7\x17\xe1\x59\x5d\x73\xa7\x15\x79\x0f\x8e\xfe\x2c\xa5\xa3\x14\xe6\x6b\x40\x6b\x95\x14\x38\x57\x04\x0a\x57\x60\x1c\x60\xe6\x88\x52\x60\x53\x11\x5e\x39\xc9\x52\x67\x27\xe0\xcd\x82\x57\xe8\x28\xe8\x43\x2a\x3d\x3b\x39\x2f\x79\x4f\xad\x8e\x9e\xf4\x7b\x09\x46\x03\x6a\xe8\x0d\x13\x88\x93\x1e\xbc\x1b\x26\x71\x72\x12\xf4\xe1\x73\x3c\xfd\x78\x7b\x37\x85\xcf\xc3\xc9\x64\x38\x9e\xc6\x57\x09\xdc\x4e\x60\x74\x3b\xbe\x8c\xa7\xf1\xed\x38\x81\xdb\xf7\x30\x1c\x7f\x81\x5f\xe3\xf1\xe5\x09\x90\xe4\x9c\x1c\xd0\x57\xeb\x2a\xfe\xc6\x81\xac\x74\xa4\xb4\x12\x2d\x21\xda\x23\xb0\x30\x0d\x21\x6f\x49\xc8\x85\x14\xa0\x50\x67\x25\x66\x04\x99\x59\x92\xd3\x52\x67\x60\xc9\x15\xd2\x57\xb7\xe9\x01\x75\x1a\xf4\x41\xc9\x42\x32\x72\x7d\xf2\x64\xa8\x30\x08\xd0\xca\xf6\xfe\x23\x10\xc6\x51\x28\xb4\x2b\x42\xa1\x4c\x99\x86\x59\x6d\xa5\x50\x98\x62\xb0\x3c\x47\x65\x73\x3c\x0f\xee\xa5\x4e\x23\x48\xc8\x2d\xa5\xa0\x1b\xb4\x56\xea\x2c\x28\x88\x31\x45\xc6\x28\x00\xd0\x58\x50\x04\xa9\xf6\x87\x91\xda\x0c\x6f\x51\x50\x04\x55\xca\xa9\x5f\x7b\xa6\x22\xa8\xe6\xda\x02\x5c\x8e\x93\x00\x60\xd9\x51\x5b\x9e\xcf\x89\xf1\x3c\x00\xf0\x4d\xeb\x8f\xc6\xf3\x78\xd3\xaa\xc1\x47\x2b\x7d\xdb\xc3\x91\x37\xa5\x13\xe4\x2b\x44\x80\xd3\x16\xb5\xc9\x9b\xa5\xda\xcf\x0a\xd4\x98\x51\x3a\xfb\xcb\x68\x0a\x1a\xd7\x35\xb3\x5d\x8e\x93\x9b\x26\xf6\xfb\x36\x84\x25\x9b\x0f\xa4\xc9\x21\x53\x1a\x01\xbb\xb2\x8b\x48\x2c\x46\x46\x2f\x64\x16\xb5\x07\x00\xd6\x28\x29\xd6\xe3\x97\x3a\xce\x24\x16\xb3\x26\xef\x51\xd9\x0d\x15\x73\x72\xdf\x2f\x2e\xea\xbc\x4d\xb1\xa3\x05\x39\xd2\x82\xde\x4b\x52\xe9\x96\x4b\xa7\xe7\x81\x71\xab\x87\xd7\x96\xa2\x3a\x65\x73\xe8\x4b\x6b\x8d\x63\x3f\x32\x3a\x95\xb5\x71\x22\x58\xa0\xf2\x9b\x81\xd3\x29\x15\x56\x21\x53\x04\x3d\xeb\xcc\x1f\x24\xd8\x0f\xbe\x7d\x6b\x7f\x7d\x78\x18\x14\x5b\xf9\xaa\x40\x85\xfe\xf0\xd0\x7b\x52\x3e\x42\xfd\x8e\xee\x3c\xa5\x53\x73\x83\x2c\xf2\x49\x7b\x67\xcd\xec\xbb\x2d\xbb\xdb\x1c\x2e\x51\xaa\x6a\xa7\x63\x3d\xf4\x9e\x38\xd6\x4b\xd2\x6c\xdc\x7a\x3f\xbd\xb3\x63\x6b\xcf\xad\x18\x8d\x14\x7b\xd3\x2a\x9c\x93\xf2\x51\xfb\xf3\x51\xbf\xf8\x72\x5b\xcb\xe8\x32\xe2\x46\xdd\x5d\x88\x5c\x92\x43\x27\x72\x29\x50\x4d\xba\x4b\xf0\xdb\xba\xd3\x56\xe3\x56\x9e\x1d\xed\xef\x69\xbd\x39\x9e\xd0\xe2\x51\xef\x67\xb0\x16\x2d\x83\x63\xe1\xaa\x27\x25\x2f\x9c\xb4\x5c\x6f\xd2\xdf\xa7\x3b\x11\x80\x69\x4e\x5d\x11\x70\x8e\xdc\xbc\xde\x3b\x12\x30\x27\x65\x74\xe6\x81\x4d\xb8\x53\x96\x2d\xef\xa3\x3d\x94\x66\x73\x3e\x3d\xe1\x74\x70\x83\x77\x70\x9c\x29\x6d\xb4\xe9\xd6\xf8\xc6\x3d\xfb\xe2\x38\xb8\xc7\x7b\x4b\xb4\xd9\xe0\x4f\xbb\xa7\x2f\x2c\xef\x77\xbd\x5c\xc3\xcb\xd7\xee\xe3\x37\xc3\xfe\x38\x86\x75\xe4\xad\xd1\x9e\x9e\x71\xee\xa4\x0d\xff\x6b\x0e\x76\xbb\x80\x8d\x93\x1f\x51\x98\xbd\x36\x67\x1f\xe2\x77\xac\xd3\x5f\xa8\x7d\x73\xfe\xab\x72\xfe\xcc\x95\xea\xc9\x9f\x5e\xfb\xf6\x9f\x6c\x33\xfe\xdb\x15\xa8\x92\x4a\xd5\x44\x4a\x45\xaf\x6f\x23\x3a\x52\x47\xaf\xc1\xa3\x82\x37\xef\xff\x8f\xde\x6f\x44\xa8\x27\x75\xfb\x7e\xde\x1b\x78\xa3\xd2\xe1\x4f\xc2\x0b\x23\x3c\xf7\x9d\x38\x7e\x98\x97\xfe\x41\xdb\xf0\x3b\xf2\xbd\x5a\x3d\x16\x1d\x69\x6e\xf7\xf2\x9f\x00\x00\x00\xff\xff\xdd\x0b\x38\xa1\x95\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\x0c\xec\xcb\xef\x07\xc4\x72\x92\xa3\x7a\xf2\x3a\xd9\x5d\xa1\x89\x13\x58\x4e\x17\xdb\x8b\x31\xa6\x46\x12\x1b\x8a\x64\x49\xca\x5e\x75\x9b\xef\x5e\xe8\x9f\x65\x3b\xb1\xb3\x87\xb6\x48\x81\x84\x02\x62\x70\x66\xde\xbc\x99\x79\x23\xc3\x43\x98\x2a\x5d\x1a\x9e\x66\x0e\x2e\xcf\x2f\x2f\xe1\x93\x52\xa9\x20\xb8\xb9\x99\x7a\x43\x6f\x08\x37\x9c\x91\xb4\x14\x43\x21\x63\x32\xe0\x32\x82\x89\x46\x96\x51\x67\x39\x83\x5f\xc8\x58\xae\x24\x5c\xfa\xe7\xf0\xbf\xca\x61\xd0\x9a\x06\xff\xff\xc9\x1b\x42\xa9\x0a\xc8\xb1\x04\xa9\x1c\x14\x96\xc0\x65\xdc\x42\xc2\x05\x01\x7d\x63\xa4\x1d\x70\x09\x4c\xe5\x5a\x70\x94\x8c\x60\xc3\x5d\x06\xae\xc7\xf7\xbd\x21\x7c\x6d\x21\xd4\xca\x21\x97\x80\xc0\x94\x2e\x41\x25\xbb\x7e\x80\xae\x26\x5c\xff\x65\xce\xe9\x60\x3c\xde\x6c\x36\x3e\xd6\x6c\x7d\x65\xd2\xb1\x68\x3c\xed\xf8\x26\x9c\x5e\xcf\xa2\xeb\xd1\xa5\x7f\x5e\xc7\x3c\x48\x41\xd6\x82\xa1\xdf\x0b\x6e\x28\x86\x55\x09\xa8\xb5\xe0\x0c\x57\x82\x40\xe0\x06\x94\x01\x4c\x0d\x51\x0c\x4e\x55\x84\x37\x86\x3b\x2e\xd3\x33\xb0\x2a\x71\x1b\x34\xe4\x0d\x21\xe6\xd6\x19\xbe\x2a\xdc\x5e\xb7\x3a\x7a\xdc\xee\x39\x28\x09\x28\x61\x30\x89\x20\x8c\x06\xf0\x61\x12\x85\xd1\x99\x37\x84\x2f\xe1\xe2\xf3\xdd\xc3\x02\xbe\x4c\xe6\xf3\xc9\x6c\x11\x5e\x47\x70\x37\x87\xe9\xdd\xec\x2a\x5c\x84\x77\xb3\x08\xee\x3e\xc2\x64\xf6\x15\x7e\x0e\x67\x57\x67\x40\xdc\x65\x64\x80\xbe\x69\x53\xf1\x57\x06\x78\xd5\x47\x8a\xab\xa6\x45\x44\x7b\x04\x12\xd5\x8c\xcf\x6a\x62\x3c\xe1\x0c\x04\xca\xb4\xc0\x94\x20\x55\x6b\x32\x92\xcb\x14\x34\x99\x9c\xdb\x6a\x9a\x16\x50\xc6\xde\x10\x04\xcf\xb9\x43\x57\xdf\x3c\x2b\xca\xf7\x3c\xd4\xbc\x9d\x7f\x00\x4c\x19\xf2\x99\x34\xb9\xcf\x84\x2a\x62\x3f\xad\xa5\xe4\x33\x95\x8f\xd7\x17\x28\x74\x86\x17\xde\x23\x97\x71\x00\x11\x99\x35\x67\x74\x8b\x5a\x73\x99\x7a\x39\x39\x8c\xd1\x61\xe0\x01\x48\xcc\x29\x80\x58\xda\x97\x91\x5a\x0f\xab\x91\x51\x00\x95\xcb\xc8\x96\xd6\x51\xee\x55\x75\xf5\x00\x57\xb3\xc8\x03\x58\x77\xd4\xd6\x17\x2b\x72\x78\xe1\x01\xd8\x26\xf5\x67\x65\xdd\x6c\x9b\xaa\xc1\x47\xcd\x6d\x9b\xc3\x90\x55\x85\x61\x64\x2b\x44\x80\x51\x8b\xda\xf8\x2d\x63\x69\x97\x39\x4a\x4c\x29\x5e\xfe\xa1\x24\xd5\x3e\x00\x4d\x6d\x57\xb3\xe8\xb6\xb1\xfd\xda\x9b\xb0\x70\xea\x13\x49\x32\xe8\x28\x0e\xc0\x99\xa2\xb3\x70\xcc\xa7\x4a\x26\x3c\x0d\xda\x0b\x00\xad\x04\x67\xe5\xec\x54\xc6\x25\xc7\x7c\xd9\xf8\x1d\x84\xdd\x52\xbe\x22\xf3\x7a\x70\x5e\xfb\x6d\x83\x0d\x25\x64\x48\x32\xfa\xc8\x49\xc4\x3d\x97\xae\x9f\xbb\xf1\x3b\x46\x57\x6a\x0a\x6a\x97\xed\xa5\x2d\xb4\x56\xc6\xd9\xa9\x92\x31\xaf\x85\x13\x40\x82\xc2\x6e\x0b\x8e\x17\x94\x6b\x81\x8e\x02\x18\x68\xa3\x7e\x23\xe6\xec\xf8\xfb\xf7\xf6\xe3\xd3\xd3\xb8\xcd\x55\xb5\xaf\x32\x54\xe8\x4f\x4f\x83\x67\xe1\x53\x94\x1f\xe8\xc1\x52\xbc\x50\xb7\xe8\x58\x36\x6f\x67\xd6\xd4\xbe\x9b\xb2\x9b\xe6\x64\x8d\x5c\x54\x3b\x1d\xca\x89\xb5\xe4\x42\xb9\x26\xe9\x94\x29\xf7\xdd\x3b\x39\xb6\xf2\xec\x9b\x51\x31\x39\xa8\x56\xe0\x8a\x84\x0d\xda\xff\x07\xf9\xc2\xab\x3e\xd6\xa1\x49\xc9\x35\xdd\xdd\x85\xc8\x38\x19\x34\x2c\xe3\x0c\xc5\xbc\x1b\x42\x2b\xbb\xea\x19\xb5\x3d\x6e\xdb\xb3\xbd\x07\x78\xa4\x72\x7b\x3d\xa7\xe4\x20\xf7\x11\xac\xa4\x65\xf0\xa3\x70\xd5\x89\xc9\x32\xc3\x75\x35\xcb\x00\xfe\x1c\xed\x58\x00\x16\x19\x75\x41\xe0\x32\x74\xcd\xeb\xbd\x23\x01\x2b\x12\x4a\xa6\x16\x9c\xf2\x77\xc2\xd2\xf5\x63\x4f\xaa\xdf\x9c\xfb\x67\x9c\x5e\xdc\xe0\xfe\xa4\x46\x15\x3a\xd8\x66\x6b\x74\x63\x8e\xbe\x38\x5e\xdc\xe3\xbd\x25\xda\x6e\xf0\xfd\xee\xed\x89\xe5\x7d\x55\xcb\x35\x3c\x7f\xeb\x3a\x7e\x17\xec\x7f\x47\xb0\x86\xac\x56\xd2\xd2\x11\xe5\xce\x5b\xf3\xdf\xa6\xe0\x2e\xdf\x7d\xaf\xe4\x03\x0a\xcb\xb7\xa6\xec\x97\xf8\x1d\x24\x3a\xaa\xf4\x13\xb1\xef\xca\x7f\x53\xca\x5f\x9a\x42\xd0\x69\xf9\xcf\x7b\x8f\x7f\x76\x05\xaa\x3d\x29\x44\x63\x29\x04\xbd\xbd\x8d\xe8\x48\x1d\xa0\x1f\x5f\x83\x83\x80\x77\xed\xff\x8b\xda\xaf\x9e\x51\x53\xe9\x9e\xf4\xca\xfd\x82\xb7\x5d\x3a\x10\xe3\xeb\x25\x1c\xfb\x9e\xf8\xf1\x62\x4e\xfd\x40\x7b\x59\x50\xfb\x1c\x77\xb5\x55\x1d\x8d\x86\xa4\x0b\xc0\x99\x82\xbc\xbf\x06\x00\xdd\x0b\x38\xa1\x95\x10\x00\x00"),
 		},
 		"/documentai.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "documentai.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1909,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\xc1\x6e\xdb\x38\x10\xbd\xeb\x2b\x1e\xec\xcb\x2e\x90\xc8\x49\x8e\xda\x93\x1b\xa7\xad\xb0\xa9\x53\xd8\x4e\x8b\x9e\x82\x31\x35\x96\xb8\x91\x48\x2e\x39\xb2\x6b\x64\xfd\xef\x0b\x59\x92\x13\x3b\x2d\x9a\x53\x75\x12\x38\x6f\xde\xbc\x99\x79\xe4\x10\xd7\xd6\x6d\xbd\xce\x0b\xc1\xd5\xc5\xd5\x15\x3e\x58\x9b\x97\x8c\xdb\xdb\xeb\x68\x18\x0d\x71\xab\x15\x9b\xc0\x19\x6a\x93\xb1\x87\x14\x8c\xb1\x23\x55\x70\x1f\x39\xc3\x17\xf6\x41\x5b\x83\xab\xf8\x02\x7f\x34\x80\x41\x17\x1a\xfc\xf9\x57\x34\xc4\xd6\xd6\xa8\x68\x0b\x63\x05\x75\x60\x48\xa1\x03\x56\xba\x64\xf0\x77\xc5\x4e\xa0\x0d\x94\xad\x5c\xa9\xc9\x28\xc6\x46\x4b\xb1\x2f\xd3\x91\xc4\xd1\x10\xdf\x3a\x0a\xbb\x14\xd2\x06\x04\x65\xdd\x16\x76\xf5\x12\x07\x92\xbd\xe0\xfd\x57\x88\xb8\x64\x34\xda\x6c\x36\x31\xed\xd5\xc6\xd6\xe7\xa3\xb2\x45\x86\xd1\x6d\x7a\x7d\x33\x9d\xdf\x9c\x5f\xc5\x17\xfb\x9c\x7b\x53\x72\x08\xf0\xfc\x6f\xad\x3d\x67\x58\x6e\x41\xce\x95\x5a\xd1\xb2\x64\x94\xb4\x81\xf5\xa0\xdc\x33\x67\x10\xdb\x08\xde\x78\x2d\xda\xe4\x67\x08\x76\x25\x1b\xf2\x1c\x0d\x91\xe9\x20\x5e\x2f\x6b\x39\x9a\x56\x2f\x4f\x87\x23\x80\x35\x20\x83\xc1\x78\x8e\x74\x3e\xc0\xbb\xf1\x3c\x9d\x9f\x45\x43\x7c\x4d\x17\x1f\xef\xee\x17\xf8\x3a\x9e\xcd\xc6\xd3\x45\x7a\x33\xc7\xdd\x0c\xd7\x77\xd3\x49\xba\x48\xef\xa6\x73\xdc\xbd\xc7\x78\xfa\x0d\x7f\xa7\xd3\xc9\x19\x58\x4b\xc1\x1e\xfc\xdd\xf9\x46\xbf\xf5\xd0\xcd\x1c\x39\x6b\x86\x36\x67\x3e\x12\xb0\xb2\xad\xa0\xe0\x58\xe9\x95\x56\x28\xc9\xe4\x35\xe5\x8c\xdc\xae\xd9\x1b\x6d\x72\x38\xf6\x95\x0e\xcd\x36\x03\xc8\x64\xd1\x10\xa5\xae\xb4\x90\xec\x4f\x5e\x35\x15\x47\x11\x39\xdd\xed\x3f\x81\xb2\x9e\x63\x65\x7c\x15\xab\xd2\xd6\x59\x9c\xef\xad\x14\x2b\x5b\x8d\xd6\x97\x54\xba\x82\x2e\xa3\x47\x6d\xb2\x04\x73\xf6\x6b\xad\xf8\x13\x39\xa7\x4d\x1e\x55\x2c\x94\x91\x50\x12\x01\x86\x2a\x4e\x90\x59\x55\x57\x6c\x84\xf4\x8f\x09\x3b\x60\x70\xa4\x38\x41\x03\x39\x0f\xdb\x20\x5c\x45\x4d\x7b\xcf\x3c\x93\x8e\x67\x9c\x46\xc0\xba\x17\xba\xbe\x5c\xb2\xd0\x65\x04\x84\x56\xc8\x47\x1b\x64\x7a\x5a\xb8\xad\x46\x4e\x87\xae\xa2\xe7\x60\x6b\xaf\x38\x34\xfc\xc0\x79\x57\xa3\xc5\x3d\xf4\x99\x0f\xa4\x1f\x9c\xb7\x8a\x43\xb0\x3e\x6a\xfd\xd8\x76\xfd\x2c\xe6\xf3\x49\x9c\x6a\xb1\x1f\xd8\xb0\x27\xe1\x2c\x81\xf8\x9a\xbb\x88\xce\x16\x5c\xb9\x92\x84\x13\x0c\x9c\xb7\xff\xb0\x92\x30\x7a\x7a\xea\x7e\x77\xbb\x51\x69\x55\xbb\x9f\xd1\xd3\x53\xff\xbf\xdb\x8d\x0e\x12\x9a\xf3\x46\xe8\x6e\x37\x78\xc5\x79\x4d\xe6\x1d\xdf\x07\xce\x16\xf6\x13\x89\x2a\x66\x5d\x87\xed\x2c\x56\x54\x86\x5e\x47\xdf\xfb\x78\x4d\xba\x6c\xae\x45\x6a\xc6\x21\xb0\xa4\x66\xcd\x46\xac\xdf\x1e\xc3\x9b\xb9\xb2\x3f\xb4\x94\x4e\xde\x6b\x2e\xb3\x64\x3f\xb1\x13\xc6\x74\x92\x74\x27\x80\x90\xcf\x59\x5e\x63\x0b\xcd\x9e\xbc\x2a\xb4\xa2\x72\xc6\x2b\xf6\x6c\x0e\x6b\x68\x57\x21\x5b\xc7\x09\xba\xa9\x1c\xce\x81\x47\xde\x1e\x8e\x67\xbc\x3a\xa9\xfd\x13\xae\x55\xa7\xe0\xad\x74\xcd\x97\x71\x50\x5e\x3b\xd9\x1b\xec\xbf\xf3\x17\x11\x60\x51\x70\x9f\x04\x29\x48\xda\x37\xb0\x17\x81\x25\x97\xd6\xe4\x01\x62\xe3\x17\x69\xf9\xfa\x31\x39\x62\x69\x4d\xf4\xf9\x95\xa6\x1f\x1a\xfb\x05\x8f\xb7\xb5\x4b\x0e\xd5\x2a\x32\x94\xb3\xff\xe9\xb5\x7a\x9b\xaf\x1f\x32\x5e\x51\x5d\xca\x43\x57\xfa\x57\x3e\x9f\xb4\xf0\x2f\x47\xe8\x37\xba\x7e\x6f\xf6\x96\xe6\xb7\x7a\xb8\x7f\x95\xba\x57\xea\x79\x19\xed\x78\x4e\xef\xf8\x2f\xfd\xfc\x9c\xf0\x7f\x00\x00\x00\xff\xff\x0d\x1a\x18\x17\x75\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\xc1\x6e\xdb\x38\x10\xbd\xeb\x2b\x1e\xec\xcb\x2e\x90\xc8\x49\x8e\xda\x93\x1b\xa7\xad\xb0\xa9\x53\xd8\x4e\x8b\x9e\x82\x31\x35\x96\xb8\x91\x48\x2e\x39\xb2\x6b\x64\xfd\xef\x0b\x59\x92\x9d\x38\x0d\x36\xa7\xb5\x7c\x10\xc8\xc7\x37\x6f\xde\x3c\x71\x88\x6b\xeb\xb6\x5e\xe7\x85\xe0\xea\xe2\xea\x0a\x9f\xac\xcd\x4b\xc6\xed\xed\x75\x34\x8c\x86\xb8\xd5\x8a\x4d\xe0\x0c\xb5\xc9\xd8\x43\x0a\xc6\xd8\x91\x2a\xb8\xdf\x39\xc3\x37\xf6\x41\x5b\x83\xab\xf8\x02\xbf\x35\x80\x41\xb7\x35\xf8\xfd\x8f\x68\x88\xad\xad\x51\xd1\x16\xc6\x0a\xea\xc0\x90\x42\x07\xac\x74\xc9\xe0\x9f\x8a\x9d\x40\x1b\x28\x5b\xb9\x52\x93\x51\x8c\x8d\x96\x02\x72\xe4\x8f\xa3\x21\x7e\x74\x14\x76\x29\xa4\x0d\x08\xca\xba\x2d\xec\xea\x39\x0e\x24\x7b\xc1\xfb\x5f\x21\xe2\x92\xd1\x68\xb3\xd9\xc4\xb4\x57\x1b\x5b\x9f\x8f\xca\x16\x19\x46\xb7\xe9\xf5\xcd\x74\x7e\x73\x7e\x15\x5f\xec\xcf\xdc\x9b\x92\x43\x80\xe7\xbf\x6b\xed\x39\xc3\x72\x0b\x72\xae\xd4\x8a\x96\x25\xa3\xa4\x0d\xac\x07\xe5\x9e\x39\x83\xd8\x46\xf0\xc6\x6b\xd1\x26\x3f\x43\xb0\x2b\xd9\x90\xe7\x68\x88\x4c\x07\xf1\x7a\x59\xcb\x0b\xb7\x7a\x79\x3a\xbc\x00\x58\x03\x32\x18\x8c\xe7\x48\xe7\x03\x7c\x18\xcf\xd3\xf9\x59\x34\xc4\xf7\x74\xf1\xf9\xee\x7e\x81\xef\xe3\xd9\x6c\x3c\x5d\xa4\x37\x73\xdc\xcd\x70\x7d\x37\x9d\xa4\x8b\xf4\x6e\x3a\xc7\xdd\x47\x8c\xa7\x3f\xf0\x67\x3a\x9d\x9c\x81\xb5\x14\xec\xc1\x3f\x9d\x6f\xf4\x5b\x0f\xdd\xf8\xc8\x59\x63\xda\x9c\xf9\x85\x80\x95\x6d\xc7\x17\x1c\x2b\xbd\xd2\x0a\x25\x99\xbc\xa6\x9c\x91\xdb\x35\x7b\xa3\x4d\x0e\xc7\xbe\xd2\xa1\x99\x66\x00\x99\x2c\x1a\xa2\xd4\x95\x16\x92\xfd\xca\xab\xa6\xe2\x28\x22\xa7\xbb\xf9\x27\x50\xd6\x73\xac\x8c\xaf\x62\x55\xda\x3a\x8b\xf3\x7d\x94\x62\x65\xab\xd1\xfa\x92\x4a\x57\xd0\x65\xf4\xa8\x4d\x96\x60\xce\x7e\xad\x15\x7f\x21\xe7\xb4\xc9\xa3\x8a\x85\x32\x12\x4a\x22\xc0\x50\xc5\x09\x32\xab\xea\x8a\x8d\x90\xfe\x35\x61\x07\x0c\x8e\x14\x27\x68\x20\xe7\x61\x1b\x84\xab\xa8\x69\xef\xc8\x33\xe9\x78\xc6\x69\x04\xac\x7b\xa1\xeb\xcb\x25\x0b\x5d\x46\x40\x68\x85\x7c\xb6\x41\xa6\xa7\x85\xdb\x6a\xe4\x74\xe8\x2a\x7a\x0e\xb6\xf6\x8a\x43\xc3\x0f\x9c\x77\x35\x5a\xdc\x43\x7f\xf2\x81\xf4\x83\xf3\x56\x71\x08\xd6\xef\x81\x40\xdb\xf5\x51\xcc\xd7\x93\x7d\xaa\xc5\x7e\x62\xc3\x9e\x84\xb3\x04\xe2\x6b\xee\x76\x74\xb6\xe0\xca\x95\x24\x9c\x60\xe0\xbc\xfd\x8b\x95\x84\xd1\xd3\x53\xf7\xba\xdb\x8d\x4a\xab\xda\xf9\x8c\x9e\x9e\xfa\xf7\xdd\x6e\x74\x90\xd0\xa0\x1b\xa1\xbb\xdd\xe0\x15\xe7\x35\x99\x0f\x7c\x1f\x38\x5b\xd8\x2f\x24\xaa\x98\x75\x1d\xb6\x5e\xac\xa8\x0c\xbd\x8e\xbe\xf7\xf1\x9a\x74\xd9\x7c\x16\xa9\x19\x87\xc0\x92\x9a\x35\x1b\xb1\x7e\xfb\x12\xde\xf8\xca\xfe\xd0\x52\x3a\xf9\xa8\xb9\xcc\x92\xbd\x63\x27\x8c\xe9\x24\xe9\x56\x00\x21\x9f\xb3\xbc\xc6\x16\x9a\x3d\x79\x55\x68\x45\xe5\x8c\x57\xec\xd9\x1c\xc6\xd0\xfc\xcf\x21\x5b\xc7\x09\x3a\x57\x0e\xeb\xc0\x23\x6f\x0f\xcb\x33\x5e\x9d\xd4\x7e\x83\x6b\xd5\x29\x78\x2f\x5d\xf3\x64\x1c\x94\xd7\xae\x71\x3f\xc1\x3f\xe7\xcf\x76\x80\x45\xc1\xfd\x21\x48\x41\xd2\xde\x81\xbd\x08\x2c\xb9\xb4\x26\x0f\x10\x1b\x3f\x3b\x96\xaf\x1f\x8f\xa2\x8e\x21\xfa\xfa\x4a\xd3\x2f\x83\x7d\x7c\x72\x6f\x6b\x97\x1c\xaa\x55\x64\x28\x67\xff\xe6\x67\xf5\xbe\x5c\x3f\x64\xbc\xa2\xba\x94\x87\xae\xf4\x7f\xe5\x7c\xd2\xc2\xbf\xbd\x40\xbf\x33\xf5\xfb\xb0\xb7\x34\xff\x6b\x86\xfb\x5b\xa9\xbb\xa5\x8e\xc3\x68\xed\x39\x88\x3a\x61\x7f\x33\xcf\xce\x5b\xc5\x21\x58\x1f\xfd\x3b\x00\x0d\x1a\x18\x17\x75\x07\x00\x00"),
 		},
 		"/edgecontainer.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "edgecontainer.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3146,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4f\x6f\xdb\xb8\x13\xbd\xeb\x53\x0c\xec\xcb\xef\x07\x24\x52\xe2\xa3\xf6\xe4\x3a\x69\x2b\x6c\x6a\x17\xb6\xd3\xa2\xa7\x60\x4c\x8d\x25\x6e\x28\x92\x4b\x8e\xe4\x1a\x59\x7f\xf7\x85\x2c\xca\xb1\xf3\x67\x77\x81\x45\x8b\x3d\x84\x07\x9b\x18\x3e\xbe\xf7\x86\x33\xa3\x21\x4c\x8c\xdd\x3a\x59\x94\x0c\xa3\x8b\xd1\x08\x3e\x18\x53\x28\x82\x9b\x9b\x49\x34\x8c\x86\x70\x23\x05\x69\x4f\x39\xd4\x3a\x27\x07\x5c\x12\x8c\x2d\x8a\x92\xfa\x93\x33\xf8\x42\xce\x4b\xa3\x61\x14\x5f\xc0\xff\x5a\xc0\x20\x1c\x0d\xfe\xff\x4b\x34\x84\xad\xa9\xa1\xc2\x2d\x68\xc3\x50\x7b\x02\x2e\xa5\x87\xb5\x54\x04\xf4\x5d\x90\x65\x90\x1a\x84\xa9\xac\x92\xa8\x05\xc1\x46\x72\xb9\x97\x09\x24\x71\x34\x84\x6f\x81\xc2\xac\x18\xa5\x06\x04\x61\xec\x16\xcc\xfa\x18\x07\xc8\x7b\xc3\xfb\x55\x32\xdb\x34\x49\x36\x9b\x4d\x8c\x7b\xb7\xb1\x71\x45\xa2\x3a\xa4\x4f\x6e\xb2\xc9\xf5\x74\x71\x7d\x3e\x8a\x2f\xf6\x77\x6e\xb5\x22\xef\xc1\xd1\xef\xb5\x74\x94\xc3\x6a\x0b\x68\xad\x92\x02\x57\x8a\x40\xe1\x06\x8c\x03\x2c\x1c\x51\x0e\x6c\x5a\xc3\x1b\x27\x59\xea\xe2\x0c\xbc\x59\xf3\x06\x1d\x45\x43\xc8\xa5\x67\x27\x57\x35\x9f\xbc\x56\x6f\x4f\xfa\x13\x80\xd1\x80\x1a\x06\xe3\x05\x64\x8b\x01\xbc\x1b\x2f\xb2\xc5\x59\x34\x84\xaf\xd9\xf2\xe3\xec\x76\x09\x5f\xc7\xf3\xf9\x78\xba\xcc\xae\x17\x30\x9b\xc3\x64\x36\xbd\xca\x96\xd9\x6c\xba\x80\xd9\x7b\x18\x4f\xbf\xc1\xaf\xd9\xf4\xea\x0c\x48\x72\x49\x0e\xe8\xbb\x75\xad\x7f\xe3\x40\xb6\xef\x48\x79\xfb\x68\x0b\xa2\x13\x03\x6b\xd3\x19\xf2\x96\x84\x5c\x4b\x01\x0a\x75\x51\x63\x41\x50\x98\x86\x9c\x96\xba\x00\x4b\xae\x92\xbe\xad\xa6\x07\xd4\x79\x34\x04\x25\x2b\xc9\xc8\xfb\xc8\xb3\xa4\xe2\x28\x42\x2b\x43\xfd\x53\x10\xc6\x51\x2c\xb4\xab\x62\xa1\x4c\x9d\xc7\xc5\xbe\x95\x62\x61\xaa\xa4\xb9\x44\x65\x4b\xbc\x8c\xee\xa5\xce\x53\x58\x90\x6b\xa4\xa0\x4f\x68\xad\xd4\x45\x54\x11\x63\x8e\x8c\x69\x04\xa0\xb1\xa2\x14\x28\x2f\x48\x18\xdd\x96\x9b\xdc\xcb\x9c\x01\xeb\x2d\x0a\x4a\xa1\x85\x9c\xfb\xad\x67\xaa\xa2\x36\xc3\x47\xaa\xeb\x63\xaa\x08\xa0\xe9\xed\x36\x97\x2b\x62\xbc\x8c\x00\x7c\x67\xe7\xa3\xf1\x3c\x7d\x41\xbe\xd3\x44\x2b\x7d\xd0\x75\xe4\x4d\xed\x04\xf9\x56\x05\xe0\x3c\x28\x75\xb8\xbb\x93\xcb\x77\x42\xd5\x9e\xf7\xc2\xed\xea\xd2\x3f\xb1\x34\x39\x01\x60\xcd\xe6\x03\x69\x72\xc8\x94\xa7\xc0\xae\xa6\x70\x22\xf3\x25\x55\x56\x21\x53\x0a\x03\xeb\xcc\x6f\x24\xd8\x27\x0f\x0f\x61\xbb\xdb\x25\xca\x88\xae\x52\xc9\xc3\x43\xbf\xdf\xed\x92\xe0\xa0\x8d\xb6\x3e\x77\xbb\xc1\x33\xc6\x09\xea\x77\x74\xeb\x29\x5f\x9a\x4f\xc8\xa2\x9c\x87\x04\xbb\xd7\x58\xa3\xf2\xbd\x8b\x3e\xf5\x71\x83\x52\xb5\xe3\x91\xe9\xb1\xf7\xc4\x99\x6e\x48\xb3\x71\xdb\x53\x78\x5f\xd9\x50\xe9\x34\x84\xfb\xda\xb4\xbf\x87\x90\xc2\x15\x29\x9f\x86\xff\x27\x7a\xd9\xd5\xe3\x5d\x46\x57\x10\xbf\x97\xa4\xf2\x13\x8a\x52\x92\x43\x27\x4a\x29\x50\xcd\x69\x4d\x8e\xf4\xa1\x46\x5d\x9d\x78\x6b\x29\x85\xf0\x62\x87\x38\xc0\x3d\x6d\x0f\xe1\x39\xad\x9f\x68\xbf\xc2\xb5\x0e\x0e\xfe\x29\x5d\xbb\x72\xf2\xc2\x49\xcb\xfb\x06\xfc\xe3\xfc\xe8\x04\x60\x59\x52\x7f\x09\xb8\x44\xee\xbe\x94\xbd\x09\x58\x91\x32\xba\xf0\xc0\x26\x3e\xba\x56\x34\xf7\xe9\x09\x4b\xd7\x61\x9f\x9f\x79\x7a\xb1\xf1\x8f\x78\x9c\xa9\x6d\x7a\x50\xab\x50\x63\xf1\x17\x93\xf7\x37\x4d\xaf\x4d\x4e\x77\xd6\x18\xf5\x7a\xdb\x4f\x4d\x4e\x9f\x1f\x11\x3f\xb6\xef\xc3\x76\xb7\x4b\x74\x90\x7d\x9b\x86\xb7\x69\xf8\x59\xd3\xd0\x58\x7d\x27\x8c\xd6\x24\xda\x4c\x5f\x1f\x89\x2f\x56\x4f\x9e\xc2\x7e\xc0\x5c\x34\xc7\x32\xfe\xbf\xd3\xfe\xbd\xc5\x7f\x39\x02\x4f\x68\xde\xc6\xe0\x27\x8d\xc1\x9f\x01\x00\x00\xff\xff\xbf\xe5\xae\xd5\x4a\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xcd\x6e\xdb\x38\x17\xdd\xeb\x29\x0e\xec\xcd\xf7\x01\x89\x94\x64\xa9\x59\xb9\x4e\xda\x0a\x93\x3a\x85\xed\xb4\xe8\x2a\xb8\xa6\xae\x25\x4e\x28\x92\x43\x52\x72\x8d\x8c\xdf\x7d\x20\x5b\x8a\xed\xfc\xcc\x0c\x30\x68\x31\x8b\x58\x86\x4d\x90\x87\xf7\x9c\xfb\x73\x34\xc4\xd8\xd8\xb5\x93\x45\x19\x70\x71\x76\x71\x81\x0f\xc6\x14\x8a\x71\x7d\x3d\x8e\x86\xd1\x10\xd7\x52\xb0\xf6\x9c\xa3\xd6\x39\x3b\x84\x92\x31\xb2\x24\x4a\xee\x4f\x4e\xf0\x85\x9d\x97\x46\xe3\x22\x3e\xc3\xff\x5a\xc0\xa0\x3b\x1a\xfc\xff\x97\x68\x88\xb5\xa9\x51\xd1\x1a\xda\x04\xd4\x9e\x11\x4a\xe9\xb1\x94\x8a\xc1\xdf\x05\xdb\x00\xa9\x21\x4c\x65\x95\x24\x2d\x18\x2b\x19\x4a\x84\x7d\xfc\x38\x1a\xe2\x5b\x17\xc2\x2c\x02\x49\x0d\x82\x30\x76\x0d\xb3\x3c\xc4\x81\xc2\x56\xf0\xf6\x53\x86\x60\xd3\x24\x59\xad\x56\x31\x6d\xd5\xc6\xc6\x15\x89\xda\x21\x7d\x72\x9d\x8d\xaf\x26\xb3\xab\xd3\x8b\xf8\x6c\x7b\xe7\x56\x2b\xf6\x1e\x8e\x7f\xaf\xa5\xe3\x1c\x8b\x35\xc8\x5a\x25\x05\x2d\x14\x43\xd1\x0a\xc6\x81\x0a\xc7\x9c\x23\x98\x56\xf0\xca\xc9\x20\x75\x71\x02\x6f\x96\x61\x45\x8e\xa3\x21\x72\xe9\x83\x93\x8b\x3a\x1c\x55\xab\x97\x27\xfd\x11\xc0\x68\x90\xc6\x60\x34\x43\x36\x1b\xe0\xdd\x68\x96\xcd\x4e\xa2\x21\xbe\x66\xf3\x8f\x37\xb7\x73\x7c\x1d\x4d\xa7\xa3\xc9\x3c\xbb\x9a\xe1\x66\x8a\xf1\xcd\xe4\x32\x9b\x67\x37\x93\x19\x6e\xde\x63\x34\xf9\x86\x5f\xb3\xc9\xe5\x09\x58\x86\x92\x1d\xf8\xbb\x75\xad\x7e\xe3\x20\xdb\x3a\x72\xde\x16\x6d\xc6\x7c\x24\x60\x69\x76\xed\xf3\x96\x85\x5c\x4a\x01\x45\xba\xa8\xa9\x60\x14\xa6\x61\xa7\xa5\x2e\x60\xd9\x55\xd2\xb7\xdd\xf4\x20\x9d\x47\x43\x28\x59\xc9\x40\x61\xbb\xf3\x2c\xa9\x38\x8a\xc8\xca\xae\xff\x29\x84\x71\x1c\x0b\xed\xaa\x58\x28\x53\xe7\x71\xb1\x1d\xa5\x58\x98\x2a\x69\xce\x49\xd9\x92\xce\xa3\x7b\xa9\xf3\x14\x33\x76\x8d\x14\xfc\x89\xac\x95\xba\x88\x2a\x0e\x94\x53\xa0\x34\x02\x34\x55\x9c\x82\xf3\x82\x85\xd1\x6d\xbb\xd9\xbd\x1c\xb3\xc3\x7a\x4b\x82\x53\xb4\x90\x53\xbf\xf6\x81\xab\xa8\xcd\x70\x1f\xea\xea\x30\x54\x04\x34\xbd\xdc\xe6\x7c\xc1\x81\xce\x23\xc0\xef\xe4\x7c\x34\x3e\x4c\x5e\xa0\xdf\x71\x92\x95\xbe\xe3\x75\xec\x4d\xed\x04\xfb\x96\x05\x38\xed\x98\x76\xb8\xbb\xa3\xcb\x77\x42\xd5\x3e\x6c\x89\x5b\xe8\x2e\xfd\x23\x49\xe3\x23\x00\xd5\xc1\x7c\x60\xcd\x8e\x02\xe7\x29\x82\xab\xb9\x3b\x91\xf9\x9c\x2b\xab\x28\x70\x8a\x81\x75\xe6\x37\x16\xc1\x27\x0f\x0f\xdd\x72\xb3\x49\x94\x11\xbb\x4e\x25\x0f\x0f\xfd\x7a\xb3\x49\x3a\x05\x2d\xb6\xd5\xb9\xd9\x0c\x9e\x45\x1c\x93\x7e\xc7\xb7\x9e\xf3\xb9\xf9\x44\x41\x94\xd3\x2e\xc1\x5d\x35\x96\xa4\x7c\xaf\xa2\x4f\x7d\xd4\x90\x54\xad\x3d\x32\x3d\xf2\x9e\x43\xa6\x1b\xd6\xc1\xb8\xf5\x31\xbc\xef\x6c\xd7\xe9\xb4\xdb\xee\x7b\xd3\xfe\x3e\x6e\x29\x5a\xb0\xf2\x69\xf7\xff\x84\x2f\xbb\xdc\xdf\x0d\xe4\x0a\x0e\xef\x25\xab\xfc\x28\x44\x29\xd9\x91\x13\xa5\x14\xa4\xa6\xbc\x64\xc7\xfa\xb1\x47\xed\xf7\x14\x61\x6d\x39\x45\x57\xb1\xc7\x7d\xe0\x9e\xd7\x8f\xdb\x53\x5e\x3e\xe1\x7e\x25\xd6\xb2\x53\xf0\x4f\xc3\xb5\x4f\xce\x5e\x38\x69\xdb\xce\xa4\xf8\xe3\xf4\xe0\x04\x98\x97\xdc\x5f\x42\x28\x29\xec\xde\x94\xbd\x08\x2c\x58\x19\x5d\x78\x04\x13\x1f\x5c\x2b\x9a\xfb\xbd\xa8\xfd\x84\x7d\x7e\xa6\xe9\xc5\xc1\xdf\x3f\x85\x33\xb5\x4d\x1f\xd9\x2a\xd2\x54\xfc\x85\xf3\xfe\x66\xe8\xb5\xc9\xf9\xce\x1a\xa3\x5e\x1f\xfb\x89\xc9\xf9\xf3\x1e\xf1\x63\xe7\xbe\x5b\x6e\x36\x89\xee\x68\xdf\xdc\xf0\xe6\x86\x9f\xe5\x86\xc6\xea\x3b\x61\xb4\x66\xd1\x66\xfa\xba\x25\xbe\x58\x3d\x7e\x0a\xfb\x01\xbe\x68\x0e\x69\xfc\x7f\x67\xfc\x7b\x89\xff\xd2\x02\x4f\xc2\xbc\xd9\xe0\x27\xd9\xe0\xcf\x01\x00\xbf\xe5\xae\xd5\x4a\x0c\x00\x00"),
 		},
 		"/essentialcontacts.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "essentialcontacts.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1160,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x6f\xdb\x46\x10\x85\xef\xfc\x15\x0f\xd2\xa5\x05\x6c\xca\xd6\x91\x3d\x29\xb2\x93\x10\x75\x24\xc0\x94\x1b\xe4\x14\x8c\x96\x23\x72\xd0\xe5\xee\x76\x77\x28\x45\x08\xf2\xdf\x0b\x4a\x54\x6a\xd5\xad\x4e\xc2\xce\xe3\x7b\xdf\xbc\xdd\x29\x96\x3e\x1c\xa3\x34\xad\x62\x7e\x37\x9f\xe3\x83\xf7\x8d\x65\x3c\x3d\x2d\xb3\x69\x36\xc5\x93\x18\x76\x89\x6b\xf4\xae\xe6\x08\x6d\x19\x8b\x40\xa6\xe5\xcb\xe4\x06\x7f\x70\x4c\xe2\x1d\xe6\xf9\x1d\x7e\x19\x04\x93\x71\x34\xf9\xf5\xb7\x6c\x8a\xa3\xef\xd1\xd1\x11\xce\x2b\xfa\xc4\xd0\x56\x12\x76\x62\x19\xfc\xcd\x70\x50\x88\x83\xf1\x5d\xb0\x42\xce\x30\x0e\xa2\xed\x29\x66\x34\xc9\xb3\x29\xbe\x8c\x16\x7e\xab\x24\x0e\x04\xe3\xc3\x11\x7e\xf7\x5a\x07\xd2\x13\xf0\xe9\xd7\xaa\x86\x62\x36\x3b\x1c\x0e\x39\x9d\x68\x73\x1f\x9b\x99\x3d\x2b\xd3\xec\xa9\x5c\x3e\xae\xaa\xc7\xdb\x79\x7e\x77\xfa\xe6\xc5\x59\x4e\x09\x91\xff\xea\x25\x72\x8d\xed\x11\x14\x82\x15\x43\x5b\xcb\xb0\x74\x80\x8f\xa0\x26\x32\xd7\x50\x3f\x00\x1f\xa2\xa8\xb8\xe6\x06\xc9\xef\xf4\x40\x91\xb3\x29\x6a\x49\x1a\x65\xdb\xeb\x55\x5b\x17\x3c\x49\x57\x02\xef\x40\x0e\x93\x45\x85\xb2\x9a\xe0\xdd\xa2\x2a\xab\x9b\x6c\x8a\xcf\xe5\xe6\xe3\xfa\x65\x83\xcf\x8b\xe7\xe7\xc5\x6a\x53\x3e\x56\x58\x3f\x63\xb9\x5e\x3d\x94\x9b\x72\xbd\xaa\xb0\x7e\x8f\xc5\xea\x0b\x7e\x2f\x57\x0f\x37\x60\xd1\x96\x23\xf8\x5b\x88\x03\xbf\x8f\x90\xa1\x47\xae\x87\xd2\x2a\xe6\x2b\x80\x9d\x3f\x03\xa5\xc0\x46\x76\x62\x60\xc9\x35\x3d\x35\x8c\xc6\xef\x39\x3a\x71\x0d\x02\xc7\x4e\xd2\x70\x9b\x09\xe4\xea\x6c\x0a\x2b\x9d\x28\xe9\xe9\xe4\xcd\x52\x79\x96\x51\x90\xf1\xfe\x0b\x18\x1f\x39\x37\x2e\x76\xb9\xb1\xbe\xaf\xf3\xe6\xf4\x94\x72\xe3\xbb\xd9\xfe\x9e\x6c\x68\xe9\x3e\xfb\x53\x5c\x5d\xa0\xe2\xb8\x17\xc3\x9f\x28\x04\x71\x4d\xd6\xb1\x52\x4d\x4a\x45\x06\x38\xea\xb8\x00\xa7\xc4\x4e\x85\xac\xf1\x4e\xc9\x68\xfa\x6f\xdf\x51\x9f\x02\x19\x2e\x30\x48\x6e\xd3\x31\x29\x77\xd9\xb0\xe5\x3f\x76\x8f\x17\xbb\xe5\x68\x97\x01\xfb\x0b\xf6\xfe\x7e\xcb\x4a\xf7\x19\x90\xce\x58\x1f\x7d\xd2\xd5\xff\x60\x9c\xb3\x29\x48\x1a\xf3\x23\x27\xdf\x47\xc3\x69\x48\x03\x6e\xc7\xc4\xb3\xee\xeb\x4f\x83\xaf\x17\x87\xcb\x9f\xec\xfc\x52\xcf\x7d\xbc\xe1\x5b\x5e\x89\xa8\x57\xff\x81\x1d\x47\x52\xae\x0b\x68\xec\x79\x9c\x48\xbd\xe1\x2e\x58\x52\x2e\x30\xf9\xfe\x7d\xc8\xfe\xf1\x63\xf2\x66\xb8\x24\xf7\x8e\x5f\x12\xd7\x1b\xff\x89\xd4\xb4\xcf\x23\xf4\x79\xcb\x1d\xd9\x74\x31\xbc\xac\xb3\xd8\x93\xd8\xe1\xf9\x97\x6e\x91\x12\x6b\xe9\xf6\xec\xd4\xc7\xe3\xb5\x7c\x68\x8c\xe3\x4f\xb6\xf2\xe1\xbd\xb0\xad\x8b\x53\x09\xff\x72\x2c\x1f\x8a\xf1\x04\x50\x8a\x0d\xeb\x6b\xed\xdf\x01\x00\x00\xff\xff\x12\x55\x82\xdf\x88\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x6f\xdb\x46\x10\x85\xef\xfc\x15\x0f\xd2\xa5\x05\x6c\xca\xd6\x91\x3d\x29\xb2\x93\x10\x75\x24\xc0\x94\x1b\xe4\x14\x8c\x96\x23\x72\xd0\xe5\xee\x76\x77\x28\x45\x08\xf2\xdf\x0b\x4a\x54\x12\xd5\x0d\x4f\xc4\xce\xdb\x37\xdf\xbc\xd9\x29\x96\x3e\x1c\xa3\x34\xad\x62\x7e\x37\x9f\xe3\x9d\xf7\x8d\x65\x3c\x3d\x2d\xb3\x69\x36\xc5\x93\x18\x76\x89\x6b\xf4\xae\xe6\x08\x6d\x19\x8b\x40\xa6\xe5\x4b\xe5\x06\x7f\x71\x4c\xe2\x1d\xe6\xf9\x1d\x7e\x1b\x04\x93\xb1\x34\xf9\xfd\x8f\x6c\x8a\xa3\xef\xd1\xd1\x11\xce\x2b\xfa\xc4\xd0\x56\x12\x76\x62\x19\xfc\xc5\x70\x50\x88\x83\xf1\x5d\xb0\x42\xce\x30\x0e\xa2\x2d\xf4\x87\x7f\x9e\x4d\xf1\x69\xb4\xf0\x5b\x25\x71\x20\x18\x1f\x8e\xf0\xbb\x9f\x75\x20\x3d\x01\x9f\xbe\x56\x35\x14\xb3\xd9\xe1\x70\xc8\xe9\x44\x9b\xfb\xd8\xcc\xec\x59\x99\x66\x4f\xe5\xf2\x71\x55\x3d\xde\xce\xf3\xbb\xd3\x9d\x17\x67\x39\x25\x44\xfe\xa7\x97\xc8\x35\xb6\x47\x50\x08\x56\x0c\x6d\x2d\xc3\xd2\x01\x3e\x82\x9a\xc8\x5c\x43\xfd\x00\x7c\x88\xa2\xe2\x9a\x1b\x24\xbf\xd3\x03\x45\xce\xa6\xa8\x25\x69\x94\x6d\xaf\x57\x69\x5d\xf0\x24\x5d\x09\xbc\x03\x39\x4c\x16\x15\xca\x6a\x82\x37\x8b\xaa\xac\x6e\xb2\x29\x3e\x96\x9b\xf7\xeb\x97\x0d\x3e\x2e\x9e\x9f\x17\xab\x4d\xf9\x58\x61\xfd\x8c\xe5\x7a\xf5\x50\x6e\xca\xf5\xaa\xc2\xfa\x2d\x16\xab\x4f\xf8\xb3\x5c\x3d\xdc\x80\x45\x5b\x8e\xe0\x2f\x21\x0e\xfc\x3e\x42\x86\x1c\xb9\x1e\x42\xab\x98\xaf\x00\x76\xfe\xbc\xbe\x14\xd8\xc8\x4e\x0c\x2c\xb9\xa6\xa7\x86\xd1\xf8\x3d\x47\x27\xae\x41\xe0\xd8\x49\x1a\xb6\x99\x40\xae\xce\xa6\xb0\xd2\x89\x92\x9e\x4e\x5e\x0d\x95\x67\x19\x05\x19\xf7\x5f\xc0\xf8\xc8\xb9\x71\xb1\xcb\x8d\xf5\x7d\x9d\x37\xa7\xa7\x94\x1b\xdf\xcd\xf6\xf7\x64\x43\x4b\xf7\xd9\xdf\xe2\xea\x02\x15\xc7\xbd\x18\xfe\x40\x21\x88\x6b\xb2\x8e\x95\x6a\x52\x2a\x32\xc0\x51\xc7\x05\x38\x25\x76\x2a\x64\x8d\x77\x4a\x46\xd3\xff\xfb\x8e\xfa\x14\xc8\x70\x81\x41\x72\x9b\x8e\x49\xb9\xcb\x86\x29\x7f\xd8\x3d\x5e\xec\x96\xa3\x5d\x06\xec\x2f\xd8\xfb\xfb\x2d\x2b\xdd\x67\x40\x3a\x63\xbd\xf7\x49\x57\xbf\xc0\x38\xf7\xa6\x20\x69\xec\x1f\x39\xf9\x3e\x1a\x4e\x43\x37\xe0\x76\xec\x78\xd6\x7d\xfe\x6e\xf0\xf9\xe2\x70\xf9\x39\xa9\x81\x73\x1e\xaf\xf8\x96\x57\x22\xea\xd5\xbf\x63\xc7\x91\x94\xeb\x02\x1a\x7b\x1e\x2b\x52\x6f\xb8\x0b\x96\x94\x0b\x4c\xbe\x7e\x1d\x7a\x7f\xfb\x36\x79\x55\x5c\x92\x7b\xc3\x2f\x89\xeb\x8d\xff\x40\x6a\xda\xe7\x11\xfa\x3c\xe5\x8e\x6c\xba\x18\x5e\xc6\x59\xec\x49\xec\xf0\xfc\x4b\xb7\x48\x89\xb5\x74\x7b\x76\xea\xe3\xf1\x5a\x3e\x24\xc6\xf1\x3b\x5b\xf9\xf0\x56\xd8\xd6\xc5\x29\x84\xff\x38\x96\x0f\xc5\x78\x02\x28\xc5\x86\xf5\x67\xed\xbf\x03\x00\x12\x55\x82\xdf\x88\x04\x00\x00"),
 		},
 		"/filestore.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "filestore.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3093,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\x52\xe2\xa3\x7a\x72\x9c\x64\x57\x68\xd6\x2e\x2c\xa7\x8b\x3d\x2d\xc6\xd4\x58\x62\x43\x91\x2c\x39\xb2\x57\x48\xfd\xdf\x0b\x7d\x79\xed\x7c\x14\x3d\x74\x7b\xb2\x0e\xb6\x30\x33\x7c\xef\x0d\xe7\x8d\xc6\x30\x33\xb6\x76\x32\x2f\x18\x26\x57\x93\x09\x7c\x30\x26\x57\x04\x0f\x0f\xb3\x60\x1c\x8c\xe1\x41\x0a\xd2\x9e\x32\xa8\x74\x46\x0e\xb8\x20\x98\x5a\x14\x05\x0d\x99\x0b\xf8\x9d\x9c\x97\x46\xc3\x24\xbc\x82\x9f\x9a\x82\x51\x9f\x1a\xfd\xfc\x4b\x30\x86\xda\x54\x50\x62\x0d\xda\x30\x54\x9e\x80\x0b\xe9\x61\x23\x15\x01\x7d\x13\x64\x19\xa4\x06\x61\x4a\xab\x24\x6a\x41\xb0\x93\x5c\xb4\x34\x3d\x48\x18\x8c\xe1\x4b\x0f\x61\xd6\x8c\x52\x03\x82\x30\xb6\x06\xb3\x39\xae\x03\xe4\x56\x70\xfb\x14\xcc\x36\x8e\xa2\xdd\x6e\x17\x62\xab\x36\x34\x2e\x8f\x54\x57\xe9\xa3\x87\x64\x76\x37\x4f\xef\x2e\x27\xe1\x55\x7b\xe6\x51\x2b\xf2\x1e\x1c\xfd\x59\x49\x47\x19\xac\x6b\x40\x6b\x95\x14\xb8\x56\x04\x0a\x77\x60\x1c\x60\xee\x88\x32\x60\xd3\x08\xde\x39\xc9\x52\xe7\x17\xe0\xcd\x86\x77\xe8\x28\x18\x43\x26\x3d\x3b\xb9\xae\xf8\xe4\xb6\x06\x79\xd2\x9f\x14\x18\x0d\xa8\x61\x34\x4d\x21\x49\x47\x70\x33\x4d\x93\xf4\x22\x18\xc3\xe7\x64\xf5\x71\xf1\xb8\x82\xcf\xd3\xe5\x72\x3a\x5f\x25\x77\x29\x2c\x96\x30\x5b\xcc\x6f\x93\x55\xb2\x98\xa7\xb0\xb8\x87\xe9\xfc\x0b\xfc\x9a\xcc\x6f\x2f\x80\x24\x17\xe4\x80\xbe\x59\xd7\xe8\x37\x0e\x64\x73\x8f\x94\x35\x97\x96\x12\x9d\x08\xd8\x98\x4e\x90\xb7\x24\xe4\x46\x0a\x50\xa8\xf3\x0a\x73\x82\xdc\x6c\xc9\x69\xa9\x73\xb0\xe4\x4a\xe9\x9b\x69\x7a\x40\x9d\x05\x63\x50\xb2\x94\x8c\xdc\x46\x5e\x35\x15\x06\x01\x5a\xd9\xcf\x3f\x06\x61\x1c\x85\x42\xbb\x32\x14\xca\x54\x59\x98\xb7\x56\x0a\x85\x29\xa3\xed\x35\x2a\x5b\xe0\x75\xf0\x24\x75\x16\x43\x4a\x6e\x2b\x05\x7d\x42\x6b\xa5\xce\x83\x92\x18\x33\x64\x8c\x03\x00\x8d\x25\xc5\xad\x3f\x3c\xbf\x8b\xd7\xd7\x79\x8b\x82\x62\x68\x4a\x2e\x7d\xed\x99\xca\xa0\xe9\xee\x3b\xcc\xfd\x00\x13\x00\x6c\x07\x99\xdb\xeb\x35\x31\x5e\x07\x00\xbe\x93\xf1\xd1\x78\x9e\xbf\xa0\xed\xb8\xd0\x4a\xdf\xf3\x39\xf2\xa6\x72\x82\x7c\x83\x0e\x70\xd9\x33\x74\x75\x5f\x0f\x07\xbf\xae\x51\x3c\x55\x36\xe8\x7c\xd8\x75\x7b\x50\x71\x73\x9c\xc3\x8a\xcd\x07\xd2\xe4\x90\x29\x8b\x81\x5d\x45\x7d\x46\x66\x2b\x2a\xad\x42\xa6\x18\x46\xd6\x99\x3f\x48\xb0\x8f\x9e\x9f\xfb\xd7\xfd\x3e\x52\x46\x74\x33\x89\x9e\x9f\x87\xf7\xfd\x3e\xea\xb8\x9b\x60\x23\x6e\xbf\x1f\xbd\x02\x9c\xa1\xbe\xa1\x47\x4f\xd9\xca\x7c\x42\x16\xc5\xb2\xef\xaa\x6f\x1f\x95\x1f\x44\x0c\xfd\x4e\xb7\x28\x55\xb3\x07\x89\x9e\x7a\x4f\x9c\xe8\x2d\x69\x36\xae\x3e\x2d\x1f\x46\xd8\x8f\x34\xee\xc3\xc3\x20\x9a\xdf\x43\x48\xe1\x9a\x94\x8f\xfb\xff\x17\x7c\xc9\xed\xf7\xb3\x8c\x2e\x27\xbe\x97\xa4\xb2\x13\x88\x42\x92\x43\x27\x0a\x29\x50\x2d\x69\x43\x8e\xf4\x61\x30\xdd\x70\xb8\xb6\x14\x43\x7f\x61\x87\x38\xc0\x13\xd5\x87\xf0\x92\x36\x2f\xb8\xdf\xc1\xda\xf4\x0a\xfe\x2d\x5c\xf3\x64\xe4\x85\x93\x96\x5b\xc7\xfd\x75\x79\x94\x01\x58\x15\x34\x1c\x02\x2e\x90\xbb\x4f\xe2\x20\x02\xd6\xa4\x8c\xce\x3d\xb0\x09\x8f\x8e\xe5\xdb\xa7\xf8\x04\xa5\xf3\xd6\x6f\xaf\x34\xbd\xe9\xf4\x23\x1c\x67\x2a\x1b\x1f\xd8\x4a\xd4\x98\x93\x7b\x77\xcd\xfe\xc1\xe9\x52\x7b\x6e\x3e\xd9\x6f\x7b\x3d\x39\xcd\xfe\x00\xb7\x0f\xfc\x67\xbf\x9f\xfd\xfe\x7f\xf8\xdd\x6b\xb4\xbe\x30\xfc\xb6\xdf\xd3\xd3\xec\x0f\xf6\xfb\xf0\xbe\xdf\x47\x83\xac\xf3\x1a\x9c\xd7\xe0\x3f\x5a\x83\xbf\x03\x00\x00\xff\xff\xe7\x90\x20\x5b\x15\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x73\xe2\x46\x10\xbd\xeb\x57\xbc\x82\x4b\x52\x65\xc3\xda\x47\xe5\x84\xbf\x76\x55\xf1\xe2\x14\xe0\x6c\xed\x69\xab\x19\x35\xd2\xc4\xa3\x99\xc9\x4c\x0b\x96\x72\xf8\xef\x29\x81\x04\xc6\x1f\xa9\x1c\xb2\x39\x19\x51\x30\x35\xfd\xe6\xf5\xeb\xe9\xd7\xea\xe3\xd2\xf9\x75\xd0\x45\x29\x38\xff\x70\x7e\x8e\x8f\xce\x15\x86\x71\x7b\x7b\x99\xf4\x93\x3e\x6e\xb5\x62\x1b\x39\x47\x6d\x73\x0e\x90\x92\x31\xf2\xa4\x4a\xee\x22\x27\xf8\x9d\x43\xd4\xce\xe2\x7c\xf0\x01\x3f\x35\x80\x5e\x1b\xea\xfd\xfc\x4b\xd2\xc7\xda\xd5\xa8\x68\x0d\xeb\x04\x75\x64\x48\xa9\x23\x16\xda\x30\xf8\xbb\x62\x2f\xd0\x16\xca\x55\xde\x68\xb2\x8a\xb1\xd2\x52\x42\x0e\xfc\x83\xa4\x8f\xaf\x2d\x85\x9b\x0b\x69\x0b\x82\x72\x7e\x0d\xb7\x78\x8a\x03\xc9\x56\xf0\xf6\x53\x8a\xf8\x74\x38\x5c\xad\x56\x03\xda\xaa\x1d\xb8\x50\x0c\xcd\x0e\x19\x87\xb7\xd9\xe5\xf5\x78\x7a\x7d\x7a\x3e\xf8\xb0\x3d\x73\x6f\x0d\xc7\x88\xc0\x7f\xd6\x3a\x70\x8e\xf9\x1a\xe4\xbd\xd1\x8a\xe6\x86\x61\x68\x05\x17\x40\x45\x60\xce\x21\xae\x11\xbc\x0a\x5a\xb4\x2d\x4e\x10\xdd\x42\x56\x14\x38\xe9\x23\xd7\x51\x82\x9e\xd7\x72\x74\x5b\x9d\x3c\x1d\x8f\x00\xce\x82\x2c\x7a\xa3\x29\xb2\x69\x0f\x17\xa3\x69\x36\x3d\x49\xfa\xf8\x92\xcd\x3e\xdd\xdd\xcf\xf0\x65\x34\x99\x8c\xc6\xb3\xec\x7a\x8a\xbb\x09\x2e\xef\xc6\x57\xd9\x2c\xbb\x1b\x4f\x71\x77\x83\xd1\xf8\x2b\x7e\xcd\xc6\x57\x27\x60\x2d\x25\x07\xf0\x77\x1f\x1a\xfd\x2e\x40\x37\xf7\xc8\x79\x73\x69\x53\xe6\x23\x01\x0b\xb7\x6b\x5f\xf4\xac\xf4\x42\x2b\x18\xb2\x45\x4d\x05\xa3\x70\x4b\x0e\x56\xdb\x02\x9e\x43\xa5\x63\xd3\xcd\x08\xb2\x79\xd2\x87\xd1\x95\x16\x92\xed\xce\x8b\xa2\x06\x49\x42\x5e\xb7\xfd\x4f\xa1\x5c\xe0\x81\xb2\xa1\x1a\x28\xe3\xea\x7c\x50\x6c\xad\x34\x50\xae\x1a\x2e\xcf\xc8\xf8\x92\xce\x92\x07\x6d\xf3\x14\x53\x0e\x4b\xad\xf8\x33\x79\xaf\x6d\x91\x54\x2c\x94\x93\x50\x9a\x00\x96\x2a\x4e\xb7\xfe\x88\xf2\x26\x5f\x8b\x8b\x9e\x14\xa7\x68\x20\xa7\x71\x1d\x85\xab\xa4\xa9\xee\x40\x73\xd3\xd1\x24\xc0\xb2\x93\xb9\x3c\x9b\xb3\xd0\x59\x02\xc4\x9d\x8c\x4f\x2e\xca\xf8\x59\xda\x9d\x76\xf2\x3a\xb6\xf9\x02\x47\x57\x07\xc5\xb1\x61\x07\x4e\xdb\x0c\x3b\xdc\xb7\xfd\xc1\x6f\x73\x52\x0f\xb5\xdf\x62\x80\x5d\xb5\x7b\x15\x17\x4f\x63\x54\x8b\xfb\xc8\x96\x03\x09\xe7\x29\x24\xd4\xdc\x46\x74\x3e\xe3\xca\x1b\x12\x4e\xd1\xf3\xc1\xfd\xc1\x4a\xe2\xf0\xf1\xb1\x5d\x6e\x36\x43\xe3\xd4\xae\x27\xc3\xc7\xc7\x6e\xbd\xd9\x0c\x77\xb9\x1b\x68\x23\x6e\xb3\xe9\xbd\x20\xbc\x24\x7b\xc1\xf7\x91\xf3\x99\xfb\x4c\xa2\xca\x49\x5b\x55\x5b\x3e\x99\xd8\x89\xe8\xea\x1d\x2d\x49\x9b\x66\x0e\x32\x3b\x8a\x91\x25\xb3\x4b\xb6\xe2\xc2\xfa\x18\xde\xb5\xb0\x6d\x69\xda\x6e\x77\x8d\x68\x7e\xf7\x5b\x86\xe6\x6c\x62\xda\xfe\x3f\xcb\x97\x5d\x1d\xce\x0a\x85\x82\xe5\x46\xb3\xc9\x8f\x28\x4a\xcd\x81\x82\x2a\xb5\x22\x33\xe1\x05\x07\xb6\xfb\xc6\x34\xdf\x53\xc8\xda\x73\x8a\xf6\xc2\xf6\xfb\xc0\x03\xaf\xf7\xdb\x13\x5e\x3c\xcb\xfd\x06\xd7\xa2\x55\xf0\x6f\xe9\x9a\x27\xe7\xa8\x82\xf6\x4d\x63\x52\xfc\x75\xfa\x24\x02\xcc\x4a\xee\x0e\x41\x4a\x92\xdd\x2b\xb1\x13\x81\x39\x1b\x67\x8b\x08\x71\x83\x27\xc7\x8a\xe5\xc3\x41\xd4\xc1\x5b\xbf\xbd\xd0\xf4\xaa\xd3\x0f\x4f\x11\x5c\xed\xd3\x7d\xb6\x8a\x2c\x15\x1c\xde\x1c\xb3\x7f\x70\xba\xb6\x51\x9a\x57\xf6\xeb\x5e\xcf\x8e\xa3\x3f\xc0\xed\x5d\xfe\x77\xbf\xbf\xfb\xfd\xff\xf0\x7b\xb4\xe4\x63\xe9\xe4\x75\xbf\x4f\x8f\xa3\x3f\xd8\xef\xdd\x7a\xb3\x19\x76\xb2\xde\xc7\xe0\x7d\x0c\xfe\xa3\x31\xf8\x7b\x00\xe7\x90\x20\x5b\x15\x0c\x00\x00"),
 		},
 		"/firebase.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "firebase.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3932,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\x5f\x6f\xdb\x36\x14\xc5\xdf\xf5\x29\x0e\xec\x97\x0d\x48\xe4\x26\x8f\xda\x93\x9b\x3f\xad\xb0\xd4\x19\x6c\x67\x41\x9f\x82\x6b\xea\x5a\xe2\x42\x91\x1c\x49\xd9\x35\xb2\x7c\xf7\x41\xff\xdc\xa8\x69\xba\x0e\x2b\x86\x3c\x58\x4f\x01\x79\x78\xee\xb9\x57\xbf\x58\x1c\xe3\xcc\xd8\x9d\x93\x79\x11\x70\xfa\xe6\xf4\x14\xef\x8c\xc9\x15\xe3\xea\xea\x2c\x1a\x47\x63\x5c\x49\xc1\xda\x73\x86\x4a\x67\xec\x10\x0a\xc6\xd4\x92\x28\xb8\xdf\x39\xc2\xef\xec\xbc\x34\x1a\xa7\xf1\x1b\xfc\x54\x0b\x46\xdd\xd6\xe8\xe7\x5f\xa2\x31\x76\xa6\x42\x49\x3b\x68\x13\x50\x79\x46\x28\xa4\xc7\x5a\x2a\x06\x7f\x12\x6c\x03\xa4\x86\x30\xa5\x55\x92\xb4\x60\x6c\x65\x28\x9a\x32\x9d\x49\x1c\x8d\xf1\xb1\xb3\x30\xab\x40\x52\x83\x20\x8c\xdd\xc1\xac\x9f\xea\x40\xa1\x09\xdc\x3c\x45\x08\x36\x99\x4c\xb6\xdb\x6d\x4c\x4d\xda\xd8\xb8\x7c\xa2\x5a\xa5\x9f\x5c\xa5\x67\x17\xb3\xc5\xc5\xf1\x69\xfc\xa6\x39\x73\xa3\x15\x7b\x0f\xc7\x7f\x56\xd2\x71\x86\xd5\x0e\x64\xad\x92\x82\x56\x8a\xa1\x68\x0b\xe3\x40\xb9\x63\xce\x10\x4c\x1d\x78\xeb\x64\x90\x3a\x3f\x82\x37\xeb\xb0\x25\xc7\xd1\x18\x99\xf4\xc1\xc9\x55\x15\x06\xd3\xea\xe3\x49\x3f\x10\x18\x0d\xd2\x18\x4d\x17\x48\x17\x23\xbc\x9d\x2e\xd2\xc5\x51\x34\xc6\x6d\xba\x7c\x7f\x7d\xb3\xc4\xed\x74\x3e\x9f\xce\x96\xe9\xc5\x02\xd7\x73\x9c\x5d\xcf\xce\xd3\x65\x7a\x3d\x5b\xe0\xfa\x12\xd3\xd9\x47\xfc\x9a\xce\xce\x8f\xc0\x32\x14\xec\xc0\x9f\xac\xab\xf3\x1b\x07\x59\xcf\x91\xb3\x7a\x68\x0b\xe6\x41\x80\xb5\x69\x03\x79\xcb\x42\xae\xa5\x80\x22\x9d\x57\x94\x33\x72\xb3\x61\xa7\xa5\xce\x61\xd9\x95\xd2\xd7\x6f\xd3\x83\x74\x16\x8d\xa1\x64\x29\x03\x85\x66\xe5\x59\x53\x71\x14\x91\x95\xdd\xfb\x4f\x20\x8c\xe3\x58\x68\x57\xc6\x42\x99\x2a\x8b\xf3\x06\xa5\x58\x98\x72\xb2\x39\x21\x65\x0b\x3a\x89\xee\xa5\xce\x12\x2c\xd8\x6d\xa4\xe0\x0f\x64\xad\xd4\x79\x54\x72\xa0\x8c\x02\x25\x11\xa0\xa9\xe4\x04\x6b\xe9\x78\x45\xfe\x05\xbb\x4e\xe6\x2d\x09\x4e\x50\x4b\x8e\xfd\xce\x07\x2e\xa3\xba\xb9\xcf\x2e\x97\x9d\x4b\x04\x6c\xfa\x90\x9b\x93\x15\x07\x3a\x89\x00\xdf\x86\x78\x6f\x7c\x98\x0d\x8b\xb6\x95\xc8\x4a\xdf\x55\x73\xec\x4d\xe5\x04\xfb\xda\x1b\x38\xee\xfc\x5b\xdd\x5d\x7f\xee\x8e\x74\xe6\x8c\xcc\xee\xc8\xda\xa8\x05\xb1\x6d\xb7\xcf\x31\x6d\xf7\xa7\xfb\x6d\xaa\x82\x79\xc7\x9a\x1d\x05\xce\x12\x04\x57\x71\xb7\x23\xb3\x25\x97\x56\x51\xe0\x04\x23\xeb\xcc\x1f\x2c\x82\x9f\x3c\x3c\x74\x7f\x3e\x3e\x4e\x68\x6f\x56\xaf\x93\xb5\x77\x32\x7b\x7c\x1c\x3d\x3b\x7f\x46\xfa\x2d\xdf\x78\xce\x96\xe6\x03\x05\x51\xcc\xbb\x5e\xba\x9e\x49\xf9\xbe\x66\xdf\xe5\x74\x43\x52\xd5\xe8\xa7\x7a\xea\x3d\x87\x54\x6f\x58\x07\xe3\x76\x43\x79\x3d\x3f\x76\xfb\xf8\xe9\xf9\xa5\x64\x95\x25\x68\xa3\x7c\xe1\x99\x9e\x27\xdd\x0a\x10\xc8\xe5\x1c\xbe\xa6\x2e\x24\x3b\x72\xa2\x90\x82\xd4\x9c\xd7\xec\x58\xef\x87\xde\x0e\x3e\xec\x2c\x27\xe8\xa6\xb0\x5f\x07\xee\x79\xb7\x5f\x9e\xf3\xfa\x8b\xea\x2f\x78\xad\xbb\x0c\xdf\x6b\x57\x3f\x19\x7b\xe1\xa4\x0d\x0d\x4c\x7f\x1d\x3f\xd9\x01\x96\x05\xf7\x87\x10\x0a\x0a\xed\x6f\x5d\x1f\x02\x2b\x56\x46\xe7\x1e\xc1\xc4\x4f\x8e\xe5\x9b\xfb\x64\xe0\xd2\x32\xf3\xdb\xb3\x4c\x5f\x85\xf8\x89\x8f\x33\x95\x4d\xf6\xd5\x4a\xd2\x94\xb3\x7b\xf1\x1f\xe8\x1b\x14\x5b\xab\xf8\x65\x86\xeb\xdd\x1f\x43\xb0\x34\xfe\x40\xef\x81\xde\x1f\x4b\xef\x70\x1a\x43\x76\x87\xa9\xfe\x03\xba\xff\x23\xa7\xfd\xb7\xb1\xfb\x56\x7e\x1e\x76\xdb\xfe\xb0\xdd\x7f\xe4\x75\x28\x3f\x00\xfb\x7a\x80\xbd\x53\x46\x34\xf7\xac\x6f\x91\x7b\x35\xd4\x1c\x08\x3e\x10\xfc\x0a\x08\xde\xf2\xea\xc5\xeb\xc2\x2d\xaf\xfe\xf5\x65\xe1\xe9\x1d\xe1\xe1\xa1\xae\xf9\x2a\x6e\x06\x75\x90\xef\xa5\xb4\xd1\xfe\x1d\x00\x00\xff\xff\xbe\x3a\x75\xcd\x5c\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\x4f\x6f\xe3\x36\x13\xc6\xef\xfa\x14\x0f\xec\xcb\xfb\x02\x89\x9c\xe4\xa8\x9e\xb4\xf9\xb3\x2b\x34\xeb\x14\xb6\xd3\x60\x4f\xc6\x98\x1a\x4b\x6c\x28\x92\x25\x29\x7b\x8d\x34\xdf\xbd\x90\x2d\xdb\x51\xb2\x5e\x6c\xd1\x45\x91\x83\xa3\x1c\x0c\xce\xc3\x99\x67\x46\x3f\x49\xec\xe3\xd2\xd8\x95\x93\x45\x19\x70\x71\x76\x71\x81\x8f\xc6\x14\x8a\x71\x7b\x7b\x19\xf5\xa3\x3e\x6e\xa5\x60\xed\x39\x47\xad\x73\x76\x08\x25\x23\xb5\x24\x4a\xde\x46\x4e\xf0\x3b\x3b\x2f\x8d\xc6\x45\x7c\x86\xff\x35\x82\x5e\x1b\xea\xfd\xff\x97\xa8\x8f\x95\xa9\x51\xd1\x0a\xda\x04\xd4\x9e\x11\x4a\xe9\x31\x97\x8a\xc1\x5f\x05\xdb\x00\xa9\x21\x4c\x65\x95\x24\x2d\x18\x4b\x19\x4a\x84\x7d\xfe\x38\xea\xe3\x4b\x9b\xc2\xcc\x02\x49\x0d\x82\x30\x76\x05\x33\x7f\xa9\x03\x85\xb5\xe1\xf5\x5f\x19\x82\x4d\x06\x83\xe5\x72\x19\xd3\xda\x6d\x6c\x5c\x31\x50\x1b\xa5\x1f\xdc\x66\x97\xd7\xc3\xf1\xf5\xe9\x45\x7c\xb6\xde\x73\xaf\x15\x7b\x0f\xc7\x7f\xd6\xd2\x71\x8e\xd9\x0a\x64\xad\x92\x82\x66\x8a\xa1\x68\x09\xe3\x40\x85\x63\xce\x11\x4c\x63\x78\xe9\x64\x90\xba\x38\x81\x37\xf3\xb0\x24\xc7\x51\x1f\xb9\xf4\xc1\xc9\x59\x1d\x3a\xd3\xda\xda\x93\xbe\x23\x30\x1a\xa4\xd1\x4b\xc7\xc8\xc6\x3d\x7c\x48\xc7\xd9\xf8\x24\xea\xe3\x21\x9b\x7c\xba\xbb\x9f\xe0\x21\x1d\x8d\xd2\xe1\x24\xbb\x1e\xe3\x6e\x84\xcb\xbb\xe1\x55\x36\xc9\xee\x86\x63\xdc\xdd\x20\x1d\x7e\xc1\xaf\xd9\xf0\xea\x04\x2c\x43\xc9\x0e\xfc\xd5\xba\xc6\xbf\x71\x90\xcd\x1c\x39\x6f\x86\x36\x66\xee\x18\x98\x9b\xcd\xed\xf3\x96\x85\x9c\x4b\x01\x45\xba\xa8\xa9\x60\x14\x66\xc1\x4e\x4b\x5d\xc0\xb2\xab\xa4\x6f\xee\xa6\x07\xe9\x3c\xea\x43\xc9\x4a\x06\x0a\xeb\x95\x37\x4d\xc5\x51\x44\x56\xb6\xf7\x3f\x81\x30\x8e\x63\xa1\x5d\x15\x0b\x65\xea\x3c\x2e\xd6\x28\xc5\xc2\x54\x83\xc5\x39\x29\x5b\xd2\x79\xf4\x28\x75\x9e\x60\xcc\x6e\x21\x05\x7f\x26\x6b\xa5\x2e\xa2\x8a\x03\xe5\x14\x28\x89\x00\x4d\x15\x27\x98\x4b\xc7\x33\xf2\x07\xd2\xb5\x32\x6f\x49\x70\x82\x46\x72\xea\x57\x3e\x70\x15\x35\xcd\xed\xb3\xdc\xb4\x59\x22\x60\xb1\x35\xb9\x38\x9f\x71\xa0\xf3\x08\xf0\x1b\x13\x9f\x8c\x0f\xc3\x6e\xd1\x8d\x71\xb2\xd2\xb7\xd5\x1c\x7b\x53\x3b\xc1\xbe\xc9\x0d\x9c\xb6\xf9\x37\xba\xe9\x76\xdf\x94\x74\xee\x8c\xcc\xa7\x64\xed\x5a\x07\x6c\xda\xdd\xfa\x48\x37\xf1\x74\x17\xa6\x3a\x98\x8f\xac\xd9\x51\xe0\x3c\x41\x70\x35\xb7\x11\x99\x4f\xb8\xb2\x8a\x02\x27\xe8\x59\x67\xfe\x60\x11\xfc\xe0\xe9\xa9\xfd\xf9\xfc\x3c\x68\x8b\xa5\xd6\x36\xeb\x64\xed\x54\xe6\xcf\xcf\xbd\x37\xfb\x2f\x49\x7f\xe0\x7b\xcf\xf9\xc4\x7c\xa6\x20\xca\x51\xdb\x4b\xdb\x33\x29\xbf\xad\xb9\xed\x32\x5d\x90\x54\x0d\xfa\x99\x4e\xbd\xe7\x90\xe9\x05\xeb\x60\xdc\xaa\x2b\x6f\xe6\xc7\x6e\x67\x3f\xbb\xba\x91\xac\xf2\xa4\x79\x74\xa6\x32\x7f\x95\x33\xbb\x4a\xda\x15\x20\x90\x2b\x38\x7c\x4b\x5d\x4a\x76\xe4\x44\x29\x05\xa9\x11\xcf\xd9\xb1\xde\x0d\xbd\xf9\x3f\x45\x58\x59\x4e\xd0\x4e\x61\xb7\x0e\x3c\xf2\x6a\xb7\x3c\xe2\xf9\xab\xea\x07\x72\xcd\x5b\x0f\x3f\x9a\xae\xb9\x72\xf6\xc2\x49\xdb\x3c\x11\x09\xfe\x3a\x7d\x11\x01\x26\x25\x6f\x37\x21\x94\x14\x36\xef\xba\xad\x09\xcc\x58\x19\x5d\x78\x04\x13\xbf\xd8\x56\x2c\x1e\xf7\xa6\xf6\xcc\xfc\xf6\xc6\xd3\x37\x21\xde\x5f\x85\x33\xb5\x4d\x76\xd5\x2a\xd2\x54\xb0\x3b\xf8\x00\x7d\x87\x62\x6b\x15\x1f\x66\xb8\x89\xfe\x1c\x82\xa5\xf1\x47\x7a\x8f\xf4\xfe\x5c\x7a\x6d\xa7\x72\x97\xdd\xae\xab\x7f\x81\xee\x7f\xc8\xe9\xf6\xdb\xd8\x7e\x2b\xf7\xc3\xde\xb4\xdf\x5a\x7a\x95\xfb\x20\xaf\x5d\xf9\x11\xd8\xf7\x03\xec\x54\x19\xb1\x3e\x67\x7d\x8f\xdc\xdb\xae\xe6\x48\xf0\x91\xe0\x77\x40\xf0\x92\x67\x07\x8f\x0b\x0f\x3c\xfb\xc7\x87\x85\x17\x98\x0e\x9e\x9e\x9a\xf7\xdc\xbb\x38\x19\x34\x46\x7e\x94\x52\x4d\x15\x47\x7f\x0f\x00\xbe\x3a\x75\xcd\x5c\x0f\x00\x00"),
 		},
 		"/firebasedatabase.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "firebasedatabase.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1604,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe2\x48\x10\xc5\xef\xfe\x14\x4f\x70\xd9\x95\x12\x93\x70\xf4\x9e\x18\x92\xcc\x58\x9b\x81\x15\x90\x1d\xcd\x29\x2a\xda\x85\xdd\x1b\xbb\xbb\xb7\xbb\x0c\x83\xb2\x7c\xf7\x95\xb1\x9d\x81\xfc\x91\xc6\x17\xac\xea\x57\xaf\x7e\xae\xaa\x66\x88\xa9\x75\x7b\xaf\xf3\x42\x30\xbe\x1a\x8f\xf1\xd9\xda\xbc\x64\xdc\xdf\x4f\xa3\x61\x34\xc4\xbd\x56\x6c\x02\x67\xa8\x4d\xc6\x1e\x52\x30\x26\x8e\x54\xc1\xfd\xc9\x05\xfe\x66\x1f\xb4\x35\x18\xc7\x57\xf8\xad\x11\x0c\xba\xa3\xc1\xef\x7f\x44\x43\xec\x6d\x8d\x8a\xf6\x30\x56\x50\x07\x86\x14\x3a\x60\xa3\x4b\x06\xff\x50\xec\x04\xda\x40\xd9\xca\x95\x9a\x8c\x62\xec\xb4\x14\xc7\x32\x9d\x49\x1c\x0d\xf1\xbd\xb3\xb0\x6b\x21\x6d\x40\x50\xd6\xed\x61\x37\xa7\x3a\x90\x1c\x81\x8f\x4f\x21\xe2\x92\xd1\x68\xb7\xdb\xc5\x74\xa4\x8d\xad\xcf\x47\x65\xab\x0c\xa3\xfb\x74\x7a\x3b\x5b\xde\x5e\x8e\xe3\xab\x63\xce\x83\x29\x39\x04\x78\xfe\xb7\xd6\x9e\x33\xac\xf7\x20\xe7\x4a\xad\x68\x5d\x32\x4a\xda\xc1\x7a\x50\xee\x99\x33\x88\x6d\x80\x77\x5e\x8b\x36\xf9\x05\x82\xdd\xc8\x8e\x3c\x47\x43\x64\x3a\x88\xd7\xeb\x5a\xce\xba\xd5\xe3\xe9\x70\x26\xb0\x06\x64\x30\x98\x2c\x91\x2e\x07\xf8\x34\x59\xa6\xcb\x8b\x68\x88\x6f\xe9\xea\xcb\xfc\x61\x85\x6f\x93\xc5\x62\x32\x5b\xa5\xb7\x4b\xcc\x17\x98\xce\x67\x37\xe9\x2a\x9d\xcf\x96\x98\xdf\x61\x32\xfb\x8e\x3f\xd3\xd9\xcd\x05\x58\x4b\xc1\x1e\xfc\xc3\xf9\x86\xdf\x7a\xe8\xa6\x8f\x9c\x35\x4d\x5b\x32\x9f\x01\x6c\x6c\x0b\x14\x1c\x2b\xbd\xd1\x0a\x25\x99\xbc\xa6\x9c\x91\xdb\x2d\x7b\xa3\x4d\x0e\xc7\xbe\xd2\xa1\x99\x66\x00\x99\x2c\x1a\xa2\xd4\x95\x16\x92\x63\xe4\xcd\x47\xc5\x51\x44\x4e\x77\xf3\x4f\xa0\xac\xe7\x58\x19\x5f\xc5\xaa\xb4\x75\x16\xe7\xc7\x55\x8a\x95\xad\x46\xdb\x6b\x2a\x5d\x41\xd7\xd1\x93\x36\x59\x82\x25\xfb\xad\x56\xfc\x95\x9c\xd3\x26\x8f\x2a\x16\xca\x48\x28\x89\x00\x43\x15\x27\xd8\x68\xcf\x6b\x0a\xdc\x44\x9b\xdf\xf7\x6d\x3b\x79\x70\xa4\x38\x41\x23\xb9\x0c\xfb\x20\x5c\x45\xcd\x47\xfe\x74\xbb\xeb\xdc\x6e\x3a\xb7\x08\xd8\xf6\xd0\xdb\xeb\x35\x0b\x5d\x47\x40\x68\xa1\xbe\xd8\x20\xb3\xf7\x21\xda\xca\xe4\x74\xe8\xaa\x7b\x0e\xb6\xf6\x8a\x43\x53\x0b\xb8\xec\xea\xb5\xba\xc7\x3e\xff\xb1\x37\x78\xd4\x26\x48\xb3\xe5\x51\xbb\xa6\x6d\x33\x5e\xd3\xa5\xe7\x22\xaa\xc5\x7e\x66\xc3\x9e\x84\xb3\x04\xe2\xeb\xfe\x44\x67\x2b\xae\x5c\x49\xc2\x09\x06\xce\xdb\x7f\x58\x49\x18\x3d\x3f\x77\xaf\x87\xc3\xa8\xb4\xaa\x9d\xdd\xe8\xf9\xd9\x73\xae\xad\x39\x1c\x46\x3d\x44\x13\xec\xdf\x1f\x75\x76\x38\x0c\xde\xf8\x4e\xc9\x7c\xe2\x87\xc0\xd9\xca\x7e\x25\x51\xc5\xa2\xfb\xde\xae\x3f\x54\x86\x9e\xa5\xef\xc4\x64\x4b\xba\x6c\xae\x4d\x6a\x26\x21\xb0\xa4\x66\xcb\x46\xac\xdf\x9f\xcb\xfb\x89\x77\x1b\x90\x74\xe1\x7e\x60\x27\x58\xaf\xfc\xd3\x9b\x9f\x5a\x21\x9f\xb3\xdc\x69\x2e\xb3\xf7\x52\x0a\xcd\x9e\xbc\x2a\xb4\xa2\x72\xc1\x1b\xf6\x6c\x5e\x26\xd5\x4e\x4b\xf6\x8e\x13\x74\xed\x7a\x89\x03\x4f\xbc\x7f\x09\x2f\x78\xf3\x0a\xe1\x03\xaf\x4d\x07\xf2\xab\x76\xcd\x93\x71\x50\x5e\x3b\x39\x6e\xe2\x7f\x97\x27\x27\xc0\xaa\xe0\x3e\x09\x52\x90\xb4\x7f\x9c\x3d\x04\xd6\x5c\x5a\x93\x07\x88\x8d\x4f\xd2\xf2\xed\x53\x72\xe6\xd2\xae\xd8\x5f\x6f\x98\xde\xbd\x01\x27\x3e\xde\xd6\x2e\x79\xa9\x56\x91\xa1\x9c\xfd\x07\xb7\xf0\xff\x00\x00\x00\xff\xff\x37\xc4\xfc\x0e\x44\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe2\x48\x10\xc5\xef\xfe\x14\x4f\x70\xd9\x95\x12\x93\xe4\xe8\x3d\x31\xf9\x33\x63\x6d\x06\x56\x40\x76\x34\xa7\xa8\x68\x17\x76\x6f\xda\xdd\xbd\xdd\x65\x18\x94\xe5\xbb\xaf\x0c\x76\x08\x49\x46\x1a\x8c\x84\x55\xfd\xea\xd5\xaf\xab\xba\x19\xe2\xda\xf9\x6d\xd0\x65\x25\xb8\xba\xb8\xba\xc2\x67\xe7\x4a\xc3\xb8\xbf\xbf\x4e\x86\xc9\x10\xf7\x5a\xb1\x8d\x5c\xa0\xb1\x05\x07\x48\xc5\x18\x7b\x52\x15\xf7\x2b\x67\xf8\x9b\x43\xd4\xce\xe2\x2a\xbd\xc0\x6f\xad\x60\xd0\x2d\x0d\x7e\xff\x23\x19\x62\xeb\x1a\xd4\xb4\x85\x75\x82\x26\x32\xa4\xd2\x11\x2b\x6d\x18\xfc\x43\xb1\x17\x68\x0b\xe5\x6a\x6f\x34\x59\xc5\xd8\x68\xa9\x20\x47\xff\x34\x19\xe2\x7b\x67\xe1\x96\x42\xda\x82\xa0\x9c\xdf\xc2\xad\x5e\xeb\x40\xb2\x07\xde\x7f\x2a\x11\x9f\x8d\x46\x9b\xcd\x26\xa5\x3d\x6d\xea\x42\x39\x32\x07\x65\x1c\xdd\xe7\xd7\xb7\x93\xf9\xed\xf9\x55\x7a\xb1\xcf\x79\xb0\x86\x63\x44\xe0\x7f\x1b\x1d\xb8\xc0\x72\x0b\xf2\xde\x68\x45\x4b\xc3\x30\xb4\x81\x0b\xa0\x32\x30\x17\x10\xd7\x02\x6f\x82\x16\x6d\xcb\x33\x44\xb7\x92\x0d\x05\x4e\x86\x28\x74\x94\xa0\x97\x8d\x9c\x74\xab\xc7\xd3\xf1\x44\xe0\x2c\xc8\x62\x30\x9e\x23\x9f\x0f\xf0\x69\x3c\xcf\xe7\x67\xc9\x10\xdf\xf2\xc5\x97\xe9\xc3\x02\xdf\xc6\xb3\xd9\x78\xb2\xc8\x6f\xe7\x98\xce\x70\x3d\x9d\xdc\xe4\x8b\x7c\x3a\x99\x63\x7a\x87\xf1\xe4\x3b\xfe\xcc\x27\x37\x67\x60\x2d\x15\x07\xf0\x0f\x1f\x5a\x7e\x17\xa0\xdb\x3e\x72\xd1\x36\x6d\xce\x7c\x02\xb0\x72\x87\xf1\x45\xcf\x4a\xaf\xb4\x82\x21\x5b\x36\x54\x32\x4a\xb7\xe6\x60\xb5\x2d\xe1\x39\xd4\x3a\xb6\xd3\x8c\x20\x5b\x24\x43\x18\x5d\x6b\x21\xd9\x47\xde\x6d\x2a\x4d\x12\xf2\xba\x9b\x7f\x06\xe5\x02\xa7\xca\x86\x3a\x55\xc6\x35\x45\x5a\xee\x8f\x52\xaa\x5c\x3d\x5a\x5f\x92\xf1\x15\x5d\x26\x4f\xda\x16\x19\xe6\x1c\xd6\x5a\xf1\x57\xf2\x5e\xdb\x32\xa9\x59\xa8\x20\xa1\x2c\x01\x2c\xd5\x9c\x61\xa5\x03\x2f\x29\x72\x1b\x6d\x7f\x3f\xb6\xed\xe4\xd1\x93\xe2\x0c\xad\xe4\x3c\x6e\xa3\x70\x9d\xb4\x9b\x3c\xba\xdd\x75\x6e\x37\x9d\x5b\x02\xac\x7b\xe8\xf5\xe5\x92\x85\x2e\x13\x20\x1e\xa0\xbe\xb8\x28\x93\x8f\x21\x0e\x95\xc9\xeb\xd8\x55\x0f\x1c\x5d\x13\x14\xc7\xb6\x16\x70\xde\xd5\x3b\xe8\x1e\xfb\xfc\xc7\xde\xe0\x51\xdb\x28\xed\x29\xdf\xab\x81\x43\x33\xde\xd2\xe5\xa7\x22\x6a\xc4\x7d\x66\xcb\x81\x84\x8b\x0c\x12\x9a\x7e\x45\x17\x0b\xae\xbd\x21\xe1\x0c\x03\x1f\xdc\x3f\xac\x24\x8e\x9e\x9f\xbb\xd7\xdd\x6e\x64\x9c\x3a\xcc\x6e\xf4\xfc\x1c\xb8\xd4\xce\xee\x76\xa3\x1e\xa2\x95\xf6\xef\x8f\xba\xd8\xed\x06\xef\x7c\xaf\xc9\x7e\xe2\x87\xc8\xc5\xc2\x7d\x25\x51\xd5\xac\xdb\x6f\xd7\x1f\x32\xb1\x67\xe9\x3b\x31\x5e\x93\x36\xed\xb5\xc9\xed\x38\x46\x96\xdc\xae\xd9\x8a\x0b\xdb\x53\x79\x3f\xf1\xee\x04\x64\x5d\xb8\x1f\xd8\x2b\xac\x37\xfe\xf9\xcd\x51\x2b\x14\x4a\x96\x3b\xcd\xa6\xf8\x28\xa5\xd2\x1c\x28\xa8\x4a\x2b\x32\x33\x5e\x71\x60\xfb\x32\xa9\xf6\x7b\x0e\xd9\x7a\xce\xd0\xb5\xeb\x25\x0e\x3c\xf1\xf6\x25\x3c\xe3\xd5\x1b\x84\x9f\x78\xad\x3a\x90\x5f\xb5\x6b\x9f\x82\xa3\x0a\xda\xb7\x23\xca\xf0\xdf\xf9\xab\x15\x60\x51\x71\x9f\x04\xa9\x48\x0e\x7f\x9c\x3d\x04\x96\x6c\x9c\x2d\x23\xc4\xa5\xaf\xd2\xca\xf5\xd3\x11\xea\x78\xc4\xfe\x7a\xc7\xf4\xe1\x0d\x38\x3e\x65\x70\x8d\xcf\x5e\xaa\xd5\x64\xa9\xe4\xf0\x93\x5b\xf8\xff\x00\x37\xc4\xfc\x0e\x44\x06\x00\x00"),
 		},
 		"/firebaseextensions.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "firebaseextensions.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1593,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xe2\x48\x10\x85\xef\xfe\x15\x4f\x70\xd9\x95\x12\x93\x70\xf4\x9e\x18\x92\xcc\x58\x9b\x81\x15\x90\x1d\xcd\x29\x2a\xda\x85\xdd\x1b\xbb\xbb\xb7\xbb\x0c\x41\xb3\xf9\xef\x2b\x63\x9b\xc0\x24\x91\xc6\x27\xab\xfa\xd5\xab\xaf\xab\xca\x1e\x62\x6a\xdd\xde\xeb\xbc\x10\x8c\xaf\xc6\x63\x7c\xb6\x36\x2f\x19\xf7\xf7\xd3\x68\x18\x0d\x71\xaf\x15\x9b\xc0\x19\x6a\x93\xb1\x87\x14\x8c\x89\x23\x55\x70\x7f\x72\x81\xbf\xd9\x07\x6d\x0d\xc6\xf1\x15\x7e\x6b\x04\x83\xee\x68\xf0\xfb\x1f\xd1\x10\x7b\x5b\xa3\xa2\x3d\x8c\x15\xd4\x81\x21\x85\x0e\xd8\xe8\x92\xc1\xcf\x8a\x9d\x40\x1b\x28\x5b\xb9\x52\x93\x51\x8c\x9d\x96\xe2\x50\xa6\x33\x89\xa3\x21\xbe\x77\x16\x76\x2d\xa4\x0d\x08\xca\xba\x3d\xec\xe6\x54\x07\x92\x03\xf0\xe1\x29\x44\x5c\x32\x1a\xed\x76\xbb\x98\x0e\xb4\xb1\xf5\xf9\xa8\x6c\x95\x61\x74\x9f\x4e\x6f\x67\xcb\xdb\xcb\x71\x7c\x75\xc8\x79\x30\x25\x87\x00\xcf\xff\xd6\xda\x73\x86\xf5\x1e\xe4\x5c\xa9\x15\xad\x4b\x46\x49\x3b\x58\x0f\xca\x3d\x73\x06\xb1\x0d\xf0\xce\x6b\xd1\x26\xbf\x40\xb0\x1b\xd9\x91\xe7\x68\x88\x4c\x07\xf1\x7a\x5d\xcb\x59\xb7\x7a\x3c\x1d\xce\x04\xd6\x80\x0c\x06\x93\x25\xd2\xe5\x00\x9f\x26\xcb\x74\x79\x11\x0d\xf1\x2d\x5d\x7d\x99\x3f\xac\xf0\x6d\xb2\x58\x4c\x66\xab\xf4\x76\x89\xf9\x02\xd3\xf9\xec\x26\x5d\xa5\xf3\xd9\x12\xf3\x3b\x4c\x66\xdf\xf1\x67\x3a\xbb\xb9\x00\x6b\x29\xd8\x83\x9f\x9d\x6f\xf8\xad\x87\x6e\xfa\xc8\x59\xd3\xb4\x25\xf3\x19\xc0\xc6\xb6\x40\xc1\xb1\xd2\x1b\xad\x50\x92\xc9\x6b\xca\x19\xb9\xdd\xb2\x37\xda\xe4\x70\xec\x2b\x1d\x9a\x69\x06\x90\xc9\xa2\x21\x4a\x5d\x69\x21\x39\x44\xde\x5c\x2a\x8e\x22\x72\xba\x9b\x7f\x02\x65\x3d\xc7\xca\xf8\x2a\x56\xa5\xad\xb3\x38\x3f\xac\x52\xac\x6c\x35\xda\x5e\x53\xe9\x0a\xba\x8e\x9e\xb4\xc9\x12\x2c\xd9\x6f\xb5\xe2\xaf\xe4\x9c\x36\x79\x54\xb1\x50\x46\x42\x49\x04\x18\xaa\x38\xc1\x46\x7b\x5e\x53\x60\x7e\x16\x36\x07\x9e\xf7\x8d\xbb\x84\xe0\x48\x71\x82\x46\x72\x19\xf6\x41\xb8\x8a\x9a\x6b\xbe\xfa\xdd\x75\x7e\xb7\x47\xbf\x08\xd8\xf6\xe0\xdb\xeb\x35\x0b\x5d\x47\x40\x68\xc1\xbe\xd8\x20\xb3\x8f\x40\xda\xea\xe4\x74\xe8\x08\x3c\x07\x5b\x7b\xc5\xa1\xa9\x07\x5c\x76\x35\x5b\xdd\x63\xef\xf0\xf8\x6a\xf1\xa8\x4d\x90\x66\xdb\xa3\x76\x5d\xdb\xa6\xbc\x65\x4c\xcf\x65\x54\x8b\xfd\xcc\x86\x3d\x09\x67\x09\xc4\xd7\xfd\x89\xce\x56\x5c\xb9\x92\x84\x13\x0c\x9c\xb7\xff\xb0\x92\x30\xfa\xf1\xa3\x7b\x7d\x79\x19\xf5\x15\x9b\x68\xff\xfe\xa8\xb3\x97\x97\xc1\x1b\x8b\x29\x99\x4f\xfc\x10\x38\x5b\xd9\xaf\x24\xaa\x58\x74\xd7\xeb\x1a\x42\x65\xe8\xcb\xf6\x17\x9f\x6c\x49\x97\xcd\xb7\x92\x9a\x49\x08\x2c\xa9\xd9\xb2\x11\xeb\xf7\xe7\xf2\x7e\xcc\xdd\xd8\x93\x2e\xdc\xcf\xe8\x04\xeb\x27\xff\xf4\xe6\x55\x2b\xe4\x73\x96\x3b\xcd\x65\xf6\x5e\x4a\xa1\xd9\x93\x57\x85\x56\x54\x2e\x78\xc3\x9e\xcd\x71\x30\xed\x70\x64\xef\x38\x41\xd7\x99\x63\x1c\x78\xe2\xfd\x31\xbc\xe0\xcd\x4f\x08\x1f\x78\x6d\x3a\x90\x5f\xb5\x6b\x9e\x8c\x83\xf2\xda\xc9\x61\xf5\xfe\xbb\x3c\x39\x01\x56\x05\xf7\x49\x90\x82\xa4\xfd\x5b\xf6\x10\x58\x73\x69\x4d\x1e\x20\x36\x3e\x49\xcb\xb7\x4f\xc9\x99\x4b\xbb\x4f\x7f\xbd\x61\x7a\x77\xe5\x4f\x7c\xbc\xad\x5d\x72\xac\x56\x91\xa1\x9c\xfd\x07\x1f\xde\xff\x01\x00\x00\xff\xff\x10\x51\xa4\xf4\x39\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xe2\x48\x10\x85\xef\xfe\x15\x4f\x70\xd9\x95\x12\x93\x70\xf4\x9e\x18\x92\xcc\x58\x9b\x81\x15\x90\x1d\xcd\x29\x2a\xda\x85\xdd\x1b\xbb\xbb\xb7\xbb\x0c\xb1\x66\xf3\xdf\x57\x06\x1b\xc2\x24\x91\x06\x73\xb0\xaa\x5f\xbd\xfa\xba\xaa\xdb\x43\x4c\xad\x6b\xbc\xce\x0b\xc1\xf8\x6a\x3c\xc6\x67\x6b\xf3\x92\x71\x7f\x3f\x8d\x86\xd1\x10\xf7\x5a\xb1\x09\x9c\xa1\x36\x19\x7b\x48\xc1\x98\x38\x52\x05\xf7\x2b\x17\xf8\x9b\x7d\xd0\xd6\x60\x1c\x5f\xe1\xb7\x56\x30\xe8\x96\x06\xbf\xff\x11\x0d\xd1\xd8\x1a\x15\x35\x30\x56\x50\x07\x86\x14\x3a\x60\xa3\x4b\x06\x3f\x2b\x76\x02\x6d\xa0\x6c\xe5\x4a\x4d\x46\x31\x76\x5a\x0a\xc8\xc9\x3f\x8e\x86\xf8\xde\x59\xd8\xb5\x90\x36\x20\x28\xeb\x1a\xd8\xcd\x6b\x1d\x48\xf6\xc0\xfb\x5f\x21\xe2\x92\xd1\x68\xb7\xdb\xc5\xb4\xa7\x8d\xad\xcf\x47\xe5\x41\x19\x46\xf7\xe9\xf4\x76\xb6\xbc\xbd\x1c\xc7\x57\xfb\x9c\x07\x53\x72\x08\xf0\xfc\x6f\xad\x3d\x67\x58\x37\x20\xe7\x4a\xad\x68\x5d\x32\x4a\xda\xc1\x7a\x50\xee\x99\x33\x88\x6d\x81\x77\x5e\x8b\x36\xf9\x05\x82\xdd\xc8\x8e\x3c\x47\x43\x64\x3a\x88\xd7\xeb\x5a\xce\xba\xd5\xe3\xe9\x70\x26\xb0\x06\x64\x30\x98\x2c\x91\x2e\x07\xf8\x34\x59\xa6\xcb\x8b\x68\x88\x6f\xe9\xea\xcb\xfc\x61\x85\x6f\x93\xc5\x62\x32\x5b\xa5\xb7\x4b\xcc\x17\x98\xce\x67\x37\xe9\x2a\x9d\xcf\x96\x98\xdf\x61\x32\xfb\x8e\x3f\xd3\xd9\xcd\x05\x58\x4b\xc1\x1e\xfc\xec\x7c\xcb\x6f\x3d\x74\xdb\x47\xce\xda\xa6\x2d\x99\xcf\x00\x36\xf6\x30\xbe\xe0\x58\xe9\x8d\x56\x28\xc9\xe4\x35\xe5\x8c\xdc\x6e\xd9\x1b\x6d\x72\x38\xf6\x95\x0e\xed\x34\x03\xc8\x64\xd1\x10\xa5\xae\xb4\x90\xec\x23\x6f\x36\x15\x47\x11\x39\xdd\xcd\x3f\x81\xb2\x9e\x63\x65\x7c\x15\xab\xd2\xd6\x59\x9c\xef\x8f\x52\xac\x6c\x35\xda\x5e\x53\xe9\x0a\xba\x8e\x9e\xb4\xc9\x12\x2c\xd9\x6f\xb5\xe2\xaf\xe4\x9c\x36\x79\x54\xb1\x50\x46\x42\x49\x04\x18\xaa\x38\xc1\x46\x7b\x5e\x53\x60\x7e\x16\x36\x7b\x9e\xf7\x8d\xbb\x84\xe0\x48\x71\x82\x56\x72\x19\x9a\x20\x5c\x45\xed\x36\x4f\x7e\x77\x9d\xdf\xed\xd1\x2f\x02\xb6\x3d\xf8\xf6\x7a\xcd\x42\xd7\x11\x10\x0e\x60\x5f\x6c\x90\xd9\x47\x20\x87\xea\xe4\x74\xe8\x08\x3c\x07\x5b\x7b\xc5\xa1\xad\x07\x5c\x76\x35\x0f\xba\xc7\xde\xe1\xf1\x64\xf1\xa8\x4d\x90\xf6\xb4\xef\xf5\xc0\xa1\x29\x6f\x19\xd3\x73\x19\xd5\x62\x3f\xb3\x61\x4f\xc2\x59\x02\xf1\x75\xbf\xa2\xb3\x15\x57\xae\x24\xe1\x04\x03\xe7\xed\x3f\xac\x24\x8c\x7e\xfc\xe8\x5e\x5f\x5e\x46\x7d\xc5\x36\xda\xbf\x3f\xea\xec\xe5\x65\xf0\xc6\x62\x4a\xe6\x13\x3f\x04\xce\x56\xf6\x2b\x89\x2a\x16\xdd\xf6\xba\x86\x50\x19\xfa\xb2\xfd\xc6\x27\x5b\xd2\x65\x7b\x57\x52\x33\x09\x81\x25\x35\x5b\x36\x62\x7d\x73\x2e\xef\xc7\xdc\x8d\x3d\xe9\xc2\xfd\x8c\x5e\x61\xfd\xe4\x9f\xde\x9c\xb4\x42\x3e\x67\xb9\xd3\x5c\x66\xef\xa5\x14\x9a\x3d\x79\x55\x68\x45\xe5\x82\x37\xec\xd9\x1c\x07\xd3\xfe\x2f\x21\x8d\xe3\x04\x5d\x67\x8e\x71\xe0\x89\x9b\x63\x78\xc1\x9b\x9f\x10\x3e\xf0\xda\x74\x20\xbf\x6a\xd7\x3e\x19\x07\xe5\xb5\x6b\xef\x54\x82\xff\x2e\x5f\xad\x00\xab\x82\xfb\x24\x48\x41\x72\xf8\x5a\xf6\x10\x58\x73\x69\x4d\x1e\x20\x36\x7e\x95\x96\x6f\x9f\x4e\x50\xa7\xf3\xf4\xd7\x1b\xa6\x77\x8f\xfc\xe9\xc9\xbd\xad\x5d\x72\xac\x56\x91\xa1\x9c\xfd\x07\x17\xef\xff\x01\x00\x10\x51\xa4\xf4\x39\x06\x00\x00"),
 		},
 		"/firebasehosting.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "firebasehosting.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2974,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x51\x6f\xe3\x36\x0c\x7e\xf7\xaf\x20\x92\x97\x0d\x68\x9d\x6b\x1f\xbd\xa7\x5c\xda\xde\x19\xeb\xa5\x43\x92\xee\x70\x4f\x05\x23\x33\xb6\x56\x59\xd2\x24\x3a\xb9\xe0\xd6\xff\x3e\x28\x91\x9b\xb8\xc9\xb5\xc1\xb0\x87\xfa\xc5\x0e\xf9\x91\xfc\x44\x7e\x14\xd2\x87\x91\xb1\x6b\x27\xcb\x8a\xe1\xf2\xc3\xe5\x25\x7c\x32\xa6\x54\x04\xb7\xb7\xa3\xa4\x9f\xf4\xe1\x56\x0a\xd2\x9e\x0a\x68\x74\x41\x0e\xb8\x22\x18\x5a\x14\x15\xb5\x9e\x33\xf8\x93\x9c\x97\x46\xc3\x65\xfa\x01\x7e\x09\x80\x5e\x74\xf5\x7e\xfd\x2d\xe9\xc3\xda\x34\x50\xe3\x1a\xb4\x61\x68\x3c\x01\x57\xd2\xc3\x42\x2a\x02\xfa\x2e\xc8\x32\x48\x0d\xc2\xd4\x56\x49\xd4\x82\x60\x25\xb9\xda\x94\x89\x49\xd2\xa4\x0f\xdf\x62\x0a\x33\x67\x94\x1a\x10\x84\xb1\x6b\x30\x8b\x7d\x1c\x20\x6f\x08\x6f\x9e\x8a\xd9\x66\x83\xc1\x6a\xb5\x4a\x71\xc3\x36\x35\xae\x1c\xa8\x2d\xd2\x0f\x6e\xf3\xd1\xf5\x78\x7a\x7d\x7e\x99\x7e\xd8\xc4\xdc\x6b\x45\xde\x83\xa3\xbf\x1b\xe9\xa8\x80\xf9\x1a\xd0\x5a\x25\x05\xce\x15\x81\xc2\x15\x18\x07\x58\x3a\xa2\x02\xd8\x04\xc2\x2b\x27\x59\xea\xf2\x0c\xbc\x59\xf0\x0a\x1d\x25\x7d\x28\xa4\x67\x27\xe7\x0d\x77\xba\xd5\xd2\x93\xbe\x03\x30\x1a\x50\x43\x6f\x38\x85\x7c\xda\x83\x8f\xc3\x69\x3e\x3d\x4b\xfa\xf0\x35\x9f\x7d\xbe\xbb\x9f\xc1\xd7\xe1\x64\x32\x1c\xcf\xf2\xeb\x29\xdc\x4d\x60\x74\x37\xbe\xca\x67\xf9\xdd\x78\x0a\x77\x37\x30\x1c\x7f\x83\xdf\xf3\xf1\xd5\x19\x90\xe4\x8a\x1c\xd0\x77\xeb\x02\x7f\xe3\x40\x86\x3e\x52\x11\x9a\x36\x25\xea\x10\x58\x98\x2d\x21\x6f\x49\xc8\x85\x14\xa0\x50\x97\x0d\x96\x04\xa5\x59\x92\xd3\x52\x97\x60\xc9\xd5\xd2\x87\x69\x7a\x40\x5d\x24\x7d\x50\xb2\x96\x8c\xbc\xb1\x1c\x1c\x2a\x4d\x12\xb4\x32\xce\x3f\x03\x61\x1c\xa5\x42\xbb\x3a\x15\xca\x34\x45\x5a\x6e\xa4\x94\x0a\x53\x0f\x96\x17\xa8\x6c\x85\x17\xc9\xa3\xd4\x45\x06\x53\x72\x4b\x29\xe8\x0b\x5a\x2b\x75\x99\xd4\xc4\x58\x20\x63\x96\x00\x68\xac\x29\x83\x85\x74\x34\x47\x4f\x95\xf1\xa1\xcf\xc7\xb3\x46\xb4\xb7\x28\x28\x83\x00\x39\xf7\x6b\xcf\x54\x27\xe1\x8c\xbb\x64\x37\x31\xd9\xe7\x6d\xb2\x04\x60\xd9\x52\x5e\x5e\xcc\x89\xf1\x22\x01\xf0\x5b\x4a\x01\x33\x3e\x4a\x61\x5b\x17\xad\xf4\xb1\xb6\x23\x6f\x1a\x27\xc8\x87\x4a\x00\xe7\xb1\xda\x16\xf7\xd0\x86\x3f\xc4\xf8\x07\x51\xa1\xd6\xa4\x92\xad\x42\xb7\x7d\x78\xc1\x6c\xd4\x81\x60\xc3\xe6\x13\x69\x72\xc8\x54\x64\xc0\xae\xa1\xe8\x91\xc5\x8c\x6a\xab\x90\x29\x83\x9e\x97\x4c\x7e\xf0\xe3\x47\x78\x3f\xc8\xe2\xe9\x69\x10\x2b\x05\x63\xfc\xdc\xd8\x7b\x07\xd1\x23\xd4\x1f\xe9\xde\x53\x31\x33\x5f\x90\x45\x35\x89\x27\x8a\x0d\x40\xe5\xdb\x8a\xed\x59\x87\x4b\x94\x2a\x6c\x45\xae\x87\xde\x13\xe7\x7a\x49\x9a\x8d\x5b\x77\xe1\xed\x40\xe3\x80\xb3\x68\x6e\x07\xb2\x63\xf5\xec\x50\x38\x27\xe5\xb3\xf8\x7e\x51\x35\xbf\xda\x65\x60\x74\x25\xf1\x8d\x24\x55\x1c\x24\x7a\x6b\x04\x8e\x14\xe1\x33\xc9\xa3\x23\x98\x74\x20\xff\xf7\x08\x06\x91\x41\xb0\xc7\xcf\x77\x36\x9a\x1d\xab\x53\x67\xf0\xd3\x88\x09\x2d\xc8\x91\x7e\x5e\x90\xed\x84\x1e\x69\x9d\xb5\xfb\x17\x0e\x33\xa1\xc5\xb3\x17\x80\x17\x31\x6b\x44\x3c\x04\x56\x7b\xfe\x72\xf9\x98\xed\xfd\xfc\xc9\x10\xe3\x8d\xd4\x01\x1e\x59\xf9\xbd\xb4\xce\x34\xf6\xf4\x5b\xe7\x68\x1f\x5e\x30\xb5\xe8\x48\xf3\x9e\x66\xde\x12\x67\x10\xcf\x6b\xca\x9c\xee\xfc\x27\xca\xd2\x3a\xf3\x17\x09\x0e\x62\x8b\x9f\x4f\x4f\x83\x03\xad\xbe\x1f\xed\x45\x4a\xa7\x0a\xaf\x0b\xaf\x24\x39\x74\xa2\x92\x02\xd5\x71\xe5\xf1\xda\x52\x06\xb1\x13\x7b\x93\xda\x28\x32\x9a\x77\x6a\x7c\x5d\xc5\xcf\x3a\x3d\x35\x5d\x78\x0a\xf2\xc2\x49\xcb\x1b\x19\xfe\x73\xde\x51\xe0\xac\xa2\x36\x08\xb8\x42\xde\xfe\x4d\x6a\x49\xc0\x9c\x94\xd1\xa5\x07\x36\xe9\xdb\xdb\xf0\xc7\x01\xa7\xd3\xe4\xdf\x56\xab\x51\x63\x49\xee\x15\xf9\xbf\xa5\xe5\x65\x67\x01\x4f\xd8\xd1\xff\x7c\xd1\xc6\x4a\xc1\xd8\x5e\x19\xef\x4b\xd4\x3b\x56\xa7\xea\x7a\x2f\xe2\xdf\x00\x00\x00\xff\xff\xc9\x7c\xa3\xa1\x9e\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x41\x53\xe3\x38\x13\xbd\xfb\x57\x74\x25\x97\xef\xab\x02\x07\x38\x7a\x4f\x99\x00\x33\xae\x65\xc2\x56\x1c\x76\x6a\x4e\xa9\x8e\xdc\xb1\xb5\xc8\x92\x56\x6a\x27\x93\x9a\xe5\xbf\x6f\x29\x91\x09\x09\x10\xd8\xad\x3d\x80\xa9\xb2\x4a\xfd\xf4\xfa\xa9\xfb\xb5\xa1\x0f\x23\x63\xd7\x4e\x56\x35\xc3\xc5\xd9\xc5\x05\x7c\x36\xa6\x52\x04\x37\x37\xa3\xa4\x9f\xf4\xe1\x46\x0a\xd2\x9e\x4a\x68\x75\x49\x0e\xb8\x26\x18\x5a\x14\x35\x75\x91\x13\xf8\x9d\x9c\x97\x46\xc3\x45\x7a\x06\xff\x0b\x80\x5e\x0c\xf5\xfe\xff\x4b\xd2\x87\xb5\x69\xa1\xc1\x35\x68\xc3\xd0\x7a\x02\xae\xa5\x87\x85\x54\x04\xf4\x43\x90\x65\x90\x1a\x84\x69\xac\x92\xa8\x05\xc1\x4a\x72\x0d\xbc\xe3\x4f\x93\x3e\x7c\x8f\x14\x66\xce\x28\x35\x20\x08\x63\xd7\x60\x16\x4f\x71\x80\xbc\x11\xbc\xf9\xa9\x99\x6d\x36\x18\xac\x56\xab\x14\x37\x6a\x53\xe3\xaa\x81\xda\x22\xfd\xe0\x26\x1f\x5d\x8d\x8b\xab\xd3\x8b\xf4\x6c\x73\xe6\x4e\x2b\xf2\x1e\x1c\xfd\xd9\x4a\x47\x25\xcc\xd7\x80\xd6\x2a\x29\x70\xae\x08\x14\xae\xc0\x38\xc0\xca\x11\x95\xc0\x26\x08\x5e\x39\xc9\x52\x57\x27\xe0\xcd\x82\x57\xe8\x28\xe9\x43\x29\x3d\x3b\x39\x6f\x79\xaf\x5a\x9d\x3c\xe9\xf7\x00\x46\x03\x6a\xe8\x0d\x0b\xc8\x8b\x1e\x7c\x1a\x16\x79\x71\x92\xf4\xe1\x5b\x3e\xfd\x72\x7b\x37\x85\x6f\xc3\xc9\x64\x38\x9e\xe6\x57\x05\xdc\x4e\x60\x74\x3b\xbe\xcc\xa7\xf9\xed\xb8\x80\xdb\x6b\x18\x8e\xbf\xc3\xaf\xf9\xf8\xf2\x04\x48\x72\x4d\x0e\xe8\x87\x75\x41\xbf\x71\x20\x43\x1d\xa9\x0c\x45\x2b\x88\xf6\x04\x2c\xcc\xb6\x7d\xde\x92\x90\x0b\x29\x40\xa1\xae\x5a\xac\x08\x2a\xb3\x24\xa7\xa5\xae\xc0\x92\x6b\xa4\x0f\xdd\xf4\x80\xba\x4c\xfa\xa0\x64\x23\x19\x79\xb3\xf3\xec\x52\x69\x92\xa0\x95\xb1\xff\x19\x08\xe3\x28\x15\xda\x35\xa9\x50\xa6\x2d\xd3\x6a\x63\xa5\x54\x98\x66\xb0\x3c\x47\x65\x6b\x3c\x4f\xee\xa5\x2e\x33\x28\xc8\x2d\xa5\xa0\xaf\x68\xad\xd4\x55\xd2\x10\x63\x89\x8c\x59\x02\xa0\xb1\xa1\x0c\x16\xd2\xd1\x1c\x3d\xd5\xc6\x87\x3a\xbf\xcc\x1a\xd1\xde\xa2\xa0\x0c\x02\xe4\xd4\xaf\x3d\x53\x93\x84\x3b\xee\xc8\xae\x23\xd9\x97\x2d\x59\x02\xb0\xec\x24\x2f\xcf\xe7\xc4\x78\x9e\x00\xf8\xad\xa4\x80\x19\xbf\x28\x61\x9b\x17\xad\xf4\x31\xb7\x23\x6f\x5a\x27\xc8\x87\x4c\x00\xa7\x31\xdb\x16\x37\xeb\x8e\xcf\xe2\xf9\x99\xa8\x51\x6b\x52\x1b\x2c\xc0\xb6\x0e\x07\xca\x46\x7b\x10\x6c\xd9\x7c\x26\x4d\x0e\x99\xca\x0c\xd8\xb5\x14\x23\xb2\x9c\x52\x63\x15\x32\x65\xd0\xf3\x92\xc9\x0f\x7e\xfe\x0c\xef\x99\x2c\x1f\x1e\x06\x31\x53\xd8\x8c\xcb\xcd\x7e\xef\xd9\xe9\x11\xea\x4f\x74\xe7\xa9\x9c\x9a\xaf\xc8\xa2\x9e\xc4\x1b\xc5\x02\xa0\xf2\x5d\xc6\xee\xae\xc3\x25\x4a\x15\xa6\x22\xd7\x43\xef\x89\x73\xbd\x24\xcd\xc6\xad\xf7\xe1\x5d\x43\x63\x83\xb3\xb8\xdd\x35\x64\xa7\xea\x31\xa0\x70\x4e\xca\x67\xf1\x7d\x90\x35\xbf\xdc\x31\x30\xba\x8a\xf8\x5a\x92\x2a\x9f\x11\xbd\xd5\x02\x47\x8a\xd0\xd3\xb1\x16\x4c\xf6\x20\xff\x75\x0b\x06\x51\x41\xd8\x8f\xcb\x0f\xd6\x9a\x9d\xaa\x03\xfa\x57\x7b\xf0\xea\x89\x09\x2d\xc8\x91\x7e\x1c\x90\xf0\x7b\x0a\xf7\xb4\xce\xba\xf9\x0b\x3e\x9b\xd0\xe2\x31\x0a\xc0\x8b\xc8\x1a\x11\xb3\xd0\xd0\x27\xf1\x6a\x79\xbf\x23\x7b\xb5\x89\xf1\x8b\xb4\x07\x7c\x61\xe4\x77\x4f\xe5\x4c\x6b\xdf\xff\xd5\x79\xb1\x0e\x07\x4a\x2d\x3a\xd2\xfc\xc4\x33\x6f\x99\x33\xcc\x6f\x72\xe4\x52\x85\xe4\x7f\x68\x4b\xeb\xcc\x1f\x24\x38\x98\x2d\x2e\x1f\x1e\x06\xcf\xbc\xfa\x71\xbc\x17\x25\x1d\x70\xbf\x6a\xbc\x7d\x78\x2d\xc9\xa1\x13\xb5\x14\xa8\x5e\x76\x1e\xaf\x2d\x65\x10\x2b\xf1\xb8\x0f\x5b\x47\xc6\xed\x9d\x1b\x8f\xbb\xf8\xd1\xa7\xef\xa5\x0b\x4f\x49\x5e\x38\x69\xc3\x1f\xd3\x0c\xfe\x3a\x7d\x12\x01\x98\xd6\xd4\x1d\x02\xae\x91\xb7\xff\x26\x75\x22\x60\x4e\xca\xe8\xca\x03\x9b\xf4\xed\x69\xf8\xed\x99\xa6\xf7\xd9\xbf\xcb\xd6\xa0\xc6\x8a\xdc\x11\xfb\xbf\xe5\xe5\x98\xed\x98\x9d\xf7\x67\xf4\x5f\x7f\x68\x63\xa6\xe0\xe8\xb8\xfc\x60\xa6\xde\xa9\x3a\xa0\x7f\xd5\xd7\x4b\x72\x5e\x1a\x3d\x93\x65\xf2\xf7\x00\xc9\x7c\xa3\xa1\x9e\x0b\x00\x00"),
 		},
 		"/firebasestorage.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "firebasestorage.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1566,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4f\x6f\xa3\xc8\x13\xbd\xf3\x29\x9e\xec\xcb\xef\x27\x25\x38\xc9\x91\x3d\x39\x4e\x32\x83\x36\x63\xaf\x8c\xb3\xa3\x39\x45\xe5\xa6\x0c\xbd\x81\xee\xde\xee\xc2\x1e\x6b\x36\xdf\x7d\x85\x01\x8f\x9d\x4c\xa4\xe5\x84\xaa\x5e\xbd\xf7\xea\x0f\x8c\x31\xb3\x6e\xef\x75\x51\x0a\x6e\xae\x6e\x6e\xf0\xc9\xda\xa2\x62\x3c\x3e\xce\xa2\x71\x34\xc6\xa3\x56\x6c\x02\xe7\x68\x4c\xce\x1e\x52\x32\xa6\x8e\x54\xc9\x43\xe6\x02\x7f\xb2\x0f\xda\x1a\xdc\xc4\x57\xf8\x5f\x0b\x18\xf5\xa9\xd1\xff\x7f\x8b\xc6\xd8\xdb\x06\x35\xed\x61\xac\xa0\x09\x0c\x29\x75\xc0\x46\x57\x0c\xfe\xae\xd8\x09\xb4\x81\xb2\xb5\xab\x34\x19\xc5\xd8\x69\x29\x0f\x32\x3d\x49\x1c\x8d\xf1\xad\xa7\xb0\x6b\x21\x6d\x40\x50\xd6\xed\x61\x37\xa7\x38\x90\x1c\x0c\x1f\x9e\x52\xc4\x25\x93\xc9\x6e\xb7\x8b\xe9\xe0\x36\xb6\xbe\x98\x54\x1d\x32\x4c\x1e\xd3\xd9\xfd\x3c\xbb\xbf\xbc\x89\xaf\x0e\x35\x4f\xa6\xe2\x10\xe0\xf9\xef\x46\x7b\xce\xb1\xde\x83\x9c\xab\xb4\xa2\x75\xc5\xa8\x68\x07\xeb\x41\x85\x67\xce\x21\xb6\x35\xbc\xf3\x5a\xb4\x29\x2e\x10\xec\x46\x76\xe4\x39\x1a\x23\xd7\x41\xbc\x5e\x37\x72\x36\xad\xc1\x9e\x0e\x67\x00\x6b\x40\x06\xa3\x69\x86\x34\x1b\xe1\x76\x9a\xa5\xd9\x45\x34\xc6\xd7\x74\xf5\x79\xf1\xb4\xc2\xd7\xe9\x72\x39\x9d\xaf\xd2\xfb\x0c\x8b\x25\x66\x8b\xf9\x5d\xba\x4a\x17\xf3\x0c\x8b\x07\x4c\xe7\xdf\xf0\x7b\x3a\xbf\xbb\x00\x6b\x29\xd9\x83\xbf\x3b\xdf\xfa\xb7\x1e\xba\x9d\x23\xe7\xed\xd0\x32\xe6\x33\x03\x1b\xdb\x19\x0a\x8e\x95\xde\x68\x85\x8a\x4c\xd1\x50\xc1\x28\xec\x96\xbd\xd1\xa6\x80\x63\x5f\xeb\xd0\x6e\x33\x80\x4c\x1e\x8d\x51\xe9\x5a\x0b\xc9\x21\xf2\xae\xa9\x38\x8a\xc8\xe9\x7e\xff\x09\x94\xf5\x1c\x2b\xe3\xeb\x58\x55\xb6\xc9\xe3\xe2\x70\x4a\xb1\xb2\xf5\x64\x7b\x4d\x95\x2b\xe9\x3a\x7a\xd1\x26\x4f\x90\xb1\xdf\x6a\xc5\x5f\xc8\x39\x6d\x8a\xa8\x66\xa1\x9c\x84\x92\x08\x30\x54\x73\x82\x8d\xf6\xbc\xa6\xc0\x41\xac\xa7\xe2\x03\xd6\x1e\x1d\x1c\x29\x4e\xd0\x42\x2e\xc3\x3e\x08\xd7\x51\xdb\xe3\x4f\xb2\x87\x9e\x2c\xeb\xc8\x22\x60\x3b\x58\xde\x5e\xaf\x59\xe8\x3a\x02\x42\x67\xe9\xb3\x0d\x32\xff\xa5\x85\x4e\x97\x9c\x0e\xbd\xb6\xe7\x60\x1b\xaf\x38\xb4\x4a\xc0\x65\xaf\xd6\xe1\x9e\x87\xf2\xe7\xbe\xfe\x79\xdd\xa8\x17\x96\xa8\x3b\xd0\x6e\x0c\x6f\x8c\xdd\x9e\x22\xa8\x11\xfb\x89\x0d\x7b\x12\xce\x13\x88\x6f\xb8\xcf\xe8\x7c\xc5\xb5\xab\x48\x38\xc1\xc8\x79\xfb\x17\x2b\x09\x93\x1f\x3f\xfa\xd7\xd7\xd7\x49\x27\xd5\xc6\xba\xb7\x67\x9d\xbf\xbe\x8e\xde\x95\xcf\xc8\xdc\xf2\x53\xe0\x7c\x65\xbf\x90\xa8\x72\xd9\x37\xd4\xf7\x4f\x55\x18\x24\x87\x56\xa7\x5b\xd2\x55\xfb\x51\xa4\x66\x1a\x02\x4b\x6a\xb6\x6c\xc4\xfa\xfd\x39\x7c\xd8\x67\xbf\xdf\xa4\x0f\x0f\xfb\x38\x9a\x7a\xc3\x9e\xde\xfd\x44\x0a\xf9\x82\xe5\x41\x73\x95\xbf\x2f\x28\x35\x7b\xf2\xaa\xd4\x8a\xaa\x25\x6f\xd8\xb3\x39\xae\xa1\x5b\x85\xec\x1d\x27\xe8\x27\x72\x8c\x03\x2f\xbc\x3f\x86\x97\xbc\x79\x63\xe0\x03\xae\x4d\x6f\xe3\xbf\xd2\xb5\x4f\xce\x41\x79\xed\xe4\x70\x65\xff\x5c\x9e\x64\x80\x55\xc9\x43\x11\xa4\x24\xe9\x7e\x89\x83\x09\xac\xb9\xb2\xa6\x08\x10\x1b\x9f\x94\x15\xdb\x97\xe4\x8c\xa5\x3b\xa1\x3f\xde\x79\xfa\xe5\x75\x9f\xf0\x78\xdb\xb8\xe4\xa8\x56\x93\xa1\x82\xfd\x07\x1f\xd8\xbf\x01\x00\x00\xff\xff\xf2\x87\x74\x06\x1e\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe3\x36\x10\xc5\xef\xfa\x14\x0f\xf6\xa5\x05\x12\x39\xc9\x51\x3d\x39\x4e\xb2\x2b\x34\x6b\x17\x96\xd3\xc5\x9e\x82\x31\x35\x96\xd8\x48\x24\x4b\x8e\xec\x35\xb6\xf9\xee\x85\x2c\xc9\xce\x9f\x0d\xb0\x96\x0f\x02\xf9\xe6\xcd\x6f\x66\x48\x8d\x31\xb3\x6e\xef\x75\x51\x0a\xae\x2e\xae\xae\xf0\xc9\xda\xa2\x62\xdc\xdf\xcf\xa2\x71\x34\xc6\xbd\x56\x6c\x02\xe7\x68\x4c\xce\x1e\x52\x32\xa6\x8e\x54\xc9\xc3\xce\x19\xfe\x66\x1f\xb4\x35\xb8\x8a\x2f\xf0\x5b\x2b\x18\xf5\x5b\xa3\xdf\xff\x88\xc6\xd8\xdb\x06\x35\xed\x61\xac\xa0\x09\x0c\x29\x75\xc0\x46\x57\x0c\xfe\xae\xd8\x09\xb4\x81\xb2\xb5\xab\x34\x19\xc5\xd8\x69\x29\x21\x27\xff\x38\x1a\xe3\x5b\x6f\x61\xd7\x42\xda\x80\xa0\xac\xdb\xc3\x6e\x5e\xea\x40\x72\x00\x3e\xfc\x4a\x11\x97\x4c\x26\xbb\xdd\x2e\xa6\x03\x6d\x6c\x7d\x31\xa9\x3a\x65\x98\xdc\xa7\xb3\xdb\x79\x76\x7b\x7e\x15\x5f\x1c\x62\x1e\x4c\xc5\x21\xc0\xf3\xbf\x8d\xf6\x9c\x63\xbd\x07\x39\x57\x69\x45\xeb\x8a\x51\xd1\x0e\xd6\x83\x0a\xcf\x9c\x43\x6c\x0b\xbc\xf3\x5a\xb4\x29\xce\x10\xec\x46\x76\xe4\x39\x1a\x23\xd7\x41\xbc\x5e\x37\xf2\xaa\x5b\x03\x9e\x0e\xaf\x04\xd6\x80\x0c\x46\xd3\x0c\x69\x36\xc2\xf5\x34\x4b\xb3\xb3\x68\x8c\xaf\xe9\xea\xf3\xe2\x61\x85\xaf\xd3\xe5\x72\x3a\x5f\xa5\xb7\x19\x16\x4b\xcc\x16\xf3\x9b\x74\x95\x2e\xe6\x19\x16\x77\x98\xce\xbf\xe1\xcf\x74\x7e\x73\x06\xd6\x52\xb2\x07\x7f\x77\xbe\xe5\xb7\x1e\xba\xed\x23\xe7\x6d\xd3\x32\xe6\x57\x00\x1b\xdb\x8d\x2f\x38\x56\x7a\xa3\x15\x2a\x32\x45\x43\x05\xa3\xb0\x5b\xf6\x46\x9b\x02\x8e\x7d\xad\x43\x3b\xcd\x00\x32\x79\x34\x46\xa5\x6b\x2d\x24\x87\x95\x77\x45\xc5\x51\x44\x4e\xf7\xf3\x4f\xa0\xac\xe7\x58\x19\x5f\xc7\xaa\xb2\x4d\x1e\x17\x87\xa3\x14\x2b\x5b\x4f\xb6\x97\x54\xb9\x92\x2e\xa3\x27\x6d\xf2\x04\x19\xfb\xad\x56\xfc\x85\x9c\xd3\xa6\x88\x6a\x16\xca\x49\x28\x89\x00\x43\x35\x27\xd8\x68\xcf\x6b\x0a\x1c\xc4\x7a\x2a\x3e\x70\xed\xd5\xc1\x91\xe2\x04\xad\xe4\x3c\xec\x83\x70\x1d\xb5\x35\x9e\xcc\xee\x7a\xb3\xac\x33\x8b\x80\xed\x80\xbc\xbd\x5c\xb3\xd0\x65\x04\x84\x0e\xe9\xb3\x0d\x32\xff\x29\x42\x57\x0d\x39\x1d\xfa\xdc\x9e\x83\x6d\xbc\xe2\xd0\x66\x02\xce\xfb\x6c\x9d\xee\x71\x08\x7f\xec\xe3\x1f\xd7\x8d\x7a\x62\x39\x48\x81\xae\x0d\x6f\xc0\xae\x5f\x2a\xa8\x11\xfb\x89\x0d\x7b\x12\xce\x13\x88\x6f\xb8\xdf\xd1\xf9\x8a\x6b\x57\x91\x70\x82\x91\xf3\xf6\x1f\x56\x12\x26\x3f\x7e\xf4\xaf\xcf\xcf\x93\x2e\x55\xbb\xd6\xbd\x3d\xea\xfc\xf9\x79\xf4\x2e\x7c\x46\xe6\x9a\x1f\x02\xe7\x2b\xfb\x85\x44\x95\xcb\xbe\xa0\xbe\x7e\xaa\xc2\x90\x72\x28\x75\xba\x25\x5d\xb5\x97\x22\x35\xd3\x10\x58\x52\xb3\x65\x23\xd6\xef\x5f\xcb\x87\x79\xf6\xf3\x4d\xfa\xe5\x61\x1e\x47\xa8\x37\xee\xe9\xcd\x49\x29\xe4\x0b\x96\x3b\xcd\x55\xfe\x3e\xa0\xd4\xec\xc9\xab\x52\x2b\xaa\x96\xbc\x61\xcf\xe6\x38\x86\xf6\x7f\x0e\xd9\x3b\x4e\xd0\x77\xe4\xb8\x0e\x3c\xf1\xfe\xb8\xbc\xe4\xcd\x1b\x80\x0f\xbc\x36\x3d\xc6\xaf\xda\xb5\x4f\xce\x41\x79\xed\xda\x8b\x93\xe0\xbf\xf3\x17\x3b\xc0\xaa\xe4\x21\x08\x52\x92\x74\x9f\xc4\x01\x02\x6b\xae\xac\x29\x02\xc4\xc6\x2f\xc2\x8a\xed\xd3\x09\xea\x74\x84\xfe\x7a\xc7\xf4\xd3\xd3\x7d\x7a\x0a\x6f\x1b\x97\x1c\xb3\xd5\x64\xa8\x60\xff\xc1\x05\xfb\x7f\x00\xf2\x87\x74\x06\x1e\x06\x00\x00"),
 		},
 		"/firestore.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "firestore.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3079,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\x22\x47\x10\xbd\xcf\xaf\x28\xc1\x25\x91\xec\x61\xcd\x71\x72\x62\xc1\xf6\x8e\xe2\x85\x08\x70\x56\x7b\x5a\x15\x3d\xc5\x4c\xc7\x3d\xdd\x9d\xee\x1a\x30\x72\xf8\xef\x51\xcf\x07\x06\xb3\x8e\xbc\xa7\xcd\xc1\x73\x40\xad\xfa\x78\xf5\xaa\xea\x15\x7d\x18\x1b\xbb\x73\x32\x2f\x18\x86\x1f\x86\x43\xb8\x35\x26\x57\x04\x77\x77\xe3\xa8\x1f\xf5\xe1\x4e\x0a\xd2\x9e\x32\xa8\x74\x46\x0e\xb8\x20\x18\x59\x14\x05\x75\x9e\x0b\xf8\x93\x9c\x97\x46\xc3\x30\xfe\x00\xbf\x84\x80\x5e\xeb\xea\xfd\xfa\x5b\xd4\x87\x9d\xa9\xa0\xc4\x1d\x68\xc3\x50\x79\x02\x2e\xa4\x87\xb5\x54\x04\xf4\x28\xc8\x32\x48\x0d\xc2\x94\x56\x49\xd4\x82\x60\x2b\xb9\xa8\xcb\xb4\x20\x71\xd4\x87\xaf\x2d\x84\x59\x31\x4a\x0d\x08\xc2\xd8\x1d\x98\xf5\x71\x1c\x20\xd7\x84\xeb\xaf\x60\xb6\xc9\x60\xb0\xdd\x6e\x63\xac\xd9\xc6\xc6\xe5\x03\xd5\x44\xfa\xc1\x5d\x3a\xbe\x9e\x2e\xae\x2f\x87\xf1\x87\x3a\xe7\x5e\x2b\xf2\x1e\x1c\xfd\x5d\x49\x47\x19\xac\x76\x80\xd6\x2a\x29\x70\xa5\x08\x14\x6e\xc1\x38\xc0\xdc\x11\x65\xc0\x26\x10\xde\x3a\xc9\x52\xe7\x17\xe0\xcd\x9a\xb7\xe8\x28\xea\x43\x26\x3d\x3b\xb9\xaa\xf8\x64\x5a\x1d\x3d\xe9\x4f\x02\x8c\x06\xd4\xd0\x1b\x2d\x20\x5d\xf4\xe0\xe3\x68\x91\x2e\x2e\xa2\x3e\x7c\x49\x97\x9f\x66\xf7\x4b\xf8\x32\x9a\xcf\x47\xd3\x65\x7a\xbd\x80\xd9\x1c\xc6\xb3\xe9\x24\x5d\xa6\xb3\xe9\x02\x66\x37\x30\x9a\x7e\x85\xdf\xd3\xe9\xe4\x02\x48\x72\x41\x0e\xe8\xd1\xba\xc0\xdf\x38\x90\x61\x8e\x94\x85\xa1\x2d\x88\x4e\x08\xac\x4d\x43\xc8\x5b\x12\x72\x2d\x05\x28\xd4\x79\x85\x39\x41\x6e\x36\xe4\xb4\xd4\x39\x58\x72\xa5\xf4\x61\x9b\x1e\x50\x67\x51\x1f\x94\x2c\x25\x23\xd7\x96\xb3\xa6\xe2\x28\x42\x2b\xdb\xfd\x27\x20\x8c\xa3\x58\x68\x57\xc6\x42\x99\x2a\x8b\xf3\x5a\x4a\xb1\x30\xe5\x60\x73\x85\xca\x16\x78\x15\x3d\x48\x9d\x25\xb0\x20\xb7\x91\x82\x3e\xa3\xb5\x52\xe7\x51\x49\x8c\x19\x32\x26\x11\x80\xc6\x92\x12\x58\x4b\x47\x9e\x5f\xc5\x6b\xe3\xbc\x45\x41\x09\x84\x90\x4b\xbf\xf3\x4c\x65\x14\xba\x7b\x86\xb9\xe9\x60\x22\x80\x4d\x47\x73\x73\xb5\x22\xc6\xab\x08\xc0\x37\x34\x3e\x19\xcf\xd3\x17\x65\x9b\x5a\x68\xa5\x6f\xeb\x39\xf2\xa6\x72\x82\x7c\x40\x07\xb8\x6c\x2b\x34\x71\xdf\x0e\x89\xdf\x42\x1f\x2b\xf4\x14\x35\x4a\x6c\xfa\x3d\xf0\x98\x9c\x7a\xb1\x62\x73\x4b\x9a\x1c\x32\x65\x09\xb0\xab\x3a\x8f\xcc\x96\x54\x5a\x85\x4c\x09\xf4\xac\x33\x7f\x91\x60\x3f\x78\x7a\x6a\x9f\xfb\xfd\xa0\x2b\x14\xac\x81\xcb\x7e\xdf\x3b\xcb\x1d\xa3\xfe\x48\xf7\x9e\xb2\xa5\xf9\x8c\x2c\x8a\x79\xdb\x44\xdb\x2d\xaa\x03\x93\xae\xbd\xd1\x06\xa5\x0a\xb2\x4f\xf5\xc8\x7b\xe2\x54\x6f\x48\xb3\x71\xbb\xd3\xf0\x6e\x63\xed\x06\x93\xd6\xdc\xcd\x3d\xfc\xbe\x00\x4e\x27\xcf\x41\x8c\x2e\x27\xbe\x91\xa4\xb2\x93\xd8\x42\x92\x43\x27\x0a\x29\x50\xcd\x69\x4d\x8e\xf4\x61\xe0\xcd\xd0\x79\x67\x29\x81\x76\x08\x07\x3b\xc0\x03\xed\x0e\xe6\x39\xad\x5f\xd4\x7e\x05\x6b\xdd\x32\x78\x2b\x5c\xf8\x32\xf2\xc2\x49\xcb\xb5\x92\xfe\xb9\x3c\xf2\x00\x2c\x0b\xea\x92\x80\x0b\xe4\xe6\xaf\xae\x23\x01\x2b\x52\x46\xe7\x1e\xd8\xc4\x47\x69\xf9\xe6\x21\x39\x41\x69\x14\xf3\xc7\x19\xa7\xef\x2a\xf8\x08\xc7\x99\xca\x26\x87\x6a\x25\x6a\xcc\xc9\xbd\x7a\x3e\xff\xa5\x60\x23\xaa\x92\x34\xbf\xa2\xe0\x53\xef\x1b\x15\xfc\x13\x24\x1a\x8e\x9b\xdc\x81\x5b\x3a\x39\xd7\xdb\x8f\x68\x13\x60\x83\xaa\xa2\x1f\xb9\xca\xee\x1d\xcc\xed\xd4\x82\x59\x18\xa5\x48\x04\x05\xed\xf7\x83\xa7\xa7\x1a\xf6\x79\x32\xef\x37\xf0\x7f\xb8\x01\xa9\x33\x7a\xfc\xfe\x01\xa4\x47\xae\x77\xf5\xbf\x49\xfd\xcf\x8a\xbf\x0d\x1b\x3a\x3b\x82\x7a\xda\x75\xd2\xfb\x31\xfc\x9c\x63\xf8\x37\x00\x00\xff\xff\xfe\xb1\xb6\xf3\x07\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\xd8\x97\x16\x48\xe4\x4d\x8e\xea\xc9\x9b\xaf\x15\x9a\x75\x0a\xdb\xe9\x62\x4f\x8b\x31\x35\x96\xd8\x50\x24\x4b\x52\x76\x8c\xd4\xff\xbd\xa0\x2d\xd9\x71\xbc\x69\xd3\xd3\xf6\x90\x28\x30\x84\x99\xc7\x99\x37\xc3\xf7\xd4\xc7\x85\xb1\x2b\x27\xcb\x2a\xe0\xfc\xc3\xf9\x39\x6e\x8c\x29\x15\xe3\xf6\xf6\x22\xe9\x27\x7d\xdc\x4a\xc1\xda\x73\x81\x46\x17\xec\x10\x2a\xc6\xd0\x92\xa8\xb8\xcb\x9c\xe0\x77\x76\x5e\x1a\x8d\xf3\xf4\x03\x7e\x8a\x80\x5e\x9b\xea\xfd\xfc\x4b\xd2\xc7\xca\x34\xa8\x69\x05\x6d\x02\x1a\xcf\x08\x95\xf4\x98\x4b\xc5\xe0\x47\xc1\x36\x40\x6a\x08\x53\x5b\x25\x49\x0b\xc6\x52\x86\x0a\x61\x5f\x3f\x4d\xfa\xf8\xda\x96\x30\xb3\x40\x52\x83\x20\x8c\x5d\xc1\xcc\x9f\xe3\x40\x61\x43\x78\xf3\x57\x85\x60\xb3\xc1\x60\xb9\x5c\xa6\xb4\x61\x9b\x1a\x57\x0e\xd4\x16\xe9\x07\xb7\xf9\xc5\xd5\x68\x72\x75\x7a\x9e\x7e\xd8\x9c\xb9\xd7\x8a\xbd\x87\xe3\x3f\x1b\xe9\xb8\xc0\x6c\x05\xb2\x56\x49\x41\x33\xc5\x50\xb4\x84\x71\xa0\xd2\x31\x17\x08\x26\x12\x5e\x3a\x19\xa4\x2e\x4f\xe0\xcd\x3c\x2c\xc9\x71\xd2\x47\x21\x7d\x70\x72\xd6\x84\x83\x6d\x75\xf4\xa4\x3f\x00\x18\x0d\xd2\xe8\x0d\x27\xc8\x27\x3d\x7c\x1c\x4e\xf2\xc9\x49\xd2\xc7\x97\x7c\xfa\xe9\xee\x7e\x8a\x2f\xc3\xf1\x78\x38\x9a\xe6\x57\x13\xdc\x8d\x71\x71\x37\xba\xcc\xa7\xf9\xdd\x68\x82\xbb\x6b\x0c\x47\x5f\xf1\x6b\x3e\xba\x3c\x01\xcb\x50\xb1\x03\x3f\x5a\x17\xf9\x1b\x07\x19\xf7\xc8\x45\x5c\xda\x84\xf9\x80\xc0\xdc\x6c\xaf\xcf\x5b\x16\x72\x2e\x05\x14\xe9\xb2\xa1\x92\x51\x9a\x05\x3b\x2d\x75\x09\xcb\xae\x96\x3e\xde\xa6\x07\xe9\x22\xe9\x43\xc9\x5a\x06\x0a\x9b\xc8\xd1\x50\x69\x92\x90\x95\xed\xfd\x67\x10\xc6\x71\x2a\xb4\xab\x53\xa1\x4c\x53\xa4\xe5\x46\x4a\xa9\x30\xf5\x60\x71\x46\xca\x56\x74\x96\x3c\x48\x5d\x64\x98\xb0\x5b\x48\xc1\x9f\xc9\x5a\xa9\xcb\xa4\xe6\x40\x05\x05\xca\x12\x40\x53\xcd\x19\xe6\xd2\xb1\x0f\xaf\xd6\x6b\x71\xde\x92\xe0\x0c\x11\x72\xea\x57\x3e\x70\x9d\xc4\xe9\xf6\x65\xae\xbb\x32\x09\xb0\xe8\x68\x2e\xce\x66\x1c\xe8\x2c\x01\xfc\x96\xc6\x27\xe3\xc3\xe8\x45\xdb\x2d\x77\xb2\xd2\xb7\xfd\x1c\x7b\xd3\x38\xc1\x3e\x56\x07\x4e\xdb\x0e\x5b\xdc\xb7\xdd\xc1\x6f\x71\x8e\x19\x79\xde\xa0\x80\xed\xbc\x3b\x1e\x97\x87\x59\x6a\x82\xb9\x61\xcd\x8e\x02\x17\x19\x82\x6b\xba\x8c\x2c\xa6\x5c\x5b\x45\x81\x33\xf4\xac\x33\x7f\xb0\x08\x7e\xf0\xf4\xd4\xbe\xae\xd7\x83\xae\x51\x8c\x46\x2e\xeb\x75\xef\xe8\xec\x05\xe9\x8f\x7c\xef\xb9\x98\x9a\xcf\x14\x44\x35\x6e\x87\x68\xa7\x25\xb5\x63\xd2\x8d\x37\x5c\x90\x54\x51\xf6\xb9\x1e\x7a\xcf\x21\xd7\x0b\xd6\xc1\xb8\xd5\x21\xbc\xbb\xb1\xf6\x06\xb3\x36\xdc\xed\x3d\xfe\xbe\x28\x9c\x5f\xee\x41\x81\x5c\xc9\xe1\x5a\xb2\x2a\x0e\xb0\x95\x64\x47\x4e\x54\x52\x90\x1a\xf3\x9c\x1d\xeb\xdd\xc2\xe3\xff\x29\xc2\xca\x72\x86\x76\x09\xbb\x38\xf0\xc0\xab\x5d\x78\xcc\xf3\x17\xbd\x5f\xa9\x35\x6f\x19\xbc\xb5\x5c\x7c\x0a\xf6\xc2\x49\x1b\x0d\x91\xe1\xaf\xd3\x67\x19\x60\x5a\x71\x77\x08\xa1\xa2\xb0\xfd\xd4\x75\x24\x30\x63\x65\x74\xe9\x11\x4c\xfa\xec\x58\xb9\x78\xd8\x93\xda\x2b\xe6\xb7\x23\x4e\xdf\x55\xf0\xfe\x29\x9d\x69\x6c\xb6\xeb\x56\x93\xa6\x92\xdd\xab\xf6\xf9\x27\x05\x1b\xd1\xd4\xac\xc3\x2b\x0a\x3e\xcc\xbe\x51\xc1\x3f\x40\xa2\xd1\xdc\xec\x76\xdc\xf2\xcb\x63\xbd\xfd\x17\x6d\x02\x0b\x52\x0d\x3f\x9b\xe9\x5f\x5d\xd9\xbd\xc7\x70\xbb\xb5\x68\x56\x61\x94\x62\x11\x15\xb4\x5e\x0f\x9e\x9e\x36\x65\xf7\x9b\x79\xf7\xc0\xff\xc1\x03\x52\x17\xfc\xf8\x7d\x03\xe4\xcf\x52\xef\xea\x7f\x93\xfa\xf7\x8a\xbf\x89\x5f\xa9\x23\x13\x6c\xb6\xcd\xfe\xdd\x0c\x3f\xcc\x0c\x7f\x0f\x00\xfe\xb1\xb6\xf3\x07\x0c\x00\x00"),
 		},
 		"/gameservices.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "gameservices.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 5596,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4b\x6f\xe3\x36\x10\xbe\xeb\x57\x0c\xec\x4b\x0b\x24\x52\x92\xa3\x7a\xf2\x3a\xd9\xac\xd0\xac\x53\xd8\x4e\x17\x7b\x0a\xc6\xd4\x48\x62\x43\x91\x2c\x49\xd9\x2b\xa4\xfe\xef\x85\x5e\x7e\xa7\x75\xd2\x36\x1b\xa0\xd1\xc5\x14\x39\x8f\x8f\xc3\xf9\x3e\x99\x7d\x18\x2a\x5d\x1a\x9e\x66\x0e\x2e\xce\x2e\x2e\xe0\x5a\xa9\x54\x10\xdc\xdc\x0c\xbd\xbe\xd7\x87\x1b\xce\x48\x5a\x8a\xa1\x90\x31\x19\x70\x19\xc1\x40\x23\xcb\xa8\x5b\x39\x81\x5f\xc9\x58\xae\x24\x5c\xf8\x67\xf0\x43\x65\xd0\x6b\x97\x7a\x3f\xfe\xe4\xf5\xa1\x54\x05\xe4\x58\x82\x54\x0e\x0a\x4b\xe0\x32\x6e\x21\xe1\x82\x80\xbe\x31\xd2\x0e\xb8\x04\xa6\x72\x2d\x38\x4a\x46\xb0\xe0\x2e\xab\xd3\xb4\x41\x7c\xaf\x0f\x5f\xdb\x10\x6a\xe6\x90\x4b\x40\x60\x4a\x97\xa0\x92\x4d\x3b\x40\x57\x03\xae\x9f\xcc\x39\x1d\x06\xc1\x62\xb1\xf0\xb1\x46\xeb\x2b\x93\x06\xa2\xb1\xb4\xc1\x4d\x34\xbc\x1a\x4d\xae\x4e\x2f\xfc\xb3\xda\xe7\x4e\x0a\xb2\x16\x0c\xfd\x5e\x70\x43\x31\xcc\x4a\x40\xad\x05\x67\x38\x13\x04\x02\x17\xa0\x0c\x60\x6a\x88\x62\x70\xaa\x02\xbc\x30\xdc\x71\x99\x9e\x80\x55\x89\x5b\xa0\x21\xaf\x0f\x31\xb7\xce\xf0\x59\xe1\xb6\xaa\xd5\xc1\xe3\x76\xcb\x40\x49\x40\x09\xbd\xc1\x04\xa2\x49\x0f\x3e\x0c\x26\xd1\xe4\xc4\xeb\xc3\x97\x68\xfa\xe9\xf6\x6e\x0a\x5f\x06\xe3\xf1\x60\x34\x8d\xae\x26\x70\x3b\x86\xe1\xed\xe8\x32\x9a\x46\xb7\xa3\x09\xdc\x7e\x84\xc1\xe8\x2b\xfc\x1c\x8d\x2e\x4f\x80\xb8\xcb\xc8\x00\x7d\xd3\xa6\xc2\xaf\x0c\xf0\xaa\x8e\x14\x57\x45\x9b\x10\x6d\x01\x48\x54\x03\xc8\x6a\x62\x3c\xe1\x0c\x04\xca\xb4\xc0\x94\x20\x55\x73\x32\x92\xcb\x14\x34\x99\x9c\xdb\xea\x34\x2d\xa0\x8c\xbd\x3e\x08\x9e\x73\x87\xae\x9e\xd9\xdb\x94\xef\x79\xa8\x79\x7b\xfe\x21\x30\x65\xc8\x67\xd2\xe4\x3e\x13\xaa\x88\xfd\xb4\x6e\x25\x9f\xa9\x3c\x98\x9f\xa3\xd0\x19\x9e\x7b\x0f\x5c\xc6\x21\x4c\xc8\xcc\x39\xa3\xcf\xa8\x35\x97\xa9\x97\x93\xc3\x18\x1d\x86\x1e\x80\xc4\x9c\x42\x48\x31\x27\xdb\x18\xd9\xc3\x21\x5b\x53\xab\x91\x51\x08\x95\xc9\xa9\x2d\xad\xa3\xdc\xab\x36\xb8\x8e\x74\x8d\x39\xb5\xe9\xac\x07\x30\xef\xc0\xce\xcf\x67\xe4\xf0\xdc\x03\x68\xf3\x7c\x52\xd6\x8d\xf6\x93\x37\x19\x51\x73\xdb\x66\x35\x64\x55\x61\x18\xd9\x2a\x07\xc0\x69\x87\xb8\xb6\xbb\xaf\x7c\xef\x3b\xe7\xf5\x1b\x99\x7b\x26\x0a\xeb\xc8\x78\x4d\x87\x36\x75\xd8\x04\xd7\x8d\xc9\x0c\xb7\x2c\xb1\x70\xea\x9a\x24\x19\x74\x14\x87\xe0\x4c\x41\xed\x0a\x8f\xa7\x94\x6b\x81\x8e\x42\xe8\x69\xa3\x7e\x23\xe6\x6c\xf0\xf8\xd8\x0e\x97\xcb\x40\x28\xd6\x9c\x5d\xf0\xf8\xd8\x8d\x97\xcb\xc0\x10\x8a\xbc\x9a\xab\x07\xf7\x3c\x5e\x2e\x83\x74\x37\x7d\xb5\xde\x62\xae\x2d\x7a\x7b\x59\x87\x28\x3f\xd0\x9d\xa5\x78\xaa\x3e\xa3\x63\xd9\xb8\xad\x4c\x53\xc5\x04\x85\xed\x90\x76\x35\x1b\xcc\x91\x8b\x8a\x54\x91\x1c\x58\x4b\x2e\x92\x73\x92\x4e\x99\x72\xdb\xbc\xeb\x87\xb6\x3f\xc2\x76\xba\x3b\xd2\x35\xaa\xd5\x82\xc0\x19\x09\x1b\xb6\xbf\x3b\x59\xa3\xcb\x75\x04\x87\x26\x25\xf7\x91\x93\x88\x0f\x04\xca\x38\x19\x34\x2c\xe3\x0c\xc5\x98\x12\x32\x24\x57\x07\xdd\x1c\xb6\x2b\x35\x85\xd0\x56\x78\x35\x0f\xf0\x40\xe5\x6a\x7a\x4c\xc9\x0e\x82\x27\x62\x25\x2d\x8e\x63\xc3\x55\x4f\x4c\x96\x19\xae\x5d\xdd\xc4\x7f\x9c\x6e\xac\x00\x4c\x33\xea\x9c\xc0\x65\xe8\x1a\xad\xed\x40\xc0\x8c\x84\x92\xa9\x05\xa7\xfc\x0d\xb7\x74\xfe\x10\x6e\x45\x69\x5a\xf3\x97\x3d\x4c\x07\xc9\xb3\x11\xc7\xa8\x42\x87\xab\x6c\x39\x4a\x4c\xc9\x3c\x49\xde\xae\x08\xf5\x4e\xeb\x3e\x8c\xe2\xed\x9d\xae\xca\xd3\x75\xe9\xdf\x83\xde\xe4\xd3\xb8\xf2\x7a\x36\xfc\xa3\x84\x67\x85\x70\xb3\x99\xaa\xde\x7c\xa6\x22\x28\x99\xf0\xf4\x18\x41\xd8\x34\xfc\x0f\xf4\x60\xcd\xfd\x4b\xd2\x42\x95\x39\xc9\xda\x31\x5e\xbd\x35\x1a\xd1\x00\xae\x85\xa1\x1e\xbd\x35\x5d\xe8\x40\xfd\x53\x59\xd8\x89\xf3\xae\x0a\xdf\x47\x15\xd6\xed\xf7\xa4\x34\x6c\x75\xe8\xf3\xf4\xe1\xfa\x40\xd3\xbf\xa2\x5c\xec\x23\x3f\x5a\x37\xe2\x5d\xbc\xcf\xda\xdc\xf7\xd3\x8f\xb7\xa3\x14\x87\xdb\xe6\x65\x6a\x71\x28\xd6\xbb\x62\xbc\xa2\x62\xbc\x80\x37\xf7\x46\x09\xa1\x8a\xe7\xf1\x67\xbc\xe5\xf3\xef\xd0\x28\x15\x6a\x86\xe2\xe8\x0f\x70\x0b\xfb\x6d\x13\xe9\x9d\x31\x6f\x9d\x31\xf0\x3f\xf9\xc6\x1e\xf7\x97\xdc\x6c\x5c\x14\xfe\xfa\x1a\xf1\x3a\x57\xf1\xb7\xc3\xee\xbd\x8b\xd7\xcb\xbe\x90\x3b\x61\xde\xa9\xfe\x4a\x54\xff\x33\x00\x00\xff\xff\x65\xba\x5e\x4e\xdc\x15\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xdf\x53\xe3\x36\x10\x7e\xf7\x5f\xb1\x93\xbc\xb4\x33\x60\x1f\x3c\xba\x4f\xb9\xc0\x71\x9e\x72\xa1\x93\x84\xde\xdc\x13\xb3\x91\x37\xb2\x8a\x2c\xa9\x92\x9c\x9c\x87\xe6\x7f\xef\xd8\xb1\xe3\x84\x84\x1e\x70\x2d\xc7\x4c\xc1\xcc\x20\x4b\xfb\xe3\xd3\x6a\xbf\xcf\xa8\x0f\x43\x6d\x4a\x2b\x78\xe6\xe1\xf4\xdd\xe9\x29\x5c\x68\xcd\x25\xc1\xe5\xe5\x30\xe8\x07\x7d\xb8\x14\x8c\x94\xa3\x14\x0a\x95\x92\x05\x9f\x11\x0c\x0c\xb2\x8c\xda\x95\x23\xf8\x9d\xac\x13\x5a\xc1\x69\xf8\x0e\x7e\xaa\x0c\x7a\xcd\x52\xef\xe7\x5f\x82\x3e\x94\xba\x80\x1c\x4b\x50\xda\x43\xe1\x08\x7c\x26\x1c\xcc\x85\x24\xa0\xaf\x8c\x8c\x07\xa1\x80\xe9\xdc\x48\x81\x8a\x11\x2c\x85\xcf\xc0\x77\xf1\xc3\xa0\x0f\x5f\x9a\x10\x7a\xe6\x51\x28\x40\x60\xda\x94\xa0\xe7\xdb\x76\x80\xbe\x06\x5c\xff\x64\xde\x9b\x38\x8a\x96\xcb\x65\x88\x35\xda\x50\x5b\x1e\xc9\xb5\xa5\x8b\x2e\x93\xe1\xf9\x68\x72\x7e\x7c\x1a\xbe\xab\x7d\xae\x95\x24\xe7\xc0\xd2\x9f\x85\xb0\x94\xc2\xac\x04\x34\x46\x0a\x86\x33\x49\x20\x71\x09\xda\x02\x72\x4b\x94\x82\xd7\x15\xe0\xa5\x15\x5e\x28\x7e\x04\x4e\xcf\xfd\x12\x2d\x05\x7d\x48\x85\xf3\x56\xcc\x0a\xbf\x53\xad\x16\x9e\x70\x3b\x06\x5a\x01\x2a\xe8\x0d\x26\x90\x4c\x7a\xf0\x7e\x30\x49\x26\x47\x41\x1f\x3e\x27\xd3\x8f\x57\xd7\x53\xf8\x3c\x18\x8f\x07\xa3\x69\x72\x3e\x81\xab\x31\x0c\xaf\x46\x67\xc9\x34\xb9\x1a\x4d\xe0\xea\x03\x0c\x46\x5f\xe0\xd7\x64\x74\x76\x04\x24\x7c\x46\x16\xe8\xab\xb1\x15\x7e\x6d\x41\x54\x75\xa4\xb4\x2a\xda\x84\x68\x07\xc0\x5c\xaf\x8f\xcf\x19\x62\x62\x2e\x18\x48\x54\xbc\x40\x4e\xc0\xf5\x82\xac\x12\x8a\x83\x21\x9b\x0b\x57\x9d\xa6\x03\x54\x69\xd0\x07\x29\x72\xe1\xd1\xd7\x33\x7b\x9b\x0a\x83\x00\x8d\x68\xce\x3f\x06\xa6\x2d\x85\x4c\xd9\x3c\x64\x52\x17\x69\xc8\xeb\x56\x0a\x99\xce\xa3\xc5\x09\x4a\x93\xe1\x49\x70\x2b\x54\x1a\xc3\x84\xec\x42\x30\xfa\x84\xc6\x08\xc5\x83\x9c\x3c\xa6\xe8\x31\x0e\x00\x14\xe6\x14\x03\xc7\x9c\xdc\xda\xc8\x1d\x0e\xd9\x98\x3a\x83\x8c\x62\xa8\x4c\x8e\x5d\xe9\x3c\xe5\x41\xb5\xc1\x2e\xd2\x05\xe6\xd4\xa4\x73\x01\xc0\xa2\x05\xbb\x38\x99\x91\xc7\x93\x00\xa0\xc9\xf3\x51\x3b\x3f\xda\x4f\xbe\xce\x88\x46\xb8\x26\xab\x25\xa7\x0b\xcb\xc8\x55\x39\x00\x8e\x5b\xc4\xb5\xdd\x4d\x05\xfc\xa6\x75\xee\xde\xc8\xde\x30\x59\x38\x4f\xb6\x76\x02\x58\xd7\x61\x1b\x5c\x3b\x26\x3b\xdc\xb1\xc4\xc2\xeb\x0b\x52\x64\xd1\x53\x1a\x83\xb7\x05\x35\x2b\x22\x9d\x52\x6e\x24\x7a\x8a\xa1\x67\xac\xfe\x83\x98\x77\xd1\xdd\x5d\x33\x5c\xad\x22\xa9\xd9\xfa\xec\xa2\xbb\xbb\x76\xbc\x5a\x45\x96\x50\xe6\x95\x65\x3d\xb8\x11\xe9\x6a\x15\xf1\xfb\xe9\xab\xf5\x06\x73\x6d\xd1\xdb\xcb\x3a\x44\xf5\x9e\xae\x1d\xa5\x53\xfd\x09\x3d\xcb\xc6\x4d\x65\xd6\x55\x9c\xa3\x74\x2d\xd2\xb6\x66\x83\x05\x0a\x59\x91\x2a\x51\x03\xe7\xc8\x27\x6a\x41\xca\x6b\x5b\xee\x9a\xb7\xfd\xd0\xf4\x47\xdc\x4c\xb7\x47\xda\xa1\xda\x2c\x48\x9c\x91\x74\x71\xf3\xf7\x5e\xd6\xe4\xac\x8b\xe0\xd1\x72\xf2\x1f\x04\xc9\xf4\x40\xa0\x4c\x90\x45\xcb\x32\xc1\x50\x8e\x69\x4e\x96\xd4\xe6\xa0\xab\xdf\x63\xf0\xa5\xa1\x18\x9a\x0a\x6f\xe6\x01\x6e\xa9\xdc\x4c\x8f\x69\x7e\x0f\xc1\x03\xb1\xe6\x0d\x8e\xc7\x86\xab\x9e\x94\x1c\xb3\xc2\x54\x27\x19\xc3\x5f\xc7\x5b\x2b\x00\xd3\x8c\x5a\x27\xf0\x19\xfa\xb5\xd6\xb6\x20\x60\x46\x52\x2b\xee\xc0\xeb\x70\xcb\x8d\x2f\x6e\x3b\x50\x5d\x6b\xfe\xb6\x87\xe9\x20\x79\xba\x87\x5b\x5d\x98\x78\x93\x2d\x47\x85\x9c\xec\x83\xe4\x6d\x8b\x50\xef\xb4\xee\xc3\x24\xdd\xdd\xe9\xa6\x3c\x6d\x97\x7e\x1b\xf4\x36\x9f\xc6\x95\xd7\x93\xe1\x3f\x4a\x78\x0e\x36\x53\xd5\x9b\x4f\x54\x04\xad\xe6\x82\x07\x0f\x6d\xa0\x1d\x93\x1d\x6e\x1b\xfe\x07\x7a\xd0\x71\xff\x8c\x8c\xd4\x65\x4e\xaa\x16\x92\x74\xf3\x56\x2b\x40\xc4\x6a\x1c\xd5\xca\x7a\xf4\xda\x74\xa1\x05\xf5\xbd\xb2\x70\x2f\xce\x9b\x2a\xfc\x18\x55\xe8\xda\xef\x41\x69\xd8\xe9\xd0\x6f\xc3\x3f\x4c\xaf\xae\xe9\x9f\xbc\xaf\xe7\xcb\xc5\x3e\xf2\x47\xeb\x46\xe7\x1a\x3c\x63\x73\x3f\x4e\x3f\x5e\x8f\x52\xec\x00\xfb\x4e\xb5\x38\x14\xeb\x4d\x31\x5e\x50\x31\x9e\xc1\x9b\x1b\xab\xa5\xd4\xc5\xd3\xf8\x33\xde\xf1\xf9\x77\x68\xc4\xa5\x9e\xa1\x7c\x2c\x81\xa2\x06\xf6\xeb\x26\xd2\x1b\x63\x5e\x3b\x63\xfe\x2f\xdf\xd8\xc7\xfd\x4b\x6e\xb7\x2e\x0a\xff\x7c\x8d\x78\x99\xab\xf8\xeb\x61\xf7\xde\xc5\xeb\x79\x5f\xc8\x7b\x61\xde\xa8\xfe\x42\x54\xff\x7b\x00\x65\xba\x5e\x4e\xdc\x15\x00\x00"),
 		},
 		"/gkebackup.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "gkebackup.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1825,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\x0c\xec\xcb\x2e\x90\xc8\x49\x8e\xda\x93\xe3\xa4\xa9\xd0\xc4\x29\x6c\x67\x8b\x9e\x82\x11\x35\x92\xb8\xa6\x48\x2e\x39\xb2\x2b\x64\xfd\xdf\x17\xfa\xf2\x57\x52\xb4\x3a\x48\x02\xe7\xcd\x9b\xc7\x37\x43\x69\x0c\x33\x63\x6b\x27\xf3\x82\xe1\xe6\xea\xe6\x06\x1e\x8c\xc9\x15\xc1\xe3\xe3\x2c\x18\x07\x63\x78\x94\x82\xb4\xa7\x14\x2a\x9d\x92\x03\x2e\x08\xa6\x16\x45\x41\x43\xe4\x02\xfe\x26\xe7\xa5\xd1\x70\x13\x5e\xc1\x1f\x0d\x60\xd4\x87\x46\x7f\xfe\x15\x8c\xa1\x36\x15\x94\x58\x83\x36\x0c\x95\x27\xe0\x42\x7a\xc8\xa4\x22\xa0\x1f\x82\x2c\x83\xd4\x20\x4c\x69\x95\x44\x2d\x08\xb6\x92\x8b\xb6\x4c\x4f\x12\x06\x63\xf8\xde\x53\x98\x84\x51\x6a\x40\x10\xc6\xd6\x60\xb2\x63\x1c\x20\xb7\x82\xdb\xab\x60\xb6\xd1\x64\xb2\xdd\x6e\x43\x6c\xd5\x86\xc6\xe5\x13\xd5\x21\xfd\xe4\x31\x9e\xdd\xcf\x97\xf7\x97\x37\xe1\x55\x9b\xf3\xa2\x15\x79\x0f\x8e\xfe\xad\xa4\xa3\x14\x92\x1a\xd0\x5a\x25\x05\x26\x8a\x40\xe1\x16\x8c\x03\xcc\x1d\x51\x0a\x6c\x1a\xc1\x5b\x27\x59\xea\xfc\x02\xbc\xc9\x78\x8b\x8e\x82\x31\xa4\xd2\xb3\x93\x49\xc5\x27\x6e\x0d\xf2\xa4\x3f\x01\x18\x0d\xa8\x61\x34\x5d\x42\xbc\x1c\xc1\xed\x74\x19\x2f\x2f\x82\x31\x7c\x8b\x57\x9f\x9f\x5f\x56\xf0\x6d\xba\x58\x4c\xe7\xab\xf8\x7e\x09\xcf\x0b\x98\x3d\xcf\xef\xe2\x55\xfc\x3c\x5f\xc2\xf3\x27\x98\xce\xbf\xc3\x97\x78\x7e\x77\x01\x24\xb9\x20\x07\xf4\xc3\xba\x46\xbf\x71\x20\x1b\x1f\x29\x6d\x4c\x5b\x12\x9d\x08\xc8\x4c\x27\xc8\x5b\x12\x32\x93\x02\x14\xea\xbc\xc2\x9c\x20\x37\x1b\x72\x5a\xea\x1c\x2c\xb9\x52\xfa\xa6\x9b\x1e\x50\xa7\xc1\x18\x94\x2c\x25\x23\xb7\x2b\xef\x36\x15\x06\x01\x5a\xd9\xf7\x3f\x02\x61\x1c\x85\x42\xbb\x32\x14\xca\x54\x69\x98\xb7\xa3\x14\x0a\x53\x4e\x36\xd7\xa8\x6c\x81\xd7\xc1\x5a\xea\x34\x82\x25\xb9\x8d\x14\xf4\x84\xd6\x4a\x9d\x07\x25\x31\xa6\xc8\x18\x05\x00\x1a\x4b\x8a\x20\x5f\x53\x82\x62\x5d\xd9\x8f\xf9\x7a\x9c\xb7\x28\x28\x82\x06\x72\xe9\x6b\xcf\x54\x06\xcd\xee\x0e\x34\x0f\x5f\xee\x6f\x5b\x9a\x00\x60\x33\xc8\xdc\x5c\x27\xc4\x78\x1d\x00\xf8\x4e\xc6\x67\xe3\x79\x7e\x56\xb6\xab\x85\x56\xfa\xbe\x9e\x23\x6f\x2a\x27\xc8\x37\xec\x00\x97\x83\xd0\x16\xf7\x9a\xaf\xe9\xb5\xcb\x1c\x1e\x56\xa1\x0e\xba\x69\xec\xf6\xbc\xd7\xd2\xdd\xbf\x1e\xe2\x58\xb1\x79\x20\x4d\x0e\x99\xd2\x08\xd8\x55\xd4\x47\x24\x96\x33\xa3\x33\x99\x47\xfd\x02\x80\x35\x4a\x8a\x7a\xfe\xeb\xe2\xaf\x12\xcb\xd7\x0e\x7d\x96\xfc\x44\x65\x42\xee\x77\x29\xca\x16\xbd\xa7\x70\x94\x91\x23\x2d\xe8\x93\x24\x95\x1e\x74\x0d\x96\x37\xf7\xa3\x45\xae\xed\xf9\xa2\xaf\xac\x35\x8e\xfd\xcc\xe8\x54\xb6\x93\x15\x41\x86\xca\xef\x37\x9d\xae\xa8\xb4\x0a\x99\x22\x18\x59\x67\xfe\x21\xc1\x7e\xf2\xf6\xd6\xbf\xee\x76\x13\x65\x44\x37\x92\x93\xb7\xb7\xe1\x7d\xb7\x9b\x24\x7b\x63\x9b\x40\x53\x73\xb7\x1b\xbd\x23\x9d\xa1\xbe\xa5\x17\x4f\xe9\xca\x3c\x21\x8b\x62\xd1\x37\xb6\xf3\xe3\x58\xc8\xd0\xf2\xe9\x06\xa5\x6a\x3e\x05\xb1\x9e\x7a\x4f\x1c\xeb\x0d\x69\x36\xae\x3e\x85\x0f\x53\xdc\x4f\xf5\xc1\x9a\x0f\x8c\x51\x98\x90\xf2\x51\xff\x3c\xab\x17\xdf\x1d\x72\x19\x5d\x4e\xdc\x79\x7d\x4c\x51\x48\x72\xe8\x44\x21\x05\xaa\xc5\xd0\x12\x7f\xc8\xbb\xec\x9d\xef\x4d\x3b\xea\xc8\x9a\xea\xfd\xf2\x82\xb2\xb3\xda\x3f\xe1\xca\x7a\x05\xbf\x4b\xd7\x5c\x29\x79\xe1\xa4\xe5\xf6\xd0\xfd\x77\x79\x14\x01\x58\x15\x34\x24\x01\x17\xc8\xdd\x5f\x61\x10\x01\x09\x29\xa3\x73\x0f\x6c\xc2\xa3\xb4\x7c\xb3\x8e\x4e\x58\xba\x83\xf5\xf5\x9d\xa6\x0f\x0f\xfb\x11\x8f\x33\x95\x8d\xf6\xd5\x4a\xd4\x98\x93\xfb\xc9\x97\xe6\xff\x00\x00\x00\xff\xff\x92\x11\xa4\x10\x21\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x73\xda\x48\x10\xbd\xeb\x57\xbc\x82\xcb\x6e\x95\x2d\x62\x1f\xb5\x27\x8c\x1d\x47\x15\x1b\xa7\x00\x6f\x2a\x27\x57\x33\x6a\xa4\x59\x46\x33\xb3\x33\x23\x08\xe5\xe5\xbf\x6f\xe9\x0b\x30\x4e\x76\x83\x28\xa4\xea\x7e\xfd\xfa\xe9\x75\x4b\x0c\x31\x31\x76\xe7\x64\x5e\x04\x5c\x7f\xb8\xbe\xc6\xbd\x31\xb9\x62\x3c\x3c\x4c\xa2\x61\x34\xc4\x83\x14\xac\x3d\x67\xa8\x74\xc6\x0e\xa1\x60\x8c\x2d\x89\x82\xfb\xcc\x05\xfe\x64\xe7\xa5\xd1\xb8\x8e\x3f\xe0\xb7\x1a\x30\xe8\x52\x83\xdf\xff\x88\x86\xd8\x99\x0a\x25\xed\xa0\x4d\x40\xe5\x19\xa1\x90\x1e\x2b\xa9\x18\xfc\x5d\xb0\x0d\x90\x1a\xc2\x94\x56\x49\xd2\x82\xb1\x95\xa1\x40\x38\xf2\xc7\xd1\x10\xdf\x3a\x0a\xb3\x0c\x24\x35\x08\xc2\xd8\x1d\xcc\xea\x14\x07\x0a\x8d\xe0\xe6\x53\x84\x60\x93\xd1\x68\xbb\xdd\xc6\xd4\xa8\x8d\x8d\xcb\x47\xaa\x45\xfa\xd1\x43\x3a\xb9\x9b\xce\xef\x2e\xaf\xe3\x0f\x4d\xcd\xb3\x56\xec\x3d\x1c\xff\x5d\x49\xc7\x19\x96\x3b\x90\xb5\x4a\x0a\x5a\x2a\x86\xa2\x2d\x8c\x03\xe5\x8e\x39\x43\x30\xb5\xe0\xad\x93\x41\xea\xfc\x02\xde\xac\xc2\x96\x1c\x47\x43\x64\xd2\x07\x27\x97\x55\x78\xe3\x56\x2f\x4f\xfa\x37\x00\xa3\x41\x1a\x83\xf1\x1c\xe9\x7c\x80\x9b\xf1\x3c\x9d\x5f\x44\x43\x7c\x4d\x17\x9f\x9e\x9e\x17\xf8\x3a\x9e\xcd\xc6\xd3\x45\x7a\x37\xc7\xd3\x0c\x93\xa7\xe9\x6d\xba\x48\x9f\xa6\x73\x3c\x7d\xc4\x78\xfa\x0d\x9f\xd3\xe9\xed\x05\x58\x86\x82\x1d\xf8\xbb\x75\xb5\x7e\xe3\x20\x6b\x1f\x39\xab\x4d\x9b\x33\xbf\x11\xb0\x32\xed\xf8\xbc\x65\x21\x57\x52\x40\x91\xce\x2b\xca\x19\xb9\xd9\xb0\xd3\x52\xe7\xb0\xec\x4a\xe9\xeb\x69\x7a\x90\xce\xa2\x21\x94\x2c\x65\xa0\xd0\x44\xde\xdd\x54\x1c\x45\x64\x65\x37\xff\x04\xc2\x38\x8e\x85\x76\x65\x2c\x94\xa9\xb2\x38\x6f\x56\x29\x16\xa6\x1c\x6d\xae\x48\xd9\x82\xae\xa2\xb5\xd4\x59\x82\x39\xbb\x8d\x14\xfc\x48\xd6\x4a\x9d\x47\x25\x07\xca\x28\x50\x12\x01\x9a\x4a\x4e\x90\xaf\x79\x49\x62\x5d\xd9\x1f\xf3\x75\x38\x6f\x49\x70\x82\x1a\x72\xe9\x77\x3e\x70\x19\xd5\x77\x77\xa4\xb9\xff\x7c\x77\xd3\xd0\x44\xc0\xa6\x97\xb9\xb9\x5a\x72\xa0\xab\x08\xf0\xad\x8c\x4f\xc6\x87\xe9\x59\xdb\xb6\x17\x59\xe9\xbb\x7e\x8e\xbd\xa9\x9c\x60\x5f\xb3\x03\x97\xbd\xd0\x06\xf7\x92\xaf\xf9\xa5\xad\xec\x4f\x56\x91\x6e\x90\x40\x7b\xcf\x07\x2d\xad\xa2\x2f\xc7\x3c\x55\xc1\xdc\xb3\x66\x47\x81\xb3\x04\xc1\x55\xdc\x65\x24\x95\x13\xa3\x57\x32\x4f\xba\x00\x60\x8d\x92\x62\x37\xfd\xff\xe6\x2f\x92\xca\x97\x16\x7d\x56\xfc\xc8\xe5\x92\xdd\xaf\x52\x94\x0d\xfa\x40\xe1\x78\xc5\x8e\xb5\xe0\x8f\x92\x55\x76\xd4\xd5\x5b\x5e\xff\x9e\x04\xc3\xce\x9e\x07\x7d\x65\xad\x71\xc1\x4f\x8c\xce\x64\xb3\x59\x09\x56\xa4\x7c\x8f\x90\xd9\x82\x4b\xab\x28\x70\x82\x81\x75\xe6\x2f\x16\xc1\x8f\x5e\x5f\xbb\xcb\xfd\x7e\xa4\x8c\x68\x57\x72\xf4\xfa\xda\x5f\xef\xf7\xa3\xe5\xc1\xd8\x1a\x5e\x0b\xd9\xef\x07\xef\x48\x27\xa4\x6f\xf8\xd9\x73\xb6\x30\x8f\x14\x44\x31\xeb\x06\xdb\xfa\x71\x2a\xa4\x1f\xf9\x78\x43\x52\xd5\xaf\x82\x54\x8f\xbd\xe7\x90\xea\x0d\xeb\x60\xdc\xee\x2d\xbc\xdf\xe2\x6e\xab\x93\xe8\x3f\x8c\x51\xb4\x64\xe5\x93\xee\x7c\xd6\x2f\xbd\x3d\xd6\x06\x72\x39\x87\xd6\xeb\x53\x8a\x42\xb2\x23\x27\x0a\x29\x48\xcd\xfa\x91\x74\xbb\x59\x7f\x2f\x3b\xe7\x3b\xd3\x0e\x71\x60\xcd\xbb\x43\x78\xc6\xab\xb3\xde\x3f\xe1\x5a\x75\x0a\x7e\x95\xae\x3e\x32\xf6\xc2\x49\x5b\x0f\x27\xc1\x3f\x97\x27\x19\x60\x51\x70\x5f\x84\x50\x50\x68\xff\x15\x7a\x11\x58\xb2\x32\x3a\xf7\x08\x26\x3e\x29\xcb\x37\xeb\xa3\xa8\xe3\x83\xf5\xe5\x9d\xa6\x1f\x3e\xec\xc7\x23\x77\xa6\xb2\xc9\xa1\x5b\x49\x9a\x72\x76\x3f\x79\xd3\xfc\x3b\x00\x92\x11\xa4\x10\x21\x07\x00\x00"),
 		},
 		"/gkehub.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "gkehub.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1831,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x5b\x73\xda\x48\x13\x7d\xd7\xaf\xe8\x82\x97\xef\xab\xb2\x85\xcd\xa3\xf6\x89\x60\xc7\x56\xc5\x86\x2d\xc0\x9b\xca\x13\xd5\x1a\xb5\xa4\x5e\x46\x33\xb3\x33\x23\x88\x2a\xeb\xff\xbe\xa5\x1b\x17\xdb\x49\x45\x2f\xc0\xf4\xe9\x73\xce\xf4\x05\x8d\x61\xae\x4d\x6d\x39\x2f\x3c\x4c\x6f\xa6\x53\x78\xd0\x3a\x97\x04\x4f\x4f\xf3\x60\x1c\x8c\xe1\x89\x05\x29\x47\x29\x54\x2a\x25\x0b\xbe\x20\x98\x19\x14\x05\x0d\x91\x2b\xf8\x8b\xac\x63\xad\x60\x1a\xde\xc0\xff\x1a\xc0\xa8\x0f\x8d\xfe\xff\x47\x30\x86\x5a\x57\x50\x62\x0d\x4a\x7b\xa8\x1c\x81\x2f\xd8\x41\xc6\x92\x80\xbe\x0b\x32\x1e\x58\x81\xd0\xa5\x91\x8c\x4a\x10\x1c\xd8\x17\xad\x4c\x4f\x12\x06\x63\xf8\xd6\x53\xe8\xc4\x23\x2b\x40\x10\xda\xd4\xa0\xb3\x73\x1c\xa0\x6f\x0d\xb7\x4f\xe1\xbd\x89\x26\x93\xc3\xe1\x10\x62\xeb\x36\xd4\x36\x9f\xc8\x0e\xe9\x26\x4f\xf1\xfc\x7e\xb1\xbe\xbf\x9e\x86\x37\x6d\xce\x8b\x92\xe4\x1c\x58\xfa\xa7\x62\x4b\x29\x24\x35\xa0\x31\x92\x05\x26\x92\x40\xe2\x01\xb4\x05\xcc\x2d\x51\x0a\x5e\x37\x86\x0f\x96\x3d\xab\xfc\x0a\x9c\xce\xfc\x01\x2d\x05\x63\x48\xd9\x79\xcb\x49\xe5\x2f\xaa\x35\xd8\x63\x77\x01\xd0\x0a\x50\xc1\x68\xb6\x86\x78\x3d\x82\x4f\xb3\x75\xbc\xbe\x0a\xc6\xf0\x35\xde\x3c\x2e\x5f\x36\xf0\x75\xb6\x5a\xcd\x16\x9b\xf8\x7e\x0d\xcb\x15\xcc\x97\x8b\xbb\x78\x13\x2f\x17\x6b\x58\x7e\x86\xd9\xe2\x1b\x7c\x89\x17\x77\x57\x40\xec\x0b\xb2\x40\xdf\x8d\x6d\xfc\x6b\x0b\xdc\xd4\x91\xd2\xa6\x68\x6b\xa2\x0b\x03\x99\xee\x0c\x39\x43\x82\x33\x16\x20\x51\xe5\x15\xe6\x04\xb9\xde\x93\x55\xac\x72\x30\x64\x4b\x76\x4d\x37\x1d\xa0\x4a\x83\x31\x48\x2e\xd9\xa3\x6f\x4f\xde\x5d\x2a\x0c\x02\x34\xdc\xf7\x3f\x02\xa1\x2d\x85\x42\xd9\x32\x14\x52\x57\x69\x98\xb7\xa3\x14\x0a\x5d\x4e\xf6\xb7\x28\x4d\x81\xb7\xc1\x8e\x55\x1a\xc1\x9a\xec\x9e\x05\x3d\xa3\x31\xac\xf2\xa0\x24\x8f\x29\x7a\x8c\x02\x00\x85\x25\x45\x90\xef\xa8\xa8\x92\x8f\xc9\x7a\x90\x33\x28\x28\x82\x06\x72\xed\x6a\xe7\xa9\x0c\x9a\xab\x9d\x38\x1e\xbe\xdc\x3f\x56\x49\x00\xb0\x1f\x0c\xee\x6f\x13\xf2\x78\x1b\x00\xb8\xce\xc0\xa3\x76\x7e\x71\x2e\xd8\xa9\xa0\x61\xd7\x2b\x59\x72\xba\xb2\x82\x5c\xc3\x0b\x70\x3d\xf8\x6b\x71\xdb\x7c\x47\xdb\xa2\x4a\xb6\x25\x95\x09\x59\x57\xb0\x09\xba\x01\xec\xae\xd9\x39\x78\x7e\x1b\xc4\xca\xeb\x07\x52\x64\xd1\x53\x1a\x81\xb7\x15\xf5\x11\xc6\x72\xae\x55\xc6\x79\xd4\x1f\x00\x18\x2d\x59\xd4\x8b\x5f\xab\x6e\x19\xcb\x6d\x87\x7c\x93\xd8\x89\xff\x4e\x7a\xf7\xf3\x98\x6e\x29\x23\x4b\x4a\xd0\x67\x26\x99\x9e\xfc\x0c\xd5\x3d\xcf\x4e\xcf\xa2\xbe\x36\x14\xb5\x98\xe3\xa1\xab\x8c\xd1\xd6\xbb\xb9\x56\x29\xb7\xa3\x14\x41\x86\xd2\x1d\x6f\x9d\x6e\xa8\x34\x12\x3d\x45\x30\x32\x56\xff\x4d\xc2\xbb\xc9\x8f\x1f\xfd\xd7\xd7\xd7\x89\xd4\xa2\x9b\xc1\x49\x2e\x75\x82\x72\x72\x52\x6f\x80\x17\x5e\x5e\x5f\x47\xef\x78\xe7\xa8\x3e\xd1\x8b\xa3\x74\xa3\x9f\xd1\x8b\x62\xd5\x77\xb5\xab\xcb\xb9\x97\xa1\xdf\xb3\x3d\xb2\x6c\xd6\x3f\x56\x33\xe7\xc8\xc7\x6a\x4f\xca\x6b\x5b\x5f\xc2\x87\xc9\xed\x27\xf9\x54\xa6\x5f\x15\x49\x62\x42\xd2\x45\xfd\xe7\x1b\xe1\xf8\xee\x44\xe2\xd1\xe6\xe4\xbb\x06\x7c\xc8\x55\x30\x59\xb4\xa2\x60\x81\x72\x35\x34\xcc\x9d\x08\xae\xfb\x76\xf4\x95\x3c\x6b\xd3\x8e\xea\xe3\xf1\x8a\xb2\x37\x26\x7e\xc2\x95\xf5\x56\x7e\x97\xae\x79\x52\x72\xc2\xb2\xf1\xed\x02\xfe\x7b\x7d\x16\x01\xd8\x14\x34\x24\x81\x2f\xd0\x77\xef\x86\xc1\x04\x24\x24\xb5\xca\x1d\x78\x1d\x9e\xa5\xe5\xfb\x5d\x74\xc1\xd2\xed\xda\x9f\xef\x3c\x7d\xb8\xf8\x67\x3c\x56\x57\x26\x3a\xaa\x95\xa8\x30\x27\xfb\x93\xbf\x9c\xff\x02\x00\x00\xff\xff\x8e\x5f\xc7\xaf\x27\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\xd8\x97\x16\x48\xe4\x4d\x8e\xea\xc9\xeb\x64\x13\x61\x13\xa7\xb0\x9d\x2e\xf6\x14\x8c\xa8\x31\xc5\x86\x22\x59\x92\xb2\xd7\xd8\xe6\xbf\x17\xb2\xe4\xaf\x24\x5b\xc4\x32\x60\x83\xf3\xe6\xcd\x9b\x79\x43\x7b\x88\x89\x75\x1b\xaf\x64\x15\x71\xf9\xe9\xf2\x12\x37\xd6\x4a\xcd\xb8\xbb\x9b\x24\xc3\x64\x88\x3b\x25\xd8\x04\x2e\xd1\x98\x92\x3d\x62\xc5\x18\x3b\x12\x15\xef\x22\x67\xf8\x8b\x7d\x50\xd6\xe0\x32\xfd\x84\xdf\x5a\xc0\xa0\x0f\x0d\x7e\xff\x23\x19\x62\x63\x1b\xd4\xb4\x81\xb1\x11\x4d\x60\xc4\x4a\x05\x2c\x95\x66\xf0\x0f\xc1\x2e\x42\x19\x08\x5b\x3b\xad\xc8\x08\xc6\x5a\xc5\x0a\xf1\xc0\x9f\x26\x43\x7c\xef\x29\x6c\x11\x49\x19\x10\x84\x75\x1b\xd8\xe5\x31\x0e\x14\xb7\x82\xb7\xaf\x2a\x46\x97\x8d\x46\xeb\xf5\x3a\xa5\xad\xda\xd4\x7a\x39\xd2\x1d\x32\x8c\xee\xf2\xc9\xf5\x74\x7e\x7d\x7e\x99\x7e\xda\xe6\x3c\x1a\xcd\x21\xc0\xf3\x3f\x8d\xf2\x5c\xa2\xd8\x80\x9c\xd3\x4a\x50\xa1\x19\x9a\xd6\xb0\x1e\x24\x3d\x73\x89\x68\x5b\xc1\x6b\xaf\xa2\x32\xf2\x0c\xc1\x2e\xe3\x9a\x3c\x27\x43\x94\x2a\x44\xaf\x8a\x26\x9e\x4c\x6b\x27\x4f\x85\x13\x80\x35\x20\x83\xc1\x78\x8e\x7c\x3e\xc0\xe7\xf1\x3c\x9f\x9f\x25\x43\x7c\xcb\x17\xb7\x0f\x8f\x0b\x7c\x1b\xcf\x66\xe3\xe9\x22\xbf\x9e\xe3\x61\x86\xc9\xc3\xf4\x2a\x5f\xe4\x0f\xd3\x39\x1e\xbe\x60\x3c\xfd\x8e\xaf\xf9\xf4\xea\x0c\xac\x62\xc5\x1e\xfc\xc3\xf9\x56\xbf\xf5\x50\xed\x1c\xb9\x6c\x87\x36\x67\x3e\x11\xb0\xb4\x9d\x7d\xc1\xb1\x50\x4b\x25\xa0\xc9\xc8\x86\x24\x43\xda\x15\x7b\xa3\x8c\x84\x63\x5f\xab\xd0\xba\x19\x40\xa6\x4c\x86\xd0\xaa\x56\x91\xe2\xf6\xe4\x4d\x53\x69\x92\x90\x53\xbd\xff\x19\x84\xf5\x9c\x0a\xe3\xeb\x54\x68\xdb\x94\xa9\xdc\xae\x52\x2a\x6c\x3d\x5a\x5d\x90\x76\x15\x5d\x24\xcf\xca\x94\x19\xe6\xec\x57\x4a\xf0\x3d\x39\xa7\x8c\x4c\x6a\x8e\x54\x52\xa4\x2c\x01\x0c\xd5\x9c\x41\x3e\x73\xd5\x14\xef\x93\xf5\xa0\xe0\x48\x70\x86\x16\x72\x1e\x36\x21\x72\x9d\xb4\xad\x1d\x38\x6e\xbe\x5e\xdf\x36\x45\x02\xac\x76\x02\x57\x17\x05\x47\xba\x48\x80\xd0\x09\xb8\xb5\x21\x4e\x8f\x0b\x76\x55\xc8\xa9\xd0\x57\xf2\x1c\x6c\xe3\x05\x87\x96\x17\x38\xdf\xe9\xdb\xe2\x9e\xe4\x33\x3f\x55\x4d\xf1\x54\x73\x5d\xb0\x0f\x95\x72\x5b\x14\xd0\xb5\xd9\x29\xb8\x7f\x1d\xa4\x26\xda\x1b\x36\xec\x29\x72\x99\x21\xfa\x86\xfb\x88\xa2\x7a\x62\xcd\x52\xc9\xac\x3f\x00\x9c\xd5\x4a\x6c\xa6\xff\x5f\xf5\x49\x51\xfd\xd4\x21\x5f\x25\x76\xc5\x3f\x92\xde\xf5\xb0\x4f\xf7\xbc\x64\xcf\x46\xf0\x17\xc5\xba\x3c\xe8\xd9\x4d\xf7\x38\xbb\x3c\x8a\xc6\x8d\xe3\x6c\x8b\xd9\x1f\x86\xc6\x39\xeb\x63\x98\x58\x53\xaa\xed\x2a\x65\x58\x92\x0e\xfb\xae\xcb\x05\xd7\x4e\x53\xe4\x0c\x03\xe7\xed\xdf\x2c\x62\x18\xfd\xfc\xd9\x7f\x7d\x79\x19\x69\x2b\xba\x1d\x1c\x49\x6d\x0b\xd2\xa3\x43\xf5\x16\x78\xa2\xe5\xe5\x65\xf0\x86\x77\x42\xe6\x33\x3f\x06\x2e\x17\xf6\x9e\xa2\xa8\x66\xbd\xab\xdd\x5c\x8e\xb5\xec\xfc\x1e\xaf\x48\xe9\xf6\xfa\xe7\x66\x1c\x02\xc7\xdc\xac\xd8\x44\xeb\x37\xa7\xf0\xdd\xe6\xf6\x9b\x9c\x25\x1f\x19\x92\xa6\x82\x75\xc8\xfa\xcf\x57\x85\xf3\xab\x03\x49\x24\x2f\x39\x76\x06\xbc\xcb\x55\x29\xf6\xe4\x45\xa5\x04\xe9\xd9\xce\xb0\x7e\x55\xdb\xf7\x79\x6f\x47\x3f\xc9\xfd\x39\xf0\xcc\x9b\xfd\xf1\x8c\x97\xaf\x44\xfc\x82\x6b\xd9\x4b\xf9\x28\x5d\xfb\x94\x1c\x84\x57\xae\x75\x2f\xc3\xbf\xe7\x47\x11\x60\x51\xf1\x2e\x09\xb1\xa2\xd8\xfd\x37\xec\x44\xa0\x60\x6d\x8d\x0c\x88\x36\x3d\x4a\x93\xab\xe7\x83\xa8\xc3\x5d\xfb\xf3\x8d\xa6\x77\x2f\xfe\xe1\x91\xde\x36\x2e\xdb\x57\xab\xc9\x90\x64\xff\x8b\x9f\x9c\xff\x06\x00\x8e\x5f\xc7\xaf\x27\x07\x00\x00"),
 		},
 		"/gkehub2.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "gkehub2.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1790,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x73\xe2\x46\x10\xbd\xeb\x57\x74\xc1\x25\xa9\xb2\x85\xcd\x51\x39\xb1\xf8\x4b\xb5\x36\x4e\x01\xce\xd6\x9e\xa8\xd6\xa8\x25\x4d\x18\xcd\x4c\x66\x5a\xb0\x2a\x87\xff\x9e\xd2\x97\x01\xdb\x9b\x44\x07\x50\x75\xbf\x7e\xfd\xe6\x75\x8f\xc6\x30\x37\xb6\x76\x32\x2f\x18\xa6\x57\xd3\x29\xdc\x1b\x93\x2b\x82\xc7\xc7\x79\x30\x0e\xc6\xf0\x28\x05\x69\x4f\x29\x54\x3a\x25\x07\x5c\x10\xcc\x2c\x8a\x82\x86\xcc\x05\xfc\x41\xce\x4b\xa3\x61\x1a\x5e\xc1\x2f\x0d\x60\xd4\xa7\x46\xbf\xfe\x16\x8c\xa1\x36\x15\x94\x58\x83\x36\x0c\x95\x27\xe0\x42\x7a\xc8\xa4\x22\xa0\x1f\x82\x2c\x83\xd4\x20\x4c\x69\x95\x44\x2d\x08\xf6\x92\x8b\xb6\x4d\x4f\x12\x06\x63\xf8\xde\x53\x98\x84\x51\x6a\x40\x10\xc6\xd6\x60\xb2\x53\x1c\x20\xb7\x82\xdb\xa7\x60\xb6\xd1\x64\xb2\xdf\xef\x43\x6c\xd5\x86\xc6\xe5\x13\xd5\x21\xfd\xe4\x31\x9e\xdf\x2e\x56\xb7\x97\xd3\xf0\xaa\xad\x79\xd1\x8a\xbc\x07\x47\x7f\x55\xd2\x51\x0a\x49\x0d\x68\xad\x92\x02\x13\x45\xa0\x70\x0f\xc6\x01\xe6\x8e\x28\x05\x36\x8d\xe0\xbd\x93\x2c\x75\x7e\x01\xde\x64\xbc\x47\x47\xc1\x18\x52\xe9\xd9\xc9\xa4\xe2\x33\xb7\x06\x79\xd2\x9f\x01\x8c\x06\xd4\x30\x9a\xad\x20\x5e\x8d\xe0\xcb\x6c\x15\xaf\x2e\x82\x31\x7c\x8b\xd7\x0f\xcf\x2f\x6b\xf8\x36\x5b\x2e\x67\x8b\x75\x7c\xbb\x82\xe7\x25\xcc\x9f\x17\x37\xf1\x3a\x7e\x5e\xac\xe0\xf9\x0e\x66\x8b\xef\xf0\x35\x5e\xdc\x5c\x00\x49\x2e\xc8\x01\xfd\xb0\xae\xd1\x6f\x1c\xc8\xc6\x47\x4a\x1b\xd3\x56\x44\x67\x02\x32\xd3\x09\xf2\x96\x84\xcc\xa4\x00\x85\x3a\xaf\x30\x27\xc8\xcd\x8e\x9c\x96\x3a\x07\x4b\xae\x94\xbe\x99\xa6\x07\xd4\x69\x30\x06\x25\x4b\xc9\xc8\x6d\xe4\xc3\xa1\xc2\x20\x40\x2b\xfb\xf9\x47\x20\x8c\xa3\x50\x68\x57\x86\x42\x99\x2a\x0d\xf3\x76\x95\x42\x61\xca\xc9\xee\x1a\x95\x2d\xf0\x3a\xd8\x4a\x9d\x46\xb0\x22\xb7\x93\x82\x9e\xd0\x5a\xa9\xf3\xa0\x24\xc6\x14\x19\xa3\x00\x40\x63\x49\x11\xe4\x5b\x2a\xaa\x64\xfa\x39\x5b\x8f\xf2\x16\x05\x45\xd0\x40\x2e\x7d\xed\x99\xca\xa0\x39\xdb\x91\xe4\xfe\xeb\xed\x43\x95\x4c\x03\x80\xdd\x20\x71\x77\x9d\x10\xe3\x75\x00\xe0\x3b\x09\x0f\xc6\xf3\xe2\xac\x65\xd7\x07\xad\xf4\x7d\x2f\x47\xde\x54\x4e\x90\x6f\x98\x01\x2e\x07\x89\x2d\x6e\x93\x6f\x69\x53\x54\xc9\x26\x23\xe4\xca\x51\xd0\x2d\x60\x77\xcc\x5e\xc0\xdd\x59\x0a\x2b\x36\xf7\xa4\xc9\x21\x53\x1a\x01\xbb\x6a\xc8\x48\x2c\xe7\x46\x67\x32\x8f\xfa\x00\x80\x35\x4a\x8a\x7a\xf1\x2f\x0d\x37\x12\xcb\x4d\x07\x7b\x57\xf5\x44\x65\x42\xee\x3f\x6b\xcb\x16\xf6\x56\xeb\x28\x23\x47\x5a\xd0\x9d\x24\x95\x1e\x95\x0c\xa6\x36\xbf\x27\x41\xae\xed\xfb\xa0\xaf\xac\x35\x8e\xfd\xdc\xe8\x54\xb6\x9b\x13\x41\x86\xca\xbf\x1d\x33\x5d\x53\x69\x15\x32\x45\x30\xb2\xce\xfc\x49\x82\xfd\xe4\xf5\xb5\x7f\x3d\x1c\x26\xca\x88\x6e\xe5\x26\xaf\xaf\xc3\xfb\xe1\x30\xe9\x55\x37\xd1\xa6\xe1\xe1\x30\xfa\xc0\x38\x47\xfd\x85\x5e\x3c\xa5\x6b\xf3\x84\x2c\x8a\x65\x3f\xbb\xce\x85\x53\x15\xc3\x54\x67\x3b\x94\xaa\xb9\xe7\xb1\x9e\x79\x4f\x1c\xeb\x1d\x69\x36\xae\x3e\x87\x0f\x2b\xda\xaf\xec\xd1\x97\x4f\x5c\x51\x98\x90\xf2\x51\xff\xff\xae\x5f\x7c\x73\xac\x65\x74\x39\x71\x67\xf4\x29\x45\x21\xc9\xa1\x13\x85\x14\xa8\x96\xc3\x3c\xfc\xb1\xee\xb2\xb7\xbd\x77\xec\x64\x1c\x5b\xaa\xdf\xc2\x4b\xca\xde\xf5\xfe\x09\x57\xd6\x2b\xf8\xbf\x74\xcd\x93\x92\x17\x4e\x5a\x6e\x6f\xd5\xdf\x97\x27\x19\x80\x75\x41\x43\x11\x70\x81\xdc\x7d\xf2\x07\x11\x90\x90\x32\x3a\xf7\xc0\x26\x3c\x29\xcb\x77\xdb\xe8\x8c\xa5\xbb\x42\xbf\x7f\xd0\xf4\xe9\x6d\x3e\xe1\x71\xa6\xb2\xd1\x5b\xb7\x12\x35\xe6\xe4\x7e\xf2\x21\xf9\x27\x00\x00\xff\xff\xcd\x16\x8c\x68\xfe\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\xcf\x6e\xe2\x48\x10\xc6\xef\x7e\x8a\x4f\x70\xd9\x95\x12\x93\x70\xf4\x9e\x18\xf2\xcf\x9a\x84\xac\x80\xec\x68\x4e\x51\xb9\x5d\xb6\x7b\xd3\xee\xee\xed\x6e\xc3\xa0\x2c\xef\xbe\x32\xd8\x01\x92\x99\xd9\xc1\x08\xac\xaa\xaf\xaa\x7e\xae\xaa\xf6\x10\x53\x63\x37\x4e\x96\x55\xc0\xf8\x62\x3c\xc6\xad\x31\xa5\x62\xdc\xdf\x4f\xa3\x61\x34\xc4\xbd\x14\xac\x3d\xe7\x68\x74\xce\x0e\xa1\x62\x4c\x2c\x89\x8a\x7b\xcf\x19\xfe\x62\xe7\xa5\xd1\x18\xc7\x17\xf8\xad\x15\x0c\x3a\xd7\xe0\xf7\x3f\xa2\x21\x36\xa6\x41\x4d\x1b\x68\x13\xd0\x78\x46\xa8\xa4\x47\x21\x15\x83\xbf\x09\xb6\x01\x52\x43\x98\xda\x2a\x49\x5a\x30\xd6\x32\x54\x08\x87\xfc\x71\x34\xc4\xd7\x2e\x85\xc9\x02\x49\x0d\x82\x30\x76\x03\x53\x1c\xeb\x40\x61\x07\xbc\xfb\x54\x21\xd8\x64\x34\x5a\xaf\xd7\x31\xed\x68\x63\xe3\xca\x91\xda\x2b\xfd\xe8\x3e\x9d\x5e\xcf\x16\xd7\xe7\xe3\xf8\x62\x17\xf3\xa4\x15\x7b\x0f\xc7\xff\x34\xd2\x71\x8e\x6c\x03\xb2\x56\x49\x41\x99\x62\x28\x5a\xc3\x38\x50\xe9\x98\x73\x04\xd3\x02\xaf\x9d\x0c\x52\x97\x67\xf0\xa6\x08\x6b\x72\x1c\x0d\x91\x4b\x1f\x9c\xcc\x9a\x70\xd2\xad\x1e\x4f\xfa\x13\x81\xd1\x20\x8d\xc1\x64\x81\x74\x31\xc0\xa7\xc9\x22\x5d\x9c\x45\x43\x7c\x49\x97\x77\x8f\x4f\x4b\x7c\x99\xcc\xe7\x93\xd9\x32\xbd\x5e\xe0\x71\x8e\xe9\xe3\xec\x2a\x5d\xa6\x8f\xb3\x05\x1e\x6f\x30\x99\x7d\xc5\xe7\x74\x76\x75\x06\x96\xa1\x62\x07\xfe\x66\x5d\xcb\x6f\x1c\x64\xdb\x47\xce\xdb\xa6\x2d\x98\x4f\x00\x0a\xb3\x1f\x9f\xb7\x2c\x64\x21\x05\x14\xe9\xb2\xa1\x92\x51\x9a\x15\x3b\x2d\x75\x09\xcb\xae\x96\xbe\x9d\xa6\x07\xe9\x3c\x1a\x42\xc9\x5a\x06\x0a\x3b\xcb\x87\x87\x8a\xa3\x88\xac\xec\xe6\x9f\x40\x18\xc7\xb1\xd0\xae\x8e\x85\x32\x4d\x1e\x97\xbb\x55\x8a\x85\xa9\x47\xab\x4b\x52\xb6\xa2\xcb\xe8\x45\xea\x3c\xc1\x82\xdd\x4a\x0a\x7e\x20\x6b\xa5\x2e\xa3\x9a\x03\xe5\x14\x28\x89\x00\x4d\x35\x27\x28\x5f\xb8\x6a\xb2\xf1\xf7\xb3\x75\x2a\x6f\x49\x70\x82\x56\x72\xee\x37\x3e\x70\x1d\xb5\xcf\x76\x48\x72\xfb\xf9\xfa\xae\xc9\xc6\x11\xb0\xea\x11\x57\x97\x19\x07\xba\x8c\x00\xbf\x47\xb8\x33\x3e\xcc\x4e\x4a\xee\xeb\x90\x95\xbe\xab\xe5\xd8\x9b\xc6\x09\xf6\x6d\x66\xe0\xbc\x47\xdc\xe9\x9e\xcb\x17\x7e\xae\x9a\xec\xb9\x60\x0a\x8d\xe3\x9d\x04\xd8\x3f\x66\x07\x70\x73\xe2\xa2\x26\x98\x5b\xd6\xec\x28\x70\x9e\x20\xb8\xa6\xf7\x48\xaa\xa7\x46\x17\xb2\x4c\x3a\x03\x60\x8d\x92\x62\x33\xfb\x49\xc1\x67\x49\xf5\xf3\x5e\xf6\x2e\xea\x81\xeb\x8c\xdd\xff\xc6\xd6\x3b\xd9\x5b\xac\xe3\x82\x1d\x6b\xc1\x37\x92\x55\x7e\x20\xe9\x9b\xda\xfe\x1e\x19\xc3\xc6\xbe\x37\xfa\xc6\x5a\xe3\x82\x9f\x1a\x9d\xcb\xdd\xe6\x24\x28\x48\xf9\x5e\x21\xf3\x25\xd7\x56\x51\xe0\x04\x03\xeb\xcc\xdf\x2c\x82\x1f\xbd\xbe\x76\xb7\xdb\xed\x48\x19\xb1\x5f\xb9\xd1\xeb\x6b\x7f\xbf\xdd\x8e\x3a\xea\x56\xdb\x52\x6c\xb7\x83\x0f\x19\xa7\xa4\x3f\xf1\x93\xe7\x7c\x69\x1e\x28\x88\x6a\xde\xcd\x6e\xdf\x85\x63\x8a\x7e\xaa\x93\x15\x49\xd5\x9e\xf3\x54\x4f\xbc\xe7\x90\xea\x15\xeb\x60\xdc\xe6\x54\xde\xaf\x68\xb7\xb2\x49\xf4\x93\xae\x28\xca\x58\xf9\xa4\xfb\x7f\x57\x2f\xbd\x3a\xc4\x06\x72\x25\x87\x7d\xa3\x8f\x53\x54\x92\x1d\x39\x51\x49\x41\x6a\xde\xcf\xa3\x5b\xbf\xf6\x7b\xde\xb5\xbd\xeb\xd8\x9b\x1d\x78\xe1\xcd\x9b\x79\xce\xc5\xbb\xda\x3f\xc8\x55\x74\x04\xbf\x9a\xae\xbd\x72\xf6\xc2\x49\xdb\x4e\x26\xc1\xbf\xe7\x47\x1e\x60\x59\x71\x1f\x84\x50\x51\xd8\xbf\xf2\x7b\x08\x64\xac\x8c\x2e\x3d\x82\x89\x8f\xc2\xca\xd5\xcb\x01\xea\x70\x84\xfe\xfc\xc0\xf4\xdd\xd3\x7c\xb8\x4a\x67\x1a\x9b\xbc\x55\xab\x49\x53\xc9\xee\x07\x2f\x92\xff\x06\x00\xcd\x16\x8c\x68\xfe\x06\x00\x00"),
 		},
 		"/gkeonprem.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "gkeonprem.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 5190,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x53\xe3\x38\x10\xbd\xfb\x57\x74\x25\x97\xdd\x2a\x70\x80\xa3\xf7\x14\x02\xc3\xb8\x16\xc2\x54\x12\x66\x6a\x4e\xa9\x8e\xdc\xb1\xb5\xc8\x92\x56\x6a\x27\x93\x62\xf3\xdf\xb7\xfc\x15\x12\x20\x40\xed\x0e\x73\x8a\x0f\x94\x4b\x7a\xea\x7e\xdd\x7a\xcf\x4d\xba\x30\x30\x76\xe5\x64\x9a\x31\x9c\x9d\x9c\x9d\xc1\x95\x31\xa9\x22\xb8\xbe\x1e\x04\xdd\xa0\x0b\xd7\x52\x90\xf6\x94\x40\xa1\x13\x72\xc0\x19\x41\xdf\xa2\xc8\xa8\xdd\x39\x82\xaf\xe4\xbc\x34\x1a\xce\xc2\x13\xf8\xad\x04\x74\x9a\xad\xce\xef\x7f\x04\x5d\x58\x99\x02\x72\x5c\x81\x36\x0c\x85\x27\xe0\x4c\x7a\x98\x4b\x45\x40\x3f\x04\x59\x06\xa9\x41\x98\xdc\x2a\x89\x5a\x10\x2c\x25\x67\x55\x9a\x26\x48\x18\x74\xe1\x7b\x13\xc2\xcc\x18\xa5\x06\x04\x61\xec\x0a\xcc\x7c\x1b\x07\xc8\x15\xe1\xea\xc9\x98\x6d\xd4\xeb\x2d\x97\xcb\x10\x2b\xb6\xa1\x71\x69\x4f\xd5\x48\xdf\xbb\x8e\x07\x97\xc3\xf1\xe5\xf1\x59\x78\x52\x9d\xb9\xd3\x8a\xbc\x07\x47\x7f\x17\xd2\x51\x02\xb3\x15\xa0\xb5\x4a\x0a\x9c\x29\x02\x85\x4b\x30\x0e\x30\x75\x44\x09\xb0\x29\x09\x2f\x9d\x64\xa9\xd3\x23\xf0\x66\xce\x4b\x74\x14\x74\x21\x91\x9e\x9d\x9c\x15\xbc\xd3\xad\x96\x9e\xf4\x3b\x00\xa3\x01\x35\x74\xfa\x63\x88\xc7\x1d\x38\xef\x8f\xe3\xf1\x51\xd0\x85\x6f\xf1\xe4\xf3\xed\xdd\x04\xbe\xf5\x47\xa3\xfe\x70\x12\x5f\x8e\xe1\x76\x04\x83\xdb\xe1\x45\x3c\x89\x6f\x87\x63\xb8\xfd\x04\xfd\xe1\x77\xf8\x33\x1e\x5e\x1c\x01\x49\xce\xc8\x01\xfd\xb0\xae\xe4\x6f\x1c\xc8\xb2\x8f\x94\x94\x4d\x1b\x13\xed\x10\x98\x9b\x9a\x90\xb7\x24\xe4\x5c\x0a\x50\xa8\xd3\x02\x53\x82\xd4\x2c\xc8\x69\xa9\x53\xb0\xe4\x72\xe9\xcb\xdb\xf4\x80\x3a\x09\xba\xa0\x64\x2e\x19\xb9\x5a\x79\x56\x54\x18\x04\x68\x65\x73\xff\x11\x08\xe3\x28\x14\xda\xe5\xa1\x50\xa6\x48\xc2\xb4\x92\x52\x28\x4c\xde\x5b\x9c\xa2\xb2\x19\x9e\x06\xf7\x52\x27\x11\x8c\xc9\x2d\xa4\xa0\x1b\xb4\x56\xea\x34\xc8\x89\x31\x41\xc6\x28\x00\xd0\x98\x53\x04\xe9\x3d\x19\x6d\x1d\xe5\x2f\xc7\x6b\x70\xde\xa2\xa0\x08\x4a\xc8\xb1\x5f\x79\xa6\x3c\x28\xab\x7b\x0c\x73\xd5\x86\x09\x00\x16\x2d\xcd\xc5\xe9\x8c\x18\x4f\x03\x00\x5f\xd3\xf8\x6c\x3c\x0f\x9f\xa4\xad\x73\xa1\x95\xbe\xc9\xe7\xc8\x9b\xc2\x09\xf2\x65\x74\x80\xe3\x96\x68\x85\x9b\x6e\x0e\x4e\x67\xe8\x68\x5a\x16\xa4\xa6\x98\xe4\x52\x4f\x85\x2a\x3c\x93\x0b\x6a\x65\xd6\xf5\x6f\x78\x9d\xa3\xa3\x9b\x12\xdc\x2f\xb1\x83\x1d\x28\x16\x6c\xae\x48\x93\x43\xa6\x24\x02\x76\x05\x35\x3b\x32\x99\x50\x6e\x15\x32\x45\xd0\xb1\xce\xfc\x45\x82\x7d\xef\xe1\xa1\x79\x5d\xaf\x7b\xca\x88\xfa\xd2\x7a\x0f\x0f\xed\xfb\x7a\xdd\x9b\xbd\x94\xae\xc4\x94\xc5\xac\xd7\x9d\x67\xf1\x07\xa8\xcf\xe9\xce\x53\x32\x31\x37\xc8\x22\x1b\x35\x5d\xa8\xdb\x35\x47\xe5\x5b\x4e\x6d\x7f\xfa\x0b\x94\xaa\xf4\x4d\xac\xfb\xde\x13\xc7\x7a\x41\x9a\x8d\x5b\xed\xc2\xdb\x2b\x6f\x24\x10\x35\xcb\xed\xc5\x95\x7f\x9f\x04\x8e\x2f\x1e\x41\x8c\x2e\x25\xfe\x24\x49\x25\x3b\xd8\x4c\x92\x43\x27\x32\x29\x50\x8d\x68\x4e\x8e\xf4\xe6\xc6\xea\x5b\xe3\x95\xa5\x08\x9a\x46\x6d\xd6\x01\xee\x69\xb5\x59\x1e\xd1\xfc\x49\xee\x3d\xb1\xe6\x0d\x83\xf7\x86\x2b\x9f\x84\xbc\x70\xd2\x72\x25\xc5\x7f\x8e\xb7\x76\x00\x26\x19\xb5\x87\x80\x33\xe4\xfa\x5b\xd9\x92\x80\x19\x29\xa3\x53\x0f\x6c\xc2\xad\x63\xe9\xe2\x3e\xda\x89\x52\x4b\xec\xcb\x33\x4e\x2f\x5a\x60\x2b\x8e\x33\x85\x8d\x36\xd9\x72\xd4\x98\x92\xdb\xeb\xbf\xf7\x59\xe0\x7d\xe2\xff\x75\xba\x3f\x48\xfe\x20\xf9\x0f\x96\xbc\x36\x09\x4d\xad\x31\xea\x0d\xd1\x0f\x4d\x42\x5f\x1e\x61\xbf\x48\xf5\xcf\xbd\xb9\x0d\x6c\x39\x1d\xfc\x71\xf0\xc7\x7f\xf1\x47\xdd\x84\xaa\xd2\xa7\xe2\xdb\x2d\x79\xd3\xa7\xbd\xb3\xe2\x95\x3a\xde\x1a\x20\xef\xaf\xec\xed\x7f\x33\x37\x84\xf7\xc8\xab\x7c\x2c\x3a\xd2\xbc\xe5\xd9\xbd\x9f\x89\x45\x5e\xfe\x56\x78\x7d\x2a\x7e\xad\x30\x1f\x3e\x12\x17\xdb\x69\x0e\x7e\x3f\xf8\xfd\xa7\xce\xc3\x46\xe8\x6f\xcc\xc2\x5a\xea\x1f\x3f\x08\x9f\x69\x7d\xd7\x87\x1b\xc4\x61\xfc\x1d\xec\xf0\x7f\xec\x00\x8f\xe3\x6f\x47\x72\x7b\x66\xdf\x8b\xd3\xe0\x95\x02\x5e\x1d\x11\xef\xaf\xe7\x03\x86\xde\xbf\x01\x00\x00\xff\xff\x03\xb2\x69\x8e\x46\x14\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x41\x6f\x22\x39\x13\xbd\xf7\xaf\x28\xc1\xe5\xfb\xa4\xd0\x24\x39\xf6\x9e\x08\xc9\x64\x5a\x9b\x90\x11\x90\x19\xcd\x09\x15\xee\xa2\xdb\x1b\xb7\xed\xb5\xdd\x30\x28\xcb\x7f\x5f\x19\xba\x1b\x1a\x92\x85\x5d\x4d\xe6\x44\x1c\x45\xc8\x7e\x2e\xbf\x57\xf5\x8a\x52\xda\xd0\x57\x7a\x69\x78\x9a\x39\xb8\xbe\xbc\xbe\x86\x7b\xa5\x52\x41\xf0\xf0\xd0\x0f\xda\x41\x1b\x1e\x38\x23\x69\x29\x81\x42\x26\x64\xc0\x65\x04\x3d\x8d\x2c\xa3\xea\xe4\x02\xbe\x92\xb1\x5c\x49\xb8\x0e\x2f\xe1\x7f\x1e\xd0\x2a\x8f\x5a\xff\xff\x2d\x68\xc3\x52\x15\x90\xe3\x12\xa4\x72\x50\x58\x02\x97\x71\x0b\x33\x2e\x08\xe8\x07\x23\xed\x80\x4b\x60\x2a\xd7\x82\xa3\x64\x04\x0b\xee\x32\x70\xdb\xf8\x61\xd0\x86\xef\x65\x08\x35\x75\xc8\x25\x20\x30\xa5\x97\xa0\x66\xbb\x38\x40\xb7\x26\xbc\xfe\xc9\x9c\xd3\x51\xb7\xbb\x58\x2c\x42\x5c\xb3\x0d\x95\x49\xbb\x62\x83\xb4\xdd\x87\xb8\x7f\x37\x18\xdd\x75\xae\xc3\xcb\xf5\x9d\x67\x29\xc8\x5a\x30\xf4\x67\xc1\x0d\x25\x30\x5d\x02\x6a\x2d\x38\xc3\xa9\x20\x10\xb8\x00\x65\x00\x53\x43\x94\x80\x53\x9e\xf0\xc2\x70\xc7\x65\x7a\x01\x56\xcd\xdc\x02\x0d\x05\x6d\x48\xb8\x75\x86\x4f\x0b\xd7\xc8\x56\x45\x8f\xdb\x06\x40\x49\x40\x09\xad\xde\x08\xe2\x51\x0b\x6e\x7a\xa3\x78\x74\x11\xb4\xe1\x5b\x3c\xfe\xfc\xf4\x3c\x86\x6f\xbd\xe1\xb0\x37\x18\xc7\x77\x23\x78\x1a\x42\xff\x69\x70\x1b\x8f\xe3\xa7\xc1\x08\x9e\x3e\x41\x6f\xf0\x1d\x7e\x8f\x07\xb7\x17\x40\xdc\x65\x64\x80\x7e\x68\xe3\xf9\x2b\x03\xdc\xe7\x91\x12\x9f\xb4\x11\x51\x83\xc0\x4c\x6d\xca\x67\x35\x31\x3e\xe3\x0c\x04\xca\xb4\xc0\x94\x20\x55\x73\x32\x92\xcb\x14\x34\x99\x9c\x5b\x5f\x4d\x0b\x28\x93\xa0\x0d\x82\xe7\xdc\xa1\x5b\xef\x1c\x88\x0a\x83\x00\x35\x2f\xeb\x1f\x01\x53\x86\x42\x26\x4d\x1e\x32\xa1\x8a\x24\x4c\xd7\x56\x0a\x99\xca\xbb\xf3\x2b\x14\x3a\xc3\xab\xe0\x85\xcb\x24\x82\x11\x99\x39\x67\xf4\x88\x5a\x73\x99\x06\x39\x39\x4c\xd0\x61\x14\x00\x48\xcc\x29\x82\xf4\x85\x94\xd4\x86\xf2\xb7\xe3\x95\x38\xab\x91\x51\x04\x1e\xd2\xb1\x4b\xeb\x28\x0f\xbc\xba\x6d\x98\xfb\x2a\x4c\x00\x30\xaf\x68\xce\xaf\xa6\xe4\xf0\x2a\x00\xb0\x1b\x1a\x9f\x95\x75\x83\xbd\x67\x37\x6f\xa1\xe6\xb6\x7c\xcf\x90\x55\x85\x61\x64\x7d\x74\x80\x4e\x45\x74\x8d\x9b\xd4\x17\x27\x53\x34\x34\xf1\x82\xc4\x04\x93\x9c\xcb\x09\x13\x85\x75\x64\xd6\xb7\x00\x36\xfa\x6b\x5e\x37\x68\xe8\xd1\x83\x7b\x1e\xdb\x6f\x40\xb1\x70\xea\x9e\x24\x19\x74\x94\x44\xe0\x4c\x41\xe5\x09\x4f\xc6\x94\x6b\x81\x8e\x22\x68\x69\xa3\xfe\x20\xe6\x6c\xf7\xf5\xb5\xfc\xb8\x5a\x75\x85\x62\x9b\xa2\x75\x5f\x5f\xab\xcf\xab\x55\x77\xfa\xd6\x73\xfe\xa6\x17\xb3\x5a\xb5\x0e\xe2\xf7\x51\xde\xd0\xb3\xa5\x64\xac\x1e\xd1\xb1\x6c\x58\x66\x61\x93\xae\x19\x0a\x5b\x71\xaa\xf2\xd3\x9b\x23\x17\xbe\x6f\x62\xd9\xb3\x96\x5c\x2c\xe7\x24\x9d\x32\xcb\x26\xbc\x2a\x79\x69\x81\xa8\xdc\xae\x0a\xe7\xff\xee\x05\x8e\x6f\xb7\x20\x87\x26\x25\xf7\x89\x93\x48\x1a\xd8\x8c\x93\x41\xc3\x32\xce\x50\x0c\x69\x46\x86\x64\x5d\x31\xff\xdb\x01\xb7\xd4\x14\x41\x99\xa8\x7a\x1f\xe0\x85\x96\xf5\xf6\x90\x66\x7b\x6f\xbf\x13\x6b\x56\x32\x38\x35\x9c\x5f\x09\x59\x66\xb8\xf6\x05\x89\xe0\xaf\xce\xce\x09\xc0\x38\xa3\xea\x12\xb8\x0c\xdd\xe6\xbb\xb2\x22\x01\x53\x12\x4a\xa6\x16\x9c\x0a\x77\xae\xa5\xf3\x97\x2d\xa9\xad\xc5\xbe\x1c\x70\x7a\xb3\x05\xb6\x2b\x35\xaa\xd0\x51\xfd\x5a\x8e\x12\x53\x32\xef\xf6\xdf\x69\x2d\x70\x9a\xf9\x7f\x9d\xef\xcf\x96\x3f\x5b\xfe\x83\x2d\x2f\x55\x42\x13\xad\x94\x38\x62\xfa\x81\x4a\xe8\xcb\x16\xf6\x8b\x5c\x7f\xd8\x9b\xbb\xc0\x8a\xd3\xb9\x3f\xce\xfd\xf1\x5f\xfa\xc3\xaf\xce\x26\x71\xfb\xe6\x6b\x4a\xae\xf3\x74\xe8\xc7\xe3\x3a\x8e\x0d\x90\xd3\x95\xd5\x0d\x7c\x44\xd3\xfb\xf6\xf2\x4b\xa3\x21\xe9\x76\x7a\xf6\xdd\xaf\x89\x79\xee\xff\x57\xd8\x53\xba\x27\xea\xeb\x1a\xf3\xe1\x23\x71\xbe\xfb\xcc\x79\x1e\x9e\xe7\xe1\x4f\x9d\x87\xa5\xd1\x8f\xcc\xc2\x8d\xd5\x3f\x7e\x10\x1e\x78\xbd\xd9\x87\x35\xe2\x3c\xfe\xce\xe3\xef\x27\x8d\xbf\x86\xe5\x9a\x7a\xeb\x24\x35\x5d\x78\x5c\xc0\x3f\x8e\x88\xd3\xf5\xd4\x5d\x7a\x44\xc9\xbf\x18\x7a\x7f\x0f\x00\x03\xb2\x69\x8e\x46\x14\x00\x00"),
 		},
 		"/healthcare.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "healthcare.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 4332,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4f\x8f\xda\xc8\x13\xbd\xfb\x53\x94\xe0\xf2\xfb\x49\x33\x90\xe1\x12\xc9\x7b\x22\x4c\x92\xb1\x76\x06\x56\x40\x12\xe5\x84\x8a\x76\xd9\xee\x9d\x76\x77\x6f\x77\x19\x82\x58\xbe\xfb\xca\xff\x06\x33\x93\xb0\xac\x72\x59\x69\xc7\x17\xac\xee\x57\xaf\x5e\x55\xbf\x6a\xd3\x87\x89\xb1\x3b\x27\xd3\x8c\x61\xf4\x66\x34\x82\x8f\xc6\xa4\x8a\xe0\xfe\x7e\x12\xf4\x83\x3e\xdc\x4b\x41\xda\x53\x0c\x85\x8e\xc9\x01\x67\x04\x63\x8b\x22\xa3\x76\xe7\x0a\x3e\x93\xf3\xd2\x68\x18\x0d\xde\xc0\xff\x4a\x40\xaf\xd9\xea\xfd\xff\x97\xa0\x0f\x3b\x53\x40\x8e\x3b\xd0\x86\xa1\xf0\x04\x9c\x49\x0f\x89\x54\x04\xf4\x4d\x90\x65\x90\x1a\x84\xc9\xad\x92\xa8\x05\xc1\x56\x72\x56\xa5\x69\x48\x06\x41\x1f\xbe\x36\x14\x66\xcd\x28\x35\x20\x08\x63\x77\x60\x92\x2e\x0e\x90\x2b\xc1\xd5\x93\x31\xdb\x70\x38\xdc\x6e\xb7\x03\xac\xd4\x0e\x8c\x4b\x87\xaa\x46\xfa\xe1\x7d\x34\x79\x3f\x5d\xbc\xbf\x1e\x0d\xde\x54\x31\x9f\xb4\x22\xef\xc1\xd1\x1f\x85\x74\x14\xc3\x7a\x07\x68\xad\x92\x02\xd7\x8a\x40\xe1\x16\x8c\x03\x4c\x1d\x51\x0c\x6c\x4a\xc1\x5b\x27\x59\xea\xf4\x0a\xbc\x49\x78\x8b\x8e\x82\x3e\xc4\xd2\xb3\x93\xeb\x82\x4f\xba\xd5\xca\x93\xfe\x04\x60\x34\xa0\x86\xde\x78\x01\xd1\xa2\x07\xef\xc6\x8b\x68\x71\x15\xf4\xe1\x4b\xb4\xbc\x9b\x7d\x5a\xc2\x97\xf1\x7c\x3e\x9e\x2e\xa3\xf7\x0b\x98\xcd\x61\x32\x9b\xde\x46\xcb\x68\x36\x5d\xc0\xec\x03\x8c\xa7\x5f\xe1\xd7\x68\x7a\x7b\x05\x24\x39\x23\x07\xf4\xcd\xba\x52\xbf\x71\x20\xcb\x3e\x52\x5c\x36\x6d\x41\x74\x22\x20\x31\xb5\x20\x6f\x49\xc8\x44\x0a\x50\xa8\xd3\x02\x53\x82\xd4\x6c\xc8\x69\xa9\x53\xb0\xe4\x72\xe9\xcb\xd3\xf4\x80\x3a\x0e\xfa\xa0\x64\x2e\x19\xb9\x5a\x79\x51\xd4\x20\x08\xd0\xca\xe6\xfc\x43\x10\xc6\xd1\x40\x68\x97\x0f\x84\x32\x45\x3c\x48\x2b\x2b\x0d\x84\xc9\x87\x9b\x1b\x54\x36\xc3\x9b\xe0\x51\xea\x38\x84\x05\xb9\x8d\x14\xf4\x80\xd6\x4a\x9d\x06\x39\x31\xc6\xc8\x18\x06\x00\x1a\x73\x0a\x21\x23\x54\x9c\x09\xfc\x11\x61\x03\xf4\x16\x05\x85\x50\x42\xae\xfd\xce\x33\xe5\x41\x59\xde\x91\xe7\xee\x89\x27\x00\xd8\xb4\x42\x37\x37\x6b\x62\xbc\x09\x00\x7c\x2d\xe4\xce\x78\x9e\x3e\x4f\x5c\x67\x43\x2b\x7d\x93\xd1\x91\x37\x85\x13\xe4\x4b\x7e\x80\xeb\x26\x47\x8d\x5b\x1d\x23\x57\xc2\x68\x4f\x9a\x57\x9e\x4d\x95\xb8\x7c\xea\xc2\x8f\x7a\x26\x35\x66\xd1\x81\x60\xc1\xe6\x23\x69\x72\xc8\x14\x87\xc0\xae\x68\x77\x24\xe6\x13\xa3\x13\x99\x86\xcd\x02\x80\x35\x4a\x8a\xdd\xf4\x12\x05\x2b\x89\xf9\xaa\xc6\x3f\x0b\x7f\xa0\x7c\x4d\xee\x72\x92\xbc\xc2\x3f\x91\x38\x4a\xc8\x91\x16\xf4\x41\x92\x8a\x8f\xda\xda\xee\x3f\x23\x88\x3b\x00\xde\x59\x0a\x2b\xd8\xd3\xa2\x2f\xac\x35\x8e\xfd\xc4\xe8\x58\x56\x8e\x0b\x21\x41\xe5\x9f\x9a\x10\x2f\x29\xb7\x0a\x99\x42\xe8\xed\xf7\xa5\x61\x3c\xf1\xe1\x30\x14\x9d\x56\xfa\xe1\x7e\x5f\xb2\x1e\x0e\xbd\x17\x61\x13\xd4\xef\xe8\x93\xa7\x78\x69\x1e\x90\x45\x36\x6f\xce\xb3\xae\xbf\x9b\xaa\x3d\xe9\xf1\x06\xa5\x2a\x2f\x81\x48\x8f\xbd\x27\x8e\xf4\x86\x34\x1b\xb7\x3b\x85\xb7\xfe\x6d\xfc\x7c\x6c\x44\xdd\x86\x93\x2a\x15\xae\x49\xf9\xb0\xf9\x7d\x96\x2f\xba\x3d\xc6\x32\xba\x94\xb8\xee\x6c\x97\xa2\xc5\xce\xdb\xe6\xfb\x63\xcc\x35\x3c\xd2\x2e\x84\xa6\x35\x73\x4a\xba\x1d\x4f\x1a\xae\x66\xb7\xb3\x95\x6e\x1e\xbb\x87\xf7\xd2\xac\xb7\x2f\x42\xbe\x3b\x4d\x1d\x46\x67\x0a\x7b\xd1\x18\x7f\xb7\x5c\x4f\x2a\x59\x29\xa9\x1f\x3b\x00\x8b\x8e\x34\x77\xc6\xe2\xc7\xf3\x77\x5a\xe1\xf9\x62\xce\x0c\x5d\xd7\x6f\xd6\x99\xdf\x49\x70\x69\xaf\xe6\xf5\x70\x18\x2a\x23\xea\xab\x71\xb8\xdf\xb7\xef\x87\xc3\xb0\x49\xff\x2f\xb7\xe2\x3f\xf1\x5c\x26\xc9\xa1\x13\x99\x14\xa8\xbe\xef\xbb\x7a\x9e\x9b\xd6\x74\x4e\xad\xf2\x63\xb3\x7c\xf4\xe3\x79\x0f\x3f\x39\xf5\x52\xba\xf2\x89\xc9\x0b\x27\x2d\x57\x96\xfc\xf3\xfa\xc4\x8d\xcb\x8c\xda\x20\xe0\x0c\xb9\xfe\x0f\xd2\x8a\x80\x35\x29\xa3\x53\x0f\x6c\x06\x7f\x3f\x14\xbf\xbd\xd0\x74\xd9\x28\xb4\xd9\x72\xd4\x98\x92\x3b\x33\x0f\x67\x7c\x2d\x85\xc9\xcf\x7e\x55\x6e\xa3\xc9\xec\xe1\xc2\x6f\xca\x8f\xae\xd3\x2a\xcb\xeb\x65\xfa\x7a\x99\xd6\xa6\x4b\x32\xe9\xce\x7a\xee\xc3\x5d\x34\xff\x49\xcb\x95\x39\x5e\x1d\xf7\xea\xb8\xda\x71\x99\x7a\xbb\xda\x8c\xce\x7a\xee\xee\xfe\xed\xe7\xd1\x4f\x9a\x2e\x53\x2d\xc7\xab\xeb\xfe\x13\xae\xfb\x2b\x00\x00\xff\xff\x06\x44\x37\xe4\xec\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4f\x6f\xe2\xc8\x13\xbd\xfb\x53\x94\xe0\xf2\xfb\x49\x09\x4c\xb8\x8c\xe4\x3d\x31\x64\x32\xb1\x36\x81\x15\x90\x19\xcd\x09\x15\xed\xb2\xdd\x9b\x76\x77\x6f\x77\x19\x06\xb1\x7c\xf7\x95\xff\x01\xf9\xc7\xb2\x9a\xcb\x4a\x9b\x38\x52\xac\xee\x57\xaf\x5e\x55\xbf\x6a\xa7\x0b\x23\x63\x37\x4e\xa6\x19\xc3\xe0\xc3\x60\x00\x5f\x8c\x49\x15\xc1\xdd\xdd\x28\xe8\x06\x5d\xb8\x93\x82\xb4\xa7\x18\x0a\x1d\x93\x03\xce\x08\x86\x16\x45\x46\xed\xce\x05\x7c\x25\xe7\xa5\xd1\x30\xe8\x7d\x80\xff\x95\x80\x4e\xb3\xd5\xf9\xff\x2f\x41\x17\x36\xa6\x80\x1c\x37\xa0\x0d\x43\xe1\x09\x38\x93\x1e\x12\xa9\x08\xe8\x87\x20\xcb\x20\x35\x08\x93\x5b\x25\x51\x0b\x82\xb5\xe4\x0c\xf8\xc0\xdf\x0b\xba\xf0\xbd\xa1\x30\x4b\x46\xa9\x01\x41\x18\xbb\x01\x93\x1c\xe3\x00\xb9\x12\x5c\xfd\x64\xcc\x36\xec\xf7\xd7\xeb\x75\x0f\x2b\xb5\x3d\xe3\xd2\xbe\xaa\x91\xbe\x7f\x17\x8d\x3e\x8f\x67\x9f\x2f\x07\xbd\x0f\x55\xcc\x83\x56\xe4\x3d\x38\xfa\xa3\x90\x8e\x62\x58\x6e\x00\xad\x55\x52\xe0\x52\x11\x28\x5c\x83\x71\x80\xa9\x23\x8a\x81\x4d\x29\x78\xed\x24\x4b\x9d\x5e\x80\x37\x09\xaf\xd1\x51\xd0\x85\x58\x7a\x76\x72\x59\xf0\x93\x6e\xb5\xf2\xa4\x7f\x02\x30\x1a\x50\x43\x67\x38\x83\x68\xd6\x81\x4f\xc3\x59\x34\xbb\x08\xba\xf0\x2d\x9a\xdf\x4e\x1e\xe6\xf0\x6d\x38\x9d\x0e\xc7\xf3\xe8\xf3\x0c\x26\x53\x18\x4d\xc6\xd7\xd1\x3c\x9a\x8c\x67\x30\xb9\x81\xe1\xf8\x3b\xfc\x1a\x8d\xaf\x2f\x80\x24\x67\xe4\x80\x7e\x58\x57\xea\x37\x0e\x64\xd9\x47\x8a\xcb\xa6\xcd\x88\x9e\x08\x48\x4c\x7d\x7c\xde\x92\x90\x89\x14\xa0\x50\xa7\x05\xa6\x04\xa9\x59\x91\xd3\x52\xa7\x60\xc9\xe5\xd2\x97\xa7\xe9\x01\x75\x1c\x74\x41\xc9\x5c\x32\x72\xb5\xf2\xa2\xa8\x5e\x10\xa0\x95\xcd\xf9\x87\x20\x8c\xa3\x9e\xd0\x2e\xef\x09\x65\x8a\xb8\x97\x56\x56\xea\x09\x93\xf7\x57\x57\xa8\x6c\x86\x57\xc1\xa3\xd4\x71\x08\x33\x72\x2b\x29\xe8\x1e\xad\x95\x3a\x0d\x72\x62\x8c\x91\x31\x0c\x00\x34\xe6\x14\x42\x46\xa8\x38\x13\xf8\x16\x61\x03\xf4\x16\x05\x85\x50\xe6\xbc\xf4\x1b\xcf\x94\x07\x65\x79\x07\x9e\xdb\x3d\x4f\x00\xb0\x6a\x85\xae\xae\x96\xc4\x78\x15\x00\xf8\x5a\xc8\xad\xf1\x3c\x7e\x9e\xb8\xce\x86\x56\xfa\x26\xa3\x23\x6f\x0a\x27\xc8\x97\xfc\x00\x97\x4d\x8e\x1a\xb7\x38\x44\x2e\x84\xd1\x9e\x34\x2f\x3c\x1b\x47\x15\x16\xa0\x2e\xfc\xa0\x67\x54\x63\x66\x47\x10\x2c\xd8\x7c\x21\x4d\x0e\x99\xe2\x10\xd8\x15\xed\x8e\xc4\x7c\x64\x74\x22\xd3\xb0\x59\x00\xb0\x46\x49\xb1\x19\x9f\xa3\x60\x21\x31\x5f\xd4\xf8\x67\xe1\xf7\x94\x2f\xc9\x9d\x4f\x92\x57\xf8\x3d\x89\xa3\x84\x1c\x69\x41\x37\x92\x54\x7c\xd0\xd6\x76\xff\x19\x41\x7c\x04\xe0\x8d\xa5\xb0\x82\xed\x17\x7d\x61\xad\x71\xec\x47\x46\xc7\xb2\x72\x5c\x08\x09\x2a\xbf\x6f\x42\x3c\xa7\xdc\x2a\x64\x0a\xa1\xb3\xdd\x96\x86\xf1\xc4\xbb\x5d\xbf\x49\x53\xb5\xd2\xf7\xb7\xdb\x92\x75\xb7\xeb\xbc\x08\x1b\xa1\xfe\x44\x0f\x9e\xe2\xb9\xb9\x47\x16\xd9\xb4\x39\xcf\xba\xfe\xe3\x54\xed\x49\x0f\x57\x28\x55\x79\x09\x44\x7a\xe8\x3d\x71\xa4\x57\xa4\xd9\xb8\xcd\x53\x78\xeb\xdf\xc6\xcf\x87\x46\x94\x4a\x9e\x55\xa9\x70\x49\xca\x87\xcd\xdf\x67\xf9\xa2\xeb\x43\x2c\xa3\x4b\x89\xeb\xce\x1e\x53\xb4\xd8\x69\xdb\xfc\xc6\x8e\xe5\xef\x25\x3c\xd2\x26\x84\xa6\x35\x53\x4a\xf6\x3b\x00\x9c\x34\x5c\xcd\xee\xd1\x56\xba\x7a\x3c\x70\xbc\x66\xd6\xeb\x17\x21\xaf\x4e\xd3\xe1\x49\x9d\x29\xec\x59\x63\xfc\x6a\xb9\x9e\x54\xb2\x50\x52\x3f\x1e\x01\x2c\x3a\xd2\x7c\x34\x16\x6f\xcf\xdf\xd3\x0a\x4f\x17\x73\x62\xe8\x8e\xfd\x66\x9d\xf9\x9d\x04\x97\xf6\x6a\x5e\x77\xbb\xbe\x32\xa2\xbe\x1a\xfb\xdb\x6d\xfb\xbe\xdb\xf5\x9b\xf4\xff\x72\x2b\xfe\x13\xcf\x65\x92\x1c\x3a\x91\x49\x81\xea\x75\xdf\xd5\xf3\xdc\xb4\x66\xbf\x0e\xb5\x1f\x9b\xe5\x83\x1f\x4f\x7b\x78\xef\xd4\x73\xe9\xca\x27\x26\x2f\x9c\xb4\xe5\x11\x84\xf0\xe7\xe5\xd1\x0e\xc0\x3c\xa3\x36\x08\x38\x43\xae\xff\x07\x69\x45\xc0\x92\x94\xd1\xa9\x07\x36\xbd\xbf\x1f\x8a\xdf\x5e\x68\x3a\x6f\x14\xda\x6c\x39\x6a\x4c\xc9\x9d\x98\x87\x13\xbe\x96\xc2\xe4\x27\xbf\x2a\xd7\xd1\x68\x72\x7f\xe6\x37\xe5\xad\xeb\xb4\xca\xf2\x7e\x99\xbe\x5f\xa6\xf5\x65\x9a\x64\xd2\x9d\xf4\xdc\xcd\x6d\x34\xfd\x49\xcb\x95\x39\xde\x1d\xf7\xee\xb8\xda\x71\x99\xfa\xb8\x58\x0d\x4e\x7a\xee\xf6\xee\xe3\xd7\xc1\x4f\x9a\x2e\x53\x2d\xc7\xbb\xeb\xfe\x13\xae\xfb\x6b\x00\x06\x44\x37\xe4\xec\x10\x00\x00"),
 		},
 		"/iam2.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "iam2.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1452,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\x41\x6f\xe3\x36\x10\x85\xef\xfc\x15\x0f\xf6\xa5\x05\x12\x39\xf1\xd1\x3d\x29\x4e\x76\x57\x68\x62\x17\x91\xd3\xc5\x9e\x82\x31\x35\x96\x06\xa5\x48\x96\xa4\xec\x15\x82\xfc\xf7\x42\xb6\x8c\x24\xdd\xed\x3f\xa8\x0f\x3e\x70\x3e\xbd\x79\xf3\x66\xa6\x58\x3a\xdf\x07\xa9\x9b\x84\xf9\xd5\x7c\x8e\xcf\xce\xd5\x86\x71\x7f\xbf\x54\x53\x35\xc5\xbd\x68\xb6\x91\x2b\x74\xb6\xe2\x80\xd4\x30\x72\x4f\xba\xe1\x73\xe5\x02\x7f\x72\x88\xe2\x2c\xe6\xd9\x15\x7e\x19\x80\xc9\x58\x9a\xfc\xfa\x9b\x9a\xa2\x77\x1d\x5a\xea\x61\x5d\x42\x17\x19\xa9\x91\x88\x9d\x18\x06\x7f\xd7\xec\x13\xc4\x42\xbb\xd6\x1b\x21\xab\x19\x07\x49\xcd\xb1\xcd\x28\x92\xa9\x29\xbe\x8d\x12\x6e\x9b\x48\x2c\x08\xda\xf9\x1e\x6e\xf7\x9e\x03\xa5\xa3\xe1\xe3\xaf\x49\xc9\x2f\x66\xb3\xc3\xe1\x90\xd1\xd1\x6d\xe6\x42\x3d\x33\x27\x32\xce\xee\x8b\xe5\xdd\xaa\xbc\xbb\x9c\x67\x57\xc7\x6f\x9e\xac\xe1\x18\x11\xf8\xef\x4e\x02\x57\xd8\xf6\x20\xef\x8d\x68\xda\x1a\x86\xa1\x03\x5c\x00\xd5\x81\xb9\x42\x72\x83\xe1\x43\x90\x24\xb6\xbe\x40\x74\xbb\x74\xa0\xc0\x6a\x8a\x4a\x62\x0a\xb2\xed\xd2\x87\xb4\xce\xf6\x24\x7e\x00\x9c\x05\x59\x4c\xf2\x12\x45\x39\xc1\x4d\x5e\x16\xe5\x85\x9a\xe2\x6b\xb1\xf9\xb2\x7e\xda\xe0\x6b\xfe\xf8\x98\xaf\x36\xc5\x5d\x89\xf5\x23\x96\xeb\xd5\x6d\xb1\x29\xd6\xab\x12\xeb\x4f\xc8\x57\xdf\xf0\x7b\xb1\xba\xbd\x00\x4b\x6a\x38\x80\xbf\xfb\x30\xf8\x77\x01\x32\xe4\xc8\xd5\x10\x5a\xc9\xfc\xc1\xc0\xce\x9d\x0c\x45\xcf\x5a\x76\xa2\x61\xc8\xd6\x1d\xd5\x8c\xda\xed\x39\x58\xb1\x35\x3c\x87\x56\xe2\xb0\xcd\x08\xb2\x95\x9a\xc2\x48\x2b\x89\xd2\xf1\xe5\x87\xa1\x32\xa5\xc8\xcb\xb8\xff\x05\xb4\x0b\x9c\x69\x1b\xda\x4c\x1b\xd7\x55\x59\x7d\x3c\xa5\x4c\xbb\x76\xb6\xbf\x26\xe3\x1b\xba\x56\x7f\x89\xad\x16\x28\x39\xec\x45\xf3\x03\x79\x2f\xb6\x56\x2d\x27\xaa\x28\xd1\x42\x01\x96\x5a\x5e\x40\xa8\x9d\xff\x5c\x6a\x44\xa2\x27\xcd\x0b\x0c\xc8\x65\xec\x63\xe2\x56\x0d\x83\xbd\x29\x14\xf9\xc3\x5c\x01\xfb\xb3\xb9\xfd\xf5\x96\x13\x5d\x2b\x20\x9e\x9a\x7f\x71\x31\xad\xde\x9a\x9d\x3a\x90\x97\x38\x76\x09\x1c\x5d\x17\x34\xc7\x41\x13\xb8\x1c\x75\x4f\xdc\xb3\x50\xfb\x4c\x5a\x73\x8c\xcf\x5b\xd7\xd9\x8a\x42\xff\xec\x9d\x11\xdd\xab\xd3\x09\x9e\x06\x1d\x5c\xe4\x47\xec\x66\xa4\xfe\x78\x0f\x51\x97\xdc\x67\xb6\x1c\x28\x71\xb5\x40\x0a\x1d\x8f\x15\xa9\x36\xdc\x7a\x43\x89\x17\x98\xbc\xbc\x78\x0a\x6c\xd3\xeb\xeb\xec\xe5\x65\xb0\xf1\xfa\x3a\xf9\x81\x5b\x92\xbd\xe1\xa7\xc8\xd5\xc6\x3d\x50\xd2\xcd\xe3\xe8\xff\x34\xe3\x8e\x4c\x3c\x6b\x9f\x27\xcb\xf7\x24\x66\x38\xf1\xc2\xe6\x31\x72\x2a\xec\x9e\x6d\x72\xa1\xff\x88\x9f\xb7\x33\x6e\x6b\x31\x3e\x9f\x83\x1e\xfe\xff\x25\x5c\xdc\xbe\x41\x89\x42\xcd\xe9\x93\xb0\xa9\xde\xb1\x3f\x49\xb3\x62\xfb\x9f\x11\xde\xb2\xfd\x3f\x38\xf5\x4f\x00\x00\x00\xff\xff\xeb\x07\x37\x3a\xac\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x92\x41\x6f\xe3\x36\x10\x85\xef\xfa\x15\x0f\xf6\xa5\x05\x12\x39\xf1\xd1\x3d\x29\x4e\x76\x57\x68\x62\x17\x91\xd3\xc5\x9e\x82\x31\x35\x96\x06\x95\x48\x96\x1c\xd9\x2b\x04\xf9\xef\x85\x6c\x19\x49\xba\xdb\x5f\x50\x1d\x74\xe0\x7c\x7c\xf3\xe6\x0d\xa7\x58\x3a\xdf\x07\xa9\x6a\xc5\xfc\x6a\x3e\xc7\x67\xe7\xaa\x86\x71\x7f\xbf\x4c\xa6\xc9\x14\xf7\x62\xd8\x46\x2e\xd1\xd9\x92\x03\xb4\x66\x64\x9e\x4c\xcd\xe7\xca\x05\xfe\xe4\x10\xc5\x59\xcc\xd3\x2b\xfc\x32\x00\x93\xb1\x34\xf9\xf5\xb7\x64\x8a\xde\x75\x68\xa9\x87\x75\x8a\x2e\x32\xb4\x96\x88\x9d\x34\x0c\xfe\x6e\xd8\x2b\xc4\xc2\xb8\xd6\x37\x42\xd6\x30\x0e\xa2\x35\xf4\x4d\x3f\x4d\xa6\xf8\x36\x4a\xb8\xad\x92\x58\x10\x8c\xf3\x3d\xdc\xee\x3d\x07\xd2\xa3\xe1\xe3\x57\xab\xfa\xc5\x6c\x76\x38\x1c\x52\x3a\xba\x4d\x5d\xa8\x66\xcd\x89\x8c\xb3\xfb\x7c\x79\xb7\x2a\xee\x2e\xe7\xe9\xd5\xf1\xce\x93\x6d\x38\x46\x04\xfe\xbb\x93\xc0\x25\xb6\x3d\xc8\xfb\x46\x0c\x6d\x1b\x46\x43\x07\xb8\x00\xaa\x02\x73\x09\x75\x83\xe1\x43\x10\x15\x5b\x5d\x20\xba\x9d\x1e\x28\x70\x32\x45\x29\x51\x83\x6c\x3b\xfd\x90\xd6\xd9\x9e\xc4\x0f\x80\xb3\x20\x8b\x49\x56\x20\x2f\x26\xb8\xc9\x8a\xbc\xb8\x48\xa6\xf8\x9a\x6f\xbe\xac\x9f\x36\xf8\x9a\x3d\x3e\x66\xab\x4d\x7e\x57\x60\xfd\x88\xe5\x7a\x75\x9b\x6f\xf2\xf5\xaa\xc0\xfa\x13\xb2\xd5\x37\xfc\x9e\xaf\x6e\x2f\xc0\xa2\x35\x07\xf0\x77\x1f\x06\xff\x2e\x40\x86\x1c\xb9\x1c\x42\x2b\x98\x3f\x18\xd8\xb9\xd3\xfa\xa2\x67\x23\x3b\x31\x68\xc8\x56\x1d\x55\x8c\xca\xed\x39\x58\xb1\x15\x3c\x87\x56\xe2\xb0\xcd\x08\xb2\x65\x32\x45\x23\xad\x28\xe9\xf1\xe4\x87\xa1\xd2\x24\x21\x2f\xe3\xfe\x17\x30\x2e\x70\x6a\x6c\x68\x53\xd3\xb8\xae\x4c\xab\xe3\x53\x4a\x8d\x6b\x67\xfb\x6b\x6a\x7c\x4d\xd7\xc9\x5f\x62\xcb\x05\x0a\x0e\x7b\x31\xfc\x40\xde\x8b\xad\x92\x96\x95\x4a\x52\x5a\x24\x80\xa5\x96\x17\x10\x6a\xe7\x3f\x97\x1a\x91\xe8\xc9\xf0\x02\x03\x72\x19\xfb\xa8\xdc\x26\xc3\x60\x6f\x0a\x79\xf6\x30\x4f\x80\xfd\xd9\xdc\xfe\x7a\xcb\x4a\xd7\x09\x10\x4f\xcd\xbf\xb8\xa8\xab\xb7\x66\xa7\x0e\xe4\x25\x8e\x5d\x02\x47\xd7\x05\xc3\x71\xd0\x04\x2e\x47\xdd\x13\xf7\x2c\xd4\x3e\x93\x31\x1c\xe3\xf3\xd6\x75\xb6\xa4\xd0\x3f\x7b\xd7\x88\xe9\x8f\x34\x70\x1a\x74\x70\x91\x1d\xb1\x9b\x91\xfa\xe3\x3d\x44\x9d\xba\xcf\x6c\x39\x90\x72\xb9\x80\x86\x8e\xc7\x8a\x94\x1b\x6e\x7d\x43\xca\x0b\x4c\x5e\x5e\x3c\x05\xb6\xfa\xfa\x3a\x7b\x79\x19\x6c\xbc\xbe\x4e\x7e\xe0\x96\x64\x6f\xf8\x29\x72\xb9\x71\x0f\xa4\xa6\x7e\x1c\xfd\x9f\x66\xdc\x51\x13\xcf\xda\xe7\xc9\xb2\x3d\x49\x33\x3c\xf1\xdc\x66\x31\xb2\xe6\x76\xcf\x56\x5d\xe8\x3f\xe2\xe7\xed\x8c\xdb\x5a\x8c\xc7\xe7\xa0\x87\xff\xbf\x84\xf3\xdb\x37\x48\x29\x54\xac\x9f\x84\x9b\xf2\x1d\xfb\x93\x34\x4b\xb6\xff\x19\xe1\x2d\xdb\xff\x79\x70\x43\x70\xff\x0c\x00\xeb\x07\x37\x3a\xac\x05\x00\x00"),
 		},
 		"/iambeta.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "iambeta.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2530,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x4f\x6f\xe3\x36\x13\xc6\xef\xfa\x14\x03\xfb\xf2\xbe\x40\x22\x25\x39\xaa\x27\xe7\xcf\xee\x0a\x4d\x9c\xc0\x76\x1a\xec\xc9\x18\x53\x63\x69\x1a\x8a\x64\xc9\x91\xbd\xc2\x36\xdf\xbd\x90\x25\x25\xf6\x26\x69\xb7\x28\xd0\xa2\x40\x75\x32\xc8\x87\xcf\xfc\x38\xc3\xc7\x63\xb8\xb0\xae\xf1\x5c\x94\x02\x67\x27\x67\x67\xf0\xd1\xda\x42\x13\x5c\x5f\x5f\x44\xe3\x68\x0c\xd7\xac\xc8\x04\xca\xa1\x36\x39\x79\x90\x92\x60\xe2\x50\x95\x34\xec\x1c\xc1\x4f\xe4\x03\x5b\x03\x67\xf1\x09\xfc\xaf\x15\x8c\xfa\xad\xd1\xff\x7f\x88\xc6\xd0\xd8\x1a\x2a\x6c\xc0\x58\x81\x3a\x10\x48\xc9\x01\xd6\xac\x09\xe8\x8b\x22\x27\xc0\x06\x94\xad\x9c\x66\x34\x8a\x60\xcb\x52\xee\xca\xf4\x26\x71\x34\x86\xcf\xbd\x85\x5d\x09\xb2\x01\x04\x65\x5d\x03\x76\xbd\xaf\x03\x94\x1d\xf0\xee\x2b\x45\x5c\x9a\x24\xdb\xed\x36\xc6\x1d\x6d\x6c\x7d\x91\xe8\x4e\x19\x92\xeb\xec\xe2\x6a\x3a\xbf\x3a\x3e\x8b\x4f\x76\x67\xee\x8d\xa6\x10\xc0\xd3\x2f\x35\x7b\xca\x61\xd5\x00\x3a\xa7\x59\xe1\x4a\x13\x68\xdc\x82\xf5\x80\x85\x27\xca\x41\x6c\x0b\xbc\xf5\x2c\x6c\x8a\x23\x08\x76\x2d\x5b\xf4\x14\x8d\x21\xe7\x20\x9e\x57\xb5\x1c\x74\x6b\xc0\xe3\x70\x20\xb0\x06\xd0\xc0\x68\x32\x87\x6c\x3e\x82\xf3\xc9\x3c\x9b\x1f\x45\x63\x78\xc8\x16\x9f\x6e\xef\x17\xf0\x30\x99\xcd\x26\xd3\x45\x76\x35\x87\xdb\x19\x5c\xdc\x4e\x2f\xb3\x45\x76\x3b\x9d\xc3\xed\x07\x98\x4c\x3f\xc3\x8f\xd9\xf4\xf2\x08\x88\xa5\x24\x0f\xf4\xc5\xf9\x96\xdf\x7a\xe0\xb6\x8f\x94\xb7\x4d\x9b\x13\x1d\x00\xac\x6d\x07\x14\x1c\x29\x5e\xb3\x02\x8d\xa6\xa8\xb1\x20\x28\xec\x86\xbc\x61\x53\x80\x23\x5f\x71\x68\xa7\x19\x00\x4d\x1e\x8d\x41\x73\xc5\x82\xb2\x5b\x79\x75\xa9\x38\x8a\xd0\x71\x3f\xff\x14\x94\xf5\x14\x2b\xe3\xab\x58\x69\x5b\xe7\x71\xb1\x7b\x4a\xb1\xb2\x55\xb2\x39\x45\xed\x4a\x3c\x8d\x1e\xd9\xe4\x29\xcc\xc9\x6f\x58\xd1\x0d\x3a\xc7\xa6\x88\x2a\x12\xcc\x51\x30\x8d\x00\x0c\x56\x94\x02\x63\xb5\x22\xc1\xb7\xdd\x7a\x55\x70\xa8\x28\x85\x56\x72\x1c\x9a\x20\x54\x45\xed\xdd\x5e\x4c\xb2\xc9\xcd\x39\x09\x46\x00\x9b\x01\x71\x73\xda\xda\x9e\x46\x00\xa1\x43\xf8\x64\x83\x4c\x0f\x4a\x76\x75\xd0\x71\xe8\x6b\x79\x0a\xb6\xf6\x8a\x42\xeb\x0c\x70\xdc\xbb\x77\xba\x25\x63\xb5\xdc\x5a\xff\xa8\x2d\xe6\x4b\xce\xc9\x08\x4b\xb3\x74\xd6\xea\xa8\x7b\x8b\xdd\x8d\x7b\x96\x87\x5e\x99\xf5\xc2\xbb\x17\x1d\xd6\x62\x3f\x92\x21\x8f\x42\x79\x0a\xe2\x6b\xea\x77\x38\x5f\x50\xe5\x34\x0a\xa5\x30\x72\xde\xfe\x4c\x4a\x42\xf2\xf5\x6b\xff\xf3\xe9\x29\xd1\x56\x75\x33\x4a\x0a\x6d\x57\xa8\x93\xed\x1b\x75\xda\x23\x6f\x93\x2e\x39\x7f\x7a\x1a\xbd\xaa\x76\x81\xe6\x9c\xee\x03\xe5\x0b\x7b\x83\xa2\xca\x59\xdf\x88\xae\x5f\x6b\xd4\x61\x20\x1c\x5a\x34\xd9\x20\xeb\x36\x34\x99\x99\x84\x40\x92\x99\x0d\x19\xb1\xbe\x39\x94\x0f\xf3\xee\xe7\x9f\xf6\xcb\xc3\xdc\xde\x85\xfc\xa6\x5a\x76\xf9\x72\x52\xd0\x17\x24\x1f\x98\x74\xfe\xc7\x06\x25\x93\x47\xaf\x4a\x56\xa8\x67\xb4\x26\x4f\xe6\x79\xbc\xdd\x88\xa5\x71\x94\x42\xdf\xe0\xe7\x75\x80\x47\x6a\x9e\x97\x67\xb4\xfe\x06\xe8\x1d\xaf\x75\x8f\xf5\xbd\x76\xed\x97\x53\x50\x9e\x9d\xec\x5e\xed\xaf\xc7\x7b\x3b\x00\x8b\x92\x86\x43\x20\x25\x4a\xf7\x97\x3a\x40\xc0\x8a\xb4\x35\x45\x00\xb1\xf1\xde\xb1\x62\xf3\x98\x1e\xb8\x74\xef\xf2\xee\x15\xd3\x9b\x69\xd9\xf3\xf1\xb6\x76\xe9\x73\xb5\x0a\x0d\x16\xe4\xdf\x0d\xea\x9f\xc8\xcb\xd2\x79\xbb\xe1\x9c\xfc\xf7\x06\xe7\xee\x50\xff\x4f\x07\x28\x19\xf8\x7f\x47\x37\x48\xfe\x1d\x89\xdb\xa3\xfd\x8b\xd1\x7b\xed\xf4\x5f\x06\xff\xa6\x0c\xfe\x16\x00\x00\xff\xff\xd1\x8b\x6b\xc6\xe2\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x4f\x6f\xe3\x36\x10\xc5\xef\xfa\x14\x03\xfb\xd2\x02\x89\x94\xe4\xa8\x9e\x9c\x3f\xbb\x2b\x34\xb1\x03\xdb\x69\xb0\x27\x63\x4c\x8d\xa5\x69\x28\x92\x25\x47\xf6\x0a\xdb\x7c\xf7\x42\xb6\x14\xc7\xf9\xd3\xee\x5e\x5a\x14\x58\xcb\x07\x83\x7c\x7c\xf3\xe3\x8c\x9e\x87\x70\x61\x5d\xe3\xb9\x28\x05\xce\x4e\xce\xce\xe0\xa3\xb5\x85\x26\xb8\xbe\xbe\x88\x86\xd1\x10\xae\x59\x91\x09\x94\x43\x6d\x72\xf2\x20\x25\xc1\xc8\xa1\x2a\xa9\xdf\x39\x82\xdf\xc8\x07\xb6\x06\xce\xe2\x13\xf8\xa9\x15\x0c\xba\xad\xc1\xcf\xbf\x44\x43\x68\x6c\x0d\x15\x36\x60\xac\x40\x1d\x08\xa4\xe4\x00\x2b\xd6\x04\xf4\x45\x91\x13\x60\x03\xca\x56\x4e\x33\x1a\x45\xb0\x61\x29\x41\xf6\xfe\x71\x34\x84\xcf\x9d\x85\x5d\x0a\xb2\x01\x04\x65\x5d\x03\x76\xf5\x5c\x07\x28\x5b\xe0\xed\xa7\x14\x71\x69\x92\x6c\x36\x9b\x18\xb7\xb4\xb1\xf5\x45\xa2\x77\xca\x90\x5c\x67\x17\x57\xe3\xd9\xd5\xf1\x59\x7c\xb2\x3d\x73\x67\x34\x85\x00\x9e\xfe\xa8\xd9\x53\x0e\xcb\x06\xd0\x39\xcd\x0a\x97\x9a\x40\xe3\x06\xac\x07\x2c\x3c\x51\x0e\x62\x5b\xe0\x8d\x67\x61\x53\x1c\x41\xb0\x2b\xd9\xa0\xa7\x68\x08\x39\x07\xf1\xbc\xac\xe5\xa0\x5b\x3d\x1e\x87\x03\x81\x35\x80\x06\x06\xa3\x19\x64\xb3\x01\x9c\x8f\x66\xd9\xec\x28\x1a\xc2\x7d\x36\xff\x34\xb9\x9b\xc3\xfd\x68\x3a\x1d\x8d\xe7\xd9\xd5\x0c\x26\x53\xb8\x98\x8c\x2f\xb3\x79\x36\x19\xcf\x60\xf2\x01\x46\xe3\xcf\xf0\x6b\x36\xbe\x3c\x02\x62\x29\xc9\x03\x7d\x71\xbe\xe5\xb7\x1e\xb8\xed\x23\xe5\x6d\xd3\x66\x44\x07\x00\x2b\xbb\x1b\x5f\x70\xa4\x78\xc5\x0a\x34\x9a\xa2\xc6\x82\xa0\xb0\x6b\xf2\x86\x4d\x01\x8e\x7c\xc5\xa1\x9d\x66\x00\x34\x79\x34\x04\xcd\x15\x0b\xca\x76\xe5\xd5\xa5\xe2\x28\x42\xc7\xdd\xfc\x53\x50\xd6\x53\xac\x8c\xaf\x62\xa5\x6d\x9d\xc7\xc5\xf6\x55\x8a\x95\xad\x92\xf5\x29\x6a\x57\xe2\x69\xf4\xc0\x26\x4f\x61\x46\x7e\xcd\x8a\x6e\xd0\x39\x36\x45\x54\x91\x60\x8e\x82\x69\x04\x60\xb0\xa2\x14\x18\xab\x25\x09\xbe\xed\xd6\xa9\x82\x43\x45\x29\xb4\x92\xe3\xd0\x04\xa1\x2a\x6a\xef\xb6\x37\xc9\x46\x37\xe7\x24\x18\x01\xac\x7b\xc4\xf5\x69\x6b\x7b\x1a\x01\x84\x1d\xc2\x27\x1b\x64\x7c\x50\x72\x57\x07\x1d\x87\xae\x96\xa7\x60\x6b\xaf\x28\xb4\xce\x00\xc7\x9d\xfb\x4e\xb7\x60\xac\x16\x1b\xeb\x1f\xb4\xc5\x7c\xc1\x39\x19\x61\x69\x16\xce\x5a\xbd\x55\x03\xec\x6e\xdc\xb1\xdc\x77\xca\xac\x13\xde\xee\x75\x58\x8b\xfd\x48\x86\x3c\x0a\xe5\x29\x88\xaf\xa9\xdb\xe1\x7c\x4e\x95\xd3\x28\x94\xc2\xc0\x79\xfb\x3b\x29\x09\xc9\xd7\xaf\xdd\xcf\xc7\xc7\x44\x5b\xb5\x9b\x51\x52\x68\xbb\x44\x9d\x6c\xde\xa8\xd3\x1e\x79\x9b\x74\xc1\xf9\xe3\xe3\xe0\x55\xb5\x0b\x34\xe7\x74\x17\x28\x9f\xdb\x1b\x14\x55\x4e\xbb\x46\xec\xfa\xb5\x42\x1d\x7a\xc2\xbe\x45\xa3\x35\xb2\x6e\x43\x93\x99\x51\x08\x24\x99\x59\x93\x11\xeb\x9b\x43\x79\x3f\xef\x6e\xfe\x69\xb7\xdc\xcf\xed\x5d\xc8\x17\xd5\xb2\xcb\xfd\x49\x41\x5f\x90\x7c\x60\xd2\xf9\x3f\x1b\x94\x4c\x1e\xbd\x2a\x59\xa1\x9e\xd2\x8a\x3c\x99\xa7\xf1\xb6\xdf\x63\x90\xc6\x51\x0a\x5d\x83\x9f\xd6\x01\x1e\xa8\x79\x5a\x9e\xd2\xea\x05\xd0\x3b\x5e\xab\x0e\xeb\x5b\xed\xda\x27\xa7\xa0\x3c\xbb\x76\xa8\x29\xfc\x79\xfc\x6c\x07\x60\x5e\x52\x7f\x08\xa4\x44\xd9\xfd\xa5\xf6\x10\xb0\x24\x6d\x4d\x11\x40\x6c\xfc\xec\x58\xb1\x7e\xd8\x43\xed\xdf\xcb\xdb\x57\x4c\x6f\xa6\x65\xff\x14\xde\xd6\x2e\x7d\xaa\x56\xa1\xc1\x82\xfc\xbb\x41\xfd\x8e\xbc\x2c\x9c\xb7\x6b\xce\xc9\x7f\x6b\x70\x6e\x0f\xf5\xff\x75\x80\x92\x9e\xff\x6f\x74\xbd\xe4\xff\x91\xb8\x67\xb4\x2f\xca\x7e\x6f\xf4\x5e\x3b\xfd\xc8\xe0\xbf\x94\xc1\xbf\x06\x00\xd1\x8b\x6b\xc6\xe2\x09\x00\x00"),
 		},
 		"/iamworkforcepool.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "iamworkforcepool.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1666,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x93\x41\x6f\xdb\x46\x10\x85\xef\xfc\x15\x03\xe9\xd2\x02\x36\x65\xeb\xc8\x9e\x18\xd9\x49\x88\xda\x52\x60\xca\x35\x72\x12\x46\xcb\x11\x39\xc8\x72\x67\xbb\xbb\x24\x23\x04\xfe\xef\x05\x45\xb2\xb1\xec\x14\xcd\xa5\x40\xf7\x44\xec\xbc\x79\xf3\xed\x3c\x69\x0e\x2b\xb1\x47\xc7\x65\x15\x60\x79\xb5\x5c\xc2\x07\x91\x52\x13\xdc\xdd\xad\xa2\x79\x34\x87\x3b\x56\x64\x3c\x15\xd0\x98\x82\x1c\x84\x8a\x20\xb5\xa8\x2a\x9a\x2a\x17\xf0\x07\x39\xcf\x62\x60\x19\x5f\xc1\x2f\xbd\x60\x36\x96\x66\xbf\xfe\x16\xcd\xe1\x28\x0d\xd4\x78\x04\x23\x01\x1a\x4f\x10\x2a\xf6\x70\x60\x4d\x40\x5f\x15\xd9\x00\x6c\x40\x49\x6d\x35\xa3\x51\x04\x1d\x87\xea\x34\x66\x34\x89\xa3\x39\x7c\x1e\x2d\x64\x1f\x90\x0d\x20\x28\xb1\x47\x90\xc3\x4b\x1d\x60\x38\x01\x9f\x4e\x15\x82\x4d\x16\x8b\xae\xeb\x62\x3c\xd1\xc6\xe2\xca\x85\x1e\x94\x7e\x71\x97\xad\x6e\xd7\xf9\xed\xe5\x32\xbe\x3a\xf5\x3c\x1a\x4d\xde\x83\xa3\x3f\x1b\x76\x54\xc0\xfe\x08\x68\xad\x66\x85\x7b\x4d\xa0\xb1\x03\x71\x80\xa5\x23\x2a\x20\x48\x0f\xdc\x39\x0e\x6c\xca\x0b\xf0\x72\x08\x1d\x3a\x8a\xe6\x50\xb0\x0f\x8e\xf7\x4d\x38\xdb\xd6\x84\xc7\xfe\x4c\x20\x06\xd0\xc0\x2c\xcd\x21\xcb\x67\xf0\x2e\xcd\xb3\xfc\x22\x9a\xc3\x53\xb6\xfd\xb8\x79\xdc\xc2\x53\xfa\xf0\x90\xae\xb7\xd9\x6d\x0e\x9b\x07\x58\x6d\xd6\x37\xd9\x36\xdb\xac\x73\xd8\xbc\x87\x74\xfd\x19\x7e\xcf\xd6\x37\x17\x40\x1c\x2a\x72\x40\x5f\xad\xeb\xf9\xc5\x01\xf7\x7b\xa4\xa2\x5f\x5a\x4e\x74\x06\x70\x90\x01\xc8\x5b\x52\x7c\x60\x05\x1a\x4d\xd9\x60\x49\x50\x4a\x4b\xce\xb0\x29\xc1\x92\xab\xd9\xf7\x69\x7a\x40\x53\x44\x73\xd0\x5c\x73\xc0\x70\xba\x79\xf3\xa8\x38\x8a\xd0\xf2\x98\x7f\x02\x4a\x1c\xc5\xca\xb8\x3a\x56\x5a\x9a\x22\x2e\x4f\x3f\xa5\x58\x49\xbd\x68\xaf\x51\xdb\x0a\xaf\xa3\x2f\x6c\x8a\x04\x72\x72\x2d\x2b\xba\x47\x6b\xd9\x94\x51\x4d\x01\x0b\x0c\x98\x44\x00\x06\x6b\x4a\x80\xb1\xee\xc4\x7d\x39\x88\x53\x64\x45\xf4\x8f\x6d\x47\xb9\xb7\xa8\x28\x81\x5e\x72\xe9\x8f\x3e\x50\x1d\xf5\x8f\xfc\xee\x96\xa5\xf7\x4f\x93\xdb\x27\x11\x1d\x01\xb4\x13\x74\x7b\xbd\xa7\x80\xd7\x11\x80\x1f\xa0\x3e\x8a\x0f\xeb\x1f\x43\x0c\x93\xd1\xb2\x1f\xa7\x3b\xf2\xd2\x38\x45\xbe\x9f\x05\x70\x39\xce\x1b\x74\x3b\xc6\x7a\xf7\xb7\xc1\xce\x0e\x83\xfb\x33\x2c\xe1\x35\xd5\x6b\xc4\xfe\x60\x13\xe4\x03\x19\x72\x18\xa8\x48\x20\xb8\x86\xc6\x0a\x17\x5b\xaa\xad\xc6\x40\x09\xcc\xb4\xa8\x21\xa3\xc5\xb7\x6f\xd3\xf7\xf3\xf3\xa2\x7b\xe9\xd8\xd7\xce\x69\x76\x5c\x3c\x3f\xcf\xde\xf8\xad\xd0\xbc\xa3\x47\x4f\xc5\x56\xee\x31\xa8\xea\x61\x7c\xe4\xb0\x94\x03\x6a\x3f\x31\x4c\xcf\x4f\x5b\x64\xdd\xff\x57\x32\x93\x7a\x4f\x21\x33\x2d\x99\x20\xee\x78\x2e\x9f\x62\x1e\x63\x4f\xc6\xeb\x29\xa5\x37\x70\xaf\xa6\x64\x37\xdf\x3b\x02\xba\x92\xc2\x7b\x26\x5d\xfc\x53\xe3\xbf\x86\xb1\xb3\x4e\x5a\x2e\xc8\xfd\x7c\x2a\x9f\xce\x3b\xfe\xfb\x74\x16\x13\x63\x5f\x9f\xbe\xff\x67\xb9\xbd\xc0\xfa\xd9\xc4\x5e\xb6\xfc\x15\x00\x00\xff\xff\x84\x1c\xfa\xad\x82\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x93\x41\x6f\xe2\x48\x10\x85\xef\xfe\x15\x4f\x70\xd9\x95\x12\x93\x70\x64\x4f\x0c\xc9\xcc\x58\x9b\xc0\x28\x26\x1b\xcd\x09\x15\xed\xc2\x2e\x4d\xbb\xbb\xb7\xbb\x0d\x83\xa2\xfc\xf7\x95\xc1\x9e\x84\x64\xa2\xdd\xcb\x4a\x03\x97\x56\xf7\xab\x57\x5f\xd5\x83\x21\x66\xd6\xed\xbd\x94\x55\xc4\xf8\x62\x3c\xc6\x27\x6b\x4b\xcd\xb8\xb9\x99\x25\xc3\x64\x88\x1b\x51\x6c\x02\x17\x68\x4c\xc1\x1e\xb1\x62\x4c\x1d\xa9\x8a\xfb\x97\x33\xfc\xc5\x3e\x88\x35\x18\xa7\x17\xf8\xad\x15\x0c\xba\xa7\xc1\xef\x7f\x24\x43\xec\x6d\x83\x9a\xf6\x30\x36\xa2\x09\x8c\x58\x49\xc0\x46\x34\x83\xbf\x2b\x76\x11\x62\xa0\x6c\xed\xb4\x90\x51\x8c\x9d\xc4\x0a\xf1\xd9\x3f\x4d\x86\xf8\xda\x59\xd8\x75\x24\x31\x20\x28\xeb\xf6\xb0\x9b\x97\x3a\x50\x3c\x00\x1f\x3e\x55\x8c\x6e\x32\x1a\xed\x76\xbb\x94\x0e\xb4\xa9\xf5\xe5\x48\x1f\x95\x61\x74\x93\xcd\xae\xe7\xf9\xf5\xf9\x38\xbd\x38\xd4\xdc\x1b\xcd\x21\xc0\xf3\xdf\x8d\x78\x2e\xb0\xde\x83\x9c\xd3\xa2\x68\xad\x19\x9a\x76\xb0\x1e\x54\x7a\xe6\x02\xd1\xb6\xc0\x3b\x2f\x51\x4c\x79\x86\x60\x37\x71\x47\x9e\x93\x21\x0a\x09\xd1\xcb\xba\x89\x27\xdb\xea\xf1\x24\x9c\x08\xac\x01\x19\x0c\xa6\x39\xb2\x7c\x80\x0f\xd3\x3c\xcb\xcf\x92\x21\x1e\xb2\xe5\xe7\xc5\xfd\x12\x0f\xd3\xbb\xbb\xe9\x7c\x99\x5d\xe7\x58\xdc\x61\xb6\x98\x5f\x65\xcb\x6c\x31\xcf\xb1\xf8\x88\xe9\xfc\x2b\xfe\xcc\xe6\x57\x67\x60\x89\x15\x7b\xf0\x77\xe7\x5b\x7e\xeb\x21\xed\x1e\xb9\x68\x97\x96\x33\x9f\x00\x6c\xec\x31\xbe\xe0\x58\xc9\x46\x14\x34\x99\xb2\xa1\x92\x51\xda\x2d\x7b\x23\xa6\x84\x63\x5f\x4b\x68\xd3\x0c\x20\x53\x24\x43\x68\xa9\x25\x52\x3c\xdc\xbc\x19\x2a\x4d\x12\x72\xd2\xe5\x3f\x81\xb2\x9e\x53\x65\x7c\x9d\x2a\x6d\x9b\x22\x2d\x0f\x3f\xa5\x54\xd9\x7a\xb4\xbd\x24\xed\x2a\xba\x4c\xbe\x89\x29\x26\xc8\xd9\x6f\x45\xf1\x2d\x39\x27\xa6\x4c\x6a\x8e\x54\x50\xa4\x49\x02\x18\xaa\x79\x02\xa1\x7a\x67\xfd\xb7\x8d\xf5\x8a\x9d\xb5\xfa\xe7\xb6\x9d\x3c\x38\x52\x3c\x41\x2b\x39\x0f\xfb\x10\xb9\x4e\xda\x21\x9f\xdd\xb2\xe9\xed\x43\xef\xf6\xc5\x5a\x9d\x00\xdb\x1e\x7a\x7b\xb9\xe6\x48\x97\x09\x10\x8e\x50\x9f\x6d\x88\xf3\x9f\x43\x1c\x3b\x93\x93\xd0\x75\xf7\x1c\x6c\xe3\x15\x87\xb6\x17\x70\xde\xf5\x3b\xea\x56\x42\xf5\xea\x87\xc1\xaa\x1d\xe3\xa0\x02\x8e\x4b\x78\x4d\xf5\x1a\xb1\xfd\x52\x13\xed\x27\x36\xec\x29\x72\x31\x41\xf4\x0d\x77\x2f\x52\x2c\xb9\x76\x9a\x22\x4f\x30\xd0\x56\x1d\x33\x1a\x3d\x3e\xf6\xe7\xa7\xa7\xd1\x8f\xe6\xad\x7d\x18\x3d\x3e\x9e\xd2\xac\xa4\x78\x7a\x1a\xbc\xf1\x9b\x91\xf9\xc0\xf7\x81\x8b\xa5\xbd\xa5\xa8\xaa\xbb\x6e\xc8\xe3\x52\x36\xa4\x43\xcf\xd0\x8f\x3f\xdd\x92\xe8\xf6\xbf\x92\x99\x69\x08\x1c\x33\xb3\x65\x13\xad\xdf\x9f\xca\xfb\x98\xbb\xd8\x27\xdd\x75\x9f\xd2\x1b\xb8\x57\x5d\xb2\xab\xe7\x8a\x48\xbe\xe4\xf8\x51\x58\x17\xef\x15\xfe\x6b\x18\x2b\xe7\xed\x56\x0a\xf6\xff\x3d\x95\x2f\xa7\x15\xff\x7f\x3a\xa3\x9e\xb1\x4d\xaf\x3f\xff\x62\xb9\xbd\xc0\x7a\xe5\xff\x6e\x62\x2f\x4b\xfe\x19\x00\x84\x1c\xfa\xad\x82\x06\x00\x00"),
 		},
 		"/iap.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "iap.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1834,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\x29\xdb\x47\xf6\x24\xcb\x4e\x42\xd4\x91\x03\x49\x6e\x90\x93\x31\x5a\x8e\xc8\xad\xc9\xdd\xed\xee\x50\x32\x91\xfa\xbf\x17\xfc\x90\x2c\x7f\x04\xcd\x31\x3c\x11\xb3\x6f\xde\xbc\x79\x7c\xdc\x31\x66\xd6\x35\x5e\xe7\x85\xe0\xe2\xec\xe2\x02\x1f\xad\xcd\x4b\xc6\xcd\xcd\x2c\x1a\x47\x63\xdc\x68\xc5\x26\x70\x86\xda\x64\xec\x21\x05\x63\xea\x48\x15\xbc\x3f\x39\xc1\x5f\xec\x83\xb6\x06\x17\xf1\x19\x7e\x6b\x01\xa3\xe1\x68\xf4\xfb\x1f\xd1\x18\x8d\xad\x51\x51\x03\x63\x05\x75\x60\x48\xa1\x03\x36\xba\x64\xf0\xa3\x62\x27\xd0\x06\xca\x56\xae\xd4\x64\x14\x63\xa7\xa5\xe8\xc6\x0c\x24\x71\x34\xc6\xb7\x81\xc2\xae\x85\xb4\x01\x41\x59\xd7\xc0\x6e\x8e\x71\x20\xe9\x04\x77\x4f\x21\xe2\x92\xc9\x64\xb7\xdb\xc5\xd4\xa9\x8d\xad\xcf\x27\x65\x8f\x0c\x93\x9b\x74\x76\x3d\x5f\x5e\x9f\x5e\xc4\x67\x5d\xcf\x9d\x29\x39\x04\x78\xfe\xa7\xd6\x9e\x33\xac\x1b\x90\x73\xa5\x56\xb4\x2e\x19\x25\xed\x60\x3d\x28\xf7\xcc\x19\xc4\xb6\x82\x77\x5e\x8b\x36\xf9\x09\x82\xdd\xc8\x8e\x3c\x47\x63\x64\x3a\x88\xd7\xeb\x5a\x5e\xb8\xb5\x97\xa7\xc3\x0b\x80\x35\x20\x83\xd1\x74\x89\x74\x39\xc2\xe5\x74\x99\x2e\x4f\xa2\x31\xbe\xa6\xab\x4f\xb7\x77\x2b\x7c\x9d\x2e\x16\xd3\xf9\x2a\xbd\x5e\xe2\x76\x81\xd9\xed\xfc\x2a\x5d\xa5\xb7\xf3\x25\x6e\x3f\x60\x3a\xff\x86\x3f\xd3\xf9\xd5\x09\x58\x4b\xc1\x1e\xfc\xe8\x7c\xab\xdf\x7a\xe8\xd6\x47\xce\x5a\xd3\x96\xcc\x2f\x04\x6c\x6c\x2f\x28\x38\x56\x7a\xa3\x15\x4a\x32\x79\x4d\x39\x23\xb7\x5b\xf6\x46\x9b\x1c\x8e\x7d\xa5\x43\xfb\x35\x03\xc8\x64\xd1\x18\xa5\xae\xb4\x90\x74\x95\x37\x4b\xc5\x51\x44\x4e\x0f\xdf\x3f\x81\xb2\x9e\x63\x65\x7c\x15\xab\xd2\xd6\x59\x9c\x77\x51\x8a\x95\xad\x26\xdb\x73\x2a\x5d\x41\xe7\xd1\x83\x36\x59\x82\x25\xfb\xad\x56\xfc\x99\x9c\xd3\x26\x8f\x2a\x16\xca\x48\x28\x89\x00\x43\x15\x27\xd0\xe4\xde\x67\x1a\x10\xc1\x91\xe2\x04\x2d\xe4\x34\x34\x41\xb8\x8a\xda\xbd\x9e\x09\x52\x72\x11\xb0\xdd\x4b\xdb\x9e\xaf\x59\xe8\x3c\x02\x42\x3f\xfa\x93\x0d\x32\x3f\x8c\xea\xf9\xc9\xe9\x30\xcc\xf0\x1c\x6c\xed\x15\x87\x96\x11\x38\x1d\x58\x7b\xdc\xbd\x26\x77\xbf\xf6\xad\x41\x7d\xdc\xfa\xa5\x52\x72\x97\x47\x45\xaa\xc5\x7e\x64\xc3\x9e\x84\xb3\x04\xe2\x6b\x1e\x4e\x74\xb6\xe2\xca\x95\x24\x9c\x60\xe4\xbc\xfd\x9b\x95\x84\xc9\xf7\xef\xc3\xeb\xbd\xce\x9e\x9e\x26\x1d\x7f\x5b\xed\x5e\xba\xda\xe8\x4d\xff\x8c\xcc\x25\xdf\x05\xce\x56\xf6\x33\x89\x2a\x16\x83\xec\x7e\xb3\x0d\x95\x61\x3f\x73\xbf\xd0\x74\x4b\xba\x6c\x63\x9d\x9a\x69\x08\x2c\xa9\xd9\xb2\x11\xeb\x9b\x97\xf0\xd6\x25\xf6\x07\xf9\xe9\xd5\x07\xcd\x65\x96\x60\x2f\xe6\x15\x6b\x7a\x95\x0c\x15\x40\xc8\xe7\x2c\xef\xe3\x0b\xcd\x9e\xbc\x2a\xb4\xa2\x72\xc1\x1b\xf6\x6c\x0e\x1e\xf7\x3e\x4b\xe3\x38\xc1\xe0\xc4\xa1\x0e\x3c\x70\x73\x28\x2f\x78\xf3\x6a\xfe\x0f\xb8\x36\x83\x8a\x67\x63\xff\x97\xb1\x7d\x32\x0e\xca\x6b\x27\x5d\x72\xfe\x3d\x3d\x3a\x01\x56\x05\xef\x9b\x20\x05\x49\x7f\x9d\xed\x75\x60\xcd\xa5\x35\x79\x80\xd8\xf8\xa8\x2d\xdf\x3e\x24\x2f\x58\xfa\xc0\x7c\x79\xb3\xe5\xbb\x89\x3d\xe2\xf1\xb6\x76\xc9\x61\x5a\x45\x86\x72\xf6\x3f\xfc\x51\xde\xcd\xad\x2a\x35\x1b\x79\x1d\xdc\xd9\x71\xf5\x27\x93\x3b\x44\xf3\xe9\x69\xa2\x33\x36\xa2\xa5\x99\xb6\x37\xe1\x17\x6f\x1f\x9b\x9e\xaf\x8d\x6f\x3f\xef\x57\xc9\xef\x41\xcd\xcf\x06\xf8\xb9\xe1\xbf\x00\x00\x00\xff\xff\x47\xb4\xad\x5c\x2a\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x6f\xe2\x48\x10\xbd\xfb\x57\x3c\xc1\x65\x57\x4a\x20\xc9\xd1\x7b\x22\x24\x33\x63\x6d\x86\x8c\x80\xec\x68\x4e\x51\xd1\x2e\xec\xda\x98\xee\xde\xee\x32\xc4\x9a\xcd\x7f\x5f\x19\x0c\xe4\x53\x9a\xe3\x62\x0e\x56\xd5\xab\x57\xaf\xaa\x9f\xbb\x8f\xb1\xf3\x4d\x90\xa2\x54\x5c\x9c\x5d\x5c\xe0\xb3\x73\x45\xc5\xb8\xb9\x19\x27\xfd\xa4\x8f\x1b\x31\x6c\x23\xe7\xa8\x6d\xce\x01\x5a\x32\x46\x9e\x4c\xc9\xfb\xcc\x09\xfe\xe2\x10\xc5\x59\x5c\x0c\xce\xf0\x5b\x0b\xe8\x75\xa9\xde\xef\x7f\x24\x7d\x34\xae\xc6\x8a\x1a\x58\xa7\xa8\x23\x43\x4b\x89\x58\x4a\xc5\xe0\x47\xc3\x5e\x21\x16\xc6\xad\x7c\x25\x64\x0d\x63\x23\x5a\x42\x8f\xfc\x83\xa4\x8f\x1f\x1d\x85\x5b\x28\x89\x05\xc1\x38\xdf\xc0\x2d\x9f\xe3\x40\xba\x15\xbc\xfd\x95\xaa\x3e\x1d\x0e\x37\x9b\xcd\x80\xb6\x6a\x07\x2e\x14\xc3\x6a\x87\x8c\xc3\x9b\x6c\x7c\x3d\x99\x5d\x9f\x5e\x0c\xce\xb6\x35\x77\xb6\xe2\x18\x11\xf8\x9f\x5a\x02\xe7\x58\x34\x20\xef\x2b\x31\xb4\xa8\x18\x15\x6d\xe0\x02\xa8\x08\xcc\x39\xd4\xb5\x82\x37\x41\x54\x6c\x71\x82\xe8\x96\xba\xa1\xc0\x49\x1f\xb9\x44\x0d\xb2\xa8\xf5\xc5\xb6\xf6\xf2\x24\xbe\x00\x38\x0b\xb2\xe8\x8d\x66\xc8\x66\x3d\x5c\x8e\x66\xd9\xec\x24\xe9\xe3\x7b\x36\xff\x72\x7b\x37\xc7\xf7\xd1\x74\x3a\x9a\xcc\xb3\xeb\x19\x6e\xa7\x18\xdf\x4e\xae\xb2\x79\x76\x3b\x99\xe1\xf6\x13\x46\x93\x1f\xf8\x33\x9b\x5c\x9d\x80\x45\x4b\x0e\xe0\x47\x1f\x5a\xfd\x2e\x40\xda\x3d\x72\xde\x2e\x6d\xc6\xfc\x42\xc0\xd2\xed\x8e\x2f\x7a\x36\xb2\x14\x83\x8a\x6c\x51\x53\xc1\x28\xdc\x9a\x83\x15\x5b\xc0\x73\x58\x49\x6c\x4f\x33\x82\x6c\x9e\xf4\x51\xc9\x4a\x94\x74\x1b\x79\x33\xd4\x20\x49\xc8\x4b\x77\xfe\x29\x8c\x0b\x3c\x30\x36\xac\x06\xa6\x72\x75\x3e\x28\xb6\x56\x1a\x18\xb7\x1a\xae\xcf\xa9\xf2\x25\x9d\x27\x0f\x62\xf3\x14\x33\x0e\x6b\x31\xfc\x95\xbc\x17\x5b\x24\x2b\x56\xca\x49\x29\x4d\x00\x4b\x2b\x4e\x21\xe4\xdf\x67\xea\x10\xd1\x93\xe1\x14\x2d\xe4\x34\x36\x51\x79\x95\xb4\x73\x1d\x09\x32\xf2\x09\xb0\xde\x4b\x5b\x9f\x2f\x58\xe9\x3c\x01\xe2\xae\xf5\x17\x17\x75\x72\x68\xb5\xe3\x27\x2f\xb1\xeb\x11\x38\xba\x3a\x18\x8e\x2d\x23\x70\xda\xb1\xee\x70\xf7\x42\xfe\x7e\x11\xda\x05\xb5\x49\x60\x37\x54\x46\xfe\xf2\x59\x90\x6a\x75\x9f\xd9\x72\x20\xe5\x3c\x85\x86\x9a\xbb\x8c\xe4\x73\x5e\xf9\x8a\x94\x53\xf4\x7c\x70\x7f\xb3\xd1\x38\xfc\xf9\xb3\x7b\xbd\x97\xfc\xe9\x69\xb8\xe5\x6f\xa3\xdb\x97\x6d\xac\xf7\xa6\x7e\x4c\xf6\x92\xef\x22\xe7\x73\xf7\x95\xd4\x94\xd3\x4e\xf6\x6e\xb2\x25\x55\x71\xdf\x73\x3f\xd0\x68\x4d\x52\xb5\xb6\xce\xec\x28\x46\xd6\xcc\xae\xd9\xaa\x0b\xcd\x4b\x78\xbb\x25\x0e\x07\xf9\xd9\xd5\x27\xe1\x2a\x4f\xb1\x17\xf3\x8a\x35\xbb\x4a\xbb\x08\xa0\x14\x0a\xd6\xf7\xf1\xa5\x70\xa0\x60\x4a\x31\x54\x4d\x79\xc9\x81\xed\x61\xc7\xed\xff\x14\xda\x78\x4e\xd1\x6d\xe2\x10\x07\x1e\xb8\x39\x84\xa7\xbc\x7c\xd5\xff\x03\xae\x65\xa7\xa2\xab\x3b\xea\xf8\x98\xb1\x7d\x72\x8e\x26\x88\x6f\x4d\x9f\xe2\xdf\xd3\x67\x19\x60\x5e\xf2\xbe\x08\x5a\x92\xee\xae\xb3\xbd\x0e\x2c\xb8\x72\xb6\x88\x50\x37\x78\x56\x56\xac\x1f\x8e\xba\x8e\x86\xf9\xf6\x66\xca\x77\x1d\x7b\x7c\x8a\xe0\x6a\x9f\x1e\xba\xad\xc8\x52\xc1\xe1\xc3\x0f\xe5\x5d\xdf\x9a\x4a\xd8\xea\x6b\xe3\x8e\x9f\x47\x7f\xd1\xb9\x9d\x35\x9f\x9e\x86\x92\xb3\x55\xd1\x66\xd4\xde\x84\xdf\x82\x7b\x6c\x76\x7c\xad\x7d\x77\xfd\xfe\x2f\xfe\x3d\xa8\xf9\x55\x03\x9b\x4a\xd8\xea\xbd\xe4\xc9\x7f\x03\x00\x47\xb4\xad\x5c\x2a\x07\x00\x00"),
 		},
 		"/identityplatform.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "identityplatform.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 7637,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5d\x6f\xdb\x36\x14\x7d\xd7\xaf\xb8\xb0\x5f\x36\x20\xb1\x9b\x3c\x7a\x4f\xae\xdd\x0f\x61\xa9\x1d\x58\xce\x8a\x3e\x19\xd7\xe4\x95\xc4\x55\x22\x39\xf2\xca\xae\xd1\xe5\xbf\x0f\xb2\xa4\xc4\x4e\x9c\xc1\x5d\xdd\x25\x1b\xe4\x07\x43\x10\x0f\xcf\x3d\x24\xcf\x21\x74\xbb\x30\x32\x76\xe3\x54\x92\x32\x5c\xbe\xba\xbc\x84\x77\xc6\x24\x19\xc1\xd5\xd5\x28\xe8\x06\x5d\xb8\x52\x82\xb4\x27\x09\x85\x96\xe4\x80\x53\x82\xa1\x45\x91\x52\x33\x72\x06\xbf\x91\xf3\xca\x68\xb8\xec\xbd\x82\x9f\x4a\x40\xa7\x1e\xea\xfc\xfc\x4b\xd0\x85\x8d\x29\x20\xc7\x0d\x68\xc3\x50\x78\x02\x4e\x95\x87\x58\x65\x04\xf4\x45\x90\x65\x50\x1a\x84\xc9\x6d\xa6\x50\x0b\x82\xb5\xe2\x74\x5b\xa6\x26\xe9\x05\x5d\xf8\x54\x53\x98\x25\xa3\xd2\x80\x20\x8c\xdd\x80\x89\x77\x71\x80\xbc\x15\xbc\xfd\xa5\xcc\x76\xd0\xef\xaf\xd7\xeb\x1e\x6e\xd5\xf6\x8c\x4b\xfa\x59\x85\xf4\xfd\xab\x70\xf4\x66\x12\xbd\x39\xbf\xec\xbd\xda\xce\xb9\xd1\x19\x79\x0f\x8e\xfe\x28\x94\x23\x09\xcb\x0d\xa0\xb5\x99\x12\xb8\xcc\x08\x32\x5c\x83\x71\x80\x89\x23\x92\xc0\xa6\x14\xbc\x76\x8a\x95\x4e\xce\xc0\x9b\x98\xd7\xe8\x28\xe8\x82\x54\x9e\x9d\x5a\x16\xbc\xb7\x5b\x8d\x3c\xe5\xf7\x00\x46\x03\x6a\xe8\x0c\x23\x08\xa3\x0e\xbc\x1e\x46\x61\x74\x16\x74\xe1\x63\x38\x7f\x3f\xbd\x99\xc3\xc7\xe1\x6c\x36\x9c\xcc\xc3\x37\x11\x4c\x67\x30\x9a\x4e\xc6\xe1\x3c\x9c\x4e\x22\x98\xbe\x85\xe1\xe4\x13\xfc\x1a\x4e\xc6\x67\x40\x8a\x53\x72\x40\x5f\xac\x2b\xf5\x1b\x07\xaa\xdc\x47\x92\xe5\xa6\x45\x44\x7b\x02\x62\x53\x09\xf2\x96\x84\x8a\x95\x80\x0c\x75\x52\x60\x42\x90\x98\x15\x39\xad\x74\x02\x96\x5c\xae\x7c\x79\x9a\x1e\x50\xcb\xa0\x0b\x99\xca\x15\x23\x6f\xdf\x3c\x5a\x54\x2f\x08\xd0\xaa\xfa\xfc\x07\x20\x8c\xa3\x9e\xd0\x2e\xef\x89\xcc\x14\xb2\x97\x6c\xad\xd4\x13\x26\xef\xaf\x2e\x30\xb3\x29\x5e\x04\x9f\x95\x96\x03\x88\xc8\xad\x94\xa0\x0f\x68\xad\xd2\x49\x90\x13\xa3\x44\xc6\x41\x00\xa0\x31\xa7\x01\x28\x49\x9a\x15\x6f\x6c\x86\x1c\x9b\x92\xf1\x10\x6d\x0d\xf7\x16\x05\x0d\xa0\x84\x9c\xfb\x8d\x67\xca\x83\x72\x91\xf7\x6c\x61\xcd\x76\x5d\xb3\x05\x00\xab\x46\xf4\xea\x62\x49\x8c\x17\x01\x80\xaf\x44\xbd\x37\x9e\x27\x87\x45\x54\x95\xd1\x2a\x5f\x57\x77\xe4\x4d\xe1\x04\xf9\xb2\x16\xc0\x79\x5d\xaf\xc2\x2d\x9a\xf9\x8b\x86\x60\x21\x8c\x8e\x55\x12\x54\x26\xad\xb6\xe2\xa1\xb6\xd1\x2e\x04\x0b\x36\xef\x48\x93\x43\x26\x39\x00\x76\x05\xd5\x23\x4a\xce\x29\x2f\x79\x69\x00\x1d\xeb\xcc\xef\x24\xd8\xf7\xbf\x7e\xad\x1f\x6f\x6f\xfb\x55\xad\xce\x23\xfc\x08\xf5\x6b\xba\xf1\x24\xe7\xe6\x03\xb2\x48\x67\xf5\x1a\xaa\x35\xc7\x98\xf9\xa6\x46\xb3\xba\xe1\x0a\x55\x56\x46\x21\xd4\x43\xef\x89\x43\xbd\x22\xcd\xc6\x6d\xf6\xe1\xcd\x29\xd6\xa7\x3a\xa8\x5f\x37\x87\x50\x2b\x7b\xc0\x1d\x8e\xef\x71\x8c\x2e\x21\x7e\xab\x28\x93\x0f\xe1\xa9\x22\x87\x4e\xa4\x4a\x60\x36\xa3\x98\x1c\xe9\xbb\x5d\xaf\x76\x9e\x37\xf6\x51\x91\xed\x2e\xd3\xe6\xee\xf5\x8c\xe2\x07\xe5\x9f\xe0\x8a\x0f\x8a\x78\x9a\xae\xfc\x49\xf2\xc2\x29\xcb\x5b\x57\xfd\x79\xbe\x33\x02\x30\x4f\xa9\x99\x04\x9c\x22\x57\x97\x60\x23\x02\x96\x94\x19\x9d\x78\x60\xd3\xdb\x99\x96\xac\x3e\x0f\xf6\x58\x2a\xc3\x5c\x3f\xd2\x74\xd0\xcd\x3b\x3c\xce\x14\x76\x70\x57\x2d\x47\x8d\x09\xb9\x27\x13\x75\x84\x8d\x25\xc5\x58\x64\xbc\xf0\x85\xb5\xc6\x31\xc9\x85\x92\xf6\x18\x73\x8f\xab\x89\x51\x33\x2f\x1c\x5f\x9f\xcc\xef\xf2\x21\xb7\xb4\x15\x77\x09\x2b\xf5\x29\x79\x7b\xfb\x72\xe2\x50\x29\x3a\x36\x0d\x7b\xe8\x36\x0c\x2f\x29\x0c\x4a\x2f\x4d\xa1\xe5\xc2\x63\x9e\x1d\x93\x81\xb0\xc2\x47\xc3\x0f\x57\x27\xf3\x7e\xad\x21\xc2\x3c\xbb\xf7\x7c\xa9\xfb\x25\x39\xbe\xfc\x3f\xd6\xef\x3b\xd8\xd6\xed\x2f\xc9\xed\x06\x0b\x4e\x8f\xbd\xee\xa7\x25\xf8\x94\x77\xfc\xb6\xfa\xde\xc5\xde\x9a\xbc\x35\xf9\xc9\x4d\x5e\x2f\xe5\xee\x3b\xe7\x08\xab\xd7\xaa\xeb\x0f\x9c\x13\x7f\xc4\x3f\x87\xcf\xcb\x6e\x88\xdc\x9d\xee\x70\xfc\xd8\xb4\xad\xc1\xff\xab\x06\x67\xd2\xa8\xf9\x6f\x0d\x3d\xdf\x85\x7c\x8f\x85\xab\x5a\xcf\x72\x57\xb7\x1e\xfe\xdf\x7b\xf8\x9f\xf7\xa2\x95\xc1\x7f\x64\x47\x7a\xef\xfc\xea\xa9\x6d\x52\xdb\xb0\x3c\x6f\x58\xbe\xb5\x57\xad\x32\xf2\x23\x3a\xd6\x03\xd9\x68\x9b\xd8\x36\x0d\xff\x6a\x1a\xbe\xa9\x97\xad\xa2\x70\xf2\x8e\xf6\x40\x0e\xda\x26\xb7\x0d\xc1\xf7\x85\xe0\xaf\x00\x00\x00\xff\xff\x95\x04\x36\x1c\xd5\x1d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x51\x6f\xe2\x38\x17\x7d\xcf\xaf\xb8\x82\x97\xef\x93\x5a\x98\xf6\x91\x7d\x62\xa0\x33\x13\x6d\x0b\x15\xa1\x3b\x9a\x27\x74\xb1\x6f\x12\x6f\x13\xdb\x6b\xdf\xc0\xa0\xd9\xfe\xf7\x55\x48\x52\x28\xa5\xab\xce\x4e\xbb\xd3\x95\x52\xa2\x2a\x8a\x8f\xef\x3d\xb6\xcf\x89\x72\xba\x30\x32\x76\xe3\x54\x92\x32\x9c\xbf\x3b\x3f\x87\x8f\xc6\x24\x19\xc1\xe5\xe5\x28\xe8\x06\x5d\xb8\x54\x82\xb4\x27\x09\x85\x96\xe4\x80\x53\x82\xa1\x45\x91\x52\x33\x72\x02\xbf\x91\xf3\xca\x68\x38\xef\xbd\x83\xff\x95\x80\x4e\x3d\xd4\xf9\xff\x2f\x41\x17\x36\xa6\x80\x1c\x37\xa0\x0d\x43\xe1\x09\x38\x55\x1e\x62\x95\x11\xd0\x57\x41\x96\x41\x69\x10\x26\xb7\x99\x42\x2d\x08\xd6\x8a\x53\xe0\x5d\xfd\x5e\xd0\x85\x2f\x75\x09\xb3\x64\x54\x1a\x10\x84\xb1\x1b\x30\xf1\x3e\x0e\x90\xb7\x84\xb7\x7f\x29\xb3\x1d\xf4\xfb\xeb\xf5\xba\x87\x5b\xb6\x3d\xe3\x92\x7e\x56\x21\x7d\xff\x32\x1c\x5d\x4c\xa2\x8b\xd3\xf3\xde\xbb\xed\x9c\x1b\x9d\x91\xf7\xe0\xe8\x8f\x42\x39\x92\xb0\xdc\x00\x5a\x9b\x29\x81\xcb\x8c\x20\xc3\x35\x18\x07\x98\x38\x22\x09\x6c\x4a\xc2\x6b\xa7\x58\xe9\xe4\x04\xbc\x89\x79\x8d\x8e\x82\x2e\x48\xe5\xd9\xa9\x65\xc1\x0f\x76\xab\xa1\xa7\xfc\x03\x80\xd1\x80\x1a\x3a\xc3\x08\xc2\xa8\x03\xef\x87\x51\x18\x9d\x04\x5d\xf8\x1c\xce\x3f\x4d\x6f\xe6\xf0\x79\x38\x9b\x0d\x27\xf3\xf0\x22\x82\xe9\x0c\x46\xd3\xc9\x38\x9c\x87\xd3\x49\x04\xd3\x0f\x30\x9c\x7c\x81\x5f\xc3\xc9\xf8\x04\x48\x71\x4a\x0e\xe8\xab\x75\x25\x7f\xe3\x40\x95\xfb\x48\xb2\xdc\xb4\x88\xe8\x01\x81\xd8\x54\xc7\xe7\x2d\x09\x15\x2b\x01\x19\xea\xa4\xc0\x84\x20\x31\x2b\x72\x5a\xe9\x04\x2c\xb9\x5c\xf9\xf2\x34\x3d\xa0\x96\x41\x17\x32\x95\x2b\x46\xde\x3e\x79\xb4\xa8\x5e\x10\xa0\x55\xf5\xf9\x0f\x40\x18\x47\x3d\xa1\x5d\xde\x13\x99\x29\x64\x2f\xd9\x4a\xa9\x27\x4c\xde\x5f\x9d\x61\x66\x53\x3c\x0b\x6e\x95\x96\x03\x88\xc8\xad\x94\xa0\x2b\xb4\x56\xe9\x24\xc8\x89\x51\x22\xe3\x20\x00\xd0\x98\xd3\x00\x94\x24\xcd\x8a\x37\x36\x43\x8e\x8d\xcb\x8f\x97\xad\xe1\xde\xa2\xa0\x01\x94\x9d\x4f\xfd\xc6\x33\xe5\x41\xb9\xc8\x5d\xb5\xb0\xae\x76\x5d\x57\x0b\x00\x56\x0d\xe9\xd5\xd9\x92\x18\xcf\x02\x00\x5f\x91\xfa\x64\x3c\x4f\x8e\x93\xa8\x3a\xa3\x55\xbe\xee\xee\xc8\x9b\xc2\x09\xf2\x65\x2f\x80\xd3\xba\x5f\x85\x5b\x34\xf3\x17\x4d\x81\x85\x30\x3a\x56\xc9\x16\x0b\x50\x6d\xc5\x21\xb7\xd1\x3e\x04\x0b\x36\x1f\x49\x93\x43\x26\x39\x00\x76\x05\xd5\x23\x4a\xce\x29\x2f\xeb\xd2\x00\x3a\xd6\x99\xdf\x49\xb0\xef\x7f\xfb\x56\xdf\xde\xdd\xf5\xab\x5e\x9d\x47\xf8\x11\xea\xf7\x74\xe3\x49\xce\xcd\x15\xb2\x48\x67\xf5\x1a\xaa\x35\xc7\x98\xf9\xa6\x47\xb3\xba\xe1\x0a\x55\x56\x5a\x21\xd4\x43\xef\x89\x43\xbd\x22\xcd\xc6\x6d\x1e\xc2\x9b\x53\xac\x4f\x75\x50\x3f\x6e\x0e\xa1\x66\x76\x50\x3b\x1c\xef\x70\x8c\x2e\x21\xfe\xa0\x28\x93\x87\xf0\x54\x91\x43\x27\x52\x25\x30\x9b\x51\x4c\x8e\xf4\xfd\xae\x97\xd7\x29\xf0\xc6\x3e\x6a\x52\x5e\xb7\xb4\xb9\x7f\x3c\xa3\xf8\xa0\xfd\x13\xb5\xe2\xa3\x24\x9e\x2e\x57\xfe\x24\x79\xe1\x94\x2d\xad\x32\x80\x3f\x4f\xf7\x46\x00\xe6\x29\x35\x93\x80\x53\xe4\xea\x25\xd8\x90\x80\x25\x65\x46\x27\x1e\xd8\xf4\xf6\xa6\x25\xab\xdb\x1d\xa9\x9d\x60\xae\x1f\x71\x3a\xaa\xe6\xdd\x2f\x71\xa6\xb0\x83\xfb\x6e\x39\x6a\x4c\xc8\x3d\xe9\xa8\x67\xc8\x58\x52\x8c\x45\xc6\x0b\x5f\x58\x6b\x1c\x93\x5c\x28\x69\x9f\x23\xee\x71\x35\x31\x6a\xe6\x85\xe3\xeb\x17\xd3\xbb\x3c\xac\x2d\x6d\x55\xbb\xb4\x45\xc9\x4f\xc9\xbb\xbb\xb7\x63\x87\x8a\xd1\x41\xe9\x27\xdd\xf0\x00\xdd\x9a\xe1\x2d\x99\x41\xe9\xa5\x29\xb4\x5c\x78\xcc\xb3\xe7\x78\x20\xac\xf0\xd1\xf0\xea\xf2\xc5\xb4\x5f\x73\x88\x30\xcf\x76\x9a\x2f\x75\xf6\x96\x14\x5f\xfe\x3f\x28\xfc\xa4\xde\xf7\xb0\xad\xda\xdf\x92\xda\x0d\x16\x9c\x3e\xf7\x75\x3f\x2d\xc1\x2f\xf9\x8e\xdf\x76\x0f\xa5\x6d\x45\xde\x8a\xfc\x15\x45\x5e\x2f\xe5\xfe\x3b\xe7\x19\x52\xaf\x59\xd7\x1f\x38\x2f\x26\xf8\xaa\xf3\xcf\xd0\x79\x99\x86\xc8\xdd\xf3\x0e\xc7\x8f\x45\xdb\x0a\xfc\xbf\x2a\x70\x26\x8d\x9a\xff\x56\xd0\xf3\x7d\xc8\x8f\x48\xb8\xea\xe5\x5b\x0d\xb7\x1a\x7e\x05\x0d\xff\xf3\x2c\x5a\x09\xfc\x35\x13\xe9\x4e\xf9\xd5\x5d\x1b\x52\xdb\x90\xfa\x93\x42\x6a\x6d\x96\xef\xcd\xaa\x95\x47\x5e\x23\xb1\x1e\xf1\x46\x1b\x62\xdb\x10\xfb\xef\x84\xd8\xda\x0d\xdf\x95\x65\x2b\x2b\xbc\x78\xa2\x3d\xe2\x83\x36\xe4\xb6\x21\xf7\xc7\x42\xee\x5f\x03\x00\x95\x04\x36\x1c\xd5\x1d\x00\x00"),
 		},
 		"/kms.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "kms.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 2944,
+			uncompressedSize: 3284,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x55\x4d\x6f\xe2\x48\x13\xbe\xfb\x57\x94\xe0\xf2\xbe\x52\x02\x49\x8e\xde\x13\x43\x32\x33\xde\x24\x64\x85\xc9\x8c\xe6\x84\x8a\x76\x61\xf7\xd0\xee\xee\xed\x2e\x43\x2c\x96\xff\xbe\xf2\x17\x01\x92\xcc\x66\x2e\xab\x91\xd6\x07\xb0\xea\xe3\xa9\xa7\x9e\x2a\x95\xfb\x30\x36\xb6\x74\x32\xcd\x18\xae\x2e\xae\xae\xe0\x93\x31\xa9\x22\xb8\xbb\x1b\x07\xfd\xa0\x0f\x77\x52\x90\xf6\x94\x40\xa1\x13\x72\xc0\x19\xc1\xc8\xa2\xc8\xa8\xf3\x9c\xc1\x17\x72\x5e\x1a\x0d\x57\x83\x0b\xf8\x5f\x15\xd0\x6b\x5d\xbd\xff\xff\x16\xf4\xa1\x34\x05\xe4\x58\x82\x36\x0c\x85\x27\xe0\x4c\x7a\x58\x4a\x45\x40\x4f\x82\x2c\x83\xd4\x20\x4c\x6e\x95\x44\x2d\x08\x36\x92\xb3\xba\x4c\x0b\x32\x08\xfa\xf0\xad\x85\x30\x0b\x46\xa9\x01\x41\x18\x5b\x82\x59\x1e\xc6\x01\x72\x4d\xb8\x7e\x32\x66\x1b\x0e\x87\x9b\xcd\x66\x80\x35\xdb\x81\x71\xe9\x50\x35\x91\x7e\x78\x17\x8d\x6f\x26\xf1\xcd\xf9\xd5\xe0\xa2\xce\x79\xd4\x8a\xbc\x07\x47\x7f\x16\xd2\x51\x02\x8b\x12\xd0\x5a\x25\x05\x2e\x14\x81\xc2\x0d\x18\x07\x98\x3a\xa2\x04\xd8\x54\x84\x37\x4e\xb2\xd4\xe9\x19\x78\xb3\xe4\x0d\x3a\x0a\xfa\x90\x48\xcf\x4e\x2e\x0a\x3e\x52\xab\xa3\x27\xfd\x51\x80\xd1\x80\x1a\x7a\xa3\x18\xa2\xb8\x07\x1f\x46\x71\x14\x9f\x05\x7d\xf8\x1a\xcd\x3e\x3f\x3c\xce\xe0\xeb\x68\x3a\x1d\x4d\x66\xd1\x4d\x0c\x0f\x53\x18\x3f\x4c\xae\xa3\x59\xf4\x30\x89\xe1\xe1\x23\x8c\x26\xdf\xe0\x36\x9a\x5c\x9f\x01\x49\xce\xc8\x01\x3d\x59\x57\xf1\x37\x0e\x64\xa5\x23\x25\x95\x68\x31\xd1\x11\x81\xa5\x69\x08\x79\x4b\x42\x2e\xa5\x00\x85\x3a\x2d\x30\x25\x48\xcd\x9a\x9c\x96\x3a\x05\x4b\x2e\x97\xbe\x9a\xa6\x07\xd4\x49\xd0\x07\x25\x73\xc9\xc8\xb5\xe5\x45\x53\x83\x20\x40\x2b\xdb\xf9\x87\x20\x8c\xa3\x81\xd0\x2e\x1f\x08\x65\x8a\x64\x90\xd6\xab\x34\x10\x26\x1f\xae\x2f\x51\xd9\x0c\x2f\x83\x95\xd4\x49\x08\x31\xb9\xb5\x14\x74\x8f\xd6\x4a\x9d\x06\x39\x31\x26\xc8\x18\x06\x00\x1a\x73\x0a\x61\x95\xfb\xd7\x91\xda\x08\x6f\x51\x50\x08\x55\xc8\xb9\x2f\x3d\x53\x1e\x54\x7d\x3d\x03\xdc\xde\xc7\x01\xc0\xba\xa3\xb6\xbe\x5c\x10\xe3\x65\x00\xe0\x9b\xd2\x9f\x8d\xe7\xc9\xbe\x54\x83\x8f\x56\xfa\xb6\x86\x23\x6f\x0a\x27\xc8\x57\x88\x00\xe7\x2d\x6a\x13\x37\x5f\xe5\x7e\x2e\x5c\x69\xd9\xcc\x57\x54\x06\xcd\xce\x35\x9d\xdd\xde\xc7\xe3\xda\x73\xbb\x77\x60\xc1\xe6\x13\x69\x72\xc8\x94\x84\xc0\xae\xa0\xd6\x23\x93\x19\xe5\x56\x21\x53\x08\xbd\xed\x76\x45\xe5\xdc\x49\x9d\xee\x76\x43\xd1\x61\xf8\xe1\x76\x5b\xd5\xde\xed\x7a\x2f\x92\xc6\xa8\x3f\xd0\xa3\xa7\x64\x66\xee\x91\x45\x36\x6d\x49\x37\x7d\x2d\x51\xf9\xae\x50\xd7\xce\x68\x8d\x52\x55\x4b\x1d\xe9\x91\xf7\xc4\x91\x5e\x93\x66\xe3\xca\xe3\xf0\x6e\x1e\xed\x7c\xc2\xd6\xdc\x69\x5b\xfd\xee\x4d\x0a\x17\xa4\x7c\xd8\xfe\x9f\xd4\x8b\xae\x9f\x73\x19\x5d\x4a\xfc\x51\x92\x4a\x0e\x20\x7e\xa8\xec\xbc\x9d\xdf\x9b\x0a\x7f\x39\xf2\xbf\x5b\xe8\x7f\x5d\xd0\x6a\xe9\xc8\xed\xb9\x45\xd7\xa7\x32\xfc\x8c\x64\xd5\xb3\x46\x55\xd0\x51\x4f\xcf\xaa\x1d\xae\x4f\x2b\x50\xb5\x45\x75\x4a\xd7\xf5\x2b\xb2\x77\xdb\x77\x2a\xf6\x2d\x95\xd3\x67\xf3\x3b\x35\xb6\xce\x7c\x27\xc1\x55\xdd\xf6\x75\xb7\x1b\x2a\x23\x9a\x4b\x32\xdc\x6e\xbb\xf7\xdd\x6e\xb8\x6a\x0a\xfc\xe2\x9b\xfe\x33\xf3\xc9\x24\x39\x74\x22\x93\x02\xd5\x94\x96\xe4\x48\xef\x4f\x49\xa3\x3e\x97\x96\x42\x68\xa5\xd9\xdb\x01\x56\x54\xee\xcd\x53\x5a\x9e\xd4\x7e\x03\x6b\xd9\x32\x78\x2f\x5c\xf5\x24\xe4\x85\x93\x96\xeb\xeb\xf8\xd7\xf9\x81\x07\x60\x96\x51\x97\x04\x9c\x21\x37\x9f\xec\x8e\x04\x2c\x48\x19\x9d\x7a\x60\x33\x38\x48\x4b\xd7\xab\xf0\x08\xa5\x59\xa0\x3f\x5e\x70\x7a\xf5\x2a\x1f\xe0\x38\x53\xd8\x70\x5f\x2d\x47\x8d\x29\xb9\x37\x3f\x06\x3f\x5c\xe5\xb9\xcc\xad\x71\x3c\xff\x6e\x16\x6f\x6c\x75\x54\x07\xfc\xbe\xf7\xff\xa7\x4f\xc8\xc1\xf7\x47\x76\xba\xbc\xe7\x72\x78\x12\x8e\x78\x2e\xa4\xcd\xc8\x31\x3d\xf1\xa9\xd8\x71\x1d\x30\x3e\xf5\xbf\x5b\xec\xa3\xdb\xb6\xdd\x3e\x17\xfa\x25\x26\xf0\xa2\xef\x7f\x9c\xc3\x41\xc6\xdf\x01\x00\x00\xff\xff\x97\xd3\xdb\x86\x80\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\xdf\x6f\xe2\x38\x10\x7e\xcf\x5f\x31\x82\x97\x3b\xa9\x25\x6d\x1f\x73\x4f\x2c\xed\xee\xe6\xda\xd2\x13\xa1\xbb\xda\x27\x64\x9c\x21\x71\x71\x6c\x9f\x3d\x81\x46\x1c\xff\xfb\x29\xbf\x08\xd0\xd2\x6b\x5f\x4e\xab\x6d\x2a\x88\x3c\xe3\xf9\xbe\xf9\xe6\x93\x4d\x1f\x46\xda\x14\x56\x24\x29\xc1\xd5\xc5\xd5\x15\x7c\xd1\x3a\x91\x08\x77\x77\x23\xaf\xef\xf5\xe1\x4e\x70\x54\x0e\x63\xc8\x55\x8c\x16\x28\x45\x18\x1a\xc6\x53\x6c\x23\x67\xf0\x0d\xad\x13\x5a\xc1\xd5\xe0\x02\x7e\x2b\x13\x7a\x4d\xa8\xf7\xfb\x1f\x5e\x1f\x0a\x9d\x43\xc6\x0a\x50\x9a\x20\x77\x08\x94\x0a\x07\x0b\x21\x11\xf0\x99\xa3\x21\x10\x0a\xb8\xce\x8c\x14\x4c\x71\x84\xb5\xa0\x14\xa8\xab\x3f\xf0\xfa\xf0\xa3\x29\xa1\xe7\xc4\x84\x02\x06\x5c\x9b\x02\xf4\x62\x3f\x0f\x18\x55\x84\xab\xbf\x94\xc8\x04\xbe\xbf\x5e\xaf\x07\xac\x62\x3b\xd0\x36\xf1\x65\x9d\xe9\xfc\xbb\x70\x74\x33\x8e\x6e\xce\xaf\x06\x17\xd5\x9e\x47\x25\xd1\x39\xb0\xf8\x77\x2e\x2c\xc6\x30\x2f\x80\x19\x23\x05\x67\x73\x89\x20\xd9\x1a\xb4\x05\x96\x58\xc4\x18\x48\x97\x84\xd7\x56\x90\x50\xc9\x19\x38\xbd\xa0\x35\xb3\xe8\xf5\x21\x16\x8e\xac\x98\xe7\x74\xa0\x56\x4b\x4f\xb8\x83\x04\xad\x80\x29\xe8\x0d\x23\x08\xa3\x1e\x7c\x1a\x46\x61\x74\xe6\xf5\xe1\x7b\x38\xfd\xfa\xf0\x38\x85\xef\xc3\xc9\x64\x38\x9e\x86\x37\x11\x3c\x4c\x60\xf4\x30\xbe\x0e\xa7\xe1\xc3\x38\x82\x87\xcf\x30\x1c\xff\x80\xdb\x70\x7c\x7d\x06\x28\x28\x45\x0b\xf8\x6c\x6c\xc9\x5f\x5b\x10\xa5\x8e\x18\x97\xa2\x45\x88\x07\x04\x16\xba\x1e\x9f\x33\xc8\xc5\x42\x70\x90\x4c\x25\x39\x4b\x10\x12\xbd\x42\xab\x84\x4a\xc0\xa0\xcd\x84\x2b\xa7\xe9\x80\xa9\xd8\xeb\x83\x14\x99\x20\x46\xd5\xca\x8b\xa6\x06\x9e\xc7\x8c\x68\xe6\x1f\x00\xd7\x16\x07\x5c\xd9\x6c\xc0\xa5\xce\xe3\x41\x52\x59\x69\xc0\x75\xe6\xaf\x2e\x99\x34\x29\xbb\xf4\x96\x42\xc5\x01\x44\x68\x57\x82\xe3\x3d\x33\x46\xa8\xc4\xcb\x90\x58\xcc\x88\x05\x1e\x80\x62\x19\x06\xb0\xcc\xdc\xeb\x95\x9a\x0c\x67\x18\xc7\x00\xca\x94\x73\x57\x38\xc2\xcc\x2b\xfb\xea\x0a\xdc\xde\x47\x1e\xc0\xaa\xa5\xb6\xba\x9c\x23\xb1\x4b\x0f\xc0\xd5\xd0\x5f\xb5\xa3\xf1\x0e\xaa\xae\xcf\x8c\x70\x0d\x86\x45\xa7\x73\xcb\xd1\x95\x15\x01\xce\x9b\xaa\x75\xde\x6c\x99\xb9\x19\xb7\x85\x21\x3d\x5b\x62\x51\x65\x00\xd4\x9d\xdd\xde\x47\xa3\x2a\x72\xbb\x0b\xb0\x9c\xf4\x17\x54\x68\x19\x61\x1c\x00\xd9\x1c\x9b\x88\x88\xa7\x98\x19\xc9\x08\x03\xe8\x6d\x36\x4b\x2c\x66\x56\xa8\x64\xbb\xf5\x79\x5b\xc3\xf9\x9b\x4d\x89\xbd\xdd\xf6\x5e\x6c\x1a\x31\xf5\x09\x1f\x1d\xc6\x53\x7d\xcf\x88\xa7\x93\x86\x74\xdd\xd7\x82\x49\xd7\x02\xb5\xed\x0c\x57\x4c\xc8\xd2\xd4\xa1\x1a\x3a\x87\x14\xaa\x15\x2a\xd2\xb6\x38\x4c\x6f\xe7\xd1\xcc\x27\x68\x96\x5b\x6d\xcb\xcf\xdd\x92\x64\x73\x94\x2e\x68\xbe\x8f\xf0\xc2\xeb\x6e\x2f\x31\x9b\x20\x7d\x16\x28\xe3\xbd\x12\x6f\x2a\x3b\x6b\xe6\x77\x52\xe1\x6f\x07\xf1\x77\x0b\xfd\xbf\x0b\x5a\x9a\x0e\xed\x8e\x5b\x78\x7d\x2c\xc3\x47\x24\x2b\x9f\x15\x93\x39\x1e\xf4\xd4\xa9\xb6\x6f\x9f\x46\xa0\xd2\x45\xd5\x96\xb6\xeb\x57\x64\x6f\xdd\x77\x2c\xf6\x2d\x16\x93\x6e\xf9\x9d\x1a\x1b\xab\x9f\x90\x53\x89\xdb\xbc\x6e\xb7\xbe\xd4\xbc\x3e\x49\xfc\xcd\xa6\x7d\xdf\x6e\xfd\x65\x0d\xf0\x93\x3b\xfd\x23\xf3\x49\x05\x5a\x66\x79\x2a\x38\x93\x13\x5c\xa0\x45\xb5\x3b\x4a\xca\xff\x73\xa0\xc2\x60\x00\x8d\x34\xbb\x75\x80\x25\x16\xbb\xe5\x09\x2e\x8e\xb0\x4f\xd4\x5a\x34\x0c\xde\x5b\xae\x7c\x62\x74\xdc\x0a\x53\x8e\x20\x80\x7f\xce\xf7\x22\x00\xd3\x14\xdb\x4d\x40\x29\xa3\xfa\xca\x6e\x49\xc0\x1c\xa5\x56\x89\x03\xd2\x83\xbd\x6d\xc9\x6a\xd9\x91\xea\x0c\xf4\xd7\x0b\x4e\xaf\x9e\xca\xdd\x93\x58\x9d\x9b\x60\x87\x96\x31\xc5\x12\xb4\x27\x2f\x83\x37\xad\x3c\x13\x99\xd1\x96\x66\x4f\x7a\x7e\xc2\xd5\x61\x95\xf0\xa7\x9e\x7f\xcc\xde\xbf\xe6\x11\xd2\xca\xb6\xdd\xfa\xa2\xd5\xe5\xc5\xc9\xf1\x6e\x37\xb6\xd5\x3e\x60\xba\x6e\x2e\x7b\xbe\xab\xa9\xc0\x93\x9e\x9f\x70\x5e\x65\xf2\xe6\x10\x39\x34\xf9\x09\x4f\x76\x30\x1f\xb6\xe5\x5b\xbf\x4b\x5e\x95\xdc\xa1\x5c\xcc\xa4\x50\xcb\xbd\x04\xc3\x2c\x2a\xda\x73\xd7\x2b\x16\x76\xc8\x2d\xd2\x8c\x0b\x93\xa2\x25\x7c\x26\xef\xa8\x83\xa8\x4a\x18\x1d\xc7\xdf\x6d\xe0\x83\xfb\x62\xb3\xe9\x80\x7e\x0a\x57\xbf\xe8\xfb\x3f\xbd\xcd\x85\x49\xd1\x12\x3e\x93\xf7\xef\x00\x73\xaa\xd9\x9e\xd4\x0c\x00\x00"),
 		},
 		"/logging.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "logging.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2295,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x4f\x6f\xe2\xc8\x13\xbd\xfb\x53\x94\xe0\xf2\xfb\x49\x09\x24\x39\x7a\x4f\x4c\x92\x99\xb1\x96\x90\x15\x90\x19\xcd\x29\x2a\xda\x85\x5d\x4b\xbb\xbb\xb7\xbb\x0c\x83\xb2\x7c\xf7\x55\xdb\x86\x40\xfe\x48\xd9\xc3\x6a\xd7\x07\x64\x55\xbd\x7e\xfd\xde\xab\xa6\xdd\x87\x6b\xeb\xb6\x9e\x8b\x52\xe0\xea\xe2\xea\x0a\xbe\x58\x5b\x68\x82\xf1\xf8\x3a\xe9\x27\x7d\x18\xb3\x22\x13\x28\x87\xda\xe4\xe4\x41\x4a\x82\x91\x43\x55\xd2\xbe\x73\x06\xdf\xc8\x07\xb6\x06\xae\x06\x17\xf0\xbf\x08\xe8\x75\xad\xde\xff\x7f\x49\xfa\xb0\xb5\x35\x54\xb8\x05\x63\x05\xea\x40\x20\x25\x07\x58\xb2\x26\xa0\x9f\x8a\x9c\x00\x1b\x50\xb6\x72\x9a\xd1\x28\x82\x0d\x4b\xd9\x6c\xd3\x91\x0c\x92\x3e\xfc\xe8\x28\xec\x42\x90\x0d\x20\x28\xeb\xb6\x60\x97\xc7\x38\x40\x69\x04\x37\x4f\x29\xe2\xd2\xe1\x70\xb3\xd9\x0c\xb0\x51\x3b\xb0\xbe\x18\xea\x16\x19\x86\xe3\xec\xfa\x76\x32\xbb\x3d\xbf\x1a\x5c\x34\x6b\x1e\x8c\xa6\x10\xc0\xd3\x1f\x35\x7b\xca\x61\xb1\x05\x74\x4e\xb3\xc2\x85\x26\xd0\xb8\x01\xeb\x01\x0b\x4f\x94\x83\xd8\x28\x78\xe3\x59\xd8\x14\x67\x10\xec\x52\x36\xe8\x29\xe9\x43\xce\x41\x3c\x2f\x6a\x39\x49\x6b\x2f\x8f\xc3\x09\xc0\x1a\x40\x03\xbd\xd1\x0c\xb2\x59\x0f\x3e\x8d\x66\xd9\xec\x2c\xe9\xc3\xf7\x6c\xfe\xf5\xfe\x61\x0e\xdf\x47\xd3\xe9\x68\x32\xcf\x6e\x67\x70\x3f\x85\xeb\xfb\xc9\x4d\x36\xcf\xee\x27\x33\xb8\xff\x0c\xa3\xc9\x0f\xf8\x35\x9b\xdc\x9c\x01\xb1\x94\xe4\x81\x7e\x3a\x1f\xf5\x5b\x0f\x1c\x73\xa4\x3c\x86\x36\x23\x3a\x11\xb0\xb4\xad\xa0\xe0\x48\xf1\x92\x15\x68\x34\x45\x8d\x05\x41\x61\xd7\xe4\x0d\x9b\x02\x1c\xf9\x8a\x43\x9c\x66\x00\x34\x79\xd2\x07\xcd\x15\x0b\x4a\x53\x79\x65\x6a\x90\x24\xe8\xb8\x9b\x7f\x0a\xca\x7a\x1a\x28\xe3\xab\x81\xd2\xb6\xce\x07\x45\x73\x94\x06\xca\x56\xc3\xf5\x25\x6a\x57\xe2\x65\xb2\x62\x93\xa7\x30\x23\xbf\x66\x45\x77\xe8\x1c\x9b\x22\xa9\x48\x30\x47\xc1\x34\x01\x30\x58\x51\x0a\xda\x16\x05\x9b\xe2\x6d\xb6\x0e\x15\x1c\x2a\x4a\x21\x42\xce\xc3\x36\x08\x55\x49\xf4\xf6\x4c\x32\x6e\x49\x12\x80\xf5\x5e\xe2\xfa\x72\x41\x82\x97\x09\x40\x68\x25\x7c\xb5\x41\x26\x27\x5b\xb6\xfb\xa0\xe3\xd0\xed\xe5\x29\xd8\xda\x2b\x0a\x91\x19\xe0\xbc\x63\x6f\x71\x8f\xdd\xb2\x47\xcd\x66\x45\xf9\x63\xb4\x11\x48\x92\xf6\x1c\xb6\x6e\x3b\x1d\xe3\x06\x71\x73\x02\xc0\x5a\xec\x17\x32\xe4\x51\x28\x4f\x41\x7c\x4d\x5d\x87\xf3\x39\x55\x4e\xa3\x50\x0a\xbd\xa7\x27\x87\x9e\x8c\xec\x76\x43\x6d\x55\x3b\x8e\xe1\xd3\xd3\xfe\x7d\xb7\x1b\x2e\x6a\xb5\x22\x89\xc5\xf6\x2d\x22\xd9\xac\x1a\x14\x9b\xd5\x23\xe7\xbb\x5d\xef\x15\xf5\x35\x9a\x4f\xf4\x10\x28\x9f\xdb\x3b\x14\x55\x4e\x3b\xab\x6d\x22\x4b\xd4\x61\x2f\x67\x1f\xc2\x68\x8d\xac\xe3\xdf\x22\x33\xa3\x10\x48\x32\xb3\x26\x23\xd6\x6f\x4f\xe1\xfb\x89\x76\x13\x4e\xbb\xf2\x61\xbc\xad\xa4\x17\xdc\xd9\xcd\x33\x4e\xd0\x17\x24\x9f\x99\x74\x7e\x0a\x7f\x2f\x7e\x5b\x3c\xae\x99\x36\x6f\x06\x6f\x8b\x6f\xcf\xad\x7f\x32\xf2\xa8\x20\x16\xa2\xc4\xff\x52\xde\xf1\xf7\xa3\x61\x1f\xb0\xef\x24\x5d\x91\x78\x56\x6f\xe5\x7c\x77\xdc\xf9\x78\xcc\xde\xfe\x4e\x4a\x76\x3b\xf8\x17\x72\x8b\xb7\x00\xf9\x83\xce\xec\xe6\x65\x08\x7f\x27\xb0\xf8\xac\x51\xd7\x74\xe4\xaf\x73\x17\x0f\xc5\xc1\xe8\xb0\x8d\x30\xd6\x1a\xf8\xb3\xe3\x92\xc9\xa3\x57\x25\x2b\xd4\x53\x5a\x92\x27\x73\xb8\x77\xda\x91\xc8\xd6\x51\x0a\x1d\xd5\xa1\x0e\xb0\xa2\xed\xa1\x3c\xa5\xe5\x0b\xed\xef\x70\x2d\x3b\x07\x1f\xa5\x8b\x4f\x4e\x41\x79\x76\xd2\x5c\xa7\x7f\x9e\x1f\x75\x00\xe6\x25\xed\x17\x81\x94\x28\xed\xb7\x7e\x2f\x02\x16\xa4\xad\x29\x02\x88\x1d\x1c\x2d\x2b\xd6\xab\xf4\x84\xa5\x3d\x53\xbf\xbd\xd2\xf4\xe6\x35\x7e\xc4\xe3\x6d\xed\xd2\xc3\x6e\x15\x1a\x2c\xc8\xbf\xf3\x05\xf9\x2b\x00\x00\xff\xff\x9e\x78\x5d\xf8\xf7\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\x52\x92\xa3\x7a\xf2\x26\xd9\x5d\xa1\x8e\x53\xd8\xce\x2e\xf6\x14\x8c\xa9\x31\xc5\x9a\x22\x59\x72\x64\xaf\xe1\xfa\xbf\x17\xb4\xe4\xaf\x7c\x00\xdb\x43\xd1\x8d\x02\x43\x98\x79\x7c\x7c\xef\x8d\x44\xf5\xe1\xd6\xba\xb5\x57\xb2\x62\xb8\xb9\xba\xb9\x81\x4f\xd6\x4a\x4d\x30\x1c\xde\x26\xfd\xa4\x0f\x43\x25\xc8\x04\x2a\xa1\x31\x25\x79\xe0\x8a\x60\xe0\x50\x54\xb4\xef\x5c\xc0\x17\xf2\x41\x59\x03\x37\xe9\x15\xfc\x12\x01\xbd\xae\xd5\xfb\xf5\xb7\xa4\x0f\x6b\xdb\x40\x8d\x6b\x30\x96\xa1\x09\x04\x5c\xa9\x00\x73\xa5\x09\xe8\xbb\x20\xc7\xa0\x0c\x08\x5b\x3b\xad\xd0\x08\x82\x95\xe2\x0a\xf8\xc8\x9f\x26\x7d\xf8\xd6\x51\xd8\x19\xa3\x32\x80\x20\xac\x5b\x83\x9d\x9f\xe2\x00\x79\x27\x78\xf7\x57\x31\xbb\x3c\xcb\x56\xab\x55\x8a\x3b\xb5\xa9\xf5\x32\xd3\x2d\x32\x64\xc3\xe2\xf6\x7e\x34\xb9\xbf\xbc\x49\xaf\x76\x6b\x9e\x8c\xa6\x10\xc0\xd3\x5f\x8d\xf2\x54\xc2\x6c\x0d\xe8\x9c\x56\x02\x67\x9a\x40\xe3\x0a\xac\x07\x94\x9e\xa8\x04\xb6\x51\xf0\xca\x2b\x56\x46\x5e\x40\xb0\x73\x5e\xa1\xa7\xa4\x0f\xa5\x0a\xec\xd5\xac\xe1\xb3\xb4\xf6\xf2\x54\x38\x03\x58\x03\x68\xa0\x37\x98\x40\x31\xe9\xc1\x87\xc1\xa4\x98\x5c\x24\x7d\xf8\x5a\x4c\x3f\x3f\x3e\x4d\xe1\xeb\x60\x3c\x1e\x8c\xa6\xc5\xfd\x04\x1e\xc7\x70\xfb\x38\xba\x2b\xa6\xc5\xe3\x68\x02\x8f\x1f\x61\x30\xfa\x06\xbf\x17\xa3\xbb\x0b\x20\xc5\x15\x79\xa0\xef\xce\x47\xfd\xd6\x83\x8a\x39\x52\x19\x43\x9b\x10\x9d\x09\x98\xdb\x76\x7c\xc1\x91\x50\x73\x25\x40\xa3\x91\x0d\x4a\x02\x69\x97\xe4\x8d\x32\x12\x1c\xf9\x5a\x85\x38\xcd\x00\x68\xca\xa4\x0f\x5a\xd5\x8a\x91\x77\x95\x57\xa6\xd2\x24\x41\xa7\xba\xf9\xe7\x20\xac\xa7\x54\x18\x5f\xa7\x42\xdb\xa6\x4c\xe5\xee\x51\x4a\x85\xad\xb3\xe5\x35\x6a\x57\xe1\x75\xb2\x50\xa6\xcc\x61\x42\x7e\xa9\x04\x3d\xa0\x73\xca\xc8\xa4\x26\xc6\x12\x19\xf3\x04\xc0\x60\x4d\x39\x68\x2b\xa5\x32\xf2\x6d\xb6\x0e\x15\x1c\x0a\xca\x21\x42\x2e\xc3\x3a\x30\xd5\x49\xf4\x76\x24\x19\xb6\x24\x09\xc0\x72\x2f\x71\x79\x3d\x23\xc6\xeb\x04\x20\xb4\x12\x3e\xdb\xc0\xa3\xb3\x2d\xdb\x7d\xd0\xa9\xd0\xed\xe5\x29\xd8\xc6\x0b\x0a\x91\x19\xe0\xb2\x63\x6f\x71\xcf\xdd\xb2\x67\xad\xcc\x82\xca\xe7\x68\x23\x10\xef\x90\x00\xad\xdb\x4e\xc7\x70\x87\xb8\x3b\x03\x60\xc3\xf6\x13\x19\xf2\xc8\x54\xe6\xc0\xbe\xa1\xae\xa3\xca\x29\xd5\x4e\x23\x53\x0e\xbd\xcd\xc6\xa1\x27\xc3\xdb\x6d\xa6\xad\x68\xc7\x91\x6d\x36\xfb\xfb\xed\x36\x9b\x35\x62\x41\x1c\xb2\xcd\xa6\xbd\x8b\x48\x65\x16\xb1\x10\x95\x3d\xab\x72\xbb\xed\xbd\xa2\xbe\x45\xf3\x81\x9e\x02\x95\x53\xfb\x80\x2c\xaa\x71\x67\xb5\x4d\x64\x8e\x3a\xec\xe5\xec\x43\x18\x2c\x51\xe9\xf8\x5a\x14\x66\x10\x02\x71\x61\x96\x64\xd8\xfa\xf5\x39\x7c\x3f\xd1\x6e\xc2\x79\x57\x3e\x8c\xb7\x95\xf4\x82\xbb\xb8\x3b\xe2\x18\xbd\x24\xfe\xa8\x48\x97\xe7\xf0\xf7\xe2\xb7\xf2\x79\xa9\x68\xf5\x66\xf0\x56\x7e\x39\xb6\xfe\xcb\xc8\xa3\x82\x88\x8a\x12\x7f\xa6\xbc\xe3\xef\x0b\xe2\x77\xc3\x3e\x60\xdf\x49\xba\x26\xf6\x4a\xbc\x95\xf3\xc3\x69\xe7\xc7\x63\xf6\xf6\x4f\x12\xbc\xdd\xc2\xff\x90\x5b\x3c\x05\xc8\x1f\x74\x16\x77\x2f\x43\xf8\x37\x81\xc5\x6b\x89\xba\xa1\x13\x7f\x9d\xbb\x90\x9d\x18\xcd\xda\x08\x63\x6d\x07\x3f\x3a\xae\x14\x79\xf4\xa2\x52\x02\xf5\x98\xe6\xe4\xc9\x1c\xce\x9d\xf8\x7f\x09\xbc\x76\x94\x43\x47\x75\xa8\x03\x2c\x68\x7d\x28\x8f\x69\xfe\x42\xfb\x3b\x5c\xf3\xce\xc1\x8f\xd2\xc5\xab\xa4\x20\xbc\x72\xf1\x1d\xc8\xe1\xef\xcb\x93\x0e\xc0\xb4\xa2\xfd\x22\xe0\x0a\xb9\xfd\xd6\xef\x45\xc0\x8c\xb4\x35\x32\x00\xdb\xf4\x64\x99\x5c\x2e\x8e\xa2\x8e\xcf\xd4\x1f\xaf\x34\xbd\x79\x8c\x1f\x2f\xe9\x6d\xe3\xf2\xc3\x6e\x35\x1a\x94\xe4\xdf\xf9\x82\xfc\x33\x00\x9e\x78\x5d\xf8\xf7\x08\x00\x00"),
 		},
 		"/looker.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "looker.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1532,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x5f\x6f\xda\xc8\x17\x7d\xf7\xa7\x38\x82\x97\xdf\x4f\x4a\x4c\x92\x47\xef\x13\x4d\xd2\xd6\x5a\x0a\x2b\x20\x5b\xf5\xa9\xba\x8c\x2f\xf6\x6c\xc6\x33\xb3\x33\xd7\x50\x94\xe5\xbb\xaf\x8c\xed\x14\x9a\x56\xda\x79\x40\xe8\xde\x73\xcf\x39\xf7\x0f\x8c\x71\xef\xfc\x21\xe8\xb2\x12\xdc\xdd\xdc\xdd\xe1\x83\x73\xa5\x61\xcc\x66\xf7\xc9\x38\x19\x63\xa6\x15\xdb\xc8\x05\x1a\x5b\x70\x80\x54\x8c\xa9\x27\x55\xf1\x90\xb9\xc2\x9f\x1c\xa2\x76\x16\x77\xe9\x0d\xfe\xd7\x02\x46\x7d\x6a\xf4\xff\xdf\x92\x31\x0e\xae\x41\x4d\x07\x58\x27\x68\x22\x43\x2a\x1d\xb1\xd5\x86\xc1\xdf\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\xbd\x96\xea\x24\xd3\x93\xa4\xc9\x18\x5f\x7a\x0a\xb7\x11\xd2\x16\x04\xe5\xfc\x01\x6e\x7b\x8e\x03\xc9\xc9\xf0\xe9\x55\x22\x3e\x9b\x4c\xf6\xfb\x7d\x4a\x27\xb7\xa9\x0b\xe5\xc4\x74\xc8\x38\x99\xe5\xf7\x8f\xf3\xd5\xe3\xf5\x5d\x7a\x73\xaa\x79\xb2\x86\x63\x44\xe0\xbf\x1b\x1d\xb8\xc0\xe6\x00\xf2\xde\x68\x45\x1b\xc3\x30\xb4\x87\x0b\xa0\x32\x30\x17\x10\xd7\x1a\xde\x07\x2d\xda\x96\x57\x88\x6e\x2b\x7b\x0a\x9c\x8c\x51\xe8\x28\x41\x6f\x1a\xb9\x98\xd6\x60\x4f\xc7\x0b\x80\xb3\x20\x8b\xd1\x74\x85\x7c\x35\xc2\xbb\xe9\x2a\x5f\x5d\x25\x63\x7c\xce\xd7\x1f\x17\x4f\x6b\x7c\x9e\x2e\x97\xd3\xf9\x3a\x7f\x5c\x61\xb1\xc4\xfd\x62\xfe\x90\xaf\xf3\xc5\x7c\x85\xc5\x7b\x4c\xe7\x5f\xf0\x7b\x3e\x7f\xb8\x02\x6b\xa9\x38\x80\xbf\xf9\xd0\xfa\x77\x01\xba\x9d\x23\x17\xed\xd0\x56\xcc\x17\x06\xb6\xae\x33\x14\x3d\x2b\xbd\xd5\x0a\x86\x6c\xd9\x50\xc9\x28\xdd\x8e\x83\xd5\xb6\x84\xe7\x50\xeb\xd8\x6e\x33\x82\x6c\x91\x8c\x61\x74\xad\x85\xe4\x14\x79\xd3\x54\x9a\x24\xe4\x75\xbf\xff\x0c\xca\x05\x4e\x95\x0d\x75\xaa\x8c\x6b\x8a\xb4\x3c\x9d\x52\xaa\x5c\x3d\xd9\xdd\x92\xf1\x15\xdd\x26\xcf\xda\x16\x19\x56\x1c\x76\x5a\xf1\x27\xf2\x5e\xdb\x32\xa9\x59\xa8\x20\xa1\x2c\x01\x2c\xd5\x9c\xc1\x38\xf7\xcc\xe1\xe7\x64\x3d\x28\x7a\x52\x9c\xa1\x85\x5c\xc7\x43\x14\xae\x93\xb6\xb5\xef\x1c\xb3\x13\x47\x02\xec\x06\x83\xbb\xdb\x0d\x0b\xdd\x26\x40\xec\x0c\x7c\x74\x51\xe6\xe7\x82\x9d\x0a\x79\x1d\x7b\xa5\xc0\xd1\x35\x41\x71\x6c\x79\x81\xeb\x9e\xbb\xc3\x7d\xed\xaa\xbe\x6a\x1b\xa5\x3d\xde\xa4\xbb\xbe\xae\xc7\x4e\x3e\xbf\x4c\x51\x23\xee\x03\x5b\x0e\x24\x5c\x64\x90\xd0\x0c\x19\x5d\xac\xb9\xf6\x86\x84\x33\x8c\x7c\x70\x7f\xb1\x92\x38\x79\x79\xe9\xbf\x1e\x8f\x13\xe3\x54\xb7\x88\xc9\xcb\x4b\xe0\x52\x3b\x7b\x3c\x4e\x06\xe9\x36\xd8\x5a\x3b\x1e\x47\x6f\x08\xef\xc9\xbe\xe3\xa7\xc8\xc5\xda\x7d\x22\x51\xd5\xb2\xef\xa9\xeb\x7c\x4b\x26\x0e\x26\x86\x6e\xa7\x3b\xd2\xa6\x3d\xfe\xdc\x4e\x63\x64\xc9\xed\x8e\xad\xb8\x70\xb8\x84\x0f\x7b\xeb\xf7\x98\xf5\xe1\x61\x01\xed\xe7\x0f\xc4\xf9\xc3\x77\x90\x50\x28\x59\xde\x6b\x36\xc5\x05\xb6\xd2\x1c\x28\xa8\x4a\x2b\x32\x4b\xde\x72\x60\xfb\x3a\xff\x6e\x07\x72\xf0\x9c\xa1\x9f\xcc\x6b\x1c\x78\xe6\xc3\x6b\x78\xc9\xdb\x1f\xb4\x7f\xc1\xb5\xed\x1d\xfc\x57\xba\xf6\x15\x1c\x55\xd0\x5e\x4e\x57\xf5\xcf\xf5\x59\x06\x58\x57\x3c\x14\x41\x2a\x92\xee\x0f\x6f\x30\x81\x0d\x1b\x67\xcb\x08\x71\xe9\x59\x59\xb9\x7b\xce\x2e\x58\xba\x1b\xfa\xe3\x8d\xa7\x9f\x5e\xf3\x19\x4f\x70\x8d\xcf\x5e\xd5\x6a\xb2\x54\xfe\xf2\x77\xf4\x6f\x00\x00\x00\xff\xff\xb3\xc0\x0e\x3f\xfc\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6f\xda\x48\x10\x7e\xf7\xaf\xf8\x04\x2f\x77\x52\x62\x92\x3c\xfa\x9e\x68\x92\xb6\xd6\xa5\x70\x02\x72\x55\x9f\xaa\x61\x3d\xd8\x7b\x59\xef\xee\xed\x8e\xa1\x28\xc7\x7f\x3f\x19\xdb\x21\x34\xad\x54\x16\x21\x34\xf3\xed\x37\xdf\xcc\x37\xf6\x18\xb7\xce\xef\x83\x2e\x2b\xc1\xcd\xd5\xcd\x0d\x3e\x38\x57\x1a\xc6\xc3\xc3\x6d\x32\x4e\xc6\x78\xd0\x8a\x6d\xe4\x02\x8d\x2d\x38\x40\x2a\xc6\xd4\x93\xaa\x78\xc8\x5c\xe0\x6f\x0e\x51\x3b\x8b\x9b\xf4\x0a\xbf\xb5\x80\x51\x9f\x1a\xfd\xfe\x47\x32\xc6\xde\x35\xa8\x69\x0f\xeb\x04\x4d\x64\x48\xa5\x23\x36\xda\x30\xf8\x9b\x62\x2f\xd0\x16\xca\xd5\xde\x68\xb2\x8a\xb1\xd3\x52\x41\x4e\xfc\x69\x32\xc6\x97\x9e\xc2\xad\x85\xb4\x05\x41\x39\xbf\x87\xdb\xbc\xc6\x81\xe4\x28\xf8\xf8\xa9\x44\x7c\x36\x99\xec\x76\xbb\x94\x8e\x6a\x53\x17\xca\x89\xe9\x90\x71\xf2\x90\xdf\xde\xcf\x96\xf7\x97\x37\xe9\xd5\xf1\xce\xa3\x35\x1c\x23\x02\xff\xdb\xe8\xc0\x05\xd6\x7b\x90\xf7\x46\x2b\x5a\x1b\x86\xa1\x1d\x5c\x00\x95\x81\xb9\x80\xb8\x56\xf0\x2e\x68\xd1\xb6\xbc\x40\x74\x1b\xd9\x51\xe0\x64\x8c\x42\x47\x09\x7a\xdd\xc8\xd9\xb4\x06\x79\x3a\x9e\x01\x9c\x05\x59\x8c\xa6\x4b\xe4\xcb\x11\xde\x4d\x97\xf9\xf2\x22\x19\xe3\x73\xbe\xfa\x38\x7f\x5c\xe1\xf3\x74\xb1\x98\xce\x56\xf9\xfd\x12\xf3\x05\x6e\xe7\xb3\xbb\x7c\x95\xcf\x67\x4b\xcc\xdf\x63\x3a\xfb\x82\x3f\xf3\xd9\xdd\x05\x58\x4b\xc5\x01\xfc\xcd\x87\x56\xbf\x0b\xd0\xed\x1c\xb9\x68\x87\xb6\x64\x3e\x13\xb0\x71\x9d\x7d\xd1\xb3\xd2\x1b\xad\x60\xc8\x96\x0d\x95\x8c\xd2\x6d\x39\x58\x6d\x4b\x78\x0e\xb5\x8e\xad\x9b\x11\x64\x8b\x64\x0c\xa3\x6b\x2d\x24\xc7\xc8\x9b\xa6\xd2\x24\x21\xaf\x7b\xff\x33\x28\x17\x38\x55\x36\xd4\xa9\x32\xae\x29\xd2\xf2\xb8\x4a\xa9\x72\xf5\x64\x7b\x4d\xc6\x57\x74\x9d\x3c\x69\x5b\x64\x58\x72\xd8\x6a\xc5\x9f\xc8\x7b\x6d\xcb\xa4\x66\xa1\x82\x84\xb2\x04\xb0\x54\x73\x06\xe3\xdc\x13\x87\x1f\x93\xf5\xa0\xe8\x49\x71\x86\x16\x72\x19\xf7\x51\xb8\x4e\xda\xd6\x4e\x1c\x0f\x47\x8e\x04\xd8\x0e\x02\xb7\xd7\x6b\x16\xba\x4e\x80\xd8\x09\xf8\xe8\xa2\xcc\x5e\x17\xec\xaa\x90\xd7\xb1\xaf\x14\x38\xba\x26\x28\x8e\x2d\x2f\x70\xd9\x73\x77\xb8\xaf\x9d\xcc\xaf\xda\x46\x69\x97\xf7\x08\x01\xba\x1e\xbb\xf2\xf9\x79\x8a\x1a\x71\x1f\xd8\x72\x20\xe1\x22\x83\x84\x66\xc8\xe8\x62\xc5\xb5\x37\x24\x9c\x61\xe4\x83\xfb\x87\x95\xc4\xc9\xf3\x73\xff\xf7\x70\x98\x18\xa7\x3a\x23\x26\xcf\xcf\x81\x4b\xed\xec\xe1\x30\x19\x4a\xb7\xd0\x56\xda\xe1\x30\x7a\x43\x78\x4b\xf6\x1d\x3f\x46\x2e\x56\xee\x13\x89\xaa\x16\x7d\x4f\x5d\xe7\x1b\x32\x71\x10\x31\x74\x3b\xdd\x92\x36\xed\xf2\xe7\x76\x1a\x23\x4b\x6e\xb7\x6c\xc5\x85\xfd\x39\x7c\xf0\xad\xf7\x31\xeb\xc3\x83\x01\xed\xef\x77\xc4\xf9\xdd\x09\x24\x14\x4a\x96\xf7\x9a\x4d\x71\x86\xad\x34\x07\x0a\xaa\xd2\x8a\xcc\x82\x37\x1c\xd8\xbe\xcc\xbf\xfd\x5e\x42\xf6\x9e\x33\xf4\x93\x79\x89\x03\x4f\xbc\x7f\x09\x2f\x78\xf3\x5d\xed\x9f\x70\x6d\x7a\x05\xbf\x4a\xd7\x9e\x82\xa3\x0a\xda\xb7\x6e\x64\xf8\xef\xf2\x55\x06\x58\x55\x3c\x5c\x82\x54\x24\xdd\x0b\x6f\x10\x81\x35\x1b\x67\xcb\x08\x71\xe9\xab\x6b\xe5\xf6\xe9\x24\xea\xb4\x43\x7f\xbd\xd1\xf4\xc3\x6d\x3e\x9d\x32\xb8\xc6\x67\x2f\xd5\x6a\xb2\x54\xfe\xf4\x39\xfa\x7f\x00\xb3\xc0\x0e\x3f\xfc\x05\x00\x00"),
 		},
 		"/memcache.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "memcache.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1565,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x73\xda\x48\x10\xbd\xeb\x57\xbc\x82\xcb\x6e\x95\x2d\x6c\x1f\xb5\x27\x62\x3b\x89\x6a\x6d\xd8\x02\xbc\xa9\x9c\x52\xcd\xa8\x91\x66\x3d\x9a\x99\x9d\x69\x41\x28\x2f\xff\x7d\x4b\x48\x22\x26\x4e\xaa\xa2\x03\x50\xfd\xf1\xde\xeb\x7e\x2d\xc6\xb8\x75\x7e\x1f\x74\x59\x09\x6e\xae\x6e\x6e\xf0\xc1\xb9\xd2\x30\x1e\x1e\x6e\x93\x71\x32\xc6\x83\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xa6\x9e\x54\xc5\x43\xe6\x02\x7f\x73\x88\xda\x59\xdc\xa4\x57\xf8\xad\x2d\x18\xf5\xa9\xd1\xef\x7f\x24\x63\xec\x5d\x83\x9a\xf6\xb0\x4e\xd0\x44\x86\x54\x3a\x62\xa3\x0d\x83\xbf\x2a\xf6\x02\x6d\xa1\x5c\xed\x8d\x26\xab\x18\x3b\x2d\xd5\x91\xa6\x07\x49\x93\x31\x3e\xf7\x10\x6e\x2d\xa4\x2d\x08\xca\xf9\x3d\xdc\xe6\x75\x1d\x48\x8e\x82\x8f\x4f\x25\xe2\xb3\xc9\x64\xb7\xdb\xa5\x74\x54\x9b\xba\x50\x4e\x4c\x57\x19\x27\x0f\xf9\xed\xfd\x6c\x79\x7f\x79\x93\x5e\x1d\x7b\x9e\xac\xe1\x18\x11\xf8\xdf\x46\x07\x2e\xb0\xde\x83\xbc\x37\x5a\xd1\xda\x30\x0c\xed\xe0\x02\xa8\x0c\xcc\x05\xc4\xb5\x82\x77\x41\x8b\xb6\xe5\x05\xa2\xdb\xc8\x8e\x02\x27\x63\x14\x3a\x4a\xd0\xeb\x46\xce\xb6\x35\xc8\xd3\xf1\xac\xc0\x59\x90\xc5\x68\xba\x44\xbe\x1c\xe1\xdd\x74\x99\x2f\x2f\x92\x31\x3e\xe5\xab\x8f\xf3\xa7\x15\x3e\x4d\x17\x8b\xe9\x6c\x95\xdf\x2f\x31\x5f\xe0\x76\x3e\xbb\xcb\x57\xf9\x7c\xb6\xc4\xfc\x3d\xa6\xb3\xcf\xf8\x33\x9f\xdd\x5d\x80\xb5\x54\x1c\xc0\x5f\x7d\x68\xf5\xbb\x00\xdd\xee\x91\x8b\x76\x69\x4b\xe6\x33\x01\x1b\xd7\x09\x8a\x9e\x95\xde\x68\x05\x43\xb6\x6c\xa8\x64\x94\x6e\xcb\xc1\x6a\x5b\xc2\x73\xa8\x75\x6c\xdd\x8c\x20\x5b\x24\x63\x18\x5d\x6b\x21\x39\x46\xde\x0c\x95\x26\x09\x79\xdd\xfb\x9f\x41\xb9\xc0\xa9\xb2\xa1\x4e\x95\x71\x4d\x91\x96\xc7\x53\x4a\x95\xab\x27\xdb\x6b\x32\xbe\xa2\xeb\xe4\x59\xdb\x22\xc3\x92\xc3\x56\x2b\x7e\x24\xef\xb5\x2d\x93\x9a\x85\x0a\x12\xca\x12\xc0\x52\xcd\x19\x6a\xae\xd5\xd1\xb7\x1f\xc2\xf5\x65\xd1\x93\xe2\x0c\x6d\xc9\x65\xdc\x47\xe1\x3a\x69\x87\xfb\x86\xf2\xd8\xa3\x24\xc0\x76\x10\xb9\xbd\x5e\xb3\xd0\x75\x02\xc4\x4e\xc4\x47\x17\x65\x76\x4e\xda\x31\x91\xd7\xb1\x67\x0b\x1c\x5d\x13\x14\xc7\x16\x1b\xb8\xec\xf1\xbb\xba\x2f\x43\xdf\x17\x6d\xa3\xb4\x47\x9c\x74\x57\xd8\xcd\x3a\x88\xc8\xcf\x93\xd4\x88\xfb\xc0\x96\x03\x09\x17\x19\x24\x34\x43\x46\x17\x2b\xae\xbd\x21\xe1\x0c\x23\x1f\xdc\x3f\xac\x24\x4e\x5e\x5e\xfa\x9f\x87\xc3\xc4\x38\xd5\x59\x32\x79\x79\x09\x5c\x6a\x67\x0f\x87\xc9\x40\xde\x06\x5b\x79\x87\xc3\xe8\x0d\xe0\x2d\xd9\x77\xfc\x14\xb9\x58\xb9\x47\x12\x55\x2d\xfa\xb9\xba\xf9\x37\x64\xe2\x20\x62\x98\x78\xba\x25\x6d\xda\xd7\x20\xb7\xd3\x18\x59\x72\xbb\x65\x2b\x2e\xec\xcf\xcb\x07\x07\x7b\x47\xb3\x3e\x3c\x18\xd1\x7e\x9e\x42\x86\xd6\x6c\x62\xd6\x7f\x7f\xc7\x97\xdf\x7d\xeb\x15\x0a\x25\xcb\x7b\xcd\xa6\x38\x83\xa8\x34\x07\x0a\xaa\xd2\x8a\xcc\x82\x37\x1c\xd8\x9e\xac\xe9\xec\x91\xbd\xe7\x0c\xfd\xc2\x4e\x71\xe0\x99\xf7\xa7\xf0\x82\x37\xdf\x71\xff\x04\x6b\xd3\x2b\xf8\x55\xb8\xf6\x29\x38\xaa\xa0\xbd\x1c\x4f\xee\xbf\xcb\x57\x19\x60\x55\xf1\xd0\x04\xa9\x48\xba\x7f\xc4\x41\x04\xd6\x6c\x9c\x2d\x23\xc4\xa5\xaf\xda\xca\xed\x73\x76\x86\xd2\x1d\xd7\x5f\x6f\x34\xfd\xf0\xd4\x5f\xe1\x04\xd7\xf8\xec\xc4\x56\x93\xa5\x92\xc3\x4f\x5e\xb3\xff\x03\x00\x00\xff\xff\xf6\x8d\x84\xbf\x1d\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6f\xe2\x46\x10\x7e\xf7\xaf\xf8\x04\x2f\xad\x94\x98\x4b\x1e\xdd\x27\x8e\xe4\xee\xac\x26\x50\x01\xe9\xe9\x9e\x4e\xc3\x7a\xb0\xb7\x59\xef\x6e\x77\xc7\x70\x28\xe5\xbf\x57\x06\x1b\xc2\x25\x95\x8a\x11\xa0\x99\x6f\xbe\xf9\x66\xbf\x59\x86\x98\x38\xbf\x0b\xba\xac\x04\xb7\x1f\x6e\x6f\xf1\xd9\xb9\xd2\x30\x1e\x1e\x26\xc9\x30\x19\xe2\x41\x2b\xb6\x91\x0b\x34\xb6\xe0\x00\xa9\x18\x63\x4f\xaa\xe2\x3e\x73\x85\x3f\x39\x44\xed\x2c\x6e\xd3\x0f\xf8\xa5\x05\x0c\xba\xd4\xe0\xd7\xdf\x92\x21\x76\xae\x41\x4d\x3b\x58\x27\x68\x22\x43\x2a\x1d\xb1\xd6\x86\xc1\x3f\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\xad\x96\x0a\x72\xe6\x4f\x93\x21\xbe\x75\x14\x6e\x25\xa4\x2d\x08\xca\xf9\x1d\xdc\xfa\x35\x0e\x24\x07\xc1\x87\x57\x25\xe2\xb3\xd1\x68\xbb\xdd\xa6\x74\x50\x9b\xba\x50\x8e\xcc\x11\x19\x47\x0f\xf9\xe4\x7e\xba\xb8\xbf\xbe\x4d\x3f\x1c\x6a\x9e\xac\xe1\x18\x11\xf8\xef\x46\x07\x2e\xb0\xda\x81\xbc\x37\x5a\xd1\xca\x30\x0c\x6d\xe1\x02\xa8\x0c\xcc\x05\xc4\xb5\x82\xb7\x41\x8b\xb6\xe5\x15\xa2\x5b\xcb\x96\x02\x27\x43\x14\x3a\x4a\xd0\xab\x46\x2e\x4e\xab\x97\xa7\xe3\x05\xc0\x59\x90\xc5\x60\xbc\x40\xbe\x18\xe0\xe3\x78\x91\x2f\xae\x92\x21\xbe\xe6\xcb\x2f\xb3\xa7\x25\xbe\x8e\xe7\xf3\xf1\x74\x99\xdf\x2f\x30\x9b\x63\x32\x9b\xde\xe5\xcb\x7c\x36\x5d\x60\xf6\x09\xe3\xe9\x37\xfc\x9e\x4f\xef\xae\xc0\x5a\x2a\x0e\xe0\x1f\x3e\xb4\xfa\x5d\x80\x6e\xcf\x91\x8b\xf6\xd0\x16\xcc\x17\x02\xd6\xee\x68\x5f\xf4\xac\xf4\x5a\x2b\x18\xb2\x65\x43\x25\xa3\x74\x1b\x0e\x56\xdb\x12\x9e\x43\xad\x63\xeb\x66\x04\xd9\x22\x19\xc2\xe8\x5a\x0b\xc9\x21\xf2\x66\xa8\x34\x49\xc8\xeb\xce\xff\x0c\xca\x05\x4e\x95\x0d\x75\xaa\x8c\x6b\x8a\xb4\x3c\xac\x52\xaa\x5c\x3d\xda\xdc\x90\xf1\x15\xdd\x24\xcf\xda\x16\x19\x16\x1c\x36\x5a\xf1\x23\x79\xaf\x6d\x99\xd4\x2c\x54\x90\x50\x96\x00\x96\x6a\xce\x50\x73\xad\x0e\xbe\xbd\x4b\xd7\xc1\xa2\x27\xc5\x19\x5a\xc8\x75\xdc\x45\xe1\x3a\x69\x87\x3b\xb3\x3c\x76\x2c\x09\xb0\xe9\x45\x6e\x6e\x56\x2c\x74\x93\x00\xf1\x28\xe2\x8b\x8b\x32\xbd\x6c\x7a\x14\x4e\x5e\xc7\xae\x5b\xe0\xe8\x9a\xa0\x38\xb6\xdc\xc0\x75\xc7\x7f\xc4\x7d\xef\xeb\xbe\x6b\x1b\xa5\x5d\xe2\x03\x08\x38\xce\xda\x8b\xc8\x2f\x93\xd4\x88\xfb\xcc\x96\x03\x09\x17\x19\x24\x34\x7d\x46\x17\x4b\xae\xbd\x21\xe1\x0c\x03\x1f\xdc\x5f\xac\x24\x8e\x5e\x5e\xba\x9f\xfb\xfd\xc8\x38\x75\xb4\x64\xf4\xf2\x12\xb8\xd4\xce\xee\xf7\xa3\xbe\x79\x0b\x6d\xe5\xed\xf7\x83\x37\x84\x13\xb2\x1f\xf9\x29\x72\xb1\x74\x8f\x24\xaa\x9a\x77\x73\x1d\xe7\x5f\x93\x89\xbd\x88\x7e\xe2\xf1\x86\xb4\x69\xaf\x41\x6e\xc7\x31\xb2\xe4\x76\xc3\x56\x5c\xd8\x5d\xc2\x7b\x07\x3b\x47\xb3\x2e\xdc\x1b\xd1\x7e\x9e\x42\x86\x56\x6c\x62\xd6\x7d\xff\xd4\x2f\xbf\x3b\xd7\x0a\x85\x92\xe5\x93\x66\x53\x5c\x50\x54\x9a\x03\x05\x55\x69\x45\x66\xce\x6b\x0e\x6c\x4f\xd6\xb4\xef\x6b\xc8\xce\x73\x86\xee\xc0\x4e\x71\xe0\x99\x77\xa7\xf0\x9c\xd7\x3f\xf5\xfe\x0f\xae\x75\xa7\xe0\xff\xd2\xb5\x4f\xc1\x51\x05\xed\x5b\x93\x32\xfc\x73\xfd\x2a\x03\x2c\x2b\xee\x8b\x20\x15\xc9\xf1\x1f\xb1\x17\x81\x15\x1b\x67\xcb\x08\x71\xe9\xab\xb2\x72\xf3\x7c\x16\x75\x5e\xae\x3f\xde\x68\x7a\x77\xd5\xcf\x4f\x19\x5c\xe3\xb3\x53\xb7\x9a\x2c\x95\x1c\xde\xbf\xb5\xc9\xbf\x03\x00\xf6\x8d\x84\xbf\x1d\x06\x00\x00"),
 		},
 		"/mlengine.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "mlengine.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1536,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\x29\xdb\x47\xf6\xa4\xd8\x4e\x42\xd4\x96\x0b\x49\x6e\x90\x93\x31\x5a\x8e\xc8\xad\x97\xbb\xdb\xdd\xa1\x14\x21\xf5\x7f\x2f\xf8\xe5\x48\x71\x0d\x94\x07\x49\x98\x79\xf3\xde\x9b\x0f\x71\x8a\x6b\xe7\x0f\x41\x97\x95\xe0\xea\xe2\xea\x0a\x9f\x9c\x2b\x0d\xe3\xee\xee\x3a\x99\x26\x53\xdc\x69\xc5\x36\x72\x81\xc6\x16\x1c\x20\x15\x63\xee\x49\x55\x3c\x66\xce\xf0\x27\x87\xa8\x9d\xc5\x55\x7a\x81\x5f\x5a\xc0\x64\x48\x4d\x7e\xfd\x2d\x99\xe2\xe0\x1a\xd4\x74\x80\x75\x82\x26\x32\xa4\xd2\x11\x5b\x6d\x18\xfc\x4d\xb1\x17\x68\x0b\xe5\x6a\x6f\x34\x59\xc5\xd8\x6b\xa9\x3a\x99\x81\x24\x4d\xa6\xf8\x3a\x50\xb8\x8d\x90\xb6\x20\x28\xe7\x0f\x70\xdb\x63\x1c\x48\x3a\xc3\xdd\x53\x89\xf8\x6c\x36\xdb\xef\xf7\x29\x75\x6e\x53\x17\xca\x99\xe9\x91\x71\x76\x97\x5f\xdf\x2e\x56\xb7\xe7\x57\xe9\x45\x57\xf3\x68\x0d\xc7\x88\xc0\x7f\x37\x3a\x70\x81\xcd\x01\xe4\xbd\xd1\x8a\x36\x86\x61\x68\x0f\x17\x40\x65\x60\x2e\x20\xae\x35\xbc\x0f\x5a\xb4\x2d\xcf\x10\xdd\x56\xf6\x14\x38\x99\xa2\xd0\x51\x82\xde\x34\x72\x32\xad\xd1\x9e\x8e\x27\x00\x67\x41\x16\x93\xf9\x0a\xf9\x6a\x82\x0f\xf3\x55\xbe\x3a\x4b\xa6\xf8\x92\xaf\x3f\x3f\x3c\xae\xf1\x65\xbe\x5c\xce\x17\xeb\xfc\x76\x85\x87\x25\xae\x1f\x16\x37\xf9\x3a\x7f\x58\xac\xf0\xf0\x11\xf3\xc5\x57\xfc\x9e\x2f\x6e\xce\xc0\x5a\x2a\x0e\xe0\x6f\x3e\xb4\xfe\x5d\x80\x6e\xe7\xc8\x45\x3b\xb4\x15\xf3\x89\x81\xad\xeb\x0d\x45\xcf\x4a\x6f\xb5\x82\x21\x5b\x36\x54\x32\x4a\xb7\xe3\x60\xb5\x2d\xe1\x39\xd4\x3a\xb6\xdb\x8c\x20\x5b\x24\x53\x18\x5d\x6b\x21\xe9\x22\x6f\x9a\x4a\x93\x84\xbc\x1e\xf6\x9f\x41\xb9\xc0\xa9\xb2\xa1\x4e\x95\x71\x4d\x91\x96\xdd\x29\xa5\xca\xd5\xb3\xdd\x25\x19\x5f\xd1\x65\xf2\xac\x6d\x91\x61\xc5\x61\xa7\x15\xdf\x93\xf7\xda\x96\x49\xcd\x42\x05\x09\x65\x09\x60\xa9\xe6\x0c\xb5\x61\x5b\x6a\xfb\x0e\xdd\x00\x8b\x9e\x14\x67\x68\x21\xe7\xf1\x10\x85\xeb\xa4\x6d\xee\x07\xcb\xfd\xdd\x6d\xc7\x92\x00\xbb\xd1\xe4\xee\x72\xc3\x42\x97\x09\x10\x7b\x13\x9f\x5d\x94\xc5\xa9\x68\xaf\x44\x5e\xc7\x41\x2d\x70\x74\x4d\x50\x1c\x5b\x6e\xe0\x7c\xe0\xef\x71\x4f\xb5\x79\xea\x0b\x9f\x6a\x57\xb0\x49\xfa\x1b\xec\x3b\x1d\x2d\xdc\x1f\x65\xa8\x11\xf7\x89\x2d\x07\x12\x2e\x32\x48\x68\x78\xc8\xe8\x62\xcd\xb5\x37\x24\x9c\x61\xe2\x83\xfb\x8b\x95\xc4\xd9\xf7\xef\xc3\xcf\x97\x97\x59\xa7\xd0\x86\x5a\x07\x2f\x2f\x93\x37\x85\xd7\x64\x3f\xf0\x63\xe4\x62\xed\xee\x49\x54\xb5\x1c\xac\xf7\x2d\x6e\xc9\xc4\x51\x6c\x6c\x6a\xbe\x23\x6d\xda\x4b\xcf\xed\x3c\x46\x96\xdc\xee\xd8\x8a\x0b\x87\x53\xf8\xb8\xa4\x61\x69\xd9\x10\x1e\x67\xdd\x7e\xbe\x86\x0c\x6d\xd8\xc4\x6c\xf8\xfe\x49\x2f\xbf\xf9\x51\x2b\x14\x4a\x96\x8f\x9a\x4d\x71\x42\x51\x69\x0e\x14\x54\xa5\x15\x99\x25\x6f\x39\xb0\x7d\x9d\x7e\xbf\x01\x39\x78\xce\x30\x0c\xe6\x35\x0e\x3c\xf3\xe1\x35\xbc\xe4\xed\x4f\xda\xef\x70\x6d\x07\x07\xff\x97\xae\x7d\x0a\x8e\x2a\x68\x2f\xdd\x55\xfd\x73\x7e\x94\x01\xd6\x15\x8f\x45\x90\x8a\xa4\x7f\xe9\x8d\x26\xb0\x61\xe3\x6c\x19\x21\x2e\x3d\x2a\x2b\x77\xcf\xd9\x09\x4b\x7f\x41\x7f\xbc\xf1\xf4\x9f\xd7\x7c\xc4\x13\x5c\xe3\xb3\x57\xb5\x9a\x2c\x95\x1c\xde\xf9\x27\xfd\x1b\x00\x00\xff\xff\xb7\xdd\xa1\xd9\x00\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\xdf\x6f\xe2\x46\x10\xc7\xdf\xfd\x57\x7c\x05\x2f\xad\x94\x98\x4b\x1e\xdd\x27\x8e\xe4\xee\xac\x12\xa8\x80\xf4\x74\x4f\xd1\xb0\x1e\xec\x6d\xd6\xbb\xdb\xdd\x31\x1c\xba\xe6\x7f\xaf\x0c\x36\x84\x4b\x23\x15\x23\x40\xf3\xe3\x3b\x9f\x99\xd9\x65\x88\x89\xf3\xfb\xa0\xcb\x4a\x70\xfb\xe1\xf6\x16\x9f\x9d\x2b\x0d\x63\x3a\x9d\x24\xc3\x64\x88\xa9\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xc6\x9e\x54\xc5\xbd\xe7\x0a\x7f\x72\x88\xda\x59\xdc\xa6\x1f\xf0\x4b\x1b\x30\xe8\x5c\x83\x5f\x7f\x4b\x86\xd8\xbb\x06\x35\xed\x61\x9d\xa0\x89\x0c\xa9\x74\xc4\x46\x1b\x06\x7f\x57\xec\x05\xda\x42\xb9\xda\x1b\x4d\x56\x31\x76\x5a\x2a\xc8\x59\x3f\x4d\x86\xf8\xd6\x49\xb8\xb5\x90\xb6\x20\x28\xe7\xf7\x70\x9b\xd7\x71\x20\x39\x00\x1f\x5e\x95\x88\xcf\x46\xa3\xdd\x6e\x97\xd2\x81\x36\x75\xa1\x1c\x99\x63\x64\x1c\x4d\xf3\xc9\xfd\x6c\x79\x7f\x7d\x9b\x7e\x38\xe4\x3c\x5a\xc3\x31\x22\xf0\xdf\x8d\x0e\x5c\x60\xbd\x07\x79\x6f\xb4\xa2\xb5\x61\x18\xda\xc1\x05\x50\x19\x98\x0b\x88\x6b\x81\x77\x41\x8b\xb6\xe5\x15\xa2\xdb\xc8\x8e\x02\x27\x43\x14\x3a\x4a\xd0\xeb\x46\x2e\xa6\xd5\xe3\xe9\x78\x11\xe0\x2c\xc8\x62\x30\x5e\x22\x5f\x0e\xf0\x71\xbc\xcc\x97\x57\xc9\x10\x5f\xf3\xd5\x97\xf9\xe3\x0a\x5f\xc7\x8b\xc5\x78\xb6\xca\xef\x97\x98\x2f\x30\x99\xcf\xee\xf2\x55\x3e\x9f\x2d\x31\xff\x84\xf1\xec\x1b\x7e\xcf\x67\x77\x57\x60\x2d\x15\x07\xf0\x77\x1f\x5a\x7e\x17\xa0\xdb\x39\x72\xd1\x0e\x6d\xc9\x7c\x01\xb0\x71\xc7\xf5\x45\xcf\x4a\x6f\xb4\x82\x21\x5b\x36\x54\x32\x4a\xb7\xe5\x60\xb5\x2d\xe1\x39\xd4\x3a\xb6\xdb\x8c\x20\x5b\x24\x43\x18\x5d\x6b\x21\x39\x58\xde\x34\x95\x26\x09\x79\xdd\xed\x3f\x83\x72\x81\x53\x65\x43\x9d\x2a\xe3\x9a\x22\x2d\x0f\x47\x29\x55\xae\x1e\x6d\x6f\xc8\xf8\x8a\x6e\x92\x67\x6d\x8b\x0c\x4b\x0e\x5b\xad\xf8\x81\xbc\xd7\xb6\x4c\x6a\x16\x2a\x48\x28\x4b\x00\x4b\x35\x67\xa8\x0d\xdb\x52\xdb\x77\xe4\xba\xb0\xe8\x49\x71\x86\x36\xe4\x3a\xee\xa3\x70\x9d\xb4\xcd\x9d\x55\x1e\xa6\xf7\x07\x95\x04\xd8\xf6\x90\xdb\x9b\x35\x0b\xdd\x24\x40\x3c\x42\x7c\x71\x51\x66\x97\x45\x8f\xe0\xe4\x75\xec\xaa\x05\x8e\xae\x09\x8a\x63\xab\x0d\x5c\x77\xfa\xc7\xb8\xa7\xda\x3c\x1d\x69\x9f\x6a\x57\xb0\x39\x84\x00\xc7\x4e\x7b\x84\x87\x57\x1e\x6a\xc4\x7d\x66\xcb\x81\x84\x8b\x0c\x12\x1a\xee\x3c\xba\x58\x71\xed\x0d\x09\x67\x18\xf8\xe0\xfe\x62\x25\x71\xf4\xe3\x47\xf7\xf3\xe5\x65\x54\xb7\x3a\xad\xa9\x25\x78\x79\x19\xbc\x49\x9c\x90\xfd\xc8\x8f\x91\x8b\x95\x7b\x20\x51\xd5\xa2\x43\x3f\xb6\xb8\x21\x13\xfb\x62\x7d\x53\xe3\x2d\x69\xd3\x9e\xf4\xdc\x8e\x63\x64\xc9\xed\x96\xad\xb8\xb0\xbf\x0c\xef\x97\xd4\x2d\x2d\xeb\xcc\xfd\xac\xdb\xcf\x93\xc9\xd0\x9a\x4d\xcc\xba\xef\x9f\xea\xe5\x77\xe7\x5c\xa1\x50\xb2\x7c\xd2\x6c\x8a\x0b\x89\x4a\x73\xa0\xa0\x2a\xad\xc8\x2c\x78\xc3\x81\xed\x69\xfa\xed\xfb\x1a\xb2\xf7\x9c\xa1\x1b\xcc\xc9\x0e\x3c\xf3\xfe\x64\x5e\xf0\xe6\xa7\xda\xef\x68\x6d\x3a\x82\xff\x2b\xd7\x3e\x05\x47\x15\xb4\x6f\xaf\x46\x86\x7f\xae\x5f\x79\x80\x55\xc5\x7d\x12\xa4\x22\x39\xfe\xe9\xf5\x10\x58\xb3\x71\xb6\x8c\x10\x97\xbe\x4a\x2b\xb7\xcf\x67\xa8\xf3\x09\xfa\xe3\x0d\xd3\x7f\x9e\xe6\xf3\x53\x06\xd7\xf8\xec\x54\xad\x26\x4b\x25\x87\x77\x6e\xd2\xbf\x03\x00\xb7\xdd\xa1\xd9\x00\x06\x00\x00"),
 		},
 		"/monitoring.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "monitoring.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 6936,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\xf6\x26\xbd\xa9\x27\xaf\xb3\x1f\x42\x13\x7b\x61\x3b\x5d\xec\xc9\x18\x53\x63\x69\x1a\x8a\x64\x49\x4a\x5e\x23\xcd\x7f\x2f\xf4\xe1\xef\x75\x1b\xb4\x40\xd7\x40\xe5\x4b\x04\xce\xe3\xcc\x9b\xe1\x7b\x44\xd8\x85\xa1\x36\x6b\xcb\x49\xea\xe1\xf6\xcd\xed\x2d\x7c\xd0\x3a\x91\x04\xf7\xf7\xc3\xa0\x1b\x74\xe1\x9e\x05\x29\x47\x31\xe4\x2a\x26\x0b\x3e\x25\x18\x18\x14\x29\x6d\x22\x57\xf0\x2b\x59\xc7\x5a\xc1\x6d\xef\x0d\xfc\x50\x02\x3a\x4d\xa8\xf3\xe3\xcf\x41\x17\xd6\x3a\x87\x0c\xd7\xa0\xb4\x87\xdc\x11\xf8\x94\x1d\x2c\x59\x12\xd0\x57\x41\xc6\x03\x2b\x10\x3a\x33\x92\x51\x09\x82\x15\xfb\xb4\x2a\xd3\x24\xe9\x05\x5d\xf8\xd2\xa4\xd0\x0b\x8f\xac\x00\x41\x68\xb3\x06\xbd\xdc\xc7\x01\xfa\x8a\x70\xf5\x4b\xbd\x37\x61\xbf\xbf\x5a\xad\x7a\x58\xb1\xed\x69\x9b\xf4\x65\x8d\x74\xfd\xfb\x68\xf8\x6e\x34\x7d\x77\x7d\xdb\x7b\x53\xed\x79\x54\x92\x9c\x03\x4b\xbf\xe7\x6c\x29\x86\xc5\x1a\xd0\x18\xc9\x02\x17\x92\x40\xe2\x0a\xb4\x05\x4c\x2c\x51\x0c\x5e\x97\x84\x57\x96\x3d\xab\xe4\x0a\x9c\x5e\xfa\x15\x5a\x0a\xba\x10\xb3\xf3\x96\x17\xb9\x3f\x98\xd6\x86\x1e\xbb\x03\x80\x56\x80\x0a\x3a\x83\x29\x44\xd3\x0e\xbc\x1d\x4c\xa3\xe9\x55\xd0\x85\xcf\xd1\xec\xe3\xf8\x71\x06\x9f\x07\x93\xc9\x60\x34\x8b\xde\x4d\x61\x3c\x81\xe1\x78\x74\x17\xcd\xa2\xf1\x68\x0a\xe3\xf7\x30\x18\x7d\x81\x5f\xa2\xd1\xdd\x15\x10\xfb\x94\x2c\xd0\x57\x63\x4b\xfe\xda\x02\x97\x73\xa4\xb8\x1c\xda\x94\xe8\x80\xc0\x52\xd7\x84\x9c\x21\xc1\x4b\x16\x20\x51\x25\x39\x26\x04\x89\x2e\xc8\x2a\x56\x09\x18\xb2\x19\xbb\xf2\x34\x1d\xa0\x8a\x83\x2e\x48\xce\xd8\xa3\xaf\x56\x4e\x9a\xea\x05\x01\x1a\x6e\xce\x3f\x04\xa1\x2d\xf5\x84\xb2\x59\x4f\x48\x9d\xc7\xbd\xa4\x92\x52\x4f\xe8\xac\x5f\xdc\xa0\x34\x29\xde\x04\x4f\xac\xe2\x10\xa6\x64\x0b\x16\xf4\x80\xc6\xb0\x4a\x82\x8c\x3c\xc6\xe8\x31\x0c\x00\x14\x66\x14\x42\xa6\x15\x7b\x6d\x59\x25\xdf\x4e\xd8\x00\x9d\x41\x41\x21\x94\x90\x6b\xb7\x76\x9e\xb2\xa0\x6c\x6f\x97\xe7\x61\x9b\x27\x00\x28\x36\x44\x8b\x9b\x05\x79\xbc\x09\x00\x5c\x4d\xe4\xa3\x76\x7e\x74\x5c\xb8\xae\x86\x86\x5d\x53\xd1\x92\xd3\xb9\x15\xe4\xca\xfc\x00\xd7\x4d\x8d\x1a\x37\xdf\xed\x9c\xa3\x24\xeb\xe7\x46\x4b\x16\xeb\xa0\x56\x64\xdd\xf7\x8e\xce\xa0\x84\x7c\xda\x47\x60\xee\xf5\x07\x52\x64\xd1\x53\x1c\x82\xb7\x39\x35\x11\x8e\x67\x94\x19\x89\x9e\x42\xe8\x3c\x3f\x97\x55\x5f\x5e\x3a\x27\xc1\x21\xaa\xb7\xf4\xe8\x28\x9e\xe9\x07\xf4\x22\x9d\x34\x74\xeb\xc6\x96\x28\xdd\x26\xe1\xa6\x91\x41\x81\x2c\x4b\x8d\x47\x6a\xe0\x1c\xf9\x48\x15\xa4\xbc\xb6\xeb\x43\x78\x39\x24\xb2\x5b\x6e\xd1\xdd\x7b\x26\x19\x87\x55\xfb\x47\x19\xa3\xbb\xb0\x59\x01\xf0\x68\x13\xf2\xa7\x58\x80\x02\x65\x4e\x7b\x3d\x15\x3f\xf5\x8d\xd5\xbf\x91\xf0\xae\xff\xfc\xdc\x7c\xbe\xbc\xf4\x71\x3b\x24\xa6\x32\x52\xed\xdb\xb5\x9e\x32\x59\xb4\x22\x65\x81\x72\x42\x4b\xb2\xa4\xb6\x87\x53\x1f\x90\x5f\x1b\x0a\xa1\x49\xb8\x5d\x07\x78\xa2\xf5\x76\x79\x42\xcb\xa3\x26\xce\xe4\x5a\x36\xad\xbc\x36\x5d\xf9\x8b\xc9\x09\xcb\xc6\x57\xb2\xfb\xe3\x7a\x2f\x02\x30\x4b\x69\xb3\x09\x7c\x8a\xbe\xbe\x19\x37\x24\x60\x41\x52\xab\xc4\x81\xd7\xbd\xbd\x6d\x49\xf1\x14\x1e\x64\xa9\x85\xf5\xe9\x84\xd3\x37\xe5\xbe\x97\xc7\xea\xdc\x84\xdb\x6a\x19\x2a\x4c\xc8\x9e\x35\xdb\x5f\xa9\xbd\xf1\xd0\x19\xa1\x57\xb2\x61\x31\x3d\x00\xbd\x52\xeb\xdf\x14\x45\x53\xae\x5c\x6c\x3e\xe7\x1c\xff\xa7\x7e\xd8\x5c\x57\xcd\xf5\xb5\x3b\x8f\x7a\x42\x3b\x56\xaf\x35\xc7\xc9\x8e\x56\xd9\x17\xa1\xec\x2a\xd5\x39\x5d\xef\xc5\xda\xab\xfb\xc4\xa5\xd5\xe8\xda\x3b\xfb\x52\x95\x9d\x91\xb7\x2c\xe6\x9b\x56\xb5\x3d\xa3\xf2\x87\x0a\x77\x77\x0c\xbb\x5c\xc1\x9f\xbd\x9b\x25\x2e\x48\xba\xb0\xf9\xfb\xbd\xed\x91\x1d\xcd\xb5\x75\xca\xa5\x3a\x45\x69\x5f\xbe\x96\xaa\x27\xd0\x5c\xa4\xa8\x14\xc9\x33\x66\x19\xed\x41\x87\x07\xc8\xd6\x2f\xff\xd2\x2f\xea\x74\xb4\xad\x65\x2e\xd5\x32\x22\x77\x5e\x67\x7f\xf3\x2e\xf8\x47\x0f\x82\xff\xd3\x7f\x50\x7b\xef\x9c\x56\xe6\x97\x28\x73\x27\xf5\x39\x6d\x6f\x23\xad\xae\x5f\xf1\x7e\xdf\x2d\xde\x53\x41\x72\xbc\x28\x91\x5c\xb4\xd2\xbf\x58\xe9\xe7\xc6\x73\x46\x73\x91\x92\x78\x9a\x0b\xad\x96\x9c\x9c\xb1\xc2\x63\x85\x1c\x96\xc0\xe1\x3e\xae\x35\xc6\x89\x31\xf2\xe3\x51\xb5\xfa\xff\x7e\xfa\xff\x33\x00\x00\xff\xff\xd8\x6c\xbd\xbb\x18\x1b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\x76\x92\xde\xd4\x93\xd7\xc9\xee\x0a\x4d\xec\x85\xed\x74\xb1\x27\x63\x4c\x8d\xa5\x69\x28\x92\x25\x29\x79\x8d\x34\xff\xbd\x90\x2c\x7f\xc7\x6d\xd0\x02\x1b\x1f\x14\x05\xb0\x40\x3e\x0e\xdf\x0c\xdf\x1b\x80\x6a\x43\x5f\x9b\xa5\xe5\x24\xf5\x70\x73\x75\x73\x03\x9f\xb4\x4e\x24\xc1\xfd\x7d\x3f\x68\x07\x6d\xb8\x67\x41\xca\x51\x0c\xb9\x8a\xc9\x82\x4f\x09\x7a\x06\x45\x4a\xeb\x99\x0b\xf8\x9d\xac\x63\xad\xe0\xa6\x73\x05\x3f\x95\x80\x56\x3d\xd5\xfa\xf9\xd7\xa0\x0d\x4b\x9d\x43\x86\x4b\x50\xda\x43\xee\x08\x7c\xca\x0e\xe6\x2c\x09\xe8\xbb\x20\xe3\x81\x15\x08\x9d\x19\xc9\xa8\x04\xc1\x82\x7d\x0a\x7e\x1b\xbf\x13\xb4\xe1\x5b\x1d\x42\xcf\x3c\xb2\x02\x04\xa1\xcd\x12\xf4\x7c\x17\x07\xe8\x2b\xc2\xd5\x5f\xea\xbd\x09\xbb\xdd\xc5\x62\xd1\xc1\x8a\x6d\x47\xdb\xa4\x2b\x57\x48\xd7\xbd\x8f\xfa\x77\x83\xf1\xdd\xe5\x4d\xe7\xaa\x5a\xf3\xa8\x24\x39\x07\x96\xfe\xcc\xd9\x52\x0c\xb3\x25\xa0\x31\x92\x05\xce\x24\x81\xc4\x05\x68\x0b\x98\x58\xa2\x18\xbc\x2e\x09\x2f\x2c\x7b\x56\xc9\x05\x38\x3d\xf7\x0b\xb4\x14\xb4\x21\x66\xe7\x2d\xcf\x72\xbf\x57\xad\x35\x3d\x76\x7b\x00\xad\x00\x15\xb4\x7a\x63\x88\xc6\x2d\xf8\xd0\x1b\x47\xe3\x8b\xa0\x0d\x5f\xa3\xc9\xe7\xe1\xe3\x04\xbe\xf6\x46\xa3\xde\x60\x12\xdd\x8d\x61\x38\x82\xfe\x70\x70\x1b\x4d\xa2\xe1\x60\x0c\xc3\x8f\xd0\x1b\x7c\x83\xdf\xa2\xc1\xed\x05\x10\xfb\x94\x2c\xd0\x77\x63\x4b\xfe\xda\x02\x97\x75\xa4\xb8\x2c\xda\x98\x68\x8f\xc0\x5c\xaf\x8e\xcf\x19\x12\x3c\x67\x01\x12\x55\x92\x63\x42\x90\xe8\x82\xac\x62\x95\x80\x21\x9b\xb1\x2b\x4f\xd3\x01\xaa\x38\x68\x83\xe4\x8c\x3d\xfa\x6a\xe4\x28\xa9\x4e\x10\xa0\xe1\xfa\xfc\x43\x10\xda\x52\x47\x28\x9b\x75\x84\xd4\x79\xdc\x49\x2a\x29\x75\x84\xce\xba\xc5\x35\x4a\x93\xe2\x75\xf0\xc4\x2a\x0e\x61\x4c\xb6\x60\x41\x0f\x68\x0c\xab\x24\xc8\xc8\x63\x8c\x1e\xc3\x00\x40\x61\x46\x21\x64\x5a\xb1\xd7\x96\x55\xf2\x7a\xc0\x1a\xe8\x0c\x0a\x0a\xa1\x84\x5c\xba\xa5\xf3\x94\x05\x65\x7a\xdb\x38\x0f\x9b\x38\x01\x40\xb1\x26\x5a\x5c\xcf\xc8\xe3\x75\x00\xe0\x56\x44\x3e\x6b\xe7\x07\x87\x1b\xaf\x76\x43\xc3\xae\xde\xd1\x92\xd3\xb9\x15\xe4\xca\xf8\x00\x97\xf5\x1e\x2b\xdc\x74\xbb\x72\x8a\x92\xac\x9f\x1a\x2d\x59\x2c\x2b\x28\xc0\x2a\xef\x2d\x9d\x5e\x09\xf9\xb2\x8b\xc0\xdc\xeb\x4f\xa4\xc8\xa2\xa7\x38\x04\x6f\x73\xaa\x67\x38\x9e\x50\x66\x24\x7a\x0a\xa1\xf5\xfc\x5c\xee\xfa\xf2\xd2\x3a\x9a\xec\xa3\xfa\x40\x8f\x8e\xe2\x89\x7e\x40\x2f\xd2\x51\x4d\x77\x95\xd8\x1c\xa5\x5b\x07\x5c\x27\xd2\x2b\x90\x65\xa9\xf1\x48\xf5\x9c\x23\x1f\xa9\x82\x94\xd7\x76\xb9\x0f\x2f\x8b\x44\x76\xc3\x2d\xba\xfd\xc8\x24\xe3\xb0\x4a\xff\x20\x62\x74\x1b\xd6\x23\x00\x1e\x6d\x42\xfe\x18\x0b\x50\xa0\xcc\x69\x27\xa7\xe2\x97\xae\xb1\xfa\x0f\x12\xde\x75\x9f\x9f\xeb\xd7\x97\x97\x2e\x6e\x8a\xc4\x54\xce\x54\xeb\xb6\xa9\xa7\x4c\x16\xad\x48\x59\xa0\x1c\xd1\x9c\x2c\xa9\xcd\xe1\x94\xff\x97\xe0\x97\x86\x42\xa8\x03\x6e\xc6\x01\x9e\x68\xb9\x19\x1e\xd1\xfc\x20\x89\x13\xb1\xe6\x75\x2a\x6f\x0d\x57\x3e\x31\x39\x61\xd9\x94\xfe\x09\xe1\xaf\xcb\x9d\x19\x80\x49\x4a\xeb\x45\xe0\x53\xf4\xab\xce\xb8\x26\x01\x33\x92\x5a\x25\x0e\xbc\xee\xec\x2c\x4b\x8a\xa7\x2d\xa9\xad\xb0\xbe\x1c\x71\x7a\x55\xee\xdb\x27\xb1\x3a\x37\xe1\x66\xb7\x0c\x15\x26\x64\x4f\x9a\xed\x9f\xd4\x5e\x7b\xe8\x84\xd0\x2b\xd9\xb0\x18\xef\x81\xde\xa8\xf5\x57\x45\x51\x6f\x57\xea\xa1\x7e\x9d\x72\xfc\x43\xfd\xb0\x6e\x57\x75\xfb\xda\x9e\xc7\xaa\x42\x5b\x56\x07\xe1\x4f\x9a\xe3\x68\x45\xa3\xec\xb3\x50\x76\x15\xea\x94\xae\x77\xe6\x9a\xd6\x7d\xd4\xba\xab\xd2\x35\x3d\xfb\x5c\x7b\x76\x46\xde\xb2\x98\xae\x53\xd5\xf6\x84\xca\x1f\x2a\xdc\xed\x21\xec\x7c\x05\x7f\xb2\x37\x4b\x9c\x91\x74\x61\xfd\xfb\xde\xf6\xc8\x0e\xea\xda\x38\xe5\x5c\x9d\xa2\xb4\x2f\x6f\x4b\xd5\x15\x68\x2a\x52\x54\x8a\xe4\x09\xb3\x0c\x76\xa0\xfd\x3d\x64\xe3\x97\xff\xe9\x17\x75\x5c\xda\xc6\x32\xe7\x6a\x19\x91\x3b\xaf\xb3\x7f\xb9\x17\xfc\xa7\x0b\xc1\x3b\x18\xe4\xdd\x24\x5f\x97\xaf\x91\xf9\xb9\xca\xdc\x49\x7d\x4a\xdb\x52\x37\xba\x7e\x83\xae\xeb\xd7\xed\xe0\x3d\x15\x24\x87\xb3\x72\x11\x17\xcd\x27\x9f\xb3\xfd\xe4\x93\x1b\xcf\x19\x4d\x45\x4a\xe2\x69\x2a\xb4\x9a\x73\x72\xc2\x0a\x8f\x15\xb2\x5f\x02\xfb\xbb\xb8\xc6\x18\x47\xc6\xc8\x0f\x4b\xd5\xe8\xff\xfd\xf4\xff\xf7\x00\xd8\x6c\xbd\xbb\x18\x1b\x00\x00"),
 		},
 		"/networkmanagement.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "networkmanagement.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1632,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xe3\xb8\x12\xbc\xeb\x2b\x0a\xf6\xe5\x3d\x20\x91\x93\x1c\xb5\x27\x8f\x93\x99\x11\x36\x71\x16\xb6\xb3\x83\x39\x05\x6d\xaa\x2d\x71\x43\x91\x5c\xb2\x65\x8f\x30\x9b\x7f\x5f\xc8\x92\x9d\x38\x99\x00\xcb\x8b\x8d\x66\xb1\xaa\xd8\x5d\xd4\x18\x33\xe7\xdb\xa0\xcb\x4a\x70\x75\x71\x75\x85\x2f\xce\x95\x86\x71\x7b\x3b\x4b\xc6\xc9\x18\xb7\x5a\xb1\x8d\x5c\xa0\xb1\x05\x07\x48\xc5\x98\x7a\x52\x15\x1f\x76\xce\xf0\x27\x87\xa8\x9d\xc5\x55\x7a\x81\xff\x75\x80\xd1\xb0\x35\xfa\xff\x6f\xc9\x18\xad\x6b\x50\x53\x0b\xeb\x04\x4d\x64\x48\xa5\x23\x36\xda\x30\xf8\x87\x62\x2f\xd0\x16\xca\xd5\xde\x68\xb2\x8a\xb1\xd3\x52\xed\x65\x06\x92\x34\x19\xe3\xfb\x40\xe1\xd6\x42\xda\x82\xa0\x9c\x6f\xe1\x36\xaf\x71\x20\xd9\x1b\xde\xaf\x4a\xc4\x67\x93\xc9\x6e\xb7\x4b\x69\xef\x36\x75\xa1\x9c\x98\x1e\x19\x27\xb7\xf9\xec\x66\xbe\xbc\x39\xbf\x4a\x2f\xf6\x67\x1e\xac\xe1\x18\x11\xf8\xef\x46\x07\x2e\xb0\x6e\x41\xde\x1b\xad\x68\x6d\x18\x86\x76\x70\x01\x54\x06\xe6\x02\xe2\x3a\xc3\xbb\xa0\x45\xdb\xf2\x0c\xd1\x6d\x64\x47\x81\x93\x31\x0a\x1d\x25\xe8\x75\x23\x27\xdd\x3a\xd8\xd3\xf1\x04\xe0\x2c\xc8\x62\x34\x5d\x22\x5f\x8e\xf0\x69\xba\xcc\x97\x67\xc9\x18\xdf\xf2\xd5\xd7\xfb\x87\x15\xbe\x4d\x17\x8b\xe9\x7c\x95\xdf\x2c\x71\xbf\xc0\xec\x7e\x7e\x9d\xaf\xf2\xfb\xf9\x12\xf7\x9f\x31\x9d\x7f\xc7\xef\xf9\xfc\xfa\x0c\xac\xa5\xe2\x00\xfe\xe1\x43\xe7\xdf\x05\xe8\xae\x8f\x5c\x74\x4d\x5b\x32\x9f\x18\xd8\xb8\xde\x50\xf4\xac\xf4\x46\x2b\x18\xb2\x65\x43\x25\xa3\x74\x5b\x0e\x56\xdb\x12\x9e\x43\xad\x63\x37\xcd\x08\xb2\x45\x32\x86\xd1\xb5\x16\x92\x7d\xe5\xdd\xa5\xd2\x24\x21\xaf\x87\xf9\x67\x50\x2e\x70\xaa\x6c\xa8\x53\x65\x5c\x53\xa4\xe5\x3e\x4a\xa9\x72\xf5\x64\x7b\x49\xc6\x57\x74\x99\x3c\x69\x5b\x64\x58\x72\xd8\x6a\xc5\x77\xe4\xbd\xb6\x65\x52\xb3\x50\x41\x42\x59\x02\x58\xaa\x39\x83\x65\xd9\xb9\xf0\x54\x93\xa5\x92\x6b\xb6\xf2\x6b\xde\x01\x1f\x3d\x29\xce\xd0\x41\xce\x63\x1b\x85\xeb\xa4\xbb\xe5\x0b\xdd\xbc\xa7\xbb\x3b\xd2\x25\xc0\xf6\x60\x7b\x7b\xb9\x66\xa1\xcb\x04\x88\xbd\xad\xaf\x2e\xca\xfc\x03\x1b\xbd\x36\x79\x1d\x07\xfd\xc0\xd1\x35\x41\x71\xec\xd4\x80\xf3\x41\xb1\xc7\x3d\x0e\x04\x8f\x2f\x0c\x8f\xca\x59\xcb\x4a\xf4\x56\x4b\xfb\x28\x1c\x25\xe9\x33\xdb\x77\xe6\x9d\xd3\xd9\x2b\xf8\xea\x05\x4d\x8d\xb8\x2f\x6c\x39\x90\x70\x91\x41\x42\xc3\xc3\x8e\x2e\x56\x5c\x7b\x43\xc2\x19\x46\x3e\xb8\xbf\x58\x49\x9c\xfc\xfc\x39\xfc\x7d\x7e\x9e\x18\xa7\xfa\x89\x4e\x4a\xe3\xd6\x64\x26\xea\x8d\x46\x07\xef\xae\xf1\xfc\x3c\x7a\x47\x3a\x23\xfb\x89\x1f\x22\x17\x2b\x77\x47\xa2\xaa\xc5\x70\xff\xbe\x61\x1b\x32\xf1\x60\xe4\xd0\x99\xe9\x96\xb4\xe9\x5e\x52\x6e\xa7\x31\xb2\xe4\x76\xcb\x56\x5c\x68\x4f\xe1\x87\x10\x0c\xa1\xc8\x86\xf2\x31\x11\x54\xf3\xb1\x64\x68\xcd\x26\x66\xc3\xef\x1b\xbd\xfc\xfa\xe5\xac\x50\x28\x59\x3e\x6b\x36\xc5\x09\x45\xa5\x39\x50\x50\x95\x56\x64\x16\xbc\xe1\xc0\xf6\x38\xc2\x7e\x8c\xd2\x7a\xce\x30\x34\xed\x58\x07\x9e\xb8\x3d\x96\x17\xbc\x79\xa3\xfd\x01\xd7\x66\x70\xf0\x5f\xe9\xba\x55\x70\x54\x41\x7b\xd9\x67\xf4\x9f\xf3\x57\x3b\xc0\xaa\xe2\xc3\x21\x48\x45\xd2\x7f\x54\x0f\x26\xb0\x66\xe3\x6c\x19\x21\x2e\x7d\x75\xac\xdc\x3e\x65\x27\x2c\x7d\xe2\xfe\x78\xe7\xe9\x97\x6f\xe3\x15\x4f\x70\x8d\xcf\x8e\x6a\x7d\xb4\xc3\x07\x0f\xf4\xdf\x00\x00\x00\xff\xff\xc5\x32\x1b\xdd\x60\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xdb\x38\x10\x85\xef\xfa\x15\x0f\xf6\x65\x17\x48\xe4\x24\x47\xed\xc9\x75\xd2\x56\xd8\xc4\x59\xd8\xce\x16\x3d\x05\x63\x6a\x2c\x71\x43\x91\x5c\x72\x64\x57\xe8\xe6\xbf\x2f\x64\xc9\x76\xd2\x34\x40\x2d\x03\x36\xc8\x37\x6f\x3e\xce\x0c\x35\xc6\xcc\xf9\x36\xe8\xb2\x12\x5c\x5d\x5c\x5d\xe1\x93\x73\xa5\x61\xdc\xde\xce\x92\x71\x32\xc6\xad\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xa6\x9e\x54\xc5\x87\x9d\x33\xfc\xcd\x21\x6a\x67\x71\x95\x5e\xe0\xb7\x4e\x30\x1a\xb6\x46\xbf\xff\x91\x8c\xd1\xba\x06\x35\xb5\xb0\x4e\xd0\x44\x86\x54\x3a\x62\xa3\x0d\x83\xbf\x29\xf6\x02\x6d\xa1\x5c\xed\x8d\x26\xab\x18\x3b\x2d\x15\xe4\xe4\x9f\x26\x63\x7c\x1d\x2c\xdc\x5a\x48\x5b\x10\x94\xf3\x2d\xdc\xe6\xa5\x0e\x24\x7b\xe0\xfd\xa7\x12\xf1\xd9\x64\xb2\xdb\xed\x52\xda\xd3\xa6\x2e\x94\x13\xd3\x2b\xe3\xe4\x36\x9f\xdd\xcc\x97\x37\xe7\x57\xe9\xc5\x3e\xe6\xc1\x1a\x8e\x11\x81\xff\x6d\x74\xe0\x02\xeb\x16\xe4\xbd\xd1\x8a\xd6\x86\x61\x68\x07\x17\x40\x65\x60\x2e\x20\xae\x03\xde\x05\x2d\xda\x96\x67\x88\x6e\x23\x3b\x0a\x9c\x8c\x51\xe8\x28\x41\xaf\x1b\x79\x55\xad\x03\x9e\x8e\xaf\x04\xce\x82\x2c\x46\xd3\x25\xf2\xe5\x08\x1f\xa6\xcb\x7c\x79\x96\x8c\xf1\x25\x5f\x7d\xbe\x7f\x58\xe1\xcb\x74\xb1\x98\xce\x57\xf9\xcd\x12\xf7\x0b\xcc\xee\xe7\xd7\xf9\x2a\xbf\x9f\x2f\x71\xff\x11\xd3\xf9\x57\xfc\x99\xcf\xaf\xcf\xc0\x5a\x2a\x0e\xe0\x6f\x3e\x74\xfc\x2e\x40\x77\x75\xe4\xa2\x2b\xda\x92\xf9\x15\xc0\xc6\xf5\xed\x8b\x9e\x95\xde\x68\x05\x43\xb6\x6c\xa8\x64\x94\x6e\xcb\xc1\x6a\x5b\xc2\x73\xa8\x75\xec\xba\x19\x41\xb6\x48\xc6\x30\xba\xd6\x42\xb2\x5f\x79\x73\xa8\x34\x49\xc8\xeb\xa1\xff\x19\x94\x0b\x9c\x2a\x1b\xea\x54\x19\xd7\x14\x69\xb9\x1f\xa5\x54\xb9\x7a\xb2\xbd\x24\xe3\x2b\xba\x4c\x9e\xb4\x2d\x32\x2c\x39\x6c\xb5\xe2\x3b\xf2\x5e\xdb\x32\xa9\x59\xa8\x20\xa1\x2c\x01\x2c\xd5\x9c\xc1\xb2\xec\x5c\x78\xaa\xc9\x52\xc9\x35\x5b\xf9\xb9\xef\xa0\x8f\x9e\x14\x67\xe8\x24\xe7\xb1\x8d\xc2\x75\xd2\x9d\xf2\x64\x37\xef\xed\xee\x8e\x76\x09\xb0\x3d\x60\x6f\x2f\xd7\x2c\x74\x99\x00\xb1\xc7\xfa\xec\xa2\xcc\xdf\xc1\xe8\x73\x93\xd7\x71\xc8\x1f\x38\xba\x26\x28\x8e\x5d\x36\xe0\x7c\xc8\xd8\xeb\x1e\x07\x83\xc7\x93\xc3\xa3\x72\xd6\xb2\x12\xbd\xd5\xd2\x3e\x0a\x47\xd9\xc7\x01\x7d\x65\xde\x90\xce\x5e\xc8\x57\x27\x35\x35\xe2\x3e\xb1\xe5\x40\xc2\x45\x06\x09\x0d\x0f\x3b\xba\x58\x71\xed\x0d\x09\x67\x18\xf9\xe0\xfe\x61\x25\x71\xf2\xfd\xfb\xf0\xf7\xf9\x79\x62\x9c\xea\x3b\x3a\x29\x8d\x5b\x93\x99\xbc\x44\xea\x72\x74\xf2\xee\x18\xcf\xcf\xa3\x37\xa6\x33\xb2\x1f\xf8\x21\x72\xb1\x72\x77\x24\xaa\x5a\x0c\xe7\xef\x0b\xb6\x21\x13\x0f\x20\x87\xca\x4c\xb7\xa4\x4d\x77\x93\x72\x3b\x8d\x91\x25\xb7\x5b\xb6\xe2\x42\xfb\x5a\x7e\x18\x82\x61\x28\xb2\x61\xf9\x38\x11\x54\x1f\x94\x80\xa1\x35\x9b\x98\x0d\xbf\x3f\xe4\xcb\xaf\x4f\xb1\x42\xa1\x64\xf9\xa8\xd9\x14\xaf\x2c\x2a\xcd\x81\x82\xaa\xb4\x22\xb3\xe0\x0d\x07\xb6\xc7\x16\x76\xdf\x73\x48\xeb\x39\xc3\x50\xb4\xe3\x3a\xf0\xc4\xed\x71\x79\xc1\x9b\x1f\x72\xbf\xe3\xb5\x19\x08\x7e\xd5\xae\x7b\x0a\x8e\x2a\x68\xdf\x35\x2a\xc3\x7f\xe7\x2f\x76\x80\x55\xc5\x87\x20\x48\x45\xd2\xbf\x54\x0f\x10\x58\xb3\x71\xb6\x8c\x10\x97\xbe\x08\x2b\xb7\x4f\x27\xa8\xd3\xc4\xfd\xf5\x86\xe9\xa7\x77\xe3\xf4\x94\xc1\x35\x3e\x3b\x66\xeb\x47\x3b\xbc\x73\x41\xff\x1f\x00\xc5\x32\x1b\xdd\x60\x06\x00\x00"),
 		},
 		"/networksecurity.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "networksecurity.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3191,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\x0c\xec\xcb\xef\x07\x24\x52\xe2\xa3\x7a\xf2\x3a\xd9\xac\x50\xaf\xbd\xb0\x9c\x2e\xf6\x64\x8c\xa9\xb1\xc4\x86\x22\x59\x72\x64\xaf\x90\xfa\xbb\x17\xb2\x24\xc7\xce\x9f\xa2\x5b\x60\x7b\x8a\x4e\x34\xf9\xe6\xcd\xe3\xf0\x3d\x0f\x61\x62\x6c\xed\x64\x5e\x30\x8c\xae\x46\x23\xb8\x33\x26\x57\x04\xd3\xe9\x24\x18\x06\x43\x98\x4a\x41\xda\x53\x06\x95\xce\xc8\x01\x17\x04\x63\x8b\xa2\xa0\xfe\xe4\x02\x7e\x23\xe7\xa5\xd1\x30\x0a\xaf\xe0\x7f\x0d\x60\xd0\x1d\x0d\xfe\xff\x4b\x30\x84\xda\x54\x50\x62\x0d\xda\x30\x54\x9e\x80\x0b\xe9\x61\x23\x15\x01\x7d\x17\x64\x19\xa4\x06\x61\x4a\xab\x24\x6a\x41\xb0\x93\x5c\x1c\xda\x74\x24\x61\x30\x84\x6f\x1d\x85\x59\x33\x4a\x0d\x08\xc2\xd8\x1a\xcc\xe6\x14\x07\xc8\x07\xc1\x87\xaf\x60\xb6\x71\x14\xed\x76\xbb\x10\x0f\x6a\x43\xe3\xf2\x48\xb5\x48\x1f\x4d\x93\xc9\xed\x2c\xbd\xbd\x1c\x85\x57\x87\x9a\x7b\xad\xc8\x7b\x70\xf4\x47\x25\x1d\x65\xb0\xae\x01\xad\x55\x52\xe0\x5a\x11\x28\xdc\x81\x71\x80\xb9\x23\xca\x80\x4d\x23\x78\xe7\x24\x4b\x9d\x5f\x80\x37\x1b\xde\xa1\xa3\x60\x08\x99\xf4\xec\xe4\xba\xe2\xb3\x69\xf5\xf2\xa4\x3f\x03\x18\x0d\xa8\x61\x30\x4e\x21\x49\x07\xf0\x61\x9c\x26\xe9\x45\x30\x84\xaf\xc9\xf2\xd3\xfc\x7e\x09\x5f\xc7\x8b\xc5\x78\xb6\x4c\x6e\x53\x98\x2f\x60\x32\x9f\xdd\x24\xcb\x64\x3e\x4b\x61\xfe\x11\xc6\xb3\x6f\xf0\x6b\x32\xbb\xb9\x00\x92\x5c\x90\x03\xfa\x6e\x5d\xa3\xdf\x38\x90\xcd\x1c\x29\x6b\x86\x96\x12\x9d\x09\xd8\x98\x56\x90\xb7\x24\xe4\x46\x0a\x50\xa8\xf3\x0a\x73\x82\xdc\x6c\xc9\x69\xa9\x73\xb0\xe4\x4a\xe9\x9b\xd7\xf4\x80\x3a\x0b\x86\xa0\x64\x29\x19\xf9\xb0\xf3\xe2\x52\x61\x10\xa0\x95\xdd\xfb\xc7\x20\x8c\xa3\x50\x68\x57\x86\x42\x99\x2a\x0b\xf3\x83\x95\x42\x61\xca\x68\x7b\x8d\xca\x16\x78\x1d\x3c\x48\x9d\xc5\x90\x92\xdb\x4a\x41\x9f\xd1\x5a\xa9\xf3\xa0\x24\xc6\x0c\x19\xe3\x00\x40\x63\x49\x31\x68\xe2\x9d\x71\x0f\x9e\x44\xe5\x24\xd7\xaf\xb3\x76\x68\x6f\x51\x50\x0c\x0d\xe4\xd2\xd7\x9e\xa9\x0c\x9a\x3b\x3e\x91\xcd\x5a\xb2\xb4\x23\x0b\x00\xb6\xbd\xe4\xed\xf5\x9a\x18\xaf\x03\x00\xdf\x4a\xfa\x64\x3c\xcf\x5e\x95\xd0\xf6\x45\x2b\x7d\xd7\xdb\x91\x37\x95\x13\xe4\x9b\x4e\x00\x97\x5d\xb7\x16\xb7\xea\xca\x57\x7d\xfd\x2a\x47\xa6\x1d\xd6\x4f\x1b\xd6\x28\x29\xea\xa0\x75\x6c\x3b\x97\x67\x4a\xef\xda\x92\xfe\xe7\x97\xd3\x02\xac\xd8\xdc\x91\x26\x87\x4c\x59\x0c\xec\x2a\xea\x4e\x64\xb6\xa4\xd2\x2a\x64\x8a\x61\x60\x9d\xf9\x9d\x04\xfb\xe8\xf1\xb1\x5b\xee\xf7\x91\x32\xa2\x7d\xd2\xe8\xf1\xb1\x5f\xef\xf7\x51\xfe\x4a\x3b\x49\x0d\xa8\xb9\xd9\x7e\x3f\x78\xd1\x60\x82\xfa\x03\xdd\x7b\xca\x96\xe6\x33\xb2\x28\x16\xdd\x48\xda\x09\x6e\x50\xf9\x5e\x54\x3f\xac\xf1\x16\xa5\x6a\x62\x95\xe8\xb1\xf7\xc4\x89\xde\x92\x66\xe3\xea\x73\x78\xef\x88\xce\x21\x71\xb7\x7d\xb4\x07\x96\xcf\x89\x93\x9b\x27\x10\xa3\xcb\x89\x3f\x4a\x52\xd9\x19\xb6\x90\xe4\xd0\x89\x42\x0a\x54\x0b\xda\x90\x23\x7d\x7c\xbe\xf6\x09\xb9\xb6\x14\x43\x37\xa9\xe3\x3e\xc0\x03\xd5\xc7\xed\x05\x6d\x9e\xf5\x7e\x83\x6b\xd3\x29\xf8\xa7\x74\xcd\x97\x91\x17\x4e\x5a\x3e\xb8\xf3\xcf\xcb\x93\x13\x80\x65\x41\x7d\x11\x70\x81\xdc\xfe\x95\xf6\x22\x60\x4d\xca\xe8\xdc\x03\x9b\xf0\xa4\x2c\xdf\x3e\xc4\x67\x2c\xad\xd3\xbe\xbc\xd0\xf4\x6a\x2a\x4e\x78\x9c\xa9\x6c\x7c\xec\x56\xa2\xc6\x9c\xdc\x9b\xc1\xfc\xd7\x79\x58\xb9\x4a\xd1\x0f\x87\x62\xf1\x54\xf4\x9f\x06\xe3\x8d\x4b\xec\xf7\x51\x73\x8d\xf7\xe8\xbc\x47\xe7\x67\x44\xa7\x72\x6a\xa5\xa4\x67\xff\x77\x39\xb9\x5f\x4c\xa7\x27\x98\x9f\x10\x8b\xca\xa9\x43\x87\x77\x97\xbf\xbb\xfc\x07\x5d\xfe\x57\x00\x00\x00\xff\xff\x30\xc0\x43\x18\x77\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4b\x6f\xe3\x46\x13\xbc\xf3\x57\x34\xa4\xcb\xf7\x01\x36\x69\xfb\xc8\x9c\xb4\xb2\xd7\x4b\x44\x2b\x2d\x44\x39\x8b\x3d\x09\xad\x61\x8b\x9c\x78\x38\x33\x99\x69\x4a\x4b\x38\xfa\xef\x01\x45\x52\x0f\x3f\x82\x6c\x80\xcd\xc9\xa2\x0e\xd4\x4c\x55\x77\xf5\xa3\x34\x84\xb1\xb1\xb5\x93\x79\xc1\x70\x73\x75\x73\x03\xf7\xc6\xe4\x8a\x60\x32\x19\x07\xc3\x60\x08\x13\x29\x48\x7b\xca\xa0\xd2\x19\x39\xe0\x82\x60\x64\x51\x14\xd4\xdf\x5c\xc0\x6f\xe4\xbc\x34\x1a\x6e\xc2\x2b\xf8\x5f\x03\x18\x74\x57\x83\xff\xff\x12\x0c\xa1\x36\x15\x94\x58\x83\x36\x0c\x95\x27\xe0\x42\x7a\x58\x4b\x45\x40\xdf\x05\x59\x06\xa9\x41\x98\xd2\x2a\x89\x5a\x10\x6c\x25\x17\xc0\xc7\xf8\x61\x30\x84\x6f\x5d\x08\xb3\x62\x94\x1a\x10\x84\xb1\x35\x98\xf5\x29\x0e\x90\xf7\x82\xf7\x9f\x82\xd9\xc6\x51\xb4\xdd\x6e\x43\xdc\xab\x0d\x8d\xcb\x23\xd5\x22\x7d\x34\x49\xc6\x77\xd3\xf4\xee\xf2\x26\xbc\xda\x73\x1e\xb4\x22\xef\xc1\xd1\x1f\x95\x74\x94\xc1\xaa\x06\xb4\x56\x49\x81\x2b\x45\xa0\x70\x0b\xc6\x01\xe6\x8e\x28\x03\x36\x8d\xe0\xad\x93\x2c\x75\x7e\x01\xde\xac\x79\x8b\x8e\x82\x21\x64\xd2\xb3\x93\xab\x8a\xcf\xba\xd5\xcb\x93\xfe\x0c\x60\x34\xa0\x86\xc1\x28\x85\x24\x1d\xc0\x87\x51\x9a\xa4\x17\xc1\x10\xbe\x26\x8b\x4f\xb3\x87\x05\x7c\x1d\xcd\xe7\xa3\xe9\x22\xb9\x4b\x61\x36\x87\xf1\x6c\x7a\x9b\x2c\x92\xd9\x34\x85\xd9\x47\x18\x4d\xbf\xc1\xaf\xc9\xf4\xf6\x02\x48\x72\x41\x0e\xe8\xbb\x75\x8d\x7e\xe3\x40\x36\x7d\xa4\xac\x69\x5a\x4a\x74\x26\x60\x6d\xda\xf1\x79\x4b\x42\xae\xa5\x00\x85\x3a\xaf\x30\x27\xc8\xcd\x86\x9c\x96\x3a\x07\x4b\xae\x94\xbe\x99\xa6\x07\xd4\x59\x30\x04\x25\x4b\xc9\xc8\xfb\x93\x17\x45\x85\x41\x80\x56\x76\xf3\x8f\x41\x18\x47\xa1\xd0\xae\x0c\x85\x32\x55\x16\xe6\xfb\x55\x0a\x85\x29\xa3\xcd\x35\x2a\x5b\xe0\x75\xf0\x28\x75\x16\x43\x4a\x6e\x23\x05\x7d\x46\x6b\xa5\xce\x83\x92\x18\x33\x64\x8c\x03\x00\x8d\x25\xc5\xa0\x89\xb7\xc6\x3d\x7a\x12\x95\x93\x5c\xbf\x1e\xb5\x43\x7b\x8b\x82\x62\x68\x20\x97\xbe\xf6\x4c\x65\xd0\xd4\x78\x0c\x36\x6d\x83\xa5\x5d\xb0\x00\x60\xd3\x4b\xde\x5c\xaf\x88\xf1\x3a\x00\xf0\xad\xa4\x4f\xc6\xf3\xf4\x55\x09\x6d\x5e\xb4\xd2\x77\xb9\x1d\x79\x53\x39\x41\xbe\xc9\x04\x70\xd9\x65\x6b\x71\xcb\x8e\xbe\xec\xf9\xcb\x1c\x99\xb6\x58\x1f\x0f\xac\x51\x52\xd4\x7b\x2e\x40\xdb\x97\x67\x4a\xef\x5b\x4a\xff\xf3\xcb\x29\x01\x2b\x36\xf7\xa4\xc9\x21\x53\x16\x03\xbb\x8a\xba\x1b\x99\x2d\xa8\xb4\x0a\x99\x62\x18\x58\x67\x7e\x27\xc1\x3e\x7a\x7a\xea\x5e\x77\xbb\x48\x19\xd1\x8e\x34\x7a\x7a\xea\xdf\x77\xbb\x28\x7f\x25\x9d\xa4\x86\xda\x54\xb6\xdb\x0d\x5e\x24\x18\xa3\xfe\x40\x0f\x9e\xb2\x85\xf9\x8c\x2c\x8a\x79\xd7\x92\xb6\x83\x6b\x54\xbe\x17\xd5\x37\x6b\xb4\x41\xa9\x1a\x5b\x25\x7a\xe4\x3d\x71\xa2\x37\xa4\xd9\xb8\xfa\x1c\xde\x6f\x44\xb7\x21\x71\x77\x7c\x58\x0f\x2c\x9f\x07\x4e\x6e\x8f\x20\x46\x97\x13\x7f\x94\xa4\xb2\x33\x6c\x21\xc9\xa1\x13\x85\x14\xa8\xe6\xb4\x26\x47\xfa\x30\xbe\xe6\x7b\x09\x5c\x5b\x8a\xa1\xeb\xd4\xe1\x1c\xe0\x91\xea\xc3\xf1\x9c\xd6\xcf\x72\xbf\x11\x6b\xdd\x29\xf8\xa7\xe1\x9a\x27\x23\x2f\x9c\xb4\xcd\x44\x62\xf8\xf3\xf2\xe4\x06\x60\x51\x50\x4f\x02\x2e\x90\xdb\xbf\xd2\x5e\x04\xac\x48\x19\x9d\x7b\x60\x13\x9e\xd0\xf2\xcd\xe3\x51\xd4\x71\xd3\xbe\xbc\xd0\xf4\xaa\x2b\x8e\x4f\xee\x4c\x65\xe3\x43\xb6\x12\x35\xe6\xe4\xde\x34\xe6\xbf\xf6\xc3\xd2\x55\x8a\x7e\xd8\x14\xf3\x23\xe9\x3f\x35\xc6\x1b\x45\xec\x76\x51\x53\xc6\xbb\x75\xde\xad\xf3\x33\xac\x53\x39\xb5\x54\xd2\xb3\xff\x3b\x9f\x3c\xcc\x27\x93\x13\xcc\x4f\xb0\x45\xe5\xd4\x3e\xc3\xfb\x96\xbf\x6f\xf9\x0f\x6e\xf9\x5f\x03\x00\x30\xc0\x43\x18\x77\x0c\x00\x00"),
 		},
 		"/networkservices.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "networkservices.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 4697,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x41\x6f\xdb\x38\x13\xbd\xeb\x57\x0c\xec\xcb\xf7\x01\x89\x9c\xe4\xa8\x3d\xb9\x4e\x9a\x0a\x4d\xec\x85\xed\x6c\xd1\x53\x30\xa6\xc6\x12\x37\x14\xc9\x25\x47\x76\x85\xac\xff\xfb\x42\x16\xe5\xd8\x49\x5a\x64\x81\xb4\x7b\xb1\x2f\x16\xc8\xc7\x79\x8f\x33\xef\xb1\x0f\x23\x63\x6b\x27\xf3\x82\xe1\xe2\xec\xe2\x02\xae\x8d\xc9\x15\xc1\xcd\xcd\x28\xea\x47\x7d\xb8\x91\x82\xb4\xa7\x0c\x2a\x9d\x91\x03\x2e\x08\x86\x16\x45\x41\xdd\xce\x09\xfc\x41\xce\x4b\xa3\xe1\x22\x3e\x83\xff\x35\x80\x5e\xd8\xea\xfd\xff\xb7\xa8\x0f\xb5\xa9\xa0\xc4\x1a\xb4\x61\xa8\x3c\x01\x17\xd2\xc3\x52\x2a\x02\xfa\x26\xc8\x32\x48\x0d\xc2\x94\x56\x49\xd4\x82\x60\x2d\xb9\xd8\xd2\x84\x22\x71\xd4\x87\xaf\xa1\x84\x59\x30\x4a\x0d\x08\xc2\xd8\x1a\xcc\x72\x1f\x07\xc8\x5b\xc1\xdb\x5f\xc1\x6c\x93\xc1\x60\xbd\x5e\xc7\xb8\x55\x1b\x1b\x97\x0f\x54\x8b\xf4\x83\x9b\x74\x74\x35\x9e\x5d\x9d\x5e\xc4\x67\xdb\x33\x77\x5a\x91\xf7\xe0\xe8\xaf\x4a\x3a\xca\x60\x51\x03\x5a\xab\xa4\xc0\x85\x22\x50\xb8\x06\xe3\x00\x73\x47\x94\x01\x9b\x46\xf0\xda\x49\x96\x3a\x3f\x01\x6f\x96\xbc\x46\x47\x51\x1f\x32\xe9\xd9\xc9\x45\xc5\x07\xdd\xea\xe4\x49\x7f\x00\x30\x1a\x50\x43\x6f\x38\x83\x74\xd6\x83\x0f\xc3\x59\x3a\x3b\x89\xfa\xf0\x25\x9d\x7f\x9a\xdc\xcd\xe1\xcb\x70\x3a\x1d\x8e\xe7\xe9\xd5\x0c\x26\x53\x18\x4d\xc6\x97\xe9\x3c\x9d\x8c\x67\x30\xf9\x08\xc3\xf1\x57\xf8\x9c\x8e\x2f\x4f\x80\x24\x17\xe4\x80\xbe\x59\xd7\xe8\x37\x0e\x64\xd3\x47\xca\x9a\xa6\xcd\x88\x0e\x04\x2c\x4d\x2b\xc8\x5b\x12\x72\x29\x05\x28\xd4\x79\x85\x39\x41\x6e\x56\xe4\xb4\xd4\x39\x58\x72\xa5\xf4\xcd\x34\x3d\xa0\xce\xa2\x3e\x28\x59\x4a\x46\xde\xae\xbc\xb8\x54\x1c\x45\x68\x65\x98\x7f\x02\xc2\x38\x8a\x85\x76\x65\x2c\x94\xa9\xb2\x38\xdf\x5a\x29\x16\xa6\x1c\xac\xce\x51\xd9\x02\xcf\xa3\x07\xa9\xb3\x04\x66\xe4\x56\x52\xd0\x2d\x5a\x2b\x75\x1e\x95\xc4\x98\x21\x63\x12\x01\x68\x2c\x29\x01\x4d\xbc\x36\xee\xc1\xb7\x38\xff\x7a\xd5\x80\xf6\x16\x05\x25\xd0\x40\x4e\x7d\xed\x99\xca\xa8\xb9\xe3\x53\xb1\x71\x5b\x2c\x90\xfa\x08\x60\xd5\x49\x5e\x9d\x2f\x88\xf1\x3c\x02\x08\x54\x9f\x8c\xe7\xf1\xab\x12\x5a\x5e\xb4\xd2\x07\x6e\x47\xde\x54\x4e\x90\x6f\x98\x00\x4e\x03\x5b\x8b\xbb\x0f\xc7\xef\xbb\xf3\xf7\x94\xe5\x74\x2f\x1a\x33\xde\x3f\x50\xed\x89\xa3\xd6\xab\x6d\x47\x9e\x69\xbc\xca\x72\x1a\x35\xd8\xcf\xfb\x50\xac\xd8\x5c\x93\x26\x87\x4c\x59\x02\xec\x2a\x0a\x3b\x32\x9b\x53\x69\x15\x32\x25\xd0\xb3\xce\xfc\x49\x82\xfd\xe0\xf1\x31\x7c\x6e\x36\x03\x65\x44\x3b\xc6\x41\xae\xcc\x02\xd5\x80\x0e\x29\x1a\x74\x73\x81\xcd\xa6\xf7\xa2\xe6\x08\xf5\x07\xba\xf3\x94\xcd\xcd\x2d\xb2\x28\xa6\xe1\xe6\x6d\xa3\x96\xa8\x7c\xa7\xa3\xeb\xc9\x70\x85\x52\x35\xe9\x49\xf5\xd0\x7b\xe2\x54\xaf\x48\xb3\x71\xf5\x21\xbc\x1b\x7c\x30\x42\x12\x96\x77\x2e\xc0\x92\x76\x4b\x0a\x17\xa4\x7c\x12\xfe\x9f\xf1\xa5\x97\x4f\x67\x19\x5d\x4e\xfc\x51\x92\xca\x0e\x4a\x14\x92\x1c\x3a\x51\x48\x81\x6a\x4a\x4b\x72\xa4\x77\xc3\x6b\x07\xc8\xb5\xa5\x04\x42\xcf\x76\xeb\x00\x0f\x54\xef\x96\xa7\xb4\x7c\xc6\xfd\x9d\x5a\xcb\xa0\xe0\xad\xe5\x9a\x5f\x46\x5e\x38\x69\x79\xeb\xcd\xbf\x4f\xf7\x76\x00\xe6\x05\x75\x87\x80\x0b\xe4\xf6\x21\xed\x44\xc0\x82\x94\xd1\xb9\x07\x36\xf1\xde\xb1\x7c\xf5\x90\x1c\x54\x69\xdd\xf6\xfb\x0b\x4d\xaf\x66\x62\xaf\x8e\x33\x95\x4d\x76\x6c\x25\x6a\xcc\xc9\x7d\x37\x96\xff\x2a\x0d\xc6\xc9\x5c\xea\x37\xa5\x61\xb2\x0f\xfd\x39\x69\x68\x29\x8e\x69\x38\xa6\xe1\xbf\x49\x43\x58\x7b\x53\x1c\x66\x07\xd8\x9f\x93\x87\x8e\xf4\x18\x88\x63\x20\x7e\x5d\x20\x72\x64\x5a\x63\xfd\xa3\x14\x5c\x1f\x40\xde\xc7\xfc\x8f\x8f\xdd\xf7\x66\x33\x08\x1a\x8e\xce\x3f\x3a\xff\x17\x3a\xbf\x24\x5f\xfc\xc8\xf6\xb7\x4f\xfb\xef\xfa\xe0\x37\xbc\xc7\x57\xfe\xe8\xf5\xf7\xf2\xfa\x3f\x01\x00\x00\xff\xff\x54\x3d\xa2\xbb\x59\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x41\x6f\xdb\x38\x13\xbd\xeb\x57\x0c\xec\xcb\xf7\x01\x89\x9c\xe4\xa8\x3d\xb9\x4e\x9a\x0a\x4d\xec\x85\xed\x6c\xd1\x53\x30\xa6\xc6\x14\x37\x14\xc9\x25\x47\x76\x85\xac\xff\xfb\x42\x96\x14\xdb\x49\x5a\x64\x81\xb4\x7b\xb1\x65\x40\x02\xf9\x66\xde\xe3\xcc\x3c\xf6\x61\x64\x5d\xe5\x95\xcc\x19\x2e\xce\x2e\x2e\xe0\xda\x5a\xa9\x09\x6e\x6e\x46\x51\x3f\xea\xc3\x8d\x12\x64\x02\x65\x50\x9a\x8c\x3c\x70\x4e\x30\x74\x28\x72\xea\x76\x4e\xe0\x0f\xf2\x41\x59\x03\x17\xf1\x19\xfc\xaf\x06\xf4\xda\xad\xde\xff\x7f\x8b\xfa\x50\xd9\x12\x0a\xac\xc0\x58\x86\x32\x10\x70\xae\x02\x2c\x95\x26\xa0\x6f\x82\x1c\x83\x32\x20\x6c\xe1\xb4\x42\x23\x08\xd6\x8a\x73\xe0\x5d\xfe\x38\xea\xc3\xd7\x36\x85\x5d\x30\x2a\x03\x08\xc2\xba\x0a\xec\x72\x1f\x07\xc8\x5b\xc1\xdb\x5f\xce\xec\x92\xc1\x60\xbd\x5e\xc7\xb8\x55\x1b\x5b\x2f\x07\xba\x41\x86\xc1\x4d\x3a\xba\x1a\xcf\xae\x4e\x2f\xe2\xb3\x6d\xcc\x9d\xd1\x14\x02\x78\xfa\xab\x54\x9e\x32\x58\x54\x80\xce\x69\x25\x70\xa1\x09\x34\xae\xc1\x7a\x40\xe9\x89\x32\x60\x5b\x0b\x5e\x7b\xc5\xca\xc8\x13\x08\x76\xc9\x6b\xf4\x14\xf5\x21\x53\x81\xbd\x5a\x94\x7c\x50\xad\x4e\x9e\x0a\x07\x00\x6b\x00\x0d\xf4\x86\x33\x48\x67\x3d\xf8\x30\x9c\xa5\xb3\x93\xa8\x0f\x5f\xd2\xf9\xa7\xc9\xdd\x1c\xbe\x0c\xa7\xd3\xe1\x78\x9e\x5e\xcd\x60\x32\x85\xd1\x64\x7c\x99\xce\xd3\xc9\x78\x06\x93\x8f\x30\x1c\x7f\x85\xcf\xe9\xf8\xf2\x04\x48\x71\x4e\x1e\xe8\x9b\xf3\xb5\x7e\xeb\x41\xd5\x75\xa4\xac\x2e\xda\x8c\xe8\x40\xc0\xd2\x36\xed\x0b\x8e\x84\x5a\x2a\x01\x1a\x8d\x2c\x51\x12\x48\xbb\x22\x6f\x94\x91\xe0\xc8\x17\x2a\xd4\xdd\x0c\x80\x26\x8b\xfa\xa0\x55\xa1\x18\x79\xbb\xf2\xe2\x50\x71\x14\xa1\x53\x6d\xff\x13\x10\xd6\x53\x2c\x8c\x2f\x62\xa1\x6d\x99\xc5\x72\x3b\x4a\xb1\xb0\xc5\x60\x75\x8e\xda\xe5\x78\x1e\x3d\x28\x93\x25\x30\x23\xbf\x52\x82\x6e\xd1\x39\x65\x64\x54\x10\x63\x86\x8c\x49\x04\x60\xb0\xa0\x04\x0c\xf1\xda\xfa\x87\xd0\xe0\xc2\xeb\x59\x5b\x74\x70\x28\x28\x81\x1a\x72\x1a\xaa\xc0\x54\x44\xf5\x19\x77\xc9\xc6\x4d\xb2\x96\x34\x44\x00\xab\x4e\xf2\xea\x7c\x41\x8c\xe7\x11\x40\x4b\xf5\xc9\x06\x1e\xbf\x2a\xa1\xe1\x45\xa7\x42\xcb\xed\x29\xd8\xd2\x0b\x0a\x35\x13\xc0\x69\xcb\xd6\xe0\xee\xdb\xf0\xfb\x2e\xfe\x9e\x32\x49\xf7\xa2\x1e\xc6\xfb\x07\xaa\x02\xf1\x36\x0a\xa0\xa9\xc8\x33\x8d\x57\x99\xa4\x51\x8d\xfd\xbc\x0f\xc5\x92\xed\x35\x19\xf2\xc8\x94\x25\xc0\xbe\xa4\x76\x47\x65\x73\x2a\x9c\x46\xa6\x04\x7a\xce\xdb\x3f\x49\x70\x18\x3c\x3e\xb6\x9f\x9b\xcd\x40\x5b\xd1\xb4\x71\x20\xb5\x5d\xa0\x1e\xd0\x21\x45\x8d\xae\x0f\xb0\xd9\xf4\x5e\xe4\x1c\xa1\xf9\x40\x77\x81\xb2\xb9\xbd\x45\x16\xf9\xb4\x3d\x79\x53\xa8\x25\xea\xd0\xe9\xe8\x6a\x32\x5c\xa1\xd2\xb5\x7b\x52\x33\x0c\x81\x38\x35\x2b\x32\x6c\x7d\x75\x08\xef\x1a\xdf\x0e\x42\xd2\x2e\x3f\x4d\x01\x16\x1d\x12\x40\xe3\x82\x74\x48\xda\xf7\x33\xbe\xf4\x72\x17\xcb\xe8\x25\xf1\x47\x45\x3a\x3b\x48\x91\x2b\xf2\xe8\x45\xae\x04\xea\x29\x2d\xc9\x93\x79\x6a\x5e\xfd\x3f\x05\xae\x1c\x25\xd0\xd6\xec\x69\x1d\xe0\x81\xaa\xa7\xe5\x29\x2d\x9f\x71\x7f\x27\xd7\xb2\x55\xf0\xd6\x74\xf5\x93\x51\x10\x5e\xb9\xba\x4f\x09\xfc\x7d\xba\xb7\x03\x30\xcf\xa9\x0b\x02\xce\x91\x9b\x8b\xb4\x13\x01\x0b\xd2\xd6\xc8\x00\x6c\xe3\xbd\x30\xb9\x7a\xd8\x89\xda\x4d\xdb\xef\x2f\x34\xbd\xea\x89\xdd\x23\xbd\x2d\x5d\xf2\xc4\x56\xa0\x41\x49\xfe\xbb\xb6\xfc\x57\x6e\xb0\x5e\x49\x65\xde\xe4\x86\xc9\x3e\xf4\xe7\xb8\xa1\xa1\x38\xba\xe1\xe8\x86\xff\xc6\x0d\xed\xda\x9b\xec\x30\x3b\xc0\xfe\x1c\x3f\x74\xa4\x47\x43\x1c\x0d\xf1\xeb\x0c\x21\x91\x69\x8d\xd5\x8f\x5c\x70\x7d\x00\x79\x9f\xe1\x7f\x7c\xec\xbe\x37\x9b\x41\xab\xe1\x38\xf9\xc7\xc9\xff\x85\x93\x5f\x50\xc8\x7f\x34\xf6\xb7\xbb\xfd\x77\xbd\xf0\x6b\xde\xe3\x2d\x7f\xbc\xe5\xdf\xeb\x96\xff\x67\x00\x54\x3d\xa2\xbb\x59\x12\x00\x00"),
 		},
 		"/notebooks.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "notebooks.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 4929,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xdb\x46\x13\xbe\xf3\x57\x0c\xa4\xcb\xfb\x02\xb6\x14\xfb\xc8\x9e\x14\xc5\x49\x88\xda\x72\x21\xc9\x0d\x72\x32\x46\xcb\x11\xb9\xf5\x72\x77\xbb\x3b\x94\x22\xb8\xfa\xef\x05\x3f\x43\xda\x96\xed\x06\x41\xeb\x83\x74\x31\xb1\xf3\xf5\xcc\xc7\x33\xe3\x21\x4c\x8d\xdd\x39\x99\xa4\x0c\xe7\xef\xce\xcf\xe1\x93\x31\x89\x22\xb8\xbc\x9c\x06\xc3\x60\x08\x97\x52\x90\xf6\x14\x43\xae\x63\x72\xc0\x29\xc1\xc4\xa2\x48\xa9\x91\x9c\xc0\xef\xe4\xbc\x34\x1a\xce\x47\xef\xe0\x7f\x85\xc2\xa0\x16\x0d\xfe\xff\x4b\x30\x84\x9d\xc9\x21\xc3\x1d\x68\xc3\x90\x7b\x02\x4e\xa5\x87\xb5\x54\x04\xf4\x4d\x90\x65\x90\x1a\x84\xc9\xac\x92\xa8\x05\xc1\x56\x72\x5a\x86\xa9\x9d\x8c\x82\x21\x7c\xad\x5d\x98\x15\xa3\xd4\x80\x20\x8c\xdd\x81\x59\x77\xf5\x00\xb9\x04\x5c\xfe\x52\x66\x1b\x8e\xc7\xdb\xed\x76\x84\x25\xda\x91\x71\xc9\x58\x55\x9a\x7e\x7c\x19\x4d\x2f\x66\x8b\x8b\xd3\xf3\xd1\xbb\xd2\xe6\x46\x2b\xf2\x1e\x1c\xfd\x99\x4b\x47\x31\xac\x76\x80\xd6\x2a\x29\x70\xa5\x08\x14\x6e\xc1\x38\xc0\xc4\x11\xc5\xc0\xa6\x00\xbc\x75\x92\xa5\x4e\x4e\xc0\x9b\x35\x6f\xd1\x51\x30\x84\x58\x7a\x76\x72\x95\x73\xaf\x5a\x0d\x3c\xe9\x7b\x0a\x46\x03\x6a\x18\x4c\x16\x10\x2d\x06\xf0\x7e\xb2\x88\x16\x27\xc1\x10\xbe\x44\xcb\xcf\xd7\x37\x4b\xf8\x32\x99\xcf\x27\xb3\x65\x74\xb1\x80\xeb\x39\x4c\xaf\x67\x1f\xa2\x65\x74\x3d\x5b\xc0\xf5\x47\x98\xcc\xbe\xc2\xaf\xd1\xec\xc3\x09\x90\xe4\x94\x1c\xd0\x37\xeb\x0a\xfc\xc6\x81\x2c\xea\x48\x71\x51\xb4\x05\x51\x0f\xc0\xda\x54\x80\xbc\x25\x21\xd7\x52\x80\x42\x9d\xe4\x98\x10\x24\x66\x43\x4e\x4b\x9d\x80\x25\x97\x49\x5f\x74\xd3\x03\xea\x38\x18\x82\x92\x99\x64\xe4\xf2\xe5\x51\x52\xa3\x20\x40\x2b\xeb\xfe\x87\x20\x8c\xa3\x91\xd0\x2e\x1b\x09\x65\xf2\x78\x94\x94\xa3\x34\x12\x26\x1b\x6f\xce\x50\xd9\x14\xcf\x82\x3b\xa9\xe3\x10\x16\xe4\x36\x52\xd0\x15\x5a\x2b\x75\x12\x64\xc4\x18\x23\x63\x18\x00\x68\xcc\x28\x2c\x86\x85\x56\xc6\xdc\xf9\xa7\xfd\xd5\x7a\xde\xa2\xa0\x10\x0a\x95\x53\xbf\xf3\x4c\x59\x50\x64\xf7\xdd\xcd\xac\x71\x13\x00\x6c\x1a\x98\x9b\xb3\x15\x31\x9e\x05\x00\xbe\x82\xf1\xd9\x78\x9e\x3d\x08\x5b\xc5\x42\x2b\x7d\x1d\xcf\x91\x37\xb9\x13\xe4\x0b\xef\x00\xa7\x75\x84\x4a\xef\xb6\x35\xbc\x25\xbd\x91\xce\xe8\x8c\x34\x07\xd5\x30\x56\x29\xb7\x50\x2e\x1e\x29\x60\xce\xe6\x13\x69\x72\xc8\x14\x87\xc0\x2e\xa7\x5a\x22\xe3\x25\x65\x56\x21\x53\x08\x03\xeb\xcc\x1f\x24\xd8\x8f\xef\xef\xeb\xcf\xfd\x7e\xac\x8c\xa8\xba\x33\xbe\xbf\x6f\xbe\xf7\xfb\x71\x07\x45\x21\x29\xb0\xee\xf7\x83\x47\x5e\xa7\xa8\xdf\xd3\x8d\xa7\x78\x69\xae\x90\x45\x3a\xaf\x93\xac\xaa\xb1\x46\xe5\x1b\x24\x4d\xfa\x93\x0d\x4a\x55\xd0\x22\xd2\x13\xef\x89\x23\xbd\x21\xcd\xc6\xed\xfa\xea\x4d\x47\xeb\x0e\x87\xf5\x73\xdb\x5e\xcc\x1e\x3a\x8e\x3e\x7c\x57\x62\x74\x09\xf1\x47\x49\x2a\xee\xe9\xa6\x92\x1c\x3a\x91\x4a\x81\x6a\x4e\x6b\x72\xa4\xdb\x86\x54\x4d\xe1\x9d\xa5\x10\xea\xf2\xb4\xef\x00\x77\xb4\x6b\x9f\xe7\xb4\x7e\x10\xfb\x80\xaf\x75\x8d\xe0\xb5\xee\x8a\x5f\x4c\x5e\x38\x69\xb9\x9c\xb4\xbf\x4e\x3b\x12\x80\x65\x4a\x8d\x11\x70\x8a\x5c\xad\xc2\x06\x04\xac\x48\x19\x9d\x78\x60\x33\xea\x98\x25\x9b\xbb\xb0\xe7\xa5\x1a\xa7\xdf\x1e\x61\x7a\x72\xc2\x3b\x7e\x9c\xc9\x6d\xd8\x46\xcb\x50\x63\x42\xee\x20\xbd\x9a\x22\x94\x99\x36\x83\xd5\x4f\xb5\xad\x4f\x23\x7e\x19\x75\x4b\x82\xcb\xc7\x26\xaf\xc3\xff\xf2\x62\x68\xe1\x3d\x39\x44\x07\x79\x2b\xb5\xe7\xe2\x00\x3d\x4d\xda\xa8\x2f\x7d\x86\xb1\x98\x4d\x8d\x5e\xcb\xce\xc8\x5b\xa3\xa4\xd8\xcd\x9e\x0f\x7b\x2b\x31\xbb\xad\x34\x1f\x18\x5e\x51\xb6\x22\xf7\x1a\xf3\xac\xd4\x6c\xcd\x5d\x33\xd5\x55\x0d\x3a\xa5\xa9\x4a\xd0\xda\x76\x18\x56\x16\xae\xe4\x50\xef\xd1\xe7\xd6\x1a\xc7\x7e\x6a\x74\x2c\xcb\x75\xd3\xa7\xfb\x0f\xef\xa9\x06\xc3\x1b\x5f\x52\x00\x0a\x57\xa4\x7c\x58\xff\x3d\xee\xae\xe3\xee\xfa\xd7\x76\x97\x27\xb5\xbe\x55\x52\xdf\x75\x14\x2c\x3a\xd2\xdc\xd9\x3c\x07\xf7\xda\x83\x04\x9f\xcf\xe5\x67\xfd\x27\xf2\x86\xa9\x7c\xe4\xec\x1b\xe5\xec\xc1\x09\x76\xb9\x66\x99\x1d\x38\xcc\xf3\x9e\xf0\xe7\xdd\xe5\x3a\xe8\x0f\x9e\xe5\xae\xf5\x3f\xbc\xca\x8d\xe9\x7f\x78\x94\x6b\x08\x47\x22\x1f\x89\x7c\x3c\xbe\x2f\x1d\xdf\xbf\x03\x00\x00\xff\xff\x37\xbe\xbc\x44\x41\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4b\x6f\xe3\x36\x10\xbe\xeb\x57\x0c\xec\x4b\x0b\x24\xf2\x26\x47\xf5\xe4\x75\xb2\xbb\x42\x13\xa7\xb0\x9d\x2e\xf6\x14\x8c\xa9\x91\xc4\x86\x22\x59\x72\x64\xaf\x91\xfa\xbf\x17\xb2\x1e\x7e\xe4\xb9\x8b\x2d\x5a\xa0\x8e\x8d\x58\x20\xbf\x99\xf9\xe6\x49\xaa\x0f\x23\x63\x57\x4e\x66\x39\xc3\xf9\xbb\xf3\x73\xf8\x68\x4c\xa6\x08\xae\xae\x46\x41\x3f\xe8\xc3\x95\x14\xa4\x3d\x25\x50\xea\x84\x1c\x70\x4e\x30\xb4\x28\x72\x6a\x77\x4e\xe0\x77\x72\x5e\x1a\x0d\xe7\xe1\x3b\xf8\xa9\x02\xf4\x9a\xad\xde\xcf\xbf\x04\x7d\x58\x99\x12\x0a\x5c\x81\x36\x0c\xa5\x27\xe0\x5c\x7a\x48\xa5\x22\xa0\xaf\x82\x2c\x83\xd4\x20\x4c\x61\x95\x44\x2d\x08\x96\x92\x73\xe0\xad\xfe\x30\xe8\xc3\x97\x46\x85\x99\x33\x4a\x0d\x08\xc2\xd8\x15\x98\x74\x17\x07\xc8\x1b\xc2\x9b\xbf\x9c\xd9\x46\x83\xc1\x72\xb9\x0c\x71\xc3\x36\x34\x2e\x1b\xa8\x1a\xe9\x07\x57\xf1\xe8\x72\x3c\xbd\x3c\x3d\x0f\xdf\x6d\x64\x6e\xb5\x22\xef\xc1\xd1\x9f\xa5\x74\x94\xc0\x7c\x05\x68\xad\x92\x02\xe7\x8a\x40\xe1\x12\x8c\x03\xcc\x1c\x51\x02\x6c\x2a\xc2\x4b\x27\x59\xea\xec\x04\xbc\x49\x79\x89\x8e\x82\x3e\x24\xd2\xb3\x93\xf3\x92\xf7\xa2\xd5\xd2\x93\x7e\x0f\x60\x34\xa0\x86\xde\x70\x0a\xf1\xb4\x07\xef\x87\xd3\x78\x7a\x12\xf4\xe1\x73\x3c\xfb\x74\x73\x3b\x83\xcf\xc3\xc9\x64\x38\x9e\xc5\x97\x53\xb8\x99\xc0\xe8\x66\x7c\x11\xcf\xe2\x9b\xf1\x14\x6e\x3e\xc0\x70\xfc\x05\x7e\x8d\xc7\x17\x27\x40\x92\x73\x72\x40\x5f\xad\xab\xf8\x1b\x07\xb2\x8a\x23\x25\x55\xd0\xa6\x44\x7b\x04\x52\x53\xa7\xcf\x5b\x12\x32\x95\x02\x14\xea\xac\xc4\x8c\x20\x33\x0b\x72\x5a\xea\x0c\x2c\xb9\x42\xfa\x2a\x9b\x1e\x50\x27\x41\x1f\x94\x2c\x24\x23\x6f\x56\x1e\x39\x15\x06\x01\x5a\xd9\xe4\x3f\x02\x61\x1c\x85\x42\xbb\x22\x14\xca\x94\x49\x98\x6d\x4a\x29\x14\xa6\x18\x2c\xce\x50\xd9\x1c\xcf\x82\x7b\xa9\x93\x08\xa6\xe4\x16\x52\xd0\x35\x5a\x2b\x75\x16\x14\xc4\x98\x20\x63\x14\x00\x68\x2c\x28\xaa\x8a\x85\xe6\xc6\xdc\xfb\xa7\xf5\x35\x38\x6f\x51\x50\x04\x15\xe4\xd4\xaf\x3c\x53\x11\x54\xde\x6d\xd5\x8c\x5b\x35\x01\xc0\xa2\xa5\xb9\x38\x9b\x13\xe3\x59\x00\xe0\x6b\x1a\x9f\x8c\xe7\xf1\x81\xd9\xda\x16\x5a\xe9\x1b\x7b\x8e\xbc\x29\x9d\x20\x5f\x69\x07\x38\x6d\x2c\xd4\xb8\xbb\x4e\xf0\x8e\xf4\x42\x3a\xa3\x0b\xd2\xbc\x01\x02\xd4\x2e\x77\x54\x2e\x1f\x01\xb0\x64\xf3\x91\x34\x39\x64\x4a\x22\x60\x57\x52\xb3\x23\x93\x19\x15\x56\x21\x53\x04\x3d\xeb\xcc\x1f\x24\xd8\x0f\x1e\x1e\x9a\xc7\xf5\x7a\xa0\x8c\xa8\xb3\x33\x78\x78\x68\x9f\xd7\xeb\xc1\x0e\x8b\x0a\x5f\x71\x5d\xaf\x7b\x8f\xb4\x8e\x50\xbf\xa7\x5b\x4f\xc9\xcc\x5c\x23\x8b\x7c\xd2\x38\x59\x47\x23\x45\xe5\x5b\x26\xad\xfb\xc3\x05\x4a\x55\xb5\x45\xac\x87\xde\x13\xc7\x7a\x41\x9a\x8d\x5b\xed\xc3\xdb\x8c\x36\x19\x8e\x9a\xe5\x36\x2f\xd5\xff\x03\xc5\xf1\xc5\x16\xc4\xe8\x32\xe2\x0f\x92\x54\xb2\x87\xcd\x25\x39\x74\x22\x97\x02\xd5\x84\x52\x72\xa4\xbb\x84\x54\xdf\x53\xe0\x95\xa5\x08\x9a\xf0\x74\xeb\x00\xf7\xb4\xea\x96\x27\x94\x1e\xd8\x7e\x46\x57\xda\x30\x78\xab\xba\xea\x93\x90\x17\x4e\xda\x2a\x0d\x11\xfc\x75\xba\xb3\x03\x30\xcb\xa9\x15\x02\xce\x91\xeb\x51\xd8\x92\x80\x39\x29\xa3\x33\x0f\x6c\xc2\x1d\xb1\x6c\x71\xbf\x25\xb5\x2d\xa7\xdf\x1e\x71\x7a\xb2\xc2\xb7\x9f\xcc\x99\xd2\x46\x9d\xb5\x02\x35\x66\xe4\x9e\x6d\xaf\x36\x08\x1b\x4f\xdb\xc2\xda\x77\xb5\x8b\x4f\xbb\xfd\x3a\xeb\xae\x09\xae\x1e\x8b\xbc\x8d\xff\xeb\x83\xe1\xe5\x22\x7a\xb6\x6f\xa5\xf6\x5c\x1d\x40\xc1\x93\x7c\xe3\xfd\xdd\x17\x3a\x16\x8b\x91\xd1\xa9\xcc\xb6\xee\x5b\xa3\xa4\x58\x8d\x5f\x36\x7b\x27\xb1\xb8\xab\x91\x07\x82\xd7\x54\xcc\xc9\xbd\x45\xbc\xd8\x20\x3b\x71\xd7\x56\x75\x1d\x83\x6e\xbd\x6d\xc2\x4e\x76\xa7\xc3\xaa\x6f\xdd\x43\x7b\x8b\xbe\xb4\xd6\x38\xf6\x23\xa3\x13\x59\xa5\xda\xef\xb7\xfb\x77\xcf\xa9\x96\xc3\x7f\x7c\x48\x01\x28\x9c\x93\xf2\x51\xf3\x7b\x60\xef\x38\xbb\x8e\xb3\xeb\x9f\x9b\x5d\x9e\x54\x7a\xa7\xa4\xbe\xdf\x01\x58\x74\xa4\x79\x67\xf2\x3c\x3b\xd7\x0e\x1c\x7c\xd9\x97\x1f\x75\x13\x39\xde\x37\x8e\xf7\x8d\x6f\xbd\x6f\x3c\x5b\xc1\xae\xd4\x2c\x0b\x7a\xba\x80\x27\x7b\x9b\x3f\xee\x5c\x6e\x8c\x7e\xe7\xb1\xbc\x2b\xfd\x8d\xa7\x72\x2b\xfa\x2f\x1e\xca\x0d\x85\xe3\x8b\xc3\xf1\xc5\xe1\x7f\xff\xe2\xf0\xea\xe1\xfb\xf7\x00\x37\xbe\xbc\x44\x41\x13\x00\x00"),
 		},
 		"/orgpolicy.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "orgpolicy.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1175,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x93\x4d\x6f\xe3\x36\x10\x86\xef\xfa\x15\x2f\xec\x4b\x0b\x24\x72\xe2\xa3\x7a\xf2\x3a\xd9\x5d\xa1\x59\xbb\x88\x9c\x2e\xf6\x14\x8c\xa9\xb1\x34\xa8\x44\xb2\xe4\xc8\x5e\x23\xc8\x7f\x2f\xf4\xe1\x6e\x93\xad\x0f\x3e\x90\x0f\xdf\x0f\x0e\x35\xc7\xda\xf9\x73\x90\xaa\x56\x2c\x6f\x96\x4b\x7c\x72\xae\x6a\x18\x0f\x0f\xeb\x64\x9e\xcc\xf1\x20\x86\x6d\xe4\x12\x9d\x2d\x39\x40\x6b\xc6\xca\x93\xa9\xf9\xb2\x73\x85\x3f\x39\x44\x71\x16\xcb\xf4\x06\xbf\xf4\xc0\x6c\xda\x9a\xfd\xfa\x5b\x32\xc7\xd9\x75\x68\xe9\x0c\xeb\x14\x5d\x64\x68\x2d\x11\x07\x69\x18\xfc\xdd\xb0\x57\x88\x85\x71\xad\x6f\x84\xac\x61\x9c\x44\xeb\xc1\x66\x12\x49\x93\x39\xbe\x4d\x12\x6e\xaf\x24\x16\x04\xe3\xfc\x19\xee\xf0\x5f\x0e\xa4\x43\xe0\xe1\x57\xab\xfa\x6c\xb1\x38\x9d\x4e\x29\x0d\x69\x53\x17\xaa\x45\x33\x92\x71\xf1\x90\xaf\xef\x37\xc5\xfd\xf5\x32\xbd\x19\xce\x3c\xd9\x86\x63\x44\xe0\xbf\x3b\x09\x5c\x62\x7f\x06\x79\xdf\x88\xa1\x7d\xc3\x68\xe8\x04\x17\x40\x55\x60\x2e\xa1\xae\x0f\x7c\x0a\xa2\x62\xab\x2b\x44\x77\xd0\x13\x05\x4e\xe6\x28\x25\x6a\x90\x7d\xa7\x6f\x6e\xeb\x12\x4f\xe2\x1b\xc0\x59\x90\xc5\x6c\x55\x20\x2f\x66\xf8\xb0\x2a\xf2\xe2\x2a\x99\xe3\x6b\xbe\xfb\xbc\x7d\xda\xe1\xeb\xea\xf1\x71\xb5\xd9\xe5\xf7\x05\xb6\x8f\x58\x6f\x37\x77\xf9\x2e\xdf\x6e\x0a\x6c\x3f\x62\xb5\xf9\x86\xdf\xf3\xcd\xdd\x15\x58\xb4\xe6\x00\xfe\xee\x43\x9f\xdf\x05\x48\x7f\x8f\x5c\xf6\x97\x56\x30\xbf\x09\x70\x70\x63\xa0\xe8\xd9\xc8\x41\x0c\x1a\xb2\x55\x47\x15\xa3\x72\x47\x0e\x56\x6c\x05\xcf\xa1\x95\xd8\x4f\x33\x82\x6c\x99\xcc\xd1\x48\x2b\x4a\x3a\xac\xfc\x54\x2a\x4d\x12\xf2\x32\xcd\x3f\x83\x71\x81\x53\x63\x43\x9b\x9a\xc6\x75\x65\x5a\x0d\x4f\x29\x35\xae\x5d\x1c\x6f\xa9\xf1\x35\xdd\x26\x7f\x89\x2d\x33\x14\x1c\x8e\x62\xf8\x0b\x79\x2f\xb6\x4a\x5a\x56\x2a\x49\x29\x4b\x00\x4b\x2d\x67\x70\xa1\xf2\xae\x11\x73\xfe\x7f\xbd\x89\x8b\x9e\x0c\x67\xe8\x91\xeb\x78\x8e\xca\x6d\xd2\xb7\xfb\x21\xb3\x0d\xd5\x1f\x83\x4c\x02\x1c\x2f\x31\x8f\xb7\x7b\x56\xba\x4d\x80\x38\xc6\xf8\xec\xa2\x6e\xde\xd9\x8e\x5e\xe4\x25\x4e\x7e\x81\xa3\xeb\x82\xe1\xd8\xab\x03\xd7\x93\xc3\xc8\x3d\xbb\x50\x3d\x8f\x27\x9f\x4d\x17\xd5\xb5\xcf\xc6\xd9\xa8\x81\xc4\x6a\x32\xbe\xc9\xb1\xf9\xbf\x89\xd6\x03\xb6\x7e\x4f\x51\xa7\xee\x13\x5b\x0e\xa4\x5c\x66\xd0\xd0\xf1\xb4\x23\xe5\x8e\x5b\xdf\x90\x72\x86\xd9\xcb\x8b\xa7\xc0\x56\x5f\x5f\x17\xe6\x9d\x50\x5c\xbc\xbc\xf4\xd9\x5e\x5f\x67\x3f\x9d\x5c\x93\xfd\xc0\x4f\x91\xcb\x9d\xfb\x42\x6a\xea\xc7\xa9\xd4\xd8\xfe\x40\x4d\xbc\xb8\x5d\xea\xae\x8e\x24\x4d\xff\x19\xe4\x76\x15\x23\x6b\x6e\x8f\x6c\xd5\x85\xf3\x5b\xfc\x32\xc1\x69\xa2\xd9\xb4\x7c\x99\x43\xff\xff\x4e\x38\xbf\xfb\x01\x29\x85\x8a\xf5\xa3\x70\x53\x4e\xec\x3f\x01\x00\x00\xff\xff\xf0\xa2\x6e\xf4\x97\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x93\x41\x6f\xe3\x36\x10\x85\xef\xfa\x15\x0f\xf6\xa5\x05\x12\x39\xf1\x51\x3d\x79\x9d\xec\xae\xd0\xac\x5d\x44\x4e\x17\x7b\x0a\xc6\xd4\x58\x1a\x54\x22\x59\x72\x64\xaf\x11\xe4\xbf\x17\x92\xe5\xa6\x71\xaa\x83\x0e\xe4\xc7\x37\xef\xf1\x49\x53\x2c\x9d\x3f\x06\xa9\x6a\xc5\xfc\x66\x3e\xc7\x17\xe7\xaa\x86\xf1\xf0\xb0\x4c\xa6\xc9\x14\x0f\x62\xd8\x46\x2e\xd1\xd9\x92\x03\xb4\x66\x2c\x3c\x99\x9a\xcf\x3b\x57\xf8\x93\x43\x14\x67\x31\x4f\x6f\xf0\x4b\x0f\x4c\xc6\xad\xc9\xaf\xbf\x25\x53\x1c\x5d\x87\x96\x8e\xb0\x4e\xd1\x45\x86\xd6\x12\xb1\x93\x86\xc1\x3f\x0d\x7b\x85\x58\x18\xd7\xfa\x46\xc8\x1a\xc6\x41\xb4\x86\xbe\xe9\xa7\xc9\x14\x3f\x46\x09\xb7\x55\x12\x0b\x82\x71\xfe\x08\xb7\xfb\x2f\x07\xd2\xc1\xf0\xf0\xd4\xaa\x3e\x9b\xcd\x0e\x87\x43\x4a\x83\xdb\xd4\x85\x6a\xd6\x9c\xc8\x38\x7b\xc8\x97\xf7\xab\xe2\xfe\x7a\x9e\xde\x0c\x67\x9e\x6c\xc3\x31\x22\xf0\xdf\x9d\x04\x2e\xb1\x3d\x82\xbc\x6f\xc4\xd0\xb6\x61\x34\x74\x80\x0b\xa0\x2a\x30\x97\x50\xd7\x1b\x3e\x04\x51\xb1\xd5\x15\xa2\xdb\xe9\x81\x02\x27\x53\x94\x12\x35\xc8\xb6\xd3\x77\xb7\x75\xb6\x27\xf1\x1d\xe0\x2c\xc8\x62\xb2\x28\x90\x17\x13\x7c\x5a\x14\x79\x71\x95\x4c\xf1\x3d\xdf\x7c\x5d\x3f\x6d\xf0\x7d\xf1\xf8\xb8\x58\x6d\xf2\xfb\x02\xeb\x47\x2c\xd7\xab\xbb\x7c\x93\xaf\x57\x05\xd6\x9f\xb1\x58\xfd\xc0\xef\xf9\xea\xee\x0a\x2c\x5a\x73\x00\xff\xf4\xa1\xf7\xef\x02\xa4\xbf\x47\x2e\xfb\x4b\x2b\x98\xdf\x19\xd8\xb9\x53\x7d\xd1\xb3\x91\x9d\x18\x34\x64\xab\x8e\x2a\x46\xe5\xf6\x1c\xac\xd8\x0a\x9e\x43\x2b\xb1\x6f\x33\x82\x6c\x99\x4c\xd1\x48\x2b\x4a\x3a\xac\x7c\x08\x95\x26\x09\x79\x19\xfb\xcf\x60\x5c\xe0\xd4\xd8\xd0\xa6\xa6\x71\x5d\x99\x56\xc3\xa7\x94\x1a\xd7\xce\xf6\xb7\xd4\xf8\x9a\x6e\x93\xbf\xc4\x96\x19\x0a\x0e\x7b\x31\xfc\x8d\xbc\x17\x5b\x25\x2d\x2b\x95\xa4\x94\x25\x80\xa5\x96\x33\xb8\x50\x79\xd7\x88\x39\xfe\xbf\xde\xc8\x45\x4f\x86\x33\xf4\xc8\x75\x3c\x46\xe5\x36\xe9\xd3\xbd\xc9\xac\x43\xf5\xc7\x20\x93\x00\xfb\xb3\xcd\xfd\xed\x96\x95\x6e\x13\x20\x9e\x6c\x7c\x75\x51\x57\x17\x63\x4f\xb3\xc8\x4b\x1c\xe7\x05\x8e\xae\x0b\x86\x63\xaf\x0e\x5c\x8f\x13\x4e\xdc\xb3\x0b\xd5\xf3\xe9\xe4\xb3\xe9\xa2\xba\xf6\xd9\x38\x1b\x35\x90\x58\x1d\x78\xe0\x94\xfc\x5f\x47\xcb\x01\x5b\x5e\x52\xd4\xa9\xfb\xc2\x96\x03\x29\x97\x19\x34\x74\x3c\xee\x48\xb9\xe1\xd6\x37\xa4\x9c\x61\xf2\xf2\xe2\x29\xb0\xd5\xd7\xd7\x99\xb9\x10\x8a\xb3\x97\x97\xde\xdb\xeb\xeb\xe4\xc3\xc9\x25\xd9\x4f\xfc\x14\xb9\xdc\xb8\x6f\xa4\xa6\x7e\x1c\x43\x9d\xd2\xef\xa8\x89\xe7\x69\xe7\xb8\x8b\x3d\x49\xd3\xff\x06\xb9\x5d\xc4\xc8\x9a\xdb\x3d\x5b\x75\xe1\xf8\x1e\x3f\x37\x38\x36\x9a\x8d\xcb\xe7\x1e\xfa\xf7\x85\x70\x7e\xf7\x06\x29\x85\x8a\xf5\xb3\x70\x53\x66\xb0\xd4\x72\xf2\xcf\x00\xf0\xa2\x6e\xf4\x97\x04\x00\x00"),
 		},
 		"/osconfig.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "osconfig.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2283,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x41\x6f\xe3\x36\x10\x85\xef\xfa\x15\x0f\xf6\xa5\x05\x12\x79\x93\xa3\x7a\xf2\x3a\xd9\xac\xd0\xac\x1d\xd8\x4e\x17\x7b\x32\xc6\xd4\x58\x62\x23\x91\x2c\x39\xb2\x57\x48\xf3\xdf\x0b\x59\x72\x6a\x27\xd9\xc5\x02\x05\x8a\x1e\xaa\x93\x41\x3e\xbe\xf9\x66\xf8\xe8\x21\x26\xd6\x35\x5e\xe7\x85\xe0\xf2\xdd\xe5\x25\x6e\xac\xcd\x4b\xc6\xed\xed\x24\x1a\x46\x43\xdc\x6a\xc5\x26\x70\x86\xda\x64\xec\x21\x05\x63\xec\x48\x15\x7c\xd8\x39\xc3\x6f\xec\x83\xb6\x06\x97\xf1\x3b\xfc\xd4\x0a\x06\xfd\xd6\xe0\xe7\x5f\xa2\x21\x1a\x5b\xa3\xa2\x06\xc6\x0a\xea\xc0\x90\x42\x07\x6c\x74\xc9\xe0\xaf\x8a\x9d\x40\x1b\x28\x5b\xb9\x52\x93\x51\x8c\x9d\x96\x62\x5f\xa6\x37\x89\xa3\x21\xbe\xf4\x16\x76\x2d\xa4\x0d\x08\xca\xba\x06\x76\x73\xac\x03\xc9\x1e\x78\xff\x15\x22\x2e\x19\x8d\x76\xbb\x5d\x4c\x7b\xda\xd8\xfa\x7c\x54\x76\xca\x30\xba\x4d\x27\xd7\xd3\xc5\xf5\xf9\x65\xfc\x6e\x7f\xe6\xde\x94\x1c\x02\x3c\xff\x51\x6b\xcf\x19\xd6\x0d\xc8\xb9\x52\x2b\x5a\x97\x8c\x92\x76\xb0\x1e\x94\x7b\xe6\x0c\x62\x5b\xe0\x9d\xd7\xa2\x4d\x7e\x86\x60\x37\xb2\x23\xcf\xd1\x10\x99\x0e\xe2\xf5\xba\x96\x93\x69\x1d\xf0\x74\x38\x11\x58\x03\x32\x18\x8c\x17\x48\x17\x03\xbc\x1f\x2f\xd2\xc5\x59\x34\xc4\xe7\x74\xf9\x71\x76\xbf\xc4\xe7\xf1\x7c\x3e\x9e\x2e\xd3\xeb\x05\x66\x73\x4c\x66\xd3\xab\x74\x99\xce\xa6\x0b\xcc\x3e\x60\x3c\xfd\x82\x5f\xd3\xe9\xd5\x19\x58\x4b\xc1\x1e\xfc\xd5\xf9\x96\xdf\x7a\xe8\x76\x8e\x9c\xb5\x43\x5b\x30\x9f\x00\x6c\x6c\x07\x14\x1c\x2b\xbd\xd1\x0a\x25\x99\xbc\xa6\x9c\x91\xdb\x2d\x7b\xa3\x4d\x0e\xc7\xbe\xd2\xa1\xbd\xcd\x00\x32\x59\x34\x44\xa9\x2b\x2d\x24\xfb\x95\x57\x4d\xc5\x51\x44\x4e\xf7\xf7\x9f\x40\x59\xcf\xb1\x32\xbe\x8a\x55\x69\xeb\x2c\xce\xf7\x51\x8a\x95\xad\x46\xdb\x0b\x2a\x5d\x41\x17\xd1\x83\x36\x59\x82\x05\xfb\xad\x56\xfc\x89\x9c\xd3\x26\x8f\x2a\x16\xca\x48\x28\x89\x00\x43\x15\x27\xb0\x41\x59\xb3\xd1\xf9\xdb\x76\xbd\x2c\x38\x52\x9c\xa0\x95\x9c\x87\x26\x08\x57\x51\xdb\xdc\xdf\x2e\xb3\xc5\x64\xef\x12\x01\xdb\x03\xe4\xf6\x62\xcd\x42\x17\x11\x10\x3a\x88\x8f\x36\xc8\xf4\xb4\x68\x57\x89\x9c\x0e\x7d\x35\xcf\xc1\xd6\x5e\x71\x68\xbd\x81\xf3\xde\xbf\xd3\xad\x6c\x58\x75\x07\x57\x79\xcd\x41\x56\xce\x96\x5a\x69\x0e\x51\x17\xc6\xae\xe5\x03\xcb\x4d\x2b\xb9\x3b\x55\x50\x2d\xf6\x86\x0d\x7b\x12\xce\x12\x88\xaf\xb9\xdf\xd1\xd9\x92\x2b\x57\x92\x70\x82\x81\xf3\xf6\x77\x56\x12\x46\x8f\x8f\xfd\xcf\xa7\xa7\x51\x7e\xec\x37\x7a\x7c\x3c\x42\x68\x56\x3a\x7b\x7a\x1a\xbc\xb2\x9a\x90\x79\xcf\xf7\x81\xb3\xa5\xfd\x44\xa2\x8a\x79\xdf\x5d\x37\x85\x0d\x95\xe1\x50\xfe\xd0\xf7\x78\x4b\xba\x6c\x1f\x43\x6a\xc6\x21\xb0\xa4\x66\xcb\x46\xac\x6f\x4e\xe5\xed\x44\xd9\x3f\x77\x92\x5e\x7d\xd0\x5c\x66\x09\x5e\x30\xbd\x30\x4f\xaf\x92\x7e\x05\x10\xf2\x39\xcb\x77\x8f\x15\x9a\x3d\x79\x55\x68\x45\xe5\x9c\x37\xec\xd9\x3c\x5f\x4c\x77\x39\xd2\x38\x4e\xd0\x8f\xe8\x79\x1d\x78\xe0\xe6\x79\x79\xce\x9b\x17\x18\xdf\xf0\xda\xf4\x30\x3f\x6a\xd7\x7e\x19\x07\xe5\xb5\x93\x7d\xe0\xfe\x3c\x3f\xda\x01\x96\x05\x1f\x0e\x41\x0a\x92\xee\xff\xf0\x00\x81\x35\x97\xd6\xe4\x01\x62\xe3\xa3\x63\xf9\xf6\x21\x39\x71\xe9\x32\x75\xf7\x8a\xe9\xcd\xa0\x1f\xf9\x78\x5b\xbb\xe4\xb9\x5a\x45\x86\x72\xf6\xdf\x7c\x64\xdf\x49\xba\x6b\x83\xb3\xca\xd8\x95\xb6\xa9\xd8\xc8\x9b\x59\xbf\x6b\x45\x57\x2f\x35\xff\x24\xed\xee\xd4\xb1\xdd\x6c\x01\xff\x13\x29\x6f\x41\x7e\x34\xda\x47\xda\xff\xf3\xfc\x2f\xe5\xf9\xaf\x00\x00\x00\xff\xff\x32\x0d\x6e\xd6\xeb\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x41\x6f\xe2\xc8\x13\xc5\xef\xfe\x14\x4f\x70\xf9\xff\xa5\xc4\x24\x39\x7a\x4f\x0c\xc9\x64\xac\xcd\x40\x04\x64\x47\x73\x42\x45\xbb\xb0\x7b\x63\x77\xf7\x76\x97\x61\xac\x6c\xbe\xfb\xca\x60\x02\x24\x99\xd1\x48\x2b\xad\xf6\xb0\x98\x03\xea\x7e\xfd\xea\x57\xd5\xcf\xf4\x31\xb2\xae\xf1\x3a\x2f\x04\x57\x17\x57\x57\xb8\xb5\x36\x2f\x19\x77\x77\xa3\xa8\x1f\xf5\x71\xa7\x15\x9b\xc0\x19\x6a\x93\xb1\x87\x14\x8c\xa1\x23\x55\xf0\x7e\xe7\x0c\xbf\xb1\x0f\xda\x1a\x5c\xc5\x17\xf8\x5f\x2b\xe8\x75\x5b\xbd\xff\xff\x12\xf5\xd1\xd8\x1a\x15\x35\x30\x56\x50\x07\x86\x14\x3a\x60\xa5\x4b\x06\x7f\x53\xec\x04\xda\x40\xd9\xca\x95\x9a\x8c\x62\x6c\xb4\x14\x90\x83\x7f\x1c\xf5\xf1\xb5\xb3\xb0\x4b\x21\x6d\x40\x50\xd6\x35\xb0\xab\x63\x1d\x48\xb6\xc0\xdb\x4f\x21\xe2\x92\xc1\x60\xb3\xd9\xc4\xb4\xa5\x8d\xad\xcf\x07\xe5\x4e\x19\x06\x77\xe9\xe8\x66\x3c\xbb\x39\xbf\x8a\x2f\xb6\x67\x1e\x4c\xc9\x21\xc0\xf3\x1f\xb5\xf6\x9c\x61\xd9\x80\x9c\x2b\xb5\xa2\x65\xc9\x28\x69\x03\xeb\x41\xb9\x67\xce\x20\xb6\x05\xde\x78\x2d\xda\xe4\x67\x08\x76\x25\x1b\xf2\x1c\xf5\x91\xe9\x20\x5e\x2f\x6b\x39\x99\xd6\x1e\x4f\x87\x13\x81\x35\x20\x83\xde\x70\x86\x74\xd6\xc3\x87\xe1\x2c\x9d\x9d\x45\x7d\x7c\x49\xe7\x9f\x26\x0f\x73\x7c\x19\x4e\xa7\xc3\xf1\x3c\xbd\x99\x61\x32\xc5\x68\x32\xbe\x4e\xe7\xe9\x64\x3c\xc3\xe4\x23\x86\xe3\xaf\xf8\x35\x1d\x5f\x9f\x81\xb5\x14\xec\xc1\xdf\x9c\x6f\xf9\xad\x87\x6e\xe7\xc8\x59\x3b\xb4\x19\xf3\x09\xc0\xca\xee\xae\x2f\x38\x56\x7a\xa5\x15\x4a\x32\x79\x4d\x39\x23\xb7\x6b\xf6\x46\x9b\x1c\x8e\x7d\xa5\x43\x7b\x9b\x01\x64\xb2\xa8\x8f\x52\x57\x5a\x48\xb6\x2b\x6f\x9a\x8a\xa3\x88\x9c\xee\xee\x3f\x81\xb2\x9e\x63\x65\x7c\x15\xab\xd2\xd6\x59\x9c\x6f\xa3\x14\x2b\x5b\x0d\xd6\x97\x54\xba\x82\x2e\xa3\x47\x6d\xb2\x04\x33\xf6\x6b\xad\xf8\x33\x39\xa7\x4d\x1e\x55\x2c\x94\x91\x50\x12\x01\x86\x2a\x4e\x60\x83\xb2\x66\xa5\xf3\xf7\xed\x3a\x59\x70\xa4\x38\x41\x2b\x39\x0f\x4d\x10\xae\xa2\xb6\xb9\x83\xcb\x64\x36\xda\xba\x44\xc0\x7a\x0f\xb9\xbe\x5c\xb2\xd0\x65\x04\x84\x1d\xc4\x27\x1b\x64\x7c\x5a\x74\x57\x89\x9c\x0e\x5d\x35\xcf\xc1\xd6\x5e\x71\x68\xbd\x81\xf3\xce\x7f\xa7\x5b\xd8\xb0\xd8\xd1\x2e\xf2\x9a\x83\x2c\x9c\x2d\xb5\xd2\x1c\xb6\x5a\x60\xd7\xf2\x9e\xe5\xb6\x95\xdc\x9f\x2a\xa8\x16\x7b\xcb\x86\x3d\x09\x67\x09\xc4\xd7\xdc\xed\xe8\x6c\xce\x95\x2b\x49\x38\x41\xcf\x79\xfb\x3b\x2b\x09\x83\xa7\xa7\xee\xe7\xf3\xf3\x20\x3f\xf6\x1b\x3c\x3d\x1d\x21\x34\x0b\x9d\x3d\x3f\xf7\xde\x58\x8d\xc8\x7c\xe0\x87\xc0\xd9\xdc\x7e\x26\x51\xc5\xb4\xeb\x6e\x37\x85\x15\x95\x61\x5f\x7e\xdf\xf7\x70\x4d\xba\x6c\x5f\x86\xd4\x0c\x43\x60\x49\xcd\x9a\x8d\x58\xdf\x9c\xca\xdb\x89\xb2\x7f\xe9\x24\xbd\xfe\xa8\xb9\xcc\x12\xbc\x62\x7a\x65\x9e\x5e\x27\xdd\x0a\x20\xe4\x73\x96\x1f\x1e\x2b\x34\x7b\xf2\xaa\xd0\x8a\xca\x29\xaf\xd8\xb3\x79\xb9\x98\xf6\x7b\x0e\x69\x1c\x27\xe8\x46\xf4\xb2\x0e\x3c\x72\xf3\xb2\x3c\xe5\xd5\x2b\x8c\xef\x78\xad\x3a\x98\x9f\xb5\x6b\x9f\x8c\x83\xf2\xda\xb5\x6f\x4d\x82\x3f\xcf\x8f\x76\x80\x79\xc1\xfb\x43\x90\x82\x64\xf7\x7f\xb8\x87\xc0\x92\x4b\x6b\xf2\x00\xb1\xf1\xd1\xb1\x7c\xfd\x78\x80\x3a\x64\xea\xfe\x0d\xd3\xbb\x41\x3f\x3c\xb9\xb7\xb5\x4b\x5e\xaa\x55\x64\x28\x67\xff\xdd\x97\xec\x07\x49\x77\x6d\x70\x16\x19\xbb\xd2\x36\x15\x1b\x79\x37\xeb\xf7\xad\xe8\xfa\xb5\xe6\xef\xa4\xdd\x9d\x3a\xb6\x9b\x2d\xe0\xbf\x22\xe5\x2d\xc8\xcf\x46\xfb\x48\xfb\x5f\x9e\xff\xa1\x3c\xff\x35\x00\x32\x0d\x6e\xd6\xeb\x08\x00\x00"),
 		},
 		"/oslogin.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "oslogin.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1172,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x4f\x6f\xdb\x38\x10\xc5\xef\xfa\x14\x0f\xf6\x65\x17\x48\xe4\xc4\x47\xed\xc9\x75\xd2\x46\xa8\x6b\x2f\x22\x67\x8b\x9e\x8c\x31\x35\x96\x06\xa5\x48\x2e\x49\xd9\x35\x8a\x7c\xf7\x85\xfe\x38\x5b\xb7\xd5\x45\x00\xe7\xf1\xcd\xef\x0d\x67\x8a\xa5\x75\x67\x2f\x55\x1d\x31\xbf\x9b\xcf\xf1\xc1\xda\x4a\x33\x56\xab\x65\x32\x4d\xa6\x58\x89\x62\x13\xb8\x44\x6b\x4a\xf6\x88\x35\x63\xe1\x48\xd5\x7c\xa9\xdc\xe0\x1f\xf6\x41\xac\xc1\x3c\xbd\xc3\x1f\x9d\x60\x32\x96\x26\x7f\xfe\x95\x4c\x71\xb6\x2d\x1a\x3a\xc3\xd8\x88\x36\x30\x62\x2d\x01\x07\xd1\x0c\xfe\xa6\xd8\x45\x88\x81\xb2\x8d\xd3\x42\x46\x31\x4e\x12\xeb\xbe\xcd\x68\x92\x26\x53\x7c\x19\x2d\xec\x3e\x92\x18\x10\x94\x75\x67\xd8\xc3\x8f\x3a\x50\xec\x81\xfb\xaf\x8e\xd1\x65\xb3\xd9\xe9\x74\x4a\xa9\xa7\x4d\xad\xaf\x66\x7a\x50\x86\xd9\x2a\x5f\x3e\xae\x8b\xc7\xdb\x79\x7a\xd7\xdf\x79\x31\x9a\x43\x80\xe7\x7f\x5b\xf1\x5c\x62\x7f\x06\x39\xa7\x45\xd1\x5e\x33\x34\x9d\x60\x3d\xa8\xf2\xcc\x25\xa2\xed\x80\x4f\x5e\xa2\x98\xea\x06\xc1\x1e\xe2\x89\x3c\x27\x53\x94\x12\xa2\x97\x7d\x1b\xaf\xa6\x75\xc1\x93\x70\x25\xb0\x06\x64\x30\x59\x14\xc8\x8b\x09\xde\x2d\x8a\xbc\xb8\x49\xa6\xf8\x9c\x6f\x9f\x36\x2f\x5b\x7c\x5e\x3c\x3f\x2f\xd6\xdb\xfc\xb1\xc0\xe6\x19\xcb\xcd\xfa\x21\xdf\xe6\x9b\x75\x81\xcd\x7b\x2c\xd6\x5f\xf0\x31\x5f\x3f\xdc\x80\x25\xd6\xec\xc1\xdf\x9c\xef\xf8\xad\x87\x74\x73\xe4\xb2\x1b\x5a\xc1\x7c\x05\x70\xb0\x03\x50\x70\xac\xe4\x20\x0a\x9a\x4c\xd5\x52\xc5\xa8\xec\x91\xbd\x11\x53\xc1\xb1\x6f\x24\x74\xaf\x19\x40\xa6\x4c\xa6\xd0\xd2\x48\xa4\xd8\x9f\xfc\x12\x2a\x4d\x12\x72\x32\xbe\x7f\x06\x65\x3d\xa7\xca\xf8\x26\x55\xda\xb6\x65\x5a\xf5\xab\x94\x2a\xdb\xcc\x8e\xf7\xa4\x5d\x4d\xf7\xc9\x57\x31\x65\x86\x82\xfd\x51\x14\x7f\x22\xe7\xc4\x54\x49\xc3\x91\x4a\x8a\x94\x25\x80\xa1\x86\x33\xd8\xa0\x6d\x25\xe6\xf7\x6e\xa3\x2a\x38\x52\x9c\xa1\x93\xdc\x86\x73\x88\xdc\x24\x5d\xb6\xff\x4d\x36\xc5\xaa\x33\x49\x80\xe3\x05\xf1\x78\xbf\xe7\x48\xf7\x09\x10\x06\x84\x27\x1b\xe2\xfa\xaa\xe5\xd0\x87\x9c\x84\xb1\x97\xe7\x60\x5b\xaf\x38\x74\xce\xc0\xed\xe8\x3e\xe8\x76\x36\xec\xfa\x7b\xbb\x10\xea\x9d\x6b\xf7\x5a\xd4\xee\x2b\x9f\x93\x61\x11\x87\xb8\x23\x48\x51\x3c\xfd\xdd\x0b\x3e\xbe\xd5\xa9\x8d\xf6\x03\x1b\xf6\x14\xb9\xcc\x10\x7d\xcb\x63\x45\xca\x2d\x37\x4e\x53\xe4\x0c\x93\x36\xb0\x0f\xb3\xef\xdf\xbb\xff\xeb\xeb\x2c\x84\xfa\xcd\xa8\x3b\x3e\x88\xa9\xd8\x3b\x2f\x26\xbe\xbe\x4e\x7e\x31\x58\x92\x79\xc7\x2f\x81\xcb\xad\xfd\x44\x51\xd5\xcf\x63\xa0\x21\xf7\x81\x74\xb8\x34\xbd\x44\x5d\x1c\x49\x74\xb7\xfc\xb9\x59\x84\xc0\x31\x37\x47\x36\xd1\xfa\xf3\xb5\xbc\x9b\x21\xfb\x37\xfe\xfc\xe1\xbd\xb0\x2e\x33\xfc\xc0\xf3\x93\x71\xfe\x90\x8d\x27\x40\x24\x5f\x71\xfc\xcd\x95\xff\x02\x00\x00\xff\xff\xcc\xe5\x8d\x4c\x94\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6f\xdb\x38\x10\x85\xef\xfa\x15\x0f\xf6\x65\x17\x48\xe4\xc4\x47\xed\xc9\x75\xd2\x46\xa8\x6b\x2f\x22\x67\x8b\x9e\x8c\x31\x35\x96\x06\xa5\x48\x2e\x49\xd9\x15\x8a\xfc\xf7\x85\x64\x39\xad\xb7\xd5\x45\x00\xe7\xf1\xcd\xf7\x66\x38\xc5\xd2\xba\xce\x4b\x55\x47\xcc\xef\xe6\x73\x7c\xb0\xb6\xd2\x8c\xd5\x6a\x99\x4c\x93\x29\x56\xa2\xd8\x04\x2e\xd1\x9a\x92\x3d\x62\xcd\x58\x38\x52\x35\x5f\x2a\x37\xf8\x87\x7d\x10\x6b\x30\x4f\xef\xf0\x47\x2f\x98\x8c\xa5\xc9\x9f\x7f\x25\x53\x74\xb6\x45\x43\x1d\x8c\x8d\x68\x03\x23\xd6\x12\x70\x10\xcd\xe0\x6f\x8a\x5d\x84\x18\x28\xdb\x38\x2d\x64\x14\xe3\x24\xb1\x46\xfc\xe1\x9f\x26\x53\x7c\x19\x2d\xec\x3e\x92\x18\x10\x94\x75\x1d\xec\xe1\x67\x1d\x28\x0e\xc0\xc3\x57\xc7\xe8\xb2\xd9\xec\x74\x3a\xa5\x34\xd0\xa6\xd6\x57\x33\x7d\x56\x86\xd9\x2a\x5f\x3e\xae\x8b\xc7\xdb\x79\x7a\x37\xdc\x79\x31\x9a\x43\x80\xe7\x7f\x5b\xf1\x5c\x62\xdf\x81\x9c\xd3\xa2\x68\xaf\x19\x9a\x4e\xb0\x1e\x54\x79\xe6\x12\xd1\xf6\xc0\x27\x2f\x51\x4c\x75\x83\x60\x0f\xf1\x44\x9e\x93\x29\x4a\x09\xd1\xcb\xbe\x8d\x57\xd3\xba\xe0\x49\xb8\x12\x58\x03\x32\x98\x2c\x0a\xe4\xc5\x04\xef\x16\x45\x5e\xdc\x24\x53\x7c\xce\xb7\x4f\x9b\x97\x2d\x3e\x2f\x9e\x9f\x17\xeb\x6d\xfe\x58\x60\xf3\x8c\xe5\x66\xfd\x90\x6f\xf3\xcd\xba\xc0\xe6\x3d\x16\xeb\x2f\xf8\x98\xaf\x1f\x6e\xc0\x12\x6b\xf6\xe0\x6f\xce\xf7\xfc\xd6\x43\xfa\x39\x72\xd9\x0f\xad\x60\xbe\x02\x38\xd8\xf3\xfa\x82\x63\x25\x07\x51\xd0\x64\xaa\x96\x2a\x46\x65\x8f\xec\x8d\x98\x0a\x8e\x7d\x23\xa1\xdf\x66\x00\x99\x32\x99\x42\x4b\x23\x91\xe2\x70\xf2\x4b\xa8\x34\x49\xc8\xc9\xb8\xff\x0c\xca\x7a\x4e\x95\xf1\x4d\xaa\xb4\x6d\xcb\xb4\x1a\x9e\x52\xaa\x6c\x33\x3b\xde\x93\x76\x35\xdd\x27\x5f\xc5\x94\x19\x0a\xf6\x47\x51\xfc\x89\x9c\x13\x53\x25\x0d\x47\x2a\x29\x52\x96\x00\x86\x1a\xce\x60\x83\xb6\x95\x98\xdf\xbb\x8d\xaa\xe0\x48\x71\x86\x5e\x72\x1b\xba\x10\xb9\x49\xfa\x6c\x3f\x4c\x36\xc5\xaa\x37\x49\x80\xe3\x05\xf1\x78\xbf\xe7\x48\xf7\x09\x10\xce\x08\x4f\x36\xc4\xf5\x55\xcb\x73\x1f\x72\x12\xc6\x5e\x9e\x83\x6d\xbd\xe2\xd0\x3b\x03\xb7\xa3\xfb\x59\xb7\xb3\x61\x37\xa0\xee\x42\xa8\x77\xae\xdd\x6b\x51\xbb\xaf\xdc\x0d\x52\xe0\x1c\x77\x04\x29\x8a\xa7\xbf\x07\xc1\xc7\xb7\x3a\xb5\xd1\x7e\x60\xc3\x9e\x22\x97\x19\xa2\x6f\x79\xac\x48\xb9\xe5\xc6\x69\x8a\x9c\x61\xd2\x06\xf6\x61\xf6\xfd\x7b\xff\x7f\x7d\x9d\x85\x50\xbf\x19\xf5\xc7\x07\x31\x15\x7b\xe7\xc5\xc4\xd7\xd7\xc9\x2f\x06\x4b\x32\xef\xf8\x25\x70\xb9\xb5\x9f\x28\xaa\xfa\x79\x0c\x74\xce\x7d\x20\x1d\x2e\x4d\x2f\x51\x17\x47\x12\xdd\x3f\xfe\xdc\x2c\x42\xe0\x98\x9b\x23\x9b\x68\x7d\x77\x2d\xef\x67\xc8\xfe\x8d\x3f\x7f\x78\x2f\xac\xcb\x0c\x3f\xf1\xfc\xcf\x38\x7f\xc8\xc6\x13\x20\x92\xaf\x38\xfe\xe6\xca\x7f\x03\x00\xcc\xe5\x8d\x4c\x94\x04\x00\x00"),
 		},
 		"/privateca.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "privateca.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3449,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4b\x6f\xdb\x46\x10\xbe\xf3\x57\x0c\xa4\x4b\x0b\xd8\x94\xed\x23\x7b\x52\x64\x27\x21\x6a\x4b\x81\x24\x37\xc8\x49\x18\x2d\x47\xe4\xd6\xcb\xdd\xed\xee\x50\x0a\xe1\xea\xbf\x17\x7c\xe9\xe5\x47\x02\x04\x30\x7a\x30\x2f\x22\x76\x67\xbe\xef\x9b\xc7\x47\xf5\x61\x64\x6c\xe9\x64\x9a\x31\x5c\x5d\x5c\x5d\xc1\x27\x63\x52\x45\x70\x7b\x3b\x0a\xfa\x41\x1f\x6e\xa5\x20\xed\x29\x81\x42\x27\xe4\x80\x33\x82\xa1\x45\x91\x51\x77\x73\x06\x7f\x91\xf3\xd2\x68\xb8\x0a\x2f\xe0\xb7\x2a\xa0\xd7\x5e\xf5\x7e\xff\x23\xe8\x43\x69\x0a\xc8\xb1\x04\x6d\x18\x0a\x4f\xc0\x99\xf4\xb0\x92\x8a\x80\xbe\x0b\xb2\x0c\x52\x83\x30\xb9\x55\x12\xb5\x20\xd8\x48\xce\x6a\x9a\x16\x24\x0c\xfa\xf0\xad\x85\x30\x4b\x46\xa9\x01\x41\x18\x5b\x82\x59\x1d\xc6\x01\x72\x2d\xb8\x7e\x32\x66\x1b\x0d\x06\x9b\xcd\x26\xc4\x5a\x6d\x68\x5c\x3a\x50\x4d\xa4\x1f\xdc\xc6\xa3\x9b\xf1\xec\xe6\xfc\x2a\xbc\xa8\x73\xee\xb5\x22\xef\xc1\xd1\x3f\x85\x74\x94\xc0\xb2\x04\xb4\x56\x49\x81\x4b\x45\xa0\x70\x03\xc6\x01\xa6\x8e\x28\x01\x36\x95\xe0\x8d\x93\x2c\x75\x7a\x06\xde\xac\x78\x83\x8e\x82\x3e\x24\xd2\xb3\x93\xcb\x82\x8f\xba\xd5\xc9\x93\xfe\x28\xc0\x68\x40\x0d\xbd\xe1\x0c\xe2\x59\x0f\x3e\x0c\x67\xf1\xec\x2c\xe8\xc3\xd7\x78\xfe\x79\x72\x3f\x87\xaf\xc3\xe9\x74\x38\x9e\xc7\x37\x33\x98\x4c\x61\x34\x19\x5f\xc7\xf3\x78\x32\x9e\xc1\xe4\x23\x0c\xc7\xdf\xe0\xcf\x78\x7c\x7d\x06\x24\x39\x23\x07\xf4\xdd\xba\x4a\xbf\x71\x20\xab\x3e\x52\x52\x35\x6d\x46\x74\x24\x60\x65\x1a\x41\xde\x92\x90\x2b\x29\x40\xa1\x4e\x0b\x4c\x09\x52\xb3\x26\xa7\xa5\x4e\xc1\x92\xcb\xa5\xaf\xa6\xe9\x01\x75\x12\xf4\x41\xc9\x5c\x32\x72\x7d\xf2\xa4\xa8\x30\x08\xd0\xca\x76\xfe\x11\x08\xe3\x28\x14\xda\xe5\xa1\x50\xa6\x48\xc2\xb4\x5e\xa5\x50\x98\x7c\xb0\xbe\x44\x65\x33\xbc\x0c\x1e\xa4\x4e\x22\x98\x91\x5b\x4b\x41\x77\x68\xad\xd4\x69\x90\x13\x63\x82\x8c\x51\x00\xa0\x31\xa7\x08\xac\x93\x6b\x64\x12\xf8\x3c\x5e\x1b\xe7\x2d\x0a\x8a\xa0\x0a\x39\xf7\xa5\x67\xca\x83\xaa\xba\x3d\xcc\x97\x0e\x26\x00\x58\x77\x32\xd7\x97\x4b\x62\xbc\x0c\x00\x7c\x23\xe3\xb3\xf1\x3c\x3e\xa1\x6d\xb8\xd0\x4a\xdf\xf2\x39\xf2\xa6\x70\x82\x7c\x85\x0e\x70\xde\x32\x34\x71\x8b\x5d\xe2\x42\xe0\xc2\x1a\xa3\x82\x66\x11\x9b\x72\x77\x32\x46\xf8\x65\x7f\x87\x05\x9b\x4f\xa4\xc9\x21\x53\x12\x01\xbb\x82\xda\x1b\x89\xf9\xc8\xe8\x95\x4c\xa3\xf6\x00\xc0\x1a\x25\x45\x39\x7e\x95\x73\x21\x31\x5f\x34\x81\x27\x79\x77\x94\x2f\xc9\xfd\x44\x76\x5e\x07\xee\xb2\x1d\xad\xc8\x91\x16\xf4\x51\x92\x4a\xf6\x6a\xba\xfe\x1e\x17\x5b\x3d\x5c\x5a\x8a\xea\xdb\xdd\xa1\x2f\xac\x35\x8e\xfd\xc8\xe8\x44\xd6\x9b\x74\x5c\x6c\x32\xa7\xdc\x2a\x64\x8a\xa0\x67\x9d\xf9\x9b\x04\xfb\xc1\xe3\x63\xfb\xba\xdd\x0e\x94\x11\xcd\x06\x0e\x1e\x1f\xbb\xf7\xed\x76\x20\xea\x66\x56\x87\x15\xdd\x76\xdb\x7b\x02\x38\x42\xfd\x81\xee\x3d\x25\x73\x73\x87\x2c\xb2\x69\x3b\xc3\xa6\x11\x2b\x54\xbe\x13\xd1\x4d\x77\xb8\x46\xa9\x2a\xd7\xc7\x7a\xe8\x3d\x71\xac\xd7\xa4\xd9\xb8\xf2\x38\xbc\x5b\xd8\x76\x81\xf7\x8d\x69\xda\x72\x54\xbe\xc2\x25\x29\x1f\xb5\xbf\x27\x7c\xf1\xf5\x3e\x97\xd1\xa5\xc4\x4d\xa7\x0f\x21\x32\x49\x0e\x9d\xc8\xa4\x40\x35\xed\x06\xe2\xf7\x79\xe7\x6d\xd3\xdb\x86\x1d\x0c\xe3\x81\xca\xdd\xf1\x94\x56\x27\xdc\x2f\x60\xad\x5a\x05\x3f\x0b\x57\x3d\x09\x79\xe1\xa4\xe5\xda\x5f\xff\x9e\x1f\xdc\x00\xcc\x33\xea\x92\x80\x33\xe4\xe6\x0f\xa0\x13\x01\x4b\x52\x46\xa7\x1e\xd8\x84\x07\x69\xe9\xfa\x21\x3a\x42\xe9\x8c\x74\xaa\xe9\x59\x5f\x1f\xe0\x38\x53\xd8\x68\xc7\x96\xa3\xc6\x94\xdc\x8b\x1f\x95\xd7\x7c\x4d\x8e\xab\xcf\x26\x32\xbd\xe0\xed\x27\x01\xaf\x18\xfc\xd7\x77\xbe\xf2\x5d\x75\xb0\x67\x7d\x77\xc2\xbb\x13\xde\xd8\x09\x0b\x2c\x38\x33\x4e\x72\xf9\x43\x4f\x0c\x4f\x22\xdf\xd8\x1c\x1d\xbd\xac\x6d\xf2\x6c\x09\x0b\x99\xfc\x9f\xac\xf3\x92\xc6\x5f\xb4\xd3\x0f\x60\xdf\x2d\xf6\x46\x16\xfb\x2f\x00\x00\xff\xff\xa6\xb7\x61\x3f\x79\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4b\x6f\xdb\x46\x10\xbe\xf3\x57\x0c\xa4\x4b\x0b\xd8\x94\xed\x23\x7b\x52\x64\x27\x21\x6a\x4b\x81\x24\x37\xc8\x49\x18\x2d\x47\xe4\xd6\xcb\xdd\xed\xee\x50\x0a\xe1\xea\xbf\x17\x7c\xe9\xe5\x47\x03\x04\x30\x72\xb0\x69\xc0\xc4\xcc\x37\x33\xdf\x3c\x3e\xba\x0f\x23\x63\x4b\x27\xd3\x8c\xe1\xea\xe2\xea\x0a\x3e\x19\x93\x2a\x82\xdb\xdb\x51\xd0\x0f\xfa\x70\x2b\x05\x69\x4f\x09\x14\x3a\x21\x07\x9c\x11\x0c\x2d\x8a\x8c\x3a\xcf\x19\xfc\x45\xce\x4b\xa3\xe1\x2a\xbc\x80\xdf\x2a\x40\xaf\x75\xf5\x7e\xff\x23\xe8\x43\x69\x0a\xc8\xb1\x04\x6d\x18\x0a\x4f\xc0\x99\xf4\xb0\x92\x8a\x80\xbe\x0b\xb2\x0c\x52\x83\x30\xb9\x55\x12\xb5\x20\xd8\x48\xce\x80\xf7\xf9\xc3\xa0\x0f\xdf\xda\x14\x66\xc9\x28\x35\x20\x08\x63\x4b\x30\xab\x43\x1c\x20\xd7\x84\xeb\x9f\x8c\xd9\x46\x83\xc1\x66\xb3\x09\xb1\x66\x1b\x1a\x97\x0e\x54\x83\xf4\x83\xdb\x78\x74\x33\x9e\xdd\x9c\x5f\x85\x17\x75\xcc\xbd\x56\xe4\x3d\x38\xfa\xa7\x90\x8e\x12\x58\x96\x80\xd6\x2a\x29\x70\xa9\x08\x14\x6e\xc0\x38\xc0\xd4\x11\x25\xc0\xa6\x22\xbc\x71\x92\xa5\x4e\xcf\xc0\x9b\x15\x6f\xd0\x51\xd0\x87\x44\x7a\x76\x72\x59\xf0\xd1\xb4\x3a\x7a\xd2\x1f\x01\x8c\x06\xd4\xd0\x1b\xce\x20\x9e\xf5\xe0\xc3\x70\x16\xcf\xce\x82\x3e\x7c\x8d\xe7\x9f\x27\xf7\x73\xf8\x3a\x9c\x4e\x87\xe3\x79\x7c\x33\x83\xc9\x14\x46\x93\xf1\x75\x3c\x8f\x27\xe3\x19\x4c\x3e\xc2\x70\xfc\x0d\xfe\x8c\xc7\xd7\x67\x40\x92\x33\x72\x40\xdf\xad\xab\xf8\x1b\x07\xb2\x9a\x23\x25\xd5\xd0\x66\x44\x47\x04\x56\xa6\x59\x9f\xb7\x24\xe4\x4a\x0a\x50\xa8\xd3\x02\x53\x82\xd4\xac\xc9\x69\xa9\x53\xb0\xe4\x72\xe9\xab\x6d\x7a\x40\x9d\x04\x7d\x50\x32\x97\x8c\x5c\x5b\x9e\x34\x15\x06\x01\x5a\xd9\xee\x3f\x02\x61\x1c\x85\x42\xbb\x3c\x14\xca\x14\x49\x98\xd6\xa7\x14\x0a\x93\x0f\xd6\x97\xa8\x6c\x86\x97\xc1\x83\xd4\x49\x04\x33\x72\x6b\x29\xe8\x0e\xad\x95\x3a\x0d\x72\x62\x4c\x90\x31\x0a\x00\x34\xe6\x14\x81\x75\x72\x8d\x4c\x02\x9f\xcf\xd7\xe2\xbc\x45\x41\x11\x54\x90\x73\x5f\x7a\xa6\x3c\xa8\xba\xdb\xa7\xf9\xd2\xa5\x09\x00\xd6\x1d\xcd\xf5\xe5\x92\x18\x2f\x03\x00\xdf\xd0\xf8\x6c\x3c\x8f\x4f\xca\x36\xb5\xd0\x4a\xdf\xd6\x73\xe4\x4d\xe1\x04\xf9\x2a\x3b\xc0\x79\x5b\xa1\xc1\x2d\x76\x81\x0b\x81\x0b\x6b\x8c\xaa\x41\x00\x4d\xbb\x3b\x1a\x23\xfc\xb2\xf7\x61\xc1\xe6\x13\x69\x72\xc8\x94\x44\xc0\xae\xa0\xd6\x23\x31\x1f\x19\xbd\x92\x69\xd4\x1a\x00\xac\x51\x52\x94\xe3\x57\x6b\x2e\x24\xe6\x8b\x06\x78\x12\x77\x47\xf9\x92\xdc\x0f\x44\xe7\x35\x70\x17\xed\x68\x45\x8e\xb4\xa0\x8f\x92\x54\xb2\x67\xd3\xcd\xb7\x0d\x3d\xb0\x73\x69\x29\xaa\xbd\x3b\xa3\x2f\xac\x35\x8e\xfd\xc8\xe8\x44\xd6\x97\x74\xdc\x6c\x32\xa7\xdc\x2a\x64\x8a\xa0\x67\x9d\xf9\x9b\x04\xfb\xc1\xe3\x63\xfb\xba\xdd\x0e\x94\x11\xcd\x05\x0e\x1e\x1f\xbb\xf7\xed\x76\x20\xea\x61\x56\xd0\xaa\xdc\x76\xdb\x7b\x92\x70\x84\xfa\x03\xdd\x7b\x4a\xe6\xe6\x0e\x59\x64\xd3\x76\x87\xcd\x20\x56\xa8\x7c\x47\xa2\xdb\xee\x70\x8d\x52\x55\xaa\x8f\xf5\xd0\x7b\xe2\x58\xaf\x49\xb3\x71\xe5\x31\xbc\x3b\xd8\xf6\x80\xf7\x83\xa9\x98\x9c\xb4\xaf\x70\x49\xca\x47\xed\xdf\x93\x7a\xf1\xf5\x3e\x96\xd1\xa5\xc4\xcd\xa4\x0f\x53\x64\x92\x1c\x3a\x91\x49\x81\x6a\xda\x2d\xa4\x3d\xc3\xea\xf7\xbc\x1d\x7a\x3b\xb0\x9d\x1d\xe0\x81\xca\x9d\x79\x4a\xab\x93\xda\x2f\xe4\x5a\xb5\x0c\x7e\x34\x5d\xf5\x24\xe4\x85\x93\xb6\x5a\x4c\x04\xff\x9e\x1f\x78\x00\xe6\x19\x75\x41\xc0\x19\x72\xf3\x0f\xa0\x23\x01\x4b\x52\x46\xa7\x1e\xd8\x84\x07\x61\xe9\xfa\x61\x4f\xea\x50\x48\xa7\x9c\x9e\xd5\xf5\xfe\x49\x9d\x29\x6c\xb4\xab\x96\xa3\xc6\x94\xdc\x8b\x1f\x95\xd7\x74\x4d\x8e\xab\xcf\x26\x32\x05\xc7\x94\x5a\xc4\xe8\x09\xe0\x15\x81\xff\xfc\xcd\x57\x92\xad\x44\xb0\xaf\xfa\xae\x84\x77\x25\xbc\xb1\x12\x16\x58\x70\x66\x9c\xe4\x32\x38\x26\xf7\x54\x13\xc3\x13\xe4\x1b\x8b\xa3\x2b\x2f\xa9\x0a\x7a\xb6\x85\x85\x4c\x7e\x25\xe9\xbc\xc4\xf1\x27\xe5\xf4\x3f\x69\xdf\x25\xf6\x46\x12\xfb\x6f\x00\xa6\xb7\x61\x3f\x79\x0d\x00\x00"),
 		},
 		"/publicca.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "publicca.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1605,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\xa9\xd8\x47\xf6\xc4\xc8\x4e\x42\xc4\x91\x0b\x49\x6e\x90\x93\x30\x5a\x8e\xc8\xad\x97\xbb\xdb\xdd\xa5\x64\x42\xd5\x7f\x2f\xf8\x21\x45\x8a\x12\xa0\x3c\x11\x33\x6f\xde\xbc\x37\x33\xe4\x18\x53\x63\x1b\x27\x8b\x32\xe0\xfe\xdd\xfd\x3d\x3e\x1a\x53\x28\xc6\xd3\xd3\x34\x1a\x47\x63\x3c\x49\xc1\xda\x73\x8e\x5a\xe7\xec\x10\x4a\x46\x6a\x49\x94\x7c\xcc\xdc\xe0\x2f\x76\x5e\x1a\x8d\xfb\xf8\x1d\x7e\x6b\x01\xa3\x21\x35\xfa\xfd\x8f\x68\x8c\xc6\xd4\xa8\xa8\x81\x36\x01\xb5\x67\x84\x52\x7a\x6c\xa4\x62\xf0\x9b\x60\x1b\x20\x35\x84\xa9\xac\x92\xa4\x05\x63\x27\x43\xd9\xb5\x19\x48\xe2\x68\x8c\x6f\x03\x85\x59\x07\x92\x1a\x04\x61\x6c\x03\xb3\x39\xc7\x81\x42\x27\xb8\x7b\xca\x10\x6c\x32\x99\xec\x76\xbb\x98\x3a\xb5\xb1\x71\xc5\x44\xf5\x48\x3f\x79\xca\xa6\x8f\xb3\xc5\xe3\xed\x7d\xfc\xae\xab\x79\xd1\x8a\xbd\x87\xe3\x7f\x6a\xe9\x38\xc7\xba\x01\x59\xab\xa4\xa0\xb5\x62\x28\xda\xc1\x38\x50\xe1\x98\x73\x04\xd3\x0a\xde\x39\x19\xa4\x2e\x6e\xe0\xcd\x26\xec\xc8\x71\x34\x46\x2e\x7d\x70\x72\x5d\x87\x8b\x69\x1d\xe5\x49\x7f\x01\x30\x1a\xa4\x31\x4a\x17\xc8\x16\x23\xbc\x4f\x17\xd9\xe2\x26\x1a\xe3\x6b\xb6\xfc\xf4\xfc\xb2\xc4\xd7\x74\x3e\x4f\x67\xcb\xec\x71\x81\xe7\x39\xa6\xcf\xb3\x87\x6c\x99\x3d\xcf\x16\x78\xfe\x80\x74\xf6\x0d\x9f\xb3\xd9\xc3\x0d\x58\x86\x92\x1d\xf8\xcd\xba\x56\xbf\x71\x90\xed\x1c\x39\x6f\x87\xb6\x60\xbe\x10\xb0\x31\xbd\x20\x6f\x59\xc8\x8d\x14\x50\xa4\x8b\x9a\x0a\x46\x61\xb6\xec\xb4\xd4\x05\x2c\xbb\x4a\xfa\x76\x9b\x1e\xa4\xf3\x68\x0c\x25\x2b\x19\x28\x74\x91\x2b\x53\x71\x14\x91\x95\xc3\xfe\x13\x08\xe3\x38\x16\xda\x55\xb1\x50\xa6\xce\xe3\xa2\x3b\xa5\x58\x98\x6a\xb2\xbd\x23\x65\x4b\xba\x8b\x5e\xa5\xce\x13\x2c\xd8\x6d\xa5\xe0\x2f\x64\xad\xd4\x45\x54\x71\xa0\x9c\x02\x25\x11\xa0\xa9\xe2\x04\xb6\x5e\x2b\x29\x04\xfd\x9c\x6e\x80\x79\x4b\x82\x13\xb4\x90\x5b\xdf\xf8\xc0\x55\xd4\x9a\xfb\xce\xf2\x67\xc7\x32\x4d\x23\x60\x7b\x14\xb9\xbd\x5b\x73\xa0\xbb\x08\xf0\xbd\x88\x4f\xc6\x87\xd9\x65\xd3\xbe\x13\x59\xe9\x87\x6e\x8e\xbd\xa9\x9d\x60\xdf\x72\x03\xb7\x03\x7f\x8f\x5b\xf5\x75\x2b\x41\x2b\x7e\x0b\xec\x34\xa9\x15\x09\x61\x6a\x1d\x56\xaf\xdc\x44\xfd\x49\xf6\xc6\x8f\x8a\x1e\x07\x60\xda\xe3\x3e\x9f\x60\x54\x07\xf3\x91\x35\x3b\x0a\x9c\x27\x08\xae\xe6\x21\x23\xf3\x25\x57\x56\x51\xe0\x04\xa3\xfd\xbe\x55\x70\x38\x8c\xae\x92\x53\xd2\xef\xf9\xc5\x73\xbe\x34\x5f\x28\x88\x72\x3e\x48\xef\x2d\x6e\x48\xf9\x23\xe1\xd1\x54\xba\x25\xa9\xda\x4b\xcf\x74\xea\x3d\x87\x4c\x6f\x59\x07\xe3\x9a\x4b\x78\x3b\x2e\x76\x27\x6d\xd9\xc3\x07\xc9\x2a\x4f\xba\x51\xfc\xc0\x98\x3d\x24\x43\x04\x08\xe4\x0a\x0e\xd7\x58\x60\x4b\xaa\xe6\x33\x4f\xd6\x99\xbf\x59\x04\x3f\xd9\xef\x87\xd7\xc3\x61\xa2\x8c\xe8\xaf\x6f\xb2\xdf\x1f\xdf\x0f\x87\x09\x5f\xcd\xaf\x05\x74\x8c\xdf\x87\x52\x4a\x76\xe4\x44\x29\x05\xa9\x39\x6f\xd8\xb1\x3e\xad\xb0\x5f\x63\x68\x6c\xbb\xf7\xbe\xdb\x29\x0e\xbc\x72\x73\x0a\xcf\x79\xf3\x83\xbd\x5f\x70\x6d\x06\x93\xff\x97\xae\x7d\x72\xf6\xc2\x49\x1b\xba\xd3\xfc\xf7\xf6\x2c\x03\x2c\x4b\x3e\x16\x21\x94\x14\xfa\x3f\xe7\x51\x04\xd6\xac\x8c\x2e\x3c\x82\x89\xcf\xca\x8a\xed\x6b\x72\xc1\x32\xdc\xdd\x95\xa6\x9f\x7e\x12\x67\x3c\xce\xd4\x36\x39\x75\xab\x48\x53\xc1\xee\x17\x9f\xe3\x7f\x01\x00\x00\xff\xff\xc6\x2b\x76\x2e\x45\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xe2\x48\x10\xbd\xfb\x2b\x9e\xe0\xb2\x2b\x25\x26\xc9\xd1\x7b\x62\x48\x66\xc6\x9a\x0c\x59\x01\xd9\xd1\x9c\xa2\xa2\x5d\xd8\xbd\x69\x77\xf7\x76\x97\x21\x88\xe5\xdf\x57\xc6\x86\x24\xc3\x8c\xb4\x98\x83\xd5\xf5\xea\xd5\x7b\x55\xd5\x1e\x62\xe2\xfc\x36\xe8\xb2\x12\xdc\x5c\xdd\xdc\xe0\x93\x73\xa5\x61\xdc\xdf\x4f\x92\x61\x32\xc4\xbd\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xc6\x9e\x54\xc5\xc7\xc8\x05\xfe\xe2\x10\xb5\xb3\xb8\x49\xaf\xf0\x5b\x0b\x18\xf4\xa1\xc1\xef\x7f\x24\x43\x6c\x5d\x83\x9a\xb6\xb0\x4e\xd0\x44\x86\x54\x3a\x62\xa5\x0d\x83\x5f\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\x8d\x96\x0a\xf2\xca\x9f\x26\x43\x7c\xef\x29\xdc\x52\x48\x5b\x10\x94\xf3\x5b\xb8\xd5\x5b\x1c\x48\x0e\x82\x0f\xbf\x4a\xc4\x67\xa3\xd1\x66\xb3\x49\xe9\xa0\x36\x75\xa1\x1c\x99\x0e\x19\x47\xf7\xf9\xe4\x6e\x3a\xbf\xbb\xbc\x49\xaf\x0e\x39\x8f\xd6\x70\x8c\x08\xfc\x4f\xa3\x03\x17\x58\x6e\x41\xde\x1b\xad\x68\x69\x18\x86\x36\x70\x01\x54\x06\xe6\x02\xe2\x5a\xc1\x9b\xa0\x45\xdb\xf2\x02\xd1\xad\x64\x43\x81\x93\x21\x0a\x1d\x25\xe8\x65\x23\xef\xba\x75\x94\xa7\xe3\x3b\x80\xb3\x20\x8b\xc1\x78\x8e\x7c\x3e\xc0\x87\xf1\x3c\x9f\x5f\x24\x43\x7c\xcb\x17\x9f\x1f\x1e\x17\xf8\x36\x9e\xcd\xc6\xd3\x45\x7e\x37\xc7\xc3\x0c\x93\x87\xe9\x6d\xbe\xc8\x1f\xa6\x73\x3c\x7c\xc4\x78\xfa\x1d\x5f\xf2\xe9\xed\x05\x58\x4b\xc5\x01\xfc\xe2\x43\xab\xdf\x05\xe8\xb6\x8f\x5c\xb4\x4d\x9b\x33\xbf\x13\xb0\x72\xdd\xf8\xa2\x67\xa5\x57\x5a\xc1\x90\x2d\x1b\x2a\x19\xa5\x5b\x73\xb0\xda\x96\xf0\x1c\x6a\x1d\xdb\x69\x46\x90\x2d\x92\x21\x8c\xae\xb5\x90\x1c\x4e\xce\x4c\xa5\x49\x42\x5e\xf7\xf3\xcf\xa0\x5c\xe0\x54\xd9\x50\xa7\xca\xb8\xa6\x48\xcb\xc3\x2a\xa5\xca\xd5\xa3\xf5\x35\x19\x5f\xd1\x75\xf2\xac\x6d\x91\x61\xce\x61\xad\x15\x7f\x25\xef\xb5\x2d\x93\x9a\x85\x0a\x12\xca\x12\xc0\x52\xcd\x19\x7c\xb3\x34\x5a\x29\xfa\x39\x5d\x0f\x8b\x9e\x14\x67\x68\x21\x97\x71\x1b\x85\xeb\xa4\x35\xf7\xca\xf2\xe7\x81\x65\x32\x4e\x80\xf5\x51\xe4\xfa\x7a\xc9\x42\xd7\x09\x10\x3b\x11\x9f\x5d\x94\xe9\xfb\xa2\x5d\x25\xf2\x3a\xf6\xd5\x02\x47\xd7\x04\xc5\xb1\xe5\x06\x2e\x7b\xfe\x0e\xf7\xd4\x89\x7d\x52\xf4\xc4\x2f\xc2\xc1\x92\x79\x22\xa5\x5c\x63\xe5\xe9\x99\xb7\x87\x0c\xa0\x33\x7e\x54\x74\xd7\x03\xc7\x1d\xee\xcb\x09\x46\x8d\xb8\x4f\x6c\x39\x90\x70\x91\x41\x42\xc3\x7d\x44\x17\x0b\xae\xbd\x21\xe1\x0c\x83\xdd\xae\x55\xb0\xdf\x0f\xce\x82\x13\xb2\x1f\xf8\x31\x72\xb1\x70\x5f\x49\x54\x35\xeb\xa5\x77\x16\x57\x64\xe2\x91\xf0\x68\x6a\xbc\x26\x6d\xda\x4d\xcf\xed\x38\x46\x96\xdc\xae\xd9\x8a\x0b\xdb\xf7\xf0\xb6\x5d\x1c\x4e\xda\xf2\xdb\x8f\x9a\x4d\x91\x1d\x5a\xf1\x03\x63\x7e\x9b\xf5\x27\x80\x50\x28\x59\xce\xb1\xc0\x9a\x4c\xc3\x6f\x3c\xf9\xe0\xfe\x66\x25\x71\xb4\xdb\xf5\xaf\xfb\xfd\xc8\x38\xd5\x6d\xdf\x68\xb7\x3b\xbe\xef\xf7\x23\x3e\xeb\x5f\x9b\x76\x60\x7c\x6d\x4a\xa5\x39\x50\x50\x95\x56\x64\x66\xbc\xe2\xc0\xf6\x34\xc2\xf6\x7f\x09\xd9\xfa\x76\xee\x5d\xb5\xd3\x39\xf0\xcc\xdb\xd3\xf1\x8c\x57\x3f\xd8\xfb\x05\xd7\xaa\x37\xf9\x7f\xe9\xda\xa7\xe0\xa8\x82\xf6\xad\xab\x0c\xff\x5e\xbe\x89\x00\x8b\x8a\x8f\x49\x90\x8a\xa4\xfb\x72\x1e\x45\x60\xc9\xc6\xd9\x32\x42\x5c\xfa\x26\xad\x5c\x3f\xbf\x8a\x7a\xb3\x77\x67\x9a\x7e\x7a\x25\x5e\x9f\x32\xb8\xc6\x67\xa7\x6a\x35\x59\x2a\x39\xfc\xe2\x3a\xfe\x37\x00\xc6\x2b\x76\x2e\x45\x06\x00\x00"),
 		},
 		"/pubsub.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "pubsub.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3379,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x0c\xa4\x4b\x0b\xd8\x94\xed\x23\x7b\x52\x64\x27\x21\x6a\xcb\x85\x28\x37\xc8\xc9\x18\x2e\x47\xe4\xd6\xcb\xdd\xed\xee\x50\x0a\x91\xfa\xbf\x17\xfc\x52\x28\xdb\x4a\x0c\xb4\x87\x1c\xcc\x83\x6d\xec\xbc\x99\xf7\x66\xf6\xed\x78\x0a\x0b\x63\x6b\x27\xf3\x82\xe1\xe2\xec\xe2\x02\x3e\x18\x93\x2b\x82\xeb\xeb\x45\x30\x0d\xa6\x70\x2d\x05\x69\x4f\x19\x54\x3a\x23\x07\x5c\x10\xcc\x2d\x8a\x82\x86\xc8\x09\xfc\x49\xce\x4b\xa3\xe1\x22\x3c\x83\x5f\x1a\xc0\xa4\x0f\x4d\x7e\xfd\x2d\x98\x42\x6d\x2a\x28\xb1\x06\x6d\x18\x2a\x4f\xc0\x85\xf4\xb0\x91\x8a\x80\xbe\x08\xb2\x0c\x52\x83\x30\xa5\x55\x12\xb5\x20\xd8\x49\x2e\x5a\x9a\xbe\x48\x18\x4c\xe1\x73\x5f\xc2\xa4\x8c\x52\x03\x82\x30\xb6\x06\xb3\x19\xe3\x00\xb9\x15\xdc\x7e\x05\xb3\x8d\x66\xb3\xdd\x6e\x17\x62\xab\x36\x34\x2e\x9f\xa9\x0e\xe9\x67\xd7\xf1\xe2\x6a\x99\x5c\x9d\x5e\x84\x67\x6d\xce\x9d\x56\xe4\x3d\x38\xfa\xbb\x92\x8e\x32\x48\x6b\x40\x6b\x95\x14\x98\x2a\x02\x85\x3b\x30\x0e\x30\x77\x44\x19\xb0\x69\x04\xef\x9c\x64\xa9\xf3\x13\xf0\x66\xc3\x3b\x74\x14\x4c\x21\x93\x9e\x9d\x4c\x2b\x3e\x98\xd6\x20\x4f\xfa\x03\x80\xd1\x80\x1a\x26\xf3\x04\xe2\x64\x02\xef\xe6\x49\x9c\x9c\x04\x53\xf8\x14\xaf\x3f\xde\xde\xad\xe1\xd3\x7c\xb5\x9a\x2f\xd7\xf1\x55\x02\xb7\x2b\x58\xdc\x2e\x2f\xe3\x75\x7c\xbb\x4c\xe0\xf6\x3d\xcc\x97\x9f\xe1\xf7\x78\x79\x79\x02\x24\xb9\x20\x07\xf4\xc5\xba\x46\xbf\x71\x20\x9b\x39\x52\xd6\x0c\x2d\x21\x3a\x10\xb0\x31\x9d\x20\x6f\x49\xc8\x8d\x14\xa0\x50\xe7\x15\xe6\x04\xb9\xd9\x92\xd3\x52\xe7\x60\xc9\x95\xd2\x37\xb7\xe9\x01\x75\x16\x4c\x41\xc9\x52\x32\x72\x7b\xf2\xac\xa9\x30\x08\xd0\xca\xfe\xfe\x23\x10\xc6\x51\x28\xb4\x2b\x43\xa1\x4c\x95\x85\x79\x6b\xa5\x50\x98\x72\xb6\x3d\x47\x65\x0b\x3c\x0f\x1e\xa4\xce\x22\x48\xc8\x6d\xa5\xa0\x1b\xb4\x56\xea\x3c\x28\x89\x31\x43\xc6\x28\x00\xd0\x58\x52\x04\xb6\x4a\x7d\x95\xbe\x5c\xac\x07\x79\x8b\x82\x22\x68\x20\xa7\xbe\xf6\x4c\x65\xd0\xb4\xf6\xad\xc6\x1f\x55\x9a\x54\x69\x00\xb0\x1d\x04\x6e\xcf\x53\x62\x3c\x0f\x00\x7c\x27\xe0\xa3\xf1\xbc\x1c\x13\x76\x2c\x68\xa5\xef\x99\x1c\x79\x53\x39\x41\xbe\xa9\x0b\x70\xda\xd7\xee\x70\xf7\x5d\xd6\xbd\x17\x05\x95\x18\x74\xde\xeb\x3a\xec\xc8\x93\x71\x00\x2b\x36\x1f\x48\x93\x43\xa6\x2c\x02\x76\x15\xf5\x11\x99\xad\xa9\xb4\x0a\x99\x22\x98\x58\x67\xfe\x22\xc1\x7e\xf6\xf5\x6b\xff\xe7\xe3\xe3\xac\x63\x68\xce\x1a\xfe\xc7\xc7\xc9\xb3\xcc\x05\xea\x77\x74\xe7\x29\x5b\x9b\x1b\x64\x51\xac\x7a\xe1\x5d\x7b\x1b\x54\x7e\x60\x1b\x5a\x9a\x6f\x51\xaa\xc6\xe1\xb1\x9e\x7b\x4f\x1c\xeb\x2d\x69\x36\xae\x3e\x84\x0f\x97\xd3\x5f\x56\xd4\x1f\x0f\x53\x6e\x7e\x3e\x29\x1c\x5f\x7e\x03\x31\xba\x9c\xf8\xbd\x24\x95\x1d\x60\x0b\x49\x0e\x9d\x28\xa4\x40\xb5\xa2\x0d\x39\xd2\xfb\x21\x77\x83\xe6\xda\x36\xf7\xd2\x8d\x60\x7f\x0e\xf0\x40\xf5\xfe\x78\x45\x9b\x27\xdc\x47\x6a\x6d\x7a\x05\xaf\x2d\xd7\x7c\x19\x79\xe1\xa4\xe5\xd6\x3a\xff\x9c\x8e\x22\x00\xeb\x82\x86\x24\xe0\x02\xb9\xdb\x6a\x83\x08\x48\x49\x19\x9d\x7b\x60\x13\x8e\xd2\xf2\xed\x43\x74\x50\xa5\xb7\xca\x33\x4d\x2f\x5a\x76\x54\xc7\x99\xca\x46\x7b\xb6\x12\x35\xe6\xe4\x8e\x3e\x96\xa3\xae\xad\xd2\x7d\x83\x2f\x79\xf7\x79\xf8\x3f\x39\x78\x54\xee\x27\xf7\x31\x80\xc2\x94\x94\x8f\xfa\xdf\x6f\xf6\xfe\xb9\xed\xdd\x0d\xa1\xed\x94\x8d\x95\xe2\xb0\xcf\xfd\x70\xda\xd8\x2b\xf4\xb6\xd6\x5f\x3f\x01\xbf\x4e\xf3\x0f\xfe\x6d\xed\x25\xbd\xe8\x9a\x97\x5f\xe9\x58\xf5\x31\x81\xdf\x79\x97\x58\x2e\x8c\xde\xc8\x91\xdd\xad\x51\x52\xd4\xcb\x63\x54\xf7\x12\xcb\xfb\x0e\xf3\x24\xe5\x86\xca\x94\xdc\xf7\x13\xcb\x16\xb3\x4f\x74\x83\x5d\xbb\x5e\x47\x23\xe8\x5a\x7d\x7a\x25\xdd\xa3\x38\x78\x8a\xbe\xb2\xd6\x38\xf6\x0b\xa3\x33\xd9\x2e\x8f\xc3\x67\xfd\xe3\xcd\xd3\x92\xbc\xad\x9c\xb7\x95\xf3\x3f\xad\x9c\x7f\x03\x00\x00\xff\xff\x2b\xbd\x9e\xdb\x33\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\x24\xdb\x47\xf6\xa4\xc8\x4e\x42\xd4\x96\x0b\x51\x6e\x90\x93\x31\x5c\x8e\xc8\xad\xc9\xdd\xed\xee\x52\x8a\x90\xfa\xbf\x17\xfc\x92\x25\xf9\x23\x06\xda\x43\x0e\x36\x09\x4b\xd8\x79\x33\xf3\x66\xe6\xcd\x6a\x88\x99\x36\x5b\x2b\xb3\xdc\xe3\xfc\xf4\xfc\x1c\x9f\xb4\xce\x0a\xc6\xd5\xd5\x2c\x18\x06\x43\x5c\x49\xc1\xca\x71\x8a\x4a\xa5\x6c\xe1\x73\xc6\xd4\x90\xc8\xb9\xb7\x9c\xe0\x4f\xb6\x4e\x6a\x85\xf3\xf1\x29\x7e\xa9\x01\x83\xce\x34\xf8\xf5\xb7\x60\x88\xad\xae\x50\xd2\x16\x4a\x7b\x54\x8e\xe1\x73\xe9\xb0\x92\x05\x83\xbf\x09\x36\x1e\x52\x41\xe8\xd2\x14\x92\x94\x60\x6c\xa4\xcf\xe1\x1f\xe3\x8f\x83\x21\xbe\x76\x21\x74\xe2\x49\x2a\x10\x84\x36\x5b\xe8\xd5\x3e\x0e\xe4\x1b\xc2\xcd\x5f\xee\xbd\x09\x27\x93\xcd\x66\x33\xa6\x86\xed\x58\xdb\x6c\x52\xb4\x48\x37\xb9\x8a\x66\x97\xf3\xf8\x72\x74\x3e\x3e\x6d\x7c\x6e\x55\xc1\xce\xc1\xf2\xdf\x95\xb4\x9c\x22\xd9\x82\x8c\x29\xa4\xa0\xa4\x60\x14\xb4\x81\xb6\xa0\xcc\x32\xa7\xf0\xba\x26\xbc\xb1\xd2\x4b\x95\x9d\xc0\xe9\x95\xdf\x90\xe5\x60\x88\x54\x3a\x6f\x65\x52\xf9\x83\x6e\xf5\xf4\xa4\x3b\x00\x68\x05\x52\x18\x4c\x63\x44\xf1\x00\x1f\xa6\x71\x14\x9f\x04\x43\x7c\x89\x96\x9f\x6f\x6e\x97\xf8\x32\x5d\x2c\xa6\xf3\x65\x74\x19\xe3\x66\x81\xd9\xcd\xfc\x22\x5a\x46\x37\xf3\x18\x37\x1f\x31\x9d\x7f\xc5\xef\xd1\xfc\xe2\x04\x2c\x7d\xce\x16\xfc\xcd\xd8\x9a\xbf\xb6\x90\x75\x1f\x39\xad\x9b\x16\x33\x1f\x10\x58\xe9\x76\x7c\xce\xb0\x90\x2b\x29\x50\x90\xca\x2a\xca\x18\x99\x5e\xb3\x55\x52\x65\x30\x6c\x4b\xe9\xea\x69\x3a\x90\x4a\x83\x21\x0a\x59\x4a\x4f\xbe\x39\x79\x52\xd4\x38\x08\xc8\xc8\x6e\xfe\x21\x84\xb6\x3c\x16\xca\x96\x63\x51\xe8\x2a\x1d\x67\x8d\x94\xc6\x42\x97\x93\xf5\x19\x15\x26\xa7\xb3\xe0\x5e\xaa\x34\x44\xcc\x76\x2d\x05\x5f\x93\x31\x52\x65\x41\xc9\x9e\x52\xf2\x14\x06\x80\xa2\x92\x43\x98\x2a\x71\x55\xf2\x7c\xb0\x0e\xe4\x0c\x09\x0e\x51\x43\x46\x6e\xeb\x3c\x97\x41\x5d\xda\x63\x8c\x3f\xaa\x24\xae\x92\x00\x58\xf7\x04\xd7\x67\x09\x7b\x3a\x0b\x00\xd7\x12\xf8\xac\x9d\x9f\xef\x27\x6c\xb3\x90\x91\xae\xcb\x64\xd9\xe9\xca\x0a\x76\x75\x5c\x60\xd4\xc5\x6e\x71\x77\x2d\xcd\x3b\x27\x72\x2e\xa9\x01\x00\x6d\x85\x6d\xf2\x78\xdf\x40\x95\xd7\x9f\x58\xb1\x25\xcf\x69\x08\x6f\x2b\xee\x2c\x32\x5d\x72\x69\x0a\xf2\x1c\x62\x60\xac\xfe\x8b\x85\x77\x93\xef\xdf\xbb\xaf\x0f\x0f\x93\x36\x43\x7d\x56\xe7\x7f\x78\x18\x3c\xf1\x9c\x91\xfa\xc0\xb7\x8e\xd3\xa5\xbe\x26\x2f\xf2\x45\x47\xbc\x2d\x6f\x45\x85\xeb\xb3\xf5\x25\x4d\xd7\x24\x8b\x5a\xe1\x91\x9a\x3a\xc7\x3e\x52\x6b\x56\x5e\xdb\xed\x21\xbc\x1f\x4e\x37\xac\xb0\x3b\xee\xbb\x5c\xff\x3f\x0a\x1c\x5d\x3c\x82\x3c\xd9\x8c\xfd\x47\xc9\x45\x7a\x80\xcd\x25\x5b\xb2\x22\x97\x82\x8a\x05\xaf\xd8\xb2\xda\x35\xb9\x7e\x47\xf0\x5b\x53\xcf\xa5\x6d\xc1\xee\x1c\xb8\xe7\xed\xee\x78\xc1\xab\xa3\xdc\x2f\xc4\x5a\x75\x0c\xde\x1a\xae\x7e\x52\x76\xc2\x4a\x53\x6b\x3f\xc4\x3f\xa3\x3d\x0b\xb0\xcc\xb9\x77\x82\xcf\xc9\xb7\xb7\x5a\x4f\x02\x09\x17\x5a\x65\x0e\x5e\x8f\xf7\xdc\xb2\xf5\xfd\x23\xa9\x3d\xa9\x3c\xe1\xf4\xac\x64\x1f\x9f\xcc\xea\xca\x84\xbb\x6c\x25\x29\xca\xd8\xbe\xb8\x2c\x2f\xaa\xb6\x4a\x76\x05\x06\x07\x84\x5a\xed\x3e\x35\xff\x27\x05\xef\x85\xfb\xc9\x75\x0c\x14\x94\x70\xe1\xc2\xee\xf3\x28\xdf\xbb\xbc\x7f\x32\x79\xd7\xef\xa8\xad\xd4\x6b\x23\xc5\x61\x9d\xbb\xe6\x34\xb6\x37\xf0\x6d\xd4\xbf\x3c\x02\xbf\x8d\xf3\x0f\x7e\xb6\x5e\x57\xcd\xf3\x5b\xba\xcf\xfa\x25\x82\xaf\xec\x25\x95\x33\xad\x56\x72\x4f\xee\x46\x17\x52\x6c\xe7\x2f\xa5\xba\x93\x54\xde\xb5\x98\x23\x97\x6b\x2e\x13\xb6\xaf\x3b\x96\x0d\x66\xe7\x68\x7b\xb9\xb6\xb5\xee\xce\xfb\xa5\x3b\x1e\x49\xbb\x14\x7b\x7b\x04\xb8\xca\x18\x6d\xbd\x9b\x69\x95\xca\x5a\xac\xee\x70\xad\x7f\x7c\xf3\x34\x49\xde\xaf\x9c\xf7\x2b\xe7\x7f\xba\x72\xfe\x1d\x00\x2b\xbd\x9e\xdb\x33\x0d\x00\x00"),
 		},
 		"/pubsublite.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "pubsublite.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3228,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4f\x6f\xe2\xc8\x13\xbd\xfb\x53\x94\xe0\xf2\xfb\x49\x89\x9d\x70\xf4\x9e\x18\x92\x99\xb1\x96\x81\x15\x26\x3b\x9a\x53\x54\x6e\x17\x76\x6f\xda\xdd\xbd\xdd\x65\x18\x36\xcb\x77\x5f\xf9\x0f\x04\xc2\x24\xca\xae\xe6\x98\x3e\x20\xd4\xfd\xea\xd5\xab\xaa\x57\x30\x84\x89\xb1\x5b\x27\x8b\x92\x61\x74\x35\x1a\xc1\x27\x63\x0a\x45\x30\x9d\x4e\x82\x61\x30\x84\xa9\x14\xa4\x3d\xe5\x50\xeb\x9c\x1c\x70\x49\x30\xb6\x28\x4a\xda\xbf\x5c\xc0\xef\xe4\xbc\x34\x1a\x46\xe1\x15\xfc\xaf\x01\x0c\xfa\xa7\xc1\xff\x7f\x09\x86\xb0\x35\x35\x54\xb8\x05\x6d\x18\x6a\x4f\xc0\xa5\xf4\xb0\x92\x8a\x80\xbe\x0b\xb2\x0c\x52\x83\x30\x95\x55\x12\xb5\x20\xd8\x48\x2e\xdb\x34\x3d\x49\x18\x0c\xe1\x5b\x4f\x61\x32\x46\xa9\x01\x41\x18\xbb\x05\xb3\x3a\xc6\x01\x72\x2b\xb8\x3d\x25\xb3\x8d\xa3\x68\xb3\xd9\x84\xd8\xaa\x0d\x8d\x2b\x22\xd5\x21\x7d\x34\x4d\x26\xb7\xb3\xf4\xf6\x72\x14\x5e\xb5\x31\x77\x5a\x91\xf7\xe0\xe8\xcf\x5a\x3a\xca\x21\xdb\x02\x5a\xab\xa4\xc0\x4c\x11\x28\xdc\x80\x71\x80\x85\x23\xca\x81\x4d\x23\x78\xe3\x24\x4b\x5d\x5c\x80\x37\x2b\xde\xa0\xa3\x60\x08\xb9\xf4\xec\x64\x56\xf3\x49\xb7\xf6\xf2\xa4\x3f\x01\x18\x0d\xa8\x61\x30\x4e\x21\x49\x07\xf0\x61\x9c\x26\xe9\x45\x30\x84\xaf\xc9\xf2\xf3\xfc\x6e\x09\x5f\xc7\x8b\xc5\x78\xb6\x4c\x6e\x53\x98\x2f\x60\x32\x9f\xdd\x24\xcb\x64\x3e\x4b\x61\xfe\x11\xc6\xb3\x6f\xf0\x6b\x32\xbb\xb9\x00\x92\x5c\x92\x03\xfa\x6e\x5d\xa3\xdf\x38\x90\x4d\x1f\x29\x6f\x9a\x96\x12\x9d\x08\x58\x99\x4e\x90\xb7\x24\xe4\x4a\x0a\x50\xa8\x8b\x1a\x0b\x82\xc2\xac\xc9\x69\xa9\x0b\xb0\xe4\x2a\xe9\x9b\x69\x7a\x40\x9d\x07\x43\x50\xb2\x92\x8c\xdc\xde\x9c\x15\x15\x06\x01\x5a\xd9\xcf\x3f\x06\x61\x1c\x85\x42\xbb\x2a\x14\xca\xd4\x79\x58\xb4\x56\x0a\x85\xa9\xa2\xf5\x35\x2a\x5b\xe2\x75\xf0\x20\x75\x1e\x43\x4a\x6e\x2d\x05\x7d\x41\x6b\xa5\x2e\x82\x8a\x18\x73\x64\x8c\x03\x00\x8d\x15\xc5\x60\xeb\xcc\xd7\x99\x92\xfc\x02\x61\x0f\xf4\x16\x05\xc5\xd0\x40\x2e\xfd\xd6\x33\x55\x41\x53\xde\x13\xcf\x6f\x75\x96\xd6\xd9\x54\x32\x05\x00\xeb\xbd\xd0\xf5\x75\x46\x8c\xd7\x01\x80\xef\x84\x7c\x36\x9e\x67\xcf\x13\x77\xd9\xd0\x4a\xdf\x67\x74\xe4\x4d\xed\x04\xf9\x86\x1f\xe0\xb2\xcf\xd1\xe1\xee\xbb\xc8\xfb\x26\xf4\xde\x51\x43\xdc\xb6\x2d\xe8\x1c\xd9\xd5\xfd\x24\x67\x71\x86\xc0\x9a\xcd\x27\xd2\xe4\x90\x29\x8f\x81\x5d\x4d\xfd\x8b\xcc\x97\x54\x59\x85\x4c\x31\x0c\xac\x33\x7f\x90\x60\x1f\x3d\x3e\xf6\x5f\x77\xbb\x48\x19\xd1\xcd\x28\x7a\x7c\x74\x54\x48\xa3\x77\xbb\xe8\x48\x44\x73\xdf\x88\xdd\xed\x06\x67\x9c\x13\xd4\x1f\xe8\xce\x53\xbe\x34\x5f\x90\x45\xb9\xe8\xab\xec\xfa\xb1\x42\xe5\xf7\x3a\xf6\xf5\x8f\xd7\x28\x55\xb3\x1a\x89\x1e\x7b\x4f\x9c\xe8\x35\x69\x36\x6e\x7b\x0a\xdf\x4f\xb5\x9f\x72\xdc\x5f\xef\x47\xd3\x7c\x3e\x23\x4e\x6e\x9e\x40\x8c\xae\x20\xfe\x28\x49\xe5\x27\xd8\x52\x92\x43\x27\x4a\x29\x50\x2d\x68\x45\x8e\xf4\x61\x22\xdd\x54\x78\x6b\x9b\x41\x76\xcd\x39\xdc\x03\x3c\xd0\xf6\x70\xbd\xa0\xd5\xb3\xdc\x2f\x70\xad\x7a\x05\x6f\xa5\x6b\x4e\x4e\x5e\x38\x69\xb9\xf5\xda\xdf\x97\x47\x2f\x00\xcb\x92\xf6\x41\xc0\x25\x72\xf7\x73\xb8\x17\x01\x19\x29\xa3\x0b\x0f\x6c\xc2\xa3\xb0\x62\xfd\x10\x9f\xb0\xf4\x6e\x3a\xd3\xf4\x43\x8f\x1f\xf1\x38\x53\xdb\xf8\x90\xad\x42\x8d\x05\xb9\x17\x37\xec\x55\x8b\xfb\x3a\x3b\x54\xf9\x82\xc7\xd3\x73\xc8\xcf\x32\xf9\x5f\x46\xd3\x6e\x17\x1d\x8b\x78\xf7\xf8\xbb\xc7\xff\x8b\xc7\xbb\x26\xb4\x95\xb2\xb1\x52\x9c\xd6\x79\x68\x4e\xfb\xf6\x06\xbd\x07\xf7\x2f\x9f\x05\xbc\x4d\xf7\x1b\xfe\xf8\x0e\xd2\x7e\xe8\x9e\x57\x56\xf6\xb8\x84\xd7\xd4\xfe\xe4\x25\x6d\xd3\xbe\x6f\xe7\xfb\x76\xfe\xdb\xed\xfc\x27\x00\x00\xff\xff\xe6\x83\x14\x5d\x9c\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x95\xcd\x6e\xdb\x46\x10\xc7\xef\x7c\x8a\x81\x74\x69\x01\x8b\x8a\x7d\x64\x4f\x8a\xed\x24\x44\x1d\xa9\x10\xe5\x06\x39\x19\xc3\xe5\x88\xdc\x7a\xb9\xbb\xdd\x1d\x4a\x51\x53\xbd\x7b\xb1\x24\xf5\x65\xd5\x86\x5b\xe4\x68\x51\x10\x84\xd9\xff\xce\xfc\xe6\x4b\x1a\xc2\xb5\xb1\x1b\x27\xcb\x8a\xe1\xea\xdd\xd5\x15\x7c\x34\xa6\x54\x04\x77\x77\xd7\xd1\x30\x1a\xc2\x9d\x14\xa4\x3d\x15\xd0\xe8\x82\x1c\x70\x45\x30\xb1\x28\x2a\xda\x9d\x5c\xc0\xef\xe4\xbc\x34\x1a\xae\xe2\x77\xf0\x53\x10\x0c\xfa\xa3\xc1\xcf\xbf\x44\x43\xd8\x98\x06\x6a\xdc\x80\x36\x0c\x8d\x27\xe0\x4a\x7a\x58\x4a\x45\x40\xdf\x04\x59\x06\xa9\x41\x98\xda\x2a\x89\x5a\x10\xac\x25\x57\xc0\x07\xff\x71\x34\x84\xaf\xbd\x0b\x93\x33\x4a\x0d\x08\xc2\xd8\x0d\x98\xe5\xb1\x0e\x90\x5b\xe0\xf6\x55\x31\xdb\x64\x3c\x5e\xaf\xd7\x31\xb6\xb4\xb1\x71\xe5\x58\x75\x4a\x3f\xbe\x4b\xaf\x6f\xa7\xd9\xed\xe8\x2a\x7e\xd7\xde\xb9\xd7\x8a\xbc\x07\x47\x7f\x36\xd2\x51\x01\xf9\x06\xd0\x5a\x25\x05\xe6\x8a\x40\xe1\x1a\x8c\x03\x2c\x1d\x51\x01\x6c\x02\xf0\xda\x49\x96\xba\xbc\x00\x6f\x96\xbc\x46\x47\xd1\x10\x0a\xe9\xd9\xc9\xbc\xe1\x93\x6a\xed\xf0\xa4\x3f\x11\x18\x0d\xa8\x61\x30\xc9\x20\xcd\x06\xf0\x7e\x92\xa5\xd9\x45\x34\x84\x2f\xe9\xe2\xd3\xec\x7e\x01\x5f\x26\xf3\xf9\x64\xba\x48\x6f\x33\x98\xcd\xe1\x7a\x36\xbd\x49\x17\xe9\x6c\x9a\xc1\xec\x03\x4c\xa6\x5f\xe1\xd7\x74\x7a\x73\x01\x24\xb9\x22\x07\xf4\xcd\xba\xc0\x6f\x1c\xc8\x50\x47\x2a\x42\xd1\x32\xa2\x13\x80\xa5\xe9\xda\xe7\x2d\x09\xb9\x94\x02\x14\xea\xb2\xc1\x92\xa0\x34\x2b\x72\x5a\xea\x12\x2c\xb9\x5a\xfa\xd0\x4d\x0f\xa8\x8b\x68\x08\x4a\xd6\x92\x91\x5b\xcb\x59\x52\x71\x14\xa1\x95\x7d\xff\x13\x10\xc6\x51\x2c\xb4\xab\x63\xa1\x4c\x53\xc4\x65\x3b\x4a\xb1\x30\xf5\x78\x75\x89\xca\x56\x78\x19\x3d\x4a\x5d\x24\x90\x91\x5b\x49\x41\x9f\xd1\x5a\xa9\xcb\xa8\x26\xc6\x02\x19\x93\x08\x40\x63\x4d\x09\xd8\x26\xf7\x4d\xae\x24\x3f\xe3\xb0\x17\x7a\x8b\x82\x12\x08\x92\x91\xdf\x78\xa6\x3a\x0a\xe9\x1d\xfc\xfc\xd6\xe4\x59\x93\xdf\x49\xa6\x08\x60\xb5\x03\x5d\x5d\xe6\xc4\x78\x19\x01\xf8\x0e\xe4\x93\xf1\x3c\x7d\x1a\xb8\xc3\x47\x2b\x7d\x1f\xd1\x91\x37\x8d\x13\xe4\x83\x7f\x80\x51\x1f\xa3\xd3\x3d\x74\xc8\x0f\x81\xf9\xc1\x51\x70\xdc\x96\xad\x95\x02\x74\x79\x1f\x70\xe6\x67\x0a\x6c\xd8\x7c\x24\x4d\x0e\x99\x8a\x04\xd8\x35\xd4\x9f\xc8\x62\x41\xb5\x55\xc8\x94\xc0\xc0\x3a\xf3\x07\x09\xf6\xe3\xef\xdf\xfb\xaf\xdb\xed\x58\x19\xd1\x06\x0b\x56\x47\xa5\x34\x7a\xbb\x1d\x1f\x41\x04\x7b\x80\xdd\x6e\x07\x67\x3e\xaf\x51\xbf\xa7\x7b\x4f\xc5\xc2\x7c\x46\x16\xd5\xbc\xcf\xb2\xab\xc7\x12\x95\xdf\x71\xec\xf2\x9f\xac\x50\xaa\xb0\x1a\xa9\x9e\x78\x4f\x9c\xea\x15\x69\x36\x6e\x73\x2a\xdf\x75\xb5\xef\x72\xd2\x9b\x77\xad\x09\x9f\x4f\x1c\xa7\x37\x07\x11\xa3\x2b\x89\x3f\x48\x52\xc5\x89\xb6\x92\xe4\xd0\x89\x4a\x0a\x54\x73\x5a\x92\x23\xbd\xef\x48\x78\x8f\x80\x37\x36\x34\xb2\x2b\xce\xde\x0e\xf0\x48\x9b\xbd\x79\x4e\xcb\x27\xb1\x9f\xf1\xb5\xec\x09\x5e\xeb\x2e\x3c\x05\x79\xe1\xa4\x0d\x0d\x49\xe0\xef\xd1\xd1\x09\xc0\xa2\xa2\xdd\x25\xe0\x0a\xb9\xfb\x39\xdc\x41\x40\x4e\xca\xe8\xd2\x03\x9b\xf8\xe8\x5a\xb9\x7a\x3c\x40\x1d\x4d\xd3\x19\xd3\xbf\xce\xf8\xe1\x29\x9d\x69\x6c\xb2\x8f\x56\xa3\xc6\x92\xdc\xb3\x1b\xf6\xe2\x88\xfb\x26\xdf\x67\x19\x9d\x50\xed\x67\x3c\x3b\x97\xfc\xa8\x21\xff\xcb\x68\xda\x6e\xc7\xc7\x10\x6f\x33\xfe\x36\xe3\xff\x67\xc6\xc3\x33\xea\x0a\xc7\xc6\x4a\x71\x9a\xe7\xbe\x38\xed\xd9\x2b\x78\xf7\xd3\xbf\x78\x72\xe1\x75\xdc\xaf\xf8\xe3\x7b\x79\x7a\x5e\x58\xd9\xe3\x14\x5e\xa2\xfd\xc1\x4b\xda\x86\x7d\xdb\xce\xb7\xed\xfc\xaf\xdb\xf9\xcf\x00\xe6\x83\x14\x5d\x9c\x0c\x00\x00"),
 		},
 		"/redis.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "redis.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1550,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xcd\x6e\xdb\x48\x13\xbc\xf3\x29\x0a\xd2\xe5\xfb\x00\x9b\xb2\x7d\xe4\x9e\x14\xdb\x49\x88\x75\xe4\x85\x24\x6f\x90\x53\xd0\x1a\xb6\xc8\x59\x0f\x67\x66\x67\x9a\x52\x04\xaf\xde\x7d\xc1\x3f\x47\x8a\x13\x60\x79\x90\x84\xee\xea\xaa\xea\x1f\x71\x8a\x5b\xe7\x0f\x41\x97\x95\xe0\xe6\xea\xe6\x06\x1f\x9c\x2b\x0d\xe3\xe1\xe1\x36\x99\x26\x53\x3c\x68\xc5\x36\x72\x81\xc6\x16\x1c\x20\x15\x63\xee\x49\x55\x3c\x66\x2e\xf0\x27\x87\xa8\x9d\xc5\x4d\x7a\x85\xff\xb5\x80\xc9\x90\x9a\xfc\xff\xb7\x64\x8a\x83\x6b\x50\xd3\x01\xd6\x09\x9a\xc8\x90\x4a\x47\x6c\xb5\x61\xf0\x37\xc5\x5e\xa0\x2d\x94\xab\xbd\xd1\x64\x15\x63\xaf\xa5\xea\x64\x06\x92\x34\x99\xe2\xcb\x40\xe1\x36\x42\xda\x82\xa0\x9c\x3f\xc0\x6d\x4f\x71\x20\xe9\x0c\x77\x4f\x25\xe2\xb3\xd9\x6c\xbf\xdf\xa7\xd4\xb9\x4d\x5d\x28\x67\xa6\x47\xc6\xd9\x43\x7e\x7b\xbf\x58\xdd\x5f\xde\xa4\x57\x5d\xcd\x93\x35\x1c\x23\x02\xff\xdd\xe8\xc0\x05\x36\x07\x90\xf7\x46\x2b\xda\x18\x86\xa1\x3d\x5c\x00\x95\x81\xb9\x80\xb8\xd6\xf0\x3e\x68\xd1\xb6\xbc\x40\x74\x5b\xd9\x53\xe0\x64\x8a\x42\x47\x09\x7a\xd3\xc8\xd9\xb4\x46\x7b\x3a\x9e\x01\x9c\x05\x59\x4c\xe6\x2b\xe4\xab\x09\xde\xcd\x57\xf9\xea\x22\x99\xe2\x73\xbe\xfe\xf8\xf8\xb4\xc6\xe7\xf9\x72\x39\x5f\xac\xf3\xfb\x15\x1e\x97\xb8\x7d\x5c\xdc\xe5\xeb\xfc\x71\xb1\xc2\xe3\x7b\xcc\x17\x5f\xf0\x7b\xbe\xb8\xbb\x00\x6b\xa9\x38\x80\xbf\xf9\xd0\xfa\x77\x01\xba\x9d\x23\x17\xed\xd0\x56\xcc\x67\x06\xb6\xae\x37\x14\x3d\x2b\xbd\xd5\x0a\x86\x6c\xd9\x50\xc9\x28\xdd\x8e\x83\xd5\xb6\x84\xe7\x50\xeb\xd8\x6e\x33\x82\x6c\x91\x4c\x61\x74\xad\x85\xa4\x8b\xbc\x69\x2a\x4d\x12\xf2\x7a\xd8\x7f\x06\xe5\x02\xa7\xca\x86\x3a\x55\xc6\x35\x45\x5a\x76\xa7\x94\x2a\x57\xcf\x76\xd7\x64\x7c\x45\xd7\xc9\xb3\xb6\x45\x86\x15\x87\x9d\x56\xfc\x89\xbc\xd7\xb6\x4c\x6a\x16\x2a\x48\x28\x4b\x00\x4b\x35\x67\x08\x5c\xe8\xf8\x73\xae\x01\x13\x3d\x29\xce\xd0\x42\x2e\xe3\x21\x0a\xd7\x49\xdb\xd9\x77\x8a\x65\x4b\x91\x00\xbb\xd1\xde\xee\x7a\xc3\x42\xd7\x09\x10\x7b\xf9\x8f\x2e\xca\xe2\x44\xae\xd7\x20\xdf\x2a\x77\x3a\x81\xa3\x6b\x82\xe2\xd8\xb2\x02\x97\x03\x73\x8f\xfb\xda\x15\x7d\xd5\x36\x4a\x7b\xb8\x49\x7f\x79\x7d\x7f\x9d\x76\x7e\x9e\xa1\x46\xdc\x07\xb6\x1c\x48\xb8\xc8\x20\xa1\x19\x33\xba\x58\x73\xed\x0d\x09\x67\x98\xf8\xe0\xfe\x62\x25\x71\xf6\xf2\x32\xfc\x3c\x1e\x67\xc6\xa9\x7e\x07\xb3\x97\x97\xc0\xa5\x76\xf6\x78\x9c\x8d\xca\x6d\xb0\x35\x76\x3c\x4e\xde\x10\xde\x92\x7d\xc7\x4f\x91\x8b\xb5\xfb\x44\xa2\xaa\xe5\xd0\x51\xdf\xf6\x96\x4c\x1c\x4d\x8c\xbd\xce\x77\xa4\x4d\x7b\xf7\xb9\x9d\xc7\xc8\x92\xdb\x1d\x5b\x71\xe1\x70\x0e\x1f\x57\x36\xac\x30\x1b\xc2\xe3\xf0\xdb\xcf\xd7\x90\xa1\x0d\x9b\x98\x0d\xdf\x3f\xe8\xe5\x77\xdf\x6b\x85\x42\xc9\xf2\x5e\xb3\x29\xce\x28\x2a\xcd\x81\x82\xaa\xb4\x22\xb3\xe4\x2d\x07\xb6\xaf\x4b\xe9\x17\x23\x07\xcf\x19\x86\x81\xbd\xc6\x81\x67\x3e\xbc\x86\x97\xbc\xfd\x41\xfb\x17\x5c\xdb\xc1\xc1\x7f\xa5\x6b\x9f\x82\xa3\x0a\xda\x4b\x77\x69\xff\x5c\x9e\x64\x80\x75\xc5\x63\x11\xa4\x22\xe9\x5f\x81\xa3\x09\x6c\xd8\x38\x5b\x46\x88\x4b\x4f\xca\xca\xdd\x73\x76\xc6\xd2\x5f\xd6\x1f\x6f\x3c\xfd\xf4\xc2\x4f\x78\x82\x6b\x7c\xf6\xaa\x56\x93\xa5\x92\xc3\x2f\xfe\x5a\xff\x06\x00\x00\xff\xff\xe5\xa8\x05\xd5\x0e\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x5d\x6f\xda\x48\x14\x7d\xf7\xaf\x38\x82\x97\x5d\x29\x31\x49\x1e\xbd\x4f\x34\x49\x5b\x6b\x53\xb2\x02\xb2\x55\x9f\xaa\xcb\xf8\x62\xcf\x66\x3c\x33\x3b\x73\x0d\x45\x59\xfe\xfb\xca\xc6\x86\xd0\xb4\x52\x19\x04\xe8\xde\x33\xe7\x9c\xfb\x81\xc7\xb8\x75\x7e\x17\x74\x59\x09\x6e\xae\x6e\x6e\xf0\xc1\xb9\xd2\x30\x1e\x1e\x6e\x93\x71\x32\xc6\x83\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xa6\x9e\x54\xc5\x43\xe6\x02\x7f\x73\x88\xda\x59\xdc\xa4\x57\xf8\xad\x05\x8c\xfa\xd4\xe8\xf7\x3f\x92\x31\x76\xae\x41\x4d\x3b\x58\x27\x68\x22\x43\x2a\x1d\xb1\xd6\x86\xc1\xdf\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\xad\x96\x0a\x72\xe2\x4f\x93\x31\xbe\xf4\x14\x6e\x25\xa4\x2d\x08\xca\xf9\x1d\xdc\xfa\x35\x0e\x24\x9d\xe1\xee\x55\x89\xf8\x6c\x32\xd9\x6e\xb7\x29\x75\x6e\x53\x17\xca\x89\x39\x20\xe3\xe4\x21\xbf\xbd\x9f\x2d\xee\x2f\x6f\xd2\xab\xee\xce\x93\x35\x1c\x23\x02\xff\xdb\xe8\xc0\x05\x56\x3b\x90\xf7\x46\x2b\x5a\x19\x86\xa1\x2d\x5c\x00\x95\x81\xb9\x80\xb8\xd6\xf0\x36\x68\xd1\xb6\xbc\x40\x74\x6b\xd9\x52\xe0\x64\x8c\x42\x47\x09\x7a\xd5\xc8\x59\xb7\x06\x7b\x3a\x9e\x01\x9c\x05\x59\x8c\xa6\x0b\xe4\x8b\x11\xde\x4d\x17\xf9\xe2\x22\x19\xe3\x73\xbe\xfc\xf8\xf8\xb4\xc4\xe7\xe9\x7c\x3e\x9d\x2d\xf3\xfb\x05\x1e\xe7\xb8\x7d\x9c\xdd\xe5\xcb\xfc\x71\xb6\xc0\xe3\x7b\x4c\x67\x5f\xf0\x67\x3e\xbb\xbb\x00\x6b\xa9\x38\x80\xbf\xf9\xd0\xfa\x77\x01\xba\xed\x23\x17\x6d\xd3\x16\xcc\x67\x06\xd6\xee\x30\xbe\xe8\x59\xe9\xb5\x56\x30\x64\xcb\x86\x4a\x46\xe9\x36\x1c\xac\xb6\x25\x3c\x87\x5a\xc7\x76\x9a\x11\x64\x8b\x64\x0c\xa3\x6b\x2d\x24\x5d\xe4\x4d\x51\x69\x92\x90\xd7\xfd\xfc\x33\x28\x17\x38\x55\x36\xd4\xa9\x32\xae\x29\xd2\xb2\x5b\xa5\x54\xb9\x7a\xb2\xb9\x26\xe3\x2b\xba\x4e\x9e\xb5\x2d\x32\x2c\x38\x6c\xb4\xe2\x4f\xe4\xbd\xb6\x65\x52\xb3\x50\x41\x42\x59\x02\x58\xaa\x39\x43\xe0\x42\xc7\x1f\x73\xf5\x98\xe8\x49\x71\x86\x16\x72\x19\x77\x51\xb8\x4e\xda\xca\x4e\x14\xf3\x96\x22\x01\x36\x83\xbd\xcd\xf5\x8a\x85\xae\x13\x20\x1e\xe4\x3f\xba\x28\xb3\x57\x72\x07\x0d\xf2\xad\x72\xa7\x13\x38\xba\x26\x28\x8e\x2d\x2b\x70\xd9\x33\x1f\x70\x5f\x3b\x8f\x5f\xb5\x8d\xd2\x2e\x6e\x87\x00\x0e\xf5\x75\xda\xf9\x79\x86\x1a\x71\x1f\xd8\x72\x20\xe1\x22\x83\x84\x66\xc8\xe8\x62\xc9\xb5\x37\x24\x9c\x61\xe4\x83\xfb\x87\x95\xc4\xc9\xcb\x4b\xff\x73\xbf\x9f\x18\xa7\x0e\x33\x98\xbc\xbc\x04\x2e\xb5\xb3\xfb\xfd\x64\x50\x6e\xa1\xad\xb1\xfd\x7e\xf4\x86\xf0\x96\xec\x3b\x7e\x8a\x5c\x2c\xdd\x27\x12\x55\xcd\xfb\x8a\x0e\x65\xaf\xc9\xc4\xc1\xc4\x50\xeb\x74\x43\xda\xb4\x7b\x9f\xdb\x69\x8c\x2c\xb9\xdd\xb0\x15\x17\x76\xe7\xf0\x61\x64\xfd\x08\xb3\x3e\x3c\x34\xbf\xfd\x3c\x86\x0c\xad\xd8\xc4\xac\xff\xfe\x4e\x2f\xbf\x3b\xdd\x15\x0a\x25\xcb\x7b\xcd\xa6\x38\xa3\xa8\x34\x07\x0a\xaa\xd2\x8a\xcc\x9c\xd7\x1c\xd8\x1e\x87\xd2\xbe\x2f\x21\x3b\xcf\x19\xfa\x86\x1d\xe3\xc0\x33\xef\x8e\xe1\x39\xaf\xbf\xd3\xfe\x09\xd7\xba\x77\xf0\xab\x74\xed\x29\x38\xaa\xa0\x7d\x3b\xa4\x0c\xff\x5d\xbe\xca\x00\xcb\x8a\x87\x4b\x90\x8a\xe4\xf0\x08\x1c\x4c\x60\xc5\xc6\xd9\x32\x42\x5c\xfa\xea\x5a\xb9\x79\x3e\x99\x3a\x6d\xd6\x5f\x6f\x3c\xfd\x70\xc3\x4f\xa7\x0c\xae\xf1\xd9\x51\xad\x26\x4b\x25\x87\x9f\xfc\xb5\xfe\x1f\x00\xe5\xa8\x05\xd5\x0e\x06\x00\x00"),
 		},
 		"/resourcemanager.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "resourcemanager.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1155,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6f\xdb\x38\x10\x85\xef\xfa\x15\x0f\xf6\x65\x17\x48\xe4\xc4\x47\xed\xc9\x75\xd2\x56\x58\xc7\x06\x22\x67\x8b\x9e\x82\xb1\x34\x96\x06\x4b\x91\x5c\x72\x64\xd7\x08\xf2\xdf\x17\xb2\xe4\xb6\x69\xa3\xa3\xe6\xf1\xbd\x8f\x8f\x33\xc5\xd2\xf9\x53\x90\xba\x51\xcc\x6f\xe6\x73\x7c\x72\xae\x36\x8c\xd5\x6a\x99\x4c\x93\x29\x56\x52\xb2\x8d\x5c\xa1\xb3\x15\x07\x68\xc3\x58\x78\x2a\x1b\xbe\x4c\xae\xf0\x0f\x87\x28\xce\x62\x9e\xde\xe0\x8f\x5e\x30\x19\x47\x93\x3f\xff\x4a\xa6\x38\xb9\x0e\x2d\x9d\x60\x9d\xa2\x8b\x0c\x6d\x24\x62\x2f\x86\xc1\xdf\x4a\xf6\x0a\xb1\x28\x5d\xeb\x8d\x90\x2d\x19\x47\xd1\xe6\x1c\x33\x9a\xa4\xc9\x14\x5f\x47\x0b\xb7\x53\x12\x0b\x42\xe9\xfc\x09\x6e\xff\xb3\x0e\xa4\x67\xe0\xf3\xd7\xa8\xfa\x6c\x36\x3b\x1e\x8f\x29\x9d\x69\x53\x17\xea\x99\x19\x94\x71\xb6\xca\x97\xf7\xeb\xe2\xfe\x7a\x9e\xde\x9c\xcf\x3c\x59\xc3\x31\x22\xf0\x7f\x9d\x04\xae\xb0\x3b\x81\xbc\x37\x52\xd2\xce\x30\x0c\x1d\xe1\x02\xa8\x0e\xcc\x15\xd4\xf5\xc0\xc7\x20\x2a\xb6\xbe\x42\x74\x7b\x3d\x52\xe0\x64\x8a\x4a\xa2\x06\xd9\x75\xfa\xa6\xad\x0b\x9e\xc4\x37\x02\x67\x41\x16\x93\x45\x81\xbc\x98\xe0\xc3\xa2\xc8\x8b\xab\x64\x8a\x2f\xf9\xf6\xf3\xe6\x69\x8b\x2f\x8b\xc7\xc7\xc5\x7a\x9b\xdf\x17\xd8\x3c\x62\xb9\x59\xdf\xe5\xdb\x7c\xb3\x2e\xb0\xf9\x88\xc5\xfa\x2b\xfe\xce\xd7\x77\x57\x60\xd1\x86\x03\xf8\x9b\x0f\x3d\xbf\x0b\x90\xbe\x47\xae\xfa\xd2\x0a\xe6\x37\x00\x7b\x37\x00\x45\xcf\xa5\xec\xa5\x84\x21\x5b\x77\x54\x33\x6a\x77\xe0\x60\xc5\xd6\xf0\x1c\x5a\x89\xfd\x6b\x46\x90\xad\x92\x29\x8c\xb4\xa2\xa4\xe7\x3f\xbf\x5d\x2a\x4d\x12\xf2\x32\xbe\x7f\x86\xd2\x05\x4e\x4b\x1b\xda\xb4\x34\xae\xab\xd2\xfa\xbc\x4a\x69\xe9\xda\xd9\xe1\x96\x8c\x6f\xe8\x36\xf9\x57\x6c\x95\xa1\xe0\x70\x90\x92\x1f\xc8\x7b\xb1\x75\xd2\xb2\x52\x45\x4a\x59\x02\x58\x6a\x39\x43\xe0\xe8\xba\x50\x72\x4b\x96\x6a\x0e\xef\xbb\x8e\xea\xe8\xa9\xe4\x0c\xbd\xe4\x3a\x9e\xa2\x72\x9b\xf4\x77\xfc\x61\xf6\x38\x9a\x3d\x0c\x66\x09\x70\xb8\x20\x1f\x6e\x77\xac\x74\x9b\x00\x71\x40\xfa\xec\xa2\xae\xdf\x45\x18\x72\xc9\x4b\x1c\xb3\x2f\x82\xd8\x27\x01\xd7\x63\xda\xa0\x7b\xbe\x4c\x9f\xc7\xf3\xcf\x46\xd8\x26\xc3\x7a\x0e\x25\xfc\x82\xb5\xfa\x31\xa7\x4e\xdd\x27\xb6\x1c\x48\xb9\xca\xa0\xa1\xe3\x71\x22\xd5\x96\x5b\x6f\x48\x39\xc3\xe4\xe5\xc5\x53\x60\xab\xaf\xaf\xb3\x97\x97\x3e\xfc\xf5\x75\xf2\x9b\x6e\x49\xf6\x03\x3f\x45\xae\xb6\xee\x81\xb4\x6c\x2e\xa9\xc3\x25\xf7\x64\xe2\xc5\xfb\x42\xbc\x38\x90\x98\x7e\xf3\x73\xbb\x88\x91\x35\xb7\x07\xb6\xea\xc2\xe9\xad\xbc\x2f\x8c\xc3\x77\xcc\xfc\xee\xa3\xb0\xa9\xb2\x73\x0b\xbf\x38\xe6\x77\xd9\xf8\x07\x50\x0a\x35\xeb\xcf\xda\xff\x03\x00\x00\xff\xff\xa3\x3e\xf4\x2f\x83\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xc1\x6e\xdb\x38\x10\x86\xef\x7a\x8a\x1f\xf6\x65\x17\x48\xe4\xc4\x47\xed\xc9\x75\xd2\x56\x58\xc7\x06\x62\x67\x8b\x9e\x82\x31\x35\x96\x06\x4b\x91\x5c\x72\x64\xd7\x08\xf2\xee\x0b\xd9\x72\xd3\xb4\xd5\x51\xf3\xf1\x9f\x8f\x33\x1c\x63\xee\xc3\x31\x4a\xdd\x28\xa6\x37\xd3\x29\x3e\x79\x5f\x5b\xc6\x62\x31\xcf\xc6\xd9\x18\x0b\x31\xec\x12\x57\xe8\x5c\xc5\x11\xda\x30\x66\x81\x4c\xc3\x97\xca\x15\xfe\xe1\x98\xc4\x3b\x4c\xf3\x1b\xfc\xd1\x03\xa3\xa1\x34\xfa\xf3\xaf\x6c\x8c\xa3\xef\xd0\xd2\x11\xce\x2b\xba\xc4\xd0\x46\x12\x76\x62\x19\xfc\xcd\x70\x50\x88\x83\xf1\x6d\xb0\x42\xce\x30\x0e\xa2\x0d\xf4\x2d\x3f\xcf\xc6\xf8\x3a\x44\xf8\xad\x92\x38\x10\x8c\x0f\x47\xf8\xdd\x8f\x1c\x48\x4f\xc2\xa7\xaf\x51\x0d\xc5\x64\x72\x38\x1c\x72\x3a\xd9\xe6\x3e\xd6\x13\x7b\x26\xd3\x64\x51\xce\xef\x97\xeb\xfb\xeb\x69\x7e\x73\x3a\xf3\xe4\x2c\xa7\x84\xc8\xff\x75\x12\xb9\xc2\xf6\x08\x0a\xc1\x8a\xa1\xad\x65\x58\x3a\xc0\x47\x50\x1d\x99\x2b\xa8\xef\x85\x0f\x51\x54\x5c\x7d\x85\xe4\x77\x7a\xa0\xc8\xd9\x18\x95\x24\x8d\xb2\xed\xf4\xdd\xb4\x2e\x7a\x92\xde\x01\xde\x81\x1c\x46\xb3\x35\xca\xf5\x08\x1f\x66\xeb\x72\x7d\x95\x8d\xf1\xa5\xdc\x7c\x5e\x3d\x6d\xf0\x65\xf6\xf8\x38\x5b\x6e\xca\xfb\x35\x56\x8f\x98\xaf\x96\x77\xe5\xa6\x5c\x2d\xd7\x58\x7d\xc4\x6c\xf9\x15\x7f\x97\xcb\xbb\x2b\xb0\x68\xc3\x11\xfc\x2d\xc4\xde\xdf\x47\x48\x3f\x47\xae\xfa\xa1\xad\x99\xdf\x09\xec\xfc\x79\x7d\x29\xb0\x91\x9d\x18\x58\x72\x75\x47\x35\xa3\xf6\x7b\x8e\x4e\x5c\x8d\xc0\xb1\x95\xd4\x6f\x33\x81\x5c\x95\x8d\x61\xa5\x15\x25\x3d\xfd\xf9\xe5\x52\x79\x96\x51\x90\x61\xff\x05\x8c\x8f\x9c\x1b\x17\xdb\xdc\x58\xdf\x55\x79\x7d\x7a\x4a\xb9\xf1\xed\x64\x7f\x4b\x36\x34\x74\x9b\xfd\x2b\xae\x2a\xb0\xe6\xb8\x17\xc3\x0f\x14\x82\xb8\x3a\x6b\x59\xa9\x22\xa5\x22\x03\x1c\xb5\x5c\x20\x72\xf2\x5d\x34\xdc\x92\xa3\x9a\xe3\xef\x53\x07\x3a\x05\x32\x5c\xa0\x47\xae\xd3\x31\x29\xb7\x59\x7f\xc7\xb7\xb0\xc7\x21\xec\xe1\x1c\x96\x01\xfb\x8b\xf2\xfe\x76\xcb\x4a\xb7\x19\x90\xce\x4a\x9f\x7d\xd2\xe5\x6f\x15\xce\x7d\x29\x48\x1a\x7a\x5f\x80\xd4\x77\x02\xae\x87\x6e\x67\xee\xf9\x52\x7d\x1e\xce\x3f\x5b\x61\x77\x02\x81\xf3\x10\x7e\xd2\x5a\xbc\xd5\xa9\x53\xff\x89\x1d\x47\x52\xae\x0a\x68\xec\x78\xa8\x48\xb5\xe1\x36\x58\x52\x2e\x30\x7a\x79\x09\x14\xd9\xe9\xeb\xeb\xe4\xe5\xa5\x6f\xfe\xfa\x3a\xfa\x85\x9b\x93\xfb\xc0\x4f\x89\xab\x8d\x7f\x20\x35\xcd\xa5\xeb\xf9\x92\x3b\xb2\xe9\x92\x7d\x31\x9e\xed\x49\x6c\xff\xf2\x4b\x37\x4b\x89\xb5\x74\x7b\x76\xea\xe3\xf1\x3d\xde\x0f\x8c\xe3\x77\xcd\xf2\xee\xa3\xb0\xad\x8a\xd3\x14\x7e\x4a\x2c\xef\x8a\xe1\x0f\xa0\x14\x6b\xd6\x1f\xd9\xff\x07\x00\xa3\x3e\xf4\x2f\x83\x04\x00\x00"),
 		},
 		"/runtimeconfig.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "runtimeconfig.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 832,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6f\xdb\x38\x10\x85\xef\xfc\x15\x0f\xd6\x65\x17\x70\xe4\xd8\x47\xed\xc9\xeb\x64\x37\x42\x5d\x19\xb0\x9c\x06\x39\x8e\xa9\xb1\x34\xa8\x44\xb2\x24\x65\xc5\xff\xbe\x90\xec\xa0\x0d\x1a\x1e\x39\x8f\xef\x7d\x33\xc3\x04\x1b\xeb\x2e\x5e\xea\x26\x62\x75\xbf\x5a\xe1\x7f\x6b\xeb\x96\xb1\xdd\x6e\x54\xa2\x12\x6c\x45\xb3\x09\x5c\xa1\x37\x15\x7b\xc4\x86\xb1\x76\xa4\x1b\x7e\xaf\xcc\xf1\x8d\x7d\x10\x6b\xb0\x4a\xef\xf1\xd7\x28\x98\xdd\x4a\xb3\xbf\xff\x51\x09\x2e\xb6\x47\x47\x17\x18\x1b\xd1\x07\x46\x6c\x24\xe0\x24\x2d\x83\xdf\x34\xbb\x08\x31\xd0\xb6\x73\xad\x90\xd1\x8c\x41\x62\x33\xc5\xdc\x4c\x52\x95\xe0\xf5\x66\x61\x8f\x91\xc4\x80\xa0\xad\xbb\xc0\x9e\x7e\xd7\x81\xe2\x04\x3c\x9d\x26\x46\x97\x2d\x16\xc3\x30\xa4\x34\xd1\xa6\xd6\xd7\x8b\xf6\xaa\x0c\x8b\x6d\xbe\x79\x2c\xca\xc7\xbb\x55\x7a\x3f\xbd\x79\x36\x2d\x87\x00\xcf\x3f\x7a\xf1\x5c\xe1\x78\x01\x39\xd7\x8a\xa6\x63\xcb\x68\x69\x80\xf5\xa0\xda\x33\x57\x88\x76\x04\x1e\xbc\x44\x31\xf5\x1c\xc1\x9e\xe2\x40\x9e\x55\x82\x4a\x42\xf4\x72\xec\xe3\x87\x69\xbd\xe3\x49\xf8\x20\xb0\x06\x64\x30\x5b\x97\xc8\xcb\x19\xfe\x5d\x97\x79\x39\x57\x09\x5e\xf2\xc3\xd3\xee\xf9\x80\x97\xf5\x7e\xbf\x2e\x0e\xf9\x63\x89\xdd\x1e\x9b\x5d\xf1\x90\x1f\xf2\x5d\x51\x62\xf7\x1f\xd6\xc5\x2b\xbe\xe4\xc5\xc3\x1c\x2c\xb1\x61\x0f\x7e\x73\x7e\xe4\xb7\x1e\x32\xce\x91\xab\x71\x68\x25\xf3\x07\x80\x93\xbd\x02\x05\xc7\x5a\x4e\xa2\xd1\x92\xa9\x7b\xaa\x19\xb5\x3d\xb3\x37\x62\x6a\x38\xf6\x9d\x84\x71\x9b\x01\x64\x2a\x95\xa0\x95\x4e\x22\xc5\xe9\xe6\x8f\xa6\x52\xa5\xc8\xc9\x6d\xff\x19\xb4\xf5\x9c\x6a\xe3\xbb\x54\xb7\xb6\xaf\xd2\x7a\xfa\x4a\xa9\xb6\xdd\xe2\xbc\xa4\xd6\x35\xb4\x54\xdf\xc5\x54\x19\x4a\xf6\x67\xd1\xfc\x95\x9c\x13\x53\xab\x8e\x23\x55\x14\x29\x53\x80\xa1\x8e\x33\xf8\xde\x44\xe9\x58\x5b\x73\x92\xfa\x73\xcf\x9b\x36\x38\xd2\x9c\x61\x94\xdc\x85\x4b\x88\xdc\xa9\xb1\xc3\x5f\x56\xfb\xab\xd5\x66\xb2\x52\xc0\xf9\x1d\xf7\xbc\x3c\x72\xa4\xa5\x02\xc2\x15\xe7\xc9\x86\x58\x7c\x12\x7f\xcd\x24\x27\xe1\x96\xeb\x39\xd8\xde\x6b\x0e\x99\xfa\x19\x00\x00\xff\xff\x86\x4b\x56\xa2\x40\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x73\xda\x30\x10\x85\xef\xfa\x15\x6f\xf0\xa5\x9d\x21\x26\x70\xa4\x27\x4a\xd2\xc6\x53\x6a\x66\x30\x69\x26\xc7\x45\x5e\xec\x9d\xda\x92\x2a\xc9\x38\xfc\xfb\x8e\x0d\x99\x34\xd3\xe8\xa8\x7d\x7a\xfb\xed\x3e\x25\x58\x5b\x77\xf6\x52\xd5\x11\x8b\xdb\xc5\x02\xdf\xad\xad\x1a\xc6\x66\xb3\x56\x89\x4a\xb0\x11\xcd\x26\x70\x89\xce\x94\xec\x11\x6b\xc6\xca\x91\xae\xf9\xb5\x32\xc5\x2f\xf6\x41\xac\xc1\x22\xbd\xc5\xa7\x41\x30\xb9\x96\x26\x9f\xbf\xa8\x04\x67\xdb\xa1\xa5\x33\x8c\x8d\xe8\x02\x23\xd6\x12\x70\x94\x86\xc1\x2f\x9a\x5d\x84\x18\x68\xdb\xba\x46\xc8\x68\x46\x2f\xb1\x46\x7c\xf3\x4f\x55\x82\xe7\xab\x85\x3d\x44\x12\x03\x82\xb6\xee\x0c\x7b\xfc\x57\x07\x8a\x23\xf0\x78\xea\x18\xdd\x72\x36\xeb\xfb\x3e\xa5\x91\x36\xb5\xbe\x9a\x35\x17\x65\x98\x6d\xb2\xf5\x7d\x5e\xdc\xdf\x2c\xd2\xdb\xf1\xcd\xa3\x69\x38\x04\x78\xfe\xd3\x89\xe7\x12\x87\x33\xc8\xb9\x46\x34\x1d\x1a\x46\x43\x3d\xac\x07\x55\x9e\xb9\x44\xb4\x03\x70\xef\x25\x8a\xa9\xa6\x08\xf6\x18\x7b\xf2\xac\x12\x94\x12\xa2\x97\x43\x17\xdf\x6d\xeb\x15\x4f\xc2\x3b\x81\x35\x20\x83\xc9\xaa\x40\x56\x4c\xf0\x75\x55\x64\xc5\x54\x25\x78\xca\xf6\x0f\xdb\xc7\x3d\x9e\x56\xbb\xdd\x2a\xdf\x67\xf7\x05\xb6\x3b\xac\xb7\xf9\x5d\xb6\xcf\xb6\x79\x81\xed\x37\xac\xf2\x67\xfc\xc8\xf2\xbb\x29\x58\x62\xcd\x1e\xfc\xe2\xfc\xc0\x6f\x3d\x64\xd8\x23\x97\xc3\xd2\x0a\xe6\x77\x00\x47\x7b\x89\x2f\x38\xd6\x72\x14\x8d\x86\x4c\xd5\x51\xc5\xa8\xec\x89\xbd\x11\x53\xc1\xb1\x6f\x25\x0c\x69\x06\x90\x29\x55\x82\x46\x5a\x89\x14\xc7\x9b\xff\x86\x4a\x95\x22\x27\xd7\xfc\x97\xd0\xd6\x73\xaa\x8d\x6f\x53\xdd\xd8\xae\x4c\xab\xf1\x2b\xa5\xda\xb6\xb3\xd3\x9c\x1a\x57\xd3\x5c\xfd\x16\x53\x2e\x51\xb0\x3f\x89\xe6\x9f\xe4\x9c\x98\x4a\xb5\x1c\xa9\xa4\x48\x4b\x05\x18\x6a\x79\x09\xdf\x99\x28\x2d\x6b\x6b\x8e\x52\x7d\xec\x79\xd5\x06\x47\x9a\x97\x18\x24\x37\xe1\x1c\x22\xb7\x6a\x98\xf0\xcd\x6a\x77\xb1\x5a\x8f\x56\x0a\x38\xbd\xe2\x9e\xe6\x07\x8e\x34\x57\x40\xb8\xe0\x3c\xd8\x10\xf3\x0f\xda\x5f\x7a\x92\x93\x70\xed\xeb\x39\xd8\xce\x6b\x0e\x4b\xf5\x77\x00\x86\x4b\x56\xa2\x40\x03\x00\x00"),
 		},
 		"/secretmanager.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "secretmanager.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2815,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xdb\x38\x13\xbe\xeb\x57\x0c\xec\xcb\xfb\x02\x89\x9c\xe4\xa8\x3d\xb9\x4e\xda\x0a\x9b\x38\x0b\xcb\x69\xd1\x93\x31\xa6\xc6\x12\x37\x14\xc9\x25\x47\x76\x8d\x6e\xfe\xfb\x42\x12\xed\x48\xf9\x68\x82\x3d\xf4\xb4\xba\x44\xe1\xcc\x3c\xcf\x33\x5f\xa2\xc7\x30\x33\x76\xef\x64\x51\x32\x5c\x9c\x5d\x5c\xc0\x27\x63\x0a\x45\x70\x7d\x3d\x8b\xc6\xd1\x18\xae\xa5\x20\xed\x29\x87\x5a\xe7\xe4\x80\x4b\x82\xa9\x45\x51\xd2\xc1\x72\x02\x5f\xc8\x79\x69\x34\x5c\xc4\x67\xf0\xbf\xc6\x61\x14\x4c\xa3\xff\xff\x16\x8d\x61\x6f\x6a\xa8\x70\x0f\xda\x30\xd4\x9e\x80\x4b\xe9\x61\x23\x15\x01\x7d\x17\x64\x19\xa4\x06\x61\x2a\xab\x24\x6a\x41\xb0\x93\x5c\xb6\x34\x01\x24\x8e\xc6\xf0\x2d\x40\x98\x35\xa3\xd4\x80\x20\x8c\xdd\x83\xd9\xf4\xfd\x00\xb9\x15\xdc\x3e\x25\xb3\x4d\x26\x93\xdd\x6e\x17\x63\xab\x36\x36\xae\x98\xa8\xce\xd3\x4f\xae\xd3\xd9\xd5\x3c\xbb\x3a\xbd\x88\xcf\xda\x98\x3b\xad\xc8\x7b\x70\xf4\x57\x2d\x1d\xe5\xb0\xde\x03\x5a\xab\xa4\xc0\xb5\x22\x50\xb8\x03\xe3\x00\x0b\x47\x94\x03\x9b\x46\xf0\xce\x49\x96\xba\x38\x01\x6f\x36\xbc\x43\x47\xd1\x18\x72\xe9\xd9\xc9\x75\xcd\x83\x6a\x1d\xe4\x49\x3f\x70\x30\x1a\x50\xc3\x68\x9a\x41\x9a\x8d\xe0\xc3\x34\x4b\xb3\x93\x68\x0c\x5f\xd3\xe5\xe7\xdb\xbb\x25\x7c\x9d\x2e\x16\xd3\xf9\x32\xbd\xca\xe0\x76\x01\xb3\xdb\xf9\x65\xba\x4c\x6f\xe7\x19\xdc\x7e\x84\xe9\xfc\x1b\xfc\x9e\xce\x2f\x4f\x80\x24\x97\xe4\x80\xbe\x5b\xd7\xe8\x37\x0e\x64\x53\x47\xca\x9b\xa2\x65\x44\x03\x01\x1b\xd3\x09\xf2\x96\x84\xdc\x48\x01\x0a\x75\x51\x63\x41\x50\x98\x2d\x39\x2d\x75\x01\x96\x5c\x25\x7d\xd3\x4d\x0f\xa8\xf3\x68\x0c\x4a\x56\x92\x91\xdb\x93\x67\x49\xc5\x51\x84\x56\x86\xfe\x27\x20\x8c\xa3\x58\x68\x57\xc5\x42\x99\x3a\x8f\x8b\x76\x94\x62\x61\xaa\xc9\xf6\x1c\x95\x2d\xf1\x3c\xba\x97\x3a\x4f\x20\x23\xb7\x95\x82\x6e\xd0\x5a\xa9\x8b\xa8\x22\xc6\x1c\x19\x93\x08\x40\x63\x45\x09\x78\x12\x8e\xb8\x42\x8d\x05\xb9\x97\x31\x83\xaf\xb7\x28\x28\x81\xc6\xe5\xd4\xef\x3d\x53\x15\x35\x19\x3e\x42\x65\x2d\xd4\x4d\x07\x15\x01\x6c\x0f\x72\xb7\xe7\x6b\x62\x3c\x8f\x00\x7c\x27\xe7\xb3\xf1\x3c\x7f\x81\xbe\xe3\x44\x2b\x7d\xe0\x75\xe4\x4d\xed\x04\xf9\x86\x05\xe0\x34\x30\x75\x7e\xab\x2e\x78\x15\xa2\xc3\xbf\x51\x37\x98\x87\xf4\x7b\x92\xb2\xbe\x1d\x6b\x36\x9f\x48\x93\x43\xa6\x3c\x01\x76\x35\x05\x8b\xc4\x6a\x66\xf4\x46\x16\x49\x38\x00\xb0\x46\x49\xb1\x9f\xbf\xc9\xbd\x92\x58\xad\x3a\xe7\x27\xb1\x37\x54\xad\xc9\xbd\x13\xa1\x6a\x9d\x8f\x08\x8e\x36\xe4\x48\x0b\xfa\x28\x49\xe5\x8f\xaa\x86\x2d\x5c\xc9\xbc\x67\xe1\xbd\xa5\xa4\xb5\x1f\x0f\x7d\x6d\xad\x71\xec\x67\x46\xe7\xb2\x9d\xb3\x04\x36\xa8\xfc\x31\xef\x7c\x49\x95\x55\xc8\x94\xc0\xc8\x3a\xf3\x27\x09\xf6\x93\x1f\x3f\xc2\xeb\xc3\xc3\xa4\x23\x6a\xce\x8e\x94\x0f\x0f\xa3\x67\xe1\x33\xd4\x1f\xe8\xce\x53\xbe\x34\x37\xc8\xa2\x5c\x84\x26\x76\xd9\xf7\x29\x0f\xed\x9d\x6e\x51\xaa\xe6\x13\x90\xea\xa9\xf7\xc4\xa9\xde\x92\x66\xe3\xf6\x43\xf7\xc3\xf4\x86\x69\x7e\xac\xc4\x6b\x75\x50\xb8\x26\xe5\x93\xf0\xf7\x09\x69\x7a\xf9\x08\xc0\xe8\x0a\xe2\xae\xbe\xcf\x70\x4a\x49\x0e\x9d\x28\xa5\x40\xb5\x38\xf4\xc2\x3f\x06\x9f\x86\x6a\x87\x42\xf5\xba\x70\x4f\xfb\xe3\xf1\x82\x36\x4f\x04\xbc\x82\xb5\x09\x32\xde\x0b\xd7\x3c\x39\x79\xe1\xa4\xe5\x76\xdd\xfe\x3e\xed\x59\x00\x96\x25\x1d\x82\x80\x4b\xe4\xee\x5e\x38\x88\x80\x35\x29\xa3\x0b\x0f\x6c\xe2\x5e\x58\xb1\xbd\x4f\x06\x28\xdd\x3e\xfd\xf1\x4c\xd3\x8b\x6b\xde\xc3\x71\xa6\xb6\xc9\x91\xed\xad\xef\xcc\xfb\x56\x7c\x15\x28\xdf\x5a\xf5\x2f\x03\xb7\x9f\x6c\xfc\xbf\x9b\xfc\x49\x90\xd1\x1c\x87\xd7\x5f\xba\x0e\xcd\xc7\x94\xdc\x31\xa5\xf4\x32\x0c\xce\xb0\x3a\x6f\x8e\xfb\xd0\xfd\xbf\x61\xff\x85\xc3\xde\x15\xa1\xcd\xb4\x9b\xab\x61\xa2\xc7\xea\x0c\x6e\xb6\x9f\x28\x7e\xfd\xba\x7b\xbf\xfa\xf7\xfd\x20\x38\x4a\xec\x4f\xd2\xe0\xba\x01\xb0\xe8\x48\x73\xd8\xb4\x7f\x02\x00\x00\xff\xff\x81\xd4\xf2\x14\xff\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4f\x6f\xdb\xb8\x13\xbd\xeb\x53\x3c\xd8\x97\xdf\x0f\x88\xed\x26\x47\xed\xc9\x75\xd2\x56\xd8\xc4\x59\x58\x4e\x8b\x9e\x8c\x31\x35\x96\xb8\x91\x48\x2e\x49\xd9\x35\xba\xf9\xee\x0b\x49\xf4\xbf\x24\x6d\x8c\x3d\xf4\xb4\x96\x00\xcb\x9c\xc7\x99\x37\x33\x6f\x44\xf7\x31\xd1\x66\x6b\x65\x5e\x78\x5c\xbd\xbb\xba\xc2\x47\xad\xf3\x92\x71\x7b\x3b\x89\xfa\x51\x1f\xb7\x52\xb0\x72\x9c\xa1\x56\x19\x5b\xf8\x82\x31\x36\x24\x0a\xde\x59\x2e\xf0\x99\xad\x93\x5a\xe1\x6a\xf8\x0e\xff\x6b\x00\xbd\x60\xea\xfd\xff\xb7\xa8\x8f\xad\xae\x51\xd1\x16\x4a\x7b\xd4\x8e\xe1\x0b\xe9\xb0\x92\x25\x83\xbf\x09\x36\x1e\x52\x41\xe8\xca\x94\x92\x94\x60\x6c\xa4\x2f\xe0\x0f\xfe\x87\x51\x1f\x5f\x83\x0b\xbd\xf4\x24\x15\x08\x42\x9b\x2d\xf4\xea\x18\x07\xf2\x2d\xe1\xf6\x53\x78\x6f\xe2\xd1\x68\xb3\xd9\x0c\xa9\x65\x3b\xd4\x36\x1f\x95\x1d\xd2\x8d\x6e\x93\xc9\xcd\x34\xbd\x19\x5c\x0d\xdf\xb5\x7b\x1e\x54\xc9\xce\xc1\xf2\x5f\xb5\xb4\x9c\x61\xb9\x05\x19\x53\x4a\x41\xcb\x92\x51\xd2\x06\xda\x82\x72\xcb\x9c\xc1\xeb\x86\xf0\xc6\x4a\x2f\x55\x7e\x01\xa7\x57\x7e\x43\x96\xa3\x3e\x32\xe9\xbc\x95\xcb\xda\x9f\x54\x6b\x47\x4f\xba\x13\x80\x56\x20\x85\xde\x38\x45\x92\xf6\xf0\x7e\x9c\x26\xe9\x45\xd4\xc7\x97\x64\xfe\xe9\xfe\x61\x8e\x2f\xe3\xd9\x6c\x3c\x9d\x27\x37\x29\xee\x67\x98\xdc\x4f\xaf\x93\x79\x72\x3f\x4d\x71\xff\x01\xe3\xe9\x57\xfc\x9e\x4c\xaf\x2f\xc0\xd2\x17\x6c\xc1\xdf\x8c\x6d\xf8\x6b\x0b\xd9\xd4\x91\xb3\xa6\x68\x29\xf3\x09\x81\x95\xee\xda\xe7\x0c\x0b\xb9\x92\x02\x25\xa9\xbc\xa6\x9c\x91\xeb\x35\x5b\x25\x55\x0e\xc3\xb6\x92\xae\xe9\xa6\x03\xa9\x2c\xea\xa3\x94\x95\xf4\xe4\xdb\x95\x17\x49\x0d\xa3\x88\x8c\x0c\xfd\x8f\x21\xb4\xe5\xa1\x50\xb6\x1a\x8a\x52\xd7\xd9\x30\x6f\xa5\x34\x14\xba\x1a\xad\x2f\xa9\x34\x05\x5d\x46\x8f\x52\x65\x31\x52\xb6\x6b\x29\xf8\x8e\x8c\x91\x2a\x8f\x2a\xf6\x94\x91\xa7\x38\x02\x14\x55\x1c\xc3\xb1\xb0\xec\x2b\x52\x94\xb3\x7d\xdd\x67\xc0\x3a\x43\x82\x63\x34\x90\x81\xdb\x3a\xcf\x55\xd4\x64\x78\x70\x95\xb6\xae\xee\x3a\x57\x11\xb0\xde\xd1\x5d\x5f\x2e\xd9\xd3\x65\x04\xb8\x8e\xce\x27\xed\xfc\xf4\x95\xf0\x5d\x4c\x32\xd2\x85\xb8\x96\x9d\xae\xad\x60\xd7\x44\x01\x06\x21\x52\x87\x5b\x74\xdc\x17\x61\x77\xf8\xd9\x02\x81\x5d\xfa\x47\x94\xd2\x63\x3b\xd5\x5e\x7f\x64\xc5\x96\x3c\x67\x31\xbc\xad\x39\x58\x24\x55\x13\xad\x56\x32\x8f\xc3\x02\x60\x74\x29\xc5\x76\xfa\x66\xec\x85\xa4\x6a\xd1\x81\x9f\xed\xbd\xe3\x6a\xc9\xf6\x4c\x0f\x55\x0b\xde\x7b\xb0\xbc\x62\xcb\x4a\xf0\x07\xc9\x65\x76\x60\x75\xda\xc2\x85\xcc\x8e\x2c\x7e\x6b\x38\x6e\xed\xfb\x45\x57\x1b\xa3\xad\x77\x13\xad\x32\xd9\xea\x2c\xc6\x8a\x4a\xb7\xcf\x3b\x9b\x73\x65\x4a\xf2\x1c\xa3\x67\xac\xfe\x93\x85\x77\xa3\xef\xdf\xc3\xe3\xd3\xd3\xa8\x0b\xd4\xac\xed\x43\x3e\x3d\xf5\x5e\x6c\x9f\x90\x7a\xcf\x0f\x8e\xb3\xb9\xbe\x23\x2f\x8a\x59\x68\x62\x97\xfd\x71\xc8\x5d\x7b\xc7\x6b\x92\x65\xf3\x0a\x48\xd4\xd8\x39\xf6\x89\x5a\xb3\xf2\xda\x6e\x4f\xe1\x3b\xf5\x06\x35\xc7\xd1\x5b\x75\x28\x69\xc9\xa5\x8b\xc3\xf7\xb3\xa0\xc9\xf5\xc1\x81\x27\x9b\xb3\xef\xea\xfb\xc2\x4f\x21\xd9\x92\x15\x85\x14\x54\xce\x76\xbd\x08\x8a\x6c\xee\x41\xa8\x76\x28\xd4\x7e\x1d\x78\xe4\xed\x7e\x79\xc6\xab\x67\x04\x7e\xe0\x6b\x15\x68\x9c\xeb\xae\xb9\x32\x76\xc2\x4a\xd3\x74\x35\xc6\xdf\x83\x23\x0b\x30\x2f\x78\xb7\x09\xbe\x20\xdf\x9d\x0b\x3b\x12\x58\x72\xa9\x55\xee\xe0\xf5\xf0\x68\x5b\xbe\x7e\x3c\x90\x3a\xcc\xd3\x1f\x2f\x38\xbd\x3a\xe6\x87\x2b\xb7\xba\x36\xf1\x3e\xda\x5b\xef\x99\xf3\x46\x7c\x11\x42\xbe\x35\xea\x9f\x4f\x60\x3f\x99\xf8\x7f\xa7\xfc\x51\xa0\xd1\x0c\x44\x78\xfc\xa5\xe3\xd0\xbc\x4c\xd9\xee\x53\x4a\xae\x83\x70\x02\x97\x73\xe5\x7e\x0a\xff\x4f\xec\xbf\x50\xec\xcd\x3d\xe8\x32\xed\x94\x7d\x9a\xe8\xbe\x3a\x27\x27\xdb\x4f\x18\xff\xf8\xb8\x3b\x9f\xfd\x79\x7f\x08\x5e\x55\xd2\xc9\x71\x03\x18\xb2\xac\x7c\x0c\x6f\x6b\x8e\xfe\x19\x00\x81\xd4\xf2\x14\xff\x0a\x00\x00"),
 		},
 		"/securitycenter.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "securitycenter.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2922,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x10\xf6\xa5\x05\x12\x79\x93\xa3\x7a\xf2\x3a\xfb\x21\x34\xb1\x01\xcb\xdb\xc5\x9e\x02\x7a\x44\x4b\x44\xa4\x99\xe9\x0c\x65\xaf\xea\xfa\xbf\x17\xfa\xb0\x57\x4e\xdc\x14\x5b\xf4\x52\x60\x75\x93\xf8\xf8\xf8\xc8\x79\x1c\x8d\x61\x66\x6c\xed\x38\xcb\x05\x6e\xdf\xdc\xde\xc2\x07\x63\xb2\x82\xe0\xfe\x7e\x16\x8c\x83\x31\xdc\xb3\x22\xed\x29\x85\x4a\xa7\xe4\x40\x72\x82\xa9\x45\x95\xd3\x31\x72\x05\xbf\x91\xf3\x6c\x34\xdc\x86\x6f\xe0\xa7\x06\x30\xea\x43\xa3\x9f\x7f\x09\xc6\x50\x9b\x0a\x4a\xac\x41\x1b\x81\xca\x13\x48\xce\x1e\x36\x5c\x10\xd0\x57\x45\x56\x80\x35\x28\x53\xda\x82\x51\x2b\x82\x1d\x4b\xde\x96\xe9\x49\xc2\x60\x0c\x5f\x7a\x0a\xb3\x16\x64\x0d\x08\xca\xd8\x1a\xcc\x66\x88\x03\x94\x56\x70\xfb\xe4\x22\x36\x9a\x4c\x76\xbb\x5d\x88\xad\xda\xd0\xb8\x6c\x52\x74\x48\x3f\xb9\x8f\x67\xef\xe6\xc9\xbb\xeb\xdb\xf0\x4d\x9b\xf3\x49\x17\xe4\x3d\x38\xfa\xbd\x62\x47\x29\xac\x6b\x40\x6b\x0b\x56\xb8\x2e\x08\x0a\xdc\x81\x71\x80\x99\x23\x4a\x41\x4c\x23\x78\xe7\x58\x58\x67\x57\xe0\xcd\x46\x76\xe8\x28\x18\x43\xca\x5e\x1c\xaf\x2b\x39\x9b\xd6\x51\x1e\xfb\x33\x80\xd1\x80\x1a\x46\xd3\x04\xe2\x64\x04\x6f\xa7\x49\x9c\x5c\x05\x63\xf8\x1c\xaf\x3e\x2e\x3e\xad\xe0\xf3\x74\xb9\x9c\xce\x57\xf1\xbb\x04\x16\x4b\x98\x2d\xe6\x77\xf1\x2a\x5e\xcc\x13\x58\xbc\x87\xe9\xfc\x0b\xfc\x1a\xcf\xef\xae\x80\x58\x72\x72\x40\x5f\xad\x6b\xf4\x1b\x07\xdc\xcc\x91\xd2\x66\x68\x09\xd1\x99\x80\x8d\xe9\x04\x79\x4b\x8a\x37\xac\xa0\x40\x9d\x55\x98\x11\x64\x66\x4b\x4e\xb3\xce\xc0\x92\x2b\xd9\x37\xa7\xe9\x01\x75\x1a\x8c\xa1\xe0\x92\x05\xa5\xfd\xf2\xa2\xa9\x30\x08\xd0\x72\x7f\xfe\x11\x28\xe3\x28\x54\xda\x95\xa1\x2a\x4c\x95\x86\x59\x6b\xa5\x50\x99\x72\xb2\xbd\xc1\xc2\xe6\x78\x13\x3c\xb1\x4e\x23\x48\xc8\x6d\x59\xd1\x03\x5a\xcb\x3a\x0b\x4a\x12\x4c\x51\x30\x0a\x00\x34\x96\x14\x81\x27\x55\x39\x96\x5a\x91\x16\x72\x97\x49\x7b\xb0\xb7\xa8\x28\x82\x06\x72\xed\x6b\x2f\x54\x06\x4d\x8b\xdf\xb8\x92\x9e\x6b\xd6\x72\x05\x00\xdb\xa3\xe0\xed\xcd\x9a\x04\x6f\x02\x00\xdf\x09\xfa\x68\xbc\xcc\x2f\x09\xe8\xaa\xa2\x65\xdf\x57\x76\xe4\x4d\xe5\x14\xf9\xa6\x0e\xc0\x75\x5f\xab\xc3\x3d\x7a\xa5\x1e\xcb\x4a\xe8\x51\x19\xbd\xe1\x2c\xe8\x5c\x79\xec\x7d\x28\xe7\xa1\x12\x9a\x0d\x41\x58\x89\xf9\x40\x9a\x1c\x0a\xa5\x11\x88\xab\xa8\x8f\x70\xba\xa2\xd2\x16\x28\x14\xc1\x68\xbf\x6f\x0a\x1e\x0e\xa3\x17\xc1\x19\xea\xb7\xf4\xc9\x53\xba\x32\x0f\x28\x2a\x5f\xf6\x4a\xbb\xbe\x36\x58\xf8\x23\xe1\xb1\x87\xe9\x16\xb9\x68\xac\x1e\xeb\xa9\xf7\x24\xb1\xde\x92\x16\xe3\xea\x73\x78\x33\x23\x72\x27\x6d\xf1\xdd\x7b\xa6\x22\x8d\xda\xce\x9f\x31\xc6\x77\x51\xff\x05\x40\xd0\x65\x24\x2f\xb1\x00\x5b\x2c\x2a\x3a\xeb\xc9\xa2\x23\x2d\x87\xc3\xa4\x3c\x8d\xc5\x4f\xf6\xfb\x16\x78\xec\xf5\xc2\xa8\xb5\x91\xc6\xd1\xad\x4d\xff\x79\xe4\xf3\x01\xfa\xdf\x8c\xde\xb8\x0c\x35\xff\xd1\x2d\xc5\x64\xbf\x1f\xbe\x1f\x0e\x13\xfd\x82\xbe\x01\xfd\xdf\x4f\x2b\x67\x72\xe8\x54\xce\x0a\x8b\x25\x6d\xc8\x91\x3e\x99\xbf\x3b\x15\xa9\x2d\x45\x30\x1c\xc6\x29\x08\xf0\x44\xf5\x79\x6c\x49\x9b\x67\x2a\xfe\x86\x75\xd3\x6b\xf9\x7e\xe2\xe6\x49\xc9\x2b\xc7\x56\xda\x75\xff\xf3\x7a\x10\x01\x58\xe5\x74\x96\x09\x92\xa3\x74\xbf\xa7\xa3\x26\x58\x53\x61\x74\xe6\x41\x4c\x38\xc8\xcd\xb6\x4f\xd1\x19\x55\x67\xb3\xc5\x65\x89\x17\x2f\x9c\x01\x99\x33\x95\x8d\x4e\x25\x4b\xd4\x98\xbd\x72\xe5\x5d\x5c\x80\x2e\xf5\x15\xcf\x27\x43\xc0\x2b\x3e\xc7\xb2\xb3\xec\xb7\xf6\xac\x29\x58\xd5\xf3\xcb\x15\x1f\x19\xcb\xc7\x0e\xf1\x2c\xe1\x81\xca\x35\xb9\xd7\xd2\xca\x16\x71\x4a\x73\xc7\xe3\xef\xce\x7b\x30\xa2\xfe\x8f\x30\xec\xa0\x75\x6a\xeb\xb7\xb3\x0b\xc5\x57\xd6\x1a\x27\x7e\x66\x74\xca\xed\x7a\x9e\xef\xc4\xf7\xac\x71\x7f\xbd\xff\x58\xdd\x1f\xab\xfb\xdf\xac\xee\x5f\x01\x00\x00\xff\xff\x6c\x30\x2d\xa9\x6a\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\xd8\x97\x16\x48\xec\x24\x47\xf5\xe4\x75\xb2\xbb\x42\x13\x1b\xb0\x9c\x2e\xf6\x14\x8c\xa9\xb1\x44\x44\x22\x59\x92\xb2\x57\x4d\xfd\xdf\x0b\x7d\xd8\x96\x13\x37\xc5\x16\xbd\x14\xd8\x30\x17\x73\xde\xcc\xbc\x99\x79\x43\x0d\x31\xd5\xa6\xb2\x32\xcd\x3c\x6e\xae\x6e\x6e\xf0\x49\xeb\x34\x67\xdc\xdf\x4f\x83\x61\x30\xc4\xbd\x14\xac\x1c\x27\x28\x55\xc2\x16\x3e\x63\x4c\x0c\x89\x8c\xf7\x96\x0b\xfc\xc6\xd6\x49\xad\x70\x33\xba\xc2\x4f\x35\x60\xd0\x99\x06\x3f\xff\x12\x0c\x51\xe9\x12\x05\x55\x50\xda\xa3\x74\x0c\x9f\x49\x87\xb5\xcc\x19\xfc\x4d\xb0\xf1\x90\x0a\x42\x17\x26\x97\xa4\x04\x63\x2b\x7d\x06\x7f\x8c\x3f\x0a\x86\xf8\xda\x85\xd0\x2b\x4f\x52\x81\x20\xb4\xa9\xa0\xd7\x7d\x1c\xc8\x37\x84\x9b\xbf\xcc\x7b\x13\x8e\xc7\xdb\xed\x76\x44\x0d\xdb\x91\xb6\xe9\x38\x6f\x91\x6e\x7c\x1f\x4d\xef\x66\xf1\xdd\xe5\xcd\xe8\xaa\xf1\x79\x54\x39\x3b\x07\xcb\xbf\x97\xd2\x72\x82\x55\x05\x32\x26\x97\x82\x56\x39\x23\xa7\x2d\xb4\x05\xa5\x96\x39\x81\xd7\x35\xe1\xad\x95\x5e\xaa\xf4\x02\x4e\xaf\xfd\x96\x2c\x07\x43\x24\xd2\x79\x2b\x57\xa5\x3f\xe9\xd6\x9e\x9e\x74\x27\x00\xad\x40\x0a\x83\x49\x8c\x28\x1e\xe0\xc3\x24\x8e\xe2\x8b\x60\x88\x2f\xd1\xf2\xf3\xfc\x71\x89\x2f\x93\xc5\x62\x32\x5b\x46\x77\x31\xe6\x0b\x4c\xe7\xb3\xdb\x68\x19\xcd\x67\x31\xe6\x1f\x31\x99\x7d\xc5\xaf\xd1\xec\xf6\x02\x2c\x7d\xc6\x16\xfc\xcd\xd8\x9a\xbf\xb6\x90\x75\x1f\x39\xa9\x9b\x16\x33\x9f\x10\x58\xeb\x76\x7c\xce\xb0\x90\x6b\x29\x90\x93\x4a\x4b\x4a\x19\xa9\xde\xb0\x55\x52\xa5\x30\x6c\x0b\xe9\xea\x69\x3a\x90\x4a\x82\x21\x72\x59\x48\x4f\xbe\xb9\x79\x53\xd4\x28\x08\xc8\xc8\x6e\xfe\x21\x84\xb6\x3c\x12\xca\x16\x23\x91\xeb\x32\x19\xa5\x8d\x94\x46\x42\x17\xe3\xcd\x35\xe5\x26\xa3\xeb\xe0\x59\xaa\x24\x44\xcc\x76\x23\x05\x3f\x90\x31\x52\xa5\x41\xc1\x9e\x12\xf2\x14\x06\x80\xa2\x82\x43\x38\x16\xa5\x95\xbe\x12\xac\x3c\xdb\xf3\x41\x3b\xb0\x33\x24\x38\x44\x0d\xb9\x74\x95\xf3\x5c\x04\x75\x89\xc7\x58\x71\x17\x6b\xda\xc4\x0a\x80\xcd\x9e\xf0\xe6\x7a\xc5\x9e\xae\x03\xc0\xb5\x84\x3e\x6b\xe7\x67\xe7\x08\xb4\x59\xc9\x48\xd7\x65\xb6\xec\x74\x69\x05\xbb\x3a\x0f\x70\xd9\xe5\x6a\x71\x4f\x4e\x88\xa7\xa2\xf4\xfc\x24\xb4\x5a\xcb\xb4\x81\x00\xfb\xda\xfb\x74\x1e\x4a\xcf\xd3\x3e\x88\x4a\xaf\x3f\xb1\x62\x4b\x9e\x93\x10\xde\x96\xdc\x59\x64\xb2\xe4\xc2\xe4\xe4\x39\xc4\xe0\xe5\xa5\x4e\xb8\xdb\x0d\xde\x18\xa7\xa4\x3e\xf0\xa3\xe3\x64\xa9\x1f\xc8\x8b\x6c\xd1\x31\x6d\xeb\x5a\x53\xee\xf6\x01\xf7\x35\x4c\x36\x24\xf3\x5a\xea\x91\x9a\x38\xc7\x3e\x52\x1b\x56\x5e\xdb\xea\x14\x5e\xf7\x88\xed\x81\x5b\x74\xfb\x51\x72\x9e\x84\x4d\xe5\xaf\x22\x46\xb7\x61\x77\x03\x78\xb2\x29\xfb\xb7\x58\x60\x43\x79\xc9\x27\x35\x19\xb2\xac\xfc\x6e\x37\x2e\x0e\x6d\x71\xe3\x97\x97\x06\xb8\xaf\xf5\x4c\xab\x95\xf6\xb5\xa2\x1b\x99\xfe\x73\xcb\x67\x3d\xf4\xbf\x69\xbd\xb6\x29\x29\xf9\x47\xe3\x5f\xb3\xeb\xff\xde\xed\xc6\x7d\x32\xc7\x12\xfe\xef\xd3\xca\x24\x5b\xb2\x22\x93\x82\xf2\x05\xaf\xd9\xb2\x3a\x88\xbf\xfe\xbf\x84\xaf\x0c\x87\xe8\x37\xe3\x60\x04\x9e\xb9\x3a\xb5\x2d\x78\xfd\x8a\xc5\xdf\x44\x5d\x77\x5c\xbe\x3f\x70\x7d\x12\x76\xc2\x4a\x53\x1b\x42\xfc\x79\xd9\xb3\x00\xcb\x8c\x4f\x3c\xe1\x33\xf2\xed\xe7\x69\xcf\x09\x2b\xce\xb5\x4a\x1d\xbc\x1e\xf5\x7c\xd3\xcd\xf3\x91\xe3\x51\x66\xf3\x5e\xb0\x13\xf3\x99\x07\xe7\x78\x52\xab\x4b\x13\x1e\x52\x16\xa4\x28\x7d\xe7\xc9\x3b\xbb\x00\x2d\xdb\x77\x34\x1f\xf7\x01\xef\xe8\x9c\x8a\x56\xb2\xc7\xf2\x8c\xce\xa5\xa8\x66\xe7\x33\x3e\x49\x2a\x9e\x5a\xc4\x2b\x87\x07\x2e\x56\x6c\xdf\x73\x2b\x1a\xc4\xc1\xcd\xee\xc7\xdf\xce\xfb\x70\x7f\xf8\x22\xf4\x2b\xa8\x4f\xab\xb7\x9e\x44\x01\x57\x1a\xa3\xad\x77\x53\xad\x12\x59\xcf\xdc\x9d\xee\xc4\xf7\xac\x71\xf7\xbc\xff\x58\xdd\x1f\xab\xfb\xdf\xac\xee\x5f\x03\x00\x6c\x30\x2d\xa9\x6a\x0b\x00\x00"),
 		},
 		"/securityscanner.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "securityscanner.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1557,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xdb\x46\x10\xc5\xef\xfc\x14\x0f\xd2\xa5\x05\x6c\x2a\xf6\x91\x3d\x29\xb2\x93\x10\x75\xe4\x42\x94\x1b\xe4\x64\x8c\x96\x43\x72\x6b\x72\x77\xbb\x3b\x94\x42\xa4\xfe\xee\x05\xff\x48\xb5\x62\x07\x28\x2f\x14\x66\xdf\xbc\xf9\xcd\xec\x50\x73\xac\xac\xeb\xbc\x2e\x2b\xc1\xf5\xbb\xeb\x6b\x7c\xb4\xb6\xac\x19\x77\x77\xab\x68\x1e\xcd\x71\xa7\x15\x9b\xc0\x39\x5a\x93\xb3\x87\x54\x8c\xa5\x23\x55\xf1\xf1\xe4\x02\x7f\xb2\x0f\xda\x1a\x5c\xc7\xef\xf0\x4b\x2f\x98\x4d\x47\xb3\x5f\x7f\x8b\xe6\xe8\x6c\x8b\x86\x3a\x18\x2b\x68\x03\x43\x2a\x1d\x50\xe8\x9a\xc1\xdf\x14\x3b\x81\x36\x50\xb6\x71\xb5\x26\xa3\x18\x07\x2d\xd5\x50\x66\x32\x89\xa3\x39\xbe\x4e\x16\x76\x27\xa4\x0d\x08\xca\xba\x0e\xb6\x78\xa9\x03\xc9\x00\x3c\x3c\x95\x88\x4b\x16\x8b\xc3\xe1\x10\xd3\x40\x1b\x5b\x5f\x2e\xea\x51\x19\x16\x77\xe9\xea\x76\x9d\xdd\x5e\x5e\xc7\xef\x86\x9c\x07\x53\x73\x08\xf0\xfc\x77\xab\x3d\xe7\xd8\x75\x20\xe7\x6a\xad\x68\x57\x33\x6a\x3a\xc0\x7a\x50\xe9\x99\x73\x88\xed\x81\x0f\x5e\x8b\x36\xe5\x05\x82\x2d\xe4\x40\x9e\xa3\x39\x72\x1d\xc4\xeb\x5d\x2b\x67\xd3\x3a\xe2\xe9\x70\x26\xb0\x06\x64\x30\x5b\x66\x48\xb3\x19\xde\x2f\xb3\x34\xbb\x88\xe6\xf8\x92\x6e\x3f\xdd\x3f\x6c\xf1\x65\xb9\xd9\x2c\xd7\xdb\xf4\x36\xc3\xfd\x06\xab\xfb\xf5\x4d\xba\x4d\xef\xd7\x19\xee\x3f\x60\xb9\xfe\x8a\xdf\xd3\xf5\xcd\x05\x58\x4b\xc5\x1e\xfc\xcd\xf9\x9e\xdf\x7a\xe8\x7e\x8e\x9c\xf7\x43\xcb\x98\xcf\x00\x0a\x3b\x02\x05\xc7\x4a\x17\x5a\xa1\x26\x53\xb6\x54\x32\x4a\xbb\x67\x6f\xb4\x29\xe1\xd8\x37\x3a\xf4\xb7\x19\x40\x26\x8f\xe6\xa8\x75\xa3\x85\x64\x88\xbc\x6a\x2a\x8e\x22\x72\x7a\xba\xff\x04\xca\x7a\x8e\x95\xf1\x4d\xac\x6a\xdb\xe6\x71\x39\xac\x52\xac\x6c\xb3\xd8\x5f\x51\xed\x2a\xba\x8a\x9e\xb4\xc9\x13\x64\xec\xf7\x5a\xf1\x67\x72\x4e\x9b\x32\x6a\x58\x28\x27\xa1\x24\x02\x0c\x35\x9c\x20\xb0\x6a\xbd\x96\x2e\x28\x32\x86\xfd\xdb\xae\x93\x3a\x38\x52\x9c\xa0\x97\x5c\x86\x2e\x08\x37\x51\xdf\xe3\x7f\x66\xd9\x64\x96\x8d\x66\x11\xb0\x3f\x22\xef\xaf\x76\x2c\x74\x15\x01\x61\x44\xfa\x64\x83\xac\xdf\x44\x18\xeb\x92\xd3\x61\xaa\xed\x39\xd8\xd6\x2b\x0e\x7d\x25\xe0\x72\xaa\x36\xea\x1e\x8f\xe9\x8f\x53\xfe\xf0\x7e\x54\xd6\x14\xba\x8c\xc6\x2d\x3d\xce\xe2\x8c\xae\x7f\xad\x5e\xaa\xa8\x15\xfb\x91\x0d\x7b\x12\xce\x13\x88\x6f\x79\x3a\xd1\xf9\x96\x1b\x57\x93\x70\x82\x99\xf3\xf6\x2f\x56\x12\x16\xdf\xbf\x4f\x3f\x9f\x9f\x17\xe1\x64\xd6\xc7\x7b\xbe\xe7\xe7\xd9\xab\xec\x15\x99\xf7\xfc\x10\x38\xdf\xda\xcf\x24\xaa\xda\x4c\x8d\x8d\x73\x28\xa8\x0e\xc7\x8a\xc7\x96\x97\x7b\xd2\x75\xff\x71\xa4\x66\x19\x02\x4b\x6a\xf6\x6c\xc4\xfa\xee\x5c\xde\xcf\x94\xfd\x09\x3e\xbd\xf9\xa0\xb9\xce\x93\x61\x50\x3f\x38\xa6\x37\xc9\x14\x01\x84\x7c\xc9\xf2\x5a\x5b\x69\xf6\xe4\x55\xa5\x15\xd5\x1b\x2e\xd8\xb3\x39\x4d\x7f\xbc\x01\xe9\x1c\x27\x98\xfa\x3f\xc5\x81\x27\xee\x4e\xe1\x0d\x17\x3f\xd4\xfe\x89\x57\x31\x11\xfc\x5f\xbb\xfe\xc9\x39\x28\xaf\x9d\x0c\xcb\xf5\xcf\xe5\x8b\x13\x60\x5b\xf1\x31\x09\x52\x91\x8c\xff\x84\x47\x08\xec\xb8\xb6\xa6\x0c\x10\x1b\xbf\x48\x2b\xf7\x4f\xc9\x99\xcb\xb8\x34\x7f\xbc\x62\x7a\x73\xa9\x5f\xf8\x78\xdb\xba\xe4\x54\xad\x21\x43\xe5\x4f\xbf\xab\x7f\x03\x00\x00\xff\xff\x66\x71\x97\xe3\x15\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xe2\xc8\x13\xc5\xef\xfe\x14\x4f\x70\xf9\xff\xa5\xc4\x24\x1c\xbd\x27\x86\x64\x66\xac\xcd\xc0\x0a\x93\x1d\xcd\x29\x2a\xda\x65\xbb\x37\x76\x77\x6f\x77\x19\xc6\x9a\xcd\x77\x5f\x19\x0c\x84\x49\x46\x5a\x8c\x64\x54\xfd\xea\xd5\xaf\xaa\xbb\x19\x63\x6e\x5d\xe7\x75\x59\x09\xa6\x37\xd3\x29\x3e\x59\x5b\xd6\x8c\x87\x87\x79\x34\x8e\xc6\x78\xd0\x8a\x4d\xe0\x1c\xad\xc9\xd9\x43\x2a\xc6\xcc\x91\xaa\xf8\xb8\x72\x85\x3f\xd9\x07\x6d\x0d\xa6\xf1\x0d\xfe\xd7\x0b\x46\xc3\xd2\xe8\xff\xbf\x45\x63\x74\xb6\x45\x43\x1d\x8c\x15\xb4\x81\x21\x95\x0e\x28\x74\xcd\xe0\xef\x8a\x9d\x40\x1b\x28\xdb\xb8\x5a\x93\x51\x8c\x9d\x96\x0a\x72\xf6\x8f\xa3\x31\xbe\x0d\x16\x76\x23\xa4\x0d\x08\xca\xba\x0e\xb6\x78\xad\x03\xc9\x1e\x78\xff\xa9\x44\x5c\x32\x99\xec\x76\xbb\x98\xf6\xb4\xb1\xf5\xe5\xa4\x3e\x28\xc3\xe4\x21\x9d\xdf\x2f\xb2\xfb\xeb\x69\x7c\xb3\xcf\x79\x34\x35\x87\x00\xcf\x7f\xb7\xda\x73\x8e\x4d\x07\x72\xae\xd6\x8a\x36\x35\xa3\xa6\x1d\xac\x07\x95\x9e\x39\x87\xd8\x1e\x78\xe7\xb5\x68\x53\x5e\x21\xd8\x42\x76\xe4\x39\x1a\x23\xd7\x41\xbc\xde\xb4\x72\x31\xad\x23\x9e\x0e\x17\x02\x6b\x40\x06\xa3\x59\x86\x34\x1b\xe1\xc3\x2c\x4b\xb3\xab\x68\x8c\xaf\xe9\xfa\xf3\xf2\x71\x8d\xaf\xb3\xd5\x6a\xb6\x58\xa7\xf7\x19\x96\x2b\xcc\x97\x8b\xbb\x74\x9d\x2e\x17\x19\x96\x1f\x31\x5b\x7c\xc3\xef\xe9\xe2\xee\x0a\xac\xa5\x62\x0f\xfe\xee\x7c\xcf\x6f\x3d\x74\x3f\x47\xce\xfb\xa1\x65\xcc\x17\x00\x85\x3d\x6c\x5f\x70\xac\x74\xa1\x15\x6a\x32\x65\x4b\x25\xa3\xb4\x5b\xf6\x46\x9b\x12\x8e\x7d\xa3\x43\xbf\x9b\x01\x64\xf2\x68\x8c\x5a\x37\x5a\x48\xf6\x91\x37\x4d\xc5\x51\x44\x4e\x0f\xfb\x9f\x40\x59\xcf\xb1\x32\xbe\x89\x55\x6d\xdb\x3c\x2e\xf7\x47\x29\x56\xb6\x99\x6c\x6f\xa9\x76\x15\xdd\x46\xcf\xda\xe4\x09\x32\xf6\x5b\xad\xf8\x0b\x39\xa7\x4d\x19\x35\x2c\x94\x93\x50\x12\x01\x86\x1a\x4e\x10\x58\xb5\x5e\x4b\x17\x14\x19\xc3\xfe\x7d\xd7\x41\x1d\x1c\x29\x4e\xd0\x4b\xae\x43\x17\x84\x9b\xa8\xef\xf1\x6c\x96\x0d\x66\xd9\xc1\x2c\x02\xb6\x47\xe4\xed\xed\x86\x85\x6e\x23\x20\x1c\x90\x3e\xdb\x20\x8b\x77\x11\x0e\x75\xc9\xe9\x30\xd4\xf6\x1c\x6c\xeb\x15\x87\xbe\x12\x70\x3d\x54\x3b\xe8\x9e\x8e\xe9\x4f\x43\xfe\xfe\xfd\xa4\xac\x29\x74\xb9\xd7\x03\xc7\x59\x5c\xd0\xf5\xaf\xf9\x6b\x15\xb5\x62\x3f\xb1\x61\x4f\xc2\x79\x02\xf1\x2d\x0f\x2b\x3a\x5f\x73\xe3\x6a\x12\x4e\x30\x72\xde\xfe\xc5\x4a\xc2\xe4\xc7\x8f\xe1\xe7\xcb\xcb\x24\x9c\xcc\xfa\x78\xcf\xf7\xf2\x32\x7a\x93\x3d\x27\xf3\x81\x1f\x03\xe7\x6b\xfb\x85\x44\x55\xab\xa1\xb1\xc3\x1c\x0a\xaa\xc3\xb1\xe2\xb1\xe5\xd9\x96\x74\xdd\x5f\x8e\xd4\xcc\x42\x60\x49\xcd\x96\x8d\x58\xdf\x5d\xca\xfb\x99\xb2\x3f\xc1\xa7\x77\x1f\x35\xd7\x79\xb2\x1f\xd4\x4f\x8e\xe9\x5d\x32\x44\x00\x21\x5f\xb2\xbc\xd5\x56\x9a\x3d\x79\x55\x69\x45\xf5\x8a\x0b\xf6\x6c\x4e\xd3\xef\xbf\xd7\x90\xce\x71\x82\xa1\xff\x53\x1c\x78\xe6\xee\x14\x5e\x71\xf1\x53\xed\x5f\x78\x15\x03\xc1\x7f\xb5\xeb\x9f\x9c\x83\xf2\xda\xf5\xf7\x25\xc1\x3f\xd7\xaf\x56\x80\x75\xc5\xc7\x24\x48\x45\x72\xf8\x27\x3c\x42\x60\xc3\xb5\x35\x65\x80\xd8\xf8\x55\x5a\xb9\x7d\x3e\x43\x9d\x0f\xcd\x1f\x6f\x98\xde\x3d\xd4\xe7\xa7\xf4\xb6\x75\xc9\xa9\x5a\x43\x86\xca\x5f\xde\xab\x7f\x07\x00\x66\x71\x97\xe3\x15\x06\x00\x00"),
 		},
 		"/servicedirectory.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "servicedirectory.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3745,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4b\x6f\xe3\x36\x10\xbe\xeb\x57\x0c\xec\x4b\x0b\x24\x72\x92\xa3\x7a\xf2\x3a\xd9\x5d\xa1\x89\x53\xd8\x4e\x17\x7b\x32\xc6\xd4\x58\x9a\x86\x22\x59\x92\xb2\xd7\x48\xfd\xdf\x0b\x3d\x63\xe5\x05\x17\x7d\x20\x28\x56\x87\x44\x9e\xc7\x37\x1f\x87\x33\x9f\x3d\x84\x89\x36\x3b\xcb\x69\xe6\xe1\xe2\xec\xe2\x02\x3e\x69\x9d\x4a\x82\xeb\xeb\x49\x30\x0c\x86\x70\xcd\x82\x94\xa3\x04\x0a\x95\x90\x05\x9f\x11\x8c\x0d\x8a\x8c\x5a\xcf\x09\xfc\x4a\xd6\xb1\x56\x70\x11\x9e\xc1\x0f\x65\xc0\xa0\x71\x0d\x7e\xfc\x29\x18\xc2\x4e\x17\x90\xe3\x0e\x94\xf6\x50\x38\x02\x9f\xb1\x83\x35\x4b\x02\xfa\x26\xc8\x78\x60\x05\x42\xe7\x46\x32\x2a\x41\xb0\x65\x9f\x55\x65\x1a\x90\x30\x18\xc2\xd7\x06\x42\xaf\x3c\xb2\x02\x04\xa1\xcd\x0e\xf4\xfa\x30\x0e\xd0\x57\x84\xab\x27\xf3\xde\x44\xa3\xd1\x76\xbb\x0d\xb1\x62\x1b\x6a\x9b\x8e\x64\x1d\xe9\x46\xd7\xf1\xe4\x6a\x3a\xbf\x3a\xbd\x08\xcf\xaa\x9c\x3b\x25\xc9\x39\xb0\xf4\x7b\xc1\x96\x12\x58\xed\x00\x8d\x91\x2c\x70\x25\x09\x24\x6e\x41\x5b\xc0\xd4\x12\x25\xe0\x75\x49\x78\x6b\xd9\xb3\x4a\x4f\xc0\xe9\xb5\xdf\xa2\xa5\x60\x08\x09\x3b\x6f\x79\x55\xf8\x5e\xb7\x5a\x7a\xec\x7a\x01\x5a\x01\x2a\x18\x8c\xe7\x10\xcf\x07\xf0\x61\x3c\x8f\xe7\x27\xc1\x10\xbe\xc4\x8b\xcf\xb7\x77\x0b\xf8\x32\x9e\xcd\xc6\xd3\x45\x7c\x35\x87\xdb\x19\x4c\x6e\xa7\x97\xf1\x22\xbe\x9d\xce\xe1\xf6\x23\x8c\xa7\x5f\xe1\xe7\x78\x7a\x79\x02\xc4\x3e\x23\x0b\xf4\xcd\xd8\x92\xbf\xb6\xc0\x65\x1f\x29\x29\x9b\x36\x27\xea\x11\x58\xeb\x9a\x90\x33\x24\x78\xcd\x02\x24\xaa\xb4\xc0\x94\x20\xd5\x1b\xb2\x8a\x55\x0a\x86\x6c\xce\xae\xbc\x4d\x07\xa8\x92\x60\x08\x92\x73\xf6\xe8\x2b\xcb\xb3\x43\x85\x41\x80\x86\x9b\xfb\x8f\x40\x68\x4b\xa1\x50\x36\x0f\x85\xd4\x45\x12\xa6\xd5\x28\x85\x42\xe7\xa3\xcd\x39\x4a\x93\xe1\x79\x70\xcf\x2a\x89\x60\x4e\x76\xc3\x82\x6e\xd0\x18\x56\x69\x90\x93\xc7\x04\x3d\x46\x01\x80\xc2\x9c\x22\x70\x75\x40\xc2\x96\x84\xd7\x76\xf7\x32\x6c\x13\xee\x0c\x0a\x8a\xa0\x0c\x39\x75\x3b\xe7\x29\x0f\xca\x43\x3e\xa2\x35\xe5\x2e\x5b\xb4\x00\x60\xd3\x92\xde\x9c\xaf\xc8\xe3\x79\x00\x6d\xcd\xcf\xda\xf9\xe9\xcb\x24\xea\xca\x68\xd8\x35\xd5\x2d\x39\x5d\x58\x41\xae\xac\x05\x70\xda\xd4\xab\xe3\x96\x4d\xfe\xb2\x03\x58\x92\x4a\x8c\x66\xe5\x83\x7a\x4c\x7b\xcd\xe8\xd8\x5d\xf5\x83\xb0\xf0\xfa\x13\x29\xb2\xe8\x29\x89\xc0\xdb\x82\x1a\x0f\x27\x0b\xca\x8d\x44\x4f\x11\x0c\x8c\xd5\xbf\x91\xf0\x6e\xf4\xf0\xd0\xbc\xee\xf7\x23\xa9\x45\x7d\x77\xa3\x87\x87\xf6\x7d\xbf\x1f\x75\x4d\x2b\xed\xdd\x87\x25\x27\xfb\xfd\xa8\x21\x5d\x7a\x5a\xfe\x95\xbd\xa5\x5e\x3a\xda\xf7\xca\x33\x78\xc6\x66\x82\xea\x03\xdd\x39\x4a\x16\xfa\x06\xbd\xc8\x66\x4d\x97\xea\xae\xae\x51\xba\xf6\x04\x6d\xff\xc6\x1b\x64\x59\x2e\x5b\xac\xc6\xce\x91\x8f\xd5\x86\x54\xd9\x8b\x7e\x78\x3b\x27\xcd\xdc\x44\x8d\xb9\xbd\xe6\x03\x5a\x4f\xf0\xe3\xcb\xc7\x58\x8f\x36\x25\xff\x91\x49\x26\x2f\xa5\x64\x4c\x16\xad\xc8\x58\xa0\x9c\xd1\x9a\x2c\xa9\xee\x7e\xeb\x3b\xf6\x3b\x43\x11\x34\x4d\xee\xec\x00\xf7\xb4\xeb\xcc\x33\x5a\x3f\xa1\xf0\x0a\xd6\xba\x21\x72\x2c\x5c\xf9\x24\xe4\x84\x65\xe3\xab\xf9\xfd\xe3\xf4\xc0\x03\xb0\xc8\xa8\x4d\x02\x9f\xa1\xaf\xe5\xb6\x25\x01\x2b\x92\x5a\xa5\x0e\xbc\x0e\x0f\xd2\xd2\xcd\x7d\xd4\x43\xa9\x07\xf3\x97\x67\x9c\x5e\xdc\x9b\x03\x1c\xab\x0b\x13\x75\xd5\x72\x54\x98\x92\x7d\x75\x77\x8f\x58\x98\x6e\x38\xdf\xdc\x98\xe9\x93\xa8\x37\x56\x06\xf3\x89\x56\x6b\x3e\x98\x1d\xa3\x25\x8b\xdd\xf4\x48\x1e\x4b\xc6\x7c\x59\xa7\x3c\x41\xb8\xa1\x7c\x45\xf6\x2f\xe1\xe4\x55\x4a\x87\x63\xdb\x11\xa9\x67\xe2\xa0\xb5\x75\x97\xca\xbf\x07\xc6\x7a\x0e\x7b\x46\x57\x18\xa3\xad\x77\x13\xad\x12\xae\x16\xbf\xbf\x40\xff\x86\x62\xbc\x9f\xfd\x3f\xe4\xd5\xb9\x24\xae\x48\xba\xa8\xf9\x7f\xac\x2e\xbc\x00\xf5\x5d\x18\xde\x93\x30\x34\x96\x37\x65\x61\xde\x8b\xf9\xe7\x45\xa1\xfb\x7e\xfc\x5b\x92\x70\x88\xf2\xbf\x10\x84\xd7\x7e\x42\xbc\x1f\xa1\x78\x64\x75\xac\x1e\x3c\xcb\xf8\xae\x06\xff\x91\x1a\xfc\x19\x00\x00\xff\xff\x0a\x2a\x29\x62\xa1\x0e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4b\x4f\x23\x47\x10\xbe\xcf\xaf\x28\xd9\x97\x44\x82\x31\x70\x9c\x9c\xbc\x86\xdd\x1d\x05\x4c\x64\x9b\xac\xf6\x64\x95\x7b\xca\x33\x15\x7a\xba\x3b\xdd\x3d\xf6\x5a\xc4\xff\x3d\x9a\xa7\x1f\x60\x44\x94\x87\x50\xb4\x0c\x82\x71\xf5\x57\x5f\x7d\x5d\x2f\xb9\x0f\x23\x6d\x36\x96\xd3\xcc\xc3\xd5\xc5\xd5\x15\x7c\xd2\x3a\x95\x04\xb7\xb7\xa3\xa0\x1f\xf4\xe1\x96\x05\x29\x47\x09\x14\x2a\x21\x0b\x3e\x23\x18\x1a\x14\x19\xb5\x27\x67\xf0\x2b\x59\xc7\x5a\xc1\x55\x78\x01\x3f\x94\x80\x5e\x73\xd4\xfb\xf1\xa7\xa0\x0f\x1b\x5d\x40\x8e\x1b\x50\xda\x43\xe1\x08\x7c\xc6\x0e\x96\x2c\x09\xe8\x9b\x20\xe3\x81\x15\x08\x9d\x1b\xc9\xa8\x04\xc1\x9a\x7d\x06\x7e\xc7\x1f\x06\x7d\xf8\xda\x50\xe8\x85\x47\x56\x80\x20\xb4\xd9\x80\x5e\xee\xe3\x00\x7d\x25\xb8\xfa\xc9\xbc\x37\xd1\x60\xb0\x5e\xaf\x43\xac\xd4\x86\xda\xa6\x03\x59\x23\xdd\xe0\x36\x1e\xdd\x8c\xa7\x37\xe7\x57\xe1\x45\xe5\xf3\xa0\x24\x39\x07\x96\x7e\x2f\xd8\x52\x02\x8b\x0d\xa0\x31\x92\x05\x2e\x24\x81\xc4\x35\x68\x0b\x98\x5a\xa2\x04\xbc\x2e\x05\xaf\x2d\x7b\x56\xe9\x19\x38\xbd\xf4\x6b\xb4\x14\xf4\x21\x61\xe7\x2d\x2f\x0a\x7f\x90\xad\x56\x1e\xbb\x03\x80\x56\x80\x0a\x7a\xc3\x29\xc4\xd3\x1e\x7c\x18\x4e\xe3\xe9\x59\xd0\x87\x2f\xf1\xec\xf3\xfd\xc3\x0c\xbe\x0c\x27\x93\xe1\x78\x16\xdf\x4c\xe1\x7e\x02\xa3\xfb\xf1\x75\x3c\x8b\xef\xc7\x53\xb8\xff\x08\xc3\xf1\x57\xf8\x39\x1e\x5f\x9f\x01\xb1\xcf\xc8\x02\x7d\x33\xb6\xd4\xaf\x2d\x70\x99\x47\x4a\xca\xa4\x4d\x89\x0e\x04\x2c\x75\x5d\x3e\x67\x48\xf0\x92\x05\x48\x54\x69\x81\x29\x41\xaa\x57\x64\x15\xab\x14\x0c\xd9\x9c\x5d\x59\x4d\x07\xa8\x92\xa0\x0f\x92\x73\xf6\xe8\x2b\xcb\xb3\x4b\x85\x41\x80\x86\x9b\xfa\x47\x20\xb4\xa5\x50\x28\x9b\x87\x42\xea\x22\x09\xd3\xaa\x95\x42\xa1\xf3\xc1\xea\x12\xa5\xc9\xf0\x32\x78\x64\x95\x44\x30\x25\xbb\x62\x41\x77\x68\x0c\xab\x34\xc8\xc9\x63\x82\x1e\xa3\x00\x40\x61\x4e\x11\xb8\x1a\x90\xb0\x25\xe1\xb5\xdd\xbc\x4c\xdb\xc0\x9d\x41\x41\x11\x94\x90\x73\xb7\x71\x9e\xf2\xa0\xbc\xe4\x8e\xad\x09\x77\xdd\xb2\x05\x00\xab\x56\xf4\xea\x72\x41\x1e\x2f\x03\x68\x63\x7e\xd6\xce\x8f\x5f\x16\x51\x47\x46\xc3\xae\x89\x6e\xc9\xe9\xc2\x0a\x72\x65\x2c\x80\xf3\x26\x5e\x8d\x9b\x37\xfe\xf3\x8e\x60\x4e\x2a\x31\x9a\x95\xaf\xd0\x00\x07\xc9\xe8\xd4\xdd\x1c\x82\xb0\xf0\xfa\x13\x29\xb2\xe8\x29\x89\xc0\xdb\x82\x9a\x13\x4e\x66\x94\x1b\x89\x9e\x22\xe8\x19\xab\x7f\x23\xe1\xdd\xe0\xe9\xa9\x79\xdd\x6e\x07\x52\x8b\xba\x76\x83\xa7\xa7\xf6\x7d\xbb\x1d\x74\x49\x2b\xd1\xdd\x87\x39\x27\xdb\xed\xa0\x11\x5d\x9e\xb4\xfa\x2b\x7b\x2b\xbd\x3c\x68\xdf\x2b\x8f\xde\x33\x35\x23\x54\x1f\xe8\xc1\x51\x32\xd3\x77\xe8\x45\x36\x69\xb2\x54\x67\x75\x89\xd2\xb5\x37\x68\xf3\x37\x5c\x21\xcb\x72\xd8\x62\x35\x74\x8e\x7c\xac\x56\xa4\xca\x4a\x1d\xc2\xdb\x3e\x69\xfa\x26\x6a\xcc\x6d\x99\xf7\x64\x1d\xf1\xc7\xd7\x3b\xac\x47\x9b\x92\xff\xc8\x24\x93\x97\x5c\x32\x26\x8b\x56\x64\x2c\x50\x4e\x68\x49\x96\x54\x57\xdf\xf2\xf7\x1c\xfc\xc6\x50\x04\x4d\x92\x3b\x3b\xc0\x23\x6d\x3a\xf3\x84\x96\x47\x12\x4e\x70\x2d\x1b\x21\x6f\xa5\x2b\x9f\x84\x9c\xb0\x6c\xca\x62\x46\xf0\xc7\xf9\xde\x09\xc0\x2c\xa3\xd6\x09\x7c\x86\xbe\x5e\xb7\xad\x08\x58\x90\xd4\x2a\x75\xe0\x75\xb8\xe7\x96\xae\x1e\x77\xa2\x76\x8d\xf9\xcb\x33\x4d\x2f\xce\xcd\xee\x49\xad\x2e\x4c\xd4\x45\xcb\x51\x61\x4a\xf6\xe4\xec\xbe\x61\x60\xba\xe6\x7c\x75\x62\xc6\x47\xa8\x57\x46\x06\xf3\x91\x56\x4b\x4e\x77\xf7\x35\x5a\xb2\xd8\x8c\xdf\xa8\x63\xce\x98\xcf\x6b\x97\x23\x86\x3b\xca\x17\x64\xff\x12\x4f\x5e\xb9\x74\x3c\xb6\x6d\x91\xba\x27\x3a\x7b\xdb\xdf\xe5\xdf\x3d\x63\xdd\x87\x07\x46\x57\x18\xa3\xad\x77\x23\xad\x12\x2e\xfb\xc3\x1d\x0e\xd0\xbf\xb1\x31\xde\xcf\xfc\xef\xeb\xea\x8e\x24\x2e\x48\xba\xa8\xf9\x7f\x14\xf7\xe4\x5e\x78\x81\xea\xfb\x62\x78\x4f\x8b\xa1\xb1\xbc\xba\x16\xa6\x07\x98\x7f\x7e\x29\xb4\x96\xbf\xb7\x12\xf6\x59\xfe\x17\x0b\xe1\xd4\x57\x88\xf7\xb3\x28\x76\xaa\x8e\xe8\x4f\xee\x83\x67\x1e\xdf\xb7\xc1\x7f\xb4\x0d\xfe\x1c\x00\x0a\x2a\x29\x62\xa1\x0e\x00\x00"),
 		},
 		"/servicemanagement.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "servicemanagement.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 844,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x73\xd3\x30\x10\x85\xef\xfa\x15\x6f\xe2\x0b\xcc\xa4\x4e\x93\x63\x38\x85\xb4\x50\x0f\xc1\x99\xa9\x53\x3a\x3d\x6e\xe4\x8d\xbd\x83\x2d\x09\x49\x8e\x9b\x7f\xcf\xd8\x49\x80\x0e\xa0\xa3\xf6\xe9\xbd\x6f\x77\x95\x60\x6d\xdd\xc9\x4b\x55\x47\x2c\x6e\x17\x0b\x7c\xb6\xb6\x6a\x18\x9b\xcd\x5a\x25\x2a\xc1\x46\x34\x9b\xc0\x25\x3a\x53\xb2\x47\xac\x19\x2b\x47\xba\xe6\x6b\x65\x8a\x6f\xec\x83\x58\x83\x45\x7a\x8b\x77\x83\x60\x72\x29\x4d\xde\x7f\x50\x09\x4e\xb6\x43\x4b\x27\x18\x1b\xd1\x05\x46\xac\x25\xe0\x20\x0d\x83\x5f\x35\xbb\x08\x31\xd0\xb6\x75\x8d\x90\xd1\x8c\x5e\x62\x3d\xc6\x5c\x4c\x52\x95\xe0\xe5\x62\x61\xf7\x91\xc4\x80\xa0\xad\x3b\xc1\x1e\xfe\xd4\x81\xe2\x08\x3c\x9e\x3a\x46\xb7\x9c\xcd\xfa\xbe\x4f\x69\xa4\x4d\xad\xaf\x66\xcd\x59\x19\x66\x9b\x6c\x7d\x9f\x17\xf7\x37\x8b\xf4\x76\x7c\xf3\x64\x1a\x0e\x01\x9e\x7f\x74\xe2\xb9\xc4\xfe\x04\x72\xae\x11\x4d\xfb\x86\xd1\x50\x0f\xeb\x41\x95\x67\x2e\x11\xed\x00\xdc\x7b\x89\x62\xaa\x29\x82\x3d\xc4\x9e\x3c\xab\x04\xa5\x84\xe8\x65\xdf\xc5\x37\xd3\xba\xe2\x49\x78\x23\xb0\x06\x64\x30\x59\x15\xc8\x8a\x09\x3e\xae\x8a\xac\x98\xaa\x04\xcf\xd9\xee\x61\xfb\xb4\xc3\xf3\xea\xf1\x71\x95\xef\xb2\xfb\x02\xdb\x47\xac\xb7\xf9\x5d\xb6\xcb\xb6\x79\x81\xed\x27\xac\xf2\x17\x7c\xc9\xf2\xbb\x29\x58\x62\xcd\x1e\xfc\xea\xfc\xc0\x6f\x3d\x64\x98\x23\x97\xc3\xd0\x0a\xe6\x37\x00\x07\x7b\x06\x0a\x8e\xb5\x1c\x44\xa3\x21\x53\x75\x54\x31\x2a\x7b\x64\x6f\xc4\x54\x70\xec\x5b\x09\xc3\x36\x03\xc8\x94\x2a\x41\x23\xad\x44\x8a\xe3\xcd\x5f\x4d\xa5\x4a\x91\x93\xcb\xfe\x97\xd0\xd6\x73\xaa\x8d\x6f\x53\xdd\xd8\xae\x4c\xab\xf1\x2b\xa5\xda\xb6\xb3\xe3\x9c\x1a\x57\xd3\x5c\x7d\x17\x53\x2e\x51\xb0\x3f\x8a\xe6\xaf\xe4\x9c\x98\x4a\xb5\x1c\xa9\xa4\x48\x4b\x05\x18\x6a\x79\x89\x70\x16\xb4\x64\xa8\xe2\x96\x4d\xfc\xb7\xef\x45\x1f\x1c\x69\x5e\x62\x90\xdc\x84\x53\x88\xdc\xaa\xa1\xcb\xdf\x76\xbf\xf2\xae\x76\x0a\x38\x5e\xb1\x8f\xf3\x3d\x47\x9a\x2b\x5c\x53\x1f\x6c\x88\xf9\x7f\x30\xce\xd9\xe4\x24\x5c\xf2\x3d\x07\xdb\x79\xcd\x61\xa9\x7e\x06\x00\x00\xff\xff\x91\x02\xa4\x0d\x4c\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcf\x6e\xdb\x3c\x10\xc4\xef\x7c\x8a\x81\x75\xf9\x3e\xc0\x91\x63\x1f\xd5\x93\xeb\xa4\x8d\x50\x57\x06\x22\xa7\x41\x8e\x6b\x6a\x2d\x2d\x2a\x91\x2c\x49\x59\xf1\xdb\x17\xf2\x9f\xa6\x41\x5b\x1e\xb9\xc3\x99\xdf\xee\x32\xc1\xca\xba\xa3\x97\xba\x89\x58\xdc\x2e\x16\xf8\x6c\x6d\xdd\x32\xd6\xeb\x95\x4a\x54\x82\xb5\x68\x36\x81\x2b\xf4\xa6\x62\x8f\xd8\x30\x96\x8e\x74\xc3\xd7\xca\x14\xdf\xd8\x07\xb1\x06\x8b\xf4\x16\xff\x8d\x82\xc9\xa5\x34\xf9\xff\x83\x4a\x70\xb4\x3d\x3a\x3a\xc2\xd8\x88\x3e\x30\x62\x23\x01\x7b\x69\x19\xfc\xaa\xd9\x45\x88\x81\xb6\x9d\x6b\x85\x8c\x66\x0c\x12\x1b\xc4\x37\xff\x54\x25\x78\xb9\x58\xd8\x5d\x24\x31\x20\x68\xeb\x8e\xb0\xfb\xdf\x75\xa0\x78\x02\x3e\x9d\x26\x46\x97\xcd\x66\xc3\x30\xa4\x74\xa2\x4d\xad\xaf\x67\xed\x59\x19\x66\xeb\x7c\x75\x5f\x94\xf7\x37\x8b\xf4\xf6\xf4\xe6\xc9\xb4\x1c\x02\x3c\xff\xe8\xc5\x73\x85\xdd\x11\xe4\x5c\x2b\x9a\x76\x2d\xa3\xa5\x01\xd6\x83\x6a\xcf\x5c\x21\xda\x11\x78\xf0\x12\xc5\xd4\x53\x04\xbb\x8f\x03\x79\x56\x09\x2a\x09\xd1\xcb\xae\x8f\xef\xa6\x75\xc5\x93\xf0\x4e\x60\x0d\xc8\x60\xb2\x2c\x91\x97\x13\x7c\x5c\x96\x79\x39\x55\x09\x9e\xf3\xed\xc3\xe6\x69\x8b\xe7\xe5\xe3\xe3\xb2\xd8\xe6\xf7\x25\x36\x8f\x58\x6d\x8a\xbb\x7c\x9b\x6f\x8a\x12\x9b\x4f\x58\x16\x2f\xf8\x92\x17\x77\x53\xb0\xc4\x86\x3d\xf8\xd5\xf9\x91\xdf\x7a\xc8\x38\x47\xae\xc6\xa1\x95\xcc\xef\x00\xf6\xf6\xbc\xbe\xe0\x58\xcb\x5e\x34\x5a\x32\x75\x4f\x35\xa3\xb6\x07\xf6\x46\x4c\x0d\xc7\xbe\x93\x30\x6e\x33\x80\x4c\xa5\x12\xb4\xd2\x49\xa4\x78\xba\xf9\xa3\xa9\x54\x29\x72\x72\xd9\x7f\x06\x6d\x3d\xa7\xda\xf8\x2e\xd5\xad\xed\xab\xb4\x3e\x7d\xa5\x54\xdb\x6e\x76\x98\x53\xeb\x1a\x9a\xab\xef\x62\xaa\x0c\x25\xfb\x83\x68\xfe\x4a\xce\x89\xa9\x55\xc7\x91\x2a\x8a\x94\x29\xc0\x50\xc7\x19\xc2\x59\xd0\x91\xa1\x9a\x3b\x36\xf1\xef\xbe\x17\x7d\x70\xa4\x39\xc3\x28\xb9\x09\xc7\x10\xb9\x53\x63\x97\x6f\x76\xbf\xf2\xae\x76\x0a\x38\x5c\xb1\x0f\xf3\x1d\x47\x9a\x2b\x5c\x53\x1f\x6c\x88\xc5\x3f\x30\xce\xd9\xe4\x24\x5c\xf2\x3d\x07\xdb\x7b\xcd\x21\x53\x3f\x07\x00\x91\x02\xa4\x0d\x4c\x03\x00\x00"),
 		},
 		"/serviceusage.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "serviceusage.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1641,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x41\x6f\xe3\x36\x13\xbd\xeb\x57\x3c\xd8\x97\xef\x03\x12\x39\xc9\x51\x3d\x79\x9d\xec\xae\xd0\xc4\x6e\x6d\xa7\x8b\x3d\x05\x63\x6a\x2c\xb1\x91\x48\x2e\x39\xb2\xd7\xd8\xfa\xbf\x17\x94\xe5\xd4\x5e\x37\x40\x75\x22\x86\x6f\xde\x7b\x7c\x43\x6a\x88\x89\x75\x3b\xaf\xcb\x4a\x70\x77\x73\x77\x87\x4f\xd6\x96\x35\xe3\xf1\x71\x92\x0c\x93\x21\x1e\xb5\x62\x13\xb8\x40\x6b\x0a\xf6\x90\x8a\x31\x76\xa4\x2a\x3e\xee\x5c\xe1\x0f\xf6\x41\x5b\x83\xbb\xf4\x06\xff\x8b\x80\x41\xbf\x35\xf8\xff\x2f\xc9\x10\x3b\xdb\xa2\xa1\x1d\x8c\x15\xb4\x81\x21\x95\x0e\x58\xeb\x9a\xc1\xdf\x15\x3b\x81\x36\x50\xb6\x71\xb5\x26\xa3\x18\x5b\x2d\x55\x27\xd3\x93\xa4\xc9\x10\x5f\x7b\x0a\xbb\x12\xd2\x06\x04\x65\xdd\x0e\x76\x7d\x8a\x03\x49\x67\xb8\xfb\x2a\x11\x97\x8d\x46\xdb\xed\x36\xa5\xce\x6d\x6a\x7d\x39\xaa\x0f\xc8\x30\x7a\xcc\x27\x0f\xd3\xc5\xc3\xf5\x5d\x7a\xd3\xf5\x3c\x9b\x9a\x43\x80\xe7\x6f\xad\xf6\x5c\x60\xb5\x03\x39\x57\x6b\x45\xab\x9a\x51\xd3\x16\xd6\x83\x4a\xcf\x5c\x40\x6c\x34\xbc\xf5\x5a\xb4\x29\xaf\x10\xec\x5a\xb6\xe4\x39\x19\xa2\xd0\x41\xbc\x5e\xb5\x72\x96\xd6\xd1\x9e\x0e\x67\x00\x6b\x40\x06\x83\xf1\x02\xf9\x62\x80\x0f\xe3\x45\xbe\xb8\x4a\x86\xf8\x92\x2f\x3f\xcf\x9e\x97\xf8\x32\x9e\xcf\xc7\xd3\x65\xfe\xb0\xc0\x6c\x8e\xc9\x6c\x7a\x9f\x2f\xf3\xd9\x74\x81\xd9\x47\x8c\xa7\x5f\xf1\x6b\x3e\xbd\xbf\x02\x6b\xa9\xd8\x83\xbf\x3b\x1f\xfd\x5b\x0f\x1d\x73\xe4\x22\x86\xb6\x60\x3e\x33\xb0\xb6\x07\x43\xc1\xb1\xd2\x6b\xad\x50\x93\x29\x5b\x2a\x19\xa5\xdd\xb0\x37\xda\x94\x70\xec\x1b\x1d\xe2\x34\x03\xc8\x14\xc9\x10\xb5\x6e\xb4\x90\x74\x95\x8b\x43\xa5\x49\x42\x4e\xf7\xf3\xcf\xa0\xac\xe7\x54\x19\xdf\xa4\xaa\xb6\x6d\x91\x96\xdd\x55\x4a\x95\x6d\x46\x9b\x5b\xaa\x5d\x45\xb7\xc9\xab\x36\x45\x86\x05\xfb\x8d\x56\xfc\x44\xce\x69\x53\x26\x0d\x0b\x15\x24\x94\x25\x80\xa1\x86\x33\x84\x03\xa0\x0d\x54\xbe\x43\xd9\x43\x83\x23\xc5\x19\x22\xe4\x3a\xec\x82\x70\x93\xc4\x03\xfe\xc3\xd4\x4b\x3d\x47\xa6\x04\xd8\x1c\xcd\x6e\x6e\x57\x2c\x74\x9b\xe0\xa8\xf5\xd9\x06\x99\x5e\x8a\x1f\x14\xc9\xe9\xd0\xab\x7a\x0e\xb6\xf5\x8a\x43\xd4\x00\xae\x7b\x9d\x03\xee\xa5\xef\x7d\xe9\x9a\x5f\x94\x35\xa1\x6d\xd8\xbf\x7c\x6b\xad\xd0\x4b\xcc\xd9\xeb\x82\x93\xc3\x2d\x3d\xcb\xa2\x33\x38\xe9\xf1\xbf\x47\xf8\xec\x1c\x4d\xad\xd8\x4f\x6c\xd8\x93\x70\x91\x41\x7c\x7b\xdc\xd1\xc5\x92\x1b\x57\x93\x70\x86\x81\xf3\xf6\x4f\x56\x12\x46\x3f\x7e\xf4\xcb\xfd\x7e\xd4\xbb\x8a\xc5\x7e\xb9\xdf\x8f\xd4\xa9\xd8\x13\x8b\xd7\x2a\x02\x9a\x6e\xb5\xdf\x8f\xba\xd1\xc7\x4a\xb7\x38\x69\x38\x1a\x8b\x7b\xf1\xf0\xfb\xfd\xe0\xc2\xc9\x84\xcc\x07\x7e\x0e\x5c\x2c\xed\x13\x89\xaa\xe6\x7d\x6a\x87\x84\xd7\x54\x87\xa3\xfb\x63\x9e\xe3\x0d\xe9\x3a\x3e\xb8\xdc\x8c\x43\x60\xc9\xcd\x86\x8d\x58\xbf\x3b\x87\x47\xff\xec\xdf\x82\xc8\xef\x3f\x6a\xae\x8b\xac\x9b\xc2\x4f\x8c\xf9\x7d\xd6\x57\x00\x21\x5f\xb2\x5c\x62\x2b\xcd\x9e\xbc\xaa\xb4\xa2\x7a\xce\x6b\xf6\x6c\xde\x46\x7b\x18\xaf\xec\x1c\x67\xe8\xb3\x7c\xab\x03\xaf\xbc\x7b\x2b\xcf\x79\xfd\x93\xf6\x3b\x5c\xeb\xde\xc1\x7f\xa5\x8b\x5f\xc1\x41\x79\xed\xa4\xbb\xb6\x7f\x5d\x9f\xec\x00\xcb\x8a\x8f\x4d\x90\x8a\xe4\xf0\x77\x3d\x9a\xc0\x8a\x6b\x6b\xca\x00\xb1\xe9\x49\x5b\xb9\x79\xcd\xce\x58\x0e\x17\xf1\xb7\x0b\x4f\xff\xfa\x5c\x4e\x78\xbc\x6d\x5d\xf6\xa6\xd6\x90\xa1\x92\xfd\x3b\xcf\xf5\xef\x00\x00\x00\xff\xff\xef\x46\x8f\x78\x69\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe3\x36\x10\xc5\xef\xfa\x14\x0f\xf6\xa5\x05\x12\x79\x93\xa3\x7a\xf2\x3a\xfb\x47\x68\x62\xb7\xb6\xd3\xc5\x9e\x82\x31\x35\x96\xd8\x48\x24\x97\x1c\xd9\x6b\x6c\xfd\xdd\x0b\xca\x52\x12\x6f\x1a\xa0\x96\x0f\xc4\xf0\xcd\xcc\x8f\x6f\x44\x8d\x31\xb3\xee\xe0\x75\x59\x09\xae\xdf\x5d\x5f\xe3\x93\xb5\x65\xcd\xb8\xbd\x9d\x25\xe3\x64\x8c\x5b\xad\xd8\x04\x2e\xd0\x9a\x82\x3d\xa4\x62\x4c\x1d\xa9\x8a\x87\x9d\x0b\xfc\xc5\x3e\x68\x6b\x70\x9d\xbe\xc3\x2f\x51\x30\xea\xb7\x46\xbf\xfe\x96\x8c\x71\xb0\x2d\x1a\x3a\xc0\x58\x41\x1b\x18\x52\xe9\x80\xad\xae\x19\xfc\x5d\xb1\x13\x68\x03\x65\x1b\x57\x6b\x32\x8a\xb1\xd7\x52\x41\x9e\xeb\xa7\xc9\x18\x5f\xfb\x12\x76\x23\xa4\x0d\x08\xca\xba\x03\xec\xf6\xa5\x0e\x24\x1d\x70\xf7\xab\x44\x5c\x36\x99\xec\xf7\xfb\x94\x3a\xda\xd4\xfa\x72\x52\x9f\x94\x61\x72\x9b\xcf\x3e\xcc\x57\x1f\x2e\xaf\xd3\x77\x5d\xce\xbd\xa9\x39\x04\x78\xfe\xd6\x6a\xcf\x05\x36\x07\x90\x73\xb5\x56\xb4\xa9\x19\x35\xed\x61\x3d\xa8\xf4\xcc\x05\xc4\x46\xe0\xbd\xd7\xa2\x4d\x79\x81\x60\xb7\xb2\x27\xcf\xc9\x18\x85\x0e\xe2\xf5\xa6\x95\x33\xb7\x06\x3c\x1d\xce\x04\xd6\x80\x0c\x46\xd3\x15\xf2\xd5\x08\xef\xa7\xab\x7c\x75\x91\x8c\xf1\x25\x5f\x7f\x5e\xdc\xaf\xf1\x65\xba\x5c\x4e\xe7\xeb\xfc\xc3\x0a\x8b\x25\x66\x8b\xf9\x4d\xbe\xce\x17\xf3\x15\x16\x1f\x31\x9d\x7f\xc5\xef\xf9\xfc\xe6\x02\xac\xa5\x62\x0f\xfe\xee\x7c\xe4\xb7\x1e\x3a\xfa\xc8\x45\x34\x6d\xc5\x7c\x06\xb0\xb5\xa7\xf1\x05\xc7\x4a\x6f\xb5\x42\x4d\xa6\x6c\xa9\x64\x94\x76\xc7\xde\x68\x53\xc2\xb1\x6f\x74\x88\xd3\x0c\x20\x53\x24\x63\xd4\xba\xd1\x42\xd2\x45\x5e\x1d\x2a\x4d\x12\x72\xba\x9f\x7f\x06\x65\x3d\xa7\xca\xf8\x26\x55\xb5\x6d\x8b\xb4\xec\x5e\xa5\x54\xd9\x66\xb2\xbb\xa2\xda\x55\x74\x95\x3c\x6a\x53\x64\x58\xb1\xdf\x69\xc5\x77\xe4\x9c\x36\x65\xd2\xb0\x50\x41\x42\x59\x02\x18\x6a\x38\x43\x38\x09\xda\x40\xe5\x1b\x25\x7b\x69\x70\xa4\x38\x43\x94\x5c\x86\x43\x10\x6e\x92\x78\xc0\xe7\x4a\x7d\xab\xfb\x58\x29\x01\x76\x03\xec\xee\x6a\xc3\x42\x57\x09\x86\x5e\x9f\x6d\x90\xf9\xeb\xe6\xa7\x43\x90\xd3\xa1\xef\xea\x39\xd8\xd6\x2b\x0e\xb1\x07\x70\xd9\xf7\x39\xe9\x1e\xfa\xdc\x87\x2e\xf9\x41\x59\x13\xda\x86\xfd\xc3\xb7\xd6\x0a\x3d\x44\x9f\xbd\x2e\xb8\x4b\x04\xce\xbc\xe8\x00\x67\xbd\xfe\xcf\x28\x5f\x9c\xab\xa9\x15\xfb\x89\x0d\x7b\x12\x2e\x32\x88\x6f\x87\x1d\x5d\xac\xb9\x71\x35\x09\x67\x18\x39\x6f\xff\x66\x25\x61\xf2\xe3\x47\xbf\x3c\x1e\x27\x3d\x55\x0c\xf6\xcb\xe3\x71\x32\xc0\x75\xcd\xee\x58\xbc\x56\x51\xd0\x74\xab\xe3\x71\xd2\x8d\x3e\x46\xba\xc5\x8b\x84\x01\x2c\xee\xc5\xc3\x1f\x8f\xa3\x57\x24\x33\x32\xef\xf9\x3e\x70\xb1\xb6\x77\x24\xaa\x5a\xf6\xae\x9d\x1c\xde\x52\x1d\x06\xfa\xc1\xcf\xe9\x8e\x74\x1d\x2f\x5c\x6e\xa6\x21\xb0\xe4\x66\xc7\x46\xac\x3f\x9c\xcb\x23\x3f\xfb\x27\x23\xf2\x9b\x8f\x9a\xeb\x22\xeb\xa6\xf0\x53\xc5\xfc\x26\xeb\x23\x80\x90\x2f\x59\x5e\x6b\x2b\xcd\x9e\xbc\xaa\xb4\xa2\x7a\xc9\x5b\xf6\x6c\x9e\x46\x1b\xff\x97\x90\x83\xe3\x0c\xbd\x97\x4f\x71\xe0\x91\x0f\x4f\xe1\x25\x6f\x7f\xea\xfd\x46\xad\x6d\x4f\xf0\x7f\xcb\xc5\xa7\xe0\xa0\xbc\x76\xf1\x0e\x66\xf8\xe7\xf2\xc5\x0e\xb0\xae\x78\x48\x82\x54\x24\xa7\xaf\xeb\x00\x81\x0d\xd7\xd6\x94\x01\x62\xd3\x17\x69\xe5\xee\xf1\x19\xea\xf9\x45\xfc\xe3\x15\xd3\x7f\x5e\x97\xe7\xa7\xf4\xb6\x75\xd9\x53\xb7\x86\x0c\x95\xec\xdf\xb8\xae\xff\x0e\x00\xef\x46\x8f\x78\x69\x06\x00\x00"),
 		},
 		"/sourcerepo.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "sourcerepo.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1780,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\x0c\xec\xcb\x2e\x90\xc8\x49\x8e\xda\x93\xeb\xa4\xad\xb0\x89\xbd\xb0\x9c\x2d\x7a\x0a\xc6\xd4\x48\x9a\x8d\x44\x72\xc9\x91\x5d\x21\x9b\xff\xbe\xd0\x97\x3f\x92\x16\x2d\x0f\x86\x41\xbe\x79\xef\xcd\xe3\x50\x53\x58\x18\xdb\x38\xce\x0b\x81\x9b\xab\x9b\x1b\xf8\x64\x4c\x5e\x12\xdc\xdf\x2f\x82\x69\x30\x85\x7b\x56\xa4\x3d\xa5\x50\xeb\x94\x1c\x48\x41\x30\xb7\xa8\x0a\x1a\x4f\x2e\xe0\x6f\x72\x9e\x8d\x86\x9b\xf0\x0a\x7e\x6b\x01\x93\xe1\x68\xf2\xfb\x1f\xc1\x14\x1a\x53\x43\x85\x0d\x68\x23\x50\x7b\x02\x29\xd8\x43\xc6\x25\x01\x7d\x53\x64\x05\x58\x83\x32\x95\x2d\x19\xb5\x22\xd8\xb3\x14\x9d\xcc\x40\x12\x06\x53\xf8\x3a\x50\x98\xad\x20\x6b\x40\x50\xc6\x36\x60\xb2\x53\x1c\xa0\x74\x86\xbb\x55\x88\xd8\x68\x36\xdb\xef\xf7\x21\x76\x6e\x43\xe3\xf2\x59\xd9\x23\xfd\xec\x3e\x5e\xdc\x2d\x93\xbb\xcb\x9b\xf0\xaa\xab\x79\xd4\x25\x79\x0f\x8e\xfe\xad\xd9\x51\x0a\xdb\x06\xd0\xda\x92\x15\x6e\x4b\x82\x12\xf7\x60\x1c\x60\xee\x88\x52\x10\xd3\x1a\xde\x3b\x16\xd6\xf9\x05\x78\x93\xc9\x1e\x1d\x05\x53\x48\xd9\x8b\xe3\x6d\x2d\x67\x69\x8d\xf6\xd8\x9f\x01\x8c\x06\xd4\x30\x99\x27\x10\x27\x13\xf8\x30\x4f\xe2\xe4\x22\x98\xc2\x97\x78\xf3\x79\xf5\xb8\x81\x2f\xf3\xf5\x7a\xbe\xdc\xc4\x77\x09\xac\xd6\xb0\x58\x2d\x6f\xe3\x4d\xbc\x5a\x26\xb0\xfa\x08\xf3\xe5\x57\xf8\x33\x5e\xde\x5e\x00\xb1\x14\xe4\x80\xbe\x59\xd7\xfa\x37\x0e\xb8\xcd\x91\xd2\x36\xb4\x84\xe8\xcc\x40\x66\x7a\x43\xde\x92\xe2\x8c\x15\x94\xa8\xf3\x1a\x73\x82\xdc\xec\xc8\x69\xd6\x39\x58\x72\x15\xfb\xf6\x36\x3d\xa0\x4e\x83\x29\x94\x5c\xb1\xa0\x74\x3b\xef\x9a\x0a\x83\x00\x2d\x0f\xf7\x1f\x81\x32\x8e\x42\xa5\x5d\x15\xaa\xd2\xd4\x69\x98\x77\xa3\x14\x2a\x53\xcd\x76\xd7\x58\xda\x02\xaf\x83\x67\xd6\x69\x04\x09\xb9\x1d\x2b\x7a\x40\x6b\x59\xe7\x41\x45\x82\x29\x0a\x46\x01\x80\xc6\x8a\x22\xf0\xa6\x76\x8a\x1c\x59\xf3\x7d\xc2\x01\xe8\x2d\x2a\x8a\xa0\x85\x5c\xfa\xc6\x0b\x55\x41\xdb\xde\x91\x27\xe9\x78\xd6\x64\x4d\x00\xb0\x1b\x8d\xee\xae\xb7\x24\x78\x1d\x00\xf8\xde\xc8\x67\xe3\x65\xf9\x56\xb8\x57\x43\xcb\x7e\x50\x74\xd4\x9f\xfa\x96\x1f\xe0\x72\xd0\xe8\x71\x4f\xc7\xca\xa7\xf6\xc7\xb3\x18\xd7\x04\xfd\x3c\x0e\x5d\x1f\xcc\xac\xdf\x02\xb0\x16\xf3\x89\x34\x39\x14\x4a\x23\x10\x57\xd3\x70\xc2\x58\x2d\x8c\xce\x38\x8f\x86\x0d\x00\x6b\x4a\x56\xcd\xf2\xa7\xe2\x4f\x8c\xd5\x53\x0f\x7e\x53\xfb\x40\xd5\x96\xdc\x2f\x32\x54\x1d\xf8\xc0\xe0\x28\x23\x47\x5a\xd1\x47\xa6\x32\x3d\xba\x1a\x23\x7f\xd7\x7c\xbb\xa4\xb1\x14\x75\x80\xc3\xa6\xaf\xad\x35\x4e\xfc\xc2\xe8\x94\xbb\x01\x8b\x20\xc3\xd2\x1f\x1a\x4f\x37\x54\xd9\x12\x85\x22\x98\x58\x67\xfe\x21\x25\x7e\xf6\xf2\x32\xfc\x7d\x7d\x9d\x75\x4a\xb3\x97\x97\x96\xf6\xf5\x75\xf2\xae\x6e\x81\xfa\x03\x3d\x7a\x4a\x37\xe6\x01\x45\x15\xeb\xe1\xfe\xfa\xbe\x4f\xb5\xc6\x9b\x9d\xef\x90\xcb\xf6\xd1\xc7\x7a\xee\x3d\x49\xac\x77\xa4\xdb\x56\xce\xe1\xe3\xbc\x0e\xf3\x7b\xcc\xa0\x4f\xe0\xa4\xcd\x91\x38\xbe\x3d\x82\x04\x5d\x4e\xd2\xa7\x77\x8a\x2d\x98\x1c\x3a\x55\xb0\xc2\x72\x3d\x86\xec\x8f\x75\x97\x43\x8a\x43\x00\x27\xe9\x3e\x53\x73\xd8\x5e\x53\xf6\x46\xfb\x07\x5c\xd9\xe0\xe0\x57\xe9\xda\x95\x92\x57\x8e\xad\x74\xaf\xe8\xbf\xcb\x93\x13\x80\x4d\x41\x63\x11\x48\x81\xd2\x7f\xe8\x47\x13\xb0\xa5\xd2\xe8\xdc\x83\x98\xf0\xa4\x2c\xdf\x3d\x47\x67\x2c\xfd\x4b\xf9\xeb\x9d\xa7\xef\xbe\xde\x13\x1e\x67\x6a\x1b\x1d\xd4\x2a\xd4\x98\x93\xfb\xc1\xb7\xe3\xff\x00\x00\x00\xff\xff\xb8\x76\x13\x91\xf4\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xe2\x48\x10\xbd\xfb\x2b\x9e\xe0\xb2\x2b\x25\x26\xc9\xd1\x7b\x62\x48\x66\xc6\xda\x84\xac\x80\xec\x68\x4e\x51\xd1\x2e\xec\xda\xd8\xdd\xbd\xdd\x6d\x18\x94\xcd\xbf\xaf\x6c\x6c\x4c\x92\x19\xcd\x60\x84\x50\xd7\xab\x57\xaf\x5e\x55\x7b\x8c\x99\xb1\x7b\x27\x79\x11\x70\x75\x71\x75\x85\x4f\xc6\xe4\x25\xe3\xf6\x76\x16\x8d\xa3\x31\x6e\x45\xb1\xf6\x9c\xa1\xd6\x19\x3b\x84\x82\x31\xb5\xa4\x0a\xee\x23\x67\xf8\x9b\x9d\x17\xa3\x71\x15\x5f\xe0\xb7\x06\x30\xea\x42\xa3\xdf\xff\x88\xc6\xd8\x9b\x1a\x15\xed\xa1\x4d\x40\xed\x19\xa1\x10\x8f\x8d\x94\x0c\xfe\xa6\xd8\x06\x88\x86\x32\x95\x2d\x85\xb4\x62\xec\x24\x14\x08\x03\x7f\x1c\x8d\xf1\xb5\xa3\x30\xeb\x40\xa2\x41\x50\xc6\xee\x61\x36\xa7\x38\x50\x68\x05\xb7\x9f\x22\x04\x9b\x4c\x26\xbb\xdd\x2e\xa6\x56\x6d\x6c\x5c\x3e\x29\x0f\x48\x3f\xb9\x4d\x67\x37\xf3\xe5\xcd\xf9\x55\x7c\xd1\xe6\x3c\xe8\x92\xbd\x87\xe3\x7f\x6b\x71\x9c\x61\xbd\x07\x59\x5b\x8a\xa2\x75\xc9\x28\x69\x07\xe3\x40\xb9\x63\xce\x10\x4c\x23\x78\xe7\x24\x88\xce\xcf\xe0\xcd\x26\xec\xc8\x71\x34\x46\x26\x3e\x38\x59\xd7\xe1\x95\x5b\xbd\x3c\xf1\xaf\x00\x46\x83\x34\x46\xd3\x25\xd2\xe5\x08\x1f\xa6\xcb\x74\x79\x16\x8d\xf1\x25\x5d\x7d\xbe\x7f\x58\xe1\xcb\x74\xb1\x98\xce\x57\xe9\xcd\x12\xf7\x0b\xcc\xee\xe7\xd7\xe9\x2a\xbd\x9f\x2f\x71\xff\x11\xd3\xf9\x57\xfc\x99\xce\xaf\xcf\xc0\x12\x0a\x76\xe0\x6f\xd6\x35\xfa\x8d\x83\x34\x3e\x72\xd6\x98\xb6\x64\x7e\x25\x60\x63\x0e\xe3\xf3\x96\x95\x6c\x44\xa1\x24\x9d\xd7\x94\x33\x72\xb3\x65\xa7\x45\xe7\xb0\xec\x2a\xf1\xcd\x34\x3d\x48\x67\xd1\x18\xa5\x54\x12\x28\xb4\x27\xef\x9a\x8a\xa3\x88\xac\x74\xf3\x4f\xa0\x8c\xe3\x58\x69\x57\xc5\xaa\x34\x75\x16\xe7\xed\x2a\xc5\xca\x54\x93\xed\x25\x95\xb6\xa0\xcb\xe8\x49\x74\x96\x60\xc9\x6e\x2b\x8a\xef\xc8\x5a\xd1\x79\x54\x71\xa0\x8c\x02\x25\x11\xa0\xa9\xe2\x04\xde\xd4\x4e\xb1\x63\x6b\xbe\x4f\xd8\x01\xbd\x25\xc5\x09\x1a\xc8\xb9\xdf\xfb\xc0\x55\xd4\xb4\x37\xf0\x2c\x5b\x9e\x05\x5b\x13\x01\xdb\x5e\xe8\xf6\x72\xcd\x81\x2e\x23\xc0\x1f\x84\x7c\x36\x3e\xcc\xdf\x16\x3e\x54\x23\x2b\xbe\xab\xe8\xf8\x20\xcb\x37\xfc\xc0\x79\x57\xe3\x80\x7b\x1c\x32\x1f\x9b\x1f\x2f\xc1\xb8\x7d\x0b\x04\xba\xae\x8f\x62\x16\x6f\x01\x54\x07\xf3\x89\x35\x3b\x0a\x9c\x25\x08\xae\xe6\x2e\x22\x54\xcd\x8c\xde\x48\x9e\x74\x07\x80\x35\xa5\xa8\xfd\xfc\xa7\xc5\x1f\x85\xaa\xc7\x03\xf8\x4d\xee\x1d\x57\x6b\x76\xbf\xc8\x50\xb5\xe0\x23\x83\xe3\x0d\x3b\xd6\x8a\x3f\x0a\x97\xd9\xa0\xaa\xb7\x7c\xc8\x3e\x09\x85\xbd\xe5\xa4\x05\x1c\x0f\x7d\x6d\xad\x71\xc1\xcf\x8c\xce\xa4\x5d\xb0\x04\x1b\x2a\xfd\xb1\xf1\x6c\xc5\x95\x2d\x29\x70\x82\x91\x75\xe6\x1f\x56\xc1\x4f\x9e\x9f\xbb\xbf\x2f\x2f\x93\xb6\xd2\xe4\xf9\xb9\xa1\x7d\x79\x19\xbd\xcb\x9b\x91\xfe\xc0\x0f\x9e\xb3\x95\xb9\xa3\xa0\x8a\x45\x37\xbf\x43\xdf\xa7\xb5\xfa\xc9\x4e\xb7\x24\x65\x73\xe9\x53\x3d\xf5\x9e\x43\xaa\xb7\xac\x9b\x56\x5e\xc3\xfb\x7d\xed\xf6\x77\xf0\xa0\x51\xf2\xaa\xcd\x9e\x38\xbd\x1e\x40\x81\x5c\xce\xe1\xe0\xde\x29\xb6\x10\x76\xe4\x54\x21\x8a\xca\x45\x6f\x72\xb7\x6b\xcd\xf7\xbc\x73\xb1\x33\xe0\x78\x0e\x3c\xf1\xfe\x78\xbc\xe0\xcd\x9b\xda\x3f\xe0\xda\x74\x0a\x7e\x95\xae\x79\x32\xf6\xca\x89\x6d\xa6\x95\xe0\xbf\xf3\x93\x08\xb0\x2a\xb8\x4f\x42\x28\x28\x1c\x5e\xf4\xbd\x08\xac\xb9\x34\x3a\xf7\x08\x26\x3e\x49\xcb\xb7\x4f\x83\xa8\xe1\xa6\xfc\xf5\x4e\xd3\x77\x6f\xef\xf0\xe4\xce\xd4\x36\x39\x56\xab\x48\x53\xce\xee\x07\xef\x8e\xff\x07\x00\xb8\x76\x13\x91\xf4\x06\x00\x00"),
 		},
 		"/spanner.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "spanner.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2709,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\xa9\xd8\x47\xf6\xa4\xc8\x4e\x42\xd4\x91\x0b\x49\x6e\x90\x53\x30\x5a\x0e\xc9\xad\xc9\xdd\xed\xee\x50\x8a\x90\xea\xbf\x17\x14\x49\xc5\xb2\xe3\x24\x05\x5a\xa0\x05\xca\x83\x44\xcc\xc7\x9b\x37\x1f\x8f\x63\xcc\xac\xdb\x79\x5d\x94\x82\xcb\x17\x97\x97\x78\x6d\x6d\x51\x31\x6e\x6e\x66\xd1\x38\x1a\xe3\x46\x2b\x36\x81\x33\x34\x26\x63\x0f\x29\x19\x53\x47\xaa\xe4\xc1\x73\x86\x5f\xd9\x07\x6d\x0d\x2e\xe3\x17\xf8\xa1\x0d\x18\xf5\xae\xd1\x8f\x3f\x45\x63\xec\x6c\x83\x9a\x76\x30\x56\xd0\x04\x86\x94\x3a\x20\xd7\x15\x83\x3f\x2a\x76\x02\x6d\xa0\x6c\xed\x2a\x4d\x46\x31\xb6\x5a\xca\x43\x99\x1e\x24\x8e\xc6\x78\xdf\x43\xd8\xb5\x90\x36\x20\x28\xeb\x76\xb0\xf9\xc3\x38\x90\x1c\x08\x1f\x9e\x52\xc4\x25\x93\xc9\x76\xbb\x8d\xe9\xc0\x36\xb6\xbe\x98\x54\x5d\x64\x98\xdc\xa4\xb3\xeb\xf9\xf2\xfa\xfc\x32\x7e\x71\xc8\xb9\x33\x15\x87\x00\xcf\xbf\x37\xda\x73\x86\xf5\x0e\xe4\x5c\xa5\x15\xad\x2b\x46\x45\x5b\x58\x0f\x2a\x3c\x73\x06\xb1\x2d\xe1\xad\xd7\xa2\x4d\x71\x86\x60\x73\xd9\x92\xe7\x68\x8c\x4c\x07\xf1\x7a\xdd\xc8\xc9\xb4\x06\x7a\x3a\x9c\x04\x58\x03\x32\x18\x4d\x97\x48\x97\x23\xbc\x9c\x2e\xd3\xe5\x59\x34\xc6\xbb\x74\xf5\xe6\xf6\x6e\x85\x77\xd3\xc5\x62\x3a\x5f\xa5\xd7\x4b\xdc\x2e\x30\xbb\x9d\x5f\xa5\xab\xf4\x76\xbe\xc4\xed\x2b\x4c\xe7\xef\xf1\x73\x3a\xbf\x3a\x03\x6b\x29\xd9\x83\x3f\x3a\xdf\xf2\xb7\x1e\xba\x9d\x23\x67\xed\xd0\x96\xcc\x27\x04\x72\xdb\x11\x0a\x8e\x95\xce\xb5\x42\x45\xa6\x68\xa8\x60\x14\x76\xc3\xde\x68\x53\xc0\xb1\xaf\x75\x68\xb7\x19\x40\x26\x8b\xc6\xa8\x74\xad\x85\xe4\x60\x79\xd2\x54\x1c\x45\xe4\x74\xbf\xff\x04\xca\x7a\x8e\x95\xf1\x75\xac\x2a\xdb\x64\x71\x71\x38\xa5\x58\xd9\x7a\xb2\xb9\xa0\xca\x95\x74\x11\xdd\x6b\x93\x25\x58\xb2\xdf\x68\xc5\x6f\xc9\x39\x6d\x8a\xa8\x66\xa1\x8c\x84\x92\x08\x30\x54\x73\x82\xe0\xc8\x18\xf6\x5f\x46\xeb\xa3\x82\x23\xc5\x09\xda\x90\xf3\xb0\x0b\xc2\x75\xd4\xf6\xf6\x19\x64\xd9\x81\x44\xc0\x66\xa0\xb8\xb9\x58\xb3\xd0\x45\x04\x84\x8e\xc2\x1b\x1b\x64\x7e\x52\xb2\xab\x43\x4e\x87\xbe\x96\xe7\x60\x1b\xaf\x38\xb4\xc8\xc0\x79\x8f\xde\xc5\x7d\xe8\xd3\x3e\xb4\xfc\xd7\x14\x38\xea\x2e\xb0\xef\xb3\x73\x5e\x9d\xfa\xa8\x11\xfb\x9a\x0d\x7b\x12\xce\x12\x88\x6f\x06\x8f\xce\x56\x5c\xbb\x8a\x84\x13\x8c\x9c\xb7\xbf\xb1\x92\x30\xf9\xf4\xa9\x7f\xdd\xef\x27\xda\x04\x69\x75\xd2\x5a\x87\xf7\xfd\x7e\x32\x54\x6f\xcd\x2d\xbd\xfd\x7e\xf4\x04\x72\x46\xe6\x25\xdf\x05\xce\x56\xf6\x2d\x89\x2a\x17\x7d\x5f\x5d\xfb\x39\x55\x47\x82\x43\xc7\xd3\x0d\xe9\xaa\xd5\x40\x6a\xa6\x21\xb0\xa4\x66\xc3\x46\xac\xdf\x9d\x86\x0f\xeb\xeb\xd7\x99\xf4\xe6\x61\x0d\xed\xef\x23\xe0\xf4\xea\x73\x90\x90\x2f\x58\x5e\x69\xae\xb2\x93\xd8\x52\xb3\x27\xaf\x4a\xad\xa8\x5a\x70\xce\x9e\xcd\x71\x07\xdd\x1e\x64\xe7\x38\x41\x3f\x9b\xa3\x1d\xb8\xe7\xdd\xd1\xbc\xe0\xfc\x51\xed\x67\xb0\xf2\x9e\xc1\xf7\xc2\xb5\x4f\xc6\x41\x79\xed\xe4\x70\x5a\x7f\x9c\x3f\xf0\x00\xab\x92\x87\x24\x48\x49\xd2\x7d\xf7\x06\x12\x58\x73\x65\x4d\x11\x20\x36\x7e\x90\x56\x6c\xee\x93\x13\x94\xee\x8c\x7e\x79\xc2\xe9\x8b\x27\xfd\x00\xc7\xdb\xc6\x25\xc7\x6a\x35\x19\x2a\xbe\xa2\xa6\x61\x08\x87\x4e\x87\xab\x3a\x6d\xf5\x38\x9f\xc1\xfd\x6d\xd6\xfd\xf1\xa7\x4f\x13\xbe\x8f\xfd\xb7\xbe\x01\x47\x6a\xcf\x1c\x50\xfb\x38\xf2\x6c\xe4\x81\xc6\x9e\x51\xef\xa3\xae\xbe\xd6\xc0\xdf\xa5\xde\x7f\xb1\x4c\x81\x8a\xd6\x5c\x85\xa4\xff\xff\x5f\xbd\xff\x19\xf5\x2a\x6b\x72\x5d\x3c\xa3\xdd\xce\xf9\x97\x95\x3b\x7b\x9c\xf6\x4f\xeb\xf7\xcf\x00\x00\x00\xff\xff\xda\xc9\xdf\xcc\x95\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4f\x6f\xdb\xc6\x13\xbd\xf3\x53\x3c\x48\x97\xdf\x0f\xb0\x29\xdb\x47\xf6\xa4\xc8\x4e\x42\xd4\x91\x0a\x49\x6e\x90\x53\x30\x5a\x8e\xc8\xad\xc9\xdd\xed\xee\x50\x8a\x90\xea\xbb\x17\x94\x48\xc9\x92\xe3\x38\x87\x16\xc8\xa1\x5a\x40\x24\x76\x66\xde\xbc\xf9\xf3\xd8\xc7\xc8\xba\x8d\xd7\x79\x21\xb8\xb9\xba\xb9\xc1\x3b\x6b\xf3\x92\x71\x7f\x3f\x8a\xfa\x51\x1f\xf7\x5a\xb1\x09\x9c\xa1\x36\x19\x7b\x48\xc1\x18\x3a\x52\x05\x77\x96\x0b\xfc\xce\x3e\x68\x6b\x70\x13\x5f\xe1\x7f\x8d\x43\xaf\x35\xf5\xfe\xff\x4b\xd4\xc7\xc6\xd6\xa8\x68\x03\x63\x05\x75\x60\x48\xa1\x03\x96\xba\x64\xf0\x17\xc5\x4e\xa0\x0d\x94\xad\x5c\xa9\xc9\x28\xc6\x5a\x4b\x01\x39\xe2\xc7\x51\x1f\x9f\x5a\x08\xbb\x10\xd2\x06\x04\x65\xdd\x06\x76\xf9\xd4\x0f\x24\x3b\xc2\xbb\x5f\x21\xe2\x92\xc1\x60\xbd\x5e\xc7\xb4\x63\x1b\x5b\x9f\x0f\xca\xbd\x67\x18\xdc\xa7\xa3\xbb\xf1\xec\xee\xf2\x26\xbe\xda\xc5\x3c\x98\x92\x43\x80\xe7\x3f\x6b\xed\x39\xc3\x62\x03\x72\xae\xd4\x8a\x16\x25\xa3\xa4\x35\xac\x07\xe5\x9e\x39\x83\xd8\x86\xf0\xda\x6b\xd1\x26\xbf\x40\xb0\x4b\x59\x93\xe7\xa8\x8f\x4c\x07\xf1\x7a\x51\xcb\x49\xb7\x3a\x7a\x3a\x9c\x38\x58\x03\x32\xe8\x0d\x67\x48\x67\x3d\xbc\x19\xce\xd2\xd9\x45\xd4\xc7\xc7\x74\xfe\x7e\xf2\x30\xc7\xc7\xe1\x74\x3a\x1c\xcf\xd3\xbb\x19\x26\x53\x8c\x26\xe3\xdb\x74\x9e\x4e\xc6\x33\x4c\xde\x62\x38\xfe\x84\x5f\xd3\xf1\xed\x05\x58\x4b\xc1\x1e\xfc\xc5\xf9\x86\xbf\xf5\xd0\x4d\x1f\x39\x6b\x9a\x36\x63\x3e\x21\xb0\xb4\xfb\xf1\x05\xc7\x4a\x2f\xb5\x42\x49\x26\xaf\x29\x67\xe4\x76\xc5\xde\x68\x93\xc3\xb1\xaf\x74\x68\xa6\x19\x40\x26\x8b\xfa\x28\x75\xa5\x85\x64\x77\xf3\xac\xa8\x38\x8a\xc8\xe9\x76\xfe\x09\x94\xf5\x1c\x2b\xe3\xab\x58\x95\xb6\xce\xe2\x7c\xb7\x4a\xb1\xb2\xd5\x60\x75\x4d\xa5\x2b\xe8\x3a\x7a\xd4\x26\x4b\x30\x63\xbf\xd2\x8a\x3f\x90\x73\xda\xe4\x51\xc5\x42\x19\x09\x25\x11\x60\xa8\xe2\x04\xc1\x91\x31\xec\xbf\x8d\xd6\x7a\x05\x47\x8a\x13\x34\x2e\x97\x61\x13\x84\xab\xa8\xa9\xed\x08\x32\xdb\x83\x44\xc0\xaa\xa3\xb8\xba\x5e\xb0\xd0\x75\x04\x84\x3d\x85\xf7\x36\xc8\xf8\x24\xe5\x3e\x0f\x39\x1d\xda\x5c\x9e\x83\xad\xbd\xe2\xd0\x20\x03\x97\x2d\xfa\xde\xef\x73\x1b\xf6\xb9\xe1\xbf\xa0\xc0\x3b\x1f\xa0\xad\x73\x6f\xbc\x3d\xb5\x51\x2d\xf6\x1d\x1b\xf6\x24\x9c\x25\x10\x5f\x77\x16\x9d\xcd\xb9\x72\x25\x09\x27\xe8\x39\x6f\xff\x60\x25\x61\xf0\xf5\x6b\xfb\xba\xdd\x0e\xb4\x09\xd2\xe8\xa4\xb9\xed\xde\xb7\xdb\x41\x97\xbd\xb9\x6e\xe8\x6d\xb7\xbd\x67\x90\x23\x32\x6f\xf8\x21\x70\x36\xb7\x1f\x48\x54\x31\x6d\xeb\xda\x97\xbf\xa4\xf2\x40\xb0\xab\x78\xb8\x22\x5d\x36\x1a\x48\xcd\x30\x04\x96\xd4\xac\xd8\x88\xf5\x9b\x53\xf7\x6e\x7c\xed\x38\x93\xf6\xba\x1b\x43\xf3\x7f\x06\x9c\xde\x1e\x9d\x84\x7c\xce\xf2\x56\x73\x99\x9d\xf8\x16\x9a\x3d\x79\x55\x68\x45\xe5\x94\x97\xec\xd9\x1c\x66\xd0\x9c\x4b\xc8\xc6\x71\x82\xb6\x37\x87\x7b\xe0\x91\x37\x87\xeb\x29\x2f\xcf\x72\xbf\x80\xb5\x6c\x19\xfc\x28\x5c\x73\x32\x0e\xca\x6b\xd7\xa8\x23\xc1\x5f\x97\x4f\x2c\xc0\xbc\xe0\x2e\x08\x52\x90\xec\xbf\x7b\x1d\x09\x2c\xb8\xb4\x26\x0f\x10\x1b\x3f\x09\xcb\x57\x8f\x47\x52\xc7\x35\xfa\xed\x19\xa7\x6f\xae\xf4\xf1\xe4\xde\xd6\x2e\x39\x64\xab\xc8\x50\xfe\x1d\x35\x75\x4d\xd8\x55\xda\x6d\xd5\x69\xa9\x87\xfe\x74\xe6\xd7\x59\xb7\xf2\x4b\x9f\x07\xfc\x18\xfb\xd7\xbe\x01\xaf\x2d\x50\x73\x1c\x79\x36\xf2\x44\x63\x2f\xa8\xf7\xac\xaa\xef\x15\xf0\x4f\xa9\xf7\x27\x96\x29\x50\xd2\x82\xcb\x90\xb4\xcf\xb3\x7c\xff\xa9\xf7\xe7\x55\xaf\xb2\x66\xa9\xf3\x17\xb4\xbb\x37\xbe\xce\xf8\x6c\xf1\x47\xe7\x61\xff\xb6\x7e\xff\x1e\x00\xda\xc9\xdf\xcc\x95\x0a\x00\x00"),
 		},
 		"/sql.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "sql.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2255,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xc1\x8e\xdb\x36\x14\xbc\xeb\x2b\x06\xf6\xa5\x05\x76\xe5\xac\x8f\xea\xc9\xf1\x6e\x12\xa1\x8e\xdd\x58\xde\x06\x39\x2d\x9e\xa9\x67\x89\x5d\x8a\xe4\x92\x94\x1d\x23\xf5\xbf\x17\xb2\x24\xc7\xde\xdd\x00\x2d\xd0\x63\x74\x10\x84\xc7\xe1\xbc\x79\xc3\xa1\x86\x98\x1a\xbb\x77\xb2\x28\x03\xc6\x6f\xc6\x63\xbc\x37\xa6\x50\x8c\xd9\x6c\x1a\x0d\xa3\x21\x66\x52\xb0\xf6\x9c\xa3\xd6\x39\x3b\x84\x92\x31\xb1\x24\x4a\xee\x57\xae\xf0\x27\x3b\x2f\x8d\xc6\x38\x7e\x83\x5f\x1a\xc0\xa0\x5b\x1a\xfc\xfa\x5b\x34\xc4\xde\xd4\xa8\x68\x0f\x6d\x02\x6a\xcf\x08\xa5\xf4\xd8\x48\xc5\xe0\xaf\x82\x6d\x80\xd4\x10\xa6\xb2\x4a\x92\x16\x8c\x9d\x0c\xe5\xb1\x4d\x47\x12\x47\x43\x7c\xe9\x28\xcc\x3a\x90\xd4\x20\x08\x63\xf7\x30\x9b\x73\x1c\x28\x1c\x05\x1f\x9f\x32\x04\x9b\x8c\x46\xbb\xdd\x2e\xa6\xa3\xda\xd8\xb8\x62\xa4\x5a\xa4\x1f\xcd\xd2\xe9\xdd\x3c\xbb\xbb\x1e\xc7\x6f\x8e\x7b\xee\xb5\x62\xef\xe1\xf8\xa9\x96\x8e\x73\xac\xf7\x20\x6b\x95\x14\xb4\x56\x0c\x45\x3b\x18\x07\x2a\x1c\x73\x8e\x60\x1a\xc1\x3b\x27\x83\xd4\xc5\x15\xbc\xd9\x84\x1d\x39\x8e\x86\xc8\xa5\x0f\x4e\xae\xeb\x70\xe1\x56\x2f\x4f\xfa\x0b\x80\xd1\x20\x8d\xc1\x24\x43\x9a\x0d\xf0\x76\x92\xa5\xd9\x55\x34\xc4\xe7\x74\xf5\x61\x71\xbf\xc2\xe7\xc9\x72\x39\x99\xaf\xd2\xbb\x0c\x8b\x25\xa6\x8b\xf9\x6d\xba\x4a\x17\xf3\x0c\x8b\x77\x98\xcc\xbf\xe0\xf7\x74\x7e\x7b\x05\x96\xa1\x64\x07\xfe\x6a\x5d\xa3\xdf\x38\xc8\xc6\x47\xce\x1b\xd3\x32\xe6\x0b\x01\x1b\xd3\x0a\xf2\x96\x85\xdc\x48\x01\x45\xba\xa8\xa9\x60\x14\x66\xcb\x4e\x4b\x5d\xc0\xb2\xab\xa4\x6f\x4e\xd3\x83\x74\x1e\x0d\xa1\x64\x25\x03\x85\x63\xe5\xc5\x50\x71\x14\x91\x95\xdd\xf9\x27\x10\xc6\x71\x2c\xb4\xab\x62\xa1\x4c\x9d\xc7\xc5\x31\x4a\xb1\x30\xd5\x68\x7b\x43\xca\x96\x74\x13\x3d\x4a\x9d\x27\xc8\xd8\x6d\xa5\xe0\x8f\x64\xad\xd4\x45\x54\x71\xa0\x9c\x02\x25\x11\xa0\xa9\xe2\x04\xfe\x49\xbd\xce\xd4\x21\xbc\x25\xc1\x09\x1a\xc8\xb5\xdf\xfb\xc0\x55\xd4\xcc\xf5\x9d\x20\xfb\x34\x8b\x80\x6d\x2f\x6d\x7b\xb3\xe6\x40\x37\x11\xe0\xdb\xd6\x1f\x8c\x0f\xf3\x53\xab\x96\x9f\xac\xf4\x5d\x0f\xc7\xde\xd4\x4e\xb0\x6f\x18\x81\xeb\x8e\xb5\xc5\x3d\xf8\x27\xf5\xd0\xe8\x5d\x93\xe7\xa8\x4d\x5c\x37\xd7\xa7\xd9\xed\x65\x9d\xea\x60\xde\xb3\x66\x47\x81\xf3\x04\xc1\xd5\xfd\x8a\xcc\x57\x5c\x59\x45\x81\x13\x0c\xac\x33\x7f\xb1\x08\x7e\xf4\xed\x5b\xf7\x79\x38\x8c\xa4\xf6\xa1\xb9\x13\x4d\xb5\xff\x3e\x1c\x46\x7d\xe7\xa6\xdc\xc8\x3a\x1c\x06\x2f\x28\xa7\xa4\xdf\xf2\xbd\xe7\x7c\x65\x3e\x52\x10\xe5\xb2\x9b\xa7\x1d\x79\x43\xea\x24\xb0\x9f\x74\xb2\x25\xa9\x9a\xbc\xa7\x7a\xe2\x3d\x87\x54\x6f\x59\x07\xe3\xf6\x97\xf0\xfe\xa8\xba\xa3\x4b\xba\x72\x6f\x7b\xf3\x7e\x46\x9c\xde\x7e\x07\x05\x72\x05\x87\x77\x92\x55\x7e\x81\x2d\x25\x3b\x72\xa2\x94\x82\xd4\x92\x37\xec\x58\x9f\xbc\x6f\xfd\x0f\x7b\xcb\x09\x3a\x6f\x4e\x75\xe0\x91\xf7\xa7\xf2\x92\x37\xcf\x7a\xff\x80\x6b\xd3\x29\xf8\xb7\x74\xcd\x93\xb3\x17\x4e\xda\x70\x8c\xd3\xdf\xd7\x67\x2b\xc0\xaa\xe4\x7e\x13\x42\x49\xa1\xfd\xc7\xf5\x22\xb0\x66\x65\x74\xe1\x11\x4c\x7c\xb6\xad\xd8\x3e\x26\x17\x2c\x6d\x84\xfe\x78\xa1\xe9\xd5\x18\x9f\xf1\x38\x53\xdb\xe4\xd4\xad\x22\x4d\x05\xbb\x1f\xde\x9e\x57\xc3\xdc\x6e\x7d\x70\xdc\xfc\x48\x58\xb7\x17\xfe\xa1\xcf\xdc\xf3\x88\x67\x9d\xb7\xe7\xe0\xf4\x12\xfb\x7f\xc5\xfe\x67\xbe\x7f\xe6\xfb\xbf\xe4\xfb\x9f\x00\x00\x00\xff\xff\x8f\xf4\x32\xc4\xcf\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xc1\x6e\xdb\x46\x10\xbd\xf3\x2b\x1e\xa4\x4b\x0b\xd8\x94\xed\x23\x7b\x52\x6c\x27\x21\xaa\xc8\x8d\x28\x37\xc8\xc9\x18\x2d\x47\xe4\xd6\xcb\xdd\xf5\xee\x52\x8a\x90\xea\xdf\x8b\x95\x48\xc9\xb2\x1d\xa0\x05\x7a\x8c\x08\x08\xc4\xcc\xdb\x37\x6f\x66\xde\x72\x88\x6b\x63\x37\x4e\x56\x75\xc0\xd5\xc5\xd5\x15\x3e\x18\x53\x29\xc6\x64\x72\x9d\x0c\x93\x21\x26\x52\xb0\xf6\x5c\xa2\xd5\x25\x3b\x84\x9a\x31\xb6\x24\x6a\xee\x33\x67\xf8\x93\x9d\x97\x46\xe3\x2a\xbd\xc0\x2f\x11\x30\xe8\x52\x83\x5f\x7f\x4b\x86\xd8\x98\x16\x0d\x6d\xa0\x4d\x40\xeb\x19\xa1\x96\x1e\x4b\xa9\x18\xfc\x4d\xb0\x0d\x90\x1a\xc2\x34\x56\x49\xd2\x82\xb1\x96\xa1\x46\x38\xf2\xa7\xc9\x10\x5f\x3b\x0a\xb3\x08\x24\x35\x08\xc2\xd8\x0d\xcc\xf2\x39\x0e\x14\x76\x82\x77\xbf\x3a\x04\x9b\x8d\x46\xeb\xf5\x3a\xa5\x9d\xda\xd4\xb8\x6a\xa4\xf6\x48\x3f\x9a\xe4\xd7\xb7\xd3\xe2\xf6\xfc\x2a\xbd\xd8\x9d\xb9\xd7\x8a\xbd\x87\xe3\xa7\x56\x3a\x2e\xb1\xd8\x80\xac\x55\x52\xd0\x42\x31\x14\xad\x61\x1c\xa8\x72\xcc\x25\x82\x89\x82\xd7\x4e\x06\xa9\xab\x33\x78\xb3\x0c\x6b\x72\x9c\x0c\x51\x4a\x1f\x9c\x5c\xb4\xe1\x64\x5a\xbd\x3c\xe9\x4f\x00\x46\x83\x34\x06\xe3\x02\x79\x31\xc0\xbb\x71\x91\x17\x67\xc9\x10\x5f\xf2\xf9\xc7\xbb\xfb\x39\xbe\x8c\x67\xb3\xf1\x74\x9e\xdf\x16\xb8\x9b\xe1\xfa\x6e\x7a\x93\xcf\xf3\xbb\x69\x81\xbb\xf7\x18\x4f\xbf\xe2\xf7\x7c\x7a\x73\x06\x96\xa1\x66\x07\xfe\x66\x5d\xd4\x6f\x1c\x64\x9c\x23\x97\x71\x68\x05\xf3\x89\x80\xa5\xd9\xaf\xcf\x5b\x16\x72\x29\x05\x14\xe9\xaa\xa5\x8a\x51\x99\x15\x3b\x2d\x75\x05\xcb\xae\x91\x3e\x6e\xd3\x83\x74\x99\x0c\xa1\x64\x23\x03\x85\x5d\xe4\x55\x53\x69\x92\x90\x95\xdd\xfe\x33\x08\xe3\x38\x15\xda\x35\xa9\x50\xa6\x2d\xd3\x6a\x67\xa5\x54\x98\x66\xb4\xba\x24\x65\x6b\xba\x4c\x1e\xa5\x2e\x33\x14\xec\x56\x52\xf0\x27\xb2\x56\xea\x2a\x69\x38\x50\x49\x81\xb2\x04\xd0\xd4\x70\x06\xff\xa4\xde\x66\xea\x10\xde\x92\xe0\x0c\x11\x72\xee\x37\x3e\x70\x93\xc4\xbe\x8e\x04\xc5\xe7\x49\x02\xac\x7a\x69\xab\xcb\x05\x07\xba\x4c\x00\xbf\x2f\xfd\xd1\xf8\x30\x3d\x94\xda\xf3\x93\x95\xbe\xab\xe1\xd8\x9b\xd6\x09\xf6\x91\x11\x38\xef\x58\xf7\xb8\x07\xff\xa4\x1e\xa2\xde\x05\x79\xde\xe5\x81\xae\xaf\xcf\x93\x9b\xd3\x38\xb5\xc1\x7c\x60\xcd\x8e\x02\x97\x19\x82\x6b\xfb\x8c\x2c\xe7\xdc\x58\x45\x81\x33\x0c\xac\x33\x7f\xb1\x08\x7e\xf4\xfd\x7b\xf7\xba\xdd\x8e\xa4\xf6\x21\xde\x89\x18\xed\xdf\xb7\xdb\x51\x5f\x39\x86\xa3\xac\xed\x76\xf0\x8a\xf2\x9a\xf4\x3b\xbe\xf7\x5c\xce\xcd\x27\x0a\xa2\x9e\x75\xfd\xec\x5b\x5e\x92\x3a\x08\xec\x3b\x1d\xaf\x48\xaa\xe8\xf7\x5c\x8f\xbd\xe7\x90\xeb\x15\xeb\x60\xdc\xe6\x14\xde\xaf\xaa\x5b\x5d\xd6\x85\xfb\xb1\xc7\xff\x17\xc4\xf9\xcd\x11\x14\xc8\x55\x1c\xde\x4b\x56\xe5\x09\xb6\x96\xec\xc8\x89\x5a\x0a\x52\x33\x5e\xb2\x63\x7d\x98\x7d\x7c\xce\x11\x36\x96\x33\x74\xb3\x39\xc4\x81\x47\xde\x1c\xc2\x33\x5e\xbe\xa8\xfd\x03\xae\x65\xa7\xe0\xdf\xd2\xc5\xa7\x64\x2f\x9c\xb4\xf1\x26\x64\xf8\xfb\xfc\x59\x06\x98\xd7\xdc\x1f\x42\xa8\x29\xec\xbf\x71\xbd\x08\x2c\x58\x19\x5d\x79\x04\x93\x3e\x3b\x56\xad\x1e\x8f\xa2\x8e\x16\xfa\xe3\x95\xa6\x37\x6d\x7c\x7c\x2a\x67\x5a\x9b\x1d\xaa\x35\xa4\xa9\x62\xf7\xc3\xdb\xf3\xa6\x99\xf7\x42\x1f\x1c\xc7\x0f\x09\xeb\xfd\x85\x7f\xe8\x3d\xf7\xd2\xe2\x45\x37\xdb\xe7\xe0\xfc\x14\xfb\x7f\xd9\xfe\xa7\xbf\x7f\xfa\xfb\xbf\xf8\xfb\x9f\x01\x00\x8f\xf4\x32\xc4\xcf\x08\x00\x00"),
 		},
 		"/storage.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "storage.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3273,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\x4d\x6f\xe3\x36\x10\x86\xef\xfa\x15\x03\xfb\xd2\x02\x89\xbc\xc9\x51\x3d\x39\x4e\x76\x23\x24\xb1\x0b\xdb\xe9\x62\x4f\xc6\x98\x1a\x4b\x6c\x28\x92\x25\x47\xf6\x0a\xae\xff\x7b\xa1\x2f\xc7\xde\x7c\xac\x0f\x45\x81\x02\xd1\x29\xe1\xbc\x9c\x79\x67\xe6\x01\xe1\x3e\x8c\x8c\x2d\x9d\x4c\x33\x86\xcb\x4f\x97\x97\xf0\xc5\x98\x54\x11\xdc\xdf\x8f\x82\x7e\xd0\x87\x7b\x29\x48\x7b\x4a\xa0\xd0\x09\x39\xe0\x8c\x60\x68\x51\x64\xd4\x45\xce\xe0\x0f\x72\x5e\x1a\x0d\x97\xe1\x27\xf8\xa5\x12\xf4\xda\x50\xef\xd7\xdf\x82\x3e\x94\xa6\x80\x1c\x4b\xd0\x86\xa1\xf0\x04\x9c\x49\x0f\x2b\xa9\x08\xe8\xbb\x20\xcb\x20\x35\x08\x93\x5b\x25\x51\x0b\x82\x8d\xe4\xac\x2e\xd3\x26\x09\x83\x3e\x7c\x6b\x53\x98\x25\xa3\xd4\x80\x20\x8c\x2d\xc1\xac\x0e\x75\x80\x5c\x1b\xae\xbf\x8c\xd9\x46\x83\xc1\x66\xb3\x09\xb1\x76\x1b\x1a\x97\x0e\x54\xa3\xf4\x83\xfb\x78\x74\x33\x9e\xdd\x9c\x5f\x86\x9f\xea\x3b\x8f\x5a\x91\xf7\xe0\xe8\xaf\x42\x3a\x4a\x60\x59\x02\x5a\xab\xa4\xc0\xa5\x22\x50\xb8\x01\xe3\x00\x53\x47\x94\x00\x9b\xca\xf0\xc6\x49\x96\x3a\x3d\x03\x6f\x56\xbc\x41\x47\x41\x1f\x12\xe9\xd9\xc9\x65\xc1\x47\xd3\xea\xec\x49\x7f\x24\x30\x1a\x50\x43\x6f\x38\x83\x78\xd6\x83\xab\xe1\x2c\x9e\x9d\x05\x7d\xf8\x1a\xcf\x6f\x27\x8f\x73\xf8\x3a\x9c\x4e\x87\xe3\x79\x7c\x33\x83\xc9\x14\x46\x93\xf1\x75\x3c\x8f\x27\xe3\x19\x4c\x3e\xc3\x70\xfc\x0d\xee\xe2\xf1\xf5\x19\x90\xe4\x8c\x1c\xd0\x77\xeb\x2a\xff\xc6\x81\xac\xe6\x48\x49\x35\xb4\x19\xd1\x91\x81\x95\x69\x0c\x79\x4b\x42\xae\xa4\x00\x85\x3a\x2d\x30\x25\x48\xcd\x9a\x9c\x96\x3a\x05\x4b\x2e\x97\xbe\xda\xa6\x07\xd4\x49\xd0\x07\x25\x73\xc9\xc8\xf5\xc9\x8b\xa6\xc2\x20\x40\x2b\xdb\xfd\x47\x20\x8c\xa3\x50\x68\x97\x87\x42\x99\x22\x09\xd3\x1a\xa5\x50\x98\x7c\xb0\xbe\x40\x65\x33\xbc\x08\x9e\xa4\x4e\x22\x98\x91\x5b\x4b\x41\x0f\x68\xad\xd4\x69\x90\x13\x63\x82\x8c\x51\x00\xa0\x31\xa7\x08\x3c\x1b\x87\xe9\x1b\xd9\x5a\x95\xb7\x28\x28\x82\x4a\x72\xee\x4b\xcf\x94\x07\x55\x6f\xcf\x49\x66\x4d\x92\x00\x60\xdd\x59\x5c\x5f\x2c\x89\xf1\x22\x00\xf0\x8d\x85\x5b\xe3\x79\x7c\x54\xb2\xa9\x83\x56\xfa\xb6\x96\x23\x6f\x0a\x27\xc8\x57\x99\x01\xce\xdb\xec\x8d\x6e\xd1\x5e\x5b\x2c\x0b\xf1\x44\xbc\x40\x21\xc8\xfb\x85\x30\x9a\x9d\x51\x41\x83\x63\xdb\x74\xa3\xbc\xaa\x85\xc3\x5a\x37\x3a\x92\x61\xc1\xe6\x0b\x69\x72\xc8\x94\x44\xc0\xae\xa0\x36\x22\x93\x39\xe5\x56\x21\x53\x04\xbd\xed\xb6\xa9\xb5\xdb\x0d\xb6\x5b\xd2\x2c\xb9\xdc\xed\x7a\x2f\x94\x23\xd4\x57\xf4\xe8\x29\x99\x9b\x07\x64\x91\x4d\xdb\x36\x9a\x6e\x57\xa8\x7c\x97\xbd\x6b\x70\xb8\x46\xa9\x2a\xe4\x63\x3d\xf4\x9e\x38\xd6\x6b\xd2\x6c\x5c\x79\x2c\xef\xb6\xd5\x6e\x2f\x6a\x8f\xbb\xa9\x37\x8e\x7e\x48\x1d\x5f\x3f\xcb\x18\x5d\x4a\xfc\x59\x92\x4a\xde\x50\x4f\x69\x45\x8e\xf4\x7e\xe4\xcd\xd8\x9f\xa8\x8c\xa0\x69\x7d\x4a\xab\x7d\x00\x80\x57\x6d\xb2\x26\x78\x10\x49\xd7\x4f\xd1\xc1\xbf\xaf\x6e\xe2\x28\xfe\x0a\x28\x07\xd9\x9c\x29\xec\xcf\xd9\x7c\xb5\xcf\x6a\x36\xef\xe1\x93\xd0\x0a\x0b\xc5\x0b\xb3\xfc\x93\xc4\x29\x18\x5d\x37\x17\x26\xb5\xfe\x83\xa6\x0f\x9a\x0e\x69\xca\x72\x14\x8b\x27\x2a\x5f\x03\xe7\xf6\x61\x38\xba\xdb\x87\x4e\xa4\xc4\x3a\x53\x71\xe6\x07\xdb\x6d\xfb\xe7\x6e\x37\xa8\xaa\xdc\x51\x59\x1d\xb6\xbc\xca\xe4\x3f\x65\xa7\x7a\xc3\xc9\xed\xed\xc7\xd7\xed\x78\xf6\x6e\x4e\xe5\xe6\xc7\x0b\x99\x24\x87\x4e\x64\x52\xa0\x7a\x1d\x1f\x2e\x2d\x45\xd0\xce\xe2\x60\x4d\x35\x56\xed\xf1\x33\x57\xef\xa3\xb8\x27\xee\xd4\x74\xd5\x97\x90\x17\x4e\x5a\xae\xf9\xfa\xfb\xfc\x08\xad\x79\x46\xdd\x25\xe0\x0c\xb9\xf9\xcd\xd5\x99\x80\x25\x29\xa3\x53\x0f\x6c\xc2\x9f\xd3\xfd\xfb\x0b\x4f\xa7\x71\xdd\x55\xcb\x51\x63\x4a\xee\x1d\xbe\xdf\x60\xf8\xe4\x97\xf0\xdf\x7d\x02\x9b\xb2\x1f\xaf\x61\x97\xf0\xff\xf2\x1a\xfe\x13\x00\x00\xff\xff\x89\xe3\x34\x25\xc9\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xe2\x48\x10\xbd\xfb\x57\x94\xe0\xb2\x2b\x25\x26\xc9\xd1\x7b\x22\x24\x33\xb1\x92\xc0\x0a\xc8\x8e\xe6\x84\x8a\x76\x61\xf7\xa6\xdd\xdd\xdb\x5d\x86\xb1\x58\xfe\xfb\xca\x5f\x10\x26\x1f\x93\x95\x56\x2b\x8d\x14\xcc\x01\x75\xbd\x7a\xf5\xaa\xea\xa9\x71\x1f\x46\xc6\x96\x4e\xa6\x19\xc3\xc5\xd9\xc5\x05\x7c\x36\x26\x55\x04\x77\x77\xa3\xa0\x1f\xf4\xe1\x4e\x0a\xd2\x9e\x12\x28\x74\x42\x0e\x38\x23\x18\x5a\x14\x19\x75\x91\x13\xf8\x83\x9c\x97\x46\xc3\x45\x78\x06\xbf\x54\x80\x5e\x1b\xea\xfd\xfa\x5b\xd0\x87\xd2\x14\x90\x63\x09\xda\x30\x14\x9e\x80\x33\xe9\x61\x25\x15\x01\x7d\x13\x64\x19\xa4\x06\x61\x72\xab\x24\x6a\x41\xb0\x91\x9c\x01\x1f\xf8\xc3\xa0\x0f\x5f\x5b\x0a\xb3\x64\x94\x1a\x10\x84\xb1\x25\x98\xd5\x53\x1c\x20\xd7\x82\xeb\x4f\xc6\x6c\xa3\xc1\x60\xb3\xd9\x84\x58\xab\x0d\x8d\x4b\x07\xaa\x41\xfa\xc1\x5d\x3c\xba\x1e\xcf\xae\x4f\x2f\xc2\xb3\x3a\xe7\x41\x2b\xf2\x1e\x1c\xfd\x55\x48\x47\x09\x2c\x4b\x40\x6b\x95\x14\xb8\x54\x04\x0a\x37\x60\x1c\x60\xea\x88\x12\x60\x53\x09\xde\x38\xc9\x52\xa7\x27\xe0\xcd\x8a\x37\xe8\x28\xe8\x43\x22\x3d\x3b\xb9\x2c\xf8\x68\x5a\x9d\x3c\xe9\x8f\x00\x46\x03\x6a\xe8\x0d\x67\x10\xcf\x7a\x70\x39\x9c\xc5\xb3\x93\xa0\x0f\x5f\xe2\xf9\xcd\xe4\x61\x0e\x5f\x86\xd3\xe9\x70\x3c\x8f\xaf\x67\x30\x99\xc2\x68\x32\xbe\x8a\xe7\xf1\x64\x3c\x83\xc9\x27\x18\x8e\xbf\xc2\x6d\x3c\xbe\x3a\x01\x92\x9c\x91\x03\xfa\x66\x5d\xa5\xdf\x38\x90\xd5\x1c\x29\xa9\x86\x36\x23\x3a\x12\xb0\x32\xcd\xfa\xbc\x25\x21\x57\x52\x80\x42\x9d\x16\x98\x12\xa4\x66\x4d\x4e\x4b\x9d\x82\x25\x97\x4b\x5f\x6d\xd3\x03\xea\x24\xe8\x83\x92\xb9\x64\xe4\xfa\xe4\x59\x53\x61\x10\xa0\x95\xed\xfe\x23\x10\xc6\x51\x28\xb4\xcb\x43\xa1\x4c\x91\x84\x69\x6d\xa5\x50\x98\x7c\xb0\x3e\x47\x65\x33\x3c\x0f\x1e\xa5\x4e\x22\x98\x91\x5b\x4b\x41\xf7\x68\xad\xd4\x69\x90\x13\x63\x82\x8c\x51\x00\xa0\x31\xa7\x08\x3c\x1b\x87\xe9\x2b\x6c\x2d\xca\x5b\x14\x14\x41\x05\x39\xf5\xa5\x67\xca\x83\xaa\xb7\x03\xc9\xac\x21\x09\x00\xd6\x9d\xc4\xf5\xf9\x92\x18\xcf\x03\x00\xdf\x48\xb8\x31\x9e\xc7\x47\x25\x1b\xd5\x68\xa5\x6f\x6b\x39\xf2\xa6\x70\x82\x7c\xc5\x0c\x70\xda\xb2\x37\xb8\x45\x9b\xb6\x58\x16\xe2\x91\x78\x81\x42\x90\xf7\x0b\x61\x34\x3b\xa3\xea\x04\x80\xb6\xe9\x06\x79\x59\x03\x87\x35\x6e\x74\x04\xc3\x82\xcd\x67\xd2\xe4\x90\x29\x89\x80\x5d\x41\x6d\x44\x26\x73\xca\xad\x42\xa6\x08\x7a\xdb\x6d\x53\x6b\xb7\x1b\x6c\xb7\xa4\x59\x72\xb9\xdb\xf5\x9e\x21\x47\xa8\x2f\xe9\xc1\x53\x32\x37\xf7\xc8\x22\x9b\xb6\x6d\x34\xdd\xae\x50\xf9\x8e\xbd\x6b\x70\xb8\x46\xa9\x2a\xcb\xc7\x7a\xe8\x3d\x71\xac\xd7\xa4\xd9\xb8\xf2\x18\xde\x6d\xab\xdd\x5e\xd4\x1e\x77\x53\x6f\x14\x7d\x47\x1d\x5f\x1d\x60\x8c\x2e\x25\xfe\x24\x49\x25\xaf\xa0\xa7\xb4\x22\x47\x7a\x3f\xf2\xea\x7b\x0a\x8f\x54\x46\xd0\xb4\x3e\xa5\xd5\x3e\x00\xc0\xab\x96\xac\x09\x3e\x89\xa4\xeb\xc7\x03\xc3\x2b\x9b\x38\x8a\xbf\x60\x94\xc3\x93\x3a\x53\xd8\x1f\x7b\xf3\xc5\x3e\xab\xd9\xbc\x65\x9f\x84\x56\x58\x28\x5e\x98\xe5\x9f\x24\xde\x63\xa3\xab\x26\x61\x52\xe3\x3f\xdc\xf4\xe1\xa6\xa7\x6e\xca\x72\x14\x8b\x47\x2a\x83\x17\x74\xde\xdc\x0f\x47\xb7\x54\xfe\x3b\x97\x58\x67\x2a\x9f\xf9\xc1\x76\xdb\xfe\xdc\xed\x06\x55\x95\x5b\x2a\xab\xc3\xd6\xaf\x32\xf9\x5f\xbd\x53\xdd\xe1\xe4\xf6\xf2\xe3\xab\x76\x3c\x7b\x35\xef\xf5\xcd\xf7\x09\x99\x24\x87\x4e\x64\x52\xa0\x7a\xd9\x3e\x5c\x5a\x8a\xa0\x9d\xc5\xfe\x1c\x1a\x5b\xb5\xc7\x07\x5f\xbd\x6d\xc5\xbd\xe3\xde\x4b\x57\x3d\x09\x79\xe1\xa4\xad\xfe\x99\x23\xf8\xfb\xf4\x49\x04\x60\x9e\x51\x97\x04\x9c\x21\x37\xef\x5c\x9d\x08\x58\x92\x32\x3a\xf5\xc0\x26\xfc\xb1\xbb\x7f\x7f\xa6\xe9\x7d\xbe\xee\xaa\xe5\xa8\x31\x25\xf7\x86\xbf\x5f\xf1\xf0\xbb\x6f\xc2\xff\xf6\x0a\x6c\xca\x7e\xdc\x86\x3f\xdb\x6d\xf8\xcf\x00\x89\xe3\x34\x25\xc9\x0c\x00\x00"),
 		},
 		"/storagetransfer.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "storagetransfer.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1561,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x48\x97\x16\xb0\xa9\x58\x47\xf6\xa4\xc8\x4e\x42\xd4\x91\x02\x51\x6e\x90\x93\x31\x5a\x0e\xc9\xad\xc9\xdd\xed\xee\x50\x8a\x90\xfa\xbf\x17\x14\x49\xdb\x8a\x1d\xa0\x3c\x08\xc2\xcc\x9b\xf7\xde\x7c\x90\x53\x2c\xad\x3b\x7a\x5d\x56\x82\xf9\xbb\xf9\x1c\x1f\xad\x2d\x6b\xc6\xed\xed\x32\x9a\x46\x53\xdc\x6a\xc5\x26\x70\x8e\xd6\xe4\xec\x21\x15\x63\xe1\x48\x55\x3c\x66\x2e\xf0\x17\xfb\xa0\xad\xc1\x3c\x7e\x87\xdf\x3a\xc0\x64\x48\x4d\x7e\xff\x23\x9a\xe2\x68\x5b\x34\x74\x84\xb1\x82\x36\x30\xa4\xd2\x01\x85\xae\x19\xfc\x5d\xb1\x13\x68\x03\x65\x1b\x57\x6b\x32\x8a\x71\xd0\x52\x9d\x64\x06\x92\x38\x9a\xe2\xdb\x40\x61\x77\x42\xda\x80\xa0\xac\x3b\xc2\x16\x2f\x71\x20\x39\x19\x3e\x3d\x95\x88\x4b\x66\xb3\xc3\xe1\x10\xd3\xc9\x6d\x6c\x7d\x39\xab\x7b\x64\x98\xdd\xa6\xcb\x9b\x55\x76\x73\x39\x8f\xdf\x9d\x6a\xee\x4c\xcd\x21\xc0\xf3\x3f\xad\xf6\x9c\x63\x77\x04\x39\x57\x6b\x45\xbb\x9a\x51\xd3\x01\xd6\x83\x4a\xcf\x9c\x43\x6c\x67\xf8\xe0\xb5\x68\x53\x5e\x20\xd8\x42\x0e\xe4\x39\x9a\x22\xd7\x41\xbc\xde\xb5\x72\x36\xad\xd1\x9e\x0e\x67\x00\x6b\x40\x06\x93\x45\x86\x34\x9b\xe0\xfd\x22\x4b\xb3\x8b\x68\x8a\xaf\xe9\xf6\xd3\xfa\x6e\x8b\xaf\x8b\xcd\x66\xb1\xda\xa6\x37\x19\xd6\x1b\x2c\xd7\xab\xeb\x74\x9b\xae\x57\x19\xd6\x1f\xb0\x58\x7d\xc3\x9f\xe9\xea\xfa\x02\xac\xa5\x62\x0f\xfe\xee\x7c\xe7\xdf\x7a\xe8\x6e\x8e\x9c\x77\x43\xcb\x98\xcf\x0c\x14\xb6\x37\x14\x1c\x2b\x5d\x68\x85\x9a\x4c\xd9\x52\xc9\x28\xed\x9e\xbd\xd1\xa6\x84\x63\xdf\xe8\xd0\x6d\x33\x80\x4c\x1e\x4d\x51\xeb\x46\x0b\xc9\x29\xf2\xaa\xa9\x38\x8a\xc8\xe9\x61\xff\x09\x94\xf5\x1c\x2b\xe3\x9b\x58\xd5\xb6\xcd\xe3\xf2\x74\x4a\xb1\xb2\xcd\x6c\x7f\x45\xb5\xab\xe8\x2a\x7a\xd0\x26\x4f\x90\xb1\xdf\x6b\xc5\x9f\xc9\x39\x6d\xca\xa8\x61\xa1\x9c\x84\x92\x08\x30\xd4\x70\x82\x20\xd6\x53\xc9\xe2\xc9\x84\x82\xfd\xdb\xac\x03\x3a\x38\x52\x9c\xa0\x83\x5c\x86\x63\x10\x6e\xa2\xae\xc7\x67\xb2\xac\x27\xdb\x0e\x64\x11\xb0\x1f\x2d\xef\xaf\x76\x2c\x74\x15\x01\xa1\xb7\xf4\xc9\x06\x59\xbd\x69\xa1\xd7\x25\xa7\xc3\xa0\xed\x39\xd8\xd6\x2b\x0e\x9d\x12\x70\x39\xa8\xf5\xb8\xfb\xa1\xfc\x7e\xac\xbf\xa7\x92\x8d\xdc\x3b\x6b\xeb\xa8\x3f\xd2\x61\x14\xe7\xe6\x16\x1d\xea\xcb\x33\x88\x5a\xb1\x1f\xd9\xb0\x27\xe1\x3c\x81\xf8\x96\x87\x8c\xce\xb7\xdc\xb8\x9a\x84\x13\x4c\x9c\xb7\x7f\xb3\x92\x30\xfb\xf1\x63\xf8\xfb\xf8\x38\xa3\x91\xab\x0b\x77\xe6\x1e\x1f\x27\xaf\x8a\x97\x64\xde\xf3\x5d\xe0\x7c\x6b\x3f\x93\xa8\x6a\x33\x74\xd5\x0f\xa1\xa0\x3a\x8c\x82\x63\xbf\x8b\x3d\xe9\xba\x7b\x33\x52\xb3\x08\x81\x25\x35\x7b\x36\x62\xfd\xf1\x1c\x3e\x2e\x75\x58\x72\x32\x84\xc7\xa5\x74\xbf\x3f\x11\xa7\xd7\xcf\x20\x21\x5f\xb2\x7c\xd0\x5c\xe7\x67\xd8\x4a\xb3\x27\xaf\x2a\xad\xa8\xde\x70\xc1\x9e\xcd\xd3\x06\xfa\x2d\xc8\xd1\x71\x82\x61\x0a\x4f\x71\xe0\x81\x8f\x4f\xe1\x0d\x17\x3f\x69\xff\x82\xab\x18\x1c\xfc\x5f\xba\xee\xc9\x39\x28\xaf\x9d\x9c\x0e\xec\xdf\xcb\x17\x19\x60\x5b\xf1\x58\x04\xa9\x48\xfa\xaf\xe1\x68\x02\x3b\xae\xad\x29\x03\xc4\xc6\x2f\xca\xca\xfd\x43\x72\xc6\xd2\x5f\xce\x97\x57\x9e\xde\x3c\xec\x17\x3c\xde\xb6\x2e\x79\x52\x6b\xc8\x50\xf9\xcb\x77\xeb\xbf\x00\x00\x00\xff\xff\x4a\x34\x2b\xf6\x19\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\xd1\x6f\xda\x48\x10\xc6\xdf\xfd\x57\x7c\x82\x97\x3b\x29\x98\x86\x47\xdf\x13\x25\x69\x6b\x5d\x0a\x15\x26\x57\xf5\x29\x1a\xd6\x63\x7b\x2f\xf6\xee\xde\xee\x18\x8a\x7a\xf9\xdf\x4f\x06\x3b\x84\x26\x95\x0e\x23\x84\x66\xbf\xf9\xe6\xb7\x33\xbb\x1e\x63\x61\xdd\xc1\xeb\xb2\x12\xcc\xde\xcd\x66\xf8\x68\x6d\x59\x33\xee\xee\x16\xd1\x38\x1a\xe3\x4e\x2b\x36\x81\x73\xb4\x26\x67\x0f\xa9\x18\x73\x47\xaa\xe2\x61\xe5\x0a\x7f\xb1\x0f\xda\x1a\xcc\xe2\x77\xf8\xad\x13\x8c\xfa\xa5\xd1\xef\x7f\x44\x63\x1c\x6c\x8b\x86\x0e\x30\x56\xd0\x06\x86\x54\x3a\xa0\xd0\x35\x83\xbf\x2b\x76\x02\x6d\xa0\x6c\xe3\x6a\x4d\x46\x31\xf6\x5a\x2a\xc8\xd9\x3f\x8e\xc6\xf8\xd6\x5b\xd8\xad\x90\x36\x20\x28\xeb\x0e\xb0\xc5\x4b\x1d\x48\x8e\xc0\xc7\x4f\x25\xe2\x92\xe9\x74\xbf\xdf\xc7\x74\xa4\x8d\xad\x2f\xa7\xf5\x49\x19\xa6\x77\xe9\xe2\x76\x99\xdd\x4e\x66\xf1\xbb\x63\xce\xbd\xa9\x39\x04\x78\xfe\xa7\xd5\x9e\x73\x6c\x0f\x20\xe7\x6a\xad\x68\x5b\x33\x6a\xda\xc3\x7a\x50\xe9\x99\x73\x88\xed\x80\xf7\x5e\x8b\x36\xe5\x15\x82\x2d\x64\x4f\x9e\xa3\x31\x72\x1d\xc4\xeb\x6d\x2b\x17\xdd\x1a\xf0\x74\xb8\x10\x58\x03\x32\x18\xcd\x33\xa4\xd9\x08\xef\xe7\x59\x9a\x5d\x45\x63\x7c\x4d\x37\x9f\x56\xf7\x1b\x7c\x9d\xaf\xd7\xf3\xe5\x26\xbd\xcd\xb0\x5a\x63\xb1\x5a\xde\xa4\x9b\x74\xb5\xcc\xb0\xfa\x80\xf9\xf2\x1b\xfe\x4c\x97\x37\x57\x60\x2d\x15\x7b\xf0\x77\xe7\x3b\x7e\xeb\xa1\xbb\x3e\x72\xde\x35\x2d\x63\xbe\x00\x28\xec\x69\x7c\xc1\xb1\xd2\x85\x56\xa8\xc9\x94\x2d\x95\x8c\xd2\xee\xd8\x1b\x6d\x4a\x38\xf6\x8d\x0e\xdd\x34\x03\xc8\xe4\xd1\x18\xb5\x6e\xb4\x90\x1c\x23\xaf\x36\x15\x47\x11\x39\xdd\xcf\x3f\x81\xb2\x9e\x63\x65\x7c\x13\xab\xda\xb6\x79\x5c\x1e\x8f\x52\xac\x6c\x33\xdd\x5d\x53\xed\x2a\xba\x8e\x1e\xb5\xc9\x13\x64\xec\x77\x5a\xf1\x67\x72\x4e\x9b\x32\x6a\x58\x28\x27\xa1\x24\x02\x0c\x35\x9c\x20\x88\xf5\x54\xb2\x78\x32\xa1\x60\xff\xb6\x6b\xaf\x0e\x8e\x14\x27\xe8\x24\x93\x70\x08\xc2\x4d\xd4\xed\xf1\x6c\x96\x9d\xcc\x36\xbd\x59\x04\xec\x06\xe4\xdd\xf5\x96\x85\xae\x23\x20\x9c\x90\x3e\xd9\x20\xcb\x37\x11\x4e\x75\xc9\xe9\xd0\xd7\xf6\x1c\x6c\xeb\x15\x87\xae\x12\x30\xe9\xab\x9d\x74\x0f\x7d\xfa\xc3\x90\xff\x40\x25\x1b\x79\x70\xd6\xd6\x47\x39\xd0\xb7\xe2\x12\x6e\xde\xa9\xbe\x9c\x45\xd4\x8a\xfd\xc8\x86\x3d\x09\xe7\x09\xc4\xb7\xdc\xaf\xe8\x7c\xc3\x8d\xab\x49\x38\xc1\xc8\x79\xfb\x37\x2b\x09\xd3\x1f\x3f\xfa\xbf\x4f\x4f\x53\x1a\xbc\xba\x70\x07\xf7\xf4\x34\x7a\x95\xbc\x20\xf3\x9e\xef\x03\xe7\x1b\xfb\x99\x44\x55\xeb\x7e\x57\xa7\x26\x14\x54\x87\xa1\xe0\xb0\xdf\xf9\x8e\x74\xdd\xdd\x8c\xd4\xcc\x43\x60\x49\xcd\x8e\x8d\x58\x7f\xb8\x94\x0f\x43\xed\x87\x9c\xf4\xe1\x61\x28\xdd\xef\x4f\xc6\xe9\xcd\x59\x24\xe4\x4b\x96\x0f\x9a\xeb\xfc\x42\x5b\x69\xf6\xe4\x55\xa5\x15\xd5\x6b\x2e\xd8\xb3\x79\x9e\x40\xf7\x9d\x40\x0e\x8e\x13\xf4\x5d\x78\x8e\x03\x8f\x7c\x78\x0e\xaf\xb9\xf8\xa9\xf6\x2f\xbc\x8a\x9e\xe0\xff\xda\x75\x4f\xce\x41\x79\xed\xba\x3b\x93\xe0\xdf\xc9\x8b\x15\x60\x53\xf1\x90\x04\xa9\x48\x4e\x6f\xc3\x01\x02\x5b\xae\xad\x29\x03\xc4\xc6\x2f\xd2\xca\xdd\xe3\x19\xea\x7c\x72\xbe\xbc\x62\x7a\xf3\x60\x9f\x9f\xd2\xdb\xd6\x25\xcf\xd5\x1a\x32\x54\xfe\xf2\x6e\xfd\x37\x00\x4a\x34\x2b\xf6\x19\x06\x00\x00"),
 		},
 		"/tags.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "tags.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3976,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x41\x4f\xe3\x48\x13\xbd\xfb\x57\x94\x92\xcb\xf7\x49\xe0\x00\x47\xef\x29\x04\x66\xc6\x02\xc2\x2a\x09\x8c\xe6\x84\x2a\xed\xb2\xd3\x8b\xdd\xdd\xdb\x5d\x4e\xc6\x42\xfc\xf7\x55\xdb\x4e\x88\x43\xc2\x8c\xd0\x68\x57\x23\xe1\x43\xa4\xb8\x5e\xbf\xaa\x7a\xfd\xaa\xdd\x7d\x18\x69\x53\x59\x99\x2d\x18\xce\x4e\xce\xce\xe0\xb3\xd6\x59\x4e\x70\x7d\x3d\x0a\xfa\x41\x1f\xae\xa5\x20\xe5\x28\x81\x52\x25\x64\x81\x17\x04\x43\x83\x62\x41\xeb\xc8\x11\xdc\x93\x75\x52\x2b\x38\x0b\x4f\xe0\x7f\x1e\xd0\x6b\x43\xbd\xff\xff\x11\xf4\xa1\xd2\x25\x14\x58\x81\xd2\x0c\xa5\x23\xe0\x85\x74\x90\xca\x9c\x80\xbe\x0b\x32\x0c\x52\x81\xd0\x85\xc9\x25\x2a\x41\xb0\x92\xbc\xa8\xd3\xb4\x24\x61\xd0\x87\x6f\x2d\x85\x9e\x33\x4a\x05\x08\x42\x9b\x0a\x74\xba\x8d\x03\xe4\xba\xe0\xfa\x59\x30\x9b\x68\x30\x58\xad\x56\x21\xd6\xd5\x86\xda\x66\x83\xbc\x41\xba\xc1\x75\x3c\xba\x1c\x4f\x2f\x8f\xcf\xc2\x93\x7a\xcd\x9d\xca\xc9\x39\xb0\xf4\x77\x29\x2d\x25\x30\xaf\x00\x8d\xc9\xa5\xc0\x79\x4e\x90\xe3\x0a\xb4\x05\xcc\x2c\x51\x02\xac\x7d\xc1\x2b\x2b\x59\xaa\xec\x08\x9c\x4e\x79\x85\x96\x82\x3e\x24\xd2\xb1\x95\xf3\x92\x3b\x6a\xad\xcb\x93\xae\x03\xd0\x0a\x50\x41\x6f\x38\x85\x78\xda\x83\xf3\xe1\x34\x9e\x1e\x05\x7d\xf8\x1a\xcf\xbe\xdc\xde\xcd\xe0\xeb\x70\x32\x19\x8e\x67\xf1\xe5\x14\x6e\x27\x30\xba\x1d\x5f\xc4\xb3\xf8\x76\x3c\x85\xdb\x4f\x30\x1c\x7f\x83\xab\x78\x7c\x71\x04\x24\x79\x41\x16\xe8\xbb\xb1\xbe\x7e\x6d\x41\x7a\x1d\x29\xf1\xa2\x4d\x89\x3a\x05\xa4\xba\x29\xc8\x19\x12\x32\x95\x02\x72\x54\x59\x89\x19\x41\xa6\x97\x64\x95\x54\x19\x18\xb2\x85\x74\x7e\x37\x1d\xa0\x4a\x82\x3e\xe4\xb2\x90\x8c\x5c\xbf\x79\xd5\x54\x18\x04\x68\x64\xbb\xff\x11\x08\x6d\x29\x14\xca\x16\xa1\xc8\x75\x99\x84\x59\x6d\xa5\x50\xe8\x62\xb0\x3c\xc5\xdc\x2c\xf0\x34\x78\x94\x2a\x89\x60\x4a\x76\x29\x05\xdd\xa0\x31\x52\x65\x41\x41\x8c\x09\x32\x46\x01\x80\xc2\x82\x22\x60\xcc\xdc\x7e\xaa\x16\xe2\x0c\x0a\x8a\xc0\x43\x8e\x5d\xe5\x98\x8a\xc0\x37\xf6\xc2\x30\xc3\xcc\x05\x00\xcb\x75\x71\xcb\xd3\x39\x31\x9e\x06\x00\xae\x49\xfe\x45\x3b\x1e\xbf\x24\x6b\x32\xa0\x91\xae\xcd\x62\xc9\xe9\xd2\x0a\x72\x9e\x13\xe0\xb8\xe5\x6d\x70\x0f\x7e\x8d\xff\x79\x98\x4b\x95\xf8\x1e\x1a\xe3\x35\xed\xf9\xdc\x33\xcc\xce\x3b\x21\x2c\x59\x7f\x26\x45\x16\x99\x92\x08\xd8\x96\xd4\x46\x64\x32\xa3\xc2\xe4\xc8\x14\x41\x8f\x37\xeb\xdc\xe0\xe9\xc9\xe7\x7c\x7e\xee\xbd\x02\x8e\x50\x9d\xd3\x9d\xa3\x64\xa6\x6f\x90\xc5\x62\xd2\x16\xdb\x34\x94\x62\xee\xd6\xe4\xeb\x36\x86\x4b\x94\xb9\xf7\x73\xac\x86\xce\x11\xc7\x6a\x49\x8a\xb5\xad\xba\x70\x2f\x0e\xd9\x4d\x9d\xf1\xc5\x27\x49\x79\x12\xd5\xcd\xef\x30\xc6\x17\x51\xfb\x06\x80\xd1\x66\xc4\x87\xb1\x13\x4a\xc9\x92\xda\xa8\xd9\x28\xfa\x48\x55\x04\x06\x2d\x29\x9e\x50\xba\x09\x00\x70\xda\x52\x35\xc1\xad\x48\xb6\x7c\x8c\xb6\xfe\xae\x15\xff\xd3\xea\xbf\x48\x70\x27\xb2\x67\xeb\xb7\x78\xac\x2e\x4d\xb4\xa9\xaf\x40\x85\x19\xd9\x83\x96\xdb\xdf\x67\x59\xcc\xc9\x6e\x45\x97\x98\x97\xb4\xb5\x97\x83\x41\x4d\xb5\x9b\xa4\x6b\xb5\x81\x69\x6a\xf7\xdb\x5d\x13\xbc\xec\xb7\x7f\x1a\x05\x3a\x7e\xd9\x48\xc7\x98\xdd\xfb\x15\x07\xc4\xf3\xf6\xac\x19\x7f\xac\x5f\xeb\xd8\xfb\x1d\xf4\xcf\x89\xf8\xe6\xb0\xfe\xc8\x21\xfb\x74\x5b\xf7\xf5\x4a\x92\x43\x43\xf8\x48\xd5\xbe\x01\xbc\xda\xbc\x7e\x63\xf8\xb0\x18\x69\x95\xca\xec\x45\x16\xa3\x73\x29\xaa\xf1\xa1\x4c\x0f\x12\x8b\x87\x06\xb3\xb3\xe4\x86\xbc\x21\xde\x5e\x58\x50\xc7\x34\x76\x3d\x18\x8d\x32\x5b\xa2\x6c\x0e\xc2\xad\xee\x6a\x21\x2b\x43\x3b\x0a\xba\xd2\x18\x6d\xd9\x8d\xb4\x4a\x64\x7d\x56\x77\x87\x7a\xf7\x80\xb9\xa2\xea\xf7\x3f\x5c\x0e\x79\x61\xdb\xf1\x07\xcd\xfd\xcb\xfc\x50\x67\x7b\x9f\x23\x5e\x96\xbe\xc3\x13\xbb\x73\xfd\x0b\x5c\xb1\x19\xb9\xdf\xdb\x17\xff\xde\x47\xe7\xd5\x29\xd3\x3c\xff\xd5\x91\xd9\x4e\xf5\xcf\x7c\x43\xf6\xcd\x4e\xae\x45\x7d\xcf\x7b\xf3\x56\x73\xdd\x82\xde\x7b\xbb\x79\x7a\x5a\xa7\x79\x7e\xfe\x70\xda\xc7\xf5\xe6\xe3\x7a\xd3\x4a\xf2\x4f\x00\x00\x00\xff\xff\x19\xff\x1d\x84\x88\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x4f\xe3\x48\x13\xbe\xfb\x57\x94\x92\xcb\xfb\x4a\x90\x00\x47\xef\x29\x04\x66\xc6\x02\x92\x55\x12\x18\xcd\x09\x55\xda\x95\x4e\x2f\x76\x77\x6f\x77\x39\x19\x0b\xf1\xdf\x57\x6d\x3b\x9f\x24\x0c\x42\x23\xad\x56\x82\x58\x1c\x5c\x4f\xd7\xc7\x53\x4f\xb5\xab\x0d\x7d\x63\x4b\xa7\xe4\x9c\xe1\xe2\xec\xe2\x02\xbe\x1a\x23\x33\x82\xdb\xdb\x7e\xd4\x8e\xda\x70\xab\x04\x69\x4f\x29\x14\x3a\x25\x07\x3c\x27\xe8\x59\x14\x73\x5a\x59\x4e\xe0\x81\x9c\x57\x46\xc3\x45\xe7\x0c\xfe\x17\x00\xad\xc6\xd4\xfa\xff\x1f\x51\x1b\x4a\x53\x40\x8e\x25\x68\xc3\x50\x78\x02\x9e\x2b\x0f\x33\x95\x11\xd0\x4f\x41\x96\x41\x69\x10\x26\xb7\x99\x42\x2d\x08\x96\x8a\xe7\xc0\x1b\xff\x9d\xa8\x0d\x3f\x1a\x17\x66\xca\xa8\x34\x20\x08\x63\x4b\x30\xb3\x6d\x1c\x20\x57\x09\x57\x7f\x73\x66\x1b\x77\xbb\xcb\xe5\xb2\x83\x55\xb6\x1d\xe3\x64\x37\xab\x91\xbe\x7b\x9b\xf4\xaf\x07\xe3\xeb\xd3\x8b\xce\x59\x75\xe6\x5e\x67\xe4\x3d\x38\xfa\xbb\x50\x8e\x52\x98\x96\x80\xd6\x66\x4a\xe0\x34\x23\xc8\x70\x09\xc6\x01\x4a\x47\x94\x02\x9b\x90\xf0\xd2\x29\x56\x5a\x9e\x80\x37\x33\x5e\xa2\xa3\xa8\x0d\xa9\xf2\xec\xd4\xb4\xe0\x1d\xb6\x56\xe9\x29\xbf\x03\x30\x1a\x50\x43\xab\x37\x86\x64\xdc\x82\xcb\xde\x38\x19\x9f\x44\x6d\xf8\x9e\x4c\xbe\x0d\xef\x27\xf0\xbd\x37\x1a\xf5\x06\x93\xe4\x7a\x0c\xc3\x11\xf4\x87\x83\xab\x64\x92\x0c\x07\x63\x18\x7e\x81\xde\xe0\x07\xdc\x24\x83\xab\x13\x20\xc5\x73\x72\x40\x3f\xad\x0b\xf9\x1b\x07\x2a\xf0\x48\x69\x20\x6d\x4c\xb4\x93\xc0\xcc\xd4\xed\xf3\x96\x84\x9a\x29\x01\x19\x6a\x59\xa0\x24\x90\x66\x41\x4e\x2b\x2d\xc1\x92\xcb\x95\x0f\xdd\xf4\x80\x3a\x8d\xda\x90\xa9\x5c\x31\x72\xf5\xe6\x55\x51\x9d\x28\x42\xab\x9a\xfe\xc7\x20\x8c\xa3\x8e\xd0\x2e\xef\x88\xcc\x14\x69\x47\x56\x52\xea\x08\x93\x77\x17\xe7\x98\xd9\x39\x9e\x47\x4f\x4a\xa7\x31\x8c\xc9\x2d\x94\xa0\x3b\xb4\x56\x69\x19\xe5\xc4\x98\x22\x63\x1c\x01\x68\xcc\x29\x06\x46\xe9\x0f\xbb\x6a\x20\xde\xa2\xa0\x18\x02\xe4\xd4\x97\x9e\x29\x8f\x42\x61\x1b\x0f\x13\x94\x3e\x02\x58\xac\x92\x5b\x9c\x4f\x89\xf1\x3c\x02\xf0\x75\xf0\x6f\xc6\xf3\x60\x13\xac\x8e\x80\x56\xf9\x26\x8a\x23\x6f\x0a\x27\xc8\x07\x9f\x00\xa7\x8d\xdf\x1a\xf7\x18\x12\x0c\xff\x1e\xa7\x4a\xa7\xa1\x86\x80\x01\xa8\xcb\x0b\xb1\x27\x28\x2f\x77\x4c\x58\xb0\xf9\x4a\x9a\x1c\x32\xa5\x31\xb0\x2b\xa8\xb1\xa8\x74\x42\xb9\xcd\x90\x29\x86\x16\xaf\xcf\xf9\xee\xf3\x73\x88\xf9\xf2\xd2\x7a\x05\xec\xa3\xbe\xa4\x7b\x4f\xe9\xc4\xdc\x21\x8b\xf9\xa8\x49\xb6\x2e\x68\x86\x99\x5f\x39\x5f\x95\xd1\x5b\xa0\xca\x82\x9e\x13\xdd\xf3\x9e\x38\xd1\x0b\xd2\x6c\x5c\xb9\x0b\x0f\xe4\x90\x5b\xe7\x99\x5c\x7d\x51\x94\xa5\x71\x55\xfc\x9e\xc7\xe4\x2a\x6e\xde\x00\x30\x3a\x49\x7c\x1c\x3b\xa2\x19\x39\xd2\x6b\x36\xc3\x73\x0a\x4f\x54\xc6\x60\xd1\x91\xe6\x11\xcd\xd6\x06\x00\x9e\x35\xae\x6a\xe3\x96\x45\x2e\x9e\x36\x1e\x36\x8c\xff\xe9\xcc\x5f\x24\xb6\x81\x07\x5b\xbf\xf9\x49\x67\x0a\x1b\xaf\xf3\xcb\x51\xa3\x24\x77\x54\x72\x87\xeb\x2c\xf2\x29\xb9\x2d\xeb\x02\xb3\x82\x56\x2d\x8a\xa1\xd5\xed\x56\xae\xf6\x83\xec\x4a\xad\x6b\xeb\xdc\x43\xbb\x2b\x07\x9b\x7e\x87\x5f\xcd\xc0\x8e\x5e\xd6\xd4\x31\xca\x87\x70\xe2\x08\x79\x41\x9e\x95\xc7\x5f\xf3\xd7\x28\xf6\x61\x0f\xfd\x3e\x12\xdf\x1c\xd6\xc3\xcc\x6d\x14\x72\x88\xb7\x55\x5d\xaf\x28\x39\x36\x84\x4f\x54\x46\x07\xca\xb9\xa1\xf2\xd7\xc3\x87\x79\xdf\xe8\x99\x92\x1b\x5a\xac\xc9\x94\x28\x07\xc7\x22\x3d\x2a\xcc\x1f\x6b\xcc\xde\x91\x3b\x0a\x82\x78\xfb\x60\x4e\x3b\xa2\x71\xab\xc1\xa8\x99\x59\xbf\xdf\xba\x08\xb7\xaa\x0b\x0f\x97\x96\xf6\x18\xf4\x85\xb5\xc6\xb1\xef\x1b\x9d\xaa\xea\xae\xde\x1d\xea\xfd\x0b\xe6\x86\xca\xff\xfe\xe5\x72\x4c\x0b\xdb\x8a\x3f\x2a\xee\xdf\xa6\x87\x2a\xda\xc7\x14\xb1\x39\xfa\x01\x4d\xec\xcf\xf5\x6f\x50\xc5\x7a\xe4\x3e\x3f\x3a\xef\xfb\xe8\xbc\xba\x65\xfe\xdd\x2b\xb3\x99\xea\xf7\x7c\x43\x0e\xcd\x4e\x66\x44\xb5\xe7\xbd\xb9\xd5\xdc\x36\xa0\x8f\x6e\x37\xcf\xcf\xab\x30\x2f\x2f\x9f\xeb\xcd\xe7\x7a\xf3\xb9\xde\x34\xeb\xcd\x3f\x03\x00\x19\xff\x1d\x84\x88\x0f\x00\x00"),
 		},
 		"/tpu.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "tpu.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1526,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x73\xda\x48\x10\xbd\xeb\x57\xbc\x82\xcb\x6e\x95\x2d\x6c\x1f\xb5\x27\x62\x3b\x89\x6a\x1d\xec\x02\xbc\xa9\x9c\x52\xcd\xa8\x91\x66\x3d\x9a\x99\x9d\x69\x41\x58\x2f\xff\x7d\x4b\x48\xc2\x26\x4e\xaa\xa2\x03\x1f\xdd\xaf\xdf\x7b\xfd\x01\x63\x5c\x3b\xbf\x0b\xba\xac\x04\x57\x17\x57\x57\xf8\xe0\x5c\x69\x18\x77\x77\xd7\xc9\x38\x19\xe3\x4e\x2b\xb6\x91\x0b\x34\xb6\xe0\x00\xa9\x18\x53\x4f\xaa\xe2\x21\x73\x86\xbf\x38\x44\xed\x2c\xae\xd2\x0b\xfc\xd6\x02\x46\x7d\x6a\xf4\xfb\x1f\xc9\x18\x3b\xd7\xa0\xa6\x1d\xac\x13\x34\x91\x21\x95\x8e\x58\x6b\xc3\xe0\x6f\x8a\xbd\x40\x5b\x28\x57\x7b\xa3\xc9\x2a\xc6\x56\x4b\x75\x90\xe9\x49\xd2\x64\x8c\x2f\x3d\x85\x5b\x09\x69\x0b\x82\x72\x7e\x07\xb7\x7e\x8d\x03\xc9\xc1\xf0\xe1\xa9\x44\x7c\x36\x99\x6c\xb7\xdb\x94\x0e\x6e\x53\x17\xca\x89\xe9\x90\x71\x72\x97\x5f\xdf\xce\x16\xb7\xe7\x57\xe9\xc5\xa1\xe6\xd1\x1a\x8e\x11\x81\xff\x69\x74\xe0\x02\xab\x1d\xc8\x7b\xa3\x15\xad\x0c\xc3\xd0\x16\x2e\x80\xca\xc0\x5c\x40\x5c\x6b\x78\x1b\xb4\x68\x5b\x9e\x21\xba\xb5\x6c\x29\x70\x32\x46\xa1\xa3\x04\xbd\x6a\xe4\x64\x5a\x83\x3d\x1d\x4f\x00\xce\x82\x2c\x46\xd3\x05\xf2\xc5\x08\xef\xa6\x8b\x7c\x71\x96\x8c\xf1\x39\x5f\x7e\xbc\x7f\x5c\xe2\xf3\x74\x3e\x9f\xce\x96\xf9\xed\x02\xf7\x73\x5c\xdf\xcf\x6e\xf2\x65\x7e\x3f\x5b\xe0\xfe\x3d\xa6\xb3\x2f\xf8\x33\x9f\xdd\x9c\x81\xb5\x54\x1c\xc0\xdf\x7c\x68\xfd\xbb\x00\xdd\xce\x91\x8b\x76\x68\x0b\xe6\x13\x03\x6b\xd7\x19\x8a\x9e\x95\x5e\x6b\x05\x43\xb6\x6c\xa8\x64\x94\x6e\xc3\xc1\x6a\x5b\xc2\x73\xa8\x75\x6c\xb7\x19\x41\xb6\x48\xc6\x30\xba\xd6\x42\x72\x88\xbc\x69\x2a\x4d\x12\xf2\xba\xdf\x7f\x06\xe5\x02\xa7\xca\x86\x3a\x55\xc6\x35\x45\x5a\x1e\x4e\x29\x55\xae\x9e\x6c\x2e\xc9\xf8\x8a\x2e\x93\x27\x6d\x8b\x0c\x0b\x0e\x1b\xad\xf8\x13\x79\xaf\x6d\x99\xd4\x2c\x54\x90\x50\x96\x00\x96\x6a\xce\x20\xbe\xf9\x31\x53\x8f\x88\x9e\x14\x67\x68\x21\xe7\x71\x17\x85\xeb\xa4\xed\xeb\x85\x60\xf9\xf0\x98\x00\x9b\xc1\xda\xe6\x72\xc5\x42\x97\x09\x10\x3b\xe9\x8f\x2e\xca\xec\x28\xd5\xf1\x93\xd7\xb1\xd7\x08\x1c\x5d\x13\x14\xc7\x96\x11\x38\xef\x59\x3b\xdc\x57\xf1\xcd\x57\xeb\x0a\x4e\xba\x6b\xeb\x7a\x5a\x3e\x3c\xce\x5e\x62\xd4\x88\xfb\xc0\x96\x03\x09\x17\x19\x24\x34\x43\x46\x17\x4b\xae\xbd\x21\xe1\x0c\x23\x1f\xdc\xdf\xac\x24\x4e\x9e\x9f\xfb\x8f\xfb\xfd\xc4\x38\xd5\x4d\x7c\xf2\xfc\xfc\xaf\xb3\xbc\xdf\x4f\x5a\xb5\xf6\x6b\x6b\x63\xbf\x1f\xbd\xa1\xba\x26\xfb\x8e\x1f\x23\x17\x4b\xf7\x89\x44\x55\xf3\xde\x7f\xd7\xe2\x9a\x4c\x1c\xe4\x87\xce\xa6\x1b\xd2\xa6\xbd\xef\xdc\x4e\x63\x64\xc9\xed\x86\xad\xb8\xb0\x3b\x85\x0f\xab\xe9\x57\x95\xf5\xe1\x61\xcc\xed\xeb\x31\x64\x68\xc5\x26\x66\xfd\xfb\x77\x7a\xf9\xcd\x4b\xad\x50\x28\x59\xde\x6b\x36\xc5\x09\x45\xa5\x39\x50\x50\x95\x56\x64\xe6\xbc\xe6\xc0\xf6\xb8\x82\x6e\x0d\xb2\xf3\x9c\xa1\x1f\xd5\x31\x0e\x3c\xf1\xee\x18\x9e\xf3\xfa\x3b\xed\x9f\x70\xad\x7b\x07\xbf\x4a\xd7\x3e\x05\x47\x15\xb4\x97\xc3\x55\xfd\x77\xfe\x2a\x03\x2c\x2b\x1e\x8a\x20\x15\x49\xf7\x57\x37\x98\xc0\x8a\x8d\xb3\x65\x84\xb8\xf4\x55\x59\xb9\x79\xca\x4e\x58\xba\x6b\x7a\x78\xe3\xe9\x87\xd7\xfc\x8a\x27\xb8\xc6\x67\x47\xb5\x9a\x2c\x95\x1c\x7e\xf2\x23\xfa\x3f\x00\x00\xff\xff\xeb\xa0\x87\x14\xf6\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x5d\x6f\xda\x48\x14\x7d\xf7\xaf\x38\x82\x97\x5d\x29\x31\x49\x1e\xbd\x4f\x34\x49\x5b\x6b\x53\x12\xf1\xb1\x55\x9f\xaa\xcb\xf8\x62\xcf\x66\x3c\x33\x3b\x73\x0d\x65\xb3\xfc\xf7\x95\xc1\x86\xd0\xb4\x52\x19\x04\xe8\xde\x33\xe7\x9c\xfb\x81\x87\xb8\x75\x7e\x1b\x74\x59\x09\x6e\xae\x6e\x6e\xf0\xc1\xb9\xd2\x30\x1e\x1e\x6e\x93\x61\x32\xc4\x83\x56\x6c\x23\x17\x68\x6c\xc1\x01\x52\x31\xc6\x9e\x54\xc5\x7d\xe6\x02\x7f\x71\x88\xda\x59\xdc\xa4\x57\xf8\xad\x05\x0c\xba\xd4\xe0\xf7\x3f\x92\x21\xb6\xae\x41\x4d\x5b\x58\x27\x68\x22\x43\x2a\x1d\xb1\xd2\x86\xc1\xdf\x14\x7b\x81\xb6\x50\xae\xf6\x46\x93\x55\x8c\x8d\x96\x0a\x72\xe2\x4f\x93\x21\xbe\x74\x14\x6e\x29\xa4\x2d\x08\xca\xf9\x2d\xdc\xea\x35\x0e\x24\x7b\xc3\xfb\x57\x25\xe2\xb3\xd1\x68\xb3\xd9\xa4\xb4\x77\x9b\xba\x50\x8e\xcc\x01\x19\x47\x0f\xf9\xed\xfd\x64\x76\x7f\x79\x93\x5e\xed\xef\x2c\xac\xe1\x18\x11\xf8\x9f\x46\x07\x2e\xb0\xdc\x82\xbc\x37\x5a\xd1\xd2\x30\x0c\x6d\xe0\x02\xa8\x0c\xcc\x05\xc4\xb5\x86\x37\x41\x8b\xb6\xe5\x05\xa2\x5b\xc9\x86\x02\x27\x43\x14\x3a\x4a\xd0\xcb\x46\xce\xba\xd5\xdb\xd3\xf1\x0c\xe0\x2c\xc8\x62\x30\x9e\x21\x9f\x0d\xf0\x6e\x3c\xcb\x67\x17\xc9\x10\x9f\xf3\xf9\xc7\xc7\xc5\x1c\x9f\xc7\xd3\xe9\x78\x32\xcf\xef\x67\x78\x9c\xe2\xf6\x71\x72\x97\xcf\xf3\xc7\xc9\x0c\x8f\xef\x31\x9e\x7c\xc1\x9f\xf9\xe4\xee\x02\xac\xa5\xe2\x00\xfe\xe6\x43\xeb\xdf\x05\xe8\xb6\x8f\x5c\xb4\x4d\x9b\x31\x9f\x19\x58\xb9\xc3\xf8\xa2\x67\xa5\x57\x5a\xc1\x90\x2d\x1b\x2a\x19\xa5\x5b\x73\xb0\xda\x96\xf0\x1c\x6a\x1d\xdb\x69\x46\x90\x2d\x92\x21\x8c\xae\xb5\x90\xec\x23\x6f\x8a\x4a\x93\x84\xbc\xee\xe6\x9f\x41\xb9\xc0\xa9\xb2\xa1\x4e\x95\x71\x4d\x91\x96\xfb\x55\x4a\x95\xab\x47\xeb\x6b\x32\xbe\xa2\xeb\xe4\x59\xdb\x22\xc3\x8c\xc3\x5a\x2b\xfe\x44\xde\x6b\x5b\x26\x35\x0b\x15\x24\x94\x25\x80\xa5\x9a\x33\x88\x6f\x7e\xcc\xd4\x21\xa2\x27\xc5\x19\x5a\xc8\x65\xdc\x46\xe1\x3a\x69\xeb\x3a\x11\xcc\x9f\x16\x09\xb0\xee\xad\xad\xaf\x97\x2c\x74\x9d\x00\xf1\x20\xfd\xd1\x45\x99\x1c\xa5\x0e\xfc\xe4\x75\xec\x34\x02\x47\xd7\x04\xc5\xb1\x65\x04\x2e\x3b\xd6\x03\xee\xab\xf8\xe6\xab\x75\x05\xef\x73\xc0\xa1\xa6\xf9\xd3\x62\x72\x8a\x51\x23\xee\x03\x5b\x0e\x24\x5c\x64\x90\xd0\xf4\x19\x5d\xcc\xb9\xf6\x86\x84\x33\x0c\x7c\x70\x7f\xb3\x92\x38\x7a\x79\xe9\x7e\xee\x76\x23\xe3\xd4\xa1\xe3\xa3\x97\x97\x7f\x9d\xe5\xdd\x6e\xd4\xaa\xb5\xa0\xd6\xc6\x6e\x37\x78\x43\x75\x4b\xf6\x1d\x2f\x22\x17\x73\xf7\x89\x44\x55\xd3\xce\xff\xa1\xc4\x15\x99\xd8\xcb\xf7\x95\x8d\xd7\xa4\x4d\xbb\xdf\xb9\x1d\xc7\xc8\x92\xdb\x35\x5b\x71\x61\x7b\x0e\xef\x47\xd3\x8d\x2a\xeb\xc2\x7d\x9b\xdb\xcf\x63\xc8\xd0\x92\x4d\xcc\xba\xef\xef\xf4\xf2\xbb\xd3\x5d\xa1\x50\xb2\xbc\xd7\x6c\x8a\x33\x8a\x4a\x73\xa0\xa0\x2a\xad\xc8\x4c\x79\xc5\x81\xed\x71\x04\xed\xfb\x12\xb2\xf5\x9c\xa1\x6b\xd5\x31\x0e\x3c\xf3\xf6\x18\x9e\xf2\xea\x3b\xed\x9f\x70\xad\x3a\x07\xbf\x4a\xd7\x9e\x82\xa3\x0a\xda\xb7\xe3\xc9\xf0\xdf\xe5\xab\x0c\x30\xaf\xb8\xbf\x04\xa9\x48\x0e\x8f\xba\xde\x04\x96\x6c\x9c\x2d\x23\xc4\xa5\xaf\xae\x95\xeb\xe7\x93\xa9\xd3\x36\x3d\xbd\xf1\xf4\xc3\x6d\x3e\x9d\x32\xb8\xc6\x67\x47\xb5\x9a\x2c\x95\x1c\x7e\xf2\x27\xfa\x7f\x00\xeb\xa0\x87\x14\xf6\x05\x00\x00"),
 		},
 		"/vertexai.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "vertexai.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 7492,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4f\x73\xda\xc8\x13\xbd\xeb\x53\x74\xc1\xe5\xf7\xab\xb2\x21\xf6\x51\x7b\x22\x18\x27\xaa\xb5\xf1\x16\x90\xa4\x72\xa2\x1a\xa9\x91\x66\x23\xcd\xcc\xce\xb4\xc0\x14\xcb\x77\xdf\xd2\x3f\x90\x0c\xfe\xb7\xe5\xa4\xd8\x04\x2e\x56\xcd\xf4\xbc\x7e\xd3\xf3\x5e\x8f\xa7\x0d\x7d\xa5\x57\x46\x84\x11\xc3\xe5\xbb\xcb\x4b\xf8\xa0\x54\x18\x13\xdc\xdc\xf4\x9d\xb6\xd3\x86\x1b\xe1\x93\xb4\x14\x40\x2a\x03\x32\xc0\x11\x41\x4f\xa3\x1f\x51\x35\x73\x06\x9f\xc9\x58\xa1\x24\x5c\x76\xde\xc1\xff\xb2\x80\x56\x39\xd5\xfa\xff\x6f\x4e\x1b\x56\x2a\x85\x04\x57\x20\x15\x43\x6a\x09\x38\x12\x16\xe6\x22\x26\xa0\x7b\x9f\x34\x83\x90\xe0\xab\x44\xc7\x02\xa5\x4f\xb0\x14\x1c\xe5\x69\x4a\x90\x8e\xd3\x86\xaf\x25\x84\x9a\x31\x0a\x09\x08\xbe\xd2\x2b\x50\xf3\x7a\x1c\x20\xe7\x84\xf3\x5f\xc4\xac\xdd\x6e\x77\xb9\x5c\x76\x30\x67\xdb\x51\x26\xec\xc6\x45\xa4\xed\xde\x78\xfd\xc1\x70\x3c\x38\xbf\xec\xbc\xcb\xd7\x7c\x92\x31\x59\x0b\x86\xfe\x4a\x85\xa1\x00\x66\x2b\x40\xad\x63\xe1\xe3\x2c\x26\x88\x71\x09\xca\x00\x86\x86\x28\x00\x56\x19\xe1\xa5\x11\x2c\x64\x78\x06\x56\xcd\x79\x89\x86\x9c\x36\x04\xc2\xb2\x11\xb3\x94\x1b\xd5\xaa\xe8\x09\xdb\x08\x50\x12\x50\x42\xab\x37\x06\x6f\xdc\x82\xf7\xbd\xb1\x37\x3e\x73\xda\xf0\xc5\x9b\x7c\xbc\xfb\x34\x81\x2f\xbd\xd1\xa8\x37\x9c\x78\x83\x31\xdc\x8d\xa0\x7f\x37\xbc\xf2\x26\xde\xdd\x70\x0c\x77\xd7\xd0\x1b\x7e\x85\xdf\xbd\xe1\xd5\x19\x90\xe0\x88\x0c\xd0\xbd\x36\x19\x7f\x65\x40\x64\x75\xa4\x20\x2b\xda\x98\xa8\x41\x60\xae\x0a\x42\x56\x93\x2f\xe6\xc2\x87\x18\x65\x98\x62\x48\x10\xaa\x05\x19\x29\x64\x08\x9a\x4c\x22\x6c\x76\x9a\x16\x50\x06\x4e\x1b\x62\x91\x08\x46\xce\x47\xf6\x36\xd5\x71\x1c\xd4\xa2\x3c\x7f\x17\x7c\x65\xa8\xe3\x4b\x93\x74\xfc\x58\xa5\x41\x27\xcc\xa5\xd4\xf1\x55\xd2\x5d\x5c\x60\xac\x23\xbc\x70\xbe\x09\x19\xb8\x30\x26\xb3\x10\x3e\xdd\xa2\xd6\x42\x86\x4e\x42\x8c\x01\x32\xba\x0e\x80\xc4\x84\x5c\x58\x90\x61\xba\x47\x71\x18\xae\x0c\xb3\x1a\x7d\x72\x21\x0b\x39\xb7\x2b\xcb\x94\x38\xd9\xe6\x76\x28\x9f\x73\x94\x9e\xe7\x40\x06\x58\x90\x5c\x5c\xcc\x88\xf1\xc2\x01\xb0\x05\x89\x8f\xca\xf2\xb0\x99\xb4\xc8\x84\x5a\xd8\x32\x9b\x21\xab\x52\xe3\x93\xcd\xb0\x01\xce\x4b\xfc\x22\x6e\x5a\xac\x9b\xa2\x98\x66\x9b\xb0\xc4\x4e\xa1\xc2\x62\xaf\x15\x89\xab\xc6\x1c\xa6\xac\x3e\x90\x24\x83\x4c\x81\x0b\x6c\x52\x2a\x67\x44\x30\xa1\x44\xc7\xc8\xe4\x42\x6b\xbd\xce\x32\x6d\x36\xad\xbd\xc9\x3e\xca\xf7\xf4\xc9\x52\x30\x51\xb7\xc8\x7e\x34\x2a\x29\x16\x5b\x99\x63\x6c\x2b\xc0\x8a\x7c\x6f\x81\x22\xce\x14\xed\xc9\x9e\xb5\xc4\x9e\x5c\x90\x64\x65\x56\xcd\xf0\xea\x30\xca\xc3\x71\xcb\x61\x80\x18\x67\x14\x5b\xb7\xfc\x5b\x0e\x67\x45\x24\xb3\xdd\x89\x77\x75\x2d\x28\x0e\xdc\xbc\x40\x0f\xf2\x7b\x57\x3b\x2c\x46\x13\x12\xef\xc7\x02\x2c\x30\x4e\xa9\x56\x01\x6d\xd4\x9f\xe4\xb3\xed\xae\xd7\xe5\xe7\x66\xd3\x8d\x95\x5f\x68\xb2\xbb\x5e\x1b\x0a\x85\x92\x9b\x4d\xb7\x2c\x7e\x36\x96\x83\xec\xaa\x16\x09\x32\x68\xfc\x48\xf8\x18\x8f\x68\x4e\x86\xe4\xf6\x2c\x8b\xf3\xe4\x95\x26\x17\xca\x04\xdb\x71\x80\x6f\xb4\xda\x0e\x8f\x68\xfe\x60\x47\x8f\x60\xcd\xcb\x7d\xbd\x14\x2e\xfb\x05\x64\x7d\x23\x34\xe7\x1a\xfd\xfb\xbc\x36\x03\x30\x89\xa8\x5a\x04\x1c\x21\x17\x2d\xb4\x22\x01\x33\x8a\x95\x0c\x2d\xb0\xea\xd4\x96\x85\x8b\x6f\x6e\x03\xa5\x50\xe3\x1f\x7b\x9c\x0e\x7a\xa3\x86\x63\x54\xaa\xdd\x6d\xb6\x04\x25\x86\x64\x1e\xf5\xe5\x13\xe6\x20\x19\x68\x25\xe4\x61\x77\x0c\x9a\x93\x2f\xb4\xc7\xb3\xe2\xa8\xbe\x37\x9b\x6e\x95\x3e\x1b\x3e\x1e\x53\x15\xb5\x6a\x38\xe0\xa0\xcf\x5e\x63\xa2\x93\xdc\x8f\x41\xee\x73\x42\x4e\x0d\x59\x56\x86\x0e\x4a\xfe\x7a\x3f\xe0\x09\xd9\x63\xd2\x57\x72\x2e\x6a\xd2\xd1\x2a\x16\xfe\x6a\xf8\x7c\xf6\xa9\xc0\x64\x5a\x44\x3f\x58\x7c\x4b\xc9\x8c\xcc\x4b\x21\x92\x3c\x7a\x0b\x61\x2a\x39\x14\xe7\x5f\x2b\x63\x51\x91\x03\x05\xc8\x55\x9b\x6b\xaf\xa1\x78\x9b\x6a\xad\x0c\xdb\xbe\x92\x81\xc8\xad\xdb\x74\xcf\xeb\x3c\xbf\xbd\x10\xea\x04\x4e\xae\x3f\xb9\xfe\x47\xbb\x7e\x4a\x92\x05\xaf\xb2\xb2\x3f\xdb\x00\x06\x79\xe8\x64\x17\xfa\x9d\x5a\xc1\x8e\xd2\x5b\x74\x85\x07\x68\xaf\x6c\x10\x7b\xe5\x81\x37\x68\x0f\xeb\x75\x9d\x61\x7e\xef\x57\x95\xfd\xd9\x7b\xc0\x6b\x45\x59\x8d\xbf\x42\x9c\xd7\x8d\x15\x2f\x7e\xc4\xec\x72\xd6\xda\xf2\xaf\x7a\x1a\x42\x06\x74\x7f\xb0\xe6\x5e\x6d\xe6\xad\xfe\x03\xde\xde\x86\x79\xda\x23\xab\xfa\x8f\x78\x52\x9e\xee\xc5\x63\xb8\x17\x73\xf5\x3d\xfd\x04\xcc\xd5\xff\x9d\xde\x81\x4d\x17\x0c\x8e\xf2\x29\x78\x32\xc3\xaf\x62\x86\x4a\x02\xd3\xc7\x1f\x87\xb7\x65\xc8\xf8\x65\xaf\xc3\x7f\x67\x86\xa4\x9e\xe4\xb8\xcc\xb0\x77\x1f\x9f\x54\xfe\x5f\x53\x39\x93\xb4\xca\xcc\x14\x9a\xe0\xa0\xc4\x27\x7b\xf3\x6f\x2e\xf0\x1a\x85\xe3\x92\xf7\xa9\xd7\xff\x44\x2e\xf8\x27\x00\x00\xff\xff\x57\xbd\x50\xdc\x44\x1d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xdf\x6f\xe2\x46\x10\x7e\xf7\x5f\x31\x82\x97\x56\x4a\xe0\x92\x47\xf7\x89\x23\xc9\x9d\xd5\x84\x54\xc0\xdd\xe9\x9e\xd0\x60\x0f\xf6\xf6\xec\xdd\xed\xee\x18\x62\x51\xfe\xf7\xca\xbf\xc0\x0e\x5c\x7e\x54\xc9\x35\x6d\x09\x91\x40\x3b\xb3\x33\xdf\xcc\x7e\xdf\xac\xb6\x0b\x43\xa5\x33\x23\xc2\x88\xe1\xfc\xdd\xf9\x39\x7c\x50\x2a\x8c\x09\xae\xaf\x87\x4e\xd7\xe9\xc2\xb5\xf0\x49\x5a\x0a\x20\x95\x01\x19\xe0\x88\x60\xa0\xd1\x8f\xa8\xb6\x9c\xc0\x67\x32\x56\x28\x09\xe7\xbd\x77\xf0\x53\xee\xd0\xa9\x4c\x9d\x9f\x7f\x71\xba\x90\xa9\x14\x12\xcc\x40\x2a\x86\xd4\x12\x70\x24\x2c\x2c\x44\x4c\x40\x77\x3e\x69\x06\x21\xc1\x57\x89\x8e\x05\x4a\x9f\x60\x25\x38\x02\xde\xc5\xef\x39\x5d\xf8\x5a\x85\x50\x73\x46\x21\x01\xc1\x57\x3a\x03\xb5\x68\xfa\x01\x72\x01\xb8\xf8\x8b\x98\xb5\xdb\xef\xaf\x56\xab\x1e\x16\x68\x7b\xca\x84\xfd\xb8\xf4\xb4\xfd\x6b\x6f\x78\x39\x9a\x5c\x9e\x9e\xf7\xde\x15\x7b\x3e\xc9\x98\xac\x05\x43\x7f\xa4\xc2\x50\x00\xf3\x0c\x50\xeb\x58\xf8\x38\x8f\x09\x62\x5c\x81\x32\x80\xa1\x21\x0a\x80\x55\x0e\x78\x65\x04\x0b\x19\x9e\x80\x55\x0b\x5e\xa1\x21\xa7\x0b\x81\xb0\x6c\xc4\x3c\xe5\x56\xb7\x6a\x78\xc2\xb6\x1c\x94\x04\x94\xd0\x19\x4c\xc0\x9b\x74\xe0\xfd\x60\xe2\x4d\x4e\x9c\x2e\x7c\xf1\xa6\x1f\x6f\x3f\x4d\xe1\xcb\x60\x3c\x1e\x8c\xa6\xde\xe5\x04\x6e\xc7\x30\xbc\x1d\x5d\x78\x53\xef\x76\x34\x81\xdb\x2b\x18\x8c\xbe\xc2\xaf\xde\xe8\xe2\x04\x48\x70\x44\x06\xe8\x4e\x9b\x1c\xbf\x32\x20\xf2\x3e\x52\x90\x37\x6d\x42\xd4\x02\xb0\x50\xe5\xf1\x59\x4d\xbe\x58\x08\x1f\x62\x94\x61\x8a\x21\x41\xa8\x96\x64\xa4\x90\x21\x68\x32\x89\xb0\xf9\x69\x5a\x40\x19\x38\x5d\x88\x45\x22\x18\xb9\x58\xd9\x2b\xaa\xe7\x38\xa8\x45\x75\xfe\x2e\xf8\xca\x50\xcf\x97\x26\xe9\xf9\xb1\x4a\x83\x5e\x58\x50\xa9\xe7\xab\xa4\xbf\x3c\xc3\x58\x47\x78\xe6\x7c\x13\x32\x70\x61\x42\x66\x29\x7c\xba\x41\xad\x85\x0c\x9d\x84\x18\x03\x64\x74\x1d\x00\x89\x09\xb9\xb0\x24\xc3\x74\x87\xe2\x70\xb8\xca\xcd\x6a\xf4\xc9\x85\xdc\xe5\xd4\x66\x96\x29\x71\xf2\xe2\x76\x51\x3e\x17\x51\x06\x9e\x03\x79\xc0\xbc\x2c\x17\x96\x67\x73\x62\x3c\x73\x00\x6c\x09\xe2\xa3\xb2\x3c\x6a\x27\x2d\x33\xa1\x16\xb6\xca\x66\xc8\xaa\xd4\xf8\x64\xf3\xd8\x00\xa7\x55\xfc\xd2\x6f\x56\x82\x9d\xa1\x98\xe5\x45\x58\xe2\xc2\x09\xa0\xac\xb5\x06\x71\xd1\xb2\x61\xca\xea\x03\x49\x32\xc8\x14\xb8\xc0\x26\xa5\xca\x22\x82\x29\x25\x3a\x46\x26\x17\x3a\xeb\x75\x9e\x69\xb3\xe9\xec\x19\x87\x28\xdf\xd3\x27\x4b\xc1\x54\xdd\x20\xfb\xd1\xb8\x82\x58\x96\xb2\xc0\xd8\xd6\x01\x6b\xf0\x83\x25\x8a\x38\x67\xb4\x27\x07\xd6\x12\x7b\x72\x49\x92\x95\xc9\xda\xee\xf5\x61\x54\x87\xe3\x56\xcb\x00\x31\xce\x29\xb6\x6e\xf5\x5d\x2d\xe7\x4d\x24\xb3\xad\xc4\xbb\xb8\x12\x14\x07\x6e\xd1\xa0\x7b\xf9\xbd\x8b\x5d\x2c\x46\x13\x12\xef\xfb\x02\x2c\x31\x4e\xa9\xd1\x01\x6d\xd4\xef\xe4\xb3\xed\xaf\xd7\xd5\xcf\xcd\xa6\x1f\x2b\xbf\xe4\x64\x7f\xbd\x36\x14\x0a\x25\x37\x9b\x7e\xd5\xfc\xdc\xb3\x08\xb2\xeb\x5a\x24\xc8\xa0\xf1\x23\xe1\x63\x3c\xa6\x05\x19\x92\xdb\xb3\xcc\xff\x4f\x81\x33\x4d\x2e\x54\x09\xb6\xeb\x00\xdf\x28\xdb\x2e\x8f\x69\x71\xaf\xa2\xef\xc4\x5a\x54\x75\x3d\x35\x5c\xfe\x09\xc8\xfa\x46\xe8\xbc\x28\x17\xfe\x3c\x6d\x58\x00\xa6\x11\xd5\x9b\x80\x23\xe4\x72\x84\xd6\x20\x60\x4e\xb1\x92\xa1\x05\x56\xbd\xc6\xb6\x70\xf9\x6d\x07\x6a\xc7\xc6\xdf\xf6\x30\x1d\xd4\xc6\xee\x13\x1a\x95\x6a\x77\x9b\x2d\x41\x89\x21\x99\xef\xea\xf2\x01\x71\x90\x0c\xb4\x12\xf2\xb0\x3a\x2e\xdb\xc6\x27\xca\xe3\x51\x72\xd4\xbf\x37\x9b\x7e\x9d\xde\xf6\xdf\x92\xa8\x72\x24\xf7\x14\x70\x50\x67\xcf\x11\xd1\x91\xee\x6f\x81\xee\x0b\x42\x4e\x0d\x59\x56\x86\x0e\x52\xfe\x6a\xdf\xe1\x01\xda\x63\x32\x54\x72\x21\x1a\xd4\xd1\x2a\x16\x7e\x36\x7a\x3c\xfb\x4c\x60\x32\x2b\xbd\xef\x6d\xbe\xa1\x64\x4e\xe6\xa9\x21\x92\xc2\x7b\x1b\xc2\xd4\x74\x28\xcf\x7f\xbb\x5e\x93\xba\xb9\xbf\x61\x2c\xb9\xd7\x62\xbc\x4d\xb5\x56\x86\xed\x50\xc9\x40\xe4\x9c\xb0\x6d\xf5\x88\xe0\x6f\x5d\x08\x4d\x00\x47\xd5\x1f\x55\xff\xa3\x55\x3f\x23\xc9\x82\xb3\xbc\xed\x8f\x0e\x80\xcb\xc2\x75\x9a\xe9\x57\x1e\x05\x3b\x48\x2f\x31\x15\xee\x45\x7b\xe6\x80\xd8\xed\x7e\xc1\xf1\xb0\x5e\x37\x11\x16\xf7\x7e\xdd\xd9\xff\xfa\x0c\x78\x2e\x29\xeb\xf5\x67\x90\xf3\xaa\xb5\xe3\x01\x8e\xb6\x8f\x64\x97\xb3\x31\x96\xff\xaf\xa7\x21\x64\x40\x77\x07\x7b\xee\x35\x2c\x4f\xec\xed\xd3\x6f\xc3\x22\xed\x1b\xeb\xfa\x8f\x78\x52\x1e\xef\xc5\xb7\x70\x2f\x16\xec\x7b\xf8\x09\x58\xb0\xff\x95\xde\x81\x6d\x15\xd4\x49\x8e\x62\x38\x8a\xe1\x9f\x10\x43\x4d\x81\x59\xf3\x6d\xd4\x16\xc3\x4d\xe5\x32\x69\x78\xbc\xb8\x18\x6a\x1c\x45\x92\x37\x7e\x1f\x1f\x59\xfe\x6f\x63\x39\x93\xb4\xca\xcc\x15\x9a\xe0\x20\xc5\xa7\x7b\xf6\x17\x27\x78\x03\xc2\x71\xd6\x1f\x67\xfd\x2b\xcd\xfa\xbf\x06\x00\x57\xbd\x50\xdc\x44\x1d\x00\x00"),
 		},
 		"/vmwareengine.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "vmwareengine.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2653,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xc1\x6e\xdb\x46\x14\xbc\xf3\x2b\x06\xd2\xa5\x05\x6c\xca\xf6\x91\x3d\x29\xb2\x93\x10\x75\xe4\x40\x92\x13\xe4\x14\x3c\x2d\x9f\xc8\xad\xc9\xdd\xed\xee\xa3\x14\x41\xd5\xbf\x17\x14\x29\x87\x8a\xab\x20\x3d\xf4\x52\x84\x07\x83\xd8\x37\x3b\x33\x3b\x3b\xa6\x86\x98\x58\xb7\xf5\x3a\x2f\x04\x37\x57\x37\x37\x78\x63\x6d\x5e\x32\xee\xef\x27\xd1\x30\x1a\xe2\x5e\x2b\x36\x81\x33\xd4\x26\x63\x0f\x29\x18\x63\x47\xaa\xe0\xe3\xe4\x02\x1f\xd8\x07\x6d\x0d\x6e\xe2\x2b\xfc\xd2\x00\x06\xdd\x68\xf0\xeb\x6f\xd1\x10\x5b\x5b\xa3\xa2\x2d\x8c\x15\xd4\x81\x21\x85\x0e\x58\xe9\x92\xc1\x5f\x14\x3b\x81\x36\x50\xb6\x72\xa5\x26\xa3\x18\x1b\x2d\xc5\x41\xa6\x23\x89\xa3\x21\x3e\x75\x14\x76\x29\xa4\x0d\x08\xca\xba\x2d\xec\xaa\x8f\x03\xc9\xc1\xf0\xe1\x29\x44\x5c\x32\x1a\x6d\x36\x9b\x98\x0e\x6e\x63\xeb\xf3\x51\xd9\x22\xc3\xe8\x3e\x9d\xdc\x4d\xe7\x77\x97\x37\xf1\xd5\x61\xcf\xa3\x29\x39\x04\x78\xfe\xb3\xd6\x9e\x33\x2c\xb7\x20\xe7\x4a\xad\x68\x59\x32\x4a\xda\xc0\x7a\x50\xee\x99\x33\x88\x6d\x0c\x6f\xbc\x16\x6d\xf2\x0b\x04\xbb\x92\x0d\x79\x8e\x86\xc8\x74\x10\xaf\x97\xb5\x9c\xa4\x75\xb4\xa7\xc3\x09\xc0\x1a\x90\xc1\x60\x3c\x47\x3a\x1f\xe0\xd5\x78\x9e\xce\x2f\xa2\x21\x3e\xa6\x8b\xb7\x0f\x8f\x0b\x7c\x1c\xcf\x66\xe3\xe9\x22\xbd\x9b\xe3\x61\x86\xc9\xc3\xf4\x36\x5d\xa4\x0f\xd3\x39\x1e\x5e\x63\x3c\xfd\x84\xdf\xd3\xe9\xed\x05\x58\x4b\xc1\x1e\xfc\xc5\xf9\xc6\xbf\xf5\xd0\x4d\x8e\x9c\x35\xa1\xcd\x99\x4f\x0c\xac\x6c\x6b\x28\x38\x56\x7a\xa5\x15\x4a\x32\x79\x4d\x39\x23\xb7\x6b\xf6\x46\x9b\x1c\x8e\x7d\xa5\x43\x73\x9b\x01\x64\xb2\x68\x88\x52\x57\x5a\x48\x0e\x2b\x2f\x0e\x15\x47\x11\x39\xdd\xdd\x7f\x02\x65\x3d\xc7\xca\xf8\x2a\x56\xa5\xad\xb3\x38\x3f\x54\x29\x56\xb6\x1a\xad\xaf\xa9\x74\x05\x5d\x47\x4f\xda\x64\x09\xe6\xec\xd7\x5a\xf1\x3b\x72\x4e\x9b\x3c\xaa\x58\x28\x23\xa1\x24\x02\x0c\x55\x9c\x60\x5d\x35\xa1\xb2\xc9\xb5\x39\x43\xd9\x41\x83\x23\xc5\x09\x1a\xc8\x65\xd8\x06\xe1\x2a\x6a\x0e\xf8\x95\xe9\x43\x8f\x29\x02\xd6\x47\xb3\xeb\xeb\x25\x0b\x5d\x47\x40\x68\xcd\xbc\xb5\x41\xa6\x2f\xc5\x5b\x45\x72\x3a\x74\xaa\x9e\x83\xad\xbd\xe2\xd0\x68\x00\x97\x9d\x4e\x8b\xfb\xdc\xdf\xfb\x59\x95\x75\x10\xf6\x51\x5b\xca\xf6\xe8\x7d\x3f\x93\x93\x39\xd5\x62\xdf\xb0\x61\x4f\xc2\x59\x02\xf1\x35\x77\x13\x9d\x2d\xb8\x72\x25\x09\x27\x18\xec\x76\x8e\x3c\x1b\xd9\xef\x47\x1d\x7f\x18\xed\x76\x8d\x89\xfd\x7e\xf0\x62\xc3\x84\xcc\x2b\x7e\x0c\x9c\x2d\xec\x3b\x12\x55\xcc\x3a\xf7\xed\x49\x57\x54\x86\xa3\xc8\xf1\x5c\xe3\x35\xe9\xb2\x29\x7e\x6a\xc6\x21\xb0\xa4\x66\xcd\x46\xac\xdf\x9e\xc2\x8f\x77\xd6\xdd\x61\xd2\x2d\x1f\x63\x6f\xfe\x7e\x43\x9c\xde\x7e\x05\x09\xf9\x9c\xe5\xb5\xe6\x32\xeb\x61\xbf\x97\xa5\x61\xd9\x58\xff\x74\x36\xcb\xe9\xc9\xfc\x07\xb3\x74\xde\xfe\xc1\x4a\x9a\x00\xbb\xd7\xfd\x7e\x54\x5a\xd5\x16\x7e\xb4\xdb\x1d\xdf\xf7\xfb\x51\xeb\xe6\xae\x2f\xf6\xbf\x09\x1e\x28\x34\x7b\xf2\xaa\xd0\x8a\xca\x19\xaf\xd8\xb3\x79\xae\x78\x7b\x35\xb2\x75\x9c\xa0\x8b\xe9\x79\x1d\x78\xe2\xed\xf3\xf2\x8c\x57\xdf\x68\x9f\xe1\x5a\x75\x0e\x7e\x94\xae\x79\x32\x0e\xca\x6b\x27\x87\x7f\xdf\xbf\x2e\x7b\x13\x60\x51\xf0\x71\x13\xa4\x20\x69\x7f\x65\x8e\x26\xb0\xe4\xd2\x9a\x3c\x40\x6c\xdc\xdb\x96\xaf\x9f\x92\x13\x96\xb6\x55\xef\x5f\x78\xfa\xc7\xcf\x46\x8f\xc7\xdb\xda\x25\xcf\x6a\x15\x19\xca\xd9\x9f\xfd\x6c\x7d\xbf\xe7\xce\xeb\x35\x49\xf3\xed\xb0\x75\x76\xb6\xed\xef\x5b\xd4\xa4\x07\xfa\x0f\x2a\xef\x7a\x2a\x3f\xbb\xfe\xb3\xeb\xff\xb6\xeb\x7f\x07\x00\x00\xff\xff\x68\xc4\x3d\x54\x5d\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x51\x6f\xda\x48\x10\x7e\xf7\xaf\xf8\x04\x2f\x77\x52\x62\x92\x3c\xfa\x9e\x28\x49\x5b\xeb\x52\x52\x01\x69\xd5\xa7\x68\x58\x0f\xf6\x5e\xec\xdd\xbd\xdd\x31\x14\x71\xfc\xf7\x93\xb1\x09\xd0\x1c\x55\xef\xe1\x5e\x4e\xc5\x08\x59\x3b\xdf\xcc\x7c\xf3\xcd\xa7\xa5\x8f\x91\x75\x6b\xaf\xf3\x42\x70\x73\x75\x73\x83\x77\xd6\xe6\x25\xe3\xfe\x7e\x14\xf5\xa3\x3e\xee\xb5\x62\x13\x38\x43\x6d\x32\xf6\x90\x82\x31\x74\xa4\x0a\xde\x47\x2e\xf0\x89\x7d\xd0\xd6\xe0\x26\xbe\xc2\x2f\x0d\xa0\xd7\x85\x7a\xbf\xfe\x16\xf5\xb1\xb6\x35\x2a\x5a\xc3\x58\x41\x1d\x18\x52\xe8\x80\x85\x2e\x19\xfc\x55\xb1\x13\x68\x03\x65\x2b\x57\x6a\x32\x8a\xb1\xd2\x52\x40\x0e\xf5\xe3\xa8\x8f\x2f\x5d\x09\x3b\x17\xd2\x06\x04\x65\xdd\x1a\x76\x71\x8c\x03\xc9\x8e\xf0\xee\x53\x88\xb8\x64\x30\x58\xad\x56\x31\xed\xd8\xc6\xd6\xe7\x83\xb2\x45\x86\xc1\x7d\x3a\xba\x1b\x4f\xef\x2e\x6f\xe2\xab\x5d\xce\xa3\x29\x39\x04\x78\xfe\xb3\xd6\x9e\x33\xcc\xd7\x20\xe7\x4a\xad\x68\x5e\x32\x4a\x5a\xc1\x7a\x50\xee\x99\x33\x88\x6d\x08\xaf\xbc\x16\x6d\xf2\x0b\x04\xbb\x90\x15\x79\x8e\xfa\xc8\x74\x10\xaf\xe7\xb5\x9c\xa8\xb5\xa7\xa7\xc3\x09\xc0\x1a\x90\x41\x6f\x38\x45\x3a\xed\xe1\xcd\x70\x9a\x4e\x2f\xa2\x3e\x3e\xa7\xb3\xf7\x0f\x8f\x33\x7c\x1e\x4e\x26\xc3\xf1\x2c\xbd\x9b\xe2\x61\x82\xd1\xc3\xf8\x36\x9d\xa5\x0f\xe3\x29\x1e\xde\x62\x38\xfe\x82\xdf\xd3\xf1\xed\x05\x58\x4b\xc1\x1e\xfc\xd5\xf9\x86\xbf\xf5\xd0\x8d\x8e\x9c\x35\xa2\x4d\x99\x4f\x08\x2c\x6c\xbb\xbe\xe0\x58\xe9\x85\x56\x28\xc9\xe4\x35\xe5\x8c\xdc\x2e\xd9\x1b\x6d\x72\x38\xf6\x95\x0e\xcd\x36\x03\xc8\x64\x51\x1f\xa5\xae\xb4\x90\xec\x4e\x5e\x0d\x15\x47\x11\x39\xdd\xed\x3f\x81\xb2\x9e\x63\x65\x7c\x15\xab\xd2\xd6\x59\x9c\xef\xac\x14\x2b\x5b\x0d\x96\xd7\x54\xba\x82\xae\xa3\x67\x6d\xb2\x04\x53\xf6\x4b\xad\xf8\x03\x39\xa7\x4d\x1e\x55\x2c\x94\x91\x50\x12\x01\x86\x2a\x4e\xb0\xac\x1a\x51\xd9\xe4\xda\x9c\x29\xd9\x41\x83\x23\xc5\x09\x1a\xc8\x65\x58\x07\xe1\x2a\x6a\x06\x3c\x54\xfa\x74\x54\x29\x02\x96\x7b\xb2\xcb\xeb\x39\x0b\x5d\x47\x40\x68\xc9\xbc\xb7\x41\xc6\xaf\x9b\xb7\x43\x90\xd3\xa1\xeb\xea\x39\xd8\xda\x2b\x0e\x4d\x0f\xe0\xb2\xeb\xd3\xe2\x9e\x8e\x73\x9f\x54\x59\x07\x61\xbf\xc3\x01\xed\xe8\xc7\x7c\x46\x27\x71\xaa\xc5\xbe\x63\xc3\x9e\x84\xb3\x04\xe2\x6b\xee\x22\x3a\x9b\x71\xe5\x4a\x12\x4e\xd0\xdb\x6c\x1c\x79\x36\xb2\xdd\x0e\xba\xfa\x61\xb0\xd9\x34\x24\xb6\xdb\xde\xab\x84\x11\x99\x37\xfc\x18\x38\x9b\xd9\x0f\x24\xaa\x98\x74\xec\xdb\x49\x17\x54\x86\x7d\x93\xfd\x5c\xc3\x25\xe9\xb2\x31\x7e\x6a\x86\x21\xb0\xa4\x66\xc9\x46\xac\x5f\x9f\xc2\xf7\x3b\xeb\x76\x98\x74\xc7\x7b\xd9\x9b\xdf\x6f\x0a\xa7\xb7\x07\x90\x90\xcf\x59\xde\x6a\x2e\xb3\x23\xec\xf7\xb4\x34\x2c\x2b\xeb\x9f\xcf\x6a\x39\x3e\x89\xff\xa0\x96\xce\xdb\x3f\x58\x49\x23\x60\xf7\xba\xdd\x0e\x4a\xab\x5a\xc3\x0f\x36\x9b\xfd\xfb\x76\x3b\x68\x37\x7b\x77\xdc\xec\x7f\x23\x3c\x50\x68\xf6\xe4\x55\xa1\x15\x95\x13\x5e\xb0\x67\xf3\x62\xf1\xe6\x7b\x09\x59\x3b\x4e\xd0\xc9\xf4\x72\x0e\x3c\xf3\xfa\xe5\x78\xc2\x8b\x6f\x7a\x9f\xa9\xb5\xe8\x18\xfc\x68\xb9\xe6\xc9\x38\x28\xaf\x5d\xb3\x8e\x04\x7f\x5d\x1e\x45\x80\x59\xc1\xfb\x24\x48\x41\xd2\xfe\xcb\xec\x49\x60\xce\xa5\x35\x79\x80\xd8\xf8\x28\x2d\x5f\x3e\x1f\x48\x1d\x5c\xf5\xf1\x15\xa7\x7f\xbc\x36\x0e\x4f\xee\x6d\xed\x92\x97\x6e\x15\x19\xca\xd9\x9f\xbd\xb6\xbe\xef\x73\xe7\xf5\x92\x84\x9f\x76\x89\x67\xdd\xfe\xb1\x45\x8d\x8e\x40\xff\x81\xe5\x3b\x2e\xbb\x2e\x3f\xbd\xfe\xd3\xeb\xff\xd6\xeb\x7f\x0f\x00\x68\xc4\x3d\x54\x5d\x0a\x00\x00"),
 		},
 		"/vpcaccess.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "vpcaccess.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1551,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xdb\x48\x0c\xbd\xeb\x2b\x1e\xec\xcb\x2e\x90\xc8\x49\x8e\xda\x93\xeb\xa4\xad\xb0\xa9\x5d\xd8\x4e\x8b\x9e\x02\x7a\x44\x4b\xb3\x19\xcd\xcc\xce\x50\x76\x8d\xac\xff\x7d\x21\x4b\x76\xe3\xa4\x05\xaa\x83\x61\x90\x8f\x8f\x8f\x7c\x94\x86\x98\x38\xbf\x0b\xba\xac\x04\x37\x57\x37\x37\xf8\xe0\x5c\x69\x18\xf7\xf7\x93\x64\x98\x0c\x71\xaf\x15\xdb\xc8\x05\x1a\x5b\x70\x80\x54\x8c\xb1\x27\x55\xf1\x31\x73\x81\x2f\x1c\xa2\x76\x16\x37\xe9\x15\xfe\x68\x01\x83\x3e\x35\xf8\xf3\xaf\x64\x88\x9d\x6b\x50\xd3\x0e\xd6\x09\x9a\xc8\x90\x4a\x47\xac\xb5\x61\xf0\x77\xc5\x5e\xa0\x2d\x94\xab\xbd\xd1\x64\x15\x63\xab\xa5\x3a\xb4\xe9\x49\xd2\x64\x88\x6f\x3d\x85\x5b\x09\x69\x0b\x82\x72\x7e\x07\xb7\x7e\x89\x03\xc9\x41\xf0\xe1\xa9\x44\x7c\x36\x1a\x6d\xb7\xdb\x94\x0e\x6a\x53\x17\xca\x91\xe9\x90\x71\x74\x9f\x4f\xee\xa6\x8b\xbb\xcb\x9b\xf4\xea\x50\xf3\x60\x0d\xc7\x88\xc0\xff\x36\x3a\x70\x81\xd5\x0e\xe4\xbd\xd1\x8a\x56\x86\x61\x68\x0b\x17\x40\x65\x60\x2e\x20\xae\x15\xbc\x0d\x5a\xb4\x2d\x2f\x10\xdd\x5a\xb6\x14\x38\x19\xa2\xd0\x51\x82\x5e\x35\x72\xb6\xad\xa3\x3c\x1d\xcf\x00\xce\x82\x2c\x06\xe3\x05\xf2\xc5\x00\xef\xc6\x8b\x7c\x71\x91\x0c\xf1\x35\x5f\x7e\x9c\x3d\x2c\xf1\x75\x3c\x9f\x8f\xa7\xcb\xfc\x6e\x81\xd9\x1c\x93\xd9\xf4\x36\x5f\xe6\xb3\xe9\x02\xb3\xf7\x18\x4f\xbf\xe1\xef\x7c\x7a\x7b\x01\xd6\x52\x71\x00\x7f\xf7\xa1\xd5\xef\x02\x74\xbb\x47\x2e\xda\xa5\x2d\x98\xcf\x04\xac\x5d\x27\x28\x7a\x56\x7a\xad\x15\x0c\xd9\xb2\xa1\x92\x51\xba\x0d\x07\xab\x6d\x09\xcf\xa1\xd6\xb1\x75\x33\x82\x6c\x91\x0c\x61\x74\xad\x85\xe4\x10\x79\x33\x54\x9a\x24\xe4\x75\xef\x7f\x06\xe5\x02\xa7\xca\x86\x3a\x55\xc6\x35\x45\x5a\x1e\x4e\x29\x55\xae\x1e\x6d\xae\xc9\xf8\x8a\xae\x93\x27\x6d\x8b\x0c\x0b\x0e\x1b\xad\xf8\x13\x79\xaf\x6d\x99\xd4\x2c\x54\x90\x50\x96\x00\x96\x6a\xce\xb0\xf1\x8a\x94\xe2\x18\x7f\xce\xd7\xe3\xa2\x27\xc5\x19\x5a\xc8\x65\xdc\x45\xe1\x3a\x69\xa7\xfb\x41\xf3\xe5\xf3\x64\x7c\xa0\x49\x80\xcd\x51\xe6\xe6\x7a\xc5\x42\xd7\x09\x10\x3b\x19\x1f\x5d\x94\xe9\xab\xb6\x5d\x2f\xf2\x3a\xf6\xfd\x02\x47\xd7\x04\xc5\xb1\x65\x07\x2e\xfb\x0e\x1d\xee\x71\xe3\xd5\x63\x57\xf9\xa8\x9c\xb5\xac\xc4\x85\xa4\xbb\xc5\x6e\xe2\x93\x92\xc9\xab\x34\x35\xe2\x3e\xb0\xe5\x40\xc2\x45\x06\x09\x0d\xf7\x19\x5d\x2c\xb9\xf6\x86\x84\x33\x0c\x7c\x70\xff\xb0\x92\x38\x7a\x7e\xee\xff\xee\xf7\x23\xe3\x54\x67\xcd\xe8\xf9\x39\x70\xa9\x9d\xdd\xef\x47\xa7\xfe\x6d\xb4\x15\xb9\xdf\x0f\xde\x30\x4e\xc8\xbe\xe3\x87\xc8\xc5\xd2\x7d\x22\x51\xd5\xbc\x9f\xae\x5b\xc3\x9a\x4c\x3c\xaa\x38\xce\x3d\xde\x90\x36\xed\xfb\x90\xdb\x71\x8c\x2c\xb9\xdd\xb0\x15\x17\x76\xe7\xf0\xa3\x95\xbd\xb5\x59\x1f\x3e\x1a\xd2\xfe\xbe\x22\xce\x6f\x7f\x80\x84\x42\xc9\xf2\x5e\xb3\x29\xce\xb0\x95\xe6\x40\x41\x55\x5a\x91\x99\xf3\x9a\x03\xdb\x93\x13\x9d\x1b\xb2\xf3\x9c\xa1\x5f\xcd\x29\x0e\x3c\xf1\xee\x14\x9e\xf3\xfa\x55\xef\x5f\x70\xad\x7b\x05\xbf\x4b\xd7\x3e\x05\x47\x15\xb4\x97\xc3\x89\xfd\x77\xf9\x22\x03\x2c\x2b\x3e\x16\x41\x2a\x92\xee\x1b\x78\x14\x81\x15\x1b\x67\xcb\x08\x71\xe9\x8b\xb2\x72\xf3\x94\x9d\xb1\x74\x87\xf4\xf9\x8d\xa6\x9f\x9e\xf6\x0b\x9e\xe0\x1a\x9f\x9d\xba\xd5\x64\xa9\xe4\xf0\x8b\xf7\xea\xff\x00\x00\x00\xff\xff\x6e\xfd\xd2\x88\x0f\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6f\xda\x4a\x13\x7d\xf7\xaf\x38\x82\x97\xef\x93\x12\x93\xf0\xe8\xfb\x44\x49\xda\x5a\x37\x85\x0a\x48\xab\x3e\x45\xc3\x7a\xb0\xf7\xc6\xde\xdd\xbb\x3b\x36\x45\xb9\xfc\xf7\x2b\x63\x03\x21\x69\xa5\x8b\x11\x42\x33\x67\xce\x9c\x99\xb3\xeb\x21\xa6\xd6\xed\xbc\xce\x0b\xc1\xf8\x66\x3c\xc6\x27\x6b\xf3\x92\xf1\xf0\x30\x8d\x86\xd1\x10\x0f\x5a\xb1\x09\x9c\xa1\x36\x19\x7b\x48\xc1\x98\x38\x52\x05\x1f\x33\x57\xf8\xc6\x3e\x68\x6b\x30\x8e\x6f\xf0\xbf\x16\x30\xe8\x53\x83\xff\xff\x11\x0d\xb1\xb3\x35\x2a\xda\xc1\x58\x41\x1d\x18\x52\xe8\x80\x8d\x2e\x19\xfc\x53\xb1\x13\x68\x03\x65\x2b\x57\x6a\x32\x8a\xb1\xd5\x52\x40\xce\xfc\x71\x34\xc4\x8f\x9e\xc2\xae\x85\xb4\x01\x41\x59\xb7\x83\xdd\xbc\xc6\x81\xe4\x20\xf8\xf0\x29\x44\x5c\x32\x1a\x6d\xb7\xdb\x98\x0e\x6a\x63\xeb\xf3\x51\xd9\x21\xc3\xe8\x21\x9d\xde\xcf\x96\xf7\xd7\xe3\xf8\xe6\x50\xf3\x68\x4a\x0e\x01\x9e\xff\xae\xb5\xe7\x0c\xeb\x1d\xc8\xb9\x52\x2b\x5a\x97\x8c\x92\xb6\xb0\x1e\x94\x7b\xe6\x0c\x62\x5b\xc1\x5b\xaf\x45\x9b\xfc\x0a\xc1\x6e\x64\x4b\x9e\xa3\x21\x32\x1d\xc4\xeb\x75\x2d\x17\xdb\x3a\xca\xd3\xe1\x02\x60\x0d\xc8\x60\x30\x59\x22\x5d\x0e\xf0\x61\xb2\x4c\x97\x57\xd1\x10\xdf\xd3\xd5\xe7\xf9\xe3\x0a\xdf\x27\x8b\xc5\x64\xb6\x4a\xef\x97\x98\x2f\x30\x9d\xcf\xee\xd2\x55\x3a\x9f\x2d\x31\xff\x88\xc9\xec\x07\xfe\x4c\x67\x77\x57\x60\x2d\x05\x7b\xf0\x4f\xe7\x5b\xfd\xd6\x43\xb7\x7b\xe4\xac\x5d\xda\x92\xf9\x42\xc0\xc6\x76\xf6\x05\xc7\x4a\x6f\xb4\x42\x49\x26\xaf\x29\x67\xe4\xb6\x61\x6f\xb4\xc9\xe1\xd8\x57\x3a\xb4\x6e\x06\x90\xc9\xa2\x21\x4a\x5d\x69\x21\x39\x44\xde\x0d\x15\x47\x11\x39\xdd\xfb\x9f\x40\x59\xcf\xb1\x32\xbe\x8a\x55\x69\xeb\x2c\xce\x0f\x47\x29\x56\xb6\x1a\x35\xb7\x54\xba\x82\x6e\xa3\x67\x6d\xb2\x04\x4b\xf6\x8d\x56\xfc\x85\x9c\xd3\x26\x8f\x2a\x16\xca\x48\x28\x89\x00\x43\x15\x27\x68\x9c\x22\xa5\x38\x84\x5f\xf3\xf5\xb8\xe0\x48\x71\x82\x16\x72\x1d\x76\x41\xb8\x8a\xda\xe9\xce\x34\xdf\xbe\x4e\x27\x07\x9a\x08\x68\x8e\x32\x9b\xdb\x35\x0b\xdd\x46\x40\xe8\x64\x7c\xb6\x41\x66\x6f\xda\x76\xbd\xc8\xe9\xd0\xf7\xf3\x1c\x6c\xed\x15\x87\x96\x1d\xb8\xee\x3b\x74\xb8\xa7\xc6\xa9\xa7\xae\xf2\x49\x59\x63\x58\x89\xf5\x07\x1c\xd0\x4d\x7c\x52\x32\x7d\x93\xa6\x5a\xec\x27\x36\xec\x49\x38\x4b\x20\xbe\xe6\x3e\xa3\xb3\x15\x57\xae\x24\xe1\x04\x03\xe7\xed\x5f\xac\x24\x8c\x5e\x5e\xfa\xbf\xfb\xfd\xa8\xb4\xaa\xb3\x66\xf4\xf2\xe2\x39\xd7\xd6\xec\xf7\xa3\x53\xff\x16\xdb\x8a\xdc\xef\x07\xef\x18\xa7\x64\x3e\xf0\x63\xe0\x6c\x65\xbf\x90\xa8\x62\xd1\x4f\xd7\xad\x61\x43\x65\x38\xaa\x38\xce\x3d\x69\x48\x97\xed\x7d\x48\xcd\x24\x04\x96\xd4\x34\x6c\xc4\xfa\xdd\x25\xfc\x68\x65\x6f\x6d\xd2\x87\x8f\x86\xb4\xbf\x6f\x88\xd3\xbb\x33\x48\xc8\xe7\x2c\x1f\x35\x97\xd9\x05\xb6\xd0\xec\xc9\xab\x42\x2b\x2a\x17\xbc\x61\xcf\xe6\xe4\x44\xfb\xbd\x86\xec\x1c\x27\xe8\x57\x73\x8a\x03\xcf\xbc\x3b\x85\x17\xbc\x79\xd3\xfb\x37\x5c\x9b\x5e\xc1\x7f\xa5\x6b\x9f\x8c\x83\xf2\xda\xb5\x76\x24\xf8\xe7\xfa\x55\x06\x58\x15\x7c\x2c\x82\x14\x24\xdd\x3b\xf0\x28\x02\x6b\x2e\xad\xc9\x03\xc4\xc6\xaf\xca\xf2\xe6\xf9\x2c\xea\x7c\x90\xbe\xbe\xd3\xf4\xcb\xa3\x7d\x7e\x72\x6f\x6b\x97\x9c\xba\x55\x64\x28\x67\xff\x9b\x7b\xf5\xef\x00\x6e\xfd\xd2\x88\x0f\x06\x00\x00"),
 		},
 		"/workflows.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "workflows.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1570,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\xd8\x97\x16\x48\xa4\x24\x47\xf5\xe4\x75\xb2\xbb\x42\xb3\x76\x61\x3b\x0d\xf6\x54\x8c\xa9\x91\xc4\x86\x22\x59\x92\xb2\xd7\x70\xfd\xdf\x0b\x59\x1f\x1b\x27\xbb\x40\x79\xb0\x89\xf9\x78\xf3\x66\xde\x50\x53\xcc\x8d\x3d\x38\x59\x56\x01\x77\x37\x77\x77\xf8\x64\x4c\xa9\x18\x8f\x8f\xf3\x68\x1a\x4d\xf1\x28\x05\x6b\xcf\x39\x1a\x9d\xb3\x43\xa8\x18\x33\x4b\xa2\xe2\xc1\x73\x85\x3f\xd9\x79\x69\x34\xee\xe2\x1b\xfc\xd2\x06\x4c\x7a\xd7\xe4\xd7\xdf\xa2\x29\x0e\xa6\x41\x4d\x07\x68\x13\xd0\x78\x46\xa8\xa4\x47\x21\x15\x83\xbf\x09\xb6\x01\x52\x43\x98\xda\x2a\x49\x5a\x30\xf6\x32\x54\xe7\x32\x3d\x48\x1c\x4d\xf1\xb5\x87\x30\xdb\x40\x52\x83\x20\x8c\x3d\xc0\x14\xaf\xe3\x40\xe1\x4c\xf8\x7c\xaa\x10\x6c\x9a\x24\xfb\xfd\x3e\xa6\x33\xdb\xd8\xb8\x32\x51\x5d\xa4\x4f\x1e\xb3\xf9\xc3\x62\xfd\x70\x7d\x17\xdf\x9c\x73\x9e\xb4\x62\xef\xe1\xf8\x9f\x46\x3a\xce\xb1\x3d\x80\xac\x55\x52\xd0\x56\x31\x14\xed\x61\x1c\xa8\x74\xcc\x39\x82\x69\x09\xef\x9d\x0c\x52\x97\x57\xf0\xa6\x08\x7b\x72\x1c\x4d\x91\x4b\x1f\x9c\xdc\x36\xe1\x62\x5a\x03\x3d\xe9\x2f\x02\x8c\x06\x69\x4c\x66\x6b\x64\xeb\x09\x3e\xcc\xd6\xd9\xfa\x2a\x9a\xe2\x39\xdb\x7c\x5e\x3e\x6d\xf0\x3c\x5b\xad\x66\x8b\x4d\xf6\xb0\xc6\x72\x85\xf9\x72\x71\x9f\x6d\xb2\xe5\x62\x8d\xe5\x47\xcc\x16\x5f\xf1\x7b\xb6\xb8\xbf\x02\xcb\x50\xb1\x03\x7f\xb3\xae\xe5\x6f\x1c\x64\x3b\x47\xce\xdb\xa1\xad\x99\x2f\x08\x14\xa6\x23\xe4\x2d\x0b\x59\x48\x01\x45\xba\x6c\xa8\x64\x94\x66\xc7\x4e\x4b\x5d\xc2\xb2\xab\xa5\x6f\xd5\xf4\x20\x9d\x47\x53\x28\x59\xcb\x40\xe1\x6c\x79\xd7\x54\x1c\x45\x64\x65\xaf\x7f\x0a\x61\x1c\xc7\x42\xbb\x3a\x16\xca\x34\x79\x5c\x9e\x57\x29\x16\xa6\x4e\x76\xb7\xa4\x6c\x45\xb7\xd1\x8b\xd4\x79\x8a\x35\xbb\x9d\x14\xfc\x85\xac\x95\xba\x8c\x6a\x0e\x94\x53\xa0\x34\x02\x34\xd5\x9c\x62\x6f\xdc\x4b\xa1\xcc\xde\xff\x18\xaf\x8f\xf3\x96\x04\xa7\x68\x43\xae\xfd\xc1\x07\xae\xa3\xb6\xbb\xef\x30\xcf\x03\x4c\x04\xec\x06\x9a\xbb\xdb\x2d\x07\xba\x8d\x00\xdf\xd1\xf8\x6c\x7c\x58\xbc\x29\xdb\xd5\x22\x2b\x7d\x5f\xcf\xb1\x37\x8d\x13\xec\x5b\x74\xe0\xba\xaf\xd0\xc5\xfd\x35\x26\x8e\xb7\xa8\xdb\xc4\xae\xdf\x91\xc7\xf3\xa5\x97\x9a\x60\x3e\xb1\x66\x47\x81\xf3\x14\xc1\x35\xdc\x7b\x64\xbe\xe1\xda\x2a\x0a\x9c\x62\x62\x9d\xf9\x9b\x45\xf0\xc9\xf1\xd8\x5f\x4f\xa7\x44\x19\xd1\xe9\x92\x1c\x8f\x8e\x4b\x69\xf4\xe9\x94\x8c\x3c\x92\xe3\xb1\x25\x78\x3a\x4d\xde\x01\xce\x49\x7f\xe0\x27\xcf\xf9\xc6\x7c\xa1\x20\xaa\x55\xdf\x59\x37\x82\x82\x94\x1f\x48\x0c\x3d\xcf\x76\x24\x55\xfb\x16\x32\x3d\xf3\x9e\x43\xa6\x77\xac\x83\x71\x87\xcb\xf0\x41\xc6\x5e\xd6\xb4\x37\x0f\x62\xb4\xbf\xa3\x49\xd1\x96\x95\x4f\xfb\xff\x37\xf5\xb2\xfb\xef\xb9\x81\x5c\xc9\xe1\xa3\x64\x95\x5f\x40\x54\x92\x1d\x39\x51\x49\x41\x6a\xc5\x05\x3b\xd6\xa3\x38\x9d\x40\xe1\x60\x39\x45\x3f\xb0\xd1\x0e\xbc\xf0\x61\x34\xaf\xb8\x78\x53\xfb\x27\x58\x45\xcf\xe0\xff\xc2\xb5\x27\x67\x2f\x9c\xb4\xe1\xbc\x75\xff\x5e\xbf\xf2\x00\x9b\x8a\x87\x24\x84\x8a\x42\xf7\x59\x1c\x48\x60\xcb\xca\xe8\xd2\x23\x98\xf8\x55\x5a\xb9\x7b\x49\x2f\x50\xba\xed\xfa\xe3\x1d\xa7\x1f\x6e\xfb\x2b\x1c\x67\x1a\x9b\x8e\xd5\x6a\xd2\x54\xb2\xfb\xc9\x53\xfb\x2f\x00\x00\xff\xff\xdf\x7f\x29\xdb\x22\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6f\xe2\x46\x10\x7e\xf7\xaf\xf8\x04\x2f\xad\x14\xec\x84\x47\xf7\x89\x23\xb9\x3b\xab\x39\xa8\x80\x34\xba\xa7\x6a\x58\x8f\xed\x6d\xd6\xbb\xdb\xdd\x35\x1c\xa2\xfc\xf7\xca\x60\x43\x48\x2e\x52\x31\x02\x6b\xe6\x9b\x6f\xbe\x99\x6f\xed\x21\xa6\xc6\xee\x9c\x2c\xab\x80\xf1\xed\x78\x8c\x2f\xc6\x94\x8a\xf1\xf8\x38\x8d\x86\xd1\x10\x8f\x52\xb0\xf6\x9c\xa3\xd1\x39\x3b\x84\x8a\x31\xb1\x24\x2a\xee\x33\x37\xf8\x93\x9d\x97\x46\x63\x1c\xdf\xe2\x97\x16\x30\xe8\x52\x83\x5f\x7f\x8b\x86\xd8\x99\x06\x35\xed\xa0\x4d\x40\xe3\x19\xa1\x92\x1e\x85\x54\x0c\xfe\x21\xd8\x06\x48\x0d\x61\x6a\xab\x24\x69\xc1\xd8\xca\x50\x21\x5c\xf8\xe3\x68\x88\xef\x1d\x85\x59\x07\x92\x1a\x04\x61\xec\x0e\xa6\x78\x8d\x03\x85\xa3\xe0\xe3\xa7\x0a\xc1\xa6\x49\xb2\xdd\x6e\x63\x3a\xaa\x8d\x8d\x2b\x13\x75\x42\xfa\xe4\x31\x9b\x3e\xcc\x96\x0f\xa3\x71\x7c\x7b\xac\x79\xd2\x8a\xbd\x87\xe3\x7f\x1a\xe9\x38\xc7\x7a\x07\xb2\x56\x49\x41\x6b\xc5\x50\xb4\x85\x71\xa0\xd2\x31\xe7\x08\xa6\x15\xbc\x75\x32\x48\x5d\xde\xc0\x9b\x22\x6c\xc9\x71\x34\x44\x2e\x7d\x70\x72\xdd\x84\xab\x6d\xf5\xf2\xa4\xbf\x02\x18\x0d\xd2\x18\x4c\x96\xc8\x96\x03\x7c\x9a\x2c\xb3\xe5\x4d\x34\xc4\x73\xb6\xfa\x3a\x7f\x5a\xe1\x79\xb2\x58\x4c\x66\xab\xec\x61\x89\xf9\x02\xd3\xf9\xec\x3e\x5b\x65\xf3\xd9\x12\xf3\xcf\x98\xcc\xbe\xe3\xf7\x6c\x76\x7f\x03\x96\xa1\x62\x07\xfe\x61\x5d\xab\xdf\x38\xc8\x76\x8f\x9c\xb7\x4b\x5b\x32\x5f\x09\x28\xcc\xc9\x3e\x6f\x59\xc8\x42\x0a\x28\xd2\x65\x43\x25\xa3\x34\x1b\x76\x5a\xea\x12\x96\x5d\x2d\x7d\xeb\xa6\x07\xe9\x3c\x1a\x42\xc9\x5a\x06\x0a\xc7\xc8\xbb\xa1\xe2\x28\x22\x2b\x3b\xff\x53\x08\xe3\x38\x16\xda\xd5\xb1\x50\xa6\xc9\xe3\xf2\x78\x94\x62\x61\xea\x64\x73\x47\xca\x56\x74\x17\xbd\x48\x9d\xa7\x58\xb2\xdb\x48\xc1\xdf\xc8\x5a\xa9\xcb\xa8\xe6\x40\x39\x05\x4a\x23\x40\x53\xcd\x29\xb6\xc6\xbd\x14\xca\x6c\xfd\xcf\xf9\x3a\x9c\xb7\x24\x38\x45\x0b\x19\xf9\x9d\x0f\x5c\x47\xed\x74\x17\x9a\xe7\x9e\x26\x02\x36\xbd\xcc\xcd\xdd\x9a\x03\xdd\x45\x80\x3f\xc9\xf8\x6a\x7c\x98\xbd\x69\x7b\xea\x45\x56\xfa\xae\x9f\x63\x6f\x1a\x27\xd8\xb7\xec\xc0\xa8\xeb\x70\xc2\xfd\x75\x2e\x3c\xdf\x1d\x51\xc0\x69\xde\xb3\x8e\xe7\xeb\x2c\x35\xc1\x7c\x61\xcd\x8e\x02\xe7\x29\x82\x6b\xb8\xcb\xc8\x7c\xc5\xb5\x55\x14\x38\xc5\xc0\x3a\xf3\x37\x8b\xe0\x93\xfd\xbe\xbb\x3d\x1c\x12\x65\xc4\xc9\x97\x64\xbf\x77\x5c\x4a\xa3\x0f\x87\xe4\xac\x23\xd9\xef\x5b\x81\x87\xc3\xe0\x1d\xe1\x94\xf4\x27\x7e\xf2\x9c\xaf\xcc\x37\x0a\xa2\x5a\x74\x93\x9d\x56\x50\x90\xf2\xbd\x88\x7e\xe6\xc9\x86\xa4\x6a\x9f\x85\x4c\x4f\xbc\xe7\x90\xe9\x0d\xeb\x60\xdc\xee\x1a\xde\xdb\xd8\xd9\x9a\x76\xe1\xde\x8c\xf6\xf7\x1c\x52\xb4\x66\xe5\xd3\xee\xff\x4d\xbf\xec\xfe\x52\x1b\xc8\x95\x1c\x3e\x4b\x56\xf9\x15\x45\x25\xd9\x91\x13\x95\x14\xa4\x16\x5c\xb0\x63\x7d\x36\xa7\xfd\x8e\x10\x76\x96\x53\x74\x0b\x3b\xc7\x81\x17\xde\x9d\xc3\x0b\x2e\xde\xf4\xfe\x80\xab\xe8\x14\xfc\x5f\xba\xf6\xca\xd9\x0b\x27\x6d\x6b\x52\x8a\x7f\x47\xaf\x32\xc0\xaa\xe2\xbe\x08\xa1\xa2\x70\x7a\x2d\xf6\x22\xb0\x66\x65\x74\xe9\x11\x4c\xfc\xaa\xac\xdc\xbc\x5c\x44\x5d\x4e\xd7\x1f\xef\x34\xfd\xf4\xb4\x5f\xae\xd2\x99\xc6\xa6\xe7\x6e\x35\x69\x2a\xd9\x7d\xf0\xa8\xfd\x37\x00\xdf\x7f\x29\xdb\x22\x06\x00\x00"),
 		},
 		"/workstations.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "workstations.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 3445,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\xec\x4b\x0b\x24\x72\xe2\xa3\x7a\xf2\x3a\xd9\x5d\xa1\x59\xbb\xb0\x9d\x06\x7b\x0a\xc6\xd4\x48\x62\x43\x91\x2c\x39\xb2\xd7\x48\xf3\xdf\x0b\x7d\x39\xb2\x9d\x00\x29\xb0\xe8\xe6\xb0\xba\x58\x9e\x79\x7c\xf3\x38\x9c\x47\x0d\x61\x6a\xec\xce\xc9\x2c\x67\x18\x5f\x8c\xc7\xf0\xc9\x98\x4c\x11\xdc\xdc\x4c\x83\x61\x30\x84\x1b\x29\x48\x7b\x4a\xa0\xd4\x09\x39\xe0\x9c\x60\x62\x51\xe4\xd4\x65\xce\xe0\x4f\x72\x5e\x1a\x0d\xe3\xf0\x02\x7e\xa9\x00\x83\x36\x35\xf8\xf5\xb7\x60\x08\x3b\x53\x42\x81\x3b\xd0\x86\xa1\xf4\x04\x9c\x4b\x0f\xa9\x54\x04\xf4\x4d\x90\x65\x90\x1a\x84\x29\xac\x92\xa8\x05\xc1\x56\x72\x5e\x97\x69\x49\xc2\x60\x08\x5f\x5b\x0a\xb3\x66\x94\x1a\x10\x84\xb1\x3b\x30\x69\x1f\x07\xc8\xb5\xe0\xfa\xc9\x99\x6d\x34\x1a\x6d\xb7\xdb\x10\x6b\xb5\xa1\x71\xd9\x48\x35\x48\x3f\xba\x89\xa7\xd7\xb3\xe5\xf5\xf9\x38\xbc\xa8\xd7\xdc\x6a\x45\xde\x83\xa3\xbf\x4b\xe9\x28\x81\xf5\x0e\xd0\x5a\x25\x05\xae\x15\x81\xc2\x2d\x18\x07\x98\x39\xa2\x04\xd8\x54\x82\xb7\x4e\xb2\xd4\xd9\x19\x78\x93\xf2\x16\x1d\x05\x43\x48\xa4\x67\x27\xd7\x25\x1f\x74\xab\x93\x27\xfd\x01\xc0\x68\x40\x0d\x83\xc9\x12\xe2\xe5\x00\x3e\x4c\x96\xf1\xf2\x2c\x18\xc2\x5d\xbc\xfa\x3c\xbf\x5d\xc1\xdd\x64\xb1\x98\xcc\x56\xf1\xf5\x12\xe6\x0b\x98\xce\x67\x57\xf1\x2a\x9e\xcf\x96\x30\xff\x08\x93\xd9\x57\xf8\x3d\x9e\x5d\x9d\x01\x49\xce\xc9\x01\x7d\xb3\xae\xd2\x6f\x1c\xc8\xaa\x8f\x94\x54\x4d\x5b\x12\x1d\x08\x48\x4d\x23\xc8\x5b\x12\x32\x95\x02\x14\xea\xac\xc4\x8c\x20\x33\x1b\x72\x5a\xea\x0c\x2c\xb9\x42\xfa\xea\x34\x3d\xa0\x4e\x82\x21\x28\x59\x48\x46\xae\x23\x27\x9b\x0a\x83\x00\xad\x6c\xcf\x3f\x02\x61\x1c\x85\x42\xbb\x22\x14\xca\x94\x49\x98\xd5\xa3\x14\x0a\x53\x8c\x36\x97\xa8\x6c\x8e\x97\xc1\x83\xd4\x49\x04\x4b\x72\x1b\x29\xe8\x0b\x5a\x2b\x75\x16\x14\xc4\x98\x20\x63\x14\x00\x68\x2c\x28\x82\xad\x71\x0f\xbe\xad\xfb\x32\x65\x0b\xf5\x16\x05\x45\x50\x41\xce\xfd\xce\x33\x15\x41\xb5\xc1\x67\xa6\xbb\x1e\x53\x00\xb0\xe9\xc4\x6e\x2e\xd7\xc4\x78\x19\x00\xf8\x46\xcc\x67\xe3\x79\x76\x5a\xbc\xa9\x88\x56\xfa\xb6\xaa\x23\x6f\x4a\x27\xc8\x57\x35\x00\xce\xdb\x3a\x0d\xee\xbe\xbf\xb6\xff\x27\x68\x06\xb3\xd9\x7e\x5f\xd3\xdd\x09\x06\x4b\x36\x9f\x48\x93\x43\xa6\x24\x02\x76\x25\xb5\x19\x99\xac\xa8\xb0\x0a\x99\x22\x18\x58\x67\xfe\x22\xc1\x7e\xf4\xf8\xd8\xbe\x3e\x3d\x8d\x94\x11\x0d\xed\xe8\xf1\xb1\x7b\x7f\x7a\x1a\xf5\x84\x4c\x55\xe9\x99\x5c\x05\xe8\x45\xef\x45\x13\xbe\x97\xc9\x11\xdc\xe8\x54\x66\x27\xe8\x3a\x7a\x02\x3e\x86\x55\xf9\xc1\x89\xf6\x29\xea\x0f\x74\xeb\x29\x59\x99\x2f\xc8\x22\x5f\xb4\x0d\x6d\x9a\x9f\xa2\xf2\xdd\x7e\xbb\x56\x4f\x36\x28\x55\xe5\xc5\x58\x4f\xbc\x27\x8e\xf5\x86\x34\x1b\xb7\x3b\x84\x77\x63\xd4\x8e\x55\xd4\x86\x5f\x98\xa9\x7b\x99\xec\x93\x0a\xd7\xa4\x7c\xd4\xfe\x1e\x55\x8e\xaf\x9e\x59\x18\x5d\x46\xfc\x51\x92\x4a\x5e\x21\xcb\x25\x39\x74\x22\x97\x02\xd5\x82\x52\x72\xa4\xf7\x73\xd2\xcc\x0a\xef\x2c\x45\xd0\x9e\xd7\x3e\x0e\xf0\x40\xbb\x7d\x78\x41\xe9\x91\x8a\x57\xb8\xd2\x56\xcb\x5b\xe9\xaa\x27\x21\x2f\x9c\xb4\x5c\x7b\xe0\x9f\xf3\x5e\x06\x60\x95\x53\xb7\x08\x38\x47\x6e\xae\xea\x4e\x04\xac\x49\x19\x9d\x79\x60\x13\xf6\x96\x65\x9b\x87\xe8\x80\xa5\x19\xf1\x3f\x4e\x34\xbd\xe8\xbd\x1e\x8f\x33\xa5\x8d\xf6\xd5\x0a\xd4\x98\x91\x7b\xd5\xfb\x6f\x36\x5e\x37\xd9\x6f\x31\xe0\xf4\x00\xfa\xc3\x7d\xf8\x3e\xad\xf3\xac\xf0\x3b\x5a\xe8\x84\xf4\xa7\x95\xde\xa3\x95\xea\x6b\xff\x4d\x4e\xea\x23\x7f\xb8\x91\xfe\xd3\x07\xed\x9d\xba\xae\x13\xf8\x3d\x4d\x77\xc4\xf9\xd3\x73\xff\x93\xe7\xfe\x0d\x00\x00\xff\xff\x3c\x8d\x42\x0c\x75\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x41\x6f\xe3\x36\x13\xbd\xeb\x57\x3c\xd8\x97\xef\x03\x12\x39\xc9\x51\x3d\x79\x9d\xec\xae\xd0\xac\x53\xd8\x4e\x83\x3d\x05\x63\x6a\x2c\xb1\xa1\x48\x96\xa4\xec\x35\xb6\xf9\xef\x85\x64\x29\x56\xec\x04\xc8\x61\xd1\xa6\xc0\x5a\x06\x2c\xcf\x3c\xbe\x79\x1c\xce\x93\x86\x98\x18\xbb\x75\x32\x2f\x02\x2e\xce\x2e\x2e\xf0\xc9\x98\x5c\x31\xae\xaf\x27\xd1\x30\x1a\xe2\x5a\x0a\xd6\x9e\x33\x54\x3a\x63\x87\x50\x30\xc6\x96\x44\xc1\x5d\xe6\x04\xbf\xb3\xf3\xd2\x68\x5c\xc4\x67\xf8\x5f\x0d\x18\xb4\xa9\xc1\xff\x7f\x89\x86\xd8\x9a\x0a\x25\x6d\xa1\x4d\x40\xe5\x19\xa1\x90\x1e\x2b\xa9\x18\xfc\x4d\xb0\x0d\x90\x1a\xc2\x94\x56\x49\xd2\x82\xb1\x91\xa1\x40\xd8\xf3\xc7\xd1\x10\x5f\x5b\x0a\xb3\x0c\x24\x35\x08\xc2\xd8\x2d\xcc\xaa\x8f\x03\x85\x46\x70\xf3\x29\x42\xb0\xc9\x68\xb4\xd9\x6c\x62\x6a\xd4\xc6\xc6\xe5\x23\xb5\x43\xfa\xd1\x75\x3a\xb9\x9a\xce\xaf\x4e\x2f\xe2\xb3\x66\xcd\xad\x56\xec\x3d\x1c\xff\x59\x49\xc7\x19\x96\x5b\x90\xb5\x4a\x0a\x5a\x2a\x86\xa2\x0d\x8c\x03\xe5\x8e\x39\x43\x30\xb5\xe0\x8d\x93\x41\xea\xfc\x04\xde\xac\xc2\x86\x1c\x47\x43\x64\xd2\x07\x27\x97\x55\x78\xd6\xad\x4e\x9e\xf4\xcf\x00\x46\x83\x34\x06\xe3\x39\xd2\xf9\x00\x1f\xc6\xf3\x74\x7e\x12\x0d\x71\x97\x2e\x3e\xdf\xdc\x2e\x70\x37\x9e\xcd\xc6\xd3\x45\x7a\x35\xc7\xcd\x0c\x93\x9b\xe9\x65\xba\x48\x6f\xa6\x73\xdc\x7c\xc4\x78\xfa\x15\xbf\xa6\xd3\xcb\x13\xb0\x0c\x05\x3b\xf0\x37\xeb\x6a\xfd\xc6\x41\xd6\x7d\xe4\xac\x6e\xda\x9c\xf9\x99\x80\x95\xd9\x1d\x9f\xb7\x2c\xe4\x4a\x0a\x28\xd2\x79\x45\x39\x23\x37\x6b\x76\x5a\xea\x1c\x96\x5d\x29\x7d\x7d\x9a\x1e\xa4\xb3\x68\x08\x25\x4b\x19\x28\x34\x91\xa3\x4d\xc5\x51\x44\x56\xb6\xe7\x9f\x40\x18\xc7\xb1\xd0\xae\x8c\x85\x32\x55\x16\xe7\xcd\x28\xc5\xc2\x94\xa3\xf5\x39\x29\x5b\xd0\x79\xf4\x20\x75\x96\x60\xce\x6e\x2d\x05\x7f\x21\x6b\xa5\xce\xa3\x92\x03\x65\x14\x28\x89\x00\x4d\x25\x27\xd8\x18\xf7\xe0\xdb\xba\x2f\x53\xb6\x50\x6f\x49\x70\x82\x1a\x72\xea\xb7\x3e\x70\x19\xd5\x1b\xdc\x33\xdd\xf5\x98\x22\x60\xdd\x89\x5d\x9f\x2f\x39\xd0\x79\x04\xf8\x9d\x98\xcf\xc6\x87\xe9\x71\xf1\x5d\x45\xb2\xd2\xb7\x55\x1d\x7b\x53\x39\xc1\xbe\xae\x01\x9c\xb6\x75\x76\xb8\xfb\xfe\xda\xfe\x9f\x06\x0b\xec\xb6\xdf\xd7\x74\x77\x84\xa1\x2a\x98\x4f\xac\xd9\x51\xe0\x2c\x41\x70\x15\xb7\x19\x99\x2d\xb8\xb4\x8a\x02\x27\x18\x58\x67\xfe\x60\x11\xfc\xe8\xfb\xf7\xf6\xf6\xf1\x71\xa4\x8c\x68\xa8\xea\x68\x77\xff\xf8\x38\xea\x09\x99\xa8\xca\x07\x76\x35\xa0\x17\xbd\x17\xbb\xf0\xbd\xcc\x0e\xe0\x46\xaf\x64\x7e\x84\x6e\xa2\x47\xe0\x43\x58\x9d\x1f\x1c\x69\x9f\x90\xfe\xc0\xb7\x9e\xb3\x85\xf9\x42\x41\x14\xb3\xb6\xa1\xbb\xe6\xaf\x48\xf9\x6e\xbf\x5d\xab\xc7\x6b\x92\xaa\xf6\x62\xaa\xc7\xde\x73\x48\xf5\x9a\x75\x30\x6e\xfb\x1c\xde\x8d\x51\x3b\x56\x49\x1b\x7e\x61\xa6\xee\x65\xf6\x94\x54\xb4\x64\xe5\x93\xf6\xf7\xa0\x72\x7a\xb9\x67\x09\xe4\x72\x0e\x1f\x25\xab\xec\x15\xb2\x42\xb2\x23\x27\x0a\x29\x48\xcd\x78\xc5\x8e\xf5\xd3\x9c\xd4\xdf\x53\x84\xad\xe5\x04\xed\x79\x3d\xc5\x81\x07\xde\x3e\x85\x67\xbc\x3a\x50\xf1\x0a\xd7\xaa\xd5\xf2\x56\xba\xfa\xca\xd8\x0b\x27\x6d\x7d\xb4\x09\xfe\x3a\xed\x65\x80\x45\xc1\xdd\x22\x84\x82\xc2\xee\x51\xdd\x89\xc0\x92\x95\xd1\xb9\x47\x30\x71\x6f\x59\xbe\x7e\xd8\x8b\xda\x8f\xf8\x6f\x47\x9a\x5e\xf4\xde\xfe\xca\x9d\xa9\x6c\xf2\x54\xad\x24\x4d\x39\xbb\x57\xbd\xff\x66\xe3\x75\x93\xfd\x16\x03\x4e\x9e\x41\xff\x75\x1f\xbe\x4f\xeb\xec\x15\xfe\x40\x0b\x1d\x91\xfe\xb4\xd2\x7b\xb4\x52\xf3\xd8\x7f\x93\x93\xfa\xc8\xff\xd6\x0b\xed\x9d\xba\xae\x13\xf8\x23\x4d\x77\xc0\xf9\xd3\x73\xff\x90\xe7\xfe\x1e\x00\x3c\x8d\x42\x0c\x75\x0d\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{